@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"github.com/usalko/prodl/internal/archive_stream"
+	"github.com/usalko/prodl/internal/dump_writer"
+	"github.com/usalko/prodl/internal/load_plan"
+	"github.com/usalko/prodl/internal/reject_file"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_transpiler"
+)
+
+// convertCmd represents the convert command
+var convertCmd = &cobra.Command{
+	Use:   "convert",
+	Short: "The 'convert' subcommand will convert dump to the other sql dialect.",
+	Long: `The 'convert' subcommand transpiles a sql dump to the dialect of the target and writes
+the new dump file without a database connection. For example:
+
+'<cmd> convert --from mysql --to psql mysqldump.sql.gz -o pg_dump.sql.zst'.
+
+The output file is compressed by the extension (.gz, .zst), the statements of every table
+are written to the separate file with the --split-per-table option.`,
+	Args: cobra.RangeArgs(1, MAX_COUNT_FOR_PROCESSING_FILES),
+	Run: func(cmd *cobra.Command, args []string) {
+		debugLevel, _ := cmd.Flags().GetInt("debug-level")
+		from, _ := cmd.Flags().GetString("from")
+		sourceDialect, err := (*dialect.SqlDialect).ParseName(nil, from)
+		if err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return
+		}
+		to, _ := cmd.Flags().GetString("to")
+		targetDialect, err := (*dialect.SqlDialect).ParseName(nil, to)
+		if err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return
+		}
+		transpiler, err := sql_transpiler.NewTranspiler(sourceDialect, targetDialect)
+		if err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return
+		}
+		enumModeValue, _ := cmd.Flags().GetString("enum-mode")
+		enumMode, err := sql_transpiler.ParseEnumMode(enumModeValue)
+		if err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return
+		}
+		transpiler.SetEnumMode(enumMode)
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+		transpiler.SetBatchSize(batchSize)
+
+		outputFileName, _ := cmd.Flags().GetString("output")
+		splitPerTable, _ := cmd.Flags().GetBool("split-per-table")
+		dumpWriter, err := dump_writer.Create(outputFileName, splitPerTable)
+		if err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return
+		}
+
+		failed := false
+		for _, fileName := range args {
+			rootCmd.Printf("process file %v", fileName)
+			err := convertFile(fileName, sourceDialect, transpiler, dumpWriter, debugLevel)
+			if err != nil {
+				failed = true
+				rootCmd.Println(" - fail")
+				rootCmd.Println()
+				rootCmd.PrintErrf("Error is %v", err)
+				rootCmd.PrintErrln()
+			} else {
+				rootCmd.Println(" - ok")
+			}
+		}
+		if err := dumpWriter.Close(); err != nil {
+			rootCmd.PrintErrf("close dump file fail: %s\n", err)
+			failed = true
+		}
+		for _, fileName := range dumpWriter.FileNames() {
+			rootCmd.Printf("write file %v\n", fileName)
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	convertCmd.Flags().StringP("from", "f", "mysql", `
+Sql dialect of the dump (mysql|psql|sqlite3)
+`)
+	convertCmd.Flags().StringP("to", "t", "psql", `
+Sql dialect of the converted dump (mysql|psql|sqlite3),
+the psql dump is converted to sqlite3 and mysql, the mysql dump is converted to psql
+`)
+	convertCmd.Flags().StringP("output", "o", "", `
+Converted dump file name, the file is compressed by the extension (.gz - gzip, .zst - zstd),
+the converted dump is written to the standard output by default
+`)
+	convertCmd.Flags().Bool("split-per-table", false, `
+Write the statements of every table to the separate file <output>.<table>.sql[.gz|.zst],
+the statements following the tables (views, foreign keys) are written to <output>.post-data.sql[.gz|.zst],
+the files are applied in the order: output file, table files, post-data file
+`)
+	convertCmd.Flags().Int("batch-size", sql_transpiler.DEFAULT_BATCH_SIZE, `
+Maximal count of rows in the INSERT made of the COPY data of the psql dump (sqlite3 and mysql),
+and in the COPY made of the extended INSERT of the mysql dump (psql), 0 - all rows of the statement
+`)
+	convertCmd.Flags().String("enum-mode", "check", `
+Transpilation of the MySQL ENUM columns for the psql dump:
+
+	check	text column with the check constraint of the labels
+	type	column of the enum type <table>_<column> made by CREATE TYPE
+
+`)
+	convertCmd.Flags().IntP("debug-level", "d", 0, `
+Debug level:
+
+	0 no debug messages
+	1 show debug messages
+	2 show advanced debug messages
+
+`)
+	rootCmd.AddCommand(convertCmd)
+}
+
+func convertFile(fileName string, sourceDialect dialect.SqlDialect, transpiler *sql_transpiler.Transpiler,
+	dumpWriter *dump_writer.DumpWriter, debugLevel int) error {
+	respBody, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("file %s open error (%v)", fileName, err)
+	}
+	defer respBody.Close()
+
+	reader := archive_stream.NewReader(respBody)
+
+	for {
+		entry, err := reader.GetNextEntry()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to get next entry (%v)", err)
+		}
+		if entry.IsDir() {
+			continue
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("unable to open file: %s", err)
+		}
+
+		statementsCount := int64(0)
+		offset := int64(0)
+		line := int64(1)
+		sourcePosition := sql_parser.StartPosition
+		var writeError error
+		entryReader := &stoppableReader{reader: rc}
+		sql_parser.StatementStreamWithMode(entryReader, sourceDialect, sql_parser.PARSE_ALL,
+			func(statementText string, statement ast.Statement, parseError error) {
+				statementsCount++
+				position := reject_file.Position{
+					FileName:  fileName,
+					Entry:     entry.GetName(),
+					Statement: statementsCount,
+					Offset:    offset,
+					Line:      line + int64(strings.Count(statementText[:len(statementText)-len(strings.TrimLeftFunc(statementText, unicode.IsSpace))], "\n")),
+				}
+				offset += int64(len(statementText))
+				line += int64(strings.Count(statementText, "\n"))
+				statementPosition := sourcePosition
+				sourcePosition = sourcePosition.Advance(statementText)
+				if writeError != nil {
+					return
+				}
+				tableName := load_plan.StatementTable(statement)
+				sourceText := strings.TrimSpace(ast.StripLeadingComments(statementText))
+				if parseError != nil || statement == nil {
+					// The statement is left in the source dialect as the comment for the review
+					if parseError != nil {
+						reportParseError(fileName, entry.GetName(), statementText, statementPosition, sourceDialect, parseError, debugLevel)
+					}
+					tableName = load_plan.StatementTextTable(statementText)
+					if writeError = dumpWriter.WriteComment(tableName, fmt.Sprintf("not converted statement at %v: parse error\n%s", position, sourceText)); writeError != nil {
+						entryReader.Stop()
+					}
+					return
+				}
+				statementTexts, transpileError := transpiler.Transpile(statementText, statement)
+				if transpileError != nil {
+					// The statements without counterpart in the target dialect are left as the comments for the review
+					rootCmd.PrintErrf("skip statement at %v: %s\n", position, transpileError)
+					writeError = dumpWriter.WriteComment(tableName, fmt.Sprintf("skip statement at %v: %s\n%s", position, transpileError, sourceText))
+				}
+				for _, outputText := range statementTexts {
+					if writeError != nil {
+						break
+					}
+					writeError = dumpWriter.Write(tableName, outputText)
+				}
+				if writeError != nil {
+					entryReader.Stop()
+				}
+			})
+		if err := rc.Close(); err != nil {
+			rootCmd.PrintErrf("close entry reader fail: %s", err)
+		}
+		if writeError != nil {
+			return writeError
+		}
+	}
+	// The foreign keys of the MySQL dump are added after the tables and the data
+	for _, statementText := range transpiler.Finish() {
+		if err := dumpWriter.Write("", statementText); err != nil {
+			return err
+		}
+	}
+	return nil
+}
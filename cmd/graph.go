@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"html"
 	"io"
 	"os"
 	"regexp"
@@ -38,7 +39,12 @@ var graphCmd = &cobra.Command{
 
 		saveDatabaseStructure(cmd, debugLevel)
 
-		dumpSqlDialect := dialect.PSQL
+		from, _ := cmd.Flags().GetString("from")
+		dumpSqlDialect, err := (*dialect.SqlDialect).ParseName(nil, from)
+		if err != nil {
+			rootCmd.PrintErrf("Error is %v\n", err)
+			return
+		}
 
 		// Open reader and do StatementStream
 		for _, fileName := range args {
@@ -72,6 +78,10 @@ Sql url for loading dump file. Examples:
     sqlite3://./local.sqlite3?cache=shared   // [Sqlite3]
     pg://username:password@localhost:5432/database_name    // [PostgresQL]
 
+`)
+	graphCmd.Flags().StringP("from", "f", "psql", `
+Sql dialect of the dump files: mysql, psql or sqlite3.
+
 `)
 	graphCmd.Flags().IntP("debug-level", "d", 0, `
 Debug level:
@@ -113,6 +123,23 @@ type Field struct {
 	Type                  string
 }
 
+type Index struct {
+	Label  string
+	Unique bool
+}
+
+type Trigger struct {
+	Label string
+}
+
+type Policy struct {
+	Label string
+}
+
+type Partition struct {
+	Label string
+}
+
 type Table struct {
 	SchemaName    string
 	Name          string
@@ -121,6 +148,10 @@ type Table struct {
 	DisableFields bool
 	Fields        []*Field
 	Relations     []*Relation
+	Indexes       []*Index
+	Triggers      []*Trigger
+	Policies      []*Policy
+	Partitions    []*Partition
 }
 
 type Graph struct {
@@ -175,6 +206,26 @@ func (dg *DiGraph) getTable(schemaName string, tableName string) *Table {
 	return dg.Graphs[0].Tables[tableIndex]
 }
 
+// attachPartition removes the partition table from the graph and rolls it up to the partitioned table,
+// so the partitioned table is drawn as one logical table
+func (dg *DiGraph) attachPartition(parent *Table, schemaName string, tableName string, bound *ast.PartitionValueRange) {
+	parent.Partitions = append(parent.Partitions, &Partition{
+		Label: html.EscapeString("partition " + partitionDescription(tableName, bound)),
+	})
+	if len(dg.Graphs) == 0 {
+		return
+	}
+	tableIndex := slices.IndexFunc(dg.Graphs[0].Tables, func(table *Table) bool {
+		return table.Name == tableName && table.SchemaName == schemaName
+	})
+	if tableIndex < 0 {
+		return
+	}
+	// The nested partitions go along with the partition
+	parent.Partitions = append(parent.Partitions, dg.Graphs[0].Tables[tableIndex].Partitions...)
+	dg.Graphs[0].Tables = slices.Delete(dg.Graphs[0].Tables, tableIndex, tableIndex+1)
+}
+
 func getComprehensiveDotFileName(dumpFileName string) string {
 	return dumpFileName + ".dot"
 }
@@ -220,6 +271,7 @@ func processFileForGraph(
 
 	reader := archive_stream.NewReader(respBody)
 	dumpGraph := newDigraph()
+	userTypes := sql_parser.NewUserTypes()
 	for {
 		entry, err := reader.GetNextEntry()
 		if err == io.EOF {
@@ -243,34 +295,111 @@ func processFileForGraph(
 
 			statementsCount := 0
 			lastTime := time.Now()
-			sql_parser.StatementStream(rc, sqlDialect,
+			position := sql_parser.StartPosition
+			sql_parser.StatementStreamWithMode(rc, sqlDialect, sql_parser.PARSE_DDL,
 				func(statementText string, statement ast.Statement, parseError error) {
 					if parseError != nil {
-						if debugLevel >= 1 {
-							rootCmd.PrintErrf("parse sql statement:\n %s \n\nfail: %s\n", statementText, parseError)
-						} else {
-							rootCmd.PrintErrf("%s\n", parseError)
-						}
+						reportParseError(fileName, entry.GetName(), statementText, position, sqlDialect, parseError, debugLevel)
 					}
+					position = position.Advance(statementText)
+
+					userTypes.Register(statement)
 
 					createStatement, ok := statement.(*ast.CreateTable)
 					if ok {
 						fields := make([]*Field, 0, 10)
 						for _, column := range createStatement.TableSpec.Columns {
+							columnType := column.Type.Type
+							if userType, ok := userTypes.Resolve(columnType); ok {
+								columnType = html.EscapeString(columnType + ": " + userType)
+							}
 							fields = append(fields, &Field{
 								Label: column.Name.Val,
-								Type:  column.Type.Type,
+								Type:  columnType,
 							})
 						}
 
 						relations := make([]*Relation, 0, 5)
+						for _, column := range createStatement.TableSpec.Columns {
+							if column.Type.Options != nil && column.Type.Options.Reference != nil {
+								relations = append(relations, &Relation{
+									NeedsNode:    true,
+									TargetSchema: column.Type.Options.Reference.ReferencedTable.Qualifier.V,
+									Target:       column.Type.Options.Reference.ReferencedTable.Name.V,
+									SchemaName:   createStatement.Table.Qualifier.V,
+									Name:         createStatement.Table.Name.V,
+									Label:        column.Name.Val,
+								})
+							}
+						}
+						for _, constraint := range createStatement.TableSpec.Constraints {
+							if foreignKeyDefinition, ok := constraint.Details.(*ast.ForeignKeyDefinition); ok {
+								relations = append(relations, &Relation{
+									NeedsNode:    true,
+									TargetSchema: foreignKeyDefinition.ReferenceDefinition.ReferencedTable.Qualifier.V,
+									Target:       foreignKeyDefinition.ReferenceDefinition.ReferencedTable.Name.V,
+									SchemaName:   createStatement.Table.Qualifier.V,
+									Name:         createStatement.Table.Name.V,
+									Label:        constraint.Name.Val,
+								})
+							}
+						}
 
 						dumpGraph.addTable(createStatement.Table.Name.V, createStatement.Table.Qualifier.V, fields, relations)
+
+						tableSpec := createStatement.TableSpec
+						if tableSpec.PartitionOption != nil {
+							table := dumpGraph.getTable(createStatement.Table.Qualifier.V, createStatement.Table.Name.V)
+							table.Partitions = append(table.Partitions, &Partition{
+								Label: html.EscapeString(partitionKeyDescription(tableSpec.PartitionOption)),
+							})
+						}
+						if !tableSpec.PartitionOf.IsEmpty() {
+							parent := dumpGraph.getTable(tableSpec.PartitionOf.Qualifier.V, tableSpec.PartitionOf.Name.V)
+							if parent != nil {
+								dumpGraph.attachPartition(parent, createStatement.Table.Qualifier.V, createStatement.Table.Name.V, tableSpec.PartitionBound)
+							}
+						}
+					}
+
+					createIndex, ok := statement.(*ast.CreateIndex)
+					if ok {
+						table := dumpGraph.getTable(createIndex.Table.Qualifier.V, createIndex.Table.Name.V)
+						if table != nil {
+							table.Indexes = append(table.Indexes, &Index{
+								Label:  html.EscapeString(indexDescription(createIndex)),
+								Unique: createIndex.Unique,
+							})
+						}
+					}
+
+					createTrigger, ok := statement.(*ast.CreateTrigger)
+					if ok {
+						table := dumpGraph.getTable(createTrigger.Table.Qualifier.V, createTrigger.Table.Name.V)
+						if table != nil {
+							table.Triggers = append(table.Triggers, &Trigger{
+								Label: html.EscapeString(triggerDescription(createTrigger)),
+							})
+						}
+					}
+
+					createPolicy, ok := statement.(*ast.CreatePolicy)
+					if ok {
+						table := dumpGraph.getTable(createPolicy.Table.Qualifier.V, createPolicy.Table.Name.V)
+						if table != nil {
+							table.Policies = append(table.Policies, &Policy{
+								Label: html.EscapeString(policyDescription(createPolicy)),
+							})
+						}
 					}
 
 					alterTable, ok := statement.(*ast.AlterTable)
 					if ok {
 						table := dumpGraph.getTable(alterTable.Table.Qualifier.V, alterTable.Table.Name.V)
+						if table != nil && alterTable.PartitionSpec != nil && alterTable.PartitionSpec.Action == ast.AttachAction {
+							partition := alterTable.PartitionSpec.TableName
+							dumpGraph.attachPartition(table, partition.Qualifier.V, partition.Name.V, alterTable.PartitionSpec.Bound)
+						}
 						if table != nil {
 							for _, alterOption := range alterTable.AlterOptions {
 								addConstraintDefinition, ok := alterOption.(*ast.AddConstraintDefinition)
@@ -325,6 +454,7 @@ func saveDatabaseStructure(cmd *cobra.Command, debugLevel int) {
 		rootCmd.PrintErrf("establish connection for target url %v fail with error: %v\n", targetSqlUrl, err)
 		return
 	}
+	defer connection.Close()
 
 	// Test connection to the database
 	err = connection.Execute("select 1")
@@ -1,70 +1,249 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
 
 	"github.com/spf13/cobra"
 	"github.com/usalko/prodl/internal/archive_stream"
+	"github.com/usalko/prodl/internal/checkpoint"
+	"github.com/usalko/prodl/internal/load_plan"
+	"github.com/usalko/prodl/internal/reject_file"
 	"github.com/usalko/prodl/internal/sql_connection"
 	"github.com/usalko/prodl/internal/sql_parser"
 	"github.com/usalko/prodl/internal/sql_parser/ast"
 	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_parser_errors"
+	"github.com/usalko/prodl/internal/sql_transpiler"
 )
 
 const MAX_COUNT_FOR_PROCESSING_FILES = 1024
 
+// Exit codes of the load command
+const (
+	EXIT_OK      = 0 // All statements are executed
+	EXIT_ERROR   = 1 // The load isn't started: wrong options, the target is unavailable
+	EXIT_PARTIAL = 2 // The load is done, but some statements or files failed
+	EXIT_ABORTED = 3 // The load is aborted by --on-error stop, --max-errors or the failed single transaction
+)
+
+var (
+	ErrTooManyErrors           = errors.New("too many errors")
+	ErrSingleTransactionFailed = errors.New("single transaction failed")
+	ErrLoadStopped             = errors.New("load is stopped on error")
+)
+
 // loadCmd represents the load command
 var loadCmd = &cobra.Command{
 	Use:   "load",
 	Short: "The 'load' subcommand will load dump to the database.",
 	Long: `The 'load' subcommand loads a sql dump to the database. For example:
 
-'<cmd> load --to sqlite3://./local.sqlite3 dump-file-name.tar.gz'.`,
+'<cmd> load --to sqlite3://./local.sqlite3 dump-file-name.tar.gz'.
+
+The dump of the other dialect (--from) is transpiled to the dialect of the target
+before the execution, for example the PostgreSQL dump is loaded to SQLite by:
+
+'<cmd> load --from psql -c sqlite3://./local.sqlite3 pg_dump.sql'.
+'<cmd> load --from mysql -c pg://user:password@localhost:5432/db mysqldump.sql.gz'.
+
+The summary of the executed and failed statements is printed at the end, the successful statements
+of the dump transaction (BEGIN ... COMMIT) rolled back by the aborted load are counted as rolled back,
+the exit codes are:
+
+	0	all statements are executed
+	1	the load isn't started (wrong options, the target is unavailable)
+	2	the load is done, but some statements or files failed
+	3	the load is aborted (--on-error stop, --max-errors, the failed single transaction)`,
 	Args: cobra.RangeArgs(1, MAX_COUNT_FOR_PROCESSING_FILES),
 	Run: func(cmd *cobra.Command, args []string) {
-		debugLevel, _ := cmd.Flags().GetInt("debug-level")
-		targetSqlUrl, _ := cmd.Flags().GetString("target-sql-connection")
-		sqlDialect, connectionOptions, err := (*dialect.SqlDialect).ParseUrl(nil, targetSqlUrl)
+		if exitCode := load(cmd, args); exitCode != EXIT_OK {
+			os.Exit(exitCode)
+		}
+	},
+}
+
+// load executes the load command and returns the exit code of the process
+func load(cmd *cobra.Command, args []string) int {
+	debugLevel, _ := cmd.Flags().GetInt("debug-level")
+	targetSqlUrl, _ := cmd.Flags().GetString("target-sql-connection")
+	parseModeValue, _ := cmd.Flags().GetString("parse")
+	parseMode, err := sql_parser.ParseParseMode(parseModeValue)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	state, err := openLoadState(cmd)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	options := &loadOptions{
+		parseMode:  parseMode,
+		state:      state,
+		debugLevel: debugLevel,
+	}
+	options.maxErrors, _ = cmd.Flags().GetInt64("max-errors")
+	errorPolicyValue, _ := cmd.Flags().GetString("on-error")
+	options.errorPolicy, err = sql_connection.ParseErrorPolicy(errorPolicyValue)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	transactionModeValue, _ := cmd.Flags().GetString("transaction-mode")
+	options.transactionMode, err = sql_connection.ParseTransactionMode(transactionModeValue)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	rejectFileName, _ := cmd.Flags().GetString("reject-file")
+	if rejectFileName != "" {
+		options.rejectFile, err = reject_file.Open(rejectFileName)
 		if err != nil {
-			rootCmd.PrintErrf("parse target url %v fail with error: %v\n", targetSqlUrl, err)
-			return
+			rootCmd.PrintErrf("%v\n", err)
+			return EXIT_ERROR
 		}
-		connection, err := sql_connection.Connect(sqlDialect)
+		defer func() {
+			if err := options.rejectFile.Close(); err != nil {
+				rootCmd.PrintErrf("close reject file fail: %s\n", err)
+			}
+		}()
+	}
+	sqlDialect, connectionOptions, err := (*dialect.SqlDialect).ParseUrl(nil, targetSqlUrl)
+	if err != nil {
+		rootCmd.PrintErrf("parse target url %v fail with error: %v\n", targetSqlUrl, err)
+		return EXIT_ERROR
+	}
+	sourceDialect := sqlDialect
+	if from, _ := cmd.Flags().GetString("from"); from != "" {
+		sourceDialect, err = (*dialect.SqlDialect).ParseName(nil, from)
 		if err != nil {
-			rootCmd.PrintErrf("make connection structure for target url %v fail with error: %v\n", targetSqlUrl, err)
-			return
+			rootCmd.PrintErrf("%v\n", err)
+			return EXIT_ERROR
 		}
+	}
+	options.transpiler, err = sql_transpiler.NewTranspiler(sourceDialect, sqlDialect)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	// The transpiler keeps the catalog of the whole dump schema, the skipped statements don't fill it
+	if options.state != nil && !options.transpiler.IsIdentity() {
+		rootCmd.PrintErrf("--from of the other dialect can't be combined with --state-file and --resume\n")
+		return EXIT_ERROR
+	}
+	enumModeValue, _ := cmd.Flags().GetString("enum-mode")
+	enumMode, err := sql_transpiler.ParseEnumMode(enumModeValue)
+	if err != nil {
+		rootCmd.PrintErrf("%v\n", err)
+		return EXIT_ERROR
+	}
+	options.transpiler.SetEnumMode(enumMode)
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	options.transpiler.SetBatchSize(batchSize)
+	if deferConstraints, _ := cmd.Flags().GetBool("defer-constraints"); deferConstraints {
+		if err := checkDeferConstraintsOptions(options); err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return EXIT_ERROR
+		}
+		options.transpiler.SetDeferConstraints(true)
+	}
+	connection, err := sql_connection.Connect(sqlDialect)
+	if err != nil {
+		rootCmd.PrintErrf("make connection structure for target url %v fail with error: %v\n", targetSqlUrl, err)
+		return EXIT_ERROR
+	}
 
-		err = connection.Establish(connectionOptions)
+	err = connection.Establish(connectionOptions)
+	if err != nil {
+		rootCmd.PrintErrf("establish connection for target url %v fail with error: %v\n", targetSqlUrl, err)
+		return EXIT_ERROR
+	}
+	defer func() {
+		if err := connection.Close(); err != nil {
+			rootCmd.PrintErrf("close connection fail: %s\n", err)
+		}
+	}()
+	connection.SetTransactionMode(options.transactionMode)
+	options.jobs, _ = cmd.Flags().GetInt("jobs")
+	if options.jobs > 1 {
+		if err := checkJobsOptions(sqlDialect, options); err != nil {
+			rootCmd.PrintErrf("%v\n", err)
+			return EXIT_ERROR
+		}
+	}
+	if options.jobs > 1 {
+		options.plan, err = load_plan.NewPlan("")
 		if err != nil {
-			rootCmd.PrintErrf("establish connection for target url %v fail with error: %v\n", targetSqlUrl, err)
-			return
+			rootCmd.PrintErrf("%v\n", err)
+			return EXIT_ERROR
 		}
+		defer options.plan.Close()
+	}
 
-		// Test connection to the database
-		err = connection.Execute("select 1")
+	// Test connection to the database
+	err = connection.Execute("select 1")
+	if err != nil {
+		rootCmd.PrintErrf("check connection for target url %v fail with error: %v\n", targetSqlUrl, err)
+		return EXIT_ERROR
+	}
+	rootCmd.Printf("connection established\n")
+	// Open reader and do StatementStream
+	for _, fileName := range args {
+		rootCmd.Printf("process file %v", fileName)
+		err := processFile(fileName, sourceDialect, connection, options)
 		if err != nil {
-			rootCmd.PrintErrf("check connection for target url %v fail with error: %v\n", targetSqlUrl, err)
-			return
-		}
-		rootCmd.Printf("connection established\n")
-		// Open reader and do StatementStream
-		for _, fileName := range args {
-			rootCmd.Printf("process file %v", fileName)
-			err := processFile(fileName, sqlDialect, connection, debugLevel)
-			if err != nil {
-				rootCmd.Println(" - fail")
-				rootCmd.Println()
-				rootCmd.PrintErrf("Error is %v", err)
-				rootCmd.PrintErrln()
-			} else {
-				rootCmd.Println(" - ok")
-			}
+			options.failedFiles++
+			rootCmd.Println(" - fail")
+			rootCmd.Println()
+			rootCmd.PrintErrf("Error is %v", err)
+			rootCmd.PrintErrln()
+		} else {
+			rootCmd.Println(" - ok")
 		}
-	},
+		if errors.Is(err, ErrTooManyErrors) || (err != nil && options.errorPolicy.Kind == sql_connection.ON_ERROR_STOP) {
+			options.stopped = true
+			break
+		}
+		if err != nil && options.transactionMode.Kind == sql_connection.TRANSACTION_SINGLE {
+			options.singleTransactionFailed = true
+			break
+		}
+	}
+	if options.plan != nil && !options.stopped {
+		if err := runJobs(sqlDialect, connectionOptions, connection, options); err != nil {
+			rootCmd.PrintErrf("Error is %v\n", err)
+			options.stopped = true
+		}
+	}
+	if len(options.constraints) > 0 && !options.stopped && !options.singleTransactionFailed {
+		if err := applyConstraints(connection, options); err != nil {
+			rootCmd.PrintErrf("Error is %v\n", err)
+			options.stopped = true
+		}
+	}
+	aborted := options.stopped || options.TooManyErrors()
+	if options.transactionMode.Kind == sql_connection.TRANSACTION_SINGLE && !options.finishSingleTransaction(connection) {
+		aborted = true
+	}
+	if aborted && connection.InDumpTransaction() {
+		options.rollBackDumpTransaction()
+	}
+	options.printSummary(aborted)
+	switch {
+	case aborted:
+		return EXIT_ABORTED
+	case options.errorsCount > 0 || options.failedFiles > 0:
+		return EXIT_PARTIAL
+	}
+	return EXIT_OK
 }
 
 func init() {
@@ -75,6 +254,89 @@ Sql url for loading dump file. Examples:
     sqlite3://./local.sqlite3?cache=shared   // [Sqlite3]
     pg://username:password@localhost:5432/database_name    // [PostgresQL]
 
+`)
+	loadCmd.Flags().StringP("from", "f", "", `
+Sql dialect of the dump (mysql|psql|sqlite3), the dialect of the target by default.
+The statements of the psql dump are transpiled for the sqlite3 and mysql targets,
+the statements of the mysql dump are transpiled for the pg target,
+the statements without counterpart in the target dialect are skipped with the warning
+`)
+	loadCmd.Flags().Int("batch-size", sql_transpiler.DEFAULT_BATCH_SIZE, `
+Maximal count of rows in the INSERT made of the COPY data of the psql dump (sqlite3 and mysql),
+and in the COPY made of the extended INSERT of the mysql dump (psql), 0 - all rows of the statement
+`)
+	loadCmd.Flags().String("transaction-mode", "none", `
+Transactions of the load, the session state (SET statements) is kept for the whole load:
+
+	none		commit every statement
+	single		load everything in one transaction, the first error rolls back the load
+			and leaves the target untouched (MySQL commits DDL statements implicitly)
+	per-entry	commit the statements of every file (archive entry) together
+	per-table	commit the statements of every table together
+	batch:N		commit every N statements together
+
+The failed statement doesn't roll back the other statements of the transaction
+(except single), the checkpoints of the --state-file commit the transaction
+`)
+	loadCmd.Flags().String("enum-mode", "check", `
+Transpilation of the MySQL ENUM columns for the pg target:
+
+	check	text column with the check constraint of the labels
+	type	column of the enum type <table>_<column> made by CREATE TYPE
+
+`)
+	loadCmd.Flags().String("parse", "all", `
+Parse mode for the statements before execution:
+
+	none	only split the dump into statements
+	ddl	parse schema statements, pass data statements (INSERT, COPY ...) as is
+	all	parse every statement
+
+`)
+	loadCmd.Flags().String("state-file", "", `
+Save checkpoints of the load to the state file (json), the load can be continued with the --resume option
+`)
+	loadCmd.Flags().String("resume", "", `
+Continue the load from the checkpoints saved in the state file (json),
+already applied statements are skipped (the session statements SET, USE and PRAGMA are executed again),
+the checkpoints continue to be saved in the same file.
+The dump of the other dialect (--from) can't be resumed
+`)
+	loadCmd.Flags().Int64("checkpoint-interval", 1000, `
+Save checkpoint to the state file every N statements, the checkpoint isn't saved inside
+the transaction of the dump (BEGIN ... COMMIT), it is saved after its COMMIT
+`)
+	loadCmd.Flags().IntP("jobs", "j", 1, `
+Load the dump by N parallel connections (like pg_restore -j): the schema statements are executed
+serially first, the data of the tables is loaded in parallel, the statements following the data
+of their tables (indexes, constraints, triggers) are executed in parallel after the data is loaded.
+The jobs are spooled to the temporary file, --state-file, --resume and --transaction-mode single
+aren't supported, the sqlite3 target is loaded by one connection
+`)
+	loadCmd.Flags().Bool("defer-constraints", false, `
+Strip the secondary indexes and the foreign keys from CREATE TABLE and ALTER TABLE and apply them
+after the data of the load (CREATE INDEX, ALTER TABLE ... ADD CONSTRAINT), the indexes are created
+before the foreign keys, every failed constraint is reported with its name and table.
+The primary keys, the checks and the foreign keys of the sqlite3 target (SQLite can't add them
+to the existing table) are kept, --state-file and --resume aren't supported
+`)
+	loadCmd.Flags().String("on-error", "skip", `
+What the load does with the failed statement:
+
+	stop		stop the load on the first failed statement or file
+	skip		report the failed statement and go on
+	retry:N		execute the statement failed by the transient error (the connection is lost,
+			deadlock, serialization failure, lock timeout, SQLITE_BUSY) again up to N times
+			with the exponential backoff (0.1s, 0.2s, 0.4s ... 10s), skip the other failed statements
+			(retry is retry:5). The statement is not retried if the failure rolled back
+			the other uncommitted statements of its transaction
+`)
+	loadCmd.Flags().String("reject-file", "", `
+Write failed statements with the error comments to the file, the file can be replayed after fix.
+Rejected COPY blocks are written to the separate file per table: <reject-file>.<table>.sql
+`)
+	loadCmd.Flags().Int64("max-errors", 0, `
+Abort the load with non-zero exit code after N failed statements (0 - unlimited)
 `)
 	loadCmd.Flags().IntP("debug-level", "d", 0, `
 Debug level:
@@ -87,7 +349,425 @@ Debug level:
 	rootCmd.AddCommand(loadCmd)
 }
 
-func processFile(fileName string, sqlDialect dialect.SqlDialect, connection sql_connection.SqlConnection, debugLevel int) error {
+// loadState wraps the state file with the checkpoint interval
+type loadState struct {
+	state              *checkpoint.State
+	checkpointInterval int64
+	rolledBack         bool // The failed commit aborted the load, the checkpoints are ahead of the committed statements
+}
+
+func openLoadState(cmd *cobra.Command) (*loadState, error) {
+	stateFileName, _ := cmd.Flags().GetString("state-file")
+	resumeFileName, _ := cmd.Flags().GetString("resume")
+	checkpointInterval, _ := cmd.Flags().GetInt64("checkpoint-interval")
+	if checkpointInterval <= 0 {
+		checkpointInterval = 1
+	}
+	if resumeFileName != "" {
+		if stateFileName != "" && stateFileName != resumeFileName {
+			return nil, fmt.Errorf("--state-file %v and --resume %v must point to the same file", stateFileName, resumeFileName)
+		}
+		state, err := checkpoint.Load(resumeFileName)
+		if err != nil {
+			return nil, err
+		}
+		return &loadState{state: state, checkpointInterval: checkpointInterval}, nil
+	}
+	if stateFileName != "" {
+		return &loadState{state: checkpoint.NewState(stateFileName), checkpointInterval: checkpointInterval}, nil
+	}
+	return nil, nil
+}
+
+// loadOptions holds the options of the load shared between processed files
+type loadOptions struct {
+	parseMode               sql_parser.ParseMode
+	transpiler              *sql_transpiler.Transpiler
+	state                   *loadState
+	rejectFile              *reject_file.RejectFile
+	transactionMode         sql_connection.TransactionMode
+	transactionTable        string // Table of the per-table transaction
+	singleTransactionFailed bool
+	jobs                    int
+	plan                    *load_plan.Plan      // Plan of the parallel load (--jobs)
+	constraints             []deferredConstraint // Constraints applied after the data (--defer-constraints)
+	stopped                 bool
+	errorPolicy             sql_connection.ErrorPolicy
+	maxErrors               int64
+	errorsCount             int64
+	errorCodes              map[int32]int64 // Count of the failed statements by the error code
+	statementsCount         int64           // Count of the executed statements
+	dumpTransactionCount    int64           // Count of the successful statements of the open dump transaction (BEGIN ... COMMIT)
+	rolledBackCount         int64           // Count of the successful statements rolled back with the dump transaction by the abort
+	retriesCount            int64
+	failedFiles             int
+	debugLevel              int
+	mutex                   sync.Mutex // Counters and errors of the parallel jobs
+}
+
+// checkJobsOptions checks the options of the parallel load, the sqlite3 target is loaded serially
+func checkJobsOptions(sqlDialect dialect.SqlDialect, options *loadOptions) error {
+	if options.state != nil {
+		return fmt.Errorf("--jobs can't be combined with --state-file and --resume")
+	}
+	if options.transactionMode.Kind == sql_connection.TRANSACTION_SINGLE {
+		return fmt.Errorf("--jobs can't be combined with --transaction-mode single")
+	}
+	if options.parseMode != sql_parser.PARSE_ALL {
+		return fmt.Errorf("--jobs requires --parse all, the jobs are planned by the parsed statements")
+	}
+	if sqlDialect == dialect.SQLITE3 {
+		rootCmd.PrintErrf("sqlite3 allows the single writer, --jobs %v is ignored\n", options.jobs)
+		options.jobs = 1
+	}
+	return nil
+}
+
+// checkDeferConstraintsOptions checks the options of the deferred constraints, the constraints
+// are kept in memory until the end of the load, so the load can't be resumed
+func checkDeferConstraintsOptions(options *loadOptions) error {
+	if options.state != nil {
+		return fmt.Errorf("--defer-constraints can't be combined with --state-file and --resume")
+	}
+	if options.parseMode == sql_parser.PARSE_NONE {
+		return fmt.Errorf("--defer-constraints requires --parse ddl or all, the constraints are stripped from the parsed statements")
+	}
+	return nil
+}
+
+// deferredConstraint is the constraint stripped by --defer-constraints with the position of its statement
+type deferredConstraint struct {
+	position   reject_file.Position
+	constraint sql_transpiler.DeferredConstraint
+}
+
+// applyConstraints applies the constraints deferred by --defer-constraints after the data of the load,
+// the indexes are created before the foreign keys, the failure of every constraint is reported
+func applyConstraints(connection sql_connection.SqlConnection, options *loadOptions) error {
+	slices.SortStableFunc(options.constraints, func(a, b deferredConstraint) int {
+		if a.constraint.ForeignKey == b.constraint.ForeignKey {
+			return 0
+		}
+		if b.constraint.ForeignKey {
+			return -1
+		}
+		return 1
+	})
+	rootCmd.Printf("apply %v deferred constraints\n", len(options.constraints))
+	failed := 0
+	for _, deferred := range options.constraints {
+		if options.transactionMode.Kind == sql_connection.TRANSACTION_PER_TABLE {
+			if err := options.commitTable(connection, deferred.constraint.Text, deferred.constraint.Statement); err != nil {
+				return err
+			}
+		}
+		start := time.Now()
+		err := options.execute(connection, deferred.constraint.Text)
+		if options.debugLevel >= 1 {
+			rootCmd.Printf("[%v] %v\n", time.Since(start), deferred.constraint)
+		}
+		if err == nil {
+			continue
+		}
+		failed++
+		if err := options.Reject(deferred.position, deferred.constraint.Text, deferred.constraint.Statement, fmt.Errorf("%v fails: %w", deferred.constraint, err)); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		rootCmd.PrintErrf("%v of %v deferred constraints fail\n", failed, len(options.constraints))
+	}
+	return nil
+}
+
+// runJobs executes the jobs of the plan by the parallel connections after the pre-data statements are committed,
+// every worker connection replays the session state of the dump
+func runJobs(sqlDialect dialect.SqlDialect, connectionOptions string, connection sql_connection.SqlConnection, options *loadOptions) error {
+	if err := connection.Commit(); err != nil {
+		return err
+	}
+	jobs := options.plan.Jobs()
+	if len(jobs) == 0 {
+		return nil
+	}
+	workers := make([]sql_connection.SqlConnection, min(options.jobs, len(jobs)))
+	defer func() {
+		for _, worker := range workers {
+			if worker == nil {
+				continue
+			}
+			if err := worker.Close(); err != nil {
+				rootCmd.PrintErrf("close connection fail: %s\n", err)
+			}
+		}
+	}()
+	for i := range workers {
+		worker, err := sql_connection.Connect(sqlDialect)
+		if err != nil {
+			return err
+		}
+		if err := worker.Establish(connectionOptions); err != nil {
+			return err
+		}
+		worker.SetTransactionMode(options.transactionMode)
+		workers[i] = worker
+		for _, statementText := range options.plan.Session() {
+			if err := worker.Execute(statementText); err != nil {
+				return fmt.Errorf("restore session state of the worker by %v fail: %w", statementText, err)
+			}
+		}
+	}
+
+	rootCmd.Printf("load %v jobs by %v connections\n", len(jobs), len(workers))
+	return options.plan.Run(len(workers), func(worker int, job *load_plan.Job) error {
+		start := time.Now()
+		err := options.plan.Records(job, func(record load_plan.Record) error {
+			return options.Execute(workers[worker], record.Position, record.Text, nil)
+		})
+		// The jobs depending on this one are executed by the other connections, they must see its data
+		if err == nil {
+			err = options.commit(workers[worker])
+		}
+		if options.debugLevel >= 1 {
+			rootCmd.Printf("[%v] %v is done by the connection %v\n", time.Since(start), job, worker)
+		}
+		return err
+	})
+}
+
+// saveState commits the executed statements and saves the state file,
+// so the checkpoint never points after the uncommitted statements.
+// The failed commit is counted as the failed statement (see commit) and the state file isn't saved then,
+// the load goes on by the error policy with the rolled back statements skipped.
+// The checkpoints of the single transaction are saved after the commit of the load only.
+func (options *loadOptions) saveState(connection sql_connection.SqlConnection) error {
+	if options.state == nil || options.state.rolledBack || options.transactionMode.Kind == sql_connection.TRANSACTION_SINGLE {
+		return nil
+	}
+	if err := connection.Commit(); err != nil {
+		rootCmd.PrintErrf("commit before the checkpoint fail: %s\n", err)
+		return options.registerCommitError(err)
+	}
+	if err := options.state.state.Save(); err != nil {
+		rootCmd.PrintErrf("save state file fail: %s\n", err)
+	}
+	return nil
+}
+
+// commit commits the transaction at the boundary of the per-entry and per-table modes,
+// the failed commit is counted as the failed statement
+func (options *loadOptions) commit(connection sql_connection.SqlConnection) error {
+	err := connection.Commit()
+	if err == nil {
+		return nil
+	}
+	rootCmd.PrintErrf("%s\n", err)
+	return options.registerCommitError(err)
+}
+
+// registerCommitError counts the failed commit and returns the error if the load must be aborted,
+// the state file isn't saved after the aborting commit, so the resumed load executes the rolled back statements again
+func (options *loadOptions) registerCommitError(err error) error {
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	var abortError error
+	if options.RegisterError(err) {
+		abortError = fmt.Errorf("%w: %v failed statements, last is the commit", ErrTooManyErrors, options.errorsCount)
+	} else if options.errorPolicy.Kind == sql_connection.ON_ERROR_STOP {
+		abortError = fmt.Errorf("%w: commit fail", ErrLoadStopped)
+	}
+	if abortError != nil && options.state != nil {
+		options.state.rolledBack = true
+	}
+	return abortError
+}
+
+// commitTable commits the per-table transaction when the statement of the next table comes,
+// the statements not bound to a table are kept in the transaction of the previous table.
+// The table of the data statement which isn't parsed is taken from the beginning of its text.
+func (options *loadOptions) commitTable(connection sql_connection.SqlConnection, statementText string, statement ast.Statement) error {
+	table := load_plan.StatementTable(statement)
+	if statement == nil {
+		table = load_plan.StatementTextTable(statementText)
+	}
+	if table == "" || table == options.transactionTable {
+		return nil
+	}
+	previousTable := options.transactionTable
+	options.transactionTable = table
+	if previousTable == "" {
+		return nil
+	}
+	return options.commit(connection)
+}
+
+// finishSingleTransaction commits the single transaction of the successful load and saves the state file,
+// the failed load is rolled back, false is returned if the load isn't committed
+func (options *loadOptions) finishSingleTransaction(connection sql_connection.SqlConnection) bool {
+	if options.singleTransactionFailed {
+		if err := connection.Rollback(); err != nil {
+			rootCmd.PrintErrf("%s\n", err)
+		}
+		rootCmd.PrintErrf("the single transaction is rolled back, the target is untouched\n")
+		return false
+	}
+	if err := connection.Commit(); err != nil {
+		rootCmd.PrintErrf("%s\n", err)
+		return false
+	}
+	if options.state != nil {
+		if err := options.state.state.Save(); err != nil {
+			rootCmd.PrintErrf("save state file fail: %s\n", err)
+		}
+	}
+	return true
+}
+
+// RegisterError counts failed statement by the code of its error and returns true if the load must be aborted
+func (options *loadOptions) RegisterError(err error) bool {
+	options.errorsCount++
+	if options.errorCodes == nil {
+		options.errorCodes = make(map[int32]int64)
+	}
+	options.errorCodes[sql_parser_errors.Code(sql_connection.ClassifyError(err))]++
+	return options.TooManyErrors()
+}
+
+func (options *loadOptions) TooManyErrors() bool {
+	return options.maxErrors > 0 && options.errorsCount >= options.maxErrors
+}
+
+// Reject reports the failed statement, writes it to the reject file and counts the error,
+// ErrTooManyErrors is returned if the load must be aborted. The nil error is ignored.
+func (options *loadOptions) Reject(position reject_file.Position, statementText string, statement ast.Statement, executionError error) error {
+	if executionError == nil {
+		return nil
+	}
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	if options.debugLevel >= 1 {
+		rootCmd.PrintErrf("execute sql statement:\n %s \n\nfail: %s\n", statementText, executionError)
+	} else {
+		rootCmd.PrintErrf("%s\n", executionError)
+	}
+	if options.rejectFile != nil {
+		if err := options.rejectFile.Reject(position, statementText, statement, executionError); err != nil {
+			rootCmd.PrintErrf("write reject file fail: %s\n", err)
+		}
+	}
+	if options.transactionMode.Kind == sql_connection.TRANSACTION_SINGLE {
+		options.singleTransactionFailed = true
+	}
+	if options.RegisterError(executionError) {
+		return fmt.Errorf("%w: %v failed statements, last at %v", ErrTooManyErrors, options.errorsCount, position)
+	}
+	if options.singleTransactionFailed {
+		return fmt.Errorf("%w: statement at %v", ErrSingleTransactionFailed, position)
+	}
+	if options.errorPolicy.Kind == sql_connection.ON_ERROR_STOP {
+		return fmt.Errorf("%w: statement at %v", ErrLoadStopped, position)
+	}
+	return nil
+}
+
+// Execute executes the statement by the error policy and rejects the failed statement (see Reject)
+func (options *loadOptions) Execute(connection sql_connection.SqlConnection, position reject_file.Position, statementText string, statement ast.Statement) error {
+	return options.Reject(position, statementText, statement, options.execute(connection, statementText))
+}
+
+// execute executes the statement by the error policy and counts the statement and its retries
+func (options *loadOptions) execute(connection sql_connection.SqlConnection, statementText string) error {
+	retries, err := options.errorPolicy.Execute(connection, statementText)
+	options.mutex.Lock()
+	defer options.mutex.Unlock()
+	options.statementsCount++
+	options.retriesCount += int64(retries)
+	if err == nil && connection.InDumpTransaction() {
+		options.dumpTransactionCount++
+	}
+	if err != nil && retries > 0 {
+		return fmt.Errorf("%w (after %v retries)", err, retries)
+	}
+	return err
+}
+
+// rollBackDumpTransaction moves the successful statements of the dump transaction, which isn't committed
+// by the aborted load, from the executed statements to the rolled back ones
+func (options *loadOptions) rollBackDumpTransaction() {
+	options.statementsCount -= options.dumpTransactionCount
+	options.rolledBackCount += options.dumpTransactionCount
+	options.dumpTransactionCount = 0
+}
+
+// printSummary prints the counts of the executed and the failed statements, the failures are counted by the error codes
+func (options *loadOptions) printSummary(aborted bool) {
+	status := "done"
+	if aborted {
+		status = "aborted"
+	}
+	summary := fmt.Sprintf("load is %v: %v statements executed, %v retries, %v failed", status, options.statementsCount, options.retriesCount, options.errorsCount)
+	if len(options.errorCodes) > 0 {
+		codes := make([]int32, 0, len(options.errorCodes))
+		for code := range options.errorCodes {
+			codes = append(codes, code)
+		}
+		slices.Sort(codes)
+		counts := make([]string, 0, len(codes))
+		for _, code := range codes {
+			counts = append(counts, fmt.Sprintf("%v %v", sql_parser_errors.Code_name[code], options.errorCodes[code]))
+		}
+		summary += " (" + strings.Join(counts, ", ") + ")"
+	}
+	if options.rolledBackCount > 0 {
+		summary += fmt.Sprintf(", %v rolled back", options.rolledBackCount)
+	}
+	if options.failedFiles > 0 {
+		summary += fmt.Sprintf(", %v files failed", options.failedFiles)
+	}
+	rootCmd.Printf("%v\n", summary)
+}
+
+// stoppableReader returns io.EOF after Stop, it is used to abort the statement stream
+type stoppableReader struct {
+	reader  io.Reader
+	stopped bool
+}
+
+func (reader *stoppableReader) Read(p []byte) (int, error) {
+	if reader.stopped {
+		return 0, io.EOF
+	}
+	return reader.reader.Read(p)
+}
+
+func (reader *stoppableReader) Stop() {
+	reader.stopped = true
+}
+
+func processFile(fileName string, sqlDialect dialect.SqlDialect, connection sql_connection.SqlConnection, options *loadOptions) (result error) {
+	state := options.state
+	debugLevel := options.debugLevel
+	var fileCheckpoint *checkpoint.Checkpoint
+	if state != nil {
+		fingerprint, err := checkpoint.Fingerprint(fileName)
+		if err != nil {
+			return fmt.Errorf("file %s fingerprint error (%v)", fileName, err)
+		}
+		fileCheckpoint, err = state.state.Checkpoint(fileName, fingerprint)
+		if err != nil {
+			return err
+		}
+		if fileCheckpoint.Completed {
+			rootCmd.Printf(" - already loaded, skip")
+			return nil
+		}
+		defer func() {
+			if err := options.saveState(connection); err != nil && result == nil {
+				result = err
+			}
+		}()
+	}
+
 	respBody, err := os.Open(fileName)
 	if err != nil {
 		return fmt.Errorf("file %s open error (%v)", fileName, err)
@@ -96,6 +776,7 @@ func processFile(fileName string, sqlDialect dialect.SqlDialect, connection sql_
 
 	reader := archive_stream.NewReader(respBody)
 
+	entryIndex := -1
 	for {
 		entry, err := reader.GetNextEntry()
 		if err == io.EOF {
@@ -105,6 +786,7 @@ func processFile(fileName string, sqlDialect dialect.SqlDialect, connection sql_
 			return fmt.Errorf("unable to get next entry (%v)", err)
 		}
 
+		entryIndex++
 		if !entry.IsDir() {
 			rc, err := entry.Open()
 			defer func() {
@@ -117,32 +799,137 @@ func processFile(fileName string, sqlDialect dialect.SqlDialect, connection sql_
 				return fmt.Errorf("unable to open file: %s", err)
 			}
 
-			statementsCount := 0
+			statementsCount := int64(0)
+			savedCount := int64(0) // Statement of the last saved checkpoint
+			offset := int64(0)
+			line := int64(1)
+			sourcePosition := sql_parser.StartPosition
+			var abortError error
 			lastTime := time.Now()
-			sql_parser.StatementStream(rc, sqlDialect,
+			entryReader := &stoppableReader{reader: rc}
+			sql_parser.StatementStreamWithMode(entryReader, sqlDialect, options.parseMode,
 				func(statementText string, statement ast.Statement, parseError error) {
+					statementsCount++
+					position := reject_file.Position{
+						FileName:  fileName,
+						Entry:     entry.GetName(),
+						Statement: statementsCount,
+						Offset:    offset,
+						Line:      line + int64(strings.Count(statementText[:len(statementText)-len(strings.TrimLeftFunc(statementText, unicode.IsSpace))], "\n")),
+					}
+					offset += int64(len(statementText))
+					line += int64(strings.Count(statementText, "\n"))
+					statementPosition := sourcePosition
+					sourcePosition = sourcePosition.Advance(statementText)
+					if abortError != nil {
+						return
+					}
+					replay := false
+					if fileCheckpoint != nil && fileCheckpoint.IsApplied(entryIndex, statementsCount) {
+						abortError = fileCheckpoint.Verify(entryIndex, statementsCount, offset)
+						if abortError != nil {
+							entryReader.Stop()
+							return
+						}
+						// The session statements (SET, USE, PRAGMA) of the applied statements are executed again,
+						// the session of the resumed load doesn't have their state
+						if kind, _ := sql_connection.ClassifyStatement(statementText); kind != sql_connection.STATEMENT_SESSION {
+							return
+						}
+						replay = true
+					}
 					if parseError != nil {
-						if debugLevel >= 1 {
-							rootCmd.PrintErrf("parse sql statement:\n %s \n\nfail: %s\n", statementText, parseError)
-						} else {
-							rootCmd.PrintErrf("%s\n", parseError)
+						reportParseError(fileName, entry.GetName(), statementText, statementPosition, sqlDialect, parseError, debugLevel)
+					}
+					statementTexts, transpileError := options.transpiler.Transpile(statementText, statement)
+					if errors.Is(transpileError, sql_transpiler.ErrUnsupportedStatement) {
+						rootCmd.PrintErrf("skip statement at %v: %s\n", position, transpileError)
+						statementTexts, transpileError = nil, nil
+					}
+					if transpileError != nil {
+						statementTexts = []string{statementText}
+					}
+					for _, constraint := range options.transpiler.DeferredConstraints() {
+						options.constraints = append(options.constraints, deferredConstraint{position: position, constraint: constraint})
+					}
+					if options.plan != nil && transpileError == nil {
+						// The data and the statements following it are spooled for the parallel jobs
+						spooled, err := options.plan.Add(statement, statementTexts, position)
+						if err != nil {
+							abortError = err
+							entryReader.Stop()
+							return
+						}
+						if spooled {
+							statementTexts = nil
 						}
 					}
-					executionError := connection.Execute(statementText)
-					if executionError != nil {
-						if debugLevel >= 1 {
-							rootCmd.PrintErrf("execute sql statement:\n %s \n\nfail: %s\n", statementText, executionError)
+					if options.transactionMode.Kind == sql_connection.TRANSACTION_PER_TABLE && len(statementTexts) > 0 {
+						if abortError = options.commitTable(connection, statementTexts[0], statement); abortError != nil {
+							entryReader.Stop()
+							return
+						}
+					}
+					for _, executionText := range statementTexts {
+						if transpileError != nil {
+							abortError = options.Reject(position, executionText, statement, transpileError)
 						} else {
-							rootCmd.PrintErrf("%s\n", executionError)
+							abortError = options.Execute(connection, position, executionText, statement)
+						}
+						if abortError != nil {
+							entryReader.Stop()
+							break
 						}
 					}
-					statementsCount++
+					// The statement which aborted the load isn't applied, the resumed load executes it again.
+					// The statements of the dump transaction (BEGIN ... COMMIT) are rolled back by the abort,
+					// so the checkpoint is moved at the COMMIT of the dump only.
+					if fileCheckpoint != nil && abortError == nil && !replay && !connection.InDumpTransaction() {
+						fileCheckpoint.Commit(entry.GetName(), entryIndex, statementsCount, offset)
+						if statementsCount-savedCount >= state.checkpointInterval {
+							savedCount = statementsCount
+							if abortError = options.saveState(connection); abortError != nil {
+								entryReader.Stop()
+							}
+						}
+					}
+					if !connection.InDumpTransaction() {
+						options.dumpTransactionCount = 0
+					}
 					if debugLevel >= 2 {
 						rootCmd.Printf("[%v] processed statements: %v\n", time.Since(lastTime), statementsCount)
 					}
 					lastTime = time.Now()
 				})
+			if abortError != nil {
+				return abortError
+			}
+			if options.transactionMode.Kind == sql_connection.TRANSACTION_PER_ENTRY {
+				if err := options.commit(connection); err != nil {
+					return err
+				}
+			}
 		}
 	}
+	// The foreign keys of the MySQL dump are added after the tables and the data
+	statements, statementTexts := options.transpiler.FinishStatements()
+	for i, statementText := range statementTexts {
+		position := reject_file.Position{FileName: fileName}
+		if options.plan != nil {
+			spooled, err := options.plan.Add(statements[i], statementTexts[i:i+1], position)
+			if err != nil {
+				return err
+			}
+			if spooled {
+				continue
+			}
+		}
+		if err := options.Execute(connection, position, statementText, statements[i]); err != nil {
+			return err
+		}
+	}
+	if fileCheckpoint != nil {
+		fileCheckpoint.Complete()
+	}
 	return nil
 }
@@ -5,10 +5,17 @@ package cmd
 
 import (
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_parser/mysql"
 )
 
+// diagnosticFormat is the format of the parse error reports
+var diagnosticFormat = sql_parser.DIAGNOSTIC_TEXT
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "prodl",
@@ -16,6 +23,36 @@ var rootCmd = &cobra.Command{
 	Long: `
 PROcessing Dump & Loading is a CLI library for Go that do transform your data from sql dump and load to the sql database.
 `,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		errorFormat, _ := cmd.Flags().GetString("error-format")
+		format, err := sql_parser.ParseDiagnosticFormat(errorFormat)
+		if err != nil {
+			return err
+		}
+		diagnosticFormat = format
+
+		// The conditional comments of the MySQL dumps are checked against the server version
+		serverVersion, _ := cmd.Flags().GetString("server-version")
+		if serverVersion == "" {
+			return nil
+		}
+		return mysql.SetServerVersion(serverVersion)
+	},
+}
+
+// reportParseError prints the parse error of the statement, the position is the position
+// of the statement text in the archive entry of the file
+func reportParseError(fileName string, entryName string, statementText string, position sql_parser.SourcePosition,
+	sqlDialect dialect.SqlDialect, parseError error, debugLevel int) {
+	if debugLevel >= 1 {
+		rootCmd.PrintErrf("parse sql statement:\n %s \n\n", statementText)
+	}
+	diagnostic := sql_parser.NewDiagnostic(statementText, position, sqlDialect, parseError)
+	diagnostic.Source = fileName
+	if entryName != "" {
+		diagnostic.Source += "/" + entryName
+	}
+	rootCmd.PrintErrf("%s\n", strings.TrimSuffix(diagnosticFormat.Format(diagnostic), "\n"))
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -33,6 +70,19 @@ func init() {
 	// will be global for your application.
 
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.sent.yaml)")
+	rootCmd.PersistentFlags().String("server-version", "", `
+MySQL server version the conditional comments /*!NNNNN ... */ of the dump are checked against,
+for example 5.7.44, 8.0.36 or 10.11.6-MariaDB (the /*M!NNNNNN ... */ comments are
+executed by MariaDB only). The default is 5.7.9.
+
+`)
+	rootCmd.PersistentFlags().String("error-format", "text", `
+Format of the parse error reports:
+
+	text  the line and the column of the error, the source excerpt with the caret and the expected tokens
+	json  the same information as a single line JSON object per error
+
+`)
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -27,7 +27,12 @@ var statCmd = &cobra.Command{
 		// 1. Open file and detect dialect
 		// 2. Request count of creating tables and they names
 
-		sqlDialect := dialect.PSQL
+		from, _ := cmd.Flags().GetString("from")
+		sqlDialect, err := (*dialect.SqlDialect).ParseName(nil, from)
+		if err != nil {
+			rootCmd.PrintErrf("Error is %v\n", err)
+			return
+		}
 
 		// Open reader and do StatementStream
 		for _, fileName := range args {
@@ -41,8 +46,49 @@ var statCmd = &cobra.Command{
 			} else {
 				text := strings.Builder{}
 				for k, _ := range stat.table_records {
+					if _, ok := stat.partition_parents[k]; ok {
+						// The partitions are rolled up to the partitioned table
+						continue
+					}
 					text.WriteString(k)
 					text.WriteRune('\n')
+					writePartitions(&text, stat, k, "    ")
+					for _, comment := range stat.table_comments[k] {
+						text.WriteString("    ")
+						text.WriteString(comment)
+						text.WriteRune('\n')
+					}
+					for _, column := range stat.table_columns[k] {
+						if userType, ok := stat.user_types.Resolve(column.Type.Type); ok {
+							text.WriteString("    column ")
+							text.WriteString(column.Name.String())
+							text.WriteRune(' ')
+							text.WriteString(column.Type.Type)
+							text.WriteString(": ")
+							text.WriteString(userType)
+							text.WriteRune('\n')
+						}
+					}
+					for _, index := range stat.table_indexes[k] {
+						text.WriteString("    index ")
+						text.WriteString(index)
+						text.WriteRune('\n')
+					}
+					for _, trigger := range stat.table_triggers[k] {
+						text.WriteString("    trigger ")
+						text.WriteString(trigger)
+						text.WriteRune('\n')
+					}
+					for _, policy := range stat.table_policies[k] {
+						text.WriteString("    ")
+						text.WriteString(policy)
+						text.WriteRune('\n')
+					}
+					for _, grant := range stat.table_grants[k] {
+						text.WriteString("    ")
+						text.WriteString(grant)
+						text.WriteRune('\n')
+					}
 				}
 				rootCmd.Printf("%s", text.String())
 			}
@@ -51,6 +97,10 @@ var statCmd = &cobra.Command{
 }
 
 func init() {
+	statCmd.Flags().StringP("from", "f", "psql", `
+Sql dialect of the dump files: mysql, psql or sqlite3.
+
+`)
 	statCmd.Flags().IntP("debug-level", "d", 0, `
 Debug level:
 
@@ -63,7 +113,167 @@ Debug level:
 }
 
 type DumpStat struct {
-	table_records map[string]int
+	table_records  map[string]int
+	table_indexes  map[string][]string
+	table_triggers map[string][]string
+	table_columns  map[string][]*ast.ColumnDefinition
+	table_comments map[string][]string
+	table_grants   map[string][]string
+	table_policies map[string][]string
+	user_types     *sql_parser.UserTypes
+	// The partitioned tables with their partitions
+	partition_keys    map[string]string
+	partition_bounds  map[string]string
+	partition_parents map[string]string
+	table_partitions  map[string][]string
+}
+
+// addPartition rolls the partition up to the partitioned table
+func (dumpStat *DumpStat) addPartition(parent string, partition string, bound *ast.PartitionValueRange) {
+	if _, ok := dumpStat.partition_parents[partition]; !ok {
+		dumpStat.table_partitions[parent] = append(dumpStat.table_partitions[parent], partition)
+	}
+	dumpStat.partition_parents[partition] = parent
+	dumpStat.partition_bounds[partition] = partitionDescription(partition, bound)
+}
+
+// writePartitions writes the partition key and the partitions (with the nested ones) of the table
+func writePartitions(text *strings.Builder, stat *DumpStat, table string, indent string) {
+	if key, ok := stat.partition_keys[table]; ok {
+		text.WriteString(indent)
+		text.WriteString(key)
+		text.WriteRune('\n')
+	}
+	for _, partition := range stat.table_partitions[table] {
+		text.WriteString(indent)
+		text.WriteString("partition ")
+		text.WriteString(stat.partition_bounds[partition])
+		text.WriteRune('\n')
+		writePartitions(text, stat, partition, indent+"    ")
+	}
+}
+
+// indexDescription returns the index name with the access method and the indexed columns
+func indexDescription(createIndex *ast.CreateIndex) string {
+	text := strings.Builder{}
+	text.WriteString(createIndex.Name.String())
+	if createIndex.Unique {
+		text.WriteString(" unique")
+	}
+	if !createIndex.Method.IsEmpty() {
+		text.WriteString(" using ")
+		text.WriteString(createIndex.Method.String())
+	}
+	text.WriteString(" (")
+	for i, element := range createIndex.Columns {
+		if i != 0 {
+			text.WriteString(", ")
+		}
+		text.WriteString(ast.String(element))
+	}
+	text.WriteString(")")
+	return text.String()
+}
+
+// triggerDescription returns the trigger name with the timing, the events and the executed function
+func triggerDescription(createTrigger *ast.CreateTrigger) string {
+	text := strings.Builder{}
+	text.WriteString(createTrigger.Name.String())
+	if createTrigger.Timing != "" {
+		text.WriteRune(' ')
+		text.WriteString(createTrigger.Timing)
+	}
+	for i, event := range createTrigger.Events {
+		if i != 0 {
+			text.WriteString(" or")
+		}
+		text.WriteRune(' ')
+		text.WriteString(event.Type)
+	}
+	if createTrigger.Body != nil {
+		// The SQLite trigger body is summarized by the count of statements
+		text.WriteString(fmt.Sprintf(" begin %d statements end", len(createTrigger.Body)))
+		return text.String()
+	}
+	if createTrigger.Function.IsEmpty() {
+		// The MySQL trigger body isn't parsed
+		return text.String()
+	}
+	text.WriteString(" execute ")
+	text.WriteString(ast.String(createTrigger.Function))
+	return text.String()
+}
+
+// policyDescription returns the policy name with the command and the roles
+func policyDescription(createPolicy *ast.CreatePolicy) string {
+	text := strings.Builder{}
+	text.WriteString(createPolicy.Name.String())
+	if createPolicy.Restrictive {
+		text.WriteString(" restrictive")
+	}
+	text.WriteString(" for ")
+	text.WriteString(createPolicy.Command)
+	for i, role := range createPolicy.Roles {
+		if i == 0 {
+			text.WriteString(" to ")
+		} else {
+			text.WriteString(", ")
+		}
+		text.WriteString(role.Name.V)
+	}
+	return text.String()
+}
+
+// partitionDescription returns the partition name with the partition bound
+func partitionDescription(partition string, bound *ast.PartitionValueRange) string {
+	if bound == nil {
+		return partition + " default"
+	}
+	return partition + " for " + ast.String(bound)
+}
+
+// partitionKeyDescription returns the partitioning method with the partition key
+func partitionKeyDescription(partitionOption *ast.PartitionOption) string {
+	return strings.TrimSpace(ast.String(partitionOption))
+}
+
+// commentDescription returns the commented table column (if any) with the comment text
+func commentDescription(commentOn *ast.CommentOn) string {
+	text := strings.Builder{}
+	if commentOn.ObjectType == ast.ColumnObjectStr {
+		text.WriteString("column ")
+		text.WriteString(commentOn.Name.String())
+		text.WriteRune(' ')
+	}
+	text.WriteString("comment ")
+	text.WriteString(ast.String(commentOn.Value))
+	return text.String()
+}
+
+// privilegesDescription returns the statement name with the privileges and the roles
+func privilegesDescription(action string, privileges []*ast.GrantPrivilege, roles []*ast.RoleName) string {
+	text := strings.Builder{}
+	text.WriteString(action)
+	for i, privilege := range privileges {
+		if i != 0 {
+			text.WriteRune(',')
+		}
+		text.WriteRune(' ')
+		text.WriteString(ast.String(privilege))
+	}
+	if action == "grant" {
+		text.WriteString(" to")
+	} else {
+		text.WriteString(" from")
+	}
+	for i, role := range roles {
+		if i != 0 {
+			text.WriteRune(',')
+		}
+		text.WriteRune(' ')
+		text.WriteString(role.Name.V)
+	}
+	return text.String()
 }
 
 func processFileForStat(
@@ -79,7 +289,19 @@ func processFileForStat(
 
 	reader := archive_stream.NewReader(respBody)
 	dumpStat := DumpStat{
-		table_records: make(map[string]int, 100),
+		table_records:  make(map[string]int, 100),
+		table_indexes:  make(map[string][]string, 100),
+		table_triggers: make(map[string][]string, 100),
+		table_columns:  make(map[string][]*ast.ColumnDefinition, 100),
+		table_comments: make(map[string][]string, 100),
+		table_grants:   make(map[string][]string, 100),
+		table_policies: make(map[string][]string, 100),
+		user_types:     sql_parser.NewUserTypes(),
+
+		partition_keys:    make(map[string]string, 10),
+		partition_bounds:  make(map[string]string, 100),
+		partition_parents: make(map[string]string, 100),
+		table_partitions:  make(map[string][]string, 10),
 	}
 
 	for {
@@ -105,18 +327,76 @@ func processFileForStat(
 
 			statementsCount := 0
 			lastTime := time.Now()
-			sql_parser.StatementStream(rc, sqlDialect,
+			position := sql_parser.StartPosition
+			sql_parser.StatementStreamWithMode(rc, sqlDialect, sql_parser.PARSE_DDL,
 				func(statementText string, statement ast.Statement, parseError error) {
 					if parseError != nil {
-						if debugLevel >= 1 {
-							rootCmd.PrintErrf("parse sql statement:\n %s \n\nfail: %s\n", statementText, parseError)
-						} else {
-							rootCmd.PrintErrf("%s\n", parseError)
-						}
+						reportParseError(fileName, entry.GetName(), statementText, position, sqlDialect, parseError, debugLevel)
 					}
+					position = position.Advance(statementText)
 					createStatement, ok := statement.(*ast.CreateTable)
 					if ok {
 						dumpStat.table_records[createStatement.Table.Name.V] = 1
+						if tableSpec := createStatement.TableSpec; tableSpec != nil {
+							dumpStat.table_columns[createStatement.Table.Name.V] = tableSpec.Columns
+							if tableSpec.PartitionOption != nil {
+								dumpStat.partition_keys[createStatement.Table.Name.V] = partitionKeyDescription(tableSpec.PartitionOption)
+							}
+							if !tableSpec.PartitionOf.IsEmpty() {
+								dumpStat.addPartition(tableSpec.PartitionOf.Name.V, createStatement.Table.Name.V, tableSpec.PartitionBound)
+							}
+						}
+					}
+					createVirtualTable, ok := statement.(*ast.CreateVirtualTable)
+					if ok {
+						dumpStat.table_records[createVirtualTable.Table.Name.V] = 1
+					}
+					dumpStat.user_types.Register(statement)
+					createIndex, ok := statement.(*ast.CreateIndex)
+					if ok {
+						tableName := createIndex.Table.Name.V
+						dumpStat.table_indexes[tableName] = append(dumpStat.table_indexes[tableName], indexDescription(createIndex))
+					}
+					createTrigger, ok := statement.(*ast.CreateTrigger)
+					if ok {
+						tableName := createTrigger.Table.Name.V
+						dumpStat.table_triggers[tableName] = append(dumpStat.table_triggers[tableName], triggerDescription(createTrigger))
+					}
+					alterTable, ok := statement.(*ast.AlterTable)
+					if ok && alterTable.PartitionSpec != nil && alterTable.PartitionSpec.Action == ast.AttachAction {
+						dumpStat.addPartition(alterTable.Table.Name.V, alterTable.PartitionSpec.TableName.Name.V, alterTable.PartitionSpec.Bound)
+					}
+					if ok {
+						for _, alterOption := range alterTable.AlterOptions {
+							if rowLevelSecurity, ok := alterOption.(*ast.RowLevelSecurity); ok {
+								tableName := alterTable.Table.Name.V
+								dumpStat.table_policies[tableName] = append(dumpStat.table_policies[tableName], rowLevelSecurity.Action+" row level security")
+							}
+						}
+					}
+					createPolicy, ok := statement.(*ast.CreatePolicy)
+					if ok {
+						tableName := createPolicy.Table.Name.V
+						dumpStat.table_policies[tableName] = append(dumpStat.table_policies[tableName], "policy "+policyDescription(createPolicy))
+					}
+					commentOn, ok := statement.(*ast.CommentOn)
+					if ok && (commentOn.ObjectType == ast.TableObjectStr || commentOn.ObjectType == ast.ColumnObjectStr) {
+						tableName := commentOn.Object.Name.V
+						dumpStat.table_comments[tableName] = append(dumpStat.table_comments[tableName], commentDescription(commentOn))
+					}
+					grant, ok := statement.(*ast.Grant)
+					if ok && grant.ObjectType == ast.TableObjectStr {
+						for _, object := range grant.Objects {
+							tableName := object.Name.Name.V
+							dumpStat.table_grants[tableName] = append(dumpStat.table_grants[tableName], privilegesDescription("grant", grant.Privileges, grant.Grantees))
+						}
+					}
+					revoke, ok := statement.(*ast.Revoke)
+					if ok && revoke.ObjectType == ast.TableObjectStr {
+						for _, object := range revoke.Objects {
+							tableName := object.Name.Name.V
+							dumpStat.table_grants[tableName] = append(dumpStat.table_grants[tableName], privilegesDescription("revoke", revoke.Privileges, revoke.Grantees))
+						}
 					}
 					statementsCount++
 					if debugLevel >= 2 {
@@ -0,0 +1,163 @@
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Size of the dump head used for the fingerprint calculation
+const FINGERPRINT_HEAD_SIZE = 1 << 20
+
+var (
+	ErrFingerprintMismatch = errors.New("dump fingerprint doesn't match the checkpoint")
+	ErrOffsetMismatch      = errors.New("dump statements don't match the checkpoint")
+)
+
+// Checkpoint stores the position of the last applied statement for the dump file
+type Checkpoint struct {
+	FileName      string    `json:"file_name"`
+	Fingerprint   string    `json:"fingerprint"`
+	Entry         string    `json:"entry"`          // Name of the archive entry
+	EntryIndex    int       `json:"entry_index"`    // Index of the archive entry (names can be empty for gzip)
+	Offset        int64     `json:"offset"`         // Byte offset after the last committed statement inside the entry
+	LastCommitted int64     `json:"last_committed"` // Ordinal of the last statement committed to the target database
+	Completed     bool      `json:"completed"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// State is the content of the state file, one checkpoint per dump file
+type State struct {
+	Files []*Checkpoint `json:"files"`
+
+	fileName string
+}
+
+// NewState creates an empty state bound to the state file
+func NewState(fileName string) *State {
+	return &State{
+		Files:    make([]*Checkpoint, 0, 1),
+		fileName: fileName,
+	}
+}
+
+// Load reads the state file
+func Load(fileName string) (*State, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("read state file %v fail: %w", fileName, err)
+	}
+	state := NewState(fileName)
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("state file %v is corrupted: %w", fileName, err)
+	}
+	return state, nil
+}
+
+// Save writes the state file atomically (write to temporary file and rename)
+func (state *State) Save() error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(state.fileName), filepath.Base(state.fileName)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), state.fileName)
+}
+
+// Checkpoint returns the checkpoint for the dump file, a new one is created if the file is unknown.
+// If the dump was changed since the checkpoint was saved ErrFingerprintMismatch is returned.
+func (state *State) Checkpoint(fileName string, fingerprint string) (*Checkpoint, error) {
+	for _, checkpoint := range state.Files {
+		if checkpoint.FileName != fileName {
+			continue
+		}
+		if checkpoint.Fingerprint != fingerprint {
+			return nil, fmt.Errorf("%w: %v", ErrFingerprintMismatch, fileName)
+		}
+		return checkpoint, nil
+	}
+	checkpoint := &Checkpoint{
+		FileName:    fileName,
+		Fingerprint: fingerprint,
+	}
+	state.Files = append(state.Files, checkpoint)
+	return checkpoint, nil
+}
+
+// IsApplied returns true if the statement was committed before the checkpoint
+func (checkpoint *Checkpoint) IsApplied(entryIndex int, statement int64) bool {
+	if checkpoint.Completed {
+		return true
+	}
+	if entryIndex != checkpoint.EntryIndex {
+		return entryIndex < checkpoint.EntryIndex
+	}
+	return statement <= checkpoint.LastCommitted
+}
+
+// Verify checks the position of the last committed statement during the replay
+func (checkpoint *Checkpoint) Verify(entryIndex int, statement int64, offset int64) error {
+	if checkpoint.Completed || entryIndex != checkpoint.EntryIndex || statement != checkpoint.LastCommitted {
+		return nil
+	}
+	if offset != checkpoint.Offset {
+		return fmt.Errorf("%w: statement %v ends at offset %v, but checkpoint has offset %v", ErrOffsetMismatch, statement, offset, checkpoint.Offset)
+	}
+	return nil
+}
+
+// Commit moves the checkpoint to the statement
+func (checkpoint *Checkpoint) Commit(entryName string, entryIndex int, statement int64, offset int64) {
+	checkpoint.Entry = entryName
+	checkpoint.EntryIndex = entryIndex
+	checkpoint.LastCommitted = statement
+	checkpoint.Offset = offset
+	checkpoint.UpdatedAt = time.Now()
+}
+
+// Complete marks the dump file as fully loaded
+func (checkpoint *Checkpoint) Complete() {
+	checkpoint.Completed = true
+	checkpoint.UpdatedAt = time.Now()
+}
+
+// Fingerprint calculates the fingerprint of the dump file: sha256 of the file size and the file head
+func Fingerprint(fileName string) (string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%d:", info.Size())
+	if _, err := io.CopyN(hash, file, FINGERPRINT_HEAD_SIZE); err != nil && err != io.EOF {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,430 @@
+package copy_codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// BINARY_SIGNATURE is the signature of the PGCOPY binary data
+const BINARY_SIGNATURE = "PGCOPY\n\377\r\n\000"
+
+const (
+	binaryOidsFlag     = 1 << 16
+	numericPositive    = 0x0000
+	numericNegative    = 0x4000
+	numericNaN         = 0xC000
+	numericPositiveInf = 0xD000
+	numericNegativeInf = 0xF000
+)
+
+// The epoch of the PostgreSQL dates and timestamps
+var postgresEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02",
+}
+
+// readBinaryHeader reads the signature, the flags and skips the header extension
+func (decoder *Decoder) readBinaryHeader() error {
+	signature := make([]byte, len(BINARY_SIGNATURE))
+	if _, err := io.ReadFull(decoder.reader, signature); err != nil {
+		return fmt.Errorf("%w: binary header: %v", ErrMalformedData, err)
+	}
+	if string(signature) != BINARY_SIGNATURE {
+		return fmt.Errorf("%w: binary signature %q", ErrMalformedData, signature)
+	}
+	var flags, extensionLength uint32
+	if err := binary.Read(decoder.reader, binary.BigEndian, &flags); err != nil {
+		return fmt.Errorf("%w: binary header: %v", ErrMalformedData, err)
+	}
+	if flags&binaryOidsFlag != 0 {
+		return fmt.Errorf("%w: binary data with oids", ErrUnsupportedOption)
+	}
+	if err := binary.Read(decoder.reader, binary.BigEndian, &extensionLength); err != nil {
+		return fmt.Errorf("%w: binary header: %v", ErrMalformedData, err)
+	}
+	if _, err := io.CopyN(io.Discard, decoder.reader, int64(extensionLength)); err != nil {
+		return fmt.Errorf("%w: binary header extension: %v", ErrMalformedData, err)
+	}
+	return nil
+}
+
+// readBinary reads the tuple of the binary format, the trailer (-1 fields) is the end of the data
+func (decoder *Decoder) readBinary() (sql_types.Row, error) {
+	var count int16
+	if err := binary.Read(decoder.reader, binary.BigEndian, &count); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("%w: tuple %v: %v", ErrMalformedData, decoder.line+1, err)
+	}
+	if count == -1 {
+		decoder.finished = true
+		return nil, io.EOF
+	}
+	decoder.line++
+	row := make(sql_types.Row, 0, count)
+	for i := 0; i < int(count); i++ {
+		var length int32
+		if err := binary.Read(decoder.reader, binary.BigEndian, &length); err != nil {
+			return nil, fmt.Errorf("%w: tuple %v, column %v: %v", ErrMalformedData, decoder.line, i+1, err)
+		}
+		if length == -1 {
+			row = append(row, sql_types.NULL)
+			continue
+		}
+		if length < 0 {
+			return nil, fmt.Errorf("%w: tuple %v, column %v: length %v", ErrMalformedData, decoder.line, i+1, length)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(decoder.reader, data); err != nil {
+			return nil, fmt.Errorf("%w: tuple %v, column %v: %v", ErrMalformedData, decoder.line, i+1, err)
+		}
+		value, err := decodeBinaryValue(data, decoder.columnType(i, sql_types.VarBinary))
+		if err != nil {
+			return nil, fmt.Errorf("%w: tuple %v, column %v: %v", ErrMalformedData, decoder.line, i+1, err)
+		}
+		row = append(row, value)
+	}
+	return row, nil
+}
+
+func (encoder *Encoder) writeBinaryHeader() error {
+	if _, err := encoder.writer.WriteString(BINARY_SIGNATURE); err != nil {
+		return err
+	}
+	// No flags and no header extension
+	_, err := encoder.writer.Write(make([]byte, 8))
+	return err
+}
+
+// writeBinary writes the tuple of the binary format
+func (encoder *Encoder) writeBinary(row sql_types.Row) error {
+	if len(row) > math.MaxInt16 {
+		return fmt.Errorf("too many columns: %v", len(row))
+	}
+	if err := binary.Write(encoder.writer, binary.BigEndian, int16(len(row))); err != nil {
+		return err
+	}
+	for i, value := range row {
+		if value.IsNull() {
+			if err := binary.Write(encoder.writer, binary.BigEndian, int32(-1)); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := encodeBinaryValue(value, encoder.columnType(i, value))
+		if err != nil {
+			return fmt.Errorf("column %v: %w", i+1, err)
+		}
+		if err := binary.Write(encoder.writer, binary.BigEndian, int32(len(data))); err != nil {
+			return err
+		}
+		if _, err := encoder.writer.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (encoder *Encoder) writeBinaryTrailer() error {
+	return binary.Write(encoder.writer, binary.BigEndian, int16(-1))
+}
+
+// decodeBinaryValue converts the binary representation of the PostgreSQL value to the type:
+// the booleans of Int8, the big-endian integers and floats, the numeric of Decimal, the days and
+// the microseconds since 2000-01-01 of Date, Timestamp, Datetime, the microseconds of Time,
+// the bytes as is for the other types
+func decodeBinaryValue(data []byte, typ sql_types.Type) (sql_types.Value, error) {
+	var text string
+	switch typ {
+	case sql_types.Int8, sql_types.Uint8:
+		if len(data) != 1 {
+			return sql_types.NULL, fmt.Errorf("boolean length %v", len(data))
+		}
+		text = "0"
+		if data[0] != 0 {
+			text = "1"
+		}
+	case sql_types.Int16, sql_types.Uint16, sql_types.Year:
+		if len(data) != 2 {
+			return sql_types.NULL, fmt.Errorf("int2 length %v", len(data))
+		}
+		text = strconv.FormatInt(int64(int16(binary.BigEndian.Uint16(data))), 10)
+	case sql_types.Int24, sql_types.Int32, sql_types.Uint24, sql_types.Uint32:
+		if len(data) != 4 {
+			return sql_types.NULL, fmt.Errorf("int4 length %v", len(data))
+		}
+		text = strconv.FormatInt(int64(int32(binary.BigEndian.Uint32(data))), 10)
+	case sql_types.Int64, sql_types.Uint64:
+		if len(data) != 8 {
+			return sql_types.NULL, fmt.Errorf("int8 length %v", len(data))
+		}
+		text = strconv.FormatInt(int64(binary.BigEndian.Uint64(data)), 10)
+	case sql_types.Float32:
+		if len(data) != 4 {
+			return sql_types.NULL, fmt.Errorf("float4 length %v", len(data))
+		}
+		text = strconv.FormatFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(data))), 'g', -1, 32)
+	case sql_types.Float64:
+		if len(data) != 8 {
+			return sql_types.NULL, fmt.Errorf("float8 length %v", len(data))
+		}
+		text = strconv.FormatFloat(math.Float64frombits(binary.BigEndian.Uint64(data)), 'g', -1, 64)
+	case sql_types.Decimal:
+		var err error
+		if text, err = decodeNumeric(data); err != nil {
+			return sql_types.NULL, err
+		}
+	case sql_types.Date:
+		if len(data) != 4 {
+			return sql_types.NULL, fmt.Errorf("date length %v", len(data))
+		}
+		switch days := int32(binary.BigEndian.Uint32(data)); days {
+		case math.MaxInt32:
+			text = "infinity"
+		case math.MinInt32:
+			text = "-infinity"
+		default:
+			text = postgresEpoch.AddDate(0, 0, int(days)).Format(time.DateOnly)
+		}
+	case sql_types.Timestamp, sql_types.Datetime:
+		if len(data) != 8 {
+			return sql_types.NULL, fmt.Errorf("timestamp length %v", len(data))
+		}
+		switch microseconds := int64(binary.BigEndian.Uint64(data)); microseconds {
+		case math.MaxInt64:
+			text = "infinity"
+		case math.MinInt64:
+			text = "-infinity"
+		default:
+			text = time.UnixMicro(postgresEpoch.UnixMicro() + microseconds).UTC().Format("2006-01-02 15:04:05.999999")
+		}
+	case sql_types.Time:
+		if len(data) != 8 {
+			return sql_types.NULL, fmt.Errorf("time length %v", len(data))
+		}
+		microseconds := int64(binary.BigEndian.Uint64(data))
+		text = postgresEpoch.Add(time.Duration(microseconds) * time.Microsecond).Format("15:04:05.999999")
+	default:
+		return sql_types.MakeTrusted(typ, data), nil
+	}
+	return sql_types.NewValue(typ, []byte(text))
+}
+
+// encodeBinaryValue returns the binary representation of the value as PostgreSQL expects
+// for the type, the inverse of decodeBinaryValue
+func encodeBinaryValue(value sql_types.Value, typ sql_types.Type) ([]byte, error) {
+	text := value.RawStr()
+	switch typ {
+	case sql_types.Int8, sql_types.Uint8:
+		switch strings.ToLower(text) {
+		case "1", "t", "true", "y", "yes", "on":
+			return []byte{1}, nil
+		case "0", "f", "false", "n", "no", "off":
+			return []byte{0}, nil
+		}
+		return nil, fmt.Errorf("invalid boolean: %v", text)
+	case sql_types.Int16, sql_types.Uint16, sql_types.Year:
+		number, err := strconv.ParseInt(text, 10, 16)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint16(nil, uint16(number)), nil
+	case sql_types.Int24, sql_types.Int32, sql_types.Uint24, sql_types.Uint32:
+		number, err := strconv.ParseInt(text, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(number)), nil
+	case sql_types.Int64, sql_types.Uint64:
+		number, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(number)), nil
+	case sql_types.Float32:
+		number, err := strconv.ParseFloat(text, 32)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32(nil, math.Float32bits(float32(number))), nil
+	case sql_types.Float64:
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(number)), nil
+	case sql_types.Decimal:
+		return encodeNumeric(text)
+	case sql_types.Date:
+		switch strings.ToLower(text) {
+		case "infinity":
+			return binary.BigEndian.AppendUint32(nil, math.MaxInt32), nil
+		case "-infinity":
+			return binary.BigEndian.AppendUint32(nil, uint32(math.MaxInt32+1)), nil
+		}
+		date, err := time.Parse(time.DateOnly, text)
+		if err != nil {
+			return nil, err
+		}
+		days := int32(date.Sub(postgresEpoch).Hours() / 24)
+		return binary.BigEndian.AppendUint32(nil, uint32(days)), nil
+	case sql_types.Timestamp, sql_types.Datetime:
+		switch strings.ToLower(text) {
+		case "infinity":
+			return binary.BigEndian.AppendUint64(nil, math.MaxInt64), nil
+		case "-infinity":
+			return binary.BigEndian.AppendUint64(nil, uint64(math.MaxInt64)+1), nil
+		}
+		timestamp, err := parseTimestamp(text)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(timestamp.UnixMicro()-postgresEpoch.UnixMicro())), nil
+	case sql_types.Time:
+		clock, err := time.Parse("15:04:05.999999999", text)
+		if err != nil {
+			return nil, err
+		}
+		midnight := time.Date(clock.Year(), clock.Month(), clock.Day(), 0, 0, 0, 0, clock.Location())
+		return binary.BigEndian.AppendUint64(nil, uint64(clock.Sub(midnight).Microseconds())), nil
+	}
+	return value.Raw(), nil
+}
+
+func parseTimestamp(text string) (time.Time, error) {
+	var err error
+	for _, layout := range timestampLayouts {
+		var timestamp time.Time
+		if timestamp, err = time.Parse(layout, text); err == nil {
+			return timestamp.UTC(), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// decodeNumeric returns the text of the PostgreSQL numeric: the number of the base 10000 digits,
+// the weight of the first digit, the sign, the display scale and the digits
+func decodeNumeric(data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", fmt.Errorf("numeric length %v", len(data))
+	}
+	ndigits := int(int16(binary.BigEndian.Uint16(data[0:])))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:])))
+	sign := binary.BigEndian.Uint16(data[4:])
+	dscale := int(int16(binary.BigEndian.Uint16(data[6:])))
+	if ndigits < 0 || dscale < 0 || len(data) != 8+2*ndigits {
+		return "", fmt.Errorf("numeric length %v for %v digits", len(data), ndigits)
+	}
+	switch sign {
+	case numericNaN:
+		return "NaN", nil
+	case numericPositiveInf:
+		return "Infinity", nil
+	case numericNegativeInf:
+		return "-Infinity", nil
+	case numericPositive, numericNegative:
+	default:
+		return "", fmt.Errorf("numeric sign %#x", sign)
+	}
+	digit := func(i int) int {
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(data[8+2*i:]))
+	}
+	text := strings.Builder{}
+	if sign == numericNegative {
+		text.WriteByte('-')
+	}
+	if weight < 0 {
+		text.WriteByte('0')
+	}
+	for i := 0; i <= weight; i++ {
+		if i == 0 {
+			text.WriteString(strconv.Itoa(digit(i)))
+		} else {
+			fmt.Fprintf(&text, "%04d", digit(i))
+		}
+	}
+	if dscale > 0 {
+		fraction := strings.Builder{}
+		for i := weight + 1; fraction.Len() < dscale; i++ {
+			fmt.Fprintf(&fraction, "%04d", digit(i))
+		}
+		text.WriteByte('.')
+		text.WriteString(fraction.String()[:dscale])
+	}
+	return text.String(), nil
+}
+
+// encodeNumeric returns the PostgreSQL numeric of the decimal text
+func encodeNumeric(text string) ([]byte, error) {
+	sign := uint16(numericPositive)
+	switch {
+	case strings.EqualFold(text, "NaN"):
+		sign = numericNaN
+	case strings.EqualFold(text, "Infinity"), strings.EqualFold(text, "+Infinity"):
+		sign = numericPositiveInf
+	case strings.EqualFold(text, "-Infinity"):
+		sign = numericNegativeInf
+	}
+	if sign != numericPositive {
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint16(data[4:], sign)
+		return data, nil
+	}
+	number := text
+	if strings.HasPrefix(number, "-") {
+		sign, number = numericNegative, number[1:]
+	} else {
+		number = strings.TrimPrefix(number, "+")
+	}
+	integer, fraction, _ := strings.Cut(number, ".")
+	if integer == "" && fraction == "" || strings.Trim(integer+fraction, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid numeric: %v", text)
+	}
+	dscale := len(fraction)
+	integer = strings.Repeat("0", (4-len(integer)%4)%4) + integer
+	fraction = fraction + strings.Repeat("0", (4-len(fraction)%4)%4)
+	digits := make([]uint16, 0, (len(integer)+len(fraction))/4)
+	for _, part := range []string{integer, fraction} {
+		for i := 0; i < len(part); i += 4 {
+			digit, _ := strconv.Atoi(part[i : i+4])
+			digits = append(digits, uint16(digit))
+		}
+	}
+	weight := len(integer)/4 - 1
+	// The leading and the trailing zero digits aren't stored
+	for len(digits) > 0 && digits[0] == 0 {
+		digits, weight = digits[1:], weight-1
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight, sign = 0, numericPositive
+	}
+	data := make([]byte, 8, 8+2*len(digits))
+	binary.BigEndian.PutUint16(data[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(data[2:], uint16(int16(weight)))
+	binary.BigEndian.PutUint16(data[4:], sign)
+	binary.BigEndian.PutUint16(data[6:], uint16(dscale))
+	for _, digit := range digits {
+		data = binary.BigEndian.AppendUint16(data, digit)
+	}
+	return data, nil
+}
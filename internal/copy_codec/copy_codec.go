@@ -0,0 +1,368 @@
+package copy_codec
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// END_OF_DATA is the end data mark of the COPY ... FROM stdin data in the text and csv formats
+const END_OF_DATA = `\.`
+
+var (
+	// ErrUnsupportedOption is returned for the COPY options the codec can't follow
+	ErrUnsupportedOption = errors.New("unsupported copy option")
+	// ErrMalformedData is returned for the COPY data not matching the format
+	ErrMalformedData = errors.New("malformed copy data")
+)
+
+// Format is the format of the COPY data
+type Format uint8
+
+const (
+	FORMAT_TEXT   Format = 0 // Tab separated values with the backslash escapes and \N for NULL
+	FORMAT_CSV    Format = 1 // Comma separated values with the quotes
+	FORMAT_BINARY Format = 2 // PGCOPY binary tuples
+)
+
+func (format Format) String() string {
+	switch format {
+	case FORMAT_TEXT:
+		return "text"
+	case FORMAT_CSV:
+		return "csv"
+	case FORMAT_BINARY:
+		return "binary"
+	}
+	return "undefined"
+}
+
+// ParseFormat converts the FORMAT option value (text|csv|binary) to the Format
+func ParseFormat(value string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "text", "":
+		return FORMAT_TEXT, nil
+	case "csv":
+		return FORMAT_CSV, nil
+	case "binary":
+		return FORMAT_BINARY, nil
+	}
+	return FORMAT_TEXT, fmt.Errorf("unknown copy format: %v, expected one of text|csv|binary", value)
+}
+
+// Options are the COPY options of the data format, the force options keep the column names,
+// the nil columns of the force options are all columns (*)
+type Options struct {
+	Format       Format
+	Delimiter    byte
+	Null         string
+	Quote        byte
+	Escape       byte
+	Header       bool
+	ForceQuote   []string
+	ForceNotNull []string
+	ForceNull    []string
+
+	forceQuoteAll   bool
+	forceNotNullAll bool
+	forceNullAll    bool
+}
+
+// DefaultOptions returns the options of the format as in PostgreSQL: the tab delimiter and \N for NULL
+// in the text format, the comma delimiter, the double quotes and the empty NULL in the csv format
+func DefaultOptions(format Format) Options {
+	switch format {
+	case FORMAT_CSV:
+		return Options{Format: format, Delimiter: ',', Null: "", Quote: '"', Escape: '"'}
+	case FORMAT_BINARY:
+		return Options{Format: format}
+	}
+	return Options{Format: format, Delimiter: '\t', Null: `\N`}
+}
+
+// NewOptions returns the options of the COPY statement
+func NewOptions(copyOptions ast.CopyOptions) (Options, error) {
+	format := FORMAT_TEXT
+	for _, option := range copyOptions {
+		if option.Type == ast.CopyOptionFormat {
+			var err error
+			if format, err = ParseFormat(option.Value); err != nil {
+				return Options{}, err
+			}
+		}
+	}
+	options := DefaultOptions(format)
+	escape := false
+	for _, option := range copyOptions {
+		switch option.Type {
+		case ast.CopyOptionDelimiter:
+			if len(option.Value) != 1 {
+				return options, fmt.Errorf("%w: delimiter must be a single one-byte character", ErrUnsupportedOption)
+			}
+			options.Delimiter = option.Value[0]
+		case ast.CopyOptionNull:
+			options.Null = option.Value
+		case ast.CopyOptionHeader:
+			options.Header = strings.EqualFold(option.Value, "true")
+		case ast.CopyOptionHeaderMatch:
+			options.Header = true
+		case ast.CopyOptionQuote:
+			if len(option.Value) != 1 {
+				return options, fmt.Errorf("%w: quote must be a single one-byte character", ErrUnsupportedOption)
+			}
+			options.Quote = option.Value[0]
+		case ast.CopyOptionEscape:
+			if len(option.Value) != 1 {
+				return options, fmt.Errorf("%w: escape must be a single one-byte character", ErrUnsupportedOption)
+			}
+			options.Escape, escape = option.Value[0], true
+		case ast.CopyOptionForceQuote:
+			options.ForceQuote, options.forceQuoteAll = columnNames(option.Columns), option.Columns == nil
+		case ast.CopyOptionForceNotNull:
+			options.ForceNotNull, options.forceNotNullAll = columnNames(option.Columns), option.Columns == nil
+		case ast.CopyOptionForceNull:
+			options.ForceNull, options.forceNullAll = columnNames(option.Columns), option.Columns == nil
+		}
+	}
+	if !escape {
+		// The escape is the same as the quote by default
+		options.Escape = options.Quote
+	}
+	return options, nil
+}
+
+func columnNames(columns ast.Columns) []string {
+	if columns == nil {
+		return nil
+	}
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, column.String())
+	}
+	return names
+}
+
+// forced returns the flags of the columns listed in the force option
+func forced(names []string, all bool, columns []string) []bool {
+	result := make([]bool, len(columns))
+	for i, column := range columns {
+		if all {
+			result[i] = true
+			continue
+		}
+		for _, name := range names {
+			if strings.EqualFold(name, column) {
+				result[i] = true
+			}
+		}
+	}
+	return result
+}
+
+// Decoder reads the rows of the COPY data, the values are converted to the types of the columns,
+// the values of the columns without the type are VarChar (text and csv) or VarBinary (binary)
+type Decoder struct {
+	reader       *bufio.Reader
+	options      Options
+	types        []sql_types.Type
+	forceNotNull []bool
+	forceNull    []bool
+	started      bool
+	finished     bool
+	line         int64
+}
+
+// NewDecoder makes the decoder of the COPY data, the columns are used by the force options and
+// the header match, the types can be nil
+func NewDecoder(reader io.Reader, options Options, columns []string, types []sql_types.Type) *Decoder {
+	return &Decoder{
+		reader:       bufio.NewReader(reader),
+		options:      options,
+		types:        types,
+		forceNotNull: forced(options.ForceNotNull, options.forceNotNullAll, columns),
+		forceNull:    forced(options.ForceNull, options.forceNullAll, columns),
+	}
+}
+
+// Read returns the next row, io.EOF after the last row (the end data mark or the end of the input)
+func (decoder *Decoder) Read() (sql_types.Row, error) {
+	if decoder.finished {
+		return nil, io.EOF
+	}
+	if !decoder.started {
+		decoder.started = true
+		if err := decoder.readHeader(); err != nil {
+			decoder.finished = true
+			return nil, err
+		}
+	}
+	var row sql_types.Row
+	var err error
+	switch decoder.options.Format {
+	case FORMAT_BINARY:
+		row, err = decoder.readBinary()
+	case FORMAT_CSV:
+		row, err = decoder.readCsv()
+	default:
+		row, err = decoder.readText()
+	}
+	if err != nil {
+		decoder.finished = true
+		return nil, err
+	}
+	return row, nil
+}
+
+// ReadAll returns all rows of the data
+func (decoder *Decoder) ReadAll() ([]sql_types.Row, error) {
+	rows := make([]sql_types.Row, 0)
+	for {
+		row, err := decoder.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+func (decoder *Decoder) readHeader() error {
+	switch decoder.options.Format {
+	case FORMAT_BINARY:
+		return decoder.readBinaryHeader()
+	case FORMAT_CSV:
+		if decoder.options.Header {
+			_, err := decoder.readCsvFields()
+			if err == io.EOF {
+				decoder.finished = true
+			}
+			return err
+		}
+	default:
+		if decoder.options.Header {
+			_, err := decoder.readLine()
+			if err == io.EOF {
+				decoder.finished = true
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// readLine returns the next line without the line end, io.EOF at the end of the input
+func (decoder *Decoder) readLine() (string, error) {
+	line, err := decoder.reader.ReadString('\n')
+	if err == io.EOF && line == "" {
+		return "", io.EOF
+	}
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	decoder.line++
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), nil
+}
+
+// columnType returns the type of the column or the default type
+func (decoder *Decoder) columnType(column int, defaultType sql_types.Type) sql_types.Type {
+	if column < len(decoder.types) && decoder.types[column] != sql_types.Null {
+		return decoder.types[column]
+	}
+	return defaultType
+}
+
+// Encoder writes the rows in the COPY data format, the data is finished by Close
+type Encoder struct {
+	writer     *bufio.Writer
+	options    Options
+	columns    []string
+	types      []sql_types.Type
+	forceQuote []bool
+	started    bool
+}
+
+// NewEncoder makes the encoder of the COPY data, the columns are used by the header and the force
+// quote option, the types are used by the binary format (the types of the values by default)
+func NewEncoder(writer io.Writer, options Options, columns []string, types []sql_types.Type) *Encoder {
+	return &Encoder{
+		writer:     bufio.NewWriter(writer),
+		options:    options,
+		columns:    columns,
+		types:      types,
+		forceQuote: forced(options.ForceQuote, options.forceQuoteAll, columns),
+	}
+}
+
+// Write writes the row
+func (encoder *Encoder) Write(row sql_types.Row) error {
+	if !encoder.started {
+		encoder.started = true
+		if err := encoder.writeHeader(); err != nil {
+			return err
+		}
+	}
+	switch encoder.options.Format {
+	case FORMAT_BINARY:
+		return encoder.writeBinary(row)
+	case FORMAT_CSV:
+		return encoder.writeCsv(row, encoder.forceQuote)
+	}
+	return encoder.writeText(row)
+}
+
+// Close writes the end of the data (the binary trailer) and flushes the writer, the end data mark
+// of COPY ... FROM stdin isn't written
+func (encoder *Encoder) Close() error {
+	if !encoder.started {
+		encoder.started = true
+		if err := encoder.writeHeader(); err != nil {
+			return err
+		}
+	}
+	if encoder.options.Format == FORMAT_BINARY {
+		if err := encoder.writeBinaryTrailer(); err != nil {
+			return err
+		}
+	}
+	return encoder.writer.Flush()
+}
+
+func (encoder *Encoder) writeHeader() error {
+	switch encoder.options.Format {
+	case FORMAT_BINARY:
+		return encoder.writeBinaryHeader()
+	case FORMAT_CSV:
+		if encoder.options.Header {
+			header := make(sql_types.Row, 0, len(encoder.columns))
+			for _, column := range encoder.columns {
+				header = append(header, sql_types.NewVarChar(column))
+			}
+			return encoder.writeCsv(header, nil)
+		}
+	default:
+		if encoder.options.Header {
+			header := make(sql_types.Row, 0, len(encoder.columns))
+			for _, column := range encoder.columns {
+				header = append(header, sql_types.NewVarChar(column))
+			}
+			return encoder.writeText(header)
+		}
+	}
+	return nil
+}
+
+// columnType returns the type of the column or the type of the value
+func (encoder *Encoder) columnType(column int, value sql_types.Value) sql_types.Type {
+	if column < len(encoder.types) && encoder.types[column] != sql_types.Null {
+		return encoder.types[column]
+	}
+	return value.Type()
+}
@@ -0,0 +1,171 @@
+package copy_codec
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// csvField is the field of the csv record, the quoted fields are never NULL without FORCE_NULL
+type csvField struct {
+	value  string
+	quoted bool
+}
+
+// readCsvFields reads the fields of the csv record, the quoted fields can span the lines
+func (decoder *Decoder) readCsvFields() ([]csvField, error) {
+	options := decoder.options
+	fields := make([]csvField, 0, len(decoder.types))
+	field := strings.Builder{}
+	quoted, inQuotes, started := false, false, false
+record:
+	for {
+		ch, err := decoder.reader.ReadByte()
+		if err == io.EOF {
+			if inQuotes {
+				return nil, fmt.Errorf("%w: line %v: unterminated quoted field", ErrMalformedData, decoder.line+1)
+			}
+			if !started {
+				return nil, io.EOF
+			}
+			decoder.line++
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		started = true
+		if inQuotes {
+			switch {
+			case ch == options.Escape && options.Escape != options.Quote:
+				// The escape is followed by the quote or the escape
+				if next, err := decoder.reader.ReadByte(); err == nil {
+					if next == options.Quote || next == options.Escape {
+						field.WriteByte(next)
+						continue
+					}
+					decoder.reader.UnreadByte()
+				}
+				field.WriteByte(ch)
+			case ch == options.Quote:
+				// The doubled quote is the quote character if the escape is the quote
+				if next, err := decoder.reader.ReadByte(); err == nil {
+					if next == options.Quote && options.Escape == options.Quote {
+						field.WriteByte(ch)
+						continue
+					}
+					decoder.reader.UnreadByte()
+				}
+				inQuotes = false
+			default:
+				if ch == '\n' {
+					decoder.line++
+				}
+				field.WriteByte(ch)
+			}
+			continue
+		}
+		switch ch {
+		case options.Quote:
+			inQuotes, quoted = true, true
+		case options.Delimiter:
+			fields = append(fields, csvField{value: field.String(), quoted: quoted})
+			field.Reset()
+			quoted = false
+		case '\r':
+			if next, err := decoder.reader.ReadByte(); err == nil && next != '\n' {
+				decoder.reader.UnreadByte()
+			}
+			decoder.line++
+			break record
+		case '\n':
+			decoder.line++
+			break record
+		default:
+			field.WriteByte(ch)
+		}
+	}
+	fields = append(fields, csvField{value: field.String(), quoted: quoted})
+	if len(fields) == 1 && !fields[0].quoted && fields[0].value == END_OF_DATA {
+		decoder.finished = true
+		return nil, io.EOF
+	}
+	return fields, nil
+}
+
+// readCsv reads the row of the csv format, the unquoted NULL string is NULL (the quoted one as well
+// for the FORCE_NULL columns, and never for the FORCE_NOT_NULL columns)
+func (decoder *Decoder) readCsv() (sql_types.Row, error) {
+	fields, err := decoder.readCsvFields()
+	if err != nil {
+		return nil, err
+	}
+	row := make(sql_types.Row, 0, len(fields))
+	for i, field := range fields {
+		isNull := field.value == decoder.options.Null
+		if field.quoted {
+			isNull = isNull && i < len(decoder.forceNull) && decoder.forceNull[i]
+		} else {
+			isNull = isNull && !(i < len(decoder.forceNotNull) && decoder.forceNotNull[i])
+		}
+		if isNull {
+			row = append(row, sql_types.NULL)
+			continue
+		}
+		value, err := decodeValue(field.value, decoder.columnType(i, sql_types.VarChar))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %v, column %v: %v", ErrMalformedData, decoder.line, i+1, err)
+		}
+		row = append(row, value)
+	}
+	return row, nil
+}
+
+// writeCsv writes the row in the csv format, the values are quoted if they contain the delimiter,
+// the quote or the line end, match the NULL string or the end data mark, and for the
+// forced columns (the FORCE_QUOTE columns, none for the header)
+func (encoder *Encoder) writeCsv(row sql_types.Row, forceQuote []bool) error {
+	options := encoder.options
+	for i, value := range row {
+		if i > 0 {
+			if err := encoder.writer.WriteByte(options.Delimiter); err != nil {
+				return err
+			}
+		}
+		if value.IsNull() {
+			if _, err := encoder.writer.WriteString(options.Null); err != nil {
+				return err
+			}
+			continue
+		}
+		text := encodeValue(value, encoder.columnType(i, value))
+		quote := (i < len(forceQuote) && forceQuote[i]) || text == options.Null || text == END_OF_DATA ||
+			strings.IndexByte(text, options.Delimiter) >= 0 || strings.IndexByte(text, options.Quote) >= 0 ||
+			strings.ContainsAny(text, "\r\n")
+		if !quote {
+			if _, err := encoder.writer.WriteString(text); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := encoder.writer.WriteByte(options.Quote); err != nil {
+			return err
+		}
+		for j := 0; j < len(text); j++ {
+			if ch := text[j]; ch == options.Quote || ch == options.Escape {
+				if err := encoder.writer.WriteByte(options.Escape); err != nil {
+					return err
+				}
+			}
+			if err := encoder.writer.WriteByte(text[j]); err != nil {
+				return err
+			}
+		}
+		if err := encoder.writer.WriteByte(options.Quote); err != nil {
+			return err
+		}
+	}
+	return encoder.writer.WriteByte('\n')
+}
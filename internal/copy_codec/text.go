@@ -0,0 +1,199 @@
+package copy_codec
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// readText reads the row of the text format
+func (decoder *Decoder) readText() (sql_types.Row, error) {
+	line, err := decoder.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if line == END_OF_DATA {
+		decoder.finished = true
+		return nil, io.EOF
+	}
+	row := make(sql_types.Row, 0, len(decoder.types))
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i+1 < len(line) && line[i] == '\\' {
+			// The escaped delimiter is the part of the value
+			i++
+			continue
+		}
+		if i < len(line) && line[i] != decoder.options.Delimiter {
+			continue
+		}
+		field := line[start:i]
+		start = i + 1
+		if field == decoder.options.Null {
+			row = append(row, sql_types.NULL)
+			continue
+		}
+		value, err := decodeValue(DecodeTextValue(field), decoder.columnType(len(row), sql_types.VarChar))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %v, column %v: %v", ErrMalformedData, decoder.line, len(row)+1, err)
+		}
+		row = append(row, value)
+	}
+	return row, nil
+}
+
+// writeText writes the row in the text format
+func (encoder *Encoder) writeText(row sql_types.Row) error {
+	for i, value := range row {
+		if i > 0 {
+			if err := encoder.writer.WriteByte(encoder.options.Delimiter); err != nil {
+				return err
+			}
+		}
+		var text string
+		if value.IsNull() {
+			text = encoder.options.Null
+		} else {
+			text = EncodeTextValue(encodeValue(value, encoder.columnType(i, value)), encoder.options.Delimiter)
+		}
+		if _, err := encoder.writer.WriteString(text); err != nil {
+			return err
+		}
+	}
+	return encoder.writer.WriteByte('\n')
+}
+
+// EncodeTextValue escapes the backslashes, the control characters and the delimiter of the value
+// for the text format of COPY
+func EncodeTextValue(value string, delimiter byte) string {
+	if !strings.ContainsAny(value, "\\\b\f\n\r\t\v") && strings.IndexByte(value, delimiter) < 0 {
+		return value
+	}
+	text := strings.Builder{}
+	text.Grow(len(value) + 8)
+	for i := 0; i < len(value); i++ {
+		switch ch := value[i]; ch {
+		case '\\':
+			text.WriteString(`\\`)
+		case '\b':
+			text.WriteString(`\b`)
+		case '\f':
+			text.WriteString(`\f`)
+		case '\n':
+			text.WriteString(`\n`)
+		case '\r':
+			text.WriteString(`\r`)
+		case '\t':
+			text.WriteString(`\t`)
+		case '\v':
+			text.WriteString(`\v`)
+		default:
+			if ch == delimiter {
+				text.WriteByte('\\')
+			}
+			text.WriteByte(ch)
+		}
+	}
+	return text.String()
+}
+
+// DecodeTextValue decodes the backslash sequences of the text format of COPY:
+// \b \f \n \r \t \v, the octal (\ooo) and the hex (\xhh) bytes, the other characters are taken as is
+func DecodeTextValue(value string) string {
+	if strings.IndexByte(value, '\\') < 0 {
+		return value
+	}
+	text := strings.Builder{}
+	text.Grow(len(value))
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ch != '\\' || i+1 == len(value) {
+			text.WriteByte(ch)
+			continue
+		}
+		i++
+		switch ch = value[i]; ch {
+		case 'b':
+			text.WriteByte('\b')
+		case 'f':
+			text.WriteByte('\f')
+		case 'n':
+			text.WriteByte('\n')
+		case 'r':
+			text.WriteByte('\r')
+		case 't':
+			text.WriteByte('\t')
+		case 'v':
+			text.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			code := 0
+			j := i
+			for ; j < len(value) && j < i+3 && value[j] >= '0' && value[j] <= '7'; j++ {
+				code = code*8 + int(value[j]-'0')
+			}
+			text.WriteByte(byte(code))
+			i = j - 1
+		case 'x':
+			code := 0
+			j := i + 1
+			for ; j < len(value) && j < i+3 && isHexDigit(value[j]); j++ {
+				code = code*16 + hexDigit(value[j])
+			}
+			if j == i+1 {
+				// No hex digits, the x is taken as is
+				text.WriteByte(ch)
+				continue
+			}
+			text.WriteByte(byte(code))
+			i = j - 1
+		default:
+			text.WriteByte(ch)
+		}
+	}
+	return text.String()
+}
+
+func isHexDigit(ch byte) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
+
+func hexDigit(ch byte) int {
+	switch {
+	case ch >= 'a':
+		return int(ch-'a') + 10
+	case ch >= 'A':
+		return int(ch-'A') + 10
+	}
+	return int(ch - '0')
+}
+
+// decodeValue converts the text of the value to the type: the bytea of the binary types is decoded,
+// the booleans (t, f) of the Int8 type become 1 and 0, the numbers are validated
+func decodeValue(text string, typ sql_types.Type) (sql_types.Value, error) {
+	switch {
+	case sql_types.IsBinary(typ):
+		bytes, err := sql_types.DecodeBytea(text)
+		if err != nil {
+			return sql_types.NULL, err
+		}
+		return sql_types.MakeTrusted(typ, bytes), nil
+	case typ == sql_types.Int8 || typ == sql_types.Uint8:
+		switch strings.ToLower(text) {
+		case "t", "true", "y", "yes", "on":
+			text = "1"
+		case "f", "false", "n", "no", "off":
+			text = "0"
+		}
+	}
+	return sql_types.NewValue(typ, []byte(text))
+}
+
+// encodeValue returns the text of the value, the values of the binary types are encoded as bytea
+func encodeValue(value sql_types.Value, typ sql_types.Type) string {
+	if sql_types.IsBinary(typ) {
+		return sql_types.EncodeBytea(value.Raw())
+	}
+	return value.RawStr()
+}
@@ -0,0 +1,270 @@
+package dump_writer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// Compression of the dump file, it is defined by the file name extension
+type Compression uint8
+
+const (
+	COMPRESSION_NONE Compression = 0
+	COMPRESSION_GZIP Compression = 1 // .gz
+	COMPRESSION_ZSTD Compression = 2 // .zst, .zstd
+)
+
+func (compression Compression) String() string {
+	switch compression {
+	case COMPRESSION_NONE:
+		return "none"
+	case COMPRESSION_GZIP:
+		return "gzip"
+	case COMPRESSION_ZSTD:
+		return "zstd"
+	}
+	return "undefined"
+}
+
+// POST_DATA_NAME is the suffix of the file with the statements following the table files in the split mode
+const POST_DATA_NAME = "post-data"
+
+// CompressionOf returns the compression and the compression extension of the file name
+func CompressionOf(fileName string) (Compression, string) {
+	ext := filepath.Ext(fileName)
+	switch strings.ToLower(ext) {
+	case ".gz":
+		return COMPRESSION_GZIP, ext
+	case ".zst", ".zstd":
+		return COMPRESSION_ZSTD, ext
+	}
+	return COMPRESSION_NONE, ""
+}
+
+// output is the compressed file of the dump
+type output struct {
+	file       io.WriteCloser
+	compressor io.WriteCloser // nil for the not compressed file
+	writer     *bufio.Writer
+}
+
+func newOutput(file io.WriteCloser, compression Compression) (*output, error) {
+	result := &output{file: file}
+	switch compression {
+	case COMPRESSION_GZIP:
+		result.compressor = gzip.NewWriter(file)
+	case COMPRESSION_ZSTD:
+		encoder, err := zstd.NewWriter(file, zstd.WithZeroFrames(true))
+		if err != nil {
+			return nil, err
+		}
+		result.compressor = encoder
+	}
+	if result.compressor != nil {
+		result.writer = bufio.NewWriter(result.compressor)
+	} else {
+		result.writer = bufio.NewWriter(file)
+	}
+	return result, nil
+}
+
+func (output *output) Close() error {
+	result := output.writer.Flush()
+	if output.compressor != nil {
+		if err := output.compressor.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	if err := output.file.Close(); err != nil && result == nil {
+		result = err
+	}
+	return result
+}
+
+// DumpWriter writes the statements to the sql dump file compressed by the extension of the file name.
+// In the split mode the statements of every table are written to the separate file <name>.<table>.sql[.gz|.zst],
+// the statements not bound to a table are written to the main file before the first table statement
+// and to the <name>.post-data.sql[.gz|.zst] file after it (views, foreign keys), so the files are applied
+// in the order: main file, table files, post-data file.
+type DumpWriter struct {
+	fileName      string
+	compression   Compression
+	splitPerTable bool
+	main          *output
+	postData      *output
+	tableFiles    map[string]*output
+	fileNames     []string
+	mutex         sync.Mutex
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// Create creates (truncates) the dump file, the empty file name is the standard output
+// (not compressed, the split mode is not allowed)
+func Create(fileName string, splitPerTable bool) (*DumpWriter, error) {
+	if fileName == "" && splitPerTable {
+		return nil, fmt.Errorf("split per table requires the output file name")
+	}
+	dumpWriter := &DumpWriter{
+		fileName:      fileName,
+		splitPerTable: splitPerTable,
+		tableFiles:    make(map[string]*output),
+	}
+	if fileName == "" {
+		dumpWriter.main, _ = newOutput(nopCloser{os.Stdout}, COMPRESSION_NONE)
+		return dumpWriter, nil
+	}
+	dumpWriter.compression, _ = CompressionOf(fileName)
+	var err error
+	dumpWriter.main, err = dumpWriter.create(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return dumpWriter, nil
+}
+
+// TableFileName returns the name of the dump file for the statements of the table in the split mode
+func (dumpWriter *DumpWriter) TableFileName(tableName string) string {
+	_, compressionExt := CompressionOf(dumpWriter.fileName)
+	name := strings.TrimSuffix(dumpWriter.fileName, compressionExt)
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	if ext == "" {
+		ext = ".sql"
+	}
+	return base + "." + unsafeFileNameChars.ReplaceAllString(tableName, "_") + ext + compressionExt
+}
+
+// FileNames returns the names of the created files in the order of the creation
+func (dumpWriter *DumpWriter) FileNames() []string {
+	dumpWriter.mutex.Lock()
+	defer dumpWriter.mutex.Unlock()
+
+	return append([]string(nil), dumpWriter.fileNames...)
+}
+
+// Write writes the statement terminated by the semicolon (the COPY data block is finished by the end data mark).
+// The statement is routed to the file of the table in the split mode, the empty table name is the statement
+// not bound to a table.
+func (dumpWriter *DumpWriter) Write(tableName string, statementText string) error {
+	dumpWriter.mutex.Lock()
+	defer dumpWriter.mutex.Unlock()
+
+	output, err := dumpWriter.output(tableName)
+	if err != nil {
+		return err
+	}
+	return writeStatement(output.writer, statementText)
+}
+
+// WriteComment writes the sql comment (every line is prefixed by --) to the file of the table
+func (dumpWriter *DumpWriter) WriteComment(tableName string, comment string) error {
+	dumpWriter.mutex.Lock()
+	defer dumpWriter.mutex.Unlock()
+
+	output, err := dumpWriter.output(tableName)
+	if err != nil {
+		return err
+	}
+	for _, line := range strings.Split(comment, "\n") {
+		if _, err := fmt.Fprintf(output.writer, "-- %s\n", line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dumpWriter *DumpWriter) output(tableName string) (*output, error) {
+	if !dumpWriter.splitPerTable {
+		return dumpWriter.main, nil
+	}
+	if tableName == "" {
+		if len(dumpWriter.tableFiles) == 0 {
+			return dumpWriter.main, nil
+		}
+		if dumpWriter.postData == nil {
+			postData, err := dumpWriter.create(dumpWriter.TableFileName(POST_DATA_NAME))
+			if err != nil {
+				return nil, err
+			}
+			dumpWriter.postData = postData
+		}
+		return dumpWriter.postData, nil
+	}
+	if output, ok := dumpWriter.tableFiles[tableName]; ok {
+		return output, nil
+	}
+	output, err := dumpWriter.create(dumpWriter.TableFileName(tableName))
+	if err != nil {
+		return nil, err
+	}
+	dumpWriter.tableFiles[tableName] = output
+	return output, nil
+}
+
+func (dumpWriter *DumpWriter) create(fileName string) (*output, error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("create dump file %v fail: %w", fileName, err)
+	}
+	output, err := newOutput(file, dumpWriter.compression)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("create dump file %v fail: %w", fileName, err)
+	}
+	dumpWriter.fileNames = append(dumpWriter.fileNames, fileName)
+	return output, nil
+}
+
+func writeStatement(writer *bufio.Writer, statementText string) error {
+	text := strings.TrimSpace(statementText)
+	if _, err := writer.WriteString(text); err != nil {
+		return err
+	}
+	// COPY data block is finished by the end data mark
+	if ast.Preview(ast.StripLeadingComments(text)) != ast.StmtCopy && !strings.HasSuffix(text, ";") {
+		if err := writer.WriteByte(';'); err != nil {
+			return err
+		}
+	}
+	_, err := writer.WriteString("\n\n")
+	return err
+}
+
+// Close flushes and closes all dump files, the compressed streams are finished
+func (dumpWriter *DumpWriter) Close() error {
+	dumpWriter.mutex.Lock()
+	defer dumpWriter.mutex.Unlock()
+
+	result := dumpWriter.main.Close()
+	for _, output := range dumpWriter.tableFiles {
+		if err := output.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	if dumpWriter.postData != nil {
+		if err := dumpWriter.postData.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// nopCloser keeps the standard output open after Close
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,277 @@
+package load_plan
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/usalko/prodl/internal/reject_file"
+	"github.com/usalko/prodl/internal/sql_connection"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// JobKind is the kind of the statements of the job
+type JobKind uint8
+
+const (
+	JOB_DATA      JobKind = 0 // Data statements of the table (COPY, INSERT, UPDATE, DELETE)
+	JOB_POST_DATA JobKind = 1 // Statement following the data of its tables (index, constraint, trigger)
+)
+
+func (kind JobKind) String() string {
+	switch kind {
+	case JOB_DATA:
+		return "data"
+	case JOB_POST_DATA:
+		return "post-data"
+	}
+	return "undefined"
+}
+
+// Job is the sequence of the statements executed by one worker of the parallel load,
+// the job is started after all jobs it depends on are done
+type Job struct {
+	Kind       JobKind
+	Table      string // Key of the table of the job (the table name without the schema in lower case), empty for the unparsed statements
+	Size       int64  // Size of the statements of the job
+	offsets    []int64
+	dependents []*Job
+	waiting    int // Count of the dependencies which are not done
+}
+
+func (job *Job) String() string {
+	if job.Table == "" {
+		return fmt.Sprintf("%v of the unparsed statements (%v statements)", job.Kind, len(job.offsets))
+	}
+	return fmt.Sprintf("%v of %v (%v statements)", job.Kind, job.Table, len(job.offsets))
+}
+
+// dependOn adds the dependency of the job, the repeated dependency is ignored
+func (job *Job) dependOn(dependency *Job) {
+	if dependency == nil || dependency == job || slices.Contains(dependency.dependents, job) {
+		return
+	}
+	dependency.dependents = append(dependency.dependents, job)
+	job.waiting++
+}
+
+// Plan splits the dump into the pre-data statements executed serially during the streaming pass
+// and the jobs executed in parallel after it. The data of every table is the job, the statements
+// using the tables which already have the jobs (indexes, constraints, triggers) are the post-data jobs.
+// The job depends on the previous jobs of its tables and on the tables referenced by its foreign keys,
+// so the order of the dump is kept for every table. The statements of the jobs are spooled to the temporary file.
+type Plan struct {
+	spool      *Spool
+	jobs       []*Job
+	lastJobs   map[string]*Job     // The last job of the table
+	references map[string][]string // Tables referenced by the foreign keys of the table
+	sequences  map[string][]string // Tables using the sequence (column defaults, inserts)
+	session    []string            // Session statements in the order of the dump
+}
+
+// NewPlan makes the plan with the spool file in the directory (the default directory for temporary files if it is empty)
+func NewPlan(dir string) (*Plan, error) {
+	spool, err := NewSpool(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{
+		spool:      spool,
+		lastJobs:   make(map[string]*Job),
+		references: make(map[string][]string),
+		sequences:  make(map[string][]string),
+	}, nil
+}
+
+// Jobs returns the jobs in the order of the dump
+func (plan *Plan) Jobs() []*Job {
+	return plan.jobs
+}
+
+// Session returns the statements setting the session state (SET, USE, the SET of the MySQL conditional comment)
+// in the order of the dump, they are replayed by every worker before its jobs
+func (plan *Plan) Session() []string {
+	return plan.session
+}
+
+// Add puts the transpiled texts of the statement to the plan. The data statements and the statements
+// using the tables which already have the jobs are spooled, false is returned for the pre-data statement,
+// it must be executed by the caller before the next statement is added.
+// The session statements are executed by the caller and kept for the workers (see Session).
+// The statement which isn't parsed (nil) is spooled after all jobs once the data starts,
+// the tables it uses are unknown.
+func (plan *Plan) Add(statement ast.Statement, texts []string, position reject_file.Position) (bool, error) {
+	if len(texts) == 0 || plan.addSession(texts) || statement == nil && len(plan.jobs) == 0 {
+		return false, nil
+	}
+	if statement == nil {
+		return true, plan.spoolUnparsed(texts, position)
+	}
+	tables := statementTables(statement)
+	for _, sequence := range sequenceKeys(statement) {
+		if len(tables) > 0 && !slices.Contains(plan.sequences[sequence], tables[0]) {
+			plan.sequences[sequence] = append(plan.sequences[sequence], tables[0])
+		}
+		for _, table := range plan.sequences[sequence] {
+			if !slices.Contains(tables, table) {
+				tables = append(tables, table)
+			}
+		}
+	}
+
+	data := isData(statement) && len(tables) > 0
+	if !data && !plan.hasJobs(tables) {
+		if len(tables) > 0 {
+			for _, reference := range foreignKeyTables(statement) {
+				if key := tableKey(reference); !slices.Contains(plan.references[tables[0]], key) {
+					plan.references[tables[0]] = append(plan.references[tables[0]], key)
+				}
+			}
+		}
+		return false, nil
+	}
+
+	job := plan.lastJobs[tables[0]]
+	if !data || len(tables) > 1 || job == nil || job.Kind != JOB_DATA || job.Table != tables[0] {
+		job = &Job{Kind: JOB_POST_DATA, Table: tables[0]}
+		if data {
+			job.Kind = JOB_DATA
+			for _, reference := range plan.references[tables[0]] {
+				job.dependOn(plan.lastJobs[reference])
+			}
+		}
+		for _, table := range tables {
+			job.dependOn(plan.lastJobs[table])
+			plan.lastJobs[table] = job
+		}
+		plan.jobs = append(plan.jobs, job)
+	}
+	return true, plan.write(job, texts, position)
+}
+
+// addSession keeps the texts of the session statement, false is returned for the other statements
+func (plan *Plan) addSession(texts []string) bool {
+	for _, text := range texts {
+		if kind, _ := sql_connection.ClassifyStatement(text); kind != sql_connection.STATEMENT_SESSION {
+			return false
+		}
+	}
+	plan.session = append(plan.session, texts...)
+	return true
+}
+
+// spoolUnparsed spools the statement which isn't parsed to the post-data job depending on the last jobs
+// of all tables, the following jobs of the tables depend on it
+func (plan *Plan) spoolUnparsed(texts []string, position reject_file.Position) error {
+	job := plan.jobs[len(plan.jobs)-1]
+	if job.Table != "" {
+		job = &Job{Kind: JOB_POST_DATA}
+		for table, lastJob := range plan.lastJobs {
+			job.dependOn(lastJob)
+			plan.lastJobs[table] = job
+		}
+		plan.jobs = append(plan.jobs, job)
+	}
+	return plan.write(job, texts, position)
+}
+
+// write spools the texts of the statement to the job
+func (plan *Plan) write(job *Job, texts []string, position reject_file.Position) error {
+	for _, text := range texts {
+		offset, err := plan.spool.Write(Record{Position: position, Text: text})
+		if err != nil {
+			return err
+		}
+		job.offsets = append(job.offsets, offset)
+		job.Size += int64(len(text))
+	}
+	return nil
+}
+
+func (plan *Plan) hasJobs(tables []string) bool {
+	for _, table := range tables {
+		if plan.lastJobs[table] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Records reads the statements of the job in the order of the dump, it is safe for the concurrent use
+func (plan *Plan) Records(job *Job, process func(record Record) error) error {
+	for _, offset := range job.offsets {
+		record, err := plan.spool.Read(offset)
+		if err != nil {
+			return err
+		}
+		if err := process(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jobResult struct {
+	job *Job
+	err error
+}
+
+// Run executes the jobs by the workers, the job is started when all its dependencies are done,
+// the biggest ready job is started first. The first error stops the start of the new jobs,
+// it is returned after the running jobs are done.
+func (plan *Plan) Run(workers int, execute func(worker int, job *Job) error) error {
+	if err := plan.spool.Flush(); err != nil {
+		return err
+	}
+	workers = max(workers, 1)
+	tasks := make(chan *Job)
+	results := make(chan jobResult)
+	for worker := 0; worker < workers; worker++ {
+		go func(worker int) {
+			for job := range tasks {
+				results <- jobResult{job: job, err: execute(worker, job)}
+			}
+		}(worker)
+	}
+	defer close(tasks)
+
+	ready := make([]*Job, 0, len(plan.jobs))
+	for _, job := range plan.jobs {
+		if job.waiting == 0 {
+			ready = append(ready, job)
+		}
+	}
+	var firstErr error
+	running, done := 0, 0
+	for done < len(plan.jobs) {
+		if firstErr != nil && running == 0 {
+			break
+		}
+		if firstErr == nil && len(ready) > 0 && running < workers {
+			slices.SortStableFunc(ready, func(a, b *Job) int {
+				return int(min(max(b.Size-a.Size, -1), 1))
+			})
+			tasks <- ready[0]
+			ready = ready[1:]
+			running++
+			continue
+		}
+		result := <-results
+		running--
+		done++
+		if result.err != nil && firstErr == nil {
+			firstErr = result.err
+		}
+		for _, dependent := range result.job.dependents {
+			dependent.waiting--
+			if dependent.waiting == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	return firstErr
+}
+
+// Close removes the spool file of the plan
+func (plan *Plan) Close() error {
+	return plan.spool.Close()
+}
@@ -0,0 +1,120 @@
+package load_plan
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/usalko/prodl/internal/reject_file"
+)
+
+var (
+	ErrMalformedSpool = errors.New("malformed spool record")
+)
+
+// Record is the statement spooled for the parallel phase of the load
+type Record struct {
+	Position reject_file.Position
+	Text     string
+}
+
+// Spool keeps the statements of the jobs in the temporary file until the parallel phase of the load.
+// The records are appended by the single writer and read concurrently by the offsets.
+type Spool struct {
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+}
+
+// NewSpool creates the temporary spool file in the directory (the default directory for temporary files if it is empty)
+func NewSpool(dir string) (*Spool, error) {
+	file, err := os.CreateTemp(dir, "prodl-spool-*")
+	if err != nil {
+		return nil, err
+	}
+	return &Spool{file: file, writer: bufio.NewWriterSize(file, 1<<20)}, nil
+}
+
+// Write appends the record, the offset of the record is returned
+func (spool *Spool) Write(record Record) (int64, error) {
+	body := make([]byte, 0, len(record.Text)+len(record.Position.FileName)+len(record.Position.Entry)+4*binary.MaxVarintLen64)
+	body = binary.AppendUvarint(body, uint64(len(record.Position.FileName)))
+	body = append(body, record.Position.FileName...)
+	body = binary.AppendUvarint(body, uint64(len(record.Position.Entry)))
+	body = append(body, record.Position.Entry...)
+	body = binary.AppendVarint(body, record.Position.Statement)
+	body = binary.AppendVarint(body, record.Position.Offset)
+	body = binary.AppendVarint(body, record.Position.Line)
+	body = append(body, record.Text...)
+
+	offset := spool.size
+	header := binary.LittleEndian.AppendUint64(nil, uint64(len(body)))
+	if _, err := spool.writer.Write(header); err != nil {
+		return 0, err
+	}
+	if _, err := spool.writer.Write(body); err != nil {
+		return 0, err
+	}
+	spool.size += int64(len(header) + len(body))
+	return offset, nil
+}
+
+// Flush writes the buffered records to the file, the records are readable after the flush
+func (spool *Spool) Flush() error {
+	return spool.writer.Flush()
+}
+
+// Size returns the size of the spooled records
+func (spool *Spool) Size() int64 {
+	return spool.size
+}
+
+// Read reads the record at the offset, it is safe for the concurrent use
+func (spool *Spool) Read(offset int64) (Record, error) {
+	header := make([]byte, 8)
+	if _, err := spool.file.ReadAt(header, offset); err != nil {
+		return Record{}, fmt.Errorf("%w at %v: %v", ErrMalformedSpool, offset, err)
+	}
+	body := make([]byte, binary.LittleEndian.Uint64(header))
+	if _, err := spool.file.ReadAt(body, offset+8); err != nil {
+		return Record{}, fmt.Errorf("%w at %v: %v", ErrMalformedSpool, offset, err)
+	}
+
+	record := Record{}
+	var ok bool
+	if record.Position.FileName, body, ok = readString(body); !ok {
+		return Record{}, fmt.Errorf("%w at %v", ErrMalformedSpool, offset)
+	}
+	if record.Position.Entry, body, ok = readString(body); !ok {
+		return Record{}, fmt.Errorf("%w at %v", ErrMalformedSpool, offset)
+	}
+	for _, value := range []*int64{&record.Position.Statement, &record.Position.Offset, &record.Position.Line} {
+		number, size := binary.Varint(body)
+		if size <= 0 {
+			return Record{}, fmt.Errorf("%w at %v", ErrMalformedSpool, offset)
+		}
+		*value, body = number, body[size:]
+	}
+	record.Text = string(body)
+	return record, nil
+}
+
+// readString reads the string prefixed by the length
+func readString(data []byte) (string, []byte, bool) {
+	length, size := binary.Uvarint(data)
+	if size <= 0 || uint64(len(data)-size) < length {
+		return "", nil, false
+	}
+	return string(data[size : size+int(length)]), data[size+int(length):], true
+}
+
+// Close closes and removes the spool file
+func (spool *Spool) Close() error {
+	err := spool.file.Close()
+	if removeErr := os.Remove(spool.file.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
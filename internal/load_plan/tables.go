@@ -0,0 +1,186 @@
+package load_plan
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// StatementTable returns the table of the statement or the empty string
+// for the statements not bound to a table (session settings, types, views)
+func StatementTable(statement ast.Statement) string {
+	table := statementTableName(statement)
+	if table.IsEmpty() {
+		return ""
+	}
+	if table.Qualifier.IsEmpty() {
+		return table.Name.String()
+	}
+	return table.Qualifier.String() + "." + table.Name.String()
+}
+
+// The table of INSERT [modifiers] INTO table, REPLACE INTO table and COPY table, the name can be qualified and quoted
+var dataTablePattern = regexp.MustCompile("(?i)^(?:(?:insert|replace)(?:\\s+(?:low_priority|delayed|high_priority|ignore))*\\s+into|copy)\\s+" +
+	"(`[^`]+`|\"[^\"]+\"|[\\w$]+)(?:\\s*\\.\\s*(`[^`]+`|\"[^\"]+\"|[\\w$]+))?")
+
+// StatementTextTable returns the table of the INSERT or COPY statement which isn't parsed (like
+// the data of the --parse ddl mode) by the beginning of its text, the empty string is returned
+// for the other statements
+func StatementTextTable(statementText string) string {
+	switch ast.Preview(statementText) {
+	case ast.StmtInsert, ast.StmtReplace, ast.StmtCopy:
+	default:
+		return ""
+	}
+	match := dataTablePattern.FindStringSubmatch(strings.TrimSpace(ast.StripLeadingComments(statementText)))
+	if match == nil {
+		return ""
+	}
+	if match[2] == "" {
+		return strings.Trim(match[1], "`\"")
+	}
+	return strings.Trim(match[1], "`\"") + "." + strings.Trim(match[2], "`\"")
+}
+
+func statementTableName(statement ast.Statement) ast.TableName {
+	var table ast.TableName
+	switch node := statement.(type) {
+	case *ast.Insert:
+		table = node.Table
+	case *ast.CopyFrom:
+		table = node.Table
+	case *ast.CreateTrigger:
+		table = node.Table
+	case *ast.CommentOn:
+		if strings.EqualFold(node.ObjectType, "table") {
+			table = node.Object
+		}
+	case *ast.CreateView, *ast.AlterView:
+		return ast.TableName{}
+	case ast.DDLStatement:
+		table = node.GetTable()
+		if fromTables := node.GetFromTables(); table.IsEmpty() && len(fromTables) == 1 {
+			// DROP TABLE IF EXISTS precedes CREATE TABLE in the dump
+			table = fromTables[0]
+		}
+	}
+	return table
+}
+
+// tableKey is the key of the table in the plan, the schema is omitted, so the tables of the same name
+// in the different schemas are scheduled as one table (the order of the dump is kept anyway)
+func tableKey(table ast.TableName) string {
+	return strings.ToLower(table.Name.String())
+}
+
+// isData returns true for the statements changing the data of the table
+func isData(statement ast.Statement) bool {
+	switch statement.(type) {
+	case *ast.Insert, *ast.CopyFrom, *ast.Update, *ast.Delete:
+		return true
+	}
+	return false
+}
+
+// statementTables returns the keys of the tables used by the statement, the table of the statement is the first one
+func statementTables(statement ast.Statement) []string {
+	result := make([]string, 0, 2)
+	add := func(table ast.TableName) {
+		if table.IsEmpty() {
+			return
+		}
+		key := tableKey(table)
+		if key == "dual" {
+			return
+		}
+		for _, existing := range result {
+			if existing == key {
+				return
+			}
+		}
+		result = append(result, key)
+	}
+	add(statementTableName(statement))
+	for _, table := range foreignKeyTables(statement) {
+		add(table)
+	}
+	ast.Walk(func(node ast.SQLNode) (bool, error) {
+		if table, ok := node.(ast.TableName); ok {
+			add(table)
+		}
+		return true, nil
+	}, statement)
+	return result
+}
+
+// foreignKeyTables returns the tables referenced by the foreign keys of CREATE TABLE and ALTER TABLE
+func foreignKeyTables(statement ast.Statement) []ast.TableName {
+	result := []ast.TableName{}
+	addColumns := func(columns []*ast.ColumnDefinition) {
+		for _, column := range columns {
+			if column.Type.Options != nil && column.Type.Options.Reference != nil {
+				result = append(result, column.Type.Options.Reference.ReferencedTable)
+			}
+		}
+	}
+	addConstraint := func(constraint *ast.ConstraintDefinition) {
+		if foreignKey, ok := constraint.Details.(*ast.ForeignKeyDefinition); ok && foreignKey.ReferenceDefinition != nil {
+			result = append(result, foreignKey.ReferenceDefinition.ReferencedTable)
+		}
+	}
+	switch node := statement.(type) {
+	case *ast.CreateTable:
+		if node.TableSpec != nil {
+			addColumns(node.TableSpec.Columns)
+			for _, constraint := range node.TableSpec.Constraints {
+				addConstraint(constraint)
+			}
+		}
+	case *ast.AlterTable:
+		for _, option := range node.AlterOptions {
+			switch option := option.(type) {
+			case *ast.AddConstraintDefinition:
+				addConstraint(option.ConstraintDefinition)
+			case *ast.AddColumns:
+				addColumns(option.Columns)
+			}
+		}
+	}
+	return result
+}
+
+// The sequence name of nextval('public.t_id_seq'::regclass)
+var sequenceArgumentPattern = regexp.MustCompile(`^\s*'([^']*)'`)
+
+// sequenceKeys returns the keys of the sequences used by nextval, currval and setval calls of the statement
+func sequenceKeys(statement ast.Statement) []string {
+	result := []string{}
+	visit := func(node ast.SQLNode) (bool, error) {
+		funcExpr, ok := node.(*ast.FuncExpr)
+		if !ok || len(funcExpr.Exprs) == 0 {
+			return true, nil
+		}
+		if !funcExpr.Name.EqualString("nextval") && !funcExpr.Name.EqualString("currval") && !funcExpr.Name.EqualString("setval") {
+			return true, nil
+		}
+		if match := sequenceArgumentPattern.FindStringSubmatch(ast.String(funcExpr.Exprs[0])); match != nil {
+			name := match[1]
+			if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+				name = name[dot+1:]
+			}
+			result = append(result, strings.ToLower(strings.Trim(name, `"`)))
+		}
+		return true, nil
+	}
+	ast.Walk(visit, statement)
+	// The column defaults of CREATE TABLE aren't visited by the walker
+	if createTable, ok := statement.(*ast.CreateTable); ok && createTable.TableSpec != nil {
+		for _, column := range createTable.TableSpec.Columns {
+			if column.Type.Options != nil && column.Type.Options.Default != nil {
+				ast.Walk(visit, column.Type.Options.Default)
+			}
+		}
+	}
+	return result
+}
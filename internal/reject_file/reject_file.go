@@ -0,0 +1,149 @@
+package reject_file
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+// Position is a position of the rejected statement in the source dump
+type Position struct {
+	FileName  string
+	Entry     string
+	Statement int64 // Ordinal of the statement inside the entry
+	Offset    int64 // Byte offset of the statement inside the entry
+	Line      int64 // Line of the statement inside the entry
+}
+
+func (position Position) String() string {
+	return fmt.Sprintf("file: %v, entry: %v, statement: %v, offset: %v, line: %v",
+		position.FileName, position.Entry, position.Statement, position.Offset, position.Line)
+}
+
+// RejectFile writes failed statements to the replayable sql file.
+// Every statement is prefixed by the comment with the source position and the error.
+// Rejected COPY blocks are written to the separate file per table.
+type RejectFile struct {
+	fileName   string
+	file       *os.File
+	writer     *bufio.Writer
+	tableFiles map[string]*bufio.Writer
+	osFiles    []*os.File
+	mutex      sync.Mutex
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// Open creates (truncates) the reject file
+func Open(fileName string) (*RejectFile, error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("create reject file %v fail: %w", fileName, err)
+	}
+	return &RejectFile{
+		fileName:   fileName,
+		file:       file,
+		writer:     bufio.NewWriter(file),
+		tableFiles: make(map[string]*bufio.Writer),
+		osFiles:    []*os.File{file},
+	}, nil
+}
+
+// TableFileName returns the name of the reject file for the COPY rows of the table
+func (rejectFile *RejectFile) TableFileName(tableName string) string {
+	ext := filepath.Ext(rejectFile.fileName)
+	base := strings.TrimSuffix(rejectFile.fileName, ext)
+	if ext == "" {
+		ext = ".sql"
+	}
+	return base + "." + unsafeFileNameChars.ReplaceAllString(tableName, "_") + ext
+}
+
+// Reject writes the failed statement, COPY statements are routed to the per-table file
+func (rejectFile *RejectFile) Reject(position Position, statementText string, statement ast.Statement, rejectError error) error {
+	rejectFile.mutex.Lock()
+	defer rejectFile.mutex.Unlock()
+
+	writer := rejectFile.writer
+	if statement == nil && ast.Preview(ast.StripLeadingComments(statementText)) == ast.StmtCopy {
+		// The statement wasn't parsed (split-only mode), parse it to get the table name
+		statement, _ = sql_parser.Parse(statementText, dialect.PSQL)
+	}
+	if copyFrom, ok := statement.(*ast.CopyFrom); ok {
+		tableName := ast.String(copyFrom.Table)
+		tableWriter, err := rejectFile.tableWriter(tableName)
+		if err != nil {
+			return err
+		}
+		writer = tableWriter
+	}
+	return writeStatement(writer, position, statementText, rejectError)
+}
+
+func (rejectFile *RejectFile) tableWriter(tableName string) (*bufio.Writer, error) {
+	if writer, ok := rejectFile.tableFiles[tableName]; ok {
+		return writer, nil
+	}
+	fileName := rejectFile.TableFileName(tableName)
+	file, err := os.Create(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("create reject file %v fail: %w", fileName, err)
+	}
+	writer := bufio.NewWriter(file)
+	rejectFile.tableFiles[tableName] = writer
+	rejectFile.osFiles = append(rejectFile.osFiles, file)
+	return writer, nil
+}
+
+func writeStatement(writer *bufio.Writer, position Position, statementText string, rejectError error) error {
+	text := strings.TrimSpace(ast.StripLeadingComments(statementText))
+	if _, err := fmt.Fprintf(writer, "-- %v\n", position); err != nil {
+		return err
+	}
+	for _, line := range strings.Split(fmt.Sprint(rejectError), "\n") {
+		if _, err := fmt.Fprintf(writer, "-- error: %v\n", line); err != nil {
+			return err
+		}
+	}
+	if _, err := writer.WriteString(text); err != nil {
+		return err
+	}
+	// COPY data block is finished by the end data mark
+	if ast.Preview(text) != ast.StmtCopy && !strings.HasSuffix(text, ";") {
+		if err := writer.WriteByte(';'); err != nil {
+			return err
+		}
+	}
+	_, err := writer.WriteString("\n\n")
+	return err
+}
+
+// Close flushes and closes all reject files
+func (rejectFile *RejectFile) Close() error {
+	rejectFile.mutex.Lock()
+	defer rejectFile.mutex.Unlock()
+
+	var result error
+	if err := rejectFile.writer.Flush(); err != nil {
+		result = err
+	}
+	for _, writer := range rejectFile.tableFiles {
+		if err := writer.Flush(); err != nil && result == nil {
+			result = err
+		}
+	}
+	for _, file := range rejectFile.osFiles {
+		if err := file.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
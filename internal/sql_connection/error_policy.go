@@ -0,0 +1,78 @@
+package sql_connection
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_RETRIES     = 5
+	DEFAULT_RETRY_DELAY = 100 * time.Millisecond
+	MAX_RETRY_DELAY     = 10 * time.Second
+)
+
+// ErrorPolicyKind defines what the load does with the failed statement
+type ErrorPolicyKind uint8
+
+const (
+	ON_ERROR_SKIP  ErrorPolicyKind = 0 // The failed statement is reported and the load goes on
+	ON_ERROR_STOP  ErrorPolicyKind = 1 // The first failed statement stops the load
+	ON_ERROR_RETRY ErrorPolicyKind = 2 // The statement failed by the transient error is executed again, the other failed statements are skipped
+)
+
+// ErrorPolicy defines what the load does with the failed statement
+type ErrorPolicy struct {
+	Kind    ErrorPolicyKind
+	Retries int           // Maximal count of the retries of the statement in the ON_ERROR_RETRY mode
+	Delay   time.Duration // Delay before the first retry, the delay is doubled for every next retry
+}
+
+func (policy ErrorPolicy) String() string {
+	switch policy.Kind {
+	case ON_ERROR_SKIP:
+		return "skip"
+	case ON_ERROR_STOP:
+		return "stop"
+	case ON_ERROR_RETRY:
+		return "retry:" + strconv.Itoa(policy.Retries)
+	}
+	return "undefined"
+}
+
+// ParseErrorPolicy converts cli option value (stop|skip|retry|retry:N) to the ErrorPolicy
+func ParseErrorPolicy(value string) (ErrorPolicy, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "skip", "":
+		return ErrorPolicy{Kind: ON_ERROR_SKIP}, nil
+	case "stop":
+		return ErrorPolicy{Kind: ON_ERROR_STOP}, nil
+	case "retry":
+		return ErrorPolicy{Kind: ON_ERROR_RETRY, Retries: DEFAULT_RETRIES, Delay: DEFAULT_RETRY_DELAY}, nil
+	}
+	if retries, ok := strings.CutPrefix(value, "retry:"); ok {
+		count, err := strconv.Atoi(retries)
+		if err == nil && count > 0 {
+			return ErrorPolicy{Kind: ON_ERROR_RETRY, Retries: count, Delay: DEFAULT_RETRY_DELAY}, nil
+		}
+	}
+	return ErrorPolicy{}, fmt.Errorf("unknown error policy: %v, expected one of stop|skip|retry|retry:N", value)
+}
+
+// Execute executes the statement by the connection, the statement failed by the transient error
+// (see IsTransient) is executed again by the ON_ERROR_RETRY policy, the delay before the retry
+// is doubled for every next retry. The count of the retries and the classified error
+// of the last attempt (see ClassifyError) are returned.
+func (policy ErrorPolicy) Execute(connection SqlConnection, rawSql string) (int, error) {
+	delay := policy.Delay
+	for retries := 0; ; retries++ {
+		err := ClassifyError(connection.Execute(rawSql))
+		if policy.Kind != ON_ERROR_RETRY || retries >= policy.Retries || !IsTransient(err) {
+			return retries, err
+		}
+		time.Sleep(delay)
+		delay = min(delay*2, MAX_RETRY_DELAY)
+	}
+}
@@ -0,0 +1,245 @@
+package sql_connection
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/usalko/prodl/internal/sql_parser_errors"
+)
+
+var (
+	ErrTransactionLost = errors.New("uncommitted statements are lost")
+)
+
+// lostStatements annotates the error of the statement which lost the uncommitted statements
+// of the transaction, such statement can't be retried alone
+func lostStatements(err error, reason string, count int) error {
+	if count == 0 {
+		return fmt.Errorf("%w (%v)", err, reason)
+	}
+	return fmt.Errorf("%w (%v, %v %w)", err, reason, count, ErrTransactionLost)
+}
+
+// ClassifyError maps the error of the database driver to the code and the state of sql_parser_errors:
+// the SQLSTATE of PostgreSQL, the error number of MySQL, the result code of SQLite and the network errors.
+// The classified error keeps the message and wraps the driver error,
+// the nil error and the error classified already are returned as is.
+func ClassifyError(err error) error {
+	if err == nil || sql_parser_errors.Code(err) != sql_parser_errors.Code_UNKNOWN {
+		return err
+	}
+	code, state := classify(err)
+	if code == sql_parser_errors.Code_UNKNOWN {
+		return err
+	}
+	return sql_parser_errors.WithCode(err, code, state)
+}
+
+// IsTransient returns true for the error of the statement which can succeed if it is executed again:
+// the deadlock, the serialization failure, the lock timeout (SQLITE_BUSY) and the lost connection.
+// The statement which lost the uncommitted statements of its transaction isn't transient.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, ErrTransactionLost) {
+		return false
+	}
+	switch sql_parser_errors.Code(ClassifyError(err)) {
+	case sql_parser_errors.Code_ABORTED, sql_parser_errors.Code_UNAVAILABLE:
+		return true
+	}
+	return false
+}
+
+func classify(err error) (int32, sql_parser_errors.State) {
+	var pgError *pgconn.PgError
+	var mysqlError *mysql.MySQLError
+	var sqliteError sqlite3.Error
+	switch {
+	case errors.As(err, &pgError):
+		return classifySqlState(pgError.Code)
+	case errors.As(err, &mysqlError):
+		return classifyMysql(mysqlError)
+	case errors.As(err, &sqliteError):
+		return classifySqlite(sqliteError)
+	case errors.Is(err, ErrSingleTransaction):
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.CantDoThisInTransaction
+	case errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err):
+		return sql_parser_errors.Code_DEADLINE_EXCEEDED, sql_parser_errors.Undefined
+	case isConnectionError(err):
+		return sql_parser_errors.Code_UNAVAILABLE, sql_parser_errors.ServerNotAvailable
+	}
+	return sql_parser_errors.Code_UNKNOWN, sql_parser_errors.Undefined
+}
+
+// isConnectionError returns true if the connection to the server is lost or can't be made
+func isConnectionError(err error) bool {
+	for _, target := range []error{driver.ErrBadConn, sql.ErrConnDone, mysql.ErrInvalidConn, io.EOF, io.ErrUnexpectedEOF,
+		syscall.ECONNRESET, syscall.ECONNREFUSED, syscall.ECONNABORTED, syscall.EPIPE} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// classifySqlState maps the SQLSTATE of PostgreSQL (and MySQL), the unknown state is mapped by its class
+func classifySqlState(sqlState string) (int32, sql_parser_errors.State) {
+	switch sqlState {
+	case "40001":
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.SerializationFailure
+	case "40P01":
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.Deadlock
+	case "55P03":
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.LockWaitTimeout
+	case "23505":
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.DupEntry
+	case "23503":
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.ForeignKeyViolation
+	case "42P07":
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.TableExists
+	case "42P04":
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.DbCreateExists
+	case "42P06", "42710", "42723":
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.Undefined
+	case "42P01":
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.NoSuchTable
+	case "3D000", "3F000":
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.BadDb
+	case "42883":
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.SPDoesNotExist
+	case "42703":
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.BadFieldError
+	case "42601":
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.SyntaxError
+	case "22003":
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.DataOutOfRange
+	case "42501":
+		return sql_parser_errors.Code_PERMISSION_DENIED, sql_parser_errors.AccessDeniedError
+	case "57014":
+		return sql_parser_errors.Code_CANCELED, sql_parser_errors.QueryInterrupted
+	case "57P01", "57P02", "57P03":
+		return sql_parser_errors.Code_UNAVAILABLE, sql_parser_errors.ServerNotAvailable
+	case "0A000":
+		return sql_parser_errors.Code_UNIMPLEMENTED, sql_parser_errors.NotSupportedYet
+	}
+	if len(sqlState) < 2 {
+		return sql_parser_errors.Code_UNKNOWN, sql_parser_errors.Undefined
+	}
+	switch sqlState[:2] {
+	case "08":
+		return sql_parser_errors.Code_UNAVAILABLE, sql_parser_errors.ServerNotAvailable
+	case "40":
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.Undefined
+	case "22", "42":
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.Undefined
+	case "23", "25", "55":
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.Undefined
+	case "28":
+		return sql_parser_errors.Code_UNAUTHENTICATED, sql_parser_errors.Undefined
+	case "53":
+		return sql_parser_errors.Code_RESOURCE_EXHAUSTED, sql_parser_errors.Undefined
+	case "XX":
+		return sql_parser_errors.Code_INTERNAL, sql_parser_errors.Undefined
+	}
+	return sql_parser_errors.Code_UNKNOWN, sql_parser_errors.Undefined
+}
+
+// classifyMysql maps the MySQL error number, the SQLSTATE of MySQL is too generic for the most errors
+func classifyMysql(mysqlError *mysql.MySQLError) (int32, sql_parser_errors.State) {
+	switch mysqlError.Number {
+	case 1213:
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.Deadlock
+	case 1205:
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.LockWaitTimeout
+	case 1062, 1586:
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.DupEntry
+	case 1050:
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.TableExists
+	case 1007:
+		return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.DbCreateExists
+	case 1216, 1217, 1451, 1452:
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.ForeignKeyViolation
+	case 1146:
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.NoSuchTable
+	case 1051:
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.UnknownTable
+	case 1049:
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.BadDb
+	case 1054:
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.BadFieldError
+	case 1064:
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.SyntaxError
+	case 1264:
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.DataOutOfRange
+	case 1044, 1045, 1142, 1143:
+		return sql_parser_errors.Code_PERMISSION_DENIED, sql_parser_errors.AccessDeniedError
+	case 1153:
+		return sql_parser_errors.Code_RESOURCE_EXHAUSTED, sql_parser_errors.NetPacketTooLarge
+	case 1317:
+		return sql_parser_errors.Code_CANCELED, sql_parser_errors.QueryInterrupted
+	case 1040, 2006, 2013:
+		return sql_parser_errors.Code_UNAVAILABLE, sql_parser_errors.ServerNotAvailable
+	}
+	return classifySqlState(string(mysqlError.SQLState[:]))
+}
+
+// classifySqlite maps the SQLite result code, the generic SQLITE_ERROR is mapped by the message
+func classifySqlite(sqliteError sqlite3.Error) (int32, sql_parser_errors.State) {
+	switch sqliteError.Code {
+	case sqlite3.ErrBusy, sqlite3.ErrLocked:
+		return sql_parser_errors.Code_ABORTED, sql_parser_errors.LockWaitTimeout
+	case sqlite3.ErrConstraint:
+		switch sqliteError.ExtendedCode {
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey, sqlite3.ErrConstraintRowID:
+			return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.DupEntry
+		case sqlite3.ErrConstraintForeignKey:
+			return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.ForeignKeyViolation
+		}
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.Undefined
+	case sqlite3.ErrPerm, sqlite3.ErrAuth:
+		return sql_parser_errors.Code_PERMISSION_DENIED, sql_parser_errors.AccessDeniedError
+	case sqlite3.ErrReadonly:
+		return sql_parser_errors.Code_FAILED_PRECONDITION, sql_parser_errors.Undefined
+	case sqlite3.ErrFull, sqlite3.ErrNomem, sqlite3.ErrTooBig:
+		return sql_parser_errors.Code_RESOURCE_EXHAUSTED, sql_parser_errors.Undefined
+	case sqlite3.ErrCorrupt, sqlite3.ErrNotADB:
+		return sql_parser_errors.Code_DATA_LOSS, sql_parser_errors.Undefined
+	case sqlite3.ErrCantOpen:
+		return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.BadDb
+	case sqlite3.ErrInterrupt:
+		return sql_parser_errors.Code_CANCELED, sql_parser_errors.QueryInterrupted
+	case sqlite3.ErrMismatch, sqlite3.ErrRange:
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.Undefined
+	case sqlite3.ErrError:
+		message := sqliteError.Error()
+		switch {
+		case strings.Contains(message, "no such table"):
+			return sql_parser_errors.Code_NOT_FOUND, sql_parser_errors.NoSuchTable
+		case strings.Contains(message, "no such column") || strings.Contains(message, "has no column named"):
+			return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.BadFieldError
+		case strings.Contains(message, "syntax error"):
+			return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.SyntaxError
+		case strings.Contains(message, "already exists"):
+			return sql_parser_errors.Code_ALREADY_EXISTS, sql_parser_errors.TableExists
+		}
+		return sql_parser_errors.Code_INVALID_ARGUMENT, sql_parser_errors.Undefined
+	}
+	return sql_parser_errors.Code_UNKNOWN, sql_parser_errors.Undefined
+}
+
+// transactionRolledBack returns true if the server rolled back the whole transaction of the failed statement
+// (the InnoDB deadlock), the statements of the transaction executed before are lost
+func transactionRolledBack(err error) bool {
+	var mysqlError *mysql.MySQLError
+	return errors.As(err, &mysqlError) && mysqlError.Number == 1213
+}
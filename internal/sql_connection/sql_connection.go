@@ -100,12 +100,23 @@ type DbStructure struct {
 
 type SqlConnection interface {
 	Establish(connectionOptions string) error
+	// SetTransactionMode defines when the executed statements are committed, the transactions
+	// of the single, per-entry and per-table modes are committed by the Commit call only
+	SetTransactionMode(mode TransactionMode)
 	Execute(rawSql string) error
+	// Commit commits the statements executed in the transaction opened by the connection
+	Commit() error
+	// Rollback rolls back the statements executed in the transaction opened by the connection
+	Rollback() error
+	// InDumpTransaction returns true while the transaction opened by the dump (BEGIN ... COMMIT) isn't finished,
+	// the statements of the dump transaction aren't committed before its COMMIT
+	InDumpTransaction() bool
+	Close() error
 	GetStructure(schemaPattern string, includeSystemTables bool) (*DbStructure, error)
 }
 
 type MysqlConnection struct {
-	db *sql.DB
+	sqlSession
 }
 
 // Establish implements SqlConnection.
@@ -118,15 +129,9 @@ func (mysqlConnection *MysqlConnection) Establish(connectionOptions string) erro
 	return nil
 }
 
-// Execute implements SqlConnection.
-func (mysqlConnection *MysqlConnection) Execute(rawSql string) error {
-	_, err := mysqlConnection.db.Exec(rawSql)
-	return err
-}
-
 // GetStructure implements SqlConnection.
 func (mysqlConnection *MysqlConnection) GetStructure(schemaPattern string, includeSystemTables bool) (*DbStructure, error) {
-	rows, err := mysqlConnection.db.Query(`SELECT *
+	rows, err := mysqlConnection.query(`SELECT *
 FROM INFORMATION_SCHEMA.Tables`)
 	if err != nil {
 		return nil, err
@@ -165,7 +170,7 @@ FROM INFORMATION_SCHEMA.Tables`)
 }
 
 type Sqlite3Connection struct {
-	db *sql.DB
+	sqlSession
 }
 
 // Establish implements SqlConnection.
@@ -179,15 +184,9 @@ func (sqlite3Connection *Sqlite3Connection) Establish(connectionOptions string)
 	return nil
 }
 
-// Execute implements SqlConnection.
-func (sqlite3Connection *Sqlite3Connection) Execute(rawSql string) error {
-	_, err := sqlite3Connection.db.Exec(rawSql)
-	return err
-}
-
 // GetStructure implements SqlConnection.
 func (sqlite3Connection *Sqlite3Connection) GetStructure(schemaPattern string, includeSystemTables bool) (*DbStructure, error) {
-	rows, err := sqlite3Connection.db.Query(`SELECT *
+	rows, err := sqlite3Connection.query(`SELECT *
 FROM INFORMATION_SCHEMA.Tables`)
 	if err != nil {
 		return nil, err
@@ -225,8 +224,30 @@ FROM INFORMATION_SCHEMA.Tables`)
 	return result, nil
 }
 
+// Timeout of the statement execution and the connection to the PostgreSQL server
+const PG_TIMEOUT = 120 * time.Second
+
+// Name of the savepoint made before every statement of the transaction opened by the connection
+const PG_STATEMENT_SAVEPOINT = "prodl_statement"
+
+// PgConnection keeps the single session to the PostgreSQL server for the whole load.
+// The session state changed by the SET statements is replayed when the session is reconnected,
+// the statements are executed in the transactions of the TransactionMode. The failed statement
+// is rolled back to the savepoint, the other statements of the transaction are kept
+// (the single transaction is rolled back completely).
 type PgConnection struct {
-	pgxOptions string
+	pgxOptions      string
+	pgConn          *pgconn.PgConn
+	mode            TransactionMode
+	transaction     bool             // The transaction is opened by the connection (not by the dump)
+	savepoint       bool             // The statement savepoint is defined in the transaction
+	pendingCount    int              // Count of the statements executed in the opened transaction
+	sessionSettings []sessionSetting // Statements changed the session state in the order of execution
+}
+
+type sessionSetting struct {
+	name      string
+	statement string
 }
 
 // Establish implements SqlConnection.
@@ -235,45 +256,233 @@ func (pgConnection *PgConnection) Establish(connectionOptions string) error {
 	return nil
 }
 
+// SetTransactionMode implements SqlConnection.
+func (pgConnection *PgConnection) SetTransactionMode(mode TransactionMode) {
+	pgConnection.mode = mode
+}
+
+// session returns the opened session, the new session is connected if the previous one is closed
+func (pgConnection *PgConnection) session(ctx context.Context) (*pgconn.PgConn, error) {
+	if pgConnection.pgConn != nil && !pgConnection.pgConn.IsClosed() {
+		return pgConnection.pgConn, nil
+	}
+	pgConnection.pgConn = nil
+	pgConnection.transaction, pgConnection.savepoint, pgConnection.pendingCount = false, false, 0
+	pgConn, err := pgconn.Connect(ctx, pgConnection.pgxOptions)
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range pgConnection.sessionSettings {
+		if _, err := pgConn.Exec(ctx, setting.statement).ReadAll(); err != nil {
+			pgConn.Close(ctx)
+			return nil, fmt.Errorf("restore session state by %v fail: %w", setting.statement, err)
+		}
+	}
+	pgConnection.pgConn = pgConn
+	return pgConn, nil
+}
+
+// keepSetting remembers the statement changed the session state,
+// the previous setting of the same parameter is replaced, RESET ALL drops all settings
+func (pgConnection *PgConnection) keepSetting(name string, rawSql string) {
+	reset := leadingWords(skipComments(rawSql), 1)[0] == "RESET"
+	settings := pgConnection.sessionSettings[:0]
+	if name != "" {
+		for _, setting := range pgConnection.sessionSettings {
+			if setting.name != name {
+				settings = append(settings, setting)
+			}
+		}
+	}
+	if !reset {
+		settings = append(settings, sessionSetting{name: name, statement: rawSql})
+	}
+	pgConnection.sessionSettings = settings
+}
+
 // Execute implements SqlConnection.
 func (pgConnection *PgConnection) Execute(rawSql string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
 	defer cancel()
 
-	pgConn, err := pgconn.Connect(ctx, pgConnection.pgxOptions)
+	pgConn, err := pgConnection.session(ctx)
 	if err != nil {
 		return err
 	}
-	defer pgConn.Close(ctx)
+
+	kind, name := ClassifyStatement(rawSql)
+	switch {
+	case kind == STATEMENT_TRANSACTION && pgConnection.mode.Kind == TRANSACTION_SINGLE:
+		// The transaction of the dump is a part of the single transaction
+		return nil
+	case kind == STATEMENT_NON_TRANSACTIONAL && pgConnection.mode.Kind == TRANSACTION_SINGLE:
+		return ErrSingleTransaction
+	case kind == STATEMENT_TRANSACTION || kind == STATEMENT_NON_TRANSACTIONAL:
+		// The transaction is controlled by the dump or the statement can't be executed in the transaction block
+		if err := pgConnection.commit(ctx); err != nil {
+			return err
+		}
+	case kind == STATEMENT_SESSION && pgConnection.mode.Kind != TRANSACTION_SINGLE:
+		// The session setting isn't rolled back with the failed batch, the statement is executed outside the batch transaction
+		if err := pgConnection.commit(ctx); err != nil {
+			return err
+		}
+	case pgConnection.mode.Kind != TRANSACTION_NONE && pgConn.TxStatus() == 'I':
+		if _, err := pgConn.Exec(ctx, "BEGIN").ReadAll(); err != nil {
+			return err
+		}
+		pgConnection.transaction, pgConnection.savepoint, pgConnection.pendingCount = true, false, 0
+	}
+
+	err = pgConnection.execute(ctx, pgConn, rawSql)
+	if err != nil {
+		return pgConnection.rollbackStatement(ctx, pgConn, err)
+	}
+	if kind == STATEMENT_SESSION {
+		pgConnection.keepSetting(name, rawSql)
+	}
+	if pgConnection.transaction {
+		pgConnection.pendingCount++
+		if pgConnection.mode.Kind == TRANSACTION_BATCH && pgConnection.pendingCount >= pgConnection.mode.BatchSize {
+			return pgConnection.commit(ctx)
+		}
+	}
+	return nil
+}
+
+// execute sends the statement to the session, the statement savepoint is made in the transaction
+// opened by the connection (the single transaction doesn't survive the error, it doesn't need savepoints)
+func (pgConnection *PgConnection) execute(ctx context.Context, pgConn *pgconn.PgConn, rawSql string) error {
+	prefix := ""
+	if pgConnection.transaction && pgConnection.mode.Kind != TRANSACTION_SINGLE {
+		if pgConnection.savepoint {
+			prefix = "RELEASE SAVEPOINT " + PG_STATEMENT_SAVEPOINT + ";\n"
+		}
+		prefix += "SAVEPOINT " + PG_STATEMENT_SAVEPOINT + ";\n"
+	}
 
 	// Recognize COPY FROM STDIN command
 	if strings.Contains(rawSql, "COPY") && strings.HasSuffix(rawSql, "\\.") {
+		if prefix != "" {
+			if _, err := pgConn.Exec(ctx, prefix).ReadAll(); err != nil {
+				return err
+			}
+			pgConnection.savepoint = true
+		}
 		sqlCommandAndData := strings.SplitN(rawSql, "stdin;\n", 2)
 		_, err := pgConn.CopyFrom(ctx, strings.NewReader(sqlCommandAndData[1][:len(sqlCommandAndData[1])-2]), sqlCommandAndData[0]+" stdin;")
-		if err != nil {
+		return err
+	}
+	_, err := pgConn.Exec(ctx, prefix+rawSql).ReadAll()
+	if prefix != "" && pgConn.TxStatus() != 'I' {
+		pgConnection.savepoint = true
+	}
+	return err
+}
+
+// rollbackStatement rolls back the failed statement to the savepoint, the other statements of the transaction are kept
+func (pgConnection *PgConnection) rollbackStatement(ctx context.Context, pgConn *pgconn.PgConn, err error) error {
+	if pgConn.IsClosed() {
+		if pgConnection.transaction {
+			return lostStatements(err, "the session is closed", pgConnection.pendingCount)
+		}
+		return err
+	}
+	if !pgConnection.transaction || pgConn.TxStatus() != 'E' {
+		return err
+	}
+	if pgConnection.savepoint {
+		if _, rollbackErr := pgConn.Exec(ctx, "ROLLBACK TO SAVEPOINT "+PG_STATEMENT_SAVEPOINT).ReadAll(); rollbackErr == nil {
 			return err
 		}
+	}
+	// The transaction can't be restored
+	lost := pgConnection.pendingCount
+	pgConnection.transaction, pgConnection.savepoint, pgConnection.pendingCount = false, false, 0
+	if _, rollbackErr := pgConn.Exec(ctx, "ROLLBACK").ReadAll(); rollbackErr != nil {
+		pgConn.Close(ctx)
+	}
+	return lostStatements(err, "the transaction is rolled back", lost)
+}
+
+// commit commits the transaction opened by the connection
+func (pgConnection *PgConnection) commit(ctx context.Context) error {
+	if !pgConnection.transaction || pgConnection.pgConn == nil {
 		return nil
 	}
-	result := pgConn.ExecParams(ctx, rawSql, nil, nil, nil, nil).Read()
-	if result.Err != nil {
-		return result.Err
+	count := pgConnection.pendingCount
+	pgConnection.transaction, pgConnection.savepoint, pgConnection.pendingCount = false, false, 0
+	if _, err := pgConnection.pgConn.Exec(ctx, "COMMIT").ReadAll(); err != nil {
+		return fmt.Errorf("commit of %v statements fail: %w", count, err)
+	}
+	return nil
+}
+
+// InDumpTransaction implements SqlConnection.
+func (pgConnection *PgConnection) InDumpTransaction() bool {
+	return pgConnection.pgConn != nil && !pgConnection.transaction && pgConnection.pgConn.TxStatus() != 'I'
+}
+
+// Commit implements SqlConnection.
+func (pgConnection *PgConnection) Commit() error {
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
+	defer cancel()
+
+	return pgConnection.commit(ctx)
+}
+
+// Rollback implements SqlConnection.
+func (pgConnection *PgConnection) Rollback() error {
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
+	defer cancel()
+
+	return pgConnection.rollback(ctx)
+}
+
+// rollback rolls back the transaction opened by the connection
+func (pgConnection *PgConnection) rollback(ctx context.Context) error {
+	if !pgConnection.transaction || pgConnection.pgConn == nil {
+		return nil
+	}
+	count := pgConnection.pendingCount
+	pgConnection.transaction, pgConnection.savepoint, pgConnection.pendingCount = false, false, 0
+	if _, err := pgConnection.pgConn.Exec(ctx, "ROLLBACK").ReadAll(); err != nil {
+		return fmt.Errorf("rollback of %v statements fail: %w", count, err)
 	}
 	return nil
 }
 
+// Close implements SqlConnection, the single transaction is rolled back if it isn't committed explicitly.
+func (pgConnection *PgConnection) Close() error {
+	if pgConnection.pgConn == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
+	defer cancel()
+
+	var err error
+	if pgConnection.mode.Kind == TRANSACTION_SINGLE {
+		err = pgConnection.rollback(ctx)
+	} else {
+		err = pgConnection.commit(ctx)
+	}
+	if closeErr := pgConnection.pgConn.Close(ctx); err == nil {
+		err = closeErr
+	}
+	pgConnection.pgConn = nil
+	return err
+}
+
 // Query implements SqlConnection.
 func (pgConnection *PgConnection) Query(rawSql string) (*sql.Rows, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
 	defer cancel()
 
-	pgConn, err := pgconn.Connect(ctx, pgConnection.pgxOptions)
+	pgConn, err := pgConnection.session(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer pgConn.Close(ctx)
 
-	// Recognize COPY FROM STDIN command
 	result := pgConn.ExecParams(ctx, rawSql, nil, nil, nil, nil).Read()
 	if result.Err != nil {
 		return nil, result.Err
@@ -284,14 +493,13 @@ func (pgConnection *PgConnection) Query(rawSql string) (*sql.Rows, error) {
 // GetStructure implements SqlConnection.
 func (pgConnection *PgConnection) GetStructure(schemaPattern string, includeSystemTables bool) (*DbStructure, error) {
 
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), PG_TIMEOUT)
 	defer cancel()
 
-	pgConn, err := pgconn.Connect(ctx, pgConnection.pgxOptions)
+	pgConn, err := pgConnection.session(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer pgConn.Close(ctx)
 
 	// An tables slice to hold data from returned rows.
 	result := &DbStructure{
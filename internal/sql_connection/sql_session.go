@@ -0,0 +1,184 @@
+package sql_connection
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// sqlSession executes the statements of the database/sql connections (MySQL, SQLite) on the single
+// connection of the pool, so the session state set by the dump is kept for the whole load,
+// the statements are executed in the transactions of the TransactionMode.
+type sqlSession struct {
+	db              *sql.DB
+	conn            *sql.Conn
+	tx              *sql.Tx
+	mode            TransactionMode
+	dumpTransaction bool // The transaction is opened by the dump (BEGIN ... COMMIT)
+	pendingCount    int  // Count of the statements executed in the opened transaction
+}
+
+// SetTransactionMode implements SqlConnection.
+func (session *sqlSession) SetTransactionMode(mode TransactionMode) {
+	session.mode = mode
+}
+
+// connection returns the connection of the session, the connection is taken from the pool on the first call
+func (session *sqlSession) connection(ctx context.Context) (*sql.Conn, error) {
+	if session.conn == nil {
+		conn, err := session.db.Conn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		session.conn = conn
+	}
+	return session.conn, nil
+}
+
+// Execute implements SqlConnection.
+func (session *sqlSession) Execute(rawSql string) error {
+	ctx := context.Background()
+	conn, err := session.connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	kind, _ := ClassifyStatement(rawSql)
+	switch {
+	case kind == STATEMENT_TRANSACTION && session.mode.Kind == TRANSACTION_SINGLE:
+		// The transaction of the dump is a part of the single transaction
+		return nil
+	case kind == STATEMENT_NON_TRANSACTIONAL && session.mode.Kind == TRANSACTION_SINGLE:
+		return ErrSingleTransaction
+	case kind == STATEMENT_TRANSACTION || kind == STATEMENT_NON_TRANSACTIONAL:
+		// The transaction is controlled by the dump or the statement can't be executed in the transaction block
+		if err := session.Commit(); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, rawSql); err != nil {
+			return session.checkConnection(err)
+		}
+		if kind == STATEMENT_TRANSACTION {
+			session.dumpTransaction = beginsTransaction(rawSql)
+		}
+		return nil
+	case kind == STATEMENT_SESSION && session.mode.Kind != TRANSACTION_SINGLE:
+		// The session setting (like PRAGMA foreign_keys, which is ignored inside the transaction) is executed
+		// outside the batch transaction
+		if err := session.Commit(); err != nil {
+			return err
+		}
+	case session.tx == nil && !session.dumpTransaction && session.mode.Kind != TRANSACTION_NONE:
+		session.tx, err = conn.BeginTx(ctx, nil)
+		if err != nil {
+			return session.checkConnection(err)
+		}
+		session.pendingCount = 0
+	}
+
+	if session.tx != nil {
+		_, err = session.tx.ExecContext(ctx, rawSql)
+	} else {
+		_, err = conn.ExecContext(ctx, rawSql)
+	}
+	if err != nil {
+		if session.tx != nil && transactionRolledBack(err) {
+			lost := session.pendingCount
+			session.tx.Rollback()
+			session.tx, session.pendingCount = nil, 0
+			return lostStatements(err, "the transaction is rolled back", lost)
+		}
+		return session.checkConnection(err)
+	}
+	if session.tx != nil {
+		session.pendingCount++
+		if session.mode.Kind == TRANSACTION_BATCH && session.pendingCount >= session.mode.BatchSize {
+			return session.Commit()
+		}
+	}
+	return nil
+}
+
+// checkConnection drops the broken connection of the session, the next statement takes the new one
+func (session *sqlSession) checkConnection(err error) error {
+	if session.conn == nil || (!errors.Is(err, driver.ErrBadConn) && !errors.Is(err, sql.ErrConnDone)) {
+		return err
+	}
+	session.conn.Close()
+	session.conn, session.dumpTransaction = nil, false
+	if session.tx != nil {
+		lost := session.pendingCount
+		session.tx, session.pendingCount = nil, 0
+		return lostStatements(err, "the session is closed", lost)
+	}
+	return err
+}
+
+// query executes the query in the session, the rows must be closed before the next statement
+func (session *sqlSession) query(rawSql string) (*sql.Rows, error) {
+	ctx := context.Background()
+	if session.tx != nil {
+		return session.tx.QueryContext(ctx, rawSql)
+	}
+	conn, err := session.connection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return conn.QueryContext(ctx, rawSql)
+}
+
+// InDumpTransaction implements SqlConnection.
+func (session *sqlSession) InDumpTransaction() bool {
+	return session.dumpTransaction
+}
+
+// Commit implements SqlConnection.
+func (session *sqlSession) Commit() error {
+	if session.tx == nil {
+		return nil
+	}
+	tx, count := session.tx, session.pendingCount
+	session.tx, session.pendingCount = nil, 0
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit of %v statements fail: %w", count, err)
+	}
+	return nil
+}
+
+// Rollback implements SqlConnection.
+func (session *sqlSession) Rollback() error {
+	if session.tx == nil {
+		return nil
+	}
+	tx, count := session.tx, session.pendingCount
+	session.tx, session.pendingCount = nil, 0
+	if err := tx.Rollback(); err != nil {
+		return fmt.Errorf("rollback of %v statements fail: %w", count, err)
+	}
+	return nil
+}
+
+// Close implements SqlConnection, the single transaction is rolled back if it isn't committed explicitly.
+func (session *sqlSession) Close() error {
+	var err error
+	if session.mode.Kind == TRANSACTION_SINGLE {
+		err = session.Rollback()
+	} else {
+		err = session.Commit()
+	}
+	if session.conn != nil {
+		if closeErr := session.conn.Close(); err == nil {
+			err = closeErr
+		}
+		session.conn = nil
+	}
+	if session.db != nil {
+		if closeErr := session.db.Close(); err == nil {
+			err = closeErr
+		}
+		session.db = nil
+	}
+	return err
+}
@@ -0,0 +1,198 @@
+package sql_connection
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// StatementKind defines how the statement is executed regarding the session and the transaction
+type StatementKind uint8
+
+const (
+	STATEMENT_REGULAR           StatementKind = 0 // Statement is executed in the batch transaction
+	STATEMENT_SESSION           StatementKind = 1 // Statement changes the session state (SET, RESET, set_config, USE, PRAGMA), it is replayed on the new session
+	STATEMENT_TRANSACTION       StatementKind = 2 // Transaction control of the dump (BEGIN, COMMIT, ROLLBACK ...)
+	STATEMENT_NON_TRANSACTIONAL StatementKind = 3 // Statement can't be executed inside the transaction block (VACUUM, CREATE DATABASE ...)
+)
+
+func (kind StatementKind) String() string {
+	switch kind {
+	case STATEMENT_REGULAR:
+		return "regular"
+	case STATEMENT_SESSION:
+		return "session"
+	case STATEMENT_TRANSACTION:
+		return "transaction"
+	case STATEMENT_NON_TRANSACTIONAL:
+		return "non-transactional"
+	}
+	return "undefined"
+}
+
+// Prefixes (upper case words) of the statements which can't be executed inside the transaction block
+var nonTransactionalPrefixes = [][]string{
+	{"CREATE", "DATABASE"},
+	{"DROP", "DATABASE"},
+	{"CREATE", "TABLESPACE"},
+	{"DROP", "TABLESPACE"},
+	{"CREATE", "SUBSCRIPTION"},
+	{"DROP", "SUBSCRIPTION"},
+	{"ALTER", "SYSTEM"},
+	{"REINDEX", "DATABASE"},
+	{"REINDEX", "SYSTEM"},
+	{"VACUUM"},
+}
+
+// SELECT pg_catalog.set_config(name, value, false) of the pg_dump sets the session parameter
+var setConfigPattern = regexp.MustCompile(`(?is)^SELECT\s+(?:pg_catalog\s*\.\s*)?set_config\s*\(\s*'((?:[^']|'')*)'\s*,.*,\s*(false|'f'|'false')\s*\)\s*;?\s*$`)
+
+// ClassifyStatement returns the kind of the statement and the name of the session parameter
+// changed by the STATEMENT_SESSION statement (empty string for RESET ALL)
+func ClassifyStatement(rawSql string) (StatementKind, string) {
+	text := skipComments(rawSql)
+	words := leadingWords(text, 4)
+	if len(words) == 0 {
+		return STATEMENT_REGULAR, ""
+	}
+	switch words[0] {
+	case "BEGIN", "COMMIT", "END", "ABORT":
+		return STATEMENT_TRANSACTION, ""
+	case "ROLLBACK":
+		// ROLLBACK TO SAVEPOINT keeps the transaction
+		if len(words) == 1 || words[1] != "TO" {
+			return STATEMENT_TRANSACTION, ""
+		}
+	case "START":
+		if len(words) > 1 && words[1] == "TRANSACTION" {
+			return STATEMENT_TRANSACTION, ""
+		}
+	case "SET":
+		return classifySet(words[1:])
+	case "USE":
+		if len(words) > 1 {
+			return STATEMENT_SESSION, "database"
+		}
+	case "PRAGMA":
+		// PRAGMA foreign_keys=OFF of the SQLite .dump, the name is taken up to the value
+		if len(words) > 1 {
+			name, _, _ := strings.Cut(words[1], "=")
+			return STATEMENT_SESSION, strings.ToLower(name)
+		}
+	case "RESET":
+		if len(words) > 1 && words[1] != "ALL" {
+			return STATEMENT_SESSION, strings.ToLower(words[1])
+		}
+		return STATEMENT_SESSION, ""
+	case "SELECT":
+		if match := setConfigPattern.FindStringSubmatch(text); match != nil {
+			return STATEMENT_SESSION, strings.ToLower(strings.ReplaceAll(match[1], "''", "'"))
+		}
+	case "CREATE", "DROP", "REINDEX":
+		// CREATE INDEX CONCURRENTLY, DROP INDEX CONCURRENTLY, REINDEX TABLE CONCURRENTLY
+		for _, word := range words[1:] {
+			if word == "CONCURRENTLY" {
+				return STATEMENT_NON_TRANSACTIONAL, ""
+			}
+		}
+	}
+	for _, prefix := range nonTransactionalPrefixes {
+		if hasPrefix(words, prefix) {
+			return STATEMENT_NON_TRANSACTIONAL, ""
+		}
+	}
+	return STATEMENT_REGULAR, ""
+}
+
+// classifySet classifies the words after SET
+func classifySet(words []string) (StatementKind, string) {
+	if len(words) > 0 && words[0] == "SESSION" {
+		words = words[1:]
+	}
+	if len(words) == 0 {
+		return STATEMENT_REGULAR, ""
+	}
+	switch words[0] {
+	case "LOCAL", "TRANSACTION", "CONSTRAINTS":
+		// The setting is valid till the end of the transaction only
+		return STATEMENT_REGULAR, ""
+	case "GLOBAL", "PERSIST", "PERSIST_ONLY":
+		// The setting of the server (like SET @@GLOBAL.GTID_PURGED of mysqldump) isn't the session state
+		return STATEMENT_REGULAR, ""
+	case "CHARACTERISTICS", "AUTHORIZATION", "ROLE":
+		return STATEMENT_SESSION, strings.ToLower(words[0])
+	}
+	name, _, _ := strings.Cut(words[0], "=")
+	if strings.HasPrefix(name, "@@GLOBAL.") || strings.HasPrefix(name, "@@PERSIST") {
+		return STATEMENT_REGULAR, ""
+	}
+	return STATEMENT_SESSION, strings.ToLower(name)
+}
+
+func hasPrefix(words []string, prefix []string) bool {
+	if len(words) < len(prefix) {
+		return false
+	}
+	for i, word := range prefix {
+		if words[i] != word {
+			return false
+		}
+	}
+	return true
+}
+
+// skipComments skips the leading spaces and comments of the statement, the body of the MySQL
+// conditional comment (/*!40101 SET NAMES utf8 */) is the text of the statement
+func skipComments(rawSql string) string {
+	text := strings.TrimLeftFunc(rawSql, unicode.IsSpace)
+	for {
+		switch {
+		case strings.HasPrefix(text, "/*!") || strings.HasPrefix(text, "/*M!"):
+			_, body, _ := strings.Cut(text, "!")
+			body = strings.TrimLeft(body, "0123456789")
+			text = strings.Replace(body, "*/", " ", 1)
+		case strings.HasPrefix(text, "--"):
+			end := strings.IndexByte(text, '\n')
+			if end < 0 {
+				return ""
+			}
+			text = text[end+1:]
+		case strings.HasPrefix(text, "/*"):
+			end := strings.Index(text, "*/")
+			if end < 0 {
+				return ""
+			}
+			text = text[end+2:]
+		default:
+			return text
+		}
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+	}
+}
+
+// beginsTransaction returns true for the statements starting the transaction (BEGIN, START TRANSACTION)
+func beginsTransaction(rawSql string) bool {
+	words := leadingWords(skipComments(rawSql), 1)
+	return len(words) > 0 && (words[0] == "BEGIN" || words[0] == "START")
+}
+
+// leadingWords returns at most count first words of the text in upper case,
+// the words are split by the spaces and the statement delimiter
+func leadingWords(text string, count int) []string {
+	words := make([]string, 0, count)
+	for len(words) < count {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		end := strings.IndexFunc(text, func(r rune) bool {
+			return unicode.IsSpace(r) || r == ';' || r == '('
+		})
+		if end < 0 {
+			end = len(text)
+		}
+		if end == 0 {
+			break
+		}
+		words = append(words, strings.ToUpper(text[:end]))
+		text = text[end:]
+	}
+	return words
+}
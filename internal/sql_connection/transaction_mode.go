@@ -0,0 +1,71 @@
+package sql_connection
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const DEFAULT_TRANSACTION_BATCH_SIZE = 1000
+
+var (
+	ErrSingleTransaction = errors.New("statement can't be executed in the single transaction")
+)
+
+// TransactionKind defines the boundaries of the transactions of the load
+type TransactionKind uint8
+
+const (
+	TRANSACTION_NONE      TransactionKind = 0 // Every statement is committed
+	TRANSACTION_SINGLE    TransactionKind = 1 // The whole load is committed by one transaction, the first error rolls back everything
+	TRANSACTION_PER_ENTRY TransactionKind = 2 // The statements of every file (archive entry) are committed together
+	TRANSACTION_PER_TABLE TransactionKind = 3 // The statements of every table are committed together
+	TRANSACTION_BATCH     TransactionKind = 4 // Every N statements are committed together
+)
+
+// TransactionMode defines when the statements executed by the connection are committed
+type TransactionMode struct {
+	Kind      TransactionKind
+	BatchSize int // Count of the statements in the transaction of the TRANSACTION_BATCH mode
+}
+
+func (mode TransactionMode) String() string {
+	switch mode.Kind {
+	case TRANSACTION_NONE:
+		return "none"
+	case TRANSACTION_SINGLE:
+		return "single"
+	case TRANSACTION_PER_ENTRY:
+		return "per-entry"
+	case TRANSACTION_PER_TABLE:
+		return "per-table"
+	case TRANSACTION_BATCH:
+		return "batch:" + strconv.Itoa(mode.BatchSize)
+	}
+	return "undefined"
+}
+
+// ParseTransactionMode converts cli option value (none|single|per-entry|per-table|batch:N) to the TransactionMode
+func ParseTransactionMode(value string) (TransactionMode, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "none", "":
+		return TransactionMode{Kind: TRANSACTION_NONE}, nil
+	case "single":
+		return TransactionMode{Kind: TRANSACTION_SINGLE}, nil
+	case "per-entry":
+		return TransactionMode{Kind: TRANSACTION_PER_ENTRY}, nil
+	case "per-table":
+		return TransactionMode{Kind: TRANSACTION_PER_TABLE}, nil
+	case "batch":
+		return TransactionMode{Kind: TRANSACTION_BATCH, BatchSize: DEFAULT_TRANSACTION_BATCH_SIZE}, nil
+	}
+	if batchSize, ok := strings.CutPrefix(value, "batch:"); ok {
+		size, err := strconv.Atoi(batchSize)
+		if err == nil && size > 0 {
+			return TransactionMode{Kind: TRANSACTION_BATCH, BatchSize: size}, nil
+		}
+	}
+	return TransactionMode{}, fmt.Errorf("unknown transaction mode: %v, expected one of none|single|per-entry|per-table|batch:N", value)
+}
@@ -57,6 +57,7 @@ const (
 	StmtCallProc
 	StmtRevert
 	StmtShowMigrationLogs
+	StmtCopy
 )
 
 // ASTToStatementType returns a StatementType from an AST stmt
@@ -70,12 +71,17 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtUpdate
 	case *Delete:
 		return StmtDelete
-	case *Set, *SetTransaction:
+	case *Set, *SetTransaction, *Pragma:
 		return StmtSet
 	case *Show:
 		return StmtShow
-	case DDLStatement, DBDDLStatement, *AlterVschema:
+	case DDLStatement, DBDDLStatement, *AlterVschema, *CreateFunction, *AlterFunction, *DropFunction, *CreateTrigger,
+		*CreateExtension, *CreateType, *CreateDomain, *AlterObjectOwner, *CommentOn,
+		*CreatePolicy, *CreatePublication, *AlterPublication, *CreateRule, *CreateMaterializedView,
+		*RefreshMaterializedView, *AlterIndexPartition, *CreateVirtualTable:
 		return StmtDDL
+	case *Grant, *Revoke, *AlterDefaultPrivileges:
+		return StmtPriv
 	case *RevertMigration:
 		return StmtRevert
 	case *ShowMigrationLogs:
@@ -110,6 +116,8 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtStream
 	case *VStream:
 		return StmtVStream
+	case *CopyFrom, *CopyTo:
+		return StmtCopy
 	default:
 		return StmtUnknown
 	}
@@ -201,6 +209,8 @@ func Preview(sql string) StatementType {
 		return StmtLockTables
 	case "unlock":
 		return StmtUnlockTables
+	case "copy":
+		return StmtCopy
 	}
 	// For the following statements it is not sufficient to rely
 	// on loweredFirstWord. This is because they are not statements
@@ -209,9 +219,9 @@ func Preview(sql string) StatementType {
 	// as StmtBegin.
 	trimmedNoComments, _ := SplitMarginComments(trimmed)
 	switch strings.ToLower(trimmedNoComments) {
-	case "begin", "start transaction":
+	case "begin", "start transaction", "begin transaction":
 		return StmtBegin
-	case "commit":
+	case "commit", "commit transaction", "end", "end transaction":
 		return StmtCommit
 	case "rollback":
 		return StmtRollback
@@ -221,7 +231,7 @@ func Preview(sql string) StatementType {
 		return StmtDDL
 	case "flush":
 		return StmtFlush
-	case "set":
+	case "set", "pragma":
 		return StmtSet
 	case "show":
 		return StmtShow
@@ -293,6 +303,8 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtCopy:
+		return "COPY"
 	default:
 		return "UNKNOWN"
 	}
@@ -307,6 +319,16 @@ func IsDML(sql string) bool {
 	return false
 }
 
+// IsDataLoad returns true if the query only carries table data:
+// an INSERT, REPLACE, UPDATE, DELETE or COPY statement.
+func IsDataLoad(sql string) bool {
+	switch Preview(sql) {
+	case StmtInsert, StmtReplace, StmtUpdate, StmtDelete, StmtCopy:
+		return true
+	}
+	return false
+}
+
 // IsDMLStatement returns true if the query is an INSERT, UPDATE or DELETE statement.
 func IsDMLStatement(stmt Statement) bool {
 	switch stmt.(type) {
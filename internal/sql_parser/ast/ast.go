@@ -139,6 +139,9 @@ type (
 		DropDefault bool
 		DefaultVal  Expr
 		Invisible   *bool
+		// Identity is ALWAYS or BY DEFAULT of the PostgreSQL ADD GENERATED ... AS IDENTITY
+		Identity         string
+		IdentitySequence *SequenceSpec
 	}
 
 	// AlterColumn is used to add or drop defaults & visibility to columns in alter table command
@@ -146,6 +149,11 @@ type (
 		Owner *RoleName
 	}
 
+	// RowLevelSecurity is used to enable, disable, force or no force the row level security of the table
+	RowLevelSecurity struct {
+		Action string
+	}
+
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
 		Ctes      []*CommonTableExpr
@@ -468,11 +476,13 @@ type (
 	// CopyOptionType is an enum for copy with options
 	CopyOptionType int8
 
-	// CopyOption is a struct that stores option value
+	// CopyOption is a struct that stores option value, the columns of FORCE_QUOTE,
+	// FORCE_NOT_NULL and FORCE_NULL are nil for all columns (*)
 	CopyOption struct {
 		Type      CopyOptionType
 		IsDefault bool
 		Value     string
+		Columns   Columns
 	}
 
 	// Flush represents a FLUSH statement.
@@ -598,6 +608,7 @@ type (
 		Sequence     SequenceName
 		Comments     *ParsedComments
 		SequenceSpec *SequenceSpec
+		AlterOptions []AlterOption
 		FullyParsed  bool
 	}
 
@@ -625,6 +636,306 @@ type (
 		Comments    *ParsedComments
 	}
 
+	// CreateVirtualTable represents a SQLite CREATE VIRTUAL TABLE ... USING module(arguments) statement,
+	// the module arguments are kept as is because every module has its own syntax for them
+	CreateVirtualTable struct {
+		IfNotExists bool
+		Table       TableName
+		Module      ColIdent
+		Arguments   string
+		Comments    *ParsedComments
+	}
+
+	// Pragma represents a SQLite PRAGMA [schema.]name [= value] statement
+	Pragma struct {
+		Name  TableName
+		Value string
+	}
+
+	// CreateMaterializedView represents a PostgreSQL CREATE MATERIALIZED VIEW statement,
+	// WithData is false for WITH NO DATA (the view is filled later by REFRESH)
+	CreateMaterializedView struct {
+		IfNotExists bool
+		ViewName    TableName
+		Columns     Columns
+		Method      ColIdent
+		Parameters  StorageParameters
+		Tablespace  TableIdent
+		Select      SelectStatement
+		WithData    bool
+		Comments    *ParsedComments
+	}
+
+	// AlterIndexPartition represents a PostgreSQL ALTER INDEX ... ATTACH PARTITION statement
+	AlterIndexPartition struct {
+		Name            TableName
+		AttachPartition TableName
+		Comments        *ParsedComments
+	}
+
+	// RefreshMaterializedView represents a PostgreSQL REFRESH MATERIALIZED VIEW statement
+	RefreshMaterializedView struct {
+		Concurrently bool
+		ViewName     TableName
+		WithData     bool
+		Comments     *ParsedComments
+	}
+
+	// CreateIndex represents a PostgreSQL CREATE INDEX statement
+	CreateIndex struct {
+		Unique       bool
+		Concurrently bool
+		IfNotExists  bool
+		Name         ColIdent
+		Only         bool
+		Table        TableName
+		Method       ColIdent
+		Columns      []*IndexElement
+		Include      Columns
+		Parameters   StorageParameters
+		Tablespace   TableIdent
+		Where        *Where
+		Comments     *ParsedComments
+		FullyParsed  bool
+	}
+
+	// CreateFunction represents a PostgreSQL CREATE FUNCTION or CREATE PROCEDURE statement
+	CreateFunction struct {
+		Procedure bool
+		IsReplace bool
+		Name      TableName
+		Arguments []*FunctionArgument
+		Returns   *FunctionReturns
+		Options   []*FunctionOption
+		Comments  *ParsedComments
+	}
+
+	// FunctionArgumentMode is an enum for the function argument modes
+	FunctionArgumentMode int8
+
+	// FunctionArgument represents a function argument [mode] [name] type [DEFAULT expr]
+	FunctionArgument struct {
+		Mode    FunctionArgumentMode
+		Name    ColIdent
+		Type    *ColumnType
+		Default Expr
+	}
+
+	// FunctionReturns represents RETURNS type, RETURNS SETOF type and RETURNS TABLE (...) clauses
+	FunctionReturns struct {
+		SetOf bool
+		Type  *ColumnType
+		Table []*FunctionArgument
+	}
+
+	// FunctionOptionType is an enum for the function options
+	FunctionOptionType int8
+
+	// FunctionOption is a struct that stores function option value,
+	// the body of the function (AS option) is kept as is with the quotes
+	FunctionOption struct {
+		Type  FunctionOptionType
+		Name  string
+		Value string
+	}
+
+	// AlterFunction represents a ALTER FUNCTION or ALTER PROCEDURE statement
+	AlterFunction struct {
+		Procedure    bool
+		Name         TableName
+		Arguments    []*FunctionArgument
+		AlterOptions []AlterOption
+		Comments     *ParsedComments
+	}
+
+	// DropFunction represents a MySQL DROP FUNCTION or DROP PROCEDURE statement
+	DropFunction struct {
+		Procedure bool
+		IfExists  bool
+		Name      TableName
+		Comments  *ParsedComments
+	}
+
+	// CreateTrigger represents a PostgreSQL or SQLite CREATE TRIGGER statement,
+	// the SQLite trigger keeps the statements of BEGIN ... END in the Body instead of the Function
+	CreateTrigger struct {
+		IsReplace   bool
+		Temp        bool
+		IfNotExists bool
+		Name        ColIdent
+		Timing      string
+		Events      []*TriggerEvent
+		Table       TableName
+		ForEachRow  bool
+		When        Expr
+		Procedure   bool
+		Function    TableName
+		Arguments   Exprs
+		Body        []Statement
+		Comments    *ParsedComments
+	}
+
+	// TriggerEvent represents INSERT, UPDATE [OF columns], DELETE or TRUNCATE trigger event
+	TriggerEvent struct {
+		Type    string
+		Columns Columns
+	}
+
+	// CreateExtension represents a PostgreSQL CREATE EXTENSION statement
+	CreateExtension struct {
+		IfNotExists bool
+		Name        ColIdent
+		Schema      TableIdent
+		Version     string
+		Cascade     bool
+		Comments    *ParsedComments
+	}
+
+	// CreateType represents a PostgreSQL CREATE TYPE statement for the enum,
+	// composite and range types, the Kind defines which of the fields are filled
+	CreateType struct {
+		Name       TableName
+		Kind       string
+		Labels     []string
+		Attributes []*ColumnDefinition
+		Parameters StorageParameters
+		Comments   *ParsedComments
+	}
+
+	// CreateDomain represents a PostgreSQL CREATE DOMAIN statement
+	CreateDomain struct {
+		Name        TableName
+		Type        *ColumnType
+		Collate     string
+		Default     Expr
+		Constraints []*DomainConstraint
+		Comments    *ParsedComments
+	}
+
+	// DomainConstraint represents [CONSTRAINT name] NOT NULL | NULL | CHECK (expr) of the domain
+	DomainConstraint struct {
+		Name  ColIdent
+		Null  *bool
+		Check Expr
+	}
+
+	// AlterObjectOwner represents a PostgreSQL ALTER VIEW | TYPE | DOMAIN | DATABASE ... OWNER TO statement
+	AlterObjectOwner struct {
+		ObjectType string
+		Object     TableName
+		Owner      *RoleName
+		Comments   *ParsedComments
+	}
+
+	// CommentOn represents a PostgreSQL COMMENT ON statement for the objects other than schema.
+	// The column, constraint, trigger, policy and rule comments keep the table in the Object and their own name in the Name.
+	// The Value is the comment text or NULL.
+	CommentOn struct {
+		ObjectType string
+		Object     TableName
+		Name       ColIdent
+		Arguments  []*FunctionArgument
+		Value      Expr
+		Comments   *ParsedComments
+	}
+
+	// Grant represents a PostgreSQL GRANT privileges ON objects TO roles statement.
+	// With the AllInSchema the ObjectType is plural (tables, sequences, ...) and the Objects are the schemas.
+	Grant struct {
+		Privileges      []*GrantPrivilege
+		ObjectType      string
+		AllInSchema     bool
+		Objects         []*PrivilegeObject
+		Grantees        []*RoleName
+		WithGrantOption bool
+		Comments        *ParsedComments
+	}
+
+	// Revoke represents a PostgreSQL REVOKE privileges ON objects FROM roles statement.
+	// The ObjectType and the Objects follow the Grant rules.
+	Revoke struct {
+		GrantOptionFor bool
+		Privileges     []*GrantPrivilege
+		ObjectType     string
+		AllInSchema    bool
+		Objects        []*PrivilegeObject
+		Grantees       []*RoleName
+		Cascade        bool
+		Comments       *ParsedComments
+	}
+
+	// GrantPrivilege represents a privilege of GRANT and REVOKE with the optional columns
+	GrantPrivilege struct {
+		Type    string
+		Columns Columns
+	}
+
+	// PrivilegeObject represents an object of GRANT and REVOKE, the functions keep the argument types
+	PrivilegeObject struct {
+		Name      TableName
+		Arguments []*FunctionArgument
+	}
+
+	// AlterDefaultPrivileges represents a PostgreSQL ALTER DEFAULT PRIVILEGES statement.
+	// The Action is *Grant or *Revoke with the plural ObjectType and without the Objects.
+	AlterDefaultPrivileges struct {
+		Roles    []*RoleName
+		Schemas  []TableIdent
+		Action   Statement
+		Comments *ParsedComments
+	}
+
+	// CreatePolicy represents a PostgreSQL CREATE POLICY statement
+	CreatePolicy struct {
+		Name        ColIdent
+		Table       TableName
+		Restrictive bool
+		Command     string
+		Roles       []*RoleName
+		Using       Expr
+		WithCheck   Expr
+		Comments    *ParsedComments
+	}
+
+	// CreatePublication represents a PostgreSQL CREATE PUBLICATION statement
+	CreatePublication struct {
+		Name       ColIdent
+		AllTables  bool
+		Tables     []*PublicationTable
+		Schemas    []TableIdent
+		Parameters StorageParameters
+		Comments   *ParsedComments
+	}
+
+	// AlterPublication represents a PostgreSQL ALTER PUBLICATION ... ADD | SET | DROP statement
+	AlterPublication struct {
+		Name     ColIdent
+		Action   string
+		Tables   []*PublicationTable
+		Schemas  []TableIdent
+		Comments *ParsedComments
+	}
+
+	// PublicationTable represents a table of the publication with the optional columns and row filter
+	PublicationTable struct {
+		Only    bool
+		Table   TableName
+		Columns Columns
+		Where   Expr
+	}
+
+	// CreateRule represents a PostgreSQL CREATE RULE statement, the empty Actions mean DO NOTHING
+	CreateRule struct {
+		IsReplace bool
+		Name      ColIdent
+		Event     string
+		Table     TableName
+		Where     Expr
+		Instead   bool
+		Actions   []Statement
+		Comments  *ParsedComments
+	}
+
 	// AlterView represents a ALTER VIEW query
 	AlterView struct {
 		ViewName    TableName
@@ -802,6 +1113,22 @@ func (*CreateDatabase) iStatement()    {}
 func (*AlterDatabase) iStatement()     {}
 func (*CreateTable) iStatement()       {}
 func (*CreateView) iStatement()        {}
+func (*CreateIndex) iStatement()       {}
+func (*CreateFunction) iStatement()    {}
+func (*AlterFunction) iStatement()     {}
+func (*DropFunction) iStatement()      {}
+func (*CreateTrigger) iStatement()     {}
+func (*CreateExtension) iStatement()   {}
+func (*CreateType) iStatement()        {}
+func (*CreateDomain) iStatement()      {}
+func (*AlterObjectOwner) iStatement()  {}
+func (*CommentOn) iStatement()         {}
+func (*Grant) iStatement()             {}
+func (*Revoke) iStatement()            {}
+func (*CreatePolicy) iStatement()      {}
+func (*CreatePublication) iStatement() {}
+func (*AlterPublication) iStatement()  {}
+func (*CreateRule) iStatement()        {}
 func (*AlterView) iStatement()         {}
 func (*CreateSequence) iStatement()    {}
 func (*AlterSequence) iStatement()     {}
@@ -829,7 +1156,15 @@ func (*CommentOnSchema) iStatement()   {}
 func (*CopyFrom) iStatement()          {}
 func (*CopyTo) iStatement()            {}
 
+func (*AlterDefaultPrivileges) iStatement()  {}
+func (*CreateMaterializedView) iStatement()  {}
+func (*RefreshMaterializedView) iStatement() {}
+func (*AlterIndexPartition) iStatement()     {}
+func (*CreateVirtualTable) iStatement()      {}
+func (*Pragma) iStatement()                  {}
+
 func (*CreateView) iDDLStatement()    {}
+func (*CreateIndex) iDDLStatement()   {}
 func (*AlterView) iDDLStatement()     {}
 func (*CreateTable) iDDLStatement()   {}
 func (*DropTable) iDDLStatement()     {}
@@ -845,6 +1180,7 @@ func (*AddColumns) iAlterOption()              {}
 func (AlgorithmValue) iAlterOption()           {}
 func (*AlterColumn) iAlterOption()             {}
 func (*AlterOwner) iAlterOption()              {}
+func (*RowLevelSecurity) iAlterOption()        {}
 func (*AlterCheck) iAlterOption()              {}
 func (*AlterIndex) iAlterOption()              {}
 func (*ChangeColumn) iAlterOption()            {}
@@ -934,9 +1270,19 @@ func (node *CreateView) IsFullyParsed() bool {
 	return true
 }
 
+// IsFullyParsed implements the DDLStatement interface
+func (node *CreateIndex) IsFullyParsed() bool {
+	return node.FullyParsed
+}
+
 // SetFullyParsed implements the DDLStatement interface
 func (node *CreateView) SetFullyParsed(fullyParsed bool) {}
 
+// SetFullyParsed implements the DDLStatement interface
+func (node *CreateIndex) SetFullyParsed(fullyParsed bool) {
+	node.FullyParsed = fullyParsed
+}
+
 // IsFullyParsed implements the DDLStatement interface
 func (node *DropView) IsFullyParsed() bool {
 	return true
@@ -996,6 +1342,11 @@ func (node *CreateView) IsTemporary() bool {
 	return false
 }
 
+// IsTemporary implements the DDLStatement interface
+func (node *CreateIndex) IsTemporary() bool {
+	return false
+}
+
 // IsTemporary implements the DDLStatement interface
 func (node *DropView) IsTemporary() bool {
 	return false
@@ -1041,6 +1392,11 @@ func (node *CreateView) GetTable() TableName {
 	return node.ViewName
 }
 
+// GetTable implements the DDLStatement interface
+func (node *CreateIndex) GetTable() TableName {
+	return node.Table
+}
+
 // GetTable implements the DDLStatement interface
 func (node *AlterView) GetTable() TableName {
 	return node.ViewName
@@ -1081,6 +1437,11 @@ func (node *CreateView) GetAction() DDLAction {
 	return CreateDDLAction
 }
 
+// GetAction implements the DDLStatement interface
+func (node *CreateIndex) GetAction() DDLAction {
+	return CreateDDLAction
+}
+
 // GetAction implements the DDLStatement interface
 func (node *AlterView) GetAction() DDLAction {
 	return AlterDDLAction
@@ -1126,6 +1487,11 @@ func (node *CreateView) GetOptLike() *OptLike {
 	return nil
 }
 
+// GetOptLike implements the DDLStatement interface
+func (node *CreateIndex) GetOptLike() *OptLike {
+	return nil
+}
+
 // GetOptLike implements the DDLStatement interface
 func (node *AlterView) GetOptLike() *OptLike {
 	return nil
@@ -1166,6 +1532,11 @@ func (node *CreateView) GetIfExists() bool {
 	return false
 }
 
+// GetIfExists implements the DDLStatement interface
+func (node *CreateIndex) GetIfExists() bool {
+	return false
+}
+
 // GetIfExists implements the DDLStatement interface
 func (node *AlterView) GetIfExists() bool {
 	return false
@@ -1206,6 +1577,11 @@ func (node *CreateView) GetIfNotExists() bool {
 	return false
 }
 
+// GetIfNotExists implements the DDLStatement interface
+func (node *CreateIndex) GetIfNotExists() bool {
+	return node.IfNotExists
+}
+
 // GetIfNotExists implements the DDLStatement interface
 func (node *AlterView) GetIfNotExists() bool {
 	return false
@@ -1246,6 +1622,11 @@ func (node *CreateView) GetIsReplace() bool {
 	return node.IsReplace
 }
 
+// GetIsReplace implements the DDLStatement interface
+func (node *CreateIndex) GetIsReplace() bool {
+	return false
+}
+
 // GetIsReplace implements the DDLStatement interface
 func (node *AlterView) GetIsReplace() bool {
 	return false
@@ -1286,6 +1667,11 @@ func (node *CreateView) GetTableSpec() *TableSpec {
 	return nil
 }
 
+// GetTableSpec implements the DDLStatement interface
+func (node *CreateIndex) GetTableSpec() *TableSpec {
+	return nil
+}
+
 // GetTableSpec implements the DDLStatement interface
 func (node *AlterView) GetTableSpec() *TableSpec {
 	return nil
@@ -1330,6 +1716,11 @@ func (node *CreateView) GetFromTables() TableNames {
 	return nil
 }
 
+// GetFromTables implements the DDLStatement interface
+func (node *CreateIndex) GetFromTables() TableNames {
+	return nil
+}
+
 // GetFromTables implements the DDLStatement interface
 func (node *DropTable) GetFromTables() TableNames {
 	return node.FromTables
@@ -1375,6 +1766,11 @@ func (node *CreateView) SetFromTables(tables TableNames) {
 	// irrelevant
 }
 
+// SetFromTables implements DDLStatement.
+func (node *CreateIndex) SetFromTables(tables TableNames) {
+	// irrelevant
+}
+
 // SetFromTables implements DDLStatement.
 func (node *DropTable) SetFromTables(tables TableNames) {
 	node.FromTables = tables
@@ -1415,6 +1811,11 @@ func (node *CreateView) SetComments(comments Comments) {
 	node.Comments = comments.Parsed()
 }
 
+// SetComments implements DDLStatement.
+func (node *CreateIndex) SetComments(comments Comments) {
+	node.Comments = comments.Parsed()
+}
+
 // SetComments implements DDLStatement.
 func (node *DropTable) SetComments(comments Comments) {
 	node.Comments = comments.Parsed()
@@ -1487,6 +1888,11 @@ func (node *CreateView) GetParsedComments() *ParsedComments {
 	return node.Comments
 }
 
+// GetParsedComments implements DDLStatement.
+func (node *CreateIndex) GetParsedComments() *ParsedComments {
+	return node.Comments
+}
+
 // GetParsedComments implements DDLStatement.
 func (node *DropTable) GetParsedComments() *ParsedComments {
 	return node.Comments
@@ -1557,6 +1963,11 @@ func (node *CreateView) GetToTables() TableNames {
 	return nil
 }
 
+// GetToTables implements the DDLStatement interface
+func (node *CreateIndex) GetToTables() TableNames {
+	return nil
+}
+
 // GetToTables implements the DDLStatement interface
 func (node *AlterView) GetToTables() TableNames {
 	return nil
@@ -1614,6 +2025,11 @@ func (node *CreateView) AffectedTables() TableNames {
 	return TableNames{node.ViewName}
 }
 
+// AffectedTables implements DDLStatement.
+func (node *CreateIndex) AffectedTables() TableNames {
+	return TableNames{node.Table}
+}
+
 // AffectedTables implements DDLStatement.
 func (node *AlterView) AffectedTables() TableNames {
 	return TableNames{node.ViewName}
@@ -1653,6 +2069,12 @@ func (node *CreateView) SetTable(qualifier string, name string) {
 	node.ViewName.Name = NewTableIdent(name)
 }
 
+// SetTable implements DDLStatement.
+func (node *CreateIndex) SetTable(qualifier string, name string) {
+	node.Table.Qualifier = NewTableIdent(qualifier)
+	node.Table.Name = NewTableIdent(name)
+}
+
 // SetTable implements DDLStatement.
 func (node *AlterView) SetTable(qualifier string, name string) {
 	node.ViewName.Qualifier = NewTableIdent(qualifier)
@@ -1775,6 +2197,8 @@ type PartitionSpec struct {
 	TableName         TableName
 	WithoutValidation bool
 	Definitions       []*PartitionDefinition
+	// Bound of the PostgreSQL ATTACH PARTITION, nil for the default partition
+	Bound *PartitionValueRange
 }
 
 // PartitionSpecAction is an enum for PartitionSpec.Action
@@ -1825,6 +2249,8 @@ type PartitionValueRange struct {
 	Type     PartitionValueRangeType
 	Range    ValTuple
 	Maxvalue bool
+	// To is the upper bound of the PostgreSQL FROM (...) TO (...) range
+	To ValTuple
 }
 
 type PartitionEngine struct {
@@ -1835,7 +2261,8 @@ type PartitionEngine struct {
 // PartitionByType is an enum storing how we are partitioning a table
 type PartitionByType int8
 
-// PartitionOption describes partitioning control (for create table statements)
+// PartitionOption describes partitioning control (for create table statements),
+// the PostgreSQL partition key of several columns or expressions is kept in Expr as ValTuple
 type PartitionOption struct {
 	Type         PartitionByType
 	IsLinear     bool
@@ -1867,6 +2294,10 @@ type TableSpec struct {
 	Constraints     []*ConstraintDefinition
 	Options         TableOptions
 	PartitionOption *PartitionOption
+	// PartitionOf is the parent of the PostgreSQL CREATE TABLE ... PARTITION OF,
+	// the nil PartitionBound means the default partition
+	PartitionOf    TableName
+	PartitionBound *PartitionValueRange
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -1896,6 +2327,12 @@ type ColumnType struct {
 
 	// Enum values
 	EnumValues []string
+
+	// PostgreSQL time zone of the time and timestamp types (with time zone, without time zone)
+	TimeZone string
+
+	// PostgreSQL array dimensions, the empty string is the dimension without the size
+	ArrayBounds []string
 }
 
 // ColumnCharset exists because in the type definition it's possible
@@ -1933,9 +2370,15 @@ type ColumnTypeOptions struct {
 	Default       Expr
 	OnUpdate      Expr
 	As            Expr
-	Comment       *Literal
-	Storage       ColumnStorage
-	Collate       string
+	// GeneratedAlways keeps the GENERATED ALWAYS of the PostgreSQL generated column
+	GeneratedAlways bool
+	// Identity is ALWAYS or BY DEFAULT of the PostgreSQL identity column,
+	// IdentitySequence keeps the options of the identity sequence
+	Identity         string
+	IdentitySequence *SequenceSpec
+	Comment          *Literal
+	Storage          ColumnStorage
+	Collate          string
 	// Reference stores a foreign key constraint for the given column
 	Reference *ReferenceDefinition
 
@@ -2011,11 +2454,16 @@ type ConstraintDefinition struct {
 
 // SequenceSpec describes the sequence parameters from a CREATE SEQUENCE statement
 type SequenceSpec struct {
+	Name        TableName
+	DataType    string
 	StartWith   *int
 	IncrementBy *int
+	MinValue    *int
 	NoMinValue  bool
+	MaxValue    *int
 	NoMaxValue  bool
 	Cache       *int
+	Cycle       *bool
 }
 
 type (
@@ -2418,6 +2866,12 @@ type (
 		Collation string
 	}
 
+	// TypeCastExpr represents PostgreSQL type cast operator expr::type
+	TypeCastExpr struct {
+		Expr Expr
+		Type *ColumnType
+	}
+
 	// WeightStringFuncExpr represents the function and arguments for WEIGHT_STRING('string' AS [CHAR|BINARY](n))
 	WeightStringFuncExpr struct {
 		Expr Expr
@@ -2765,6 +3219,7 @@ func (*UnaryExpr) iExpr()                          {}
 func (*IntroducerExpr) iExpr()                     {}
 func (*IntervalExpr) iExpr()                       {}
 func (*CollateExpr) iExpr()                        {}
+func (*TypeCastExpr) iExpr()                       {}
 func (*FuncExpr) iExpr()                           {}
 func (*TimestampFuncExpr) iExpr()                  {}
 func (*ExtractFuncExpr) iExpr()                    {}
@@ -45,14 +45,26 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfAlterColumn(in)
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterDefaultPrivileges:
+		return CloneRefOfAlterDefaultPrivileges(in)
+	case *AlterFunction:
+		return CloneRefOfAlterFunction(in)
 	case *AlterIndex:
 		return CloneRefOfAlterIndex(in)
+	case *AlterIndexPartition:
+		return CloneRefOfAlterIndexPartition(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterObjectOwner:
+		return CloneRefOfAlterObjectOwner(in)
+	case *AlterPublication:
+		return CloneRefOfAlterPublication(in)
 	case *AlterSchema:
 		return CloneRefOfAlterSchema(in)
 	case *AlterOwner:
 		return CloneRefOfAlterOwner(in)
+	case *AlterSequence:
+		return CloneRefOfAlterSequence(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
 	case *AlterView:
@@ -93,6 +105,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfColumnType(in)
 	case Columns:
 		return CloneColumns(in)
+	case *CommentOn:
+		return CloneRefOfCommentOn(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
 	case *CommonTableExpr:
@@ -109,12 +123,34 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfConvertUsingExpr(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateDomain:
+		return CloneRefOfCreateDomain(in)
+	case *CreateExtension:
+		return CloneRefOfCreateExtension(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateIndex:
+		return CloneRefOfCreateIndex(in)
+	case *CreateMaterializedView:
+		return CloneRefOfCreateMaterializedView(in)
+	case *CreatePolicy:
+		return CloneRefOfCreatePolicy(in)
+	case *CreatePublication:
+		return CloneRefOfCreatePublication(in)
+	case *CreateRule:
+		return CloneRefOfCreateRule(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateType:
+		return CloneRefOfCreateType(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
 	case *CreateSequence:
 		return CloneRefOfCreateSequence(in)
+	case *CreateVirtualTable:
+		return CloneRefOfCreateVirtualTable(in)
 	case *CurTimeFuncExpr:
 		return CloneRefOfCurTimeFuncExpr(in)
 	case *DeallocateStmt:
@@ -127,10 +163,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfDelete(in)
 	case *DerivedTable:
 		return CloneRefOfDerivedTable(in)
+	case *DomainConstraint:
+		return CloneRefOfDomainConstraint(in)
 	case *DropColumn:
 		return CloneRefOfDropColumn(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
+	case *DropFunction:
+		return CloneRefOfDropFunction(in)
 	case *DropKey:
 		return CloneRefOfDropKey(in)
 	case *DropTable:
@@ -159,12 +199,24 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForeignKeyDefinition(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
+	case *FunctionArgument:
+		return CloneRefOfFunctionArgument(in)
+	case *FunctionOption:
+		return CloneRefOfFunctionOption(in)
+	case *FunctionReturns:
+		return CloneRefOfFunctionReturns(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
+	case *GrantPrivilege:
+		return CloneRefOfGrantPrivilege(in)
 	case GroupBy:
 		return CloneGroupBy(in)
 	case *GroupConcatExpr:
 		return CloneRefOfGroupConcatExpr(in)
 	case *IndexDefinition:
 		return CloneRefOfIndexDefinition(in)
+	case *IndexElement:
+		return CloneRefOfIndexElement(in)
 	case *IndexHint:
 		return CloneRefOfIndexHint(in)
 	case IndexHints:
@@ -297,14 +349,22 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfPartitionValueRange(in)
 	case Partitions:
 		return ClonePartitions(in)
+	case *Pragma:
+		return CloneRefOfPragma(in)
 	case *PrepareStmt:
 		return CloneRefOfPrepareStmt(in)
 	case *CommentOnSchema:
 		return CloneRefOfCommentOnSchema(in)
+	case *PrivilegeObject:
+		return CloneRefOfPrivilegeObject(in)
+	case *PublicationTable:
+		return CloneRefOfPublicationTable(in)
 	case ReferenceAction:
 		return in
 	case *ReferenceDefinition:
 		return CloneRefOfReferenceDefinition(in)
+	case *RefreshMaterializedView:
+		return CloneRefOfRefreshMaterializedView(in)
 	case *Release:
 		return CloneRefOfRelease(in)
 	case *RenameIndex:
@@ -315,10 +375,16 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfRenameTableName(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
+	case *RoleName:
+		return CloneRefOfRoleName(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
 	case RootNode:
 		return CloneRootNode(in)
+	case *RowLevelSecurity:
+		return CloneRefOfRowLevelSecurity(in)
 	case *SRollback:
 		return CloneRefOfSRollback(in)
 	case *Savepoint:
@@ -353,6 +419,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfShowThrottledApps(in)
 	case *StarExpr:
 		return CloneRefOfStarExpr(in)
+	case StorageParameters:
+		return CloneStorageParameters(in)
 	case *Stream:
 		return CloneRefOfStream(in)
 	case *SubPartition:
@@ -385,10 +453,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfSequenceSpec(in)
 	case *TimestampFuncExpr:
 		return CloneRefOfTimestampFuncExpr(in)
+	case *TriggerEvent:
+		return CloneRefOfTriggerEvent(in)
 	case *TrimFuncExpr:
 		return CloneRefOfTrimFuncExpr(in)
 	case *TruncateTable:
 		return CloneRefOfTruncateTable(in)
+	case *TypeCastExpr:
+		return CloneRefOfTypeCastExpr(in)
 	case *UnaryExpr:
 		return CloneRefOfUnaryExpr(in)
 	case *Union:
@@ -508,6 +580,56 @@ func CloneRefOfAlterCheck(n *AlterCheck) *AlterCheck {
 	return &out
 }
 
+// CloneRefOfAlterDefaultPrivileges creates a deep clone of the input.
+func CloneRefOfAlterDefaultPrivileges(n *AlterDefaultPrivileges) *AlterDefaultPrivileges {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Roles = CloneSliceOfRefOfRoleName(n.Roles)
+	out.Schemas = CloneSliceOfTableIdent(n.Schemas)
+	out.Action = CloneStatement(n.Action)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfAlterFunction creates a deep clone of the input.
+func CloneRefOfAlterFunction(n *AlterFunction) *AlterFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Arguments = CloneSliceOfRefOfFunctionArgument(n.Arguments)
+	out.AlterOptions = CloneSliceOfAlterOption(n.AlterOptions)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfAlterIndexPartition creates a deep clone of the input.
+func CloneRefOfAlterIndexPartition(n *AlterIndexPartition) *AlterIndexPartition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.AttachPartition = CloneTableName(n.AttachPartition)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfAlterObjectOwner creates a deep clone of the input.
+func CloneRefOfAlterObjectOwner(n *AlterObjectOwner) *AlterObjectOwner {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Object = CloneTableName(n.Object)
+	out.Owner = CloneRefOfRoleName(n.Owner)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
 // CloneRefOfAlterOwner creates a deep clone of the input.
 func CloneRefOfAlterOwner(n *AlterOwner) *AlterOwner {
 	if n == nil {
@@ -527,6 +649,7 @@ func CloneRefOfAlterColumn(n *AlterColumn) *AlterColumn {
 	out.Column = CloneRefOfColName(n.Column)
 	out.DefaultVal = CloneExpr(n.DefaultVal)
 	out.Invisible = CloneRefOfBool(n.Invisible)
+	out.IdentitySequence = CloneRefOfSequenceSpec(n.IdentitySequence)
 	return &out
 }
 
@@ -561,6 +684,19 @@ func CloneRefOfAlterMigration(n *AlterMigration) *AlterMigration {
 	return &out
 }
 
+// CloneRefOfAlterPublication creates a deep clone of the input.
+func CloneRefOfAlterPublication(n *AlterPublication) *AlterPublication {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Tables = CloneSliceOfRefOfPublicationTable(n.Tables)
+	out.Schemas = CloneSliceOfTableIdent(n.Schemas)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
 // CloneRefOfAlterSchema creates a deep clone of the input.
 func CloneRefOfAlterSchema(n *AlterSchema) *AlterSchema {
 	if n == nil {
@@ -572,6 +708,19 @@ func CloneRefOfAlterSchema(n *AlterSchema) *AlterSchema {
 	return &out
 }
 
+// CloneRefOfAlterSequence creates a deep clone of the input.
+func CloneRefOfAlterSequence(n *AlterSequence) *AlterSequence {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Sequence = CloneSequenceName(n.Sequence)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	out.SequenceSpec = CloneRefOfSequenceSpec(n.SequenceSpec)
+	out.AlterOptions = CloneSliceOfAlterOption(n.AlterOptions)
+	return &out
+}
+
 // CloneRefOfAlterTable creates a deep clone of the input.
 func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
 	if n == nil {
@@ -722,6 +871,328 @@ func CloneRefOfColName(n *ColName) *ColName {
 	return n
 }
 
+// CloneRefOfCommentOn creates a deep clone of the input.
+func CloneRefOfCommentOn(n *CommentOn) *CommentOn {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Object = CloneTableName(n.Object)
+	out.Name = CloneColIdent(n.Name)
+	out.Arguments = CloneSliceOfRefOfFunctionArgument(n.Arguments)
+	out.Value = CloneExpr(n.Value)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateDomain creates a deep clone of the input.
+func CloneRefOfCreateDomain(n *CreateDomain) *CreateDomain {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Type = CloneRefOfColumnType(n.Type)
+	out.Default = CloneExpr(n.Default)
+	out.Constraints = CloneSliceOfRefOfDomainConstraint(n.Constraints)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateExtension creates a deep clone of the input.
+func CloneRefOfCreateExtension(n *CreateExtension) *CreateExtension {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Schema = CloneTableIdent(n.Schema)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateFunction creates a deep clone of the input.
+func CloneRefOfCreateFunction(n *CreateFunction) *CreateFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Arguments = CloneSliceOfRefOfFunctionArgument(n.Arguments)
+	out.Returns = CloneRefOfFunctionReturns(n.Returns)
+	out.Options = CloneSliceOfRefOfFunctionOption(n.Options)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateIndex creates a deep clone of the input.
+func CloneRefOfCreateIndex(n *CreateIndex) *CreateIndex {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Table = CloneTableName(n.Table)
+	out.Method = CloneColIdent(n.Method)
+	out.Columns = CloneSliceOfRefOfIndexElement(n.Columns)
+	out.Include = CloneColumns(n.Include)
+	out.Parameters = CloneStorageParameters(n.Parameters)
+	out.Tablespace = CloneTableIdent(n.Tablespace)
+	out.Where = CloneRefOfWhere(n.Where)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateMaterializedView creates a deep clone of the input.
+func CloneRefOfCreateMaterializedView(n *CreateMaterializedView) *CreateMaterializedView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ViewName = CloneTableName(n.ViewName)
+	out.Columns = CloneColumns(n.Columns)
+	out.Method = CloneColIdent(n.Method)
+	out.Parameters = CloneStorageParameters(n.Parameters)
+	out.Tablespace = CloneTableIdent(n.Tablespace)
+	out.Select = CloneSelectStatement(n.Select)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreatePolicy creates a deep clone of the input.
+func CloneRefOfCreatePolicy(n *CreatePolicy) *CreatePolicy {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Table = CloneTableName(n.Table)
+	out.Roles = CloneSliceOfRefOfRoleName(n.Roles)
+	out.Using = CloneExpr(n.Using)
+	out.WithCheck = CloneExpr(n.WithCheck)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreatePublication creates a deep clone of the input.
+func CloneRefOfCreatePublication(n *CreatePublication) *CreatePublication {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Tables = CloneSliceOfRefOfPublicationTable(n.Tables)
+	out.Schemas = CloneSliceOfTableIdent(n.Schemas)
+	out.Parameters = CloneStorageParameters(n.Parameters)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateRule creates a deep clone of the input.
+func CloneRefOfCreateRule(n *CreateRule) *CreateRule {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Table = CloneTableName(n.Table)
+	out.Where = CloneExpr(n.Where)
+	out.Actions = CloneSliceOfStatement(n.Actions)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateTrigger creates a deep clone of the input.
+func CloneRefOfCreateTrigger(n *CreateTrigger) *CreateTrigger {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Events = CloneSliceOfRefOfTriggerEvent(n.Events)
+	out.Table = CloneTableName(n.Table)
+	out.When = CloneExpr(n.When)
+	out.Function = CloneTableName(n.Function)
+	out.Arguments = CloneExprs(n.Arguments)
+	out.Body = CloneSliceOfStatement(n.Body)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateType creates a deep clone of the input.
+func CloneRefOfCreateType(n *CreateType) *CreateType {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Labels = CloneSliceOfString(n.Labels)
+	out.Attributes = CloneSliceOfRefOfColumnDefinition(n.Attributes)
+	out.Parameters = CloneStorageParameters(n.Parameters)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfCreateVirtualTable creates a deep clone of the input.
+func CloneRefOfCreateVirtualTable(n *CreateVirtualTable) *CreateVirtualTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Module = CloneColIdent(n.Module)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfDomainConstraint creates a deep clone of the input.
+func CloneRefOfDomainConstraint(n *DomainConstraint) *DomainConstraint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Null = CloneRefOfBool(n.Null)
+	out.Check = CloneExpr(n.Check)
+	return &out
+}
+
+// CloneRefOfDropFunction creates a deep clone of the input.
+func CloneRefOfDropFunction(n *DropFunction) *DropFunction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfFunctionArgument creates a deep clone of the input.
+func CloneRefOfFunctionArgument(n *FunctionArgument) *FunctionArgument {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Type = CloneRefOfColumnType(n.Type)
+	out.Default = CloneExpr(n.Default)
+	return &out
+}
+
+// CloneRefOfFunctionOption creates a deep clone of the input.
+func CloneRefOfFunctionOption(n *FunctionOption) *FunctionOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfFunctionReturns creates a deep clone of the input.
+func CloneRefOfFunctionReturns(n *FunctionReturns) *FunctionReturns {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Type = CloneRefOfColumnType(n.Type)
+	out.Table = CloneSliceOfRefOfFunctionArgument(n.Table)
+	return &out
+}
+
+// CloneRefOfGrant creates a deep clone of the input.
+func CloneRefOfGrant(n *Grant) *Grant {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = CloneSliceOfRefOfGrantPrivilege(n.Privileges)
+	out.Objects = CloneSliceOfRefOfPrivilegeObject(n.Objects)
+	out.Grantees = CloneSliceOfRefOfRoleName(n.Grantees)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfGrantPrivilege creates a deep clone of the input.
+func CloneRefOfGrantPrivilege(n *GrantPrivilege) *GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
+// CloneRefOfIndexElement creates a deep clone of the input.
+func CloneRefOfIndexElement(n *IndexElement) *IndexElement {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Column = CloneColIdent(n.Column)
+	out.Expression = CloneExpr(n.Expression)
+	out.OpClass = CloneTableName(n.OpClass)
+	out.OpClassParameters = CloneStorageParameters(n.OpClassParameters)
+	return &out
+}
+
+// CloneRefOfPragma creates a deep clone of the input.
+func CloneRefOfPragma(n *Pragma) *Pragma {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	return &out
+}
+
+// CloneRefOfPrivilegeObject creates a deep clone of the input.
+func CloneRefOfPrivilegeObject(n *PrivilegeObject) *PrivilegeObject {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneTableName(n.Name)
+	out.Arguments = CloneSliceOfRefOfFunctionArgument(n.Arguments)
+	return &out
+}
+
+// CloneRefOfPublicationTable creates a deep clone of the input.
+func CloneRefOfPublicationTable(n *PublicationTable) *PublicationTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Columns = CloneColumns(n.Columns)
+	out.Where = CloneExpr(n.Where)
+	return &out
+}
+
+// CloneRefOfRefreshMaterializedView creates a deep clone of the input.
+func CloneRefOfRefreshMaterializedView(n *RefreshMaterializedView) *RefreshMaterializedView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ViewName = CloneTableName(n.ViewName)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfRevoke creates a deep clone of the input.
+func CloneRefOfRevoke(n *Revoke) *Revoke {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = CloneSliceOfRefOfGrantPrivilege(n.Privileges)
+	out.Objects = CloneSliceOfRefOfPrivilegeObject(n.Objects)
+	out.Grantees = CloneSliceOfRefOfRoleName(n.Grantees)
+	out.Comments = CloneRefOfParsedComments(n.Comments)
+	return &out
+}
+
 func CloneRefOfRoleName(n *RoleName) * RoleName {
 	return n
 }
@@ -763,6 +1234,7 @@ func CloneRefOfColumnType(n *ColumnType) *ColumnType {
 	out.Scale = CloneRefOfLiteral(n.Scale)
 	out.Charset = CloneColumnCharset(n.Charset)
 	out.EnumValues = CloneSliceOfString(n.EnumValues)
+	out.ArrayBounds = CloneSliceOfString(n.ArrayBounds)
 	return &out
 }
 
@@ -1827,6 +2299,7 @@ func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
 	out.Number = CloneRefOfLiteral(n.Number)
 	out.TableName = CloneTableName(n.TableName)
 	out.Definitions = CloneSliceOfRefOfPartitionDefinition(n.Definitions)
+	out.Bound = CloneRefOfPartitionValueRange(n.Bound)
 	return &out
 }
 
@@ -1837,6 +2310,7 @@ func CloneRefOfPartitionValueRange(n *PartitionValueRange) *PartitionValueRange
 	}
 	out := *n
 	out.Range = CloneValTuple(n.Range)
+	out.To = CloneValTuple(n.To)
 	return &out
 }
 
@@ -1952,6 +2426,15 @@ func CloneRootNode(n RootNode) RootNode {
 	return *CloneRefOfRootNode(&n)
 }
 
+// CloneRefOfRowLevelSecurity creates a deep clone of the input.
+func CloneRefOfRowLevelSecurity(n *RowLevelSecurity) *RowLevelSecurity {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfSRollback creates a deep clone of the input.
 func CloneRefOfSRollback(n *SRollback) *SRollback {
 	if n == nil {
@@ -2141,6 +2624,18 @@ func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
 	return &out
 }
 
+// CloneStorageParameters creates a deep clone of the input.
+func CloneStorageParameters(n StorageParameters) StorageParameters {
+	if n == nil {
+		return nil
+	}
+	res := make(StorageParameters, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfStorageParameter(x))
+	}
+	return res
+}
+
 // CloneRefOfStream creates a deep clone of the input.
 func CloneRefOfStream(n *Stream) *Stream {
 	if n == nil {
@@ -2296,6 +2791,8 @@ func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
 	out.Constraints = CloneSliceOfRefOfConstraintDefinition(n.Constraints)
 	out.Options = CloneTableOptions(n.Options)
 	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
+	out.PartitionOf = CloneTableName(n.PartitionOf)
+	out.PartitionBound = CloneRefOfPartitionValueRange(n.PartitionBound)
 	return &out
 }
 
@@ -2314,9 +2811,13 @@ func CloneRefOfSequenceSpec(n *SequenceSpec) *SequenceSpec {
 		return nil
 	}
 	out := *n
+	out.Name = CloneTableName(n.Name)
 	out.StartWith = CloneIntReference(n.StartWith)
 	out.IncrementBy = CloneIntReference(n.IncrementBy)
+	out.MinValue = CloneIntReference(n.MinValue)
+	out.MaxValue = CloneIntReference(n.MaxValue)
 	out.Cache = CloneIntReference(n.Cache)
+	out.Cycle = CloneRefOfBool(n.Cycle)
 	out.NoMinValue = n.NoMinValue
 	out.NoMaxValue = n.NoMaxValue
 	return &out
@@ -2333,6 +2834,16 @@ func CloneRefOfTimestampFuncExpr(n *TimestampFuncExpr) *TimestampFuncExpr {
 	return &out
 }
 
+// CloneRefOfTriggerEvent creates a deep clone of the input.
+func CloneRefOfTriggerEvent(n *TriggerEvent) *TriggerEvent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
 // CloneRefOfTrimFuncExpr creates a deep clone of the input.
 func CloneRefOfTrimFuncExpr(n *TrimFuncExpr) *TrimFuncExpr {
 	if n == nil {
@@ -2354,6 +2865,17 @@ func CloneRefOfTruncateTable(n *TruncateTable) *TruncateTable {
 	return &out
 }
 
+// CloneRefOfTypeCastExpr creates a deep clone of the input.
+func CloneRefOfTypeCastExpr(n *TypeCastExpr) *TypeCastExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Type = CloneRefOfColumnType(n.Type)
+	return &out
+}
+
 // CloneRefOfUnaryExpr creates a deep clone of the input.
 func CloneRefOfUnaryExpr(n *UnaryExpr) *UnaryExpr {
 	if n == nil {
@@ -2586,6 +3108,8 @@ func CloneAlterOption(in AlterOption) AlterOption {
 		return CloneRefOfAlterColumn(in)
 	case *AlterIndex:
 		return CloneRefOfAlterIndex(in)
+	case *AlterOwner:
+		return CloneRefOfAlterOwner(in)
 	case *ChangeColumn:
 		return CloneRefOfChangeColumn(in)
 	case *DropColumn:
@@ -2606,6 +3130,8 @@ func CloneAlterOption(in AlterOption) AlterOption {
 		return CloneRefOfRenameIndex(in)
 	case *RenameTableName:
 		return CloneRefOfRenameTableName(in)
+	case *RowLevelSecurity:
+		return CloneRefOfRowLevelSecurity(in)
 	case TableOptions:
 		return CloneTableOptions(in)
 	case *TablespaceOperation:
@@ -2774,6 +3300,8 @@ func CloneDDLStatement(in DDLStatement) DDLStatement {
 		return CloneRefOfAlterTable(in)
 	case *AlterView:
 		return CloneRefOfAlterView(in)
+	case *CreateIndex:
+		return CloneRefOfCreateIndex(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
 	case *CreateView:
@@ -2920,6 +3448,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfTimestampFuncExpr(in)
 	case *TrimFuncExpr:
 		return CloneRefOfTrimFuncExpr(in)
+	case *TypeCastExpr:
+		return CloneRefOfTypeCastExpr(in)
 	case *UnaryExpr:
 		return CloneRefOfUnaryExpr(in)
 	case ValTuple:
@@ -3158,8 +3688,20 @@ func CloneStatement(in Statement) Statement {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return CloneRefOfAlterDatabase(in)
+	case *AlterDefaultPrivileges:
+		return CloneRefOfAlterDefaultPrivileges(in)
+	case *AlterFunction:
+		return CloneRefOfAlterFunction(in)
+	case *AlterIndexPartition:
+		return CloneRefOfAlterIndexPartition(in)
 	case *AlterMigration:
 		return CloneRefOfAlterMigration(in)
+	case *AlterObjectOwner:
+		return CloneRefOfAlterObjectOwner(in)
+	case *AlterPublication:
+		return CloneRefOfAlterPublication(in)
+	case *AlterSequence:
+		return CloneRefOfAlterSequence(in)
 	case *AlterTable:
 		return CloneRefOfAlterTable(in)
 	case *AlterView:
@@ -3170,20 +3712,48 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfBegin(in)
 	case *CallProc:
 		return CloneRefOfCallProc(in)
+	case *CommentOn:
+		return CloneRefOfCommentOn(in)
+	case *CommentOnSchema:
+		return CloneRefOfCommentOnSchema(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
 	case *CreateDatabase:
 		return CloneRefOfCreateDatabase(in)
+	case *CreateDomain:
+		return CloneRefOfCreateDomain(in)
+	case *CreateExtension:
+		return CloneRefOfCreateExtension(in)
+	case *CreateFunction:
+		return CloneRefOfCreateFunction(in)
+	case *CreateIndex:
+		return CloneRefOfCreateIndex(in)
+	case *CreateMaterializedView:
+		return CloneRefOfCreateMaterializedView(in)
+	case *CreatePolicy:
+		return CloneRefOfCreatePolicy(in)
+	case *CreatePublication:
+		return CloneRefOfCreatePublication(in)
+	case *CreateRule:
+		return CloneRefOfCreateRule(in)
 	case *CreateTable:
 		return CloneRefOfCreateTable(in)
+	case *CreateTrigger:
+		return CloneRefOfCreateTrigger(in)
+	case *CreateType:
+		return CloneRefOfCreateType(in)
 	case *CreateView:
 		return CloneRefOfCreateView(in)
+	case *CreateVirtualTable:
+		return CloneRefOfCreateVirtualTable(in)
 	case *DeallocateStmt:
 		return CloneRefOfDeallocateStmt(in)
 	case *Delete:
 		return CloneRefOfDelete(in)
 	case *DropDatabase:
 		return CloneRefOfDropDatabase(in)
+	case *DropFunction:
+		return CloneRefOfDropFunction(in)
 	case *DropTable:
 		return CloneRefOfDropTable(in)
 	case *DropView:
@@ -3196,6 +3766,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfExplainTab(in)
 	case *Flush:
 		return CloneRefOfFlush(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
 	case *Load:
@@ -3206,14 +3778,20 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *Pragma:
+		return CloneRefOfPragma(in)
 	case *PrepareStmt:
 		return CloneRefOfPrepareStmt(in)
+	case *RefreshMaterializedView:
+		return CloneRefOfRefreshMaterializedView(in)
 	case *Release:
 		return CloneRefOfRelease(in)
 	case *RenameTable:
 		return CloneRefOfRenameTable(in)
 	case *RevertMigration:
 		return CloneRefOfRevertMigration(in)
+	case *Revoke:
+		return CloneRefOfRevoke(in)
 	case *Rollback:
 		return CloneRefOfRollback(in)
 	case *SRollback:
@@ -3374,6 +3952,7 @@ func CloneRefOfColumnTypeOptions(n *ColumnTypeOptions) *ColumnTypeOptions {
 	out.Default = CloneExpr(n.Default)
 	out.OnUpdate = CloneExpr(n.OnUpdate)
 	out.As = CloneExpr(n.As)
+	out.IdentitySequence = CloneRefOfSequenceSpec(n.IdentitySequence)
 	out.Comment = CloneRefOfLiteral(n.Comment)
 	out.Reference = CloneRefOfReferenceDefinition(n.Reference)
 	out.Invisible = CloneRefOfBool(n.Invisible)
@@ -3393,7 +3972,7 @@ func CloneSliceOfString(n []string) []string {
 	if n == nil {
 		return nil
 	}
-	res := make([]string, 0, len(n))
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
@@ -3799,3 +4378,158 @@ func CloneRefOfDatabaseOption(n *DatabaseOption) *DatabaseOption {
 	out := *n
 	return &out
 }
+
+// CloneSliceOfRefOfIndexElement creates a deep clone of the input.
+func CloneSliceOfRefOfIndexElement(n []*IndexElement) []*IndexElement {
+	if n == nil {
+		return nil
+	}
+	res := make([]*IndexElement, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfIndexElement(x))
+	}
+	return res
+}
+
+// CloneRefOfStorageParameter creates a deep clone of the input.
+func CloneRefOfStorageParameter(n *StorageParameter) *StorageParameter {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRoleIdent creates a deep clone of the input.
+func CloneRoleIdent(n RoleIdent) RoleIdent {
+	return *CloneRefOfRoleIdent(&n)
+}
+
+// CloneRefOfRoleIdent creates a deep clone of the input.
+func CloneRefOfRoleIdent(n *RoleIdent) *RoleIdent {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneSliceOfRefOfFunctionArgument creates a deep clone of the input.
+func CloneSliceOfRefOfFunctionArgument(n []*FunctionArgument) []*FunctionArgument {
+	if n == nil {
+		return nil
+	}
+	res := make([]*FunctionArgument, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfFunctionArgument(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfFunctionOption creates a deep clone of the input.
+func CloneSliceOfRefOfFunctionOption(n []*FunctionOption) []*FunctionOption {
+	if n == nil {
+		return nil
+	}
+	res := make([]*FunctionOption, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfFunctionOption(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfTriggerEvent creates a deep clone of the input.
+func CloneSliceOfRefOfTriggerEvent(n []*TriggerEvent) []*TriggerEvent {
+	if n == nil {
+		return nil
+	}
+	res := make([]*TriggerEvent, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfTriggerEvent(x))
+	}
+	return res
+}
+
+// CloneSliceOfStatement creates a deep clone of the input.
+func CloneSliceOfStatement(n []Statement) []Statement {
+	if n == nil {
+		return nil
+	}
+	res := make([]Statement, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneStatement(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfDomainConstraint creates a deep clone of the input.
+func CloneSliceOfRefOfDomainConstraint(n []*DomainConstraint) []*DomainConstraint {
+	if n == nil {
+		return nil
+	}
+	res := make([]*DomainConstraint, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfDomainConstraint(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfGrantPrivilege creates a deep clone of the input.
+func CloneSliceOfRefOfGrantPrivilege(n []*GrantPrivilege) []*GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	res := make([]*GrantPrivilege, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfGrantPrivilege(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfPrivilegeObject creates a deep clone of the input.
+func CloneSliceOfRefOfPrivilegeObject(n []*PrivilegeObject) []*PrivilegeObject {
+	if n == nil {
+		return nil
+	}
+	res := make([]*PrivilegeObject, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfPrivilegeObject(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfRoleName creates a deep clone of the input.
+func CloneSliceOfRefOfRoleName(n []*RoleName) []*RoleName {
+	if n == nil {
+		return nil
+	}
+	res := make([]*RoleName, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfRoleName(x))
+	}
+	return res
+}
+
+// CloneSliceOfTableIdent creates a deep clone of the input.
+func CloneSliceOfTableIdent(n []TableIdent) []TableIdent {
+	if n == nil {
+		return nil
+	}
+	res := make([]TableIdent, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneTableIdent(x))
+	}
+	return res
+}
+
+// CloneSliceOfRefOfPublicationTable creates a deep clone of the input.
+func CloneSliceOfRefOfPublicationTable(n []*PublicationTable) []*PublicationTable {
+	if n == nil {
+		return nil
+	}
+	res := make([]*PublicationTable, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfPublicationTable(x))
+	}
+	return res
+}
@@ -86,6 +86,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterColumn(a, b)
+	case *AlterDefaultPrivileges:
+		b, ok := inB.(*AlterDefaultPrivileges)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterDefaultPrivileges(a, b)
+	case *AlterFunction:
+		b, ok := inB.(*AlterFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterFunction(a, b)
+	case *AlterIndexPartition:
+		b, ok := inB.(*AlterIndexPartition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterIndexPartition(a, b)
+	case *AlterObjectOwner:
+		b, ok := inB.(*AlterObjectOwner)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterObjectOwner(a, b)
 	case *AlterOwner:
 		b, ok := inB.(*AlterOwner)
 		if !ok {
@@ -110,12 +134,24 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterMigration(a, b)
+	case *AlterPublication:
+		b, ok := inB.(*AlterPublication)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterPublication(a, b)
 	case *AlterSchema:
 		b, ok := inB.(*AlterSchema)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAlterSchema(a, b)
+	case *AlterSequence:
+		b, ok := inB.(*AlterSequence)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterSequence(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
@@ -236,6 +272,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsColumns(a, b)
+	case *CommentOn:
+		b, ok := inB.(*CommentOn)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommentOn(a, b)
+	case *CommentOnSchema:
+		b, ok := inB.(*CommentOnSchema)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommentOnSchema(a, b)
 	case *Commit:
 		b, ok := inB.(*Commit)
 		if !ok {
@@ -284,12 +332,72 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCreateDatabase(a, b)
+	case *CreateDomain:
+		b, ok := inB.(*CreateDomain)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateDomain(a, b)
+	case *CreateExtension:
+		b, ok := inB.(*CreateExtension)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateExtension(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateFunction(a, b)
+	case *CreateIndex:
+		b, ok := inB.(*CreateIndex)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateIndex(a, b)
+	case *CreateMaterializedView:
+		b, ok := inB.(*CreateMaterializedView)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateMaterializedView(a, b)
+	case *CreatePolicy:
+		b, ok := inB.(*CreatePolicy)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreatePolicy(a, b)
+	case *CreatePublication:
+		b, ok := inB.(*CreatePublication)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreatePublication(a, b)
+	case *CreateRule:
+		b, ok := inB.(*CreateRule)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateRule(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateTrigger(a, b)
+	case *CreateType:
+		b, ok := inB.(*CreateType)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateType(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
@@ -302,6 +410,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfCreateSequence(a, b)
+	case *CreateVirtualTable:
+		b, ok := inB.(*CreateVirtualTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateVirtualTable(a, b)
 	case *CurTimeFuncExpr:
 		b, ok := inB.(*CurTimeFuncExpr)
 		if !ok {
@@ -338,6 +452,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfDerivedTable(a, b)
+	case *DomainConstraint:
+		b, ok := inB.(*DomainConstraint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDomainConstraint(a, b)
 	case *DropColumn:
 		b, ok := inB.(*DropColumn)
 		if !ok {
@@ -350,6 +470,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfDropDatabase(a, b)
+	case *DropFunction:
+		b, ok := inB.(*DropFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropFunction(a, b)
 	case *DropKey:
 		b, ok := inB.(*DropKey)
 		if !ok {
@@ -434,6 +560,36 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfFuncExpr(a, b)
+	case *FunctionArgument:
+		b, ok := inB.(*FunctionArgument)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFunctionArgument(a, b)
+	case *FunctionOption:
+		b, ok := inB.(*FunctionOption)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFunctionOption(a, b)
+	case *FunctionReturns:
+		b, ok := inB.(*FunctionReturns)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFunctionReturns(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrant(a, b)
+	case *GrantPrivilege:
+		b, ok := inB.(*GrantPrivilege)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrantPrivilege(a, b)
 	case GroupBy:
 		b, ok := inB.(GroupBy)
 		if !ok {
@@ -452,6 +608,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfIndexDefinition(a, b)
+	case *IndexElement:
+		b, ok := inB.(*IndexElement)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfIndexElement(a, b)
 	case *IndexHint:
 		b, ok := inB.(*IndexHint)
 		if !ok {
@@ -848,12 +1010,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsPartitions(a, b)
+	case *Pragma:
+		b, ok := inB.(*Pragma)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPragma(a, b)
 	case *PrepareStmt:
 		b, ok := inB.(*PrepareStmt)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfPrepareStmt(a, b)
+	case *PrivilegeObject:
+		b, ok := inB.(*PrivilegeObject)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPrivilegeObject(a, b)
+	case *PublicationTable:
+		b, ok := inB.(*PublicationTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPublicationTable(a, b)
 	case ReferenceAction:
 		b, ok := inB.(ReferenceAction)
 		if !ok {
@@ -866,6 +1046,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfReferenceDefinition(a, b)
+	case *RefreshMaterializedView:
+		b, ok := inB.(*RefreshMaterializedView)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRefreshMaterializedView(a, b)
 	case *Release:
 		b, ok := inB.(*Release)
 		if !ok {
@@ -896,6 +1082,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRevoke(a, b)
+	case *RoleName:
+		b, ok := inB.(*RoleName)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRoleName(a, b)
 	case *Rollback:
 		b, ok := inB.(*Rollback)
 		if !ok {
@@ -908,6 +1106,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRootNode(a, b)
+	case *RowLevelSecurity:
+		b, ok := inB.(*RowLevelSecurity)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRowLevelSecurity(a, b)
 	case *SRollback:
 		b, ok := inB.(*SRollback)
 		if !ok {
@@ -1010,6 +1214,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfStarExpr(a, b)
+	case StorageParameters:
+		b, ok := inB.(StorageParameters)
+		if !ok {
+			return false
+		}
+		return EqualsStorageParameters(a, b)
 	case *Stream:
 		b, ok := inB.(*Stream)
 		if !ok {
@@ -1106,6 +1316,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfTimestampFuncExpr(a, b)
+	case *TriggerEvent:
+		b, ok := inB.(*TriggerEvent)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTriggerEvent(a, b)
 	case *TrimFuncExpr:
 		b, ok := inB.(*TrimFuncExpr)
 		if !ok {
@@ -1118,6 +1334,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfTruncateTable(a, b)
+	case *TypeCastExpr:
+		b, ok := inB.(*TypeCastExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTypeCastExpr(a, b)
 	case *UnaryExpr:
 		b, ok := inB.(*UnaryExpr)
 		if !ok {
@@ -1324,6 +1546,62 @@ func EqualsRefOfAlterCheck(a, b *AlterCheck) bool {
 		EqualsColIdent(a.Name, b.Name)
 }
 
+// EqualsRefOfAlterDefaultPrivileges does deep equals between the two objects.
+func EqualsRefOfAlterDefaultPrivileges(a, b *AlterDefaultPrivileges) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSliceOfRefOfRoleName(a.Roles, b.Roles) &&
+		EqualsSliceOfTableIdent(a.Schemas, b.Schemas) &&
+		EqualsStatement(a.Action, b.Action) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfAlterFunction does deep equals between the two objects.
+func EqualsRefOfAlterFunction(a, b *AlterFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Procedure == b.Procedure &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfFunctionArgument(a.Arguments, b.Arguments) &&
+		EqualsSliceOfAlterOption(a.AlterOptions, b.AlterOptions) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfAlterIndexPartition does deep equals between the two objects.
+func EqualsRefOfAlterIndexPartition(a, b *AlterIndexPartition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableName(a.Name, b.Name) &&
+		EqualsTableName(a.AttachPartition, b.AttachPartition) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfAlterObjectOwner does deep equals between the two objects.
+func EqualsRefOfAlterObjectOwner(a, b *AlterObjectOwner) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ObjectType == b.ObjectType &&
+		EqualsTableName(a.Object, b.Object) &&
+		EqualsRefOfRoleName(a.Owner, b.Owner) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
 // EqualsRefOfAlterColumn does deep equals between the two objects.
 func EqualsRefOfAlterOwner(a, b *AlterOwner) bool {
 	if a == b {
@@ -1345,8 +1623,10 @@ func EqualsRefOfAlterColumn(a, b *AlterColumn) bool {
 	}
 	return a.DropDefault == b.DropDefault &&
 		EqualsRefOfColName(a.Column, b.Column) &&
+		a.Identity == b.Identity &&
 		EqualsExpr(a.DefaultVal, b.DefaultVal) &&
-		EqualsRefOfBool(a.Invisible, b.Invisible)
+		EqualsRefOfBool(a.Invisible, b.Invisible) &&
+		EqualsRefOfSequenceSpec(a.IdentitySequence, b.IdentitySequence)
 }
 
 // EqualsRefOfAlterDatabase does deep equals between the two objects.
@@ -1389,6 +1669,21 @@ func EqualsRefOfAlterMigration(a, b *AlterMigration) bool {
 		EqualsRefOfLiteral(a.Ratio, b.Ratio)
 }
 
+// EqualsRefOfAlterPublication does deep equals between the two objects.
+func EqualsRefOfAlterPublication(a, b *AlterPublication) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Action == b.Action &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsSliceOfRefOfPublicationTable(a.Tables, b.Tables) &&
+		EqualsSliceOfTableIdent(a.Schemas, b.Schemas) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
 // EqualsRefOfAlterSchema does deep equals between the two objects.
 func EqualsRefOfAlterSchema(a, b *AlterSchema) bool {
 	if a == b {
@@ -1402,6 +1697,21 @@ func EqualsRefOfAlterSchema(a, b *AlterSchema) bool {
 		EqualsRefOfParsedComments(a.Comments, b.Comments)
 }
 
+// EqualsRefOfAlterSequence does deep equals between the two objects.
+func EqualsRefOfAlterSequence(a, b *AlterSequence) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.FullyParsed == b.FullyParsed &&
+		EqualsSequenceName(a.Sequence, b.Sequence) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments) &&
+		EqualsRefOfSequenceSpec(a.SequenceSpec, b.SequenceSpec) &&
+		EqualsSliceOfAlterOption(a.AlterOptions, b.AlterOptions)
+}
+
 // EqualsRefOfAlterTable does deep equals between the two objects.
 func EqualsRefOfAlterTable(a, b *AlterTable) bool {
 	if a == b {
@@ -1583,6 +1893,417 @@ func EqualsRefOfColName(a, b *ColName) bool {
 		EqualsTableName(a.Qualifier, b.Qualifier)
 }
 
+// EqualsRefOfCommentOn does deep equals between the two objects.
+func EqualsRefOfCommentOn(a, b *CommentOn) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ObjectType == b.ObjectType &&
+		EqualsTableName(a.Object, b.Object) &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsSliceOfRefOfFunctionArgument(a.Arguments, b.Arguments) &&
+		EqualsExpr(a.Value, b.Value) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCommentOnSchema does deep equals between the two objects.
+func EqualsRefOfCommentOnSchema(a, b *CommentOnSchema) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsSchemaIdent(a.Schema, b.Schema) &&
+		EqualsExpr(a.Value, b.Value) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateDomain does deep equals between the two objects.
+func EqualsRefOfCreateDomain(a, b *CreateDomain) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Collate == b.Collate &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsRefOfColumnType(a.Type, b.Type) &&
+		EqualsExpr(a.Default, b.Default) &&
+		EqualsSliceOfRefOfDomainConstraint(a.Constraints, b.Constraints) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateExtension does deep equals between the two objects.
+func EqualsRefOfCreateExtension(a, b *CreateExtension) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		a.Version == b.Version &&
+		a.Cascade == b.Cascade &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsTableIdent(a.Schema, b.Schema) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateFunction does deep equals between the two objects.
+func EqualsRefOfCreateFunction(a, b *CreateFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Procedure == b.Procedure &&
+		a.IsReplace == b.IsReplace &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfFunctionArgument(a.Arguments, b.Arguments) &&
+		EqualsRefOfFunctionReturns(a.Returns, b.Returns) &&
+		EqualsSliceOfRefOfFunctionOption(a.Options, b.Options) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateIndex does deep equals between the two objects.
+func EqualsRefOfCreateIndex(a, b *CreateIndex) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unique == b.Unique &&
+		a.Concurrently == b.Concurrently &&
+		a.IfNotExists == b.IfNotExists &&
+		a.Only == b.Only &&
+		a.FullyParsed == b.FullyParsed &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsColIdent(a.Method, b.Method) &&
+		EqualsSliceOfRefOfIndexElement(a.Columns, b.Columns) &&
+		EqualsColumns(a.Include, b.Include) &&
+		EqualsStorageParameters(a.Parameters, b.Parameters) &&
+		EqualsTableIdent(a.Tablespace, b.Tablespace) &&
+		EqualsRefOfWhere(a.Where, b.Where) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateMaterializedView does deep equals between the two objects.
+func EqualsRefOfCreateMaterializedView(a, b *CreateMaterializedView) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		a.WithData == b.WithData &&
+		EqualsTableName(a.ViewName, b.ViewName) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsColIdent(a.Method, b.Method) &&
+		EqualsStorageParameters(a.Parameters, b.Parameters) &&
+		EqualsTableIdent(a.Tablespace, b.Tablespace) &&
+		EqualsSelectStatement(a.Select, b.Select) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreatePolicy does deep equals between the two objects.
+func EqualsRefOfCreatePolicy(a, b *CreatePolicy) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Restrictive == b.Restrictive &&
+		a.Command == b.Command &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsSliceOfRefOfRoleName(a.Roles, b.Roles) &&
+		EqualsExpr(a.Using, b.Using) &&
+		EqualsExpr(a.WithCheck, b.WithCheck) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreatePublication does deep equals between the two objects.
+func EqualsRefOfCreatePublication(a, b *CreatePublication) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.AllTables == b.AllTables &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsSliceOfRefOfPublicationTable(a.Tables, b.Tables) &&
+		EqualsSliceOfTableIdent(a.Schemas, b.Schemas) &&
+		EqualsStorageParameters(a.Parameters, b.Parameters) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateRule does deep equals between the two objects.
+func EqualsRefOfCreateRule(a, b *CreateRule) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsReplace == b.IsReplace &&
+		a.Event == b.Event &&
+		a.Instead == b.Instead &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsExpr(a.Where, b.Where) &&
+		EqualsSliceOfStatement(a.Actions, b.Actions) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateTrigger does deep equals between the two objects.
+func EqualsRefOfCreateTrigger(a, b *CreateTrigger) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsReplace == b.IsReplace &&
+		a.Temp == b.Temp &&
+		a.IfNotExists == b.IfNotExists &&
+		a.Timing == b.Timing &&
+		a.ForEachRow == b.ForEachRow &&
+		a.Procedure == b.Procedure &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsSliceOfRefOfTriggerEvent(a.Events, b.Events) &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsExpr(a.When, b.When) &&
+		EqualsTableName(a.Function, b.Function) &&
+		EqualsExprs(a.Arguments, b.Arguments) &&
+		EqualsSliceOfStatement(a.Body, b.Body) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateType does deep equals between the two objects.
+func EqualsRefOfCreateType(a, b *CreateType) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Kind == b.Kind &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfString(a.Labels, b.Labels) &&
+		EqualsSliceOfRefOfColumnDefinition(a.Attributes, b.Attributes) &&
+		EqualsStorageParameters(a.Parameters, b.Parameters) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfCreateVirtualTable does deep equals between the two objects.
+func EqualsRefOfCreateVirtualTable(a, b *CreateVirtualTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfNotExists == b.IfNotExists &&
+		a.Arguments == b.Arguments &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsColIdent(a.Module, b.Module) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfDomainConstraint does deep equals between the two objects.
+func EqualsRefOfDomainConstraint(a, b *DomainConstraint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfBool(a.Null, b.Null) &&
+		EqualsExpr(a.Check, b.Check)
+}
+
+// EqualsRefOfDropFunction does deep equals between the two objects.
+func EqualsRefOfDropFunction(a, b *DropFunction) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Procedure == b.Procedure &&
+		a.IfExists == b.IfExists &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfFunctionArgument does deep equals between the two objects.
+func EqualsRefOfFunctionArgument(a, b *FunctionArgument) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Mode == b.Mode &&
+		EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfColumnType(a.Type, b.Type) &&
+		EqualsExpr(a.Default, b.Default)
+}
+
+// EqualsRefOfFunctionOption does deep equals between the two objects.
+func EqualsRefOfFunctionOption(a, b *FunctionOption) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		a.Name == b.Name &&
+		a.Value == b.Value
+}
+
+// EqualsRefOfFunctionReturns does deep equals between the two objects.
+func EqualsRefOfFunctionReturns(a, b *FunctionReturns) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.SetOf == b.SetOf &&
+		EqualsRefOfColumnType(a.Type, b.Type) &&
+		EqualsSliceOfRefOfFunctionArgument(a.Table, b.Table)
+}
+
+// EqualsRefOfGrant does deep equals between the two objects.
+func EqualsRefOfGrant(a, b *Grant) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ObjectType == b.ObjectType &&
+		a.AllInSchema == b.AllInSchema &&
+		a.WithGrantOption == b.WithGrantOption &&
+		EqualsSliceOfRefOfGrantPrivilege(a.Privileges, b.Privileges) &&
+		EqualsSliceOfRefOfPrivilegeObject(a.Objects, b.Objects) &&
+		EqualsSliceOfRefOfRoleName(a.Grantees, b.Grantees) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfGrantPrivilege does deep equals between the two objects.
+func EqualsRefOfGrantPrivilege(a, b *GrantPrivilege) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsColumns(a.Columns, b.Columns)
+}
+
+// EqualsRefOfIndexElement does deep equals between the two objects.
+func EqualsRefOfIndexElement(a, b *IndexElement) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Collate == b.Collate &&
+		a.Direction == b.Direction &&
+		a.Nulls == b.Nulls &&
+		EqualsColIdent(a.Column, b.Column) &&
+		EqualsExpr(a.Expression, b.Expression) &&
+		EqualsTableName(a.OpClass, b.OpClass) &&
+		EqualsStorageParameters(a.OpClassParameters, b.OpClassParameters)
+}
+
+// EqualsRefOfPragma does deep equals between the two objects.
+func EqualsRefOfPragma(a, b *Pragma) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Value == b.Value &&
+		EqualsTableName(a.Name, b.Name)
+}
+
+// EqualsRefOfPrivilegeObject does deep equals between the two objects.
+func EqualsRefOfPrivilegeObject(a, b *PrivilegeObject) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsTableName(a.Name, b.Name) &&
+		EqualsSliceOfRefOfFunctionArgument(a.Arguments, b.Arguments)
+}
+
+// EqualsRefOfPublicationTable does deep equals between the two objects.
+func EqualsRefOfPublicationTable(a, b *PublicationTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Only == b.Only &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsExpr(a.Where, b.Where)
+}
+
+// EqualsRefOfRefreshMaterializedView does deep equals between the two objects.
+func EqualsRefOfRefreshMaterializedView(a, b *RefreshMaterializedView) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Concurrently == b.Concurrently &&
+		a.WithData == b.WithData &&
+		EqualsTableName(a.ViewName, b.ViewName) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
+// EqualsRefOfRevoke does deep equals between the two objects.
+func EqualsRefOfRevoke(a, b *Revoke) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.GrantOptionFor == b.GrantOptionFor &&
+		a.ObjectType == b.ObjectType &&
+		a.AllInSchema == b.AllInSchema &&
+		a.Cascade == b.Cascade &&
+		EqualsSliceOfRefOfGrantPrivilege(a.Privileges, b.Privileges) &&
+		EqualsSliceOfRefOfPrivilegeObject(a.Objects, b.Objects) &&
+		EqualsSliceOfRefOfRoleName(a.Grantees, b.Grantees) &&
+		EqualsRefOfParsedComments(a.Comments, b.Comments)
+}
+
 // EqualsRefOfColName does deep equals between the two objects.
 func EqualsRefOfRoleName(a, b *RoleName) bool {
 	if a == b {
@@ -1629,11 +2350,13 @@ func EqualsRefOfColumnType(a, b *ColumnType) bool {
 	return a.Type == b.Type &&
 		a.Unsigned == b.Unsigned &&
 		a.Zerofill == b.Zerofill &&
+		a.TimeZone == b.TimeZone &&
 		EqualsRefOfColumnTypeOptions(a.Options, b.Options) &&
 		EqualsRefOfLiteral(a.Length, b.Length) &&
 		EqualsRefOfLiteral(a.Scale, b.Scale) &&
 		EqualsColumnCharset(a.Charset, b.Charset) &&
-		EqualsSliceOfString(a.EnumValues, b.EnumValues)
+		EqualsSliceOfString(a.EnumValues, b.EnumValues) &&
+		EqualsSliceOfString(a.ArrayBounds, b.ArrayBounds)
 }
 
 // EqualsColumns does deep equals between the two objects.
@@ -2871,7 +3594,8 @@ func EqualsRefOfPartitionSpec(a, b *PartitionSpec) bool {
 		EqualsPartitions(a.Names, b.Names) &&
 		EqualsRefOfLiteral(a.Number, b.Number) &&
 		EqualsTableName(a.TableName, b.TableName) &&
-		EqualsSliceOfRefOfPartitionDefinition(a.Definitions, b.Definitions)
+		EqualsSliceOfRefOfPartitionDefinition(a.Definitions, b.Definitions) &&
+		EqualsRefOfPartitionValueRange(a.Bound, b.Bound)
 }
 
 // EqualsRefOfPartitionValueRange does deep equals between the two objects.
@@ -2884,7 +3608,8 @@ func EqualsRefOfPartitionValueRange(a, b *PartitionValueRange) bool {
 	}
 	return a.Maxvalue == b.Maxvalue &&
 		a.Type == b.Type &&
-		EqualsValTuple(a.Range, b.Range)
+		EqualsValTuple(a.Range, b.Range) &&
+		EqualsValTuple(a.To, b.To)
 }
 
 // EqualsPartitions does deep equals between the two objects.
@@ -3001,6 +3726,17 @@ func EqualsRootNode(a, b RootNode) bool {
 	return EqualsSQLNode(a.SQLNode, b.SQLNode)
 }
 
+// EqualsRefOfRowLevelSecurity does deep equals between the two objects.
+func EqualsRefOfRowLevelSecurity(a, b *RowLevelSecurity) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Action == b.Action
+}
+
 // EqualsRefOfSRollback does deep equals between the two objects.
 func EqualsRefOfSRollback(a, b *SRollback) bool {
 	if a == b {
@@ -3225,6 +3961,19 @@ func EqualsRefOfStarExpr(a, b *StarExpr) bool {
 	return EqualsTableName(a.TableName, b.TableName)
 }
 
+// EqualsStorageParameters does deep equals between the two objects.
+func EqualsStorageParameters(a, b StorageParameters) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfStorageParameter(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfStream does deep equals between the two objects.
 func EqualsRefOfStream(a, b *Stream) bool {
 	if a == b {
@@ -3403,7 +4152,9 @@ func EqualsRefOfTableSpec(a, b *TableSpec) bool {
 		EqualsSliceOfRefOfIndexDefinition(a.Indexes, b.Indexes) &&
 		EqualsSliceOfRefOfConstraintDefinition(a.Constraints, b.Constraints) &&
 		EqualsTableOptions(a.Options, b.Options) &&
-		EqualsRefOfPartitionOption(a.PartitionOption, b.PartitionOption)
+		EqualsRefOfPartitionOption(a.PartitionOption, b.PartitionOption) &&
+		EqualsTableName(a.PartitionOf, b.PartitionOf) &&
+		EqualsRefOfPartitionValueRange(a.PartitionBound, b.PartitionBound)
 }
 
 // EqualsRefOfTablespaceOperation does deep equals between the two objects.
@@ -3425,9 +4176,14 @@ func EqualsRefOfSequenceSpec(a, b *SequenceSpec) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return EqualsIntReferences(a.StartWith, b.StartWith) &&
+	return a.DataType == b.DataType &&
+		EqualsTableName(a.Name, b.Name) &&
+		EqualsIntReferences(a.StartWith, b.StartWith) &&
 		EqualsIntReferences(a.IncrementBy, b.IncrementBy) &&
+		EqualsIntReferences(a.MinValue, b.MinValue) &&
+		EqualsIntReferences(a.MaxValue, b.MaxValue) &&
 		EqualsIntReferences(a.Cache, b.Cache) &&
+		EqualsRefOfBool(a.Cycle, b.Cycle) &&
 		a.NoMinValue == b.NoMinValue &&
 		a.NoMaxValue == b.NoMaxValue
 }
@@ -3446,6 +4202,18 @@ func EqualsRefOfTimestampFuncExpr(a, b *TimestampFuncExpr) bool {
 		EqualsExpr(a.Expr2, b.Expr2)
 }
 
+// EqualsRefOfTriggerEvent does deep equals between the two objects.
+func EqualsRefOfTriggerEvent(a, b *TriggerEvent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsColumns(a.Columns, b.Columns)
+}
+
 // EqualsRefOfTrimFuncExpr does deep equals between the two objects.
 func EqualsRefOfTrimFuncExpr(a, b *TrimFuncExpr) bool {
 	if a == b {
@@ -3471,6 +4239,18 @@ func EqualsRefOfTruncateTable(a, b *TruncateTable) bool {
 	return EqualsTableName(a.Table, b.Table)
 }
 
+// EqualsRefOfTypeCastExpr does deep equals between the two objects.
+func EqualsRefOfTypeCastExpr(a, b *TypeCastExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExpr(a.Expr, b.Expr) &&
+		EqualsRefOfColumnType(a.Type, b.Type)
+}
+
 // EqualsRefOfUnaryExpr does deep equals between the two objects.
 func EqualsRefOfUnaryExpr(a, b *UnaryExpr) bool {
 	if a == b {
@@ -3781,6 +4561,12 @@ func EqualsAlterOption(inA, inB AlterOption) bool {
 			return false
 		}
 		return EqualsRefOfAlterIndex(a, b)
+	case *AlterOwner:
+		b, ok := inB.(*AlterOwner)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterOwner(a, b)
 	case *ChangeColumn:
 		b, ok := inB.(*ChangeColumn)
 		if !ok {
@@ -3841,6 +4627,12 @@ func EqualsAlterOption(inA, inB AlterOption) bool {
 			return false
 		}
 		return EqualsRefOfRenameTableName(a, b)
+	case *RowLevelSecurity:
+		b, ok := inB.(*RowLevelSecurity)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRowLevelSecurity(a, b)
 	case TableOptions:
 		b, ok := inB.(TableOptions)
 		if !ok {
@@ -4219,6 +5011,12 @@ func EqualsDDLStatement(inA, inB DDLStatement) bool {
 			return false
 		}
 		return EqualsRefOfAlterView(a, b)
+	case *CreateIndex:
+		b, ok := inB.(*CreateIndex)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateIndex(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
@@ -4615,6 +5413,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfTrimFuncExpr(a, b)
+	case *TypeCastExpr:
+		b, ok := inB.(*TypeCastExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfTypeCastExpr(a, b)
 	case *UnaryExpr:
 		b, ok := inB.(*UnaryExpr)
 		if !ok {
@@ -5182,12 +5986,48 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfAlterDatabase(a, b)
+	case *AlterDefaultPrivileges:
+		b, ok := inB.(*AlterDefaultPrivileges)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterDefaultPrivileges(a, b)
+	case *AlterFunction:
+		b, ok := inB.(*AlterFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterFunction(a, b)
+	case *AlterIndexPartition:
+		b, ok := inB.(*AlterIndexPartition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterIndexPartition(a, b)
 	case *AlterMigration:
 		b, ok := inB.(*AlterMigration)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfAlterMigration(a, b)
+	case *AlterObjectOwner:
+		b, ok := inB.(*AlterObjectOwner)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterObjectOwner(a, b)
+	case *AlterPublication:
+		b, ok := inB.(*AlterPublication)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterPublication(a, b)
+	case *AlterSequence:
+		b, ok := inB.(*AlterSequence)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAlterSequence(a, b)
 	case *AlterTable:
 		b, ok := inB.(*AlterTable)
 		if !ok {
@@ -5218,6 +6058,18 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfCallProc(a, b)
+	case *CommentOn:
+		b, ok := inB.(*CommentOn)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommentOn(a, b)
+	case *CommentOnSchema:
+		b, ok := inB.(*CommentOnSchema)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCommentOnSchema(a, b)
 	case *Commit:
 		b, ok := inB.(*Commit)
 		if !ok {
@@ -5230,18 +6082,84 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfCreateDatabase(a, b)
+	case *CreateDomain:
+		b, ok := inB.(*CreateDomain)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateDomain(a, b)
+	case *CreateExtension:
+		b, ok := inB.(*CreateExtension)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateExtension(a, b)
+	case *CreateFunction:
+		b, ok := inB.(*CreateFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateFunction(a, b)
+	case *CreateIndex:
+		b, ok := inB.(*CreateIndex)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateIndex(a, b)
+	case *CreateMaterializedView:
+		b, ok := inB.(*CreateMaterializedView)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateMaterializedView(a, b)
+	case *CreatePolicy:
+		b, ok := inB.(*CreatePolicy)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreatePolicy(a, b)
+	case *CreatePublication:
+		b, ok := inB.(*CreatePublication)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreatePublication(a, b)
+	case *CreateRule:
+		b, ok := inB.(*CreateRule)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateRule(a, b)
 	case *CreateTable:
 		b, ok := inB.(*CreateTable)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateTable(a, b)
+	case *CreateTrigger:
+		b, ok := inB.(*CreateTrigger)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateTrigger(a, b)
+	case *CreateType:
+		b, ok := inB.(*CreateType)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateType(a, b)
 	case *CreateView:
 		b, ok := inB.(*CreateView)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfCreateView(a, b)
+	case *CreateVirtualTable:
+		b, ok := inB.(*CreateVirtualTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfCreateVirtualTable(a, b)
 	case *DeallocateStmt:
 		b, ok := inB.(*DeallocateStmt)
 		if !ok {
@@ -5260,6 +6178,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfDropDatabase(a, b)
+	case *DropFunction:
+		b, ok := inB.(*DropFunction)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfDropFunction(a, b)
 	case *DropTable:
 		b, ok := inB.(*DropTable)
 		if !ok {
@@ -5296,6 +6220,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfFlush(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfGrant(a, b)
 	case *Insert:
 		b, ok := inB.(*Insert)
 		if !ok {
@@ -5326,12 +6256,24 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *Pragma:
+		b, ok := inB.(*Pragma)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfPragma(a, b)
 	case *PrepareStmt:
 		b, ok := inB.(*PrepareStmt)
 		if !ok {
 			return false
 		}
 		return EqualsRefOfPrepareStmt(a, b)
+	case *RefreshMaterializedView:
+		b, ok := inB.(*RefreshMaterializedView)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRefreshMaterializedView(a, b)
 	case *Release:
 		b, ok := inB.(*Release)
 		if !ok {
@@ -5350,6 +6292,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfRevertMigration(a, b)
+	case *Revoke:
+		b, ok := inB.(*Revoke)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfRevoke(a, b)
 	case *Rollback:
 		b, ok := inB.(*Rollback)
 		if !ok {
@@ -5585,11 +6533,13 @@ func EqualsColumnType(a, b ColumnType) bool {
 	return a.Type == b.Type &&
 		a.Unsigned == b.Unsigned &&
 		a.Zerofill == b.Zerofill &&
+		a.TimeZone == b.TimeZone &&
 		EqualsRefOfColumnTypeOptions(a.Options, b.Options) &&
 		EqualsRefOfLiteral(a.Length, b.Length) &&
 		EqualsRefOfLiteral(a.Scale, b.Scale) &&
 		EqualsColumnCharset(a.Charset, b.Charset) &&
-		EqualsSliceOfString(a.EnumValues, b.EnumValues)
+		EqualsSliceOfString(a.EnumValues, b.EnumValues) &&
+		EqualsSliceOfString(a.ArrayBounds, b.ArrayBounds)
 }
 
 // EqualsRefOfColumnTypeOptions does deep equals between the two objects.
@@ -5601,11 +6551,14 @@ func EqualsRefOfColumnTypeOptions(a, b *ColumnTypeOptions) bool {
 		return false
 	}
 	return a.Autoincrement == b.Autoincrement &&
+		a.GeneratedAlways == b.GeneratedAlways &&
+		a.Identity == b.Identity &&
 		a.Collate == b.Collate &&
 		EqualsRefOfBool(a.Null, b.Null) &&
 		EqualsExpr(a.Default, b.Default) &&
 		EqualsExpr(a.OnUpdate, b.OnUpdate) &&
 		EqualsExpr(a.As, b.As) &&
+		EqualsRefOfSequenceSpec(a.IdentitySequence, b.IdentitySequence) &&
 		EqualsRefOfLiteral(a.Comment, b.Comment) &&
 		a.Storage == b.Storage &&
 		EqualsRefOfReferenceDefinition(a.Reference, b.Reference) &&
@@ -6056,3 +7009,174 @@ func EqualsRefOfDatabaseOption(a, b *DatabaseOption) bool {
 		a.Value == b.Value &&
 		a.Type == b.Type
 }
+
+// EqualsSliceOfRefOfIndexElement does deep equals between the two objects.
+func EqualsSliceOfRefOfIndexElement(a, b []*IndexElement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfIndexElement(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfStorageParameter does deep equals between the two objects.
+func EqualsRefOfStorageParameter(a, b *StorageParameter) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		a.Value == b.Value
+}
+
+// EqualsRoleIdent does deep equals between the two objects.
+func EqualsRoleIdent(a, b RoleIdent) bool {
+	return EqualsRefOfRoleIdent(&a, &b)
+}
+
+// EqualsRefOfRoleIdent does deep equals between the two objects.
+func EqualsRefOfRoleIdent(a, b *RoleIdent) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.V == b.V
+}
+
+// EqualsSliceOfRefOfFunctionArgument does deep equals between the two objects.
+func EqualsSliceOfRefOfFunctionArgument(a, b []*FunctionArgument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfFunctionArgument(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfFunctionOption does deep equals between the two objects.
+func EqualsSliceOfRefOfFunctionOption(a, b []*FunctionOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfFunctionOption(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfTriggerEvent does deep equals between the two objects.
+func EqualsSliceOfRefOfTriggerEvent(a, b []*TriggerEvent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfTriggerEvent(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfStatement does deep equals between the two objects.
+func EqualsSliceOfStatement(a, b []Statement) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsStatement(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfDomainConstraint does deep equals between the two objects.
+func EqualsSliceOfRefOfDomainConstraint(a, b []*DomainConstraint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfDomainConstraint(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfGrantPrivilege does deep equals between the two objects.
+func EqualsSliceOfRefOfGrantPrivilege(a, b []*GrantPrivilege) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfGrantPrivilege(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfPrivilegeObject does deep equals between the two objects.
+func EqualsSliceOfRefOfPrivilegeObject(a, b []*PrivilegeObject) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfPrivilegeObject(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfRoleName does deep equals between the two objects.
+func EqualsSliceOfRefOfRoleName(a, b []*RoleName) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfRoleName(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfTableIdent does deep equals between the two objects.
+func EqualsSliceOfTableIdent(a, b []TableIdent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsTableIdent(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsSliceOfRefOfPublicationTable does deep equals between the two objects.
+func EqualsSliceOfRefOfPublicationTable(a, b []*PublicationTable) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfPublicationTable(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
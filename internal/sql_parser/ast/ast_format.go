@@ -19,6 +19,7 @@ package ast
 import (
 	"strings"
 
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
 	"github.com/usalko/prodl/internal/sql_types"
 )
 
@@ -46,12 +47,15 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		buf.literal(SQLCalcFoundRowsStr)
 	}
 
-	buf.astPrintf(node, "%v from ", node.SelectExprs)
+	buf.astPrintf(node, "%v", node.SelectExprs)
 
-	prefix := ""
-	for _, expr := range node.From {
-		buf.astPrintf(node, "%s%v", prefix, expr)
-		prefix = ", "
+	// The SELECT without FROM has no DUAL table outside MySQL
+	if buf.Dialect() == dialect.MYSQL || !isDual(node.From) {
+		prefix := " from "
+		for _, expr := range node.From {
+			buf.astPrintf(node, "%s%v", prefix, expr)
+			prefix = ", "
+		}
 	}
 
 	buf.astPrintf(node, "%v%v%v%v%v%s%v",
@@ -381,6 +385,15 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 		if node.WithoutValidation {
 			buf.literal(" without validation")
 		}
+	case AttachAction:
+		buf.astPrintf(node, "%s %v", AttachPartitionStr, node.TableName)
+		if node.Bound == nil {
+			buf.literal(" default")
+		} else {
+			buf.astPrintf(node, " for %v", node.Bound)
+		}
+	case DetachAction:
+		buf.astPrintf(node, "%s %v", DetachPartitionStr, node.TableName)
 	case AnalyzeAction:
 		buf.astPrintf(node, "%s ", AnalyzePartitionStr)
 		if node.IsAll {
@@ -541,9 +554,14 @@ func (node *PartitionValueRange) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "values %s", node.Type.ToString())
 	if node.Maxvalue {
 		buf.literal(" maxvalue")
+	} else if node.Type == ModulusType {
+		buf.astPrintf(node, " (modulus %v, remainder %v)", node.Range[0], node.Range[1])
 	} else {
 		buf.astPrintf(node, " %v", node.Range)
 	}
+	if node.To != nil {
+		buf.astPrintf(node, " to %v", node.To)
+	}
 }
 
 // Format formats the node
@@ -562,16 +580,18 @@ func (node *PartitionOption) Format(buf *TrackedBuffer) {
 		buf.literal(" linear")
 	}
 
-	switch node.Type {
-	case HashType:
+	switch key, isTuple := node.Expr.(ValTuple); {
+	case isTuple:
+		buf.astPrintf(node, " %s %v", node.Type.ToString(), key)
+	case node.Type == HashType:
 		buf.astPrintf(node, " hash (%v)", node.Expr)
-	case KeyType:
+	case node.Type == KeyType:
 		buf.literal(" key")
 		if node.KeyAlgorithm != 0 {
 			buf.astPrintf(node, " algorithm = %d", node.KeyAlgorithm)
 		}
 		buf.astPrintf(node, " %v", node.ColList)
-	case RangeType, ListType:
+	case node.Type == RangeType, node.Type == ListType:
 		buf.astPrintf(node, " %s", node.Type.ToString())
 		if node.Expr != nil {
 			buf.astPrintf(node, " (%v)", node.Expr)
@@ -623,6 +643,18 @@ func (node *SubPartition) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (ts *TableSpec) Format(buf *TrackedBuffer) {
+	if !ts.PartitionOf.IsEmpty() {
+		buf.astPrintf(ts, "partition of %v", ts.PartitionOf)
+		if ts.PartitionBound == nil {
+			buf.literal(" default")
+		} else {
+			buf.astPrintf(ts, " for %v", ts.PartitionBound)
+		}
+		if ts.PartitionOption != nil {
+			buf.astPrintf(ts, "%v", ts.PartitionOption)
+		}
+		return
+	}
 	buf.astPrintf(ts, "(\n")
 	for i, col := range ts.Columns {
 		if i == 0 {
@@ -652,7 +684,7 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 			}
 		} else if opt.Value != nil {
 			buf.astPrintf(ts, " %v", opt.Value)
-		} else {
+		} else if opt.Tables != nil {
 			buf.astPrintf(ts, " (%v)", opt.Tables)
 		}
 	}
@@ -663,6 +695,11 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (col *ColumnDefinition) Format(buf *TrackedBuffer) {
+	// SQLite allows columns without a type, the options then follow the name
+	if col.Type.Type == "" {
+		buf.astPrintf(col, "%v%v", col.Name, &col.Type)
+		return
+	}
 	buf.astPrintf(col, "%v %v", col.Name, &col.Type)
 }
 
@@ -676,6 +713,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	} else if ct.Length != nil {
 		buf.astPrintf(ct, "(%v)", ct.Length)
 	}
+	if ct.TimeZone != "" {
+		buf.astPrintf(ct, " %s", ct.TimeZone)
+	}
 
 	if ct.EnumValues != nil {
 		buf.WriteString("(")
@@ -687,6 +727,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 		}
 		buf.WriteString(")")
 	}
+	for _, bound := range ct.ArrayBounds {
+		buf.astPrintf(ct, "[%s]", bound)
+	}
 
 	if ct.Unsigned {
 		buf.astPrintf(ct, " %#s", "unsigned")
@@ -713,7 +756,8 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 		}
 		if ct.Options.Default != nil {
 			buf.astPrintf(ct, " %s", "default")
-			if defaultRequiresParens(ct) {
+			// The literal defaults of the text columns need the parentheses in MySQL only
+			if defaultRequiresParens(ct) && (buf.Dialect() == dialect.MYSQL || !isExprLiteral(ct.Options.Default)) {
 				buf.astPrintf(ct, " (%v)", ct.Options.Default)
 			} else {
 				buf.astPrintf(ct, " %v", ct.Options.Default)
@@ -722,7 +766,16 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 		if ct.Options.OnUpdate != nil {
 			buf.astPrintf(ct, " %s %s %v", "on", "update", ct.Options.OnUpdate)
 		}
+		if ct.Options.Identity != "" {
+			buf.astPrintf(ct, " %s %s %s %s", "generated", ct.Options.Identity, "as", "identity")
+			if !ct.Options.IdentitySequence.IsEmpty() {
+				buf.astPrintf(ct, " (%v)", ct.Options.IdentitySequence)
+			}
+		}
 		if ct.Options.As != nil {
+			if ct.Options.GeneratedAlways {
+				buf.astPrintf(ct, " %s %s", "generated", "always")
+			}
 			buf.astPrintf(ct, " %s (%v)", "as", ct.Options.As)
 
 			if ct.Options.Storage == VirtualStorage {
@@ -927,20 +980,47 @@ func (c *CheckConstraintDefinition) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (ts *SequenceSpec) Format(buf *TrackedBuffer) {
-	if ts.StartWith != nil {
-		buf.astPrintf(ts, "start with %d ", *ts.StartWith)
+	sep := ""
+	if !ts.Name.IsEmpty() {
+		buf.astPrintf(ts, "sequence name %v", ts.Name)
+		sep = " "
 	}
-	if ts.IncrementBy != nil {
-		buf.astPrintf(ts, "increment by %d ", *ts.IncrementBy)
+	if ts.DataType != "" {
+		buf.astPrintf(ts, "%sas %#s", sep, ts.DataType)
+		sep = " "
 	}
-	if ts.NoMinValue {
-		buf.astPrintf(ts, "no minvalue ")
+	if ts.StartWith != nil {
+		buf.astPrintf(ts, "%sstart with %d", sep, *ts.StartWith)
+		sep = " "
 	}
-	if ts.NoMaxValue {
-		buf.astPrintf(ts, "no maxvalue")
+	if ts.IncrementBy != nil {
+		buf.astPrintf(ts, "%sincrement by %d", sep, *ts.IncrementBy)
+		sep = " "
+	}
+	if ts.MinValue != nil {
+		buf.astPrintf(ts, "%sminvalue %d", sep, *ts.MinValue)
+		sep = " "
+	} else if ts.NoMinValue {
+		buf.astPrintf(ts, "%sno minvalue", sep)
+		sep = " "
+	}
+	if ts.MaxValue != nil {
+		buf.astPrintf(ts, "%smaxvalue %d", sep, *ts.MaxValue)
+		sep = " "
+	} else if ts.NoMaxValue {
+		buf.astPrintf(ts, "%sno maxvalue", sep)
+		sep = " "
 	}
 	if ts.Cache != nil {
-		buf.astPrintf(ts, "cache %d ", *ts.Cache)
+		buf.astPrintf(ts, "%scache %d", sep, *ts.Cache)
+		sep = " "
+	}
+	if ts.Cycle != nil {
+		if *ts.Cycle {
+			buf.astPrintf(ts, "%scycle", sep)
+		} else {
+			buf.astPrintf(ts, "%sno cycle", sep)
+		}
 	}
 }
 
@@ -1294,7 +1374,11 @@ func (node *ExistsExpr) Format(buf *TrackedBuffer) {
 func (node *Literal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sql_types.MakeTrusted(sql_types.VarBinary, node.Bytes()).EncodeSQL(buf)
+		if buf.Dialect() == dialect.MYSQL {
+			sql_types.MakeTrusted(sql_types.VarBinary, node.Bytes()).EncodeSQL(buf)
+		} else {
+			writeStandardString(buf, node.Val)
+		}
 	case IntVal, FloatVal, DecimalVal, HexNum:
 		buf.astPrintf(node, "%s", node.Val)
 	case HexVal:
@@ -1421,6 +1505,9 @@ func (node *WeightStringFuncExpr) Format(buf *TrackedBuffer) {
 func (node *CurTimeFuncExpr) Format(buf *TrackedBuffer) {
 	if node.Fsp != nil {
 		buf.astPrintf(node, "%s(%v)", node.Name.String(), node.Fsp)
+	} else if buf.Dialect() != dialect.MYSQL {
+		// The standard datetime value functions are called without parentheses
+		buf.astPrintf(node, "%s", node.Name.String())
 	} else {
 		buf.astPrintf(node, "%s()", node.Name.String())
 	}
@@ -1431,6 +1518,15 @@ func (node *CollateExpr) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%v collate %#s", node.Expr, node.Collation)
 }
 
+// Format formats the node.
+func (node *TypeCastExpr) Format(buf *TrackedBuffer) {
+	if buf.Dialect() == dialect.PSQL {
+		buf.astPrintf(node, "%l::%v", node.Expr, node.Type)
+	} else {
+		buf.astPrintf(node, "cast(%v as %v)", node.Expr, node.Type)
+	}
+}
+
 // Format formats the node.
 func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	var distinct string
@@ -1833,16 +1929,640 @@ func (node *CreateView) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *CreateMaterializedView) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vmaterialized view ", node.Comments)
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v%v", node.ViewName, node.Columns)
+	if !node.Method.IsEmpty() {
+		buf.astPrintf(node, " using %v", node.Method)
+	}
+	if node.Parameters != nil {
+		buf.astPrintf(node, " with %v", node.Parameters)
+	}
+	if !node.Tablespace.IsEmpty() {
+		buf.astPrintf(node, " tablespace %v", node.Tablespace)
+	}
+	buf.astPrintf(node, " as %v", node.Select)
+	if !node.WithData {
+		buf.literal(" with no data")
+	}
+}
+
+// Format formats the node.
+func (node *AlterIndexPartition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %vindex %v %s %v", node.Comments, node.Name, AttachPartitionStr, node.AttachPartition)
+}
+
+// Format formats the node.
+func (node *CreateVirtualTable) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vvirtual table ", node.Comments)
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v using %v", node.Table, node.Module)
+	if node.Arguments != "" {
+		buf.astPrintf(node, "(%s)", node.Arguments)
+	}
+}
+
+// Format formats the node.
+func (node *Pragma) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "pragma %v", node.Name)
+	if node.Value != "" {
+		buf.astPrintf(node, " = %s", node.Value)
+	}
+}
+
+// Format formats the node.
+func (node *RefreshMaterializedView) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "refresh %vmaterialized view ", node.Comments)
+	if node.Concurrently {
+		buf.literal("concurrently ")
+	}
+	buf.astPrintf(node, "%v", node.ViewName)
+	if !node.WithData {
+		buf.literal(" with no data")
+	}
+}
+
+// Format formats the node.
+func (node *CreateIndex) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.Unique {
+		buf.literal("unique ")
+	}
+	buf.literal("index ")
+	if node.Concurrently {
+		buf.literal("concurrently ")
+	}
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v ", node.Name)
+	}
+	buf.literal("on ")
+	if node.Only {
+		buf.literal("only ")
+	}
+	buf.astPrintf(node, "%v", node.Table)
+	if !node.Method.IsEmpty() {
+		buf.astPrintf(node, " using %v", node.Method)
+	}
+	buf.literal(" (")
+	for i, element := range node.Columns {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", element)
+	}
+	buf.literal(")")
+	if node.Include != nil {
+		buf.astPrintf(node, " include %v", node.Include)
+	}
+	if node.Parameters != nil {
+		buf.astPrintf(node, " with %v", node.Parameters)
+	}
+	if !node.Tablespace.IsEmpty() {
+		buf.astPrintf(node, " tablespace %v", node.Tablespace)
+	}
+	buf.astPrintf(node, "%v", node.Where)
+}
+
+// Format formats the node.
+func (node *IndexElement) Format(buf *TrackedBuffer) {
+	switch node.Expression.(type) {
+	case nil:
+		buf.astPrintf(node, "%v", node.Column)
+	case *FuncExpr:
+		buf.astPrintf(node, "%v", node.Expression)
+	default:
+		buf.astPrintf(node, "(%v)", node.Expression)
+	}
+	if node.Collate != "" {
+		buf.astPrintf(node, " collate %s", node.Collate)
+	}
+	if !node.OpClass.IsEmpty() {
+		buf.astPrintf(node, " %v", node.OpClass)
+		if node.OpClassParameters != nil {
+			buf.astPrintf(node, " %v", node.OpClassParameters)
+		}
+	}
+	if node.Direction == DescOrder {
+		buf.literal(" desc")
+	}
+	if node.Nulls != "" {
+		buf.astPrintf(node, " %s", node.Nulls)
+	}
+}
+
+// Format formats the node.
+func (node StorageParameters) Format(buf *TrackedBuffer) {
+	prefix := "("
+	for _, parameter := range node {
+		buf.astPrintf(node, "%s%s", prefix, parameter.Name)
+		if parameter.Value != "" {
+			buf.astPrintf(node, " = %s", parameter.Value)
+		}
+		prefix = ", "
+	}
+	buf.literal(")")
+}
+
+// Format formats the node.
+func (node *CreateFunction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.IsReplace {
+		buf.literal("or replace ")
+	}
+	if node.Procedure {
+		buf.literal("procedure ")
+	} else {
+		buf.literal("function ")
+	}
+	buf.astPrintf(node, "%v", node.Name)
+	formatFunctionArguments(buf, node, node.Arguments)
+	if node.Returns != nil {
+		buf.astPrintf(node, " %v", node.Returns)
+	}
+	for _, option := range node.Options {
+		buf.astPrintf(node, " %v", option)
+	}
+}
+
+// formatFunctionArguments formats the parenthesized argument list
+func formatFunctionArguments(buf *TrackedBuffer, node SQLNode, arguments []*FunctionArgument) {
+	buf.literal("(")
+	for i, argument := range arguments {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", argument)
+	}
+	buf.literal(")")
+}
+
+// Format formats the node.
+func (node *FunctionArgument) Format(buf *TrackedBuffer) {
+	if node.Mode != FunctionArgumentDefault {
+		buf.astPrintf(node, "%s ", node.Mode.ToString())
+	}
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v ", node.Name)
+	}
+	buf.astPrintf(node, "%v", node.Type)
+	if node.Default != nil {
+		buf.astPrintf(node, " default %v", node.Default)
+	}
+}
+
+// Format formats the node.
+func (node *FunctionReturns) Format(buf *TrackedBuffer) {
+	buf.literal("returns ")
+	if node.Table != nil {
+		buf.literal("table ")
+		formatFunctionArguments(buf, node, node.Table)
+		return
+	}
+	if node.SetOf {
+		buf.literal("setof ")
+	}
+	buf.astPrintf(node, "%v", node.Type)
+}
+
+// Format formats the node.
+func (node *FunctionOption) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case FunctionOptionWindow:
+		buf.literal(FunctionOptionWindowStr)
+	case FunctionOptionVolatility, FunctionOptionLeakproof, FunctionOptionNullInput:
+		buf.astPrintf(node, "%s", node.Value)
+	case FunctionOptionSet:
+		if node.Value == "" {
+			buf.astPrintf(node, "set %s from current", node.Name)
+		} else {
+			buf.astPrintf(node, "set %s to %s", node.Name, node.Value)
+		}
+	default:
+		buf.astPrintf(node, "%s %s", node.Type.ToString(), node.Value)
+	}
+}
+
+// Format formats the node.
+func (node *AlterFunction) Format(buf *TrackedBuffer) {
+	if node.Procedure {
+		buf.astPrintf(node, "alter %vprocedure %v", node.Comments, node.Name)
+	} else {
+		buf.astPrintf(node, "alter %vfunction %v", node.Comments, node.Name)
+	}
+	if node.Arguments != nil {
+		formatFunctionArguments(buf, node, node.Arguments)
+	}
+	for i, option := range node.AlterOptions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.astPrintf(node, " %v", option)
+	}
+}
+
+// Format formats the node.
+func (node *DropFunction) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "drop %v", node.Comments)
+	if node.Procedure {
+		buf.literal("procedure")
+	} else {
+		buf.literal("function")
+	}
+	if node.IfExists {
+		buf.literal(" if exists")
+	}
+	buf.astPrintf(node, " %v", node.Name)
+}
+
+// Format formats the node.
+func (node *CreateTrigger) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.IsReplace {
+		buf.literal("or replace ")
+	}
+	if node.Temp {
+		buf.literal("temp ")
+	}
+	buf.literal("trigger ")
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v ", node.Name)
+	if node.Timing != "" {
+		buf.astPrintf(node, "%s ", node.Timing)
+	}
+	for i, event := range node.Events {
+		if i != 0 {
+			buf.literal(" or ")
+		}
+		buf.astPrintf(node, "%v", event)
+	}
+	buf.astPrintf(node, " on %v", node.Table)
+	if node.ForEachRow {
+		buf.literal(" for each row")
+	}
+	if node.When != nil {
+		buf.astPrintf(node, " when (%v)", node.When)
+	}
+	if node.Body != nil {
+		buf.literal(" begin ")
+		for _, statement := range node.Body {
+			buf.astPrintf(node, "%v; ", statement)
+		}
+		buf.literal("end")
+		return
+	}
+	if node.Procedure {
+		buf.literal(" execute procedure ")
+	} else {
+		buf.literal(" execute function ")
+	}
+	buf.astPrintf(node, "%v(%v)", node.Function, node.Arguments)
+}
+
+// Format formats the node.
+func (node *TriggerEvent) Format(buf *TrackedBuffer) {
+	buf.literal(node.Type)
+	for i, column := range node.Columns {
+		if i == 0 {
+			buf.literal(" of ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", column)
+	}
+}
+
+// Format formats the node.
+func (node *CreateExtension) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vextension ", node.Comments)
+	if node.IfNotExists {
+		buf.literal("if not exists ")
+	}
+	buf.astPrintf(node, "%v", node.Name)
+	if !node.Schema.IsEmpty() {
+		buf.astPrintf(node, " schema %v", node.Schema)
+	}
+	if node.Version != "" {
+		buf.astPrintf(node, " version %s", node.Version)
+	}
+	if node.Cascade {
+		buf.literal(" cascade")
+	}
+}
+
+// Format formats the node.
+func (node *CreateType) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vtype %v as ", node.Comments, node.Name)
+	switch node.Kind {
+	case TypeEnumStr:
+		buf.literal("enum (")
+		for i, label := range node.Labels {
+			if i != 0 {
+				buf.literal(", ")
+			}
+			buf.astPrintf(node, "%s", label)
+		}
+		buf.literal(")")
+	case TypeCompositeStr:
+		buf.literal("(")
+		for i, attribute := range node.Attributes {
+			if i != 0 {
+				buf.literal(", ")
+			}
+			buf.astPrintf(node, "%v", attribute)
+		}
+		buf.literal(")")
+	case TypeRangeStr:
+		buf.astPrintf(node, "range %v", node.Parameters)
+	}
+}
+
+// Format formats the node.
+func (node *CreateDomain) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vdomain %v as %v", node.Comments, node.Name, node.Type)
+	if node.Collate != "" {
+		buf.astPrintf(node, " collate %s", node.Collate)
+	}
+	if node.Default != nil {
+		buf.astPrintf(node, " default %v", node.Default)
+	}
+	for _, constraint := range node.Constraints {
+		buf.astPrintf(node, " %v", constraint)
+	}
+}
+
+// Format formats the node.
+func (node *DomainConstraint) Format(buf *TrackedBuffer) {
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "constraint %v ", node.Name)
+	}
+	switch {
+	case node.Check != nil:
+		buf.astPrintf(node, "check (%v)", node.Check)
+	case *node.Null:
+		buf.literal("null")
+	default:
+		buf.literal("not null")
+	}
+}
+
+// Format formats the node.
+func (node *AlterObjectOwner) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %v%s %v owner to %v", node.Comments, node.ObjectType, node.Object, node.Owner)
+}
+
+// Format formats the node.
+func (node *CommentOn) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "comment %von %s ", node.Comments, node.ObjectType)
+	switch node.ObjectType {
+	case ColumnObjectStr:
+		if !node.Object.IsEmpty() {
+			buf.astPrintf(node, "%v.", node.Object)
+		}
+		buf.astPrintf(node, "%v", node.Name)
+	case ConstraintObjectStr, TriggerObjectStr, PolicyObjectStr, RuleObjectStr:
+		buf.astPrintf(node, "%v on %v", node.Name, node.Object)
+	default:
+		buf.astPrintf(node, "%v", node.Object)
+		if node.Arguments != nil {
+			formatFunctionArguments(buf, node, node.Arguments)
+		}
+	}
+	buf.astPrintf(node, " is %v", node.Value)
+}
+
+// Format formats the node.
+func (node *Grant) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "grant %v", node.Comments)
+	formatPrivileges(buf, node, node.Privileges, node.ObjectType, node.AllInSchema, node.Objects)
+	buf.literal(" to ")
+	formatRoleNames(buf, node, node.Grantees)
+	if node.WithGrantOption {
+		buf.literal(" with grant option")
+	}
+}
+
+// Format formats the node.
+func (node *Revoke) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "revoke %v", node.Comments)
+	if node.GrantOptionFor {
+		buf.literal("grant option for ")
+	}
+	formatPrivileges(buf, node, node.Privileges, node.ObjectType, node.AllInSchema, node.Objects)
+	buf.literal(" from ")
+	formatRoleNames(buf, node, node.Grantees)
+	if node.Cascade {
+		buf.literal(" cascade")
+	}
+}
+
+func formatPrivileges(buf *TrackedBuffer, node SQLNode, privileges []*GrantPrivilege, objectType string, allInSchema bool, objects []*PrivilegeObject) {
+	for i, privilege := range privileges {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", privilege)
+	}
+	buf.literal(" on ")
+	if allInSchema {
+		buf.astPrintf(node, "all %s in schema ", objectType)
+	} else {
+		buf.literal(objectType)
+		if len(objects) != 0 {
+			buf.literal(" ")
+		}
+	}
+	for i, object := range objects {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", object)
+	}
+}
+
+func formatRoleNames(buf *TrackedBuffer, node SQLNode, roles []*RoleName) {
+	for i, role := range roles {
+		if i != 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", role)
+	}
+}
+
+// Format formats the node.
+func (node *GrantPrivilege) Format(buf *TrackedBuffer) {
+	buf.literal(node.Type)
+	if len(node.Columns) != 0 {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+}
+
+// Format formats the node.
+func (node *PrivilegeObject) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v", node.Name)
+	if node.Arguments != nil {
+		formatFunctionArguments(buf, node, node.Arguments)
+	}
+}
+
+// Format formats the node.
+func (node *AlterDefaultPrivileges) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %vdefault privileges", node.Comments)
+	if len(node.Roles) != 0 {
+		buf.literal(" for role ")
+		formatRoleNames(buf, node, node.Roles)
+	}
+	for i, schema := range node.Schemas {
+		if i == 0 {
+			buf.literal(" in schema ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", schema)
+	}
+	buf.astPrintf(node, " %v", node.Action)
+}
+
+// Format formats the node.
+func (node *CreatePolicy) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vpolicy %v on %v", node.Comments, node.Name, node.Table)
+	if node.Restrictive {
+		buf.literal(" as restrictive")
+	}
+	buf.astPrintf(node, " for %s", node.Command)
+	if len(node.Roles) != 0 {
+		buf.literal(" to ")
+		formatRoleNames(buf, node, node.Roles)
+	}
+	if node.Using != nil {
+		buf.astPrintf(node, " using (%v)", node.Using)
+	}
+	if node.WithCheck != nil {
+		buf.astPrintf(node, " with check (%v)", node.WithCheck)
+	}
+}
+
+// Format formats the node.
+func (node *CreatePublication) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %vpublication %v", node.Comments, node.Name)
+	if node.AllTables {
+		buf.literal(" for all tables")
+	} else if len(node.Tables) != 0 || len(node.Schemas) != 0 {
+		buf.literal(" for ")
+		formatPublicationObjects(buf, node, node.Tables, node.Schemas)
+	}
+	if len(node.Parameters) != 0 {
+		buf.astPrintf(node, " with %v", node.Parameters)
+	}
+}
+
+// Format formats the node.
+func (node *AlterPublication) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "alter %vpublication %v %s ", node.Comments, node.Name, node.Action)
+	formatPublicationObjects(buf, node, node.Tables, node.Schemas)
+}
+
+func formatPublicationObjects(buf *TrackedBuffer, node SQLNode, tables []*PublicationTable, schemas []TableIdent) {
+	for i, table := range tables {
+		if i == 0 {
+			buf.literal("table ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", table)
+	}
+	for i, schema := range schemas {
+		if i == 0 {
+			if len(tables) != 0 {
+				buf.literal(", ")
+			}
+			buf.literal("tables in schema ")
+		} else {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", schema)
+	}
+}
+
+// Format formats the node.
+func (node *PublicationTable) Format(buf *TrackedBuffer) {
+	if node.Only {
+		buf.literal("only ")
+	}
+	buf.astPrintf(node, "%v", node.Table)
+	if len(node.Columns) != 0 {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+	if node.Where != nil {
+		buf.astPrintf(node, " where (%v)", node.Where)
+	}
+}
+
+// Format formats the node.
+func (node *CreateRule) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "create %v", node.Comments)
+	if node.IsReplace {
+		buf.literal("or replace ")
+	}
+	buf.astPrintf(node, "rule %v as on %s to %v", node.Name, node.Event, node.Table)
+	if node.Where != nil {
+		buf.astPrintf(node, " where %v", node.Where)
+	}
+	if node.Instead {
+		buf.literal(" do instead ")
+	} else {
+		buf.literal(" do also ")
+	}
+	switch len(node.Actions) {
+	case 0:
+		buf.literal("nothing")
+	case 1:
+		buf.astPrintf(node, "%v", node.Actions[0])
+	default:
+		for i, action := range node.Actions {
+			if i == 0 {
+				buf.literal("(")
+			} else {
+				buf.literal("; ")
+			}
+			buf.astPrintf(node, "%v", action)
+		}
+		buf.literal(")")
+	}
+}
+
 // Format formats the node.
 func (node *CreateSequence) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "create %v", node.Comments)
 	buf.astPrintf(node, "sequence %v", node.Sequence)
+	if !node.SequenceSpec.IsEmpty() {
+		buf.astPrintf(node, " %v", node.SequenceSpec)
+	}
 }
 
 // Format formats the node.
 func (node *AlterSequence) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "alter %v", node.Comments)
 	buf.astPrintf(node, "sequence %v", node.Sequence)
+	if !node.SequenceSpec.IsEmpty() {
+		buf.astPrintf(node, " %v", node.SequenceSpec)
+	}
+	for i, option := range node.AlterOptions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.astPrintf(node, " %v", option)
+	}
 }
 
 // Format formats the LockTables node.
@@ -1909,7 +2629,11 @@ func (node *DropView) Format(buf *TrackedBuffer) {
 
 // Format formats the AlterTable node.
 func (node *AlterTable) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "alter %vtable %v", node.Comments, node.Table)
+	buf.astPrintf(node, "alter %vtable ", node.Comments)
+	if node.Only && buf.Dialect() == dialect.PSQL {
+		buf.literal("only ")
+	}
+	buf.astPrintf(node, "%v", node.Table)
 	prefix := ""
 	for i, option := range node.AlterOptions {
 		if i != 0 {
@@ -1980,6 +2704,16 @@ func (node *AlterOwner) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "owner to %v", node.Owner)
 }
 
+// Format formats the node
+func (node *RowLevelSecurity) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s row level security", node.Action)
+}
+
+// Format formats the node.
+func (node *RoleName) Format(buf *TrackedBuffer) {
+	buf.literal(node.Name.V)
+}
+
 // Format formats the node
 func (node *AlterColumn) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "alter column %v", node.Column)
@@ -1988,6 +2722,12 @@ func (node *AlterColumn) Format(buf *TrackedBuffer) {
 	} else if node.DefaultVal != nil {
 		buf.astPrintf(node, " set default %v", node.DefaultVal)
 	}
+	if node.Identity != "" {
+		buf.astPrintf(node, " add generated %s as identity", node.Identity)
+		if !node.IdentitySequence.IsEmpty() {
+			buf.astPrintf(node, " (%v)", node.IdentitySequence)
+		}
+	}
 	if node.Invisible != nil {
 		if *node.Invisible {
 			buf.astPrintf(node, " set invisible")
@@ -537,6 +537,20 @@ func (node *PartitionSpec) formatFast(buf *TrackedBuffer) {
 		if node.WithoutValidation {
 			buf.WriteString(" without validation")
 		}
+	case AttachAction:
+		buf.WriteString(AttachPartitionStr)
+		buf.WriteByte(' ')
+		node.TableName.formatFast(buf)
+		if node.Bound == nil {
+			buf.WriteString(" default")
+		} else {
+			buf.WriteString(" for ")
+			node.Bound.formatFast(buf)
+		}
+	case DetachAction:
+		buf.WriteString(DetachPartitionStr)
+		buf.WriteByte(' ')
+		node.TableName.formatFast(buf)
 	case AnalyzeAction:
 		buf.WriteString(AnalyzePartitionStr)
 		buf.WriteByte(' ')
@@ -728,10 +742,20 @@ func (node *PartitionValueRange) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Type.ToString())
 	if node.Maxvalue {
 		buf.WriteString(" maxvalue")
+	} else if node.Type == ModulusType {
+		buf.WriteString(" (modulus ")
+		node.Range[0].formatFast(buf)
+		buf.WriteString(", remainder ")
+		node.Range[1].formatFast(buf)
+		buf.WriteByte(')')
 	} else {
 		buf.WriteByte(' ')
 		node.Range.formatFast(buf)
 	}
+	if node.To != nil {
+		buf.WriteString(" to ")
+		node.To.formatFast(buf)
+	}
 }
 
 // formatFast formats the node
@@ -750,12 +774,17 @@ func (node *PartitionOption) formatFast(buf *TrackedBuffer) {
 		buf.WriteString(" linear")
 	}
 
-	switch node.Type {
-	case HashType:
+	switch key, isTuple := node.Expr.(ValTuple); {
+	case isTuple:
+		buf.WriteByte(' ')
+		buf.WriteString(node.Type.ToString())
+		buf.WriteByte(' ')
+		key.formatFast(buf)
+	case node.Type == HashType:
 		buf.WriteString(" hash (")
 		node.Expr.formatFast(buf)
 		buf.WriteByte(')')
-	case KeyType:
+	case node.Type == KeyType:
 		buf.WriteString(" key")
 		if node.KeyAlgorithm != 0 {
 			buf.WriteString(" algorithm = ")
@@ -763,7 +792,7 @@ func (node *PartitionOption) formatFast(buf *TrackedBuffer) {
 		}
 		buf.WriteByte(' ')
 		node.ColList.formatFast(buf)
-	case RangeType, ListType:
+	case node.Type == RangeType, node.Type == ListType:
 		buf.WriteByte(' ')
 		buf.WriteString(node.Type.ToString())
 		if node.Expr != nil {
@@ -826,6 +855,20 @@ func (node *SubPartition) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
+	if !ts.PartitionOf.IsEmpty() {
+		buf.WriteString("partition of ")
+		ts.PartitionOf.formatFast(buf)
+		if ts.PartitionBound == nil {
+			buf.WriteString(" default")
+		} else {
+			buf.WriteString(" for ")
+			ts.PartitionBound.formatFast(buf)
+		}
+		if ts.PartitionOption != nil {
+			ts.PartitionOption.formatFast(buf)
+		}
+		return
+	}
 	buf.WriteString("(\n")
 	for i, col := range ts.Columns {
 		if i == 0 {
@@ -863,7 +906,7 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 		} else if opt.Value != nil {
 			buf.WriteByte(' ')
 			opt.Value.formatFast(buf)
-		} else {
+		} else if opt.Tables != nil {
 			buf.WriteString(" (")
 			opt.Tables.formatFast(buf)
 			buf.WriteByte(')')
@@ -876,6 +919,12 @@ func (ts *TableSpec) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (col *ColumnDefinition) formatFast(buf *TrackedBuffer) {
+	// SQLite allows columns without a type, the options then follow the name
+	if col.Type.Type == "" {
+		col.Name.formatFast(buf)
+		(&col.Type).formatFast(buf)
+		return
+	}
 	col.Name.formatFast(buf)
 	buf.WriteByte(' ')
 	(&col.Type).formatFast(buf)
@@ -897,6 +946,10 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 		ct.Length.formatFast(buf)
 		buf.WriteByte(')')
 	}
+	if ct.TimeZone != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(ct.TimeZone)
+	}
 
 	if ct.EnumValues != nil {
 		buf.WriteString("(")
@@ -908,6 +961,11 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 		}
 		buf.WriteString(")")
 	}
+	for _, bound := range ct.ArrayBounds {
+		buf.WriteByte('[')
+		buf.WriteString(bound)
+		buf.WriteByte(']')
+	}
 
 	if ct.Unsigned {
 		buf.WriteByte(' ')
@@ -967,7 +1025,28 @@ func (ct *ColumnType) formatFast(buf *TrackedBuffer) {
 			buf.WriteByte(' ')
 			ct.Options.OnUpdate.formatFast(buf)
 		}
+		if ct.Options.Identity != "" {
+			buf.WriteByte(' ')
+			buf.WriteString("generated")
+			buf.WriteByte(' ')
+			buf.WriteString(ct.Options.Identity)
+			buf.WriteByte(' ')
+			buf.WriteString("as")
+			buf.WriteByte(' ')
+			buf.WriteString("identity")
+			if !ct.Options.IdentitySequence.IsEmpty() {
+				buf.WriteString(" (")
+				ct.Options.IdentitySequence.formatFast(buf)
+				buf.WriteByte(')')
+			}
+		}
 		if ct.Options.As != nil {
+			if ct.Options.GeneratedAlways {
+				buf.WriteByte(' ')
+				buf.WriteString("generated")
+				buf.WriteByte(' ')
+				buf.WriteString("always")
+			}
 			buf.WriteByte(' ')
 			buf.WriteString("as")
 			buf.WriteString(" (")
@@ -1264,20 +1343,53 @@ func (node *ShowFilter) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (ss *SequenceSpec) formatFast(buf *TrackedBuffer) {
+	sep := ""
+	if !ss.Name.IsEmpty() {
+		buf.WriteString("sequence name ")
+		ss.Name.formatFast(buf)
+		sep = " "
+	}
+	if ss.DataType != "" {
+		buf.WriteString(sep)
+		buf.WriteString("as ")
+		buf.WriteString(ss.DataType)
+		sep = " "
+	}
 	if ss.StartWith != nil {
-		buf.WriteString(fmt.Sprintf("start with %d ", *ss.StartWith))
+		buf.WriteString(fmt.Sprintf("%sstart with %d", sep, *ss.StartWith))
+		sep = " "
 	}
 	if ss.IncrementBy != nil {
-		buf.WriteString(fmt.Sprintf("increment by %d ", *ss.IncrementBy))
-	}
-	if ss.NoMinValue {
-		buf.WriteString("no minvalue ")
-	}
-	if ss.NoMaxValue {
+		buf.WriteString(fmt.Sprintf("%sincrement by %d", sep, *ss.IncrementBy))
+		sep = " "
+	}
+	if ss.MinValue != nil {
+		buf.WriteString(fmt.Sprintf("%sminvalue %d", sep, *ss.MinValue))
+		sep = " "
+	} else if ss.NoMinValue {
+		buf.WriteString(sep)
+		buf.WriteString("no minvalue")
+		sep = " "
+	}
+	if ss.MaxValue != nil {
+		buf.WriteString(fmt.Sprintf("%smaxvalue %d", sep, *ss.MaxValue))
+		sep = " "
+	} else if ss.NoMaxValue {
+		buf.WriteString(sep)
 		buf.WriteString("no maxvalue")
+		sep = " "
 	}
 	if ss.Cache != nil {
-		buf.WriteString(fmt.Sprintf("cache %d ", *ss.Cache))
+		buf.WriteString(fmt.Sprintf("%scache %d", sep, *ss.Cache))
+		sep = " "
+	}
+	if ss.Cycle != nil {
+		buf.WriteString(sep)
+		if *ss.Cycle {
+			buf.WriteString("cycle")
+		} else {
+			buf.WriteString("no cycle")
+		}
 	}
 }
 
@@ -1883,6 +1995,13 @@ func (node *CollateExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(node.Collation)
 }
 
+// formatFast formats the node.
+func (node *TypeCastExpr) formatFast(buf *TrackedBuffer) {
+	buf.printExpr(node, node.Expr, true)
+	buf.WriteString("::")
+	node.Type.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	var distinct string
@@ -2396,174 +2515,937 @@ func (node *CreateView) formatFast(buf *TrackedBuffer) {
 }
 
 // formatFast formats the node.
-func (node *CreateSequence) formatFast(buf *TrackedBuffer) {
+func (node *CreateMaterializedView) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("create ")
 	node.Comments.formatFast(buf)
-	buf.WriteString("sequence ")
-	node.Sequence.formatFast(buf)
-	node.SequenceSpec.formatFast(buf)
+	buf.WriteString("materialized view ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.ViewName.formatFast(buf)
+	node.Columns.formatFast(buf)
+	if !node.Method.IsEmpty() {
+		buf.WriteString(" using ")
+		node.Method.formatFast(buf)
+	}
+	if node.Parameters != nil {
+		buf.WriteString(" with ")
+		node.Parameters.formatFast(buf)
+	}
+	if !node.Tablespace.IsEmpty() {
+		buf.WriteString(" tablespace ")
+		node.Tablespace.formatFast(buf)
+	}
+	buf.WriteString(" as ")
+	node.Select.formatFast(buf)
+	if !node.WithData {
+		buf.WriteString(" with no data")
+	}
 }
 
 // formatFast formats the node.
-func (node *SequenceName) formatFast(buf *TrackedBuffer) {
-	if !node.Qualifier.IsEmpty() {
-		node.Qualifier.formatFast(buf)
-		buf.WriteByte('.')
-	}
+func (node *AlterIndexPartition) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("index ")
 	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(AttachPartitionStr)
+	buf.WriteByte(' ')
+	node.AttachPartition.formatFast(buf)
 }
 
 // formatFast formats the node.
-func (node *AlterSequence) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter ")
+func (node *CreateVirtualTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
 	node.Comments.formatFast(buf)
-	buf.WriteString("sequence ")
-	node.Sequence.formatFast(buf)
-	node.SequenceSpec.formatFast(buf)
+	buf.WriteString("virtual table ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Table.formatFast(buf)
+	buf.WriteString(" using ")
+	node.Module.formatFast(buf)
+	if node.Arguments != "" {
+		buf.WriteByte('(')
+		buf.WriteString(node.Arguments)
+		buf.WriteByte(')')
+	}
 }
 
-// formatFast formats the LockTables node.
-func (node *LockTables) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("lock tables ")
-	node.Tables[0].Table.formatFast(buf)
-	buf.WriteByte(' ')
-	buf.WriteString(node.Tables[0].Lock.ToString())
-	for i := 1; i < len(node.Tables); i++ {
-		buf.WriteString(", ")
-		node.Tables[i].Table.formatFast(buf)
-		buf.WriteByte(' ')
-		buf.WriteString(node.Tables[i].Lock.ToString())
+// formatFast formats the node.
+func (node *Pragma) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("pragma ")
+	node.Name.formatFast(buf)
+	if node.Value != "" {
+		buf.WriteString(" = ")
+		buf.WriteString(node.Value)
 	}
 }
 
-// formatFast formats the UnlockTables node.
-func (node *UnlockTables) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("unlock tables")
+// formatFast formats the node.
+func (node *RefreshMaterializedView) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("refresh ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("materialized view ")
+	if node.Concurrently {
+		buf.WriteString("concurrently ")
+	}
+	node.ViewName.formatFast(buf)
+	if !node.WithData {
+		buf.WriteString(" with no data")
+	}
 }
 
 // formatFast formats the node.
-func (node *AlterView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter ")
+func (node *CreateIndex) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
 	node.Comments.formatFast(buf)
-	if node.Algorithm != "" {
-		buf.WriteString("algorithm = ")
-		buf.WriteString(node.Algorithm)
-		buf.WriteByte(' ')
+	if node.Unique {
+		buf.WriteString("unique ")
 	}
-	if node.Definer != nil {
-		buf.WriteString("definer = ")
-		node.Definer.formatFast(buf)
-		buf.WriteByte(' ')
+	buf.WriteString("index ")
+	if node.Concurrently {
+		buf.WriteString("concurrently ")
 	}
-	if node.Security != "" {
-		buf.WriteString("sql security ")
-		buf.WriteString(node.Security)
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
 		buf.WriteByte(' ')
 	}
-	buf.WriteString("view ")
-	node.ViewName.formatFast(buf)
-	node.Columns.formatFast(buf)
-	buf.WriteString(" as ")
-	node.Select.formatFast(buf)
-	if node.CheckOption != "" {
+	buf.WriteString("on ")
+	if node.Only {
+		buf.WriteString("only ")
+	}
+	node.Table.formatFast(buf)
+	if !node.Method.IsEmpty() {
+		buf.WriteString(" using ")
+		node.Method.formatFast(buf)
+	}
+	buf.WriteString(" (")
+	for i, element := range node.Columns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		element.formatFast(buf)
+	}
+	buf.WriteByte(')')
+	if node.Include != nil {
+		buf.WriteString(" include ")
+		node.Include.formatFast(buf)
+	}
+	if node.Parameters != nil {
 		buf.WriteString(" with ")
-		buf.WriteString(node.CheckOption)
-		buf.WriteString(" check option")
+		node.Parameters.formatFast(buf)
 	}
-}
-
-func (definer *Definer) formatFast(buf *TrackedBuffer) {
-	buf.WriteString(definer.Name)
-	if definer.Address != "" {
-		buf.WriteByte('@')
-		buf.WriteString(definer.Address)
+	if !node.Tablespace.IsEmpty() {
+		buf.WriteString(" tablespace ")
+		node.Tablespace.formatFast(buf)
 	}
+	node.Where.formatFast(buf)
 }
 
 // formatFast formats the node.
-func (node *DropTable) formatFast(buf *TrackedBuffer) {
-	temp := ""
-	if node.Temp {
-		temp = "temporary "
+func (node *IndexElement) formatFast(buf *TrackedBuffer) {
+	switch node.Expression.(type) {
+	case nil:
+		node.Column.formatFast(buf)
+	case *FuncExpr:
+		node.Expression.formatFast(buf)
+	default:
+		buf.WriteByte('(')
+		node.Expression.formatFast(buf)
+		buf.WriteByte(')')
 	}
-	exists := ""
-	if node.IfExists {
-		exists = " if exists"
+	if node.Collate != "" {
+		buf.WriteString(" collate ")
+		buf.WriteString(node.Collate)
+	}
+	if !node.OpClass.IsEmpty() {
+		buf.WriteByte(' ')
+		node.OpClass.formatFast(buf)
+		if node.OpClassParameters != nil {
+			buf.WriteByte(' ')
+			node.OpClassParameters.formatFast(buf)
+		}
+	}
+	if node.Direction == DescOrder {
+		buf.WriteString(" desc")
+	}
+	if node.Nulls != "" {
+		buf.WriteByte(' ')
+		buf.WriteString(node.Nulls)
 	}
-	buf.WriteString("drop ")
-	node.Comments.formatFast(buf)
-	buf.WriteString(temp)
-	buf.WriteString("table")
-	buf.WriteString(exists)
-	buf.WriteByte(' ')
-	node.FromTables.formatFast(buf)
 }
 
 // formatFast formats the node.
-func (node *DropView) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("drop ")
-	node.Comments.formatFast(buf)
-	exists := ""
-	if node.IfExists {
-		exists = " if exists"
+func (node StorageParameters) formatFast(buf *TrackedBuffer) {
+	prefix := "("
+	for _, parameter := range node {
+		buf.WriteString(prefix)
+		buf.WriteString(parameter.Name)
+		if parameter.Value != "" {
+			buf.WriteString(" = ")
+			buf.WriteString(parameter.Value)
+		}
+		prefix = ", "
 	}
-	buf.WriteString("view")
-	buf.WriteString(exists)
-	buf.WriteByte(' ')
-	node.FromTables.formatFast(buf)
+	buf.WriteByte(')')
 }
 
-// formatFast formats the AlterTable node.
-func (node *AlterTable) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter ")
+// formatFast formats the node.
+func (node *CreateFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
 	node.Comments.formatFast(buf)
-	buf.WriteString("table ")
-	node.Table.formatFast(buf)
-	prefix := ""
-	for i, option := range node.AlterOptions {
-		if i != 0 {
-			buf.WriteByte(',')
-		}
-		buf.WriteByte(' ')
-		option.formatFast(buf)
-		if node.PartitionSpec != nil && node.PartitionSpec.Action != RemoveAction {
-			prefix = ","
-		}
+	if node.IsReplace {
+		buf.WriteString("or replace ")
 	}
-	if node.PartitionSpec != nil {
-		buf.WriteString(prefix)
+	if node.Procedure {
+		buf.WriteString("procedure ")
+	} else {
+		buf.WriteString("function ")
+	}
+	node.Name.formatFast(buf)
+	formatFastFunctionArguments(buf, node.Arguments)
+	if node.Returns != nil {
 		buf.WriteByte(' ')
-		node.PartitionSpec.formatFast(buf)
+		node.Returns.formatFast(buf)
 	}
-	if node.PartitionOption != nil {
-		buf.WriteString(prefix)
+	for _, option := range node.Options {
 		buf.WriteByte(' ')
-		node.PartitionOption.formatFast(buf)
+		option.formatFast(buf)
 	}
 }
 
-// formatFast formats the node.
-func (node *AddConstraintDefinition) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("add ")
-	node.ConstraintDefinition.formatFast(buf)
+// formatFastFunctionArguments formats the parenthesized argument list
+func formatFastFunctionArguments(buf *TrackedBuffer, arguments []*FunctionArgument) {
+	buf.WriteByte('(')
+	for i, argument := range arguments {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		argument.formatFast(buf)
+	}
+	buf.WriteByte(')')
 }
 
-func (node *AlterCheck) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("alter check ")
-	node.Name.formatFast(buf)
-	if node.Enforced {
-		buf.WriteByte(' ')
-		buf.WriteString("enforced")
-	} else {
+// formatFast formats the node.
+func (node *FunctionArgument) formatFast(buf *TrackedBuffer) {
+	if node.Mode != FunctionArgumentDefault {
+		buf.WriteString(node.Mode.ToString())
 		buf.WriteByte(' ')
-		buf.WriteString("not")
+	}
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
 		buf.WriteByte(' ')
-		buf.WriteString("enforced")
+	}
+	node.Type.formatFast(buf)
+	if node.Default != nil {
+		buf.WriteString(" default ")
+		node.Default.formatFast(buf)
 	}
 }
 
 // formatFast formats the node.
-func (node *AddIndexDefinition) formatFast(buf *TrackedBuffer) {
+func (node *FunctionReturns) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("returns ")
+	if node.Table != nil {
+		buf.WriteString("table ")
+		formatFastFunctionArguments(buf, node.Table)
+		return
+	}
+	if node.SetOf {
+		buf.WriteString("setof ")
+	}
+	node.Type.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FunctionOption) formatFast(buf *TrackedBuffer) {
+	switch node.Type {
+	case FunctionOptionWindow:
+		buf.WriteString(FunctionOptionWindowStr)
+	case FunctionOptionVolatility, FunctionOptionLeakproof, FunctionOptionNullInput:
+		buf.WriteString(node.Value)
+	case FunctionOptionSet:
+		buf.WriteString("set ")
+		buf.WriteString(node.Name)
+		if node.Value == "" {
+			buf.WriteString(" from current")
+		} else {
+			buf.WriteString(" to ")
+			buf.WriteString(node.Value)
+		}
+	default:
+		buf.WriteString(node.Type.ToString())
+		buf.WriteByte(' ')
+		buf.WriteString(node.Value)
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	if node.Procedure {
+		buf.WriteString("procedure ")
+	} else {
+		buf.WriteString("function ")
+	}
+	node.Name.formatFast(buf)
+	if node.Arguments != nil {
+		formatFastFunctionArguments(buf, node.Arguments)
+	}
+	for i, option := range node.AlterOptions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte(' ')
+		option.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DropFunction) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	if node.Procedure {
+		buf.WriteString("procedure")
+	} else {
+		buf.WriteString("function")
+	}
+	if node.IfExists {
+		buf.WriteString(" if exists")
+	}
+	buf.WriteByte(' ')
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CreateTrigger) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.IsReplace {
+		buf.WriteString("or replace ")
+	}
+	if node.Temp {
+		buf.WriteString("temp ")
+	}
+	buf.WriteString("trigger ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	if node.Timing != "" {
+		buf.WriteString(node.Timing)
+		buf.WriteByte(' ')
+	}
+	for i, event := range node.Events {
+		if i != 0 {
+			buf.WriteString(" or ")
+		}
+		event.formatFast(buf)
+	}
+	buf.WriteString(" on ")
+	node.Table.formatFast(buf)
+	if node.ForEachRow {
+		buf.WriteString(" for each row")
+	}
+	if node.When != nil {
+		buf.WriteString(" when (")
+		node.When.formatFast(buf)
+		buf.WriteByte(')')
+	}
+	if node.Body != nil {
+		buf.WriteString(" begin ")
+		for _, statement := range node.Body {
+			statement.formatFast(buf)
+			buf.WriteString("; ")
+		}
+		buf.WriteString("end")
+		return
+	}
+	if node.Procedure {
+		buf.WriteString(" execute procedure ")
+	} else {
+		buf.WriteString(" execute function ")
+	}
+	node.Function.formatFast(buf)
+	buf.WriteByte('(')
+	node.Arguments.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *TriggerEvent) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type)
+	for i, column := range node.Columns {
+		if i == 0 {
+			buf.WriteString(" of ")
+		} else {
+			buf.WriteString(", ")
+		}
+		column.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateExtension) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("extension ")
+	if node.IfNotExists {
+		buf.WriteString("if not exists ")
+	}
+	node.Name.formatFast(buf)
+	if !node.Schema.IsEmpty() {
+		buf.WriteString(" schema ")
+		node.Schema.formatFast(buf)
+	}
+	if node.Version != "" {
+		buf.WriteString(" version ")
+		buf.WriteString(node.Version)
+	}
+	if node.Cascade {
+		buf.WriteString(" cascade")
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateType) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("type ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" as ")
+	switch node.Kind {
+	case TypeEnumStr:
+		buf.WriteString("enum (")
+		for i, label := range node.Labels {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(label)
+		}
+		buf.WriteByte(')')
+	case TypeCompositeStr:
+		buf.WriteByte('(')
+		for i, attribute := range node.Attributes {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			attribute.formatFast(buf)
+		}
+		buf.WriteByte(')')
+	case TypeRangeStr:
+		buf.WriteString("range ")
+		node.Parameters.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateDomain) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("domain ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" as ")
+	node.Type.formatFast(buf)
+	if node.Collate != "" {
+		buf.WriteString(" collate ")
+		buf.WriteString(node.Collate)
+	}
+	if node.Default != nil {
+		buf.WriteString(" default ")
+		node.Default.formatFast(buf)
+	}
+	for _, constraint := range node.Constraints {
+		buf.WriteByte(' ')
+		constraint.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *DomainConstraint) formatFast(buf *TrackedBuffer) {
+	if !node.Name.IsEmpty() {
+		buf.WriteString("constraint ")
+		node.Name.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	switch {
+	case node.Check != nil:
+		buf.WriteString("check (")
+		node.Check.formatFast(buf)
+		buf.WriteByte(')')
+	case *node.Null:
+		buf.WriteString("null")
+	default:
+		buf.WriteString("not null")
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterObjectOwner) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString(node.ObjectType)
+	buf.WriteByte(' ')
+	node.Object.formatFast(buf)
+	buf.WriteString(" owner to ")
+	node.Owner.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CommentOn) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("comment ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("on ")
+	buf.WriteString(node.ObjectType)
+	buf.WriteByte(' ')
+	switch node.ObjectType {
+	case ColumnObjectStr:
+		if !node.Object.IsEmpty() {
+			node.Object.formatFast(buf)
+			buf.WriteByte('.')
+		}
+		node.Name.formatFast(buf)
+	case ConstraintObjectStr, TriggerObjectStr, PolicyObjectStr, RuleObjectStr:
+		node.Name.formatFast(buf)
+		buf.WriteString(" on ")
+		node.Object.formatFast(buf)
+	default:
+		node.Object.formatFast(buf)
+		if node.Arguments != nil {
+			formatFastFunctionArguments(buf, node.Arguments)
+		}
+	}
+	buf.WriteString(" is ")
+	node.Value.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *Grant) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("grant ")
+	node.Comments.formatFast(buf)
+	formatFastPrivileges(buf, node.Privileges, node.ObjectType, node.AllInSchema, node.Objects)
+	buf.WriteString(" to ")
+	formatFastRoleNames(buf, node.Grantees)
+	if node.WithGrantOption {
+		buf.WriteString(" with grant option")
+	}
+}
+
+// formatFast formats the node.
+func (node *Revoke) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("revoke ")
+	node.Comments.formatFast(buf)
+	if node.GrantOptionFor {
+		buf.WriteString("grant option for ")
+	}
+	formatFastPrivileges(buf, node.Privileges, node.ObjectType, node.AllInSchema, node.Objects)
+	buf.WriteString(" from ")
+	formatFastRoleNames(buf, node.Grantees)
+	if node.Cascade {
+		buf.WriteString(" cascade")
+	}
+}
+
+func formatFastPrivileges(buf *TrackedBuffer, privileges []*GrantPrivilege, objectType string, allInSchema bool, objects []*PrivilegeObject) {
+	for i, privilege := range privileges {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		privilege.formatFast(buf)
+	}
+	buf.WriteString(" on ")
+	if allInSchema {
+		buf.WriteString("all ")
+		buf.WriteString(objectType)
+		buf.WriteString(" in schema ")
+	} else {
+		buf.WriteString(objectType)
+		if len(objects) != 0 {
+			buf.WriteByte(' ')
+		}
+	}
+	for i, object := range objects {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		object.formatFast(buf)
+	}
+}
+
+func formatFastRoleNames(buf *TrackedBuffer, roles []*RoleName) {
+	for i, role := range roles {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		role.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *GrantPrivilege) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type)
+	if len(node.Columns) != 0 {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *PrivilegeObject) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	if node.Arguments != nil {
+		formatFastFunctionArguments(buf, node.Arguments)
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterDefaultPrivileges) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("default privileges")
+	if len(node.Roles) != 0 {
+		buf.WriteString(" for role ")
+		formatFastRoleNames(buf, node.Roles)
+	}
+	for i, schema := range node.Schemas {
+		if i == 0 {
+			buf.WriteString(" in schema ")
+		} else {
+			buf.WriteString(", ")
+		}
+		schema.formatFast(buf)
+	}
+	buf.WriteByte(' ')
+	node.Action.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *CreatePolicy) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("policy ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" on ")
+	node.Table.formatFast(buf)
+	if node.Restrictive {
+		buf.WriteString(" as restrictive")
+	}
+	buf.WriteString(" for ")
+	buf.WriteString(node.Command)
+	if len(node.Roles) != 0 {
+		buf.WriteString(" to ")
+		formatFastRoleNames(buf, node.Roles)
+	}
+	if node.Using != nil {
+		buf.WriteString(" using (")
+		node.Using.formatFast(buf)
+		buf.WriteByte(')')
+	}
+	if node.WithCheck != nil {
+		buf.WriteString(" with check (")
+		node.WithCheck.formatFast(buf)
+		buf.WriteByte(')')
+	}
+}
+
+// formatFast formats the node.
+func (node *CreatePublication) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("publication ")
+	node.Name.formatFast(buf)
+	if node.AllTables {
+		buf.WriteString(" for all tables")
+	} else if len(node.Tables) != 0 || len(node.Schemas) != 0 {
+		buf.WriteString(" for ")
+		formatFastPublicationObjects(buf, node.Tables, node.Schemas)
+	}
+	if len(node.Parameters) != 0 {
+		buf.WriteString(" with ")
+		node.Parameters.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *AlterPublication) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("publication ")
+	node.Name.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Action)
+	buf.WriteByte(' ')
+	formatFastPublicationObjects(buf, node.Tables, node.Schemas)
+}
+
+func formatFastPublicationObjects(buf *TrackedBuffer, tables []*PublicationTable, schemas []TableIdent) {
+	for i, table := range tables {
+		if i == 0 {
+			buf.WriteString("table ")
+		} else {
+			buf.WriteString(", ")
+		}
+		table.formatFast(buf)
+	}
+	for i, schema := range schemas {
+		if i == 0 {
+			if len(tables) != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("tables in schema ")
+		} else {
+			buf.WriteString(", ")
+		}
+		schema.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *PublicationTable) formatFast(buf *TrackedBuffer) {
+	if node.Only {
+		buf.WriteString("only ")
+	}
+	node.Table.formatFast(buf)
+	if len(node.Columns) != 0 {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+	if node.Where != nil {
+		buf.WriteString(" where (")
+		node.Where.formatFast(buf)
+		buf.WriteByte(')')
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateRule) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	if node.IsReplace {
+		buf.WriteString("or replace ")
+	}
+	buf.WriteString("rule ")
+	node.Name.formatFast(buf)
+	buf.WriteString(" as on ")
+	buf.WriteString(node.Event)
+	buf.WriteString(" to ")
+	node.Table.formatFast(buf)
+	if node.Where != nil {
+		buf.WriteString(" where ")
+		node.Where.formatFast(buf)
+	}
+	if node.Instead {
+		buf.WriteString(" do instead ")
+	} else {
+		buf.WriteString(" do also ")
+	}
+	switch len(node.Actions) {
+	case 0:
+		buf.WriteString("nothing")
+	case 1:
+		node.Actions[0].formatFast(buf)
+	default:
+		for i, action := range node.Actions {
+			if i == 0 {
+				buf.WriteByte('(')
+			} else {
+				buf.WriteString("; ")
+			}
+			action.formatFast(buf)
+		}
+		buf.WriteByte(')')
+	}
+}
+
+// formatFast formats the node.
+func (node *CreateSequence) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("create ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("sequence ")
+	node.Sequence.formatFast(buf)
+	if !node.SequenceSpec.IsEmpty() {
+		buf.WriteByte(' ')
+		node.SequenceSpec.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *SequenceName) formatFast(buf *TrackedBuffer) {
+	if !node.Qualifier.IsEmpty() {
+		node.Qualifier.formatFast(buf)
+		buf.WriteByte('.')
+	}
+	node.Name.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *AlterSequence) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("sequence ")
+	node.Sequence.formatFast(buf)
+	if !node.SequenceSpec.IsEmpty() {
+		buf.WriteByte(' ')
+		node.SequenceSpec.formatFast(buf)
+	}
+	for i, option := range node.AlterOptions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte(' ')
+		option.formatFast(buf)
+	}
+}
+
+// formatFast formats the LockTables node.
+func (node *LockTables) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("lock tables ")
+	node.Tables[0].Table.formatFast(buf)
+	buf.WriteByte(' ')
+	buf.WriteString(node.Tables[0].Lock.ToString())
+	for i := 1; i < len(node.Tables); i++ {
+		buf.WriteString(", ")
+		node.Tables[i].Table.formatFast(buf)
+		buf.WriteByte(' ')
+		buf.WriteString(node.Tables[i].Lock.ToString())
+	}
+}
+
+// formatFast formats the UnlockTables node.
+func (node *UnlockTables) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("unlock tables")
+}
+
+// formatFast formats the node.
+func (node *AlterView) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	if node.Algorithm != "" {
+		buf.WriteString("algorithm = ")
+		buf.WriteString(node.Algorithm)
+		buf.WriteByte(' ')
+	}
+	if node.Definer != nil {
+		buf.WriteString("definer = ")
+		node.Definer.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	if node.Security != "" {
+		buf.WriteString("sql security ")
+		buf.WriteString(node.Security)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("view ")
+	node.ViewName.formatFast(buf)
+	node.Columns.formatFast(buf)
+	buf.WriteString(" as ")
+	node.Select.formatFast(buf)
+	if node.CheckOption != "" {
+		buf.WriteString(" with ")
+		buf.WriteString(node.CheckOption)
+		buf.WriteString(" check option")
+	}
+}
+
+func (definer *Definer) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(definer.Name)
+	if definer.Address != "" {
+		buf.WriteByte('@')
+		buf.WriteString(definer.Address)
+	}
+}
+
+// formatFast formats the node.
+func (node *DropTable) formatFast(buf *TrackedBuffer) {
+	temp := ""
+	if node.Temp {
+		temp = "temporary "
+	}
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	buf.WriteString(temp)
+	buf.WriteString("table")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.FromTables.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *DropView) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("drop ")
+	node.Comments.formatFast(buf)
+	exists := ""
+	if node.IfExists {
+		exists = " if exists"
+	}
+	buf.WriteString("view")
+	buf.WriteString(exists)
+	buf.WriteByte(' ')
+	node.FromTables.formatFast(buf)
+}
+
+// formatFast formats the AlterTable node.
+func (node *AlterTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter ")
+	node.Comments.formatFast(buf)
+	buf.WriteString("table ")
+	node.Table.formatFast(buf)
+	prefix := ""
+	for i, option := range node.AlterOptions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte(' ')
+		option.formatFast(buf)
+		if node.PartitionSpec != nil && node.PartitionSpec.Action != RemoveAction {
+			prefix = ","
+		}
+	}
+	if node.PartitionSpec != nil {
+		buf.WriteString(prefix)
+		buf.WriteByte(' ')
+		node.PartitionSpec.formatFast(buf)
+	}
+	if node.PartitionOption != nil {
+		buf.WriteString(prefix)
+		buf.WriteByte(' ')
+		node.PartitionOption.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *AddConstraintDefinition) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("add ")
+	node.ConstraintDefinition.formatFast(buf)
+}
+
+func (node *AlterCheck) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("alter check ")
+	node.Name.formatFast(buf)
+	if node.Enforced {
+		buf.WriteByte(' ')
+		buf.WriteString("enforced")
+	} else {
+		buf.WriteByte(' ')
+		buf.WriteString("not")
+		buf.WriteByte(' ')
+		buf.WriteString("enforced")
+	}
+}
+
+// formatFast formats the node.
+func (node *AddIndexDefinition) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("add ")
 	node.IndexDefinition.formatFast(buf)
 }
@@ -2607,6 +3489,12 @@ func (node *AlterOwner) formatFast(buf *TrackedBuffer) {
 	node.Owner.formatFast(buf)
 }
 
+// formatFast formats the node
+func (node *RowLevelSecurity) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Action)
+	buf.WriteString(" row level security")
+}
+
 // formatFast formats the node
 func (node *AlterColumn) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("alter column ")
@@ -2617,6 +3505,16 @@ func (node *AlterColumn) formatFast(buf *TrackedBuffer) {
 		buf.WriteString(" set default ")
 		node.DefaultVal.formatFast(buf)
 	}
+	if node.Identity != "" {
+		buf.WriteString(" add generated ")
+		buf.WriteString(node.Identity)
+		buf.WriteString(" as identity")
+		if !node.IdentitySequence.IsEmpty() {
+			buf.WriteString(" (")
+			node.IdentitySequence.formatFast(buf)
+			buf.WriteByte(')')
+		}
+	}
 	if node.Invisible != nil {
 		if *node.Invisible {
 			buf.WriteString(" set invisible")
@@ -71,6 +71,29 @@ type IndexColumn struct {
 	Direction  OrderDirection
 }
 
+// IndexElement describes a column or expression of the PostgreSQL CREATE INDEX statement
+// with optional collation, operator class and ordering
+type IndexElement struct {
+	// Only one of Column or Expression can be specified
+	Column            ColIdent
+	Expression        Expr
+	Collate           string
+	OpClass           TableName
+	OpClassParameters StorageParameters
+	Direction         OrderDirection
+	Nulls             string
+}
+
+// StorageParameter is used for the WITH (name = value) clause of the PostgreSQL statements,
+// the value is kept as sql text
+type StorageParameter struct {
+	Name  string
+	Value string
+}
+
+// StorageParameters is a list of the storage parameters
+type StorageParameters []*StorageParameter
+
 // LengthScaleOption is used for types that have an optional length
 // and scale
 type LengthScaleOption struct {
@@ -406,6 +429,11 @@ func (node TableName) IsEmpty() bool {
 	return node.Name.IsEmpty()
 }
 
+// IsEmpty returns true if the sequence spec is nil or has no options set.
+func (ss *SequenceSpec) IsEmpty() bool {
+	return ss == nil || *ss == SequenceSpec{}
+}
+
 // ToViewName returns a TableName acceptable for use as a VIEW. VIEW names are
 // always lowercase, so ToViewName lowercasese the name. Databases are case-sensitive
 // so Qualifier is left untouched.
@@ -831,8 +859,11 @@ func containEscapableChars(s string, at AtCount) bool {
 }
 
 func formatID(buf *TrackedBuffer, original string, at AtCount) {
-	_, isKeyword := cache.KeywordLookup(original, dialect.MYSQL)
-	if buf.escape || isKeyword || containEscapableChars(original, at) {
+	sqlDialect := buf.Dialect()
+	_, isKeyword := cache.KeywordLookup(original, sqlDialect)
+	if buf.escape || isKeyword || containEscapableChars(original, at) ||
+		// PostgreSQL folds the unquoted identifiers to the lower case
+		(sqlDialect == dialect.PSQL && strings.ToLower(original) != original) {
 		writeEscapedString(buf, original)
 	} else {
 		buf.WriteString(original)
@@ -840,14 +871,44 @@ func formatID(buf *TrackedBuffer, original string, at AtCount) {
 }
 
 func writeEscapedString(buf *TrackedBuffer, original string) {
-	buf.WriteByte('`')
+	quote := '`'
+	if buf.Dialect() != dialect.MYSQL {
+		quote = '"'
+	}
+	buf.WriteRune(quote)
 	for _, c := range original {
 		buf.WriteRune(c)
-		if c == '`' {
-			buf.WriteByte('`')
+		if c == quote {
+			buf.WriteRune(quote)
+		}
+	}
+	buf.WriteRune(quote)
+}
+
+// writeStandardString writes the string literal the SQL standard way (PostgreSQL, SQLite),
+// the quotes are doubled and the backslashes are kept as is
+func writeStandardString(buf *TrackedBuffer, val string) {
+	buf.WriteByte('\'')
+	for i := 0; i < len(val); i++ {
+		buf.WriteByte(val[i])
+		if val[i] == '\'' {
+			buf.WriteByte('\'')
 		}
 	}
-	buf.WriteByte('`')
+	buf.WriteByte('\'')
+}
+
+// isDual returns true if the FROM clause is the MySQL DUAL table of the SELECT without FROM
+func isDual(from TableExprs) bool {
+	if len(from) != 1 {
+		return false
+	}
+	aliased, ok := from[0].(*AliasedTableExpr)
+	if !ok || !aliased.As.IsEmpty() {
+		return false
+	}
+	tableName, ok := aliased.Expr.(TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.String() == "dual"
 }
 
 func compliantName(in string) string {
@@ -1185,6 +1246,14 @@ func (op ComparisonExprOperator) ToString() string {
 		return RegexpStr
 	case NotRegexpOp:
 		return NotRegexpStr
+	case PosixMatchOp:
+		return PosixMatchStr
+	case PosixIMatchOp:
+		return PosixIMatchStr
+	case NotPosixMatchOp:
+		return NotPosixMatchStr
+	case NotPosixIMatchOp:
+		return NotPosixIMatchStr
 	default:
 		return "Unknown ComparisonExpOperator"
 	}
@@ -1239,6 +1308,8 @@ func (op BinaryExprOperator) ToString() string {
 		return JSONExtractOpStr
 	case JSONUnquoteExtractOp:
 		return JSONUnquoteExtractOpStr
+	case ConcatOp:
+		return ConcatStr
 	default:
 		return "Unknown BinaryExprOperator"
 	}
@@ -1267,6 +1338,10 @@ func (t PartitionValueRangeType) ToString() string {
 		return LessThanTypeStr
 	case InType:
 		return InTypeStr
+	case FromToType:
+		return FromToTypeStr
+	case ModulusType:
+		return ModulusTypeStr
 	default:
 		return "Unknown PartitionValueRangeType"
 	}
@@ -1602,6 +1677,86 @@ func (node CopyOptionType) ToString() string {
 	}
 }
 
+// ToString returns the mode as a string
+func (node FunctionArgumentMode) ToString() string {
+	switch node {
+	case FunctionArgumentIn:
+		return FunctionArgumentInStr
+	case FunctionArgumentOut:
+		return FunctionArgumentOutStr
+	case FunctionArgumentInOut:
+		return FunctionArgumentInOutStr
+	case FunctionArgumentVariadic:
+		return FunctionArgumentVariadicStr
+	default:
+		return ""
+	}
+}
+
+// ToString returns the type as a string
+func (node FunctionOptionType) ToString() string {
+	switch node {
+	case FunctionOptionLanguage:
+		return FunctionOptionLanguageStr
+	case FunctionOptionWindow:
+		return FunctionOptionWindowStr
+	case FunctionOptionVolatility:
+		return FunctionOptionVolatilityStr
+	case FunctionOptionLeakproof:
+		return FunctionOptionLeakproofStr
+	case FunctionOptionNullInput:
+		return FunctionOptionNullInputStr
+	case FunctionOptionSecurity:
+		return FunctionOptionSecurityStr
+	case FunctionOptionParallel:
+		return FunctionOptionParallelStr
+	case FunctionOptionCost:
+		return FunctionOptionCostStr
+	case FunctionOptionRows:
+		return FunctionOptionRowsStr
+	case FunctionOptionSet:
+		return FunctionOptionSetStr
+	case FunctionOptionAs:
+		return FunctionOptionAsStr
+	default:
+		return "Unknown Function Option Type"
+	}
+}
+
+// Option returns the first option of the given type or nil
+func (node *CreateFunction) Option(optionType FunctionOptionType) *FunctionOption {
+	for _, option := range node.Options {
+		if option.Type == optionType {
+			return option
+		}
+	}
+	return nil
+}
+
+// Language returns the language of the function body or empty string
+func (node *CreateFunction) Language() string {
+	if option := node.Option(FunctionOptionLanguage); option != nil {
+		return option.Value
+	}
+	return ""
+}
+
+// Body returns the function body with the quotes or empty string
+func (node *CreateFunction) Body() string {
+	if option := node.Option(FunctionOptionAs); option != nil {
+		return option.Value
+	}
+	return ""
+}
+
+// NewRoleName makes a role name, the PUBLIC pseudo-role is always lowercase
+func NewRoleName(name string) *RoleName {
+	if strings.EqualFold(name, PublicRoleStr) {
+		name = PublicRoleStr
+	}
+	return &RoleName{Name: RoleIdent{V: name}}
+}
+
 // ToString returns the type as a string
 func (ty LockType) ToString() string {
 	switch ty {
@@ -44,10 +44,24 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterDefaultPrivileges:
+		return a.rewriteRefOfAlterDefaultPrivileges(parent, node, replacer)
+	case *AlterFunction:
+		return a.rewriteRefOfAlterFunction(parent, node, replacer)
 	case *AlterIndex:
 		return a.rewriteRefOfAlterIndex(parent, node, replacer)
+	case *AlterIndexPartition:
+		return a.rewriteRefOfAlterIndexPartition(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterObjectOwner:
+		return a.rewriteRefOfAlterObjectOwner(parent, node, replacer)
+	case *AlterOwner:
+		return a.rewriteRefOfAlterOwner(parent, node, replacer)
+	case *AlterPublication:
+		return a.rewriteRefOfAlterPublication(parent, node, replacer)
+	case *AlterSequence:
+		return a.rewriteRefOfAlterSequence(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
 	case *AlterView:
@@ -88,6 +102,10 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfColumnType(parent, node, replacer)
 	case Columns:
 		return a.rewriteColumns(parent, node, replacer)
+	case *CommentOn:
+		return a.rewriteRefOfCommentOn(parent, node, replacer)
+	case *CommentOnSchema:
+		return a.rewriteRefOfCommentOnSchema(parent, node, replacer)
 	case *Commit:
 		return a.rewriteRefOfCommit(parent, node, replacer)
 	case *CommonTableExpr:
@@ -104,10 +122,32 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfConvertUsingExpr(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateDomain:
+		return a.rewriteRefOfCreateDomain(parent, node, replacer)
+	case *CreateExtension:
+		return a.rewriteRefOfCreateExtension(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateIndex:
+		return a.rewriteRefOfCreateIndex(parent, node, replacer)
+	case *CreateMaterializedView:
+		return a.rewriteRefOfCreateMaterializedView(parent, node, replacer)
+	case *CreatePolicy:
+		return a.rewriteRefOfCreatePolicy(parent, node, replacer)
+	case *CreatePublication:
+		return a.rewriteRefOfCreatePublication(parent, node, replacer)
+	case *CreateRule:
+		return a.rewriteRefOfCreateRule(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateType:
+		return a.rewriteRefOfCreateType(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
+	case *CreateVirtualTable:
+		return a.rewriteRefOfCreateVirtualTable(parent, node, replacer)
 	case *CurTimeFuncExpr:
 		return a.rewriteRefOfCurTimeFuncExpr(parent, node, replacer)
 	case *DeallocateStmt:
@@ -120,10 +160,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfDelete(parent, node, replacer)
 	case *DerivedTable:
 		return a.rewriteRefOfDerivedTable(parent, node, replacer)
+	case *DomainConstraint:
+		return a.rewriteRefOfDomainConstraint(parent, node, replacer)
 	case *DropColumn:
 		return a.rewriteRefOfDropColumn(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
+	case *DropFunction:
+		return a.rewriteRefOfDropFunction(parent, node, replacer)
 	case *DropKey:
 		return a.rewriteRefOfDropKey(parent, node, replacer)
 	case *DropTable:
@@ -152,12 +196,24 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfForeignKeyDefinition(parent, node, replacer)
 	case *FuncExpr:
 		return a.rewriteRefOfFuncExpr(parent, node, replacer)
+	case *FunctionArgument:
+		return a.rewriteRefOfFunctionArgument(parent, node, replacer)
+	case *FunctionOption:
+		return a.rewriteRefOfFunctionOption(parent, node, replacer)
+	case *FunctionReturns:
+		return a.rewriteRefOfFunctionReturns(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
+	case *GrantPrivilege:
+		return a.rewriteRefOfGrantPrivilege(parent, node, replacer)
 	case GroupBy:
 		return a.rewriteGroupBy(parent, node, replacer)
 	case *GroupConcatExpr:
 		return a.rewriteRefOfGroupConcatExpr(parent, node, replacer)
 	case *IndexDefinition:
 		return a.rewriteRefOfIndexDefinition(parent, node, replacer)
+	case *IndexElement:
+		return a.rewriteRefOfIndexElement(parent, node, replacer)
 	case *IndexHint:
 		return a.rewriteRefOfIndexHint(parent, node, replacer)
 	case IndexHints:
@@ -290,12 +346,20 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfPartitionValueRange(parent, node, replacer)
 	case Partitions:
 		return a.rewritePartitions(parent, node, replacer)
+	case *Pragma:
+		return a.rewriteRefOfPragma(parent, node, replacer)
 	case *PrepareStmt:
 		return a.rewriteRefOfPrepareStmt(parent, node, replacer)
+	case *PrivilegeObject:
+		return a.rewriteRefOfPrivilegeObject(parent, node, replacer)
+	case *PublicationTable:
+		return a.rewriteRefOfPublicationTable(parent, node, replacer)
 	case ReferenceAction:
 		return a.rewriteReferenceAction(parent, node, replacer)
 	case *ReferenceDefinition:
 		return a.rewriteRefOfReferenceDefinition(parent, node, replacer)
+	case *RefreshMaterializedView:
+		return a.rewriteRefOfRefreshMaterializedView(parent, node, replacer)
 	case *Release:
 		return a.rewriteRefOfRelease(parent, node, replacer)
 	case *RenameIndex:
@@ -306,10 +370,16 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfRenameTableName(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
+	case *RoleName:
+		return a.rewriteRefOfRoleName(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
 	case RootNode:
 		return a.rewriteRootNode(parent, node, replacer)
+	case *RowLevelSecurity:
+		return a.rewriteRefOfRowLevelSecurity(parent, node, replacer)
 	case *SRollback:
 		return a.rewriteRefOfSRollback(parent, node, replacer)
 	case *Savepoint:
@@ -320,6 +390,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteSelectExprs(parent, node, replacer)
 	case *SelectInto:
 		return a.rewriteRefOfSelectInto(parent, node, replacer)
+	case *SequenceSpec:
+		return a.rewriteRefOfSequenceSpec(parent, node, replacer)
 	case *Set:
 		return a.rewriteRefOfSet(parent, node, replacer)
 	case *SetExpr:
@@ -344,6 +416,8 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfShowThrottledApps(parent, node, replacer)
 	case *StarExpr:
 		return a.rewriteRefOfStarExpr(parent, node, replacer)
+	case StorageParameters:
+		return a.rewriteStorageParameters(parent, node, replacer)
 	case *Stream:
 		return a.rewriteRefOfStream(parent, node, replacer)
 	case *SubPartition:
@@ -374,10 +448,14 @@ func (a *application) rewriteSQLNode(parent SQLNode, node SQLNode, replacer repl
 		return a.rewriteRefOfTablespaceOperation(parent, node, replacer)
 	case *TimestampFuncExpr:
 		return a.rewriteRefOfTimestampFuncExpr(parent, node, replacer)
+	case *TriggerEvent:
+		return a.rewriteRefOfTriggerEvent(parent, node, replacer)
 	case *TrimFuncExpr:
 		return a.rewriteRefOfTrimFuncExpr(parent, node, replacer)
 	case *TruncateTable:
 		return a.rewriteRefOfTruncateTable(parent, node, replacer)
+	case *TypeCastExpr:
+		return a.rewriteRefOfTypeCastExpr(parent, node, replacer)
 	case *UnaryExpr:
 		return a.rewriteRefOfUnaryExpr(parent, node, replacer)
 	case *Union:
@@ -700,6 +778,106 @@ func (a *application) rewriteRefOfAlterDatabase(parent SQLNode, node *AlterDatab
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterDefaultPrivileges(parent SQLNode, node *AlterDefaultPrivileges, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfRoleName(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterDefaultPrivileges).Roles[idx] = newNode.(*RoleName)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Schemas {
+		if !a.rewriteTableIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterDefaultPrivileges).Schemas[idx] = newNode.(TableIdent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStatement(node, node.Action, func(newNode, parent SQLNode) {
+		parent.(*AlterDefaultPrivileges).Action = newNode.(Statement)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterDefaultPrivileges).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfAlterFunction(parent SQLNode, node *AlterFunction, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterFunction).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Arguments {
+		if !a.rewriteRefOfFunctionArgument(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterFunction).Arguments[idx] = newNode.(*FunctionArgument)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.AlterOptions {
+		if !a.rewriteAlterOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterFunction).AlterOptions[idx] = newNode.(AlterOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterFunction).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterIndex(parent SQLNode, node *AlterIndex, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -727,6 +905,43 @@ func (a *application) rewriteRefOfAlterIndex(parent SQLNode, node *AlterIndex, r
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterIndexPartition(parent SQLNode, node *AlterIndexPartition, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterIndexPartition).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.AttachPartition, func(newNode, parent SQLNode) {
+		parent.(*AlterIndexPartition).AttachPartition = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterIndexPartition).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterMigration(parent SQLNode, node *AlterMigration, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -754,6 +969,161 @@ func (a *application) rewriteRefOfAlterMigration(parent SQLNode, node *AlterMigr
 	}
 	return true
 }
+func (a *application) rewriteRefOfAlterObjectOwner(parent SQLNode, node *AlterObjectOwner, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Object, func(newNode, parent SQLNode) {
+		parent.(*AlterObjectOwner).Object = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfRoleName(node, node.Owner, func(newNode, parent SQLNode) {
+		parent.(*AlterObjectOwner).Owner = newNode.(*RoleName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterObjectOwner).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfAlterOwner(parent SQLNode, node *AlterOwner, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfRoleName(node, node.Owner, func(newNode, parent SQLNode) {
+		parent.(*AlterOwner).Owner = newNode.(*RoleName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfAlterPublication(parent SQLNode, node *AlterPublication, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*AlterPublication).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	for x, el := range node.Tables {
+		if !a.rewriteRefOfPublicationTable(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterPublication).Tables[idx] = newNode.(*PublicationTable)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Schemas {
+		if !a.rewriteTableIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterPublication).Schemas[idx] = newNode.(TableIdent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterPublication).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfAlterSequence(parent SQLNode, node *AlterSequence, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*AlterSequence).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSequenceSpec(node, node.SequenceSpec, func(newNode, parent SQLNode) {
+		parent.(*AlterSequence).SequenceSpec = newNode.(*SequenceSpec)
+	}) {
+		return false
+	}
+	for x, el := range node.AlterOptions {
+		if !a.rewriteAlterOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*AlterSequence).AlterOptions[idx] = newNode.(AlterOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfAlterTable(parent SQLNode, node *AlterTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1368,7 +1738,7 @@ func (a *application) rewriteColumns(parent SQLNode, node Columns, replacer repl
 	}
 	return true
 }
-func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCommentOn(parent SQLNode, node *CommentOn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1380,14 +1750,97 @@ func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer
 			return true
 		}
 	}
-	if a.post != nil {
-		if a.pre == nil {
-			a.cur.replacer = replacer
-			a.cur.parent = parent
-			a.cur.node = node
-		}
-		if !a.post(&a.cur) {
-			return false
+	if !a.rewriteTableName(node, node.Object, func(newNode, parent SQLNode) {
+		parent.(*CommentOn).Object = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CommentOn).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	for x, el := range node.Arguments {
+		if !a.rewriteRefOfFunctionArgument(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CommentOn).Arguments[idx] = newNode.(*FunctionArgument)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*CommentOn).Value = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CommentOn).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCommentOnSchema(parent SQLNode, node *CommentOnSchema, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Value, func(newNode, parent SQLNode) {
+		parent.(*CommentOnSchema).Value = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CommentOnSchema).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCommit(parent SQLNode, node *Commit, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
 		}
 	}
 	return true
@@ -1547,8 +2000,612 @@ func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType,
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
-		parent.(*ConvertType).Scale = newNode.(*Literal)
+	if !a.rewriteRefOfLiteral(node, node.Scale, func(newNode, parent SQLNode) {
+		parent.(*ConvertType).Scale = newNode.(*Literal)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *ConvertUsingExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ConvertUsingExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDatabase, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateDatabase).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
+		parent.(*CreateDatabase).DBName = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateDomain(parent SQLNode, node *CreateDomain, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateDomain).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*CreateDomain).Type = newNode.(*ColumnType)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*CreateDomain).Default = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Constraints {
+		if !a.rewriteRefOfDomainConstraint(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateDomain).Constraints[idx] = newNode.(*DomainConstraint)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateDomain).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateExtension(parent SQLNode, node *CreateExtension, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateExtension).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.Schema, func(newNode, parent SQLNode) {
+		parent.(*CreateExtension).Schema = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateExtension).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateFunction(parent SQLNode, node *CreateFunction, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Arguments {
+		if !a.rewriteRefOfFunctionArgument(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Arguments[idx] = newNode.(*FunctionArgument)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfFunctionReturns(node, node.Returns, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Returns = newNode.(*FunctionReturns)
+	}) {
+		return false
+	}
+	for x, el := range node.Options {
+		if !a.rewriteRefOfFunctionOption(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateFunction).Options[idx] = newNode.(*FunctionOption)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateFunction).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateIndex(parent SQLNode, node *CreateIndex, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.Method, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Method = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	for x, el := range node.Columns {
+		if !a.rewriteRefOfIndexElement(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateIndex).Columns[idx] = newNode.(*IndexElement)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteColumns(node, node.Include, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Include = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteStorageParameters(node, node.Parameters, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Parameters = newNode.(StorageParameters)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.Tablespace, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Tablespace = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfWhere(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Where = newNode.(*Where)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateIndex).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateMaterializedView(parent SQLNode, node *CreateMaterializedView, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).ViewName = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.Method, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Method = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteStorageParameters(node, node.Parameters, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Parameters = newNode.(StorageParameters)
+	}) {
+		return false
+	}
+	if !a.rewriteTableIdent(node, node.Tablespace, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Tablespace = newNode.(TableIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Select = newNode.(SelectStatement)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateMaterializedView).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreatePolicy(parent SQLNode, node *CreatePolicy, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreatePolicy).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreatePolicy).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Roles {
+		if !a.rewriteRefOfRoleName(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreatePolicy).Roles[idx] = newNode.(*RoleName)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteExpr(node, node.Using, func(newNode, parent SQLNode) {
+		parent.(*CreatePolicy).Using = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.WithCheck, func(newNode, parent SQLNode) {
+		parent.(*CreatePolicy).WithCheck = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreatePolicy).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreatePublication(parent SQLNode, node *CreatePublication, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreatePublication).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	for x, el := range node.Tables {
+		if !a.rewriteRefOfPublicationTable(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreatePublication).Tables[idx] = newNode.(*PublicationTable)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Schemas {
+		if !a.rewriteTableIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreatePublication).Schemas[idx] = newNode.(TableIdent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteStorageParameters(node, node.Parameters, func(newNode, parent SQLNode) {
+		parent.(*CreatePublication).Parameters = newNode.(StorageParameters)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreatePublication).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateRule(parent SQLNode, node *CreateRule, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateRule).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateRule).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*CreateRule).Where = newNode.(Expr)
+	}) {
+		return false
+	}
+	for x, el := range node.Actions {
+		if !a.rewriteStatement(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateRule).Actions[idx] = newNode.(Statement)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateRule).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).OptLike = newNode.(*OptLike)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfCreateTrigger(parent SQLNode, node *CreateTrigger, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	for x, el := range node.Events {
+		if !a.rewriteRefOfTriggerEvent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateTrigger).Events[idx] = newNode.(*TriggerEvent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Table = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.When, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).When = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.Function, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Function = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteExprs(node, node.Arguments, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Arguments = newNode.(Exprs)
+	}) {
+		return false
+	}
+	for x, el := range node.Body {
+		if !a.rewriteStatement(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateTrigger).Body[idx] = newNode.(Statement)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateTrigger).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -1562,7 +2619,7 @@ func (a *application) rewriteRefOfConvertType(parent SQLNode, node *ConvertType,
 	}
 	return true
 }
-func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *ConvertUsingExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateType(parent SQLNode, node *CreateType, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1574,40 +2631,27 @@ func (a *application) rewriteRefOfConvertUsingExpr(parent SQLNode, node *Convert
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ConvertUsingExpr).Expr = newNode.(Expr)
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*CreateType).Name = newNode.(TableName)
 	}) {
 		return false
 	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.post(&a.cur) {
+	for x, el := range node.Attributes {
+		if !a.rewriteRefOfColumnDefinition(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*CreateType).Attributes[idx] = newNode.(*ColumnDefinition)
+			}
+		}(x)) {
 			return false
 		}
 	}
-	return true
-}
-func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDatabase, replacer replacerFunc) bool {
-	if node == nil {
-		return true
-	}
-	if a.pre != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
-		if !a.pre(&a.cur) {
-			return true
-		}
-	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateDatabase).Comments = newNode.(*ParsedComments)
+	if !a.rewriteStorageParameters(node, node.Parameters, func(newNode, parent SQLNode) {
+		parent.(*CreateType).Parameters = newNode.(StorageParameters)
 	}) {
 		return false
 	}
-	if !a.rewriteTableIdent(node, node.DBName, func(newNode, parent SQLNode) {
-		parent.(*CreateDatabase).DBName = newNode.(TableIdent)
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*CreateType).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -1621,7 +2665,7 @@ func (a *application) rewriteRefOfCreateDatabase(parent SQLNode, node *CreateDat
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1633,23 +2677,28 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Table = newNode.(TableName)
+	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
+		parent.(*CreateView).ViewName = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfTableSpec(node, node.TableSpec, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).TableSpec = newNode.(*TableSpec)
+	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Definer = newNode.(*Definer)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfOptLike(node, node.OptLike, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).OptLike = newNode.(*OptLike)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
+		parent.(*CreateView).Select = newNode.(SelectStatement)
 	}) {
 		return false
 	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateTable).Comments = newNode.(*ParsedComments)
+		parent.(*CreateView).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -1663,7 +2712,7 @@ func (a *application) rewriteRefOfCreateTable(parent SQLNode, node *CreateTable,
 	}
 	return true
 }
-func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfCreateVirtualTable(parent SQLNode, node *CreateVirtualTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -1675,28 +2724,18 @@ func (a *application) rewriteRefOfCreateView(parent SQLNode, node *CreateView, r
 			return true
 		}
 	}
-	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
-		parent.(*CreateView).ViewName = newNode.(TableName)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfDefiner(node, node.Definer, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Definer = newNode.(*Definer)
-	}) {
-		return false
-	}
-	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Columns = newNode.(Columns)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*CreateVirtualTable).Table = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteSelectStatement(node, node.Select, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Select = newNode.(SelectStatement)
+	if !a.rewriteColIdent(node, node.Module, func(newNode, parent SQLNode) {
+		parent.(*CreateVirtualTable).Module = newNode.(ColIdent)
 	}) {
 		return false
 	}
 	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*CreateView).Comments = newNode.(*ParsedComments)
+		parent.(*CreateVirtualTable).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
@@ -1911,6 +2950,38 @@ func (a *application) rewriteRefOfDerivedTable(parent SQLNode, node *DerivedTabl
 	}
 	return true
 }
+func (a *application) rewriteRefOfDomainConstraint(parent SQLNode, node *DomainConstraint, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DomainConstraint).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Check, func(newNode, parent SQLNode) {
+		parent.(*DomainConstraint).Check = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfDropColumn(parent SQLNode, node *DropColumn, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -1970,6 +3041,38 @@ func (a *application) rewriteRefOfDropDatabase(parent SQLNode, node *DropDatabas
 	}
 	return true
 }
+func (a *application) rewriteRefOfDropFunction(parent SQLNode, node *DropFunction, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*DropFunction).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*DropFunction).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfDropKey(parent SQLNode, node *DropKey, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -2205,18 +3308,138 @@ func (a *application) rewriteExprs(parent SQLNode, node Exprs, replacer replacer
 		}(x)) {
 			return false
 		}
-	}
-	if a.post != nil {
-		a.cur.replacer = replacer
-		a.cur.parent = parent
-		a.cur.node = node
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Original = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
+		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
+		parent.(*Flush).TableNames = newNode.(TableNames)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
 		if !a.post(&a.cur) {
 			return false
 		}
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractFuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2228,8 +3451,18 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
-		parent.(*ExtractFuncExpr).Expr = newNode.(Expr)
+	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
+	}) {
+		return false
+	}
+	if !a.rewriteColIdent(node, node.IndexName, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).IndexName = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
+		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
 	}) {
 		return false
 	}
@@ -2243,7 +3476,7 @@ func (a *application) rewriteRefOfExtractFuncExpr(parent SQLNode, node *ExtractF
 	}
 	return true
 }
-func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *ExtractedSubquery, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2255,23 +3488,18 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 			return true
 		}
 	}
-	if !a.rewriteExpr(node, node.Original, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Original = newNode.(Expr)
-	}) {
-		return false
-	}
-	if !a.rewriteRefOfSubquery(node, node.Subquery, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).Subquery = newNode.(*Subquery)
+	if !a.rewriteTableIdent(node, node.Qualifier, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.OtherSide, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).OtherSide = newNode.(Expr)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Name = newNode.(ColIdent)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.alternative, func(newNode, parent SQLNode) {
-		parent.(*ExtractedSubquery).alternative = newNode.(Expr)
+	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
+		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 	}) {
 		return false
 	}
@@ -2285,7 +3513,7 @@ func (a *application) rewriteRefOfExtractedSubquery(parent SQLNode, node *Extrac
 	}
 	return true
 }
-func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFunctionArgument(parent SQLNode, node *FunctionArgument, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2297,8 +3525,18 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 			return true
 		}
 	}
-	if !a.rewriteTableNames(node, node.TableNames, func(newNode, parent SQLNode) {
-		parent.(*Flush).TableNames = newNode.(TableNames)
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*FunctionArgument).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*FunctionArgument).Type = newNode.(*ColumnType)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Default, func(newNode, parent SQLNode) {
+		parent.(*FunctionArgument).Default = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -2312,7 +3550,7 @@ func (a *application) rewriteRefOfFlush(parent SQLNode, node *Flush, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFunctionOption(parent SQLNode, node *FunctionOption, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2336,7 +3574,7 @@ func (a *application) rewriteRefOfForce(parent SQLNode, node *Force, replacer re
 	}
 	return true
 }
-func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *ForeignKeyDefinition, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfFunctionReturns(parent SQLNode, node *FunctionReturns, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2348,20 +3586,19 @@ func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *For
 			return true
 		}
 	}
-	if !a.rewriteColumns(node, node.Source, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
-	}) {
-		return false
-	}
-	if !a.rewriteColIdent(node, node.IndexName, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).IndexName = newNode.(ColIdent)
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*FunctionReturns).Type = newNode.(*ColumnType)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfReferenceDefinition(node, node.ReferenceDefinition, func(newNode, parent SQLNode) {
-		parent.(*ForeignKeyDefinition).ReferenceDefinition = newNode.(*ReferenceDefinition)
-	}) {
-		return false
+	for x, el := range node.Table {
+		if !a.rewriteRefOfFunctionArgument(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*FunctionReturns).Table[idx] = newNode.(*FunctionArgument)
+			}
+		}(x)) {
+			return false
+		}
 	}
 	if a.post != nil {
 		a.cur.replacer = replacer
@@ -2373,7 +3610,7 @@ func (a *application) rewriteRefOfForeignKeyDefinition(parent SQLNode, node *For
 	}
 	return true
 }
-func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfGrant(parent SQLNode, node *Grant, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -2385,18 +3622,62 @@ func (a *application) rewriteRefOfFuncExpr(parent SQLNode, node *FuncExpr, repla
 			return true
 		}
 	}
-	if !a.rewriteTableIdent(node, node.Qualifier, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
-	}) {
-		return false
+	for x, el := range node.Privileges {
+		if !a.rewriteRefOfGrantPrivilege(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).Privileges[idx] = newNode.(*GrantPrivilege)
+			}
+		}(x)) {
+			return false
+		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Name = newNode.(ColIdent)
+	for x, el := range node.Objects {
+		if !a.rewriteRefOfPrivilegeObject(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).Objects[idx] = newNode.(*PrivilegeObject)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Grantees {
+		if !a.rewriteRefOfRoleName(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Grant).Grantees[idx] = newNode.(*RoleName)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Grant).Comments = newNode.(*ParsedComments)
 	}) {
 		return false
 	}
-	if !a.rewriteSelectExprs(node, node.Exprs, func(newNode, parent SQLNode) {
-		parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfGrantPrivilege(parent SQLNode, node *GrantPrivilege, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*GrantPrivilege).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
@@ -2511,6 +3792,48 @@ func (a *application) rewriteRefOfIndexDefinition(parent SQLNode, node *IndexDef
 	}
 	return true
 }
+func (a *application) rewriteRefOfIndexElement(parent SQLNode, node *IndexElement, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Column, func(newNode, parent SQLNode) {
+		parent.(*IndexElement).Column = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Expression, func(newNode, parent SQLNode) {
+		parent.(*IndexElement).Expression = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteTableName(node, node.OpClass, func(newNode, parent SQLNode) {
+		parent.(*IndexElement).OpClass = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteStorageParameters(node, node.OpClassParameters, func(newNode, parent SQLNode) {
+		parent.(*IndexElement).OpClassParameters = newNode.(StorageParameters)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfIndexHint(parent SQLNode, node *IndexHint, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -4414,6 +5737,11 @@ func (a *application) rewriteRefOfPartitionSpec(parent SQLNode, node *PartitionS
 			return false
 		}
 	}
+	if !a.rewriteRefOfPartitionValueRange(node, node.Bound, func(newNode, parent SQLNode) {
+		parent.(*PartitionSpec).Bound = newNode.(*PartitionValueRange)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4441,6 +5769,112 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 	}) {
 		return false
 	}
+	if !a.rewriteValTuple(node, node.To, func(newNode, parent SQLNode) {
+		parent.(*PartitionValueRange).To = newNode.(ValTuple)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(Partitions)
+			a.cur.revisit = false
+			return a.rewritePartitions(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	for x, el := range node {
+		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(Partitions)[idx] = newNode.(ColIdent)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPragma(parent SQLNode, node *Pragma, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*Pragma).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Name = newNode.(ColIdent)
+	}) {
+		return false
+	}
+	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -4451,7 +5885,7 @@ func (a *application) rewriteRefOfPartitionValueRange(parent SQLNode, node *Part
 	}
 	return true
 }
-func (a *application) rewritePartitions(parent SQLNode, node Partitions, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfPrivilegeObject(parent SQLNode, node *PrivilegeObject, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4459,20 +5893,19 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 		a.cur.replacer = replacer
 		a.cur.parent = parent
 		a.cur.node = node
-		kontinue := !a.pre(&a.cur)
-		if a.cur.revisit {
-			node = a.cur.node.(Partitions)
-			a.cur.revisit = false
-			return a.rewritePartitions(parent, node, replacer)
-		}
-		if kontinue {
+		if !a.pre(&a.cur) {
 			return true
 		}
 	}
-	for x, el := range node {
-		if !a.rewriteColIdent(node, el, func(idx int) replacerFunc {
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*PrivilegeObject).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	for x, el := range node.Arguments {
+		if !a.rewriteRefOfFunctionArgument(node, el, func(idx int) replacerFunc {
 			return func(newNode, parent SQLNode) {
-				parent.(Partitions)[idx] = newNode.(ColIdent)
+				parent.(*PrivilegeObject).Arguments[idx] = newNode.(*FunctionArgument)
 			}
 		}(x)) {
 			return false
@@ -4488,7 +5921,7 @@ func (a *application) rewritePartitions(parent SQLNode, node Partitions, replace
 	}
 	return true
 }
-func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt, replacer replacerFunc) bool {
+func (a *application) rewriteRefOfPublicationTable(parent SQLNode, node *PublicationTable, replacer replacerFunc) bool {
 	if node == nil {
 		return true
 	}
@@ -4500,18 +5933,18 @@ func (a *application) rewriteRefOfPrepareStmt(parent SQLNode, node *PrepareStmt,
 			return true
 		}
 	}
-	if !a.rewriteColIdent(node, node.Name, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Name = newNode.(ColIdent)
+	if !a.rewriteTableName(node, node.Table, func(newNode, parent SQLNode) {
+		parent.(*PublicationTable).Table = newNode.(TableName)
 	}) {
 		return false
 	}
-	if !a.rewriteExpr(node, node.Statement, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Statement = newNode.(Expr)
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*PublicationTable).Columns = newNode.(Columns)
 	}) {
 		return false
 	}
-	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
-		parent.(*PrepareStmt).Comments = newNode.(*ParsedComments)
+	if !a.rewriteExpr(node, node.Where, func(newNode, parent SQLNode) {
+		parent.(*PublicationTable).Where = newNode.(Expr)
 	}) {
 		return false
 	}
@@ -4572,6 +6005,38 @@ func (a *application) rewriteRefOfReferenceDefinition(parent SQLNode, node *Refe
 	}
 	return true
 }
+func (a *application) rewriteRefOfRefreshMaterializedView(parent SQLNode, node *RefreshMaterializedView, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.ViewName, func(newNode, parent SQLNode) {
+		parent.(*RefreshMaterializedView).ViewName = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*RefreshMaterializedView).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfRelease(parent SQLNode, node *Release, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -4709,6 +6174,84 @@ func (a *application) rewriteRefOfRevertMigration(parent SQLNode, node *RevertMi
 	}
 	return true
 }
+func (a *application) rewriteRefOfRevoke(parent SQLNode, node *Revoke, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	for x, el := range node.Privileges {
+		if !a.rewriteRefOfGrantPrivilege(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).Privileges[idx] = newNode.(*GrantPrivilege)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Objects {
+		if !a.rewriteRefOfPrivilegeObject(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).Objects[idx] = newNode.(*PrivilegeObject)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	for x, el := range node.Grantees {
+		if !a.rewriteRefOfRoleName(node, el, func(idx int) replacerFunc {
+			return func(newNode, parent SQLNode) {
+				parent.(*Revoke).Grantees[idx] = newNode.(*RoleName)
+			}
+		}(x)) {
+			return false
+		}
+	}
+	if !a.rewriteRefOfParsedComments(node, node.Comments, func(newNode, parent SQLNode) {
+		parent.(*Revoke).Comments = newNode.(*ParsedComments)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
+func (a *application) rewriteRefOfRoleName(parent SQLNode, node *RoleName, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfRollback(parent SQLNode, node *Rollback, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -4757,6 +6300,30 @@ func (a *application) rewriteRootNode(parent SQLNode, node RootNode, replacer re
 	}
 	return true
 }
+func (a *application) rewriteRefOfRowLevelSecurity(parent SQLNode, node *RowLevelSecurity, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfSRollback(parent SQLNode, node *SRollback, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -4948,6 +6515,33 @@ func (a *application) rewriteRefOfSelectInto(parent SQLNode, node *SelectInto, r
 	}
 	return true
 }
+func (a *application) rewriteRefOfSequenceSpec(parent SQLNode, node *SequenceSpec, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteTableName(node, node.Name, func(newNode, parent SQLNode) {
+		parent.(*SequenceSpec).Name = newNode.(TableName)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfSet(parent SQLNode, node *Set, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5310,6 +6904,36 @@ func (a *application) rewriteRefOfStarExpr(parent SQLNode, node *StarExpr, repla
 	}
 	return true
 }
+func (a *application) rewriteStorageParameters(parent SQLNode, node StorageParameters, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		kontinue := !a.pre(&a.cur)
+		if a.cur.revisit {
+			node = a.cur.node.(StorageParameters)
+			a.cur.revisit = false
+			return a.rewriteStorageParameters(parent, node, replacer)
+		}
+		if kontinue {
+			return true
+		}
+	}
+	if a.post != nil {
+		if a.pre == nil {
+			a.cur.replacer = replacer
+			a.cur.parent = parent
+			a.cur.node = node
+		}
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfStream(parent SQLNode, node *Stream, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5757,6 +7381,16 @@ func (a *application) rewriteRefOfTableSpec(parent SQLNode, node *TableSpec, rep
 	}) {
 		return false
 	}
+	if !a.rewriteTableName(node, node.PartitionOf, func(newNode, parent SQLNode) {
+		parent.(*TableSpec).PartitionOf = newNode.(TableName)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfPartitionValueRange(node, node.PartitionBound, func(newNode, parent SQLNode) {
+		parent.(*TableSpec).PartitionBound = newNode.(*PartitionValueRange)
+	}) {
+		return false
+	}
 	if a.post != nil {
 		a.cur.replacer = replacer
 		a.cur.parent = parent
@@ -5823,6 +7457,33 @@ func (a *application) rewriteRefOfTimestampFuncExpr(parent SQLNode, node *Timest
 	}
 	return true
 }
+func (a *application) rewriteRefOfTriggerEvent(parent SQLNode, node *TriggerEvent, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteColumns(node, node.Columns, func(newNode, parent SQLNode) {
+		parent.(*TriggerEvent).Columns = newNode.(Columns)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfTrimFuncExpr(parent SQLNode, node *TrimFuncExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -5882,6 +7543,38 @@ func (a *application) rewriteRefOfTruncateTable(parent SQLNode, node *TruncateTa
 	}
 	return true
 }
+func (a *application) rewriteRefOfTypeCastExpr(parent SQLNode, node *TypeCastExpr, replacer replacerFunc) bool {
+	if node == nil {
+		return true
+	}
+	if a.pre != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.pre(&a.cur) {
+			return true
+		}
+	}
+	if !a.rewriteExpr(node, node.Expr, func(newNode, parent SQLNode) {
+		parent.(*TypeCastExpr).Expr = newNode.(Expr)
+	}) {
+		return false
+	}
+	if !a.rewriteRefOfColumnType(node, node.Type, func(newNode, parent SQLNode) {
+		parent.(*TypeCastExpr).Type = newNode.(*ColumnType)
+	}) {
+		return false
+	}
+	if a.post != nil {
+		a.cur.replacer = replacer
+		a.cur.parent = parent
+		a.cur.node = node
+		if !a.post(&a.cur) {
+			return false
+		}
+	}
+	return true
+}
 func (a *application) rewriteRefOfUnaryExpr(parent SQLNode, node *UnaryExpr, replacer replacerFunc) bool {
 	if node == nil {
 		return true
@@ -6550,6 +8243,8 @@ func (a *application) rewriteAlterOption(parent SQLNode, node AlterOption, repla
 		return a.rewriteRefOfAlterColumn(parent, node, replacer)
 	case *AlterIndex:
 		return a.rewriteRefOfAlterIndex(parent, node, replacer)
+	case *AlterOwner:
+		return a.rewriteRefOfAlterOwner(parent, node, replacer)
 	case *ChangeColumn:
 		return a.rewriteRefOfChangeColumn(parent, node, replacer)
 	case *DropColumn:
@@ -6570,6 +8265,8 @@ func (a *application) rewriteAlterOption(parent SQLNode, node AlterOption, repla
 		return a.rewriteRefOfRenameIndex(parent, node, replacer)
 	case *RenameTableName:
 		return a.rewriteRefOfRenameTableName(parent, node, replacer)
+	case *RowLevelSecurity:
+		return a.rewriteRefOfRowLevelSecurity(parent, node, replacer)
 	case TableOptions:
 		return a.rewriteTableOptions(parent, node, replacer)
 	case *TablespaceOperation:
@@ -6726,6 +8423,8 @@ func (a *application) rewriteDDLStatement(parent SQLNode, node DDLStatement, rep
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
 	case *AlterView:
 		return a.rewriteRefOfAlterView(parent, node, replacer)
+	case *CreateIndex:
+		return a.rewriteRefOfCreateIndex(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
 	case *CreateView:
@@ -6868,6 +8567,8 @@ func (a *application) rewriteExpr(parent SQLNode, node Expr, replacer replacerFu
 		return a.rewriteRefOfTimestampFuncExpr(parent, node, replacer)
 	case *TrimFuncExpr:
 		return a.rewriteRefOfTrimFuncExpr(parent, node, replacer)
+	case *TypeCastExpr:
+		return a.rewriteRefOfTypeCastExpr(parent, node, replacer)
 	case *UnaryExpr:
 		return a.rewriteRefOfUnaryExpr(parent, node, replacer)
 	case ValTuple:
@@ -7092,8 +8793,20 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 	switch node := node.(type) {
 	case *AlterDatabase:
 		return a.rewriteRefOfAlterDatabase(parent, node, replacer)
+	case *AlterDefaultPrivileges:
+		return a.rewriteRefOfAlterDefaultPrivileges(parent, node, replacer)
+	case *AlterFunction:
+		return a.rewriteRefOfAlterFunction(parent, node, replacer)
+	case *AlterIndexPartition:
+		return a.rewriteRefOfAlterIndexPartition(parent, node, replacer)
 	case *AlterMigration:
 		return a.rewriteRefOfAlterMigration(parent, node, replacer)
+	case *AlterObjectOwner:
+		return a.rewriteRefOfAlterObjectOwner(parent, node, replacer)
+	case *AlterPublication:
+		return a.rewriteRefOfAlterPublication(parent, node, replacer)
+	case *AlterSequence:
+		return a.rewriteRefOfAlterSequence(parent, node, replacer)
 	case *AlterTable:
 		return a.rewriteRefOfAlterTable(parent, node, replacer)
 	case *AlterView:
@@ -7104,20 +8817,48 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfBegin(parent, node, replacer)
 	case *CallProc:
 		return a.rewriteRefOfCallProc(parent, node, replacer)
+	case *CommentOn:
+		return a.rewriteRefOfCommentOn(parent, node, replacer)
+	case *CommentOnSchema:
+		return a.rewriteRefOfCommentOnSchema(parent, node, replacer)
 	case *Commit:
 		return a.rewriteRefOfCommit(parent, node, replacer)
 	case *CreateDatabase:
 		return a.rewriteRefOfCreateDatabase(parent, node, replacer)
+	case *CreateDomain:
+		return a.rewriteRefOfCreateDomain(parent, node, replacer)
+	case *CreateExtension:
+		return a.rewriteRefOfCreateExtension(parent, node, replacer)
+	case *CreateFunction:
+		return a.rewriteRefOfCreateFunction(parent, node, replacer)
+	case *CreateIndex:
+		return a.rewriteRefOfCreateIndex(parent, node, replacer)
+	case *CreateMaterializedView:
+		return a.rewriteRefOfCreateMaterializedView(parent, node, replacer)
+	case *CreatePolicy:
+		return a.rewriteRefOfCreatePolicy(parent, node, replacer)
+	case *CreatePublication:
+		return a.rewriteRefOfCreatePublication(parent, node, replacer)
+	case *CreateRule:
+		return a.rewriteRefOfCreateRule(parent, node, replacer)
 	case *CreateTable:
 		return a.rewriteRefOfCreateTable(parent, node, replacer)
+	case *CreateTrigger:
+		return a.rewriteRefOfCreateTrigger(parent, node, replacer)
+	case *CreateType:
+		return a.rewriteRefOfCreateType(parent, node, replacer)
 	case *CreateView:
 		return a.rewriteRefOfCreateView(parent, node, replacer)
+	case *CreateVirtualTable:
+		return a.rewriteRefOfCreateVirtualTable(parent, node, replacer)
 	case *DeallocateStmt:
 		return a.rewriteRefOfDeallocateStmt(parent, node, replacer)
 	case *Delete:
 		return a.rewriteRefOfDelete(parent, node, replacer)
 	case *DropDatabase:
 		return a.rewriteRefOfDropDatabase(parent, node, replacer)
+	case *DropFunction:
+		return a.rewriteRefOfDropFunction(parent, node, replacer)
 	case *DropTable:
 		return a.rewriteRefOfDropTable(parent, node, replacer)
 	case *DropView:
@@ -7130,6 +8871,8 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfExplainTab(parent, node, replacer)
 	case *Flush:
 		return a.rewriteRefOfFlush(parent, node, replacer)
+	case *Grant:
+		return a.rewriteRefOfGrant(parent, node, replacer)
 	case *Insert:
 		return a.rewriteRefOfInsert(parent, node, replacer)
 	case *Load:
@@ -7140,14 +8883,20 @@ func (a *application) rewriteStatement(parent SQLNode, node Statement, replacer
 		return a.rewriteRefOfOtherAdmin(parent, node, replacer)
 	case *OtherRead:
 		return a.rewriteRefOfOtherRead(parent, node, replacer)
+	case *Pragma:
+		return a.rewriteRefOfPragma(parent, node, replacer)
 	case *PrepareStmt:
 		return a.rewriteRefOfPrepareStmt(parent, node, replacer)
+	case *RefreshMaterializedView:
+		return a.rewriteRefOfRefreshMaterializedView(parent, node, replacer)
 	case *Release:
 		return a.rewriteRefOfRelease(parent, node, replacer)
 	case *RenameTable:
 		return a.rewriteRefOfRenameTable(parent, node, replacer)
 	case *RevertMigration:
 		return a.rewriteRefOfRevertMigration(parent, node, replacer)
+	case *Revoke:
+		return a.rewriteRefOfRevoke(parent, node, replacer)
 	case *Rollback:
 		return a.rewriteRefOfRollback(parent, node, replacer)
 	case *SRollback:
@@ -1,8 +1,17 @@
 package ast
 
+import "strings"
+
 // String methods for different types
 
 func (o CopyOption) String() string {
+	switch o.Type {
+	case CopyOptionForceQuote, CopyOptionForceNotNull, CopyOptionForceNull:
+		if o.Columns == nil {
+			return o.Type.ToString() + " *"
+		}
+		return o.Type.ToString() + " (" + strings.Join(Map(o.Columns, ColIdent.String), ", ") + ")"
+	}
 	return o.Type.ToString() + " " + o.Value
 }
 
@@ -44,10 +44,24 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterDefaultPrivileges:
+		return VisitRefOfAlterDefaultPrivileges(in, f)
+	case *AlterFunction:
+		return VisitRefOfAlterFunction(in, f)
 	case *AlterIndex:
 		return VisitRefOfAlterIndex(in, f)
+	case *AlterIndexPartition:
+		return VisitRefOfAlterIndexPartition(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterObjectOwner:
+		return VisitRefOfAlterObjectOwner(in, f)
+	case *AlterOwner:
+		return VisitRefOfAlterOwner(in, f)
+	case *AlterPublication:
+		return VisitRefOfAlterPublication(in, f)
+	case *AlterSequence:
+		return VisitRefOfAlterSequence(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
 	case *AlterView:
@@ -88,6 +102,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfColumnType(in, f)
 	case Columns:
 		return VisitColumns(in, f)
+	case *CommentOn:
+		return VisitRefOfCommentOn(in, f)
+	case *CommentOnSchema:
+		return VisitRefOfCommentOnSchema(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
 	case *CommonTableExpr:
@@ -104,10 +122,32 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfConvertUsingExpr(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateDomain:
+		return VisitRefOfCreateDomain(in, f)
+	case *CreateExtension:
+		return VisitRefOfCreateExtension(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateIndex:
+		return VisitRefOfCreateIndex(in, f)
+	case *CreateMaterializedView:
+		return VisitRefOfCreateMaterializedView(in, f)
+	case *CreatePolicy:
+		return VisitRefOfCreatePolicy(in, f)
+	case *CreatePublication:
+		return VisitRefOfCreatePublication(in, f)
+	case *CreateRule:
+		return VisitRefOfCreateRule(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateType:
+		return VisitRefOfCreateType(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
+	case *CreateVirtualTable:
+		return VisitRefOfCreateVirtualTable(in, f)
 	case *CurTimeFuncExpr:
 		return VisitRefOfCurTimeFuncExpr(in, f)
 	case *DeallocateStmt:
@@ -120,10 +160,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfDelete(in, f)
 	case *DerivedTable:
 		return VisitRefOfDerivedTable(in, f)
+	case *DomainConstraint:
+		return VisitRefOfDomainConstraint(in, f)
 	case *DropColumn:
 		return VisitRefOfDropColumn(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
+	case *DropFunction:
+		return VisitRefOfDropFunction(in, f)
 	case *DropKey:
 		return VisitRefOfDropKey(in, f)
 	case *DropTable:
@@ -152,12 +196,24 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForeignKeyDefinition(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
+	case *FunctionArgument:
+		return VisitRefOfFunctionArgument(in, f)
+	case *FunctionOption:
+		return VisitRefOfFunctionOption(in, f)
+	case *FunctionReturns:
+		return VisitRefOfFunctionReturns(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
+	case *GrantPrivilege:
+		return VisitRefOfGrantPrivilege(in, f)
 	case GroupBy:
 		return VisitGroupBy(in, f)
 	case *GroupConcatExpr:
 		return VisitRefOfGroupConcatExpr(in, f)
 	case *IndexDefinition:
 		return VisitRefOfIndexDefinition(in, f)
+	case *IndexElement:
+		return VisitRefOfIndexElement(in, f)
 	case *IndexHint:
 		return VisitRefOfIndexHint(in, f)
 	case IndexHints:
@@ -290,12 +346,20 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfPartitionValueRange(in, f)
 	case Partitions:
 		return VisitPartitions(in, f)
+	case *Pragma:
+		return VisitRefOfPragma(in, f)
 	case *PrepareStmt:
 		return VisitRefOfPrepareStmt(in, f)
+	case *PrivilegeObject:
+		return VisitRefOfPrivilegeObject(in, f)
+	case *PublicationTable:
+		return VisitRefOfPublicationTable(in, f)
 	case ReferenceAction:
 		return VisitReferenceAction(in, f)
 	case *ReferenceDefinition:
 		return VisitRefOfReferenceDefinition(in, f)
+	case *RefreshMaterializedView:
+		return VisitRefOfRefreshMaterializedView(in, f)
 	case *Release:
 		return VisitRefOfRelease(in, f)
 	case *RenameIndex:
@@ -306,10 +370,16 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfRenameTableName(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
+	case *RoleName:
+		return VisitRefOfRoleName(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
 	case RootNode:
 		return VisitRootNode(in, f)
+	case *RowLevelSecurity:
+		return VisitRefOfRowLevelSecurity(in, f)
 	case *SRollback:
 		return VisitRefOfSRollback(in, f)
 	case *Savepoint:
@@ -320,6 +390,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitSelectExprs(in, f)
 	case *SelectInto:
 		return VisitRefOfSelectInto(in, f)
+	case *SequenceSpec:
+		return VisitRefOfSequenceSpec(in, f)
 	case *Set:
 		return VisitRefOfSet(in, f)
 	case *SetExpr:
@@ -344,6 +416,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfShowThrottledApps(in, f)
 	case *StarExpr:
 		return VisitRefOfStarExpr(in, f)
+	case StorageParameters:
+		return VisitStorageParameters(in, f)
 	case *Stream:
 		return VisitRefOfStream(in, f)
 	case *SubPartition:
@@ -374,10 +448,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfTablespaceOperation(in, f)
 	case *TimestampFuncExpr:
 		return VisitRefOfTimestampFuncExpr(in, f)
+	case *TriggerEvent:
+		return VisitRefOfTriggerEvent(in, f)
 	case *TrimFuncExpr:
 		return VisitRefOfTrimFuncExpr(in, f)
 	case *TruncateTable:
 		return VisitRefOfTruncateTable(in, f)
+	case *TypeCastExpr:
+		return VisitRefOfTypeCastExpr(in, f)
 	case *UnaryExpr:
 		return VisitRefOfUnaryExpr(in, f)
 	case *Union:
@@ -549,6 +627,56 @@ func VisitRefOfAlterDatabase(in *AlterDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterDefaultPrivileges(in *AlterDefaultPrivileges, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfRoleName(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Schemas {
+		if err := VisitTableIdent(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStatement(in.Action, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAlterFunction(in *AlterFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Arguments {
+		if err := VisitRefOfFunctionArgument(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.AlterOptions {
+		if err := VisitAlterOption(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterIndex(in *AlterIndex, f Visit) error {
 	if in == nil {
 		return nil
@@ -561,6 +689,24 @@ func VisitRefOfAlterIndex(in *AlterIndex, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterIndexPartition(in *AlterIndexPartition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.AttachPartition, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	if in == nil {
 		return nil
@@ -573,6 +719,81 @@ func VisitRefOfAlterMigration(in *AlterMigration, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAlterObjectOwner(in *AlterObjectOwner, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Object, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfRoleName(in.Owner, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAlterOwner(in *AlterOwner, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfRoleName(in.Owner, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAlterPublication(in *AlterPublication, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Tables {
+		if err := VisitRefOfPublicationTable(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Schemas {
+		if err := VisitTableIdent(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfAlterSequence(in *AlterSequence, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfSequenceSpec(in.SequenceSpec, f); err != nil {
+		return err
+	}
+	for _, el := range in.AlterOptions {
+		if err := VisitAlterOption(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfAlterTable(in *AlterTable, f Visit) error {
 	if in == nil {
 		return nil
@@ -857,6 +1078,47 @@ func VisitColumns(in Columns, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCommentOn(in *CommentOn, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Object, f); err != nil {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Arguments {
+		if err := VisitRefOfFunctionArgument(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitExpr(in.Value, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCommentOnSchema(in *CommentOnSchema, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Value, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCommit(in *Commit, f Visit) error {
 	if in == nil {
 		return nil
@@ -974,6 +1236,229 @@ func VisitRefOfCreateDatabase(in *CreateDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCreateDomain(in *CreateDomain, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Default, f); err != nil {
+		return err
+	}
+	for _, el := range in.Constraints {
+		if err := VisitRefOfDomainConstraint(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateExtension(in *CreateExtension, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableIdent(in.Schema, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateFunction(in *CreateFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Arguments {
+		if err := VisitRefOfFunctionArgument(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfFunctionReturns(in.Returns, f); err != nil {
+		return err
+	}
+	for _, el := range in.Options {
+		if err := VisitRefOfFunctionOption(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateIndex(in *CreateIndex, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitColIdent(in.Method, f); err != nil {
+		return err
+	}
+	for _, el := range in.Columns {
+		if err := VisitRefOfIndexElement(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitColumns(in.Include, f); err != nil {
+		return err
+	}
+	if err := VisitStorageParameters(in.Parameters, f); err != nil {
+		return err
+	}
+	if err := VisitTableIdent(in.Tablespace, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWhere(in.Where, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateMaterializedView(in *CreateMaterializedView, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.ViewName, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitColIdent(in.Method, f); err != nil {
+		return err
+	}
+	if err := VisitStorageParameters(in.Parameters, f); err != nil {
+		return err
+	}
+	if err := VisitTableIdent(in.Tablespace, f); err != nil {
+		return err
+	}
+	if err := VisitSelectStatement(in.Select, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreatePolicy(in *CreatePolicy, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	for _, el := range in.Roles {
+		if err := VisitRefOfRoleName(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitExpr(in.Using, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.WithCheck, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreatePublication(in *CreatePublication, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Tables {
+		if err := VisitRefOfPublicationTable(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Schemas {
+		if err := VisitTableIdent(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStorageParameters(in.Parameters, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateRule(in *CreateRule, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Where, f); err != nil {
+		return err
+	}
+	for _, el := range in.Actions {
+		if err := VisitStatement(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
 	if in == nil {
 		return nil
@@ -995,6 +1480,66 @@ func VisitRefOfCreateTable(in *CreateTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfCreateTrigger(in *CreateTrigger, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Events {
+		if err := VisitRefOfTriggerEvent(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.When, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.Function, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.Arguments, f); err != nil {
+		return err
+	}
+	for _, el := range in.Body {
+		if err := VisitStatement(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateType(in *CreateType, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Attributes {
+		if err := VisitRefOfColumnDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitStorageParameters(in.Parameters, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfCreateView(in *CreateView, f Visit) error {
 	if in == nil {
 		return nil
@@ -1005,13 +1550,31 @@ func VisitRefOfCreateView(in *CreateView, f Visit) error {
 	if err := VisitTableName(in.ViewName, f); err != nil {
 		return err
 	}
-	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+	if err := VisitRefOfDefiner(in.Definer, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitSelectStatement(in.Select, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfCreateVirtualTable(in *CreateVirtualTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	if err := VisitColumns(in.Columns, f); err != nil {
+	if err := VisitTableName(in.Table, f); err != nil {
 		return err
 	}
-	if err := VisitSelectStatement(in.Select, f); err != nil {
+	if err := VisitColIdent(in.Module, f); err != nil {
 		return err
 	}
 	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
@@ -1112,6 +1675,21 @@ func VisitRefOfDerivedTable(in *DerivedTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfDomainConstraint(in *DomainConstraint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Check, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfDropColumn(in *DropColumn, f Visit) error {
 	if in == nil {
 		return nil
@@ -1139,6 +1717,21 @@ func VisitRefOfDropDatabase(in *DropDatabase, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfDropFunction(in *DropFunction, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfDropKey(in *DropKey, f Visit) error {
 	if in == nil {
 		return nil
@@ -1339,6 +1932,89 @@ func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFunctionArgument(in *FunctionArgument, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Default, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFunctionOption(in *FunctionOption, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFunctionReturns(in *FunctionReturns, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
+		return err
+	}
+	for _, el := range in.Table {
+		if err := VisitRefOfFunctionArgument(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfGrant(in *Grant, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Privileges {
+		if err := VisitRefOfGrantPrivilege(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Objects {
+		if err := VisitRefOfPrivilegeObject(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Grantees {
+		if err := VisitRefOfRoleName(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfGrantPrivilege(in *GrantPrivilege, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitGroupBy(in GroupBy, f Visit) error {
 	if in == nil {
 		return nil
@@ -1383,6 +2059,27 @@ func VisitRefOfIndexDefinition(in *IndexDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfIndexElement(in *IndexElement, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Column, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Expression, f); err != nil {
+		return err
+	}
+	if err := VisitTableName(in.OpClass, f); err != nil {
+		return err
+	}
+	if err := VisitStorageParameters(in.OpClassParameters, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfIndexHint(in *IndexHint, f Visit) error {
 	if in == nil {
 		return nil
@@ -2246,6 +2943,9 @@ func VisitRefOfPartitionSpec(in *PartitionSpec, f Visit) error {
 			return err
 		}
 	}
+	if err := VisitRefOfPartitionValueRange(in.Bound, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfPartitionValueRange(in *PartitionValueRange, f Visit) error {
@@ -2258,6 +2958,9 @@ func VisitRefOfPartitionValueRange(in *PartitionValueRange, f Visit) error {
 	if err := VisitValTuple(in.Range, f); err != nil {
 		return err
 	}
+	if err := VisitValTuple(in.To, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitPartitions(in Partitions, f Visit) error {
@@ -2274,6 +2977,18 @@ func VisitPartitions(in Partitions, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfPragma(in *Pragma, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
 	if in == nil {
 		return nil
@@ -2292,6 +3007,41 @@ func VisitRefOfPrepareStmt(in *PrepareStmt, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfPrivilegeObject(in *PrivilegeObject, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	for _, el := range in.Arguments {
+		if err := VisitRefOfFunctionArgument(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfPublicationTable(in *PublicationTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitExpr(in.Where, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfReferenceDefinition(in *ReferenceDefinition, f Visit) error {
 	if in == nil {
 		return nil
@@ -2316,6 +3066,21 @@ func VisitRefOfReferenceDefinition(in *ReferenceDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRefreshMaterializedView(in *RefreshMaterializedView, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.ViewName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfRelease(in *Release, f Visit) error {
 	if in == nil {
 		return nil
@@ -2376,6 +3141,42 @@ func VisitRefOfRevertMigration(in *RevertMigration, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRevoke(in *Revoke, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in.Privileges {
+		if err := VisitRefOfGrantPrivilege(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Objects {
+		if err := VisitRefOfPrivilegeObject(el, f); err != nil {
+			return err
+		}
+	}
+	for _, el := range in.Grantees {
+		if err := VisitRefOfRoleName(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitRefOfParsedComments(in.Comments, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfRoleName(in *RoleName, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfRollback(in *Rollback, f Visit) error {
 	if in == nil {
 		return nil
@@ -2394,6 +3195,15 @@ func VisitRootNode(in RootNode, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfRowLevelSecurity(in *RowLevelSecurity, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfSRollback(in *SRollback, f Visit) error {
 	if in == nil {
 		return nil
@@ -2482,6 +3292,18 @@ func VisitRefOfSelectInto(in *SelectInto, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfSequenceSpec(in *SequenceSpec, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Name, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfSet(in *Set, f Visit) error {
 	if in == nil {
 		return nil
@@ -2642,6 +3464,10 @@ func VisitRefOfStarExpr(in *StarExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitStorageParameters(in StorageParameters, f Visit) error {
+	_, err := f(in)
+	return err
+}
 func VisitRefOfStream(in *Stream, f Visit) error {
 	if in == nil {
 		return nil
@@ -2833,6 +3659,12 @@ func VisitRefOfTableSpec(in *TableSpec, f Visit) error {
 	if err := VisitRefOfPartitionOption(in.PartitionOption, f); err != nil {
 		return err
 	}
+	if err := VisitTableName(in.PartitionOf, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfPartitionValueRange(in.PartitionBound, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfTablespaceOperation(in *TablespaceOperation, f Visit) error {
@@ -2859,6 +3691,18 @@ func VisitRefOfTimestampFuncExpr(in *TimestampFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTriggerEvent(in *TriggerEvent, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfTrimFuncExpr(in *TrimFuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2886,6 +3730,21 @@ func VisitRefOfTruncateTable(in *TruncateTable, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfTypeCastExpr(in *TypeCastExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfColumnType(in.Type, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfUnaryExpr(in *UnaryExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -3199,6 +4058,8 @@ func VisitAlterOption(in AlterOption, f Visit) error {
 		return VisitRefOfAlterColumn(in, f)
 	case *AlterIndex:
 		return VisitRefOfAlterIndex(in, f)
+	case *AlterOwner:
+		return VisitRefOfAlterOwner(in, f)
 	case *ChangeColumn:
 		return VisitRefOfChangeColumn(in, f)
 	case *DropColumn:
@@ -3219,6 +4080,8 @@ func VisitAlterOption(in AlterOption, f Visit) error {
 		return VisitRefOfRenameIndex(in, f)
 	case *RenameTableName:
 		return VisitRefOfRenameTableName(in, f)
+	case *RowLevelSecurity:
+		return VisitRefOfRowLevelSecurity(in, f)
 	case TableOptions:
 		return VisitTableOptions(in, f)
 	case *TablespaceOperation:
@@ -3375,6 +4238,8 @@ func VisitDDLStatement(in DDLStatement, f Visit) error {
 		return VisitRefOfAlterTable(in, f)
 	case *AlterView:
 		return VisitRefOfAlterView(in, f)
+	case *CreateIndex:
+		return VisitRefOfCreateIndex(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
 	case *CreateView:
@@ -3517,6 +4382,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfTimestampFuncExpr(in, f)
 	case *TrimFuncExpr:
 		return VisitRefOfTrimFuncExpr(in, f)
+	case *TypeCastExpr:
+		return VisitRefOfTypeCastExpr(in, f)
 	case *UnaryExpr:
 		return VisitRefOfUnaryExpr(in, f)
 	case ValTuple:
@@ -3741,8 +4608,20 @@ func VisitStatement(in Statement, f Visit) error {
 	switch in := in.(type) {
 	case *AlterDatabase:
 		return VisitRefOfAlterDatabase(in, f)
+	case *AlterDefaultPrivileges:
+		return VisitRefOfAlterDefaultPrivileges(in, f)
+	case *AlterFunction:
+		return VisitRefOfAlterFunction(in, f)
+	case *AlterIndexPartition:
+		return VisitRefOfAlterIndexPartition(in, f)
 	case *AlterMigration:
 		return VisitRefOfAlterMigration(in, f)
+	case *AlterObjectOwner:
+		return VisitRefOfAlterObjectOwner(in, f)
+	case *AlterPublication:
+		return VisitRefOfAlterPublication(in, f)
+	case *AlterSequence:
+		return VisitRefOfAlterSequence(in, f)
 	case *AlterTable:
 		return VisitRefOfAlterTable(in, f)
 	case *AlterView:
@@ -3753,20 +4632,48 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfBegin(in, f)
 	case *CallProc:
 		return VisitRefOfCallProc(in, f)
+	case *CommentOn:
+		return VisitRefOfCommentOn(in, f)
+	case *CommentOnSchema:
+		return VisitRefOfCommentOnSchema(in, f)
 	case *Commit:
 		return VisitRefOfCommit(in, f)
 	case *CreateDatabase:
 		return VisitRefOfCreateDatabase(in, f)
+	case *CreateDomain:
+		return VisitRefOfCreateDomain(in, f)
+	case *CreateExtension:
+		return VisitRefOfCreateExtension(in, f)
+	case *CreateFunction:
+		return VisitRefOfCreateFunction(in, f)
+	case *CreateIndex:
+		return VisitRefOfCreateIndex(in, f)
+	case *CreateMaterializedView:
+		return VisitRefOfCreateMaterializedView(in, f)
+	case *CreatePolicy:
+		return VisitRefOfCreatePolicy(in, f)
+	case *CreatePublication:
+		return VisitRefOfCreatePublication(in, f)
+	case *CreateRule:
+		return VisitRefOfCreateRule(in, f)
 	case *CreateTable:
 		return VisitRefOfCreateTable(in, f)
+	case *CreateTrigger:
+		return VisitRefOfCreateTrigger(in, f)
+	case *CreateType:
+		return VisitRefOfCreateType(in, f)
 	case *CreateView:
 		return VisitRefOfCreateView(in, f)
+	case *CreateVirtualTable:
+		return VisitRefOfCreateVirtualTable(in, f)
 	case *DeallocateStmt:
 		return VisitRefOfDeallocateStmt(in, f)
 	case *Delete:
 		return VisitRefOfDelete(in, f)
 	case *DropDatabase:
 		return VisitRefOfDropDatabase(in, f)
+	case *DropFunction:
+		return VisitRefOfDropFunction(in, f)
 	case *DropTable:
 		return VisitRefOfDropTable(in, f)
 	case *DropView:
@@ -3779,6 +4686,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfExplainTab(in, f)
 	case *Flush:
 		return VisitRefOfFlush(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
 	case *Load:
@@ -3789,14 +4698,20 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *Pragma:
+		return VisitRefOfPragma(in, f)
 	case *PrepareStmt:
 		return VisitRefOfPrepareStmt(in, f)
+	case *RefreshMaterializedView:
+		return VisitRefOfRefreshMaterializedView(in, f)
 	case *Release:
 		return VisitRefOfRelease(in, f)
 	case *RenameTable:
 		return VisitRefOfRenameTable(in, f)
 	case *RevertMigration:
 		return VisitRefOfRevertMigration(in, f)
+	case *Revoke:
+		return VisitRefOfRevoke(in, f)
 	case *Rollback:
 		return VisitRefOfRollback(in, f)
 	case *SRollback:
@@ -157,7 +157,7 @@ func (cached *AlterColumn) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Column *vitess.io/vitess/go/vt/sql_parser.ColName
 	size += cached.Column.CachedSize(true)
@@ -167,6 +167,10 @@ func (cached *AlterColumn) CachedSize(alloc bool) int64 {
 	}
 	// field Invisible *bool
 	size += hack.RuntimeAllocSize(int64(1))
+	// field Identity string
+	size += hack.RuntimeAllocSize(int64(len(cached.Identity)))
+	// field IdentitySequence *vitess.io/vitess/go/vt/sql_parser.SequenceSpec
+	size += cached.IdentitySequence.CachedSize(true)
 	return size
 }
 func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
@@ -188,6 +192,66 @@ func (cached *AlterDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *AlterDefaultPrivileges) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Roles []*vitess.io/vitess/go/vt/sql_parser.RoleName
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Schemas []vitess.io/vitess/go/vt/sql_parser.TableIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Schemas)) * int64(16))
+		for _, elem := range cached.Schemas {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Action vitess.io/vitess/go/vt/sql_parser.Statement
+	if cc, ok := cached.Action.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *AlterFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Arguments []*vitess.io/vitess/go/vt/sql_parser.FunctionArgument
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(8))
+		for _, elem := range cached.Arguments {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field AlterOptions []vitess.io/vitess/go/vt/sql_parser.AlterOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.AlterOptions)) * int64(16))
+		for _, elem := range cached.AlterOptions {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
 func (cached *AlterIndex) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -200,6 +264,22 @@ func (cached *AlterIndex) CachedSize(alloc bool) int64 {
 	size += cached.Name.CachedSize(false)
 	return size
 }
+func (cached *AlterIndexPartition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field AttachPartition vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.AttachPartition.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
 func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -216,6 +296,91 @@ func (cached *AlterMigration) CachedSize(alloc bool) int64 {
 	size += cached.Ratio.CachedSize(true)
 	return size
 }
+func (cached *AlterObjectOwner) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field ObjectType string
+	size += hack.RuntimeAllocSize(int64(len(cached.ObjectType)))
+	// field Object vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Object.CachedSize(false)
+	// field Owner *vitess.io/vitess/go/vt/sql_parser.RoleName
+	size += cached.Owner.CachedSize(true)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *AlterOwner) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(8)
+	}
+	// field Owner *vitess.io/vitess/go/vt/sql_parser.RoleName
+	size += cached.Owner.CachedSize(true)
+	return size
+}
+func (cached *AlterPublication) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Action string
+	size += hack.RuntimeAllocSize(int64(len(cached.Action)))
+	// field Tables []*vitess.io/vitess/go/vt/sql_parser.PublicationTable
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(8))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Schemas []vitess.io/vitess/go/vt/sql_parser.TableIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Schemas)) * int64(16))
+		for _, elem := range cached.Schemas {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *AlterSequence) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Sequence vitess.io/vitess/go/vt/sql_parser.SequenceName
+	size += cached.Sequence.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field SequenceSpec *vitess.io/vitess/go/vt/sql_parser.SequenceSpec
+	size += cached.SequenceSpec.CachedSize(true)
+	// field AlterOptions []vitess.io/vitess/go/vt/sql_parser.AlterOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.AlterOptions)) * int64(16))
+		for _, elem := range cached.AlterOptions {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
 func (cached *AlterTable) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -509,7 +674,7 @@ func (cached *ColumnDefinition) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(176)
 	}
 	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -523,7 +688,7 @@ func (cached *ColumnType) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(144)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -542,6 +707,15 @@ func (cached *ColumnType) CachedSize(alloc bool) int64 {
 			size += hack.RuntimeAllocSize(int64(len(elem)))
 		}
 	}
+	// field TimeZone string
+	size += hack.RuntimeAllocSize(int64(len(cached.TimeZone)))
+	// field ArrayBounds []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ArrayBounds)) * int64(16))
+		for _, elem := range cached.ArrayBounds {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
 	return size
 }
 func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
@@ -550,7 +724,7 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(160)
+		size += int64(192)
 	}
 	// field Null *bool
 	size += hack.RuntimeAllocSize(int64(1))
@@ -566,6 +740,10 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.As.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field Identity string
+	size += hack.RuntimeAllocSize(int64(len(cached.Identity)))
+	// field IdentitySequence *vitess.io/vitess/go/vt/sql_parser.SequenceSpec
+	size += cached.IdentitySequence.CachedSize(true)
 	// field Comment *vitess.io/vitess/go/vt/sql_parser.Literal
 	size += cached.Comment.CachedSize(true)
 	// field Collate string
@@ -582,6 +760,53 @@ func (cached *ColumnTypeOptions) CachedSize(alloc bool) int64 {
 	size += cached.SRID.CachedSize(true)
 	return size
 }
+func (cached *CommentOn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
+	}
+	// field ObjectType string
+	size += hack.RuntimeAllocSize(int64(len(cached.ObjectType)))
+	// field Object vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Object.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Arguments []*vitess.io/vitess/go/vt/sql_parser.FunctionArgument
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(8))
+		for _, elem := range cached.Arguments {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Value vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CommentOnSchema) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Schema vitess.io/vitess/go/vt/sql_parser.SchemaIdent
+	size += cached.Schema.CachedSize(false)
+	// field Value vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
 func (cached *CommonTableExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -712,98 +937,457 @@ func (cached *CreateDatabase) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
-func (cached *CreateTable) CachedSize(alloc bool) int64 {
+func (cached *CreateDomain) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
-	}
-	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
-	size += cached.Table.CachedSize(false)
-	// field TableSpec *vitess.io/vitess/go/vt/sql_parser.TableSpec
-	size += cached.TableSpec.CachedSize(true)
-	// field OptLike *vitess.io/vitess/go/vt/sql_parser.OptLike
-	size += cached.OptLike.CachedSize(true)
-	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
-	size += cached.Comments.CachedSize(true)
-	return size
-}
-func (cached *CreateView) CachedSize(alloc bool) int64 {
-	if cached == nil {
-		return int64(0)
+		size += int64(112)
 	}
-	size := int64(0)
-	if alloc {
-		size += int64(144)
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Type *vitess.io/vitess/go/vt/sql_parser.ColumnType
+	size += cached.Type.CachedSize(true)
+	// field Collate string
+	size += hack.RuntimeAllocSize(int64(len(cached.Collate)))
+	// field Default vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Default.(cachedObject); ok {
+		size += cc.CachedSize(true)
 	}
-	// field ViewName vitess.io/vitess/go/vt/sql_parser.TableName
-	size += cached.ViewName.CachedSize(false)
-	// field Algorithm string
-	size += hack.RuntimeAllocSize(int64(len(cached.Algorithm)))
-	// field Definer *vitess.io/vitess/go/vt/sql_parser.Definer
-	size += cached.Definer.CachedSize(true)
-	// field Security string
-	size += hack.RuntimeAllocSize(int64(len(cached.Security)))
-	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
+	// field Constraints []*vitess.io/vitess/go/vt/sql_parser.DomainConstraint
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
-		for _, elem := range cached.Columns {
-			size += elem.CachedSize(false)
+		size += hack.RuntimeAllocSize(int64(cap(cached.Constraints)) * int64(8))
+		for _, elem := range cached.Constraints {
+			size += elem.CachedSize(true)
 		}
 	}
-	// field Select vitess.io/vitess/go/vt/sql_parser.SelectStatement
-	if cc, ok := cached.Select.(cachedObject); ok {
-		size += cc.CachedSize(true)
-	}
-	// field CheckOption string
-	size += hack.RuntimeAllocSize(int64(len(cached.CheckOption)))
 	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
 	size += cached.Comments.CachedSize(true)
 	return size
 }
-func (cached *CurTimeFuncExpr) CachedSize(alloc bool) int64 {
+func (cached *CreateExtension) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(96)
 	}
 	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
 	size += cached.Name.CachedSize(false)
-	// field Fsp vitess.io/vitess/go/vt/sql_parser.Expr
-	if cc, ok := cached.Fsp.(cachedObject); ok {
-		size += cc.CachedSize(true)
-	}
+	// field Schema vitess.io/vitess/go/vt/sql_parser.TableIdent
+	size += cached.Schema.CachedSize(false)
+	// field Version string
+	size += hack.RuntimeAllocSize(int64(len(cached.Version)))
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
 	return size
 }
-func (cached *DatabaseOption) CachedSize(alloc bool) int64 {
+func (cached *CreateFunction) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(112)
 	}
-	// field Value string
-	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Arguments []*vitess.io/vitess/go/vt/sql_parser.FunctionArgument
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(8))
+		for _, elem := range cached.Arguments {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Returns *vitess.io/vitess/go/vt/sql_parser.FunctionReturns
+	size += cached.Returns.CachedSize(true)
+	// field Options []*vitess.io/vitess/go/vt/sql_parser.FunctionOption
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Options)) * int64(8))
+		for _, elem := range cached.Options {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
 	return size
 }
-func (cached *DeallocateStmt) CachedSize(alloc bool) int64 {
+func (cached *CreateIndex) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(240)
 	}
-	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
-	size += cached.Comments.CachedSize(true)
 	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
 	size += cached.Name.CachedSize(false)
-	return size
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Method vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Method.CachedSize(false)
+	// field Columns []*vitess.io/vitess/go/vt/sql_parser.IndexElement
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(8))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Include vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Include)) * int64(40))
+		for _, elem := range cached.Include {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Parameters vitess.io/vitess/go/vt/sql_parser.StorageParameters
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Parameters)) * int64(8))
+		for _, elem := range cached.Parameters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Tablespace vitess.io/vitess/go/vt/sql_parser.TableIdent
+	size += cached.Tablespace.CachedSize(false)
+	// field Where *vitess.io/vitess/go/vt/sql_parser.Where
+	size += cached.Where.CachedSize(true)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateMaterializedView) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(176)
+	}
+	// field ViewName vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.ViewName.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Method vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Method.CachedSize(false)
+	// field Parameters vitess.io/vitess/go/vt/sql_parser.StorageParameters
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Parameters)) * int64(8))
+		for _, elem := range cached.Parameters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Tablespace vitess.io/vitess/go/vt/sql_parser.TableIdent
+	size += cached.Tablespace.CachedSize(false)
+	// field Select vitess.io/vitess/go/vt/sql_parser.SelectStatement
+	if cc, ok := cached.Select.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreatePolicy) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Command string
+	size += hack.RuntimeAllocSize(int64(len(cached.Command)))
+	// field Roles []*vitess.io/vitess/go/vt/sql_parser.RoleName
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Roles)) * int64(8))
+		for _, elem := range cached.Roles {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Using vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Using.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field WithCheck vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.WithCheck.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreatePublication) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Tables []*vitess.io/vitess/go/vt/sql_parser.PublicationTable
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Tables)) * int64(8))
+		for _, elem := range cached.Tables {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Schemas []vitess.io/vitess/go/vt/sql_parser.TableIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Schemas)) * int64(16))
+		for _, elem := range cached.Schemas {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Parameters vitess.io/vitess/go/vt/sql_parser.StorageParameters
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Parameters)) * int64(8))
+		for _, elem := range cached.Parameters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateRule) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Event string
+	size += hack.RuntimeAllocSize(int64(len(cached.Event)))
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Where vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Where.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Actions []vitess.io/vitess/go/vt/sql_parser.Statement
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Actions)) * int64(16))
+		for _, elem := range cached.Actions {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field TableSpec *vitess.io/vitess/go/vt/sql_parser.TableSpec
+	size += cached.TableSpec.CachedSize(true)
+	// field OptLike *vitess.io/vitess/go/vt/sql_parser.OptLike
+	size += cached.OptLike.CachedSize(true)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateTrigger) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(240)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Timing string
+	size += hack.RuntimeAllocSize(int64(len(cached.Timing)))
+	// field Events []*vitess.io/vitess/go/vt/sql_parser.TriggerEvent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Events)) * int64(8))
+		for _, elem := range cached.Events {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field When vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.When.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Function vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Function.CachedSize(false)
+	// field Arguments vitess.io/vitess/go/vt/sql_parser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(16))
+		for _, elem := range cached.Arguments {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Body []vitess.io/vitess/go/vt/sql_parser.Statement
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Body)) * int64(16))
+		for _, elem := range cached.Body {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateType) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Kind string
+	size += hack.RuntimeAllocSize(int64(len(cached.Kind)))
+	// field Labels []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Labels)) * int64(16))
+		for _, elem := range cached.Labels {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field Attributes []*vitess.io/vitess/go/vt/sql_parser.ColumnDefinition
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Attributes)) * int64(8))
+		for _, elem := range cached.Attributes {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Parameters vitess.io/vitess/go/vt/sql_parser.StorageParameters
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Parameters)) * int64(8))
+		for _, elem := range cached.Parameters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateView) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(144)
+	}
+	// field ViewName vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.ViewName.CachedSize(false)
+	// field Algorithm string
+	size += hack.RuntimeAllocSize(int64(len(cached.Algorithm)))
+	// field Definer *vitess.io/vitess/go/vt/sql_parser.Definer
+	size += cached.Definer.CachedSize(true)
+	// field Security string
+	size += hack.RuntimeAllocSize(int64(len(cached.Security)))
+	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Select vitess.io/vitess/go/vt/sql_parser.SelectStatement
+	if cc, ok := cached.Select.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field CheckOption string
+	size += hack.RuntimeAllocSize(int64(len(cached.CheckOption)))
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CreateVirtualTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Module vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Module.CachedSize(false)
+	// field Arguments string
+	size += hack.RuntimeAllocSize(int64(len(cached.Arguments)))
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *CurTimeFuncExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Fsp vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Fsp.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *DatabaseOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	return size
+}
+func (cached *DeallocateStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	return size
 }
 func (cached *Default) CachedSize(alloc bool) int64 {
 	if cached == nil {
@@ -893,6 +1477,24 @@ func (cached *DerivedTable) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *DomainConstraint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Null *bool
+	size += hack.RuntimeAllocSize(int64(1))
+	// field Check vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Check.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *DropColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -919,6 +1521,20 @@ func (cached *DropDatabase) CachedSize(alloc bool) int64 {
 	size += cached.DBName.CachedSize(false)
 	return size
 }
+func (cached *DropFunction) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
 func (cached *DropKey) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1104,38 +1720,143 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(80)
 	}
-	// field Source vitess.io/vitess/go/vt/sql_parser.Columns
+	// field Source vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Source)) * int64(40))
+		for _, elem := range cached.Source {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field IndexName vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.IndexName.CachedSize(false)
+	// field ReferenceDefinition *vitess.io/vitess/go/vt/sql_parser.ReferenceDefinition
+	size += cached.ReferenceDefinition.CachedSize(true)
+	return size
+}
+func (cached *FuncExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Qualifier vitess.io/vitess/go/vt/sql_parser.TableIdent
+	size += cached.Qualifier.CachedSize(false)
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Exprs vitess.io/vitess/go/vt/sql_parser.SelectExprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
+		for _, elem := range cached.Exprs {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *FunctionArgument) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field Type *vitess.io/vitess/go/vt/sql_parser.ColumnType
+	size += cached.Type.CachedSize(true)
+	// field Default vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Default.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *FunctionOption) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	return size
+}
+func (cached *FunctionReturns) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Type *vitess.io/vitess/go/vt/sql_parser.ColumnType
+	size += cached.Type.CachedSize(true)
+	// field Table []*vitess.io/vitess/go/vt/sql_parser.FunctionArgument
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Table)) * int64(8))
+		for _, elem := range cached.Table {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *Grant) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Privileges []*vitess.io/vitess/go/vt/sql_parser.GrantPrivilege
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ObjectType string
+	size += hack.RuntimeAllocSize(int64(len(cached.ObjectType)))
+	// field Objects []*vitess.io/vitess/go/vt/sql_parser.PrivilegeObject
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Objects)) * int64(8))
+		for _, elem := range cached.Objects {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Grantees []*vitess.io/vitess/go/vt/sql_parser.RoleName
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Source)) * int64(40))
-		for _, elem := range cached.Source {
-			size += elem.CachedSize(false)
+		size += hack.RuntimeAllocSize(int64(cap(cached.Grantees)) * int64(8))
+		for _, elem := range cached.Grantees {
+			size += elem.CachedSize(true)
 		}
 	}
-	// field IndexName vitess.io/vitess/go/vt/sql_parser.ColIdent
-	size += cached.IndexName.CachedSize(false)
-	// field ReferenceDefinition *vitess.io/vitess/go/vt/sql_parser.ReferenceDefinition
-	size += cached.ReferenceDefinition.CachedSize(true)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
 	return size
 }
-func (cached *FuncExpr) CachedSize(alloc bool) int64 {
+func (cached *GrantPrivilege) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(48)
 	}
-	// field Qualifier vitess.io/vitess/go/vt/sql_parser.TableIdent
-	size += cached.Qualifier.CachedSize(false)
-	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
-	size += cached.Name.CachedSize(false)
-	// field Exprs vitess.io/vitess/go/vt/sql_parser.SelectExprs
+	// field Type string
+	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
+	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.Exprs)) * int64(16))
-		for _, elem := range cached.Exprs {
-			if cc, ok := elem.(cachedObject); ok {
-				size += cc.CachedSize(true)
-			}
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
 		}
 	}
 	return size
@@ -1214,6 +1935,35 @@ func (cached *IndexDefinition) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *IndexElement) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(160)
+	}
+	// field Column vitess.io/vitess/go/vt/sql_parser.ColIdent
+	size += cached.Column.CachedSize(false)
+	// field Expression vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Expression.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Collate string
+	size += hack.RuntimeAllocSize(int64(len(cached.Collate)))
+	// field OpClass vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.OpClass.CachedSize(false)
+	// field OpClassParameters vitess.io/vitess/go/vt/sql_parser.StorageParameters
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OpClassParameters)) * int64(8))
+		for _, elem := range cached.OpClassParameters {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Nulls string
+	size += hack.RuntimeAllocSize(int64(len(cached.Nulls)))
+	return size
+}
 func (cached *IndexHint) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1907,7 +2657,7 @@ func (cached *JtPathColDef) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(224)
 	}
 	// field Name vitess.io/vitess/go/vt/sql_parser.ColIdent
 	size += cached.Name.CachedSize(false)
@@ -2283,7 +3033,7 @@ func (cached *PartitionSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(128)
 	}
 	// field Names vitess.io/vitess/go/vt/sql_parser.Partitions
 	{
@@ -2303,6 +3053,8 @@ func (cached *PartitionSpec) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field Bound *vitess.io/vitess/go/vt/sql_parser.PartitionValueRange
+	size += cached.Bound.CachedSize(true)
 	return size
 }
 func (cached *PartitionValueRange) CachedSize(alloc bool) int64 {
@@ -2311,7 +3063,7 @@ func (cached *PartitionValueRange) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(48)
+		size += int64(64)
 	}
 	// field Range vitess.io/vitess/go/vt/sql_parser.ValTuple
 	{
@@ -2322,6 +3074,29 @@ func (cached *PartitionValueRange) CachedSize(alloc bool) int64 {
 			}
 		}
 	}
+	// field To vitess.io/vitess/go/vt/sql_parser.ValTuple
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.To)) * int64(16))
+		for _, elem := range cached.To {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *Pragma) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
 	return size
 }
 func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
@@ -2342,6 +3117,48 @@ func (cached *PrepareStmt) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *PrivilegeObject) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field Arguments []*vitess.io/vitess/go/vt/sql_parser.FunctionArgument
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Arguments)) * int64(8))
+		for _, elem := range cached.Arguments {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
+func (cached *PublicationTable) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Table vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Table.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Where vitess.io/vitess/go/vt/sql_parser.Expr
+	if cc, ok := cached.Where.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *ReferenceDefinition) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2361,6 +3178,20 @@ func (cached *ReferenceDefinition) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *RefreshMaterializedView) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field ViewName vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.ViewName.CachedSize(false)
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
 func (cached *Release) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2444,6 +3275,65 @@ func (cached *RevertMigration) CachedSize(alloc bool) int64 {
 	size += cached.Comments.CachedSize(true)
 	return size
 }
+func (cached *Revoke) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Privileges []*vitess.io/vitess/go/vt/sql_parser.GrantPrivilege
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Privileges)) * int64(8))
+		for _, elem := range cached.Privileges {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ObjectType string
+	size += hack.RuntimeAllocSize(int64(len(cached.ObjectType)))
+	// field Objects []*vitess.io/vitess/go/vt/sql_parser.PrivilegeObject
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Objects)) * int64(8))
+		for _, elem := range cached.Objects {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Grantees []*vitess.io/vitess/go/vt/sql_parser.RoleName
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Grantees)) * int64(8))
+		for _, elem := range cached.Grantees {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Comments *vitess.io/vitess/go/vt/sql_parser.ParsedComments
+	size += cached.Comments.CachedSize(true)
+	return size
+}
+func (cached *RoleIdent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field V string
+	size += hack.RuntimeAllocSize(int64(len(cached.V)))
+	return size
+}
+func (cached *RoleName) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.RoleIdent
+	size += cached.Name.CachedSize(false)
+	return size
+}
 func (cached *RootNode) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2458,6 +3348,18 @@ func (cached *RootNode) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *RowLevelSecurity) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Action string
+	size += hack.RuntimeAllocSize(int64(len(cached.Action)))
+	return size
+}
 func (cached *SRollback) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2482,6 +3384,18 @@ func (cached *Savepoint) CachedSize(alloc bool) int64 {
 	size += cached.Name.CachedSize(false)
 	return size
 }
+func (cached *SchemaIdent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field V string
+	size += hack.RuntimeAllocSize(int64(len(cached.V)))
+	return size
+}
 func (cached *Select) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2562,6 +3476,58 @@ func (cached *SelectInto) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Overwrite)))
 	return size
 }
+func (cached *SequenceIdent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field V string
+	size += hack.RuntimeAllocSize(int64(len(cached.V)))
+	return size
+}
+func (cached *SequenceName) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.SequenceIdent
+	size += cached.Name.CachedSize(false)
+	// field Qualifier vitess.io/vitess/go/vt/sql_parser.SequenceIdent
+	size += cached.Qualifier.CachedSize(false)
+	return size
+}
+func (cached *SequenceSpec) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(112)
+	}
+	// field Name vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.Name.CachedSize(false)
+	// field DataType string
+	size += hack.RuntimeAllocSize(int64(len(cached.DataType)))
+	// field StartWith *int
+	size += hack.RuntimeAllocSize(int64(8))
+	// field IncrementBy *int
+	size += hack.RuntimeAllocSize(int64(8))
+	// field MinValue *int
+	size += hack.RuntimeAllocSize(int64(8))
+	// field MaxValue *int
+	size += hack.RuntimeAllocSize(int64(8))
+	// field Cache *int
+	size += hack.RuntimeAllocSize(int64(8))
+	// field Cycle *bool
+	size += hack.RuntimeAllocSize(int64(1))
+	return size
+}
 func (cached *Set) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2735,6 +3701,20 @@ func (cached *StarExpr) CachedSize(alloc bool) int64 {
 	size += cached.TableName.CachedSize(false)
 	return size
 }
+func (cached *StorageParameter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Value string
+	size += hack.RuntimeAllocSize(int64(len(cached.Value)))
+	return size
+}
 func (cached *Stream) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2917,7 +3897,7 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(144)
 	}
 	// field Columns []*vitess.io/vitess/go/vt/sql_parser.ColumnDefinition
 	{
@@ -2949,6 +3929,10 @@ func (cached *TableSpec) CachedSize(alloc bool) int64 {
 	}
 	// field PartitionOption *vitess.io/vitess/go/vt/sql_parser.PartitionOption
 	size += cached.PartitionOption.CachedSize(true)
+	// field PartitionOf vitess.io/vitess/go/vt/sql_parser.TableName
+	size += cached.PartitionOf.CachedSize(false)
+	// field PartitionBound *vitess.io/vitess/go/vt/sql_parser.PartitionValueRange
+	size += cached.PartitionBound.CachedSize(true)
 	return size
 }
 func (cached *TablespaceOperation) CachedSize(alloc bool) int64 {
@@ -2983,6 +3967,25 @@ func (cached *TimestampFuncExpr) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Unit)))
 	return size
 }
+func (cached *TriggerEvent) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Type string
+	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
+	// field Columns vitess.io/vitess/go/vt/sql_parser.Columns
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
 func (cached *TrimFuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
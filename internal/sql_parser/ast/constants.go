@@ -73,9 +73,19 @@ const (
 	RangeTypeStr = "range"
 	ListTypeStr  = "list"
 
+	// Time zone strings of the time and timestamp types
+	WithTimeZoneStr    = "with time zone"
+	WithoutTimeZoneStr = "without time zone"
+
+	// Identity column strings
+	IdentityAlwaysStr    = "always"
+	IdentityByDefaultStr = "by default"
+
 	// Partition value range type strings
 	LessThanTypeStr = "less than"
 	InTypeStr       = "in"
+	FromToTypeStr   = "from"
+	ModulusTypeStr  = "with"
 
 	// Online DDL hint
 	OnlineStr = "online"
@@ -99,6 +109,8 @@ const (
 	RepairStr            = "repair partition"
 	RemoveStr            = "remove partitioning"
 	UpgradeStr           = "upgrade partitioning"
+	AttachPartitionStr   = "attach partition"
+	DetachPartitionStr   = "detach partition"
 
 	// JoinTableExpr.Join
 	JoinStr             = "join"
@@ -124,19 +136,23 @@ const (
 	HavingStr = "having"
 
 	// ComparisonExpr.Operator
-	EqualStr         = "="
-	LessThanStr      = "<"
-	GreaterThanStr   = ">"
-	LessEqualStr     = "<="
-	GreaterEqualStr  = ">="
-	NotEqualStr      = "!="
-	NullSafeEqualStr = "<=>"
-	InStr            = "in"
-	NotInStr         = "not in"
-	LikeStr          = "like"
-	NotLikeStr       = "not like"
-	RegexpStr        = "regexp"
-	NotRegexpStr     = "not regexp"
+	EqualStr          = "="
+	LessThanStr       = "<"
+	GreaterThanStr    = ">"
+	LessEqualStr      = "<="
+	GreaterEqualStr   = ">="
+	NotEqualStr       = "!="
+	NullSafeEqualStr  = "<=>"
+	InStr             = "in"
+	NotInStr          = "not in"
+	LikeStr           = "like"
+	NotLikeStr        = "not like"
+	RegexpStr         = "regexp"
+	NotRegexpStr      = "not regexp"
+	PosixMatchStr     = "~"
+	PosixIMatchStr    = "~*"
+	NotPosixMatchStr  = "!~"
+	NotPosixIMatchStr = "!~*"
 
 	// IsExpr.Operator
 	IsNullStr     = "is null"
@@ -160,6 +176,7 @@ const (
 	ShiftRightStr           = ">>"
 	JSONExtractOpStr        = "->"
 	JSONUnquoteExtractOpStr = "->>"
+	ConcatStr               = "||"
 
 	// UnaryExpr.Operator
 	UPlusStr    = "+"
@@ -240,6 +257,118 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// IndexElement.Nulls
+	NullsFirstStr = "nulls first"
+	NullsLastStr  = "nulls last"
+
+	// FunctionOption.Value
+	ImmutableStr              = "immutable"
+	StableStr                 = "stable"
+	VolatileStr               = "volatile"
+	LeakproofStr              = "leakproof"
+	NotLeakproofStr           = "not leakproof"
+	StrictStr                 = "strict"
+	CalledOnNullInputStr      = "called on null input"
+	ReturnsNullOnNullInputStr = "returns null on null input"
+	SecurityDefinerStr        = "definer"
+	SecurityInvokerStr        = "invoker"
+
+	// CreateType.Kind
+	TypeEnumStr      = "enum"
+	TypeCompositeStr = "composite"
+	TypeRangeStr     = "range"
+
+	// CreateTrigger.Timing
+	TriggerBeforeStr    = "before"
+	TriggerAfterStr     = "after"
+	TriggerInsteadOfStr = "instead of"
+
+	// TriggerEvent.Type
+	TriggerInsertStr   = "insert"
+	TriggerUpdateStr   = "update"
+	TriggerDeleteStr   = "delete"
+	TriggerTruncateStr = "truncate"
+
+	// RowLevelSecurity.Action
+	RowLevelSecurityEnableStr  = "enable"
+	RowLevelSecurityDisableStr = "disable"
+	RowLevelSecurityForceStr   = "force"
+	RowLevelSecurityNoForceStr = "no force"
+
+	// CreatePolicy.Command
+	PolicyAllStr    = "all"
+	PolicySelectStr = "select"
+	PolicyInsertStr = "insert"
+	PolicyUpdateStr = "update"
+	PolicyDeleteStr = "delete"
+
+	// AlterPublication.Action
+	PublicationAddStr  = "add"
+	PublicationSetStr  = "set"
+	PublicationDropStr = "drop"
+
+	// CreateRule.Event
+	RuleSelectStr = "select"
+	RuleInsertStr = "insert"
+	RuleUpdateStr = "update"
+	RuleDeleteStr = "delete"
+
+	// CommentOn.ObjectType, Grant.ObjectType, Revoke.ObjectType, AlterObjectOwner.ObjectType
+	TableObjectStr              = "table"
+	ColumnObjectStr             = "column"
+	ViewObjectStr               = "view"
+	MaterializedViewObjectStr   = "materialized view"
+	ForeignTableObjectStr       = "foreign table"
+	IndexObjectStr              = "index"
+	SequenceObjectStr           = "sequence"
+	TypeObjectStr               = "type"
+	DomainObjectStr             = "domain"
+	FunctionObjectStr           = "function"
+	ProcedureObjectStr          = "procedure"
+	RoutineObjectStr            = "routine"
+	SchemaObjectStr             = "schema"
+	DatabaseObjectStr           = "database"
+	ExtensionObjectStr          = "extension"
+	ConstraintObjectStr         = "constraint"
+	TriggerObjectStr            = "trigger"
+	LanguageObjectStr           = "language"
+	TablespaceObjectStr         = "tablespace"
+	ForeignDataWrapperObjectStr = "foreign data wrapper"
+	ForeignServerObjectStr      = "foreign server"
+	PolicyObjectStr             = "policy"
+	PublicationObjectStr        = "publication"
+	RuleObjectStr               = "rule"
+
+	// Grant.ObjectType, Revoke.ObjectType for ALL ... IN SCHEMA and ALTER DEFAULT PRIVILEGES
+	TablesObjectStr     = "tables"
+	SequencesObjectStr  = "sequences"
+	FunctionsObjectStr  = "functions"
+	ProceduresObjectStr = "procedures"
+	RoutinesObjectStr   = "routines"
+	TypesObjectStr      = "types"
+	SchemasObjectStr    = "schemas"
+
+	// GrantPrivilege.Type
+	AllPrivilegeStr         = "all"
+	SelectPrivilegeStr      = "select"
+	InsertPrivilegeStr      = "insert"
+	UpdatePrivilegeStr      = "update"
+	DeletePrivilegeStr      = "delete"
+	TruncatePrivilegeStr    = "truncate"
+	ReferencesPrivilegeStr  = "references"
+	TriggerPrivilegeStr     = "trigger"
+	CreatePrivilegeStr      = "create"
+	TemporaryPrivilegeStr   = "temporary"
+	ExecutePrivilegeStr     = "execute"
+	SetPrivilegeStr         = "set"
+	AlterSystemPrivilegeStr = "alter system"
+
+	// RoleName.Name for the special role specifications
+	PublicRoleStr      = "public"
+	CurrentUserRoleStr = "current_user"
+	SessionUserRoleStr = "session_user"
+	CurrentRoleStr     = "current_role"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -386,6 +515,25 @@ const (
 	CopyOptionOnErrorStr            = "on_error"
 	CopyOptionEncodingStr           = "encoding"
 	CopyOptionHeaderLogVerbosityStr = "log_verbosity"
+
+	// FunctionArgumentModes strings
+	FunctionArgumentInStr       = "in"
+	FunctionArgumentOutStr      = "out"
+	FunctionArgumentInOutStr    = "inout"
+	FunctionArgumentVariadicStr = "variadic"
+
+	// FunctionOptionTypes strings
+	FunctionOptionLanguageStr   = "language"
+	FunctionOptionWindowStr     = "window"
+	FunctionOptionVolatilityStr = "volatility"
+	FunctionOptionLeakproofStr  = "leakproof"
+	FunctionOptionNullInputStr  = "null input"
+	FunctionOptionSecurityStr   = "security"
+	FunctionOptionParallelStr   = "parallel"
+	FunctionOptionCostStr       = "cost"
+	FunctionOptionRowsStr       = "rows"
+	FunctionOptionSetStr        = "set"
+	FunctionOptionAsStr         = "as"
 )
 
 // Constants for Enum type - AccessMode
@@ -514,6 +662,10 @@ const (
 	NotLikeOp
 	RegexpOp
 	NotRegexpOp
+	PosixMatchOp
+	PosixIMatchOp
+	NotPosixMatchOp
+	NotPosixIMatchOp
 )
 
 // Constant for Enum Type - IsExprOperator
@@ -541,6 +693,7 @@ const (
 	ShiftRightOp
 	JSONExtractOp
 	JSONUnquoteExtractOp
+	ConcatOp
 )
 
 // Constant for Enum Type - UnaryExprOperator
@@ -599,6 +752,8 @@ const (
 	RepairAction
 	RemoveAction
 	UpgradeAction
+	AttachAction
+	DetachAction
 )
 
 // Constant for Enum Type - PartitionByType
@@ -613,6 +768,8 @@ const (
 const (
 	LessThanType PartitionValueRangeType = iota
 	InType
+	FromToType
+	ModulusType
 )
 
 // Constant for Enum Type - ExplainType
@@ -804,3 +961,27 @@ const (
 	CopyOptionEncoding
 	CopyOptionHeaderLogVerbosity
 )
+
+// FunctionArgumentModes constants
+const (
+	FunctionArgumentDefault FunctionArgumentMode = iota
+	FunctionArgumentIn
+	FunctionArgumentOut
+	FunctionArgumentInOut
+	FunctionArgumentVariadic
+)
+
+// FunctionOptionTypes constants
+const (
+	FunctionOptionLanguage FunctionOptionType = iota
+	FunctionOptionWindow
+	FunctionOptionVolatility
+	FunctionOptionLeakproof
+	FunctionOptionNullInput
+	FunctionOptionSecurity
+	FunctionOptionParallel
+	FunctionOptionCost
+	FunctionOptionRows
+	FunctionOptionSet
+	FunctionOptionAs
+)
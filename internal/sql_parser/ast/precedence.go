@@ -59,7 +59,7 @@ func precedenceFor(in Expr) Precendence {
 		return P12
 	case *ComparisonExpr:
 		switch node.Operator {
-		case EqualOp, NotEqualOp, GreaterThanOp, GreaterEqualOp, LessThanOp, LessEqualOp, LikeOp, InOp, RegexpOp:
+		case EqualOp, NotEqualOp, GreaterThanOp, GreaterEqualOp, LessThanOp, LessEqualOp, LikeOp, InOp, RegexpOp, PosixMatchOp, PosixIMatchOp, NotPosixMatchOp, NotPosixIMatchOp:
 			return P11
 		}
 	case *IsExpr:
@@ -76,7 +76,7 @@ func precedenceFor(in Expr) Precendence {
 			return P7
 		case DivOp, MultOp, ModOp, IntDivOp:
 			return P6
-		case BitXorOp:
+		case BitXorOp, ConcatOp:
 			return P5
 		}
 	case *UnaryExpr:
@@ -86,7 +86,7 @@ func precedenceFor(in Expr) Precendence {
 		case BangOp:
 			return P3
 		}
-	case *IntervalExpr:
+	case *IntervalExpr, *TypeCastExpr:
 		return P1
 	case *ExtractedSubquery:
 		return precedenceFor(node.alternative)
@@ -19,6 +19,8 @@ package ast
 import (
 	"fmt"
 	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
 )
 
 type bindLocation struct {
@@ -43,6 +45,7 @@ type TrackedBuffer struct {
 	literal       func(string) (int, error)
 	escape        bool
 	fast          bool
+	dialect       dialect.SqlDialect
 }
 
 // NewTrackedBuffer creates a new TrackedBuffer.
@@ -90,6 +93,23 @@ func (buf *TrackedBuffer) SetEscapeAllIdentifiers(enable bool) {
 	buf.escape = enable
 }
 
+// SetDialect sets the sql dialect the statements formatted by this TrackedBuffer are written for:
+// the quoting of the identifiers, the escaping of the string literals and the dialect specific
+// syntax (casts, FROM DUAL). By default, statements are formatted for MySQL.
+// Setting the dialect will prevent the optimized fastFormat routines from running.
+func (buf *TrackedBuffer) SetDialect(sqlDialect dialect.SqlDialect) {
+	buf.fast = false
+	buf.dialect = sqlDialect
+}
+
+// Dialect returns the sql dialect of the formatted statements.
+func (buf *TrackedBuffer) Dialect() dialect.SqlDialect {
+	if buf.dialect == 0 {
+		return dialect.MYSQL
+	}
+	return buf.dialect
+}
+
 // WriteNode function, initiates the writing of a single SQLNode tree by passing
 // through to Myprintf with a default format string
 func (buf *TrackedBuffer) WriteNode(node SQLNode) *TrackedBuffer {
@@ -308,3 +328,16 @@ func CanonicalString(node SQLNode) string {
 	node.Format(buf)
 	return buf.String()
 }
+
+// DialectString returns a string representation of an SQLNode for the sql dialect, the identifiers
+// and the string literals are quoted the way the dialect expects.
+func DialectString(node SQLNode, sqlDialect dialect.SqlDialect) string {
+	if node == nil {
+		return ""
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.SetDialect(sqlDialect)
+	node.Format(buf)
+	return buf.String()
+}
@@ -0,0 +1,225 @@
+package sql_parser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_parser/tokenizer"
+)
+
+const (
+	// EXCERPT_WIDTH is the maximal count of runes in the source excerpt,
+	// the long lines (like the extended INSERT of mysqldump) are cut around the error
+	EXCERPT_WIDTH = 80
+	// EXPECTED_TOKENS_LIMIT is the maximal count of the expected tokens in the text output
+	EXPECTED_TOKENS_LIMIT = 10
+)
+
+// DiagnosticFormat defines how the parse errors are reported
+type DiagnosticFormat uint8
+
+const (
+	DIAGNOSTIC_TEXT DiagnosticFormat = 0 // Human readable text with the source excerpt and the caret
+	DIAGNOSTIC_JSON DiagnosticFormat = 1 // Single line JSON object per error
+)
+
+func (format DiagnosticFormat) String() string {
+	switch format {
+	case DIAGNOSTIC_TEXT:
+		return "text"
+	case DIAGNOSTIC_JSON:
+		return "json"
+	}
+	return "undefined"
+}
+
+// ParseDiagnosticFormat converts cli option value (text|json) to the DiagnosticFormat
+func ParseDiagnosticFormat(value string) (DiagnosticFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "text", "":
+		return DIAGNOSTIC_TEXT, nil
+	case "json":
+		return DIAGNOSTIC_JSON, nil
+	}
+	return DIAGNOSTIC_TEXT, fmt.Errorf("unknown error format: %v, expected one of text|json", value)
+}
+
+// Format returns the diagnostic in the format
+func (format DiagnosticFormat) Format(diagnostic *Diagnostic) string {
+	if format == DIAGNOSTIC_JSON {
+		return diagnostic.JSON()
+	}
+	return diagnostic.Text()
+}
+
+// SourcePosition is a position in the source entry
+type SourcePosition struct {
+	Line   int64 // 1-based line
+	Column int64 // 1-based column, counted in runes
+	Offset int64 // 0-based byte offset
+}
+
+// StartPosition is the position of the beginning of the source entry
+var StartPosition = SourcePosition{Line: 1, Column: 1}
+
+// Advance returns the position after the text
+func (position SourcePosition) Advance(text string) SourcePosition {
+	position.Offset += int64(len(text))
+	if lastNewLine := strings.LastIndexByte(text, '\n'); lastNewLine >= 0 {
+		position.Line += int64(strings.Count(text, "\n"))
+		position.Column = 1
+		text = text[lastNewLine+1:]
+	}
+	position.Column += int64(utf8.RuneCountInString(text))
+	return position
+}
+
+// Diagnostic is the parse error positioned in the source entry
+type Diagnostic struct {
+	Source    string   `json:"source,omitempty"`   // File name (and archive entry) of the statement
+	Line      int64    `json:"line"`               // 1-based line of the error
+	Column    int64    `json:"column"`             // 1-based column of the error, counted in runes
+	Offset    int64    `json:"offset"`             // 0-based byte offset of the error
+	Statement string   `json:"statement"`          // Statement kind guessed by ast.Preview
+	Message   string   `json:"message"`            // Parser message
+	Near      string   `json:"near,omitempty"`     // Token the error is found at
+	Expected  []string `json:"expected,omitempty"` // Tokens the parser accepts at the position
+	Excerpt   string   `json:"excerpt"`            // Source line of the error
+	Caret     int      `json:"caret"`              // 0-based rune index of the error in the excerpt
+}
+
+// NewDiagnostic positions the parse error of the statement text, the statement text
+// starts at the position in the source entry. The statement is parsed again if the
+// parse error lost the position (the errors returned by Parse keep the message only).
+func NewDiagnostic(statementText string, position SourcePosition, sqlDialect dialect.SqlDialect, parseError error) *Diagnostic {
+	diagnostic := &Diagnostic{
+		Statement: ast.Preview(statementText).String(),
+		Message:   fmt.Sprint(parseError),
+	}
+
+	var positionedErr tokenizer.PositionedErr
+	found := errors.As(parseError, &positionedErr)
+	if !found {
+		if _tokenizer, err := NewStringTokenizer(statementText, sqlDialect); err == nil {
+			if val, _ := parsePooled(_tokenizer, sqlDialect); val != 0 {
+				found = errors.As(_tokenizer.GetLastError(), &positionedErr)
+			}
+		}
+	}
+
+	// The error is at the beginning of the statement if the position is unknown
+	errorPos := len(statementText) - len(strings.TrimLeftFunc(statementText, unicode.IsSpace))
+	if found {
+		diagnostic.Message = positionedErr.Err
+		diagnostic.Near = positionedErr.Near
+		diagnostic.Expected = positionedErr.Expected
+		// The position is 1-based and points after the token the error is found at
+		errorPos = min(max(positionedErr.Pos-1, 0), len(statementText))
+		if positionedErr.Near != "" {
+			if nearPos := strings.LastIndex(statementText[:errorPos], positionedErr.Near); nearPos >= 0 {
+				errorPos = nearPos
+			}
+		} else if ch, size := utf8.DecodeLastRuneInString(statementText[:errorPos]); unicode.IsPunct(ch) || unicode.IsSymbol(ch) {
+			// The punctuation tokens keep no value, the position points after the character
+			errorPos -= size
+		}
+	}
+
+	errorPosition := position.Advance(statementText[:errorPos])
+	diagnostic.Line = errorPosition.Line
+	diagnostic.Column = errorPosition.Column
+	diagnostic.Offset = errorPosition.Offset
+
+	lineStart := strings.LastIndexByte(statementText[:errorPos], '\n') + 1
+	lineEnd := strings.IndexByte(statementText[errorPos:], '\n')
+	if lineEnd < 0 {
+		lineEnd = len(statementText)
+	} else {
+		lineEnd += errorPos
+	}
+	diagnostic.Excerpt, diagnostic.Caret = excerpt(
+		[]rune(strings.TrimRight(statementText[lineStart:lineEnd], "\r")),
+		utf8.RuneCountInString(statementText[lineStart:errorPos]),
+	)
+	return diagnostic
+}
+
+// excerpt cuts the line around the caret to EXCERPT_WIDTH runes
+func excerpt(line []rune, caret int) (string, int) {
+	if len(line) <= EXCERPT_WIDTH {
+		return string(line), caret
+	}
+	start := max(caret-EXCERPT_WIDTH/2, 0)
+	end := min(start+EXCERPT_WIDTH, len(line))
+	start = max(end-EXCERPT_WIDTH, 0)
+	text := string(line[start:end])
+	caret -= start
+	if start > 0 {
+		text = "..." + text
+		caret += 3
+	}
+	if end < len(line) {
+		text += "..."
+	}
+	return text, caret
+}
+
+// Text returns the diagnostic as a human readable text:
+//
+//	dump.sql:3:21: syntax error: unexpected STRING near 'a' (INSERT statement)
+//	    3 | INSERT INTO t VALUE 'a';
+//	      |                     ^
+//	expected: '(' or LIST_ARG
+func (diagnostic *Diagnostic) Text() string {
+	text := strings.Builder{}
+	if diagnostic.Source != "" {
+		text.WriteString(diagnostic.Source)
+		text.WriteByte(':')
+	}
+	fmt.Fprintf(&text, "%d:%d: %s", diagnostic.Line, diagnostic.Column, diagnostic.Message)
+	if diagnostic.Near != "" {
+		fmt.Fprintf(&text, " near '%s'", diagnostic.Near)
+	}
+	fmt.Fprintf(&text, " (%s statement)\n", diagnostic.Statement)
+
+	lineNumber := fmt.Sprint(diagnostic.Line)
+	fmt.Fprintf(&text, "    %s | %s\n", lineNumber, diagnostic.Excerpt)
+	text.WriteString("    ")
+	text.WriteString(strings.Repeat(" ", len(lineNumber)))
+	text.WriteString(" | ")
+	// The tabs are kept to align the caret with the excerpt
+	for i, ch := range []rune(diagnostic.Excerpt) {
+		if i >= diagnostic.Caret {
+			break
+		}
+		if ch == '\t' {
+			text.WriteByte('\t')
+		} else {
+			text.WriteByte(' ')
+		}
+	}
+	text.WriteString("^\n")
+
+	if len(diagnostic.Expected) > EXPECTED_TOKENS_LIMIT {
+		fmt.Fprintf(&text, "expected: %s or %d more\n",
+			strings.Join(diagnostic.Expected[:EXPECTED_TOKENS_LIMIT], ", "), len(diagnostic.Expected)-EXPECTED_TOKENS_LIMIT)
+	} else if len(diagnostic.Expected) > 0 {
+		fmt.Fprintf(&text, "expected: %s\n", strings.Join(diagnostic.Expected, " or "))
+	}
+	return text.String()
+}
+
+// JSON returns the diagnostic as a single line JSON object
+func (diagnostic *Diagnostic) JSON() string {
+	data, err := json.Marshal(diagnostic)
+	if err != nil {
+		return fmt.Sprintf(`{"message": %q}`, err.Error())
+	}
+	return string(data)
+}
@@ -54,3 +54,21 @@ func (dialect *SqlDialect) ParseUrl(url string) (SqlDialect, string, error) {
 	}
 	return 0, inputComponents[1], fmt.Errorf("unknown driver identity: %v, for url: %v", driverId, url)
 }
+
+// Parse dialect name.
+// Example.
+//   - input: 	postgres
+//   - output:	PSQL, nil
+//
+// The names are the url driver identities and their common aliases.
+func (dialect *SqlDialect) ParseName(name string) (SqlDialect, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "mysql", "mariadb":
+		return MYSQL, nil
+	case "pg", "psql", "postgres", "postgresql":
+		return PSQL, nil
+	case "sqlite3", "sqlite":
+		return SQLITE3, nil
+	}
+	return 0, fmt.Errorf("unknown dialect name: %v", name)
+}
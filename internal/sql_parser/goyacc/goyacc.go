@@ -3368,6 +3368,11 @@ type $$Lexer interface {
 	Error(s string)
 }
 
+// $$ExpectedLexer is implemented by the lexers that report the expected tokens of the syntax error
+type $$ExpectedLexer interface {
+	SetExpectedTokens(tokens []string)
+}
+
 type $$Parser interface {
 	Parse($$Lexer) int
 	Lookahead() int
@@ -3471,6 +3476,43 @@ func $$ErrorMessage(state, lookAhead int) string {
 	return res
 }
 
+// $$ExpectedTokens returns the names of all tokens the parser accepts in the state,
+// nil is returned if the default action of the state is to accept or reduce
+func $$ExpectedTokens(state int) []string {
+	const TOKSTART = 4
+
+	expected := make([]string, 0)
+
+	// Look for shiftable tokens.
+	base := $$Pact[state]
+	for tok := TOKSTART; tok-1 < len($$Toknames); tok++ {
+		if n := base + tok; n >= 0 && n < $$Last && $$Chk[$$Act[n]] == tok {
+			expected = append(expected, $$Tokname(tok))
+		}
+	}
+
+	if $$Def[state] == -2 {
+		i := 0
+		for $$Exca[i] != -1 || $$Exca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; $$Exca[i] >= 0; i += 2 {
+			tok := $$Exca[i]
+			if tok < TOKSTART || $$Exca[i+1] == 0 {
+				continue
+			}
+			expected = append(expected, $$Tokname(tok))
+		}
+
+		if $$Exca[i+1] != 0 {
+			return nil
+		}
+	}
+	return expected
+}
+
 func $$lex1(lex $$Lexer, lval *$$SymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
@@ -3611,6 +3653,9 @@ $$default:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
+			if lexer, ok := $$lex.($$ExpectedLexer); ok {
+				lexer.SetExpectedTokens($$ExpectedTokens($$state))
+			}
 			$$lex.Error($$ErrorMessage($$state, $$token))
 			Nerrs++
 			if $$Debug >= 1 {
@@ -1,4 +1,4 @@
-// Code generated by goyacc -v y.output -fast-append -p mysq -o mysql.go mysql.y. DO NOT EDIT.
+// Code generated by goyacc -o mysql.go -fast-append -p mysq mysql.y. DO NOT EDIT.
 
 //line mysql.y:18
 package mysql
@@ -47,6 +47,14 @@ func skipToEnd(mysqlex mysqLexer) {
 	mysqlex.(tokenizer.Tokenizer).SetSkipToEnd(true)
 }
 
+// skipRoutineToEnd makes the lexer return EOF at the end of the text, the body of the trigger
+// or the routine isn't parsed and its semicolons don't end the statement.
+func skipRoutineToEnd(mysqlex mysqLexer) {
+	tkn := mysqlex.(*MysqlTokenizer)
+	tkn.SkipToEnd = true
+	tkn.skipRoutine = true
+}
+
 func bindVariable(mysqlex mysqLexer, bvar string) {
 	mysqlex.(tokenizer.Tokenizer).BindVar(bvar, struct{}{})
 }
@@ -325,312 +333,313 @@ const INVOKER = 57616
 const SECURITY = 57617
 const FIRST = 57618
 const AFTER = 57619
-const LAST = 57620
-const CANCEL = 57621
-const RETRY = 57622
-const COMPLETE = 57623
-const CLEANUP = 57624
-const THROTTLE = 57625
-const UNTHROTTLE = 57626
-const EXPIRE = 57627
-const RATIO = 57628
-const BEGIN = 57629
-const START = 57630
-const TRANSACTION = 57631
-const COMMIT = 57632
-const ROLLBACK = 57633
-const SAVEPOINT = 57634
-const RELEASE = 57635
-const WORK = 57636
-const BIT = 57637
-const TINYINT = 57638
-const SMALLINT = 57639
-const MEDIUMINT = 57640
-const INT = 57641
-const INTEGER = 57642
-const BIGINT = 57643
-const INTNUM = 57644
-const REAL = 57645
-const DOUBLE = 57646
-const FLOAT_TYPE = 57647
-const DECIMAL_TYPE = 57648
-const NUMERIC = 57649
-const TIME = 57650
-const TIMESTAMP = 57651
-const DATETIME = 57652
-const YEAR = 57653
-const CHAR = 57654
-const VARCHAR = 57655
-const BOOL = 57656
-const CHARACTER = 57657
-const VARBINARY = 57658
-const NCHAR = 57659
-const TEXT = 57660
-const TINYTEXT = 57661
-const MEDIUMTEXT = 57662
-const LONGTEXT = 57663
-const BLOB = 57664
-const TINYBLOB = 57665
-const MEDIUMBLOB = 57666
-const LONGBLOB = 57667
-const JSON = 57668
-const JSON_SCHEMA_VALID = 57669
-const JSON_SCHEMA_VALIDATION_REPORT = 57670
-const ENUM = 57671
-const GEOMETRY = 57672
-const POINT = 57673
-const LINESTRING = 57674
-const POLYGON = 57675
-const GEOMETRYCOLLECTION = 57676
-const MULTIPOINT = 57677
-const MULTILINESTRING = 57678
-const MULTIPOLYGON = 57679
-const ASCII = 57680
-const UNICODE = 57681
-const NULLX = 57682
-const AUTO_INCREMENT = 57683
-const APPROXNUM = 57684
-const SIGNED = 57685
-const UNSIGNED = 57686
-const ZEROFILL = 57687
-const CODE = 57688
-const COLLATION = 57689
-const COLUMNS = 57690
-const DATABASES = 57691
-const ENGINES = 57692
-const EVENT = 57693
-const EXTENDED = 57694
-const FIELDS = 57695
-const FULL = 57696
-const FUNCTION = 57697
-const GTID_EXECUTED = 57698
-const KEYSPACES = 57699
-const OPEN = 57700
-const PLUGINS = 57701
-const PRIVILEGES = 57702
-const PROCESSLIST = 57703
-const SCHEMAS = 57704
-const TABLES = 57705
-const TRIGGERS = 57706
-const USER = 57707
-const VGTID_EXECUTED = 57708
-const VSCHEMA = 57709
-const NAMES = 57710
-const GLOBAL = 57711
-const SESSION = 57712
-const ISOLATION = 57713
-const LEVEL = 57714
-const READ = 57715
-const WRITE = 57716
-const ONLY = 57717
-const REPEATABLE = 57718
-const COMMITTED = 57719
-const UNCOMMITTED = 57720
-const SERIALIZABLE = 57721
-const CURRENT_TIMESTAMP = 57722
-const DATABASE = 57723
-const CURRENT_DATE = 57724
-const NOW = 57725
-const CURRENT_TIME = 57726
-const LOCALTIME = 57727
-const LOCALTIMESTAMP = 57728
-const CURRENT_USER = 57729
-const UTC_DATE = 57730
-const UTC_TIME = 57731
-const UTC_TIMESTAMP = 57732
-const DAY = 57733
-const DAY_HOUR = 57734
-const DAY_MICROSECOND = 57735
-const DAY_MINUTE = 57736
-const DAY_SECOND = 57737
-const HOUR = 57738
-const HOUR_MICROSECOND = 57739
-const HOUR_MINUTE = 57740
-const HOUR_SECOND = 57741
-const MICROSECOND = 57742
-const MINUTE = 57743
-const MINUTE_MICROSECOND = 57744
-const MINUTE_SECOND = 57745
-const MONTH = 57746
-const QUARTER = 57747
-const SECOND = 57748
-const SECOND_MICROSECOND = 57749
-const YEAR_MONTH = 57750
-const WEEK = 57751
-const REPLACE = 57752
-const CONVERT = 57753
-const CAST = 57754
-const SUBSTR = 57755
-const SUBSTRING = 57756
-const GROUP_CONCAT = 57757
-const SEPARATOR = 57758
-const TIMESTAMPADD = 57759
-const TIMESTAMPDIFF = 57760
-const WEIGHT_STRING = 57761
-const LTRIM = 57762
-const RTRIM = 57763
-const TRIM = 57764
-const JSON_ARRAY = 57765
-const JSON_OBJECT = 57766
-const JSON_QUOTE = 57767
-const JSON_DEPTH = 57768
-const JSON_TYPE = 57769
-const JSON_LENGTH = 57770
-const JSON_VALID = 57771
-const JSON_ARRAY_APPEND = 57772
-const JSON_ARRAY_INSERT = 57773
-const JSON_INSERT = 57774
-const JSON_MERGE = 57775
-const JSON_MERGE_PATCH = 57776
-const JSON_MERGE_PRESERVE = 57777
-const JSON_REMOVE = 57778
-const JSON_REPLACE = 57779
-const JSON_SET = 57780
-const JSON_UNQUOTE = 57781
-const MATCH = 57782
-const AGAINST = 57783
-const BOOLEAN = 57784
-const LANGUAGE = 57785
-const WITH = 57786
-const QUERY = 57787
-const EXPANSION = 57788
-const WITHOUT = 57789
-const VALIDATION = 57790
-const UNUSED = 57791
-const ARRAY = 57792
-const BYTE = 57793
-const CUME_DIST = 57794
-const DESCRIPTION = 57795
-const DENSE_RANK = 57796
-const EMPTY = 57797
-const EXCEPT = 57798
-const FIRST_VALUE = 57799
-const GROUPING = 57800
-const GROUPS = 57801
-const JSON_TABLE = 57802
-const LAG = 57803
-const LAST_VALUE = 57804
-const LATERAL = 57805
-const LEAD = 57806
-const NTH_VALUE = 57807
-const NTILE = 57808
-const OF = 57809
-const OVER = 57810
-const PERCENT_RANK = 57811
-const RANK = 57812
-const RECURSIVE = 57813
-const ROW_NUMBER = 57814
-const SYSTEM = 57815
-const WINDOW = 57816
-const ACTIVE = 57817
-const ADMIN = 57818
-const AUTOEXTEND_SIZE = 57819
-const BUCKETS = 57820
-const CLONE = 57821
-const COLUMN_FORMAT = 57822
-const COMPONENT = 57823
-const DEFINITION = 57824
-const ENFORCED = 57825
-const ENGINE_ATTRIBUTE = 57826
-const EXCLUDE = 57827
-const FOLLOWING = 57828
-const GEOMCOLLECTION = 57829
-const GET_MASTER_PUBLIC_KEY = 57830
-const HISTOGRAM = 57831
-const HISTORY = 57832
-const INACTIVE = 57833
-const INVISIBLE = 57834
-const LOCKED = 57835
-const MASTER_COMPRESSION_ALGORITHMS = 57836
-const MASTER_PUBLIC_KEY_PATH = 57837
-const MASTER_TLS_CIPHERSUITES = 57838
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57839
-const NESTED = 57840
-const NETWORK_NAMESPACE = 57841
-const NOWAIT = 57842
-const NULLS = 57843
-const OJ = 57844
-const OLD = 57845
-const OPTIONAL = 57846
-const ORDINALITY = 57847
-const ORGANIZATION = 57848
-const OTHERS = 57849
-const PARTIAL = 57850
-const PATH = 57851
-const PERSIST = 57852
-const PERSIST_ONLY = 57853
-const PRECEDING = 57854
-const PRIVILEGE_CHECKS_USER = 57855
-const PROCESS = 57856
-const RANDOM = 57857
-const REFERENCE = 57858
-const REQUIRE_ROW_FORMAT = 57859
-const RESOURCE = 57860
-const RESPECT = 57861
-const RESTART = 57862
-const RETAIN = 57863
-const REUSE = 57864
-const ROLE = 57865
-const SECONDARY = 57866
-const SECONDARY_ENGINE = 57867
-const SECONDARY_ENGINE_ATTRIBUTE = 57868
-const SECONDARY_LOAD = 57869
-const SECONDARY_UNLOAD = 57870
-const SIMPLE = 57871
-const SKIP = 57872
-const SRID = 57873
-const THREAD_PRIORITY = 57874
-const TIES = 57875
-const UNBOUNDED = 57876
-const VCPU = 57877
-const VISIBLE = 57878
-const RETURNING = 57879
-const FORMAT = 57880
-const TREE = 57881
-const TRADITIONAL = 57882
-const LOCAL = 57883
-const LOW_PRIORITY = 57884
-const NO_WRITE_TO_BINLOG = 57885
-const LOGS = 57886
-const ERROR = 57887
-const GENERAL = 57888
-const HOSTS = 57889
-const OPTIMIZER_COSTS = 57890
-const USER_RESOURCES = 57891
-const SLOW = 57892
-const CHANNEL = 57893
-const RELAY = 57894
-const EXPORT = 57895
-const AVG_ROW_LENGTH = 57896
-const CONNECTION = 57897
-const CHECKSUM = 57898
-const DELAY_KEY_WRITE = 57899
-const ENCRYPTION = 57900
-const ENGINE = 57901
-const INSERT_METHOD = 57902
-const MAX_ROWS = 57903
-const MIN_ROWS = 57904
-const PACK_KEYS = 57905
-const PASSWORD = 57906
-const FIXED = 57907
-const DYNAMIC = 57908
-const COMPRESSED = 57909
-const REDUNDANT = 57910
-const COMPACT = 57911
-const ROW_FORMAT = 57912
-const STATS_AUTO_RECALC = 57913
-const STATS_PERSISTENT = 57914
-const STATS_SAMPLE_PAGES = 57915
-const STORAGE = 57916
-const MEMORY = 57917
-const DISK = 57918
-const PARTITIONS = 57919
-const LINEAR = 57920
-const RANGE = 57921
-const LIST = 57922
-const SUBPARTITION = 57923
-const SUBPARTITIONS = 57924
-const HASH = 57925
+const BEFORE = 57620
+const LAST = 57621
+const CANCEL = 57622
+const RETRY = 57623
+const COMPLETE = 57624
+const CLEANUP = 57625
+const THROTTLE = 57626
+const UNTHROTTLE = 57627
+const EXPIRE = 57628
+const RATIO = 57629
+const BEGIN = 57630
+const START = 57631
+const TRANSACTION = 57632
+const COMMIT = 57633
+const ROLLBACK = 57634
+const SAVEPOINT = 57635
+const RELEASE = 57636
+const WORK = 57637
+const BIT = 57638
+const TINYINT = 57639
+const SMALLINT = 57640
+const MEDIUMINT = 57641
+const INT = 57642
+const INTEGER = 57643
+const BIGINT = 57644
+const INTNUM = 57645
+const REAL = 57646
+const DOUBLE = 57647
+const FLOAT_TYPE = 57648
+const DECIMAL_TYPE = 57649
+const NUMERIC = 57650
+const TIME = 57651
+const TIMESTAMP = 57652
+const DATETIME = 57653
+const YEAR = 57654
+const CHAR = 57655
+const VARCHAR = 57656
+const BOOL = 57657
+const CHARACTER = 57658
+const VARBINARY = 57659
+const NCHAR = 57660
+const TEXT = 57661
+const TINYTEXT = 57662
+const MEDIUMTEXT = 57663
+const LONGTEXT = 57664
+const BLOB = 57665
+const TINYBLOB = 57666
+const MEDIUMBLOB = 57667
+const LONGBLOB = 57668
+const JSON = 57669
+const JSON_SCHEMA_VALID = 57670
+const JSON_SCHEMA_VALIDATION_REPORT = 57671
+const ENUM = 57672
+const GEOMETRY = 57673
+const POINT = 57674
+const LINESTRING = 57675
+const POLYGON = 57676
+const GEOMETRYCOLLECTION = 57677
+const MULTIPOINT = 57678
+const MULTILINESTRING = 57679
+const MULTIPOLYGON = 57680
+const ASCII = 57681
+const UNICODE = 57682
+const NULLX = 57683
+const AUTO_INCREMENT = 57684
+const APPROXNUM = 57685
+const SIGNED = 57686
+const UNSIGNED = 57687
+const ZEROFILL = 57688
+const CODE = 57689
+const COLLATION = 57690
+const COLUMNS = 57691
+const DATABASES = 57692
+const ENGINES = 57693
+const EVENT = 57694
+const EXTENDED = 57695
+const FIELDS = 57696
+const FULL = 57697
+const FUNCTION = 57698
+const GTID_EXECUTED = 57699
+const KEYSPACES = 57700
+const OPEN = 57701
+const PLUGINS = 57702
+const PRIVILEGES = 57703
+const PROCESSLIST = 57704
+const SCHEMAS = 57705
+const TABLES = 57706
+const TRIGGERS = 57707
+const USER = 57708
+const VGTID_EXECUTED = 57709
+const VSCHEMA = 57710
+const NAMES = 57711
+const GLOBAL = 57712
+const SESSION = 57713
+const ISOLATION = 57714
+const LEVEL = 57715
+const READ = 57716
+const WRITE = 57717
+const ONLY = 57718
+const REPEATABLE = 57719
+const COMMITTED = 57720
+const UNCOMMITTED = 57721
+const SERIALIZABLE = 57722
+const CURRENT_TIMESTAMP = 57723
+const DATABASE = 57724
+const CURRENT_DATE = 57725
+const NOW = 57726
+const CURRENT_TIME = 57727
+const LOCALTIME = 57728
+const LOCALTIMESTAMP = 57729
+const CURRENT_USER = 57730
+const UTC_DATE = 57731
+const UTC_TIME = 57732
+const UTC_TIMESTAMP = 57733
+const DAY = 57734
+const DAY_HOUR = 57735
+const DAY_MICROSECOND = 57736
+const DAY_MINUTE = 57737
+const DAY_SECOND = 57738
+const HOUR = 57739
+const HOUR_MICROSECOND = 57740
+const HOUR_MINUTE = 57741
+const HOUR_SECOND = 57742
+const MICROSECOND = 57743
+const MINUTE = 57744
+const MINUTE_MICROSECOND = 57745
+const MINUTE_SECOND = 57746
+const MONTH = 57747
+const QUARTER = 57748
+const SECOND = 57749
+const SECOND_MICROSECOND = 57750
+const YEAR_MONTH = 57751
+const WEEK = 57752
+const REPLACE = 57753
+const CONVERT = 57754
+const CAST = 57755
+const SUBSTR = 57756
+const SUBSTRING = 57757
+const GROUP_CONCAT = 57758
+const SEPARATOR = 57759
+const TIMESTAMPADD = 57760
+const TIMESTAMPDIFF = 57761
+const WEIGHT_STRING = 57762
+const LTRIM = 57763
+const RTRIM = 57764
+const TRIM = 57765
+const JSON_ARRAY = 57766
+const JSON_OBJECT = 57767
+const JSON_QUOTE = 57768
+const JSON_DEPTH = 57769
+const JSON_TYPE = 57770
+const JSON_LENGTH = 57771
+const JSON_VALID = 57772
+const JSON_ARRAY_APPEND = 57773
+const JSON_ARRAY_INSERT = 57774
+const JSON_INSERT = 57775
+const JSON_MERGE = 57776
+const JSON_MERGE_PATCH = 57777
+const JSON_MERGE_PRESERVE = 57778
+const JSON_REMOVE = 57779
+const JSON_REPLACE = 57780
+const JSON_SET = 57781
+const JSON_UNQUOTE = 57782
+const MATCH = 57783
+const AGAINST = 57784
+const BOOLEAN = 57785
+const LANGUAGE = 57786
+const WITH = 57787
+const QUERY = 57788
+const EXPANSION = 57789
+const WITHOUT = 57790
+const VALIDATION = 57791
+const UNUSED = 57792
+const ARRAY = 57793
+const BYTE = 57794
+const CUME_DIST = 57795
+const DESCRIPTION = 57796
+const DENSE_RANK = 57797
+const EMPTY = 57798
+const EXCEPT = 57799
+const FIRST_VALUE = 57800
+const GROUPING = 57801
+const GROUPS = 57802
+const JSON_TABLE = 57803
+const LAG = 57804
+const LAST_VALUE = 57805
+const LATERAL = 57806
+const LEAD = 57807
+const NTH_VALUE = 57808
+const NTILE = 57809
+const OF = 57810
+const OVER = 57811
+const PERCENT_RANK = 57812
+const RANK = 57813
+const RECURSIVE = 57814
+const ROW_NUMBER = 57815
+const SYSTEM = 57816
+const WINDOW = 57817
+const ACTIVE = 57818
+const ADMIN = 57819
+const AUTOEXTEND_SIZE = 57820
+const BUCKETS = 57821
+const CLONE = 57822
+const COLUMN_FORMAT = 57823
+const COMPONENT = 57824
+const DEFINITION = 57825
+const ENFORCED = 57826
+const ENGINE_ATTRIBUTE = 57827
+const EXCLUDE = 57828
+const FOLLOWING = 57829
+const GEOMCOLLECTION = 57830
+const GET_MASTER_PUBLIC_KEY = 57831
+const HISTOGRAM = 57832
+const HISTORY = 57833
+const INACTIVE = 57834
+const INVISIBLE = 57835
+const LOCKED = 57836
+const MASTER_COMPRESSION_ALGORITHMS = 57837
+const MASTER_PUBLIC_KEY_PATH = 57838
+const MASTER_TLS_CIPHERSUITES = 57839
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57840
+const NESTED = 57841
+const NETWORK_NAMESPACE = 57842
+const NOWAIT = 57843
+const NULLS = 57844
+const OJ = 57845
+const OLD = 57846
+const OPTIONAL = 57847
+const ORDINALITY = 57848
+const ORGANIZATION = 57849
+const OTHERS = 57850
+const PARTIAL = 57851
+const PATH = 57852
+const PERSIST = 57853
+const PERSIST_ONLY = 57854
+const PRECEDING = 57855
+const PRIVILEGE_CHECKS_USER = 57856
+const PROCESS = 57857
+const RANDOM = 57858
+const REFERENCE = 57859
+const REQUIRE_ROW_FORMAT = 57860
+const RESOURCE = 57861
+const RESPECT = 57862
+const RESTART = 57863
+const RETAIN = 57864
+const REUSE = 57865
+const ROLE = 57866
+const SECONDARY = 57867
+const SECONDARY_ENGINE = 57868
+const SECONDARY_ENGINE_ATTRIBUTE = 57869
+const SECONDARY_LOAD = 57870
+const SECONDARY_UNLOAD = 57871
+const SIMPLE = 57872
+const SKIP = 57873
+const SRID = 57874
+const THREAD_PRIORITY = 57875
+const TIES = 57876
+const UNBOUNDED = 57877
+const VCPU = 57878
+const VISIBLE = 57879
+const RETURNING = 57880
+const FORMAT = 57881
+const TREE = 57882
+const TRADITIONAL = 57883
+const LOCAL = 57884
+const LOW_PRIORITY = 57885
+const NO_WRITE_TO_BINLOG = 57886
+const LOGS = 57887
+const ERROR = 57888
+const GENERAL = 57889
+const HOSTS = 57890
+const OPTIMIZER_COSTS = 57891
+const USER_RESOURCES = 57892
+const SLOW = 57893
+const CHANNEL = 57894
+const RELAY = 57895
+const EXPORT = 57896
+const AVG_ROW_LENGTH = 57897
+const CONNECTION = 57898
+const CHECKSUM = 57899
+const DELAY_KEY_WRITE = 57900
+const ENCRYPTION = 57901
+const ENGINE = 57902
+const INSERT_METHOD = 57903
+const MAX_ROWS = 57904
+const MIN_ROWS = 57905
+const PACK_KEYS = 57906
+const PASSWORD = 57907
+const FIXED = 57908
+const DYNAMIC = 57909
+const COMPRESSED = 57910
+const REDUNDANT = 57911
+const COMPACT = 57912
+const ROW_FORMAT = 57913
+const STATS_AUTO_RECALC = 57914
+const STATS_PERSISTENT = 57915
+const STATS_SAMPLE_PAGES = 57916
+const STORAGE = 57917
+const MEMORY = 57918
+const DISK = 57919
+const PARTITIONS = 57920
+const LINEAR = 57921
+const RANGE = 57922
+const LIST = 57923
+const SUBPARTITION = 57924
+const SUBPARTITIONS = 57925
+const HASH = 57926
 
 var mysqToknames = [...]string{
 	"$end",
@@ -927,6 +936,7 @@ var mysqToknames = [...]string{
 	"SECURITY",
 	"FIRST",
 	"AFTER",
+	"BEFORE",
 	"LAST",
 	"CANCEL",
 	"RETRY",
@@ -1252,2949 +1262,3384 @@ var mysqExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 46,
-	1, 144,
-	601, 144,
-	-2, 152,
+	1, 147,
+	602, 147,
+	-2, 155,
 	-1, 47,
-	132, 152,
-	172, 152,
-	332, 152,
-	-2, 492,
+	132, 155,
+	172, 155,
+	333, 155,
+	-2, 495,
 	-1, 54,
-	35, 715,
-	233, 715,
-	244, 715,
-	279, 727,
-	280, 727,
-	-2, 717,
+	35, 720,
+	233, 720,
+	244, 720,
+	279, 732,
+	280, 732,
+	-2, 722,
 	-1, 59,
-	235, 740,
-	-2, 738,
+	235, 745,
+	-2, 743,
 	-1, 112,
-	232, 1263,
-	-2, 118,
+	232, 1273,
+	-2, 121,
 	-1, 114,
-	1, 145,
-	601, 145,
-	-2, 152,
+	1, 148,
+	602, 148,
+	-2, 155,
 	-1, 125,
-	133, 383,
-	238, 383,
-	-2, 486,
+	133, 386,
+	238, 386,
+	-2, 489,
 	-1, 144,
-	132, 152,
-	172, 152,
-	332, 152,
-	-2, 501,
-	-1, 674,
-	217, 1284,
-	-2, 1280,
+	132, 155,
+	172, 155,
+	333, 155,
+	-2, 504,
 	-1, 675,
-	217, 1285,
-	-2, 1281,
-	-1, 749,
-	59, 806,
-	-2, 821,
-	-1, 786,
-	148, 1692,
-	-2, 111,
+	217, 1294,
+	-2, 1290,
+	-1, 676,
+	217, 1295,
+	-2, 1291,
+	-1, 750,
+	59, 811,
+	-2, 826,
 	-1, 787,
-	148, 1533,
+	148, 1703,
+	-2, 111,
+	-1, 788,
+	148, 1544,
 	-2, 112,
-	-1, 793,
-	148, 1617,
-	-2, 1257,
-	-1, 941,
-	148, 1461,
-	-2, 1254,
-	-1, 982,
+	-1, 794,
+	148, 1628,
+	-2, 1267,
+	-1, 942,
+	148, 1472,
+	-2, 1264,
+	-1, 983,
 	243, 40,
 	248, 40,
-	-2, 394,
-	-1, 1065,
-	1, 548,
-	601, 548,
-	-2, 152,
-	-1, 1293,
-	59, 807,
-	-2, 826,
-	-1, 1294,
-	59, 808,
-	-2, 827,
-	-1, 1347,
-	132, 152,
-	172, 152,
-	332, 152,
-	-2, 433,
-	-1, 1426,
-	133, 383,
-	238, 383,
-	-2, 486,
-	-1, 1435,
+	-2, 397,
+	-1, 1066,
+	1, 551,
+	602, 551,
+	-2, 155,
+	-1, 1296,
+	59, 812,
+	-2, 831,
+	-1, 1297,
+	59, 813,
+	-2, 832,
+	-1, 1350,
+	132, 155,
+	172, 155,
+	333, 155,
+	-2, 436,
+	-1, 1429,
+	133, 386,
+	238, 386,
+	-2, 489,
+	-1, 1438,
 	243, 41,
 	248, 41,
-	-2, 395,
-	-1, 1735,
-	217, 1289,
-	-2, 1283,
-	-1, 1818,
-	132, 152,
-	172, 152,
-	332, 152,
-	-2, 434,
-	-1, 1825,
-	25, 173,
-	-2, 175,
-	-1, 2065,
+	-2, 398,
+	-1, 1740,
+	217, 1299,
+	-2, 1293,
+	-1, 1823,
+	132, 155,
+	172, 155,
+	333, 155,
+	-2, 437,
+	-1, 1830,
+	25, 176,
+	-2, 178,
+	-1, 2072,
 	87, 38,
-	-2, 863,
-	-1, 2122,
+	-2, 868,
+	-1, 2129,
 	77, 93,
 	87, 93,
-	-2, 883,
-	-1, 2190,
-	576, 651,
-	-2, 625,
-	-1, 2326,
-	49, 1225,
-	-2, 1219,
-	-1, 2544,
+	-2, 888,
+	-1, 2200,
+	577, 654,
+	-2, 628,
+	-1, 2336,
+	49, 1235,
+	-2, 1229,
+	-1, 2557,
 	87, 38,
-	-2, 864,
-	-1, 2582,
+	-2, 869,
+	-1, 2595,
 	7, 52,
 	18, 52,
 	20, 52,
 	88, 52,
-	-2, 855,
-	-1, 2860,
-	576, 651,
-	-2, 639,
-	-1, 2918,
-	22, 1619,
-	32, 1619,
-	173, 1619,
-	255, 1619,
-	312, 1619,
-	313, 1619,
-	314, 1619,
-	315, 1619,
-	316, 1619,
-	317, 1619,
-	318, 1619,
-	320, 1619,
-	321, 1619,
-	322, 1619,
-	323, 1619,
-	324, 1619,
-	325, 1619,
-	326, 1619,
-	327, 1619,
-	328, 1619,
-	329, 1619,
-	330, 1619,
-	331, 1619,
-	333, 1619,
-	335, 1619,
-	336, 1619,
-	337, 1619,
-	338, 1619,
-	339, 1619,
-	340, 1619,
-	341, 1619,
-	342, 1619,
-	343, 1619,
-	346, 1619,
-	347, 1619,
-	348, 1619,
-	349, 1619,
-	350, 1619,
-	351, 1619,
-	352, 1619,
-	353, 1619,
-	354, 1619,
-	459, 1619,
-	-2, 583,
+	-2, 860,
+	-1, 2885,
+	577, 654,
+	-2, 642,
+	-1, 2944,
+	22, 1630,
+	32, 1630,
+	173, 1630,
+	255, 1630,
+	313, 1630,
+	314, 1630,
+	315, 1630,
+	316, 1630,
+	317, 1630,
+	318, 1630,
+	319, 1630,
+	321, 1630,
+	322, 1630,
+	323, 1630,
+	324, 1630,
+	325, 1630,
+	326, 1630,
+	327, 1630,
+	328, 1630,
+	329, 1630,
+	330, 1630,
+	331, 1630,
+	332, 1630,
+	334, 1630,
+	336, 1630,
+	337, 1630,
+	338, 1630,
+	339, 1630,
+	340, 1630,
+	341, 1630,
+	342, 1630,
+	343, 1630,
+	344, 1630,
+	347, 1630,
+	348, 1630,
+	349, 1630,
+	350, 1630,
+	351, 1630,
+	352, 1630,
+	353, 1630,
+	354, 1630,
+	355, 1630,
+	460, 1630,
+	-2, 586,
 }
 
 const mysqPrivate = 57344
 
-const mysqLast = 42975
+const mysqLast = 43200
 
 var mysqAct = [...]int{
-	674, 683, 2683, 2684, 2285, 2682, 2980, 2972, 2841, 2948,
-	2916, 2949, 2659, 1350, 1768, 2475, 2900, 676, 2381, 1815,
-	2388, 2019, 2429, 1749, 667, 36, 2438, 2443, 2794, 3,
-	596, 2339, 2440, 2439, 2437, 2442, 2441, 2646, 1120, 1751,
-	94, 668, 2342, 742, 2697, 2817, 2053, 2458, 2396, 2457,
-	184, 1885, 1273, 184, 764, 557, 184, 592, 2343, 2340,
-	2547, 572, 1772, 184, 1308, 2553, 2247, 2702, 2284, 677,
-	665, 184, 666, 2283, 1788, 2573, 2093, 589, 618, 2460,
-	1848, 2116, 2539, 2230, 2327, 184, 588, 2155, 2337, 2080,
-	2187, 590, 1873, 1853, 2156, 1916, 1404, 944, 2157, 1804,
-	746, 791, 750, 153, 2105, 765, 2086, 572, 1792, 1295,
-	572, 184, 572, 35, 2055, 744, 1793, 37, 1894, 584,
-	767, 2072, 1077, 1729, 1451, 1593, 1705, 1704, 1635, 1645,
-	1433, 1872, 1855, 1780, 1932, 2149, 972, 1339, 2124, 977,
-	139, 1317, 93, 1795, 601, 788, 1276, 1547, 1657, 1732,
-	1205, 1612, 1141, 1440, 1543, 951, 948, 1531, 1870, 983,
-	980, 1844, 89, 952, 978, 979, 1338, 1322, 1336, 756,
-	1118, 157, 1399, 1552, 1425, 122, 117, 115, 990, 116,
-	123, 1773, 1701, 1062, 754, 778, 95, 74, 579, 83,
-	1742, 751, 1212, 1105, 2850, 752, 2180, 1208, 87, 96,
-	2973, 1887, 1888, 1889, 1014, 2647, 2426, 1887, 2178, 186,
-	187, 188, 2209, 2208, 1930, 2639, 561, 2238, 118, 2448,
-	1142, 8, 124, 7, 100, 2602, 88, 2239, 2687, 6,
-	772, 2873, 777, 753, 75, 2874, 537, 1142, 2687, 1600,
-	1516, 186, 187, 188, 1599, 1746, 1747, 1598, 1019, 945,
-	1597, 758, 561, 580, 1596, 1595, 970, 582, 1588, 583,
-	2937, 743, 2051, 745, 102, 103, 2323, 106, 2527, 2448,
-	112, 1920, 2952, 181, 2200, 785, 532, 2446, 2082, 2989,
-	759, 2947, 2445, 2963, 2203, 766, 118, 993, 792, 1918,
-	956, 968, 967, 558, 966, 1302, 739, 740, 741, 2452,
-	969, 749, 1605, 561, 2790, 994, 1020, 1023, 1024, 2789,
-	1018, 85, 1017, 2686, 2652, 1919, 961, 2653, 180, 2988,
-	2910, 2874, 1965, 2686, 2976, 2869, 1152, 2446, 76, 780,
-	781, 1029, 76, 2961, 2660, 78, 2901, 1913, 2909, 1035,
-	552, 2868, 119, 1152, 1776, 2246, 1016, 2921, 1414, 2452,
-	2509, 2052, 550, 2217, 118, 162, 76, 2216, 2377, 1032,
-	1033, 1034, 2237, 1037, 1038, 1039, 1040, 1064, 1809, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
-	1054, 1055, 1056, 1057, 1058, 1059, 1962, 1963, 1112, 1340,
-	1114, 1341, 547, 2133, 2378, 2379, 2132, 76, 2138, 2134,
-	1117, 556, 1810, 1811, 1095, 2715, 85, 1084, 2449, 737,
-	85, 736, 1085, 159, 1748, 160, 562, 1100, 1101, 2926,
-	1083, 2096, 1082, 2181, 1148, 1096, 2953, 1140, 1111, 1113,
-	2842, 1084, 2145, 2924, 85, 179, 1085, 2384, 1174, 1089,
-	2500, 1148, 2930, 2931, 2089, 2090, 2097, 2954, 1828, 1827,
-	2398, 2399, 562, 561, 2550, 561, 2498, 2925, 2449, 2799,
-	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1183, 1182, 1184,
-	1185, 1589, 1590, 2477, 570, 85, 2273, 1587, 574, 568,
-	2188, 1895, 2385, 2212, 538, 1864, 540, 553, 1938, 564,
-	1933, 563, 543, 1036, 542, 545, 554, 546, 1061, 541,
-	1687, 551, 2975, 562, 555, 1116, 549, 565, 2387, 1097,
-	1858, 1102, 2470, 965, 1532, 1070, 1071, 965, 1060, 1506,
-	2471, 1103, 2382, 1090, 1109, 1104, 1774, 1775, 1110, 1098,
-	1099, 1941, 184, 1942, 184, 1943, 2938, 184, 1115, 2398,
-	2399, 1066, 2225, 1939, 163, 2641, 2383, 1073, 2640, 1944,
-	1042, 1041, 2479, 169, 1108, 2397, 2774, 2478, 572, 1122,
-	572, 572, 1937, 1507, 1935, 1508, 973, 2400, 1003, 2637,
-	974, 963, 1001, 1898, 85, 572, 572, 1789, 2389, 1282,
-	1679, 1668, 1669, 1670, 1671, 1681, 1672, 1673, 1674, 1686,
-	1682, 1675, 1676, 1683, 1684, 1685, 1677, 1678, 1680, 974,
-	1012, 36, 1011, 1010, 1936, 1418, 1134, 561, 1147, 1144,
-	1145, 1146, 1151, 1153, 1150, 1009, 1149, 1008, 1007, 1006,
-	1005, 1000, 1013, 1143, 1186, 1147, 1144, 1145, 1146, 1151,
-	1153, 1150, 2274, 1149, 1186, 2400, 949, 2990, 949, 2147,
-	1143, 986, 947, 2959, 2397, 949, 985, 1544, 1776, 1917,
-	1857, 1871, 1439, 562, 779, 562, 2400, 2226, 1924, 971,
-	2849, 1080, 2179, 1086, 1087, 1088, 1022, 1540, 2214, 154,
-	1063, 1031, 985, 1128, 2056, 2058, 1021, 1025, 566, 1963,
-	964, 2636, 1412, 2229, 964, 2248, 1915, 2419, 2211, 1189,
-	1190, 1191, 1192, 1411, 1410, 1093, 559, 2984, 2198, 1197,
-	1541, 1200, 2182, 992, 1408, 536, 531, 2838, 2242, 2589,
-	1271, 560, 1004, 1123, 1266, 2569, 1002, 2224, 1977, 2129,
-	2223, 960, 1187, 1188, 962, 2092, 2043, 2867, 1741, 2087,
-	1124, 1125, 1326, 1252, 1075, 1816, 1186, 184, 2551, 1185,
-	572, 572, 114, 2376, 761, 2863, 1015, 2632, 1193, 2563,
-	1106, 79, 2202, 2450, 2451, 2855, 184, 1438, 1553, 2685,
-	1934, 1964, 2800, 1537, 2386, 1079, 2454, 2250, 1285, 2685,
-	109, 1342, 1289, 1138, 2266, 572, 2600, 2601, 746, 184,
-	992, 84, 1658, 2171, 572, 84, 744, 1288, 1157, 1286,
-	572, 1518, 1517, 1519, 1520, 1521, 2201, 1283, 1137, 1272,
-	1135, 2711, 991, 2450, 2451, 155, 1136, 562, 2607, 84,
-	75, 1536, 167, 992, 1214, 1210, 2454, 1211, 1081, 965,
-	2606, 957, 788, 1069, 1902, 1658, 2929, 1991, 959, 958,
-	1774, 1775, 2260, 2259, 2258, 2252, 2057, 2256, 2232, 2251,
-	110, 2249, 2232, 2231, 1617, 1448, 2254, 2231, 1447, 1072,
-	84, 1437, 1287, 2879, 175, 2253, 93, 1912, 1618, 1619,
-	1616, 1272, 1258, 1259, 1260, 1261, 1262, 1156, 1157, 1861,
-	2928, 992, 2255, 2257, 2955, 1910, 1914, 963, 1003, 991,
-	1277, 1030, 156, 161, 158, 164, 165, 166, 168, 170,
-	171, 172, 173, 1092, 1107, 1001, 2880, 1907, 174, 176,
-	177, 178, 1554, 2390, 1094, 2991, 1078, 2394, 1907, 1862,
-	2831, 2982, 991, 96, 2983, 2393, 2981, 1860, 985, 988,
-	989, 1158, 949, 2590, 1274, 1065, 982, 986, 992, 85,
-	1327, 1987, 743, 1284, 1911, 992, 1337, 2986, 1158, 1302,
-	1307, 745, 1304, 1615, 184, 1909, 1662, 981, 1400, 2395,
-	1663, 1863, 1158, 2832, 2391, 1533, 2782, 1534, 1409, 2392,
-	1535, 1859, 1969, 1970, 1971, 792, 1332, 1333, 2781, 1302,
-	991, 2772, 2675, 1290, 2674, 2614, 985, 988, 989, 2613,
-	949, 572, 2603, 1435, 982, 986, 964, 688, 689, 690,
-	2992, 1444, 186, 187, 188, 1446, 2597, 2427, 1449, 1450,
-	572, 572, 2415, 572, 1986, 572, 572, 2153, 572, 572,
-	572, 572, 572, 572, 1178, 1179, 1180, 1181, 1183, 1182,
-	1184, 1185, 1481, 1482, 1445, 572, 2152, 991, 1867, 184,
-	1487, 1527, 995, 985, 991, 2810, 1302, 997, 1158, 995,
-	985, 998, 996, 783, 997, 1511, 184, 1510, 998, 996,
-	1509, 1480, 1499, 1155, 1483, 1156, 1157, 1493, 1490, 572,
-	1489, 184, 999, 1180, 1181, 1183, 1182, 1184, 1185, 1488,
-	1155, 1542, 1156, 1157, 572, 1460, 184, 2956, 1431, 2268,
-	2858, 1311, 2857, 1526, 1155, 1501, 1156, 1157, 186, 187,
-	188, 184, 2136, 2835, 1607, 1609, 1610, 1158, 184, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1160, 184, 184, 184,
-	184, 184, 184, 184, 184, 184, 572, 1424, 1608, 1524,
-	2834, 1443, 1484, 572, 572, 1453, 2833, 1454, 2777, 1456,
-	1458, 1312, 1513, 1462, 1464, 1466, 1468, 1470, 2762, 1407,
-	2761, 184, 2710, 1442, 186, 187, 188, 1525, 1883, 2708,
-	1421, 1422, 2671, 1158, 2611, 1434, 1420, 1176, 1177, 1178,
-	1179, 1180, 1181, 1183, 1182, 1184, 1185, 1441, 1441, 2596,
-	1155, 1158, 1156, 1157, 1415, 1416, 1417, 1549, 2418, 2417,
-	2504, 1302, 2162, 1523, 1158, 2150, 1413, 572, 1268, 1642,
-	1642, 1158, 1639, 1643, 1781, 1782, 1512, 186, 187, 188,
-	1648, 572, 2506, 1928, 1927, 1640, 1640, 1485, 1771, 1550,
-	1522, 1613, 1557, 1158, 1514, 1504, 572, 572, 1500, 1561,
-	1496, 1563, 1564, 1565, 1566, 1158, 1982, 1545, 1570, 1155,
-	1495, 1156, 1157, 1494, 2000, 2008, 1981, 118, 1659, 1313,
-	1491, 1492, 968, 967, 2474, 966, 1497, 1498, 1611, 1620,
-	1127, 1622, 1623, 1624, 1625, 1626, 1627, 1628, 1629, 1630,
-	1631, 1632, 1633, 1634, 1621, 1559, 2807, 1158, 1302, 2806,
-	90, 90, 2078, 2974, 2028, 1302, 1555, 1556, 92, 184,
-	1702, 91, 91, 572, 1315, 1155, 1580, 1156, 1157, 1560,
-	2125, 1733, 2766, 1584, 1585, 2765, 1567, 1568, 1569, 92,
-	1158, 2943, 1302, 1155, 2658, 1156, 1157, 184, 2189, 1302,
-	572, 186, 187, 188, 1614, 1881, 1155, 99, 1156, 1157,
-	184, 1980, 2094, 1155, 572, 1156, 1157, 98, 98, 184,
-	97, 184, 1702, 184, 184, 572, 1302, 1302, 572, 92,
-	2167, 1758, 1824, 1759, 1644, 1155, 2371, 1156, 1157, 572,
-	1314, 1650, 1651, 2126, 1735, 1158, 1963, 1155, 1302, 1156,
-	1157, 1174, 1158, 2128, 1170, 1733, 1171, 186, 187, 188,
-	1825, 1879, 2562, 788, 2078, 2907, 788, 93, 1737, 1738,
-	1172, 1173, 1169, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1183, 1182, 1184, 1185, 2102, 93, 1978, 675, 1302, 1155,
-	1734, 1156, 1157, 1736, 572, 1764, 1739, 1740, 2078, 2887,
-	2564, 1874, 1875, 1876, 1791, 1158, 1878, 1880, 1882, 2078,
-	2883, 2875, 1302, 1908, 1834, 1835, 1836, 1837, 1735, 1158,
-	2101, 572, 1155, 1154, 1156, 1157, 2862, 572, 1444, 2957,
-	2078, 1444, 1763, 1444, 1158, 2020, 1820, 185, 2102, 1906,
-	185, 1819, 1829, 185, 1830, 1831, 1832, 1833, 573, 1802,
-	185, 2523, 1753, 2406, 1158, 2078, 2851, 758, 185, 2311,
-	1840, 1841, 1842, 1843, 1786, 1158, 1766, 2650, 2848, 1850,
-	1896, 1907, 185, 1808, 572, 2102, 572, 1155, 1823, 1156,
-	1157, 1784, 572, 572, 1155, 1856, 1156, 1157, 2934, 2846,
-	2523, 1302, 1807, 1806, 573, 2785, 1302, 573, 185, 573,
-	2078, 2773, 2584, 1822, 1821, 1978, 792, 1158, 2013, 792,
-	2650, 1302, 1158, 2078, 2648, 1907, 1302, 2525, 1893, 1158,
-	184, 2567, 1302, 1154, 1302, 2013, 1302, 184, 1866, 1997,
-	184, 184, 2408, 2407, 184, 2094, 184, 1155, 1302, 1156,
-	1157, 2404, 2405, 184, 2404, 2403, 1851, 1846, 1847, 2770,
-	184, 1155, 1996, 1156, 1157, 1869, 1158, 1865, 1901, 2338,
-	1158, 1904, 1877, 1905, 2102, 1302, 1155, 1907, 1156, 1157,
-	2562, 1921, 184, 1978, 1302, 1963, 2210, 572, 1403, 2192,
-	993, 1851, 1900, 1903, 1899, 1890, 1155, 1158, 1156, 1157,
-	1174, 2633, 2241, 1922, 1158, 2521, 1779, 1155, 994, 1156,
-	1157, 1174, 2512, 1976, 1923, 1306, 1158, 2562, 1441, 1744,
-	1925, 1926, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1183,
-	1182, 1184, 1185, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1183, 1182, 1184, 1185, 1174, 2185, 2186, 1158, 1591, 1155,
-	2154, 1156, 1157, 2511, 1155, 1931, 1156, 1157, 1613, 1158,
-	1539, 1155, 2125, 1156, 1157, 1334, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1183, 1182, 1184, 1185, 1158, 1954, 1955,
-	2037, 2078, 2077, 1957, 1403, 1402, 976, 2036, 1348, 1347,
-	975, 2074, 1958, 1158, 2890, 1973, 1947, 1975, 1155, 2035,
-	1156, 1157, 1155, 1158, 1156, 1157, 92, 1301, 85, 748,
-	1974, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1183, 1182,
-	1184, 1185, 2796, 1158, 1309, 2126, 2763, 1158, 2722, 1155,
-	2034, 1156, 1157, 2631, 2430, 1963, 1155, 2628, 1156, 1157,
-	2609, 1158, 2033, 2515, 1961, 184, 2514, 1405, 1155, 1849,
-	1156, 1157, 2472, 184, 2432, 2428, 1642, 572, 2193, 2062,
-	2032, 1614, 1845, 572, 1978, 1839, 1158, 1838, 1972, 1529,
-	1436, 2066, 1640, 1432, 572, 2158, 2031, 1401, 111, 1155,
-	2049, 1156, 1157, 1158, 2159, 1064, 2030, 85, 99, 2615,
-	2476, 1155, 2060, 1156, 1157, 2797, 2574, 2575, 184, 98,
-	1476, 97, 184, 1988, 1864, 1990, 2029, 1756, 1582, 1155,
-	2023, 1156, 1157, 36, 2969, 2967, 2950, 2135, 2098, 2872,
-	2815, 2577, 2118, 2159, 2022, 1155, 2424, 1156, 1157, 2423,
-	2422, 1158, 2338, 2172, 2079, 1155, 1158, 1156, 1157, 1948,
-	2616, 2617, 2618, 1735, 2580, 2006, 2579, 1158, 2619, 2021,
-	2357, 1477, 1478, 1479, 2356, 1155, 1310, 1156, 1157, 1155,
-	2940, 1156, 1157, 2360, 2908, 572, 2018, 2358, 2361, 1302,
-	1583, 184, 2359, 1155, 2075, 1156, 1157, 1770, 184, 1762,
-	2040, 2041, 2088, 2692, 1158, 2691, 2568, 2316, 1277, 1734,
-	2315, 2050, 572, 2146, 2148, 2620, 2621, 2622, 1155, 572,
-	1156, 1157, 2830, 1444, 1444, 2555, 2184, 2362, 572, 2111,
-	2112, 2117, 2076, 2554, 2017, 1155, 2701, 1156, 1157, 2016,
-	2703, 2091, 2139, 2328, 2330, 2207, 2071, 2123, 2558, 185,
-	2014, 185, 2331, 2690, 185, 2325, 184, 184, 184, 184,
-	184, 1538, 2127, 2206, 762, 735, 2130, 1856, 184, 184,
-	2402, 2143, 763, 2163, 2137, 573, 1027, 573, 573, 1026,
-	2140, 2486, 1472, 1155, 184, 1156, 1157, 2010, 1155, 90,
-	1156, 1157, 573, 573, 90, 2151, 92, 2158, 1158, 1155,
-	91, 1156, 1157, 572, 2235, 91, 1654, 2160, 2107, 2110,
-	2111, 2112, 2108, 1126, 2109, 2113, 2199, 119, 2574, 2575,
-	1655, 99, 2168, 2560, 2169, 92, 2173, 2174, 2175, 1473,
-	1474, 1475, 98, 2205, 97, 2978, 1155, 2420, 1156, 1157,
-	1642, 1951, 1642, 92, 2540, 1642, 1781, 1782, 2847, 744,
-	1642, 1424, 2265, 2194, 2195, 2792, 1640, 2401, 1640, 2115,
-	2204, 1640, 1767, 1967, 572, 99, 1640, 2314, 2261, 2286,
-	97, 2286, 770, 771, 2286, 2313, 98, 98, 97, 2286,
-	572, 2009, 2161, 2243, 2822, 2821, 2709, 2164, 2165, 2244,
-	2707, 2706, 2699, 2227, 2629, 184, 2559, 2557, 2433, 572,
-	1891, 1419, 2305, 769, 99, 2698, 2548, 2298, 2299, 2300,
-	2301, 2094, 2291, 572, 2288, 98, 2074, 2292, 2971, 2970,
-	572, 572, 2275, 184, 184, 184, 184, 184, 2245, 1998,
-	1155, 2262, 1156, 1157, 1754, 184, 1328, 1319, 104, 105,
-	184, 2970, 2971, 184, 750, 184, 2836, 2341, 184, 184,
-	184, 2305, 2341, 2350, 185, 2595, 760, 573, 573, 2344,
-	2279, 101, 86, 1, 1289, 2118, 2370, 2233, 2335, 2318,
-	2234, 2599, 2923, 185, 548, 1745, 2319, 2293, 2294, 2295,
-	2296, 2297, 1275, 2951, 2416, 2919, 2304, 2920, 1515, 2306,
-	1505, 2661, 573, 184, 1703, 2793, 185, 2436, 1897, 2627,
-	1854, 573, 984, 144, 2310, 2320, 572, 573, 1817, 1818,
-	2332, 2333, 2903, 2317, 572, 108, 942, 107, 987, 184,
-	1091, 1892, 2651, 2144, 1826, 1354, 2372, 1352, 2456, 2373,
-	1353, 2351, 184, 751, 2354, 1351, 1356, 752, 2363, 2435,
-	2352, 2353, 1355, 2355, 93, 2349, 1999, 184, 2374, 2526,
-	184, 1549, 1586, 2367, 2368, 2307, 2308, 2309, 1300, 1296,
-	2487, 569, 2380, 2114, 182, 1343, 1320, 1028, 539, 2409,
-	2410, 1929, 2412, 1297, 544, 2411, 2107, 2110, 2111, 2112,
-	2108, 1198, 2109, 2113, 1581, 2312, 1300, 1296, 2131, 789,
-	782, 2464, 1755, 2463, 2064, 2346, 2552, 2324, 1760, 1761,
-	1299, 1297, 1298, 2434, 2326, 1856, 2455, 2081, 2329, 2322,
-	2829, 2700, 2888, 2141, 1316, 2467, 1989, 1656, 1796, 1606,
-	594, 593, 591, 2520, 2067, 2095, 1293, 1294, 1299, 1161,
-	1298, 2481, 572, 184, 678, 2054, 2482, 1329, 2106, 2104,
-	2103, 1949, 1803, 2576, 2572, 2915, 1798, 1794, 2489, 2073,
-	602, 595, 587, 2496, 2490, 2592, 2462, 2213, 2473, 2215,
-	2142, 185, 2469, 1139, 1292, 2493, 2494, 581, 2495, 955,
-	1653, 2497, 2798, 2499, 2853, 2501, 2484, 2485, 1966, 2508,
-	1291, 1666, 1667, 2413, 2414, 2860, 2444, 2645, 2425, 2190,
-	1884, 62, 184, 40, 1694, 576, 2936, 1130, 573, 776,
-	31, 30, 29, 28, 23, 22, 21, 20, 19, 25,
-	2598, 2541, 2542, 18, 2546, 17, 16, 573, 573, 2447,
-	573, 184, 573, 573, 2946, 573, 573, 573, 573, 573,
-	573, 2556, 2549, 2977, 113, 49, 46, 44, 121, 120,
-	184, 47, 573, 184, 184, 184, 185, 2571, 2561, 2581,
-	2578, 43, 1067, 572, 572, 41, 27, 26, 15, 14,
-	13, 12, 11, 185, 10, 2585, 9, 2634, 2635, 2587,
-	2588, 5, 4, 2586, 34, 2464, 573, 2463, 185, 1303,
-	1305, 33, 32, 2593, 2594, 1133, 2610, 24, 2612, 2,
-	2177, 573, 1886, 185, 0, 0, 0, 572, 572, 572,
-	572, 0, 0, 0, 0, 0, 0, 0, 185, 0,
+	675, 684, 2701, 2295, 2702, 3000, 3008, 2700, 2865, 2975,
+	1820, 2976, 2677, 2942, 677, 1353, 2488, 2926, 2790, 1773,
+	2391, 2398, 2026, 1754, 2818, 765, 3, 2442, 2451, 2456,
+	2453, 2452, 2664, 2349, 94, 1756, 2450, 2455, 1123, 2454,
+	2352, 668, 36, 2715, 2841, 2060, 2471, 2406, 1890, 1311,
+	184, 2566, 2470, 184, 2560, 558, 184, 2353, 743, 593,
+	669, 573, 590, 184, 1777, 2100, 2257, 2294, 597, 2350,
+	2123, 184, 2720, 1276, 2293, 666, 678, 667, 619, 2473,
+	1793, 2347, 2337, 2087, 1853, 184, 2586, 2552, 589, 591,
+	2162, 2197, 1407, 2240, 1015, 1878, 945, 1858, 2163, 153,
+	2164, 1797, 1809, 1921, 2112, 792, 766, 573, 35, 745,
+	573, 184, 573, 37, 1298, 1798, 2079, 747, 1709, 751,
+	2062, 2093, 1080, 1710, 1734, 1598, 1899, 139, 1877, 1785,
+	1937, 1860, 2156, 1640, 1650, 973, 1342, 768, 978, 1454,
+	585, 1320, 2131, 93, 1800, 789, 1279, 602, 1552, 1208,
+	1436, 1662, 89, 1617, 1144, 952, 981, 1443, 1534, 949,
+	1546, 1875, 1706, 984, 953, 979, 980, 1341, 1557, 1778,
+	1108, 1325, 1121, 1849, 1339, 757, 1402, 1428, 122, 123,
+	779, 755, 1063, 95, 2875, 157, 752, 580, 83, 96,
+	1747, 753, 117, 115, 116, 1215, 1211, 87, 3001, 2665,
+	2190, 74, 1892, 1893, 1894, 1892, 2439, 2188, 991, 2219,
+	2218, 1935, 2615, 2657, 754, 75, 8, 562, 2248, 1519,
+	7, 6, 2249, 2899, 538, 124, 88, 2705, 2705, 1605,
+	1604, 2461, 118, 2898, 1603, 773, 1017, 778, 1145, 1751,
+	1752, 759, 1602, 1601, 1020, 186, 187, 188, 946, 1033,
+	1034, 1035, 581, 1038, 1039, 1040, 1041, 971, 744, 1044,
+	1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054,
+	1055, 1056, 1057, 1058, 1059, 1060, 793, 746, 1737, 1145,
+	760, 786, 1600, 994, 767, 1305, 1593, 2964, 970, 2459,
+	583, 2058, 584, 2333, 2540, 2979, 962, 1925, 2210, 100,
+	118, 2461, 1021, 1024, 1025, 2089, 957, 969, 968, 967,
+	3017, 2465, 2704, 2704, 2458, 2974, 2991, 1923, 2213, 186,
+	187, 188, 1610, 2899, 2814, 2813, 562, 1036, 2670, 76,
+	2394, 2671, 3016, 1019, 76, 995, 1018, 78, 2936, 102,
+	103, 1924, 106, 85, 1155, 112, 76, 3004, 181, 76,
+	2894, 533, 2989, 2103, 2678, 2927, 1918, 2935, 2893, 2459,
+	2952, 1030, 562, 2256, 1972, 2947, 2522, 1781, 118, 1417,
+	2059, 740, 741, 742, 2950, 2395, 750, 2387, 2104, 2388,
+	2389, 2465, 2247, 2956, 2957, 1155, 2227, 1815, 1816, 1969,
+	2226, 2408, 2409, 1065, 1814, 2733, 1098, 966, 2951, 1071,
+	1072, 2397, 738, 559, 781, 782, 737, 85, 1753, 1343,
+	2140, 1344, 85, 2139, 2191, 2392, 2141, 1869, 563, 1120,
+	2462, 1087, 2866, 2152, 85, 1099, 1088, 85, 2563, 1970,
+	2513, 1076, 2408, 2409, 1115, 2283, 1117, 562, 1074, 2393,
+	562, 1092, 1863, 1151, 1833, 1832, 1143, 1103, 1104, 2980,
+	553, 2511, 1087, 2096, 2097, 964, 562, 1088, 1592, 571,
+	2490, 575, 551, 2198, 569, 1086, 1900, 1085, 2639, 2638,
+	2981, 2399, 2178, 2177, 1114, 1116, 2222, 1037, 1062, 3003,
+	961, 2483, 1535, 963, 1151, 1938, 1943, 1509, 1938, 2484,
+	2462, 1177, 1101, 1102, 2235, 1107, 2407, 1781, 1067, 1594,
+	1595, 1946, 548, 1947, 2659, 1948, 1942, 2658, 2410, 1949,
+	1100, 557, 2823, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
+	1186, 1185, 1187, 1188, 1043, 1119, 1093, 563, 1042, 2492,
+	1940, 1510, 2798, 184, 1511, 184, 2655, 2407, 184, 1075,
+	1004, 1944, 1105, 966, 1061, 2491, 85, 1903, 1941, 2410,
+	1779, 1780, 1106, 2410, 974, 2258, 1794, 1002, 975, 573,
+	1125, 573, 573, 563, 2965, 965, 975, 3018, 1013, 1012,
+	1011, 1112, 1010, 2179, 1009, 1113, 573, 573, 966, 1008,
+	958, 1007, 1862, 1006, 1001, 1118, 1421, 960, 959, 2987,
+	1285, 1189, 2284, 1014, 3012, 539, 950, 541, 554, 1970,
+	565, 1111, 564, 544, 1137, 543, 546, 555, 547, 1189,
+	542, 1442, 552, 986, 1023, 556, 993, 550, 566, 36,
+	986, 1547, 950, 1032, 1022, 1876, 948, 1150, 1147, 1148,
+	1149, 1154, 1156, 1153, 950, 1152, 964, 2260, 563, 987,
+	780, 563, 1146, 1096, 2063, 2065, 2236, 1929, 2654, 1543,
+	1131, 2874, 1026, 2208, 2429, 2239, 993, 563, 2396, 2221,
+	1415, 972, 1083, 2154, 1089, 1090, 1091, 2189, 1150, 1147,
+	1148, 1149, 1154, 1156, 1153, 2252, 1152, 1414, 1922, 1413,
+	1779, 1780, 1544, 1146, 1005, 1411, 537, 532, 1192, 1193,
+	1194, 1195, 2234, 1984, 2192, 2233, 1920, 1064, 1200, 2094,
+	1203, 1003, 2224, 2270, 2269, 2268, 2262, 2862, 2266, 2602,
+	2261, 965, 2259, 2564, 2582, 992, 1441, 2264, 1126, 1190,
+	1191, 986, 989, 990, 2136, 950, 2263, 2880, 1274, 983,
+	987, 1539, 993, 1127, 1128, 2099, 2050, 1746, 184, 1821,
+	1329, 573, 573, 2265, 2267, 2892, 965, 1255, 1078, 1189,
+	982, 114, 1196, 1269, 79, 992, 1188, 184, 2242, 2703,
+	2703, 2386, 762, 2241, 2613, 2614, 2463, 2464, 2955, 1286,
+	2888, 1521, 1520, 1522, 1523, 1524, 573, 2212, 1016, 2467,
+	184, 745, 1291, 84, 993, 573, 1288, 1082, 84, 567,
+	1292, 573, 75, 1109, 1140, 1275, 747, 2400, 1138, 1139,
+	84, 2404, 1866, 84, 1971, 1289, 2064, 560, 3010, 2403,
+	2650, 3011, 2954, 3009, 2242, 1213, 2824, 1214, 1217, 2241,
+	1558, 2211, 561, 789, 2576, 1692, 109, 993, 1939, 1540,
+	1073, 992, 1345, 1031, 1141, 2276, 2463, 2464, 1663, 2181,
+	1070, 1095, 1867, 2405, 1160, 1663, 1084, 1998, 2401, 2467,
+	1865, 1161, 1097, 2402, 1290, 1912, 1161, 1275, 93, 1159,
+	1160, 993, 2729, 1612, 1614, 1615, 1261, 1262, 1263, 1264,
+	1265, 2620, 2619, 1907, 1917, 1536, 1451, 1537, 1450, 1440,
+	1538, 1915, 1280, 992, 1868, 1622, 1919, 1613, 1912, 986,
+	989, 990, 1916, 950, 1864, 1004, 110, 983, 987, 1623,
+	1624, 1621, 1002, 2904, 96, 1684, 1673, 1674, 1675, 1676,
+	1686, 1677, 1678, 1679, 1691, 1687, 1680, 1681, 1688, 1689,
+	1690, 1682, 1683, 1685, 1277, 1914, 992, 2982, 1081, 2603,
+	744, 996, 986, 1287, 1066, 2985, 998, 1110, 1310, 1340,
+	999, 997, 3019, 1307, 2855, 184, 2905, 1330, 2575, 1403,
+	3014, 1161, 2806, 2805, 793, 2796, 746, 1161, 2693, 1412,
+	992, 1000, 1335, 1336, 1559, 996, 986, 1976, 1977, 1978,
+	998, 2692, 2007, 2627, 999, 997, 1183, 1184, 1186, 1185,
+	1187, 1188, 573, 1158, 1438, 1159, 1160, 2856, 1158, 1161,
+	1159, 1160, 1447, 2626, 85, 2616, 1449, 2278, 2440, 1452,
+	1453, 573, 573, 2487, 573, 2425, 573, 573, 1620, 573,
+	573, 573, 573, 573, 573, 1181, 1182, 1183, 1184, 1186,
+	1185, 1187, 1188, 1484, 1485, 2160, 573, 3020, 2159, 1872,
+	184, 1490, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1186,
+	1185, 1187, 1188, 689, 690, 691, 784, 184, 2983, 1293,
+	1179, 1180, 1181, 1182, 1183, 1184, 1186, 1185, 1187, 1188,
+	573, 1529, 184, 1448, 1527, 1418, 1419, 1420, 1530, 1305,
+	1516, 1514, 1545, 2870, 1513, 1161, 1667, 573, 1434, 184,
+	1668, 1512, 1504, 1158, 1502, 1159, 1160, 1496, 1493, 1158,
+	1483, 1159, 1160, 1486, 184, 186, 187, 188, 1130, 2610,
+	1492, 184, 186, 187, 188, 1427, 2143, 1491, 2883, 1463,
+	184, 184, 184, 184, 184, 184, 184, 184, 184, 573,
+	2882, 1158, 1487, 1159, 1160, 1528, 573, 573, 1526, 2859,
+	2858, 1494, 1495, 2857, 1515, 2801, 1314, 1500, 1501, 1410,
+	1177, 1456, 1446, 1457, 184, 1459, 1461, 2780, 1445, 1465,
+	1467, 1469, 1471, 1473, 1424, 1425, 1423, 2779, 2834, 1305,
+	1437, 2728, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1186,
+	1185, 1187, 1188, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
+	1163, 186, 187, 188, 1554, 1888, 1315, 1161, 2726, 2689,
+	573, 2624, 1647, 1647, 1644, 1648, 1488, 2609, 1444, 1444,
+	186, 187, 188, 1653, 573, 1645, 1645, 1158, 2428, 1159,
+	1160, 1161, 2427, 2169, 2157, 1562, 1161, 1271, 1933, 573,
+	573, 1932, 1566, 1161, 1568, 1569, 1570, 1571, 1161, 2519,
+	1776, 1575, 1555, 1618, 1548, 1549, 1550, 1625, 2015, 1627,
+	1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636, 1637,
+	1638, 1639, 118, 1616, 1525, 1517, 1560, 1561, 1507, 969,
+	968, 967, 1416, 1564, 1503, 1626, 1499, 1498, 1497, 1565,
+	2517, 1305, 1316, 2085, 3002, 1305, 1572, 1573, 1574, 1664,
+	2970, 1305, 184, 186, 187, 188, 573, 1886, 2085, 2933,
+	2831, 1585, 1161, 2830, 1738, 1305, 2085, 2912, 1589, 1590,
+	1305, 2784, 1994, 99, 1707, 2783, 2035, 1305, 2085, 2908,
+	184, 92, 1305, 573, 98, 1763, 97, 1764, 2676, 1158,
+	1619, 1159, 1160, 184, 186, 187, 188, 573, 1884, 1161,
+	2900, 1305, 184, 1161, 184, 2199, 184, 184, 573, 2085,
+	2876, 573, 90, 1158, 2174, 1159, 1160, 1913, 1158, 92,
+	1159, 1160, 573, 91, 2348, 1158, 1707, 1159, 1160, 1161,
+	1158, 1740, 1159, 1160, 1830, 2575, 1177, 90, 1738, 1173,
+	1305, 1174, 2668, 2873, 98, 1993, 789, 2101, 91, 789,
+	1829, 93, 1742, 1743, 1305, 1175, 1176, 1172, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1186, 1185, 1187, 1188, 93,
+	2536, 1305, 1989, 2101, 1739, 1912, 1796, 573, 1305, 2809,
+	1305, 2108, 1988, 1769, 1879, 1880, 1881, 2794, 2381, 1883,
+	1885, 1887, 2085, 2797, 1158, 1374, 1159, 1160, 1970, 1839,
+	1840, 1841, 1842, 1305, 573, 1740, 2668, 1305, 2085, 2666,
+	573, 1447, 2027, 1985, 1447, 1758, 1447, 2961, 1825, 2109,
+	1824, 1834, 1911, 1835, 1836, 1837, 1838, 1912, 1305, 2577,
+	759, 1158, 1807, 1159, 1160, 1158, 2109, 1159, 1160, 1845,
+	1846, 1847, 1848, 2580, 1305, 2575, 1649, 1157, 1791, 1157,
+	1305, 1161, 1901, 1655, 1656, 1771, 1855, 573, 2887, 573,
+	1828, 1158, 2085, 1159, 1160, 573, 573, 1177, 1789, 2251,
+	1161, 1861, 2020, 1305, 1786, 1787, 1812, 793, 2418, 2417,
+	793, 2109, 1811, 2132, 676, 1161, 2536, 1827, 1826, 1178,
+	1179, 1180, 1181, 1182, 1183, 1184, 1186, 1185, 1187, 1188,
+	1898, 2416, 1318, 184, 1161, 1741, 2321, 1871, 1744, 1745,
+	184, 2414, 2415, 184, 184, 1813, 1161, 184, 1985, 184,
+	184, 184, 2414, 2413, 2109, 1305, 2020, 1161, 184, 1985,
+	1305, 1161, 1856, 2004, 185, 184, 1362, 185, 2003, 1874,
+	185, 1870, 1851, 1852, 1768, 574, 2133, 185, 1882, 1912,
+	1177, 1895, 1983, 1926, 2651, 185, 2135, 184, 1784, 994,
+	2132, 1906, 573, 1309, 1909, 1905, 1910, 1856, 1317, 185,
+	1908, 1904, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1186,
+	1185, 1187, 1188, 1158, 1927, 1159, 1160, 2597, 1970, 2220,
+	1161, 574, 1928, 1749, 574, 185, 574, 1596, 1930, 1931,
+	2161, 1542, 1158, 1337, 1159, 1160, 1406, 2202, 1161, 977,
+	2538, 995, 2195, 2196, 2534, 2085, 2084, 1158, 1375, 1159,
+	1160, 1444, 976, 2133, 2916, 1161, 1406, 1405, 1936, 1987,
+	1351, 1350, 2489, 1970, 85, 2820, 1158, 1312, 1159, 1160,
+	1161, 749, 2781, 2740, 1161, 2649, 2646, 2641, 1158, 2640,
+	1159, 1160, 1618, 1961, 1962, 1980, 2081, 1982, 1964, 1158,
+	2622, 1159, 1160, 1158, 1161, 1159, 1160, 1965, 2528, 1161,
+	2527, 92, 2443, 2525, 1408, 1952, 1388, 1391, 1392, 1393,
+	1394, 1395, 1396, 1981, 1397, 1398, 1399, 1400, 1401, 1376,
+	1377, 1378, 1379, 1360, 1361, 1389, 1854, 1363, 1161, 1364,
+	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 2524, 2485,
+	1373, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 85,
+	184, 1968, 1158, 2044, 1159, 1160, 1161, 2043, 184, 1985,
+	2445, 1647, 573, 2069, 2628, 2441, 2203, 1850, 573, 1619,
+	1158, 1161, 1159, 1160, 1645, 1979, 2632, 2042, 1844, 573,
+	1843, 2073, 2041, 1161, 1532, 2056, 1439, 1158, 1435, 1159,
+	1160, 1404, 111, 2067, 2165, 2166, 1065, 2587, 2588, 1161,
+	2821, 2997, 1158, 184, 1159, 1160, 1158, 184, 1159, 1160,
+	2995, 2040, 1869, 1997, 1761, 2629, 2630, 2631, 1587, 2977,
+	2105, 1479, 2142, 2633, 2634, 2635, 1158, 2897, 1159, 1160,
+	1475, 1158, 2872, 1159, 1160, 36, 2839, 1313, 2590, 2039,
+	2437, 2086, 2166, 2593, 2125, 2436, 1161, 2435, 2348, 2013,
+	2182, 1953, 1740, 1390, 2038, 2372, 2967, 2118, 2119, 2592,
+	1158, 2854, 1159, 1160, 1161, 2934, 2037, 2367, 2366, 2710,
+	573, 2709, 1480, 1481, 1482, 1775, 184, 1476, 1477, 1478,
+	1588, 1767, 2036, 184, 2082, 2581, 1161, 2370, 1158, 2326,
+	1159, 1160, 2371, 2368, 1280, 1739, 2057, 573, 2369, 1161,
+	2153, 2155, 2095, 1158, 573, 1159, 1160, 2325, 1447, 1447,
+	2719, 2124, 2571, 573, 99, 1158, 2194, 1159, 1160, 2708,
+	2083, 2078, 2721, 2146, 2335, 98, 2098, 97, 1161, 2030,
+	2217, 1158, 2130, 1159, 1160, 2412, 92, 1995, 2568, 2216,
+	763, 184, 184, 184, 184, 184, 2567, 2029, 764, 1541,
+	2137, 2134, 736, 184, 184, 1861, 2150, 2168, 2144, 2170,
+	1303, 1299, 2171, 2172, 2147, 2338, 2340, 1028, 1027, 2028,
+	1659, 184, 90, 2499, 2341, 1300, 90, 2158, 1158, 92,
+	1159, 1160, 2025, 91, 1660, 2165, 2245, 91, 1129, 2209,
+	573, 2167, 119, 2573, 92, 1305, 1158, 3006, 1159, 1160,
+	1765, 1766, 1302, 2175, 1301, 2176, 1786, 1787, 2183, 2184,
+	2185, 2024, 2433, 99, 2047, 2048, 1956, 2871, 1158, 2215,
+	1159, 1160, 2816, 1427, 98, 2411, 97, 1647, 2122, 1647,
+	745, 1158, 1647, 1159, 1160, 92, 1772, 1647, 771, 772,
+	1645, 1161, 1645, 2959, 2553, 1645, 1974, 185, 97, 185,
+	1645, 573, 185, 2214, 2275, 2271, 1161, 2324, 2204, 2205,
+	1158, 2846, 1159, 1160, 2845, 2323, 1161, 573, 99, 2727,
+	2725, 98, 2253, 574, 2254, 574, 574, 2724, 2237, 98,
+	2716, 97, 184, 2717, 1161, 2647, 573, 2572, 2570, 2315,
+	574, 574, 2446, 1896, 2296, 1422, 2296, 770, 2301, 2296,
+	573, 2561, 2298, 2302, 2296, 2101, 2081, 573, 573, 2285,
+	184, 184, 184, 184, 184, 2308, 2309, 2310, 2311, 2005,
+	2255, 1759, 184, 2272, 2023, 1331, 2345, 184, 1322, 99,
+	184, 2243, 184, 2998, 2244, 184, 184, 184, 2315, 2021,
+	98, 2999, 2360, 2860, 2289, 2328, 2999, 2998, 751, 2017,
+	104, 105, 2329, 2380, 2608, 2303, 2304, 2305, 2306, 2307,
+	2787, 2788, 2789, 761, 101, 2354, 86, 2016, 1292, 2125,
+	1, 2426, 2351, 1158, 2314, 1159, 1160, 2351, 573, 184,
+	184, 2612, 2316, 184, 2949, 549, 1750, 1278, 1158, 2978,
+	1159, 1160, 2945, 2946, 1518, 2327, 573, 2330, 1158, 1508,
+	1159, 1160, 2382, 2679, 573, 2383, 2320, 1708, 2817, 184,
+	2449, 2361, 2342, 2343, 2364, 752, 1158, 1902, 1159, 1160,
+	753, 2645, 184, 2469, 1859, 2373, 2362, 2363, 985, 2365,
+	144, 1822, 93, 1823, 2929, 2377, 2378, 184, 2384, 108,
+	184, 943, 1554, 1303, 1299, 2390, 107, 2359, 988, 1094,
+	2500, 1897, 185, 2669, 2151, 574, 574, 1831, 1300, 1357,
+	2423, 2424, 1355, 2422, 2421, 2420, 1356, 2448, 1354, 1359,
+	1358, 185, 2317, 2318, 2319, 2114, 2117, 2118, 2119, 2115,
+	2006, 2116, 2120, 1296, 1297, 1302, 2477, 1301, 2476, 2539,
+	574, 2786, 2447, 2637, 185, 1591, 570, 2121, 1861, 574,
+	2468, 182, 1346, 1323, 1029, 574, 2480, 540, 2419, 2114,
+	2117, 2118, 2119, 2115, 2533, 2116, 2120, 1934, 545, 2587,
+	2588, 1201, 573, 184, 1586, 2322, 2138, 790, 783, 2495,
+	1760, 2494, 2071, 2356, 2565, 2334, 2336, 2088, 2339, 2332,
+	2502, 2853, 2503, 659, 2718, 2913, 2148, 2509, 1319, 1996,
+	1661, 2506, 2507, 1801, 2508, 1611, 595, 2510, 594, 2512,
+	592, 2514, 2074, 2102, 1164, 679, 2497, 2498, 2061, 1332,
+	2113, 2111, 2110, 1954, 1808, 2589, 2585, 2941, 1803, 1799,
+	2080, 603, 184, 596, 588, 2605, 2475, 2223, 2486, 2225,
+	2149, 2482, 1142, 2559, 1295, 582, 956, 1658, 2822, 2878,
+	2611, 1973, 2521, 1294, 572, 1671, 2554, 2555, 1672, 2885,
+	2457, 184, 2663, 2438, 2200, 1889, 2562, 62, 40, 2569,
+	1699, 577, 2963, 1133, 2594, 777, 2584, 31, 30, 29,
+	184, 2574, 28, 23, 22, 21, 184, 184, 184, 20,
+	19, 25, 18, 17, 16, 2460, 573, 573, 2600, 2601,
+	791, 2591, 2599, 947, 2973, 954, 3005, 113, 49, 185,
+	46, 2598, 44, 121, 120, 2652, 2653, 47, 43, 1068,
+	2477, 41, 2476, 2606, 2607, 27, 26, 15, 2623, 14,
+	2625, 13, 12, 11, 10, 9, 5, 4, 34, 33,
+	573, 573, 573, 573, 32, 1136, 574, 24, 2, 2617,
+	2618, 2187, 1891, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 574, 574, 0, 574, 0,
+	574, 574, 0, 574, 574, 574, 574, 574, 574, 0,
+	0, 2675, 0, 0, 0, 0, 1647, 0, 1647, 0,
+	574, 0, 0, 0, 185, 0, 0, 2688, 0, 1645,
+	0, 1645, 0, 0, 2656, 2673, 2674, 184, 2660, 2661,
+	2662, 185, 0, 0, 0, 0, 0, 2684, 0, 0,
+	0, 0, 2685, 0, 574, 0, 185, 0, 0, 0,
+	2713, 573, 0, 573, 0, 0, 0, 0, 0, 0,
+	0, 574, 0, 185, 0, 0, 0, 0, 0, 1647,
+	0, 2742, 0, 2296, 2697, 2296, 2734, 2706, 185, 2696,
+	0, 0, 1645, 0, 2714, 185, 0, 2723, 0, 745,
+	0, 36, 2736, 0, 185, 185, 185, 185, 185, 185,
+	185, 185, 185, 574, 0, 2732, 0, 2722, 2354, 0,
+	574, 574, 2354, 2738, 2351, 2730, 0, 0, 180, 0,
+	573, 0, 0, 0, 0, 0, 0, 0, 185, 0,
+	2791, 0, 0, 184, 2743, 2744, 573, 0, 2746, 2795,
+	0, 0, 119, 0, 0, 0, 0, 0, 0, 573,
+	0, 0, 0, 0, 0, 162, 2785, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2793, 0, 2807, 2792,
+	0, 0, 0, 2836, 574, 2837, 0, 1647, 573, 2840,
+	0, 0, 573, 573, 2811, 2812, 2799, 2819, 574, 0,
+	1645, 0, 0, 0, 0, 0, 0, 0, 2145, 0,
+	0, 0, 0, 574, 574, 0, 0, 0, 0, 0,
+	0, 573, 0, 159, 0, 160, 0, 0, 0, 0,
+	0, 0, 2802, 2803, 2804, 0, 2847, 2848, 2850, 2849,
+	2864, 0, 0, 0, 2863, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 2861, 0, 0, 0, 745, 0,
+	0, 0, 573, 0, 0, 0, 0, 0, 0, 0,
+	0, 2852, 0, 0, 2354, 0, 185, 0, 0, 0,
+	574, 2869, 2867, 184, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 573, 184, 0, 185, 0, 0, 574, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 2881, 1304,
+	2889, 574, 2884, 2886, 0, 0, 185, 0, 185, 0,
+	185, 185, 574, 0, 0, 574, 0, 0, 36, 2877,
+	0, 0, 0, 573, 0, 0, 574, 0, 0, 0,
+	0, 0, 0, 184, 163, 0, 0, 0, 0, 0,
+	0, 0, 0, 169, 0, 0, 0, 0, 573, 573,
+	0, 0, 0, 2911, 2906, 0, 0, 0, 2914, 0,
+	0, 573, 791, 2917, 791, 791, 0, 0, 0, 0,
+	184, 573, 2928, 36, 2920, 2925, 2922, 2921, 2937, 1132,
+	1134, 574, 2919, 2924, 0, 2923, 2819, 2930, 0, 2351,
+	0, 0, 2958, 2940, 2948, 2953, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 574, 0,
+	2966, 0, 0, 0, 574, 0, 0, 0, 0, 2972,
+	0, 0, 573, 0, 0, 0, 0, 0, 0, 0,
+	0, 573, 0, 0, 0, 1647, 0, 2993, 2984, 2988,
+	0, 2986, 0, 2992, 2990, 0, 2996, 2994, 1645, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 574, 3007, 574, 0, 0, 3013, 0, 3015, 574,
+	574, 0, 0, 0, 0, 0, 0, 0, 0, 1647,
+	0, 3021, 0, 0, 3023, 3024, 0, 2837, 1272, 3022,
+	0, 0, 1645, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
+	0, 0, 0, 0, 185, 0, 0, 185, 185, 0,
+	0, 185, 0, 185, 185, 185, 0, 0, 0, 0,
+	0, 0, 185, 0, 0, 0, 0, 0, 0, 185,
+	0, 0, 0, 0, 1283, 1284, 0, 0, 0, 0,
+	0, 0, 0, 0, 180, 0, 0, 0, 0, 0,
+	0, 185, 0, 0, 0, 2193, 574, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 1327,
+	141, 0, 0, 0, 0, 0, 0, 0, 791, 0,
+	0, 162, 0, 0, 1347, 0, 155, 0, 0, 0,
+	0, 0, 0, 167, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 151, 0, 0, 0, 0, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 175, 0, 0, 0, 159,
+	0, 160, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1430, 1431, 150,
+	149, 179, 0, 156, 161, 158, 164, 165, 166, 168,
+	170, 171, 172, 173, 0, 0, 0, 0, 0, 174,
+	176, 177, 178, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 0, 0, 0, 0, 0,
+	0, 0, 185, 0, 0, 0, 574, 0, 0, 0,
+	0, 0, 574, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 574, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 145, 1432,
+	152, 0, 1429, 0, 146, 147, 0, 185, 0, 0,
+	163, 185, 0, 0, 0, 947, 0, 0, 0, 169,
+	0, 0, 0, 0, 0, 0, 0, 0, 1272, 0,
+	0, 0, 0, 0, 1455, 1455, 0, 1455, 0, 1455,
+	1455, 0, 1464, 1455, 1455, 1455, 1455, 1455, 0, 0,
+	0, 0, 0, 0, 0, 1272, 0, 0, 1272, 947,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 574, 0, 0, 0, 0, 0,
+	185, 0, 0, 0, 0, 0, 0, 185, 0, 0,
+	0, 0, 0, 1531, 180, 0, 0, 0, 0, 0,
+	0, 574, 0, 0, 0, 0, 0, 0, 574, 0,
+	1551, 0, 0, 0, 0, 0, 0, 574, 119, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 162, 0, 0, 0, 0, 154, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 185, 185, 185, 185,
+	0, 0, 791, 0, 0, 0, 0, 185, 185, 791,
+	791, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 0, 0, 0, 185, 185, 185, 185, 185, 185,
-	185, 185, 185, 573, 0, 0, 0, 0, 0, 0,
-	573, 573, 0, 1642, 0, 1642, 0, 0, 0, 658,
-	0, 0, 0, 2657, 0, 2655, 2656, 0, 185, 1640,
-	0, 1640, 0, 0, 184, 0, 0, 0, 0, 0,
-	0, 0, 2286, 0, 2286, 0, 0, 2667, 0, 0,
-	0, 0, 0, 2670, 0, 0, 0, 2695, 572, 2666,
-	572, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 573, 0, 1642, 0, 0, 2724,
-	571, 36, 2679, 2341, 2688, 2678, 2716, 0, 573, 2604,
-	2605, 0, 1640, 0, 0, 0, 2344, 2696, 0, 0,
-	2344, 2718, 744, 573, 573, 2720, 0, 0, 2705, 2704,
-	0, 0, 2712, 2714, 0, 0, 0, 0, 0, 0,
-	0, 0, 572, 0, 0, 0, 790, 0, 0, 946,
-	0, 953, 0, 0, 0, 184, 2771, 0, 572, 0,
-	0, 2638, 0, 2728, 0, 2642, 2643, 2644, 0, 0,
-	0, 572, 2725, 2726, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 2768, 2775,
-	573, 2769, 0, 0, 2783, 2812, 2813, 0, 2767, 1642,
-	572, 0, 2816, 0, 572, 572, 0, 2787, 2788, 2795,
-	0, 0, 0, 0, 185, 1640, 0, 573, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	0, 160, 0, 0, 574, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1641, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1654, 0, 142,
+	0, 2971, 143, 0, 1272, 0, 0, 0, 0, 0,
+	0, 1374, 1669, 1670, 0, 574, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 574, 155, 0, 0, 0, 0, 0, 0, 167,
+	0, 0, 1306, 1308, 0, 0, 185, 0, 0, 791,
+	574, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 574, 0, 0, 0, 0, 0,
+	0, 574, 574, 0, 185, 185, 185, 185, 185, 1762,
+	163, 175, 0, 0, 0, 0, 185, 0, 0, 169,
+	0, 185, 0, 0, 185, 0, 185, 0, 0, 185,
+	185, 185, 0, 0, 0, 0, 1774, 0, 0, 156,
+	161, 158, 164, 165, 166, 168, 170, 171, 172, 173,
+	1327, 0, 0, 791, 0, 174, 176, 177, 178, 0,
+	0, 791, 0, 0, 791, 0, 0, 0, 0, 0,
+	0, 0, 574, 185, 185, 947, 180, 185, 0, 0,
+	0, 0, 1362, 0, 0, 0, 0, 1426, 0, 0,
+	574, 0, 0, 0, 0, 0, 0, 0, 574, 0,
+	119, 0, 141, 185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 162, 0, 0, 185, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	954, 185, 0, 0, 185, 0, 154, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
+	140, 0, 0, 0, 0, 0, 0, 947, 0, 0,
+	0, 0, 0, 954, 1375, 0, 0, 0, 0, 0,
+	0, 159, 0, 160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1430,
+	1431, 150, 149, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	947, 0, 1641, 0, 0, 0, 574, 185, 1641, 1641,
+	0, 0, 1388, 1391, 1392, 1393, 1394, 1395, 1396, 0,
+	1397, 1398, 1399, 1400, 1401, 1376, 1377, 1378, 1379, 1360,
+	1361, 1389, 0, 1363, 0, 1364, 1365, 1366, 1367, 1368,
+	1369, 1370, 1371, 1372, 0, 0, 1373, 1380, 1381, 1382,
+	1383, 1384, 1385, 1386, 1387, 0, 0, 0, 0, 0,
+	0, 0, 155, 0, 0, 0, 185, 0, 0, 167,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	145, 1432, 152, 0, 1429, 0, 146, 147, 0, 0,
+	0, 0, 163, 0, 0, 185, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 1967, 0, 0, 0, 0,
+	0, 175, 0, 0, 185, 0, 0, 0, 0, 0,
+	185, 185, 185, 0, 0, 0, 0, 0, 0, 0,
+	574, 574, 0, 0, 660, 0, 0, 0, 0, 156,
+	161, 158, 164, 165, 166, 168, 170, 171, 172, 173,
+	0, 0, 0, 0, 0, 174, 176, 177, 178, 1390,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 574, 574, 574, 574, 0, 0,
+	0, 0, 0, 0, 183, 0, 0, 536, 0, 0,
+	568, 0, 0, 0, 0, 0, 0, 536, 0, 0,
+	0, 0, 0, 0, 0, 536, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 154, 758,
+	0, 0, 1665, 0, 0, 0, 1666, 0, 0, 0,
+	0, 0, 0, 0, 0, 776, 0, 776, 0, 0,
+	0, 185, 0, 0, 0, 536, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	791, 0, 0, 0, 0, 574, 0, 574, 0, 0,
+	0, 0, 0, 0, 0, 1774, 0, 0, 0, 0,
+	0, 2075, 0, 0, 0, 1306, 1748, 0, 148, 0,
+	0, 0, 2090, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1770, 0, 0,
+	0, 142, 0, 0, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 574, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
-	0, 573, 0, 572, 0, 0, 185, 0, 185, 0,
-	185, 185, 573, 2944, 0, 573, 0, 2840, 0, 2823,
-	2824, 2826, 2825, 1371, 0, 0, 573, 0, 0, 0,
-	0, 0, 2828, 0, 0, 0, 0, 2344, 2837, 0,
-	0, 0, 0, 0, 572, 0, 744, 0, 0, 2843,
-	0, 0, 0, 0, 0, 2839, 0, 0, 2845, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 572, 184, 0,
-	0, 573, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2864, 0, 0, 0, 0, 2852, 36, 2856, 0,
-	0, 0, 2861, 2859, 0, 0, 0, 0, 573, 0,
-	0, 0, 0, 0, 573, 0, 0, 0, 0, 572,
-	0, 0, 0, 0, 2778, 2779, 2780, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 572, 572, 0, 0, 2341, 2881, 0,
-	0, 0, 36, 2891, 0, 0, 572, 2889, 0, 0,
-	0, 573, 0, 573, 1359, 184, 572, 2894, 2899, 573,
-	573, 2902, 2911, 2896, 2895, 2893, 2898, 2897, 2886, 0,
-	0, 2795, 2904, 0, 0, 0, 2932, 2922, 2914, 2927,
-	0, 0, 0, 0, 0, 0, 0, 1660, 0, 0,
-	0, 1661, 0, 2939, 0, 0, 0, 185, 0, 0,
-	0, 2945, 0, 0, 185, 572, 0, 185, 185, 0,
-	0, 185, 0, 185, 572, 0, 0, 0, 1642, 2958,
-	185, 2965, 2960, 0, 2962, 0, 0, 185, 0, 2968,
-	2966, 0, 0, 0, 1640, 2964, 1372, 0, 0, 0,
-	0, 0, 0, 0, 2979, 0, 0, 0, 2985, 185,
-	1303, 1743, 2987, 0, 573, 0, 0, 0, 0, 0,
-	0, 1642, 0, 0, 2993, 0, 2995, 2996, 2813, 2994,
-	0, 0, 0, 0, 0, 0, 0, 1640, 0, 0,
-	0, 0, 1765, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1385, 1388, 1389, 1390, 1391, 1392, 1393,
-	0, 1394, 1395, 1396, 1397, 1398, 1373, 1374, 1375, 1376,
-	1357, 1358, 1386, 0, 1360, 0, 1361, 1362, 1363, 1364,
-	1365, 1366, 1367, 1368, 1369, 0, 0, 1370, 1377, 1378,
-	1379, 1380, 1381, 1382, 1383, 1384, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 790, 0, 790,
-	790, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1129, 1131, 0, 0, 0, 0,
-	0, 0, 0, 0, 1868, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 0, 573, 119, 0, 0, 0, 0,
-	573, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	1387, 573, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 0, 0, 0, 185,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1269, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 573, 0, 0, 0, 0, 0, 185, 1280,
-	1281, 0, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 573,
-	0, 0, 0, 0, 0, 0, 573, 0, 0, 0,
-	0, 0, 0, 0, 1324, 573, 0, 0, 0, 0,
-	0, 0, 0, 790, 0, 0, 0, 0, 0, 1344,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 185, 185, 185, 185, 0, 0,
-	0, 0, 0, 0, 0, 185, 185, 0, 0, 0,
-	0, 0, 1979, 0, 0, 0, 1983, 163, 1984, 1985,
-	0, 185, 0, 0, 0, 0, 169, 1993, 0, 0,
-	1994, 1995, 0, 0, 0, 0, 0, 0, 0, 0,
-	573, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2001, 2002, 2003,
-	2004, 2005, 0, 2007, 0, 0, 0, 0, 0, 2011,
-	0, 2012, 0, 0, 0, 2015, 0, 0, 0, 0,
-	0, 0, 0, 2024, 2025, 2026, 2027, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2038, 2039, 0, 0,
-	0, 573, 0, 0, 2044, 2045, 2046, 2047, 2048, 0,
-	1765, 0, 0, 0, 0, 0, 0, 573, 0, 0,
-	0, 2061, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 573, 0, 0, 0,
-	0, 0, 154, 0, 0, 0, 0, 0, 0, 0,
-	573, 0, 0, 0, 0, 0, 0, 573, 573, 0,
-	185, 185, 185, 185, 185, 0, 0, 0, 0, 0,
-	946, 0, 185, 0, 0, 0, 0, 185, 0, 0,
-	185, 0, 185, 1269, 0, 185, 185, 185, 0, 1452,
-	1452, 0, 1452, 0, 1452, 1452, 0, 1461, 1452, 1452,
-	1452, 1452, 1452, 0, 0, 0, 0, 0, 0, 0,
-	1269, 0, 0, 1269, 946, 0, 0, 0, 0, 0,
-	0, 0, 0, 659, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 573, 2183, 0, 0, 0, 1528, 0,
-	0, 573, 0, 0, 0, 0, 185, 119, 0, 141,
-	0, 0, 0, 1546, 0, 0, 0, 0, 0, 185,
-	162, 0, 0, 183, 0, 0, 535, 0, 155, 567,
-	0, 0, 0, 0, 185, 167, 535, 185, 0, 0,
-	0, 0, 0, 0, 535, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 790, 0, 140, 757, 0,
-	0, 0, 790, 790, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 775, 0, 775, 175, 159, 0,
-	160, 0, 0, 0, 535, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1427, 1428, 150, 149,
-	179, 0, 0, 0, 0, 156, 161, 158, 164, 165,
-	166, 168, 170, 171, 172, 173, 0, 2272, 0, 573,
-	185, 174, 176, 177, 178, 0, 1636, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2287, 0, 0,
-	1649, 0, 0, 0, 0, 0, 0, 1269, 0, 0,
-	0, 0, 0, 0, 0, 1664, 1665, 0, 2302, 2303,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 790, 0, 0, 0, 0, 145, 1429, 152,
-	0, 1426, 0, 146, 147, 0, 0, 0, 185, 163,
-	0, 0, 1278, 0, 0, 0, 0, 2347, 169, 0,
-	0, 0, 1757, 0, 0, 0, 0, 185, 0, 0,
-	185, 185, 185, 0, 2365, 2366, 0, 0, 0, 0,
-	573, 573, 0, 0, 0, 0, 0, 0, 0, 1769,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1324, 0, 534, 790, 0, 0, 0,
-	0, 0, 0, 0, 790, 575, 0, 790, 0, 0,
-	0, 0, 0, 738, 573, 573, 573, 573, 946, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 950, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 154, 0, 0, 0, 0, 0,
-	0, 0, 0, 953, 0, 0, 0, 0, 0, 0,
-	0, 185, 0, 0, 0, 0, 0, 0, 2488, 0,
-	0, 0, 0, 0, 0, 2492, 0, 0, 0, 0,
-	946, 0, 0, 0, 0, 573, 953, 573, 0, 2502,
-	2503, 2505, 2507, 0, 0, 0, 0, 0, 0, 2513,
-	0, 0, 0, 0, 2517, 2518, 2519, 0, 0, 0,
-	0, 2522, 0, 0, 148, 0, 0, 2524, 0, 0,
-	2528, 2529, 2530, 2531, 2532, 2533, 2534, 2535, 2536, 2537,
-	0, 0, 2538, 946, 0, 1636, 0, 0, 2543, 0,
-	0, 1636, 1636, 0, 0, 0, 0, 142, 0, 573,
-	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 573, 0, 0, 0, 0,
-	0, 0, 0, 2565, 2566, 0, 0, 2570, 573, 0,
-	155, 0, 0, 0, 0, 0, 0, 167, 0, 0,
-	0, 0, 0, 0, 0, 2582, 2583, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 573, 0, 0,
-	0, 573, 573, 0, 0, 535, 0, 535, 0, 0,
-	535, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	0, 0, 0, 0, 0, 0, 1960, 0, 0, 0,
-	573, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 156, 161, 158,
-	164, 165, 166, 168, 170, 171, 172, 173, 0, 0,
-	0, 0, 0, 174, 176, 177, 178, 0, 0, 0,
-	0, 573, 0, 0, 0, 2649, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1371, 185, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 573, 185, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2676, 0, 0, 0, 2677, 0,
-	0, 0, 0, 0, 2681, 0, 573, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1270, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	573, 573, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 790, 0, 573, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 573, 0, 0, 1769, 0, 0, 0,
-	0, 0, 2068, 0, 0, 0, 0, 0, 0, 0,
-	535, 0, 0, 2083, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1359, 0, 0, 757,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 573, 0, 1068, 0, 1074, 0, 0, 1076,
-	0, 573, 535, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2784, 0, 0, 0, 0, 0, 0,
-	0, 0, 2791, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2801, 2802, 2803, 0,
-	2804, 2805, 0, 0, 0, 0, 2808, 0, 2809, 0,
-	2811, 2814, 0, 0, 2166, 0, 0, 2818, 1372, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1769, 0, 0, 0, 0, 0, 0, 2191, 0,
-	0, 0, 0, 0, 0, 2844, 0, 2196, 0, 0,
-	0, 0, 0, 2730, 2732, 2731, 2741, 2742, 2743, 2744,
-	2745, 2746, 2747, 624, 0, 1385, 1388, 1389, 1390, 1391,
-	1392, 1393, 0, 1394, 1395, 1396, 1397, 1398, 1373, 1374,
-	1375, 1376, 1357, 1358, 1386, 0, 1360, 0, 1361, 1362,
-	1363, 1364, 1365, 1366, 1367, 1368, 1369, 0, 0, 1370,
-	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 0, 0,
-	0, 0, 0, 0, 0, 0, 2866, 535, 0, 0,
-	0, 0, 1636, 0, 0, 0, 0, 0, 0, 0,
-	2871, 0, 0, 0, 0, 2876, 0, 0, 0, 0,
-	0, 2877, 2878, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1269, 2884,
-	1269, 0, 0, 1269, 0, 0, 0, 1270, 1269, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 790, 0, 0, 0, 0, 0, 0,
-	0, 1331, 0, 0, 1270, 0, 0, 1270, 0, 1452,
-	0, 0, 535, 0, 0, 0, 0, 0, 0, 0,
-	2935, 0, 1387, 0, 0, 0, 0, 0, 2321, 1502,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2941,
-	0, 0, 790, 2942, 535, 0, 1269, 0, 0, 2348,
-	1452, 1269, 0, 0, 0, 0, 0, 0, 0, 1548,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 535, 0, 0, 0, 0, 0,
-	0, 535, 0, 0, 0, 0, 0, 0, 0, 0,
-	1571, 1572, 535, 535, 535, 535, 535, 535, 535, 0,
-	0, 0, 2736, 2737, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 535, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 946, 0, 0, 1269, 0,
-	0, 0, 0, 1769, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 669, 0, 619, 673, 621,
-	670, 671, 0, 617, 620, 672, 1349, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 775, 0,
-	0, 0, 0, 0, 0, 775, 775, 0, 0, 0,
-	0, 1270, 622, 623, 2729, 2733, 2734, 2735, 2738, 2739,
-	2740, 2748, 2750, 647, 2749, 2751, 2752, 2753, 2756, 2757,
-	2758, 2759, 2754, 2755, 2760, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 775, 1548, 775, 775, 775,
-	775, 775, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1486, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2321, 1502, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 775, 0, 0, 0,
-	0, 0, 0, 1530, 0, 0, 0, 0, 0, 0,
-	757, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 535, 0, 0, 0, 0, 0, 0,
-	1548, 0, 535, 1558, 535, 0, 535, 1805, 0, 0,
-	1562, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1573, 1574, 1575, 1576, 1577, 1578, 1579, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 774, 0,
-	0, 0, 0, 1594, 0, 0, 0, 0, 0, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1769, 1769, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 585, 2662, 2663, 2664, 2665,
-	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 768, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 160, 0, 0, 0,
-	0, 1269, 0, 1269, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 129, 150, 149, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1269, 535, 0, 0, 0, 2717, 0, 2719,
-	535, 0, 0, 535, 535, 0, 0, 535, 0, 1952,
-	0, 0, 0, 0, 0, 0, 535, 0, 0, 0,
-	0, 0, 1783, 535, 0, 0, 0, 0, 0, 0,
-	0, 1787, 0, 1790, 0, 0, 1594, 0, 0, 0,
-	0, 0, 0, 0, 0, 535, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1769, 0, 145, 126, 152, 133, 125, 0, 146,
-	147, 0, 0, 0, 0, 163, 0, 2786, 0, 0,
-	0, 0, 0, 0, 169, 134, 0, 0, 0, 0,
-	790, 0, 0, 0, 0, 685, 77, 0, 0, 137,
-	135, 130, 131, 132, 136, 0, 0, 0, 0, 0,
-	0, 127, 0, 0, 0, 0, 0, 775, 0, 2819,
-	138, 0, 0, 2819, 2819, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 76, 38, 39,
-	78, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1769, 0, 0, 0, 0, 82, 0, 0,
-	0, 42, 68, 69, 0, 66, 70, 0, 0, 0,
-	0, 747, 0, 77, 67, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 747, 0, 1769, 775, 775, 0, 0, 0, 0,
-	154, 0, 0, 55, 0, 1548, 0, 954, 535, 0,
-	0, 0, 0, 0, 0, 85, 1502, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1769, 0, 0, 0,
-	0, 0, 1594, 0, 0, 0, 0, 0, 0, 1940,
-	0, 0, 1945, 1946, 0, 0, 1950, 0, 0, 0,
-	0, 0, 0, 0, 0, 1953, 0, 0, 0, 0,
-	0, 535, 1956, 0, 0, 535, 1269, 0, 2882, 0,
-	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1959, 0, 0, 0, 0, 0,
-	0, 0, 790, 790, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 2912, 143, 0, 0, 0,
-	0, 0, 0, 0, 0, 2917, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 535, 0, 155, 0, 0, 0,
-	0, 2176, 0, 167, 0, 0, 0, 0, 0, 45,
-	48, 51, 50, 53, 0, 65, 0, 0, 73, 0,
-	0, 0, 0, 0, 1769, 0, 0, 0, 0, 0,
-	0, 0, 0, 2917, 0, 0, 0, 0, 0, 0,
-	0, 54, 81, 80, 0, 175, 63, 64, 52, 0,
-	0, 0, 0, 0, 71, 72, 0, 0, 0, 535,
-	535, 535, 535, 535, 0, 0, 0, 0, 0, 0,
-	0, 535, 535, 156, 161, 158, 164, 165, 166, 168,
-	170, 171, 172, 173, 0, 1159, 0, 535, 0, 174,
-	176, 177, 178, 56, 57, 0, 58, 59, 60, 61,
-	0, 0, 775, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1206, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1270, 0, 1270, 0, 0, 1270, 0, 0,
-	0, 0, 1270, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2122, 0, 775, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 535, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
-	1270, 0, 0, 0, 0, 1270, 535, 535, 535, 535,
-	535, 0, 0, 2170, 0, 0, 0, 0, 2364, 0,
-	0, 0, 0, 535, 0, 0, 1502, 0, 535, 0,
-	84, 535, 2375, 1548, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 535, 0, 2218, 2219,
-	2220, 2221, 2222, 0, 0, 0, 0, 0, 0, 0,
-	1594, 2228, 1270, 1119, 0, 1119, 1119, 0, 0, 0,
-	0, 0, 535, 0, 0, 0, 2236, 0, 0, 0,
-	0, 0, 77, 0, 0, 535, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	535, 0, 0, 535, 0, 0, 0, 0, 0, 747,
-	1194, 1195, 1196, 0, 1199, 0, 1201, 1202, 1203, 1204,
-	0, 1207, 1209, 1209, 0, 1209, 1213, 1213, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1248, 1249, 1250, 1251, 0, 1253, 1254, 1255, 1256,
-	1257, 0, 0, 180, 0, 1213, 1213, 1213, 1213, 1213,
-	0, 0, 0, 0, 1423, 0, 535, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 141,
-	0, 0, 0, 1406, 0, 0, 0, 0, 0, 0,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 1279, 535, 0, 140, 0, 747,
-	0, 0, 0, 747, 0, 0, 0, 0, 0, 747,
-	2063, 0, 0, 686, 687, 0, 0, 0, 159, 1641,
-	160, 0, 0, 0, 535, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2421, 1427, 1428, 150, 149,
-	179, 0, 0, 535, 0, 0, 535, 535, 535, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2459, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2468, 0, 0, 0, 1551, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2480,
-	0, 0, 2483, 0, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
-	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
-	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 734, 0, 145, 1429, 152,
-	0, 1426, 0, 146, 147, 1270, 0, 1270, 0, 163,
-	0, 0, 0, 1601, 1602, 1603, 1604, 0, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 1502, 0, 0,
-	0, 0, 0, 0, 0, 2545, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1270, 0, 0, 0,
-	0, 0, 0, 0, 1646, 1647, 0, 0, 0, 0,
-	0, 0, 1652, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1688, 1689, 1690,
-	1691, 1692, 1693, 1695, 1699, 1700, 585, 1706, 1707, 1708,
-	1709, 1710, 1711, 1712, 1713, 1714, 1715, 1716, 1717, 1718,
-	1719, 1720, 1721, 1722, 1723, 1724, 1725, 1726, 1727, 1728,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2608, 0, 0, 0, 0, 1502, 0,
-	0, 0, 0, 0, 154, 0, 0, 0, 585, 0,
-	0, 0, 2623, 0, 0, 2624, 2625, 2626, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1777, 1778, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1814, 0, 0, 148, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1119, 0, 0, 0, 0, 0, 142, 1119, 1119,
-	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1852, 0, 1502, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 0, 0, 0, 167, 0, 0,
-	0, 535, 85, 0, 0, 0, 0, 0, 679, 686,
-	687, 688, 689, 690, 680, 682, 0, 0, 0, 681,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 684, 691, 692, 0, 0, 0, 0, 175,
-	1270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 156, 161, 158,
-	164, 165, 166, 168, 170, 171, 172, 173, 2465, 2466,
-	0, 0, 0, 174, 176, 177, 178, 0, 1502, 0,
-	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
-	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
-	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
-	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1797, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1992, 0, 954,
-	2865, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 85, 954, 1206, 0, 0, 0, 679, 686, 687,
-	688, 689, 690, 680, 682, 0, 0, 0, 681, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 684, 691, 692, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 669, 0, 0, 673, 0, 670, 671,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2465, 2466, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1318, 693,
-	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
-	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
-	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
-	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	734, 686, 687, 0, 0, 0, 0, 1641, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	574, 0, 0, 0, 155, 0, 0, 0, 0, 0,
+	0, 167, 0, 574, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2173, 0, 0, 0, 0, 0, 0,
+	0, 0, 574, 0, 0, 0, 574, 574, 0, 0,
+	0, 0, 0, 175, 0, 0, 0, 0, 0, 0,
+	1774, 0, 0, 0, 0, 0, 0, 2201, 0, 1873,
+	0, 0, 0, 0, 0, 574, 2206, 0, 0, 0,
+	0, 156, 161, 158, 164, 165, 166, 168, 170, 171,
+	172, 173, 0, 0, 0, 0, 0, 174, 176, 177,
+	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 574, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1968, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1641, 0, 574, 185, 2748, 2750, 2749,
+	2759, 2760, 2761, 2762, 2763, 2764, 2765, 625, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1272,
+	0, 1272, 0, 0, 1272, 0, 0, 574, 0, 1272,
+	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
+	0, 0, 0, 0, 791, 0, 0, 0, 0, 0,
+	0, 0, 574, 574, 0, 0, 0, 0, 0, 0,
+	1455, 0, 0, 0, 0, 574, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 574, 0, 0, 0, 2331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 791, 0, 0, 0, 1272, 0, 0,
+	2358, 1455, 1272, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 574, 0, 0, 0,
+	0, 0, 0, 0, 0, 574, 0, 0, 0, 1986,
+	0, 0, 0, 1990, 0, 1991, 1992, 536, 0, 536,
+	0, 0, 536, 0, 2000, 0, 0, 2001, 2002, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2430, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2008, 2009, 2010, 2011, 2012, 947,
+	2014, 0, 1272, 0, 0, 0, 2018, 1774, 2019, 0,
+	0, 0, 2022, 0, 0, 0, 0, 0, 0, 0,
+	2031, 2032, 2033, 2034, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2045, 2046, 0, 0, 2754, 2755, 0,
+	0, 2051, 2052, 2053, 2054, 2055, 0, 1770, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2068, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	670, 0, 620, 674, 622, 671, 672, 0, 618, 621,
+	673, 0, 0, 0, 0, 0, 0, 0, 0, 1273,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2331, 0, 623, 624, 2747,
+	2751, 2752, 2753, 2756, 2757, 2758, 2766, 2768, 648, 2767,
+	2769, 2770, 2771, 2774, 2775, 2776, 2777, 2772, 2773, 2778,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 536, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 758, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 536, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1281, 0, 0, 0, 0, 1774,
+	1774, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 686, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2680, 2681, 2682, 2683, 535, 0, 0,
+	0, 0, 0, 0, 0, 0, 2282, 576, 0, 0,
+	0, 0, 0, 0, 0, 739, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1272, 748,
+	1272, 77, 0, 0, 0, 0, 0, 2312, 2313, 0,
+	0, 0, 0, 0, 0, 951, 0, 0, 0, 748,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 536,
+	0, 0, 0, 0, 0, 955, 0, 0, 0, 1272,
+	0, 0, 0, 0, 2735, 0, 2737, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2357, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1273,
+	0, 0, 0, 2375, 2376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1273, 0, 0, 1273,
+	0, 0, 0, 1774, 536, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2810,
+	0, 1505, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 791, 0, 0, 0, 536, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1553, 0, 0, 0, 0, 0, 0,
+	0, 2843, 0, 0, 0, 2843, 2843, 0, 536, 0,
+	0, 0, 0, 0, 0, 536, 0, 0, 0, 0,
+	0, 0, 0, 0, 1576, 1577, 536, 536, 536, 536,
+	536, 536, 536, 0, 1774, 0, 0, 0, 0, 0,
+	2501, 0, 0, 0, 0, 0, 0, 2505, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 536, 0,
+	0, 2515, 2516, 2518, 2520, 0, 0, 0, 0, 0,
+	0, 2526, 0, 0, 0, 1774, 2530, 2531, 2532, 0,
+	0, 0, 0, 2535, 0, 0, 0, 0, 0, 2537,
+	0, 0, 2541, 2542, 2543, 2544, 2545, 2546, 2547, 2548,
+	2549, 2550, 0, 0, 2551, 0, 0, 0, 0, 0,
+	2556, 0, 776, 0, 1774, 0, 0, 0, 0, 776,
+	776, 0, 0, 0, 0, 1273, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2578, 2579, 0, 0, 2583,
+	0, 0, 0, 0, 1272, 0, 2907, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2595, 2596, 776,
+	1553, 776, 776, 776, 776, 776, 0, 0, 0, 0,
+	0, 791, 791, 0, 0, 0, 0, 775, 0, 0,
+	0, 0, 0, 0, 2938, 0, 1505, 0, 0, 0,
+	0, 0, 0, 0, 2943, 0, 0, 0, 0, 0,
+	776, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 758, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 536, 0, 0,
+	0, 0, 0, 0, 1553, 0, 536, 0, 536, 0,
+	536, 1810, 0, 0, 586, 1774, 0, 0, 0, 0,
+	2667, 0, 0, 0, 2943, 0, 0, 1069, 0, 1077,
+	0, 0, 1079, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 769, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1122, 0, 1122, 1122, 0, 0, 0, 2694,
+	0, 0, 0, 2695, 0, 0, 0, 0, 0, 2699,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 748, 1197,
+	1198, 1199, 0, 1202, 0, 1204, 1205, 1206, 1207, 0,
+	1210, 1212, 1212, 0, 1212, 1216, 1216, 1218, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
+	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 1254, 0, 1256, 1257, 1258, 1259, 1260,
+	0, 0, 0, 0, 1216, 1216, 1216, 1216, 1216, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 536, 0, 0,
+	0, 0, 0, 2808, 536, 0, 0, 536, 536, 0,
+	0, 536, 2815, 1957, 536, 536, 0, 0, 0, 0,
+	0, 0, 536, 0, 0, 0, 2825, 2826, 2827, 536,
+	2828, 2829, 0, 0, 0, 0, 2832, 0, 2833, 0,
+	2835, 2838, 0, 1282, 0, 0, 0, 2842, 748, 0,
+	0, 536, 748, 0, 0, 0, 0, 0, 748, 0,
+	0, 0, 0, 0, 1334, 0, 0, 0, 0, 0,
+	0, 76, 38, 39, 78, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2868, 0, 0, 0, 0,
+	0, 82, 0, 0, 0, 42, 68, 69, 0, 66,
+	70, 0, 0, 0, 0, 0, 0, 0, 67, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 776, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2891, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2896, 0, 0, 0,
+	0, 2901, 0, 0, 0, 0, 0, 2902, 2903, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	776, 776, 0, 0, 0, 2909, 0, 0, 0, 0,
+	0, 1553, 0, 0, 536, 0, 0, 0, 0, 0,
+	0, 0, 1505, 0, 0, 0, 0, 0, 0, 1352,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2962, 0,
+	0, 0, 0, 0, 0, 0, 0, 536, 0, 0,
+	0, 536, 0, 0, 0, 0, 0, 2968, 0, 0,
+	0, 2969, 0, 45, 48, 51, 50, 53, 0, 65,
+	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1489, 54, 81, 80, 0, 0,
+	63, 64, 52, 0, 0, 1162, 0, 0, 71, 72,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	536, 0, 0, 0, 0, 0, 1533, 2186, 0, 0,
+	0, 0, 0, 1209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 56, 57,
+	0, 58, 59, 60, 61, 0, 0, 0, 1563, 0,
+	0, 0, 0, 0, 0, 1567, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1578, 1579, 1580, 1581,
+	1582, 1583, 1584, 0, 0, 536, 536, 536, 536, 536,
+	0, 0, 0, 0, 0, 0, 0, 536, 536, 0,
+	0, 0, 1122, 0, 586, 0, 0, 0, 1599, 1122,
+	1122, 0, 0, 0, 0, 536, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	776, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1273, 79, 1273, 0, 0, 1273, 0, 0, 0, 0,
+	1273, 0, 0, 0, 0, 0, 0, 0, 1321, 0,
+	0, 0, 0, 0, 776, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 84, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 536, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1273, 0,
+	0, 0, 0, 1273, 536, 536, 536, 536, 536, 0,
+	0, 0, 0, 0, 0, 0, 2374, 0, 0, 0,
+	0, 536, 0, 0, 1505, 0, 536, 0, 0, 536,
+	2385, 1553, 0, 0, 0, 0, 0, 1788, 0, 0,
+	0, 0, 0, 0, 0, 0, 1792, 0, 1795, 0,
+	0, 1599, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1802,
+	0, 0, 0, 536, 536, 0, 0, 536, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2070, 0, 1273, 687, 688, 0, 0, 0, 0,
+	1646, 0, 0, 536, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 536, 0, 0, 0,
+	0, 0, 0, 1409, 0, 0, 0, 0, 0, 0,
+	0, 536, 0, 0, 536, 0, 0, 0, 0, 0,
+	955, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 955, 0, 694, 695, 696, 697, 698,
+	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
+	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
+	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
+	729, 730, 731, 732, 733, 734, 735, 536, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 693, 694, 695, 696, 697, 698, 699, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 733, 734, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1599, 0, 0,
+	1556, 0, 0, 0, 1945, 0, 0, 1950, 1951, 0,
+	0, 1955, 0, 0, 1958, 1959, 0, 0, 0, 0,
+	0, 0, 1960, 0, 0, 0, 536, 0, 0, 1963,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2240, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2263, 2264, 0, 0, 2042,
-	2267, 0, 0, 0, 0, 2269, 2270, 2271, 0, 0,
-	0, 0, 0, 0, 0, 0, 2059, 2276, 2277, 2278,
-	0, 0, 1706, 2280, 0, 2281, 2282, 0, 0, 0,
-	2289, 2290, 0, 747, 0, 0, 0, 0, 1706, 1706,
-	1706, 1706, 1706, 585, 585, 585, 585, 0, 0, 0,
-	0, 0, 0, 0, 2099, 2100, 0, 0, 0, 0,
-	0, 0, 0, 2119, 0, 2120, 2121, 0, 0, 0,
+	0, 1966, 0, 0, 0, 536, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 536, 1606, 1607, 1608, 1609, 0,
+	536, 536, 536, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1975, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1651, 1652, 0, 0,
+	0, 0, 0, 0, 1657, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1693,
+	1694, 1695, 1696, 1697, 1698, 1700, 1704, 1705, 586, 1711,
+	1712, 1713, 1714, 1715, 1716, 1717, 1718, 1719, 1720, 1721,
+	1722, 1723, 1724, 1725, 1726, 1727, 1728, 1729, 1730, 1731,
+	1732, 1733, 0, 0, 0, 0, 0, 0, 0, 1273,
+	0, 1273, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2336, 0, 0, 0, 0, 0, 0,
+	586, 1505, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1273, 0, 2049, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1782, 1783, 0, 0, 2066,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2369, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 748, 180, 0, 0,
+	0, 0, 0, 1819, 0, 0, 0, 0, 0, 0,
+	0, 2129, 0, 0, 0, 0, 0, 2106, 2107, 0,
+	0, 119, 0, 141, 0, 0, 2126, 0, 2127, 2128,
+	0, 0, 0, 0, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1505, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1857, 0, 0, 151, 0, 0, 0, 0,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	2180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 0, 160, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	128, 129, 150, 149, 179, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2207, 0, 0,
+	0, 0, 0, 0, 0, 2228, 2229, 2230, 2231, 2232,
+	0, 0, 0, 0, 0, 0, 0, 1599, 2238, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2431, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2246, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1505, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 536, 0, 0, 0,
+	0, 145, 126, 152, 133, 125, 0, 146, 147, 0,
+	0, 0, 0, 163, 0, 0, 0, 0, 0, 0,
+	0, 0, 169, 134, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1273, 0, 137, 135, 130,
+	131, 132, 136, 0, 0, 0, 0, 536, 0, 127,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1505, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1802, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1999, 0, 0, 0, 0, 0, 0, 2355, 0,
+	77, 0, 0, 1802, 1802, 1802, 1802, 1802, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	2126, 748, 0, 0, 0, 1802, 0, 1209, 1802, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2431, 2432, 0, 0, 2434, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2510, 0, 0,
-	0, 0, 0, 0, 2516, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2472, 0, 0, 0, 0, 0, 148,
+	0, 0, 0, 0, 2466, 0, 2481, 0, 0, 0,
+	0, 0, 0, 0, 2474, 0, 0, 0, 0, 0,
+	0, 2493, 1321, 0, 2496, 0, 0, 0, 0, 0,
+	0, 0, 142, 0, 0, 143, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2504, 0, 0, 0, 0, 155, 0, 0, 0, 0,
+	0, 0, 167, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1797, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
-	0, 0, 0, 2345, 0, 77, 0, 0, 1797, 1797,
-	1797, 1797, 1797, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2119, 747, 0, 0, 0,
-	1797, 0, 0, 1797, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2558, 0, 0,
+	0, 0, 0, 0, 175, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 156, 161, 158, 164, 165, 166, 168, 170,
+	171, 172, 173, 0, 0, 0, 0, 0, 174, 176,
+	177, 178, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2630, 0, 0,
+	0, 0, 0, 0, 0, 1802, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2621, 0, 0, 2604, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2453, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2461, 0, 0, 0,
-	2654, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2250, 2636, 0, 0, 0, 0, 0,
+	2642, 2643, 2644, 0, 0, 0, 0, 0, 0, 0,
+	0, 2273, 2274, 0, 0, 0, 2277, 0, 0, 0,
+	0, 2279, 2280, 2281, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2286, 2287, 2288, 0, 0, 1711, 2290,
+	0, 2291, 2292, 0, 0, 0, 2299, 2300, 0, 0,
+	0, 0, 0, 0, 1711, 1711, 1711, 1711, 1711, 586,
+	586, 586, 586, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
+	0, 0, 0, 0, 0, 680, 687, 688, 689, 690,
+	691, 681, 683, 0, 0, 0, 682, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 685,
+	692, 693, 0, 0, 0, 0, 0, 0, 0, 2346,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2707, 0, 2711, 2712,
+	0, 0, 0, 0, 0, 0, 2379, 0, 0, 0,
+	0, 0, 0, 0, 0, 2478, 2479, 0, 0, 0,
+	0, 2355, 0, 77, 0, 2355, 0, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
+	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
+	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
+	727, 728, 729, 730, 731, 732, 733, 734, 735, 2444,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2491, 2668, 0, 2669, 0, 0, 0, 0,
-	2672, 2673, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2680, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2800,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2713, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2721, 0, 0, 2723, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2727, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2764, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1797, 0, 0,
+	0, 0, 0, 0, 0, 0, 2523, 0, 0, 0,
+	0, 0, 0, 2529, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2355, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2591, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
+	1240, 1241, 1242, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 586, 0, 0, 0, 0, 0, 0,
+	0, 0, 2879, 0, 0, 0, 2890, 0, 0, 0,
+	0, 670, 0, 0, 674, 0, 671, 672, 0, 0,
+	77, 673, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 687, 688, 0, 0, 0, 0, 1646, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2915, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2910, 0, 0, 0, 0, 77, 0, 0, 0, 2648,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2827, 585, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2672, 694, 695, 696, 697, 698, 699, 700,
+	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
+	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
+	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
+	731, 732, 733, 734, 735, 2686, 0, 2687, 0, 0,
+	0, 0, 2690, 2691, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 85, 0, 0, 2698, 0, 0, 680,
+	687, 688, 689, 690, 691, 681, 683, 0, 0, 0,
+	682, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 685, 692, 693, 0, 0, 0, 0,
+	0, 0, 0, 0, 2731, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2739, 0, 0, 2741, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2745, 0, 0, 0, 0, 0, 0, 2478,
+	2479, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2782, 694, 695, 696, 697, 698, 699, 700, 701, 702,
+	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
+	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
+	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
+	733, 734, 735, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2851, 586, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 585, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2689, 0, 2693, 2694, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2870,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2345, 0, 77, 0, 2345, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 585, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 586, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2933,
-	0, 0, 0, 2776, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2895, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 586, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2345, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1241, 1242, 1243,
-	1244, 1245, 1246, 1247, 1248, 1249, 1250, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 361, 0,
+	0, 925, 910, 463, 2960, 856, 928, 825, 844, 938,
+	847, 850, 892, 804, 870, 379, 841, 796, 829, 799,
+	836, 800, 827, 858, 250, 824, 912, 874, 927, 334,
+	247, 806, 830, 393, 846, 195, 894, 437, 234, 344,
+	341, 470, 262, 253, 249, 233, 289, 351, 391, 457,
+	385, 934, 338, 880, 0, 447, 364, 0, 0, 0,
+	860, 916, 868, 906, 855, 893, 814, 879, 929, 842,
+	889, 930, 295, 232, 297, 194, 376, 448, 266, 0,
+	0, 0, 0, 186, 187, 188, 0, 2931, 0, 2932,
+	0, 0, 0, 0, 0, 0, 223, 0, 230, 319,
+	328, 327, 308, 309, 311, 313, 318, 325, 331, 838,
+	886, 924, 839, 888, 245, 293, 252, 244, 467, 935,
+	915, 803, 867, 923, 0, 0, 214, 926, 862, 0,
+	891, 0, 941, 798, 882, 0, 801, 805, 937, 919,
+	833, 255, 0, 0, 0, 0, 0, 0, 0, 859,
+	869, 903, 853, 0, 0, 0, 0, 0, 0, 0,
+	831, 0, 878, 0, 0, 0, 810, 802, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2854, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 360, 0, 0, 924, 909, 462, 77, 855,
-	927, 824, 843, 937, 846, 849, 891, 803, 869, 378,
-	840, 795, 828, 798, 835, 799, 826, 857, 249, 823,
-	911, 873, 926, 333, 246, 805, 829, 392, 845, 195,
-	893, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 933, 337, 879, 2885, 446,
-	363, 0, 0, 77, 859, 915, 867, 905, 854, 892,
-	813, 878, 928, 841, 888, 929, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 2905, 0, 2906, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 837, 885, 923, 838, 887, 244, 292,
-	251, 243, 466, 934, 914, 802, 866, 922, 0, 0,
-	213, 925, 861, 0, 890, 0, 940, 797, 881, 0,
-	800, 804, 936, 918, 832, 254, 0, 0, 0, 0,
-	0, 0, 0, 858, 868, 902, 852, 0, 0, 0,
-	0, 0, 0, 0, 830, 0, 877, 0, 0, 0,
-	809, 801, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 856, 0, 0, 0, 812, 0,
-	831, 903, 0, 794, 275, 806, 364, 236, 0, 907,
-	917, 853, 502, 921, 851, 850, 897, 810, 913, 844,
-	332, 808, 301, 190, 209, 0, 842, 374, 417, 423,
-	912, 827, 836, 234, 834, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 876, 895, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	1121, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 822, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 908, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 900, 939,
-	387, 422, 224, 487, 445, 817, 821, 815, 882, 816,
-	871, 872, 818, 930, 931, 932, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 904, 811, 0, 819, 820,
-	0, 910, 919, 920, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 875, 189, 205, 335, 935,
-	410, 267, 508, 496, 492, 796, 207, 814, 241, 825,
-	833, 0, 839, 847, 848, 860, 862, 863, 864, 865,
-	883, 884, 886, 894, 896, 899, 901, 906, 916, 938,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 874, 880, 346,
-	260, 280, 291, 889, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 870, 898, 342, 463, 464, 287, 360,
-	0, 0, 924, 909, 462, 0, 855, 927, 824, 843,
-	937, 846, 849, 891, 803, 869, 378, 840, 795, 828,
-	798, 835, 799, 826, 857, 249, 823, 911, 873, 926,
-	333, 246, 805, 829, 392, 845, 195, 893, 436, 233,
-	343, 340, 469, 261, 252, 248, 232, 288, 350, 390,
-	456, 384, 933, 337, 879, 0, 446, 363, 0, 0,
-	0, 859, 915, 867, 905, 854, 892, 813, 878, 928,
-	841, 888, 929, 294, 231, 296, 194, 375, 447, 265,
-	0, 0, 0, 0, 186, 187, 188, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 222, 0, 229,
-	318, 327, 326, 307, 308, 310, 312, 317, 324, 330,
-	837, 885, 923, 838, 887, 244, 292, 251, 243, 466,
-	934, 914, 802, 866, 922, 0, 0, 213, 925, 861,
-	0, 890, 0, 940, 797, 881, 0, 800, 804, 936,
-	918, 832, 254, 0, 0, 0, 0, 0, 0, 0,
-	858, 868, 902, 852, 0, 0, 0, 0, 0, 2376,
-	0, 830, 0, 877, 0, 0, 0, 809, 801, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 856, 0, 0, 0, 812, 0, 831, 903, 0,
-	794, 275, 806, 364, 236, 0, 907, 917, 853, 502,
-	921, 851, 850, 897, 810, 913, 844, 332, 808, 301,
-	190, 209, 0, 842, 374, 417, 423, 912, 827, 836,
-	234, 834, 421, 388, 485, 217, 263, 414, 394, 419,
-	401, 266, 876, 895, 420, 338, 471, 408, 482, 503,
-	504, 242, 368, 493, 460, 499, 507, 210, 239, 382,
-	453, 488, 443, 361, 467, 468, 300, 442, 273, 193,
-	336, 208, 429, 226, 215, 473, 491, 268, 412, 200,
-	455, 480, 223, 433, 0, 0, 509, 202, 478, 452,
-	357, 297, 298, 201, 0, 413, 247, 271, 237, 377,
-	475, 476, 235, 510, 212, 498, 204, 1121, 497, 370,
-	470, 479, 358, 348, 203, 477, 356, 347, 303, 322,
-	323, 259, 282, 406, 341, 407, 283, 366, 365, 367,
-	196, 489, 0, 197, 0, 448, 490, 511, 218, 219,
-	221, 822, 258, 262, 270, 272, 278, 279, 286, 334,
-	381, 405, 403, 409, 908, 465, 483, 494, 501, 506,
-	369, 285, 444, 302, 339, 900, 939, 387, 422, 224,
-	487, 445, 817, 821, 815, 882, 816, 871, 872, 818,
-	930, 931, 932, 512, 513, 514, 515, 516, 517, 518,
-	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	529, 0, 904, 811, 0, 819, 820, 0, 910, 919,
-	920, 530, 349, 435, 484, 304, 316, 319, 309, 328,
-	0, 329, 305, 306, 311, 313, 314, 315, 320, 321,
-	325, 331, 875, 189, 205, 335, 935, 410, 267, 508,
-	496, 492, 796, 207, 814, 241, 825, 833, 0, 839,
-	847, 848, 860, 862, 863, 864, 865, 883, 884, 886,
-	894, 896, 899, 901, 906, 916, 938, 191, 192, 198,
-	206, 216, 220, 227, 240, 255, 257, 264, 276, 281,
-	284, 289, 290, 293, 299, 345, 351, 352, 353, 354,
-	371, 372, 373, 376, 379, 380, 383, 385, 386, 389,
-	393, 397, 398, 399, 400, 402, 404, 411, 416, 424,
-	425, 426, 427, 428, 431, 432, 437, 438, 439, 440,
-	441, 449, 450, 454, 472, 474, 486, 500, 505, 430,
-	277, 481, 0, 344, 874, 880, 346, 260, 280, 291,
-	889, 495, 451, 211, 418, 269, 199, 230, 214, 238,
-	253, 256, 295, 355, 362, 391, 396, 274, 250, 228,
-	415, 225, 434, 457, 458, 459, 461, 359, 245, 395,
-	870, 898, 342, 463, 464, 287, 360, 0, 0, 924,
-	909, 462, 0, 855, 927, 824, 843, 937, 846, 849,
-	891, 803, 869, 378, 840, 795, 828, 798, 835, 799,
-	826, 857, 249, 823, 911, 873, 926, 333, 246, 805,
-	829, 392, 845, 195, 893, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 933,
-	337, 879, 0, 446, 363, 0, 0, 0, 859, 915,
-	867, 905, 854, 892, 813, 878, 928, 841, 888, 929,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
+	857, 0, 0, 0, 813, 0, 832, 904, 0, 795,
+	276, 807, 365, 237, 0, 908, 918, 854, 503, 922,
+	852, 851, 898, 811, 914, 845, 333, 809, 302, 190,
+	210, 0, 843, 375, 418, 424, 913, 828, 837, 235,
+	835, 422, 389, 486, 218, 264, 415, 395, 420, 402,
+	267, 877, 896, 421, 339, 472, 409, 483, 504, 505,
+	243, 369, 494, 461, 500, 508, 211, 240, 383, 454,
+	489, 444, 362, 468, 469, 301, 443, 274, 193, 200,
+	337, 209, 430, 227, 216, 474, 492, 269, 413, 201,
+	456, 481, 224, 434, 0, 0, 510, 203, 479, 453,
+	358, 298, 299, 202, 0, 414, 248, 272, 238, 378,
+	476, 477, 236, 511, 213, 499, 205, 1124, 498, 371,
+	471, 480, 359, 349, 204, 478, 357, 348, 304, 323,
+	324, 260, 283, 407, 342, 408, 284, 367, 366, 368,
+	196, 490, 0, 197, 0, 449, 491, 512, 219, 220,
+	222, 823, 259, 263, 271, 273, 279, 280, 287, 335,
+	382, 406, 404, 410, 909, 466, 484, 495, 502, 507,
+	370, 286, 445, 303, 340, 901, 940, 388, 423, 225,
+	488, 446, 818, 822, 816, 883, 817, 872, 873, 819,
+	931, 932, 933, 513, 514, 515, 516, 517, 518, 519,
+	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
+	530, 0, 905, 812, 0, 820, 821, 0, 911, 920,
+	921, 531, 350, 436, 485, 305, 317, 320, 310, 329,
+	0, 330, 306, 307, 312, 314, 315, 316, 321, 322,
+	326, 332, 876, 189, 206, 336, 936, 411, 268, 509,
+	497, 493, 797, 208, 815, 242, 826, 834, 0, 840,
+	848, 849, 861, 863, 864, 865, 866, 884, 885, 887,
+	895, 897, 900, 902, 907, 917, 939, 191, 192, 198,
+	207, 217, 221, 228, 241, 256, 258, 265, 277, 282,
+	285, 290, 291, 294, 300, 346, 352, 353, 354, 355,
+	372, 373, 374, 377, 380, 381, 384, 386, 387, 390,
+	394, 398, 399, 400, 401, 403, 405, 412, 417, 425,
+	426, 427, 428, 429, 432, 433, 438, 439, 440, 441,
+	442, 450, 451, 455, 473, 475, 487, 501, 506, 431,
+	278, 482, 0, 345, 875, 881, 347, 261, 281, 292,
+	890, 496, 452, 212, 419, 270, 199, 231, 215, 239,
+	254, 257, 296, 356, 363, 392, 397, 275, 251, 229,
+	416, 226, 435, 458, 459, 460, 462, 360, 246, 396,
+	871, 899, 343, 464, 465, 288, 361, 0, 0, 925,
+	910, 463, 0, 856, 928, 825, 844, 938, 847, 850,
+	892, 804, 870, 379, 841, 796, 829, 799, 836, 800,
+	827, 858, 250, 824, 912, 874, 927, 334, 247, 806,
+	830, 393, 846, 195, 894, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 934,
+	338, 880, 0, 447, 364, 0, 0, 0, 860, 916,
+	868, 906, 855, 893, 814, 879, 929, 842, 889, 930,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
 	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 837, 885, 923,
-	838, 887, 244, 292, 251, 243, 466, 934, 914, 802,
-	866, 922, 0, 0, 213, 925, 861, 0, 890, 0,
-	940, 797, 881, 0, 800, 804, 936, 918, 832, 254,
-	0, 0, 0, 0, 0, 0, 0, 858, 868, 902,
-	852, 0, 0, 0, 0, 0, 2334, 0, 830, 0,
-	877, 0, 0, 0, 809, 801, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 856, 0,
-	0, 0, 812, 0, 831, 903, 0, 794, 275, 806,
-	364, 236, 0, 907, 917, 853, 502, 921, 851, 850,
-	897, 810, 913, 844, 332, 808, 301, 190, 209, 0,
-	842, 374, 417, 423, 912, 827, 836, 234, 834, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 876,
-	895, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 1121, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 822, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 908, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 900, 939, 387, 422, 224, 487, 445, 817,
-	821, 815, 882, 816, 871, 872, 818, 930, 931, 932,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 904,
-	811, 0, 819, 820, 0, 910, 919, 920, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 875,
-	189, 205, 335, 935, 410, 267, 508, 496, 492, 796,
-	207, 814, 241, 825, 833, 0, 839, 847, 848, 860,
-	862, 863, 864, 865, 883, 884, 886, 894, 896, 899,
-	901, 906, 916, 938, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 874, 880, 346, 260, 280, 291, 889, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 870, 898, 342,
-	463, 464, 287, 360, 0, 0, 924, 909, 462, 0,
-	855, 927, 824, 843, 937, 846, 849, 891, 803, 869,
-	378, 840, 795, 828, 798, 835, 799, 826, 857, 249,
-	823, 911, 873, 926, 333, 246, 805, 829, 392, 845,
-	195, 893, 436, 233, 343, 340, 469, 261, 252, 248,
-	232, 288, 350, 390, 456, 384, 933, 337, 879, 0,
-	446, 363, 0, 0, 0, 859, 915, 867, 905, 854,
-	892, 813, 878, 928, 841, 888, 929, 294, 231, 296,
-	194, 375, 447, 265, 0, 0, 0, 0, 186, 187,
-	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 222, 0, 229, 318, 327, 326, 307, 308, 310,
-	312, 317, 324, 330, 837, 885, 923, 838, 887, 244,
-	292, 251, 243, 466, 934, 914, 802, 866, 922, 0,
-	0, 213, 925, 861, 0, 890, 0, 940, 797, 881,
-	0, 800, 804, 936, 918, 832, 254, 0, 0, 0,
-	0, 0, 0, 0, 858, 868, 902, 852, 0, 0,
-	0, 0, 0, 1785, 0, 830, 0, 877, 0, 0,
-	0, 809, 801, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 856, 0, 0, 0, 812,
-	0, 831, 903, 0, 794, 275, 806, 364, 236, 0,
-	907, 917, 853, 502, 921, 851, 850, 897, 810, 913,
-	844, 332, 808, 301, 190, 209, 0, 842, 374, 417,
-	423, 912, 827, 836, 234, 834, 421, 388, 485, 217,
-	263, 414, 394, 419, 401, 266, 876, 895, 420, 338,
-	471, 408, 482, 503, 504, 242, 368, 493, 460, 499,
-	507, 210, 239, 382, 453, 488, 443, 361, 467, 468,
-	300, 442, 273, 193, 336, 208, 429, 226, 215, 473,
-	491, 268, 412, 200, 455, 480, 223, 433, 0, 0,
-	509, 202, 478, 452, 357, 297, 298, 201, 0, 413,
-	247, 271, 237, 377, 475, 476, 235, 510, 212, 498,
-	204, 1121, 497, 370, 470, 479, 358, 348, 203, 477,
-	356, 347, 303, 322, 323, 259, 282, 406, 341, 407,
-	283, 366, 365, 367, 196, 489, 0, 197, 0, 448,
-	490, 511, 218, 219, 221, 822, 258, 262, 270, 272,
-	278, 279, 286, 334, 381, 405, 403, 409, 908, 465,
-	483, 494, 501, 506, 369, 285, 444, 302, 339, 900,
-	939, 387, 422, 224, 487, 445, 817, 821, 815, 882,
-	816, 871, 872, 818, 930, 931, 932, 512, 513, 514,
-	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
-	525, 526, 527, 528, 529, 0, 904, 811, 0, 819,
-	820, 0, 910, 919, 920, 530, 349, 435, 484, 304,
-	316, 319, 309, 328, 0, 329, 305, 306, 311, 313,
-	314, 315, 320, 321, 325, 331, 875, 189, 205, 335,
-	935, 410, 267, 508, 496, 492, 796, 207, 814, 241,
-	825, 833, 0, 839, 847, 848, 860, 862, 863, 864,
-	865, 883, 884, 886, 894, 896, 899, 901, 906, 916,
-	938, 191, 192, 198, 206, 216, 220, 227, 240, 255,
-	257, 264, 276, 281, 284, 289, 290, 293, 299, 345,
-	351, 352, 353, 354, 371, 372, 373, 376, 379, 380,
-	383, 385, 386, 389, 393, 397, 398, 399, 400, 402,
-	404, 411, 416, 424, 425, 426, 427, 428, 431, 432,
-	437, 438, 439, 440, 441, 449, 450, 454, 472, 474,
-	486, 500, 505, 430, 277, 481, 0, 344, 874, 880,
-	346, 260, 280, 291, 889, 495, 451, 211, 418, 269,
-	199, 230, 214, 238, 253, 256, 295, 355, 362, 391,
-	396, 274, 250, 228, 415, 225, 434, 457, 458, 459,
-	461, 359, 245, 395, 870, 898, 342, 463, 464, 287,
-	360, 0, 0, 924, 909, 462, 0, 855, 927, 824,
-	843, 937, 846, 849, 891, 803, 869, 378, 840, 795,
-	828, 798, 835, 799, 826, 857, 249, 823, 911, 873,
-	926, 333, 246, 805, 829, 392, 845, 195, 893, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 933, 337, 879, 0, 446, 363, 0,
-	0, 0, 859, 915, 867, 905, 854, 892, 813, 878,
-	928, 841, 888, 929, 294, 231, 296, 194, 375, 447,
-	265, 0, 85, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 837, 885, 923, 838, 887, 244, 292, 251, 243,
-	466, 934, 914, 802, 866, 922, 0, 0, 213, 925,
-	861, 0, 890, 0, 940, 797, 881, 0, 800, 804,
-	936, 918, 832, 254, 0, 0, 0, 0, 0, 0,
-	0, 858, 868, 902, 852, 0, 0, 0, 0, 0,
-	0, 0, 830, 0, 877, 0, 0, 0, 809, 801,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 856, 0, 0, 0, 812, 0, 831, 903,
-	0, 794, 275, 806, 364, 236, 0, 907, 917, 853,
-	502, 921, 851, 850, 897, 810, 913, 844, 332, 808,
-	301, 190, 209, 0, 842, 374, 417, 423, 912, 827,
-	836, 234, 834, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 876, 895, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 1121, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 822, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 908, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 900, 939, 387, 422,
-	224, 487, 445, 817, 821, 815, 882, 816, 871, 872,
-	818, 930, 931, 932, 512, 513, 514, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 904, 811, 0, 819, 820, 0, 910,
-	919, 920, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 875, 189, 205, 335, 935, 410, 267,
-	508, 496, 492, 796, 207, 814, 241, 825, 833, 0,
-	839, 847, 848, 860, 862, 863, 864, 865, 883, 884,
-	886, 894, 896, 899, 901, 906, 916, 938, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 874, 880, 346, 260, 280,
-	291, 889, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 870, 898, 342, 463, 464, 287, 360, 0, 0,
-	924, 909, 462, 0, 855, 927, 824, 843, 937, 846,
-	849, 891, 803, 869, 378, 840, 795, 828, 798, 835,
-	799, 826, 857, 249, 823, 911, 873, 926, 333, 246,
-	805, 829, 392, 845, 195, 893, 436, 233, 343, 340,
-	469, 261, 252, 248, 232, 288, 350, 390, 456, 384,
-	933, 337, 879, 0, 446, 363, 0, 0, 0, 859,
-	915, 867, 905, 854, 892, 813, 878, 928, 841, 888,
-	929, 294, 231, 296, 194, 375, 447, 265, 0, 0,
-	0, 0, 186, 187, 188, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 222, 0, 229, 318, 327,
-	326, 307, 308, 310, 312, 317, 324, 330, 837, 885,
-	923, 838, 887, 244, 292, 251, 243, 466, 934, 914,
-	802, 866, 922, 0, 0, 213, 925, 861, 0, 890,
-	0, 940, 797, 881, 0, 800, 804, 936, 918, 832,
-	254, 0, 0, 0, 0, 0, 0, 0, 858, 868,
-	902, 852, 0, 0, 0, 0, 0, 0, 0, 830,
-	0, 877, 0, 0, 0, 809, 801, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 856,
-	0, 0, 0, 812, 0, 831, 903, 0, 794, 275,
-	806, 364, 236, 0, 907, 917, 853, 502, 921, 851,
-	850, 897, 810, 913, 844, 332, 808, 301, 190, 209,
-	0, 842, 374, 417, 423, 912, 827, 836, 234, 834,
-	421, 388, 485, 217, 263, 414, 394, 419, 401, 266,
-	876, 895, 420, 338, 471, 408, 482, 503, 504, 242,
-	368, 493, 460, 499, 507, 210, 239, 382, 453, 488,
-	443, 361, 467, 468, 300, 442, 273, 193, 336, 208,
-	429, 226, 215, 473, 491, 268, 412, 200, 455, 480,
-	223, 433, 0, 0, 509, 202, 478, 452, 357, 297,
-	298, 201, 0, 413, 247, 271, 237, 377, 475, 476,
-	235, 510, 212, 498, 204, 1121, 497, 370, 470, 479,
-	358, 348, 203, 477, 356, 347, 303, 322, 323, 259,
-	282, 406, 341, 407, 283, 366, 365, 367, 196, 489,
-	0, 197, 0, 448, 490, 511, 218, 219, 221, 822,
-	258, 262, 270, 272, 278, 279, 286, 334, 381, 405,
-	403, 409, 908, 465, 483, 494, 501, 506, 369, 285,
-	444, 302, 339, 900, 939, 387, 422, 224, 487, 445,
-	817, 821, 815, 882, 816, 871, 872, 818, 930, 931,
-	932, 512, 513, 514, 515, 516, 517, 518, 519, 520,
-	521, 522, 523, 524, 525, 526, 527, 528, 529, 0,
-	904, 811, 0, 819, 820, 0, 910, 919, 920, 530,
-	349, 435, 484, 304, 316, 319, 309, 328, 0, 329,
-	305, 306, 311, 313, 314, 315, 320, 321, 325, 331,
-	875, 189, 205, 335, 935, 410, 267, 508, 496, 492,
-	796, 207, 814, 241, 825, 833, 0, 839, 847, 848,
-	860, 862, 863, 864, 865, 883, 884, 886, 894, 896,
-	899, 901, 906, 916, 938, 191, 192, 198, 206, 216,
-	220, 227, 240, 255, 257, 264, 276, 281, 284, 289,
-	290, 293, 299, 345, 351, 352, 353, 354, 371, 372,
-	373, 376, 379, 380, 383, 385, 386, 389, 393, 397,
-	398, 399, 400, 402, 404, 411, 416, 424, 425, 426,
-	427, 428, 431, 432, 437, 438, 439, 440, 441, 449,
-	450, 454, 472, 474, 486, 500, 505, 430, 277, 481,
-	0, 344, 874, 880, 346, 260, 280, 291, 889, 495,
-	451, 211, 418, 269, 199, 230, 214, 238, 253, 256,
-	295, 355, 362, 391, 396, 274, 250, 228, 415, 225,
-	434, 457, 458, 459, 461, 359, 245, 395, 870, 898,
-	342, 463, 464, 287, 360, 0, 0, 924, 909, 462,
-	0, 855, 927, 824, 843, 937, 846, 849, 891, 803,
-	869, 378, 840, 795, 828, 798, 835, 799, 826, 857,
-	249, 823, 911, 873, 926, 333, 246, 805, 829, 392,
-	845, 195, 893, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 933, 337, 879,
-	0, 446, 363, 0, 0, 0, 859, 915, 867, 905,
-	854, 892, 813, 878, 928, 841, 888, 929, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 838, 886, 924,
+	839, 888, 245, 293, 252, 244, 467, 935, 915, 803,
+	867, 923, 0, 0, 214, 926, 862, 0, 891, 0,
+	941, 798, 882, 0, 801, 805, 937, 919, 833, 255,
+	0, 0, 0, 0, 0, 0, 0, 859, 869, 903,
+	853, 0, 0, 0, 0, 0, 2386, 0, 831, 0,
+	878, 0, 0, 0, 810, 802, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 857, 0,
+	0, 0, 813, 0, 832, 904, 0, 795, 276, 807,
+	365, 237, 0, 908, 918, 854, 503, 922, 852, 851,
+	898, 811, 914, 845, 333, 809, 302, 190, 210, 0,
+	843, 375, 418, 424, 913, 828, 837, 235, 835, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 877,
+	896, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 1124, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 823,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 909, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 901, 940, 388, 423, 225, 488, 446,
+	818, 822, 816, 883, 817, 872, 873, 819, 931, 932,
+	933, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	905, 812, 0, 820, 821, 0, 911, 920, 921, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	876, 189, 206, 336, 936, 411, 268, 509, 497, 493,
+	797, 208, 815, 242, 826, 834, 0, 840, 848, 849,
+	861, 863, 864, 865, 866, 884, 885, 887, 895, 897,
+	900, 902, 907, 917, 939, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 875, 881, 347, 261, 281, 292, 890, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 871, 899,
+	343, 464, 465, 288, 361, 0, 0, 925, 910, 463,
+	0, 856, 928, 825, 844, 938, 847, 850, 892, 804,
+	870, 379, 841, 796, 829, 799, 836, 800, 827, 858,
+	250, 824, 912, 874, 927, 334, 247, 806, 830, 393,
+	846, 195, 894, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 934, 338, 880,
+	0, 447, 364, 0, 0, 0, 860, 916, 868, 906,
+	855, 893, 814, 879, 929, 842, 889, 930, 295, 232,
+	297, 194, 376, 448, 266, 0, 0, 0, 0, 186,
 	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 837, 885, 923, 838, 887,
-	244, 292, 251, 243, 466, 934, 914, 802, 866, 922,
-	0, 0, 941, 925, 861, 0, 890, 0, 940, 797,
-	881, 0, 800, 804, 936, 918, 832, 254, 0, 0,
-	0, 0, 0, 0, 0, 858, 868, 902, 852, 0,
-	0, 0, 0, 0, 0, 0, 830, 0, 877, 0,
-	0, 0, 809, 801, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 856, 0, 0, 0,
-	812, 0, 831, 903, 0, 794, 275, 806, 364, 236,
-	0, 907, 917, 853, 502, 921, 851, 850, 897, 810,
-	913, 844, 332, 808, 301, 190, 209, 0, 842, 374,
-	417, 423, 912, 827, 836, 234, 834, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 876, 895, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 807, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 822, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 908,
-	465, 483, 494, 501, 506, 793, 787, 786, 302, 339,
-	900, 939, 387, 422, 224, 487, 445, 817, 821, 815,
-	882, 816, 871, 872, 818, 930, 931, 932, 512, 513,
+	0, 0, 223, 0, 230, 319, 328, 327, 308, 309,
+	311, 313, 318, 325, 331, 838, 886, 924, 839, 888,
+	245, 293, 252, 244, 467, 935, 915, 803, 867, 923,
+	0, 0, 214, 926, 862, 0, 891, 0, 941, 798,
+	882, 0, 801, 805, 937, 919, 833, 255, 0, 0,
+	0, 0, 0, 0, 0, 859, 869, 903, 853, 0,
+	0, 0, 0, 0, 2344, 0, 831, 0, 878, 0,
+	0, 0, 810, 802, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 857, 0, 0, 0,
+	813, 0, 832, 904, 0, 795, 276, 807, 365, 237,
+	0, 908, 918, 854, 503, 922, 852, 851, 898, 811,
+	914, 845, 333, 809, 302, 190, 210, 0, 843, 375,
+	418, 424, 913, 828, 837, 235, 835, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 877, 896, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 481, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 1124, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 323, 324, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 823, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	909, 466, 484, 495, 502, 507, 370, 286, 445, 303,
+	340, 901, 940, 388, 423, 225, 488, 446, 818, 822,
+	816, 883, 817, 872, 873, 819, 931, 932, 933, 513,
 	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 904, 811, 0,
-	819, 820, 0, 910, 919, 920, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 875, 189, 205,
-	335, 935, 410, 267, 508, 496, 492, 796, 207, 814,
-	241, 825, 833, 0, 839, 847, 848, 860, 862, 863,
-	864, 865, 883, 884, 886, 894, 896, 899, 901, 906,
-	916, 938, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 874,
-	880, 346, 260, 280, 291, 889, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 870, 898, 342, 463, 464,
-	287, 360, 0, 0, 924, 909, 462, 0, 855, 927,
-	824, 843, 937, 846, 849, 891, 803, 869, 378, 840,
-	795, 828, 798, 835, 799, 826, 857, 249, 823, 911,
-	873, 926, 333, 246, 805, 829, 392, 845, 195, 893,
-	436, 233, 343, 340, 469, 261, 252, 248, 232, 288,
-	350, 390, 456, 384, 933, 337, 879, 0, 446, 363,
-	0, 0, 0, 859, 915, 867, 905, 854, 892, 813,
-	878, 928, 841, 888, 929, 294, 231, 296, 194, 375,
-	447, 265, 0, 0, 0, 0, 186, 187, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
-	0, 229, 318, 327, 326, 307, 308, 310, 312, 317,
-	324, 330, 837, 885, 923, 838, 887, 244, 292, 251,
-	243, 466, 934, 914, 802, 866, 922, 0, 0, 941,
-	925, 861, 0, 890, 0, 940, 797, 881, 0, 800,
-	804, 936, 918, 832, 254, 0, 0, 0, 0, 0,
-	0, 0, 858, 868, 902, 852, 0, 0, 0, 0,
-	0, 0, 0, 830, 0, 877, 0, 0, 0, 809,
-	801, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 856, 0, 0, 0, 812, 0, 831,
-	903, 0, 794, 275, 806, 364, 236, 0, 907, 917,
-	853, 502, 921, 851, 850, 897, 810, 913, 844, 332,
-	808, 301, 190, 209, 0, 842, 374, 417, 423, 912,
-	827, 836, 234, 834, 421, 388, 485, 217, 263, 414,
-	394, 419, 401, 266, 876, 895, 420, 338, 471, 408,
-	482, 503, 504, 242, 368, 493, 460, 499, 507, 210,
-	239, 382, 453, 488, 443, 361, 467, 468, 300, 442,
-	273, 193, 336, 208, 429, 226, 215, 473, 491, 268,
-	412, 200, 455, 1335, 223, 433, 0, 0, 509, 202,
-	478, 452, 357, 297, 298, 201, 0, 413, 247, 271,
-	237, 377, 475, 476, 235, 510, 212, 498, 204, 807,
-	497, 370, 470, 479, 358, 348, 203, 477, 356, 347,
-	303, 322, 323, 259, 282, 406, 341, 407, 283, 366,
-	365, 367, 196, 489, 0, 197, 0, 448, 490, 511,
-	218, 219, 221, 822, 258, 262, 270, 272, 278, 279,
-	286, 334, 381, 405, 403, 409, 908, 465, 483, 494,
-	501, 506, 793, 787, 786, 302, 339, 900, 939, 387,
-	422, 224, 487, 445, 817, 821, 815, 882, 816, 871,
-	872, 818, 930, 931, 932, 512, 513, 514, 515, 516,
-	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
-	527, 528, 529, 0, 904, 811, 0, 819, 820, 0,
-	910, 919, 920, 530, 349, 435, 484, 304, 316, 319,
-	309, 328, 0, 329, 305, 306, 311, 313, 314, 315,
-	320, 321, 325, 331, 875, 189, 205, 335, 935, 410,
-	267, 508, 496, 492, 796, 207, 814, 241, 825, 833,
-	0, 839, 847, 848, 860, 862, 863, 864, 865, 883,
-	884, 886, 894, 896, 899, 901, 906, 916, 938, 191,
-	192, 198, 206, 216, 220, 227, 240, 255, 257, 264,
-	276, 281, 284, 289, 290, 293, 299, 345, 351, 352,
-	353, 354, 371, 372, 373, 376, 379, 380, 383, 385,
-	386, 389, 393, 397, 398, 399, 400, 402, 404, 411,
-	416, 424, 425, 426, 427, 428, 431, 432, 437, 438,
-	439, 440, 441, 449, 450, 454, 472, 474, 486, 500,
-	505, 430, 277, 481, 0, 344, 874, 880, 346, 260,
-	280, 291, 889, 495, 451, 211, 418, 269, 199, 230,
-	214, 238, 253, 256, 295, 355, 362, 391, 396, 274,
-	250, 228, 415, 225, 434, 457, 458, 459, 461, 359,
-	245, 395, 870, 898, 342, 463, 464, 287, 360, 0,
-	0, 924, 909, 462, 0, 855, 927, 824, 843, 937,
-	846, 849, 891, 803, 869, 378, 840, 795, 828, 798,
-	835, 799, 826, 857, 249, 823, 911, 873, 926, 333,
-	246, 805, 829, 392, 845, 195, 893, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 933, 337, 879, 0, 446, 363, 0, 0, 0,
-	859, 915, 867, 905, 854, 892, 813, 878, 928, 841,
-	888, 929, 294, 231, 296, 194, 375, 447, 265, 0,
+	524, 525, 526, 527, 528, 529, 530, 0, 905, 812,
+	0, 820, 821, 0, 911, 920, 921, 531, 350, 436,
+	485, 305, 317, 320, 310, 329, 0, 330, 306, 307,
+	312, 314, 315, 316, 321, 322, 326, 332, 876, 189,
+	206, 336, 936, 411, 268, 509, 497, 493, 797, 208,
+	815, 242, 826, 834, 0, 840, 848, 849, 861, 863,
+	864, 865, 866, 884, 885, 887, 895, 897, 900, 902,
+	907, 917, 939, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	875, 881, 347, 261, 281, 292, 890, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 871, 899, 343, 464,
+	465, 288, 361, 0, 0, 925, 910, 463, 0, 856,
+	928, 825, 844, 938, 847, 850, 892, 804, 870, 379,
+	841, 796, 829, 799, 836, 800, 827, 858, 250, 824,
+	912, 874, 927, 334, 247, 806, 830, 393, 846, 195,
+	894, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 934, 338, 880, 0, 447,
+	364, 0, 0, 0, 860, 916, 868, 906, 855, 893,
+	814, 879, 929, 842, 889, 930, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 187, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 838, 886, 924, 839, 888, 245, 293,
+	252, 244, 467, 935, 915, 803, 867, 923, 0, 0,
+	214, 926, 862, 0, 891, 0, 941, 798, 882, 0,
+	801, 805, 937, 919, 833, 255, 0, 0, 0, 0,
+	0, 0, 0, 859, 869, 903, 853, 0, 0, 0,
+	0, 0, 1790, 0, 831, 0, 878, 0, 0, 0,
+	810, 802, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 857, 0, 0, 0, 813, 0,
+	832, 904, 0, 795, 276, 807, 365, 237, 0, 908,
+	918, 854, 503, 922, 852, 851, 898, 811, 914, 845,
+	333, 809, 302, 190, 210, 0, 843, 375, 418, 424,
+	913, 828, 837, 235, 835, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 877, 896, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 1124, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 823, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 909, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 901,
+	940, 388, 423, 225, 488, 446, 818, 822, 816, 883,
+	817, 872, 873, 819, 931, 932, 933, 513, 514, 515,
+	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
+	526, 527, 528, 529, 530, 0, 905, 812, 0, 820,
+	821, 0, 911, 920, 921, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 876, 189, 206, 336,
+	936, 411, 268, 509, 497, 493, 797, 208, 815, 242,
+	826, 834, 0, 840, 848, 849, 861, 863, 864, 865,
+	866, 884, 885, 887, 895, 897, 900, 902, 907, 917,
+	939, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 875, 881,
+	347, 261, 281, 292, 890, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 871, 899, 343, 464, 465, 288,
+	361, 0, 0, 925, 910, 463, 0, 856, 928, 825,
+	844, 938, 847, 850, 892, 804, 870, 379, 841, 796,
+	829, 799, 836, 800, 827, 858, 250, 824, 912, 874,
+	927, 334, 247, 806, 830, 393, 846, 195, 894, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 934, 338, 880, 0, 447, 364, 0,
+	0, 0, 860, 916, 868, 906, 855, 893, 814, 879,
+	929, 842, 889, 930, 295, 232, 297, 194, 376, 448,
+	266, 0, 85, 0, 0, 186, 187, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 223, 0,
+	230, 319, 328, 327, 308, 309, 311, 313, 318, 325,
+	331, 838, 886, 924, 839, 888, 245, 293, 252, 244,
+	467, 935, 915, 803, 867, 923, 0, 0, 214, 926,
+	862, 0, 891, 0, 941, 798, 882, 0, 801, 805,
+	937, 919, 833, 255, 0, 0, 0, 0, 0, 0,
+	0, 859, 869, 903, 853, 0, 0, 0, 0, 0,
+	0, 0, 831, 0, 878, 0, 0, 0, 810, 802,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 857, 0, 0, 0, 813, 0, 832, 904,
+	0, 795, 276, 807, 365, 237, 0, 908, 918, 854,
+	503, 922, 852, 851, 898, 811, 914, 845, 333, 809,
+	302, 190, 210, 0, 843, 375, 418, 424, 913, 828,
+	837, 235, 835, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 877, 896, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 1124,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 323, 324, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 823, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 909, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 901, 940, 388,
+	423, 225, 488, 446, 818, 822, 816, 883, 817, 872,
+	873, 819, 931, 932, 933, 513, 514, 515, 516, 517,
+	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
+	528, 529, 530, 0, 905, 812, 0, 820, 821, 0,
+	911, 920, 921, 531, 350, 436, 485, 305, 317, 320,
+	310, 329, 0, 330, 306, 307, 312, 314, 315, 316,
+	321, 322, 326, 332, 876, 189, 206, 336, 936, 411,
+	268, 509, 497, 493, 797, 208, 815, 242, 826, 834,
+	0, 840, 848, 849, 861, 863, 864, 865, 866, 884,
+	885, 887, 895, 897, 900, 902, 907, 917, 939, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 875, 881, 347, 261,
+	281, 292, 890, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 871, 899, 343, 464, 465, 288, 361, 0,
+	0, 925, 910, 463, 0, 856, 928, 825, 844, 938,
+	847, 850, 892, 804, 870, 379, 841, 796, 829, 799,
+	836, 800, 827, 858, 250, 824, 912, 874, 927, 334,
+	247, 806, 830, 393, 846, 195, 894, 437, 234, 344,
+	341, 470, 262, 253, 249, 233, 289, 351, 391, 457,
+	385, 934, 338, 880, 0, 447, 364, 0, 0, 0,
+	860, 916, 868, 906, 855, 893, 814, 879, 929, 842,
+	889, 930, 295, 232, 297, 194, 376, 448, 266, 0,
 	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 837,
-	885, 923, 838, 887, 244, 292, 251, 243, 466, 934,
-	914, 802, 866, 922, 0, 0, 941, 925, 861, 0,
-	890, 0, 940, 797, 881, 0, 800, 804, 936, 918,
-	832, 254, 0, 0, 0, 0, 0, 0, 0, 858,
-	868, 902, 852, 0, 0, 0, 0, 0, 0, 0,
-	830, 0, 877, 0, 0, 0, 809, 801, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	856, 0, 0, 0, 812, 0, 831, 903, 0, 794,
-	275, 806, 364, 236, 0, 907, 917, 853, 502, 921,
-	851, 850, 897, 810, 913, 844, 332, 808, 301, 190,
-	209, 0, 842, 374, 417, 423, 912, 827, 836, 234,
-	834, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 876, 895, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	784, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 807, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	822, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 908, 465, 483, 494, 501, 506, 793,
-	787, 786, 302, 339, 900, 939, 387, 422, 224, 487,
-	445, 817, 821, 815, 882, 816, 871, 872, 818, 930,
-	931, 932, 512, 513, 514, 515, 516, 517, 518, 519,
+	0, 0, 0, 0, 0, 0, 223, 0, 230, 319,
+	328, 327, 308, 309, 311, 313, 318, 325, 331, 838,
+	886, 924, 839, 888, 245, 293, 252, 244, 467, 935,
+	915, 803, 867, 923, 0, 0, 214, 926, 862, 0,
+	891, 0, 941, 798, 882, 0, 801, 805, 937, 919,
+	833, 255, 0, 0, 0, 0, 0, 0, 0, 859,
+	869, 903, 853, 0, 0, 0, 0, 0, 0, 0,
+	831, 0, 878, 0, 0, 0, 810, 802, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	857, 0, 0, 0, 813, 0, 832, 904, 0, 795,
+	276, 807, 365, 237, 0, 908, 918, 854, 503, 922,
+	852, 851, 898, 811, 914, 845, 333, 809, 302, 190,
+	210, 0, 843, 375, 418, 424, 913, 828, 837, 235,
+	835, 422, 389, 486, 218, 264, 415, 395, 420, 402,
+	267, 877, 896, 421, 339, 472, 409, 483, 504, 505,
+	243, 369, 494, 461, 500, 508, 211, 240, 383, 454,
+	489, 444, 362, 468, 469, 301, 443, 274, 193, 200,
+	337, 209, 430, 227, 216, 474, 492, 269, 413, 201,
+	456, 481, 224, 434, 0, 0, 510, 203, 479, 453,
+	358, 298, 299, 202, 0, 414, 248, 272, 238, 378,
+	476, 477, 236, 511, 213, 499, 205, 1124, 498, 371,
+	471, 480, 359, 349, 204, 478, 357, 348, 304, 323,
+	324, 260, 283, 407, 342, 408, 284, 367, 366, 368,
+	196, 490, 0, 197, 0, 449, 491, 512, 219, 220,
+	222, 823, 259, 263, 271, 273, 279, 280, 287, 335,
+	382, 406, 404, 410, 909, 466, 484, 495, 502, 507,
+	370, 286, 445, 303, 340, 901, 940, 388, 423, 225,
+	488, 446, 818, 822, 816, 883, 817, 872, 873, 819,
+	931, 932, 933, 513, 514, 515, 516, 517, 518, 519,
 	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
-	0, 904, 811, 0, 819, 820, 0, 910, 919, 920,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 875, 189, 205, 335, 935, 410, 267, 508, 496,
-	492, 796, 207, 814, 241, 825, 833, 0, 839, 847,
-	848, 860, 862, 863, 864, 865, 883, 884, 886, 894,
-	896, 899, 901, 906, 916, 938, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 874, 880, 346, 260, 280, 291, 889,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 870,
-	898, 342, 463, 464, 287, 360, 0, 0, 0, 0,
-	462, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 378, 0, 0, 1730, 0, 603, 0, 0,
-	0, 249, 608, 0, 0, 0, 333, 246, 0, 1731,
-	392, 0, 195, 0, 436, 233, 343, 340, 469, 261,
-	252, 248, 232, 288, 350, 390, 456, 384, 615, 337,
-	0, 0, 446, 363, 0, 0, 0, 0, 0, 610,
-	611, 0, 0, 0, 0, 0, 0, 0, 0, 294,
-	231, 296, 194, 375, 447, 265, 0, 85, 0, 0,
-	186, 187, 188, 679, 686, 687, 688, 689, 690, 680,
-	682, 0, 0, 222, 681, 229, 626, 628, 627, 637,
-	638, 639, 640, 641, 642, 643, 624, 684, 691, 692,
-	0, 244, 292, 251, 243, 466, 0, 0, 0, 0,
-	0, 0, 0, 213, 0, 0, 0, 0, 0, 0,
-	0, 586, 600, 0, 614, 0, 0, 0, 254, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 597, 598, 773, 0, 0, 0, 663,
-	0, 599, 0, 0, 607, 693, 694, 695, 696, 697,
-	698, 699, 700, 701, 702, 703, 704, 705, 706, 707,
-	708, 709, 710, 711, 712, 713, 714, 715, 716, 717,
-	718, 719, 720, 721, 722, 723, 724, 725, 726, 727,
-	728, 729, 730, 731, 732, 733, 734, 609, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 0, 364,
-	236, 0, 662, 0, 0, 502, 0, 0, 660, 0,
-	0, 0, 0, 332, 0, 301, 190, 209, 0, 0,
-	374, 417, 423, 0, 0, 0, 234, 0, 421, 388,
-	485, 217, 263, 414, 394, 419, 401, 266, 0, 0,
-	420, 338, 471, 408, 482, 503, 504, 242, 368, 493,
-	460, 499, 507, 210, 239, 382, 453, 488, 443, 361,
-	467, 468, 300, 442, 273, 193, 336, 208, 429, 226,
-	215, 473, 491, 268, 412, 200, 455, 480, 223, 433,
-	0, 0, 509, 202, 478, 452, 357, 297, 298, 201,
-	0, 413, 247, 271, 237, 377, 475, 476, 235, 510,
-	212, 498, 204, 0, 497, 370, 470, 479, 358, 348,
-	203, 477, 356, 347, 303, 632, 633, 259, 282, 406,
-	341, 407, 283, 366, 365, 367, 196, 489, 0, 197,
-	0, 448, 490, 511, 218, 219, 221, 0, 258, 262,
-	270, 272, 278, 279, 286, 334, 381, 405, 403, 409,
-	0, 465, 483, 494, 501, 506, 369, 285, 444, 302,
-	339, 0, 0, 387, 422, 224, 487, 445, 669, 661,
-	619, 673, 621, 670, 671, 616, 617, 620, 672, 512,
-	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
-	523, 524, 525, 526, 527, 528, 529, 0, 664, 606,
-	605, 0, 612, 613, 0, 622, 623, 625, 629, 630,
-	631, 634, 635, 636, 644, 646, 647, 645, 648, 649,
-	650, 653, 654, 655, 656, 651, 652, 657, 604, 189,
-	205, 335, 0, 410, 267, 508, 496, 492, 0, 207,
-	0, 241, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 198, 206, 216, 220, 227,
-	240, 255, 257, 264, 276, 281, 284, 289, 290, 293,
-	299, 345, 351, 352, 353, 354, 371, 372, 373, 376,
-	379, 380, 383, 385, 386, 389, 393, 397, 398, 399,
-	400, 402, 404, 411, 416, 424, 425, 426, 427, 428,
-	431, 432, 437, 438, 439, 440, 441, 449, 450, 454,
-	472, 474, 486, 500, 505, 430, 277, 481, 0, 344,
-	0, 0, 346, 260, 280, 291, 0, 495, 451, 211,
-	418, 269, 199, 230, 214, 238, 253, 256, 295, 355,
-	362, 391, 396, 274, 250, 228, 415, 225, 434, 457,
-	458, 459, 461, 359, 245, 395, 0, 360, 342, 463,
-	464, 287, 462, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 378, 0, 0, 0, 0, 603,
-	0, 0, 0, 249, 608, 0, 0, 0, 333, 246,
-	0, 0, 392, 0, 195, 0, 436, 233, 343, 340,
-	469, 261, 252, 248, 232, 288, 350, 390, 456, 384,
-	615, 337, 0, 0, 446, 363, 0, 0, 0, 0,
-	0, 610, 611, 0, 0, 0, 0, 0, 0, 0,
-	0, 294, 231, 296, 194, 375, 447, 265, 0, 85,
-	0, 0, 186, 187, 188, 679, 686, 687, 688, 689,
-	690, 680, 682, 0, 0, 222, 681, 229, 626, 628,
-	627, 637, 638, 639, 640, 641, 642, 643, 624, 684,
-	691, 692, 0, 244, 292, 251, 243, 466, 0, 0,
-	1696, 1697, 1698, 0, 0, 213, 0, 0, 0, 0,
-	0, 0, 0, 586, 600, 0, 614, 0, 0, 0,
-	254, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 597, 598, 0, 0, 0,
-	0, 663, 0, 599, 0, 0, 607, 693, 694, 695,
-	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
-	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 734, 609,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	0, 364, 236, 0, 662, 0, 0, 502, 0, 0,
-	660, 0, 0, 0, 0, 332, 0, 301, 190, 209,
-	0, 0, 374, 417, 423, 0, 0, 0, 234, 0,
-	421, 388, 485, 217, 263, 414, 394, 419, 401, 266,
-	0, 0, 420, 338, 471, 408, 482, 503, 504, 242,
-	368, 493, 460, 499, 507, 210, 239, 382, 453, 488,
-	443, 361, 467, 468, 300, 442, 273, 193, 336, 208,
-	429, 226, 215, 473, 491, 268, 412, 200, 455, 480,
-	223, 433, 0, 0, 509, 202, 478, 452, 357, 297,
-	298, 201, 0, 413, 247, 271, 237, 377, 475, 476,
-	235, 510, 212, 498, 204, 0, 497, 370, 470, 479,
-	358, 348, 203, 477, 356, 347, 303, 632, 633, 259,
-	282, 406, 341, 407, 283, 366, 365, 367, 196, 489,
-	0, 197, 0, 448, 490, 511, 218, 219, 221, 0,
-	258, 262, 270, 272, 278, 279, 286, 334, 381, 405,
-	403, 409, 0, 465, 483, 494, 501, 506, 369, 285,
-	444, 302, 339, 0, 0, 387, 422, 224, 487, 445,
-	669, 661, 619, 673, 621, 670, 671, 616, 617, 620,
-	672, 512, 513, 514, 515, 516, 517, 518, 519, 520,
-	521, 522, 523, 524, 525, 526, 527, 528, 529, 0,
-	664, 606, 605, 0, 612, 613, 0, 622, 623, 625,
-	629, 630, 631, 634, 635, 636, 644, 646, 647, 645,
-	648, 649, 650, 653, 654, 655, 656, 651, 652, 657,
-	604, 189, 205, 335, 0, 410, 267, 508, 496, 492,
-	0, 207, 0, 241, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 198, 206, 216,
-	220, 227, 240, 255, 257, 264, 276, 281, 284, 289,
-	290, 293, 299, 345, 351, 352, 353, 354, 371, 372,
-	373, 376, 379, 380, 383, 385, 386, 389, 393, 397,
-	398, 399, 400, 402, 404, 411, 416, 424, 425, 426,
-	427, 428, 431, 432, 437, 438, 439, 440, 441, 449,
-	450, 454, 472, 474, 486, 500, 505, 430, 277, 481,
-	0, 344, 0, 0, 346, 260, 280, 291, 0, 495,
-	451, 211, 418, 269, 199, 230, 214, 238, 253, 256,
-	295, 355, 362, 391, 396, 274, 250, 228, 415, 225,
-	434, 457, 458, 459, 461, 359, 245, 395, 0, 360,
-	342, 463, 464, 287, 462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 378, 0, 0, 0,
-	0, 603, 0, 0, 0, 249, 608, 0, 0, 0,
-	333, 246, 0, 0, 392, 0, 195, 0, 436, 233,
-	343, 340, 469, 261, 252, 248, 232, 288, 350, 390,
-	456, 384, 615, 337, 0, 0, 446, 363, 0, 0,
-	0, 0, 0, 610, 611, 0, 0, 0, 0, 0,
-	0, 1812, 0, 294, 231, 296, 194, 375, 447, 265,
-	0, 85, 0, 0, 186, 187, 188, 679, 686, 687,
-	688, 689, 690, 680, 682, 0, 0, 222, 681, 229,
-	626, 628, 627, 637, 638, 639, 640, 641, 642, 643,
-	624, 684, 691, 692, 1813, 244, 292, 251, 243, 466,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
-	0, 0, 0, 0, 0, 586, 600, 0, 614, 0,
-	0, 0, 254, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 597, 598, 0,
-	0, 0, 0, 663, 0, 599, 0, 0, 607, 693,
+	530, 0, 905, 812, 0, 820, 821, 0, 911, 920,
+	921, 531, 350, 436, 485, 305, 317, 320, 310, 329,
+	0, 330, 306, 307, 312, 314, 315, 316, 321, 322,
+	326, 332, 876, 189, 206, 336, 936, 411, 268, 509,
+	497, 493, 797, 208, 815, 242, 826, 834, 0, 840,
+	848, 849, 861, 863, 864, 865, 866, 884, 885, 887,
+	895, 897, 900, 902, 907, 917, 939, 191, 192, 198,
+	207, 217, 221, 228, 241, 256, 258, 265, 277, 282,
+	285, 290, 291, 294, 300, 346, 352, 353, 354, 355,
+	372, 373, 374, 377, 380, 381, 384, 386, 387, 390,
+	394, 398, 399, 400, 401, 403, 405, 412, 417, 425,
+	426, 427, 428, 429, 432, 433, 438, 439, 440, 441,
+	442, 450, 451, 455, 473, 475, 487, 501, 506, 431,
+	278, 482, 0, 345, 875, 881, 347, 261, 281, 292,
+	890, 496, 452, 212, 419, 270, 199, 231, 215, 239,
+	254, 257, 296, 356, 363, 392, 397, 275, 251, 229,
+	416, 226, 435, 458, 459, 460, 462, 360, 246, 396,
+	871, 899, 343, 464, 465, 288, 361, 0, 0, 925,
+	910, 463, 0, 856, 928, 825, 844, 938, 847, 850,
+	892, 804, 870, 379, 841, 796, 829, 799, 836, 800,
+	827, 858, 250, 824, 912, 874, 927, 334, 247, 806,
+	830, 393, 846, 195, 894, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 934,
+	338, 880, 0, 447, 364, 0, 0, 0, 860, 916,
+	868, 906, 855, 893, 814, 879, 929, 842, 889, 930,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
+	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 838, 886, 924,
+	839, 888, 245, 293, 252, 244, 467, 935, 915, 803,
+	867, 923, 0, 0, 942, 926, 862, 0, 891, 0,
+	941, 798, 882, 0, 801, 805, 937, 919, 833, 255,
+	0, 0, 0, 0, 0, 0, 0, 859, 869, 903,
+	853, 0, 0, 0, 0, 0, 0, 0, 831, 0,
+	878, 0, 0, 0, 810, 802, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 857, 0,
+	0, 0, 813, 0, 832, 904, 0, 795, 276, 807,
+	365, 237, 0, 908, 918, 854, 503, 922, 852, 851,
+	898, 811, 914, 845, 333, 809, 302, 190, 210, 0,
+	843, 375, 418, 424, 913, 828, 837, 235, 835, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 877,
+	896, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 808, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 823,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 909, 466, 484, 495, 502, 507, 794, 788,
+	787, 303, 340, 901, 940, 388, 423, 225, 488, 446,
+	818, 822, 816, 883, 817, 872, 873, 819, 931, 932,
+	933, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	905, 812, 0, 820, 821, 0, 911, 920, 921, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	876, 189, 206, 336, 936, 411, 268, 509, 497, 493,
+	797, 208, 815, 242, 826, 834, 0, 840, 848, 849,
+	861, 863, 864, 865, 866, 884, 885, 887, 895, 897,
+	900, 902, 907, 917, 939, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 875, 881, 347, 261, 281, 292, 890, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 871, 899,
+	343, 464, 465, 288, 361, 0, 0, 925, 910, 463,
+	0, 856, 928, 825, 844, 938, 847, 850, 892, 804,
+	870, 379, 841, 796, 829, 799, 836, 800, 827, 858,
+	250, 824, 912, 874, 927, 334, 247, 806, 830, 393,
+	846, 195, 894, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 934, 338, 880,
+	0, 447, 364, 0, 0, 0, 860, 916, 868, 906,
+	855, 893, 814, 879, 929, 842, 889, 930, 295, 232,
+	297, 194, 376, 448, 266, 0, 0, 0, 0, 186,
+	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 230, 319, 328, 327, 308, 309,
+	311, 313, 318, 325, 331, 838, 886, 924, 839, 888,
+	245, 293, 252, 244, 467, 935, 915, 803, 867, 923,
+	0, 0, 942, 926, 862, 0, 891, 0, 941, 798,
+	882, 0, 801, 805, 937, 919, 833, 255, 0, 0,
+	0, 0, 0, 0, 0, 859, 869, 903, 853, 0,
+	0, 0, 0, 0, 0, 0, 831, 0, 878, 0,
+	0, 0, 810, 802, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 857, 0, 0, 0,
+	813, 0, 832, 904, 0, 795, 276, 807, 365, 237,
+	0, 908, 918, 854, 503, 922, 852, 851, 898, 811,
+	914, 845, 333, 809, 302, 190, 210, 0, 843, 375,
+	418, 424, 913, 828, 837, 235, 835, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 877, 896, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 1338, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 808, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 323, 324, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 823, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	909, 466, 484, 495, 502, 507, 794, 788, 787, 303,
+	340, 901, 940, 388, 423, 225, 488, 446, 818, 822,
+	816, 883, 817, 872, 873, 819, 931, 932, 933, 513,
+	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
+	524, 525, 526, 527, 528, 529, 530, 0, 905, 812,
+	0, 820, 821, 0, 911, 920, 921, 531, 350, 436,
+	485, 305, 317, 320, 310, 329, 0, 330, 306, 307,
+	312, 314, 315, 316, 321, 322, 326, 332, 876, 189,
+	206, 336, 936, 411, 268, 509, 497, 493, 797, 208,
+	815, 242, 826, 834, 0, 840, 848, 849, 861, 863,
+	864, 865, 866, 884, 885, 887, 895, 897, 900, 902,
+	907, 917, 939, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	875, 881, 347, 261, 281, 292, 890, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 871, 899, 343, 464,
+	465, 288, 361, 0, 0, 925, 910, 463, 0, 856,
+	928, 825, 844, 938, 847, 850, 892, 804, 870, 379,
+	841, 796, 829, 799, 836, 800, 827, 858, 250, 824,
+	912, 874, 927, 334, 247, 806, 830, 393, 846, 195,
+	894, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 934, 338, 880, 0, 447,
+	364, 0, 0, 0, 860, 916, 868, 906, 855, 893,
+	814, 879, 929, 842, 889, 930, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 187, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 838, 886, 924, 839, 888, 245, 293,
+	252, 244, 467, 935, 915, 803, 867, 923, 0, 0,
+	942, 926, 862, 0, 891, 0, 941, 798, 882, 0,
+	801, 805, 937, 919, 833, 255, 0, 0, 0, 0,
+	0, 0, 0, 859, 869, 903, 853, 0, 0, 0,
+	0, 0, 0, 0, 831, 0, 878, 0, 0, 0,
+	810, 802, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 857, 0, 0, 0, 813, 0,
+	832, 904, 0, 795, 276, 807, 365, 237, 0, 908,
+	918, 854, 503, 922, 852, 851, 898, 811, 914, 845,
+	333, 809, 302, 190, 210, 0, 843, 375, 418, 424,
+	913, 828, 837, 235, 835, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 877, 896, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 785, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 808, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 823, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 909, 466,
+	484, 495, 502, 507, 794, 788, 787, 303, 340, 901,
+	940, 388, 423, 225, 488, 446, 818, 822, 816, 883,
+	817, 872, 873, 819, 931, 932, 933, 513, 514, 515,
+	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
+	526, 527, 528, 529, 530, 0, 905, 812, 0, 820,
+	821, 0, 911, 920, 921, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 876, 189, 206, 336,
+	936, 411, 268, 509, 497, 493, 797, 208, 815, 242,
+	826, 834, 0, 840, 848, 849, 861, 863, 864, 865,
+	866, 884, 885, 887, 895, 897, 900, 902, 907, 917,
+	939, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 875, 881,
+	347, 261, 281, 292, 890, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 871, 899, 343, 464, 465, 288,
+	361, 0, 0, 0, 0, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	1735, 0, 604, 0, 0, 0, 250, 609, 0, 0,
+	0, 334, 247, 0, 1736, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 616, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 611, 612, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 85, 0, 0, 186, 187, 188, 680, 687,
+	688, 689, 690, 691, 681, 683, 0, 0, 223, 682,
+	230, 627, 629, 628, 638, 639, 640, 641, 642, 643,
+	644, 625, 685, 692, 693, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 587, 601, 0, 615,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 598, 599,
+	774, 0, 0, 0, 664, 0, 600, 0, 0, 608,
 	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
 	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
 	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
 	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
-	734, 609, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 0, 364, 236, 0, 662, 0, 0, 502,
-	0, 0, 660, 0, 0, 0, 0, 332, 0, 301,
-	190, 209, 0, 0, 374, 417, 423, 0, 0, 0,
-	234, 0, 421, 388, 485, 217, 263, 414, 394, 419,
-	401, 266, 0, 0, 420, 338, 471, 408, 482, 503,
-	504, 242, 368, 493, 460, 499, 507, 210, 239, 382,
-	453, 488, 443, 361, 467, 468, 300, 442, 273, 193,
-	336, 208, 429, 226, 215, 473, 491, 268, 412, 200,
-	455, 480, 223, 433, 0, 0, 509, 202, 478, 452,
-	357, 297, 298, 201, 0, 413, 247, 271, 237, 377,
-	475, 476, 235, 510, 212, 498, 204, 0, 497, 370,
-	470, 479, 358, 348, 203, 477, 356, 347, 303, 632,
-	633, 259, 282, 406, 341, 407, 283, 366, 365, 367,
-	196, 489, 0, 197, 0, 448, 490, 511, 218, 219,
-	221, 0, 258, 262, 270, 272, 278, 279, 286, 334,
-	381, 405, 403, 409, 0, 465, 483, 494, 501, 506,
-	369, 285, 444, 302, 339, 0, 0, 387, 422, 224,
-	487, 445, 669, 661, 619, 673, 621, 670, 671, 616,
-	617, 620, 672, 512, 513, 514, 515, 516, 517, 518,
-	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	529, 0, 664, 606, 605, 0, 612, 613, 0, 622,
-	623, 625, 629, 630, 631, 634, 635, 636, 644, 646,
-	647, 645, 648, 649, 650, 653, 654, 655, 656, 651,
-	652, 657, 604, 189, 205, 335, 0, 410, 267, 508,
-	496, 492, 0, 207, 0, 241, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 198,
-	206, 216, 220, 227, 240, 255, 257, 264, 276, 281,
-	284, 289, 290, 293, 299, 345, 351, 352, 353, 354,
-	371, 372, 373, 376, 379, 380, 383, 385, 386, 389,
-	393, 397, 398, 399, 400, 402, 404, 411, 416, 424,
-	425, 426, 427, 428, 431, 432, 437, 438, 439, 440,
-	441, 449, 450, 454, 472, 474, 486, 500, 505, 430,
-	277, 481, 0, 344, 0, 0, 346, 260, 280, 291,
-	0, 495, 451, 211, 418, 269, 199, 230, 214, 238,
-	253, 256, 295, 355, 362, 391, 396, 274, 250, 228,
-	415, 225, 434, 457, 458, 459, 461, 359, 245, 395,
-	0, 0, 342, 463, 464, 287, 360, 0, 0, 0,
-	76, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 603, 0,
-	0, 0, 249, 608, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 615,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	610, 611, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 85, 0,
-	0, 186, 187, 188, 679, 686, 687, 688, 689, 690,
-	680, 682, 0, 0, 222, 681, 229, 626, 628, 627,
-	637, 638, 639, 640, 641, 642, 643, 624, 684, 691,
-	692, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 586, 600, 0, 614, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 597, 598, 0, 0, 0, 0,
-	663, 0, 599, 0, 0, 607, 693, 694, 695, 696,
-	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
-	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
-	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
-	727, 728, 729, 730, 731, 732, 733, 734, 609, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 662, 0, 0, 502, 0, 0, 660,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 632, 633, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 669,
-	661, 619, 673, 621, 670, 671, 616, 617, 620, 672,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 664,
-	606, 605, 0, 612, 613, 0, 622, 623, 625, 629,
-	630, 631, 634, 635, 636, 644, 646, 647, 645, 648,
-	649, 650, 653, 654, 655, 656, 651, 652, 657, 604,
-	189, 205, 335, 84, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	603, 0, 0, 0, 249, 608, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 615, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 610, 611, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	85, 0, 0, 186, 187, 188, 679, 686, 687, 688,
-	689, 690, 680, 682, 0, 0, 222, 681, 229, 626,
-	628, 627, 637, 638, 639, 640, 641, 642, 643, 624,
-	684, 691, 692, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 586, 600, 0, 614, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 597, 598, 0, 0,
-	0, 0, 663, 0, 599, 0, 0, 607, 693, 694,
-	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
-	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
-	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
-	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
-	609, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 662, 0, 0, 502, 0,
-	0, 660, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 2892, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 632, 633,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 669, 661, 619, 673, 621, 670, 671, 616, 617,
-	620, 672, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
-	0, 664, 606, 605, 0, 612, 613, 0, 622, 623,
-	625, 629, 630, 631, 634, 635, 636, 644, 646, 647,
-	645, 648, 649, 650, 653, 654, 655, 656, 651, 652,
-	657, 604, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 603, 0, 0, 0, 249, 608, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 615, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 610, 611, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 85, 0, 1302, 186, 187, 188, 679, 686,
-	687, 688, 689, 690, 680, 682, 0, 0, 222, 681,
-	229, 626, 628, 627, 637, 638, 639, 640, 641, 642,
-	643, 624, 684, 691, 692, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 586, 600, 0, 614,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 597, 598,
-	0, 0, 0, 0, 663, 0, 599, 0, 0, 607,
-	693, 694, 695, 696, 697, 698, 699, 700, 701, 702,
-	703, 704, 705, 706, 707, 708, 709, 710, 711, 712,
-	713, 714, 715, 716, 717, 718, 719, 720, 721, 722,
-	723, 724, 725, 726, 727, 728, 729, 730, 731, 732,
-	733, 734, 609, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 662, 0, 0,
-	502, 0, 0, 660, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	632, 633, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 669, 661, 619, 673, 621, 670, 671,
-	616, 617, 620, 672, 512, 513, 514, 515, 516, 517,
+	734, 735, 610, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 663, 0, 0,
+	503, 0, 0, 661, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 633, 634, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 670, 662, 620, 674, 622, 671,
+	672, 617, 618, 621, 673, 513, 514, 515, 516, 517,
 	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 664, 606, 605, 0, 612, 613, 0,
-	622, 623, 625, 629, 630, 631, 634, 635, 636, 644,
-	646, 647, 645, 648, 649, 650, 653, 654, 655, 656,
-	651, 652, 657, 604, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
+	528, 529, 530, 0, 665, 607, 606, 0, 613, 614,
+	0, 623, 624, 626, 630, 631, 632, 635, 636, 637,
+	645, 647, 648, 646, 649, 650, 651, 654, 655, 656,
+	657, 652, 653, 658, 605, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 603, 0, 0, 0, 249, 608,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 615, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 610, 611, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 85, 0, 0, 186, 187, 188,
-	679, 686, 687, 688, 689, 690, 680, 682, 0, 0,
-	222, 681, 229, 626, 628, 627, 637, 638, 639, 640,
-	641, 642, 643, 624, 684, 691, 692, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 586, 600,
-	0, 614, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	597, 598, 773, 0, 0, 0, 663, 0, 599, 0,
-	0, 607, 693, 694, 695, 696, 697, 698, 699, 700,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 604, 0, 0, 0, 250,
+	609, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 616, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 611, 612, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 85, 0, 0, 186, 187,
+	188, 680, 687, 688, 689, 690, 691, 681, 683, 0,
+	0, 223, 682, 230, 627, 629, 628, 638, 639, 640,
+	641, 642, 643, 644, 625, 685, 692, 693, 0, 245,
+	293, 252, 244, 467, 0, 0, 1701, 1702, 1703, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 587,
+	601, 0, 615, 0, 0, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 598, 599, 0, 0, 0, 0, 664, 0, 600,
+	0, 0, 608, 694, 695, 696, 697, 698, 699, 700,
 	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
 	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
 	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 733, 734, 609, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 662,
-	0, 0, 502, 0, 0, 660, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 632, 633, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 669, 661, 619, 673, 621,
-	670, 671, 616, 617, 620, 672, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 664, 606, 605, 0, 612,
-	613, 0, 622, 623, 625, 629, 630, 631, 634, 635,
-	636, 644, 646, 647, 645, 648, 649, 650, 653, 654,
-	655, 656, 651, 652, 657, 604, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 603, 0, 0, 0,
-	249, 608, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 615, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 610, 611,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 85, 0, 0, 186,
-	187, 188, 679, 686, 687, 688, 689, 690, 680, 682,
-	0, 0, 222, 681, 229, 626, 628, 627, 637, 638,
-	639, 640, 641, 642, 643, 624, 684, 691, 692, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	586, 600, 0, 614, 0, 0, 0, 254, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 597, 598, 0, 0, 0, 0, 663, 0,
-	599, 0, 0, 607, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
-	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
-	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 734, 609, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 662, 0, 0, 502, 0, 0, 660, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 632, 633, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 669, 661, 619,
-	673, 621, 670, 671, 616, 617, 620, 672, 512, 513,
+	731, 732, 733, 734, 735, 610, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	663, 0, 0, 503, 0, 0, 661, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 633, 634, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 670, 662, 620,
+	674, 622, 671, 672, 617, 618, 621, 673, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 665, 607, 606,
+	0, 613, 614, 0, 623, 624, 626, 630, 631, 632,
+	635, 636, 637, 645, 647, 648, 646, 649, 650, 651,
+	654, 655, 656, 657, 652, 653, 658, 605, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 604, 0,
+	0, 0, 250, 609, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 616,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	611, 612, 0, 0, 0, 0, 0, 0, 1817, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 85, 0,
+	0, 186, 187, 188, 680, 687, 688, 689, 690, 691,
+	681, 683, 0, 0, 223, 682, 230, 627, 629, 628,
+	638, 639, 640, 641, 642, 643, 644, 625, 685, 692,
+	693, 1818, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 587, 601, 0, 615, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 598, 599, 0, 0, 0, 0,
+	664, 0, 600, 0, 0, 608, 694, 695, 696, 697,
+	698, 699, 700, 701, 702, 703, 704, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 715, 716, 717,
+	718, 719, 720, 721, 722, 723, 724, 725, 726, 727,
+	728, 729, 730, 731, 732, 733, 734, 735, 610, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 663, 0, 0, 503, 0, 0, 661,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 633, 634, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	670, 662, 620, 674, 622, 671, 672, 617, 618, 621,
+	673, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	665, 607, 606, 0, 613, 614, 0, 623, 624, 626,
+	630, 631, 632, 635, 636, 637, 645, 647, 648, 646,
+	649, 650, 651, 654, 655, 656, 657, 652, 653, 658,
+	605, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 0,
+	343, 464, 465, 288, 361, 0, 0, 0, 76, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 604, 0, 0, 0,
+	250, 609, 0, 0, 0, 334, 247, 0, 0, 393,
+	0, 195, 0, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 616, 338, 0,
+	0, 447, 364, 0, 0, 0, 0, 0, 611, 612,
+	0, 0, 0, 0, 0, 0, 0, 0, 295, 232,
+	297, 194, 376, 448, 266, 0, 85, 0, 0, 186,
+	187, 188, 680, 687, 688, 689, 690, 691, 681, 683,
+	0, 0, 223, 682, 230, 627, 629, 628, 638, 639,
+	640, 641, 642, 643, 644, 625, 685, 692, 693, 0,
+	245, 293, 252, 244, 467, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	587, 601, 0, 615, 0, 0, 0, 255, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 598, 599, 0, 0, 0, 0, 664, 0,
+	600, 0, 0, 608, 694, 695, 696, 697, 698, 699,
+	700, 701, 702, 703, 704, 705, 706, 707, 708, 709,
+	710, 711, 712, 713, 714, 715, 716, 717, 718, 719,
+	720, 721, 722, 723, 724, 725, 726, 727, 728, 729,
+	730, 731, 732, 733, 734, 735, 610, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 365, 237,
+	0, 663, 0, 0, 503, 0, 0, 661, 0, 0,
+	0, 0, 333, 0, 302, 190, 210, 0, 0, 375,
+	418, 424, 0, 0, 0, 235, 0, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 0, 0, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 481, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 0, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 633, 634, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 0, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	0, 466, 484, 495, 502, 507, 370, 286, 445, 303,
+	340, 0, 0, 388, 423, 225, 488, 446, 670, 662,
+	620, 674, 622, 671, 672, 617, 618, 621, 673, 513,
 	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 664, 606, 605,
-	0, 612, 613, 0, 622, 623, 625, 629, 630, 631,
-	634, 635, 636, 644, 646, 647, 645, 648, 649, 650,
-	653, 654, 655, 656, 651, 652, 657, 604, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 603, 0,
-	0, 0, 249, 608, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 615,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	610, 611, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 85, 0,
-	0, 186, 187, 188, 679, 686, 687, 688, 689, 690,
-	680, 682, 0, 0, 222, 681, 229, 626, 628, 627,
-	637, 638, 639, 640, 641, 642, 643, 624, 684, 691,
-	692, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 600, 0, 614, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 597, 598, 0, 0, 0, 0,
-	663, 0, 599, 0, 0, 607, 693, 694, 695, 696,
+	524, 525, 526, 527, 528, 529, 530, 0, 665, 607,
+	606, 0, 613, 614, 0, 623, 624, 626, 630, 631,
+	632, 635, 636, 637, 645, 647, 648, 646, 649, 650,
+	651, 654, 655, 656, 657, 652, 653, 658, 605, 189,
+	206, 336, 84, 411, 268, 509, 497, 493, 0, 208,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	0, 0, 347, 261, 281, 292, 0, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 0, 361, 343, 464,
+	465, 288, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 604,
+	0, 0, 0, 250, 609, 0, 0, 0, 334, 247,
+	0, 0, 393, 0, 195, 0, 437, 234, 344, 341,
+	470, 262, 253, 249, 233, 289, 351, 391, 457, 385,
+	616, 338, 0, 0, 447, 364, 0, 0, 0, 0,
+	0, 611, 612, 0, 0, 0, 0, 0, 0, 0,
+	0, 295, 232, 297, 194, 376, 448, 266, 0, 85,
+	0, 0, 186, 187, 188, 680, 687, 688, 689, 690,
+	691, 681, 683, 0, 0, 223, 682, 230, 627, 629,
+	628, 638, 639, 640, 641, 642, 643, 644, 625, 685,
+	692, 693, 0, 245, 293, 252, 244, 467, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 587, 601, 0, 615, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 598, 599, 0, 0, 0,
+	0, 664, 0, 600, 0, 0, 608, 694, 695, 696,
 	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
 	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
 	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
-	727, 728, 729, 730, 731, 732, 733, 734, 609, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 662, 0, 0, 502, 0, 0, 660,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 632, 633, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 669,
-	661, 619, 673, 621, 670, 671, 616, 617, 620, 672,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 664,
-	606, 605, 0, 612, 613, 0, 622, 623, 625, 629,
-	630, 631, 634, 635, 636, 644, 646, 647, 645, 648,
-	649, 650, 653, 654, 655, 656, 651, 652, 657, 604,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 187, 188, 0, 686, 687, 0,
-	0, 0, 0, 1641, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 693, 694,
+	727, 728, 729, 730, 731, 732, 733, 734, 735, 610,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 365, 237, 0, 663, 0, 0, 503, 0, 0,
+	661, 0, 0, 0, 0, 333, 0, 302, 190, 210,
+	0, 0, 375, 418, 424, 0, 0, 0, 235, 0,
+	422, 389, 486, 218, 264, 415, 395, 420, 402, 267,
+	2918, 0, 421, 339, 472, 409, 483, 504, 505, 243,
+	369, 494, 461, 500, 508, 211, 240, 383, 454, 489,
+	444, 362, 468, 469, 301, 443, 274, 193, 200, 337,
+	209, 430, 227, 216, 474, 492, 269, 413, 201, 456,
+	481, 224, 434, 0, 0, 510, 203, 479, 453, 358,
+	298, 299, 202, 0, 414, 248, 272, 238, 378, 476,
+	477, 236, 511, 213, 499, 205, 0, 498, 371, 471,
+	480, 359, 349, 204, 478, 357, 348, 304, 633, 634,
+	260, 283, 407, 342, 408, 284, 367, 366, 368, 196,
+	490, 0, 197, 0, 449, 491, 512, 219, 220, 222,
+	0, 259, 263, 271, 273, 279, 280, 287, 335, 382,
+	406, 404, 410, 0, 466, 484, 495, 502, 507, 370,
+	286, 445, 303, 340, 0, 0, 388, 423, 225, 488,
+	446, 670, 662, 620, 674, 622, 671, 672, 617, 618,
+	621, 673, 513, 514, 515, 516, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	0, 665, 607, 606, 0, 613, 614, 0, 623, 624,
+	626, 630, 631, 632, 635, 636, 637, 645, 647, 648,
+	646, 649, 650, 651, 654, 655, 656, 657, 652, 653,
+	658, 605, 189, 206, 336, 0, 411, 268, 509, 497,
+	493, 0, 208, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 192, 198, 207,
+	217, 221, 228, 241, 256, 258, 265, 277, 282, 285,
+	290, 291, 294, 300, 346, 352, 353, 354, 355, 372,
+	373, 374, 377, 380, 381, 384, 386, 387, 390, 394,
+	398, 399, 400, 401, 403, 405, 412, 417, 425, 426,
+	427, 428, 429, 432, 433, 438, 439, 440, 441, 442,
+	450, 451, 455, 473, 475, 487, 501, 506, 431, 278,
+	482, 0, 345, 0, 0, 347, 261, 281, 292, 0,
+	496, 452, 212, 419, 270, 199, 231, 215, 239, 254,
+	257, 296, 356, 363, 392, 397, 275, 251, 229, 416,
+	226, 435, 458, 459, 460, 462, 360, 246, 396, 0,
+	361, 343, 464, 465, 288, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 604, 0, 0, 0, 250, 609, 0, 0,
+	0, 334, 247, 0, 0, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 616, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 611, 612, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 85, 0, 1305, 186, 187, 188, 680, 687,
+	688, 689, 690, 691, 681, 683, 0, 0, 223, 682,
+	230, 627, 629, 628, 638, 639, 640, 641, 642, 643,
+	644, 625, 685, 692, 693, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 587, 601, 0, 615,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 598, 599,
+	0, 0, 0, 0, 664, 0, 600, 0, 0, 608,
+	694, 695, 696, 697, 698, 699, 700, 701, 702, 703,
+	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
+	714, 715, 716, 717, 718, 719, 720, 721, 722, 723,
+	724, 725, 726, 727, 728, 729, 730, 731, 732, 733,
+	734, 735, 610, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 663, 0, 0,
+	503, 0, 0, 661, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 633, 634, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 670, 662, 620, 674, 622, 671,
+	672, 617, 618, 621, 673, 513, 514, 515, 516, 517,
+	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
+	528, 529, 530, 0, 665, 607, 606, 0, 613, 614,
+	0, 623, 624, 626, 630, 631, 632, 635, 636, 637,
+	645, 647, 648, 646, 649, 650, 651, 654, 655, 656,
+	657, 652, 653, 658, 605, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 604, 0, 0, 0, 250,
+	609, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 616, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 611, 612, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 85, 0, 0, 186, 187,
+	188, 680, 687, 688, 689, 690, 691, 681, 683, 0,
+	0, 223, 682, 230, 627, 629, 628, 638, 639, 640,
+	641, 642, 643, 644, 625, 685, 692, 693, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 587,
+	601, 0, 615, 0, 0, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 598, 599, 774, 0, 0, 0, 664, 0, 600,
+	0, 0, 608, 694, 695, 696, 697, 698, 699, 700,
+	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
+	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
+	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
+	731, 732, 733, 734, 735, 610, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	663, 0, 0, 503, 0, 0, 661, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 633, 634, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 670, 662, 620,
+	674, 622, 671, 672, 617, 618, 621, 673, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 665, 607, 606,
+	0, 613, 614, 0, 623, 624, 626, 630, 631, 632,
+	635, 636, 637, 645, 647, 648, 646, 649, 650, 651,
+	654, 655, 656, 657, 652, 653, 658, 605, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 604, 0,
+	0, 0, 250, 609, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 616,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	611, 612, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 85, 0,
+	0, 186, 187, 188, 680, 687, 688, 689, 690, 691,
+	681, 683, 0, 0, 223, 682, 230, 627, 629, 628,
+	638, 639, 640, 641, 642, 643, 644, 625, 685, 692,
+	693, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 587, 601, 0, 615, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 598, 599, 0, 0, 0, 0,
+	664, 0, 600, 0, 0, 608, 694, 695, 696, 697,
+	698, 699, 700, 701, 702, 703, 704, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 715, 716, 717,
+	718, 719, 720, 721, 722, 723, 724, 725, 726, 727,
+	728, 729, 730, 731, 732, 733, 734, 735, 610, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 663, 0, 0, 503, 0, 0, 661,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 633, 634, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	670, 662, 620, 674, 622, 671, 672, 617, 618, 621,
+	673, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	665, 607, 606, 0, 613, 614, 0, 623, 624, 626,
+	630, 631, 632, 635, 636, 637, 645, 647, 648, 646,
+	649, 650, 651, 654, 655, 656, 657, 652, 653, 658,
+	605, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 604, 0, 0, 0, 250, 609, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 616, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 611, 612, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 85, 0, 0, 186, 187, 188, 680, 687, 688,
+	689, 690, 691, 681, 683, 0, 0, 223, 682, 230,
+	627, 629, 628, 638, 639, 640, 641, 642, 643, 644,
+	625, 685, 692, 693, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 601, 0, 615, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 598, 599, 0,
+	0, 0, 0, 664, 0, 600, 0, 0, 608, 694,
 	695, 696, 697, 698, 699, 700, 701, 702, 703, 704,
 	705, 706, 707, 708, 709, 710, 711, 712, 713, 714,
 	715, 716, 717, 718, 719, 720, 721, 722, 723, 724,
 	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
+	735, 610, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 663, 0, 0, 503,
+	0, 0, 661, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	633, 634, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 670, 662, 620, 674, 622, 671, 672,
+	617, 618, 621, 673, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 665, 607, 606, 0, 613, 614, 0,
+	623, 624, 626, 630, 631, 632, 635, 636, 637, 645,
+	647, 648, 646, 649, 650, 651, 654, 655, 656, 657,
+	652, 653, 658, 605, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 361, 343, 464, 465, 288, 463, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 334, 247, 0, 0, 393, 0, 195,
+	0, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 0, 338, 0, 0, 447,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 187, 188,
+	0, 687, 688, 0, 0, 0, 0, 1646, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 0, 0, 0, 0, 0, 245, 293,
+	252, 244, 467, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 694, 695, 696, 697, 698, 699, 700, 701,
+	702, 703, 704, 705, 706, 707, 708, 709, 710, 711,
+	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 729, 730, 731,
+	732, 733, 734, 735, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 365, 237, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	333, 0, 302, 190, 210, 0, 0, 375, 418, 424,
+	0, 0, 0, 235, 0, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 0, 0, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 0, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 0, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 0, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 0,
+	0, 388, 423, 225, 488, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 513, 514, 515,
+	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
+	526, 527, 528, 529, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 0, 189, 206, 336,
+	0, 411, 268, 509, 497, 493, 0, 208, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 0, 0,
+	347, 261, 281, 292, 0, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 0, 361, 343, 464, 465, 288,
+	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 334, 247, 0, 0,
+	393, 0, 195, 0, 437, 234, 344, 341, 470, 262,
+	253, 249, 233, 289, 351, 391, 457, 385, 0, 338,
+	0, 0, 447, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
+	232, 297, 194, 376, 448, 266, 0, 0, 0, 0,
+	186, 187, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 223, 0, 230, 319, 328, 327, 308,
+	309, 311, 313, 318, 325, 331, 0, 0, 0, 0,
+	0, 245, 293, 252, 244, 467, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 993, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 365,
+	237, 0, 0, 0, 992, 503, 0, 0, 0, 0,
+	0, 989, 990, 333, 950, 302, 190, 210, 983, 987,
+	375, 418, 424, 0, 0, 0, 235, 0, 422, 389,
+	486, 218, 264, 415, 395, 420, 402, 267, 0, 0,
+	421, 339, 472, 409, 483, 504, 505, 243, 369, 494,
+	461, 500, 508, 211, 240, 383, 454, 489, 444, 362,
+	468, 469, 301, 443, 274, 193, 200, 337, 209, 430,
+	227, 216, 474, 492, 269, 413, 201, 456, 481, 224,
+	434, 0, 0, 510, 203, 479, 453, 358, 298, 299,
+	202, 0, 414, 248, 272, 238, 378, 476, 477, 236,
+	511, 213, 499, 205, 0, 498, 371, 471, 480, 359,
+	349, 204, 478, 357, 348, 304, 323, 324, 260, 283,
+	407, 342, 408, 284, 367, 366, 368, 196, 490, 0,
+	197, 0, 449, 491, 512, 219, 220, 222, 0, 259,
+	263, 271, 273, 279, 280, 287, 335, 382, 406, 404,
+	410, 0, 466, 484, 495, 502, 507, 370, 286, 445,
+	303, 340, 0, 0, 388, 423, 225, 488, 446, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
+	523, 524, 525, 526, 527, 528, 529, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 350,
+	436, 485, 305, 317, 320, 310, 329, 0, 330, 306,
+	307, 312, 314, 315, 316, 321, 322, 326, 332, 0,
+	189, 206, 336, 0, 411, 268, 509, 497, 493, 0,
+	208, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 191, 192, 198, 207, 217, 221,
+	228, 241, 256, 258, 265, 277, 282, 285, 290, 291,
+	294, 300, 346, 352, 353, 354, 355, 372, 373, 374,
+	377, 380, 381, 384, 386, 387, 390, 394, 398, 399,
+	400, 401, 403, 405, 412, 417, 425, 426, 427, 428,
+	429, 432, 433, 438, 439, 440, 441, 442, 450, 451,
+	455, 473, 475, 487, 501, 506, 431, 278, 482, 0,
+	345, 0, 0, 347, 261, 281, 292, 0, 496, 452,
+	212, 419, 270, 199, 231, 215, 239, 254, 257, 296,
+	356, 363, 392, 397, 275, 251, 229, 416, 226, 435,
+	458, 459, 460, 462, 360, 246, 396, 0, 0, 343,
+	464, 465, 288, 361, 0, 0, 0, 76, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 0, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 85, 0, 0, 186, 187,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 230, 319, 328, 327, 308, 309, 311,
+	313, 318, 325, 331, 0, 0, 0, 0, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	992, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 991,
-	502, 0, 0, 0, 0, 0, 988, 989, 332, 949,
-	301, 190, 209, 982, 986, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 0, 342, 463, 464, 287, 360, 0, 0,
-	0, 76, 462, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 333, 246,
-	0, 0, 392, 0, 195, 0, 436, 233, 343, 340,
-	469, 261, 252, 248, 232, 288, 350, 390, 456, 384,
-	0, 337, 0, 0, 446, 363, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 294, 231, 296, 194, 375, 447, 265, 0, 85,
-	0, 0, 186, 187, 188, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 222, 0, 229, 318, 327,
-	326, 307, 308, 310, 312, 317, 324, 330, 0, 0,
-	0, 0, 0, 244, 292, 251, 243, 466, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	254, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	0, 364, 236, 0, 0, 0, 0, 502, 0, 0,
-	0, 0, 0, 0, 0, 332, 0, 301, 190, 209,
-	0, 0, 374, 417, 423, 0, 0, 0, 234, 0,
-	421, 388, 485, 217, 263, 414, 394, 419, 401, 266,
-	0, 0, 420, 338, 471, 408, 482, 503, 504, 242,
-	368, 493, 460, 499, 507, 210, 239, 382, 453, 488,
-	443, 361, 467, 468, 300, 442, 273, 193, 336, 208,
-	429, 226, 215, 473, 491, 268, 412, 200, 455, 480,
-	223, 433, 0, 0, 509, 202, 478, 452, 357, 297,
-	298, 201, 0, 413, 247, 271, 237, 377, 475, 476,
-	235, 510, 212, 498, 204, 0, 497, 370, 470, 479,
-	358, 348, 203, 477, 356, 347, 303, 322, 323, 259,
-	282, 406, 341, 407, 283, 366, 365, 367, 196, 489,
-	0, 197, 0, 448, 490, 511, 218, 219, 221, 0,
-	258, 262, 270, 272, 278, 279, 286, 334, 381, 405,
-	403, 409, 0, 465, 483, 494, 501, 506, 369, 285,
-	444, 302, 339, 0, 0, 387, 422, 224, 487, 445,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 512, 513, 514, 515, 516, 517, 518, 519, 520,
-	521, 522, 523, 524, 525, 526, 527, 528, 529, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 530,
-	349, 435, 484, 304, 316, 319, 309, 328, 0, 329,
-	305, 306, 311, 313, 314, 315, 320, 321, 325, 331,
-	0, 189, 205, 335, 84, 410, 267, 508, 496, 492,
-	0, 207, 0, 241, 0, 0, 0, 0, 0, 0,
-	1800, 0, 0, 1799, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 198, 206, 216,
-	220, 227, 240, 255, 257, 264, 276, 281, 284, 289,
-	290, 293, 299, 345, 351, 352, 353, 354, 371, 372,
-	373, 376, 379, 380, 383, 385, 386, 389, 393, 397,
-	398, 399, 400, 402, 404, 411, 416, 424, 425, 426,
-	427, 428, 431, 432, 437, 438, 439, 440, 441, 449,
-	450, 454, 472, 474, 486, 500, 505, 430, 277, 481,
-	0, 344, 0, 0, 346, 260, 280, 291, 0, 495,
-	451, 211, 418, 269, 199, 230, 214, 238, 253, 256,
-	295, 355, 362, 391, 396, 274, 250, 228, 415, 225,
-	434, 457, 458, 459, 461, 359, 245, 395, 0, 1321,
-	342, 463, 464, 287, 462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 378, 0, 0, 0,
-	1323, 0, 0, 0, 0, 249, 0, 0, 0, 0,
-	333, 246, 0, 0, 392, 0, 195, 0, 436, 233,
-	343, 340, 469, 261, 252, 248, 232, 288, 350, 390,
-	456, 384, 0, 337, 0, 0, 446, 363, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 294, 231, 296, 194, 375, 447, 265,
-	0, 0, 0, 0, 186, 187, 188, 0, 1325, 0,
-	0, 0, 0, 0, 0, 0, 0, 222, 0, 229,
-	318, 327, 326, 307, 308, 310, 312, 317, 324, 330,
-	0, 0, 0, 0, 0, 244, 292, 251, 243, 466,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
-	0, 1155, 0, 1156, 1157, 0, 0, 0, 0, 0,
-	0, 0, 254, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 0, 364, 236, 0, 0, 0, 0, 502,
-	0, 0, 0, 0, 0, 0, 0, 332, 0, 301,
-	190, 209, 0, 0, 374, 417, 423, 0, 0, 0,
-	234, 0, 421, 388, 485, 217, 263, 414, 394, 419,
-	401, 266, 0, 0, 420, 338, 471, 408, 482, 503,
-	504, 242, 368, 493, 460, 499, 507, 210, 239, 382,
-	453, 488, 443, 361, 467, 468, 300, 442, 273, 193,
-	336, 208, 429, 226, 215, 473, 491, 268, 412, 200,
-	455, 480, 223, 433, 0, 0, 509, 202, 478, 452,
-	357, 297, 298, 201, 0, 413, 247, 271, 237, 377,
-	475, 476, 235, 510, 212, 498, 204, 0, 497, 370,
-	470, 479, 358, 348, 203, 477, 356, 347, 303, 322,
-	323, 259, 282, 406, 341, 407, 283, 366, 365, 367,
-	196, 489, 0, 197, 0, 448, 490, 511, 218, 219,
-	221, 0, 258, 262, 270, 272, 278, 279, 286, 334,
-	381, 405, 403, 409, 0, 465, 483, 494, 501, 506,
-	369, 285, 444, 302, 339, 0, 0, 387, 422, 224,
-	487, 445, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 512, 513, 514, 515, 516, 517, 518,
-	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	529, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 530, 349, 435, 484, 304, 316, 319, 309, 328,
-	0, 329, 305, 306, 311, 313, 314, 315, 320, 321,
-	325, 331, 0, 189, 205, 335, 0, 410, 267, 508,
-	496, 492, 0, 207, 0, 241, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 198,
-	206, 216, 220, 227, 240, 255, 257, 264, 276, 281,
-	284, 289, 290, 293, 299, 345, 351, 352, 353, 354,
-	371, 372, 373, 376, 379, 380, 383, 385, 386, 389,
-	393, 397, 398, 399, 400, 402, 404, 411, 416, 424,
-	425, 426, 427, 428, 431, 432, 437, 438, 439, 440,
-	441, 449, 450, 454, 472, 474, 486, 500, 505, 430,
-	277, 481, 0, 344, 0, 0, 346, 260, 280, 291,
-	0, 495, 451, 211, 418, 269, 199, 230, 214, 238,
-	253, 256, 295, 355, 362, 391, 396, 274, 250, 228,
-	415, 225, 434, 457, 458, 459, 461, 359, 245, 395,
-	0, 360, 342, 463, 464, 287, 462, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
-	0, 0, 333, 246, 0, 0, 392, 0, 195, 0,
-	436, 233, 343, 340, 469, 261, 252, 248, 232, 288,
-	350, 390, 456, 384, 0, 337, 0, 0, 446, 363,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 294, 231, 296, 194, 375,
-	447, 265, 0, 0, 0, 0, 186, 187, 188, 1265,
-	1268, 0, 0, 0, 0, 1264, 1267, 0, 0, 222,
-	1263, 229, 318, 327, 326, 307, 308, 310, 312, 317,
-	324, 330, 0, 0, 0, 0, 0, 244, 292, 251,
-	243, 466, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 0, 364, 236, 0, 0, 0,
-	0, 502, 0, 0, 0, 0, 0, 0, 0, 332,
-	0, 301, 190, 209, 0, 0, 374, 417, 423, 0,
-	0, 0, 234, 0, 421, 388, 485, 217, 263, 414,
-	394, 419, 401, 266, 0, 0, 420, 338, 471, 408,
-	482, 503, 504, 242, 368, 493, 460, 499, 507, 210,
-	239, 382, 453, 488, 443, 361, 467, 468, 300, 442,
-	273, 193, 336, 208, 429, 226, 215, 473, 491, 268,
-	412, 200, 455, 480, 223, 433, 0, 0, 509, 202,
-	478, 452, 357, 297, 298, 201, 0, 413, 247, 271,
-	237, 377, 475, 476, 235, 510, 212, 498, 204, 0,
-	497, 370, 470, 479, 358, 348, 203, 477, 356, 347,
-	303, 322, 323, 259, 282, 406, 341, 407, 283, 366,
-	365, 367, 196, 489, 0, 197, 0, 448, 490, 511,
-	218, 219, 221, 0, 258, 262, 270, 272, 278, 279,
-	286, 334, 381, 405, 403, 409, 0, 465, 483, 494,
-	501, 506, 369, 285, 444, 302, 339, 0, 0, 387,
-	422, 224, 487, 445, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 512, 513, 514, 515, 516,
-	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
-	527, 528, 529, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 349, 435, 484, 304, 316, 319,
-	309, 328, 0, 329, 305, 306, 311, 313, 314, 315,
-	320, 321, 325, 331, 0, 189, 205, 335, 0, 410,
-	267, 508, 496, 492, 0, 207, 0, 241, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 198, 206, 216, 220, 227, 240, 255, 257, 264,
-	276, 281, 284, 289, 290, 293, 299, 345, 351, 352,
-	353, 354, 371, 372, 373, 376, 379, 380, 383, 385,
-	386, 389, 393, 397, 398, 399, 400, 402, 404, 411,
-	416, 424, 425, 426, 427, 428, 431, 432, 437, 438,
-	439, 440, 441, 449, 450, 454, 472, 474, 486, 500,
-	505, 430, 277, 481, 0, 344, 0, 0, 346, 260,
-	280, 291, 0, 495, 451, 211, 418, 269, 199, 230,
-	214, 238, 253, 256, 295, 355, 362, 391, 396, 274,
-	250, 228, 415, 225, 434, 457, 458, 459, 461, 359,
-	245, 395, 0, 0, 342, 463, 464, 287, 360, 0,
-	0, 0, 76, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	85, 0, 1302, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 84, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 85, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	0, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 323, 324, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 350, 436, 485,
+	305, 317, 320, 310, 329, 0, 330, 306, 307, 312,
+	314, 315, 316, 321, 322, 326, 332, 0, 189, 206,
+	336, 84, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 1805, 0, 0,
+	1804, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 1324, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 1326, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 0,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
+	0, 186, 187, 188, 0, 1328, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 0, 0, 0,
+	0, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 1158, 0,
+	1159, 1160, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	0, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 0, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 0, 0, 0, 186, 187, 188, 1268, 1271, 0,
+	0, 0, 0, 1267, 1270, 0, 0, 223, 1266, 230,
+	319, 328, 327, 308, 309, 311, 313, 318, 325, 331,
+	0, 0, 0, 0, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	323, 324, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 350, 436, 485, 305, 317, 320, 310,
+	329, 0, 330, 306, 307, 312, 314, 315, 316, 321,
+	322, 326, 332, 0, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 0, 343, 464, 465, 288, 361, 0, 0,
+	0, 76, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 334, 247,
+	0, 0, 393, 0, 195, 0, 437, 234, 344, 341,
+	470, 262, 253, 249, 233, 289, 351, 391, 457, 385,
+	0, 338, 0, 0, 447, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 295, 232, 297, 194, 376, 448, 266, 0, 85,
+	0, 1305, 186, 187, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 230, 319, 328,
+	327, 308, 309, 311, 313, 318, 325, 331, 0, 0,
+	0, 0, 0, 245, 293, 252, 244, 467, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 365, 237, 0, 0, 0, 0, 503, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 302, 190, 210,
+	0, 0, 375, 418, 424, 0, 0, 0, 235, 0,
+	422, 389, 486, 218, 264, 415, 395, 420, 402, 267,
+	0, 0, 421, 339, 472, 409, 483, 504, 505, 243,
+	369, 494, 461, 500, 508, 211, 240, 383, 454, 489,
+	444, 362, 468, 469, 301, 443, 274, 193, 200, 337,
+	209, 430, 227, 216, 474, 492, 269, 413, 201, 456,
+	481, 224, 434, 0, 0, 510, 203, 479, 453, 358,
+	298, 299, 202, 0, 414, 248, 272, 238, 378, 476,
+	477, 236, 511, 213, 499, 205, 0, 498, 371, 471,
+	480, 359, 349, 204, 478, 357, 348, 304, 323, 324,
+	260, 283, 407, 342, 408, 284, 367, 366, 368, 196,
+	490, 0, 197, 0, 449, 491, 512, 219, 220, 222,
+	0, 259, 263, 271, 273, 279, 280, 287, 335, 382,
+	406, 404, 410, 0, 466, 484, 495, 502, 507, 370,
+	286, 445, 303, 340, 0, 0, 388, 423, 225, 488,
+	446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 513, 514, 515, 516, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 350, 436, 485, 305, 317, 320, 310, 329, 0,
+	330, 306, 307, 312, 314, 315, 316, 321, 322, 326,
+	332, 0, 189, 206, 336, 84, 411, 268, 509, 497,
+	493, 0, 208, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 192, 198, 207,
+	217, 221, 228, 241, 256, 258, 265, 277, 282, 285,
+	290, 291, 294, 300, 346, 352, 353, 354, 355, 372,
+	373, 374, 377, 380, 381, 384, 386, 387, 390, 394,
+	398, 399, 400, 401, 403, 405, 412, 417, 425, 426,
+	427, 428, 429, 432, 433, 438, 439, 440, 441, 442,
+	450, 451, 455, 473, 475, 487, 501, 506, 431, 278,
+	482, 0, 345, 0, 0, 347, 261, 281, 292, 0,
+	496, 452, 212, 419, 270, 199, 231, 215, 239, 254,
+	257, 296, 356, 363, 392, 397, 275, 251, 229, 416,
+	226, 435, 458, 459, 460, 462, 360, 246, 396, 0,
+	361, 343, 464, 465, 288, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 334, 247, 0, 0, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 0, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 85, 0, 0, 186, 187, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 223, 0,
+	230, 319, 328, 327, 308, 309, 311, 313, 318, 325,
+	331, 0, 0, 0, 0, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 0, 0, 0,
+	503, 0, 0, 0, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 323, 324, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 513, 514, 515, 516, 517,
 	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
-	0, 0, 0, 1800, 0, 0, 1799, 0, 0, 0,
+	528, 529, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 350, 436, 485, 305, 317, 320,
+	310, 329, 0, 330, 306, 307, 312, 314, 315, 316,
+	321, 322, 326, 332, 0, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
+	0, 0, 0, 0, 1805, 0, 0, 1804, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 1757, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 0, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 0, 0, 0, 186, 187,
+	188, 0, 1506, 0, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 230, 319, 328, 327, 308, 309, 311,
+	313, 318, 325, 331, 0, 0, 0, 0, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	0, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 1755, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 323, 324, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 350, 436, 485,
+	305, 317, 320, 310, 329, 0, 330, 306, 307, 312,
+	314, 315, 316, 321, 322, 326, 332, 0, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 0,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
+	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 0, 0, 0,
+	0, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 944, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 333, 950, 302, 190, 210, 948,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	0, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	1757, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 0, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 0, 0, 0, 186, 187, 188, 0, 1506, 0,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 230,
+	319, 328, 327, 308, 309, 311, 313, 318, 325, 331,
+	0, 0, 0, 0, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	323, 324, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 350, 436, 485, 305, 317, 320, 310,
+	329, 0, 330, 306, 307, 312, 314, 315, 316, 321,
+	322, 326, 332, 0, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 1752, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 1503, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 1750, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 361, 343, 464, 465, 288, 463, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 334, 247, 0, 0, 393, 0, 195,
+	0, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 0, 338, 0, 0, 447,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 1305, 186, 187, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 0, 0, 0, 0, 0, 245, 293,
+	252, 244, 467, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 365, 237, 0, 0,
+	0, 0, 503, 0, 0, 0, 2844, 0, 0, 0,
+	333, 0, 302, 190, 210, 0, 0, 375, 418, 424,
+	0, 0, 0, 235, 0, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 0, 0, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 0, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 0, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 0, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 0,
+	0, 388, 423, 225, 488, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 513, 514, 515,
 	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
-	0, 0, 0, 0, 0, 0, 943, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 949, 301, 190, 209, 947, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
-	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 1752, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 187, 188, 0, 1503, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 1302, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 2820, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
+	526, 527, 528, 529, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 0, 189, 206, 336,
+	0, 411, 268, 509, 497, 493, 0, 208, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 0, 0,
+	347, 261, 281, 292, 0, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 0, 361, 343, 464, 465, 288,
+	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 334, 247, 0, 0,
+	393, 0, 195, 0, 437, 234, 344, 341, 470, 262,
+	253, 249, 233, 289, 351, 391, 457, 385, 0, 338,
+	0, 0, 447, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
+	232, 297, 194, 376, 448, 266, 0, 0, 0, 0,
+	186, 187, 188, 0, 1642, 0, 0, 0, 0, 0,
+	0, 0, 0, 223, 0, 230, 319, 328, 327, 308,
+	309, 311, 313, 318, 325, 331, 0, 0, 0, 0,
+	0, 245, 293, 252, 244, 467, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1643, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 365,
+	237, 0, 0, 0, 0, 503, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 302, 190, 210, 0, 0,
+	375, 418, 424, 0, 0, 0, 235, 0, 422, 389,
+	486, 218, 264, 415, 395, 420, 402, 267, 0, 0,
+	421, 339, 472, 409, 483, 504, 505, 243, 369, 494,
+	461, 500, 508, 211, 240, 383, 454, 489, 444, 362,
+	468, 469, 301, 443, 274, 193, 200, 337, 209, 430,
+	227, 216, 474, 492, 269, 413, 201, 456, 481, 224,
+	434, 0, 0, 510, 203, 479, 453, 358, 298, 299,
+	202, 0, 414, 248, 272, 238, 378, 476, 477, 236,
+	511, 213, 499, 205, 0, 498, 371, 471, 480, 359,
+	349, 204, 478, 357, 348, 304, 323, 324, 260, 283,
+	407, 342, 408, 284, 367, 366, 368, 196, 490, 0,
+	197, 0, 449, 491, 512, 219, 220, 222, 0, 259,
+	263, 271, 273, 279, 280, 287, 335, 382, 406, 404,
+	410, 0, 466, 484, 495, 502, 507, 370, 286, 445,
+	303, 340, 0, 0, 388, 423, 225, 488, 446, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
+	523, 524, 525, 526, 527, 528, 529, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 350,
+	436, 485, 305, 317, 320, 310, 329, 0, 330, 306,
+	307, 312, 314, 315, 316, 321, 322, 326, 332, 0,
+	189, 206, 336, 0, 411, 268, 509, 497, 493, 0,
+	208, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 191, 192, 198, 207, 217, 221,
+	228, 241, 256, 258, 265, 277, 282, 285, 290, 291,
+	294, 300, 346, 352, 353, 354, 355, 372, 373, 374,
+	377, 380, 381, 384, 386, 387, 390, 394, 398, 399,
+	400, 401, 403, 405, 412, 417, 425, 426, 427, 428,
+	429, 432, 433, 438, 439, 440, 441, 442, 450, 451,
+	455, 473, 475, 487, 501, 506, 431, 278, 482, 0,
+	345, 0, 0, 347, 261, 281, 292, 0, 496, 452,
+	212, 419, 270, 199, 231, 215, 239, 254, 257, 296,
+	356, 363, 392, 397, 275, 251, 229, 416, 226, 435,
+	458, 459, 460, 462, 360, 246, 396, 0, 361, 343,
+	464, 465, 288, 463, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 334,
+	247, 0, 0, 393, 0, 195, 0, 437, 234, 344,
+	341, 470, 262, 253, 249, 233, 289, 351, 391, 457,
+	385, 0, 338, 0, 0, 447, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 232, 297, 194, 376, 448, 266, 0,
+	0, 0, 0, 186, 187, 188, 0, 2091, 0, 0,
+	0, 0, 0, 0, 0, 0, 223, 0, 230, 319,
+	328, 327, 308, 309, 311, 313, 318, 325, 331, 0,
+	0, 0, 0, 0, 245, 293, 252, 244, 467, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2092, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 365, 237, 0, 0, 0, 0, 503, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 302, 190,
+	210, 0, 0, 375, 418, 424, 0, 0, 0, 235,
+	0, 422, 389, 486, 218, 264, 415, 395, 420, 402,
+	267, 0, 0, 421, 339, 472, 409, 483, 504, 505,
+	243, 369, 494, 461, 500, 508, 211, 240, 383, 454,
+	489, 444, 362, 468, 469, 301, 443, 274, 193, 200,
+	337, 209, 430, 227, 216, 474, 492, 269, 413, 201,
+	456, 481, 224, 434, 0, 0, 510, 203, 479, 453,
+	358, 298, 299, 202, 0, 414, 248, 272, 238, 378,
+	476, 477, 236, 511, 213, 499, 205, 0, 498, 371,
+	471, 480, 359, 349, 204, 478, 357, 348, 304, 323,
+	324, 260, 283, 407, 342, 408, 284, 367, 366, 368,
+	196, 490, 0, 197, 0, 449, 491, 512, 219, 220,
+	222, 0, 259, 263, 271, 273, 279, 280, 287, 335,
+	382, 406, 404, 410, 0, 466, 484, 495, 502, 507,
+	370, 286, 445, 303, 340, 0, 0, 388, 423, 225,
+	488, 446, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 513, 514, 515, 516, 517, 518, 519,
 	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 531, 350, 436, 485, 305, 317, 320, 310, 329,
+	0, 330, 306, 307, 312, 314, 315, 316, 321, 322,
+	326, 332, 0, 189, 206, 336, 0, 411, 268, 509,
+	497, 493, 0, 208, 0, 242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 1637,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1638,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
+	0, 0, 0, 0, 0, 0, 0, 191, 192, 198,
+	207, 217, 221, 228, 241, 256, 258, 265, 277, 282,
+	285, 290, 291, 294, 300, 346, 352, 353, 354, 355,
+	372, 373, 374, 377, 380, 381, 384, 386, 387, 390,
+	394, 398, 399, 400, 401, 403, 405, 412, 417, 425,
+	426, 427, 428, 429, 432, 433, 438, 439, 440, 441,
+	442, 450, 451, 455, 473, 475, 487, 501, 506, 431,
+	278, 482, 0, 345, 0, 0, 347, 261, 281, 292,
+	0, 496, 452, 212, 419, 270, 199, 231, 215, 239,
+	254, 257, 296, 356, 363, 392, 397, 275, 251, 229,
+	416, 226, 435, 458, 459, 460, 462, 360, 246, 396,
+	0, 361, 343, 464, 465, 288, 463, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 334, 247, 0, 0, 393, 0, 195, 0,
+	437, 234, 344, 341, 470, 262, 253, 249, 233, 289,
+	351, 391, 457, 385, 0, 338, 0, 0, 447, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 295, 232, 297, 194, 376,
+	448, 266, 0, 0, 0, 0, 186, 187, 188, 0,
+	0, 0, 2076, 0, 0, 0, 2077, 0, 0, 223,
+	0, 230, 319, 328, 327, 308, 309, 311, 313, 318,
+	325, 331, 0, 0, 0, 0, 0, 245, 293, 252,
+	244, 467, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 365, 237, 0, 0, 0,
+	0, 503, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 302, 190, 210, 0, 0, 375, 418, 424, 0,
+	0, 0, 235, 0, 422, 389, 486, 218, 264, 415,
+	395, 420, 402, 267, 0, 0, 421, 339, 472, 409,
+	483, 504, 505, 243, 369, 494, 461, 500, 508, 211,
+	240, 383, 454, 489, 444, 362, 468, 469, 301, 443,
+	274, 193, 200, 337, 209, 430, 227, 216, 474, 492,
+	269, 413, 201, 456, 481, 224, 434, 0, 0, 510,
+	203, 479, 453, 358, 298, 299, 202, 0, 414, 248,
+	272, 238, 378, 476, 477, 236, 511, 213, 499, 205,
+	0, 498, 371, 471, 480, 359, 349, 204, 478, 357,
+	348, 304, 323, 324, 260, 283, 407, 342, 408, 284,
+	367, 366, 368, 196, 490, 0, 197, 0, 449, 491,
+	512, 219, 220, 222, 0, 259, 263, 271, 273, 279,
+	280, 287, 335, 382, 406, 404, 410, 0, 466, 484,
+	495, 502, 507, 370, 286, 445, 303, 340, 0, 0,
+	388, 423, 225, 488, 446, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 514, 515, 516,
+	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
+	527, 528, 529, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 350, 436, 485, 305, 317,
+	320, 310, 329, 0, 330, 306, 307, 312, 314, 315,
+	316, 321, 322, 326, 332, 0, 189, 206, 336, 0,
+	411, 268, 509, 497, 493, 0, 208, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 192, 198, 207, 217, 221, 228, 241, 256, 258,
+	265, 277, 282, 285, 290, 291, 294, 300, 346, 352,
+	353, 354, 355, 372, 373, 374, 377, 380, 381, 384,
+	386, 387, 390, 394, 398, 399, 400, 401, 403, 405,
+	412, 417, 425, 426, 427, 428, 429, 432, 433, 438,
+	439, 440, 441, 442, 450, 451, 455, 473, 475, 487,
+	501, 506, 431, 278, 482, 0, 345, 0, 0, 347,
+	261, 281, 292, 0, 496, 452, 212, 419, 270, 199,
+	231, 215, 239, 254, 257, 296, 356, 363, 392, 397,
+	275, 251, 229, 416, 226, 435, 458, 459, 460, 462,
+	360, 246, 396, 0, 361, 343, 464, 465, 288, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 1349, 0, 0, 0, 334, 247, 0, 0, 393,
+	0, 195, 0, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 0, 338, 0,
+	0, 447, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 295, 232,
+	297, 194, 376, 448, 266, 0, 0, 0, 0, 186,
+	187, 188, 0, 1348, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 230, 319, 328, 327, 308, 309,
+	311, 313, 318, 325, 331, 0, 0, 0, 0, 0,
+	245, 293, 252, 244, 467, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 365, 237,
+	0, 0, 0, 0, 503, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 302, 190, 210, 0, 0, 375,
+	418, 424, 0, 0, 0, 235, 0, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 0, 0, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 481, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 0, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 323, 324, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 0, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	0, 466, 484, 495, 502, 507, 370, 286, 445, 303,
+	340, 0, 0, 388, 423, 225, 488, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
+	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
+	524, 525, 526, 527, 528, 529, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 531, 350, 436,
+	485, 305, 317, 320, 310, 329, 0, 330, 306, 307,
+	312, 314, 315, 316, 321, 322, 326, 332, 0, 189,
+	206, 336, 0, 411, 268, 509, 497, 493, 0, 208,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	0, 0, 347, 261, 281, 292, 0, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 0, 361, 343, 464,
+	465, 288, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 334, 247,
+	0, 0, 393, 0, 195, 0, 437, 234, 344, 341,
+	470, 262, 253, 249, 233, 289, 351, 391, 457, 385,
+	0, 338, 0, 0, 447, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 295, 232, 297, 194, 376, 448, 266, 0, 0,
+	0, 0, 186, 187, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 230, 319, 328,
+	327, 308, 309, 311, 313, 318, 325, 331, 0, 0,
+	0, 0, 0, 245, 293, 252, 244, 467, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 365, 237, 0, 0, 0, 0, 503, 0, 0,
+	0, 2939, 0, 0, 0, 333, 0, 302, 190, 210,
+	0, 0, 375, 418, 424, 0, 0, 0, 235, 0,
+	422, 389, 486, 218, 264, 415, 395, 420, 402, 267,
+	0, 0, 421, 339, 472, 409, 483, 504, 505, 243,
+	369, 494, 461, 500, 508, 211, 240, 383, 454, 489,
+	444, 362, 468, 469, 301, 443, 274, 193, 200, 337,
+	209, 430, 227, 216, 474, 492, 269, 413, 201, 456,
+	481, 224, 434, 0, 0, 510, 203, 479, 453, 358,
+	298, 299, 202, 0, 414, 248, 272, 238, 378, 476,
+	477, 236, 511, 213, 499, 205, 0, 498, 371, 471,
+	480, 359, 349, 204, 478, 357, 348, 304, 323, 324,
+	260, 283, 407, 342, 408, 284, 367, 366, 368, 196,
+	490, 0, 197, 0, 449, 491, 512, 219, 220, 222,
+	0, 259, 263, 271, 273, 279, 280, 287, 335, 382,
+	406, 404, 410, 0, 466, 484, 495, 502, 507, 370,
+	286, 445, 303, 340, 0, 0, 388, 423, 225, 488,
+	446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 513, 514, 515, 516, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 350, 436, 485, 305, 317, 320, 310, 329, 0,
+	330, 306, 307, 312, 314, 315, 316, 321, 322, 326,
+	332, 0, 189, 206, 336, 0, 411, 268, 509, 497,
+	493, 0, 208, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 192, 198, 207,
+	217, 221, 228, 241, 256, 258, 265, 277, 282, 285,
+	290, 291, 294, 300, 346, 352, 353, 354, 355, 372,
+	373, 374, 377, 380, 381, 384, 386, 387, 390, 394,
+	398, 399, 400, 401, 403, 405, 412, 417, 425, 426,
+	427, 428, 429, 432, 433, 438, 439, 440, 441, 442,
+	450, 451, 455, 473, 475, 487, 501, 506, 431, 278,
+	482, 0, 345, 0, 0, 347, 261, 281, 292, 0,
+	496, 452, 212, 419, 270, 199, 231, 215, 239, 254,
+	257, 296, 356, 363, 392, 397, 275, 251, 229, 416,
+	226, 435, 458, 459, 460, 462, 360, 246, 396, 0,
+	361, 343, 464, 465, 288, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 334, 247, 0, 0, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 0, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 0, 0, 0, 186, 187, 188, 0, 1506,
+	0, 0, 0, 0, 0, 0, 0, 0, 223, 0,
+	230, 319, 328, 327, 308, 309, 311, 313, 318, 325,
+	331, 0, 0, 0, 0, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 0, 0, 0,
+	503, 0, 0, 0, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 323, 324, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 513, 514, 515, 516, 517,
 	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
+	528, 529, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 350, 436, 485, 305, 317, 320,
+	310, 329, 0, 330, 306, 307, 312, 314, 315, 316,
+	321, 322, 326, 332, 0, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 0, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 0, 0, 0, 186, 187,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 230, 319, 328, 327, 308, 309, 311,
+	313, 318, 325, 331, 0, 0, 0, 0, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	0, 0, 0, 503, 0, 0, 0, 2844, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 323, 324, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 350, 436, 485,
+	305, 317, 320, 310, 329, 0, 330, 306, 307, 312,
+	314, 315, 316, 321, 322, 326, 332, 0, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 0,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 85, 0,
+	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 0, 0, 0,
+	0, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	0, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 1806,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 0, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 0, 0, 0, 186, 187, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 230,
+	319, 328, 327, 308, 309, 311, 313, 318, 325, 331,
+	0, 0, 0, 0, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	323, 324, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 350, 436, 485, 305, 317, 320, 310,
+	329, 0, 330, 306, 307, 312, 314, 315, 316, 321,
+	322, 326, 332, 0, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 2084, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2085, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 361, 343, 464, 465, 288, 463, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 334, 247, 0, 0, 393, 0, 195,
+	0, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 0, 338, 0, 0, 447,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 187, 188,
+	0, 1328, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 0, 0, 0, 0, 0, 245, 293,
+	252, 244, 467, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 365, 237, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	333, 0, 302, 190, 210, 0, 0, 375, 418, 424,
+	0, 0, 0, 235, 0, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 0, 0, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 0, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 0, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 0, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 0,
+	0, 388, 423, 225, 488, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 513, 514, 515,
 	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 0, 0, 2069, 0, 0, 0, 2070,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
-	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 1346, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 187, 188, 0, 1345, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
+	526, 527, 528, 529, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 0, 189, 206, 336,
+	0, 411, 268, 509, 497, 493, 0, 208, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 0, 0,
+	347, 261, 281, 292, 0, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 0, 361, 343, 464, 465, 288,
+	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 334, 247, 0, 0,
+	393, 0, 195, 0, 437, 234, 344, 341, 470, 262,
+	253, 249, 233, 289, 351, 391, 457, 385, 0, 338,
+	0, 0, 447, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
+	232, 297, 194, 376, 448, 266, 0, 0, 0, 0,
+	186, 187, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 223, 0, 230, 319, 328, 327, 308,
+	309, 311, 313, 318, 325, 331, 0, 0, 0, 0,
+	0, 245, 293, 252, 244, 467, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1189, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 365,
+	237, 0, 0, 0, 0, 503, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 302, 190, 210, 0, 0,
+	375, 418, 424, 0, 0, 0, 235, 0, 422, 389,
+	486, 218, 264, 415, 395, 420, 402, 267, 0, 0,
+	421, 339, 472, 409, 483, 504, 505, 243, 369, 494,
+	461, 500, 508, 211, 240, 383, 454, 489, 444, 362,
+	468, 469, 301, 443, 274, 193, 200, 337, 209, 430,
+	227, 216, 474, 492, 269, 413, 201, 456, 481, 224,
+	434, 0, 0, 510, 203, 479, 453, 358, 298, 299,
+	202, 0, 414, 248, 272, 238, 378, 476, 477, 236,
+	511, 213, 499, 205, 0, 498, 371, 471, 480, 359,
+	349, 204, 478, 357, 348, 304, 323, 324, 260, 283,
+	407, 342, 408, 284, 367, 366, 368, 196, 490, 0,
+	197, 0, 449, 491, 512, 219, 220, 222, 0, 259,
+	263, 271, 273, 279, 280, 287, 335, 382, 406, 404,
+	410, 0, 466, 484, 495, 502, 507, 370, 286, 445,
+	303, 340, 0, 0, 388, 423, 225, 488, 446, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
+	523, 524, 525, 526, 527, 528, 529, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 350,
+	436, 485, 305, 317, 320, 310, 329, 0, 330, 306,
+	307, 312, 314, 315, 316, 321, 322, 326, 332, 0,
+	189, 206, 336, 0, 411, 268, 509, 497, 493, 0,
+	208, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 191, 192, 198, 207, 217, 221,
+	228, 241, 256, 258, 265, 277, 282, 285, 290, 291,
+	294, 300, 346, 352, 353, 354, 355, 372, 373, 374,
+	377, 380, 381, 384, 386, 387, 390, 394, 398, 399,
+	400, 401, 403, 405, 412, 417, 425, 426, 427, 428,
+	429, 432, 433, 438, 439, 440, 441, 442, 450, 451,
+	455, 473, 475, 487, 501, 506, 431, 278, 482, 0,
+	345, 0, 0, 347, 261, 281, 292, 0, 496, 452,
+	212, 419, 270, 199, 231, 215, 239, 254, 257, 296,
+	356, 363, 392, 397, 275, 251, 229, 416, 226, 435,
+	458, 459, 460, 462, 360, 246, 396, 0, 361, 343,
+	464, 465, 288, 463, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 0, 0, 0, 0, 334,
+	247, 0, 0, 393, 0, 195, 0, 437, 234, 344,
+	341, 470, 262, 253, 249, 233, 289, 351, 391, 457,
+	385, 0, 338, 0, 0, 447, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 232, 297, 194, 376, 448, 266, 0,
 	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 223, 0, 230, 319,
+	328, 327, 308, 309, 311, 313, 318, 325, 331, 0,
+	0, 0, 0, 0, 245, 293, 252, 244, 467, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4202,176 +4647,117 @@ var mysqAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 2913, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
+	276, 0, 365, 237, 0, 0, 0, 0, 503, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 302, 190,
+	210, 0, 0, 375, 418, 424, 0, 0, 0, 235,
+	0, 422, 389, 486, 218, 264, 415, 395, 420, 402,
+	267, 0, 0, 421, 339, 472, 409, 483, 504, 505,
+	243, 369, 494, 461, 500, 508, 211, 240, 383, 454,
+	489, 444, 362, 468, 469, 301, 443, 274, 193, 200,
+	337, 209, 430, 227, 216, 474, 492, 269, 413, 201,
+	456, 481, 224, 434, 0, 0, 510, 203, 479, 453,
+	358, 298, 299, 202, 0, 414, 248, 272, 238, 378,
+	476, 477, 236, 511, 213, 499, 205, 0, 498, 371,
+	471, 480, 359, 349, 204, 478, 357, 348, 304, 323,
+	324, 260, 283, 407, 342, 408, 284, 367, 366, 368,
+	196, 490, 0, 197, 0, 449, 491, 512, 219, 220,
+	222, 0, 259, 263, 271, 273, 279, 280, 287, 335,
+	382, 406, 404, 410, 0, 466, 484, 495, 502, 507,
+	370, 286, 445, 303, 340, 0, 0, 388, 423, 225,
+	488, 446, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 513, 514, 515, 516, 517, 518, 519,
 	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 531, 350, 436, 485, 305, 317, 320, 310, 329,
+	0, 330, 306, 307, 312, 314, 315, 316, 321, 322,
+	326, 332, 0, 189, 206, 336, 1597, 411, 268, 509,
+	497, 493, 0, 208, 0, 242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 1503,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 2820, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 85, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 0, 191, 192, 198,
+	207, 217, 221, 228, 241, 256, 258, 265, 277, 282,
+	285, 290, 291, 294, 300, 346, 352, 353, 354, 355,
+	372, 373, 374, 377, 380, 381, 384, 386, 387, 390,
+	394, 398, 399, 400, 401, 403, 405, 412, 417, 425,
+	426, 427, 428, 429, 432, 433, 438, 439, 440, 441,
+	442, 450, 451, 455, 473, 475, 487, 501, 506, 431,
+	278, 482, 0, 345, 0, 0, 347, 261, 281, 292,
+	0, 496, 452, 212, 419, 270, 199, 231, 215, 239,
+	254, 257, 296, 356, 363, 392, 397, 275, 251, 229,
+	416, 226, 435, 458, 459, 460, 462, 360, 246, 396,
+	0, 361, 343, 464, 465, 288, 463, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 379, 0,
+	1474, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 334, 247, 0, 0, 393, 0, 195, 0,
+	437, 234, 344, 341, 470, 262, 253, 249, 233, 289,
+	351, 391, 457, 385, 0, 338, 0, 0, 447, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 295, 232, 297, 194, 376,
+	448, 266, 0, 0, 0, 0, 186, 187, 188, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 230, 319, 328, 327, 308, 309, 311, 313, 318,
+	325, 331, 0, 0, 0, 0, 0, 245, 293, 252,
+	244, 467, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 365, 237, 0, 0, 0,
+	0, 503, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 302, 190, 210, 0, 0, 375, 418, 424, 0,
+	0, 0, 235, 0, 422, 389, 486, 218, 264, 415,
+	395, 420, 402, 267, 0, 0, 421, 339, 472, 409,
+	483, 504, 505, 243, 369, 494, 461, 500, 508, 211,
+	240, 383, 454, 489, 444, 362, 468, 469, 301, 443,
+	274, 193, 200, 337, 209, 430, 227, 216, 474, 492,
+	269, 413, 201, 456, 481, 224, 434, 0, 0, 510,
+	203, 479, 453, 358, 298, 299, 202, 0, 414, 248,
+	272, 238, 378, 476, 477, 236, 511, 213, 499, 205,
+	0, 498, 371, 471, 480, 359, 349, 204, 478, 357,
+	348, 304, 323, 324, 260, 283, 407, 342, 408, 284,
+	367, 366, 368, 196, 490, 0, 197, 0, 449, 491,
+	512, 219, 220, 222, 0, 259, 263, 271, 273, 279,
+	280, 287, 335, 382, 406, 404, 410, 0, 466, 484,
+	495, 502, 507, 370, 286, 445, 303, 340, 0, 0,
+	388, 423, 225, 488, 446, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 514, 515, 516,
+	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
+	527, 528, 529, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 350, 436, 485, 305, 317,
+	320, 310, 329, 0, 330, 306, 307, 312, 314, 315,
+	316, 321, 322, 326, 332, 0, 189, 206, 336, 0,
+	411, 268, 509, 497, 493, 0, 208, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 192, 198, 207, 217, 221, 228, 241, 256, 258,
+	265, 277, 282, 285, 290, 291, 294, 300, 346, 352,
+	353, 354, 355, 372, 373, 374, 377, 380, 381, 384,
+	386, 387, 390, 394, 398, 399, 400, 401, 403, 405,
+	412, 417, 425, 426, 427, 428, 429, 432, 433, 438,
+	439, 440, 441, 442, 450, 451, 455, 473, 475, 487,
+	501, 506, 431, 278, 482, 0, 345, 0, 0, 347,
+	261, 281, 292, 0, 496, 452, 212, 419, 270, 199,
+	231, 215, 239, 254, 257, 296, 356, 363, 392, 397,
+	275, 251, 229, 416, 226, 435, 458, 459, 460, 462,
+	360, 246, 396, 0, 361, 343, 464, 465, 288, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 1472, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 334, 247, 0, 0, 393,
+	0, 195, 0, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 0, 338, 0,
+	0, 447, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 295, 232,
+	297, 194, 376, 448, 266, 0, 0, 0, 0, 186,
 	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	0, 0, 223, 0, 230, 319, 328, 327, 308, 309,
+	311, 313, 318, 325, 331, 0, 0, 0, 0, 0,
+	245, 293, 252, 244, 467, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4379,295 +4765,177 @@ var mysqAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
+	0, 0, 0, 0, 0, 0, 276, 0, 365, 237,
+	0, 0, 0, 0, 503, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 302, 190, 210, 0, 0, 375,
+	418, 424, 0, 0, 0, 235, 0, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 0, 0, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 481, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 0, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 323, 324, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 0, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	0, 466, 484, 495, 502, 507, 370, 286, 445, 303,
+	340, 0, 0, 388, 423, 225, 488, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
 	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 1801, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 187, 188, 0, 1325, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
+	524, 525, 526, 527, 528, 529, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 531, 350, 436,
+	485, 305, 317, 320, 310, 329, 0, 330, 306, 307,
+	312, 314, 315, 316, 321, 322, 326, 332, 0, 189,
+	206, 336, 0, 411, 268, 509, 497, 493, 0, 208,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	0, 0, 347, 261, 281, 292, 0, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 0, 361, 343, 464,
+	465, 288, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 1470, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 334, 247,
+	0, 0, 393, 0, 195, 0, 437, 234, 344, 341,
+	470, 262, 253, 249, 233, 289, 351, 391, 457, 385,
+	0, 338, 0, 0, 447, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 295, 232, 297, 194, 376, 448, 266, 0, 0,
+	0, 0, 186, 187, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 230, 319, 328,
+	327, 308, 309, 311, 313, 318, 325, 331, 0, 0,
+	0, 0, 0, 245, 293, 252, 244, 467, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 365, 237, 0, 0, 0, 0, 503, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 302, 190, 210,
+	0, 0, 375, 418, 424, 0, 0, 0, 235, 0,
+	422, 389, 486, 218, 264, 415, 395, 420, 402, 267,
+	0, 0, 421, 339, 472, 409, 483, 504, 505, 243,
+	369, 494, 461, 500, 508, 211, 240, 383, 454, 489,
+	444, 362, 468, 469, 301, 443, 274, 193, 200, 337,
+	209, 430, 227, 216, 474, 492, 269, 413, 201, 456,
+	481, 224, 434, 0, 0, 510, 203, 479, 453, 358,
+	298, 299, 202, 0, 414, 248, 272, 238, 378, 476,
+	477, 236, 511, 213, 499, 205, 0, 498, 371, 471,
+	480, 359, 349, 204, 478, 357, 348, 304, 323, 324,
+	260, 283, 407, 342, 408, 284, 367, 366, 368, 196,
+	490, 0, 197, 0, 449, 491, 512, 219, 220, 222,
+	0, 259, 263, 271, 273, 279, 280, 287, 335, 382,
+	406, 404, 410, 0, 466, 484, 495, 502, 507, 370,
+	286, 445, 303, 340, 0, 0, 388, 423, 225, 488,
+	446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 513, 514, 515, 516, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 350, 436, 485, 305, 317, 320, 310, 329, 0,
+	330, 306, 307, 312, 314, 315, 316, 321, 322, 326,
+	332, 0, 189, 206, 336, 0, 411, 268, 509, 497,
+	493, 0, 208, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 192, 198, 207,
+	217, 221, 228, 241, 256, 258, 265, 277, 282, 285,
+	290, 291, 294, 300, 346, 352, 353, 354, 355, 372,
+	373, 374, 377, 380, 381, 384, 386, 387, 390, 394,
+	398, 399, 400, 401, 403, 405, 412, 417, 425, 426,
+	427, 428, 429, 432, 433, 438, 439, 440, 441, 442,
+	450, 451, 455, 473, 475, 487, 501, 506, 431, 278,
+	482, 0, 345, 0, 0, 347, 261, 281, 292, 0,
+	496, 452, 212, 419, 270, 199, 231, 215, 239, 254,
+	257, 296, 356, 363, 392, 397, 275, 251, 229, 416,
+	226, 435, 458, 459, 460, 462, 360, 246, 396, 0,
+	361, 343, 464, 465, 288, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 1468,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 334, 247, 0, 0, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 0, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 0, 0, 0, 186, 187, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 223, 0,
+	230, 319, 328, 327, 308, 309, 311, 313, 318, 325,
+	331, 0, 0, 0, 0, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 0, 0, 0,
+	503, 0, 0, 0, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 323, 324, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 513, 514, 515, 516, 517,
 	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
+	528, 529, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 350, 436, 485, 305, 317, 320,
+	310, 329, 0, 330, 306, 307, 312, 314, 315, 316,
+	321, 322, 326, 332, 0, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 1592,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 1471, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 1466, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 0, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 0, 0, 0, 186, 187,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 230, 319, 328, 327, 308, 309, 311,
+	313, 318, 325, 331, 0, 0, 0, 0, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4675,887 +4943,592 @@ var mysqAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
-	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 1469, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	0, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 323, 324, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 350, 436, 485,
+	305, 317, 320, 310, 329, 0, 330, 306, 307, 312,
+	314, 315, 316, 321, 322, 326, 332, 0, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 372, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 1462, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 0,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
 	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 1467, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 0, 0, 0,
+	0, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	0, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 1460, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 0, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 0, 0, 0, 186, 187, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 230,
+	319, 328, 327, 308, 309, 311, 313, 318, 325, 331,
+	0, 0, 0, 0, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 1465,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	323, 324, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 350, 436, 485, 305, 317, 320, 310,
+	329, 0, 330, 306, 307, 312, 314, 315, 316, 321,
+	322, 326, 332, 0, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 1463, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 361, 343, 464, 465, 288, 463, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 1458, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 334, 247, 0, 0, 393, 0, 195,
+	0, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 0, 338, 0, 0, 447,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 187, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 0, 0, 0, 0, 0, 245, 293,
+	252, 244, 467, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 365, 237, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	333, 0, 302, 190, 210, 0, 0, 375, 418, 424,
+	0, 0, 0, 235, 0, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 0, 0, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 0, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 0, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 0, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 0,
+	0, 388, 423, 225, 488, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 513, 514, 515,
 	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 1459, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	526, 527, 528, 529, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 0, 189, 206, 336,
+	0, 411, 268, 509, 497, 493, 0, 208, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 0, 0,
+	347, 261, 281, 292, 0, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 0, 361, 343, 464, 465, 288,
+	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 0, 0, 0, 0, 334, 247, 0, 0,
+	393, 0, 195, 0, 437, 234, 344, 341, 470, 262,
+	253, 249, 233, 289, 351, 391, 457, 385, 0, 338,
+	0, 0, 447, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
+	232, 297, 194, 376, 448, 266, 0, 1433, 0, 0,
+	186, 187, 188, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 223, 0, 230, 319, 328, 327, 308,
+	309, 311, 313, 318, 325, 331, 0, 0, 0, 0,
+	0, 245, 293, 252, 244, 467, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 365,
+	237, 0, 0, 0, 0, 503, 0, 0, 0, 0,
+	0, 0, 0, 333, 0, 302, 190, 210, 0, 0,
+	375, 418, 424, 0, 0, 0, 235, 0, 422, 389,
+	486, 218, 264, 415, 395, 420, 402, 267, 0, 0,
+	421, 339, 472, 409, 483, 504, 505, 243, 369, 494,
+	461, 500, 508, 211, 240, 383, 454, 489, 444, 362,
+	468, 469, 301, 443, 274, 193, 200, 337, 209, 430,
+	227, 216, 474, 492, 269, 413, 201, 456, 481, 224,
+	434, 0, 0, 510, 203, 479, 453, 358, 298, 299,
+	202, 0, 414, 248, 272, 238, 378, 476, 477, 236,
+	511, 213, 499, 205, 0, 498, 371, 471, 480, 359,
+	349, 204, 478, 357, 348, 304, 323, 324, 260, 283,
+	407, 342, 408, 284, 367, 366, 368, 196, 490, 0,
+	197, 0, 449, 491, 512, 219, 220, 222, 0, 259,
+	263, 271, 273, 279, 280, 287, 335, 382, 406, 404,
+	410, 0, 466, 484, 495, 502, 507, 370, 286, 445,
+	303, 340, 0, 0, 388, 423, 225, 488, 446, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	513, 514, 515, 516, 517, 518, 519, 520, 521, 522,
+	523, 524, 525, 526, 527, 528, 529, 530, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 350,
+	436, 485, 305, 317, 320, 310, 329, 0, 330, 306,
+	307, 312, 314, 315, 316, 321, 322, 326, 332, 0,
+	189, 206, 336, 0, 411, 268, 509, 497, 493, 0,
+	208, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 191, 192, 198, 207, 217, 221,
+	228, 241, 256, 258, 265, 277, 282, 285, 290, 291,
+	294, 300, 346, 352, 353, 354, 355, 372, 373, 374,
+	377, 380, 381, 384, 386, 387, 390, 394, 398, 399,
+	400, 401, 403, 405, 412, 417, 425, 426, 427, 428,
+	429, 432, 433, 438, 439, 440, 441, 442, 450, 451,
+	455, 473, 475, 487, 501, 506, 431, 278, 482, 0,
+	345, 0, 0, 347, 261, 281, 292, 0, 496, 452,
+	212, 419, 270, 199, 231, 215, 239, 254, 257, 296,
+	356, 363, 392, 397, 275, 251, 229, 416, 226, 435,
+	458, 459, 460, 462, 360, 246, 396, 0, 361, 343,
+	464, 465, 288, 463, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 0, 1333, 250, 0, 0, 0, 0, 334,
+	247, 0, 0, 393, 0, 195, 0, 437, 234, 344,
+	341, 470, 262, 253, 249, 233, 289, 351, 391, 457,
+	385, 0, 338, 0, 0, 447, 364, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 232, 297, 194, 376, 448, 266, 0,
+	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 223, 0, 230, 319,
+	328, 327, 308, 309, 311, 313, 318, 325, 331, 0,
+	0, 0, 0, 0, 245, 293, 252, 244, 467, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 255, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
-	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 1457, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 1455, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 365, 237, 0, 0, 0, 0, 503, 0,
+	0, 0, 0, 0, 0, 0, 333, 0, 302, 190,
+	210, 0, 0, 375, 418, 424, 0, 0, 0, 235,
+	0, 422, 389, 486, 218, 264, 415, 395, 420, 402,
+	267, 0, 0, 421, 339, 472, 409, 483, 504, 505,
+	243, 369, 494, 461, 500, 508, 211, 240, 383, 454,
+	489, 444, 362, 468, 469, 301, 443, 274, 193, 200,
+	337, 209, 430, 227, 216, 474, 492, 269, 413, 201,
+	456, 481, 224, 434, 0, 0, 510, 203, 479, 453,
+	358, 298, 299, 202, 0, 414, 248, 272, 238, 378,
+	476, 477, 236, 511, 213, 499, 205, 0, 498, 371,
+	471, 480, 359, 349, 204, 478, 357, 348, 304, 323,
+	324, 260, 283, 407, 342, 408, 284, 367, 366, 368,
+	196, 490, 0, 197, 0, 449, 491, 512, 219, 220,
+	222, 0, 259, 263, 271, 273, 279, 280, 287, 335,
+	382, 406, 404, 410, 0, 466, 484, 495, 502, 507,
+	370, 286, 445, 303, 340, 0, 0, 388, 423, 225,
+	488, 446, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 513, 514, 515, 516, 517, 518, 519,
+	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
+	530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 531, 350, 436, 485, 305, 317, 320, 310, 329,
+	0, 330, 306, 307, 312, 314, 315, 316, 321, 322,
+	326, 332, 0, 189, 206, 336, 0, 411, 268, 509,
+	497, 493, 0, 208, 0, 242, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 191, 192, 198,
+	207, 217, 221, 228, 241, 256, 258, 265, 277, 282,
+	285, 290, 291, 294, 300, 346, 352, 353, 354, 355,
+	372, 373, 374, 377, 380, 381, 384, 386, 387, 390,
+	394, 398, 399, 400, 401, 403, 405, 412, 417, 425,
+	426, 427, 428, 429, 432, 433, 438, 439, 440, 441,
+	442, 450, 451, 455, 473, 475, 487, 501, 506, 431,
+	278, 482, 0, 345, 0, 0, 347, 261, 281, 292,
+	0, 496, 452, 212, 419, 270, 199, 231, 215, 239,
+	254, 257, 296, 356, 363, 392, 397, 275, 251, 229,
+	416, 226, 435, 458, 459, 460, 462, 360, 246, 396,
+	0, 361, 343, 464, 465, 288, 463, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 0, 0,
+	0, 0, 334, 247, 0, 0, 393, 0, 195, 0,
+	437, 234, 344, 341, 470, 262, 253, 249, 233, 289,
+	351, 391, 457, 385, 0, 338, 0, 0, 447, 364,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 295, 232, 297, 194, 376,
+	448, 266, 0, 0, 0, 0, 186, 187, 188, 0,
+	1135, 0, 0, 0, 0, 0, 0, 0, 0, 223,
+	0, 230, 319, 328, 327, 308, 309, 311, 313, 318,
+	325, 331, 0, 0, 0, 0, 0, 245, 293, 252,
+	244, 467, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 255, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 365, 237, 0, 0, 0,
+	0, 503, 0, 0, 0, 0, 0, 0, 0, 333,
+	0, 302, 190, 210, 0, 0, 375, 418, 424, 0,
+	0, 0, 235, 0, 422, 389, 486, 218, 264, 415,
+	395, 420, 402, 267, 0, 0, 421, 339, 472, 409,
+	483, 504, 505, 243, 369, 494, 461, 500, 508, 211,
+	240, 383, 454, 489, 444, 362, 468, 469, 301, 443,
+	274, 193, 200, 337, 209, 430, 227, 216, 474, 492,
+	269, 413, 201, 456, 481, 224, 434, 0, 0, 510,
+	203, 479, 453, 358, 298, 299, 202, 0, 414, 248,
+	272, 238, 378, 476, 477, 236, 511, 213, 499, 205,
+	0, 498, 371, 471, 480, 359, 349, 204, 478, 357,
+	348, 304, 323, 324, 260, 283, 407, 342, 408, 284,
+	367, 366, 368, 196, 490, 0, 197, 0, 449, 491,
+	512, 219, 220, 222, 0, 259, 263, 271, 273, 279,
+	280, 287, 335, 382, 406, 404, 410, 0, 466, 484,
+	495, 502, 507, 370, 286, 445, 303, 340, 0, 0,
+	388, 423, 225, 488, 446, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 514, 515, 516,
+	517, 518, 519, 520, 521, 522, 523, 524, 525, 526,
+	527, 528, 529, 530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 350, 436, 485, 305, 317,
+	320, 310, 329, 0, 330, 306, 307, 312, 314, 315,
+	316, 321, 322, 326, 332, 0, 189, 206, 336, 0,
+	411, 268, 509, 497, 493, 0, 208, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 192, 198, 207, 217, 221, 228, 241, 256, 258,
+	265, 277, 282, 285, 290, 291, 294, 300, 346, 352,
+	353, 354, 355, 372, 373, 374, 377, 380, 381, 384,
+	386, 387, 390, 394, 398, 399, 400, 401, 403, 405,
+	412, 417, 425, 426, 427, 428, 429, 432, 433, 438,
+	439, 440, 441, 442, 450, 451, 455, 473, 475, 487,
+	501, 506, 431, 278, 482, 0, 345, 0, 0, 347,
+	261, 281, 292, 0, 496, 452, 212, 419, 270, 199,
+	231, 215, 239, 254, 257, 296, 356, 363, 392, 397,
+	275, 251, 229, 416, 226, 435, 458, 459, 460, 462,
+	360, 246, 396, 0, 361, 343, 464, 465, 288, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 0, 0, 0, 0, 334, 247, 0, 0, 393,
+	0, 195, 0, 437, 234, 344, 341, 470, 262, 253,
+	249, 233, 289, 351, 391, 457, 385, 0, 338, 0,
+	0, 447, 364, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 295, 232,
+	297, 194, 376, 448, 266, 0, 0, 0, 0, 186,
+	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 223, 0, 230, 319, 328, 327, 308, 309,
+	311, 313, 318, 325, 331, 0, 0, 0, 0, 0,
+	245, 293, 252, 244, 467, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 255, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 1430, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 0, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 1330, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 371, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 1132, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
+	0, 0, 0, 0, 0, 0, 276, 0, 365, 237,
+	0, 0, 0, 0, 503, 0, 0, 0, 0, 0,
+	0, 0, 333, 0, 302, 190, 210, 0, 0, 375,
+	418, 424, 0, 0, 0, 235, 0, 422, 389, 486,
+	218, 264, 415, 395, 420, 402, 267, 0, 0, 421,
+	339, 472, 409, 483, 504, 505, 243, 369, 494, 461,
+	500, 508, 211, 240, 383, 454, 489, 444, 362, 468,
+	469, 301, 443, 274, 193, 200, 337, 209, 430, 227,
+	216, 474, 492, 269, 413, 201, 456, 481, 224, 434,
+	0, 0, 510, 203, 479, 453, 358, 298, 299, 202,
+	0, 414, 248, 272, 238, 378, 476, 477, 236, 511,
+	213, 499, 205, 0, 498, 371, 471, 480, 359, 349,
+	204, 478, 357, 348, 304, 323, 324, 260, 283, 407,
+	342, 408, 284, 367, 366, 368, 196, 490, 0, 197,
+	0, 449, 491, 512, 219, 220, 222, 0, 259, 263,
+	271, 273, 279, 280, 287, 335, 382, 406, 404, 410,
+	0, 466, 484, 495, 502, 507, 370, 286, 445, 303,
+	340, 0, 0, 388, 423, 225, 488, 446, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
 	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	755, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 187, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 578, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 577,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	360, 342, 463, 464, 287, 462, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
-	0, 333, 246, 0, 0, 392, 0, 195, 0, 436,
-	233, 343, 340, 469, 261, 252, 248, 232, 288, 350,
-	390, 456, 384, 0, 337, 0, 0, 446, 363, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 294, 231, 296, 194, 375, 447,
-	265, 0, 0, 0, 0, 186, 187, 188, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
-	229, 318, 327, 326, 307, 308, 310, 312, 317, 324,
-	330, 0, 0, 0, 0, 0, 244, 292, 251, 243,
-	466, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 0, 364, 236, 0, 0, 533, 0,
-	502, 0, 0, 0, 0, 0, 0, 0, 332, 0,
-	301, 190, 209, 0, 0, 374, 417, 423, 0, 0,
-	0, 234, 0, 421, 388, 485, 217, 263, 414, 394,
-	419, 401, 266, 0, 0, 420, 338, 471, 408, 482,
-	503, 504, 242, 368, 493, 460, 499, 507, 210, 239,
-	382, 453, 488, 443, 361, 467, 468, 300, 442, 273,
-	193, 336, 208, 429, 226, 215, 473, 491, 268, 412,
-	200, 455, 480, 223, 433, 0, 0, 509, 202, 478,
-	452, 357, 297, 298, 201, 0, 413, 247, 271, 237,
-	377, 475, 476, 235, 510, 212, 498, 204, 0, 497,
-	370, 470, 479, 358, 348, 203, 477, 356, 347, 303,
-	322, 323, 259, 282, 406, 341, 407, 283, 366, 365,
-	367, 196, 489, 0, 197, 0, 448, 490, 511, 218,
-	219, 221, 0, 258, 262, 270, 272, 278, 279, 286,
-	334, 381, 405, 403, 409, 0, 465, 483, 494, 501,
-	506, 369, 285, 444, 302, 339, 0, 0, 387, 422,
-	224, 487, 445, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 513, 514, 515, 516, 517,
+	524, 525, 526, 527, 528, 529, 530, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 531, 350, 436,
+	485, 305, 317, 320, 310, 329, 0, 330, 306, 307,
+	312, 314, 315, 316, 321, 322, 326, 332, 0, 189,
+	206, 336, 0, 411, 268, 509, 497, 493, 0, 208,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 756,
+	0, 0, 0, 191, 192, 198, 207, 217, 221, 228,
+	241, 256, 258, 265, 277, 282, 285, 290, 291, 294,
+	300, 346, 352, 353, 354, 355, 372, 373, 374, 377,
+	380, 381, 384, 386, 387, 390, 394, 398, 399, 400,
+	401, 403, 405, 412, 417, 425, 426, 427, 428, 429,
+	432, 433, 438, 439, 440, 441, 442, 450, 451, 455,
+	473, 475, 487, 501, 506, 431, 278, 482, 0, 345,
+	0, 0, 347, 261, 281, 292, 0, 496, 452, 212,
+	419, 270, 199, 231, 215, 239, 254, 257, 296, 356,
+	363, 392, 397, 275, 251, 229, 416, 226, 435, 458,
+	459, 460, 462, 360, 246, 396, 0, 361, 343, 464,
+	465, 288, 463, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 0, 0, 0, 0, 334, 247,
+	0, 0, 393, 0, 195, 0, 437, 234, 344, 341,
+	470, 262, 253, 249, 233, 289, 351, 391, 457, 385,
+	0, 338, 0, 0, 447, 364, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 295, 232, 297, 194, 376, 448, 266, 0, 0,
+	0, 0, 186, 187, 188, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 223, 0, 230, 319, 328,
+	327, 308, 309, 311, 313, 318, 325, 331, 0, 0,
+	0, 0, 0, 245, 293, 252, 244, 467, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 579, 0, 276,
+	0, 365, 237, 0, 0, 0, 0, 503, 0, 0,
+	0, 0, 0, 0, 0, 333, 0, 302, 190, 210,
+	0, 0, 375, 418, 424, 0, 0, 0, 235, 0,
+	422, 389, 486, 218, 264, 415, 395, 420, 402, 267,
+	0, 0, 421, 339, 472, 409, 483, 504, 505, 243,
+	369, 494, 461, 500, 508, 211, 240, 383, 454, 489,
+	444, 362, 468, 469, 301, 443, 274, 193, 200, 337,
+	209, 430, 227, 216, 474, 492, 269, 413, 201, 456,
+	481, 224, 434, 0, 0, 510, 203, 479, 453, 358,
+	298, 299, 202, 0, 414, 248, 272, 238, 378, 476,
+	477, 236, 511, 213, 499, 205, 0, 498, 371, 471,
+	480, 359, 349, 204, 478, 357, 348, 304, 323, 324,
+	260, 283, 407, 342, 408, 284, 367, 366, 368, 196,
+	490, 0, 197, 0, 449, 491, 512, 219, 220, 222,
+	0, 259, 263, 271, 273, 279, 280, 287, 335, 382,
+	406, 404, 410, 0, 466, 484, 495, 502, 507, 370,
+	286, 445, 303, 340, 0, 0, 388, 423, 225, 488,
+	446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 513, 514, 515, 516, 517, 518, 519, 520,
+	521, 522, 523, 524, 525, 526, 527, 528, 529, 530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 350, 436, 485, 305, 317, 320, 310, 329, 0,
+	330, 306, 307, 312, 314, 315, 316, 321, 322, 326,
+	332, 0, 189, 206, 336, 0, 411, 268, 509, 497,
+	493, 0, 208, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 192, 198, 207,
+	217, 221, 228, 241, 256, 258, 265, 277, 282, 285,
+	290, 291, 294, 300, 346, 352, 353, 354, 355, 372,
+	373, 374, 377, 380, 381, 384, 386, 387, 390, 394,
+	398, 399, 400, 401, 403, 405, 412, 417, 425, 426,
+	427, 428, 429, 432, 433, 438, 439, 440, 441, 442,
+	450, 451, 455, 473, 475, 487, 501, 506, 431, 578,
+	482, 0, 345, 0, 0, 347, 261, 281, 292, 0,
+	496, 452, 212, 419, 270, 199, 231, 215, 239, 254,
+	257, 296, 356, 363, 392, 397, 275, 251, 229, 416,
+	226, 435, 458, 459, 460, 462, 360, 246, 396, 0,
+	361, 343, 464, 465, 288, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 0, 0, 0,
+	0, 334, 247, 0, 0, 393, 0, 195, 0, 437,
+	234, 344, 341, 470, 262, 253, 249, 233, 289, 351,
+	391, 457, 385, 0, 338, 0, 0, 447, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 295, 232, 297, 194, 376, 448,
+	266, 0, 0, 0, 0, 186, 187, 188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 223, 0,
+	230, 319, 328, 327, 308, 309, 311, 313, 318, 325,
+	331, 0, 0, 0, 0, 0, 245, 293, 252, 244,
+	467, 0, 0, 0, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 365, 237, 0, 0, 534, 0,
+	503, 0, 0, 0, 0, 0, 0, 0, 333, 0,
+	302, 190, 210, 0, 0, 375, 418, 424, 0, 0,
+	0, 235, 0, 422, 389, 486, 218, 264, 415, 395,
+	420, 402, 267, 0, 0, 421, 339, 472, 409, 483,
+	504, 505, 243, 369, 494, 461, 500, 508, 211, 240,
+	383, 454, 489, 444, 362, 468, 469, 301, 443, 274,
+	193, 200, 337, 209, 430, 227, 216, 474, 492, 269,
+	413, 201, 456, 481, 224, 434, 0, 0, 510, 203,
+	479, 453, 358, 298, 299, 202, 0, 414, 248, 272,
+	238, 378, 476, 477, 236, 511, 213, 499, 205, 0,
+	498, 371, 471, 480, 359, 349, 204, 478, 357, 348,
+	304, 323, 324, 260, 283, 407, 342, 408, 284, 367,
+	366, 368, 196, 490, 0, 197, 0, 449, 491, 512,
+	219, 220, 222, 0, 259, 263, 271, 273, 279, 280,
+	287, 335, 382, 406, 404, 410, 0, 466, 484, 495,
+	502, 507, 370, 286, 445, 303, 340, 0, 0, 388,
+	423, 225, 488, 446, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 513, 514, 515, 516, 517,
 	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 529, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 530, 349, 435, 484, 304, 316, 319, 309,
-	328, 0, 329, 305, 306, 311, 313, 314, 315, 320,
-	321, 325, 331, 0, 189, 205, 335, 0, 410, 267,
-	508, 496, 492, 0, 207, 0, 241, 0, 0, 0,
+	528, 529, 530, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 350, 436, 485, 305, 317, 320,
+	310, 329, 0, 330, 306, 307, 312, 314, 315, 316,
+	321, 322, 326, 332, 0, 189, 206, 336, 0, 411,
+	268, 509, 497, 493, 0, 208, 0, 242, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	198, 206, 216, 220, 227, 240, 255, 257, 264, 276,
-	281, 284, 289, 290, 293, 299, 345, 351, 352, 353,
-	354, 371, 372, 373, 376, 379, 380, 383, 385, 386,
-	389, 393, 397, 398, 399, 400, 402, 404, 411, 416,
-	424, 425, 426, 427, 428, 431, 432, 437, 438, 439,
-	440, 441, 449, 450, 454, 472, 474, 486, 500, 505,
-	430, 277, 481, 0, 344, 0, 0, 346, 260, 280,
-	291, 0, 495, 451, 211, 418, 269, 199, 230, 214,
-	238, 253, 256, 295, 355, 362, 391, 396, 274, 250,
-	228, 415, 225, 434, 457, 458, 459, 461, 359, 245,
-	395, 0, 360, 342, 463, 464, 287, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 333, 246, 0, 0, 392, 0, 195,
-	0, 436, 233, 343, 340, 469, 261, 252, 248, 232,
-	288, 350, 390, 456, 384, 0, 337, 0, 0, 446,
-	363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 294, 231, 296, 194,
-	375, 447, 265, 0, 0, 0, 0, 186, 187, 188,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	222, 0, 229, 318, 327, 326, 307, 308, 310, 312,
-	317, 324, 330, 0, 0, 0, 0, 0, 244, 292,
-	251, 243, 466, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 0, 364, 236, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 301, 190, 209, 0, 0, 374, 417, 423,
-	0, 0, 0, 234, 0, 421, 388, 485, 217, 263,
-	414, 394, 419, 401, 266, 0, 0, 420, 338, 471,
-	408, 482, 503, 504, 242, 368, 493, 460, 499, 507,
-	210, 239, 382, 453, 488, 443, 361, 467, 468, 300,
-	442, 273, 193, 336, 208, 429, 226, 215, 473, 491,
-	268, 412, 200, 455, 480, 223, 433, 0, 0, 509,
-	202, 478, 452, 357, 297, 298, 201, 0, 413, 247,
-	271, 237, 377, 475, 476, 235, 510, 212, 498, 204,
-	0, 497, 370, 470, 479, 358, 348, 203, 477, 356,
-	347, 303, 322, 323, 259, 282, 406, 341, 407, 283,
-	366, 365, 367, 196, 489, 0, 197, 0, 448, 490,
-	511, 218, 219, 221, 0, 258, 262, 270, 272, 278,
-	279, 286, 334, 381, 405, 403, 409, 0, 465, 483,
-	494, 501, 506, 369, 285, 444, 302, 339, 0, 0,
-	387, 422, 224, 487, 445, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
-	526, 527, 528, 529, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 530, 349, 435, 484, 304, 316,
-	319, 309, 328, 0, 329, 305, 306, 311, 313, 314,
-	315, 320, 321, 325, 331, 0, 189, 205, 335, 0,
-	410, 267, 508, 496, 492, 0, 207, 0, 241, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 198, 206, 216, 220, 227, 240, 255, 257,
-	264, 276, 281, 284, 289, 290, 293, 299, 345, 351,
-	352, 353, 354, 2918, 372, 373, 376, 379, 380, 383,
-	385, 386, 389, 393, 397, 398, 399, 400, 402, 404,
-	411, 416, 424, 425, 426, 427, 428, 431, 432, 437,
-	438, 439, 440, 441, 449, 450, 454, 472, 474, 486,
-	500, 505, 430, 277, 481, 0, 344, 0, 0, 346,
-	260, 280, 291, 0, 495, 451, 211, 418, 269, 199,
-	230, 214, 238, 253, 256, 295, 355, 362, 391, 396,
-	274, 250, 228, 415, 225, 434, 457, 458, 459, 461,
-	359, 245, 395, 0, 360, 342, 463, 464, 287, 462,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 333, 246, 0, 0, 392,
-	0, 195, 0, 436, 233, 343, 340, 469, 261, 252,
-	248, 232, 288, 350, 390, 456, 384, 0, 337, 0,
-	0, 446, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 294, 231,
-	296, 194, 375, 447, 265, 0, 0, 0, 0, 186,
-	187, 188, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 222, 0, 229, 318, 327, 326, 307, 308,
-	310, 312, 317, 324, 330, 0, 0, 0, 0, 0,
-	244, 292, 251, 243, 466, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	192, 198, 207, 217, 221, 228, 241, 256, 258, 265,
+	277, 282, 285, 290, 291, 294, 300, 346, 352, 353,
+	354, 355, 372, 373, 374, 377, 380, 381, 384, 386,
+	387, 390, 394, 398, 399, 400, 401, 403, 405, 412,
+	417, 425, 426, 427, 428, 429, 432, 433, 438, 439,
+	440, 441, 442, 450, 451, 455, 473, 475, 487, 501,
+	506, 431, 278, 482, 0, 345, 0, 0, 347, 261,
+	281, 292, 0, 496, 452, 212, 419, 270, 199, 231,
+	215, 239, 254, 257, 296, 356, 363, 392, 397, 275,
+	251, 229, 416, 226, 435, 458, 459, 460, 462, 360,
+	246, 396, 0, 361, 343, 464, 465, 288, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	0, 0, 0, 0, 334, 247, 0, 0, 393, 0,
+	195, 0, 437, 234, 344, 341, 470, 262, 253, 249,
+	233, 289, 351, 391, 457, 385, 0, 338, 0, 0,
+	447, 364, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 232, 297,
+	194, 376, 448, 266, 0, 0, 0, 0, 186, 187,
+	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 223, 0, 230, 319, 328, 327, 308, 309, 311,
+	313, 318, 325, 331, 0, 0, 0, 0, 0, 245,
+	293, 252, 244, 467, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -5563,189 +5536,244 @@ var mysqAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 0, 364, 236,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 301, 190, 209, 0, 0, 374,
-	417, 423, 0, 0, 0, 234, 0, 421, 388, 485,
-	217, 263, 414, 394, 419, 401, 266, 0, 0, 420,
-	338, 471, 408, 482, 503, 504, 242, 368, 493, 460,
-	499, 507, 210, 239, 382, 453, 488, 443, 361, 467,
-	468, 300, 442, 273, 193, 336, 208, 429, 226, 215,
-	473, 491, 268, 412, 200, 455, 480, 223, 433, 0,
-	0, 509, 202, 478, 452, 357, 297, 298, 201, 0,
-	413, 247, 271, 237, 377, 475, 476, 235, 510, 212,
-	498, 204, 0, 497, 370, 470, 479, 358, 348, 203,
-	477, 356, 347, 303, 322, 323, 259, 282, 406, 341,
-	407, 283, 366, 365, 367, 196, 489, 0, 197, 0,
-	448, 490, 511, 218, 219, 221, 0, 258, 262, 270,
-	272, 278, 279, 286, 334, 381, 405, 403, 409, 0,
-	465, 483, 494, 501, 506, 369, 285, 444, 302, 339,
-	0, 0, 387, 422, 224, 487, 445, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 513,
-	514, 515, 516, 517, 518, 519, 520, 521, 522, 523,
-	524, 525, 526, 527, 528, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 530, 349, 435, 484,
-	304, 316, 319, 309, 328, 0, 329, 305, 306, 311,
-	313, 314, 315, 320, 321, 325, 331, 0, 189, 205,
-	335, 0, 410, 267, 508, 496, 492, 0, 207, 0,
-	241, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 198, 206, 216, 220, 227, 240,
-	255, 257, 264, 276, 281, 284, 289, 290, 293, 299,
-	345, 351, 352, 353, 354, 371, 372, 373, 376, 379,
-	380, 383, 385, 386, 389, 393, 397, 398, 399, 400,
-	402, 404, 411, 416, 424, 425, 426, 427, 428, 431,
-	432, 437, 438, 439, 440, 441, 449, 450, 454, 472,
-	474, 486, 500, 505, 430, 277, 481, 0, 344, 0,
-	0, 346, 260, 280, 291, 0, 495, 451, 211, 418,
-	269, 199, 230, 214, 238, 253, 256, 295, 355, 362,
-	391, 396, 274, 250, 228, 415, 225, 434, 457, 458,
-	459, 461, 359, 245, 395, 0, 360, 342, 463, 464,
-	287, 462, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 333, 246, 0,
-	0, 392, 0, 195, 0, 436, 233, 343, 340, 469,
-	261, 252, 248, 232, 288, 350, 390, 456, 384, 0,
-	337, 0, 0, 446, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	294, 231, 296, 194, 375, 447, 265, 0, 0, 0,
-	0, 186, 2544, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 222, 0, 229, 318, 327, 326,
-	307, 308, 310, 312, 317, 324, 330, 0, 0, 0,
-	0, 0, 244, 292, 251, 243, 466, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 0,
-	364, 236, 0, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 301, 190, 209, 0,
-	0, 374, 417, 423, 0, 0, 0, 234, 0, 421,
-	388, 485, 217, 263, 414, 394, 419, 401, 266, 0,
-	0, 420, 338, 471, 408, 482, 503, 504, 242, 368,
-	493, 460, 499, 507, 210, 239, 382, 453, 488, 443,
-	361, 467, 468, 300, 442, 273, 193, 336, 208, 429,
-	226, 215, 473, 491, 268, 412, 200, 455, 480, 223,
-	433, 0, 0, 509, 202, 478, 452, 357, 297, 298,
-	201, 0, 413, 247, 271, 237, 377, 475, 476, 235,
-	510, 212, 498, 204, 0, 497, 370, 470, 479, 358,
-	348, 203, 477, 356, 347, 303, 322, 323, 259, 282,
-	406, 341, 407, 283, 366, 365, 367, 196, 489, 0,
-	197, 0, 448, 490, 511, 218, 219, 221, 0, 258,
-	262, 270, 272, 278, 279, 286, 334, 381, 405, 403,
-	409, 0, 465, 483, 494, 501, 506, 369, 285, 444,
-	302, 339, 0, 0, 387, 422, 224, 487, 445, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 349,
-	435, 484, 304, 316, 319, 309, 328, 0, 329, 305,
-	306, 311, 313, 314, 315, 320, 321, 325, 331, 0,
-	189, 205, 335, 0, 410, 267, 508, 496, 492, 0,
-	207, 0, 241, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 198, 206, 216, 220,
-	227, 240, 255, 257, 264, 276, 281, 284, 289, 290,
-	293, 299, 345, 351, 352, 353, 354, 371, 372, 373,
-	376, 379, 380, 383, 385, 386, 389, 393, 397, 398,
-	399, 400, 402, 404, 411, 416, 424, 425, 426, 427,
-	428, 431, 432, 437, 438, 439, 440, 441, 449, 450,
-	454, 472, 474, 486, 500, 505, 430, 277, 481, 0,
-	344, 0, 0, 346, 260, 280, 291, 0, 495, 451,
-	211, 418, 269, 199, 230, 214, 238, 253, 256, 295,
-	355, 362, 391, 396, 274, 250, 228, 415, 225, 434,
-	457, 458, 459, 461, 359, 245, 395, 0, 360, 342,
-	463, 464, 287, 462, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 333,
-	246, 0, 0, 392, 0, 195, 0, 436, 233, 343,
-	340, 469, 261, 252, 248, 232, 288, 350, 390, 456,
-	384, 0, 337, 0, 0, 446, 363, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 294, 231, 296, 194, 375, 447, 265, 0,
-	0, 0, 0, 186, 2065, 188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 222, 0, 229, 318,
-	327, 326, 307, 308, 310, 312, 317, 324, 330, 0,
-	0, 0, 0, 0, 244, 292, 251, 243, 466, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 0, 364, 236, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 301, 190,
-	209, 0, 0, 374, 417, 423, 0, 0, 0, 234,
-	0, 421, 388, 485, 217, 263, 414, 394, 419, 401,
-	266, 0, 0, 420, 338, 471, 408, 482, 503, 504,
-	242, 368, 493, 460, 499, 507, 210, 239, 382, 453,
-	488, 443, 361, 467, 468, 300, 442, 273, 193, 336,
-	208, 429, 226, 215, 473, 491, 268, 412, 200, 455,
-	480, 223, 433, 0, 0, 509, 202, 478, 452, 357,
-	297, 298, 201, 0, 413, 247, 271, 237, 377, 475,
-	476, 235, 510, 212, 498, 204, 0, 497, 370, 470,
-	479, 358, 348, 203, 477, 356, 347, 303, 322, 323,
-	259, 282, 406, 341, 407, 283, 366, 365, 367, 196,
-	489, 0, 197, 0, 448, 490, 511, 218, 219, 221,
-	0, 258, 262, 270, 272, 278, 279, 286, 334, 381,
-	405, 403, 409, 0, 465, 483, 494, 501, 506, 369,
-	285, 444, 302, 339, 0, 0, 387, 422, 224, 487,
-	445, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 513, 514, 515, 516, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 529,
+	0, 0, 0, 0, 0, 276, 0, 365, 237, 0,
+	0, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 333, 0, 302, 190, 210, 0, 0, 375, 418,
+	424, 0, 0, 0, 235, 0, 422, 389, 486, 218,
+	264, 415, 395, 420, 402, 267, 0, 0, 421, 339,
+	472, 409, 483, 504, 505, 243, 369, 494, 461, 500,
+	508, 211, 240, 383, 454, 489, 444, 362, 468, 469,
+	301, 443, 274, 193, 200, 337, 209, 430, 227, 216,
+	474, 492, 269, 413, 201, 456, 481, 224, 434, 0,
+	0, 510, 203, 479, 453, 358, 298, 299, 202, 0,
+	414, 248, 272, 238, 378, 476, 477, 236, 511, 213,
+	499, 205, 0, 498, 371, 471, 480, 359, 349, 204,
+	478, 357, 348, 304, 323, 324, 260, 283, 407, 342,
+	408, 284, 367, 366, 368, 196, 490, 0, 197, 0,
+	449, 491, 512, 219, 220, 222, 0, 259, 263, 271,
+	273, 279, 280, 287, 335, 382, 406, 404, 410, 0,
+	466, 484, 495, 502, 507, 370, 286, 445, 303, 340,
+	0, 0, 388, 423, 225, 488, 446, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 528, 529, 530, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 350, 436, 485,
+	305, 317, 320, 310, 329, 0, 330, 306, 307, 312,
+	314, 315, 316, 321, 322, 326, 332, 0, 189, 206,
+	336, 0, 411, 268, 509, 497, 493, 0, 208, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 192, 198, 207, 217, 221, 228, 241,
+	256, 258, 265, 277, 282, 285, 290, 291, 294, 300,
+	346, 352, 353, 354, 355, 2944, 373, 374, 377, 380,
+	381, 384, 386, 387, 390, 394, 398, 399, 400, 401,
+	403, 405, 412, 417, 425, 426, 427, 428, 429, 432,
+	433, 438, 439, 440, 441, 442, 450, 451, 455, 473,
+	475, 487, 501, 506, 431, 278, 482, 0, 345, 0,
+	0, 347, 261, 281, 292, 0, 496, 452, 212, 419,
+	270, 199, 231, 215, 239, 254, 257, 296, 356, 363,
+	392, 397, 275, 251, 229, 416, 226, 435, 458, 459,
+	460, 462, 360, 246, 396, 0, 361, 343, 464, 465,
+	288, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 0, 0, 0, 0, 334, 247, 0,
+	0, 393, 0, 195, 0, 437, 234, 344, 341, 470,
+	262, 253, 249, 233, 289, 351, 391, 457, 385, 0,
+	338, 0, 0, 447, 364, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 232, 297, 194, 376, 448, 266, 0, 0, 0,
+	0, 186, 187, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 223, 0, 230, 319, 328, 327,
+	308, 309, 311, 313, 318, 325, 331, 0, 0, 0,
+	0, 0, 245, 293, 252, 244, 467, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	365, 237, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 333, 0, 302, 190, 210, 0,
+	0, 375, 418, 424, 0, 0, 0, 235, 0, 422,
+	389, 486, 218, 264, 415, 395, 420, 402, 267, 0,
+	0, 421, 339, 472, 409, 483, 504, 505, 243, 369,
+	494, 461, 500, 508, 211, 240, 383, 454, 489, 444,
+	362, 468, 469, 301, 443, 274, 193, 200, 337, 209,
+	430, 227, 216, 474, 492, 269, 413, 201, 456, 481,
+	224, 434, 0, 0, 510, 203, 479, 453, 358, 298,
+	299, 202, 0, 414, 248, 272, 238, 378, 476, 477,
+	236, 511, 213, 499, 205, 0, 498, 371, 471, 480,
+	359, 349, 204, 478, 357, 348, 304, 323, 324, 260,
+	283, 407, 342, 408, 284, 367, 366, 368, 196, 490,
+	0, 197, 0, 449, 491, 512, 219, 220, 222, 0,
+	259, 263, 271, 273, 279, 280, 287, 335, 382, 406,
+	404, 410, 0, 466, 484, 495, 502, 507, 370, 286,
+	445, 303, 340, 0, 0, 388, 423, 225, 488, 446,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 514, 515, 516, 517, 518, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	350, 436, 485, 305, 317, 320, 310, 329, 0, 330,
+	306, 307, 312, 314, 315, 316, 321, 322, 326, 332,
+	0, 189, 206, 336, 0, 411, 268, 509, 497, 493,
+	0, 208, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 192, 198, 207, 217,
+	221, 228, 241, 256, 258, 265, 277, 282, 285, 290,
+	291, 294, 300, 346, 352, 353, 354, 355, 372, 373,
+	374, 377, 380, 381, 384, 386, 387, 390, 394, 398,
+	399, 400, 401, 403, 405, 412, 417, 425, 426, 427,
+	428, 429, 432, 433, 438, 439, 440, 441, 442, 450,
+	451, 455, 473, 475, 487, 501, 506, 431, 278, 482,
+	0, 345, 0, 0, 347, 261, 281, 292, 0, 496,
+	452, 212, 419, 270, 199, 231, 215, 239, 254, 257,
+	296, 356, 363, 392, 397, 275, 251, 229, 416, 226,
+	435, 458, 459, 460, 462, 360, 246, 396, 0, 361,
+	343, 464, 465, 288, 463, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 0, 0, 0, 0,
+	334, 247, 0, 0, 393, 0, 195, 0, 437, 234,
+	344, 341, 470, 262, 253, 249, 233, 289, 351, 391,
+	457, 385, 0, 338, 0, 0, 447, 364, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 295, 232, 297, 194, 376, 448, 266,
+	0, 0, 0, 0, 186, 2557, 188, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 223, 0, 230,
+	319, 328, 327, 308, 309, 311, 313, 318, 325, 331,
+	0, 0, 0, 0, 0, 245, 293, 252, 244, 467,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 365, 237, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 333, 0, 302,
+	190, 210, 0, 0, 375, 418, 424, 0, 0, 0,
+	235, 0, 422, 389, 486, 218, 264, 415, 395, 420,
+	402, 267, 0, 0, 421, 339, 472, 409, 483, 504,
+	505, 243, 369, 494, 461, 500, 508, 211, 240, 383,
+	454, 489, 444, 362, 468, 469, 301, 443, 274, 193,
+	200, 337, 209, 430, 227, 216, 474, 492, 269, 413,
+	201, 456, 481, 224, 434, 0, 0, 510, 203, 479,
+	453, 358, 298, 299, 202, 0, 414, 248, 272, 238,
+	378, 476, 477, 236, 511, 213, 499, 205, 0, 498,
+	371, 471, 480, 359, 349, 204, 478, 357, 348, 304,
+	323, 324, 260, 283, 407, 342, 408, 284, 367, 366,
+	368, 196, 490, 0, 197, 0, 449, 491, 512, 219,
+	220, 222, 0, 259, 263, 271, 273, 279, 280, 287,
+	335, 382, 406, 404, 410, 0, 466, 484, 495, 502,
+	507, 370, 286, 445, 303, 340, 0, 0, 388, 423,
+	225, 488, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 350, 436, 485, 305, 317, 320, 310,
+	329, 0, 330, 306, 307, 312, 314, 315, 316, 321,
+	322, 326, 332, 0, 189, 206, 336, 0, 411, 268,
+	509, 497, 493, 0, 208, 0, 242, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 349, 435, 484, 304, 316, 319, 309, 328, 0,
-	329, 305, 306, 311, 313, 314, 315, 320, 321, 325,
-	331, 0, 189, 205, 335, 0, 410, 267, 508, 496,
-	492, 0, 207, 0, 241, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 198, 206,
-	216, 220, 227, 240, 255, 257, 264, 276, 281, 284,
-	289, 290, 293, 299, 345, 351, 352, 353, 354, 371,
-	372, 373, 376, 379, 380, 383, 385, 386, 389, 393,
-	397, 398, 399, 400, 402, 404, 411, 416, 424, 425,
-	426, 427, 428, 431, 432, 437, 438, 439, 440, 441,
-	449, 450, 454, 472, 474, 486, 500, 505, 430, 277,
-	481, 0, 344, 0, 0, 346, 260, 280, 291, 0,
-	495, 451, 211, 418, 269, 199, 230, 214, 238, 253,
-	256, 295, 355, 362, 391, 396, 274, 250, 228, 415,
-	225, 434, 457, 458, 459, 461, 359, 245, 395, 0,
-	0, 342, 463, 464, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
+	198, 207, 217, 221, 228, 241, 256, 258, 265, 277,
+	282, 285, 290, 291, 294, 300, 346, 352, 353, 354,
+	355, 372, 373, 374, 377, 380, 381, 384, 386, 387,
+	390, 394, 398, 399, 400, 401, 403, 405, 412, 417,
+	425, 426, 427, 428, 429, 432, 433, 438, 439, 440,
+	441, 442, 450, 451, 455, 473, 475, 487, 501, 506,
+	431, 278, 482, 0, 345, 0, 0, 347, 261, 281,
+	292, 0, 496, 452, 212, 419, 270, 199, 231, 215,
+	239, 254, 257, 296, 356, 363, 392, 397, 275, 251,
+	229, 416, 226, 435, 458, 459, 460, 462, 360, 246,
+	396, 0, 361, 343, 464, 465, 288, 463, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 0,
+	0, 0, 0, 334, 247, 0, 0, 393, 0, 195,
+	0, 437, 234, 344, 341, 470, 262, 253, 249, 233,
+	289, 351, 391, 457, 385, 0, 338, 0, 0, 447,
+	364, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 295, 232, 297, 194,
+	376, 448, 266, 0, 0, 0, 0, 186, 2072, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	223, 0, 230, 319, 328, 327, 308, 309, 311, 313,
+	318, 325, 331, 0, 0, 0, 0, 0, 245, 293,
+	252, 244, 467, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 365, 237, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	333, 0, 302, 190, 210, 0, 0, 375, 418, 424,
+	0, 0, 0, 235, 0, 422, 389, 486, 218, 264,
+	415, 395, 420, 402, 267, 0, 0, 421, 339, 472,
+	409, 483, 504, 505, 243, 369, 494, 461, 500, 508,
+	211, 240, 383, 454, 489, 444, 362, 468, 469, 301,
+	443, 274, 193, 200, 337, 209, 430, 227, 216, 474,
+	492, 269, 413, 201, 456, 481, 224, 434, 0, 0,
+	510, 203, 479, 453, 358, 298, 299, 202, 0, 414,
+	248, 272, 238, 378, 476, 477, 236, 511, 213, 499,
+	205, 0, 498, 371, 471, 480, 359, 349, 204, 478,
+	357, 348, 304, 323, 324, 260, 283, 407, 342, 408,
+	284, 367, 366, 368, 196, 490, 0, 197, 0, 449,
+	491, 512, 219, 220, 222, 0, 259, 263, 271, 273,
+	279, 280, 287, 335, 382, 406, 404, 410, 0, 466,
+	484, 495, 502, 507, 370, 286, 445, 303, 340, 0,
+	0, 388, 423, 225, 488, 446, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 513, 514, 515,
+	516, 517, 518, 519, 520, 521, 522, 523, 524, 525,
+	526, 527, 528, 529, 530, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 350, 436, 485, 305,
+	317, 320, 310, 329, 0, 330, 306, 307, 312, 314,
+	315, 316, 321, 322, 326, 332, 0, 189, 206, 336,
+	0, 411, 268, 509, 497, 493, 0, 208, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 191, 192, 198, 207, 217, 221, 228, 241, 256,
+	258, 265, 277, 282, 285, 290, 291, 294, 300, 346,
+	352, 353, 354, 355, 372, 373, 374, 377, 380, 381,
+	384, 386, 387, 390, 394, 398, 399, 400, 401, 403,
+	405, 412, 417, 425, 426, 427, 428, 429, 432, 433,
+	438, 439, 440, 441, 442, 450, 451, 455, 473, 475,
+	487, 501, 506, 431, 278, 482, 0, 345, 0, 0,
+	347, 261, 281, 292, 0, 496, 452, 212, 419, 270,
+	199, 231, 215, 239, 254, 257, 296, 356, 363, 392,
+	397, 275, 251, 229, 416, 226, 435, 458, 459, 460,
+	462, 360, 246, 396, 0, 0, 343, 464, 465, 288,
 }
 
 var mysqPact = [...]int{
-	5229, -1000, -403, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1947, 1994, -1000, -1000, -1000,
-	-1000, 2106, -1000, 684, 1692, -1000, 1966, 4942, -1000, 41190,
-	474, -1000, 40006, 473, 120, 41190, -1000, 173, -1000, 163,
-	41190, 169, 39414, -1000, -1000, -301, 17500, 1901, 31, 29,
-	41190, -1000, -1000, -1000, 2077, 1701, -1000, 320, -1000, -1000,
-	-1000, -1000, -1000, -1000, 38822, -1000, -1000, -1000, 1976, 1952,
-	2124, 586, 1902, -1000, 2030, 1701, -1000, 17500, 2064, 2029,
-	16908, -1000, 16908, 418, -1000, -1000, 12754, -1000, -1000, 23430,
-	41190, 29350, 588, -1000, 1966, -1000, -1000, -1000, 84, -1000,
-	302, 1603, -1000, 1599, -1000, 679, 794, 353, 448, 444,
-	352, 351, 350, 349, 347, 335, 334, 332, 359, -1000,
-	598, 598, -153, -155, 3124, 433, 407, 407, 442, 1924,
-	1921, -1000, 646, -1000, 598, 598, 598, 321, 598, 598,
-	598, 598, 275, 274, 598, 598, 598, 598, 598, 598,
-	598, 598, 598, 598, 598, 598, 598, 598, 598, 598,
-	598, 286, 1966, 263, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5563, -1000, -405, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1950, 2006, -1000, -1000, -1000,
+	-1000, 2138, -1000, 740, 1706, -1000, 1961, 6570, -1000, 41412,
+	455, -1000, 40226, 454, 230, 41412, -1000, 157, -1000, 147,
+	41412, 151, 39633, -1000, -1000, -269, 17682, 1908, 25, 21,
+	41412, -1000, -1000, -1000, 2122, 1663, -1000, 341, -1000, -1000,
+	-1000, -1000, -1000, -1000, 39040, -1000, -1000, -1000, 1965, 1954,
+	2151, 604, 1898, -1000, 2028, 1663, -1000, 17682, 2078, 2015,
+	17089, -1000, 17089, 404, -1000, -1000, 12928, -1000, -1000, 23622,
+	41412, 29552, 347, -1000, 1961, -1000, -1000, -1000, 85, -1000,
+	290, 1565, -1000, 1552, -1000, 482, 693, 316, 433, 416,
+	315, 313, 311, 306, 304, 302, 301, 300, 330, -1000,
+	630, 630, -130, -133, 3397, 381, 374, 374, 417, 1933,
+	1932, -1000, 598, -1000, 630, 630, 630, 305, 630, 630,
+	630, 630, 252, 248, 630, 630, 630, 630, 630, 630,
+	630, 630, 630, 630, 630, 630, 630, 630, 630, 630,
+	630, 312, 1961, 220, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -5776,37 +5804,38 @@ var mysqPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 41190, 282, 41190, -1000, 517, 41190, 750, 750, 42,
-	750, 750, 750, 160, 660, 24, -1000, 146, 250, 138,
-	246, 736, 156, -1000, -1000, 125, -1000, 10366, 152, 10366,
-	10366, -1000, 1961, -1000, -1000, -1000, -1000, 1160, -1000, 438,
-	-1000, -1000, -1000, -1000, 41190, 38230, 324, 625, -1000, -1000,
-	-1000, 47, -1000, -1000, 1346, 1034, 17500, 951, -1000, 1224,
-	564, -1000, -1000, -1000, -1000, -1000, 504, 18092, 18092, 18092,
-	18092, -1000, -1000, 1622, 1622, 1622, 1622, 18092, 1622, 18092,
-	1622, 1622, 1622, 1622, 17500, 1622, 1622, 1622, -1000, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 516,
-	1622, 1622, 1622, 1622, 1622, -1000, -1000, -1000, -1000, 1622,
-	1622, 1622, 1622, 1622, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 21057, -1000, 15132, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 41190, -1000, 1622, 41190,
-	41190, 314, 2030, 1701, -1000, 2077, 2038, 320, -1000, 2243,
-	1310, 1249, 881, 1701, 1528, 41190, -1000, 1638, -1000, -1000,
-	-1000, 1795, 1038, 1146, -1000, -1000, -1000, -1000, 1263, 17500,
-	-1000, -1000, 2103, -1000, 20465, 515, 829, 2102, 37638, -1000,
-	418, 418, 1578, 12157, 1, -1000, -1000, -1000, 623, 26982,
+	-1000, -1000, 41412, 166, 41412, -1000, 531, 41412, 772, 772,
+	86, 772, 772, 772, 162, 608, 15, -1000, 146, 213,
+	168, 216, 779, 202, -1000, -1000, 144, -1000, 10536, 156,
+	10536, 10536, -1000, 1956, -1000, -1000, -1000, -1000, 1008, -1000,
+	415, -1000, -1000, -1000, -1000, 41412, 38447, 326, 686, -1000,
+	-1000, -1000, 65, -1000, -1000, 1390, 953, 17682, 1025, -1000,
+	1229, 577, -1000, -1000, -1000, -1000, -1000, 501, 18275, 18275,
+	18275, 18275, -1000, -1000, 1578, 1578, 1578, 1578, 18275, 1578,
+	18275, 1578, 1578, 1578, 1578, 17682, 1578, 1578, 1578, -1000,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	530, 1578, 1578, 1578, 1578, 1578, -1000, -1000, -1000, -1000,
+	1578, 1578, 1578, 1578, 1578, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 21245, -1000, 15310, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1961, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 41412, -1000, 1578,
+	41412, 41412, 325, 2028, 1663, -1000, 2122, 2061, 341, -1000,
+	2220, 1907, 1320, 1187, 1663, 1506, 41412, -1000, 1581, -1000,
+	-1000, -1000, 1776, 1093, 1179, -1000, -1000, -1000, -1000, 1511,
+	17682, -1000, -1000, 2114, -1000, 20652, 523, 846, 2111, 37854,
+	-1000, 404, 404, 1546, 12330, 20, -1000, -1000, -1000, 684,
+	27180, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1956, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -5817,255 +5846,262 @@ var mysqPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1601, 41190, -1000, -1000, 4143, 1108, -1000, 1691,
-	-1000, 1597, -1000, 1661, 17500, 1704, 472, 1108, 461, 460,
-	449, -1000, -79, -1000, -1000, -1000, -1000, -1000, -1000, 598,
-	598, 598, -1000, 342, 2062, 4942, 5856, -1000, -1000, -1000,
-	37046, 1687, 1108, -1000, 1684, -1000, 717, 519, 569, 569,
-	1108, -1000, -1000, 41190, 1108, 714, 711, 1108, 1108, 41190,
-	41190, -1000, 36454, -1000, 35862, 35270, 980, 41190, 34678, 34086,
-	33494, 32902, 32310, -1000, 1931, -1000, 1769, -1000, -1000, -1000,
-	41190, 1108, 1108, 41190, 41190, 323, -1000, -1000, 41190, 1108,
-	-1000, -1000, 974, 965, 963, 598, 598, 962, 1140, 1137,
-	1127, 598, 598, 957, 1125, 28166, 1122, 270, 955, 952,
-	950, 1101, 1121, 209, 1117, 1088, 1052, 936, 41190, 1683,
-	41190, -1000, 231, 735, 615, 1966, 1897, 1573, 432, 468,
-	1108, 410, 410, 41190, -1000, 10963, -1000, -1000, 1116, 17500,
-	-1000, 744, 736, 736, -1000, -1000, -1000, -1000, -1000, 750,
-	41190, 744, -1000, -1000, -1000, 736, 750, 41190, 750, 750,
-	750, 750, 736, 736, 736, 750, 41190, 41190, 41190, 41190,
-	41190, 41190, 41190, 41190, 41190, 10366, -1000, 1794, -1000, -1000,
-	-1000, -1000, 10366, 10366, -1000, -1000, -1000, -1000, 168, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -85, 1561,
-	31718, -1000, -306, -307, -311, -314, -1000, -1000, -1000, -317,
-	-322, -1000, -1000, -1000, 17500, 17500, 17500, 17500, -182, -1000,
-	978, 18092, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 843,
-	702, 18092, 18092, 18092, 18092, 18092, 18092, 18092, 18092, 18092,
-	18092, 18092, 18092, 18092, 18092, 18092, 25206, 6668, 6668, 564,
-	564, 564, 564, -1000, 16908, 17500, 17500, 564, -1000, 1108,
-	31126, 16908, 16908, 17500, 1962, 638, 1034, 41190, -1000, 881,
-	-1000, -1000, -1000, 851, -1000, 41190, 41190, 172, 17500, 17500,
-	17500, 17500, 17500, 17500, 13943, 17500, 17500, 17500, 17500, 17500,
-	17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500,
-	17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500,
-	17500, 13351, 10963, 16908, 16908, 16908, 16908, 16908, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	511, 1781, 1248, 1446, -1000, 1532, -1000, -145, 22838, 17500,
-	2100, 1730, 41190, -1000, -1000, -1000, 2030, -1000, 2030, 1781,
-	2215, 1820, 16908, -1000, -1000, 2215, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1270, -1000, 41190, 1528, 2017, 41190,
-	1815, 1115, 475, -1000, 17500, 17500, 1519, -1000, 1167, 41190,
-	-1000, -182, -1000, 30534, -1000, -1000, 9769, -1000, 41190, 309,
-	41190, -1000, 22246, 29942, 11560, 1, -1000, 11560, 1396, -1000,
-	-21, 11, 14535, 563, -1000, -1000, -1000, 3124, 19276, 1254,
-	563, 88, -1000, -1000, -1000, 1661, -1000, 1661, 1661, 1661,
-	1661, 475, 475, 475, 475, -1000, -1000, -1000, -1000, -1000,
-	1681, 1679, -1000, 1661, 1661, 1661, 1661, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1676, 1676, 1676, 1663, 1663, 402,
-	-1000, 17500, 408, 29350, 1999, 933, 1460, 231, 414, 1727,
-	1108, 1108, 1108, 414, -1000, 1278, 1222, 1055, -1000, -394,
-	1508, -1000, -1000, 2061, -1000, -1000, 737, 771, 754, 801,
-	41190, 188, 305, -1000, 393, -1000, 29350, 1108, 690, 569,
-	1108, -1000, 1108, -1000, -1000, -1000, -1000, -1000, 1108, -1000,
-	-1000, 1490, -1000, 1394, 821, 751, 810, 733, 1490, -1000,
-	-1000, -124, 1490, -1000, 1490, -1000, 1490, -1000, 1490, -1000,
-	1490, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	654, 149, -238, 41190, 188, 25206, -1000, 423, -1000, -1000,
-	-1000, 25206, 25206, -1000, -1000, -1000, -1000, 1111, 1110, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1573, 41412, -1000, -1000, 1393, 1111, -1000,
+	1705, -1000, 1569, -1000, 1618, 17682, 1715, 453, 1111, 446,
+	444, 427, -1000, -59, -1000, -1000, -1000, -1000, -1000, -1000,
+	630, 630, 630, -1000, 323, 2076, 6570, 3689, -1000, -1000,
+	-1000, 37261, 1702, 1111, -1000, 1700, -1000, 745, 478, 522,
+	522, 1111, -1000, -1000, 41412, 1111, 744, 742, 1111, 1111,
+	41412, 41412, -1000, 36668, -1000, 36075, 35482, 1014, 41412, 34889,
+	34296, 33703, 33110, 32517, -1000, 1799, -1000, 1790, -1000, -1000,
+	-1000, 41412, 1111, 1111, 41412, 41412, 308, -1000, -1000, 41412,
+	1111, -1000, -1000, 1012, 1005, 993, 630, 630, 992, 1175,
+	1174, 1173, 630, 630, 989, 1171, 28366, 1165, 238, 986,
+	979, 976, 1039, 1162, 188, 1161, 1033, 1030, 973, 41412,
+	1698, 41412, -1000, 199, 655, 681, 1961, 1905, 1544, 414,
+	450, 1111, 384, 384, 384, 384, 41412, -1000, 11134, -1000,
+	-1000, 1139, 17682, -1000, 806, 779, 779, -1000, -1000, -1000,
+	-1000, -1000, 772, 41412, 806, -1000, -1000, -1000, 779, 772,
+	41412, 772, 772, 772, 772, 779, 779, 779, 772, 41412,
+	41412, 41412, 41412, 41412, 41412, 41412, 41412, 41412, 10536, -1000,
+	1804, -1000, -1000, -1000, -1000, 10536, 10536, -1000, -1000, -1000,
+	-1000, 148, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -58, 1540, 31924, -1000, -280, -319, -320, -328, -1000,
+	-1000, -1000, -332, -333, -1000, -1000, -1000, 17682, 17682, 17682,
+	17682, -163, -1000, 747, 18275, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 908, 743, 18275, 18275, 18275, 18275, 18275, 18275,
+	18275, 18275, 18275, 18275, 18275, 18275, 18275, 18275, 18275, 25401,
+	7539, 7539, 577, 577, 577, 577, -1000, 17089, 17682, 17682,
+	577, -1000, 1111, 31331, 17089, 17089, 17682, 1946, 694, 953,
+	41412, -1000, 1187, -1000, -1000, -1000, 981, -1000, 41412, 41412,
+	496, 17682, 17682, 17682, 17682, 17682, 17682, 14119, 17682, 17682,
+	17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682,
+	17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682, 17682,
+	17682, 17682, 17682, 17682, 13526, 11134, 17089, 17089, 17089, 17089,
+	17089, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 520, 1296, 1345, 1392, -1000, 1536, -1000,
+	-152, 23029, 17682, 2107, 1737, 41412, -1000, -1000, -1000, 2028,
+	-1000, 2028, 1296, 1937, 1822, 17089, -1000, -1000, 1937, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1282, -1000, 41412,
+	1506, 2011, 41412, 1813, 1137, 324, -1000, 17682, 17682, 1501,
+	-1000, 1477, 41412, -1000, -163, -1000, 30738, -1000, -1000, 9938,
+	-1000, 41412, 288, 41412, -1000, 22436, 30145, 11732, 20, -1000,
+	11732, 1458, -1000, 4, -5, 14712, 567, -1000, -1000, -1000,
+	3397, 19461, 1292, 567, 83, -1000, -1000, -1000, 1618, -1000,
+	1618, 1618, 1618, 1618, 324, 324, 324, 324, -1000, -1000,
+	-1000, -1000, -1000, 1694, 1692, -1000, 1618, 1618, 1618, 1618,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -377, 41190,
-	-1000, 205, 612, 290, 330, 256, 41190, 245, 273, 41190,
-	41190, 410, 1763, 41190, 1995, 41190, -1000, -1000, -1000, -1000,
-	-1000, 1034, 41190, -1000, -1000, 750, 750, -1000, -1000, 41190,
-	750, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 750,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1681, 1681, 1681,
+	1640, 1640, 353, -1000, 17682, 340, 29552, 1979, 934, 1212,
+	199, 388, 1735, 1111, 1111, 1111, 388, -1000, 1235, 1194,
+	1092, -1000, -394, 1494, -1000, -1000, 2074, -1000, -1000, 650,
+	778, 771, 727, 41412, 173, 279, -1000, 391, -1000, 29552,
+	1111, 739, 522, 1111, -1000, 1111, -1000, -1000, -1000, -1000,
+	-1000, 1111, -1000, -1000, 1492, -1000, 1318, 801, 757, 768,
+	750, 1492, -1000, -1000, -106, 1492, -1000, 1492, -1000, 1492,
+	-1000, 1492, -1000, 1492, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 664, 177, -213, 41412, 173, 25401, -1000,
+	412, -1000, -1000, -1000, 25401, 25401, -1000, -1000, -1000, -1000,
+	1128, 1125, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 41190, -1000, -1000, -1000, -1000, 41190, -1000, -1000, -1000,
-	-1000, 64, -4, 300, -1000, -1000, -1000, -1000, -1000, 2021,
-	-1000, 1034, 729, 649, -1000, 1622, -1000, -1000, 846, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 843, 18092, 18092, 18092,
-	1474, 462, 1507, 1552, 997, 852, 852, 899, 899, 570,
-	570, 570, 570, 570, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1095, -1000, 1496, -1000, 1296, 1149, 881, -1000,
-	1496, 1496, 917, 16908, -1000, -1000, 681, -1000, 17500, 881,
-	-1000, -1000, 881, 881, 1475, 1452, 2095, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1209, 1460,
-	1460, 1460, 1460, 1460, 17500, 1221, -1000, -1000, -1000, 1974,
-	1880, 881, 1346, 881, 1431, -1000, 1843, 1460, 1832, 1827,
-	1779, 1358, 1762, 1737, 1723, 1460, 1460, 1460, 1187, 1719,
-	1699, 1689, 1673, 1655, 1643, 1612, 1600, 1593, 1460, 881,
-	16908, 16908, 1428, 1622, 509, -1000, 1496, 881, 881, 1496,
-	1496, 10963, 881, -1000, 41190, -1000, -296, -1000, -40, 600,
-	1622, -1000, 28166, 881, 5860, -1000, 42374, -1000, -1000, -1000,
-	-1000, -1000, 26390, 1677, 2215, -1000, -1000, 1622, 1594, -1000,
-	-1000, 475, 66, 25798, 556, 556, 89, 1034, 1034, 17500,
-	-1000, -1000, -1000, -1000, -1000, -1000, 508, 2076, 389, 1622,
-	-1000, 1398, 2190, -1000, -1000, -1000, 2014, 19873, -1000, 1622,
-	1622, 41190, 1648, 1276, -1000, 502, -1000, 1396, 1, 3,
-	-1000, -1000, -1000, -1000, 1034, -1000, 999, 331, 311, -1000,
-	395, -1000, -1000, -1000, -1000, 1911, 70, -1000, -1000, -1000,
-	171, 475, -1000, -1000, -1000, -1000, -1000, -1000, 1092, 1092,
-	-1000, -1000, -1000, -1000, -1000, 931, -1000, -1000, -1000, 912,
-	-1000, -1000, 1562, 1742, 408, -1000, -1000, 598, 1089, -1000,
-	-1000, 1914, 598, 598, 41190, -1000, -1000, 1252, 1999, 205,
-	41190, 643, 1757, -1000, 1727, 1727, 1727, 41190, -1000, -1000,
-	-1000, -1000, -1000, -1000, -386, 62, 337, -1000, -1000, -1000,
-	3566, 41190, 1558, -1000, 186, -1000, 1220, -1000, 41190, -1000,
-	1501, 1672, 1108, 1108, -1000, -1000, -1000, 41190, 1622, -1000,
-	-1000, -1000, -1000, 466, 1965, 243, -1000, -1000, -216, -1000,
-	-1000, 188, 186, 563, 1108, -1000, -1000, -1000, -1000, -1000,
-	-380, 1498, 454, 191, 264, 41190, 41190, 41190, 41190, 41190,
-	495, -1000, -1000, -1000, 265, 422, -1000, 41190, 41190, 596,
-	-1000, -1000, -1000, 736, -1000, -1000, 736, -1000, -1000, -1000,
-	-1000, -1000, 1951, 41190, -28, -353, -1000, -341, 17500, -1000,
-	-1000, -1000, -1000, 1463, 452, 1507, 18092, 18092, 16908, -113,
-	512, 512, 25206, -1000, -1000, -1000, 17500, 17500, 1309, 627,
-	-1000, 17500, 934, -1000, -1000, -1000, 17500, 17500, 17500, 881,
-	303, -1000, -1000, -1000, -1000, -1000, 2088, -1000, 17500, 17500,
-	17500, -1000, -1000, 17500, 17500, -1000, 17500, 17500, 18684, 881,
-	18684, 17500, 17500, 18684, -1000, -1000, -1000, -1000, 18684, 17500,
-	17500, 17500, 17500, 17500, 17500, 17500, 17500, 17500, -1000, -1000,
-	1496, 1496, 16908, 10963, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 600, -1000, 569, 569, 569, 41190,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1382, 2033, -1000, 1833,
-	1830, 2082, 2076, -1000, 22246, 2215, -1000, -1000, 41190, -289,
-	-1000, 1889, 1876, 556, 556, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 9172, 2030, 17500, 1756, 41190, 1622, -1000, 21654,
-	41190, 41190, 22246, 22246, 22246, 22246, 22246, -1000, 1788, 1784,
-	-1000, 1801, 1797, 1841, 41190, -1000, 1487, 881, 2038, 19873,
-	320, 17500, 24022, 1269, 22246, -1000, -1000, 22246, 41190, 8575,
-	-1000, -1000, -32, 0, -1000, -1000, -1000, -1000, 3124, -1000,
-	-1000, 406, 2012, 1909, -1000, -1000, -1000, -1000, -1000, 1467,
-	-1000, 1464, 1376, 1455, 149, -1000, 1703, 1944, 598, 598,
-	-1000, 907, -1000, 1108, 1086, 1085, -1000, -1000, -1000, 453,
-	-1000, 1991, 41190, 1754, 1753, 1750, -1000, -392, 902, 1669,
-	1653, 17500, 1668, 2059, 1353, 41190, -1000, -1000, 41190, -1000,
-	225, -1000, 408, 41190, -1000, -1000, -1000, 305, 41190, -1000,
-	6545, -1000, -1000, -1000, 186, -1000, -1000, -1000, -1000, -1000,
-	-1000, 41190, 229, -1000, 1666, 1154, -1000, -1000, 1713, -1000,
-	-1000, -1000, -1000, 199, 278, -1000, 41190, 592, 1742, 41190,
-	-1000, -1000, -1000, 750, 750, -1000, -1000, 1928, -1000, 1108,
-	1460, 18092, 18092, -1000, 564, -1000, 1622, 881, 1661, 1661,
-	-1000, 1661, 1663, -1000, 1661, 139, 1661, 123, 1661, -1000,
-	-1000, 881, 881, 1093, 1180, -83, -1000, 1034, 17500, 1566,
-	1525, 1460, -1000, 1660, 1657, 17500, 1460, 1460, 1460, -1000,
-	1034, 1358, 1518, 1413, -1000, -1000, -1000, -1000, 1374, 1460,
-	1440, -286, 881, 1448, 1448, 1448, 1448, 1448, 1446, 1446,
-	1446, 1446, -1000, -1000, 881, -1000, -1000, 2002, 2002, 2002,
-	1438, 41782, 41190, -1000, -1000, -1000, -1000, 2076, 2070, 1361,
-	-1000, -1000, 66, 403, -1000, 1858, 1876, -1000, 2058, 1879,
-	2057, -1000, -1000, -1000, -1000, -1000, 1034, -1000, 1973, 1493,
-	-1000, 601, 1323, -1000, -1000, 16316, 1444, 1829, 498, 1438,
-	1530, 2190, 1720, 1745, 1922, -1000, -1000, -1000, -1000, 1780,
-	-1000, 1778, -1000, -1000, 1638, -1000, -1000, 1248, 881, 1425,
-	309, 22246, 1307, 1307, -1000, 492, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 807, 6276, 2123, -1000, 1076, -1000, 903,
-	194, 887, -1000, -1000, 598, 598, -1000, 686, 674, -1000,
-	41190, 1654, -1000, 475, 1061, 475, 884, -1000, 880, -1000,
-	-1000, -1000, -1000, 1758, 1817, -1000, -1000, -1000, -1000, 41190,
-	-1000, -1000, 41190, 41190, 41190, 1651, 2055, -1000, 17500, 1647,
-	599, 1513, 41190, 41190, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 411, 598, -361, 272, 269,
-	598, 598, 598, -393, -1000, -1000, 1436, 1433, -1000, -147,
-	-1000, 17500, -1000, -1000, -1000, 892, 892, -1000, 1638, -1000,
-	-1000, -1000, 1216, -1000, -1000, -127, 41190, 41190, 41190, 41190,
+	-1000, -381, 41412, -1000, 203, 680, 256, 274, 254, 41412,
+	215, 233, 41412, 41412, 384, 1775, 41412, 1990, 41412, 41412,
+	41412, -1000, -1000, -1000, -1000, -1000, 953, 41412, -1000, -1000,
+	772, 772, -1000, -1000, 41412, 772, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 772, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 41412, -1000, -1000, -1000,
+	-1000, 41412, -1000, -1000, -1000, -1000, 106, -2, 342, -1000,
+	-1000, -1000, -1000, -1000, 2024, -1000, 953, 721, 705, -1000,
+	1578, -1000, -1000, 851, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 908, 18275, 18275, 18275, 1443, 437, 1003, 873, 890,
+	853, 853, 812, 812, 587, 587, 587, 587, 587, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1124, -1000, 1472,
+	-1000, 1634, 1325, 1187, -1000, 1472, 1472, 1288, 17089, -1000,
+	-1000, 701, -1000, 17682, 1187, -1000, -1000, 1187, 1187, 1481,
+	1476, 2105, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	564, 18092, -1000, -1000, 475, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 17500, -1000, 17500, -1000, 2030, 1059,
-	1034, 17500, 17500, -1000, 879, 877, 1460, -1000, -1000, -1000,
-	881, 18684, -1000, 18684, -1000, 17500, 207, 512, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1622,
-	1867, 1622, 1622, 24022, -1000, -1000, 2070, 2068, 2053, 1863,
-	1868, 1868, 1858, -1000, 2052, 2051, -1000, 1056, 2047, 1049,
-	667, -1000, 41190, 17500, 1622, -1000, 348, 41190, 1622, 41190,
-	-1000, 2039, -1000, -1000, 17500, 1642, -1000, 17500, -1000, -1000,
-	-1000, -1000, -1000, -1000, 6668, 2076, 1307, -1000, -1000, 579,
-	-1000, 17500, -1000, -1000, -1000, 4338, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1047, 1045, -1000, -1000, 1640, 17500,
-	-1000, -1000, -1000, 1207, 1204, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1638, -1000, -1000, -1000, -1000, 305, -388,
-	1471, 41190, 876, -1000, 1423, 1353, 285, 1622, 1035, 598,
-	598, 598, 873, 861, 28166, 1418, -1000, 41190, 385, -1000,
-	305, -1000, -156, -161, 1460, -1000, -1000, 2010, -1000, -1000,
-	10963, -1000, -1000, 1636, 1718, -1000, 301, -1000, 1460, 1460,
-	881, -1000, 1460, 1460, 1181, 1178, -1000, -1000, 1413, -1000,
-	948, -1000, 207, 881, 1744, -1000, -1000, 6668, -1000, 24614,
-	-1000, 2046, 2045, 28758, 28758, 600, 2068, -1000, 17500, 17500,
-	1848, 834, -1000, -1000, -1000, -1000, 1033, 1027, -1000, 1000,
-	-1000, 2114, -1000, 1034, -1000, 1622, -1000, 490, 1323, -1000,
-	2030, 1034, 41190, 1034, 65, 2039, -1000, 1460, -1000, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622, 1622,
-	1622, -1000, -1000, 41190, 1411, -1000, -1000, 2003, 1390, 60,
-	-1000, 1378, -1000, -1000, 488, -1000, 17500, -1000, 28166, 989,
-	987, -1000, -1000, -1000, -1000, -393, -1000, -1000, -1000, -1000,
-	-1000, -1000, 320, 1349, -1000, 597, 41190, 41190, 881, 268,
-	-137, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	17500, -1000, 881, 1743, -1000, -241, -1000, 1334, -1000, -1000,
-	-1000, -1000, -1000, 1334, 1334, -1000, -1000, 1034, 1346, -1000,
-	777, -1000, -1000, -1000, -1000, -1000, 41190, 1323, 41190, -1000,
-	1332, 881, 1622, 2030, -1000, 1321, -1000, 320, -1000, 1608,
-	1653, -1000, -1000, -1000, 15724, -1000, -1000, -1000, -1000, -1000,
-	175, -125, 10963, 7978, 1287, -1000, -1000, 1802, -122, -143,
-	1358, -1000, -327, -1000, -1000, 27574, -1000, -1000, -1000, -1000,
-	-1000, 1285, -1000, -1000, 24022, 40598, -1000, -110, 317, -125,
-	17500, 1412, 881, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -22, -1000, -1000, -1000, -1000, -1000, 1713, -1000, 1798,
-	-1000, 881, -1000, -1000, -1000, 1214, -1000, 2711, -245, 1740,
-	-1000, -99, -1000, -1000, -1000, 758, 984, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1351, 41190, -1000, 400, -1000, -1000, -127,
-	-128, -1000, -1000, 40598, -239, 563, 6668, -1000, 1739, 1738,
-	2086, -1000, -1000, -1000, -1000, -1000, -1000, -399, 1185, 218,
-	-1000, -138, -1000, -1000, 1989, 65, -1000, 2110, -1000, 2108,
-	665, 665, -1000, 842, -399, -1000, -144, -247, -1000, -1000,
-	-1000, -1000, -1000, 392, 874, -1000, -1000, -1000, -1000, 6668,
-	-1000, -1000, -1000, 197, 197, -1000, -1000,
+	-1000, -1000, -1000, 947, 1212, 1212, 1212, 1212, 1212, 17682,
+	1224, -1000, -1000, -1000, 2080, 2062, 1187, 1390, 1187, 1469,
+	-1000, 2052, 1212, 2037, 1924, 1895, 1355, 1882, 1860, 1842,
+	1212, 1212, 1212, 1219, 1795, 1779, 1767, 1752, 1724, 1695,
+	1690, 1670, 1666, 1212, 1187, 17089, 17089, 1461, 1578, 519,
+	-1000, 1472, 1187, 1187, 1472, 1472, 11134, 1187, -1000, 41412,
+	-1000, -268, -1000, -22, 570, 1578, -1000, 28366, 1187, 6071,
+	-1000, 42598, -1000, -1000, -1000, -1000, -1000, 26587, 1672, 1937,
+	-1000, -1000, 1578, 1558, -1000, -1000, 324, 55, 25994, 526,
+	526, 97, 953, 953, 17682, -1000, -1000, -1000, -1000, -1000,
+	-1000, 518, 2090, 321, 1578, -1000, 1379, 2209, -1000, -1000,
+	-1000, 2003, 20059, -1000, 1578, 1578, 41412, 1576, 1499, -1000,
+	507, -1000, 1458, 20, 19, -1000, -1000, -1000, -1000, 953,
+	-1000, 1013, 298, 2641, -1000, 379, -1000, -1000, -1000, -1000,
+	1916, 60, -1000, -1000, -1000, 194, 324, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1121, 1121, -1000, -1000, -1000, -1000, -1000,
+	933, -1000, -1000, -1000, 930, -1000, -1000, 1542, 1761, 340,
+	-1000, -1000, 630, 1120, -1000, -1000, 1920, 630, 630, 41412,
+	-1000, -1000, 1256, 1979, 200, 41412, 699, 1774, -1000, 1735,
+	1735, 1735, 41412, -1000, -1000, -1000, -1000, -1000, -1000, -388,
+	66, 328, -1000, -1000, -1000, 3087, 41412, 1555, -1000, 169,
+	-1000, 1247, -1000, 41412, -1000, 1549, 1680, 1111, 1111, -1000,
+	-1000, -1000, 41412, 1578, -1000, -1000, -1000, -1000, 421, 1958,
+	267, -1000, -1000, -183, -1000, -1000, 173, 169, 567, 1111,
+	-1000, -1000, -1000, -1000, -1000, -384, 1531, 425, 184, 297,
+	41412, 41412, 41412, 41412, 41412, 470, -1000, -1000, -1000, 217,
+	411, -1000, 41412, 41412, 568, -1000, -1000, -1000, -1000, -1000,
+	779, -1000, -1000, 779, -1000, -1000, -1000, -1000, -1000, 1953,
+	41412, -9, -353, -1000, -347, 17682, -1000, -1000, -1000, -1000,
+	1360, 419, 1003, 18275, 18275, 17089, -96, 382, 382, 25401,
+	-1000, -1000, -1000, 17682, 17682, 1356, 688, -1000, 17682, 852,
+	-1000, -1000, -1000, 17682, 17682, 17682, 1187, 262, -1000, -1000,
+	-1000, -1000, -1000, 2095, -1000, 17682, 17682, 17682, -1000, -1000,
+	17682, 17682, -1000, 17682, 17682, 18868, 1187, 18868, 17682, 17682,
+	18868, -1000, -1000, -1000, -1000, 18868, 17682, 17682, 17682, 17682,
+	17682, 17682, 17682, 17682, 17682, -1000, -1000, 1472, 1472, 17089,
+	11134, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 570, -1000, 522, 522, 522, 41412, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1449, 2043, -1000, 1850, 1832, 2092, 2090,
+	-1000, 22436, 1937, -1000, -1000, 41412, -263, -1000, 1878, 1918,
+	526, 526, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9340,
+	2028, 17682, 1772, 41412, 1578, -1000, 21843, 41412, 41412, 22436,
+	22436, 22436, 22436, 22436, -1000, 1802, 1801, -1000, 1827, 1821,
+	1789, 41412, -1000, 1467, 1187, 2061, 20059, 341, 17682, 24215,
+	1341, 22436, -1000, -1000, 22436, 41412, 8742, -1000, -1000, -14,
+	-16, -1000, -1000, -1000, -1000, 3397, -1000, -1000, 299, 2000,
+	1894, -1000, -1000, -1000, -1000, -1000, 1465, -1000, 1454, 1444,
+	1421, 177, -1000, 1714, 1952, 630, 630, -1000, 910, -1000,
+	1111, 1119, 1115, -1000, -1000, -1000, 420, 41412, 41412, 41412,
+	-1000, 1986, 41412, 1771, 1769, 1764, -1000, -393, 903, 1679,
+	1621, 17682, 1674, 2073, 1405, 41412, -1000, -1000, 41412, -1000,
+	257, -1000, 340, 41412, -1000, -1000, -1000, 279, 41412, -1000,
+	7687, -1000, -1000, -1000, 169, -1000, -1000, -1000, -1000, -1000,
+	-1000, 41412, 198, -1000, 1653, 913, -1000, -1000, 1585, -1000,
+	-1000, -1000, -1000, 186, 255, -1000, 41412, 512, 1761, 41412,
+	-1000, -1000, -1000, 772, 772, -1000, -1000, 1940, -1000, 1111,
+	1212, 18275, 18275, -1000, 577, -1000, 1578, 1187, 1618, 1618,
+	-1000, 1618, 1640, -1000, 1618, 133, 1618, 112, 1618, -1000,
+	-1000, 1187, 1187, 1183, 1207, -68, -1000, 953, 17682, 1651,
+	1616, 1212, -1000, 1614, 1612, 17682, 1212, 1212, 1212, -1000,
+	953, 1355, 1557, 1313, -1000, -1000, -1000, -1000, 1429, 1212,
+	1553, -261, 1187, 1415, 1415, 1415, 1415, 1415, 1392, 1392,
+	1392, 1392, -1000, -1000, 1187, -1000, -1000, 2022, 2022, 2022,
+	1370, 42005, 41412, -1000, -1000, -1000, -1000, 2090, 2085, 1424,
+	-1000, -1000, 55, 377, -1000, 1891, 1918, -1000, 2069, 1863,
+	2068, -1000, -1000, -1000, -1000, -1000, 953, -1000, 1963, 1278,
+	-1000, 676, 1372, -1000, -1000, 16496, 1386, 1828, 497, 1370,
+	1388, 2209, 1721, 1762, 2243, -1000, -1000, -1000, -1000, 1793,
+	-1000, 1777, -1000, -1000, 1581, -1000, -1000, 1345, 1187, 1530,
+	288, 22436, 1362, 1362, -1000, 492, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 813, 7213, 2142, -1000, 1104, -1000, 1006,
+	181, 900, -1000, -1000, 630, 630, -1000, 738, 737, -1000,
+	41412, 1604, -1000, 324, 1098, 324, 898, -1000, 878, -1000,
+	-1000, -1000, -1000, 1733, 1745, -1000, -1000, -1000, -1000, 41412,
+	174, 1593, 1591, -1000, -1000, 41412, 41412, 41412, 1590, 2066,
+	-1000, 17682, 1589, 662, 1496, 41412, 41412, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 378, 630,
+	-364, 231, 228, 630, 630, 630, -400, -1000, -1000, 1351,
+	1349, -1000, -134, -1000, 17682, -1000, -1000, -1000, 948, 948,
+	-1000, 1581, -1000, -1000, -1000, 1230, -1000, -1000, -108, 41412,
+	41412, 41412, 41412, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 577, 18275, -1000, -1000, 324, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17682, -1000, 17682,
+	-1000, 2028, 1096, 953, 17682, 17682, -1000, 876, 863, 1212,
+	-1000, -1000, -1000, 1187, 18868, -1000, 18868, -1000, 17682, 197,
+	382, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1578, 1853, 1578, 1578, 24215, -1000, -1000, 2085,
+	2063, 2064, 1857, 1870, 1870, 1891, -1000, 2058, 2051, -1000,
+	1095, 2050, 1068, 728, -1000, 41412, 17682, 1578, -1000, 338,
+	41412, 1578, 41412, -1000, 2053, -1000, -1000, 17682, 1587, -1000,
+	17682, -1000, -1000, -1000, -1000, -1000, -1000, 7539, 2090, 1362,
+	-1000, -1000, 597, -1000, 17682, -1000, -1000, -1000, 4232, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1064, 1054, -1000,
+	-1000, 1586, 17682, -1000, -1000, -1000, 1217, 1213, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1581, 2149, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 279, -391, 1329, 41412,
+	860, -1000, 1335, 1405, 261, 1578, 1042, 630, 630, 630,
+	858, 857, 28366, 1322, -1000, 41412, 303, -1000, 279, -1000,
+	-141, -142, 1212, -1000, -1000, 1997, -1000, -1000, 11134, -1000,
+	-1000, 1579, 1723, -1000, 354, -1000, 1212, 1212, 1187, -1000,
+	1212, 1212, 1205, 1202, -1000, -1000, 1313, -1000, 1071, -1000,
+	197, 1187, 1760, -1000, -1000, 7539, -1000, 24808, -1000, 2045,
+	2042, 28959, 28959, 570, 2063, -1000, 17682, 17682, 1807, 868,
+	-1000, -1000, -1000, -1000, 1040, 1037, -1000, 1036, -1000, 2131,
+	-1000, 953, -1000, 1578, -1000, 490, 1372, -1000, 2028, 953,
+	41412, 953, 56, 2053, -1000, 1212, -1000, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578,
+	1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, 1578, -1000,
+	-1000, 41412, 985, -1000, -1000, 1992, 1756, -1000, -1000, -1000,
+	-1000, -1000, 1285, 50, -1000, 1252, -1000, -1000, 460, -1000,
+	17682, -1000, 28366, 1027, 1015, -1000, -1000, -1000, -1000, -400,
+	-1000, -1000, -1000, -1000, -1000, -1000, 341, 1401, -1000, 622,
+	41412, 41412, 1187, 285, -113, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 17682, -1000, 1187, 1751, -1000, -240,
+	-1000, 1243, -1000, -1000, -1000, -1000, -1000, 1243, 1243, -1000,
+	-1000, 953, 1390, -1000, 827, -1000, -1000, -1000, -1000, -1000,
+	41412, 1372, 41412, -1000, 1221, 1187, 1578, 2028, -1000, 1209,
+	-1000, 341, 41412, -1000, 1568, 1621, -1000, -1000, -1000, 15903,
+	-1000, -1000, -1000, -1000, -1000, 187, -107, 11134, 8144, 1201,
+	-1000, -1000, 1803, -104, -126, 1355, -1000, -340, -1000, -1000,
+	27773, -1000, -1000, -1000, -1000, -1000, 861, -1000, -1000, 24215,
+	40819, -1000, -93, 258, -107, 2021, 17682, 1361, 1187, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5, -1000, -1000,
+	-1000, -1000, -1000, 1585, -1000, 1794, -1000, 1187, -1000, -1000,
+	-1000, 1193, -1000, 3529, -212, 1743, -1000, -77, -1000, -1000,
+	-1000, 811, 955, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	847, 41412, -1000, 346, -1000, -1000, -108, -110, -1000, -1000,
+	40819, -207, 567, 7539, -1000, 1734, 1725, 2134, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -402, 1186, 195, -1000, -116,
+	-1000, -1000, 1971, 56, -1000, 2129, -1000, 2120, 562, 562,
+	-1000, 855, -402, -1000, -132, -217, -1000, -1000, -1000, -1000,
+	-1000, 322, 911, -1000, -1000, -1000, -1000, 7539, -1000, -1000,
+	-1000, 196, 196, -1000, -1000,
 }
 
 var mysqPgo = [...]int{
-	0, 2472, 2470, 22, 7, 36, 35, 2469, 24, 113,
-	187, 29, 189, 117, 2467, 2465, 2462, 2461, 2454, 2452,
-	2451, 229, 223, 221, 2446, 2444, 2442, 2441, 2440, 2439,
-	2438, 2437, 2436, 2435, 233, 169, 184, 2432, 2431, 2421,
-	140, 174, 94, 98, 180, 2419, 2418, 87, 2417, 2416,
-	2415, 179, 177, 176, 742, 2414, 171, 118, 51, 2413,
-	2404, 2399, 2396, 2395, 2393, 2389, 2388, 2387, 2386, 2385,
-	2384, 2383, 2382, 2381, 2380, 224, 2379, 2377, 16, 2376,
-	83, 2375, 2374, 2373, 2371, 136, 2370, 2369, 2368, 37,
-	2367, 2366, 2365, 2362, 2361, 2360, 2359, 152, 2358, 2354,
-	2352, 2350, 186, 2349, 2347, 149, 123, 129, 2344, 2343,
-	109, 183, 2342, 134, 2340, 2339, 2338, 157, 2337, 4938,
-	2336, 79, 72, 2335, 77, 91, 70, 69, 17, 4,
-	2332, 2331, 78, 86, 2330, 121, 81, 2329, 108, 116,
-	2327, 2326, 10, 8, 2325, 2, 5, 3, 75, 2324,
-	2323, 125, 2322, 2321, 2320, 104, 2319, 2318, 3802, 2317,
-	99, 143, 114, 82, 2315, 46, 76, 2314, 2309, 2305,
-	2304, 2302, 57, 2301, 2300, 2299, 151, 52, 182, 42,
-	41, 58, 144, 2298, 30, 90, 2297, 148, 150, 2296,
-	60, 44, 43, 2294, 40, 141, 133, 54, 105, 162,
-	2293, 2292, 67, 89, 2291, 2290, 2289, 2288, 2287, 2284,
-	84, 2277, 65, 2276, 190, 2275, 14, 2274, 64, 45,
-	2272, 74, 124, 88, 31, 2270, 181, 2269, 59, 168,
-	137, 166, 2268, 2265, 2264, 2261, 185, 278, 2254, 2251,
-	122, 154, 158, 95, 2249, 290, 2248, 2247, 101, 1397,
-	2529, 38, 167, 2246, 2245, 3563, 147, 39, 23, 2244,
-	193, 2243, 2241, 2232, 188, 170, 128, 936, 66, 2229,
-	2226, 13, 2222, 2216, 2215, 2210, 2207, 2205, 96, 34,
-	33, 32, 192, 62, 19, 106, 161, 80, 2204, 2203,
-	2202, 135, 97, 2201, 165, 164, 139, 178, 2200, 173,
-	153, 130, 2198, 204, 27, 2197, 2196, 2195, 2192, 103,
-	2189, 2188, 2183, 2182, 163, 155, 132, 92, 2180, 93,
-	131, 159, 156, 49, 2179, 47, 2178, 2177, 26, 175,
-	28, 2175, 12, 68, 73, 21, 126, 127, 2174, 0,
-	2171, 15, 172, 316, 160, 2170, 2168, 6, 9, 11,
-	2167, 2165, 2163, 2162, 146, 2155, 2154, 2152, 2151, 20,
-	48, 18, 1, 2143, 2142, 5195, 1707, 138, 2141, 197,
+	0, 2502, 2501, 27, 5, 39, 37, 2498, 41, 108,
+	201, 26, 188, 113, 2497, 2495, 2494, 2489, 2488, 2487,
+	2486, 221, 220, 216, 2485, 2484, 2483, 2482, 2481, 2479,
+	2477, 2476, 2475, 2471, 214, 175, 181, 2469, 2468, 2467,
+	127, 177, 98, 100, 179, 2464, 2463, 90, 2462, 2460,
+	2458, 194, 193, 192, 751, 2457, 185, 126, 48, 2456,
+	2454, 2445, 2444, 2443, 2442, 2441, 2440, 2439, 2435, 2434,
+	2433, 2432, 2429, 2428, 2427, 299, 2425, 2423, 17, 2422,
+	93, 2421, 2420, 2418, 2417, 135, 2415, 2414, 2413, 32,
+	2412, 2410, 2409, 2408, 2405, 2403, 2402, 154, 2401, 2399,
+	2398, 2397, 183, 2396, 2395, 278, 124, 134, 2394, 2392,
+	114, 182, 2391, 130, 2390, 2389, 2388, 158, 2387, 5237,
+	2386, 79, 77, 2385, 62, 89, 75, 76, 14, 3,
+	2384, 2383, 78, 88, 2381, 116, 70, 2380, 101, 115,
+	2379, 2378, 13, 8, 2377, 2, 7, 4, 86, 2376,
+	2375, 125, 2374, 2373, 2372, 104, 2371, 2370, 4784, 2369,
+	102, 144, 120, 87, 2368, 45, 65, 2365, 2364, 2363,
+	2362, 2360, 59, 2358, 2356, 2355, 153, 73, 162, 40,
+	60, 57, 147, 2353, 68, 91, 2350, 151, 149, 2349,
+	54, 43, 58, 2348, 34, 141, 129, 25, 106, 152,
+	2346, 2345, 72, 83, 2344, 2341, 2339, 2338, 2337, 2336,
+	82, 2335, 51, 2334, 190, 2333, 19, 2332, 49, 44,
+	2330, 80, 139, 81, 33, 2328, 169, 2327, 69, 174,
+	136, 167, 2326, 2325, 2324, 2321, 180, 305, 2318, 2317,
+	122, 160, 161, 103, 2308, 306, 2307, 2304, 105, 1514,
+	2343, 38, 171, 2303, 2302, 3964, 148, 35, 23, 2301,
+	170, 2297, 2296, 2295, 187, 172, 18, 2293, 2291, 133,
+	939, 66, 2289, 2280, 15, 2270, 2269, 2268, 2266, 2262,
+	2259, 92, 36, 31, 30, 195, 64, 10, 121, 173,
+	84, 2257, 2254, 2253, 132, 96, 2251, 166, 165, 138,
+	208, 2249, 168, 157, 150, 2248, 94, 29, 2246, 2241,
+	2239, 2234, 99, 2233, 2231, 2230, 2228, 164, 155, 131,
+	95, 2224, 97, 128, 163, 159, 52, 2221, 46, 2217,
+	2210, 28, 178, 24, 2208, 12, 67, 74, 22, 123,
+	118, 2207, 0, 2203, 16, 176, 296, 156, 2199, 2194,
+	6, 9, 11, 2193, 2192, 2189, 2187, 146, 2186, 2185,
+	2184, 2181, 21, 47, 20, 1, 2170, 2166, 4803, 2839,
+	142, 2164, 196,
 }
 
-//line mysql.y:6972
+//line mysql.y:7033
 type mysqSymType struct {
 	union             any
 	empty             struct{}
@@ -6600,6 +6636,11 @@ func (st *mysqSymType) tableSpecUnion() *ast.TableSpec {
 	return v
 }
 
+func (st *mysqSymType) triggerEventUnion() *ast.TriggerEvent {
+	v, _ := st.union.(*ast.TriggerEvent)
+	return v
+}
+
 func (st *mysqSymType) trimTypeUnion() ast.TrimType {
 	v, _ := st.union.(ast.TrimType)
 	return v
@@ -6646,125 +6687,126 @@ func (st *mysqSymType) withUnion() *ast.With {
 }
 
 var mysqR1 = [...]int{
-	0, 363, 364, 364, 7, 7, 7, 7, 7, 7,
+	0, 366, 367, 367, 7, 7, 7, 7, 7, 7,
 	7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
 	7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
-	7, 7, 7, 7, 7, 7, 7, 339, 339, 339,
-	342, 342, 32, 71, 34, 34, 33, 33, 36, 36,
+	7, 7, 7, 7, 7, 7, 7, 342, 342, 342,
+	345, 345, 32, 71, 34, 34, 33, 33, 36, 36,
 	35, 8, 8, 8, 9, 9, 9, 9, 9, 9,
 	9, 9, 10, 10, 10, 10, 10, 11, 11, 11,
 	11, 13, 13, 13, 13, 13, 19, 20, 12, 12,
-	21, 21, 83, 83, 22, 23, 23, 23, 23, 367,
-	367, 153, 153, 151, 151, 152, 152, 221, 221, 24,
+	21, 21, 83, 83, 22, 23, 23, 23, 23, 370,
+	370, 153, 153, 151, 151, 152, 152, 221, 221, 24,
 	25, 25, 231, 231, 230, 230, 230, 232, 232, 232,
-	232, 267, 267, 26, 26, 26, 26, 26, 103, 103,
-	341, 341, 340, 332, 332, 331, 331, 330, 338, 338,
-	337, 337, 336, 38, 39, 48, 48, 48, 48, 49,
-	50, 343, 343, 305, 55, 55, 54, 54, 54, 54,
-	54, 54, 56, 56, 52, 52, 51, 51, 53, 53,
-	307, 307, 293, 293, 306, 306, 306, 306, 306, 306,
-	306, 292, 292, 114, 114, 200, 200, 200, 200, 200,
+	232, 270, 270, 26, 26, 26, 26, 26, 26, 26,
+	26, 103, 103, 344, 344, 343, 335, 335, 334, 334,
+	333, 341, 341, 340, 340, 339, 38, 39, 48, 48,
+	48, 48, 49, 50, 346, 346, 308, 55, 55, 54,
+	54, 54, 54, 54, 54, 56, 56, 52, 52, 51,
+	51, 53, 53, 310, 310, 296, 296, 309, 309, 309,
+	309, 309, 309, 309, 295, 295, 114, 114, 200, 200,
 	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
-	200, 200, 358, 358, 358, 357, 357, 201, 201, 201,
-	201, 201, 201, 201, 201, 123, 123, 132, 132, 132,
-	132, 132, 121, 121, 122, 120, 120, 120, 126, 126,
-	126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 362, 362, 362, 362, 362, 362, 362, 362,
-	362, 362, 131, 131, 127, 127, 127, 128, 128, 128,
-	129, 129, 359, 359, 359, 359, 271, 271, 271, 271,
-	274, 274, 272, 272, 272, 272, 272, 272, 272, 272,
-	272, 273, 273, 273, 273, 273, 275, 275, 275, 275,
-	275, 276, 276, 276, 276, 276, 276, 276, 276, 276,
-	276, 276, 276, 276, 276, 276, 276, 277, 277, 277,
-	277, 277, 277, 277, 277, 291, 291, 278, 278, 286,
-	286, 287, 287, 287, 288, 288, 288, 289, 289, 283,
-	283, 283, 283, 283, 283, 283, 283, 283, 285, 285,
-	284, 284, 284, 294, 319, 319, 318, 318, 316, 316,
-	316, 316, 316, 316, 316, 316, 303, 303, 313, 313,
-	313, 313, 313, 302, 302, 298, 298, 298, 299, 299,
-	300, 300, 297, 297, 301, 301, 315, 315, 314, 314,
-	295, 295, 296, 296, 321, 360, 360, 360, 360, 360,
-	361, 361, 322, 350, 352, 352, 352, 351, 351, 348,
-	349, 347, 347, 347, 347, 347, 80, 80, 80, 243,
-	243, 244, 244, 311, 311, 310, 310, 310, 312, 312,
-	309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
-	309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
-	309, 309, 309, 309, 309, 309, 309, 309, 309, 309,
-	309, 239, 239, 239, 346, 346, 346, 346, 346, 346,
-	345, 345, 345, 308, 308, 308, 344, 344, 57, 57,
-	185, 185, 45, 45, 45, 45, 45, 45, 44, 44,
-	44, 40, 40, 40, 40, 40, 40, 40, 40, 40,
+	200, 200, 200, 200, 200, 361, 361, 361, 360, 360,
+	201, 201, 201, 201, 201, 201, 201, 201, 123, 123,
+	132, 132, 132, 132, 132, 121, 121, 122, 120, 120,
+	120, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 365, 365, 365, 365, 365, 365, 365,
+	365, 365, 365, 365, 365, 365, 365, 365, 365, 365,
+	365, 365, 365, 365, 365, 365, 365, 365, 365, 365,
+	365, 365, 365, 365, 365, 365, 365, 365, 365, 365,
+	365, 365, 365, 365, 365, 131, 131, 127, 127, 127,
+	128, 128, 128, 129, 129, 362, 362, 362, 362, 274,
+	274, 274, 274, 277, 277, 275, 275, 275, 275, 275,
+	275, 275, 275, 275, 276, 276, 276, 276, 276, 278,
+	278, 278, 278, 278, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	280, 280, 280, 280, 280, 280, 280, 280, 294, 294,
+	281, 281, 289, 289, 290, 290, 290, 291, 291, 291,
+	292, 292, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 288, 288, 287, 287, 287, 297, 322, 322, 321,
+	321, 319, 319, 319, 319, 319, 319, 319, 319, 306,
+	306, 316, 316, 316, 316, 316, 305, 305, 301, 301,
+	301, 302, 302, 303, 303, 300, 300, 304, 304, 318,
+	318, 317, 317, 298, 298, 299, 299, 324, 363, 363,
+	363, 363, 363, 364, 364, 325, 353, 355, 355, 355,
+	354, 354, 351, 352, 350, 350, 350, 350, 350, 80,
+	80, 80, 243, 243, 244, 244, 314, 314, 313, 313,
+	313, 315, 315, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 312, 312, 312, 312, 312, 312,
+	312, 312, 312, 312, 239, 239, 239, 349, 349, 349,
+	349, 349, 349, 348, 348, 348, 311, 311, 311, 347,
+	347, 57, 57, 185, 185, 45, 45, 45, 45, 45,
+	45, 44, 44, 44, 40, 40, 40, 40, 40, 40,
 	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
 	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 46, 46, 41, 41, 41, 41, 41, 41, 41,
-	41, 41, 41, 27, 27, 27, 27, 27, 27, 27,
-	27, 27, 27, 27, 27, 27, 27, 27, 85, 85,
-	86, 86, 86, 86, 88, 88, 88, 324, 324, 58,
-	58, 3, 3, 141, 143, 144, 144, 142, 142, 142,
-	142, 142, 142, 60, 60, 59, 59, 146, 145, 147,
-	147, 147, 1, 1, 2, 2, 4, 4, 329, 329,
-	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
-	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
-	290, 290, 290, 323, 323, 325, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 91, 90, 90, 89,
-	92, 92, 92, 92, 92, 92, 92, 92, 327, 327,
-	327, 61, 61, 328, 279, 280, 281, 5, 6, 304,
-	326, 99, 99, 28, 37, 37, 29, 29, 29, 29,
-	30, 30, 62, 63, 63, 63, 63, 63, 63, 63,
+	40, 40, 40, 40, 46, 46, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 27, 27, 27, 27,
+	27, 27, 27, 27, 27, 27, 27, 27, 27, 27,
+	27, 85, 85, 86, 86, 86, 86, 88, 88, 88,
+	327, 327, 58, 58, 3, 3, 141, 143, 144, 144,
+	142, 142, 142, 142, 142, 142, 60, 60, 59, 59,
+	146, 145, 147, 147, 147, 1, 1, 2, 2, 4,
+	4, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+	332, 332, 332, 332, 332, 332, 332, 332, 332, 332,
+	332, 332, 332, 293, 293, 293, 326, 326, 328, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 87, 91,
+	90, 90, 89, 92, 92, 92, 92, 92, 92, 92,
+	92, 330, 330, 330, 61, 61, 331, 282, 283, 284,
+	5, 6, 307, 329, 99, 99, 28, 37, 37, 29,
+	29, 29, 29, 29, 29, 30, 30, 62, 63, 63,
+	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
 	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
 	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
 	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 238, 238, 246, 246, 237,
-	237, 260, 260, 260, 240, 240, 240, 356, 356, 356,
-	234, 234, 64, 64, 64, 66, 66, 67, 68, 68,
-	262, 262, 263, 263, 69, 70, 81, 81, 81, 81,
-	81, 84, 84, 84, 15, 15, 15, 15, 77, 77,
-	77, 14, 14, 65, 65, 72, 353, 353, 354, 355,
-	355, 355, 355, 73, 31, 31, 31, 31, 31, 31,
-	109, 109, 97, 97, 97, 97, 97, 97, 97, 97,
-	97, 97, 97, 97, 104, 104, 104, 98, 98, 368,
-	75, 76, 76, 102, 102, 102, 95, 95, 95, 101,
-	101, 101, 16, 16, 17, 220, 220, 18, 18, 106,
-	106, 108, 108, 108, 108, 108, 110, 110, 110, 110,
-	110, 110, 110, 105, 105, 107, 107, 107, 107, 253,
-	253, 253, 252, 252, 135, 135, 137, 136, 136, 138,
-	138, 139, 139, 139, 139, 183, 183, 161, 161, 218,
-	218, 216, 216, 217, 217, 219, 219, 219, 219, 222,
-	222, 140, 140, 140, 140, 148, 148, 149, 149, 150,
-	150, 261, 261, 258, 258, 258, 257, 257, 154, 154,
-	154, 156, 155, 155, 155, 155, 157, 157, 159, 159,
-	158, 158, 160, 165, 165, 164, 164, 162, 162, 162,
-	162, 163, 163, 163, 163, 166, 166, 119, 119, 119,
-	119, 119, 119, 119, 130, 130, 130, 130, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 124,
-	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
-	124, 124, 125, 125, 125, 125, 125, 125, 125, 125,
+	238, 238, 246, 246, 237, 237, 260, 260, 260, 240,
+	240, 240, 359, 359, 359, 234, 234, 64, 64, 64,
+	66, 66, 67, 68, 68, 262, 262, 263, 263, 69,
+	70, 81, 81, 81, 81, 81, 84, 84, 84, 15,
+	15, 15, 15, 77, 77, 77, 14, 14, 65, 65,
+	72, 356, 356, 357, 358, 358, 358, 358, 73, 31,
+	31, 31, 31, 31, 31, 109, 109, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 104,
+	104, 104, 98, 98, 371, 75, 76, 76, 102, 102,
+	102, 95, 95, 95, 101, 101, 101, 16, 16, 17,
+	220, 220, 18, 18, 106, 106, 108, 108, 108, 108,
+	108, 110, 110, 110, 110, 110, 110, 110, 105, 105,
+	107, 107, 107, 107, 253, 253, 253, 252, 252, 135,
+	135, 137, 136, 136, 138, 138, 139, 139, 139, 139,
+	183, 183, 161, 161, 218, 218, 216, 216, 217, 217,
+	219, 219, 219, 219, 222, 222, 140, 140, 140, 140,
+	148, 148, 149, 149, 150, 150, 261, 261, 258, 258,
+	258, 257, 257, 154, 154, 154, 156, 155, 155, 155,
+	155, 157, 157, 159, 159, 158, 158, 160, 165, 165,
+	164, 164, 162, 162, 162, 162, 163, 163, 163, 163,
+	166, 166, 119, 119, 119, 119, 119, 119, 119, 130,
+	130, 130, 130, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 125, 125, 125,
 	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
-	125, 125, 125, 125, 125, 82, 82, 82, 235, 235,
-	167, 167, 175, 175, 175, 175, 168, 168, 168, 168,
-	168, 168, 168, 176, 176, 176, 182, 177, 177, 173,
-	173, 173, 173, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 172, 172, 172, 172, 172, 172, 172,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	82, 82, 82, 235, 235, 167, 167, 175, 175, 175,
+	175, 168, 168, 168, 168, 168, 168, 168, 176, 176,
+	176, 182, 177, 177, 173, 173, 173, 173, 171, 171,
+	171, 171, 171, 171, 171, 171, 171, 171, 172, 172,
 	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
 	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
 	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
 	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 269, 269, 335, 335, 334, 334, 333, 333, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 93, 93, 93, 93, 93, 93, 93, 93, 93,
-	369, 369, 282, 282, 282, 282, 174, 174, 174, 174,
-	174, 100, 100, 100, 100, 100, 266, 266, 266, 270,
-	270, 270, 268, 268, 268, 268, 268, 268, 268, 268,
-	268, 268, 268, 268, 268, 268, 268, 188, 188, 96,
-	96, 186, 186, 187, 189, 189, 184, 184, 184, 170,
-	170, 170, 190, 190, 191, 191, 192, 192, 194, 193,
-	193, 195, 196, 196, 196, 197, 197, 198, 198, 198,
-	47, 47, 47, 47, 47, 42, 42, 42, 42, 43,
-	43, 43, 43, 111, 111, 111, 111, 113, 113, 112,
-	112, 78, 78, 79, 79, 79, 117, 117, 118, 118,
+	172, 172, 172, 172, 172, 172, 272, 272, 338, 338,
+	337, 337, 336, 336, 94, 94, 94, 94, 94, 94,
+	94, 94, 94, 94, 94, 94, 93, 93, 93, 93,
+	93, 93, 93, 93, 93, 372, 372, 285, 285, 285,
+	285, 174, 174, 174, 174, 174, 100, 100, 100, 100,
+	100, 269, 269, 269, 273, 273, 273, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 188, 188, 96, 96, 186, 186, 187, 189,
+	189, 184, 184, 184, 170, 170, 170, 190, 190, 191,
+	191, 192, 192, 194, 193, 193, 195, 196, 196, 196,
+	197, 197, 198, 198, 198, 47, 47, 47, 47, 47,
+	42, 42, 42, 42, 43, 43, 43, 43, 111, 111,
+	111, 111, 113, 113, 112, 112, 78, 78, 79, 79,
+	79, 267, 267, 268, 268, 268, 117, 117, 118, 118,
 	118, 115, 115, 116, 116, 214, 214, 199, 199, 199,
 	206, 206, 206, 202, 202, 204, 204, 204, 205, 205,
 	205, 203, 211, 211, 213, 213, 212, 212, 208, 208,
@@ -6773,7 +6815,7 @@ var mysqR1 = [...]int{
 	181, 181, 180, 134, 224, 224, 228, 225, 225, 229,
 	229, 229, 229, 229, 226, 226, 227, 227, 254, 254,
 	254, 233, 233, 245, 245, 241, 241, 242, 242, 236,
-	236, 247, 247, 247, 74, 178, 178, 320, 320, 317,
+	236, 247, 247, 247, 74, 178, 178, 323, 323, 320,
 	250, 250, 251, 251, 255, 255, 259, 259, 256, 256,
 	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
 	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
@@ -6823,8 +6865,8 @@ var mysqR1 = [...]int{
 	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
 	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
 	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
-	249, 249, 249, 249, 249, 249, 249, 249, 249, 365,
-	366, 264, 265, 265, 265,
+	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+	368, 369, 264, 266, 265, 265, 265,
 }
 
 var mysqR2 = [...]int{
@@ -6839,114 +6881,115 @@ var mysqR2 = [...]int{
 	7, 8, 1, 1, 10, 11, 9, 8, 8, 1,
 	1, 1, 3, 1, 3, 1, 3, 0, 4, 3,
 	5, 4, 1, 3, 3, 2, 2, 2, 2, 2,
-	1, 1, 1, 2, 2, 6, 12, 2, 0, 2,
-	0, 2, 1, 0, 2, 1, 3, 3, 0, 1,
-	1, 3, 3, 6, 4, 7, 8, 8, 8, 6,
-	3, 1, 1, 5, 0, 1, 1, 1, 1, 2,
-	2, 2, 0, 1, 4, 4, 4, 4, 4, 4,
-	2, 4, 1, 3, 1, 1, 3, 4, 3, 3,
-	3, 5, 10, 0, 2, 0, 2, 3, 5, 3,
-	4, 2, 3, 2, 3, 3, 3, 3, 2, 2,
-	4, 4, 1, 1, 1, 1, 1, 0, 2, 2,
-	3, 3, 2, 2, 2, 1, 1, 2, 2, 2,
-	2, 2, 1, 1, 1, 1, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 1, 1, 2, 2, 6, 12, 13, 9, 9,
+	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
+	3, 0, 1, 1, 3, 3, 6, 4, 7, 8,
+	8, 8, 6, 3, 1, 1, 5, 0, 1, 1,
+	1, 1, 2, 2, 2, 0, 1, 4, 4, 4,
+	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
+	4, 3, 3, 3, 5, 10, 0, 2, 0, 2,
+	3, 5, 3, 4, 2, 3, 2, 3, 3, 3,
+	3, 2, 2, 4, 4, 1, 1, 1, 1, 1,
+	0, 2, 2, 3, 3, 2, 2, 2, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 1, 1, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	1, 1, 2, 1, 2, 1, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 3, 3, 3, 2, 2, 2, 2, 2, 2,
-	1, 1, 1, 1, 1, 5, 5, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
-	5, 0, 3, 5, 0, 1, 1, 0, 1, 0,
-	3, 3, 2, 2, 2, 1, 2, 2, 0, 1,
-	0, 2, 2, 5, 0, 1, 1, 2, 1, 3,
-	2, 1, 1, 3, 3, 3, 0, 1, 4, 3,
-	3, 4, 2, 0, 2, 1, 1, 1, 1, 1,
-	0, 1, 1, 1, 0, 1, 1, 3, 3, 4,
-	3, 1, 3, 1, 7, 6, 7, 7, 8, 8,
-	0, 1, 5, 2, 1, 1, 1, 0, 1, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 1, 1,
-	2, 0, 1, 0, 1, 1, 3, 2, 1, 2,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 4,
-	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	5, 0, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 1,
-	0, 2, 0, 1, 5, 1, 3, 7, 1, 3,
-	3, 1, 2, 2, 2, 5, 5, 5, 6, 8,
-	5, 5, 4, 4, 4, 6, 5, 5, 2, 2,
-	2, 2, 3, 3, 3, 4, 3, 3, 1, 3,
-	5, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 4, 4, 2, 11, 3, 6,
-	8, 6, 6, 6, 13, 8, 6, 10, 0, 6,
-	5, 6, 4, 5, 0, 8, 9, 0, 3, 0,
-	1, 0, 3, 8, 4, 1, 3, 3, 6, 7,
-	7, 8, 4, 0, 1, 0, 1, 3, 3, 1,
-	1, 2, 1, 1, 0, 2, 0, 2, 5, 3,
-	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	0, 2, 2, 1, 3, 2, 0, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 3, 3,
-	0, 2, 2, 2, 2, 2, 2, 2, 4, 4,
-	3, 0, 1, 4, 3, 4, 4, 3, 3, 3,
-	2, 1, 3, 3, 3, 5, 7, 7, 6, 5,
-	3, 2, 3, 3, 3, 7, 3, 3, 3, 4,
-	7, 5, 2, 4, 4, 4, 4, 4, 5, 5,
-	4, 4, 4, 4, 4, 4, 4, 4, 2, 2,
-	4, 4, 3, 3, 5, 2, 3, 4, 4, 4,
-	3, 4, 4, 5, 3, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 1, 1,
-	1, 1, 2, 1, 3, 1, 2, 1, 1, 5,
-	0, 1, 0, 1, 2, 3, 0, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
-	2, 1, 2, 2, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 5, 5, 4, 0, 2, 4, 4, 0,
-	1, 0, 1, 2, 3, 4, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 2, 3, 5, 0,
-	1, 2, 1, 1, 0, 1, 2, 1, 3, 1,
-	1, 1, 4, 3, 1, 3, 4, 3, 7, 0,
-	3, 1, 3, 1, 3, 1, 1, 3, 3, 1,
-	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 3, 0, 1, 1, 2, 6, 5, 6,
-	6, 0, 2, 3, 3, 0, 2, 3, 3, 3,
-	2, 3, 1, 6, 3, 4, 3, 1, 3, 4,
-	5, 6, 3, 4, 5, 6, 3, 4, 1, 3,
+	1, 1, 2, 1, 1, 2, 1, 2, 1, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 3, 3, 3, 2, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 5, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	0, 3, 0, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 0, 3, 3, 2, 2, 2, 1, 2,
+	2, 0, 1, 0, 2, 2, 5, 0, 1, 1,
+	2, 1, 3, 2, 1, 1, 3, 3, 3, 0,
+	1, 4, 3, 3, 4, 2, 0, 2, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 0, 1, 1,
+	3, 3, 4, 3, 1, 3, 1, 7, 6, 7,
+	7, 8, 8, 0, 1, 5, 2, 1, 1, 1,
+	0, 1, 3, 3, 1, 1, 2, 2, 2, 0,
+	1, 1, 1, 2, 0, 1, 0, 1, 1, 3,
+	2, 1, 2, 3, 3, 3, 4, 4, 3, 3,
+	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 1, 3, 1, 1, 2,
-	2, 2, 2, 1, 1, 2, 9, 6, 6, 6,
-	2, 2, 3, 3, 3, 1, 1, 1, 0, 3,
-	1, 1, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 4,
-	5, 5, 6, 4, 4, 8, 6, 8, 6, 8,
-	5, 4, 2, 2, 1, 2, 2, 2, 8, 8,
-	6, 5, 4, 4, 4, 4, 4, 7, 4, 6,
-	6, 6, 4, 4, 4, 7, 8, 6, 5, 6,
-	8, 11, 7, 8, 8, 9, 4, 4, 4, 4,
-	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-	4, 0, 2, 0, 2, 1, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 4, 5, 0, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 1, 0, 2, 0, 1, 5, 1, 3,
+	7, 1, 3, 3, 1, 2, 2, 2, 5, 5,
+	5, 6, 8, 5, 5, 4, 4, 4, 6, 5,
+	5, 2, 2, 2, 2, 3, 3, 3, 4, 3,
+	3, 1, 3, 5, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 2, 3, 4, 4, 2,
+	11, 3, 6, 8, 6, 6, 6, 13, 8, 6,
+	10, 0, 6, 5, 6, 4, 5, 0, 8, 9,
+	0, 3, 0, 1, 0, 3, 8, 4, 1, 3,
+	3, 6, 7, 7, 8, 4, 0, 1, 0, 1,
+	3, 3, 1, 1, 2, 1, 1, 0, 2, 0,
+	2, 5, 3, 7, 4, 4, 4, 4, 3, 3,
+	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 0, 2, 2, 1, 3, 2, 0,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 3,
+	1, 3, 3, 0, 2, 2, 2, 2, 2, 2,
+	2, 4, 4, 3, 0, 1, 4, 3, 4, 4,
+	3, 3, 3, 2, 1, 3, 3, 3, 5, 7,
+	7, 6, 5, 5, 5, 3, 2, 3, 3, 3,
+	7, 3, 3, 3, 4, 7, 5, 2, 4, 4,
+	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
+	4, 4, 4, 2, 2, 4, 4, 3, 3, 5,
+	2, 3, 4, 4, 4, 3, 4, 4, 5, 3,
+	0, 1, 0, 1, 1, 1, 0, 2, 2, 0,
+	2, 2, 0, 1, 1, 1, 1, 2, 1, 3,
+	1, 2, 1, 1, 5, 0, 1, 0, 1, 2,
+	3, 0, 3, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 3, 3, 2, 2,
+	3, 1, 3, 2, 1, 2, 1, 2, 2, 3,
+	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
+	2, 1, 1, 1, 3, 2, 1, 1, 1, 0,
+	1, 1, 0, 3, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 1, 5, 5, 4,
+	0, 2, 4, 4, 0, 1, 0, 1, 2, 3,
+	4, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
+	1, 2, 1, 3, 1, 1, 1, 4, 3, 1,
+	3, 4, 3, 7, 0, 3, 1, 3, 1, 3,
+	1, 1, 3, 3, 1, 3, 4, 4, 4, 3,
+	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
+	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
+	3, 2, 2, 2, 1, 1, 3, 3, 0, 1,
+	1, 2, 6, 5, 6, 6, 0, 2, 3, 3,
+	0, 2, 3, 3, 3, 2, 3, 1, 6, 3,
+	4, 3, 1, 3, 4, 5, 6, 3, 4, 5,
+	6, 3, 4, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 1, 1, 1, 1,
+	1, 3, 1, 1, 2, 2, 2, 2, 1, 1,
+	2, 9, 6, 6, 6, 2, 2, 3, 3, 3,
+	1, 1, 1, 0, 3, 1, 1, 1, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 4, 5, 5, 6, 4, 4,
+	8, 6, 8, 6, 8, 5, 4, 2, 2, 1,
+	2, 2, 2, 8, 8, 6, 5, 4, 4, 4,
+	4, 4, 7, 4, 6, 6, 6, 4, 4, 4,
+	7, 8, 6, 5, 6, 8, 11, 7, 8, 8,
+	9, 4, 4, 4, 4, 6, 6, 6, 6, 6,
+	6, 6, 6, 6, 6, 4, 0, 2, 0, 2,
+	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 2, 0, 2, 3, 3, 4, 4, 4, 4,
-	4, 0, 3, 4, 7, 3, 1, 1, 1, 0,
-	5, 5, 2, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 2, 1, 1, 0, 1, 0,
-	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
-	2, 2, 0, 3, 0, 2, 0, 1, 3, 1,
-	3, 2, 0, 1, 1, 0, 1, 2, 4, 4,
-	0, 2, 2, 1, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 0, 3, 3, 3, 0, 3, 1,
-	1, 0, 4, 0, 1, 1, 0, 3, 1, 3,
+	1, 1, 1, 1, 1, 0, 2, 0, 2, 3,
+	3, 4, 4, 4, 4, 4, 0, 3, 4, 7,
+	3, 1, 1, 1, 0, 5, 5, 2, 3, 1,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 2,
+	1, 1, 0, 1, 0, 2, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 2, 2, 0, 3, 0,
+	2, 0, 1, 3, 1, 3, 2, 0, 1, 1,
+	0, 1, 2, 4, 4, 0, 2, 2, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 0, 3,
+	3, 3, 0, 3, 1, 1, 0, 4, 0, 1,
+	1, 1, 1, 1, 1, 1, 0, 3, 1, 3,
 	2, 1, 1, 0, 1, 2, 4, 9, 3, 5,
 	0, 3, 3, 0, 1, 0, 2, 2, 0, 2,
 	2, 2, 0, 2, 1, 2, 3, 3, 0, 2,
@@ -7006,333 +7049,335 @@ var mysqR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 0, 0, 0, 1, 1,
 }
 
 var mysqChk = [...]int{
-	-1000, -363, -7, -11, -19, -20, -21, -22, -23, -24,
+	-1000, -366, -7, -11, -19, -20, -21, -22, -23, -24,
 	-25, -26, -27, -28, -29, -30, -62, -63, -64, -66,
 	-67, -68, -69, -70, -14, -65, -31, -32, -71, -72,
 	-73, -74, -16, -17, -18, -9, -8, -13, 9, 10,
 	-83, -33, 32, -38, -48, 220, -49, -39, 221, -50,
-	223, 222, 259, 224, 252, 74, 304, 305, 307, 308,
-	309, 310, -84, 257, 258, 226, 36, 45, 33, 34,
-	37, 265, 266, 229, -10, -34, 8, -365, 11, 427,
-	254, 253, 28, -12, 461, 86, -364, 601, -214, -199,
+	223, 222, 259, 224, 252, 74, 305, 306, 308, 309,
+	310, 311, -84, 257, 258, 226, 36, 45, 33, 34,
+	37, 265, 266, 229, -10, -34, 8, -368, 11, 428,
+	254, 253, 28, -12, 462, 86, -367, 602, -214, -199,
 	22, 33, 29, -198, -194, -102, -199, 20, 18, 7,
-	-75, -368, -75, -75, 12, 13, -75, -305, -307, 86,
+	-75, -371, -75, -75, 12, 13, -75, -308, -310, 86,
 	156, 86, -75, -55, -54, -52, -51, -53, -56, 31,
-	-45, -46, -329, -44, -41, 225, 222, 269, 120, 121,
+	-45, -46, -332, -44, -41, 225, 222, 269, 120, 121,
 	259, 260, 261, 224, 243, 258, 262, 257, 278, -40,
-	81, 33, 461, 464, -312, 221, 227, 228, 428, 123,
-	122, 75, 223, -309, 358, 494, 571, -56, 573, 102,
-	104, 572, 44, 233, 574, 575, 576, 501, 577, 242,
-	578, 579, 580, 581, 587, 543, 588, 589, 590, 124,
-	7, -75, -259, -255, -339, -249, 89, 90, 91, 458,
-	245, 492, 493, 294, 81, 41, 355, 358, 494, 571,
-	304, 318, 312, 339, 331, 459, 495, 468, 296, 246,
-	282, 568, 329, 132, 573, 299, 496, 260, 363, 364,
-	497, 365, 102, 307, 394, 586, 298, 498, 584, 104,
-	572, 79, 51, 44, 255, 327, 229, 323, 574, 283,
-	499, 470, 276, 123, 120, 593, 36, 321, 50, 30,
-	583, 122, 49, 575, 147, 500, 576, 501, 367, 346,
-	562, 48, 368, 261, 502, 84, 266, 463, 302, 570,
-	369, 322, 370, 293, 582, 226, 503, 555, 371, 372,
-	563, 504, 347, 351, 505, 386, 373, 600, 52, 506,
-	507, 564, 121, 508, 78, 577, 80, 316, 317, 509,
-	291, 244, 388, 343, 440, 447, 448, 108, 109, 443,
-	110, 449, 111, 450, 451, 452, 441, 112, 105, 442,
-	453, 454, 344, 345, 113, 455, 107, 106, 444, 446,
-	114, 456, 242, 35, 374, 460, 295, 58, 270, 389,
-	46, 349, 597, 45, 558, 510, 561, 342, 338, 437,
-	53, 511, 512, 513, 514, 578, 341, 315, 337, 592,
-	4, 288, 579, 62, 228, 353, 352, 354, 277, 385,
-	334, 515, 516, 517, 249, 82, 518, 324, 21, 519,
-	520, 375, 284, 521, 56, 522, 523, 392, 258, 524,
-	54, 580, 39, 525, 263, 594, 581, 526, 527, 528,
-	529, 265, 530, 377, 531, 376, 348, 350, 272, 378,
-	462, 532, 303, 320, 262, 585, 533, 250, 569, 264,
-	269, 257, 393, 251, 534, 535, 536, 537, 538, 297,
-	554, 539, 540, 308, 587, 438, 43, 541, 542, 543,
-	544, 545, 292, 287, 387, 396, 61, 83, 360, 546,
-	547, 567, 314, 285, 548, 305, 55, 588, 589, 590,
-	279, 591, 9, 598, 599, 380, 124, 289, 290, 47,
-	335, 271, 549, 300, 550, 325, 326, 340, 313, 336,
-	306, 556, 273, 381, 439, 259, 551, 395, 286, 356,
-	361, 301, 466, 278, 382, 566, 465, 333, 330, 280,
-	552, 383, 234, 274, 275, 553, 384, 281, 464, 311,
-	328, 362, 408, 409, 410, 411, 412, 413, 414, 415,
+	81, 33, 462, 465, -315, 221, 227, 228, 429, 123,
+	122, 75, 223, -312, 359, 495, 572, -56, 574, 102,
+	104, 573, 44, 233, 575, 576, 577, 502, 578, 242,
+	579, 580, 581, 582, 588, 544, 589, 590, 591, 124,
+	7, -75, -259, -255, -342, -249, 89, 90, 91, 459,
+	245, 493, 494, 294, 81, 41, 356, 359, 495, 572,
+	295, 305, 319, 313, 340, 332, 460, 496, 469, 297,
+	246, 282, 569, 330, 132, 574, 300, 497, 260, 364,
+	365, 498, 366, 102, 308, 395, 587, 299, 499, 585,
+	104, 573, 79, 51, 44, 255, 328, 229, 324, 575,
+	283, 500, 471, 276, 123, 120, 594, 36, 322, 50,
+	30, 584, 122, 49, 576, 147, 501, 577, 502, 368,
+	347, 563, 48, 369, 261, 503, 84, 266, 464, 303,
+	571, 370, 323, 371, 293, 583, 226, 504, 556, 372,
+	373, 564, 505, 348, 352, 506, 387, 374, 601, 52,
+	507, 508, 565, 121, 509, 78, 578, 80, 317, 318,
+	510, 291, 244, 389, 344, 441, 448, 449, 108, 109,
+	444, 110, 450, 111, 451, 452, 453, 442, 112, 105,
+	443, 454, 455, 345, 346, 113, 456, 107, 106, 445,
+	447, 114, 457, 242, 35, 375, 461, 296, 58, 270,
+	390, 46, 350, 598, 45, 559, 511, 562, 343, 339,
+	438, 53, 512, 513, 514, 515, 579, 342, 316, 338,
+	593, 4, 288, 580, 62, 228, 354, 353, 355, 277,
+	386, 335, 516, 517, 518, 249, 82, 519, 325, 21,
+	520, 521, 376, 284, 522, 56, 523, 524, 393, 258,
+	525, 54, 581, 39, 526, 263, 595, 582, 527, 528,
+	529, 530, 265, 531, 378, 532, 377, 349, 351, 272,
+	379, 463, 533, 304, 321, 262, 586, 534, 250, 570,
+	264, 269, 257, 394, 251, 535, 536, 537, 538, 539,
+	298, 555, 540, 541, 309, 588, 439, 43, 542, 543,
+	544, 545, 546, 292, 287, 388, 397, 61, 83, 361,
+	547, 548, 568, 315, 285, 549, 306, 55, 589, 590,
+	591, 279, 592, 9, 599, 600, 381, 124, 289, 290,
+	47, 336, 271, 550, 301, 551, 326, 327, 341, 314,
+	337, 307, 557, 273, 382, 440, 259, 552, 396, 286,
+	357, 362, 302, 467, 278, 383, 567, 466, 334, 331,
+	280, 553, 384, 234, 274, 275, 554, 385, 281, 465,
+	312, 329, 363, 409, 410, 411, 412, 413, 414, 415,
 	416, 417, 418, 419, 420, 421, 422, 423, 424, 425,
-	436, 232, -75, 232, -158, -255, 232, -226, 364, -246,
-	366, 379, 374, 372, -238, 375, 377, 272, -356, 386,
-	232, 381, 220, 367, 376, 384, 281, -339, 173, 576,
-	591, 132, 332, 371, 369, 387, 558, -255, 306, -262,
-	311, -250, -339, -249, 309, -158, -81, 555, 224, -264,
-	-264, -104, 558, 560, -177, -119, 140, -130, -133, -124,
-	-125, -171, -172, -173, -174, -131, -184, 162, 163, 170,
-	141, -182, -134, 26, 457, 429, 428, 173, 31, 216,
-	68, 69, 431, 432, 143, 57, 404, 405, -132, 399,
-	406, 401, 434, 435, 115, 436, 105, 107, 106, 437,
-	438, 439, 344, 345, 440, 441, 442, 108, 109, 110,
-	111, 112, 113, 114, 443, 446, 444, 445, 447, 448,
-	449, 454, 455, 450, 451, 452, 453, 456, -250, -255,
-	237, 398, 231, 168, 427, -126, -122, -8, -180, 397,
-	402, 403, 407, 400, -339, -249, -128, -127, -167, 92,
-	98, 103, 99, -362, 116, -365, 93, 94, 95, 96,
-	97, 117, 118, 174, 175, 176, 177, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 44, 380, 380, -158, -75,
-	-75, -75, -192, -102, -194, -10, -8, -365, 8, -75,
-	-8, -9, -13, -34, -36, 488, -35, -255, -199, -214,
-	12, 158, 42, 50, -197, -198, -12, -8, -119, 19,
-	23, 24, -107, 164, -119, -255, -76, -107, -236, 236,
-	-75, -75, -225, -267, 306, -229, 387, 386, -251, -227,
-	-250, -248, -226, 385, 225, 23, 467, 139, 25, 27,
-	142, 173, 127, 19, 143, 37, 227, 332, 243, 172,
-	239, 428, 220, 72, 469, 399, 401, 397, 404, 430,
-	431, 398, 366, 31, 13, 471, 28, 253, 24, 38,
-	166, 222, 146, 472, 256, 26, 254, 115, 118, 474,
-	22, 75, 248, 14, 241, 40, 16, 475, 476, 17,
-	237, 236, 158, 233, 70, 11, 216, 29, 155, 66,
-	477, 134, 478, 479, 480, 481, 128, 68, 156, 20,
-	595, 402, 403, 33, 559, 457, 267, 168, 73, 59,
-	560, 140, 400, 482, 483, 116, 484, 119, 76, 565,
-	136, 18, 71, 42, 485, 268, 486, 238, 596, 487,
-	390, 488, 157, 223, 427, 69, 489, 231, 379, 8,
-	433, 32, 252, 240, 126, 67, 490, 232, 145, 434,
-	435, 235, 129, 117, 7, 133, 34, 12, 74, 77,
-	405, 406, 407, 57, 125, 461, 144, 15, 491, 391,
-	138, 132, -306, 156, -292, -296, -250, 247, -322, 243,
-	-158, -315, -314, -250, -365, -103, -245, 233, 241, 240,
-	133, -343, 136, 289, 398, 231, -51, -52, -53, -226,
-	172, 575, -85, 264, 268, 87, 87, -296, -295, -294,
-	-344, 268, 247, -321, -313, 239, 248, -302, 240, 241,
-	-297, 233, 134, -344, -297, 238, 248, 243, 247, 268,
-	268, 124, 268, 124, 268, 268, 268, 268, 268, 268,
-	268, 268, 268, 263, -303, 148, -303, 465, 465, -309,
-	-344, 243, 233, -344, -344, 235, 35, 35, -247, -297,
-	235, 25, -303, -303, -303, -226, 172, -303, -303, -303,
-	-303, 276, 276, -303, -303, -303, -303, -303, -303, -303,
-	-303, -303, -303, -303, -303, -303, -303, -303, -303, -303,
-	232, -343, -111, 384, 81, -54, 278, -37, -158, -245,
-	233, 234, -343, 265, -158, 217, -158, -240, 156, 15,
-	-240, -237, 380, 378, 365, 370, -240, -240, -240, 279,
-	363, -298, 233, 35, 244, 380, 279, 363, 279, 280,
-	279, 280, 373, 383, 279, -260, 14, 158, 398, 368,
-	372, 272, 232, 273, 234, 382, 380, 275, -265, -365,
-	-251, 332, -339, 561, -265, -265, 32, 90, 235, -250,
-	-77, -250, 93, -15, -11, -22, -21, -23, 148, -109,
-	380, -97, 173, 576, 562, 563, 564, 561, 377, 569,
-	567, 565, 279, 566, 87, 136, 138, 139, 4, -119,
-	155, -168, 148, 149, 150, 151, 152, 153, 154, 158,
-	140, 142, 156, 157, 137, 159, 160, 161, 162, 163,
-	164, 165, 167, 166, 168, 169, 172, 218, 219, -125,
-	-125, -125, -125, -182, -365, -365, -365, -125, -235, -365,
-	-125, -365, -365, -365, -365, -188, -119, -365, -369, -365,
-	-369, -369, -282, -365, -282, -365, -365, -365, -365, -365,
-	-365, -365, -365, -365, -365, -365, -365, -365, -365, -365,
-	-365, -365, -365, -365, -365, -365, -365, -365, -365, -365,
-	-365, -365, -365, -365, -365, -365, -365, -365, -365, -365,
-	-365, -365, 217, -365, -365, -365, -365, -365, -282, -282,
-	-282, -282, -282, 103, 98, 92, -184, 99, 93, -250,
-	-255, -8, -9, -177, -264, -353, -354, -161, -158, -365,
-	-250, -250, 265, -197, -12, -8, -192, -198, -194, -8,
-	-75, -95, -108, 63, 64, -110, 24, 38, 67, 65,
-	23, -366, 88, -366, -214, -366, 87, -36, -218, 86,
-	61, 43, 93, 93, 87, 21, -193, -195, -119, 14,
-	-253, 4, -252, 25, -250, 93, 217, 101, 14, -159,
-	29, -158, -236, -236, 87, 306, -229, -267, -231, -230,
-	388, 390, 148, -254, -250, 93, 31, 88, 87, -158,
-	-271, -274, -276, -275, -277, -272, -273, 329, 330, 173,
-	333, 335, 336, 337, 338, 339, 340, 341, 342, 343,
-	346, 32, 255, 325, 326, 327, 328, 347, 348, 349,
-	350, 351, 352, 353, 354, 312, 331, 459, 313, 314,
-	315, 316, 317, 318, 320, 321, 322, 323, 324, -342,
-	-339, 86, 88, 87, -278, 86, -119, -111, 232, -339,
-	233, 233, 233, -75, 427, -303, -303, -303, 263, 19,
-	-44, -41, -329, 18, -40, -41, 225, 120, 121, 222,
-	86, -292, 86, -301, -342, -339, 86, 134, 238, 133,
-	-300, -297, -300, -301, -339, -184, -339, 134, 134, -339,
-	-339, -222, -250, -222, -222, 23, -222, 23, -222, 23,
-	95, -250, -222, 23, -222, 23, -222, 23, -222, 23,
-	-222, 23, 31, 78, 79, 80, 31, 82, 83, 84,
-	-184, -339, -339, -184, -292, -226, -158, -339, 95, 95,
-	95, -303, -303, 95, 93, 93, 93, -303, -303, 95,
-	93, -257, -255, 93, 93, -345, 249, 293, 295, 95,
-	95, 95, 95, 31, 93, -346, 31, 583, 582, 584,
-	585, 586, 93, 95, 31, 95, 31, 95, -250, 86,
-	-158, -117, 283, 220, 222, 225, 76, 148, 44, 87,
-	235, 232, -339, -241, 237, -241, -250, -256, -255, -248,
-	93, -119, -299, 14, 158, -260, -260, -240, -158, -299,
-	-260, -240, -158, -240, -240, -240, -240, -260, -260, -260,
-	-240, -255, -255, -158, -158, -158, -158, -158, -158, -158,
-	-265, -234, 14, 76, -265, -265, -263, 309, 343, 556,
-	557, 87, 461, -151, -158, 561, 561, 561, 561, 561,
-	561, -119, -119, -119, -119, 484, -175, 116, 140, 117,
-	118, -133, -176, -180, -182, 100, 158, 142, 156, 157,
-	-124, -125, -124, -124, -124, -124, -124, -124, -124, -124,
-	-124, -124, -124, -124, -124, -266, -250, 93, 173, -129,
-	-128, 99, -362, -129, -105, -107, -119, -119, -339, -250,
-	-105, -105, -119, -101, 24, 38, -186, -187, 144, -184,
-	-366, -366, 95, 99, -250, -250, -94, -93, 409, 410,
-	411, 412, 414, 415, 416, 419, 420, 424, 425, 408,
-	426, 413, 418, 421, 422, 423, 417, 328, -119, -119,
-	-119, -119, -119, -119, -82, -119, 127, 128, 129, -119,
-	-119, -178, -177, -338, -337, -336, -119, -119, -119, -119,
+	426, 437, 232, -75, 232, -158, -255, 232, -226, 365,
+	-246, 367, 380, 375, 373, -238, 376, 378, 272, -359,
+	387, 232, 382, 220, 368, 377, 385, 281, -342, 173,
+	577, 592, 132, 333, 372, 370, 388, 559, -255, 307,
+	-262, 312, -250, -342, -249, 310, -158, -81, 556, 224,
+	-264, -264, -104, 559, 561, -177, -119, 140, -130, -133,
+	-124, -125, -171, -172, -173, -174, -131, -184, 162, 163,
+	170, 141, -182, -134, 26, 458, 430, 429, 173, 31,
+	216, 68, 69, 432, 433, 143, 57, 405, 406, -132,
+	400, 407, 402, 435, 436, 115, 437, 105, 107, 106,
+	438, 439, 440, 345, 346, 441, 442, 443, 108, 109,
+	110, 111, 112, 113, 114, 444, 447, 445, 446, 448,
+	449, 450, 455, 456, 451, 452, 453, 454, 457, -250,
+	-255, 237, 399, 231, 168, 428, -126, -122, -8, -180,
+	398, 403, 404, 408, 401, -342, -249, -128, -127, -167,
+	92, 98, 103, 99, -365, 116, -368, 93, 94, 95,
+	96, 97, 117, 118, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 44, 381, 381, -158,
+	-75, -75, -75, -192, -102, -194, -10, -8, -368, 8,
+	-75, -8, -9, -13, -34, -36, 489, -35, -255, -199,
+	-214, 12, 158, 42, 50, -197, -198, -12, -8, -119,
+	19, 23, 24, -107, 164, -119, -255, -76, -107, -236,
+	236, -75, -75, -225, -270, 307, -229, 388, 387, -251,
+	-227, -250, -248, -226, 386, 225, 23, 468, 139, 25,
+	27, 142, 173, 127, 19, 143, 37, 227, 333, 243,
+	172, 239, 429, 220, 72, 470, 400, 402, 398, 405,
+	431, 432, 399, 367, 31, 13, 472, 28, 253, 24,
+	38, 166, 222, 146, 473, 256, 26, 254, 115, 118,
+	475, 22, 75, 248, 14, 241, 40, 16, 476, 477,
+	17, 237, 236, 158, 233, 70, 11, 216, 29, 155,
+	66, 478, 134, 479, 480, 481, 482, 128, 68, 156,
+	20, 596, 403, 404, 33, 560, 458, 267, 168, 73,
+	59, 561, 140, 401, 483, 484, 116, 485, 119, 76,
+	566, 136, 18, 71, 42, 486, 268, 487, 238, 597,
+	488, 391, 489, 157, 223, 428, 69, 490, 231, 380,
+	8, 434, 32, 252, 240, 126, 67, 491, 232, 145,
+	435, 436, 235, 129, 117, 7, 133, 34, 12, 74,
+	77, 406, 407, 408, 57, 125, 462, 144, 15, 492,
+	392, 138, 132, -309, 156, -295, -299, -250, 247, -325,
+	243, -158, -318, -317, -250, -368, -103, -245, 233, 241,
+	240, 133, -346, 136, 289, 399, 231, -51, -52, -53,
+	-226, 172, 576, -85, 264, 268, 87, 87, -299, -298,
+	-297, -347, 268, 247, -324, -316, 239, 248, -305, 240,
+	241, -300, 233, 134, -347, -300, 238, 248, 243, 247,
+	268, 268, 124, 268, 124, 268, 268, 268, 268, 268,
+	268, 268, 268, 268, 263, -306, 148, -306, 466, 466,
+	-312, -347, 243, 233, -347, -347, 235, 35, 35, -247,
+	-300, 235, 25, -306, -306, -306, -226, 172, -306, -306,
+	-306, -306, 276, 276, -306, -306, -306, -306, -306, -306,
+	-306, -306, -306, -306, -306, -306, -306, -306, -306, -306,
+	-306, 232, -346, -111, 385, 81, -54, 278, -37, -158,
+	-245, 233, 234, -346, 272, 373, 265, -158, 217, -158,
+	-240, 156, 15, -240, -237, 381, 379, 366, 371, -240,
+	-240, -240, 279, 364, -301, 233, 35, 244, 381, 279,
+	364, 279, 280, 279, 280, 374, 384, 279, -260, 14,
+	158, 399, 369, 373, 272, 232, 273, 234, 383, 381,
+	275, -265, -368, -251, 333, -342, 562, -265, -265, 32,
+	90, 235, -250, -77, -250, 93, -15, -11, -22, -21,
+	-23, 148, -109, 381, -97, 173, 577, 563, 564, 565,
+	562, 378, 570, 568, 566, 279, 567, 87, 136, 138,
+	139, 4, -119, 155, -168, 148, 149, 150, 151, 152,
+	153, 154, 158, 140, 142, 156, 157, 137, 159, 160,
+	161, 162, 163, 164, 165, 167, 166, 168, 169, 172,
+	218, 219, -125, -125, -125, -125, -182, -368, -368, -368,
+	-125, -235, -368, -125, -368, -368, -368, -368, -188, -119,
+	-368, -372, -368, -372, -372, -285, -368, -285, -368, -368,
+	-368, -368, -368, -368, -368, -368, -368, -368, -368, -368,
+	-368, -368, -368, -368, -368, -368, -368, -368, -368, -368,
+	-368, -368, -368, -368, -368, -368, -368, -368, -368, -368,
+	-368, -368, -368, -368, -368, 217, -368, -368, -368, -368,
+	-368, -285, -285, -285, -285, -285, 103, 98, 92, -184,
+	99, 93, -250, -255, -8, -9, -177, -264, -356, -357,
+	-161, -158, -368, -250, -250, 265, -197, -12, -8, -192,
+	-198, -194, -8, -75, -95, -108, 63, 64, -110, 24,
+	38, 67, 65, 23, -369, 88, -369, -214, -369, 87,
+	-36, -218, 86, 61, 43, 93, 93, 87, 21, -193,
+	-195, -119, 14, -253, 4, -252, 25, -250, 93, 217,
+	101, 14, -159, 29, -158, -236, -236, 87, 307, -229,
+	-270, -231, -230, 389, 391, 148, -254, -250, 93, 31,
+	88, 87, -158, -274, -277, -279, -278, -280, -275, -276,
+	330, 331, 173, 334, 336, 337, 338, 339, 340, 341,
+	342, 343, 344, 347, 32, 255, 326, 327, 328, 329,
+	348, 349, 350, 351, 352, 353, 354, 355, 313, 332,
+	460, 314, 315, 316, 317, 318, 319, 321, 322, 323,
+	324, 325, -345, -342, 86, 88, 87, -281, 86, -119,
+	-111, 232, -342, 233, 233, 233, -75, 428, -306, -306,
+	-306, 263, 19, -44, -41, -332, 18, -40, -41, 225,
+	120, 121, 222, 86, -295, 86, -304, -345, -342, 86,
+	134, 238, 133, -303, -300, -303, -304, -342, -184, -342,
+	134, 134, -342, -342, -222, -250, -222, -222, 23, -222,
+	23, -222, 23, 95, -250, -222, 23, -222, 23, -222,
+	23, -222, 23, -222, 23, 31, 78, 79, 80, 31,
+	82, 83, 84, -184, -342, -342, -184, -295, -226, -158,
+	-342, 95, 95, 95, -306, -306, 95, 93, 93, 93,
+	-306, -306, 95, 93, -257, -255, 93, 93, -348, 249,
+	293, 296, 95, 95, 95, 95, 31, 93, -349, 31,
+	584, 583, 585, 586, 587, 93, 95, 31, 95, 31,
+	95, -250, 86, -158, -117, 283, 220, 222, 225, 76,
+	148, 44, 87, 235, 232, -342, -241, 237, -241, -241,
+	-241, -250, -256, -255, -248, 93, -119, -302, 14, 158,
+	-260, -260, -240, -158, -302, -260, -240, -158, -240, -240,
+	-240, -240, -260, -260, -260, -240, -255, -255, -158, -158,
+	-158, -158, -158, -158, -158, -265, -234, 14, 76, -265,
+	-265, -263, 310, 344, 557, 558, 87, 462, -151, -158,
+	562, 562, 562, 562, 562, 562, -119, -119, -119, -119,
+	485, -175, 116, 140, 117, 118, -133, -176, -180, -182,
+	100, 158, 142, 156, 157, -124, -125, -124, -124, -124,
+	-124, -124, -124, -124, -124, -124, -124, -124, -124, -124,
+	-269, -250, 93, 173, -129, -128, 99, -365, -129, -105,
+	-107, -119, -119, -342, -250, -105, -105, -119, -101, 24,
+	38, -186, -187, 144, -184, -369, -369, 95, 99, -250,
+	-250, -94, -93, 410, 411, 412, 413, 415, 416, 417,
+	420, 421, 425, 426, 409, 427, 414, 419, 422, 423,
+	424, 418, 329, -119, -119, -119, -119, -119, -119, -82,
+	-119, 127, 128, 129, -119, -119, -178, -177, -341, -340,
+	-339, -119, -119, -119, -119, -119, -119, -119, -119, -119,
 	-119, -119, -119, -119, -119, -119, -119, -119, -119, -119,
-	-119, -119, -119, -119, -119, -119, -119, -119, -119, -106,
-	24, 38, -105, -251, -256, -248, -105, -106, -106, -105,
-	-105, 217, -214, -366, 87, -355, 390, 391, 559, -258,
-	268, -257, 25, -178, 14, -220, 77, -250, -197, -197,
-	63, 64, 59, -105, -110, -366, -35, 25, -216, -250,
-	62, 93, -283, -226, 355, 356, 173, -119, -119, 87,
-	-196, 27, 28, -158, -252, 164, -256, -158, -221, 268,
-	-158, -136, -138, -139, -140, -161, -183, -365, -141, 480,
-	477, 14, -151, -152, -160, -255, -229, -231, 87, 389,
-	391, 392, 76, 119, -119, -284, 172, -311, -310, -309,
-	-292, -294, -295, -296, 88, -284, -288, 361, 360, -278,
-	-278, -278, -278, -278, -283, -283, -283, -283, 86, 86,
-	-278, -278, -278, -278, -286, 86, -286, -286, -287, 86,
-	-287, -322, -119, -319, -318, -316, -317, 242, 102, 553,
-	509, 461, 501, 543, 77, -314, -196, 95, -366, -117,
-	-242, 237, -320, -317, -339, -339, -339, -242, -339, 93,
-	-339, 93, -339, 93, -86, -58, -1, 595, 596, 597,
-	87, 19, -293, -292, -57, 293, -325, -326, 268, -321,
-	-315, -301, 134, -300, -301, -301, -339, 87, 29, 124,
-	124, 124, 124, 461, 222, 32, -243, 500, 140, 553,
-	509, -292, -57, -266, 235, -266, -266, 93, 93, -239,
-	591, -151, -113, 285, 148, 274, 274, 232, 232, 287,
-	-158, 286, 288, 290, 276, -158, -158, -241, 76, -153,
-	-158, 26, -255, -158, -240, -240, -158, -240, -240, -158,
-	-250, -97, 390, 87, 461, 22, -98, 22, -365, 116,
-	117, 118, -176, -124, -125, -124, 139, 256, 87, -366,
-	25, 87, 77, -366, -366, -366, 87, 14, -105, -189,
-	-187, 146, -119, -366, -366, -366, 87, 87, 14, -270,
-	25, -366, -366, -366, -366, -366, -188, -366, 14, 87,
-	87, -366, -366, 87, 87, -366, 87, 87, 87, -335,
-	87, 87, 87, 87, -366, -366, -366, -366, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 87, -366, -366,
-	-105, -105, -365, 217, -366, -366, -366, -366, -366, -251,
-	-354, 558, 391, -165, -164, -162, 74, 236, 75, -365,
-	-257, -366, -129, 90, -217, 90, -216, -170, -250, 95,
-	99, -199, -135, -137, 14, -110, -182, 88, 87, -283,
-	-203, -208, -237, -250, 93, 173, -285, 173, -285, 355,
-	356, -195, 217, -166, 15, -169, 32, 57, -11, -365,
-	-365, 32, 87, -154, -156, -155, -157, 66, 70, 72,
-	67, 68, 69, 73, -261, 25, -136, -9, -8, -365,
-	-365, -365, -158, -151, -367, 14, 77, -367, 87, 217,
-	-230, -232, 393, 390, 396, -339, 93, -85, 87, -309,
-	-296, -200, -114, 40, -289, 362, -283, 468, -283, -291,
-	93, -291, 95, 95, 88, -47, -42, -43, 33, 81,
-	-316, -303, 93, 39, -303, -303, -250, 88, -196, -113,
-	-158, 140, 76, -320, -320, -320, -255, -2, 594, 600,
-	134, 86, 365, 18, -216, 87, 88, -185, 294, 88,
-	-87, -250, 88, 86, -301, -301, -250, -365, 232, 31,
-	31, 553, 509, 500, -57, -185, -284, -339, 593, 592,
-	88, 234, 292, -118, 404, -115, 93, 89, -158, -158,
+	-119, -119, -119, -119, -106, 24, 38, -105, -251, -256,
+	-248, -105, -106, -106, -105, -105, 217, -214, -369, 87,
+	-358, 391, 392, 560, -258, 268, -257, 25, -178, 14,
+	-220, 77, -250, -197, -197, 63, 64, 59, -105, -110,
+	-369, -35, 25, -216, -250, 62, 93, -286, -226, 356,
+	357, 173, -119, -119, 87, -196, 27, 28, -158, -252,
+	164, -256, -158, -221, 268, -158, -136, -138, -139, -140,
+	-161, -183, -368, -141, 481, 478, 14, -151, -152, -160,
+	-255, -229, -231, 87, 390, 392, 393, 76, 119, -119,
+	-287, 172, -314, -313, -312, -295, -297, -298, -299, 88,
+	-287, -291, 362, 361, -281, -281, -281, -281, -281, -286,
+	-286, -286, -286, 86, 86, -281, -281, -281, -281, -289,
+	86, -289, -289, -290, 86, -290, -325, -119, -322, -321,
+	-319, -320, 242, 102, 554, 510, 462, 502, 544, 77,
+	-317, -196, 95, -369, -117, -242, 237, -323, -320, -342,
+	-342, -342, -242, -342, 93, -342, 93, -342, 93, -86,
+	-58, -1, 596, 597, 598, 87, 19, -296, -295, -57,
+	293, -328, -329, 268, -324, -318, -304, 134, -303, -304,
+	-304, -342, 87, 29, 124, 124, 124, 124, 462, 222,
+	32, -243, 501, 140, 554, 510, -295, -57, -269, 235,
+	-269, -269, 93, 93, -239, 592, -151, -113, 285, 148,
+	274, 274, 232, 232, 287, -158, 286, 288, 290, 276,
+	-158, -158, -241, 76, -153, -158, 26, -255, -158, -158,
+	-158, -240, -240, -158, -240, -240, -158, -250, -97, 391,
+	87, 462, 22, -98, 22, -368, 116, 117, 118, -176,
+	-124, -125, -124, 139, 256, 87, -369, 25, 87, 77,
+	-369, -369, -369, 87, 14, -105, -189, -187, 146, -119,
+	-369, -369, -369, 87, 87, 14, -273, 25, -369, -369,
+	-369, -369, -369, -188, -369, 14, 87, 87, -369, -369,
+	87, 87, -369, 87, 87, 87, -338, 87, 87, 87,
+	87, -369, -369, -369, -369, 87, 87, 87, 87, 87,
+	87, 87, 87, 87, 87, -369, -369, -105, -105, -368,
+	217, -369, -369, -369, -369, -369, -251, -357, 559, 392,
+	-165, -164, -162, 74, 236, 75, -368, -257, -369, -129,
+	90, -217, 90, -216, -170, -250, 95, 99, -199, -135,
+	-137, 14, -110, -182, 88, 87, -286, -203, -208, -237,
+	-250, 93, 173, -288, 173, -288, 356, 357, -195, 217,
+	-166, 15, -169, 32, 57, -11, -368, -368, 32, 87,
+	-154, -156, -155, -157, 66, 70, 72, 67, 68, 69,
+	73, -261, 25, -136, -9, -8, -368, -368, -368, -158,
+	-151, -370, 14, 77, -370, 87, 217, -230, -232, 394,
+	391, 397, -342, 93, -85, 87, -312, -299, -200, -114,
+	40, -292, 363, -286, 469, -286, -294, 93, -294, 95,
+	95, 88, -47, -42, -43, 33, 81, -319, -306, 93,
+	39, -306, -306, -250, 88, -196, -113, 273, 272, 373,
+	-158, 140, 76, -323, -323, -323, -255, -2, 595, 601,
+	134, 86, 366, 18, -216, 87, 88, -185, 294, 88,
+	-87, -250, 88, 86, -304, -304, -250, -368, 232, 31,
+	31, 554, 510, 501, -57, -185, -287, -342, 594, 593,
+	88, 234, 292, -118, 405, -115, 93, 89, -158, -158,
 	-158, -158, -158, 225, 222, 277, 235, -151, -158, 87,
-	-80, 251, 246, -260, -260, 33, -158, 390, 570, 568,
-	-119, 139, 256, -133, -125, -107, 458, -268, 173, 329,
-	255, 327, 323, 343, 334, 360, 325, 361, 322, 321,
-	320, -268, -266, -119, -119, -192, 147, -119, 145, -119,
-	-119, -119, -366, 173, 329, 14, -119, -119, -119, -336,
-	-119, -119, -119, -334, -333, -129, -184, -366, -334, -119,
-	-119, -333, -333, -337, -337, -337, -337, -337, -177, -177,
-	-177, -177, -366, -366, -106, -251, -162, -297, -297, -297,
+	-80, 251, 246, -260, -260, 33, -158, 391, 571, 569,
+	-119, 139, 256, -133, -125, -107, 459, -271, 173, 330,
+	255, 328, 324, 344, 335, 361, 326, 362, 323, 322,
+	321, -271, -269, -119, -119, -192, 147, -119, 145, -119,
+	-119, -119, -369, 173, 330, 14, -119, -119, -119, -339,
+	-119, -119, -119, -337, -336, -129, -184, -369, -337, -119,
+	-119, -336, -336, -340, -340, -340, -340, -340, -177, -177,
+	-177, -177, -369, -369, -106, -251, -162, -300, -300, -300,
 	-222, 87, -233, 22, 14, 57, 57, -135, -166, -136,
-	-110, -250, -206, 555, -211, 46, -209, -210, 47, -207,
-	48, 56, -285, -285, 164, -197, -119, -223, 76, -224,
-	-228, -184, -179, -181, -180, -365, -215, -366, -250, -222,
+	-110, -250, -206, 556, -211, 46, -209, -210, 47, -207,
+	48, 56, -288, -288, 164, -197, -119, -223, 76, -224,
+	-228, -184, -179, -181, -180, -368, -215, -369, -250, -222,
 	-224, -138, -139, -139, -138, -139, 66, 66, 66, 71,
-	66, 71, 66, -155, -255, -366, -366, -9, -9, -119,
-	-258, 77, -136, -136, -160, -255, 164, 390, 394, 395,
-	-309, -361, 116, 140, 31, 76, 358, 102, -359, 172,
-	497, 548, 553, 509, 501, 543, -360, 238, 133, 134,
+	66, 71, 66, -155, -255, -369, -369, -9, -9, -119,
+	-258, 77, -136, -136, -160, -255, 164, 391, 395, 396,
+	-312, -364, 116, 140, 31, 76, 359, 102, -362, 172,
+	498, 549, 554, 510, 502, 544, -363, 238, 133, 134,
 	250, 25, 41, 88, 87, 88, 87, 88, 87, -244,
-	-243, -43, -42, -303, -303, 95, -339, 93, 93, 234,
-	26, -158, 76, 76, 76, -88, 598, 95, 86, -3,
-	81, -119, 86, 19, -292, -184, -327, -279, -328, -280,
-	-281, -5, -6, -304, -91, 57, 102, -61, 44, 233,
-	578, 579, 124, -365, 591, -319, -216, -323, -325, -158,
-	-121, -365, -120, -122, -126, 162, 163, -185, -158, -112,
-	283, 291, 86, -116, 90, -341, 77, 274, 358, 274,
-	-158, -80, -47, -158, -240, -240, 33, -339, -366, -133,
-	-125, -365, -366, -278, -278, -278, -287, -278, 317, -278,
-	317, -278, -366, -366, 87, -366, 22, -366, -96, 433,
-	-119, 87, 87, -366, 86, 86, -119, -366, -366, -366,
-	-335, 87, -366, 87, -366, 87, -269, 554, -366, -366,
-	-366, -366, -366, -366, -366, -366, -366, -366, -366, -163,
-	22, -163, -163, -366, 90, -158, -166, -190, 16, -203,
-	51, 335, -213, -212, 55, 47, -210, 19, 49, 19,
-	30, -223, 87, 148, 87, -366, -366, 87, 57, 217,
-	-366, -166, -149, -148, 76, 77, -150, 76, -148, 66,
-	66, -218, -366, -366, 87, -221, -136, -166, -166, 217,
-	116, -365, -123, -132, -121, 12, 93, 93, -339, -358,
-	582, 583, 31, 95, -303, -303, 134, 134, -158, 86,
-	-283, 93, -283, 95, 95, 31, 82, 83, 84, 31,
-	78, 79, 80, -158, -158, -158, -158, -324, 86, 19,
-	-119, 86, 148, 88, -216, -216, 270, 158, -303, 576,
-	276, 276, -303, -303, -303, -90, -89, 598, 88, -366,
-	87, -290, 461, 464, -119, -127, -127, -218, 88, -332,
-	461, -340, -250, -250, -250, -250, -124, -283, -119, -119,
-	-197, 93, -119, -119, 95, 95, -366, -366, -334, -333,
-	-119, -366, -146, -145, -147, 562, 116, 31, -268, -365,
-	66, 18, 16, -365, -365, -258, -190, -191, 17, 19,
-	-204, 53, -202, 52, -202, -212, 19, 19, 93, 19,
-	93, 134, -228, -119, -181, 57, -11, -250, -179, -250,
-	-192, -119, 86, -119, -129, -166, -166, -119, -172, 436,
-	105, 107, 106, 437, 438, 439, 344, 345, 440, 441,
-	442, 108, 109, 110, 111, 112, 113, 114, 443, 446,
-	444, 447, 448, 449, 454, 455, 450, 451, 452, 453,
-	456, 93, 93, 86, -119, 88, 88, -218, -323, -58,
-	88, -216, 95, 88, 271, -180, -365, 93, -303, -303,
-	-303, 95, 95, -257, -366, 87, -250, -360, -325, 465,
-	465, -366, 25, -331, -330, -251, 86, 77, -100, 158,
-	461, -366, -366, -366, -366, -366, 88, 88, -366, -366,
-	87, -366, -145, -147, -366, 76, -129, -219, -366, -250,
-	238, 19, 19, -219, -219, -165, -191, -119, -177, -205,
-	54, 76, 119, 93, 93, 93, 12, -179, 217, -197,
-	-216, -143, 365, -192, -366, -216, 88, 25, 88, 600,
-	134, 88, -180, -99, -365, 267, -257, 93, 93, -89,
-	-92, -11, 87, 148, -216, -158, -366, 459, 73, 462,
-	-119, -366, 76, 472, 562, 87, -366, -366, -366, 76,
-	119, -224, -250, 88, -366, -365, -197, 88, -201, -11,
-	86, -3, 267, -279, -328, -280, -281, -5, -6, -304,
-	-78, 461, -330, -308, -251, 93, 95, 88, 62, 460,
-	463, -335, -250, 238, -258, -144, -142, -250, 515, -351,
-	-350, 457, -361, -357, 116, 140, 102, -359, 553, 509,
-	125, 126, -78, -119, 86, -366, -79, 282, 558, -341,
-	62, -366, -366, 87, 22, -271, -60, 526, -348, -349,
-	76, -352, 371, 525, 546, 116, 93, 88, -216, 243,
-	-332, 461, -142, 522, -284, -129, -349, 76, -348, 76,
-	13, 12, -4, 599, 88, 284, 462, -59, 26, -143,
-	-347, 251, 246, 249, 32, -347, 95, -4, 463, 526,
-	245, 31, 116, -129, -146, -145, -145,
+	-243, -43, -42, -306, -306, 95, -342, 93, 93, 234,
+	-250, -158, -158, 26, -158, 76, 76, 76, -88, 599,
+	95, 86, -3, 81, -119, 86, 19, -295, -184, -330,
+	-282, -331, -283, -284, -5, -6, -307, -91, 57, 102,
+	-61, 44, 233, 579, 580, 124, -368, 592, -322, -216,
+	-326, -328, -158, -121, -368, -120, -122, -126, 162, 163,
+	-185, -158, -112, 283, 291, 86, -116, 90, -344, 77,
+	274, 359, 274, -158, -80, -47, -158, -240, -240, 33,
+	-342, -369, -133, -125, -368, -369, -281, -281, -281, -290,
+	-281, 318, -281, 318, -281, -369, -369, 87, -369, 22,
+	-369, -96, 434, -119, 87, 87, -369, 86, 86, -119,
+	-369, -369, -369, -338, 87, -369, 87, -369, 87, -272,
+	555, -369, -369, -369, -369, -369, -369, -369, -369, -369,
+	-369, -369, -163, 22, -163, -163, -369, 90, -158, -166,
+	-190, 16, -203, 51, 336, -213, -212, 55, 47, -210,
+	19, 49, 19, 30, -223, 87, 148, 87, -369, -369,
+	87, 57, 217, -369, -166, -149, -148, 76, 77, -150,
+	76, -148, 66, 66, -218, -369, -369, 87, -221, -136,
+	-166, -166, 217, 116, -368, -123, -132, -121, 12, 93,
+	93, -342, -361, 583, 584, 31, 95, -306, -306, 134,
+	134, -158, 86, -286, 93, -286, 95, 95, 31, 82,
+	83, 84, 31, 78, 79, 80, -158, -267, 295, 294,
+	86, 86, -158, -158, -158, -327, 86, 19, -119, 86,
+	148, 88, -216, -216, 270, 158, -306, 577, 276, 276,
+	-306, -306, -306, -90, -89, 599, 88, -369, 87, -293,
+	462, 465, -119, -127, -127, -218, 88, -335, 462, -343,
+	-250, -250, -250, -250, -124, -286, -119, -119, -197, 93,
+	-119, -119, 95, 95, -369, -369, -337, -336, -119, -369,
+	-146, -145, -147, 563, 116, 31, -271, -368, 66, 18,
+	16, -368, -368, -258, -190, -191, 17, 19, -204, 53,
+	-202, 52, -202, -212, 19, 19, 93, 19, 93, 134,
+	-228, -119, -181, 57, -11, -250, -179, -250, -192, -119,
+	86, -119, -129, -166, -166, -119, -172, 437, 105, 107,
+	106, 438, 439, 440, 345, 346, 441, 442, 443, 108,
+	109, 110, 111, 112, 113, 114, 444, 447, 445, 448,
+	449, 450, 455, 456, 451, 452, 453, 454, 457, 93,
+	93, 86, -119, 88, 88, -218, -268, 11, 12, 13,
+	-266, -266, -326, -58, 88, -216, 95, 88, 271, -180,
+	-368, 93, -306, -306, -306, 95, 95, -257, -369, 87,
+	-250, -363, -328, 466, 466, -369, 25, -334, -333, -251,
+	86, 77, -100, 158, 462, -369, -369, -369, -369, -369,
+	88, 88, -369, -369, 87, -369, -145, -147, -369, 76,
+	-129, -219, -369, -250, 238, 19, 19, -219, -219, -165,
+	-191, -119, -177, -205, 54, 76, 119, 93, 93, 93,
+	12, -179, 217, -197, -216, -143, 366, -192, -369, -216,
+	88, 25, 76, 88, 601, 134, 88, -180, -99, -368,
+	267, -257, 93, 93, -89, -92, -11, 87, 148, -216,
+	-158, -369, 460, 73, 463, -119, -369, 76, 473, 563,
+	87, -369, -369, -369, 76, 119, -224, -250, 88, -369,
+	-368, -197, 88, -201, -11, -158, 86, -3, 267, -282,
+	-331, -283, -284, -5, -6, -307, -78, 462, -333, -311,
+	-251, 93, 95, 88, 62, 461, 464, -338, -250, 238,
+	-258, -144, -142, -250, 516, -354, -353, 458, -364, -360,
+	116, 140, 102, -362, 554, 510, 125, 126, -78, 22,
+	-119, 86, -369, -79, 282, 559, -344, 62, -369, -369,
+	87, 22, -274, -60, 527, -351, -352, 76, -355, 372,
+	526, 547, 116, 93, -266, 88, -216, 243, -335, 462,
+	-142, 523, -287, -129, -352, 76, -351, 76, 13, 12,
+	-4, 600, 88, 284, 463, -59, 26, -143, -350, 251,
+	246, 249, 32, -350, 95, -4, 464, 527, 245, 31,
+	116, -129, -146, -145, -145,
 }
 
 var mysqDef = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 34, 35, 67, 69, 70, 799, 799,
-	799, 0, 799, 0, 0, 799, -2, -2, 799, 1286,
-	0, 799, 0, 0, -2, 733, 735, 0, 737, -2,
-	0, 0, 746, 1781, 1781, 794, 0, 0, 0, 0,
-	0, 799, 799, 799, 1146, 47, 799, 0, 82, 83,
-	751, 752, 753, 62, 0, 1779, 1, 3, 68, 72,
-	0, 0, 0, 55, 1155, 0, 75, 0, 0, 803,
-	0, 801, 0, 1269, 799, 799, 0, 113, 114, 0,
-	0, 0, -2, 117, -2, 146, 147, 148, 0, 153,
-	558, 495, 546, 493, 531, -2, 486, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
-	376, 376, 0, 0, -2, 486, 486, 486, 0, 0,
-	0, 528, 1271, 438, 376, 376, 376, 0, 376, 376,
-	376, 376, 0, 0, 376, 376, 376, 376, 376, 376,
-	376, 376, 376, 376, 376, 376, 376, 376, 376, 376,
-	376, 1173, 152, 1287, 1284, 1285, 37, 38, 39, 1437,
-	1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447,
+	31, 32, 33, 34, 35, 67, 69, 70, 804, 804,
+	804, 0, 804, 0, 0, 804, -2, -2, 804, 1296,
+	0, 804, 0, 0, -2, 738, 740, 0, 742, -2,
+	0, 0, 751, 1792, 1792, 799, 0, 0, 0, 0,
+	0, 804, 804, 804, 1151, 47, 804, 0, 82, 83,
+	756, 757, 758, 62, 0, 1790, 1, 3, 68, 72,
+	0, 0, 0, 55, 1160, 0, 75, 0, 0, 808,
+	0, 806, 0, 1279, 804, 804, 0, 113, 114, 0,
+	0, 0, -2, 120, -2, 149, 150, 151, 0, 156,
+	561, 498, 549, 496, 534, -2, 489, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
+	379, 379, 0, 0, -2, 489, 489, 489, 0, 0,
+	0, 531, 1281, 441, 379, 379, 379, 0, 379, 379,
+	379, 379, 0, 0, 379, 379, 379, 379, 379, 379,
+	379, 379, 379, 379, 379, 379, 379, 379, 379, 379,
+	379, 1178, 155, 1297, 1294, 1295, 37, 38, 39, 1447,
 	1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457,
 	1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467,
 	1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477,
@@ -7366,253 +7411,257 @@ var mysqDef = [...]int{
 	1748, 1749, 1750, 1751, 1752, 1753, 1754, 1755, 1756, 1757,
 	1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766, 1767,
 	1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775, 1776, 1777,
-	1778, 0, 1263, 0, 671, 900, 0, 724, 724, 0,
-	724, 724, 724, 0, 0, 0, 682, 0, 0, 0,
-	0, 721, 0, 698, 699, 0, 705, 1782, 0, 1782,
-	1782, 1254, 0, 718, 716, 728, 729, 732, 736, 0,
-	741, 744, 1280, 1281, 0, 758, 46, 1525, 750, 763,
-	764, 0, 795, 796, 42, 997, 0, 922, 927, 938,
-	951, 952, 953, 954, 955, 957, 958, 0, 0, 0,
-	0, 963, 964, 0, 0, 0, 0, 0, 978, 0,
-	0, 0, 0, 0, 1127, 0, 1090, 1090, 1014, 1090,
-	1092, 1092, 0, 0, 0, 1778, 1566, 1574, 1575, 1597,
-	1683, 1732, 1570, 1571, 1552, 1564, 1567, 1555, 1556, 1558,
-	1560, 1565, 1572, 1578, 1557, 1577, 1576, 0, 1553, 1554,
-	1559, 1569, 1573, 1561, 1562, 1563, 1568, 1579, 1136, 0,
-	0, 0, 0, 0, 0, 272, 273, 996, 1243, 1092,
-	1092, 1092, 1092, 1092, -2, -2, 218, 219, 220, 221,
-	222, 223, 224, 0, 214, 0, 277, 278, 274, 275,
-	276, 980, 981, 230, 231, 232, 233, 234, 235, 236,
-	237, 238, 239, 240, 241, 242, 243, 244, 245, 246,
-	247, 248, 249, 250, 251, 252, 253, 254, 255, 256,
-	257, 258, 259, 260, 261, 262, 263, 264, 265, 266,
-	267, 268, 269, 270, 271, 1781, 0, 773, 0, 0,
-	0, 0, 1155, 0, 1147, 1146, 60, 0, 799, -2,
-	0, 0, 0, 0, 44, 0, 49, 859, 74, 73,
-	1195, 0, 0, 0, 56, 1156, 64, 66, 1157, 0,
-	804, 805, 0, 835, 839, 0, 800, 0, 0, 1270,
-	1269, 1269, 99, 0, 1728, 1247, -2, -2, 0, 0,
-	1282, 1283, 1256, -2, 1290, 1291, 1292, 1293, 1294, 1295,
-	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
-	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
-	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
-	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
-	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
-	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
-	1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385,
-	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
-	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
-	1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415,
-	1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425,
-	1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435,
-	1436, -2, 0, 0, 164, 165, 0, 40, 403, 0,
-	160, 0, 396, 337, 0, 1173, 0, 0, 0, 0,
-	0, 799, 0, 1264, 141, 142, 149, 150, 151, 376,
-	376, 376, 543, 0, 0, 152, 152, 502, 503, 504,
-	0, 0, -2, 401, 0, 487, 0, 0, 390, 390,
-	394, 392, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 520, 0, 521, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 619, 0, 377, 0, 541, 542, 439,
-	0, 0, 0, 0, 0, 0, 518, 519, 0, 0,
-	1272, 1273, 0, 0, 0, 376, 376, 0, 0, 0,
-	0, 376, 376, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 140, 1186, 0, 0, -2, 0, 663, 0, 0,
-	0, 1265, 1265, 0, 670, 0, 672, 673, 0, 0,
-	674, 0, 721, 721, 719, 720, 676, 677, 678, 724,
-	0, 0, 385, 386, 387, 721, 724, 0, 724, 724,
-	724, 724, 721, 721, 721, 724, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1782, 702, 703, 706, 1783,
-	1784, 1303, 1782, 1782, 710, 714, 1255, 734, 742, 745,
-	761, 759, 760, 762, 754, 755, 756, 757, 0, 774,
-	775, 780, 0, 0, 0, 0, 786, 787, 788, 0,
-	0, 791, 792, 793, 0, 0, 0, 0, 0, 920,
-	0, 0, 986, 987, 988, 989, 990, 991, 992, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 959,
-	960, 961, 962, 965, 0, 0, 0, 970, 971, 0,
-	0, 0, 0, 0, 809, 0, 1128, 0, 1012, 0,
-	1013, 1015, 1016, 0, 1017, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1275, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 819, 0, 0, 819, 819, 0, 0, 207, 208,
-	209, 210, 211, 225, 226, 227, 228, 229, 279, 1136,
-	0, 996, 0, 0, 43, 765, 766, 0, 883, 1275,
-	0, 815, 0, 54, 63, 65, 1155, 58, 1155, 0,
-	821, 0, 0, -2, -2, 822, 828, 829, 830, 831,
-	832, 51, 1780, 52, 0, 71, 0, 45, 0, 0,
-	0, 0, 349, 1198, 0, 0, 1148, 1149, 1152, 0,
-	836, 1608, 840, 0, 842, 843, 0, 802, 0, 97,
-	0, 899, 0, 0, 0, 1728, 1253, 0, 101, 102,
-	0, 0, 0, 360, 1258, 1259, 1260, -2, 383, 0,
-	360, 344, 287, 288, 289, 337, 291, 337, 337, 337,
-	337, 349, 349, 349, 349, 320, 321, 322, 323, 324,
-	0, 0, 306, 337, 337, 337, 337, 327, 328, 329,
-	330, 331, 332, 333, 334, 292, 293, 294, 295, 296,
-	297, 298, 299, 300, 339, 339, 339, 341, 341, 0,
-	41, 0, 364, 0, 1152, 0, 0, 1186, 1267, 1277,
-	0, 0, 0, 1267, 119, 0, 0, 0, 544, 569,
-	496, 532, 545, 0, 499, 500, -2, 0, 0, 486,
-	0, 488, 0, 384, 0, -2, 0, 394, 0, 390,
-	394, 391, 394, 382, 395, 522, 523, 524, 0, 526,
-	527, 599, 869, 0, 0, 0, 0, 0, 605, 606,
-	607, 0, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 618, 533, 534, 535, 536, 537, 538, 539, 540,
-	0, 0, 0, 0, 488, 0, 529, 0, 440, 441,
-	442, 0, 0, 445, 446, 447, 448, 0, 0, 451,
-	452, 453, 886, 887, 454, 455, 480, 481, 482, 456,
-	457, 458, 459, 460, 461, 462, 474, 475, 476, 477,
-	478, 479, 463, 464, 465, 466, 467, 468, 471, 0,
-	134, 1177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1265, 0, 0, 0, 0, 818, 901, 1288, 1289,
-	725, 726, 0, 388, 389, 724, 724, 679, 711, 0,
-	724, 683, 712, 684, 686, 685, 687, 700, 701, 724,
-	690, 722, 723, 691, 692, 693, 694, 695, 696, 697,
-	707, 0, 730, 731, 708, 709, 0, 743, 747, 748,
-	749, 0, 0, 777, 93, 782, 783, 784, 785, 797,
-	790, 998, 917, 918, 919, 0, 921, 924, 0, 982,
-	984, 926, 928, 993, 994, 995, 0, 0, 0, 0,
-	0, 932, 936, 939, 940, 941, 942, 943, 944, 945,
-	946, 947, 948, 949, 950, 956, 1106, 1107, 1108, 973,
-	280, 281, 0, 974, 0, 833, 0, 0, 0, 972,
-	0, 0, 0, 0, 810, 811, 1134, 1131, 0, 0,
-	1091, 1093, 0, 0, 0, 0, 0, 1069, 1070, 1071,
-	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
-	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1109, 0,
-	0, 0, 0, 0, 1127, 0, 975, 976, 977, 0,
-	0, 0, 1276, 0, 129, 130, 0, 0, 0, 0,
-	0, 1063, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 820, 1137, 0, -2, 0, 0, 0, 0,
-	0, 0, 0, 1242, 0, 768, 769, 771, 0, 903,
-	0, 884, 0, 0, 0, 814, 0, 817, 57, 59,
-	826, 827, 0, 844, 823, 53, 48, 0, 0, 861,
-	1196, 349, 1218, 0, 358, 358, 355, 1158, 1159, 0,
-	1151, 1153, 1154, 76, 841, 837, 0, 915, 0, 0,
-	898, 0, 847, 849, 850, 851, 881, 0, 854, 0,
-	0, 0, 0, 0, 95, 900, 1248, 100, 0, 0,
-	105, 106, 1249, 1250, 1251, 1252, 0, 558, -2, 435,
-	166, 168, 169, 170, 161, -2, 347, 345, 346, 290,
-	349, 349, 314, 315, 316, 317, 318, 319, 0, 0,
-	307, 308, 309, 310, 301, 0, 302, 303, 304, 0,
-	305, 402, 0, 1160, 365, 366, 368, 376, 0, 371,
-	372, 0, 376, 376, 0, 397, 398, 0, 1152, 1177,
-	0, 0, 0, 1278, 1277, 1277, 1277, 0, 154, 155,
-	156, 157, 158, 159, 594, 0, 0, 570, 592, 593,
-	152, 0, 0, 162, 490, 489, 0, 626, 0, 400,
-	0, 0, 394, 394, 379, 380, 525, 0, 0, 601,
-	602, 603, 604, 0, 0, 0, 512, 429, 0, 513,
-	514, 488, 490, 360, 0, 443, 444, 449, 450, 469,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1174, 1175, 1176, 0, 0, 664, 0, 0, 426,
-	91, 1266, 669, 721, 689, 713, 721, 681, 688, 704,
-	739, 781, 0, 0, 0, 0, 789, 0, 0, 925,
-	983, 985, 929, 0, 933, 937, 0, 0, 0, 0,
-	0, 0, 0, 979, 1003, 1004, 0, 0, 1146, 0,
-	1132, 0, 0, 1011, 1094, 1095, 0, 0, 0, 0,
-	0, 1022, 1023, 1024, 1025, 1026, 0, 1028, 0, 0,
-	0, 1032, 1033, 0, 0, 1034, 0, 0, 0, 0,
-	0, 0, 0, 0, 1046, 1047, 1048, 1049, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1060, 999,
-	0, 0, 819, 0, 1096, 1097, 1098, 1099, 1100, 1137,
-	767, 770, 772, 857, 904, 905, 0, 0, 0, 0,
-	885, 1274, 812, 813, 816, -2, 0, 0, 1139, 0,
-	0, 844, 915, 845, 0, 824, 50, 860, 0, 1200,
-	1199, 1212, 1225, 358, 358, 352, 353, 359, 354, 356,
-	357, 1150, 0, 1155, 0, 1236, 0, 0, 1228, 0,
-	0, 0, 0, 0, 0, 0, 0, 888, 0, 0,
-	891, 0, 0, 0, 0, 882, 0, 0, 0, 0,
-	0, 0, -2, 0, 0, 89, 90, 0, 0, 0,
-	103, 104, 0, 0, 110, 361, 362, 143, 152, 437,
-	167, 410, 0, 0, 286, 348, 311, 312, 313, 0,
-	335, 0, 0, 0, 431, 115, 1164, 1163, 376, 376,
-	367, 0, 370, 0, 0, 0, 1279, 338, 399, 0,
-	133, 0, 0, 0, 0, 0, 139, 564, 0, 0,
-	571, 0, 0, 0, 494, 0, 505, 506, 0, 598,
-	-2, 660, 364, 0, 378, 381, 870, 0, 0, 507,
-	0, 510, 511, 430, 490, 516, 517, 530, 472, 473,
-	470, 0, 0, 1187, 1188, 1193, 1191, 1192, 120, 551,
-	553, 552, 556, 0, 0, 549, 0, 426, 1160, 0,
-	668, 427, 428, 724, 724, 776, 94, 0, 779, 0,
-	0, 0, 0, 930, 934, 834, 0, 0, 337, 337,
-	1114, 337, 341, 1117, 337, 1119, 337, 1122, 337, 1125,
-	1126, 0, 0, 0, 0, 1129, 1010, 1135, 0, 0,
-	0, 0, 1021, 0, 0, 0, 0, 0, 0, 131,
-	132, 1063, 0, 0, 1065, 1067, 1068, 1038, 1064, 0,
-	0, 1061, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1000, 1001, 0, 1138, 906, 911, 911, 911,
-	0, 0, 0, 1261, 1262, 1140, 1141, 915, 1142, 846,
-	825, 862, 1218, 0, 1211, 0, -2, 1220, 0, 0,
-	0, 1226, 350, 351, 838, 77, 916, 80, 0, 1236,
-	1244, 0, 1227, 1238, 1240, 0, 0, 0, 1232, 0,
-	915, 848, 877, 879, 0, 874, 889, 890, 892, 0,
-	894, 0, 896, 897, 859, 853, 855, 0, 0, 0,
-	97, 0, 915, 915, 96, 0, 902, 107, 108, 109,
-	436, 171, 176, 0, 0, 0, 181, 0, 183, 0,
-	0, 0, 188, 189, 376, 376, 411, 0, 283, 285,
-	0, 0, 174, 349, 0, 349, 0, 342, 0, 412,
-	432, 1161, 1162, 0, 0, 369, 373, 374, 375, 0,
-	1268, 135, 0, 0, 0, 567, 0, 595, 0, 0,
-	0, 0, 0, 0, 163, 491, 627, 628, 629, 630,
-	631, 632, 633, 634, 635, 0, 376, 0, 0, 0,
-	376, 376, 376, 0, 652, 363, 0, 0, 623, 620,
-	508, 0, 212, 213, 215, 0, 0, 515, 859, 1178,
-	1179, 1180, 0, 1190, 1194, 123, 0, 0, 0, 0,
-	665, 666, 667, 92, 675, 680, 778, 798, 923, 931,
-	935, 0, 967, 1112, 349, 1115, 1116, 1118, 1120, 1121,
-	1123, 1124, 968, 969, 0, 1006, 0, 1008, 1155, 0,
-	1133, 0, 0, 1020, 0, 0, 0, 1029, 1030, 1031,
-	0, 0, 1037, 0, 1039, 0, 0, 0, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1002, 0,
-	0, 0, 0, 883, -2, 61, 1142, 1144, 0, 1205,
-	1203, 1203, 1213, 1214, 0, 0, 1221, 0, 0, 0,
-	0, 81, 0, 0, 0, 1241, 0, 0, 0, 0,
-	98, 1146, 871, 878, 0, 0, 872, 0, 873, 893,
-	895, 852, -2, 856, 0, 915, 915, 87, 88, 0,
-	177, 0, 179, 205, 206, 0, 182, 184, 185, 186,
-	192, 193, 194, 187, 0, 0, 282, 284, 0, 0,
-	325, 336, 326, 0, 0, 1165, 1166, 1167, 1168, 1169,
-	1170, 1171, 1172, 859, 136, 137, 138, 559, 0, 569,
-	0, 0, 0, 562, 0, 497, 0, 0, 0, 376,
-	376, 376, 0, 0, 0, 0, 637, 0, 0, 600,
-	0, 608, 0, 0, 0, 216, 217, 0, 1189, 550,
-	0, 121, 122, 0, 0, 555, 1101, 1113, 0, 0,
-	0, 1130, 0, 0, 0, 0, 1027, 1035, 0, 1066,
-	0, 1042, 0, 0, 0, 589, 590, 0, 1062, 0,
-	912, 0, 0, 0, 0, 903, 1144, 79, 0, 0,
-	1208, 0, 1201, 1204, 1202, 1215, 0, 0, 1222, 0,
-	1224, 0, 1245, 1246, 1239, 0, 1231, 1234, 1230, 1233,
-	1155, 875, 0, 880, 0, 1146, 86, 0, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 191, 0, 0, 340, 343, 0, 0, 0,
-	560, 0, 572, 563, 0, 650, 0, 654, 0, 0,
-	0, 657, 658, 659, 636, 0, 640, 404, 624, 621,
-	622, 509, 0, 124, 125, 0, 0, 0, 0, 0,
-	0, 1005, 1007, 1009, 1018, 1019, 1110, 1111, 1036, 1040,
-	0, 1043, 0, 0, 1044, 0, 591, 0, 908, 865,
-	866, 913, 914, 0, 0, 858, 78, 1145, 1143, 1197,
-	0, 1206, 1207, 1216, 1217, 1223, 0, 1229, 0, 84,
-	0, 0, 0, 1155, 178, 0, 197, 0, 568, 0,
-	571, 561, 648, 649, 0, 661, 653, 655, 656, 638,
-	-2, 1181, 0, 0, 0, 557, 966, 0, 0, 0,
-	1063, 1045, 0, 587, 588, 0, 907, 909, 910, 1209,
-	1210, 1237, 1235, 876, 883, 0, 85, 417, 410, 1181,
-	0, 0, 0, 641, 642, 643, 644, 645, 646, 647,
-	547, 1183, 126, 127, 483, 484, 485, 120, 1102, 0,
-	1105, 0, 867, 868, 573, 0, 575, 0, -2, 405,
-	418, 0, 172, 198, 199, 0, 0, 202, 203, 204,
-	195, 196, 116, 0, 0, 662, 0, 1184, 1185, 123,
-	1103, 1041, 574, 0, 0, 360, 0, 584, 406, 407,
-	0, 413, 414, 415, 416, 200, 201, 596, 0, 0,
-	554, 0, 576, 577, 585, 0, 408, 0, 409, 0,
-	0, 0, 565, 0, 596, 1182, 0, 0, 586, 582,
-	419, 421, 422, 0, 0, 420, 597, 566, 1104, 0,
-	423, 424, 425, 578, 579, 580, 581,
+	1778, 1779, 1780, 1781, 1782, 1783, 1784, 1785, 1786, 1787,
+	1788, 1789, 0, 1273, 0, 676, 905, 0, 729, 729,
+	0, 729, 729, 729, 0, 0, 0, 687, 0, 0,
+	0, 0, 726, 0, 703, 704, 0, 710, 1794, 0,
+	1794, 1794, 1264, 0, 723, 721, 733, 734, 737, 741,
+	0, 746, 749, 1290, 1291, 0, 763, 46, 1536, 755,
+	768, 769, 0, 800, 801, 42, 1002, 0, 927, 932,
+	943, 956, 957, 958, 959, 960, 962, 963, 0, 0,
+	0, 0, 968, 969, 0, 0, 0, 0, 0, 983,
+	0, 0, 0, 0, 0, 1132, 0, 1095, 1095, 1019,
+	1095, 1097, 1097, 0, 0, 0, 1789, 1577, 1585, 1586,
+	1608, 1694, 1743, 1581, 1582, 1563, 1575, 1578, 1566, 1567,
+	1569, 1571, 1576, 1583, 1589, 1568, 1588, 1587, 0, 1564,
+	1565, 1570, 1580, 1584, 1572, 1573, 1574, 1579, 1590, 1141,
+	0, 0, 0, 0, 0, 0, 275, 276, 1001, 1253,
+	1097, 1097, 1097, 1097, 1097, -2, -2, 221, 222, 223,
+	224, 225, 226, 227, 0, 217, 0, 280, 281, 277,
+	278, 279, 985, 986, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274, 1792, 0, 778, 0,
+	0, 0, 0, 1160, 0, 1152, 1151, 60, 0, 804,
+	-2, 0, 0, 0, 0, 44, 0, 49, 864, 74,
+	73, 1205, 0, 0, 0, 56, 1161, 64, 66, 1162,
+	0, 809, 810, 0, 840, 844, 0, 805, 0, 0,
+	1280, 1279, 1279, 99, 0, 1739, 1257, -2, -2, 0,
+	0, 1292, 1293, 1266, -2, 1300, 1301, 1302, 1303, 1304,
+	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354,
+	1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364,
+	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374,
+	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
+	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
+	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
+	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
+	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434,
+	1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444,
+	1445, 1446, -2, 0, 0, 167, 168, 0, 40, 406,
+	0, 163, 0, 399, 340, 0, 1178, 0, 0, 0,
+	0, 0, 804, 0, 1274, 144, 145, 152, 153, 154,
+	379, 379, 379, 546, 0, 0, 155, 155, 505, 506,
+	507, 0, 0, -2, 404, 0, 490, 0, 0, 393,
+	393, 397, 395, 396, 0, 0, 0, 0, 0, 0,
+	0, 0, 523, 0, 524, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 622, 0, 380, 0, 544, 545,
+	442, 0, 0, 0, 0, 0, 0, 521, 522, 0,
+	0, 1282, 1283, 0, 0, 0, 379, 379, 0, 0,
+	0, 0, 379, 379, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 1196, 0, 0, -2, 0, 666, 0,
+	0, 0, 1275, 1275, 1275, 1275, 0, 675, 0, 677,
+	678, 0, 0, 679, 0, 726, 726, 724, 725, 681,
+	682, 683, 729, 0, 0, 388, 389, 390, 726, 729,
+	0, 729, 729, 729, 729, 726, 726, 726, 729, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1794, 707,
+	708, 711, 1795, 1796, 1313, 1794, 1794, 715, 719, 1265,
+	739, 747, 750, 766, 764, 765, 767, 759, 760, 761,
+	762, 0, 779, 780, 785, 0, 0, 0, 0, 791,
+	792, 793, 0, 0, 796, 797, 798, 0, 0, 0,
+	0, 0, 925, 0, 0, 991, 992, 993, 994, 995,
+	996, 997, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 964, 965, 966, 967, 970, 0, 0, 0,
+	975, 976, 0, 0, 0, 0, 0, 814, 0, 1133,
+	0, 1017, 0, 1018, 1020, 1021, 0, 1022, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1285, 131, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 824, 0, 0, 824, 824, 0,
+	0, 210, 211, 212, 213, 214, 228, 229, 230, 231,
+	232, 282, 1141, 0, 1001, 0, 0, 43, 770, 771,
+	0, 888, 1285, 0, 820, 0, 54, 63, 65, 1160,
+	58, 1160, 0, 826, 0, 0, -2, -2, 827, 833,
+	834, 835, 836, 837, 51, 1791, 52, 0, 71, 0,
+	45, 0, 0, 0, 0, 352, 1208, 0, 0, 1153,
+	1154, 1157, 0, 841, 1619, 845, 0, 847, 848, 0,
+	807, 0, 97, 0, 904, 0, 0, 0, 1739, 1263,
+	0, 101, 102, 0, 0, 0, 363, 1268, 1269, 1270,
+	-2, 386, 0, 363, 347, 290, 291, 292, 340, 294,
+	340, 340, 340, 340, 352, 352, 352, 352, 323, 324,
+	325, 326, 327, 0, 0, 309, 340, 340, 340, 340,
+	330, 331, 332, 333, 334, 335, 336, 337, 295, 296,
+	297, 298, 299, 300, 301, 302, 303, 342, 342, 342,
+	344, 344, 0, 41, 0, 367, 0, 1157, 0, 0,
+	1196, 1277, 1287, 0, 0, 0, 1277, 122, 0, 0,
+	0, 547, 572, 499, 535, 548, 0, 502, 503, -2,
+	0, 0, 489, 0, 491, 0, 387, 0, -2, 0,
+	397, 0, 393, 397, 394, 397, 385, 398, 525, 526,
+	527, 0, 529, 530, 602, 874, 0, 0, 0, 0,
+	0, 608, 609, 610, 0, 612, 613, 614, 615, 616,
+	617, 618, 619, 620, 621, 536, 537, 538, 539, 540,
+	541, 542, 543, 0, 0, 0, 0, 491, 0, 532,
+	0, 443, 444, 445, 0, 0, 448, 449, 450, 451,
+	0, 0, 454, 455, 456, 891, 892, 457, 458, 483,
+	484, 485, 459, 460, 461, 462, 463, 464, 465, 477,
+	478, 479, 480, 481, 482, 466, 467, 468, 469, 470,
+	471, 474, 0, 137, 1182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1275, 0, 0, 0, 0, 0,
+	0, 823, 906, 1298, 1299, 730, 731, 0, 391, 392,
+	729, 729, 684, 716, 0, 729, 688, 717, 689, 691,
+	690, 692, 705, 706, 729, 695, 727, 728, 696, 697,
+	698, 699, 700, 701, 702, 712, 0, 735, 736, 713,
+	714, 0, 748, 752, 753, 754, 0, 0, 782, 93,
+	787, 788, 789, 790, 802, 795, 1003, 922, 923, 924,
+	0, 926, 929, 0, 987, 989, 931, 933, 998, 999,
+	1000, 0, 0, 0, 0, 0, 937, 941, 944, 945,
+	946, 947, 948, 949, 950, 951, 952, 953, 954, 955,
+	961, 1111, 1112, 1113, 978, 283, 284, 0, 979, 0,
+	838, 0, 0, 0, 977, 0, 0, 0, 0, 815,
+	816, 1139, 1136, 0, 0, 1096, 1098, 0, 0, 0,
+	0, 0, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
+	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
+	1092, 1093, 1094, 1114, 0, 0, 0, 0, 0, 1132,
+	0, 980, 981, 982, 0, 0, 0, 1286, 0, 132,
+	133, 0, 0, 0, 0, 0, 1068, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 825, 1142, 0,
+	-2, 0, 0, 0, 0, 0, 0, 0, 1252, 0,
+	773, 774, 776, 0, 908, 0, 889, 0, 0, 0,
+	819, 0, 822, 57, 59, 831, 832, 0, 849, 828,
+	53, 48, 0, 0, 866, 1206, 352, 1228, 0, 361,
+	361, 358, 1163, 1164, 0, 1156, 1158, 1159, 76, 846,
+	842, 0, 920, 0, 0, 903, 0, 852, 854, 855,
+	856, 886, 0, 859, 0, 0, 0, 0, 0, 95,
+	905, 1258, 100, 0, 0, 105, 106, 1259, 1260, 1261,
+	1262, 0, 561, -2, 438, 169, 171, 172, 173, 164,
+	-2, 350, 348, 349, 293, 352, 352, 317, 318, 319,
+	320, 321, 322, 0, 0, 310, 311, 312, 313, 304,
+	0, 305, 306, 307, 0, 308, 405, 0, 1165, 368,
+	369, 371, 379, 0, 374, 375, 0, 379, 379, 0,
+	400, 401, 0, 1157, 1182, 0, 0, 0, 1288, 1287,
+	1287, 1287, 0, 157, 158, 159, 160, 161, 162, 597,
+	0, 0, 573, 595, 596, 155, 0, 0, 165, 493,
+	492, 0, 629, 0, 403, 0, 0, 397, 397, 382,
+	383, 528, 0, 0, 604, 605, 606, 607, 0, 0,
+	0, 515, 432, 0, 516, 517, 491, 493, 363, 0,
+	446, 447, 452, 453, 472, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1179, 1180, 1181, 0,
+	0, 667, 0, 0, 429, 91, 1276, 672, 673, 674,
+	726, 694, 718, 726, 686, 693, 709, 744, 786, 0,
+	0, 0, 0, 794, 0, 0, 930, 988, 990, 934,
+	0, 938, 942, 0, 0, 0, 0, 0, 0, 0,
+	984, 1008, 1009, 0, 0, 1151, 0, 1137, 0, 0,
+	1016, 1099, 1100, 0, 0, 0, 0, 0, 1027, 1028,
+	1029, 1030, 1031, 0, 1033, 0, 0, 0, 1037, 1038,
+	0, 0, 1039, 0, 0, 0, 0, 0, 0, 0,
+	0, 1051, 1052, 1053, 1054, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1065, 1004, 0, 0, 824,
+	0, 1101, 1102, 1103, 1104, 1105, 1142, 772, 775, 777,
+	862, 909, 910, 0, 0, 0, 0, 890, 1284, 817,
+	818, 821, -2, 0, 0, 1144, 0, 0, 849, 920,
+	850, 0, 829, 50, 865, 0, 1210, 1209, 1222, 1235,
+	361, 361, 355, 356, 362, 357, 359, 360, 1155, 0,
+	1160, 0, 1246, 0, 0, 1238, 0, 0, 0, 0,
+	0, 0, 0, 0, 893, 0, 0, 896, 0, 0,
+	0, 0, 887, 0, 0, 0, 0, 0, 0, -2,
+	0, 0, 89, 90, 0, 0, 0, 103, 104, 0,
+	0, 110, 364, 365, 146, 155, 440, 170, 413, 0,
+	0, 289, 351, 314, 315, 316, 0, 338, 0, 0,
+	0, 434, 115, 1169, 1168, 379, 379, 370, 0, 373,
+	0, 0, 0, 1289, 341, 402, 0, 0, 0, 0,
+	136, 0, 0, 0, 0, 0, 142, 567, 0, 0,
+	574, 0, 0, 0, 497, 0, 508, 509, 0, 601,
+	-2, 663, 367, 0, 381, 384, 875, 0, 0, 510,
+	0, 513, 514, 433, 493, 519, 520, 533, 475, 476,
+	473, 0, 0, 1197, 1198, 1203, 1201, 1202, 123, 554,
+	556, 555, 559, 0, 0, 552, 0, 429, 1165, 0,
+	671, 430, 431, 729, 729, 781, 94, 0, 784, 0,
+	0, 0, 0, 935, 939, 839, 0, 0, 340, 340,
+	1119, 340, 344, 1122, 340, 1124, 340, 1127, 340, 1130,
+	1131, 0, 0, 0, 0, 1134, 1015, 1140, 0, 0,
+	0, 0, 1026, 0, 0, 0, 0, 0, 0, 134,
+	135, 1068, 0, 0, 1070, 1072, 1073, 1043, 1069, 0,
+	0, 1066, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1005, 1006, 0, 1143, 911, 916, 916, 916,
+	0, 0, 0, 1271, 1272, 1145, 1146, 920, 1147, 851,
+	830, 867, 1228, 0, 1221, 0, -2, 1230, 0, 0,
+	0, 1236, 353, 354, 843, 77, 921, 80, 0, 1246,
+	1254, 0, 1237, 1248, 1250, 0, 0, 0, 1242, 0,
+	920, 853, 882, 884, 0, 879, 894, 895, 897, 0,
+	899, 0, 901, 902, 864, 858, 860, 0, 0, 0,
+	97, 0, 920, 920, 96, 0, 907, 107, 108, 109,
+	439, 174, 179, 0, 0, 0, 184, 0, 186, 0,
+	0, 0, 191, 192, 379, 379, 414, 0, 286, 288,
+	0, 0, 177, 352, 0, 352, 0, 345, 0, 415,
+	435, 1166, 1167, 0, 0, 372, 376, 377, 378, 0,
+	0, 0, 0, 1278, 138, 0, 0, 0, 570, 0,
+	598, 0, 0, 0, 0, 0, 0, 166, 494, 630,
+	631, 632, 633, 634, 635, 636, 637, 638, 0, 379,
+	0, 0, 0, 379, 379, 379, 0, 655, 366, 0,
+	0, 626, 623, 511, 0, 215, 216, 218, 0, 0,
+	518, 864, 1183, 1184, 1185, 0, 1200, 1204, 126, 0,
+	0, 0, 0, 668, 669, 670, 92, 680, 685, 783,
+	803, 928, 936, 940, 0, 972, 1117, 352, 1120, 1121,
+	1123, 1125, 1126, 1128, 1129, 973, 974, 0, 1011, 0,
+	1013, 1160, 0, 1138, 0, 0, 1025, 0, 0, 0,
+	1034, 1035, 1036, 0, 0, 1042, 0, 1044, 0, 0,
+	0, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
+	1064, 1007, 0, 0, 0, 0, 888, -2, 61, 1147,
+	1149, 0, 1215, 1213, 1213, 1223, 1224, 0, 0, 1231,
+	0, 0, 0, 0, 81, 0, 0, 0, 1251, 0,
+	0, 0, 0, 98, 1151, 876, 883, 0, 0, 877,
+	0, 878, 898, 900, 857, -2, 861, 0, 920, 920,
+	87, 88, 0, 180, 0, 182, 208, 209, 0, 185,
+	187, 188, 189, 195, 196, 197, 190, 0, 0, 285,
+	287, 0, 0, 328, 339, 329, 0, 0, 1170, 1171,
+	1172, 1173, 1174, 1175, 1176, 1177, 864, 0, 1191, 1192,
+	1793, 1793, 139, 140, 141, 562, 0, 572, 0, 0,
+	0, 565, 0, 500, 0, 0, 0, 379, 379, 379,
+	0, 0, 0, 0, 640, 0, 0, 603, 0, 611,
+	0, 0, 0, 219, 220, 0, 1199, 553, 0, 124,
+	125, 0, 0, 558, 1106, 1118, 0, 0, 0, 1135,
+	0, 0, 0, 0, 1032, 1040, 0, 1071, 0, 1047,
+	0, 0, 0, 592, 593, 0, 1067, 0, 917, 0,
+	0, 0, 0, 908, 1149, 79, 0, 0, 1218, 0,
+	1211, 1214, 1212, 1225, 0, 0, 1232, 0, 1234, 0,
+	1255, 1256, 1249, 0, 1241, 1244, 1240, 1243, 1160, 880,
+	0, 885, 0, 1151, 86, 0, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	194, 0, 0, 343, 346, 0, 0, 1193, 1194, 1195,
+	118, 119, 0, 0, 563, 0, 575, 566, 0, 653,
+	0, 657, 0, 0, 0, 660, 661, 662, 639, 0,
+	643, 407, 627, 624, 625, 512, 0, 127, 128, 0,
+	0, 0, 0, 0, 0, 1010, 1012, 1014, 1023, 1024,
+	1115, 1116, 1041, 1045, 0, 1048, 0, 0, 1049, 0,
+	594, 0, 913, 870, 871, 918, 919, 0, 0, 863,
+	78, 1150, 1148, 1207, 0, 1216, 1217, 1226, 1227, 1233,
+	0, 1239, 0, 84, 0, 0, 0, 1160, 181, 0,
+	200, 0, 0, 571, 0, 574, 564, 651, 652, 0,
+	664, 656, 658, 659, 641, -2, 1186, 0, 0, 0,
+	560, 971, 0, 0, 0, 1068, 1050, 0, 590, 591,
+	0, 912, 914, 915, 1219, 1220, 1247, 1245, 881, 888,
+	0, 85, 420, 413, 1186, 0, 0, 0, 0, 644,
+	645, 646, 647, 648, 649, 650, 550, 1188, 129, 130,
+	486, 487, 488, 123, 1107, 0, 1110, 0, 872, 873,
+	576, 0, 578, 0, -2, 408, 421, 0, 175, 201,
+	202, 0, 0, 205, 206, 207, 198, 199, 116, 1793,
+	0, 0, 665, 0, 1189, 1190, 126, 1108, 1046, 577,
+	0, 0, 363, 0, 587, 409, 410, 0, 416, 417,
+	418, 419, 203, 204, 117, 599, 0, 0, 557, 0,
+	579, 580, 588, 0, 411, 0, 412, 0, 0, 0,
+	568, 0, 599, 1187, 0, 0, 589, 585, 422, 424,
+	425, 0, 0, 423, 600, 569, 1109, 0, 426, 427,
+	428, 581, 582, 583, 584,
 }
 
 var mysqTok1 = [...]int{
@@ -7621,7 +7670,7 @@ var mysqTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 141, 3, 3, 3, 167, 159, 3,
 	86, 88, 164, 162, 87, 163, 217, 165, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 601,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 602,
 	149, 148, 150, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -7726,7 +7775,7 @@ var mysqTok3 = [...]int{
 	57910, 585, 57911, 586, 57912, 587, 57913, 588, 57914, 589,
 	57915, 590, 57916, 591, 57917, 592, 57918, 593, 57919, 594,
 	57920, 595, 57921, 596, 57922, 597, 57923, 598, 57924, 599,
-	57925, 600, 0,
+	57925, 600, 57926, 601, 0,
 }
 
 var mysqErrorMessages = [...]struct {
@@ -7758,6 +7807,11 @@ type mysqLexer interface {
 	Error(s string)
 }
 
+// mysqExpectedLexer is implemented by the lexers that report the expected tokens of the syntax error
+type mysqExpectedLexer interface {
+	SetExpectedTokens(tokens []string)
+}
+
 type mysqParser interface {
 	Parse(mysqLexer) int
 	Lookahead() int
@@ -7861,16 +7915,53 @@ func mysqErrorMessage(state, lookAhead int) string {
 	return res
 }
 
-func mysqlex1(lex mysqLexer, lval *mysqSymType) (char, token int) {
-	token = 0
-	char = lex.Lex(lval)
-	if mysqDebug >= 4 {
-		__yyfmt__.Printf("lval is %v => ", lval)
-	}
-	if char <= 0 {
-		token = mysqTok1[0]
-		goto out
-	}
+// mysqExpectedTokens returns the names of all tokens the parser accepts in the state,
+// nil is returned if the default action of the state is to accept or reduce
+func mysqExpectedTokens(state int) []string {
+	const TOKSTART = 4
+
+	expected := make([]string, 0)
+
+	// Look for shiftable tokens.
+	base := mysqPact[state]
+	for tok := TOKSTART; tok-1 < len(mysqToknames); tok++ {
+		if n := base + tok; n >= 0 && n < mysqLast && mysqChk[mysqAct[n]] == tok {
+			expected = append(expected, mysqTokname(tok))
+		}
+	}
+
+	if mysqDef[state] == -2 {
+		i := 0
+		for mysqExca[i] != -1 || mysqExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; mysqExca[i] >= 0; i += 2 {
+			tok := mysqExca[i]
+			if tok < TOKSTART || mysqExca[i+1] == 0 {
+				continue
+			}
+			expected = append(expected, mysqTokname(tok))
+		}
+
+		if mysqExca[i+1] != 0 {
+			return nil
+		}
+	}
+	return expected
+}
+
+func mysqlex1(lex mysqLexer, lval *mysqSymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if mysqDebug >= 4 {
+		__yyfmt__.Printf("lval is %v => ", lval)
+	}
+	if char <= 0 {
+		token = mysqTok1[0]
+		goto out
+	}
 	if char < len(mysqTok1) {
 		token = mysqTok1[char]
 		goto out
@@ -8001,6 +8092,9 @@ mysqdefault:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
+			if lexer, ok := mysqlex.(mysqExpectedLexer); ok {
+				lexer.SetExpectedTokens(mysqExpectedTokens(mysqstate))
+			}
 			mysqlex.Error(mysqErrorMessage(mysqstate, mysqtoken))
 			Nerrs++
 			if mysqDebug >= 1 {
@@ -8081,68 +8175,68 @@ mysqdefault:
 
 	case 1:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:533
+//line mysql.y:544
 		{
 			setParseTree(mysqlex, mysqDollar[1].statementUnion())
 		}
 	case 2:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:538
+//line mysql.y:549
 		{
 		}
 	case 3:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:539
+//line mysql.y:550
 		{
 		}
 	case 4:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:543
+//line mysql.y:554
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
 		mysqVAL.union = mysqLOCAL
 	case 36:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:578
+//line mysql.y:589
 		{
 			setParseTree(mysqlex, nil)
 		}
 	case 37:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:584
+//line mysql.y:595
 		{
 			mysqVAL.colIdent = ast.NewColIdentWithAt(string(mysqDollar[1].str), ast.NoAt)
 		}
 	case 38:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:588
+//line mysql.y:599
 		{
 			mysqVAL.colIdent = ast.NewColIdentWithAt(string(mysqDollar[1].str), ast.SingleAt)
 		}
 	case 39:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:592
+//line mysql.y:603
 		{
 			mysqVAL.colIdent = ast.NewColIdentWithAt(string(mysqDollar[1].str), ast.DoubleAt)
 		}
 	case 40:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:597
+//line mysql.y:608
 		{
 			mysqVAL.colIdent = ast.NewColIdentWithAt("", ast.NoAt)
 		}
 	case 41:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:601
+//line mysql.y:612
 		{
 			mysqVAL.colIdent = mysqDollar[1].colIdent
 		}
 	case 42:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:607
+//line mysql.y:618
 		{
 			mysqLOCAL = &ast.OtherAdmin{}
 		}
@@ -8150,7 +8244,7 @@ mysqdefault:
 	case 43:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:613
+//line mysql.y:624
 		{
 			mysqLOCAL = &ast.Load{}
 		}
@@ -8158,7 +8252,7 @@ mysqdefault:
 	case 44:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.With
-//line mysql.y:619
+//line mysql.y:630
 		{
 			mysqLOCAL = &ast.With{Ctes: mysqDollar[2].ctesUnion(), Recursive: false}
 		}
@@ -8166,7 +8260,7 @@ mysqdefault:
 	case 45:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.With
-//line mysql.y:623
+//line mysql.y:634
 		{
 			mysqLOCAL = &ast.With{Ctes: mysqDollar[3].ctesUnion(), Recursive: true}
 		}
@@ -8174,7 +8268,7 @@ mysqdefault:
 	case 46:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.With
-//line mysql.y:628
+//line mysql.y:639
 		{
 			mysqLOCAL = nil
 		}
@@ -8182,14 +8276,14 @@ mysqdefault:
 	case 47:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.With
-//line mysql.y:632
+//line mysql.y:643
 		{
 			mysqLOCAL = mysqDollar[1].withUnion()
 		}
 		mysqVAL.union = mysqLOCAL
 	case 48:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:638
+//line mysql.y:649
 		{
 			mysqSLICE := (*[]*ast.CommonTableExpr)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].cteUnion())
@@ -8197,7 +8291,7 @@ mysqdefault:
 	case 49:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.CommonTableExpr
-//line mysql.y:642
+//line mysql.y:653
 		{
 			mysqLOCAL = []*ast.CommonTableExpr{mysqDollar[1].cteUnion()}
 		}
@@ -8205,7 +8299,7 @@ mysqdefault:
 	case 50:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.CommonTableExpr
-//line mysql.y:648
+//line mysql.y:659
 		{
 			mysqLOCAL = &ast.CommonTableExpr{TableID: mysqDollar[1].tableIdent, Columns: mysqDollar[2].columnsUnion(), Subquery: mysqDollar[4].subqueryUnion()}
 		}
@@ -8213,7 +8307,7 @@ mysqdefault:
 	case 51:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:654
+//line mysql.y:665
 		{
 			mysqLOCAL = mysqDollar[2].selStmtUnion()
 		}
@@ -8221,7 +8315,7 @@ mysqdefault:
 	case 52:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:658
+//line mysql.y:669
 		{
 			mysqLOCAL = mysqDollar[2].selStmtUnion()
 		}
@@ -8229,7 +8323,7 @@ mysqdefault:
 	case 53:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:662
+//line mysql.y:673
 		{
 			ast.SetLockInSelect(mysqDollar[2].selStmtUnion(), mysqDollar[3].lockUnion())
 			mysqLOCAL = mysqDollar[2].selStmtUnion()
@@ -8238,7 +8332,7 @@ mysqdefault:
 	case 54:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:685
+//line mysql.y:696
 		{
 			mysqDollar[1].selStmtUnion().SetOrderBy(mysqDollar[2].orderByUnion())
 			mysqDollar[1].selStmtUnion().SetLimit(mysqDollar[3].limitUnion())
@@ -8248,7 +8342,7 @@ mysqdefault:
 	case 55:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:691
+//line mysql.y:702
 		{
 			mysqDollar[1].selStmtUnion().SetLimit(mysqDollar[2].limitUnion())
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
@@ -8257,7 +8351,7 @@ mysqdefault:
 	case 56:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:696
+//line mysql.y:707
 		{
 			mysqDollar[1].selStmtUnion().SetOrderBy(mysqDollar[2].orderByUnion())
 			mysqDollar[1].selStmtUnion().SetLimit(mysqDollar[3].limitUnion())
@@ -8267,7 +8361,7 @@ mysqdefault:
 	case 57:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:702
+//line mysql.y:713
 		{
 			mysqDollar[2].selStmtUnion().SetWith(mysqDollar[1].withUnion())
 			mysqDollar[2].selStmtUnion().SetOrderBy(mysqDollar[3].orderByUnion())
@@ -8278,7 +8372,7 @@ mysqdefault:
 	case 58:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:709
+//line mysql.y:720
 		{
 			mysqDollar[2].selStmtUnion().SetWith(mysqDollar[1].withUnion())
 			mysqDollar[2].selStmtUnion().SetLimit(mysqDollar[3].limitUnion())
@@ -8288,7 +8382,7 @@ mysqdefault:
 	case 59:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:715
+//line mysql.y:726
 		{
 			mysqDollar[2].selStmtUnion().SetWith(mysqDollar[1].withUnion())
 			mysqDollar[2].selStmtUnion().SetOrderBy(mysqDollar[3].orderByUnion())
@@ -8298,14 +8392,14 @@ mysqdefault:
 		mysqVAL.union = mysqLOCAL
 	case 60:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:722
+//line mysql.y:733
 		{
 			mysqDollar[2].selStmtUnion().SetWith(mysqDollar[1].withUnion())
 		}
 	case 61:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:726
+//line mysql.y:737
 		{
 			mysqLOCAL = ast.NewSelect(ast.Comments(mysqDollar[2].strs), ast.SelectExprs{&ast.Nextval{Expr: mysqDollar[5].exprUnion()}}, []string{mysqDollar[3].str} /*options*/, nil, ast.TableExprs{&ast.AliasedTableExpr{Expr: mysqDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -8313,7 +8407,7 @@ mysqdefault:
 	case 62:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:732
+//line mysql.y:743
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
@@ -8321,7 +8415,7 @@ mysqdefault:
 	case 63:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:736
+//line mysql.y:747
 		{
 			mysqLOCAL = &ast.Union{Left: mysqDollar[1].selStmtUnion(), Distinct: mysqDollar[2].booleanUnion(), Right: mysqDollar[3].selStmtUnion()}
 		}
@@ -8329,7 +8423,7 @@ mysqdefault:
 	case 64:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:740
+//line mysql.y:751
 		{
 			mysqLOCAL = &ast.Union{Left: mysqDollar[1].selStmtUnion(), Distinct: mysqDollar[2].booleanUnion(), Right: mysqDollar[3].selStmtUnion()}
 		}
@@ -8337,7 +8431,7 @@ mysqdefault:
 	case 65:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:744
+//line mysql.y:755
 		{
 			mysqLOCAL = &ast.Union{Left: mysqDollar[1].selStmtUnion(), Distinct: mysqDollar[2].booleanUnion(), Right: mysqDollar[3].selStmtUnion()}
 		}
@@ -8345,7 +8439,7 @@ mysqdefault:
 	case 66:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:748
+//line mysql.y:759
 		{
 			mysqLOCAL = &ast.Union{Left: mysqDollar[1].selStmtUnion(), Distinct: mysqDollar[2].booleanUnion(), Right: mysqDollar[3].selStmtUnion()}
 		}
@@ -8353,7 +8447,7 @@ mysqdefault:
 	case 67:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:754
+//line mysql.y:765
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
@@ -8361,7 +8455,7 @@ mysqdefault:
 	case 68:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:758
+//line mysql.y:769
 		{
 			ast.SetLockInSelect(mysqDollar[1].selStmtUnion(), mysqDollar[2].lockUnion())
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
@@ -8370,7 +8464,7 @@ mysqdefault:
 	case 69:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:763
+//line mysql.y:774
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
@@ -8378,7 +8472,7 @@ mysqdefault:
 	case 70:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:767
+//line mysql.y:778
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
@@ -8386,7 +8480,7 @@ mysqdefault:
 	case 71:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:773
+//line mysql.y:784
 		{
 			mysqLOCAL = mysqDollar[2].selStmtUnion()
 		}
@@ -8394,7 +8488,7 @@ mysqdefault:
 	case 72:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:777
+//line mysql.y:788
 		{
 			mysqDollar[1].selStmtUnion().SetInto(mysqDollar[2].selectIntoUnion())
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
@@ -8403,7 +8497,7 @@ mysqdefault:
 	case 73:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:782
+//line mysql.y:793
 		{
 			mysqDollar[1].selStmtUnion().SetInto(mysqDollar[2].selectIntoUnion())
 			mysqDollar[1].selStmtUnion().SetLock(mysqDollar[3].lockUnion())
@@ -8413,7 +8507,7 @@ mysqdefault:
 	case 74:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:788
+//line mysql.y:799
 		{
 			mysqDollar[1].selStmtUnion().SetInto(mysqDollar[3].selectIntoUnion())
 			mysqDollar[1].selStmtUnion().SetLock(mysqDollar[2].lockUnion())
@@ -8423,7 +8517,7 @@ mysqdefault:
 	case 75:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:794
+//line mysql.y:805
 		{
 			mysqDollar[1].selStmtUnion().SetInto(mysqDollar[2].selectIntoUnion())
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
@@ -8432,7 +8526,7 @@ mysqdefault:
 	case 76:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:801
+//line mysql.y:812
 		{
 			mysqLOCAL = &ast.Stream{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), SelectExpr: mysqDollar[3].selectExprUnion(), Table: mysqDollar[5].tableName}
 		}
@@ -8440,7 +8534,7 @@ mysqdefault:
 	case 77:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:807
+//line mysql.y:818
 		{
 			mysqLOCAL = &ast.VStream{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), SelectExpr: mysqDollar[3].selectExprUnion(), Table: mysqDollar[5].tableName, Where: ast.NewWhere(ast.WhereClause, mysqDollar[6].exprUnion()), Limit: mysqDollar[7].limitUnion()}
 		}
@@ -8448,7 +8542,7 @@ mysqdefault:
 	case 78:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:815
+//line mysql.y:826
 		{
 			mysqLOCAL = ast.NewSelect(ast.Comments(mysqDollar[2].strs), mysqDollar[4].selectExprsUnion() /*SelectExprs*/, mysqDollar[3].strs /*options*/, mysqDollar[5].selectIntoUnion() /*into*/, mysqDollar[6].tableExprsUnion() /*from*/, ast.NewWhere(ast.WhereClause, mysqDollar[7].exprUnion()), ast.GroupBy(mysqDollar[8].exprsUnion()), ast.NewWhere(ast.HavingClause, mysqDollar[9].exprUnion()))
 		}
@@ -8456,7 +8550,7 @@ mysqdefault:
 	case 79:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.SelectStatement
-//line mysql.y:819
+//line mysql.y:830
 		{
 			mysqLOCAL = ast.NewSelect(ast.Comments(mysqDollar[2].strs), mysqDollar[4].selectExprsUnion() /*SelectExprs*/, mysqDollar[3].strs /*options*/, nil, mysqDollar[5].tableExprsUnion() /*from*/, ast.NewWhere(ast.WhereClause, mysqDollar[6].exprUnion()), ast.GroupBy(mysqDollar[7].exprsUnion()), ast.NewWhere(ast.HavingClause, mysqDollar[8].exprUnion()))
 		}
@@ -8464,7 +8558,7 @@ mysqdefault:
 	case 80:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:827
+//line mysql.y:838
 		{
 			// insert_data returns a *ast.Insert pre-filled with Columns & Values
 			ins := mysqDollar[6].insUnion()
@@ -8480,7 +8574,7 @@ mysqdefault:
 	case 81:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:839
+//line mysql.y:850
 		{
 			cols := make(ast.Columns, 0, len(mysqDollar[7].updateExprsUnion()))
 			vals := make(ast.ValTuple, 0, len(mysqDollar[8].updateExprsUnion()))
@@ -8494,7 +8588,7 @@ mysqdefault:
 	case 82:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.InsertAction
-//line mysql.y:851
+//line mysql.y:862
 		{
 			mysqLOCAL = ast.InsertAct
 		}
@@ -8502,7 +8596,7 @@ mysqdefault:
 	case 83:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.InsertAction
-//line mysql.y:855
+//line mysql.y:866
 		{
 			mysqLOCAL = ast.ReplaceAct
 		}
@@ -8510,7 +8604,7 @@ mysqdefault:
 	case 84:
 		mysqDollar = mysqS[mysqpt-10 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:861
+//line mysql.y:872
 		{
 			mysqLOCAL = &ast.Update{With: mysqDollar[1].withUnion(), Comments: ast.Comments(mysqDollar[3].strs).Parsed(), Ignore: mysqDollar[4].ignoreUnion(), TableExprs: mysqDollar[5].tableExprsUnion(), Exprs: mysqDollar[7].updateExprsUnion(), Where: ast.NewWhere(ast.WhereClause, mysqDollar[8].exprUnion()), OrderBy: mysqDollar[9].orderByUnion(), Limit: mysqDollar[10].limitUnion()}
 		}
@@ -8518,7 +8612,7 @@ mysqdefault:
 	case 85:
 		mysqDollar = mysqS[mysqpt-11 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:867
+//line mysql.y:878
 		{
 			mysqLOCAL = &ast.Delete{With: mysqDollar[1].withUnion(), Comments: ast.Comments(mysqDollar[3].strs).Parsed(), Ignore: mysqDollar[4].ignoreUnion(), TableExprs: ast.TableExprs{&ast.AliasedTableExpr{Expr: mysqDollar[6].tableName, As: mysqDollar[7].tableIdent}}, Partitions: mysqDollar[8].partitionsUnion(), Where: ast.NewWhere(ast.WhereClause, mysqDollar[9].exprUnion()), OrderBy: mysqDollar[10].orderByUnion(), Limit: mysqDollar[11].limitUnion()}
 		}
@@ -8526,7 +8620,7 @@ mysqdefault:
 	case 86:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:871
+//line mysql.y:882
 		{
 			mysqLOCAL = &ast.Delete{With: mysqDollar[1].withUnion(), Comments: ast.Comments(mysqDollar[3].strs).Parsed(), Ignore: mysqDollar[4].ignoreUnion(), Targets: mysqDollar[6].tableNamesUnion(), TableExprs: mysqDollar[8].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, mysqDollar[9].exprUnion())}
 		}
@@ -8534,7 +8628,7 @@ mysqdefault:
 	case 87:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:875
+//line mysql.y:886
 		{
 			mysqLOCAL = &ast.Delete{With: mysqDollar[1].withUnion(), Comments: ast.Comments(mysqDollar[3].strs).Parsed(), Ignore: mysqDollar[4].ignoreUnion(), Targets: mysqDollar[5].tableNamesUnion(), TableExprs: mysqDollar[7].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, mysqDollar[8].exprUnion())}
 		}
@@ -8542,32 +8636,32 @@ mysqdefault:
 	case 88:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:879
+//line mysql.y:890
 		{
 			mysqLOCAL = &ast.Delete{With: mysqDollar[1].withUnion(), Comments: ast.Comments(mysqDollar[3].strs).Parsed(), Ignore: mysqDollar[4].ignoreUnion(), Targets: mysqDollar[5].tableNamesUnion(), TableExprs: mysqDollar[7].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, mysqDollar[8].exprUnion())}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 89:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:884
+//line mysql.y:895
 		{
 		}
 	case 90:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:885
+//line mysql.y:896
 		{
 		}
 	case 91:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableNames
-//line mysql.y:889
+//line mysql.y:900
 		{
 			mysqLOCAL = ast.TableNames{mysqDollar[1].tableName.ToViewName()}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 92:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:893
+//line mysql.y:904
 		{
 			mysqSLICE := (*ast.TableNames)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableName.ToViewName())
@@ -8575,14 +8669,14 @@ mysqdefault:
 	case 93:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableNames
-//line mysql.y:899
+//line mysql.y:910
 		{
 			mysqLOCAL = ast.TableNames{mysqDollar[1].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 94:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:903
+//line mysql.y:914
 		{
 			mysqSLICE := (*ast.TableNames)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableName)
@@ -8590,14 +8684,14 @@ mysqdefault:
 	case 95:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableNames
-//line mysql.y:909
+//line mysql.y:920
 		{
 			mysqLOCAL = ast.TableNames{mysqDollar[1].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 96:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:913
+//line mysql.y:924
 		{
 			mysqSLICE := (*ast.TableNames)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableName)
@@ -8605,7 +8699,7 @@ mysqdefault:
 	case 97:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Partitions
-//line mysql.y:918
+//line mysql.y:929
 		{
 			mysqLOCAL = nil
 		}
@@ -8613,7 +8707,7 @@ mysqdefault:
 	case 98:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Partitions
-//line mysql.y:922
+//line mysql.y:933
 		{
 			mysqLOCAL = mysqDollar[3].partitionsUnion()
 		}
@@ -8621,7 +8715,7 @@ mysqdefault:
 	case 99:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:928
+//line mysql.y:939
 		{
 			mysqLOCAL = &ast.Set{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Exprs: mysqDollar[3].setExprsUnion()}
 		}
@@ -8629,7 +8723,7 @@ mysqdefault:
 	case 100:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:934
+//line mysql.y:945
 		{
 			mysqLOCAL = &ast.SetTransaction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Scope: mysqDollar[3].scopeUnion(), Characteristics: mysqDollar[5].characteristicsUnion()}
 		}
@@ -8637,7 +8731,7 @@ mysqdefault:
 	case 101:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:938
+//line mysql.y:949
 		{
 			mysqLOCAL = &ast.SetTransaction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Characteristics: mysqDollar[4].characteristicsUnion(), Scope: ast.ImplicitScope}
 		}
@@ -8645,14 +8739,14 @@ mysqdefault:
 	case 102:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.Characteristic
-//line mysql.y:944
+//line mysql.y:955
 		{
 			mysqLOCAL = []ast.Characteristic{mysqDollar[1].characteristicUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 103:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:948
+//line mysql.y:959
 		{
 			mysqSLICE := (*[]ast.Characteristic)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].characteristicUnion())
@@ -8660,7 +8754,7 @@ mysqdefault:
 	case 104:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Characteristic
-//line mysql.y:954
+//line mysql.y:965
 		{
 			mysqLOCAL = mysqDollar[3].isolationLevelUnion()
 		}
@@ -8668,7 +8762,7 @@ mysqdefault:
 	case 105:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Characteristic
-//line mysql.y:958
+//line mysql.y:969
 		{
 			mysqLOCAL = ast.ReadWrite
 		}
@@ -8676,7 +8770,7 @@ mysqdefault:
 	case 106:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Characteristic
-//line mysql.y:962
+//line mysql.y:973
 		{
 			mysqLOCAL = ast.ReadOnly
 		}
@@ -8684,7 +8778,7 @@ mysqdefault:
 	case 107:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IsolationLevel
-//line mysql.y:968
+//line mysql.y:979
 		{
 			mysqLOCAL = ast.RepeatableRead
 		}
@@ -8692,7 +8786,7 @@ mysqdefault:
 	case 108:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IsolationLevel
-//line mysql.y:972
+//line mysql.y:983
 		{
 			mysqLOCAL = ast.ReadCommitted
 		}
@@ -8700,7 +8794,7 @@ mysqdefault:
 	case 109:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IsolationLevel
-//line mysql.y:976
+//line mysql.y:987
 		{
 			mysqLOCAL = ast.ReadUncommitted
 		}
@@ -8708,7 +8802,7 @@ mysqdefault:
 	case 110:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IsolationLevel
-//line mysql.y:980
+//line mysql.y:991
 		{
 			mysqLOCAL = ast.Serializable
 		}
@@ -8716,7 +8810,7 @@ mysqdefault:
 	case 111:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Scope
-//line mysql.y:986
+//line mysql.y:997
 		{
 			mysqLOCAL = ast.SessionScope
 		}
@@ -8724,7 +8818,7 @@ mysqdefault:
 	case 112:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Scope
-//line mysql.y:990
+//line mysql.y:1001
 		{
 			mysqLOCAL = ast.GlobalScope
 		}
@@ -8732,7 +8826,7 @@ mysqdefault:
 	case 113:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:996
+//line mysql.y:1007
 		{
 			mysqDollar[1].createTableUnion().TableSpec = mysqDollar[2].tableSpecUnion()
 			mysqDollar[1].createTableUnion().FullyParsed = true
@@ -8742,7 +8836,7 @@ mysqdefault:
 	case 114:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:1002
+//line mysql.y:1013
 		{
 			// Create table [name] like [name]
 			mysqDollar[1].createTableUnion().OptLike = mysqDollar[2].optLikeUnion()
@@ -8753,7 +8847,7 @@ mysqdefault:
 	case 115:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:1009
+//line mysql.y:1020
 		{
 			indexDef := mysqDollar[1].alterTableUnion().AlterOptions[0].(*ast.AddIndexDefinition).IndexDefinition
 			indexDef.Columns = mysqDollar[3].indexColumnsUnion()
@@ -8766,410 +8860,434 @@ mysqdefault:
 	case 116:
 		mysqDollar = mysqS[mysqpt-12 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:1018
+//line mysql.y:1029
 		{
 			mysqLOCAL = &ast.CreateView{ViewName: mysqDollar[8].tableName.ToViewName(), Comments: ast.Comments(mysqDollar[2].strs).Parsed(), IsReplace: mysqDollar[3].booleanUnion(), Algorithm: mysqDollar[4].str, Definer: mysqDollar[5].definerUnion(), Security: mysqDollar[6].str, Columns: mysqDollar[9].columnsUnion(), Select: mysqDollar[11].selStmtUnion(), CheckOption: mysqDollar[12].str}
 		}
 		mysqVAL.union = mysqLOCAL
 	case 117:
+		mysqDollar = mysqS[mysqpt-13 : mysqpt+1]
+		var mysqLOCAL ast.Statement
+//line mysql.y:1033
+		{
+			mysqLOCAL = &ast.CreateTrigger{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Name: mysqDollar[7].colIdent, Timing: mysqDollar[8].str, Events: []*ast.TriggerEvent{mysqDollar[9].triggerEventUnion()}, Table: mysqDollar[11].tableName, ForEachRow: true}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 118:
+		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
+		var mysqLOCAL ast.Statement
+//line mysql.y:1037
+		{
+			mysqLOCAL = &ast.CreateFunction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Procedure: true, Name: mysqDollar[7].tableName}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 119:
+		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
+		var mysqLOCAL ast.Statement
+//line mysql.y:1041
+		{
+			mysqLOCAL = &ast.CreateFunction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Name: mysqDollar[7].tableName}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 120:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:1022
+//line mysql.y:1045
 		{
 			mysqDollar[1].createDatabaseUnion().FullyParsed = true
 			mysqDollar[1].createDatabaseUnion().CreateOptions = mysqDollar[2].databaseOptionsUnion()
 			mysqLOCAL = mysqDollar[1].createDatabaseUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 118:
+	case 121:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:1029
+//line mysql.y:1052
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 119:
+	case 122:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:1033
+//line mysql.y:1056
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 120:
+	case 123:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:1038
+//line mysql.y:1061
 		{
 			mysqVAL.colIdent = ast.NewColIdent("")
 		}
-	case 121:
+	case 124:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1042
+//line mysql.y:1065
 		{
 			mysqVAL.colIdent = mysqDollar[2].colIdent
 		}
-	case 122:
+	case 125:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1048
+//line mysql.y:1071
 		{
 			mysqVAL.colIdent = mysqDollar[1].colIdent
 		}
-	case 123:
+	case 126:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []ast.VindexParam
-//line mysql.y:1053
+//line mysql.y:1076
 		{
 			var v []ast.VindexParam
 			mysqLOCAL = v
 		}
 		mysqVAL.union = mysqLOCAL
-	case 124:
+	case 127:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL []ast.VindexParam
-//line mysql.y:1058
+//line mysql.y:1081
 		{
 			mysqLOCAL = mysqDollar[2].vindexParamsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 125:
+	case 128:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.VindexParam
-//line mysql.y:1064
+//line mysql.y:1087
 		{
 			mysqLOCAL = make([]ast.VindexParam, 0, 4)
 			mysqLOCAL = append(mysqLOCAL, mysqDollar[1].vindexParam)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 126:
+	case 129:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1069
+//line mysql.y:1092
 		{
 			mysqSLICE := (*[]ast.VindexParam)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].vindexParam)
 		}
-	case 127:
+	case 130:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1075
+//line mysql.y:1098
 		{
 			mysqVAL.vindexParam = ast.VindexParam{Key: mysqDollar[1].colIdent, Val: mysqDollar[3].str}
 		}
-	case 128:
+	case 131:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []*ast.JSONObjectParam
-//line mysql.y:1080
+//line mysql.y:1103
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 129:
+	case 132:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.JSONObjectParam
-//line mysql.y:1084
+//line mysql.y:1107
 		{
 			mysqLOCAL = mysqDollar[1].jsonObjectParamsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 130:
+	case 133:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.JSONObjectParam
-//line mysql.y:1090
+//line mysql.y:1113
 		{
 			mysqLOCAL = []*ast.JSONObjectParam{mysqDollar[1].jsonObjectParam}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 131:
+	case 134:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1094
+//line mysql.y:1117
 		{
 			mysqSLICE := (*[]*ast.JSONObjectParam)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].jsonObjectParam)
 		}
-	case 132:
+	case 135:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1100
+//line mysql.y:1123
 		{
 			mysqVAL.jsonObjectParam = &ast.JSONObjectParam{Key: mysqDollar[1].exprUnion(), Value: mysqDollar[3].exprUnion()}
 		}
-	case 133:
+	case 136:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.CreateTable
-//line mysql.y:1106
+//line mysql.y:1129
 		{
 			mysqLOCAL = &ast.CreateTable{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Table: mysqDollar[6].tableName, IfNotExists: mysqDollar[5].booleanUnion(), Temp: mysqDollar[3].booleanUnion()}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 134:
+	case 137:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.AlterTable
-//line mysql.y:1113
+//line mysql.y:1136
 		{
 			mysqLOCAL = &ast.AlterTable{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Table: mysqDollar[4].tableName}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 135:
+	case 138:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.AlterTable
-//line mysql.y:1120
+//line mysql.y:1143
 		{
 			mysqLOCAL = &ast.AlterTable{Table: mysqDollar[7].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: mysqDollar[4].colIdent, Type: string(mysqDollar[3].str)}, Options: mysqDollar[5].indexOptionsUnion()}}}}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 136:
+	case 139:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.AlterTable
-//line mysql.y:1125
+//line mysql.y:1148
 		{
 			mysqLOCAL = &ast.AlterTable{Table: mysqDollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: mysqDollar[5].colIdent, Type: string(mysqDollar[3].str) + " " + string(mysqDollar[4].str), Fulltext: true}, Options: mysqDollar[6].indexOptionsUnion()}}}}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 137:
+	case 140:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.AlterTable
-//line mysql.y:1130
+//line mysql.y:1153
 		{
 			mysqLOCAL = &ast.AlterTable{Table: mysqDollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: mysqDollar[5].colIdent, Type: string(mysqDollar[3].str) + " " + string(mysqDollar[4].str), Spatial: true}, Options: mysqDollar[6].indexOptionsUnion()}}}}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 138:
+	case 141:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.AlterTable
-//line mysql.y:1135
+//line mysql.y:1158
 		{
 			mysqLOCAL = &ast.AlterTable{Table: mysqDollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: mysqDollar[5].colIdent, Type: string(mysqDollar[3].str) + " " + string(mysqDollar[4].str), Unique: true}, Options: mysqDollar[6].indexOptionsUnion()}}}}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 139:
+	case 142:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.CreateDatabase
-//line mysql.y:1142
+//line mysql.y:1165
 		{
 			mysqLOCAL = &ast.CreateDatabase{Comments: ast.Comments(mysqDollar[4].strs).Parsed(), DBName: mysqDollar[6].tableIdent, IfNotExists: mysqDollar[5].booleanUnion()}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 140:
+	case 143:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.AlterDatabase
-//line mysql.y:1149
+//line mysql.y:1172
 		{
 			mysqLOCAL = &ast.AlterDatabase{}
 			setDDL(mysqlex, mysqLOCAL)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 143:
+	case 146:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.TableSpec
-//line mysql.y:1160
+//line mysql.y:1183
 		{
 			mysqLOCAL = mysqDollar[2].tableSpecUnion()
 			mysqLOCAL.Options = mysqDollar[4].tableOptionsUnion()
 			mysqLOCAL.PartitionOption = mysqDollar[5].partitionOptionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 144:
+	case 147:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []ast.DatabaseOption
-//line mysql.y:1167
+//line mysql.y:1190
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 145:
+	case 148:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.DatabaseOption
-//line mysql.y:1171
+//line mysql.y:1194
 		{
 			mysqLOCAL = mysqDollar[1].databaseOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 146:
+	case 149:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.DatabaseOption
-//line mysql.y:1177
+//line mysql.y:1200
 		{
 			mysqLOCAL = []ast.DatabaseOption{mysqDollar[1].databaseOption}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 147:
+	case 150:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.DatabaseOption
-//line mysql.y:1181
+//line mysql.y:1204
 		{
 			mysqLOCAL = []ast.DatabaseOption{mysqDollar[1].databaseOption}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 148:
+	case 151:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.DatabaseOption
-//line mysql.y:1185
+//line mysql.y:1208
 		{
 			mysqLOCAL = []ast.DatabaseOption{mysqDollar[1].databaseOption}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 149:
+	case 152:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1189
+//line mysql.y:1212
 		{
 			mysqSLICE := (*[]ast.DatabaseOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].databaseOption)
 		}
-	case 150:
+	case 153:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1193
+//line mysql.y:1216
 		{
 			mysqSLICE := (*[]ast.DatabaseOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].databaseOption)
 		}
-	case 151:
+	case 154:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1197
+//line mysql.y:1220
 		{
 			mysqSLICE := (*[]ast.DatabaseOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].databaseOption)
 		}
-	case 152:
+	case 155:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:1203
+//line mysql.y:1226
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 153:
+	case 156:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:1207
+//line mysql.y:1230
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 154:
+	case 157:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1213
+//line mysql.y:1236
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.CharacterSetType, Value: (mysqDollar[4].colIdent.String()), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 155:
+	case 158:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1217
+//line mysql.y:1240
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.CharacterSetType, Value: (sql_types.EncodeStringSQL(mysqDollar[4].str)), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 156:
+	case 159:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1223
+//line mysql.y:1246
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.CollateType, Value: (mysqDollar[4].colIdent.String()), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 157:
+	case 160:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1227
+//line mysql.y:1250
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.CollateType, Value: (sql_types.EncodeStringSQL(mysqDollar[4].str)), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 158:
+	case 161:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1233
+//line mysql.y:1256
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.EncryptionType, Value: (mysqDollar[4].colIdent.String()), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 159:
+	case 162:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1237
+//line mysql.y:1260
 		{
 			mysqVAL.databaseOption = ast.DatabaseOption{Type: ast.EncryptionType, Value: (sql_types.EncodeStringSQL(mysqDollar[4].str)), IsDefault: mysqDollar[1].booleanUnion()}
 		}
-	case 160:
+	case 163:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.OptLike
-//line mysql.y:1243
+//line mysql.y:1266
 		{
 			mysqLOCAL = &ast.OptLike{LikeTable: mysqDollar[2].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 161:
+	case 164:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.OptLike
-//line mysql.y:1247
+//line mysql.y:1270
 		{
 			mysqLOCAL = &ast.OptLike{LikeTable: mysqDollar[3].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 162:
+	case 165:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.ColumnDefinition
-//line mysql.y:1253
+//line mysql.y:1276
 		{
 			mysqLOCAL = []*ast.ColumnDefinition{mysqDollar[1].columnDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 163:
+	case 166:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1257
+//line mysql.y:1280
 		{
 			mysqSLICE := (*[]*ast.ColumnDefinition)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].columnDefinitionUnion())
 		}
-	case 164:
+	case 167:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.TableSpec
-//line mysql.y:1263
+//line mysql.y:1286
 		{
 			mysqLOCAL = &ast.TableSpec{}
 			mysqLOCAL.AddColumn(mysqDollar[1].columnDefinitionUnion())
 		}
 		mysqVAL.union = mysqLOCAL
-	case 165:
+	case 168:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.TableSpec
-//line mysql.y:1268
+//line mysql.y:1291
 		{
 			mysqLOCAL = &ast.TableSpec{}
 			mysqLOCAL.AddConstraint(mysqDollar[1].constraintDefinitionUnion())
 		}
 		mysqVAL.union = mysqLOCAL
-	case 166:
+	case 169:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1273
+//line mysql.y:1296
 		{
 			mysqVAL.tableSpecUnion().AddColumn(mysqDollar[3].columnDefinitionUnion())
 		}
-	case 167:
+	case 170:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1277
+//line mysql.y:1300
 		{
 			mysqVAL.tableSpecUnion().AddColumn(mysqDollar[3].columnDefinitionUnion())
 			mysqVAL.tableSpecUnion().AddConstraint(mysqDollar[4].constraintDefinitionUnion())
 		}
-	case 168:
+	case 171:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1282
+//line mysql.y:1305
 		{
 			mysqVAL.tableSpecUnion().AddIndex(mysqDollar[3].indexDefinitionUnion())
 		}
-	case 169:
+	case 172:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1286
+//line mysql.y:1309
 		{
 			mysqVAL.tableSpecUnion().AddConstraint(mysqDollar[3].constraintDefinitionUnion())
 		}
-	case 170:
+	case 173:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1290
+//line mysql.y:1313
 		{
 			mysqVAL.tableSpecUnion().AddConstraint(mysqDollar[3].constraintDefinitionUnion())
 		}
-	case 171:
+	case 174:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnDefinition
-//line mysql.y:1301
+//line mysql.y:1324
 		{
 			mysqDollar[2].columnType.Options = mysqDollar[4].columnTypeOptionsUnion()
 			if mysqDollar[2].columnType.Options.Collate == "" {
@@ -9179,10 +9297,10 @@ mysqdefault:
 			mysqLOCAL = &ast.ColumnDefinition{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 172:
+	case 175:
 		mysqDollar = mysqS[mysqpt-10 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnDefinition
-//line mysql.y:1310
+//line mysql.y:1333
 		{
 			mysqDollar[2].columnType.Options = mysqDollar[9].columnTypeOptionsUnion()
 			mysqDollar[2].columnType.Options.As = mysqDollar[7].exprUnion()
@@ -9191,2594 +9309,2594 @@ mysqdefault:
 			mysqLOCAL = &ast.ColumnDefinition{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 173:
+	case 176:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:1319
+//line mysql.y:1342
 		{
 			mysqVAL.str = ""
 		}
-	case 174:
+	case 177:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1323
+//line mysql.y:1346
 		{
 			mysqVAL.str = ""
 		}
-	case 175:
+	case 178:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1332
+//line mysql.y:1355
 		{
 			mysqLOCAL = &ast.ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ast.ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: ast.UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 176:
+	case 179:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1336
+//line mysql.y:1359
 		{
 			val := true
 			mysqDollar[1].columnTypeOptionsUnion().Null = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 177:
+	case 180:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1342
+//line mysql.y:1365
 		{
 			val := false
 			mysqDollar[1].columnTypeOptionsUnion().Null = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 178:
+	case 181:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1348
+//line mysql.y:1371
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Default = mysqDollar[4].exprUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 179:
+	case 182:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1353
+//line mysql.y:1376
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Default = mysqDollar[3].exprUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 180:
+	case 183:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1358
+//line mysql.y:1381
 		{
 			mysqDollar[1].columnTypeOptionsUnion().OnUpdate = mysqDollar[4].exprUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 181:
+	case 184:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1363
+//line mysql.y:1386
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 182:
+	case 185:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1368
+//line mysql.y:1391
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Comment = ast.NewStrLiteral(mysqDollar[3].str)
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 183:
+	case 186:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1373
+//line mysql.y:1396
 		{
 			mysqDollar[1].columnTypeOptionsUnion().KeyOpt = mysqDollar[2].colKeyOptUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 184:
+	case 187:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1378
+//line mysql.y:1401
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Collate = sql_types.EncodeStringSQL(mysqDollar[3].str)
 		}
-	case 185:
+	case 188:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1382
+//line mysql.y:1405
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Collate = string(mysqDollar[3].colIdent.String())
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 186:
+	case 189:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1387
+//line mysql.y:1410
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Format = mysqDollar[3].columnFormatUnion()
 		}
-	case 187:
+	case 190:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1391
+//line mysql.y:1414
 		{
 			mysqDollar[1].columnTypeOptionsUnion().SRID = ast.NewIntLiteral(mysqDollar[3].str)
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 188:
+	case 191:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1396
+//line mysql.y:1419
 		{
 			val := false
 			mysqDollar[1].columnTypeOptionsUnion().Invisible = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 189:
+	case 192:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1402
+//line mysql.y:1425
 		{
 			val := true
 			mysqDollar[1].columnTypeOptionsUnion().Invisible = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 190:
+	case 193:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1408
+//line mysql.y:1431
 		{
 			mysqDollar[1].columnTypeOptionsUnion().EngineAttribute = ast.NewStrLiteral(mysqDollar[4].str)
 		}
-	case 191:
+	case 194:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:1412
+//line mysql.y:1435
 		{
 			mysqDollar[1].columnTypeOptionsUnion().SecondaryEngineAttribute = ast.NewStrLiteral(mysqDollar[4].str)
 		}
-	case 192:
+	case 195:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnFormat
-//line mysql.y:1418
+//line mysql.y:1441
 		{
 			mysqLOCAL = ast.FixedFormat
 		}
 		mysqVAL.union = mysqLOCAL
-	case 193:
+	case 196:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnFormat
-//line mysql.y:1422
+//line mysql.y:1445
 		{
 			mysqLOCAL = ast.DynamicFormat
 		}
 		mysqVAL.union = mysqLOCAL
-	case 194:
+	case 197:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnFormat
-//line mysql.y:1426
+//line mysql.y:1449
 		{
 			mysqLOCAL = ast.DefaultFormat
 		}
 		mysqVAL.union = mysqLOCAL
-	case 195:
+	case 198:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnStorage
-//line mysql.y:1432
+//line mysql.y:1455
 		{
 			mysqLOCAL = ast.VirtualStorage
 		}
 		mysqVAL.union = mysqLOCAL
-	case 196:
+	case 199:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnStorage
-//line mysql.y:1436
+//line mysql.y:1459
 		{
 			mysqLOCAL = ast.StoredStorage
 		}
 		mysqVAL.union = mysqLOCAL
-	case 197:
+	case 200:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1441
+//line mysql.y:1464
 		{
 			mysqLOCAL = &ast.ColumnTypeOptions{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 198:
+	case 201:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1445
+//line mysql.y:1468
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Storage = mysqDollar[2].columnStorageUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 199:
+	case 202:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1450
+//line mysql.y:1473
 		{
 			val := true
 			mysqDollar[1].columnTypeOptionsUnion().Null = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 200:
+	case 203:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1456
+//line mysql.y:1479
 		{
 			val := false
 			mysqDollar[1].columnTypeOptionsUnion().Null = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 201:
+	case 204:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1462
+//line mysql.y:1485
 		{
 			mysqDollar[1].columnTypeOptionsUnion().Comment = ast.NewStrLiteral(mysqDollar[3].str)
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 202:
+	case 205:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1467
+//line mysql.y:1490
 		{
 			mysqDollar[1].columnTypeOptionsUnion().KeyOpt = mysqDollar[2].colKeyOptUnion()
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 203:
+	case 206:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1472
+//line mysql.y:1495
 		{
 			val := false
 			mysqDollar[1].columnTypeOptionsUnion().Invisible = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 204:
+	case 207:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColumnTypeOptions
-//line mysql.y:1478
+//line mysql.y:1501
 		{
 			val := true
 			mysqDollar[1].columnTypeOptionsUnion().Invisible = &val
 			mysqLOCAL = mysqDollar[1].columnTypeOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 205:
+	case 208:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1486
+//line mysql.y:1509
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 207:
+	case 210:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1493
+//line mysql.y:1516
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_timestamp"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 208:
+	case 211:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1497
+//line mysql.y:1520
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("localtime"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 209:
+	case 212:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1501
+//line mysql.y:1524
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("localtimestamp"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 210:
+	case 213:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1505
+//line mysql.y:1528
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("utc_timestamp"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 211:
+	case 214:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1509
+//line mysql.y:1532
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("now"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 214:
+	case 217:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1520
+//line mysql.y:1543
 		{
 			mysqLOCAL = &ast.NullVal{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 216:
+	case 219:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1527
+//line mysql.y:1550
 		{
 			mysqLOCAL = mysqDollar[2].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 217:
+	case 220:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1531
+//line mysql.y:1554
 		{
 			mysqLOCAL = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 218:
+	case 221:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1537
+//line mysql.y:1560
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 219:
+	case 222:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1541
+//line mysql.y:1564
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 220:
+	case 223:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1545
+//line mysql.y:1568
 		{
 			mysqLOCAL = mysqDollar[1].boolValUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 221:
+	case 224:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1549
+//line mysql.y:1572
 		{
 			mysqLOCAL = ast.NewHexLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 222:
+	case 225:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1553
+//line mysql.y:1576
 		{
 			mysqLOCAL = ast.NewHexNumLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 223:
+	case 226:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1557
+//line mysql.y:1580
 		{
 			mysqLOCAL = ast.NewBitLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 224:
+	case 227:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1561
+//line mysql.y:1584
 		{
 			mysqLOCAL = ast.NewArgument(mysqDollar[1].str[1:])
 			bindVariable(mysqlex, mysqDollar[1].str[1:])
 		}
 		mysqVAL.union = mysqLOCAL
-	case 225:
+	case 228:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1566
+//line mysql.y:1589
 		{
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: ast.NewBitLiteral(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 226:
+	case 229:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1570
+//line mysql.y:1593
 		{
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: ast.NewHexNumLiteral(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 227:
+	case 230:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1574
+//line mysql.y:1597
 		{
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: ast.NewHexLiteral(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 228:
+	case 231:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1578
+//line mysql.y:1601
 		{
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: mysqDollar[2].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 229:
+	case 232:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1582
+//line mysql.y:1605
 		{
 			bindVariable(mysqlex, mysqDollar[2].str[1:])
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: ast.NewArgument(mysqDollar[2].str[1:])}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 230:
+	case 233:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1589
+//line mysql.y:1612
 		{
 			mysqVAL.str = ast.Armscii8Str
 		}
-	case 231:
+	case 234:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1593
+//line mysql.y:1616
 		{
 			mysqVAL.str = ast.ASCIIStr
 		}
-	case 232:
+	case 235:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1597
+//line mysql.y:1620
 		{
 			mysqVAL.str = ast.Big5Str
 		}
-	case 233:
+	case 236:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1601
+//line mysql.y:1624
 		{
 			mysqVAL.str = ast.UBinaryStr
 		}
-	case 234:
+	case 237:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1605
+//line mysql.y:1628
 		{
 			mysqVAL.str = ast.Cp1250Str
 		}
-	case 235:
+	case 238:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1609
+//line mysql.y:1632
 		{
 			mysqVAL.str = ast.Cp1251Str
 		}
-	case 236:
+	case 239:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1613
+//line mysql.y:1636
 		{
 			mysqVAL.str = ast.Cp1256Str
 		}
-	case 237:
+	case 240:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1617
+//line mysql.y:1640
 		{
 			mysqVAL.str = ast.Cp1257Str
 		}
-	case 238:
+	case 241:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1621
+//line mysql.y:1644
 		{
 			mysqVAL.str = ast.Cp850Str
 		}
-	case 239:
+	case 242:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1625
+//line mysql.y:1648
 		{
 			mysqVAL.str = ast.Cp852Str
 		}
-	case 240:
+	case 243:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1629
+//line mysql.y:1652
 		{
 			mysqVAL.str = ast.Cp866Str
 		}
-	case 241:
+	case 244:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1633
+//line mysql.y:1656
 		{
 			mysqVAL.str = ast.Cp932Str
 		}
-	case 242:
+	case 245:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1637
+//line mysql.y:1660
 		{
 			mysqVAL.str = ast.Dec8Str
 		}
-	case 243:
+	case 246:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1641
+//line mysql.y:1664
 		{
 			mysqVAL.str = ast.EucjpmsStr
 		}
-	case 244:
+	case 247:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1645
+//line mysql.y:1668
 		{
 			mysqVAL.str = ast.EuckrStr
 		}
-	case 245:
+	case 248:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1649
+//line mysql.y:1672
 		{
 			mysqVAL.str = ast.Gb18030Str
 		}
-	case 246:
+	case 249:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1653
+//line mysql.y:1676
 		{
 			mysqVAL.str = ast.Gb2312Str
 		}
-	case 247:
+	case 250:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1657
+//line mysql.y:1680
 		{
 			mysqVAL.str = ast.GbkStr
 		}
-	case 248:
+	case 251:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1661
+//line mysql.y:1684
 		{
 			mysqVAL.str = ast.Geostd8Str
 		}
-	case 249:
+	case 252:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1665
+//line mysql.y:1688
 		{
 			mysqVAL.str = ast.GreekStr
 		}
-	case 250:
+	case 253:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1669
+//line mysql.y:1692
 		{
 			mysqVAL.str = ast.HebrewStr
 		}
-	case 251:
+	case 254:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1673
+//line mysql.y:1696
 		{
 			mysqVAL.str = ast.Hp8Str
 		}
-	case 252:
+	case 255:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1677
+//line mysql.y:1700
 		{
 			mysqVAL.str = ast.Keybcs2Str
 		}
-	case 253:
+	case 256:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1681
+//line mysql.y:1704
 		{
 			mysqVAL.str = ast.Koi8rStr
 		}
-	case 254:
+	case 257:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1685
+//line mysql.y:1708
 		{
 			mysqVAL.str = ast.Koi8uStr
 		}
-	case 255:
+	case 258:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1689
+//line mysql.y:1712
 		{
 			mysqVAL.str = ast.Latin1Str
 		}
-	case 256:
+	case 259:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1693
+//line mysql.y:1716
 		{
 			mysqVAL.str = ast.Latin2Str
 		}
-	case 257:
+	case 260:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1697
+//line mysql.y:1720
 		{
 			mysqVAL.str = ast.Latin5Str
 		}
-	case 258:
+	case 261:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1701
+//line mysql.y:1724
 		{
 			mysqVAL.str = ast.Latin7Str
 		}
-	case 259:
+	case 262:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1705
+//line mysql.y:1728
 		{
 			mysqVAL.str = ast.MacceStr
 		}
-	case 260:
+	case 263:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1709
+//line mysql.y:1732
 		{
 			mysqVAL.str = ast.MacromanStr
 		}
-	case 261:
+	case 264:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1713
+//line mysql.y:1736
 		{
 			mysqVAL.str = ast.SjisStr
 		}
-	case 262:
+	case 265:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1717
+//line mysql.y:1740
 		{
 			mysqVAL.str = ast.Swe7Str
 		}
-	case 263:
+	case 266:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1721
+//line mysql.y:1744
 		{
 			mysqVAL.str = ast.Tis620Str
 		}
-	case 264:
+	case 267:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1725
+//line mysql.y:1748
 		{
 			mysqVAL.str = ast.Ucs2Str
 		}
-	case 265:
+	case 268:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1729
+//line mysql.y:1752
 		{
 			mysqVAL.str = ast.UjisStr
 		}
-	case 266:
+	case 269:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1733
+//line mysql.y:1756
 		{
 			mysqVAL.str = ast.Utf16Str
 		}
-	case 267:
+	case 270:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1737
+//line mysql.y:1760
 		{
 			mysqVAL.str = ast.Utf16leStr
 		}
-	case 268:
+	case 271:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1741
+//line mysql.y:1764
 		{
 			mysqVAL.str = ast.Utf32Str
 		}
-	case 269:
+	case 272:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1745
+//line mysql.y:1768
 		{
 			mysqVAL.str = ast.Utf8Str
 		}
-	case 270:
+	case 273:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1749
+//line mysql.y:1772
 		{
 			mysqVAL.str = ast.Utf8mb4Str
 		}
-	case 271:
+	case 274:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1753
+//line mysql.y:1776
 		{
 			mysqVAL.str = ast.Utf8Str
 		}
-	case 274:
+	case 277:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1763
+//line mysql.y:1786
 		{
 			mysqLOCAL = ast.NewIntLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 275:
+	case 278:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1767
+//line mysql.y:1790
 		{
 			mysqLOCAL = ast.NewFloatLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 276:
+	case 279:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1771
+//line mysql.y:1794
 		{
 			mysqLOCAL = ast.NewDecimalLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 277:
+	case 280:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1777
+//line mysql.y:1800
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 278:
+	case 281:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1781
+//line mysql.y:1804
 		{
 			mysqLOCAL = &ast.UnaryExpr{Operator: ast.NStringOp, Expr: ast.NewStrLiteral(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 279:
+	case 282:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1785
+//line mysql.y:1808
 		{
 			mysqLOCAL = &ast.IntroducerExpr{CharacterSet: mysqDollar[1].str, Expr: ast.NewStrLiteral(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 280:
+	case 283:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1791
+//line mysql.y:1814
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 281:
+	case 284:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:1795
+//line mysql.y:1818
 		{
 			mysqLOCAL = ast.NewArgument(mysqDollar[1].str[1:])
 			bindVariable(mysqlex, mysqDollar[1].str[1:])
 		}
 		mysqVAL.union = mysqLOCAL
-	case 282:
+	case 285:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ColumnKeyOption
-//line mysql.y:1802
+//line mysql.y:1825
 		{
 			mysqLOCAL = ast.ColKeyPrimary
 		}
 		mysqVAL.union = mysqLOCAL
-	case 283:
+	case 286:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnKeyOption
-//line mysql.y:1806
+//line mysql.y:1829
 		{
 			mysqLOCAL = ast.ColKeyUnique
 		}
 		mysqVAL.union = mysqLOCAL
-	case 284:
+	case 287:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ColumnKeyOption
-//line mysql.y:1810
+//line mysql.y:1833
 		{
 			mysqLOCAL = ast.ColKeyUniqueKey
 		}
 		mysqVAL.union = mysqLOCAL
-	case 285:
+	case 288:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColumnKeyOption
-//line mysql.y:1814
+//line mysql.y:1837
 		{
 			mysqLOCAL = ast.ColKey
 		}
 		mysqVAL.union = mysqLOCAL
-	case 286:
+	case 289:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1820
+//line mysql.y:1843
 		{
 			mysqVAL.columnType = mysqDollar[1].columnType
 			mysqVAL.columnType.Unsigned = mysqDollar[2].booleanUnion()
 			mysqVAL.columnType.Zerofill = mysqDollar[3].booleanUnion()
 		}
-	case 290:
+	case 293:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1831
+//line mysql.y:1854
 		{
 			mysqVAL.columnType = mysqDollar[1].columnType
 			mysqVAL.columnType.Length = mysqDollar[2].literalUnion()
 		}
-	case 291:
+	case 294:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1836
+//line mysql.y:1859
 		{
 			mysqVAL.columnType = mysqDollar[1].columnType
 		}
-	case 292:
+	case 295:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1842
+//line mysql.y:1865
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 293:
+	case 296:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1846
+//line mysql.y:1869
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 294:
+	case 297:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1850
+//line mysql.y:1873
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 295:
+	case 298:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1854
+//line mysql.y:1877
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 296:
+	case 299:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1858
+//line mysql.y:1881
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 297:
+	case 300:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1862
+//line mysql.y:1885
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 298:
+	case 301:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1866
+//line mysql.y:1889
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 299:
+	case 302:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1870
+//line mysql.y:1893
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 300:
+	case 303:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1874
+//line mysql.y:1897
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 301:
+	case 304:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1880
+//line mysql.y:1903
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 			mysqVAL.columnType.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqVAL.columnType.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
-	case 302:
+	case 305:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1886
+//line mysql.y:1909
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 			mysqVAL.columnType.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqVAL.columnType.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
-	case 303:
+	case 306:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1892
+//line mysql.y:1915
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 			mysqVAL.columnType.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqVAL.columnType.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
-	case 304:
+	case 307:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1898
+//line mysql.y:1921
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 			mysqVAL.columnType.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqVAL.columnType.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
-	case 305:
+	case 308:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1904
+//line mysql.y:1927
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 			mysqVAL.columnType.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqVAL.columnType.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
-	case 306:
+	case 309:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1912
+//line mysql.y:1935
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 307:
+	case 310:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1916
+//line mysql.y:1939
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 308:
+	case 311:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1920
+//line mysql.y:1943
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 309:
+	case 312:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1924
+//line mysql.y:1947
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 310:
+	case 313:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1928
+//line mysql.y:1951
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 311:
+	case 314:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1934
+//line mysql.y:1957
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion(), Charset: mysqDollar[3].columnCharset}
 		}
-	case 312:
+	case 315:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1938
+//line mysql.y:1961
 		{
 			// CHAR BYTE is an alias for binary. See also:
 			// https://dev.mysql.com/doc/refman/8.0/en/string-type-syntax.html
 			mysqVAL.columnType = ast.ColumnType{Type: "binary", Length: mysqDollar[2].literalUnion()}
 		}
-	case 313:
+	case 316:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:1944
+//line mysql.y:1967
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion(), Charset: mysqDollar[3].columnCharset}
 		}
-	case 314:
+	case 317:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1948
+//line mysql.y:1971
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 315:
+	case 318:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1952
+//line mysql.y:1975
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
-	case 316:
+	case 319:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1956
+//line mysql.y:1979
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Charset: mysqDollar[2].columnCharset}
 		}
-	case 317:
+	case 320:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1960
+//line mysql.y:1983
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Charset: mysqDollar[2].columnCharset}
 		}
-	case 318:
+	case 321:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1964
+//line mysql.y:1987
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Charset: mysqDollar[2].columnCharset}
 		}
-	case 319:
+	case 322:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:1968
+//line mysql.y:1991
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), Charset: mysqDollar[2].columnCharset}
 		}
-	case 320:
+	case 323:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1972
+//line mysql.y:1995
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 321:
+	case 324:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1976
+//line mysql.y:1999
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 322:
+	case 325:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1980
+//line mysql.y:2003
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 323:
+	case 326:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1984
+//line mysql.y:2007
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 324:
+	case 327:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:1988
+//line mysql.y:2011
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 325:
+	case 328:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:1992
+//line mysql.y:2015
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), EnumValues: mysqDollar[3].strs, Charset: mysqDollar[5].columnCharset}
 		}
-	case 326:
+	case 329:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:1997
+//line mysql.y:2020
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str), EnumValues: mysqDollar[3].strs, Charset: mysqDollar[5].columnCharset}
 		}
-	case 327:
+	case 330:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2003
+//line mysql.y:2026
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 328:
+	case 331:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2007
+//line mysql.y:2030
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 329:
+	case 332:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2011
+//line mysql.y:2034
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 330:
+	case 333:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2015
+//line mysql.y:2038
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 331:
+	case 334:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2019
+//line mysql.y:2042
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 332:
+	case 335:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2023
+//line mysql.y:2046
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 333:
+	case 336:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2027
+//line mysql.y:2050
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 334:
+	case 337:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2031
+//line mysql.y:2054
 		{
 			mysqVAL.columnType = ast.ColumnType{Type: string(mysqDollar[1].str)}
 		}
-	case 335:
+	case 338:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2037
+//line mysql.y:2060
 		{
 			mysqVAL.strs = make([]string, 0, 4)
 			mysqVAL.strs = append(mysqVAL.strs, sql_types.EncodeStringSQL(mysqDollar[1].str))
 		}
-	case 336:
+	case 339:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2042
+//line mysql.y:2065
 		{
 			mysqVAL.strs = append(mysqDollar[1].strs, sql_types.EncodeStringSQL(mysqDollar[3].str))
 		}
-	case 337:
+	case 340:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.Literal
-//line mysql.y:2047
+//line mysql.y:2070
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 338:
+	case 341:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.Literal
-//line mysql.y:2051
+//line mysql.y:2074
 		{
 			mysqLOCAL = ast.NewIntLiteral(mysqDollar[2].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 339:
+	case 342:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2056
+//line mysql.y:2079
 		{
 			mysqVAL.LengthScaleOption = ast.LengthScaleOption{}
 		}
-	case 340:
+	case 343:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:2060
+//line mysql.y:2083
 		{
 			mysqVAL.LengthScaleOption = ast.LengthScaleOption{
 				Length: ast.NewIntLiteral(mysqDollar[2].str),
 				Scale:  ast.NewIntLiteral(mysqDollar[4].str),
 			}
 		}
-	case 341:
+	case 344:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2068
+//line mysql.y:2091
 		{
 			mysqVAL.LengthScaleOption = ast.LengthScaleOption{}
 		}
-	case 342:
+	case 345:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2072
+//line mysql.y:2095
 		{
 			mysqVAL.LengthScaleOption = ast.LengthScaleOption{
 				Length: ast.NewIntLiteral(mysqDollar[2].str),
 			}
 		}
-	case 343:
+	case 346:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:2078
+//line mysql.y:2101
 		{
 			mysqVAL.LengthScaleOption = ast.LengthScaleOption{
 				Length: ast.NewIntLiteral(mysqDollar[2].str),
 				Scale:  ast.NewIntLiteral(mysqDollar[4].str),
 			}
 		}
-	case 344:
+	case 347:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2086
+//line mysql.y:2109
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 345:
+	case 348:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2090
+//line mysql.y:2113
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 346:
+	case 349:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2094
+//line mysql.y:2117
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 347:
+	case 350:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2099
+//line mysql.y:2122
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 348:
+	case 351:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2103
+//line mysql.y:2126
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 349:
+	case 352:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2108
+//line mysql.y:2131
 		{
 			mysqVAL.columnCharset = ast.ColumnCharset{}
 		}
-	case 350:
+	case 353:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2112
+//line mysql.y:2135
 		{
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: string(mysqDollar[2].colIdent.String()), Binary: mysqDollar[3].booleanUnion()}
 		}
-	case 351:
+	case 354:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2116
+//line mysql.y:2139
 		{
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: sql_types.EncodeStringSQL(mysqDollar[2].str), Binary: mysqDollar[3].booleanUnion()}
 		}
-	case 352:
+	case 355:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2120
+//line mysql.y:2143
 		{
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: string(mysqDollar[2].str)}
 		}
-	case 353:
+	case 356:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2124
+//line mysql.y:2147
 		{
 			// ASCII: ast.Shorthand for CHARACTER SET latin1.
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: "latin1", Binary: mysqDollar[2].booleanUnion()}
 		}
-	case 354:
+	case 357:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2129
+//line mysql.y:2152
 		{
 			// UNICODE: ast.Shorthand for CHARACTER SET ucs2.
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: "ucs2", Binary: mysqDollar[2].booleanUnion()}
 		}
-	case 355:
+	case 358:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2134
+//line mysql.y:2157
 		{
 			// BINARY: ast.Shorthand for default CHARACTER SET but with binary collation
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: "", Binary: true}
 		}
-	case 356:
+	case 359:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2139
+//line mysql.y:2162
 		{
 			// BINARY ASCII: ast.Shorthand for CHARACTER SET latin1 with binary collation
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: "latin1", Binary: true}
 		}
-	case 357:
+	case 360:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2144
+//line mysql.y:2167
 		{
 			// BINARY UNICODE: ast.Shorthand for CHARACTER SET ucs2 with binary collation
 			mysqVAL.columnCharset = ast.ColumnCharset{Name: "ucs2", Binary: true}
 		}
-	case 358:
+	case 361:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2150
+//line mysql.y:2173
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 359:
+	case 362:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2154
+//line mysql.y:2177
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 360:
+	case 363:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2159
+//line mysql.y:2182
 		{
 			mysqVAL.str = ""
 		}
-	case 361:
+	case 364:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2163
+//line mysql.y:2186
 		{
 			mysqVAL.str = string(mysqDollar[2].colIdent.String())
 		}
-	case 362:
+	case 365:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2167
+//line mysql.y:2190
 		{
 			mysqVAL.str = sql_types.EncodeStringSQL(mysqDollar[2].str)
 		}
-	case 363:
+	case 366:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.IndexDefinition
-//line mysql.y:2174
+//line mysql.y:2197
 		{
 			mysqLOCAL = &ast.IndexDefinition{Info: mysqDollar[1].indexInfoUnion(), Columns: mysqDollar[3].indexColumnsUnion(), Options: mysqDollar[5].indexOptionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 364:
+	case 367:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexOption
-//line mysql.y:2179
+//line mysql.y:2202
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 365:
+	case 368:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexOption
-//line mysql.y:2183
+//line mysql.y:2206
 		{
 			mysqLOCAL = mysqDollar[1].indexOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 366:
+	case 369:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexOption
-//line mysql.y:2189
+//line mysql.y:2212
 		{
 			mysqLOCAL = []*ast.IndexOption{mysqDollar[1].indexOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 367:
+	case 370:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2193
+//line mysql.y:2216
 		{
 			mysqSLICE := (*[]*ast.IndexOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].indexOptionUnion())
 		}
-	case 368:
+	case 371:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2199
+//line mysql.y:2222
 		{
 			mysqLOCAL = mysqDollar[1].indexOptionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 369:
+	case 372:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2203
+//line mysql.y:2226
 		{
 			// should not be string
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 370:
+	case 373:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2208
+//line mysql.y:2231
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 371:
+	case 374:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2212
+//line mysql.y:2235
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 372:
+	case 375:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2216
+//line mysql.y:2239
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 373:
+	case 376:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2220
+//line mysql.y:2243
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str) + " " + string(mysqDollar[2].str), String: mysqDollar[3].colIdent.String()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 374:
+	case 377:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2224
+//line mysql.y:2247
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 375:
+	case 378:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:2228
+//line mysql.y:2251
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 376:
+	case 379:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2234
+//line mysql.y:2257
 		{
 			mysqVAL.str = ""
 		}
-	case 377:
+	case 380:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2238
+//line mysql.y:2261
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 378:
+	case 381:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.IndexInfo
-//line mysql.y:2244
+//line mysql.y:2267
 		{
 			mysqLOCAL = &ast.IndexInfo{Type: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str), ConstraintName: ast.NewColIdent(mysqDollar[1].str), Name: ast.NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 379:
+	case 382:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexInfo
-//line mysql.y:2248
+//line mysql.y:2271
 		{
 			mysqLOCAL = &ast.IndexInfo{Type: string(mysqDollar[1].str) + " " + string(mysqDollar[2].str), Name: ast.NewColIdent(mysqDollar[3].str), Spatial: true, Unique: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 380:
+	case 383:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexInfo
-//line mysql.y:2252
+//line mysql.y:2275
 		{
 			mysqLOCAL = &ast.IndexInfo{Type: string(mysqDollar[1].str) + " " + string(mysqDollar[2].str), Name: ast.NewColIdent(mysqDollar[3].str), Fulltext: true, Unique: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 381:
+	case 384:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.IndexInfo
-//line mysql.y:2256
+//line mysql.y:2279
 		{
 			mysqLOCAL = &ast.IndexInfo{Type: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str), ConstraintName: ast.NewColIdent(mysqDollar[1].str), Name: ast.NewColIdent(mysqDollar[4].str), Unique: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 382:
+	case 385:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.IndexInfo
-//line mysql.y:2260
+//line mysql.y:2283
 		{
 			mysqLOCAL = &ast.IndexInfo{Type: string(mysqDollar[1].str), Name: ast.NewColIdent(mysqDollar[2].str), Unique: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 383:
+	case 386:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2265
+//line mysql.y:2288
 		{
 			mysqVAL.str = ""
 		}
-	case 384:
+	case 387:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2269
+//line mysql.y:2292
 		{
 			mysqVAL.str = mysqDollar[2].str
 		}
-	case 385:
+	case 388:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2275
+//line mysql.y:2298
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 386:
+	case 389:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2279
+//line mysql.y:2302
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 387:
+	case 390:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2283
+//line mysql.y:2306
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 388:
+	case 391:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2290
+//line mysql.y:2313
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 389:
+	case 392:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2294
+//line mysql.y:2317
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 390:
+	case 393:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2299
+//line mysql.y:2322
 		{
 			mysqVAL.str = "key"
 		}
-	case 391:
+	case 394:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2303
+//line mysql.y:2326
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 392:
+	case 395:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2309
+//line mysql.y:2332
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 393:
+	case 396:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2313
+//line mysql.y:2336
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 394:
+	case 397:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2318
+//line mysql.y:2341
 		{
 			mysqVAL.str = ""
 		}
-	case 395:
+	case 398:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2322
+//line mysql.y:2345
 		{
 			mysqVAL.str = string(mysqDollar[1].colIdent.String())
 		}
-	case 396:
+	case 399:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexColumn
-//line mysql.y:2328
+//line mysql.y:2351
 		{
 			mysqLOCAL = []*ast.IndexColumn{mysqDollar[1].indexColumnUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 397:
+	case 400:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2332
+//line mysql.y:2355
 		{
 			mysqSLICE := (*[]*ast.IndexColumn)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].indexColumnUnion())
 		}
-	case 398:
+	case 401:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.IndexColumn
-//line mysql.y:2338
+//line mysql.y:2361
 		{
 			mysqLOCAL = &ast.IndexColumn{Column: mysqDollar[1].colIdent, Length: mysqDollar[2].literalUnion(), Direction: mysqDollar[3].orderDirectionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 399:
+	case 402:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.IndexColumn
-//line mysql.y:2342
+//line mysql.y:2365
 		{
 			mysqLOCAL = &ast.IndexColumn{Expression: mysqDollar[2].exprUnion(), Direction: mysqDollar[4].orderDirectionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 400:
+	case 403:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ConstraintDefinition
-//line mysql.y:2348
+//line mysql.y:2371
 		{
 			mysqLOCAL = &ast.ConstraintDefinition{Name: mysqDollar[2].colIdent, Details: mysqDollar[3].constraintInfoUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 401:
+	case 404:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConstraintDefinition
-//line mysql.y:2352
+//line mysql.y:2375
 		{
 			mysqLOCAL = &ast.ConstraintDefinition{Details: mysqDollar[1].constraintInfoUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 402:
+	case 405:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ConstraintDefinition
-//line mysql.y:2358
+//line mysql.y:2381
 		{
 			mysqLOCAL = &ast.ConstraintDefinition{Name: mysqDollar[2].colIdent, Details: mysqDollar[3].constraintInfoUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 403:
+	case 406:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConstraintDefinition
-//line mysql.y:2362
+//line mysql.y:2385
 		{
 			mysqLOCAL = &ast.ConstraintDefinition{Details: mysqDollar[1].constraintInfoUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 404:
+	case 407:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.ConstraintInfo
-//line mysql.y:2368
+//line mysql.y:2391
 		{
 			mysqLOCAL = &ast.ForeignKeyDefinition{IndexName: ast.NewColIdent(mysqDollar[3].str), Source: mysqDollar[5].columnsUnion(), ReferenceDefinition: mysqDollar[7].referenceDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 405:
+	case 408:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2374
+//line mysql.y:2397
 		{
 			mysqLOCAL = &ast.ReferenceDefinition{ReferencedTable: mysqDollar[2].tableName, ReferencedColumns: mysqDollar[4].columnsUnion(), Match: mysqDollar[6].matchActionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 406:
+	case 409:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2378
+//line mysql.y:2401
 		{
 			mysqLOCAL = &ast.ReferenceDefinition{ReferencedTable: mysqDollar[2].tableName, ReferencedColumns: mysqDollar[4].columnsUnion(), Match: mysqDollar[6].matchActionUnion(), OnDelete: mysqDollar[7].referenceActionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 407:
+	case 410:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2382
+//line mysql.y:2405
 		{
 			mysqLOCAL = &ast.ReferenceDefinition{ReferencedTable: mysqDollar[2].tableName, ReferencedColumns: mysqDollar[4].columnsUnion(), Match: mysqDollar[6].matchActionUnion(), OnUpdate: mysqDollar[7].referenceActionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 408:
+	case 411:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2386
+//line mysql.y:2409
 		{
 			mysqLOCAL = &ast.ReferenceDefinition{ReferencedTable: mysqDollar[2].tableName, ReferencedColumns: mysqDollar[4].columnsUnion(), Match: mysqDollar[6].matchActionUnion(), OnDelete: mysqDollar[7].referenceActionUnion(), OnUpdate: mysqDollar[8].referenceActionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 409:
+	case 412:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2390
+//line mysql.y:2413
 		{
 			mysqLOCAL = &ast.ReferenceDefinition{ReferencedTable: mysqDollar[2].tableName, ReferencedColumns: mysqDollar[4].columnsUnion(), Match: mysqDollar[6].matchActionUnion(), OnUpdate: mysqDollar[7].referenceActionUnion(), OnDelete: mysqDollar[8].referenceActionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 410:
+	case 413:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2395
+//line mysql.y:2418
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 411:
+	case 414:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ReferenceDefinition
-//line mysql.y:2399
+//line mysql.y:2422
 		{
 			mysqLOCAL = mysqDollar[1].referenceDefinitionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 412:
+	case 415:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.ConstraintInfo
-//line mysql.y:2405
+//line mysql.y:2428
 		{
 			mysqLOCAL = &ast.CheckConstraintDefinition{Expr: mysqDollar[3].exprUnion(), Enforced: mysqDollar[5].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 413:
+	case 416:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2411
+//line mysql.y:2434
 		{
 			mysqLOCAL = mysqDollar[2].matchActionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 414:
+	case 417:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2417
+//line mysql.y:2440
 		{
 			mysqLOCAL = ast.Full
 		}
 		mysqVAL.union = mysqLOCAL
-	case 415:
+	case 418:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2421
+//line mysql.y:2444
 		{
 			mysqLOCAL = ast.Partial
 		}
 		mysqVAL.union = mysqLOCAL
-	case 416:
+	case 419:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2425
+//line mysql.y:2448
 		{
 			mysqLOCAL = ast.Simple
 		}
 		mysqVAL.union = mysqLOCAL
-	case 417:
+	case 420:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2430
+//line mysql.y:2453
 		{
 			mysqLOCAL = ast.DefaultMatch
 		}
 		mysqVAL.union = mysqLOCAL
-	case 418:
+	case 421:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.MatchAction
-//line mysql.y:2434
+//line mysql.y:2457
 		{
 			mysqLOCAL = mysqDollar[1].matchActionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 419:
+	case 422:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2440
+//line mysql.y:2463
 		{
 			mysqLOCAL = mysqDollar[3].referenceActionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 420:
+	case 423:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2446
+//line mysql.y:2469
 		{
 			mysqLOCAL = mysqDollar[3].referenceActionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 421:
+	case 424:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2452
+//line mysql.y:2475
 		{
 			mysqLOCAL = ast.Restrict
 		}
 		mysqVAL.union = mysqLOCAL
-	case 422:
+	case 425:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2456
+//line mysql.y:2479
 		{
 			mysqLOCAL = ast.Cascade
 		}
 		mysqVAL.union = mysqLOCAL
-	case 423:
+	case 426:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2460
+//line mysql.y:2483
 		{
 			mysqLOCAL = ast.NoAction
 		}
 		mysqVAL.union = mysqLOCAL
-	case 424:
+	case 427:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2464
+//line mysql.y:2487
 		{
 			mysqLOCAL = ast.SetDefault
 		}
 		mysqVAL.union = mysqLOCAL
-	case 425:
+	case 428:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ReferenceAction
-//line mysql.y:2468
+//line mysql.y:2491
 		{
 			mysqLOCAL = ast.SetNull
 		}
 		mysqVAL.union = mysqLOCAL
-	case 426:
+	case 429:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2473
+//line mysql.y:2496
 		{
 			mysqVAL.str = ""
 		}
-	case 427:
+	case 430:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2477
+//line mysql.y:2500
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 428:
+	case 431:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2481
+//line mysql.y:2504
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 429:
+	case 432:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2487
+//line mysql.y:2510
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 430:
+	case 433:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2491
+//line mysql.y:2514
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 431:
+	case 434:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2496
+//line mysql.y:2519
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 432:
+	case 435:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2500
+//line mysql.y:2523
 		{
 			mysqLOCAL = mysqDollar[1].booleanUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 433:
+	case 436:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.TableOptions
-//line mysql.y:2505
+//line mysql.y:2528
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 434:
+	case 437:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableOptions
-//line mysql.y:2509
+//line mysql.y:2532
 		{
 			mysqLOCAL = mysqDollar[1].tableOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 435:
+	case 438:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableOptions
-//line mysql.y:2515
+//line mysql.y:2538
 		{
 			mysqLOCAL = ast.TableOptions{mysqDollar[1].tableOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 436:
+	case 439:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2519
+//line mysql.y:2542
 		{
 			mysqSLICE := (*ast.TableOptions)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableOptionUnion())
 		}
-	case 437:
+	case 440:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2523
+//line mysql.y:2546
 		{
 			mysqSLICE := (*ast.TableOptions)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].tableOptionUnion())
 		}
-	case 438:
+	case 441:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableOptions
-//line mysql.y:2529
+//line mysql.y:2552
 		{
 			mysqLOCAL = ast.TableOptions{mysqDollar[1].tableOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 439:
+	case 442:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2533
+//line mysql.y:2556
 		{
 			mysqSLICE := (*ast.TableOptions)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].tableOptionUnion())
 		}
-	case 440:
+	case 443:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2539
+//line mysql.y:2562
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 441:
+	case 444:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2543
+//line mysql.y:2566
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 442:
+	case 445:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2547
+//line mysql.y:2570
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 443:
+	case 446:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2551
+//line mysql.y:2574
 		{
 			mysqLOCAL = &ast.TableOption{Name: (string(mysqDollar[2].str)), String: mysqDollar[4].str, CaseSensitive: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 444:
+	case 447:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2555
+//line mysql.y:2578
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[2].str), String: mysqDollar[4].str, CaseSensitive: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 445:
+	case 448:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2559
+//line mysql.y:2582
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 446:
+	case 449:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2563
+//line mysql.y:2586
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 447:
+	case 450:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2567
+//line mysql.y:2590
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 448:
+	case 451:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2571
+//line mysql.y:2594
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 449:
+	case 452:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2575
+//line mysql.y:2598
 		{
 			mysqLOCAL = &ast.TableOption{Name: (string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)), Value: ast.NewStrLiteral(mysqDollar[4].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 450:
+	case 453:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2579
+//line mysql.y:2602
 		{
 			mysqLOCAL = &ast.TableOption{Name: (string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)), Value: ast.NewStrLiteral(mysqDollar[4].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 451:
+	case 454:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2583
+//line mysql.y:2606
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 452:
+	case 455:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2587
+//line mysql.y:2610
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 453:
+	case 456:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2591
+//line mysql.y:2614
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: mysqDollar[3].tableIdent.String(), CaseSensitive: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 454:
+	case 457:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2595
+//line mysql.y:2618
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 455:
+	case 458:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2599
+//line mysql.y:2622
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 456:
+	case 459:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2603
+//line mysql.y:2626
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 457:
+	case 460:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2607
+//line mysql.y:2630
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 458:
+	case 461:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2611
+//line mysql.y:2634
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 459:
+	case 462:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2615
+//line mysql.y:2638
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 460:
+	case 463:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2619
+//line mysql.y:2642
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 461:
+	case 464:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2623
+//line mysql.y:2646
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 462:
+	case 465:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2627
+//line mysql.y:2650
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 463:
+	case 466:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2631
+//line mysql.y:2654
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewStrLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 464:
+	case 467:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2635
+//line mysql.y:2658
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 465:
+	case 468:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2639
+//line mysql.y:2662
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 466:
+	case 469:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2643
+//line mysql.y:2666
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 467:
+	case 470:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2647
+//line mysql.y:2670
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 468:
+	case 471:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2651
+//line mysql.y:2674
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Value: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 469:
+	case 472:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2655
+//line mysql.y:2678
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), String: (mysqDollar[3].colIdent.String() + mysqDollar[4].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 470:
+	case 473:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.TableOption
-//line mysql.y:2659
+//line mysql.y:2682
 		{
 			mysqLOCAL = &ast.TableOption{Name: string(mysqDollar[1].str), Tables: mysqDollar[4].tableNamesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 471:
+	case 474:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2664
+//line mysql.y:2687
 		{
 			mysqVAL.str = ""
 		}
-	case 472:
+	case 475:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2668
+//line mysql.y:2691
 		{
 			mysqVAL.str = " " + string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 473:
+	case 476:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:2672
+//line mysql.y:2695
 		{
 			mysqVAL.str = " " + string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 483:
+	case 486:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2691
+//line mysql.y:2714
 		{
 			mysqVAL.str = mysqDollar[1].colIdent.String()
 		}
-	case 484:
+	case 487:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2695
+//line mysql.y:2718
 		{
 			mysqVAL.str = sql_types.EncodeStringSQL(mysqDollar[1].str)
 		}
-	case 485:
+	case 488:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:2699
+//line mysql.y:2722
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 486:
+	case 489:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:2704
+//line mysql.y:2727
 		{
 			mysqVAL.str = ""
 		}
-	case 488:
+	case 491:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2710
+//line mysql.y:2733
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 489:
+	case 492:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:2714
+//line mysql.y:2737
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 490:
+	case 493:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ColName
-//line mysql.y:2719
+//line mysql.y:2742
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 491:
+	case 494:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ColName
-//line mysql.y:2723
+//line mysql.y:2746
 		{
 			mysqLOCAL = mysqDollar[2].colNameUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 492:
+	case 495:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2728
+//line mysql.y:2751
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 493:
+	case 496:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2732
+//line mysql.y:2755
 		{
 			mysqLOCAL = mysqDollar[1].alterOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 494:
+	case 497:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:2736
+//line mysql.y:2759
 		{
 			mysqSLICE := (*[]ast.AlterOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, &ast.OrderByOption{Cols: mysqDollar[5].columnsUnion()})
 		}
-	case 495:
+	case 498:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2740
+//line mysql.y:2763
 		{
 			mysqLOCAL = mysqDollar[1].alterOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 496:
+	case 499:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2744
+//line mysql.y:2767
 		{
 			mysqSLICE := (*[]ast.AlterOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].alterOptionsUnion()...)
 		}
-	case 497:
+	case 500:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2748
+//line mysql.y:2771
 		{
 			mysqLOCAL = append(append(mysqDollar[1].alterOptionsUnion(), mysqDollar[3].alterOptionsUnion()...), &ast.OrderByOption{Cols: mysqDollar[7].columnsUnion()})
 		}
 		mysqVAL.union = mysqLOCAL
-	case 498:
+	case 501:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2754
+//line mysql.y:2777
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 499:
+	case 502:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2758
+//line mysql.y:2781
 		{
 			mysqSLICE := (*[]ast.AlterOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].alterOptionUnion())
 		}
-	case 500:
+	case 503:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2762
+//line mysql.y:2785
 		{
 			mysqSLICE := (*[]ast.AlterOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].alterOptionUnion())
 		}
-	case 501:
+	case 504:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2768
+//line mysql.y:2791
 		{
 			mysqLOCAL = mysqDollar[1].tableOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 502:
+	case 505:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2772
+//line mysql.y:2795
 		{
 			mysqLOCAL = &ast.AddConstraintDefinition{ConstraintDefinition: mysqDollar[2].constraintDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 503:
+	case 506:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2776
+//line mysql.y:2799
 		{
 			mysqLOCAL = &ast.AddConstraintDefinition{ConstraintDefinition: mysqDollar[2].constraintDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 504:
+	case 507:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2780
+//line mysql.y:2803
 		{
 			mysqLOCAL = &ast.AddIndexDefinition{IndexDefinition: mysqDollar[2].indexDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 505:
+	case 508:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2784
+//line mysql.y:2807
 		{
 			mysqLOCAL = &ast.AddColumns{Columns: mysqDollar[4].columnDefinitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 506:
+	case 509:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2788
+//line mysql.y:2811
 		{
 			mysqLOCAL = &ast.AddColumns{Columns: []*ast.ColumnDefinition{mysqDollar[3].columnDefinitionUnion()}, First: mysqDollar[4].booleanUnion(), After: mysqDollar[5].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 507:
+	case 510:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2792
+//line mysql.y:2815
 		{
 			mysqLOCAL = &ast.AlterColumn{Column: mysqDollar[3].colNameUnion(), DropDefault: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 508:
+	case 511:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2796
+//line mysql.y:2819
 		{
 			mysqLOCAL = &ast.AlterColumn{Column: mysqDollar[3].colNameUnion(), DropDefault: false, DefaultVal: mysqDollar[6].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 509:
+	case 512:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2800
+//line mysql.y:2823
 		{
 			mysqLOCAL = &ast.AlterColumn{Column: mysqDollar[3].colNameUnion(), DropDefault: false, DefaultVal: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 510:
+	case 513:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2804
+//line mysql.y:2827
 		{
 			val := false
 			mysqLOCAL = &ast.AlterColumn{Column: mysqDollar[3].colNameUnion(), Invisible: &val}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 511:
+	case 514:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2809
+//line mysql.y:2832
 		{
 			val := true
 			mysqLOCAL = &ast.AlterColumn{Column: mysqDollar[3].colNameUnion(), Invisible: &val}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 512:
+	case 515:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2814
+//line mysql.y:2837
 		{
 			mysqLOCAL = &ast.AlterCheck{Name: mysqDollar[3].colIdent, Enforced: mysqDollar[4].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 513:
+	case 516:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2818
+//line mysql.y:2841
 		{
 			mysqLOCAL = &ast.AlterIndex{Name: mysqDollar[3].colIdent, Invisible: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 514:
+	case 517:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2822
+//line mysql.y:2845
 		{
 			mysqLOCAL = &ast.AlterIndex{Name: mysqDollar[3].colIdent, Invisible: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 515:
+	case 518:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2826
+//line mysql.y:2849
 		{
 			mysqLOCAL = &ast.ChangeColumn{OldColumn: mysqDollar[3].colNameUnion(), NewColDefinition: mysqDollar[4].columnDefinitionUnion(), First: mysqDollar[5].booleanUnion(), After: mysqDollar[6].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 516:
+	case 519:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2830
+//line mysql.y:2853
 		{
 			mysqLOCAL = &ast.ModifyColumn{NewColDefinition: mysqDollar[3].columnDefinitionUnion(), First: mysqDollar[4].booleanUnion(), After: mysqDollar[5].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 517:
+	case 520:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2834
+//line mysql.y:2857
 		{
 			mysqLOCAL = &ast.AlterCharset{CharacterSet: mysqDollar[4].str, Collate: mysqDollar[5].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 518:
+	case 521:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2838
+//line mysql.y:2861
 		{
 			mysqLOCAL = &ast.KeyState{Enable: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 519:
+	case 522:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2842
+//line mysql.y:2865
 		{
 			mysqLOCAL = &ast.KeyState{Enable: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 520:
+	case 523:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2846
+//line mysql.y:2869
 		{
 			mysqLOCAL = &ast.TablespaceOperation{Import: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 521:
+	case 524:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2850
+//line mysql.y:2873
 		{
 			mysqLOCAL = &ast.TablespaceOperation{Import: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 522:
+	case 525:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2854
+//line mysql.y:2877
 		{
 			mysqLOCAL = &ast.DropColumn{Name: mysqDollar[3].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 523:
+	case 526:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2858
+//line mysql.y:2881
 		{
 			mysqLOCAL = &ast.DropKey{Type: ast.NormalKeyType, Name: mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 524:
+	case 527:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2862
+//line mysql.y:2885
 		{
 			mysqLOCAL = &ast.DropKey{Type: ast.PrimaryKeyType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 525:
+	case 528:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2866
+//line mysql.y:2889
 		{
 			mysqLOCAL = &ast.DropKey{Type: ast.ForeignKeyType, Name: mysqDollar[4].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 526:
+	case 529:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2870
+//line mysql.y:2893
 		{
 			mysqLOCAL = &ast.DropKey{Type: ast.CheckKeyType, Name: mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 527:
+	case 530:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2874
+//line mysql.y:2897
 		{
 			mysqLOCAL = &ast.DropKey{Type: ast.CheckKeyType, Name: mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 528:
+	case 531:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2878
+//line mysql.y:2901
 		{
 			mysqLOCAL = &ast.Force{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 529:
+	case 532:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2882
+//line mysql.y:2905
 		{
 			mysqLOCAL = &ast.RenameTableName{Table: mysqDollar[3].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 530:
+	case 533:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2886
+//line mysql.y:2909
 		{
 			mysqLOCAL = &ast.RenameIndex{OldName: mysqDollar[3].colIdent, NewName: mysqDollar[5].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 531:
+	case 534:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:2892
+//line mysql.y:2915
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 532:
+	case 535:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:2896
+//line mysql.y:2919
 		{
 			mysqSLICE := (*[]ast.AlterOption)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].alterOptionUnion())
 		}
-	case 533:
+	case 536:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2902
+//line mysql.y:2925
 		{
 			mysqLOCAL = ast.AlgorithmValue(string(mysqDollar[3].str))
 		}
 		mysqVAL.union = mysqLOCAL
-	case 534:
+	case 537:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2906
+//line mysql.y:2929
 		{
 			mysqLOCAL = ast.AlgorithmValue(string(mysqDollar[3].str))
 		}
 		mysqVAL.union = mysqLOCAL
-	case 535:
+	case 538:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2910
+//line mysql.y:2933
 		{
 			mysqLOCAL = ast.AlgorithmValue(string(mysqDollar[3].str))
 		}
 		mysqVAL.union = mysqLOCAL
-	case 536:
+	case 539:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2914
+//line mysql.y:2937
 		{
 			mysqLOCAL = ast.AlgorithmValue(string(mysqDollar[3].str))
 		}
 		mysqVAL.union = mysqLOCAL
-	case 537:
+	case 540:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2918
+//line mysql.y:2941
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.DefaultType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 538:
+	case 541:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2922
+//line mysql.y:2945
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.NoneType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 539:
+	case 542:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2926
+//line mysql.y:2949
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.SharedType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 540:
+	case 543:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2930
+//line mysql.y:2953
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.ExclusiveType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 541:
+	case 544:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2934
+//line mysql.y:2957
 		{
 			mysqLOCAL = &ast.Validation{With: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 542:
+	case 545:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:2938
+//line mysql.y:2961
 		{
 			mysqLOCAL = &ast.Validation{With: false}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 543:
+	case 546:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2944
+//line mysql.y:2967
 		{
 			mysqDollar[1].alterTableUnion().FullyParsed = true
 			mysqDollar[1].alterTableUnion().AlterOptions = mysqDollar[2].alterOptionsUnion()
@@ -11786,10 +11904,10 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[1].alterTableUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 544:
+	case 547:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2951
+//line mysql.y:2974
 		{
 			mysqDollar[1].alterTableUnion().FullyParsed = true
 			mysqDollar[1].alterTableUnion().AlterOptions = mysqDollar[2].alterOptionsUnion()
@@ -11797,10 +11915,10 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[1].alterTableUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 545:
+	case 548:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2958
+//line mysql.y:2981
 		{
 			mysqDollar[1].alterTableUnion().FullyParsed = true
 			mysqDollar[1].alterTableUnion().AlterOptions = mysqDollar[2].alterOptionsUnion()
@@ -11808,28 +11926,28 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[1].alterTableUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 546:
+	case 549:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2965
+//line mysql.y:2988
 		{
 			mysqDollar[1].alterTableUnion().FullyParsed = true
 			mysqDollar[1].alterTableUnion().PartitionSpec = mysqDollar[2].partSpecUnion()
 			mysqLOCAL = mysqDollar[1].alterTableUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 547:
+	case 550:
 		mysqDollar = mysqS[mysqpt-11 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2971
+//line mysql.y:2994
 		{
 			mysqLOCAL = &ast.AlterView{ViewName: mysqDollar[7].tableName.ToViewName(), Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Algorithm: mysqDollar[3].str, Definer: mysqDollar[4].definerUnion(), Security: mysqDollar[5].str, Columns: mysqDollar[8].columnsUnion(), Select: mysqDollar[10].selStmtUnion(), CheckOption: mysqDollar[11].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 548:
+	case 551:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2981
+//line mysql.y:3004
 		{
 			mysqDollar[1].alterDatabaseUnion().FullyParsed = true
 			mysqDollar[1].alterDatabaseUnion().DBName = mysqDollar[2].tableIdent
@@ -11837,10 +11955,10 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[1].alterDatabaseUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 549:
+	case 552:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2988
+//line mysql.y:3011
 		{
 			mysqDollar[1].alterDatabaseUnion().FullyParsed = true
 			mysqDollar[1].alterDatabaseUnion().DBName = mysqDollar[2].tableIdent
@@ -11848,10 +11966,10 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[1].alterDatabaseUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 550:
+	case 553:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:2995
+//line mysql.y:3018
 		{
 			mysqLOCAL = &ast.AlterVschema{
 				Action: ast.CreateVindexDDLAction,
@@ -11864,10 +11982,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 551:
+	case 554:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3007
+//line mysql.y:3030
 		{
 			mysqLOCAL = &ast.AlterVschema{
 				Action: ast.DropVindexDDLAction,
@@ -11878,26 +11996,26 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 552:
+	case 555:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3017
+//line mysql.y:3040
 		{
 			mysqLOCAL = &ast.AlterVschema{Action: ast.AddVschemaTableDDLAction, Table: mysqDollar[6].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 553:
+	case 556:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3021
+//line mysql.y:3044
 		{
 			mysqLOCAL = &ast.AlterVschema{Action: ast.DropVschemaTableDDLAction, Table: mysqDollar[6].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 554:
+	case 557:
 		mysqDollar = mysqS[mysqpt-13 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3025
+//line mysql.y:3048
 		{
 			mysqLOCAL = &ast.AlterVschema{
 				Action: ast.AddColVindexDDLAction,
@@ -11911,10 +12029,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 555:
+	case 558:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3038
+//line mysql.y:3061
 		{
 			mysqLOCAL = &ast.AlterVschema{
 				Action: ast.DropColVindexDDLAction,
@@ -11925,18 +12043,18 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 556:
+	case 559:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3048
+//line mysql.y:3071
 		{
 			mysqLOCAL = &ast.AlterVschema{Action: ast.AddSequenceDDLAction, Table: mysqDollar[6].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 557:
+	case 560:
 		mysqDollar = mysqS[mysqpt-10 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3052
+//line mysql.y:3075
 		{
 			mysqLOCAL = &ast.AlterVschema{
 				Action: ast.AddAutoIncDDLAction,
@@ -11948,18 +12066,18 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 558:
+	case 561:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3064
+//line mysql.y:3087
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 559:
+	case 562:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3068
+//line mysql.y:3091
 		{
 			mysqDollar[3].partitionOptionUnion().Partitions = mysqDollar[4].integerUnion()
 			mysqDollar[3].partitionOptionUnion().SubPartition = mysqDollar[5].subPartitionUnion()
@@ -11967,10 +12085,10 @@ mysqdefault:
 			mysqLOCAL = mysqDollar[3].partitionOptionUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 560:
+	case 563:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3077
+//line mysql.y:3100
 		{
 			mysqLOCAL = &ast.PartitionOption{
 				IsLinear: mysqDollar[1].booleanUnion(),
@@ -11979,10 +12097,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 561:
+	case 564:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3085
+//line mysql.y:3108
 		{
 			mysqLOCAL = &ast.PartitionOption{
 				IsLinear:     mysqDollar[1].booleanUnion(),
@@ -11992,10 +12110,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 562:
+	case 565:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3094
+//line mysql.y:3117
 		{
 			mysqLOCAL = &ast.PartitionOption{
 				Type: mysqDollar[1].partitionByTypeUnion(),
@@ -12003,10 +12121,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 563:
+	case 566:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionOption
-//line mysql.y:3101
+//line mysql.y:3124
 		{
 			mysqLOCAL = &ast.PartitionOption{
 				Type:    mysqDollar[1].partitionByTypeUnion(),
@@ -12014,18 +12132,18 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 564:
+	case 567:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartition
-//line mysql.y:3109
+//line mysql.y:3132
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 565:
+	case 568:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartition
-//line mysql.y:3113
+//line mysql.y:3136
 		{
 			mysqLOCAL = &ast.SubPartition{
 				IsLinear:      mysqDollar[3].booleanUnion(),
@@ -12035,10 +12153,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 566:
+	case 569:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartition
-//line mysql.y:3122
+//line mysql.y:3145
 		{
 			mysqLOCAL = &ast.SubPartition{
 				IsLinear:      mysqDollar[3].booleanUnion(),
@@ -12049,682 +12167,682 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 567:
+	case 570:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []*ast.PartitionDefinition
-//line mysql.y:3133
+//line mysql.y:3156
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 568:
+	case 571:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL []*ast.PartitionDefinition
-//line mysql.y:3137
+//line mysql.y:3160
 		{
 			mysqLOCAL = mysqDollar[2].partDefsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 569:
+	case 572:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3142
+//line mysql.y:3165
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 570:
+	case 573:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3146
+//line mysql.y:3169
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 571:
+	case 574:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3151
+//line mysql.y:3174
 		{
 			mysqLOCAL = 0
 		}
 		mysqVAL.union = mysqLOCAL
-	case 572:
+	case 575:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3155
+//line mysql.y:3178
 		{
 			mysqLOCAL = ast.ConvertStringToInt(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 573:
+	case 576:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:3161
+//line mysql.y:3184
 		{
 			mysqLOCAL = &ast.JSONTableExpr{Expr: mysqDollar[3].exprUnion(), Filter: mysqDollar[5].exprUnion(), Columns: mysqDollar[6].jtColumnListUnion(), Alias: mysqDollar[8].tableIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 574:
+	case 577:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL []*ast.JtColumnDefinition
-//line mysql.y:3167
+//line mysql.y:3190
 		{
 			mysqLOCAL = mysqDollar[3].jtColumnListUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 575:
+	case 578:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.JtColumnDefinition
-//line mysql.y:3173
+//line mysql.y:3196
 		{
 			mysqLOCAL = []*ast.JtColumnDefinition{mysqDollar[1].jtColumnDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 576:
+	case 579:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:3177
+//line mysql.y:3200
 		{
 			mysqSLICE := (*[]*ast.JtColumnDefinition)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].jtColumnDefinitionUnion())
 		}
-	case 577:
+	case 580:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3183
+//line mysql.y:3206
 		{
 			mysqLOCAL = &ast.JtColumnDefinition{JtOrdinal: &ast.JtOrdinalColDef{Name: mysqDollar[1].colIdent}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 578:
+	case 581:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3187
+//line mysql.y:3210
 		{
 			mysqDollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: mysqDollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType, JtColExists: mysqDollar[4].booleanUnion(), Path: mysqDollar[6].exprUnion()}
 			mysqLOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 579:
+	case 582:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3193
+//line mysql.y:3216
 		{
 			mysqDollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: mysqDollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType, JtColExists: mysqDollar[4].booleanUnion(), Path: mysqDollar[6].exprUnion(), EmptyOnResponse: mysqDollar[7].jtOnResponseUnion()}
 			mysqLOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 580:
+	case 583:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3199
+//line mysql.y:3222
 		{
 			mysqDollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: mysqDollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType, JtColExists: mysqDollar[4].booleanUnion(), Path: mysqDollar[6].exprUnion(), ErrorOnResponse: mysqDollar[7].jtOnResponseUnion()}
 			mysqLOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 581:
+	case 584:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3205
+//line mysql.y:3228
 		{
 			mysqDollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: mysqDollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: mysqDollar[1].colIdent, Type: mysqDollar[2].columnType, JtColExists: mysqDollar[4].booleanUnion(), Path: mysqDollar[6].exprUnion(), EmptyOnResponse: mysqDollar[7].jtOnResponseUnion(), ErrorOnResponse: mysqDollar[8].jtOnResponseUnion()}
 			mysqLOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 582:
+	case 585:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.JtColumnDefinition
-//line mysql.y:3211
+//line mysql.y:3234
 		{
 			jtNestedPath := &ast.JtNestedPathColDef{Path: mysqDollar[3].exprUnion(), Columns: mysqDollar[4].jtColumnListUnion()}
 			mysqLOCAL = &ast.JtColumnDefinition{JtNestedPath: jtNestedPath}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 583:
+	case 586:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3217
+//line mysql.y:3240
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 584:
+	case 587:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3221
+//line mysql.y:3244
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 585:
+	case 588:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3225
+//line mysql.y:3248
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 586:
+	case 589:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3229
+//line mysql.y:3252
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 587:
+	case 590:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.JtOnResponse
-//line mysql.y:3235
+//line mysql.y:3258
 		{
 			mysqLOCAL = mysqDollar[1].jtOnResponseUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 588:
+	case 591:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.JtOnResponse
-//line mysql.y:3241
+//line mysql.y:3264
 		{
 			mysqLOCAL = mysqDollar[1].jtOnResponseUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 589:
+	case 592:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.JtOnResponse
-//line mysql.y:3247
+//line mysql.y:3270
 		{
 			mysqLOCAL = &ast.JtOnResponse{ResponseType: ast.ErrorJSONType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 590:
+	case 593:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.JtOnResponse
-//line mysql.y:3251
+//line mysql.y:3274
 		{
 			mysqLOCAL = &ast.JtOnResponse{ResponseType: ast.NullJSONType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 591:
+	case 594:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.JtOnResponse
-//line mysql.y:3255
+//line mysql.y:3278
 		{
 			mysqLOCAL = &ast.JtOnResponse{ResponseType: ast.DefaultJSONType, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 592:
+	case 595:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.PartitionByType
-//line mysql.y:3261
+//line mysql.y:3284
 		{
 			mysqLOCAL = ast.RangeType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 593:
+	case 596:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.PartitionByType
-//line mysql.y:3265
+//line mysql.y:3288
 		{
 			mysqLOCAL = ast.ListType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 594:
+	case 597:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3270
+//line mysql.y:3293
 		{
 			mysqLOCAL = -1
 		}
 		mysqVAL.union = mysqLOCAL
-	case 595:
+	case 598:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3274
+//line mysql.y:3297
 		{
 			mysqLOCAL = ast.ConvertStringToInt(mysqDollar[2].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 596:
+	case 599:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3279
+//line mysql.y:3302
 		{
 			mysqLOCAL = -1
 		}
 		mysqVAL.union = mysqLOCAL
-	case 597:
+	case 600:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3283
+//line mysql.y:3306
 		{
 			mysqLOCAL = ast.ConvertStringToInt(mysqDollar[2].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 598:
+	case 601:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3289
+//line mysql.y:3312
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.AddAction, Definitions: []*ast.PartitionDefinition{mysqDollar[4].partDefUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 599:
+	case 602:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3293
+//line mysql.y:3316
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.DropAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 600:
+	case 603:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3297
+//line mysql.y:3320
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.ReorganizeAction, Names: mysqDollar[3].partitionsUnion(), Definitions: mysqDollar[6].partDefsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 601:
+	case 604:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3301
+//line mysql.y:3324
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.DiscardAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 602:
+	case 605:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3305
+//line mysql.y:3328
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.DiscardAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 603:
+	case 606:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3309
+//line mysql.y:3332
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.ImportAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 604:
+	case 607:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3313
+//line mysql.y:3336
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.ImportAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 605:
+	case 608:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3317
+//line mysql.y:3340
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.TruncateAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 606:
+	case 609:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3321
+//line mysql.y:3344
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.TruncateAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 607:
+	case 610:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3325
+//line mysql.y:3348
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.CoalesceAction, Number: ast.NewIntLiteral(mysqDollar[3].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 608:
+	case 611:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3329
+//line mysql.y:3352
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.ExchangeAction, Names: ast.Partitions{mysqDollar[3].colIdent}, TableName: mysqDollar[6].tableName, WithoutValidation: mysqDollar[7].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 609:
+	case 612:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3333
+//line mysql.y:3356
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.AnalyzeAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 610:
+	case 613:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3337
+//line mysql.y:3360
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.AnalyzeAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 611:
+	case 614:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3341
+//line mysql.y:3364
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.CheckAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 612:
+	case 615:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3345
+//line mysql.y:3368
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.CheckAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 613:
+	case 616:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3349
+//line mysql.y:3372
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.OptimizeAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 614:
+	case 617:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3353
+//line mysql.y:3376
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.OptimizeAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 615:
+	case 618:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3357
+//line mysql.y:3380
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.RebuildAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 616:
+	case 619:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3361
+//line mysql.y:3384
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.RebuildAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 617:
+	case 620:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3365
+//line mysql.y:3388
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.RepairAction, Names: mysqDollar[3].partitionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 618:
+	case 621:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3369
+//line mysql.y:3392
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.RepairAction, IsAll: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 619:
+	case 622:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionSpec
-//line mysql.y:3373
+//line mysql.y:3396
 		{
 			mysqLOCAL = &ast.PartitionSpec{Action: ast.UpgradeAction}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 620:
+	case 623:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3378
+//line mysql.y:3401
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 621:
+	case 624:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3382
+//line mysql.y:3405
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 622:
+	case 625:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3386
+//line mysql.y:3409
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 623:
+	case 626:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.PartitionDefinition
-//line mysql.y:3393
+//line mysql.y:3416
 		{
 			mysqLOCAL = []*ast.PartitionDefinition{mysqDollar[1].partDefUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 624:
+	case 627:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:3397
+//line mysql.y:3420
 		{
 			mysqSLICE := (*[]*ast.PartitionDefinition)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].partDefUnion())
 		}
-	case 625:
+	case 628:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:3403
+//line mysql.y:3426
 		{
 			mysqVAL.partDefUnion().Options = mysqDollar[2].partitionDefinitionOptionsUnion()
 		}
-	case 626:
+	case 629:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3408
+//line mysql.y:3431
 		{
 			mysqLOCAL = &ast.PartitionDefinitionOptions{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 627:
+	case 630:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3412
+//line mysql.y:3435
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().ValueRange = mysqDollar[2].partitionValueRangeUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 628:
+	case 631:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3417
+//line mysql.y:3440
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().Comment = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 629:
+	case 632:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3422
+//line mysql.y:3445
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().Engine = mysqDollar[2].partitionEngineUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 630:
+	case 633:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3427
+//line mysql.y:3450
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().DataDirectory = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 631:
+	case 634:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3432
+//line mysql.y:3455
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().IndexDirectory = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 632:
+	case 635:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3437
+//line mysql.y:3460
 		{
 			val := mysqDollar[2].integerUnion()
 			mysqDollar[1].partitionDefinitionOptionsUnion().MaxRows = &val
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 633:
+	case 636:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3443
+//line mysql.y:3466
 		{
 			val := mysqDollar[2].integerUnion()
 			mysqDollar[1].partitionDefinitionOptionsUnion().MinRows = &val
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 634:
+	case 637:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3449
+//line mysql.y:3472
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().TableSpace = mysqDollar[2].str
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 635:
+	case 638:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinitionOptions
-//line mysql.y:3454
+//line mysql.y:3477
 		{
 			mysqDollar[1].partitionDefinitionOptionsUnion().SubPartitionDefinitions = mysqDollar[2].subPartitionDefinitionsUnion()
 			mysqLOCAL = mysqDollar[1].partitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 636:
+	case 639:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SubPartitionDefinitions
-//line mysql.y:3460
+//line mysql.y:3483
 		{
 			mysqLOCAL = mysqDollar[2].subPartitionDefinitionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 637:
+	case 640:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SubPartitionDefinitions
-//line mysql.y:3466
+//line mysql.y:3489
 		{
 			mysqLOCAL = ast.SubPartitionDefinitions{mysqDollar[1].subPartitionDefinitionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 638:
+	case 641:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:3470
+//line mysql.y:3493
 		{
 			mysqSLICE := (*ast.SubPartitionDefinitions)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].subPartitionDefinitionUnion())
 		}
-	case 639:
+	case 642:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinition
-//line mysql.y:3476
+//line mysql.y:3499
 		{
 			mysqLOCAL = &ast.SubPartitionDefinition{Name: mysqDollar[2].colIdent, Options: mysqDollar[3].subPartitionDefinitionOptionsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 640:
+	case 643:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3481
+//line mysql.y:3504
 		{
 			mysqLOCAL = &ast.SubPartitionDefinitionOptions{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 641:
+	case 644:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3485
+//line mysql.y:3508
 		{
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().Comment = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 642:
+	case 645:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3490
+//line mysql.y:3513
 		{
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().Engine = mysqDollar[2].partitionEngineUnion()
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 643:
+	case 646:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3495
+//line mysql.y:3518
 		{
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().DataDirectory = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 644:
+	case 647:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3500
+//line mysql.y:3523
 		{
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().IndexDirectory = mysqDollar[2].literalUnion()
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 645:
+	case 648:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3505
+//line mysql.y:3528
 		{
 			val := mysqDollar[2].integerUnion()
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().MaxRows = &val
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 646:
+	case 649:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3511
+//line mysql.y:3534
 		{
 			val := mysqDollar[2].integerUnion()
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().MinRows = &val
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 647:
+	case 650:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SubPartitionDefinitionOptions
-//line mysql.y:3517
+//line mysql.y:3540
 		{
 			mysqDollar[1].subPartitionDefinitionOptionsUnion().TableSpace = mysqDollar[2].str
 			mysqLOCAL = mysqDollar[1].subPartitionDefinitionOptionsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 648:
+	case 651:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionValueRange
-//line mysql.y:3524
+//line mysql.y:3547
 		{
 			mysqLOCAL = &ast.PartitionValueRange{
 				Type:  ast.LessThanType,
@@ -12732,10 +12850,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 649:
+	case 652:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionValueRange
-//line mysql.y:3531
+//line mysql.y:3554
 		{
 			mysqLOCAL = &ast.PartitionValueRange{
 				Type:     ast.LessThanType,
@@ -12743,10 +12861,10 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 650:
+	case 653:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionValueRange
-//line mysql.y:3538
+//line mysql.y:3561
 		{
 			mysqLOCAL = &ast.PartitionValueRange{
 				Type:  ast.InType,
@@ -12754,131 +12872,131 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 651:
+	case 654:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3546
+//line mysql.y:3569
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 652:
+	case 655:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3550
+//line mysql.y:3573
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 653:
+	case 656:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionEngine
-//line mysql.y:3556
+//line mysql.y:3579
 		{
 			mysqLOCAL = &ast.PartitionEngine{Storage: mysqDollar[1].booleanUnion(), Name: mysqDollar[4].tableIdent.String()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 654:
+	case 657:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.Literal
-//line mysql.y:3562
+//line mysql.y:3585
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 655:
+	case 658:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Literal
-//line mysql.y:3568
+//line mysql.y:3591
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[4].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 656:
+	case 659:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Literal
-//line mysql.y:3574
+//line mysql.y:3597
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[4].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 657:
+	case 660:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3580
+//line mysql.y:3603
 		{
 			mysqLOCAL = ast.ConvertStringToInt(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 658:
+	case 661:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL int
-//line mysql.y:3586
+//line mysql.y:3609
 		{
 			mysqLOCAL = ast.ConvertStringToInt(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 659:
+	case 662:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:3592
+//line mysql.y:3615
 		{
 			mysqVAL.str = mysqDollar[3].tableIdent.String()
 		}
-	case 660:
+	case 663:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.PartitionDefinition
-//line mysql.y:3598
+//line mysql.y:3621
 		{
 			mysqLOCAL = &ast.PartitionDefinition{Name: mysqDollar[2].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 661:
+	case 664:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3604
+//line mysql.y:3627
 		{
 			mysqVAL.str = ""
 		}
-	case 662:
+	case 665:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:3608
+//line mysql.y:3631
 		{
 			mysqVAL.str = ""
 		}
-	case 663:
+	case 666:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3614
+//line mysql.y:3637
 		{
 			mysqLOCAL = &ast.RenameTable{TablePairs: mysqDollar[3].renameTablePairsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 664:
+	case 667:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL []*ast.RenameTablePair
-//line mysql.y:3620
+//line mysql.y:3643
 		{
 			mysqLOCAL = []*ast.RenameTablePair{{FromTable: mysqDollar[1].tableName, ToTable: mysqDollar[3].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 665:
+	case 668:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:3624
+//line mysql.y:3647
 		{
 			mysqSLICE := (*[]*ast.RenameTablePair)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, &ast.RenameTablePair{FromTable: mysqDollar[3].tableName, ToTable: mysqDollar[5].tableName})
 		}
-	case 666:
+	case 669:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3630
+//line mysql.y:3653
 		{
 			mysqLOCAL = &ast.DropTable{FromTables: mysqDollar[6].tableNamesUnion(), IfExists: mysqDollar[5].booleanUnion(), Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Temp: mysqDollar[3].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 667:
+	case 670:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3634
+//line mysql.y:3657
 		{
 			// Change this to an alter statement
 			if mysqDollar[4].colIdent.Lowered() == "primary" {
@@ -12888,1080 +13006,1096 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 668:
+	case 671:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3643
+//line mysql.y:3666
 		{
 			mysqLOCAL = &ast.DropView{FromTables: mysqDollar[5].tableNamesUnion(), Comments: ast.Comments(mysqDollar[2].strs).Parsed(), IfExists: mysqDollar[4].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 669:
+	case 672:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3647
+//line mysql.y:3670
 		{
 			mysqLOCAL = &ast.DropDatabase{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), DBName: mysqDollar[5].tableIdent, IfExists: mysqDollar[4].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 670:
+	case 673:
+		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
+		var mysqLOCAL ast.Statement
+//line mysql.y:3674
+		{
+			mysqLOCAL = &ast.DropFunction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Procedure: true, IfExists: mysqDollar[4].booleanUnion(), Name: mysqDollar[5].tableName}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 674:
+		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
+		var mysqLOCAL ast.Statement
+//line mysql.y:3678
+		{
+			mysqLOCAL = &ast.DropFunction{Comments: ast.Comments(mysqDollar[2].strs).Parsed(), IfExists: mysqDollar[4].booleanUnion(), Name: mysqDollar[5].tableName}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 675:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3653
+//line mysql.y:3684
 		{
 			mysqLOCAL = &ast.TruncateTable{Table: mysqDollar[3].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 671:
+	case 676:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3657
+//line mysql.y:3688
 		{
 			mysqLOCAL = &ast.TruncateTable{Table: mysqDollar[2].tableName}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 672:
+	case 677:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3662
+//line mysql.y:3693
 		{
 			mysqLOCAL = &ast.OtherRead{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 673:
+	case 678:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3668
+//line mysql.y:3699
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Charset, Filter: mysqDollar[3].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 674:
+	case 679:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3672
+//line mysql.y:3703
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Collation, Filter: mysqDollar[3].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 675:
+	case 680:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3676
+//line mysql.y:3707
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Full: mysqDollar[2].booleanUnion(), Command: ast.Column, Tbl: mysqDollar[5].tableName, DbName: mysqDollar[6].tableIdent, Filter: mysqDollar[7].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 676:
+	case 681:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3680
+//line mysql.y:3711
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: mysqDollar[3].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 677:
+	case 682:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3684
+//line mysql.y:3715
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: mysqDollar[3].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 678:
+	case 683:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3688
+//line mysql.y:3719
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Keyspace, Filter: mysqDollar[3].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 679:
+	case 684:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3692
+//line mysql.y:3723
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Function, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 680:
+	case 685:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3696
+//line mysql.y:3727
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Index, Tbl: mysqDollar[5].tableName, DbName: mysqDollar[6].tableIdent, Filter: mysqDollar[7].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 681:
+	case 686:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3700
+//line mysql.y:3731
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.OpenTable, DbName: mysqDollar[4].tableIdent, Filter: mysqDollar[5].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 682:
+	case 687:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3704
+//line mysql.y:3735
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Privilege}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 683:
+	case 688:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3708
+//line mysql.y:3739
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Procedure, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 684:
+	case 689:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3712
+//line mysql.y:3743
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusSession, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 685:
+	case 690:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3716
+//line mysql.y:3747
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusGlobal, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 686:
+	case 691:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3720
+//line mysql.y:3751
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableSession, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 687:
+	case 692:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3724
+//line mysql.y:3755
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableGlobal, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 688:
+	case 693:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3728
+//line mysql.y:3759
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.TableStatus, DbName: mysqDollar[4].tableIdent, Filter: mysqDollar[5].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 689:
+	case 694:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3732
+//line mysql.y:3763
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Table, Full: mysqDollar[2].booleanUnion(), DbName: mysqDollar[4].tableIdent, Filter: mysqDollar[5].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 690:
+	case 695:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3736
+//line mysql.y:3767
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Trigger, DbName: mysqDollar[3].tableIdent, Filter: mysqDollar[4].showFilterUnion()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 691:
+	case 696:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3740
+//line mysql.y:3771
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateDb, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 692:
+	case 697:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3744
+//line mysql.y:3775
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateE, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 693:
+	case 698:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3748
+//line mysql.y:3779
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateF, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 694:
+	case 699:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3752
+//line mysql.y:3783
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateProc, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 695:
+	case 700:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3756
+//line mysql.y:3787
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTbl, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 696:
+	case 701:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3760
+//line mysql.y:3791
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTr, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 697:
+	case 702:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3764
+//line mysql.y:3795
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateV, Op: mysqDollar[4].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 698:
+	case 703:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3768
+//line mysql.y:3799
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Engines}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 699:
+	case 704:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3772
+//line mysql.y:3803
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Plugins}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 700:
+	case 705:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3776
+//line mysql.y:3807
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.GtidExecGlobal, DbName: mysqDollar[4].tableIdent}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 701:
+	case 706:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3780
+//line mysql.y:3811
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VGtidExecGlobal, DbName: mysqDollar[4].tableIdent}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 702:
+	case 707:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3784
+//line mysql.y:3815
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaTables}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 703:
+	case 708:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3788
+//line mysql.y:3819
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 704:
+	case 709:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3792
+//line mysql.y:3823
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes, Tbl: mysqDollar[5].tableName}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 705:
+	case 710:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3796
+//line mysql.y:3827
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Warnings}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 706:
+	case 711:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3803
+//line mysql.y:3834
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].colIdent.String())}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 707:
+	case 712:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3807
+//line mysql.y:3838
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 708:
+	case 713:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3811
+//line mysql.y:3842
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str) + " " + mysqDollar[3].colIdent.String()}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 709:
+	case 714:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3815
+//line mysql.y:3846
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 710:
+	case 715:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3819
+//line mysql.y:3850
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 711:
+	case 716:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3823
+//line mysql.y:3854
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str) + " " + ast.String(mysqDollar[4].tableName)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 712:
+	case 717:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3827
+//line mysql.y:3858
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str) + " " + string(mysqDollar[3].str) + " " + ast.String(mysqDollar[4].tableName)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 713:
+	case 718:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3831
+//line mysql.y:3862
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[3].str)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 714:
+	case 719:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3835
+//line mysql.y:3866
 		{
 			mysqLOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(mysqDollar[2].str)}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 715:
+	case 720:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:3841
+//line mysql.y:3872
 		{
 			mysqVAL.str = ""
 		}
-	case 716:
+	case 721:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3845
+//line mysql.y:3876
 		{
 			mysqVAL.str = "extended "
 		}
-	case 717:
+	case 722:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3851
+//line mysql.y:3882
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 718:
+	case 723:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:3855
+//line mysql.y:3886
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 719:
+	case 724:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3861
+//line mysql.y:3892
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 720:
+	case 725:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3865
+//line mysql.y:3896
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 721:
+	case 726:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:3871
+//line mysql.y:3902
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 722:
+	case 727:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:3875
+//line mysql.y:3906
 		{
 			mysqVAL.tableIdent = mysqDollar[2].tableIdent
 		}
-	case 723:
+	case 728:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:3879
+//line mysql.y:3910
 		{
 			mysqVAL.tableIdent = mysqDollar[2].tableIdent
 		}
-	case 724:
+	case 729:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ShowFilter
-//line mysql.y:3885
+//line mysql.y:3916
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 725:
+	case 730:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ShowFilter
-//line mysql.y:3889
+//line mysql.y:3920
 		{
 			mysqLOCAL = &ast.ShowFilter{Like: string(mysqDollar[2].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 726:
+	case 731:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ShowFilter
-//line mysql.y:3893
+//line mysql.y:3924
 		{
 			mysqLOCAL = &ast.ShowFilter{Filter: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 727:
+	case 732:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:3899
+//line mysql.y:3930
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 728:
+	case 733:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3903
+//line mysql.y:3934
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 729:
+	case 734:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3907
+//line mysql.y:3938
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 730:
+	case 735:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3913
+//line mysql.y:3944
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 731:
+	case 736:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3917
+//line mysql.y:3948
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 732:
+	case 737:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3923
+//line mysql.y:3954
 		{
 			mysqLOCAL = &ast.Use{DBName: mysqDollar[2].tableIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 733:
+	case 738:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3927
+//line mysql.y:3958
 		{
 			mysqLOCAL = &ast.Use{DBName: ast.TableIdent{V: ""}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 734:
+	case 739:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3931
+//line mysql.y:3962
 		{
 			mysqLOCAL = &ast.Use{DBName: ast.NewTableIdent(mysqDollar[2].tableIdent.String() + "@" + string(mysqDollar[3].str))}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 735:
+	case 740:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3937
+//line mysql.y:3968
 		{
 			mysqLOCAL = &ast.Begin{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 736:
+	case 741:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3941
+//line mysql.y:3972
 		{
 			mysqLOCAL = &ast.Begin{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 737:
+	case 742:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3947
+//line mysql.y:3978
 		{
 			mysqLOCAL = &ast.Commit{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 738:
+	case 743:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3953
+//line mysql.y:3984
 		{
 			mysqLOCAL = &ast.Rollback{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 739:
+	case 744:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3957
+//line mysql.y:3988
 		{
 			mysqLOCAL = &ast.SRollback{Name: mysqDollar[5].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 740:
+	case 745:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:3962
+//line mysql.y:3993
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 741:
+	case 746:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3964
+//line mysql.y:3995
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 742:
+	case 747:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:3967
+//line mysql.y:3998
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 743:
+	case 748:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:3969
+//line mysql.y:4000
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 744:
+	case 749:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3974
+//line mysql.y:4005
 		{
 			mysqLOCAL = &ast.Savepoint{Name: mysqDollar[2].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 745:
+	case 750:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:3980
+//line mysql.y:4011
 		{
 			mysqLOCAL = &ast.Release{Name: mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 746:
+	case 751:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.ExplainType
-//line mysql.y:3985
+//line mysql.y:4016
 		{
 			mysqLOCAL = ast.EmptyType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 747:
+	case 752:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ExplainType
-//line mysql.y:3989
+//line mysql.y:4020
 		{
 			mysqLOCAL = ast.JSONType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 748:
+	case 753:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ExplainType
-//line mysql.y:3993
+//line mysql.y:4024
 		{
 			mysqLOCAL = ast.TreeType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 749:
+	case 754:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ExplainType
-//line mysql.y:3997
+//line mysql.y:4028
 		{
 			mysqLOCAL = ast.TraditionalType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 750:
+	case 755:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ExplainType
-//line mysql.y:4001
+//line mysql.y:4032
 		{
 			mysqLOCAL = ast.AnalyzeType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 751:
+	case 756:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4007
+//line mysql.y:4038
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 752:
+	case 757:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4011
+//line mysql.y:4042
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 753:
+	case 758:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4015
+//line mysql.y:4046
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 754:
+	case 759:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4021
+//line mysql.y:4052
 		{
 			mysqLOCAL = mysqDollar[1].selStmtUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 755:
+	case 760:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4025
+//line mysql.y:4056
 		{
 			mysqLOCAL = mysqDollar[1].statementUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 756:
+	case 761:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4029
+//line mysql.y:4060
 		{
 			mysqLOCAL = mysqDollar[1].statementUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 757:
+	case 762:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4033
+//line mysql.y:4064
 		{
 			mysqLOCAL = mysqDollar[1].statementUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 758:
+	case 763:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4038
+//line mysql.y:4069
 		{
 			mysqVAL.str = ""
 		}
-	case 759:
+	case 764:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4042
+//line mysql.y:4073
 		{
 			mysqVAL.str = mysqDollar[1].colIdent.Val
 		}
-	case 760:
+	case 765:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4046
+//line mysql.y:4077
 		{
 			mysqVAL.str = sql_types.EncodeStringSQL(mysqDollar[1].str)
 		}
-	case 761:
+	case 766:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4052
+//line mysql.y:4083
 		{
 			mysqLOCAL = &ast.ExplainTab{Table: mysqDollar[2].tableName, Wild: mysqDollar[3].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 762:
+	case 767:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4056
+//line mysql.y:4087
 		{
 			mysqLOCAL = &ast.ExplainStmt{Type: mysqDollar[2].explainTypeUnion(), Statement: mysqDollar[3].statementUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 763:
+	case 768:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4062
+//line mysql.y:4093
 		{
 			mysqLOCAL = &ast.OtherAdmin{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 764:
+	case 769:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4066
+//line mysql.y:4097
 		{
 			mysqLOCAL = &ast.OtherAdmin{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 765:
+	case 770:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4072
+//line mysql.y:4103
 		{
 			mysqLOCAL = &ast.LockTables{Tables: mysqDollar[3].tableAndLockTypesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 766:
+	case 771:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableAndLockTypes
-//line mysql.y:4078
+//line mysql.y:4109
 		{
 			mysqLOCAL = ast.TableAndLockTypes{mysqDollar[1].tableAndLockTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 767:
+	case 772:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4082
+//line mysql.y:4113
 		{
 			mysqSLICE := (*ast.TableAndLockTypes)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableAndLockTypeUnion())
 		}
-	case 768:
+	case 773:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.TableAndLockType
-//line mysql.y:4088
+//line mysql.y:4119
 		{
 			mysqLOCAL = &ast.TableAndLockType{Table: mysqDollar[1].aliasedTableNameUnion(), Lock: mysqDollar[2].lockTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 769:
+	case 774:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.LockType
-//line mysql.y:4094
+//line mysql.y:4125
 		{
 			mysqLOCAL = ast.Read
 		}
 		mysqVAL.union = mysqLOCAL
-	case 770:
+	case 775:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.LockType
-//line mysql.y:4098
+//line mysql.y:4129
 		{
 			mysqLOCAL = ast.ReadLocal
 		}
 		mysqVAL.union = mysqLOCAL
-	case 771:
+	case 776:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.LockType
-//line mysql.y:4102
+//line mysql.y:4133
 		{
 			mysqLOCAL = ast.Write
 		}
 		mysqVAL.union = mysqLOCAL
-	case 772:
+	case 777:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.LockType
-//line mysql.y:4106
+//line mysql.y:4137
 		{
 			mysqLOCAL = ast.LowPriorityWrite
 		}
 		mysqVAL.union = mysqLOCAL
-	case 773:
+	case 778:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4112
+//line mysql.y:4143
 		{
 			mysqLOCAL = &ast.UnlockTables{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 774:
+	case 779:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4118
+//line mysql.y:4149
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion(), FlushOptions: mysqDollar[3].strs}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 775:
+	case 780:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4122
+//line mysql.y:4153
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 776:
+	case 781:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4126
+//line mysql.y:4157
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion(), WithLock: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 777:
+	case 782:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4130
+//line mysql.y:4161
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion(), TableNames: mysqDollar[4].tableNamesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 778:
+	case 783:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4134
+//line mysql.y:4165
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion(), TableNames: mysqDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 779:
+	case 784:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4138
+//line mysql.y:4169
 		{
 			mysqLOCAL = &ast.Flush{IsLocal: mysqDollar[2].booleanUnion(), TableNames: mysqDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 780:
+	case 785:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4144
+//line mysql.y:4175
 		{
 			mysqVAL.strs = []string{mysqDollar[1].str}
 		}
-	case 781:
+	case 786:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4148
+//line mysql.y:4179
 		{
 			mysqVAL.strs = append(mysqDollar[1].strs, mysqDollar[3].str)
 		}
-	case 782:
+	case 787:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4154
+//line mysql.y:4185
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 783:
+	case 788:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4158
+//line mysql.y:4189
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 784:
+	case 789:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4162
+//line mysql.y:4193
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 785:
+	case 790:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4166
+//line mysql.y:4197
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 786:
+	case 791:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4170
+//line mysql.y:4201
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 787:
+	case 792:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4174
+//line mysql.y:4205
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 788:
+	case 793:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4178
+//line mysql.y:4209
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 789:
+	case 794:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4182
+//line mysql.y:4213
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str) + mysqDollar[3].str
 		}
-	case 790:
+	case 795:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4186
+//line mysql.y:4217
 		{
 			mysqVAL.str = string(mysqDollar[1].str) + " " + string(mysqDollar[2].str)
 		}
-	case 791:
+	case 796:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4190
+//line mysql.y:4221
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 792:
+	case 797:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4194
+//line mysql.y:4225
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 793:
+	case 798:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4198
+//line mysql.y:4229
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 794:
+	case 799:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4203
+//line mysql.y:4234
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 795:
+	case 800:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4207
+//line mysql.y:4238
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 796:
+	case 801:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4211
+//line mysql.y:4242
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 797:
+	case 802:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4216
+//line mysql.y:4247
 		{
 			mysqVAL.str = ""
 		}
-	case 798:
+	case 803:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4220
+//line mysql.y:4251
 		{
 			mysqVAL.str = " " + string(mysqDollar[1].str) + " " + string(mysqDollar[2].str) + " " + mysqDollar[3].colIdent.String()
 		}
-	case 799:
+	case 804:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4225
+//line mysql.y:4256
 		{
 			setAllowComments(mysqlex, true)
 		}
-	case 800:
+	case 805:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4229
+//line mysql.y:4260
 		{
 			mysqVAL.strs = mysqDollar[2].strs
 			setAllowComments(mysqlex, false)
 		}
-	case 801:
+	case 806:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4235
+//line mysql.y:4266
 		{
 			mysqVAL.strs = nil
 		}
-	case 802:
+	case 807:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4239
+//line mysql.y:4270
 		{
 			mysqVAL.strs = append(mysqDollar[1].strs, mysqDollar[2].str)
 		}
-	case 803:
+	case 808:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4245
+//line mysql.y:4276
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 804:
+	case 809:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4249
+//line mysql.y:4280
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 805:
+	case 810:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4253
+//line mysql.y:4284
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 806:
+	case 811:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4258
+//line mysql.y:4289
 		{
 			mysqVAL.str = ""
 		}
-	case 807:
+	case 812:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4262
+//line mysql.y:4293
 		{
 			mysqVAL.str = ast.SQLNoCacheStr
 		}
-	case 808:
+	case 813:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4266
+//line mysql.y:4297
 		{
 			mysqVAL.str = ast.SQLCacheStr
 		}
-	case 809:
+	case 814:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4271
+//line mysql.y:4302
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 810:
+	case 815:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4275
+//line mysql.y:4306
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 811:
+	case 816:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:4279
+//line mysql.y:4310
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 812:
+	case 817:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4285
+//line mysql.y:4316
 		{
 			mysqLOCAL = &ast.PrepareStmt{Name: mysqDollar[3].colIdent, Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Statement: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 813:
+	case 818:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4289
+//line mysql.y:4320
 		{
 			mysqLOCAL = &ast.PrepareStmt{
 				Name:     mysqDollar[3].colIdent,
@@ -13972,579 +14106,579 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 814:
+	case 819:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4301
+//line mysql.y:4332
 		{
 			mysqLOCAL = &ast.ExecuteStmt{Name: mysqDollar[3].colIdent, Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Arguments: mysqDollar[4].columnsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 815:
+	case 820:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4306
+//line mysql.y:4337
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 816:
+	case 821:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4310
+//line mysql.y:4341
 		{
 			mysqLOCAL = mysqDollar[2].columnsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 817:
+	case 822:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4316
+//line mysql.y:4347
 		{
 			mysqLOCAL = &ast.DeallocateStmt{Type: ast.DeallocateType, Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Name: mysqDollar[4].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 818:
+	case 823:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:4320
+//line mysql.y:4351
 		{
 			mysqLOCAL = &ast.DeallocateStmt{Type: ast.DropType, Comments: ast.Comments(mysqDollar[2].strs).Parsed(), Name: mysqDollar[4].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 819:
+	case 824:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.SelectExprs
-//line mysql.y:4325
+//line mysql.y:4356
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 820:
+	case 825:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectExprs
-//line mysql.y:4329
+//line mysql.y:4360
 		{
 			mysqLOCAL = mysqDollar[1].selectExprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 821:
+	case 826:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4334
+//line mysql.y:4365
 		{
 			mysqVAL.strs = nil
 		}
-	case 822:
+	case 827:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4338
+//line mysql.y:4369
 		{
 			mysqVAL.strs = []string{mysqDollar[1].str}
 		}
-	case 823:
+	case 828:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4342
+//line mysql.y:4373
 		{ // TODO: ast.This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			mysqVAL.strs = []string{mysqDollar[1].str, mysqDollar[2].str}
 		}
-	case 824:
+	case 829:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4346
+//line mysql.y:4377
 		{
 			mysqVAL.strs = []string{mysqDollar[1].str, mysqDollar[2].str, mysqDollar[3].str}
 		}
-	case 825:
+	case 830:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:4350
+//line mysql.y:4381
 		{
 			mysqVAL.strs = []string{mysqDollar[1].str, mysqDollar[2].str, mysqDollar[3].str, mysqDollar[4].str}
 		}
-	case 826:
+	case 831:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4356
+//line mysql.y:4387
 		{
 			mysqVAL.str = ast.SQLNoCacheStr
 		}
-	case 827:
+	case 832:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4360
+//line mysql.y:4391
 		{
 			mysqVAL.str = ast.SQLCacheStr
 		}
-	case 828:
+	case 833:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4364
+//line mysql.y:4395
 		{
 			mysqVAL.str = ast.DistinctStr
 		}
-	case 829:
+	case 834:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4368
+//line mysql.y:4399
 		{
 			mysqVAL.str = ast.DistinctStr
 		}
-	case 830:
+	case 835:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4372
+//line mysql.y:4403
 		{
 			mysqVAL.str = ast.StraightJoinHint
 		}
-	case 831:
+	case 836:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4376
+//line mysql.y:4407
 		{
 			mysqVAL.str = ast.SQLCalcFoundRowsStr
 		}
-	case 832:
+	case 837:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4380
+//line mysql.y:4411
 		{
 			mysqVAL.str = ast.AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 833:
+	case 838:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectExprs
-//line mysql.y:4386
+//line mysql.y:4417
 		{
 			mysqLOCAL = ast.SelectExprs{mysqDollar[1].selectExprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 834:
+	case 839:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4390
+//line mysql.y:4421
 		{
 			mysqSLICE := (*ast.SelectExprs)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].selectExprUnion())
 		}
-	case 835:
+	case 840:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SelectExpr
-//line mysql.y:4396
+//line mysql.y:4427
 		{
 			mysqLOCAL = &ast.StarExpr{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 836:
+	case 841:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.SelectExpr
-//line mysql.y:4400
+//line mysql.y:4431
 		{
 			mysqLOCAL = &ast.AliasedExpr{Expr: mysqDollar[1].exprUnion(), As: mysqDollar[2].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 837:
+	case 842:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.SelectExpr
-//line mysql.y:4404
+//line mysql.y:4435
 		{
 			mysqLOCAL = &ast.StarExpr{TableName: ast.TableName{Name: mysqDollar[1].tableIdent}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 838:
+	case 843:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.SelectExpr
-//line mysql.y:4408
+//line mysql.y:4439
 		{
 			mysqLOCAL = &ast.StarExpr{TableName: ast.TableName{Qualifier: mysqDollar[1].tableIdent, Name: mysqDollar[3].tableIdent}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 839:
+	case 844:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4413
+//line mysql.y:4444
 		{
 			mysqVAL.colIdent = ast.ColIdent{}
 		}
-	case 840:
+	case 845:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4417
+//line mysql.y:4448
 		{
 			mysqVAL.colIdent = mysqDollar[1].colIdent
 		}
-	case 841:
+	case 846:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4421
+//line mysql.y:4452
 		{
 			mysqVAL.colIdent = mysqDollar[2].colIdent
 		}
-	case 843:
+	case 848:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4428
+//line mysql.y:4459
 		{
 			mysqVAL.colIdent = ast.NewColIdent(string(mysqDollar[1].str))
 		}
-	case 844:
+	case 849:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.TableExprs
-//line mysql.y:4433
+//line mysql.y:4464
 		{
 			mysqLOCAL = ast.TableExprs{&ast.AliasedTableExpr{Expr: ast.TableName{Name: ast.NewTableIdent("dual")}}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 845:
+	case 850:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableExprs
-//line mysql.y:4437
+//line mysql.y:4468
 		{
 			mysqLOCAL = mysqDollar[1].tableExprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 846:
+	case 851:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.TableExprs
-//line mysql.y:4443
+//line mysql.y:4474
 		{
 			mysqLOCAL = mysqDollar[2].tableExprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 847:
+	case 852:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableExprs
-//line mysql.y:4449
+//line mysql.y:4480
 		{
 			mysqLOCAL = ast.TableExprs{mysqDollar[1].tableExprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 848:
+	case 853:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4453
+//line mysql.y:4484
 		{
 			mysqSLICE := (*ast.TableExprs)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].tableExprUnion())
 		}
-	case 851:
+	case 856:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4463
+//line mysql.y:4494
 		{
 			mysqLOCAL = mysqDollar[1].aliasedTableNameUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 852:
+	case 857:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4467
+//line mysql.y:4498
 		{
 			mysqLOCAL = &ast.AliasedTableExpr{Expr: mysqDollar[1].derivedTableUnion(), As: mysqDollar[3].tableIdent, Columns: mysqDollar[4].columnsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 853:
+	case 858:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4471
+//line mysql.y:4502
 		{
 			mysqLOCAL = &ast.ParenTableExpr{Exprs: mysqDollar[2].tableExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 854:
+	case 859:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4475
+//line mysql.y:4506
 		{
 			mysqLOCAL = mysqDollar[1].tableExprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 855:
+	case 860:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.DerivedTable
-//line mysql.y:4481
+//line mysql.y:4512
 		{
 			mysqLOCAL = &ast.DerivedTable{Lateral: false, Select: mysqDollar[2].selStmtUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 856:
+	case 861:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.DerivedTable
-//line mysql.y:4485
+//line mysql.y:4516
 		{
 			mysqLOCAL = &ast.DerivedTable{Lateral: true, Select: mysqDollar[3].selStmtUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 857:
+	case 862:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.AliasedTableExpr
-//line mysql.y:4491
+//line mysql.y:4522
 		{
 			mysqLOCAL = &ast.AliasedTableExpr{Expr: mysqDollar[1].tableName, As: mysqDollar[2].tableIdent, Hints: mysqDollar[3].indexHintsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 858:
+	case 863:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL *ast.AliasedTableExpr
-//line mysql.y:4495
+//line mysql.y:4526
 		{
 			mysqLOCAL = &ast.AliasedTableExpr{Expr: mysqDollar[1].tableName, Partitions: mysqDollar[4].partitionsUnion(), As: mysqDollar[6].tableIdent, Hints: mysqDollar[7].indexHintsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 859:
+	case 864:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4500
+//line mysql.y:4531
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 860:
+	case 865:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4504
+//line mysql.y:4535
 		{
 			mysqLOCAL = mysqDollar[2].columnsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 861:
+	case 866:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4510
+//line mysql.y:4541
 		{
 			mysqLOCAL = ast.Columns{mysqDollar[1].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 862:
+	case 867:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4514
+//line mysql.y:4545
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].colIdent)
 		}
-	case 863:
+	case 868:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4520
+//line mysql.y:4551
 		{
 			mysqLOCAL = ast.Columns{ast.NewColIdentWithAt(string(mysqDollar[1].str), ast.SingleAt)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 864:
+	case 869:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4524
+//line mysql.y:4555
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, ast.NewColIdentWithAt(string(mysqDollar[3].str), ast.SingleAt))
 		}
-	case 865:
+	case 870:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4530
+//line mysql.y:4561
 		{
 			mysqLOCAL = ast.Columns{mysqDollar[1].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 866:
+	case 871:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:4534
+//line mysql.y:4565
 		{
 			mysqLOCAL = ast.Columns{ast.NewColIdent(string(mysqDollar[1].str))}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 867:
+	case 872:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4538
+//line mysql.y:4569
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].colIdent)
 		}
-	case 868:
+	case 873:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4542
+//line mysql.y:4573
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, ast.NewColIdent(string(mysqDollar[3].str)))
 		}
-	case 869:
+	case 874:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Partitions
-//line mysql.y:4548
+//line mysql.y:4579
 		{
 			mysqLOCAL = ast.Partitions{mysqDollar[1].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 870:
+	case 875:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4552
+//line mysql.y:4583
 		{
 			mysqSLICE := (*ast.Partitions)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].colIdent)
 		}
-	case 871:
+	case 876:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4565
+//line mysql.y:4596
 		{
 			mysqLOCAL = &ast.JoinTableExpr{LeftExpr: mysqDollar[1].tableExprUnion(), Join: mysqDollar[2].joinTypeUnion(), RightExpr: mysqDollar[3].tableExprUnion(), Condition: mysqDollar[4].joinCondition}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 872:
+	case 877:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4569
+//line mysql.y:4600
 		{
 			mysqLOCAL = &ast.JoinTableExpr{LeftExpr: mysqDollar[1].tableExprUnion(), Join: mysqDollar[2].joinTypeUnion(), RightExpr: mysqDollar[3].tableExprUnion(), Condition: mysqDollar[4].joinCondition}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 873:
+	case 878:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4573
+//line mysql.y:4604
 		{
 			mysqLOCAL = &ast.JoinTableExpr{LeftExpr: mysqDollar[1].tableExprUnion(), Join: mysqDollar[2].joinTypeUnion(), RightExpr: mysqDollar[3].tableExprUnion(), Condition: mysqDollar[4].joinCondition}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 874:
+	case 879:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.TableExpr
-//line mysql.y:4577
+//line mysql.y:4608
 		{
 			mysqLOCAL = &ast.JoinTableExpr{LeftExpr: mysqDollar[1].tableExprUnion(), Join: mysqDollar[2].joinTypeUnion(), RightExpr: mysqDollar[3].tableExprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 875:
+	case 880:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4583
+//line mysql.y:4614
 		{
 			mysqVAL.joinCondition = &ast.JoinCondition{On: mysqDollar[2].exprUnion()}
 		}
-	case 876:
+	case 881:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:4585
+//line mysql.y:4616
 		{
 			mysqVAL.joinCondition = &ast.JoinCondition{Using: mysqDollar[3].columnsUnion()}
 		}
-	case 877:
+	case 882:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4589
+//line mysql.y:4620
 		{
 			mysqVAL.joinCondition = &ast.JoinCondition{}
 		}
-	case 878:
+	case 883:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4591
+//line mysql.y:4622
 		{
 			mysqVAL.joinCondition = mysqDollar[1].joinCondition
 		}
-	case 879:
+	case 884:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4595
+//line mysql.y:4626
 		{
 			mysqVAL.joinCondition = &ast.JoinCondition{}
 		}
-	case 880:
+	case 885:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4597
+//line mysql.y:4628
 		{
 			mysqVAL.joinCondition = &ast.JoinCondition{On: mysqDollar[2].exprUnion()}
 		}
-	case 881:
+	case 886:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4600
+//line mysql.y:4631
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 882:
+	case 887:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4602
+//line mysql.y:4633
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 883:
+	case 888:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:4605
+//line mysql.y:4636
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 884:
+	case 889:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4609
+//line mysql.y:4640
 		{
 			mysqVAL.tableIdent = mysqDollar[1].tableIdent
 		}
-	case 885:
+	case 890:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4613
+//line mysql.y:4644
 		{
 			mysqVAL.tableIdent = mysqDollar[2].tableIdent
 		}
-	case 887:
+	case 892:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4620
+//line mysql.y:4651
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent(string(mysqDollar[1].str))
 		}
-	case 888:
+	case 893:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4626
+//line mysql.y:4657
 		{
 			mysqLOCAL = ast.NormalJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 889:
+	case 894:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4630
+//line mysql.y:4661
 		{
 			mysqLOCAL = ast.NormalJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 890:
+	case 895:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4634
+//line mysql.y:4665
 		{
 			mysqLOCAL = ast.NormalJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 891:
+	case 896:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4640
+//line mysql.y:4671
 		{
 			mysqLOCAL = ast.StraightJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 892:
+	case 897:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4646
+//line mysql.y:4677
 		{
 			mysqLOCAL = ast.LeftJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 893:
+	case 898:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4650
+//line mysql.y:4681
 		{
 			mysqLOCAL = ast.LeftJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 894:
+	case 899:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4654
+//line mysql.y:4685
 		{
 			mysqLOCAL = ast.RightJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 895:
+	case 900:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4658
+//line mysql.y:4689
 		{
 			mysqLOCAL = ast.RightJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 896:
+	case 901:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4664
+//line mysql.y:4695
 		{
 			mysqLOCAL = ast.NaturalJoinType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 897:
+	case 902:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.JoinType
-//line mysql.y:4668
+//line mysql.y:4699
 		{
 			if mysqDollar[2].joinTypeUnion() == ast.LeftJoinType {
 				mysqLOCAL = ast.NaturalLeftJoinType
@@ -14553,575 +14687,575 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 898:
+	case 903:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4678
+//line mysql.y:4709
 		{
 			mysqVAL.tableName = mysqDollar[2].tableName
 		}
-	case 899:
+	case 904:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4682
+//line mysql.y:4713
 		{
 			mysqVAL.tableName = mysqDollar[1].tableName
 		}
-	case 900:
+	case 905:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:4688
+//line mysql.y:4719
 		{
 			mysqVAL.tableName = ast.TableName{Name: mysqDollar[1].tableIdent}
 		}
-	case 901:
+	case 906:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4692
+//line mysql.y:4723
 		{
 			mysqVAL.tableName = ast.TableName{Qualifier: mysqDollar[1].tableIdent, Name: mysqDollar[3].tableIdent}
 		}
-	case 902:
+	case 907:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:4698
+//line mysql.y:4729
 		{
 			mysqVAL.tableName = ast.TableName{Name: mysqDollar[1].tableIdent}
 		}
-	case 903:
+	case 908:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.IndexHints
-//line mysql.y:4703
+//line mysql.y:4734
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 904:
+	case 909:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IndexHints
-//line mysql.y:4707
+//line mysql.y:4738
 		{
 			mysqLOCAL = mysqDollar[1].indexHintsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 905:
+	case 910:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IndexHints
-//line mysql.y:4713
+//line mysql.y:4744
 		{
 			mysqLOCAL = ast.IndexHints{mysqDollar[1].indexHintUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 906:
+	case 911:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:4717
+//line mysql.y:4748
 		{
 			mysqSLICE := (*ast.IndexHints)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].indexHintUnion())
 		}
-	case 907:
+	case 912:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.IndexHint
-//line mysql.y:4723
+//line mysql.y:4754
 		{
 			mysqLOCAL = &ast.IndexHint{Type: ast.UseOp, ForType: mysqDollar[3].indexHintForTypeUnion(), Indexes: mysqDollar[5].columnsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 908:
+	case 913:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.IndexHint
-//line mysql.y:4727
+//line mysql.y:4758
 		{
 			mysqLOCAL = &ast.IndexHint{Type: ast.UseOp, ForType: mysqDollar[3].indexHintForTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 909:
+	case 914:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.IndexHint
-//line mysql.y:4731
+//line mysql.y:4762
 		{
 			mysqLOCAL = &ast.IndexHint{Type: ast.IgnoreOp, ForType: mysqDollar[3].indexHintForTypeUnion(), Indexes: mysqDollar[5].columnsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 910:
+	case 915:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL *ast.IndexHint
-//line mysql.y:4735
+//line mysql.y:4766
 		{
 			mysqLOCAL = &ast.IndexHint{Type: ast.ForceOp, ForType: mysqDollar[3].indexHintForTypeUnion(), Indexes: mysqDollar[5].columnsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 911:
+	case 916:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.IndexHintForType
-//line mysql.y:4740
+//line mysql.y:4771
 		{
 			mysqLOCAL = ast.NoForType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 912:
+	case 917:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IndexHintForType
-//line mysql.y:4744
+//line mysql.y:4775
 		{
 			mysqLOCAL = ast.JoinForType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 913:
+	case 918:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.IndexHintForType
-//line mysql.y:4748
+//line mysql.y:4779
 		{
 			mysqLOCAL = ast.OrderByForType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 914:
+	case 919:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.IndexHintForType
-//line mysql.y:4752
+//line mysql.y:4783
 		{
 			mysqLOCAL = ast.GroupByForType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 915:
+	case 920:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4758
+//line mysql.y:4789
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 916:
+	case 921:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4762
+//line mysql.y:4793
 		{
 			mysqLOCAL = mysqDollar[2].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 917:
+	case 922:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4769
+//line mysql.y:4800
 		{
 			mysqLOCAL = &ast.OrExpr{Left: mysqDollar[1].exprUnion(), Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 918:
+	case 923:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4773
+//line mysql.y:4804
 		{
 			mysqLOCAL = &ast.XorExpr{Left: mysqDollar[1].exprUnion(), Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 919:
+	case 924:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4777
+//line mysql.y:4808
 		{
 			mysqLOCAL = &ast.AndExpr{Left: mysqDollar[1].exprUnion(), Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 920:
+	case 925:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4781
+//line mysql.y:4812
 		{
 			mysqLOCAL = &ast.NotExpr{Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 921:
+	case 926:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4785
+//line mysql.y:4816
 		{
 			mysqLOCAL = &ast.IsExpr{Left: mysqDollar[1].exprUnion(), Right: mysqDollar[3].isExprOperatorUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 922:
+	case 927:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4789
+//line mysql.y:4820
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 923:
+	case 928:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4793
+//line mysql.y:4824
 		{
 			mysqLOCAL = &ast.MemberOfExpr{Value: mysqDollar[1].exprUnion(), JSONArr: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 924:
+	case 929:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4800
+//line mysql.y:4831
 		{
 			mysqLOCAL = &ast.IsExpr{Left: mysqDollar[1].exprUnion(), Right: ast.IsNullOp}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 925:
+	case 930:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4804
+//line mysql.y:4835
 		{
 			mysqLOCAL = &ast.IsExpr{Left: mysqDollar[1].exprUnion(), Right: ast.IsNotNullOp}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 926:
+	case 931:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4808
+//line mysql.y:4839
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: mysqDollar[2].comparisonExprOperatorUnion(), Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 927:
+	case 932:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4812
+//line mysql.y:4843
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 928:
+	case 933:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4818
+//line mysql.y:4849
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.InOp, Right: mysqDollar[3].colTupleUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 929:
+	case 934:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4822
+//line mysql.y:4853
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.NotInOp, Right: mysqDollar[4].colTupleUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 930:
+	case 935:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4826
+//line mysql.y:4857
 		{
 			mysqLOCAL = &ast.BetweenExpr{Left: mysqDollar[1].exprUnion(), IsBetween: true, From: mysqDollar[3].exprUnion(), To: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 931:
+	case 936:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4830
+//line mysql.y:4861
 		{
 			mysqLOCAL = &ast.BetweenExpr{Left: mysqDollar[1].exprUnion(), IsBetween: false, From: mysqDollar[4].exprUnion(), To: mysqDollar[6].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 932:
+	case 937:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4834
+//line mysql.y:4865
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.LikeOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 933:
+	case 938:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4838
+//line mysql.y:4869
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.NotLikeOp, Right: mysqDollar[4].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 934:
+	case 939:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4842
+//line mysql.y:4873
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.LikeOp, Right: mysqDollar[3].exprUnion(), Escape: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 935:
+	case 940:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4846
+//line mysql.y:4877
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.NotLikeOp, Right: mysqDollar[4].exprUnion(), Escape: mysqDollar[6].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 936:
+	case 941:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4850
+//line mysql.y:4881
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.RegexpOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 937:
+	case 942:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4854
+//line mysql.y:4885
 		{
 			mysqLOCAL = &ast.ComparisonExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.NotRegexpOp, Right: mysqDollar[4].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 938:
+	case 943:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4858
+//line mysql.y:4889
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 939:
+	case 944:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4864
+//line mysql.y:4895
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.BitOrOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 940:
+	case 945:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4868
+//line mysql.y:4899
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.BitAndOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 941:
+	case 946:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4872
+//line mysql.y:4903
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.ShiftLeftOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 942:
+	case 947:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4876
+//line mysql.y:4907
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.ShiftRightOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 943:
+	case 948:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4880
+//line mysql.y:4911
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.PlusOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 944:
+	case 949:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4884
+//line mysql.y:4915
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.MinusOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 945:
+	case 950:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4888
+//line mysql.y:4919
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.MultOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 946:
+	case 951:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4892
+//line mysql.y:4923
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.DivOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 947:
+	case 952:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4896
+//line mysql.y:4927
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.ModOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 948:
+	case 953:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4900
+//line mysql.y:4931
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.IntDivOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 949:
+	case 954:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4904
+//line mysql.y:4935
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.ModOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 950:
+	case 955:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4908
+//line mysql.y:4939
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].exprUnion(), Operator: ast.BitXorOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 951:
+	case 956:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4912
+//line mysql.y:4943
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 952:
+	case 957:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4918
+//line mysql.y:4949
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 953:
+	case 958:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4922
+//line mysql.y:4953
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 954:
+	case 959:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4926
+//line mysql.y:4957
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 955:
+	case 960:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4930
+//line mysql.y:4961
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 956:
+	case 961:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4934
+//line mysql.y:4965
 		{
 			mysqLOCAL = &ast.CollateExpr{Expr: mysqDollar[1].exprUnion(), Collation: mysqDollar[3].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 957:
+	case 962:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4938
+//line mysql.y:4969
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 958:
+	case 963:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4942
+//line mysql.y:4973
 		{
 			mysqLOCAL = mysqDollar[1].colNameUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 959:
+	case 964:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4946
+//line mysql.y:4977
 		{
 			mysqLOCAL = mysqDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		mysqVAL.union = mysqLOCAL
-	case 960:
+	case 965:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4950
+//line mysql.y:4981
 		{
 			mysqLOCAL = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 961:
+	case 966:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4954
+//line mysql.y:4985
 		{
 			mysqLOCAL = &ast.UnaryExpr{Operator: ast.TildaOp, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 962:
+	case 967:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4958
+//line mysql.y:4989
 		{
 			mysqLOCAL = &ast.UnaryExpr{Operator: ast.BangOp, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 963:
+	case 968:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4962
+//line mysql.y:4993
 		{
 			mysqLOCAL = mysqDollar[1].subqueryUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 964:
+	case 969:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4966
+//line mysql.y:4997
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 965:
+	case 970:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4970
+//line mysql.y:5001
 		{
 			mysqLOCAL = &ast.ExistsExpr{Subquery: mysqDollar[2].subqueryUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 966:
+	case 971:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4974
+//line mysql.y:5005
 		{
 			mysqLOCAL = &ast.MatchExpr{Columns: mysqDollar[3].selectExprsUnion(), Expr: mysqDollar[7].exprUnion(), Option: mysqDollar[8].matchExprOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 967:
+	case 972:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4978
+//line mysql.y:5009
 		{
 			mysqLOCAL = &ast.ConvertExpr{Expr: mysqDollar[3].exprUnion(), Type: mysqDollar[5].convertTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 968:
+	case 973:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4982
+//line mysql.y:5013
 		{
 			mysqLOCAL = &ast.ConvertExpr{Expr: mysqDollar[3].exprUnion(), Type: mysqDollar[5].convertTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 969:
+	case 974:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4986
+//line mysql.y:5017
 		{
 			mysqLOCAL = &ast.ConvertUsingExpr{Expr: mysqDollar[3].exprUnion(), Type: mysqDollar[5].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 970:
+	case 975:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4990
+//line mysql.y:5021
 		{
 			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
 			// To convert a string expression to a binary string, these constructs are equivalent:
@@ -15130,18 +15264,18 @@ mysqdefault:
 			mysqLOCAL = &ast.ConvertExpr{Expr: mysqDollar[2].exprUnion(), Type: &ast.ConvertType{Type: mysqDollar[1].str}}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 971:
+	case 976:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:4998
+//line mysql.y:5029
 		{
 			mysqLOCAL = &ast.Default{ColName: mysqDollar[2].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 972:
+	case 977:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5002
+//line mysql.y:5033
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -15150,1302 +15284,1302 @@ mysqdefault:
 			mysqLOCAL = &ast.IntervalExpr{Expr: mysqDollar[2].exprUnion(), Unit: mysqDollar[3].colIdent.String()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 973:
+	case 978:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5010
+//line mysql.y:5041
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].colNameUnion(), Operator: ast.JSONExtractOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 974:
+	case 979:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5014
+//line mysql.y:5045
 		{
 			mysqLOCAL = &ast.BinaryExpr{Left: mysqDollar[1].colNameUnion(), Operator: ast.JSONUnquoteExtractOp, Right: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 975:
+	case 980:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TrimType
-//line mysql.y:5020
+//line mysql.y:5051
 		{
 			mysqLOCAL = ast.BothTrimType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 976:
+	case 981:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TrimType
-//line mysql.y:5024
+//line mysql.y:5055
 		{
 			mysqLOCAL = ast.LeadingTrimType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 977:
+	case 982:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.TrimType
-//line mysql.y:5028
+//line mysql.y:5059
 		{
 			mysqLOCAL = ast.TrailingTrimType
 		}
 		mysqVAL.union = mysqLOCAL
-	case 978:
+	case 983:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5034
+//line mysql.y:5065
 		{
 			mysqVAL.str = ""
 		}
-	case 979:
+	case 984:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5038
+//line mysql.y:5069
 		{
 			mysqVAL.str = string(mysqDollar[2].colIdent.String())
 		}
-	case 980:
+	case 985:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.BoolVal
-//line mysql.y:5044
+//line mysql.y:5075
 		{
 			mysqLOCAL = ast.BoolVal(true)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 981:
+	case 986:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.BoolVal
-//line mysql.y:5048
+//line mysql.y:5079
 		{
 			mysqLOCAL = ast.BoolVal(false)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 982:
+	case 987:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IsExprOperator
-//line mysql.y:5055
+//line mysql.y:5086
 		{
 			mysqLOCAL = ast.IsTrueOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 983:
+	case 988:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IsExprOperator
-//line mysql.y:5059
+//line mysql.y:5090
 		{
 			mysqLOCAL = ast.IsNotTrueOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 984:
+	case 989:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IsExprOperator
-//line mysql.y:5063
+//line mysql.y:5094
 		{
 			mysqLOCAL = ast.IsFalseOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 985:
+	case 990:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.IsExprOperator
-//line mysql.y:5067
+//line mysql.y:5098
 		{
 			mysqLOCAL = ast.IsNotFalseOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 986:
+	case 991:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5073
+//line mysql.y:5104
 		{
 			mysqLOCAL = ast.EqualOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 987:
+	case 992:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5077
+//line mysql.y:5108
 		{
 			mysqLOCAL = ast.LessThanOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 988:
+	case 993:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5081
+//line mysql.y:5112
 		{
 			mysqLOCAL = ast.GreaterThanOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 989:
+	case 994:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5085
+//line mysql.y:5116
 		{
 			mysqLOCAL = ast.LessEqualOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 990:
+	case 995:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5089
+//line mysql.y:5120
 		{
 			mysqLOCAL = ast.GreaterEqualOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 991:
+	case 996:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5093
+//line mysql.y:5124
 		{
 			mysqLOCAL = ast.NotEqualOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 992:
+	case 997:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ComparisonExprOperator
-//line mysql.y:5097
+//line mysql.y:5128
 		{
 			mysqLOCAL = ast.NullSafeEqualOp
 		}
 		mysqVAL.union = mysqLOCAL
-	case 993:
+	case 998:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColTuple
-//line mysql.y:5103
+//line mysql.y:5134
 		{
 			mysqLOCAL = mysqDollar[1].valTupleUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 994:
+	case 999:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColTuple
-//line mysql.y:5107
+//line mysql.y:5138
 		{
 			mysqLOCAL = mysqDollar[1].subqueryUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 995:
+	case 1000:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ColTuple
-//line mysql.y:5111
+//line mysql.y:5142
 		{
 			mysqLOCAL = ast.ListArg(mysqDollar[1].str[2:])
 			bindVariable(mysqlex, mysqDollar[1].str[2:])
 		}
 		mysqVAL.union = mysqLOCAL
-	case 996:
+	case 1001:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.Subquery
-//line mysql.y:5118
+//line mysql.y:5149
 		{
 			mysqLOCAL = &ast.Subquery{mysqDollar[1].selStmtUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 997:
+	case 1002:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Exprs
-//line mysql.y:5124
+//line mysql.y:5155
 		{
 			mysqLOCAL = ast.Exprs{mysqDollar[1].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 998:
+	case 1003:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5128
+//line mysql.y:5159
 		{
 			mysqSLICE := (*ast.Exprs)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].exprUnion())
 		}
-	case 999:
+	case 1004:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5138
+//line mysql.y:5169
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: mysqDollar[1].colIdent, Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1000:
+	case 1005:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5142
+//line mysql.y:5173
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: mysqDollar[1].colIdent, Distinct: true, Exprs: mysqDollar[4].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1001:
+	case 1006:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5146
+//line mysql.y:5177
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: mysqDollar[1].colIdent, Distinct: true, Exprs: mysqDollar[4].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1002:
+	case 1007:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5150
+//line mysql.y:5181
 		{
 			mysqLOCAL = &ast.FuncExpr{Qualifier: mysqDollar[1].tableIdent, Name: mysqDollar[3].colIdent, Exprs: mysqDollar[5].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1003:
+	case 1008:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5160
+//line mysql.y:5191
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("left"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1004:
+	case 1009:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5164
+//line mysql.y:5195
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("right"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1005:
+	case 1010:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5168
+//line mysql.y:5199
 		{
 			mysqLOCAL = &ast.SubstrExpr{Name: mysqDollar[3].exprUnion(), From: mysqDollar[5].exprUnion(), To: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1006:
+	case 1011:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5172
+//line mysql.y:5203
 		{
 			mysqLOCAL = &ast.SubstrExpr{Name: mysqDollar[3].exprUnion(), From: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1007:
+	case 1012:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5176
+//line mysql.y:5207
 		{
 			mysqLOCAL = &ast.SubstrExpr{Name: mysqDollar[3].exprUnion(), From: mysqDollar[5].exprUnion(), To: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1008:
+	case 1013:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5180
+//line mysql.y:5211
 		{
 			mysqLOCAL = &ast.SubstrExpr{Name: mysqDollar[3].exprUnion(), From: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1009:
+	case 1014:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5184
+//line mysql.y:5215
 		{
 			mysqLOCAL = &ast.GroupConcatExpr{Distinct: mysqDollar[3].booleanUnion(), Exprs: mysqDollar[4].selectExprsUnion(), OrderBy: mysqDollar[5].orderByUnion(), Separator: mysqDollar[6].str, Limit: mysqDollar[7].limitUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1010:
+	case 1015:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5188
+//line mysql.y:5219
 		{
 			mysqLOCAL = &ast.CaseExpr{Expr: mysqDollar[2].exprUnion(), Whens: mysqDollar[3].whensUnion(), Else: mysqDollar[4].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1011:
+	case 1016:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5192
+//line mysql.y:5223
 		{
 			mysqLOCAL = &ast.ValuesFuncExpr{Name: mysqDollar[3].colNameUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1012:
+	case 1017:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5196
+//line mysql.y:5227
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1013:
+	case 1018:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5207
+//line mysql.y:5238
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("utc_date")}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1014:
+	case 1019:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5211
+//line mysql.y:5242
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1015:
+	case 1020:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5217
+//line mysql.y:5248
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("current_date")}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1016:
+	case 1021:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5221
+//line mysql.y:5252
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("utc_time"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1017:
+	case 1022:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5226
+//line mysql.y:5257
 		{
 			mysqLOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_time"), Fsp: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1018:
+	case 1023:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5230
+//line mysql.y:5261
 		{
 			mysqLOCAL = &ast.TimestampFuncExpr{Name: string("timestampadd"), Unit: mysqDollar[3].colIdent.String(), Expr1: mysqDollar[5].exprUnion(), Expr2: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1019:
+	case 1024:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5234
+//line mysql.y:5265
 		{
 			mysqLOCAL = &ast.TimestampFuncExpr{Name: string("timestampdiff"), Unit: mysqDollar[3].colIdent.String(), Expr1: mysqDollar[5].exprUnion(), Expr2: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1020:
+	case 1025:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5238
+//line mysql.y:5269
 		{
 			mysqLOCAL = &ast.ExtractFuncExpr{IntervalTypes: mysqDollar[3].intervalTypeUnion(), Expr: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1021:
+	case 1026:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5242
+//line mysql.y:5273
 		{
 			mysqLOCAL = &ast.WeightStringFuncExpr{Expr: mysqDollar[3].exprUnion(), As: mysqDollar[4].convertTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1022:
+	case 1027:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5246
+//line mysql.y:5277
 		{
 			mysqLOCAL = &ast.JSONPrettyExpr{JSONVal: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1023:
+	case 1028:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5250
+//line mysql.y:5281
 		{
 			mysqLOCAL = &ast.JSONStorageFreeExpr{JSONVal: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1024:
+	case 1029:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5254
+//line mysql.y:5285
 		{
 			mysqLOCAL = &ast.JSONStorageSizeExpr{JSONVal: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1025:
+	case 1030:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5258
+//line mysql.y:5289
 		{
 			mysqLOCAL = &ast.TrimFuncExpr{TrimFuncType: ast.LTrimType, StringArg: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1026:
+	case 1031:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5262
+//line mysql.y:5293
 		{
 			mysqLOCAL = &ast.TrimFuncExpr{TrimFuncType: ast.RTrimType, StringArg: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1027:
+	case 1032:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5266
+//line mysql.y:5297
 		{
 			mysqLOCAL = &ast.TrimFuncExpr{Type: mysqDollar[3].trimTypeUnion(), TrimArg: mysqDollar[4].exprUnion(), StringArg: mysqDollar[6].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1028:
+	case 1033:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5270
+//line mysql.y:5301
 		{
 			mysqLOCAL = &ast.TrimFuncExpr{StringArg: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1029:
+	case 1034:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5274
+//line mysql.y:5305
 		{
 			mysqLOCAL = &ast.TrimFuncExpr{TrimArg: mysqDollar[3].exprUnion(), StringArg: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1030:
+	case 1035:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5278
+//line mysql.y:5309
 		{
 			mysqLOCAL = &ast.JSONSchemaValidFuncExpr{Schema: mysqDollar[3].exprUnion(), Document: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1031:
+	case 1036:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5282
+//line mysql.y:5313
 		{
 			mysqLOCAL = &ast.JSONSchemaValidationReportFuncExpr{Schema: mysqDollar[3].exprUnion(), Document: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1032:
+	case 1037:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5286
+//line mysql.y:5317
 		{
 			mysqLOCAL = &ast.JSONArrayExpr{Params: mysqDollar[3].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1033:
+	case 1038:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5290
+//line mysql.y:5321
 		{
 			mysqLOCAL = &ast.JSONObjectExpr{Params: mysqDollar[3].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1034:
+	case 1039:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5294
+//line mysql.y:5325
 		{
 			mysqLOCAL = &ast.JSONQuoteExpr{StringArg: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1035:
+	case 1040:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5298
+//line mysql.y:5329
 		{
 			mysqLOCAL = &ast.JSONContainsExpr{Target: mysqDollar[3].exprUnion(), Candidate: mysqDollar[5].exprUnion(), PathList: mysqDollar[6].jsonPathParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1036:
+	case 1041:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5302
+//line mysql.y:5333
 		{
 			mysqLOCAL = &ast.JSONContainsPathExpr{JSONDoc: mysqDollar[3].exprUnion(), OneOrAll: mysqDollar[5].exprUnion(), PathList: mysqDollar[7].jsonPathParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1037:
+	case 1042:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5306
+//line mysql.y:5337
 		{
 			mysqLOCAL = &ast.JSONExtractExpr{JSONDoc: mysqDollar[3].exprUnion(), PathList: mysqDollar[5].jsonPathParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1038:
+	case 1043:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5310
+//line mysql.y:5341
 		{
 			mysqLOCAL = &ast.JSONKeysExpr{JSONDoc: mysqDollar[3].exprUnion(), PathList: mysqDollar[4].jsonPathParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1039:
+	case 1044:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5314
+//line mysql.y:5345
 		{
 			mysqLOCAL = &ast.JSONOverlapsExpr{JSONDoc1: mysqDollar[3].exprUnion(), JSONDoc2: mysqDollar[5].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1040:
+	case 1045:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5318
+//line mysql.y:5349
 		{
 			mysqLOCAL = &ast.JSONSearchExpr{JSONDoc: mysqDollar[3].exprUnion(), OneOrAll: mysqDollar[5].exprUnion(), SearchStr: mysqDollar[7].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1041:
+	case 1046:
 		mysqDollar = mysqS[mysqpt-11 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5322
+//line mysql.y:5353
 		{
 			mysqLOCAL = &ast.JSONSearchExpr{JSONDoc: mysqDollar[3].exprUnion(), OneOrAll: mysqDollar[5].exprUnion(), SearchStr: mysqDollar[7].exprUnion(), EscapeChar: mysqDollar[9].exprUnion(), PathList: mysqDollar[10].jsonPathParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1042:
+	case 1047:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5326
+//line mysql.y:5357
 		{
 			mysqLOCAL = &ast.JSONValueExpr{JSONDoc: mysqDollar[3].exprUnion(), Path: mysqDollar[5].jsonPathParam, ReturningType: mysqDollar[6].convertTypeUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1043:
+	case 1048:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5330
+//line mysql.y:5361
 		{
 			mysqLOCAL = &ast.JSONValueExpr{JSONDoc: mysqDollar[3].exprUnion(), Path: mysqDollar[5].jsonPathParam, ReturningType: mysqDollar[6].convertTypeUnion(), EmptyOnResponse: mysqDollar[7].jtOnResponseUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1044:
+	case 1049:
 		mysqDollar = mysqS[mysqpt-8 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5334
+//line mysql.y:5365
 		{
 			mysqLOCAL = &ast.JSONValueExpr{JSONDoc: mysqDollar[3].exprUnion(), Path: mysqDollar[5].jsonPathParam, ReturningType: mysqDollar[6].convertTypeUnion(), ErrorOnResponse: mysqDollar[7].jtOnResponseUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1045:
+	case 1050:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5338
+//line mysql.y:5369
 		{
 			mysqLOCAL = &ast.JSONValueExpr{JSONDoc: mysqDollar[3].exprUnion(), Path: mysqDollar[5].jsonPathParam, ReturningType: mysqDollar[6].convertTypeUnion(), EmptyOnResponse: mysqDollar[7].jtOnResponseUnion(), ErrorOnResponse: mysqDollar[8].jtOnResponseUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1046:
+	case 1051:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5342
+//line mysql.y:5373
 		{
 			mysqLOCAL = &ast.JSONAttributesExpr{Type: ast.DepthAttributeType, JSONDoc: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1047:
+	case 1052:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5346
+//line mysql.y:5377
 		{
 			mysqLOCAL = &ast.JSONAttributesExpr{Type: ast.ValidAttributeType, JSONDoc: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1048:
+	case 1053:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5350
+//line mysql.y:5381
 		{
 			mysqLOCAL = &ast.JSONAttributesExpr{Type: ast.TypeAttributeType, JSONDoc: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1049:
+	case 1054:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5354
+//line mysql.y:5385
 		{
 			mysqLOCAL = &ast.JSONAttributesExpr{Type: ast.LengthAttributeType, JSONDoc: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1050:
+	case 1055:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5358
+//line mysql.y:5389
 		{
 			mysqLOCAL = &ast.JSONAttributesExpr{Type: ast.LengthAttributeType, JSONDoc: mysqDollar[3].exprUnion(), Path: mysqDollar[5].jsonPathParam}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1051:
+	case 1056:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5362
+//line mysql.y:5393
 		{
 			mysqLOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONArrayAppendType, JSONDoc: mysqDollar[3].exprUnion(), Params: mysqDollar[5].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1052:
+	case 1057:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5366
+//line mysql.y:5397
 		{
 			mysqLOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONArrayInsertType, JSONDoc: mysqDollar[3].exprUnion(), Params: mysqDollar[5].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1053:
+	case 1058:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5370
+//line mysql.y:5401
 		{
 			mysqLOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONInsertType, JSONDoc: mysqDollar[3].exprUnion(), Params: mysqDollar[5].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1054:
+	case 1059:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5374
+//line mysql.y:5405
 		{
 			mysqLOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONReplaceType, JSONDoc: mysqDollar[3].exprUnion(), Params: mysqDollar[5].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1055:
+	case 1060:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5378
+//line mysql.y:5409
 		{
 			mysqLOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONSetType, JSONDoc: mysqDollar[3].exprUnion(), Params: mysqDollar[5].jsonObjectParamsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1056:
+	case 1061:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5382
+//line mysql.y:5413
 		{
 			mysqLOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergeType, JSONDoc: mysqDollar[3].exprUnion(), JSONDocList: mysqDollar[5].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1057:
+	case 1062:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5386
+//line mysql.y:5417
 		{
 			mysqLOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergePatchType, JSONDoc: mysqDollar[3].exprUnion(), JSONDocList: mysqDollar[5].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1058:
+	case 1063:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5390
+//line mysql.y:5421
 		{
 			mysqLOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergePreserveType, JSONDoc: mysqDollar[3].exprUnion(), JSONDocList: mysqDollar[5].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1059:
+	case 1064:
 		mysqDollar = mysqS[mysqpt-6 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5394
+//line mysql.y:5425
 		{
 			mysqLOCAL = &ast.JSONRemoveExpr{JSONDoc: mysqDollar[3].exprUnion(), PathList: mysqDollar[5].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1060:
+	case 1065:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5398
+//line mysql.y:5429
 		{
 			mysqLOCAL = &ast.JSONUnquoteExpr{JSONValue: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1061:
+	case 1066:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5403
+//line mysql.y:5434
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1062:
+	case 1067:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5407
+//line mysql.y:5438
 		{
 			mysqLOCAL = mysqDollar[2].convertTypeUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1063:
+	case 1068:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []ast.JSONPathParam
-//line mysql.y:5412
+//line mysql.y:5443
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1064:
+	case 1069:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL []ast.JSONPathParam
-//line mysql.y:5416
+//line mysql.y:5447
 		{
 			mysqLOCAL = mysqDollar[2].jsonPathParamsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1065:
+	case 1070:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.JSONPathParam
-//line mysql.y:5422
+//line mysql.y:5453
 		{
 			mysqLOCAL = []ast.JSONPathParam{mysqDollar[1].jsonPathParam}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1066:
+	case 1071:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5426
+//line mysql.y:5457
 		{
 			mysqSLICE := (*[]ast.JSONPathParam)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].jsonPathParam)
 		}
-	case 1067:
+	case 1072:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5432
+//line mysql.y:5463
 		{
 			mysqVAL.jsonPathParam = ast.JSONPathParam(mysqDollar[1].exprUnion())
 		}
-	case 1068:
+	case 1073:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5436
+//line mysql.y:5467
 		{
 			mysqVAL.jsonPathParam = ast.JSONPathParam(mysqDollar[1].colNameUnion())
 		}
-	case 1069:
+	case 1074:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5442
+//line mysql.y:5473
 		{
 		}
-	case 1070:
+	case 1075:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5444
+//line mysql.y:5475
 		{
 			mysqLOCAL = ast.IntervalDayHour
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1071:
+	case 1076:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5448
+//line mysql.y:5479
 		{
 			mysqLOCAL = ast.IntervalDayMicrosecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1072:
+	case 1077:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5452
+//line mysql.y:5483
 		{
 			mysqLOCAL = ast.IntervalDayMinute
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1073:
+	case 1078:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5456
+//line mysql.y:5487
 		{
 			mysqLOCAL = ast.IntervalDaySecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1074:
+	case 1079:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5460
+//line mysql.y:5491
 		{
 			mysqLOCAL = ast.IntervalHourMicrosecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1075:
+	case 1080:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5464
+//line mysql.y:5495
 		{
 			mysqLOCAL = ast.IntervalHourMinute
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1076:
+	case 1081:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5468
+//line mysql.y:5499
 		{
 			mysqLOCAL = ast.IntervalHourSecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1077:
+	case 1082:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5472
+//line mysql.y:5503
 		{
 			mysqLOCAL = ast.IntervalMinuteMicrosecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1078:
+	case 1083:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5476
+//line mysql.y:5507
 		{
 			mysqLOCAL = ast.IntervalMinuteSecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1079:
+	case 1084:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5480
+//line mysql.y:5511
 		{
 			mysqLOCAL = ast.IntervalSecondMicrosecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1080:
+	case 1085:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5484
+//line mysql.y:5515
 		{
 			mysqLOCAL = ast.IntervalYearMonth
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1081:
+	case 1086:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5490
+//line mysql.y:5521
 		{
 			mysqLOCAL = ast.IntervalDay
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1082:
+	case 1087:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5494
+//line mysql.y:5525
 		{
 			mysqLOCAL = ast.IntervalWeek
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1083:
+	case 1088:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5498
+//line mysql.y:5529
 		{
 			mysqLOCAL = ast.IntervalHour
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1084:
+	case 1089:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5502
+//line mysql.y:5533
 		{
 			mysqLOCAL = ast.IntervalMinute
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1085:
+	case 1090:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5506
+//line mysql.y:5537
 		{
 			mysqLOCAL = ast.IntervalMonth
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1086:
+	case 1091:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5510
+//line mysql.y:5541
 		{
 			mysqLOCAL = ast.IntervalQuarter
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1087:
+	case 1092:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5514
+//line mysql.y:5545
 		{
 			mysqLOCAL = ast.IntervalSecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1088:
+	case 1093:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5518
+//line mysql.y:5549
 		{
 			mysqLOCAL = ast.IntervalMicrosecond
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1089:
+	case 1094:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.IntervalTypes
-//line mysql.y:5522
+//line mysql.y:5553
 		{
 			mysqLOCAL = ast.IntervalYear
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1092:
+	case 1097:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5532
+//line mysql.y:5563
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1093:
+	case 1098:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5536
+//line mysql.y:5567
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1094:
+	case 1099:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5540
+//line mysql.y:5571
 		{
 			mysqLOCAL = ast.NewIntLiteral(mysqDollar[2].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1095:
+	case 1100:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5544
+//line mysql.y:5575
 		{
 			mysqLOCAL = ast.NewArgument(mysqDollar[2].str[1:])
 			bindVariable(mysqlex, mysqDollar[2].str[1:])
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1096:
+	case 1101:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5555
+//line mysql.y:5586
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("if"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1097:
+	case 1102:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5559
+//line mysql.y:5590
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("database"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1098:
+	case 1103:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5563
+//line mysql.y:5594
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("schema"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1099:
+	case 1104:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5567
+//line mysql.y:5598
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("mod"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1100:
+	case 1105:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5571
+//line mysql.y:5602
 		{
 			mysqLOCAL = &ast.FuncExpr{Name: ast.NewColIdent("replace"), Exprs: mysqDollar[3].selectExprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1101:
+	case 1106:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.MatchExprOption
-//line mysql.y:5577
+//line mysql.y:5608
 		{
 			mysqLOCAL = ast.NoOption
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1102:
+	case 1107:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.MatchExprOption
-//line mysql.y:5581
+//line mysql.y:5612
 		{
 			mysqLOCAL = ast.BooleanModeOpt
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1103:
+	case 1108:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.MatchExprOption
-//line mysql.y:5585
+//line mysql.y:5616
 		{
 			mysqLOCAL = ast.NaturalLanguageModeOpt
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1104:
+	case 1109:
 		mysqDollar = mysqS[mysqpt-7 : mysqpt+1]
 		var mysqLOCAL ast.MatchExprOption
-//line mysql.y:5589
+//line mysql.y:5620
 		{
 			mysqLOCAL = ast.NaturalLanguageModeWithQueryExpansionOpt
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1105:
+	case 1110:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.MatchExprOption
-//line mysql.y:5593
+//line mysql.y:5624
 		{
 			mysqLOCAL = ast.QueryExpansionOpt
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1106:
+	case 1111:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5599
+//line mysql.y:5630
 		{
 			mysqVAL.str = string(mysqDollar[1].colIdent.String())
 		}
-	case 1107:
+	case 1112:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5603
+//line mysql.y:5634
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1108:
+	case 1113:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5607
+//line mysql.y:5638
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1109:
+	case 1114:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5613
+//line mysql.y:5644
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1110:
+	case 1115:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5617
+//line mysql.y:5648
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[2].str), Length: ast.NewIntLiteral(mysqDollar[4].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1111:
+	case 1116:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5621
+//line mysql.y:5652
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[2].str), Length: ast.NewIntLiteral(mysqDollar[4].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1112:
+	case 1117:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5627
+//line mysql.y:5658
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1113:
+	case 1118:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5631
+//line mysql.y:5662
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion(), Charset: mysqDollar[3].columnCharset}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1114:
+	case 1119:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5635
+//line mysql.y:5666
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1115:
+	case 1120:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5639
+//line mysql.y:5670
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1116:
+	case 1121:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5643
+//line mysql.y:5674
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 			mysqLOCAL.Length = mysqDollar[2].LengthScaleOption.Length
 			mysqLOCAL.Scale = mysqDollar[2].LengthScaleOption.Scale
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1117:
+	case 1122:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5649
+//line mysql.y:5680
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1118:
+	case 1123:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5653
+//line mysql.y:5684
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1119:
+	case 1124:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5657
+//line mysql.y:5688
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1120:
+	case 1125:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5661
+//line mysql.y:5692
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1121:
+	case 1126:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5665
+//line mysql.y:5696
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1122:
+	case 1127:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5669
+//line mysql.y:5700
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1123:
+	case 1128:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5673
+//line mysql.y:5704
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1124:
+	case 1129:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5677
+//line mysql.y:5708
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str), Length: mysqDollar[2].literalUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1125:
+	case 1130:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5681
+//line mysql.y:5712
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1126:
+	case 1131:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ConvertType
-//line mysql.y:5685
+//line mysql.y:5716
 		{
 			mysqLOCAL = &ast.ConvertType{Type: string(mysqDollar[1].str)}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1127:
+	case 1132:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5691
+//line mysql.y:5722
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1128:
+	case 1133:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5695
+//line mysql.y:5726
 		{
 			mysqLOCAL = mysqDollar[1].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1129:
+	case 1134:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5700
+//line mysql.y:5731
 		{
 			mysqVAL.str = string("")
 		}
-	case 1130:
+	case 1135:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:5704
+//line mysql.y:5735
 		{
 			mysqVAL.str = " separator " + sql_types.EncodeStringSQL(mysqDollar[2].str)
 		}
-	case 1131:
+	case 1136:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.When
-//line mysql.y:5710
+//line mysql.y:5741
 		{
 			mysqLOCAL = []*ast.When{mysqDollar[1].whenUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1132:
+	case 1137:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:5714
+//line mysql.y:5745
 		{
 			mysqSLICE := (*[]*ast.When)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[2].whenUnion())
 		}
-	case 1133:
+	case 1138:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.When
-//line mysql.y:5720
+//line mysql.y:5751
 		{
 			mysqLOCAL = &ast.When{Cond: mysqDollar[2].exprUnion(), Val: mysqDollar[4].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1134:
+	case 1139:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5725
+//line mysql.y:5756
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1135:
+	case 1140:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5729
+//line mysql.y:5760
 		{
 			mysqLOCAL = mysqDollar[2].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1136:
+	case 1141:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.ColName
-//line mysql.y:5735
+//line mysql.y:5766
 		{
 			mysqLOCAL = &ast.ColName{Name: mysqDollar[1].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1137:
+	case 1142:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.ColName
-//line mysql.y:5739
+//line mysql.y:5770
 		{
 			mysqLOCAL = &ast.ColName{Qualifier: ast.TableName{Name: mysqDollar[1].tableIdent}, Name: mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1138:
+	case 1143:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.ColName
-//line mysql.y:5743
+//line mysql.y:5774
 		{
 			mysqLOCAL = &ast.ColName{Qualifier: ast.TableName{Qualifier: mysqDollar[1].tableIdent, Name: mysqDollar[3].tableIdent}, Name: mysqDollar[5].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1139:
+	case 1144:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5749
+//line mysql.y:5780
 		{
 			// TODO(sougou): ast.Deprecate this construct.
 			if mysqDollar[1].colIdent.Lowered() != "value" {
@@ -16455,388 +16589,424 @@ mysqdefault:
 			mysqLOCAL = ast.NewIntLiteral("1")
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1140:
+	case 1145:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5758
+//line mysql.y:5789
 		{
 			mysqLOCAL = ast.NewIntLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1141:
+	case 1146:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5762
+//line mysql.y:5793
 		{
 			mysqLOCAL = ast.NewArgument(mysqDollar[1].str[1:])
 			bindVariable(mysqlex, mysqDollar[1].str[1:])
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1142:
+	case 1147:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Exprs
-//line mysql.y:5768
+//line mysql.y:5799
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1143:
+	case 1148:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Exprs
-//line mysql.y:5772
+//line mysql.y:5803
 		{
 			mysqLOCAL = mysqDollar[3].exprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1144:
+	case 1149:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5777
+//line mysql.y:5808
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1145:
+	case 1150:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:5781
+//line mysql.y:5812
 		{
 			mysqLOCAL = mysqDollar[2].exprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1146:
+	case 1151:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.OrderBy
-//line mysql.y:5786
+//line mysql.y:5817
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1147:
+	case 1152:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.OrderBy
-//line mysql.y:5790
+//line mysql.y:5821
 		{
 			mysqLOCAL = mysqDollar[1].orderByUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1148:
+	case 1153:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.OrderBy
-//line mysql.y:5796
+//line mysql.y:5827
 		{
 			mysqLOCAL = mysqDollar[3].orderByUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1149:
+	case 1154:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.OrderBy
-//line mysql.y:5802
+//line mysql.y:5833
 		{
 			mysqLOCAL = ast.OrderBy{mysqDollar[1].orderUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1150:
+	case 1155:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5806
+//line mysql.y:5837
 		{
 			mysqSLICE := (*ast.OrderBy)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].orderUnion())
 		}
-	case 1151:
+	case 1156:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.Order
-//line mysql.y:5812
+//line mysql.y:5843
 		{
 			mysqLOCAL = &ast.Order{Expr: mysqDollar[1].exprUnion(), Direction: mysqDollar[2].orderDirectionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1152:
+	case 1157:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.OrderDirection
-//line mysql.y:5817
+//line mysql.y:5848
 		{
 			mysqLOCAL = ast.AscOrder
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1153:
+	case 1158:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.OrderDirection
-//line mysql.y:5821
+//line mysql.y:5852
 		{
 			mysqLOCAL = ast.AscOrder
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1154:
+	case 1159:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.OrderDirection
-//line mysql.y:5825
+//line mysql.y:5856
 		{
 			mysqLOCAL = ast.DescOrder
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1155:
+	case 1160:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.Limit
-//line mysql.y:5830
+//line mysql.y:5861
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1156:
+	case 1161:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.Limit
-//line mysql.y:5834
+//line mysql.y:5865
 		{
 			mysqLOCAL = mysqDollar[1].limitUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1157:
+	case 1162:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.Limit
-//line mysql.y:5840
+//line mysql.y:5871
 		{
 			mysqLOCAL = &ast.Limit{Rowcount: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1158:
+	case 1163:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Limit
-//line mysql.y:5844
+//line mysql.y:5875
 		{
 			mysqLOCAL = &ast.Limit{Offset: mysqDollar[2].exprUnion(), Rowcount: mysqDollar[4].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1159:
+	case 1164:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Limit
-//line mysql.y:5848
+//line mysql.y:5879
 		{
 			mysqLOCAL = &ast.Limit{Offset: mysqDollar[4].exprUnion(), Rowcount: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1160:
+	case 1165:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:5853
+//line mysql.y:5884
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1161:
+	case 1166:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:5857
+//line mysql.y:5888
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion(), mysqDollar[2].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1162:
+	case 1167:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:5861
+//line mysql.y:5892
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion(), mysqDollar[2].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1163:
+	case 1168:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:5865
+//line mysql.y:5896
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1164:
+	case 1169:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []ast.AlterOption
-//line mysql.y:5869
+//line mysql.y:5900
 		{
 			mysqLOCAL = []ast.AlterOption{mysqDollar[1].alterOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1165:
+	case 1170:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5876
+//line mysql.y:5907
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.DefaultType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1166:
+	case 1171:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5880
+//line mysql.y:5911
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.NoneType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1167:
+	case 1172:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5884
+//line mysql.y:5915
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.SharedType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1168:
+	case 1173:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5888
+//line mysql.y:5919
 		{
 			mysqLOCAL = &ast.LockOption{Type: ast.ExclusiveType}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1169:
+	case 1174:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5894
+//line mysql.y:5925
 		{
 			mysqLOCAL = ast.AlgorithmValue(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1170:
+	case 1175:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5898
+//line mysql.y:5929
 		{
 			mysqLOCAL = ast.AlgorithmValue(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1171:
+	case 1176:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5902
+//line mysql.y:5933
 		{
 			mysqLOCAL = ast.AlgorithmValue(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1172:
+	case 1177:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.AlterOption
-//line mysql.y:5906
+//line mysql.y:5937
 		{
 			mysqLOCAL = ast.AlgorithmValue(mysqDollar[3].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1173:
+	case 1178:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5911
+//line mysql.y:5942
 		{
 			mysqVAL.str = ""
 		}
-	case 1174:
+	case 1179:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5915
+//line mysql.y:5946
 		{
 			mysqVAL.str = string(mysqDollar[3].str)
 		}
-	case 1175:
+	case 1180:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5919
+//line mysql.y:5950
 		{
 			mysqVAL.str = string(mysqDollar[3].str)
 		}
-	case 1176:
+	case 1181:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5923
+//line mysql.y:5954
 		{
 			mysqVAL.str = string(mysqDollar[3].str)
 		}
-	case 1177:
+	case 1182:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5928
+//line mysql.y:5959
 		{
 			mysqVAL.str = ""
 		}
-	case 1178:
+	case 1183:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:5932
+//line mysql.y:5963
 		{
 			mysqVAL.str = mysqDollar[3].str
 		}
-	case 1179:
+	case 1184:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5938
+//line mysql.y:5969
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1180:
+	case 1185:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5942
+//line mysql.y:5973
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1181:
+	case 1186:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5947
+//line mysql.y:5978
 		{
 			mysqVAL.str = ""
 		}
-	case 1182:
+	case 1187:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:5951
+//line mysql.y:5982
 		{
 			mysqVAL.str = mysqDollar[2].str
 		}
-	case 1183:
+	case 1188:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:5956
+//line mysql.y:5987
 		{
 			mysqVAL.str = "cascaded"
 		}
-	case 1184:
+	case 1189:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5960
+//line mysql.y:5991
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1185:
+	case 1190:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:5964
+//line mysql.y:5995
 		{
 			mysqVAL.str = string(mysqDollar[1].str)
 		}
-	case 1186:
+	case 1191:
+		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
+//line mysql.y:6001
+		{
+			mysqVAL.str = ast.TriggerBeforeStr
+		}
+	case 1192:
+		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
+//line mysql.y:6005
+		{
+			mysqVAL.str = ast.TriggerAfterStr
+		}
+	case 1193:
+		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
+		var mysqLOCAL *ast.TriggerEvent
+//line mysql.y:6011
+		{
+			mysqLOCAL = &ast.TriggerEvent{Type: ast.TriggerInsertStr}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 1194:
+		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
+		var mysqLOCAL *ast.TriggerEvent
+//line mysql.y:6015
+		{
+			mysqLOCAL = &ast.TriggerEvent{Type: ast.TriggerUpdateStr}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 1195:
+		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
+		var mysqLOCAL *ast.TriggerEvent
+//line mysql.y:6019
+		{
+			mysqLOCAL = &ast.TriggerEvent{Type: ast.TriggerDeleteStr}
+		}
+		mysqVAL.union = mysqLOCAL
+	case 1196:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL *ast.Definer
-//line mysql.y:5969
+//line mysql.y:6024
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1187:
+	case 1197:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.Definer
-//line mysql.y:5973
+//line mysql.y:6028
 		{
 			mysqLOCAL = mysqDollar[3].definerUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1188:
+	case 1198:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.Definer
-//line mysql.y:5979
+//line mysql.y:6034
 		{
 			mysqLOCAL = &ast.Definer{
 				Name: string(mysqDollar[1].str),
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1189:
+	case 1199:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.Definer
-//line mysql.y:5985
+//line mysql.y:6040
 		{
 			mysqLOCAL = &ast.Definer{
 				Name: string(mysqDollar[1].str),
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1190:
+	case 1200:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.Definer
-//line mysql.y:5991
+//line mysql.y:6046
 		{
 			mysqLOCAL = &ast.Definer{
 				Name:    mysqDollar[1].str,
@@ -16844,361 +17014,361 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1191:
+	case 1201:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6000
+//line mysql.y:6055
 		{
 			mysqVAL.str = sql_types.EncodeStringSQL(mysqDollar[1].str)
 		}
-	case 1192:
+	case 1202:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6004
+//line mysql.y:6059
 		{
 			mysqVAL.str = ast.FormatIdentifier(mysqDollar[1].str)
 		}
-	case 1193:
+	case 1203:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6009
+//line mysql.y:6064
 		{
 			mysqVAL.str = ""
 		}
-	case 1194:
+	case 1204:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6013
+//line mysql.y:6068
 		{
 			mysqVAL.str = ast.FormatAddress(mysqDollar[1].str)
 		}
-	case 1195:
+	case 1205:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.Lock
-//line mysql.y:6019
+//line mysql.y:6074
 		{
 			mysqLOCAL = ast.ForUpdateLock
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1196:
+	case 1206:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL ast.Lock
-//line mysql.y:6023
+//line mysql.y:6078
 		{
 			mysqLOCAL = ast.ShareModeLock
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1197:
+	case 1207:
 		mysqDollar = mysqS[mysqpt-9 : mysqpt+1]
 		var mysqLOCAL *ast.SelectInto
-//line mysql.y:6029
+//line mysql.y:6084
 		{
 			mysqLOCAL = &ast.SelectInto{Type: ast.IntoOutfileS3, FileName: sql_types.EncodeStringSQL(mysqDollar[4].str), Charset: mysqDollar[5].columnCharset, FormatOption: mysqDollar[6].str, ExportOption: mysqDollar[7].str, Manifest: mysqDollar[8].str, Overwrite: mysqDollar[9].str}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1198:
+	case 1208:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SelectInto
-//line mysql.y:6033
+//line mysql.y:6088
 		{
 			mysqLOCAL = &ast.SelectInto{Type: ast.IntoDumpfile, FileName: sql_types.EncodeStringSQL(mysqDollar[3].str), Charset: ast.ColumnCharset{}, FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1199:
+	case 1209:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.SelectInto
-//line mysql.y:6037
+//line mysql.y:6092
 		{
 			mysqLOCAL = &ast.SelectInto{Type: ast.IntoOutfile, FileName: sql_types.EncodeStringSQL(mysqDollar[3].str), Charset: mysqDollar[4].columnCharset, FormatOption: "", ExportOption: mysqDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1200:
+	case 1210:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6042
+//line mysql.y:6097
 		{
 			mysqVAL.str = ""
 		}
-	case 1201:
+	case 1211:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6046
+//line mysql.y:6101
 		{
 			mysqVAL.str = " format csv" + mysqDollar[3].str
 		}
-	case 1202:
+	case 1212:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6050
+//line mysql.y:6105
 		{
 			mysqVAL.str = " format text" + mysqDollar[3].str
 		}
-	case 1203:
+	case 1213:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6055
+//line mysql.y:6110
 		{
 			mysqVAL.str = ""
 		}
-	case 1204:
+	case 1214:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6059
+//line mysql.y:6114
 		{
 			mysqVAL.str = " header"
 		}
-	case 1205:
+	case 1215:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6064
+//line mysql.y:6119
 		{
 			mysqVAL.str = ""
 		}
-	case 1206:
+	case 1216:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6068
+//line mysql.y:6123
 		{
 			mysqVAL.str = " manifest on"
 		}
-	case 1207:
+	case 1217:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6072
+//line mysql.y:6127
 		{
 			mysqVAL.str = " manifest off"
 		}
-	case 1208:
+	case 1218:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6077
+//line mysql.y:6132
 		{
 			mysqVAL.str = ""
 		}
-	case 1209:
+	case 1219:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6081
+//line mysql.y:6136
 		{
 			mysqVAL.str = " overwrite on"
 		}
-	case 1210:
+	case 1220:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6085
+//line mysql.y:6140
 		{
 			mysqVAL.str = " overwrite off"
 		}
-	case 1211:
+	case 1221:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6091
+//line mysql.y:6146
 		{
 			mysqVAL.str = mysqDollar[1].str + mysqDollar[2].str
 		}
-	case 1212:
+	case 1222:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6096
+//line mysql.y:6151
 		{
 			mysqVAL.str = ""
 		}
-	case 1213:
+	case 1223:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6100
+//line mysql.y:6155
 		{
 			mysqVAL.str = " lines" + mysqDollar[2].str
 		}
-	case 1214:
+	case 1224:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6106
+//line mysql.y:6161
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 1215:
+	case 1225:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6110
+//line mysql.y:6165
 		{
 			mysqVAL.str = mysqDollar[1].str + mysqDollar[2].str
 		}
-	case 1216:
+	case 1226:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6116
+//line mysql.y:6171
 		{
 			mysqVAL.str = " starting by " + sql_types.EncodeStringSQL(mysqDollar[3].str)
 		}
-	case 1217:
+	case 1227:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6120
+//line mysql.y:6175
 		{
 			mysqVAL.str = " terminated by " + sql_types.EncodeStringSQL(mysqDollar[3].str)
 		}
-	case 1218:
+	case 1228:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6125
+//line mysql.y:6180
 		{
 			mysqVAL.str = ""
 		}
-	case 1219:
+	case 1229:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6129
+//line mysql.y:6184
 		{
 			mysqVAL.str = " " + mysqDollar[1].str + mysqDollar[2].str
 		}
-	case 1220:
+	case 1230:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6135
+//line mysql.y:6190
 		{
 			mysqVAL.str = mysqDollar[1].str
 		}
-	case 1221:
+	case 1231:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6139
+//line mysql.y:6194
 		{
 			mysqVAL.str = mysqDollar[1].str + mysqDollar[2].str
 		}
-	case 1222:
+	case 1232:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6145
+//line mysql.y:6200
 		{
 			mysqVAL.str = " terminated by " + sql_types.EncodeStringSQL(mysqDollar[3].str)
 		}
-	case 1223:
+	case 1233:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
-//line mysql.y:6149
+//line mysql.y:6204
 		{
 			mysqVAL.str = mysqDollar[1].str + " enclosed by " + sql_types.EncodeStringSQL(mysqDollar[4].str)
 		}
-	case 1224:
+	case 1234:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6153
+//line mysql.y:6208
 		{
 			mysqVAL.str = " escaped by " + sql_types.EncodeStringSQL(mysqDollar[3].str)
 		}
-	case 1225:
+	case 1235:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6158
+//line mysql.y:6213
 		{
 			mysqVAL.str = ""
 		}
-	case 1226:
+	case 1236:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6162
+//line mysql.y:6217
 		{
 			mysqVAL.str = " optionally"
 		}
-	case 1227:
+	case 1237:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.Insert
-//line mysql.y:6175
+//line mysql.y:6230
 		{
 			mysqLOCAL = &ast.Insert{Rows: mysqDollar[2].valuesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1228:
+	case 1238:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL *ast.Insert
-//line mysql.y:6179
+//line mysql.y:6234
 		{
 			mysqLOCAL = &ast.Insert{Rows: mysqDollar[1].selStmtUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1229:
+	case 1239:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL *ast.Insert
-//line mysql.y:6183
+//line mysql.y:6238
 		{
 			mysqLOCAL = &ast.Insert{Columns: mysqDollar[2].columnsUnion(), Rows: mysqDollar[5].valuesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1230:
+	case 1240:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Insert
-//line mysql.y:6187
+//line mysql.y:6242
 		{
 			mysqLOCAL = &ast.Insert{Rows: mysqDollar[4].valuesUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1231:
+	case 1241:
 		mysqDollar = mysqS[mysqpt-4 : mysqpt+1]
 		var mysqLOCAL *ast.Insert
-//line mysql.y:6191
+//line mysql.y:6246
 		{
 			mysqLOCAL = &ast.Insert{Columns: mysqDollar[2].columnsUnion(), Rows: mysqDollar[4].selStmtUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1232:
+	case 1242:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:6197
+//line mysql.y:6252
 		{
 			mysqLOCAL = ast.Columns{mysqDollar[1].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1233:
+	case 1243:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.Columns
-//line mysql.y:6201
+//line mysql.y:6256
 		{
 			mysqLOCAL = ast.Columns{mysqDollar[3].colIdent}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1234:
+	case 1244:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6205
+//line mysql.y:6260
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].colIdent)
 		}
-	case 1235:
+	case 1245:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
-//line mysql.y:6209
+//line mysql.y:6264
 		{
 			mysqSLICE := (*ast.Columns)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[5].colIdent)
 		}
-	case 1236:
+	case 1246:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.UpdateExprs
-//line mysql.y:6214
+//line mysql.y:6269
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1237:
+	case 1247:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.UpdateExprs
-//line mysql.y:6218
+//line mysql.y:6273
 		{
 			mysqLOCAL = mysqDollar[5].updateExprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1238:
+	case 1248:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Values
-//line mysql.y:6224
+//line mysql.y:6279
 		{
 			mysqLOCAL = ast.Values{mysqDollar[1].valTupleUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1239:
+	case 1249:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6228
+//line mysql.y:6283
 		{
 			mysqSLICE := (*ast.Values)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].valTupleUnion())
 		}
-	case 1240:
+	case 1250:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.ValTuple
-//line mysql.y:6234
+//line mysql.y:6289
 		{
 			mysqLOCAL = mysqDollar[1].valTupleUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1241:
+	case 1251:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL ast.ValTuple
-//line mysql.y:6238
+//line mysql.y:6293
 		{
 			mysqLOCAL = ast.ValTuple{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1242:
+	case 1252:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL ast.ValTuple
-//line mysql.y:6244
+//line mysql.y:6299
 		{
 			mysqLOCAL = ast.ValTuple(mysqDollar[2].exprsUnion())
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1243:
+	case 1253:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:6249
+//line mysql.y:6304
 		{
 			if len(mysqDollar[1].valTupleUnion()) == 1 {
 				mysqLOCAL = mysqDollar[1].valTupleUnion()[0]
@@ -17207,329 +17377,335 @@ mysqdefault:
 			}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1244:
+	case 1254:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.UpdateExprs
-//line mysql.y:6259
+//line mysql.y:6314
 		{
 			mysqLOCAL = ast.UpdateExprs{mysqDollar[1].updateExprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1245:
+	case 1255:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6263
+//line mysql.y:6318
 		{
 			mysqSLICE := (*ast.UpdateExprs)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].updateExprUnion())
 		}
-	case 1246:
+	case 1256:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.UpdateExpr
-//line mysql.y:6269
+//line mysql.y:6324
 		{
 			mysqLOCAL = &ast.UpdateExpr{Name: mysqDollar[1].colNameUnion(), Expr: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1247:
+	case 1257:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.SetExprs
-//line mysql.y:6275
+//line mysql.y:6330
 		{
 			mysqLOCAL = ast.SetExprs{mysqDollar[1].setExprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1248:
+	case 1258:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
-//line mysql.y:6279
+//line mysql.y:6334
 		{
 			mysqSLICE := (*ast.SetExprs)(mysqIaddr(mysqVAL.union))
 			*mysqSLICE = append(*mysqSLICE, mysqDollar[3].setExprUnion())
 		}
-	case 1249:
+	case 1259:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SetExpr
-//line mysql.y:6285
+//line mysql.y:6340
 		{
 			mysqLOCAL = &ast.SetExpr{Name: mysqDollar[1].colIdent, Scope: ast.ImplicitScope, Expr: ast.NewStrLiteral("on")}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1250:
+	case 1260:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SetExpr
-//line mysql.y:6289
+//line mysql.y:6344
 		{
 			mysqLOCAL = &ast.SetExpr{Name: mysqDollar[1].colIdent, Scope: ast.ImplicitScope, Expr: ast.NewStrLiteral("off")}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1251:
+	case 1261:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SetExpr
-//line mysql.y:6293
+//line mysql.y:6348
 		{
 			mysqLOCAL = &ast.SetExpr{Name: mysqDollar[1].colIdent, Scope: ast.ImplicitScope, Expr: mysqDollar[3].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1252:
+	case 1262:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL *ast.SetExpr
-//line mysql.y:6297
+//line mysql.y:6352
 		{
 			mysqLOCAL = &ast.SetExpr{Name: ast.NewColIdent(string(mysqDollar[1].str)), Scope: ast.ImplicitScope, Expr: mysqDollar[2].exprUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1253:
+	case 1263:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.SetExpr
-//line mysql.y:6301
+//line mysql.y:6356
 		{
 			mysqDollar[2].setExprUnion().Scope = mysqDollar[1].scopeUnion()
 			mysqLOCAL = mysqDollar[2].setExprUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1255:
+	case 1265:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
-//line mysql.y:6309
+//line mysql.y:6364
 		{
 			mysqVAL.str = "charset"
 		}
-	case 1258:
+	case 1268:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:6319
+//line mysql.y:6374
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[1].colIdent.String())
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1259:
+	case 1269:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:6323
+//line mysql.y:6378
 		{
 			mysqLOCAL = ast.NewStrLiteral(mysqDollar[1].str)
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1260:
+	case 1270:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Expr
-//line mysql.y:6327
+//line mysql.y:6382
 		{
 			mysqLOCAL = &ast.Default{}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1263:
+	case 1273:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6336
+//line mysql.y:6391
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1264:
+	case 1274:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6338
+//line mysql.y:6393
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1265:
+	case 1275:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6341
+//line mysql.y:6396
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1266:
+	case 1276:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6343
+//line mysql.y:6398
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1267:
+	case 1277:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6346
+//line mysql.y:6401
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1268:
+	case 1278:
 		mysqDollar = mysqS[mysqpt-3 : mysqpt+1]
 		var mysqLOCAL bool
-//line mysql.y:6348
+//line mysql.y:6403
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1269:
+	case 1279:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Ignore
-//line mysql.y:6351
+//line mysql.y:6406
 		{
 			mysqLOCAL = false
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1270:
+	case 1280:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Ignore
-//line mysql.y:6353
+//line mysql.y:6408
 		{
 			mysqLOCAL = true
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1271:
+	case 1281:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6356
+//line mysql.y:6411
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 1272:
+	case 1282:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6358
+//line mysql.y:6413
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 1273:
+	case 1283:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6360
+//line mysql.y:6415
 		{
 			mysqVAL.empty = struct{}{}
 		}
-	case 1274:
+	case 1284:
 		mysqDollar = mysqS[mysqpt-5 : mysqpt+1]
 		var mysqLOCAL ast.Statement
-//line mysql.y:6364
+//line mysql.y:6419
 		{
 			mysqLOCAL = &ast.CallProc{Name: mysqDollar[2].tableName, Params: mysqDollar[4].exprsUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1275:
+	case 1285:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL ast.Exprs
-//line mysql.y:6369
+//line mysql.y:6424
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1276:
+	case 1286:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL ast.Exprs
-//line mysql.y:6373
+//line mysql.y:6428
 		{
 			mysqLOCAL = mysqDollar[1].exprsUnion()
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1277:
+	case 1287:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexOption
-//line mysql.y:6378
+//line mysql.y:6433
 		{
 			mysqLOCAL = nil
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1278:
+	case 1288:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
 		var mysqLOCAL []*ast.IndexOption
-//line mysql.y:6380
+//line mysql.y:6435
 		{
 			mysqLOCAL = []*ast.IndexOption{mysqDollar[1].indexOptionUnion()}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1279:
+	case 1289:
 		mysqDollar = mysqS[mysqpt-2 : mysqpt+1]
 		var mysqLOCAL *ast.IndexOption
-//line mysql.y:6384
+//line mysql.y:6439
 		{
 			mysqLOCAL = &ast.IndexOption{Name: string(mysqDollar[1].str), String: string(mysqDollar[2].colIdent.String())}
 		}
 		mysqVAL.union = mysqLOCAL
-	case 1280:
+	case 1290:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6390
+//line mysql.y:6445
 		{
 			mysqVAL.colIdent = mysqDollar[1].colIdent
 		}
-	case 1281:
+	case 1291:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6394
+//line mysql.y:6449
 		{
 			mysqVAL.colIdent = ast.NewColIdent(string(mysqDollar[1].str))
 		}
-	case 1283:
+	case 1293:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6401
+//line mysql.y:6456
 		{
 			mysqVAL.colIdent = ast.NewColIdent(string(mysqDollar[1].str))
 		}
-	case 1284:
+	case 1294:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6407
+//line mysql.y:6462
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent(string(mysqDollar[1].colIdent.String()))
 		}
-	case 1285:
+	case 1295:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6411
+//line mysql.y:6466
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent(string(mysqDollar[1].str))
 		}
-	case 1286:
+	case 1296:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6417
+//line mysql.y:6472
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 1287:
+	case 1297:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6421
+//line mysql.y:6476
 		{
 			mysqVAL.tableIdent = mysqDollar[1].tableIdent
 		}
-	case 1289:
+	case 1299:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6428
+//line mysql.y:6483
 		{
 			mysqVAL.tableIdent = ast.NewTableIdent(string(mysqDollar[1].str))
 		}
-	case 1779:
+	case 1790:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6942
+//line mysql.y:6998
 		{
 			if incNesting(mysqlex) {
 				mysqlex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1780:
+	case 1791:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6951
+//line mysql.y:7007
 		{
 			decNesting(mysqlex)
 		}
-	case 1781:
+	case 1792:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6956
+//line mysql.y:7012
 		{
 			skipToEnd(mysqlex)
 		}
-	case 1782:
+	case 1793:
+		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
+//line mysql.y:7017
+		{
+			skipRoutineToEnd(mysqlex)
+		}
+	case 1794:
 		mysqDollar = mysqS[mysqpt-0 : mysqpt+1]
-//line mysql.y:6961
+//line mysql.y:7022
 		{
 			skipToEnd(mysqlex)
 		}
-	case 1783:
+	case 1795:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6965
+//line mysql.y:7026
 		{
 			skipToEnd(mysqlex)
 		}
-	case 1784:
+	case 1796:
 		mysqDollar = mysqS[mysqpt-1 : mysqpt+1]
-//line mysql.y:6969
+//line mysql.y:7030
 		{
 			skipToEnd(mysqlex)
 		}
@@ -54,6 +54,14 @@ func skipToEnd(mysqlex mysqLexer) {
   mysqlex.(tokenizer.Tokenizer).SetSkipToEnd(true)
 }
 
+// skipRoutineToEnd makes the lexer return EOF at the end of the text, the body of the trigger
+// or the routine isn't parsed and its semicolons don't end the statement.
+func skipRoutineToEnd(mysqlex mysqLexer) {
+  tkn := mysqlex.(*MysqlTokenizer)
+  tkn.SkipToEnd = true
+  tkn.skipRoutine = true
+}
+
 func bindVariable(mysqlex mysqLexer, bvar string) {
   mysqlex.(tokenizer.Tokenizer).BindVar(bvar, struct{}{})
 }
@@ -121,6 +129,7 @@ func bindVariable(mysqlex mysqLexer, bvar string) {
   createDatabase  *ast.CreateDatabase
   alterDatabase  *ast.AlterDatabase
   createTable      *ast.CreateTable
+  triggerEvent     *ast.TriggerEvent
   tableAndLockType *ast.TableAndLockType
   alterTable       *ast.AlterTable
   tableOption      *ast.TableOption
@@ -284,7 +293,7 @@ func bindVariable(mysqlex mysqLexer, bvar string) {
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <str> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <str> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
-%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
+%token <str> SEQUENCE MERGE TEMPORARY TEMPTABLE INVOKER SECURITY FIRST AFTER BEFORE LAST
 
 // Migration tokens
 %token <str> CANCEL RETRY COMPLETE CLEANUP THROTTLE UNTHROTTLE EXPIRE RATIO
@@ -468,7 +477,9 @@ func bindVariable(mysqlex mysqLexer, bvar string) {
 %type <expr> charset_value
 %type <tableIdent> table_id reserved_table_id table_alias as_opt_id table_id_opt from_database_opt
 %type <empty> as_opt work_opt savepoint_opt
-%type <empty> skip_to_end ddl_skip_to_end
+%type <empty> skip_to_end ddl_skip_to_end routine_skip_to_end
+%type <str> trigger_timing
+%type <triggerEvent> trigger_event
 %type <str> charset
 %type <scope> set_session_or_global
 %type <convertType> convert_type returning_type_opt convert_type_weight_string
@@ -1018,6 +1029,18 @@ create_statement:
   {
     $$ = &ast.CreateView{ViewName: $8.ToViewName(), Comments: ast.Comments($2).Parsed(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
   }
+| CREATE comment_opt replace_opt algorithm_view definer_opt TRIGGER sql_id trigger_timing trigger_event ON table_name FOR routine_skip_to_end
+  {
+    $$ = &ast.CreateTrigger{Comments: ast.Comments($2).Parsed(), Name: $7, Timing: $8, Events: []*ast.TriggerEvent{$9}, Table: $11, ForEachRow: true}
+  }
+| CREATE comment_opt replace_opt algorithm_view definer_opt PROCEDURE table_name '(' routine_skip_to_end
+  {
+    $$ = &ast.CreateFunction{Comments: ast.Comments($2).Parsed(), Procedure: true, Name: $7}
+  }
+| CREATE comment_opt replace_opt algorithm_view definer_opt FUNCTION table_name '(' routine_skip_to_end
+  {
+    $$ = &ast.CreateFunction{Comments: ast.Comments($2).Parsed(), Name: $7}
+  }
 | create_database_prefix create_options_opt
   {
     $1.FullyParsed = true
@@ -3647,6 +3670,14 @@ drop_statement:
   {
     $$ = &ast.DropDatabase{Comments: ast.Comments($2).Parsed(), DBName: $5, IfExists: $4}
   }
+| DROP comment_opt PROCEDURE exists_opt table_name
+  {
+    $$ = &ast.DropFunction{Comments: ast.Comments($2).Parsed(), Procedure: true, IfExists: $4, Name: $5}
+  }
+| DROP comment_opt FUNCTION exists_opt table_name
+  {
+    $$ = &ast.DropFunction{Comments: ast.Comments($2).Parsed(), IfExists: $4, Name: $5}
+  }
 
 truncate_statement:
   TRUNCATE TABLE table_name
@@ -5965,6 +5996,30 @@ cascade_or_local_opt:
     $$ = string($1)
   }
 
+trigger_timing:
+  BEFORE
+  {
+    $$ = ast.TriggerBeforeStr
+  }
+| AFTER
+  {
+    $$ = ast.TriggerAfterStr
+  }
+
+trigger_event:
+  INSERT
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerInsertStr}
+  }
+| UPDATE
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerUpdateStr}
+  }
+| DELETE
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerDeleteStr}
+  }
+
 definer_opt:
   {
     $$ = nil
@@ -6605,6 +6660,7 @@ non_reserved_keyword:
 | AUTO_INCREMENT
 | AUTOEXTEND_SIZE
 | AVG_ROW_LENGTH
+| BEFORE
 | BEGIN
 | BIGINT
 | BIT
@@ -6957,6 +7013,11 @@ skip_to_end:
   skipToEnd(mysqlex)
 }
 
+routine_skip_to_end:
+{
+  skipRoutineToEnd(mysqlex)
+}
+
 ddl_skip_to_end:
   {
     skipToEnd(mysqlex)
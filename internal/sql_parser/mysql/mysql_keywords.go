@@ -95,7 +95,7 @@ var keywords = []cache.Keyword{
 	{Name: "auto_increment", Id: AUTO_INCREMENT},
 	{Name: "autoextend_size", Id: AUTOEXTEND_SIZE},
 	{Name: "avg_row_length", Id: AVG_ROW_LENGTH},
-	{Name: "before", Id: UNUSED},
+	{Name: "before", Id: BEFORE},
 	{Name: "begin", Id: BEGIN},
 	{Name: "between", Id: BETWEEN},
 	{Name: "bigint", Id: BIGINT},
@@ -43,20 +43,27 @@ type MysqlTokenizer struct {
 	BindVars            map[string]struct{}
 
 	lastToken            string
+	expected             []string
 	posVarIndex          int
 	partialDDL           ast.Statement
 	nesting              int
 	multi                bool
 	specialComment       *MysqlTokenizer
 	ignoreCommentKeyword bool
+	pendingType          int
+	pendingVal           string
+	hasPending           bool
+	specialToken         bool
+	delimiter            string // The custom statement delimiter of the DELIMITER command, empty for ';'
+	skipRoutine          bool   // The body of the trigger or the routine is skipped up to the end of the text
 
 	Pos int
-	buf string
+	buf *tokenizer.BytesBuffer
 }
 
 // ResetTo implements tokenizer.Tokenizer.
 func (tkn *MysqlTokenizer) ResetTo(nextPos int) {
-	tkn.buf = strings.Clone(tkn.buf[nextPos:])
+	tkn.buf.ClipFrom(nextPos)
 	tkn.Pos = 0
 }
 
@@ -67,7 +74,7 @@ func (tkn *MysqlTokenizer) GetDialect() dialect.SqlDialect {
 
 // GetText implements tokenizer.Tokenizer.
 func (tkn *MysqlTokenizer) GetText(startPos int) string {
-	return tkn.buf[startPos:tkn.Pos]
+	return tkn.buf.StringAt(startPos, tkn.Pos)
 }
 
 // SetSkipSpecialComments implements tokenizer.Tokenizer.
@@ -155,6 +162,35 @@ func (tkn *MysqlTokenizer) SetPartialDDL(node ast.Statement) {
 	tkn.partialDDL = node
 }
 
+// SetDelimiter implements tokenizer.DelimiterTokenizer.
+func (tkn *MysqlTokenizer) SetDelimiter(delimiter string) {
+	if delimiter == ";" {
+		delimiter = ""
+	}
+	tkn.delimiter = delimiter
+}
+
+// GetDelimiter implements tokenizer.DelimiterTokenizer.
+func (tkn *MysqlTokenizer) GetDelimiter() string {
+	if tkn.delimiter == "" {
+		return ";"
+	}
+	return tkn.delimiter
+}
+
+// isDelimiter returns true if the custom delimiter starts at the current position
+func (tkn *MysqlTokenizer) isDelimiter() bool {
+	if tkn.delimiter == "" {
+		return false
+	}
+	for i := 0; i < len(tkn.delimiter); i++ {
+		if tkn.Peek(i) != rune(tkn.delimiter[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // SetSkipToEnd implements sql_parser.Tokenizer.
 func (tkn *MysqlTokenizer) SetSkipToEnd(skip bool) {
 	tkn.SkipToEnd = skip
@@ -252,11 +288,56 @@ func checkParserVersionFlag() {
 // MySQLVersion is the version of MySQL that the parser would emulate
 var MySQLVersion = "50709" // default version if nothing else is stated
 
+// MariaDB is true if the parser emulates a MariaDB server, the MariaDB
+// specific comments /*M!NNNNNN ... */ are ignored by the MySQL servers
+var MariaDB = false
+
+// SetServerVersion sets the server version the conditional comments are checked against,
+// the version is a server version string like 5.7.44, 8.0.36 or 10.11.6-MariaDB
+func SetServerVersion(version string) error {
+	commentVersion, err := ConvertMySQLVersionToCommentVersion(version)
+	if err != nil {
+		return err
+	}
+	MySQLVersion = commentVersion
+	MariaDB = strings.Contains(strings.ToLower(version), "mariadb")
+	return nil
+}
+
+// serverVersionAtLeast returns true if the emulated server version is higher or equal to
+// the comment version, the versions are compared as numbers because the MariaDB
+// versions have six digits (10.11.6 is 101106)
+func serverVersionAtLeast(commentVersion string) bool {
+	if commentVersion == "" {
+		return true
+	}
+	serverVersion, err := strconv.Atoi(MySQLVersion)
+	if err != nil {
+		return MySQLVersion >= commentVersion
+	}
+	version, err := strconv.Atoi(commentVersion)
+	if err != nil {
+		return MySQLVersion >= commentVersion
+	}
+	return serverVersion >= version
+}
+
 // NewMysqlStringTokenizer creates a new Tokenizer for the
 // sql string.
 func NewMysqlStringTokenizer(sql string) *MysqlTokenizer {
 	checkParserVersionFlag()
 
+	return &MysqlTokenizer{
+		buf:      tokenizer.NewBytesBufferString(sql),
+		BindVars: make(map[string]struct{}),
+	}
+}
+
+// NewBufferedMysqlStringTokenizer creates a new Tokenizer for the
+// BytesBuffer.
+func NewBufferedMysqlStringTokenizer(sql *tokenizer.BytesBuffer) *MysqlTokenizer {
+	checkParserVersionFlag()
+
 	return &MysqlTokenizer{
 		buf:      sql,
 		BindVars: make(map[string]struct{}),
@@ -274,12 +355,19 @@ func (tkn *MysqlTokenizer) Lex(lval *mysqSymType) int {
 		return tkn.skipStatement()
 	}
 
-	typ, val := tkn.Scan()
-	for typ == COMMENT {
-		if tkn.AllowComments {
-			break
+	typ, val := tkn.nextToken()
+	if typ == STRING && tkn.specialToken {
+		// The string of the conditional comment is concatenated with the adjacent
+		// string literals, mysqldump writes SET @@GLOBAL.GTID_PURGED=/*!80000 '+'*/ 'gtid set'
+		for {
+			nextTyp, nextVal := tkn.nextToken()
+			if nextTyp != STRING {
+				tkn.pendingType, tkn.pendingVal = nextTyp, nextVal
+				tkn.hasPending = true
+				break
+			}
+			val += nextVal
 		}
-		typ, val = tkn.Scan()
 	}
 	if typ == 0 || typ == ';' || typ == LEX_ERROR {
 		// If encounter end of statement or invalid token,
@@ -293,23 +381,34 @@ func (tkn *MysqlTokenizer) Lex(lval *mysqSymType) int {
 	return typ
 }
 
-// PositionedErr holds context related to parser errors
-type PositionedErr struct {
-	Err  string
-	Pos  int
-	Near string
+// nextToken returns the token kept by the lookahead or scans the next one skipping the comments
+func (tkn *MysqlTokenizer) nextToken() (int, string) {
+	if tkn.hasPending {
+		tkn.hasPending = false
+		return tkn.pendingType, tkn.pendingVal
+	}
+	typ, val := tkn.Scan()
+	for typ == COMMENT {
+		if tkn.AllowComments {
+			break
+		}
+		typ, val = tkn.Scan()
+	}
+	return typ, val
 }
 
-func (p PositionedErr) Error() string {
-	if p.Near != "" {
-		return fmt.Sprintf("%s at position %v near '%s'", p.Err, p.Pos, p.Near)
-	}
-	return fmt.Sprintf("%s at position %v", p.Err, p.Pos)
+// PositionedErr holds context related to parser errors
+type PositionedErr = tokenizer.PositionedErr
+
+// SetExpectedTokens is called by go yacc before the Error with the tokens expected by the parser.
+func (tkn *MysqlTokenizer) SetExpectedTokens(tokens []string) {
+	tkn.expected = tokens
 }
 
 // Error is called by go yacc if there's a parsing error.
 func (tkn *MysqlTokenizer) Error(err string) {
-	tkn.LastError = PositionedErr{Err: err, Pos: tkn.Pos + 1, Near: tkn.lastToken}
+	tkn.LastError = PositionedErr{Err: err, Pos: tkn.Pos + 1, Near: tkn.lastToken, Expected: tkn.expected}
+	tkn.expected = nil
 
 	// Try and re-sync to the next statement
 	tkn.skipStatement()
@@ -318,6 +417,7 @@ func (tkn *MysqlTokenizer) Error(err string) {
 // Scan scans the tokenizer for the next token and returns
 // the token type and an optional value.
 func (tkn *MysqlTokenizer) Scan() (int, string) {
+	tkn.specialToken = false
 	if tkn.specialComment != nil {
 		// Enter specialComment scan mode.
 		// for scanning such kind of comment: /*! MySQL-specific code */
@@ -325,6 +425,7 @@ func (tkn *MysqlTokenizer) Scan() (int, string) {
 		tok, val := specialComment.Scan()
 		if tok != 0 {
 			// return the specialComment scan result as the result
+			tkn.specialToken = true
 			return tok, val
 		}
 		// leave specialComment scan mode after all stream consumed.
@@ -332,6 +433,10 @@ func (tkn *MysqlTokenizer) Scan() (int, string) {
 	}
 
 	tkn.SkipBlank()
+	if tkn.isDelimiter() {
+		tkn.Skip(len(tkn.delimiter))
+		return ';', ""
+	}
 	switch ch := tkn.Cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -388,6 +493,9 @@ func (tkn *MysqlTokenizer) Scan() (int, string) {
 			return 0, ""
 		}
 		tkn.Skip(1)
+		if tkn.delimiter != "" {
+			return tokenizer.SemicolonChar, ""
+		}
 		return ';', ""
 	case ch == tokenizer.EofChar:
 		return 0, ""
@@ -431,6 +539,10 @@ func (tkn *MysqlTokenizer) Scan() (int, string) {
 					tkn.Skip(1)
 					return tkn.scanMySQLSpecificComment()
 				}
+				if tkn.Cur() == 'M' && tkn.Peek(1) == '!' && !tkn.SkipSpecialComments {
+					tkn.Skip(2)
+					return tkn.scanMariaDBSpecificComment()
+				}
 				return tkn.scanCommentType2()
 			default:
 				return int(ch), ""
@@ -504,9 +616,15 @@ func (tkn *MysqlTokenizer) Scan() (int, string) {
 // skipStatement scans until end of statement.
 func (tkn *MysqlTokenizer) skipStatement() int {
 	tkn.SkipToEnd = false
+	if tkn.hasPending {
+		tkn.hasPending = false
+		if typ := tkn.pendingType; typ == 0 || typ == ';' && !tkn.skipRoutine || typ == LEX_ERROR {
+			return typ
+		}
+	}
 	for {
 		typ, _ := tkn.Scan()
-		if typ == 0 || typ == ';' || typ == LEX_ERROR {
+		if typ == 0 || typ == ';' && !tkn.skipRoutine || typ == LEX_ERROR {
 			return typ
 		}
 	}
@@ -528,12 +646,12 @@ func (tkn *MysqlTokenizer) scanIdentifier(isVariable bool) (int, string) {
 
 	for {
 		ch := tkn.Cur()
-		if !isLetter(ch) && !isDigit(ch) && !(isVariable && isCarat(ch)) {
+		if !isLetter(ch) && !isDigit(ch) && !(isVariable && isCarat(ch)) || tkn.isDelimiter() {
 			break
 		}
 		tkn.Skip(1)
 	}
-	keywordName := tkn.buf[start:tkn.Pos]
+	keywordName := tkn.buf.StringAt(start, tkn.Pos)
 	if keywordID, found := cache.KeywordLookup(keywordName, dialect.MYSQL); found {
 		return keywordID, keywordName
 	}
@@ -548,7 +666,7 @@ func (tkn *MysqlTokenizer) scanIdentifier(isVariable bool) (int, string) {
 func (tkn *MysqlTokenizer) scanHex() (int, string) {
 	start := tkn.Pos
 	tkn.scanMantissa(16)
-	hex := tkn.buf[start:tkn.Pos]
+	hex := tkn.buf.StringAt(start, tkn.Pos)
 	if tkn.Cur() != '\'' {
 		return LEX_ERROR, hex
 	}
@@ -563,7 +681,7 @@ func (tkn *MysqlTokenizer) scanHex() (int, string) {
 func (tkn *MysqlTokenizer) scanBitLiteral() (int, string) {
 	start := tkn.Pos
 	tkn.scanMantissa(2)
-	bit := tkn.buf[start:tkn.Pos]
+	bit := tkn.buf.StringAt(start, tkn.Pos)
 	if tkn.Cur() != '\'' {
 		return LEX_ERROR, bit
 	}
@@ -617,16 +735,16 @@ func (tkn *MysqlTokenizer) scanLiteralIdentifier() (int, string) {
 					return LEX_ERROR, ""
 				}
 				tkn.Skip(1)
-				return ID, tkn.buf[start : tkn.Pos-1]
+				return ID, tkn.buf.StringAt(start, tkn.Pos-1)
 			}
 
 			var buf strings.Builder
-			buf.WriteString(tkn.buf[start:tkn.Pos])
+			buf.WriteString(tkn.buf.StringAt(start, tkn.Pos))
 			tkn.Skip(1)
 			return tkn.scanLiteralIdentifierSlow(&buf)
 		case tokenizer.EofChar:
 			// Premature EOF.
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		default:
 			tkn.Skip(1)
 		}
@@ -644,7 +762,7 @@ func (tkn *MysqlTokenizer) scanBindVar() (int, string) {
 		tkn.Skip(1)
 	}
 	if !isLetter(tkn.Cur()) {
-		return LEX_ERROR, tkn.buf[start:tkn.Pos]
+		return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 	}
 	for {
 		ch := tkn.Cur()
@@ -653,7 +771,7 @@ func (tkn *MysqlTokenizer) scanBindVar() (int, string) {
 		}
 		tkn.Skip(1)
 	}
-	return token, tkn.buf[start:tkn.Pos]
+	return token, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanMantissa scans a sequence of numeric characters with the same base.
@@ -706,24 +824,24 @@ exponent:
 	}
 
 exit:
-	if isLetter(tkn.Cur()) {
+	if isLetter(tkn.Cur()) && !tkn.isDelimiter() {
 		// A letter cannot immediately follow a float number.
 		if token == FLOAT || token == DECIMAL {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		// A letter seen after a few numbers means that we should parse this
 		// as an identifier and not a number.
 		for {
 			ch := tkn.Cur()
-			if !isLetter(ch) && !isDigit(ch) {
+			if !isLetter(ch) && !isDigit(ch) || tkn.isDelimiter() {
 				break
 			}
 			tkn.Skip(1)
 		}
-		return ID, tkn.buf[start:tkn.Pos]
+		return ID, tkn.buf.StringAt(start, tkn.Pos)
 	}
 
-	return token, tkn.buf[start:tkn.Pos]
+	return token, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanString scans a string surrounded by the given `delim`, which can be
@@ -738,17 +856,17 @@ func (tkn *MysqlTokenizer) scanString(delim rune, typ int) (int, string) {
 		case delim:
 			if tkn.Peek(1) != delim {
 				tkn.Skip(1)
-				return typ, tkn.buf[start : tkn.Pos-1]
+				return typ, tkn.buf.StringAt(start, tkn.Pos-1)
 			}
 			fallthrough
 
 		case '\\':
 			var buffer strings.Builder
-			buffer.WriteString(tkn.buf[start:tkn.Pos])
+			buffer.WriteString(tkn.buf.StringAt(start, tkn.Pos))
 			return tkn.scanStringSlow(&buffer, delim, typ)
 
 		case tokenizer.EofChar:
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 
 		tkn.Skip(1)
@@ -769,15 +887,15 @@ func (tkn *MysqlTokenizer) scanStringSlow(buffer *strings.Builder, delim rune, t
 		if ch != delim && ch != '\\' {
 			// Scan ahead to the next interesting character.
 			start := tkn.Pos
-			for ; tkn.Pos < len(tkn.buf); tkn.Pos++ {
-				ch = rune(tkn.buf[tkn.Pos])
+			for ; tkn.Pos < tkn.buf.Size(); tkn.Pos++ {
+				ch = tkn.buf.RuneAt(tkn.Pos)
 				if ch == delim || ch == '\\' {
 					break
 				}
 			}
 
-			buffer.WriteString(tkn.buf[start:tkn.Pos])
-			if tkn.Pos >= len(tkn.buf) {
+			buffer.WriteString(tkn.buf.StringAt(start, tkn.Pos))
+			if tkn.Pos >= tkn.buf.Size() {
 				// Reached the end of the buffer without finding a delim or
 				// escape character.
 				tkn.Skip(1)
@@ -820,7 +938,7 @@ func (tkn *MysqlTokenizer) scanCommentType1(prefixLen int) (int, string) {
 		}
 		tkn.Skip(1)
 	}
-	return COMMENT, tkn.buf[start:tkn.Pos]
+	return COMMENT, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanCommentType2 scans a '/*' delimited comment; assumes the opening
@@ -837,11 +955,11 @@ func (tkn *MysqlTokenizer) scanCommentType2() (int, string) {
 			continue
 		}
 		if tkn.Cur() == tokenizer.EofChar {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		tkn.Skip(1)
 	}
-	return COMMENT, tkn.buf[start:tkn.Pos]
+	return COMMENT, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
@@ -857,16 +975,46 @@ func (tkn *MysqlTokenizer) scanMySQLSpecificComment() (int, string) {
 			continue
 		}
 		if tkn.Cur() == tokenizer.EofChar {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		tkn.Skip(1)
 	}
 
-	commentVersion, sql := ExtractMysqlComment(tkn.buf[start:tkn.Pos])
+	commentVersion, sql := ExtractMysqlComment(tkn.buf.StringAt(start, tkn.Pos))
 
-	if MySQLVersion >= commentVersion {
+	if serverVersionAtLeast(commentVersion) {
 		// Only add the special comment to the tokenizer if the version of MySQL is higher or equal to the comment version
 		tkn.specialComment = NewMysqlStringTokenizer(sql)
+		tkn.specialComment.delimiter = tkn.delimiter
+	}
+
+	return tkn.Scan()
+}
+
+// scanMariaDBSpecificComment scans a MariaDB comment pragma, which always starts with '/*M!`
+func (tkn *MysqlTokenizer) scanMariaDBSpecificComment() (int, string) {
+	start := tkn.Pos - 4
+	for {
+		if tkn.Cur() == '*' {
+			tkn.Skip(1)
+			if tkn.Cur() == '/' {
+				tkn.Skip(1)
+				break
+			}
+			continue
+		}
+		if tkn.Cur() == tokenizer.EofChar {
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
+		}
+		tkn.Skip(1)
+	}
+
+	commentVersion, sql := ExtractMariaDBComment(tkn.buf.StringAt(start, tkn.Pos))
+
+	if MariaDB && serverVersionAtLeast(commentVersion) {
+		// Only the MariaDB servers execute the MariaDB specific comments
+		tkn.specialComment = NewMysqlStringTokenizer(sql)
+		tkn.specialComment.delimiter = tkn.delimiter
 	}
 
 	return tkn.Scan()
@@ -881,10 +1029,10 @@ func (tkn *MysqlTokenizer) Skip(dist int) {
 }
 
 func (tkn *MysqlTokenizer) Peek(dist int) rune {
-	if tkn.Pos+dist >= len(tkn.buf) {
+	if tkn.Pos+dist >= tkn.buf.Size() {
 		return tokenizer.EofChar
 	}
-	return rune(tkn.buf[tkn.Pos+dist])
+	return tkn.buf.RuneAt(tkn.Pos + dist)
 }
 
 // Reset clears any internal state.
@@ -892,9 +1040,11 @@ func (tkn *MysqlTokenizer) Reset() {
 	tkn.ParseTree = nil
 	tkn.partialDDL = nil
 	tkn.specialComment = nil
+	tkn.hasPending = false
 	tkn.posVarIndex = 0
 	tkn.nesting = 0
 	tkn.SkipToEnd = false
+	tkn.skipRoutine = false
 }
 
 func isLetter(ch rune) bool {
@@ -942,3 +1092,26 @@ func ExtractMysqlComment(sql string) (string, string) {
 
 	return version, innerSQL
 }
+
+// ExtractMariaDBComment extracts the version and SQL from a MariaDB comment-only query
+// such as /*M!100616 sql here */
+func ExtractMariaDBComment(sql string) (string, string) {
+	sql = sql[4 : len(sql)-2]
+
+	endOfVersionIndex := strings.IndexFunc(sql, func(c rune) bool {
+		return !unicode.IsDigit(c)
+	})
+	if endOfVersionIndex < 0 {
+		endOfVersionIndex = len(sql)
+	}
+	if endOfVersionIndex > 6 {
+		endOfVersionIndex = 6
+	}
+	if endOfVersionIndex < 5 {
+		endOfVersionIndex = 0
+	}
+	version := sql[0:endOfVersionIndex]
+	innerSQL := strings.TrimFunc(sql[endOfVersionIndex:], unicode.IsSpace)
+
+	return version, innerSQL
+}
@@ -0,0 +1,53 @@
+package sql_parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// ParseMode defines which statements from the stream are parsed by the full grammar
+type ParseMode uint8
+
+const (
+	PARSE_ALL  ParseMode = 0 // Parse every statement
+	PARSE_DDL  ParseMode = 1 // Parse everything except data statements (INSERT, UPDATE, DELETE, COPY ...)
+	PARSE_NONE ParseMode = 2 // Only split the stream into statements, don't parse anything
+)
+
+func (parseMode ParseMode) String() string {
+	switch parseMode {
+	case PARSE_ALL:
+		return "all"
+	case PARSE_DDL:
+		return "ddl"
+	case PARSE_NONE:
+		return "none"
+	}
+	return "undefined"
+}
+
+// ParseParseMode converts cli option value (none|ddl|all) to the ParseMode
+func ParseParseMode(value string) (ParseMode, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "all", "":
+		return PARSE_ALL, nil
+	case "ddl":
+		return PARSE_DDL, nil
+	case "none":
+		return PARSE_NONE, nil
+	}
+	return PARSE_ALL, fmt.Errorf("unknown parse mode: %v, expected one of none|ddl|all", value)
+}
+
+// NeedParse returns true if the statement text has to be parsed in the mode
+func (parseMode ParseMode) NeedParse(statementText string) bool {
+	switch parseMode {
+	case PARSE_NONE:
+		return false
+	case PARSE_DDL:
+		return !ast.IsDataLoad(statementText)
+	}
+	return true
+}
@@ -138,18 +138,52 @@ func bindVariable(psqlex psqLexer, bvar string) {
   alterTable       *ast.AlterTable
   tableOption      *ast.TableOption
   columnTypeOptions *ast.ColumnTypeOptions
+  columnTypePtr     *ast.ColumnType
   createSequence    *ast.CreateSequence
   alterSequence     *ast.AlterSequence
   constraintDefinition *ast.ConstraintDefinition
   revertMigration   *ast.RevertMigration
   alterMigration    *ast.AlterMigration
   alterSchema       *ast.AlterSchema
+  createIndex       *ast.CreateIndex
+  indexElement      *ast.IndexElement
+  storageParameter  *ast.StorageParameter
+  createFunction    *ast.CreateFunction
+  functionArgument  *ast.FunctionArgument
+  functionReturns   *ast.FunctionReturns
+  functionOption    *ast.FunctionOption
+  createTrigger     *ast.CreateTrigger
+  triggerEvent      *ast.TriggerEvent
+  createExtension   *ast.CreateExtension
+  createType        *ast.CreateType
+  createDomain      *ast.CreateDomain
+  domainConstraint  *ast.DomainConstraint
+  grant             *ast.Grant
+  createPolicy      *ast.CreatePolicy
+  createPublication *ast.CreatePublication
+  publicationTable  *ast.PublicationTable
+  grantPrivilege    *ast.GrantPrivilege
+  privilegeObject   *ast.PrivilegeObject
+  roleName          *ast.RoleName
+  partitionOption   *ast.PartitionOption
+  partitionBound    *ast.PartitionValueRange
+  partitionByType   ast.PartitionByType
   trimType          ast.TrimType
 
   whens         []*ast.When
   columnDefinitions []*ast.ColumnDefinition
   indexOptions  []*ast.IndexOption
   indexColumns  []*ast.IndexColumn
+  indexElements []*ast.IndexElement
+  storageParameters ast.StorageParameters
+  functionArguments []*ast.FunctionArgument
+  functionOptions   []*ast.FunctionOption
+  triggerEvents     []*ast.TriggerEvent
+  grantPrivileges   []*ast.GrantPrivilege
+  privilegeObjects  []*ast.PrivilegeObject
+  roleNames         []*ast.RoleName
+  tableIdents       []ast.TableIdent
+  statements        []ast.Statement
   databaseOptions []ast.DatabaseOption
   tableAndLockTypes ast.TableAndLockTypes
   renameTablePairs []*ast.RenameTablePair
@@ -282,7 +316,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %left <str> ON USING INPLACE COPY INSTANT NONE SHARED EXCLUSIVE
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
-%token <str> ID AT_ID AT_AT_ID HEX STRING NCHAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM VALUE_ARG LIST_ARG COMMENT_KEYWORD BIT_LITERAL COMPRESSION
+%token <str> ID AT_ID AT_AT_ID HEX STRING NCHAR_STRING DOLLAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM VALUE_ARG LIST_ARG COMMENT_KEYWORD BIT_LITERAL COMPRESSION
 %token <str> JSON_PRETTY JSON_STORAGE_SIZE JSON_STORAGE_FREE JSON_CONTAINS JSON_CONTAINS_PATH JSON_EXTRACT JSON_KEYS JSON_OVERLAPS JSON_SEARCH JSON_VALUE
 %token <str> EXTRACT
 %token <str> NULL TRUE FALSE OFF
@@ -307,7 +341,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %left <str> AND
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
-%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN
+%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN POSIX_IMATCH NOT_POSIX_MATCH NOT_POSIX_IMATCH
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
 %left <str> '+' '-'
@@ -321,6 +355,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %right <str> UNDERSCORE_GREEK UNDERSCORE_HEBREW UNDERSCORE_HP8 UNDERSCORE_KEYBCS2 UNDERSCORE_KOI8R UNDERSCORE_KOI8U UNDERSCORE_LATIN1 UNDERSCORE_LATIN2 UNDERSCORE_LATIN5
 %right <str> UNDERSCORE_LATIN7 UNDERSCORE_MACCE UNDERSCORE_MACROMAN UNDERSCORE_SJIS UNDERSCORE_SWE7 UNDERSCORE_TIS620 UNDERSCORE_UCS2 UNDERSCORE_UJIS UNDERSCORE_UTF16
 %right <str> UNDERSCORE_UTF16LE UNDERSCORE_UTF32 UNDERSCORE_UTF8 UNDERSCORE_UTF8MB4 UNDERSCORE_UTF8MB3
+%left <str> TYPECAST
 %nonassoc <str> '.'
 
 // There is no need to define precedence for the JSON
@@ -414,6 +449,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <statement> execute_statement deallocate_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement comment_statement
+%type <statement> grant_statement revoke_statement default_privileges_action
 %type <statement> copy_statement
 %type <with> with_clause_opt with_clause
 %type <cte> common_table_expr
@@ -424,12 +460,81 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <alterSequence> alter_sequence_prefix
 %type <renameTablePairs> rename_list
 %type <alterOptions> alter_schema_commands_list
+%type <alterOption> alter_owner
 %type <sequenceName> sequence_name
 %type <createTable> create_table_prefix
 %type <alterTable> alter_table_prefix
 %type <alterOption> alter_option alter_commands_modifier
 %type <alterOptions> alter_options alter_commands_list alter_commands_modifier_list
-%type <alterTable> create_index_prefix
+%type <createIndex> create_index_prefix
+%type <indexElement> index_element index_element_options index_opclass_opt
+%type <indexElements> index_element_list
+%type <storageParameter> storage_parameter
+%type <storageParameters> storage_parameter_list storage_parameters_opt storage_parameters_paren_opt
+%type <str> storage_parameter_value signed_integer
+%type <boolean> unique_opt concurrently_opt
+%type <colIdent> index_name_opt index_method_opt
+%type <columns> index_include_opt
+%type <str> index_nulls_opt
+%type <tableIdent> index_tablespace_opt
+%type <createFunction> create_function_prefix
+%type <boolean> function_or_procedure
+%type <functionArguments> function_arguments_opt function_argument_list_opt function_argument_list function_table_column_list
+%type <functionArgument> function_argument function_table_column
+%type <integer> function_argument_mode_opt
+%type <expr> function_argument_default_opt
+%type <functionReturns> function_returns
+%type <functionOptions> function_option_list
+%type <functionOption> function_option
+%type <str> function_body function_set_value function_set_value_list function_number
+%type <createTrigger> create_trigger_prefix
+%type <str> trigger_timing
+%type <triggerEvents> trigger_event_list
+%type <triggerEvent> trigger_event
+%type <boolean> trigger_for_each_opt
+%type <expr> trigger_when_opt
+%type <createExtension> create_extension
+%type <colIdent> extension_name
+%type <empty> extension_with_opt
+%type <str> extension_version
+%type <createType> create_type
+%type <strs> type_enum_labels_opt
+%type <columnDefinitions> type_attribute_list
+%type <columnDefinition> type_attribute
+%type <storageParameters> type_range_parameter_list
+%type <storageParameter> type_range_parameter
+%type <createDomain> create_domain
+%type <domainConstraint> domain_constraint
+%type <strs> comment_on_prefix
+%type <str> comment_object_type owned_object_type
+%type <expr> comment_value
+%type <grant> grant_target
+%type <str> grant_object_type grant_objects_type
+%type <grantPrivileges> grant_privilege_list
+%type <grantPrivilege> grant_privilege
+%type <str> grant_privilege_type
+%type <privilegeObjects> privilege_object_list
+%type <privilegeObject> privilege_object
+%type <roleNames> role_name_list default_privileges_roles_opt
+%type <roleName> role_name
+%type <boolean> with_grant_option_opt grant_option_for_opt revoke_cascade_opt
+%type <tableIdents> default_privileges_schemas_opt schema_id_list
+%type <createPolicy> create_policy
+%type <boolean> policy_as_opt
+%type <str> policy_for_opt
+%type <roleNames> policy_to_opt
+%type <expr> policy_using_opt policy_check_opt
+%type <createPublication> create_publication publication_for_opt publication_objects publication_tables_tail publication_schemas_tail
+%type <publicationTable> publication_table
+%type <str> publication_action
+%type <statement> create_rule rule_action refresh_statement
+%type <boolean> with_data_opt
+%type <partitionOption> partition_by_opt
+%type <partitionByType> partition_by_type
+%type <partitionBound> partition_bound
+%type <str> rule_event
+%type <boolean> rule_instead_opt
+%type <statements> rule_actions rule_action_list
 %type <createDatabase> create_database_prefix
 %type <alterDatabase> alter_database_prefix
 %type <databaseOption> collate character_set encryption
@@ -452,7 +557,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <selectExpr> select_expression
 %type <strs> select_options flush_option_list
 %type <str> select_option security_view security_view_opt
-%type <str> generated_always_opt user_username address_opt
+%type <str> identity_kind time_zone_opt user_username address_opt
 %type <definer> definer_opt user
 %type <expr> expression signed_literal signed_literal_or_null null_as_literal now_or_signed_literal signed_literal bit_expr simple_expr literal NUM_literal text_literal text_literal_or_arg bool_pri literal_or_null now predicate tuple_expression
 %type <tableExprs> from_opt table_references from_clause
@@ -523,9 +628,10 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <sequenceIdent> reserved_sequence_id
 %type <scope> set_session_or_global
 %type <convertType> convert_type returning_type_opt convert_type_weight_string
-%type <columnType> column_type
+%type <columnTypePtr> cast_type
+%type <columnType> column_type user_defined_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type spatial_type
-%type <literal> length_opt varying_opt with_timezone_opt
+%type <literal> length_opt varying_opt
 %type <expr> func_datetime_precision
 %type <columnCharset> charset_opt
 %type <str> collate_opt
@@ -549,10 +655,10 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <indexColumn> index_column
 %type <indexColumns> index_column_list
 %type <indexOption> index_option using_index_type
-%type <indexOptions> index_option_list index_option_list_opt using_opt
+%type <indexOptions> index_option_list index_option_list_opt
 %type <constraintInfo> constraint_info check_constraint_info
 %type <vindexParam> vindex_param
-%type <sequenceSpec> sequence_spec
+%type <sequenceSpec> sequence_spec identity_sequence_opt
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <jsonPathParam> json_path_param
 %type <jsonPathParams> json_path_param_list json_path_param_list_opt
@@ -575,7 +681,7 @@ func bindVariable(psqlex psqLexer, bvar string) {
 %type <copyToTarget> copy_to
 %type <copyOptions> copy_option_list copy_option_list_opt
 %type <copyOption> copy_option
-%type <str> error_action
+%type <str> error_action copy_format
 %start any_command
 
 %%
@@ -599,6 +705,8 @@ command:
   {
     $$ = $1
   }
+| grant_statement
+| revoke_statement
 | stream_statement
 | vstream_statement
 | insert_statement
@@ -611,6 +719,7 @@ command:
 | rename_statement
 | drop_statement
 | truncate_statement
+| refresh_statement
 | analyze_statement
 | show_statement
 | use_statement
@@ -927,7 +1036,7 @@ copy_option_list:
   }
 
 copy_option:
-  FORMAT ID
+  FORMAT copy_format
   {
     $$ = ast.CopyOption{Type: ast.CopyOptionFormat, Value: $2}
   }
@@ -965,15 +1074,15 @@ copy_option:
   }
 | FORCE_QUOTE column_list_opt
   {
-    $$ = ast.CopyOption{Type: ast.CopyOptionForceQuote}
+    $$ = ast.CopyOption{Type: ast.CopyOptionForceQuote, Columns: $2}
   }
 | FORCE_NOT_NULL column_list_opt
   {
-    $$ = ast.CopyOption{Type: ast.CopyOptionForceNotNull}
+    $$ = ast.CopyOption{Type: ast.CopyOptionForceNotNull, Columns: $2}
   }
 | FORCE_NULL column_list_opt
   {
-    $$ = ast.CopyOption{Type: ast.CopyOptionForceNull}
+    $$ = ast.CopyOption{Type: ast.CopyOptionForceNull, Columns: $2}
   }
 | ON_ERROR error_action
   {
@@ -988,6 +1097,13 @@ copy_option:
     $$ = ast.CopyOption{Type: ast.CopyOptionHeaderLogVerbosity, Value: $2}
   }
 
+copy_format:
+  ID
+| TEXT
+| CSV
+| BINARY
+| STRING
+
 error_action:
   {
     $$ = "stop"
@@ -1167,6 +1283,12 @@ create_statement:
     $1.FullyParsed = true
     $$ = $1
   }
+| create_table_prefix PARTITION OF table_name partition_bound partition_by_opt
+  {
+    $1.TableSpec = &ast.TableSpec{PartitionOf: $4, PartitionBound: $5, PartitionOption: $6}
+    $1.FullyParsed = true
+    $$ = $1
+  }
 | create_table_prefix create_like
   {
     // Create table [name] like [name]
@@ -1174,18 +1296,65 @@ create_statement:
     $1.FullyParsed = true
     $$ = $1
   }
-| create_index_prefix '(' index_column_list ')' index_option_list_opt
+| create_index_prefix '(' index_element_list ')' index_include_opt storage_parameters_opt index_tablespace_opt where_expression_opt
   {
-    indexDef := $1.AlterOptions[0].(*ast.AddIndexDefinition).IndexDefinition
-    indexDef.Columns = $3
-    indexDef.Options = append(indexDef.Options,$5...)
+    $1.Columns = $3
+    $1.Include = $5
+    $1.Parameters = $6
+    $1.Tablespace = $7
+    $1.Where = ast.NewWhere(ast.WhereClause, $8)
     $1.FullyParsed = true
     $$ = $1
   }
+| create_function_prefix function_option_list
+  {
+    $1.Options = $2
+    $$ = $1
+  }
+| create_function_prefix function_returns function_option_list
+  {
+    $1.Returns = $2
+    $1.Options = $3
+    $$ = $1
+  }
+| create_trigger_prefix trigger_for_each_opt trigger_when_opt EXECUTE function_or_procedure table_name openb expression_list_opt closeb
+  {
+    $1.ForEachRow = $2
+    $1.When = $3
+    $1.Procedure = $5
+    $1.Function = $6
+    $1.Arguments = $8
+    $$ = $1
+  }
+| create_extension
+  {
+    $$ = $1
+  }
+| create_type
+  {
+    $$ = $1
+  }
+| create_domain
+  {
+    $$ = $1
+  }
+| create_policy
+  {
+    $$ = $1
+  }
+| create_publication
+  {
+    $$ = $1
+  }
+| create_rule
 | CREATE comment_opt replace_opt definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
     $$ = &ast.CreateView{ViewName: $7.ToViewName(), Comments: ast.Comments($2).Parsed(), IsReplace:$3, Definer: $4 ,Security:$5, Columns:$8, Select: $10, CheckOption: $11 }
   }
+| CREATE comment_opt MATERIALIZED VIEW not_exists_opt table_name column_list_opt index_method_opt storage_parameters_opt index_tablespace_opt AS select_statement with_data_opt
+  {
+    $$ = &ast.CreateMaterializedView{Comments: ast.Comments($2).Parsed(), IfNotExists: $5, ViewName: $6, Columns: $7, Method: $8, Parameters: $9, Tablespace: $10, Select: $12, WithData: $13}
+  }
 | create_database_prefix create_options_opt
   {
     $1.FullyParsed = true
@@ -1297,2566 +1466,4237 @@ alter_table_prefix:
   }
 
 create_index_prefix:
-  CREATE comment_opt INDEX id_or_var using_opt ON table_name
+  CREATE comment_opt unique_opt INDEX concurrently_opt not_exists_opt index_name_opt ON only_opt table_name index_method_opt
   {
-    $$ = &ast.AlterTable{Table: $7, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$4, Type:string($3)}, Options:$5}}}}
+    $$ = &ast.CreateIndex{Comments: ast.Comments($2).Parsed(), Unique: $3, Concurrently: $5, IfNotExists: $6, Name: $7, Only: $9, Table: $10, Method: $11}
     setDDL(psqlex, $$)
   }
-| CREATE comment_opt FULLTEXT INDEX id_or_var using_opt ON table_name
+
+create_function_prefix:
+  CREATE comment_opt replace_opt function_or_procedure table_name openb function_argument_list_opt closeb
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Fulltext:true}, Options:$6}}}}
-    setDDL(psqlex, $$)
+    $$ = &ast.CreateFunction{Comments: ast.Comments($2).Parsed(), IsReplace: $3, Procedure: $4, Name: $5, Arguments: $7}
   }
-| CREATE comment_opt SPATIAL INDEX id_or_var using_opt ON table_name
+
+function_or_procedure:
+  FUNCTION
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Spatial:true}, Options:$6}}}}
-    setDDL(psqlex, $$)
+    $$ = false
   }
-| CREATE comment_opt UNIQUE INDEX id_or_var using_opt ON table_name
+| PROCEDURE
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Unique:true}, Options:$6}}}}
-    setDDL(psqlex, $$)
+    $$ = true
   }
 
-create_sequence_prefix:
-  CREATE comment_opt sequence_name
+function_arguments_opt:
   {
-    $$ = &ast.CreateSequence{Comments: ast.Comments($2).Parsed(), Sequence: $3}
-    setDDL(psqlex, $$)
+    $$ = nil
   }
-
-alter_sequence_prefix:
-  ALTER comment_opt sequence_name
+| openb function_argument_list_opt closeb
   {
-    $$ = &ast.AlterSequence{Comments: ast.Comments($2).Parsed(), Sequence: $3}
-    setDDL(psqlex, $$)
+    $$ = $2
   }
 
-create_database_prefix:
-  CREATE comment_opt database comment_opt not_exists_opt table_id
+function_argument_list_opt:
   {
-    $$ = &ast.CreateDatabase{Comments: ast.Comments($4).Parsed(), DBName: $6, IfNotExists: $5}
-    setDDL(psqlex,$$)
+    $$ = []*ast.FunctionArgument{}
   }
-
-alter_database_prefix:
-  ALTER comment_opt database
+| function_argument_list
   {
-    $$ = &ast.AlterDatabase{}
-    setDDL(psqlex,$$)
+    $$ = $1
   }
 
-database:
-  DATABASE
-
-table_spec:
-  '(' table_column_list ')' table_option_list_opt
+function_argument_list:
+  function_argument
   {
-    $$ = $2
-    $$.Options = $4
+    $$ = []*ast.FunctionArgument{$1}
+  }
+| function_argument_list ',' function_argument
+  {
+    $$ = append($1, $3)
   }
 
-create_options_opt:
+function_argument:
+  function_argument_mode_opt cast_type function_argument_default_opt
   {
-    $$ = nil
+    $$ = &ast.FunctionArgument{Mode: ast.FunctionArgumentMode($1), Type: $2, Default: $3}
   }
-| create_options
+| function_argument_mode_opt sql_id cast_type function_argument_default_opt
   {
-    $$ = $1
+    $$ = &ast.FunctionArgument{Mode: ast.FunctionArgumentMode($1), Name: $2, Type: $3, Default: $4}
   }
 
-create_options:
-  character_set
+function_argument_mode_opt:
   {
-    $$ = []ast.DatabaseOption{$1}
+    $$ = int(ast.FunctionArgumentDefault)
   }
-| collate
+| IN
   {
-    $$ = []ast.DatabaseOption{$1}
+    $$ = int(ast.FunctionArgumentIn)
   }
-| encryption
+| OUT
   {
-    $$ = []ast.DatabaseOption{$1}
+    $$ = int(ast.FunctionArgumentOut)
   }
-| create_options collate
+| INOUT
   {
-    $$ = append($1,$2)
+    $$ = int(ast.FunctionArgumentInOut)
   }
-| create_options character_set
+| IN OUT
   {
-    $$ = append($1,$2)
+    $$ = int(ast.FunctionArgumentInOut)
   }
-| create_options encryption
+| VARIADIC
   {
-    $$ = append($1,$2)
+    $$ = int(ast.FunctionArgumentVariadic)
   }
 
-default_optional:
-  /* empty */ %prec LOWER_THAN_CHARSET
+function_argument_default_opt:
   {
-    $$ = false
+    $$ = nil
   }
-| DEFAULT
+| DEFAULT expression
   {
-    $$ = true
+    $$ = $2
+  }
+| '=' expression
+  {
+    $$ = $2
   }
 
-character_set:
-  default_optional charset_or_character_set equal_opt id_or_var
+function_returns:
+  RETURNS cast_type
   {
-    $$ = ast.DatabaseOption{Type:ast.CharacterSetType, Value:($4.String()), IsDefault:$1}
+    $$ = &ast.FunctionReturns{Type: $2}
   }
-| default_optional charset_or_character_set equal_opt STRING
+| RETURNS SETOF cast_type
   {
-    $$ = ast.DatabaseOption{Type:ast.CharacterSetType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
+    $$ = &ast.FunctionReturns{SetOf: true, Type: $3}
   }
-
-collate:
-  default_optional COLLATE equal_opt id_or_var
+| RETURNS TRIGGER
   {
-    $$ = ast.DatabaseOption{Type:ast.CollateType, Value:($4.String()), IsDefault:$1}
+    $$ = &ast.FunctionReturns{Type: &ast.ColumnType{Type: "trigger"}}
   }
-| default_optional COLLATE equal_opt STRING
+| RETURNS TABLE openb function_table_column_list closeb
   {
-    $$ = ast.DatabaseOption{Type:ast.CollateType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
+    $$ = &ast.FunctionReturns{Table: $4}
   }
 
-encryption:
-  default_optional ENCRYPTION equal_opt id_or_var
+function_table_column_list:
+  function_table_column
   {
-    $$ = ast.DatabaseOption{Type:ast.EncryptionType, Value:($4.String()), IsDefault:$1}
+    $$ = []*ast.FunctionArgument{$1}
   }
-| default_optional ENCRYPTION equal_opt STRING
+| function_table_column_list ',' function_table_column
   {
-    $$ = ast.DatabaseOption{Type:ast.EncryptionType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
+    $$ = append($1, $3)
   }
 
-create_like:
-  LIKE table_name
+function_table_column:
+  sql_id cast_type
   {
-    $$ = &ast.OptLike{LikeTable: $2}
+    $$ = &ast.FunctionArgument{Name: $1, Type: $2}
   }
-| '(' LIKE table_name ')'
+
+function_option_list:
+  function_option
   {
-    $$ = &ast.OptLike{LikeTable: $3}
+    $$ = []*ast.FunctionOption{$1}
+  }
+| function_option_list function_option
+  {
+    $$ = append($1, $2)
   }
 
-column_definition_list:
-  column_definition
+function_option:
+  LANGUAGE sql_id
   {
-    $$ = []*ast.ColumnDefinition{$1}
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionLanguage, Value: $2.String()}
   }
-| column_definition_list ',' column_definition
+| WINDOW
   {
-    $$ = append($1,$3)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionWindow}
   }
-
-table_column_list:
-  column_definition
+| IMMUTABLE
   {
-    $$ = &ast.TableSpec{}
-    $$.AddColumn($1)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionVolatility, Value: ast.ImmutableStr}
   }
-| check_constraint_definition
+| STABLE
   {
-    $$ = &ast.TableSpec{}
-    $$.AddConstraint($1)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionVolatility, Value: ast.StableStr}
   }
-| table_column_list ',' column_definition
+| VOLATILE
   {
-    $$.AddColumn($3)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionVolatility, Value: ast.VolatileStr}
   }
-| table_column_list ',' column_definition check_constraint_definition
+| LEAKPROOF
   {
-    $$.AddColumn($3)
-    $$.AddConstraint($4)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionLeakproof, Value: ast.LeakproofStr}
   }
-| table_column_list ',' index_definition
+| NOT LEAKPROOF
   {
-    $$.AddIndex($3)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionLeakproof, Value: ast.NotLeakproofStr}
   }
-| table_column_list ',' constraint_definition
+| STRICT
   {
-    $$.AddConstraint($3)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionNullInput, Value: ast.StrictStr}
   }
-| table_column_list ',' check_constraint_definition
+| CALLED ON NULL INPUT
   {
-    $$.AddConstraint($3)
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionNullInput, Value: ast.CalledOnNullInputStr}
   }
-
-// collate_opt has to be in the first rule so that we don\'t have a shift reduce conflict when seeing a COLLATE
-// with column_attribute_list_opt. Always shifting there would have meant that we would have always ended up using the
-// second rule in the grammar whenever COLLATE was specified.
-// We now have a shift reduce conflict between COLLATE and collate_opt. Shifting there is fine. Essentially, we have
-// postponed the decision of which rule to use until we have consumed the COLLATE id/string tokens.
-column_definition:
-  sql_id column_type collate_opt column_attribute_list_opt reference_definition_opt
+| RETURNS NULL ON NULL INPUT
   {
-    $2.Options = $4
-    if $2.Options.Collate == "" {
-    	$2.Options.Collate = $3
-    }
-    $2.Options.Reference = $5
-    $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionNullInput, Value: ast.ReturnsNullOnNullInputStr}
   }
-| sql_id column_type collate_opt generated_always_opt AS '(' expression ')' generated_column_attribute_list_opt reference_definition_opt
+| SECURITY DEFINER
   {
-    $2.Options = $9
-    $2.Options.As = $7
-    $2.Options.Reference = $10
-    $2.Options.Collate = $3
-    $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSecurity, Value: ast.SecurityDefinerStr}
   }
-
-generated_always_opt:
+| SECURITY INVOKER
   {
-    $$ = ""
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSecurity, Value: ast.SecurityInvokerStr}
   }
-|  GENERATED ALWAYS
+| EXTERNAL SECURITY DEFINER
   {
-    $$ = ""
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSecurity, Value: ast.SecurityDefinerStr}
   }
-
-// There is a shift reduce conflict that arises here because UNIQUE and KEY are column_type_option and so is UNIQUE KEY.
-// So in the state "column_type_options UNIQUE. KEY" there is a shift-reduce conflict(resovled by "%rigth <str> UNIQUE KEY").
-// This has been added to emulate what PostgresQL does. The previous architecture was such that the order of the column options
-// was specific (as stated in the PostgresQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
-column_attribute_list_opt:
+| EXTERNAL SECURITY INVOKER
   {
-    $$ = &ast.ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ast.ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: ast.UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil }
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSecurity, Value: ast.SecurityInvokerStr}
   }
-| column_attribute_list_opt NULL
+| PARALLEL sql_id
   {
-    val := true
-    $1.Null = &val
-    $$ = $1
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionParallel, Value: $2.Lowered()}
   }
-| column_attribute_list_opt NOT NULL
+| COST function_number
   {
-    val := false
-    $1.Null = &val
-    $$ = $1
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionCost, Value: $2}
   }
-| column_attribute_list_opt DEFAULT openb expression closeb
+| ROWS function_number
   {
-	$1.Default = $4
-	$$ = $1
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionRows, Value: $2}
   }
-| column_attribute_list_opt DEFAULT now_or_signed_literal
+| SET sql_id TO function_set_value_list
   {
-    $1.Default = $3
-    $$ = $1
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSet, Name: $2.String(), Value: $4}
   }
-| column_attribute_list_opt ON UPDATE function_call_nonkeyword
+| SET sql_id '=' function_set_value_list
+  {
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSet, Name: $2.String(), Value: $4}
+  }
+| SET sql_id FROM CURRENT
+  {
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionSet, Name: $2.String()}
+  }
+| AS function_body
+  {
+    $$ = &ast.FunctionOption{Type: ast.FunctionOptionAs, Value: $2}
+  }
+
+function_number:
+  INTEGRAL
   {
-    $1.OnUpdate = $4
     $$ = $1
   }
-| column_attribute_list_opt AUTO_INCREMENT
+| FLOAT
   {
-    $1.Autoincrement = true
     $$ = $1
   }
-| column_attribute_list_opt COMMENT_KEYWORD STRING
+| DECIMAL
   {
-    $1.Comment = ast.NewStrLiteral($3)
     $$ = $1
   }
-| column_attribute_list_opt keys
+
+function_set_value_list:
+  function_set_value
   {
-    $1.KeyOpt = $2
     $$ = $1
   }
-| column_attribute_list_opt COLLATE STRING
+| function_set_value_list ',' function_set_value
   {
-    $1.Collate = sql_types.EncodeStringSQL($3)
+    $$ = $1 + ", " + $3
   }
-| column_attribute_list_opt COLLATE id_or_var
+
+function_set_value:
+  STRING
+  {
+    $$ = sql_types.EncodeStringSQL($1)
+  }
+| sql_id
+  {
+    $$ = $1.String()
+  }
+| function_number
   {
-    $1.Collate = string($3.String())
     $$ = $1
   }
-| column_attribute_list_opt COLUMN_FORMAT column_format
+
+/*
+  The function body is kept as is, a dollar-quoted string keeps its quotes
+*/
+function_body:
+  DOLLAR_STRING
   {
-    $1.Format = $3
+    $$ = $1
   }
-| column_attribute_list_opt SRID INTEGRAL
+| STRING
   {
-    $1.SRID = ast.NewIntLiteral($3)
+    $$ = sql_types.EncodeStringSQL($1)
+  }
+| STRING ',' STRING
+  {
+    $$ = sql_types.EncodeStringSQL($1) + ", " + sql_types.EncodeStringSQL($3)
+  }
+
+create_extension:
+  CREATE comment_opt EXTENSION not_exists_opt extension_name extension_with_opt
+  {
+    $$ = &ast.CreateExtension{Comments: ast.Comments($2).Parsed(), IfNotExists: $4, Name: $5}
+  }
+| create_extension SCHEMA sql_id
+  {
+    $1.Schema = ast.NewTableIdent($3.String())
     $$ = $1
   }
-| column_attribute_list_opt VISIBLE
+| create_extension VERSION extension_version
   {
-    val := false
-    $1.Invisible = &val
+    $1.Version = $3
     $$ = $1
   }
-| column_attribute_list_opt INVISIBLE
+| create_extension CASCADE
   {
-    val := true
-    $1.Invisible = &val
+    $1.Cascade = true
     $$ = $1
   }
 
-column_format:
-  FIXED
-{
-  $$ = ast.FixedFormat
-}
-| DYNAMIC
-{
-  $$ = ast.DynamicFormat
-}
-| DEFAULT
-{
-  $$ = ast.DefaultFormat
-}
-
-column_storage:
-  VIRTUAL
-{
-  $$ = ast.VirtualStorage
-}
-| STORED
-{
-  $$ = ast.StoredStorage
-}
-
-generated_column_attribute_list_opt:
-  {
-    $$ = &ast.ColumnTypeOptions{}
-  }
-| generated_column_attribute_list_opt column_storage
+extension_name:
+  sql_id
   {
-    $1.Storage = $2
     $$ = $1
   }
-| generated_column_attribute_list_opt NULL
+| STRING
   {
-    val := true
-    $1.Null = &val
-    $$ = $1
+    $$ = ast.NewColIdent($1)
   }
-| generated_column_attribute_list_opt NOT NULL
+
+extension_with_opt:
   {
-    val := false
-    $1.Null = &val
-    $$ = $1
+    $$ = struct{}{}
   }
-| generated_column_attribute_list_opt COMMENT_KEYWORD STRING
+| WITH
   {
-    $1.Comment = ast.NewStrLiteral($3)
-    $$ = $1
+    $$ = struct{}{}
   }
-| generated_column_attribute_list_opt keys
+
+extension_version:
+  STRING
   {
-    $1.KeyOpt = $2
-    $$ = $1
+    $$ = sql_types.EncodeStringSQL($1)
   }
-| generated_column_attribute_list_opt VISIBLE
+| sql_id
   {
-    val := false
-    $1.Invisible = &val
-    $$ = $1
+    $$ = $1.String()
   }
-| generated_column_attribute_list_opt INVISIBLE
+| function_number
   {
-    val := true
-    $1.Invisible = &val
     $$ = $1
   }
 
-now_or_signed_literal:
-now
+create_type:
+  CREATE comment_opt TYPE table_name AS ENUM openb type_enum_labels_opt closeb
   {
-  	$$ = $1
+    $$ = &ast.CreateType{Comments: ast.Comments($2).Parsed(), Name: $4, Kind: ast.TypeEnumStr, Labels: $8}
   }
-| signed_literal_or_null
-
-now:
-CURRENT_TIMESTAMP func_datetime_precision
+| CREATE comment_opt TYPE table_name AS openb type_attribute_list closeb
   {
-    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("current_timestamp"), Fsp: $2}
+    $$ = &ast.CreateType{Comments: ast.Comments($2).Parsed(), Name: $4, Kind: ast.TypeCompositeStr, Attributes: $7}
   }
-| LOCALTIME func_datetime_precision
+| CREATE comment_opt TYPE table_name AS RANGE openb type_range_parameter_list closeb
   {
-    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("localtime"), Fsp: $2}
+    $$ = &ast.CreateType{Comments: ast.Comments($2).Parsed(), Name: $4, Kind: ast.TypeRangeStr, Parameters: $8}
   }
-| LOCALTIMESTAMP func_datetime_precision
+
+type_enum_labels_opt:
   {
-    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("localtimestamp"), Fsp: $2}
+    $$ = []string{}
   }
-| UTC_TIMESTAMP func_datetime_precision
+| enum_values
   {
-    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("utc_timestamp"), Fsp:$2}
+    $$ = $1
   }
-| NOW func_datetime_precision
+
+type_attribute_list:
+  type_attribute
   {
-    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("now"), Fsp: $2}
+    $$ = []*ast.ColumnDefinition{$1}
   }
-
-sequence_spec:
-  START WITH INTEGRAL INCREMENT BY INTEGRAL NO MINVALUE NO MAXVALUE CACHE INTEGRAL
+| type_attribute_list ',' type_attribute
   {
-    $$ = &ast.SequenceSpec{StartWith: ast.IntRef($3), IncrementBy: ast.IntRef($6)}
+    $$ = append($1, $3)
   }
 
-signed_literal_or_null:
-signed_literal
-| null_as_literal
-
- null_as_literal:
-NULL
- {
-    $$ = &ast.NullVal{}
- }
-
- signed_literal:
- literal
-| '+' NUM_literal
-   {
- 	$$= $2
-   }
-| '-' NUM_literal
-   {
-   	$$ = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: $2}
-   }
+type_attribute:
+  sql_id column_type collate_opt
+  {
+    if $3 != "" {
+      $2.Options = &ast.ColumnTypeOptions{Collate: $3}
+    }
+    $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
+  }
 
-literal:
-text_literal
+type_range_parameter_list:
+  type_range_parameter
   {
-   $$= $1
+    $$ = ast.StorageParameters{$1}
   }
-| NUM_literal
+| type_range_parameter_list ',' type_range_parameter
   {
-  	$$= $1
+    $$ = append($1, $3)
   }
-| boolean_value
+
+type_range_parameter:
+  sql_id '=' column_type
   {
-  	$$ = $1
+    columnType := $3
+    $$ = &ast.StorageParameter{Name: $1.String(), Value: ast.String(&columnType)}
   }
-| HEX
+
+/*
+  The default value of the domain is restricted to the simple expression
+  to avoid conflicts with the NOT NULL constraint
+*/
+create_domain:
+  CREATE comment_opt DOMAIN table_name as_opt column_type
   {
-	$$ = ast.NewHexLiteral($1)
+    columnType := $6
+    $$ = &ast.CreateDomain{Comments: ast.Comments($2).Parsed(), Name: $4, Type: &columnType}
   }
-| HEXNUM
+| create_domain COLLATE id_or_var
   {
-  	$$ = ast.NewHexNumLiteral($1)
+    $1.Collate = $3.String()
+    $$ = $1
   }
-| BIT_LITERAL
+| create_domain COLLATE STRING
   {
-	$$ = ast.NewBitLiteral($1)
+    $1.Collate = sql_types.EncodeStringSQL($3)
+    $$ = $1
   }
-| VALUE_ARG
+| create_domain DEFAULT simple_expr
   {
-    $$ = ast.NewArgument($1[1:])
-    bindVariable(psqlex, $1[1:])
+    $1.Default = $3
+    $$ = $1
   }
-| underscore_charsets  BIT_LITERAL %prec UNARY
+| create_domain domain_constraint
   {
-  	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewBitLiteral($2)}
+    $1.Constraints = append($1.Constraints, $2)
+    $$ = $1
   }
-| underscore_charsets HEXNUM %prec UNARY
+| create_domain CONSTRAINT sql_id domain_constraint
   {
-  	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewHexNumLiteral($2)}
+    $4.Name = $3
+    $1.Constraints = append($1.Constraints, $4)
+    $$ = $1
   }
-| underscore_charsets HEX %prec UNARY
+
+domain_constraint:
+  NOT NULL
   {
-   	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewHexLiteral($2)}
+    null := false
+    $$ = &ast.DomainConstraint{Null: &null}
   }
-| underscore_charsets column_name %prec UNARY
+| NULL
   {
-    $$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: $2}
+    null := true
+    $$ = &ast.DomainConstraint{Null: &null}
   }
-| underscore_charsets VALUE_ARG %prec UNARY
+| CHECK openb expression closeb
   {
-    bindVariable(psqlex, $2[1:])
-    $$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewArgument($2[1:])}
+    $$ = &ast.DomainConstraint{Check: $3}
   }
 
-underscore_charsets:
-  UNDERSCORE_ARMSCII8
+create_trigger_prefix:
+  CREATE comment_opt replace_opt TRIGGER sql_id trigger_timing trigger_event_list ON table_name
   {
-    $$ = ast.Armscii8Str
+    $$ = &ast.CreateTrigger{Comments: ast.Comments($2).Parsed(), IsReplace: $3, Name: $5, Timing: $6, Events: $7, Table: $9}
   }
-| UNDERSCORE_ASCII
+
+trigger_timing:
+  BEFORE
   {
-    $$ = ast.ASCIIStr
+    $$ = ast.TriggerBeforeStr
   }
-| UNDERSCORE_BIG5
+| AFTER
   {
-    $$ = ast.Big5Str
+    $$ = ast.TriggerAfterStr
   }
-| UNDERSCORE_BINARY
+| INSTEAD OF
   {
-    $$ = ast.UBinaryStr
+    $$ = ast.TriggerInsteadOfStr
   }
-| UNDERSCORE_CP1250
+
+trigger_event_list:
+  trigger_event
   {
-    $$ = ast.Cp1250Str
+    $$ = []*ast.TriggerEvent{$1}
   }
-| UNDERSCORE_CP1251
+| trigger_event_list OR trigger_event
   {
-    $$ = ast.Cp1251Str
+    $$ = append($1, $3)
   }
-| UNDERSCORE_CP1256
+
+trigger_event:
+  INSERT
   {
-    $$ = ast.Cp1256Str
+    $$ = &ast.TriggerEvent{Type: ast.TriggerInsertStr}
   }
-| UNDERSCORE_CP1257
+| UPDATE
   {
-    $$ = ast.Cp1257Str
+    $$ = &ast.TriggerEvent{Type: ast.TriggerUpdateStr}
   }
-| UNDERSCORE_CP850
+| UPDATE OF column_list
   {
-    $$ = ast.Cp850Str
+    $$ = &ast.TriggerEvent{Type: ast.TriggerUpdateStr, Columns: $3}
   }
-| UNDERSCORE_CP852
+| DELETE
   {
-    $$ = ast.Cp852Str
+    $$ = &ast.TriggerEvent{Type: ast.TriggerDeleteStr}
   }
-| UNDERSCORE_CP866
+| TRUNCATE
   {
-    $$ = ast.Cp866Str
+    $$ = &ast.TriggerEvent{Type: ast.TriggerTruncateStr}
   }
-| UNDERSCORE_CP932
+
+trigger_for_each_opt:
   {
-    $$ = ast.Cp932Str
+    $$ = false
   }
-| UNDERSCORE_DEC8
+| FOR EACH ROW
   {
-    $$ = ast.Dec8Str
+    $$ = true
   }
-| UNDERSCORE_EUCJPMS
+| FOR ROW
   {
-    $$ = ast.EucjpmsStr
+    $$ = true
   }
-| UNDERSCORE_EUCKR
+| FOR EACH STATEMENT
   {
-    $$ = ast.EuckrStr
+    $$ = false
   }
-| UNDERSCORE_GB18030
+| FOR STATEMENT
   {
-    $$ = ast.Gb18030Str
+    $$ = false
   }
-| UNDERSCORE_GB2312
+
+trigger_when_opt:
   {
-    $$ = ast.Gb2312Str
+    $$ = nil
   }
-| UNDERSCORE_GBK
+| WHEN openb expression closeb
   {
-    $$ = ast.GbkStr
+    $$ = $3
   }
-| UNDERSCORE_GEOSTD8
+
+unique_opt:
   {
-    $$ = ast.Geostd8Str
+    $$ = false
   }
-| UNDERSCORE_GREEK
+| UNIQUE
   {
-    $$ = ast.GreekStr
+    $$ = true
   }
-| UNDERSCORE_HEBREW
+
+concurrently_opt:
   {
-    $$ = ast.HebrewStr
+    $$ = false
   }
-| UNDERSCORE_HP8
+| CONCURRENTLY
   {
-    $$ = ast.Hp8Str
+    $$ = true
   }
-| UNDERSCORE_KEYBCS2
+
+index_name_opt:
   {
-    $$ = ast.Keybcs2Str
+    $$ = ast.NewColIdent("")
   }
-| UNDERSCORE_KOI8R
+| sql_id
   {
-    $$ = ast.Koi8rStr
+    $$ = $1
   }
-| UNDERSCORE_KOI8U
+
+index_method_opt:
   {
-    $$ = ast.Koi8uStr
+    $$ = ast.NewColIdent("")
   }
-| UNDERSCORE_LATIN1
+| USING sql_id
   {
-    $$ = ast.Latin1Str
+    $$ = $2
   }
-| UNDERSCORE_LATIN2
+
+index_element_list:
+  index_element
   {
-    $$ = ast.Latin2Str
+    $$ = []*ast.IndexElement{$1}
   }
-| UNDERSCORE_LATIN5
+| index_element_list ',' index_element
   {
-    $$ = ast.Latin5Str
+    $$ = append($1, $3)
   }
-| UNDERSCORE_LATIN7
+
+index_element:
+  sql_id index_element_options
   {
-    $$ = ast.Latin7Str
+    $2.Column = $1
+    $$ = $2
   }
-| UNDERSCORE_MACCE
+| function_call_generic index_element_options
   {
-    $$ = ast.MacceStr
+    $2.Expression = $1
+    $$ = $2
   }
-| UNDERSCORE_MACROMAN
+| openb expression closeb index_element_options
   {
-    $$ = ast.MacromanStr
+    $4.Expression = $2
+    $$ = $4
   }
-| UNDERSCORE_SJIS
+
+index_element_options:
+  collate_opt index_opclass_opt asc_desc_opt index_nulls_opt
   {
-    $$ = ast.SjisStr
+    $2.Collate = $1
+    $2.Direction = $3
+    $2.Nulls = $4
+    $$ = $2
   }
-| UNDERSCORE_SWE7
-  {
-    $$ = ast.Swe7Str
-  }
-| UNDERSCORE_TIS620
+
+/*
+  Operator class names are restricted to the plain identifiers
+  to avoid conflicts with the NULLS FIRST/LAST options
+*/
+index_opclass_opt:
   {
-    $$ = ast.Tis620Str
+    $$ = &ast.IndexElement{}
   }
-| UNDERSCORE_UCS2
+| id_or_var storage_parameters_paren_opt
   {
-    $$ = ast.Ucs2Str
+    $$ = &ast.IndexElement{OpClass: ast.TableName{Name: ast.NewTableIdent($1.String())}, OpClassParameters: $2}
   }
-| UNDERSCORE_UJIS
+| id_or_var '.' id_or_var storage_parameters_paren_opt
   {
-    $$ = ast.UjisStr
+    $$ = &ast.IndexElement{OpClass: ast.TableName{Qualifier: ast.NewTableIdent($1.String()), Name: ast.NewTableIdent($3.String())}, OpClassParameters: $4}
   }
-| UNDERSCORE_UTF16
+
+index_nulls_opt:
   {
-    $$ = ast.Utf16Str
+    $$ = ""
   }
-| UNDERSCORE_UTF16LE
+| NULLS FIRST
   {
-    $$ = ast.Utf16leStr
+    $$ = ast.NullsFirstStr
   }
-| UNDERSCORE_UTF32
+| NULLS LAST
   {
-    $$ = ast.Utf32Str
+    $$ = ast.NullsLastStr
   }
-| UNDERSCORE_UTF8
+
+index_include_opt:
   {
-    $$ = ast.Utf8Str
+    $$ = nil
   }
-| UNDERSCORE_UTF8MB4
+| INCLUDE openb column_list closeb
   {
-    $$ = ast.Utf8mb4Str
+    $$ = $3
   }
-| UNDERSCORE_UTF8MB3
+
+index_tablespace_opt:
   {
-    $$ = ast.Utf8Str
+    $$ = ast.NewTableIdent("")
   }
-
-literal_or_null:
-literal
-| null_as_literal
-
-NUM_literal:
-INTEGRAL
+| TABLESPACE table_id
   {
-    $$ = ast.NewIntLiteral($1)
+    $$ = $2
   }
-| FLOAT
+
+storage_parameters_opt:
   {
-    $$ = ast.NewFloatLiteral($1)
+    $$ = nil
   }
-| DECIMAL
+| WITH openb storage_parameter_list closeb
   {
-    $$ = ast.NewDecimalLiteral($1)
+    $$ = $3
   }
 
-text_literal:
-STRING
+storage_parameters_paren_opt:
   {
-	$$ = ast.NewStrLiteral($1)
+    $$ = nil
   }
-| NCHAR_STRING
+| openb storage_parameter_list closeb
   {
-	$$ = &ast.UnaryExpr{Operator: ast.NStringOp, Expr: ast.NewStrLiteral($1)}
+    $$ = $2
   }
- | underscore_charsets STRING %prec UNARY
-   {
-   	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewStrLiteral($2)}
-   }
 
-text_literal_or_arg:
-  text_literal
+storage_parameter_list:
+  storage_parameter
   {
-    $$ = $1
+    $$ = ast.StorageParameters{$1}
   }
-| VALUE_ARG
+| storage_parameter_list ',' storage_parameter
   {
-    $$ = ast.NewArgument($1[1:])
-    bindVariable(psqlex, $1[1:])
+    $$ = append($1, $3)
   }
 
-keys:
-  PRIMARY KEY
+storage_parameter:
+  sql_id
   {
-    $$ = ast.ColKeyPrimary
+    $$ = &ast.StorageParameter{Name: $1.String()}
   }
-| UNIQUE
+| sql_id '=' storage_parameter_value
   {
-    $$ = ast.ColKeyUnique
+    $$ = &ast.StorageParameter{Name: $1.String(), Value: $3}
   }
-| UNIQUE KEY
+| sql_id '.' sql_id
   {
-    $$ = ast.ColKeyUniqueKey
+    $$ = &ast.StorageParameter{Name: $1.String() + "." + $3.String()}
   }
-| KEY
+| sql_id '.' sql_id '=' storage_parameter_value
   {
-    $$ = ast.ColKey
+    $$ = &ast.StorageParameter{Name: $1.String() + "." + $3.String(), Value: $5}
   }
 
-column_type:
-  numeric_type unsigned_opt zero_fill_opt
+storage_parameter_value:
+  table_opt_value
   {
     $$ = $1
-    $$.Unsigned = $2
-    $$.Zerofill = $3
   }
-| char_type
-| time_type
-| spatial_type
-
-numeric_type:
-  int_type length_opt
+| FLOAT
   {
-    $$ = $1
-    $$.Length = $2
+    $$ = string($1)
   }
-| decimal_type
+| DECIMAL
   {
-    $$ = $1
+    $$ = string($1)
   }
 
-int_type:
-  BIT
+create_sequence_prefix:
+  CREATE comment_opt sequence_name
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.CreateSequence{Comments: ast.Comments($2).Parsed(), Sequence: $3}
+    setDDL(psqlex, $$)
   }
-| BOOL
+
+alter_sequence_prefix:
+  ALTER comment_opt sequence_name
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.AlterSequence{Comments: ast.Comments($2).Parsed(), Sequence: $3}
+    setDDL(psqlex, $$)
   }
-| BOOLEAN
+
+create_database_prefix:
+  CREATE comment_opt database comment_opt not_exists_opt table_id
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.CreateDatabase{Comments: ast.Comments($4).Parsed(), DBName: $6, IfNotExists: $5}
+    setDDL(psqlex,$$)
   }
-| TINYINT
+
+alter_database_prefix:
+  ALTER comment_opt database
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.AlterDatabase{}
+    setDDL(psqlex,$$)
   }
-| SMALLINT
+
+database:
+  DATABASE
+
+table_spec:
+  '(' table_column_list ')' table_option_list_opt partition_by_opt
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = $2
+    $$.Options = $4
+    $$.PartitionOption = $5
   }
-| MEDIUMINT
+
+partition_by_opt:
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = nil
   }
-| INT
+| PARTITION BY partition_by_type tuple_expression
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.PartitionOption{Type: $3, Expr: $4, Partitions: -1}
   }
-| INTEGER
+
+// LIST and HASH are not keywords
+partition_by_type:
+  RANGE
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = ast.RangeType
   }
-| BIGINT
+| ID
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    switch ast.NewColIdent($1).Lowered() {
+    case "list":
+      $$ = ast.ListType
+    case "hash":
+      $$ = ast.HashType
+    default:
+      psqlex.Error("expecting range, list or hash")
+      return 1
+    }
   }
 
-decimal_type:
-REAL float_length_opt
+// The nil bound is the default partition
+partition_bound:
+  FOR VALUES IN row_tuple
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = &ast.PartitionValueRange{Type: ast.InType, Range: $4}
   }
-| DOUBLE float_length_opt
+| FOR VALUES FROM row_tuple TO row_tuple
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = &ast.PartitionValueRange{Type: ast.FromToType, Range: $4, To: $6}
   }
-| DOUBLE PRECISION
+| FOR VALUES WITH openb ID INTEGRAL ',' ID INTEGRAL closeb
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    if ast.NewColIdent($5).Lowered() != "modulus" || ast.NewColIdent($8).Lowered() != "remainder" {
+      psqlex.Error("expecting modulus and remainder")
+      return 1
+    }
+    $$ = &ast.PartitionValueRange{Type: ast.ModulusType, Range: ast.ValTuple{ast.NewIntLiteral($6), ast.NewIntLiteral($9)}}
   }
-| FLOAT_TYPE float_length_opt
+| DEFAULT
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = nil
   }
-| DECIMAL_TYPE decimal_length_opt
+
+create_options_opt:
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = nil
   }
-| NUMERIC decimal_length_opt
+| create_options
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = $1
   }
-| DECIMAL decimal_length_opt
+
+create_options:
+  character_set
   {
-    $$ = ast.ColumnType{Type: string($1)}
-    $$.Length = $2.Length
-    $$.Scale = $2.Scale
+    $$ = []ast.DatabaseOption{$1}
   }
-
-time_type:
-  DATE
+| collate
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = []ast.DatabaseOption{$1}
   }
-| TIME length_opt
+| encryption
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2}
+    $$ = []ast.DatabaseOption{$1}
   }
-| TIMESTAMP with_timezone_opt length_opt
+| create_options collate
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $3}
+    $$ = append($1,$2)
   }
-| INTERVAL length_opt
+| create_options character_set
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2}
+    $$ = append($1,$2)
+  }
+| create_options encryption
+  {
+    $$ = append($1,$2)
   }
 
-char_type:
-  CHAR length_opt charset_opt
+default_optional:
+  /* empty */ %prec LOWER_THAN_CHARSET
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2, Charset: $3}
+    $$ = false
   }
-| CHAR length_opt BYTE
+| DEFAULT
   {
-    // CHAR BYTE is an alias for binary. See also:
-    // https://dev.psql.com/doc/refman/8.0/en/string-type-syntax.html
-    $$ = ast.ColumnType{Type: "binary", Length: $2}
+    $$ = true
   }
-| CHARACTER varying_opt length_opt charset_opt
+
+character_set:
+  default_optional charset_or_character_set equal_opt id_or_var
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $3, Charset: $4}
+    $$ = ast.DatabaseOption{Type:ast.CharacterSetType, Value:($4.String()), IsDefault:$1}
   }
-| VARCHAR length_opt charset_opt
+| default_optional charset_or_character_set equal_opt STRING
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2, Charset: $3}
+    $$ = ast.DatabaseOption{Type:ast.CharacterSetType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
   }
-| BINARY length_opt
+
+collate:
+  default_optional COLLATE equal_opt id_or_var
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2}
+    $$ = ast.DatabaseOption{Type:ast.CollateType, Value:($4.String()), IsDefault:$1}
   }
-| BYTEA length_opt
+| default_optional COLLATE equal_opt STRING
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2}
+    $$ = ast.DatabaseOption{Type:ast.CollateType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
   }
-| VARBINARY length_opt
+
+encryption:
+  default_optional ENCRYPTION equal_opt id_or_var
   {
-    $$ = ast.ColumnType{Type: string($1), Length: $2}
+    $$ = ast.DatabaseOption{Type:ast.EncryptionType, Value:($4.String()), IsDefault:$1}
   }
-| TEXT charset_opt
+| default_optional ENCRYPTION equal_opt STRING
   {
-    $$ = ast.ColumnType{Type: string($1), Charset: $2}
+    $$ = ast.DatabaseOption{Type:ast.EncryptionType, Value:(sql_types.EncodeStringSQL($4)), IsDefault:$1}
   }
-| JSON
+
+create_like:
+  LIKE table_name
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.OptLike{LikeTable: $2}
   }
-| ENUM '(' enum_values ')' charset_opt
+| '(' LIKE table_name ')'
   {
-    $$ = ast.ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
-  }
-// need set_values / SetValues ?
-| SET '(' enum_values ')' charset_opt
-  {
-    $$ = ast.ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
+    $$ = &ast.OptLike{LikeTable: $3}
   }
 
-spatial_type:
-  GEOMETRY
-  {
-    $$ = ast.ColumnType{Type: string($1)}
-  }
-| POINT
+column_definition_list:
+  column_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = []*ast.ColumnDefinition{$1}
   }
-| LINESTRING
+| column_definition_list ',' column_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = append($1,$3)
   }
-| POLYGON
+
+table_column_list:
+  column_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.TableSpec{}
+    $$.AddColumn($1)
   }
-| GEOMETRYCOLLECTION
+| check_constraint_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$ = &ast.TableSpec{}
+    $$.AddConstraint($1)
   }
-| MULTIPOINT
+| table_column_list ',' column_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$.AddColumn($3)
   }
-| MULTILINESTRING
+| table_column_list ',' column_definition check_constraint_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$.AddColumn($3)
+    $$.AddConstraint($4)
   }
-| MULTIPOLYGON
+| table_column_list ',' index_definition
   {
-    $$ = ast.ColumnType{Type: string($1)}
+    $$.AddIndex($3)
   }
-
-enum_values:
-  STRING
+| table_column_list ',' constraint_definition
   {
-    $$ = make([]string, 0, 4)
-    $$ = append($$, sql_types.EncodeStringSQL($1))
+    $$.AddConstraint($3)
   }
-| enum_values ',' STRING
+| table_column_list ',' check_constraint_definition
   {
-    $$ = append($1, sql_types.EncodeStringSQL($3))
+    $$.AddConstraint($3)
   }
 
-length_opt:
+// collate_opt has to be in the first rule so that we don\'t have a shift reduce conflict when seeing a COLLATE
+// with column_attribute_list_opt. Always shifting there would have meant that we would have always ended up using the
+// second rule in the grammar whenever COLLATE was specified.
+// We now have a shift reduce conflict between COLLATE and collate_opt. Shifting there is fine. Essentially, we have
+// postponed the decision of which rule to use until we have consumed the COLLATE id/string tokens.
+column_definition:
+  sql_id column_type collate_opt column_attribute_list_opt reference_definition_opt
   {
-    $$ = nil
+    $2.Options = $4
+    if $2.Options.Collate == "" {
+    	$2.Options.Collate = $3
+    }
+    $2.Options.Reference = $5
+    $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
   }
-| '(' INTEGRAL ')'
+| sql_id column_type collate_opt AS '(' expression ')' generated_column_attribute_list_opt reference_definition_opt
   {
-    $$ = ast.NewIntLiteral($2)
+    $2.Options = $8
+    $2.Options.As = $6
+    $2.Options.Reference = $9
+    $2.Options.Collate = $3
+    $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
   }
 
-varying_opt:
-  {
-    $$ = nil
-  }
-| VARYING
+// There is a shift reduce conflict that arises here because UNIQUE and KEY are column_type_option and so is UNIQUE KEY.
+// So in the state "column_type_options UNIQUE. KEY" there is a shift-reduce conflict(resovled by "%rigth <str> UNIQUE KEY").
+// This has been added to emulate what PostgresQL does. The previous architecture was such that the order of the column options
+// was specific (as stated in the PostgresQL guide) and did not accept arbitrary order options. For example NOT NULL DEFAULT 1 and not DEFAULT 1 NOT NULL
+column_attribute_list_opt:
   {
-    $$ = ast.NewStrLiteral($1)
+    $$ = &ast.ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ast.ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: ast.UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil }
   }
-
-with_timezone_opt:
+| column_attribute_list_opt NULL
   {
-    $$ = nil
+    val := true
+    $1.Null = &val
+    $$ = $1
   }
-| WITH TIME ZONE
+| column_attribute_list_opt NOT NULL
   {
-    $$ = ast.NewStrLiteral($1)
+    val := false
+    $1.Null = &val
+    $$ = $1
   }
-
-float_length_opt:
+| column_attribute_list_opt DEFAULT openb expression closeb
   {
-    $$ = ast.LengthScaleOption{}
+	$1.Default = $4
+	$$ = $1
   }
-
-decimal_length_opt:
+| column_attribute_list_opt DEFAULT now_or_signed_literal
   {
-    $$ = ast.LengthScaleOption{}
+    $1.Default = $3
+    $$ = $1
   }
-| '(' INTEGRAL ')'
+| column_attribute_list_opt DEFAULT now_or_signed_literal TYPECAST cast_type
   {
-    $$ = ast.LengthScaleOption{
-        Length: ast.NewIntLiteral($2),
-    }
+    $1.Default = &ast.TypeCastExpr{Expr: $3, Type: $5}
+    $$ = $1
   }
-| '(' INTEGRAL ',' INTEGRAL ')'
+| column_attribute_list_opt DEFAULT function_call_generic
   {
-    $$ = ast.LengthScaleOption{
-        Length: ast.NewIntLiteral($2),
-        Scale: ast.NewIntLiteral($4),
-    }
+    $1.Default = $3
+    $$ = $1
   }
-
-unsigned_opt:
+| column_attribute_list_opt ON UPDATE function_call_nonkeyword
   {
-    $$ = false
+    $1.OnUpdate = $4
+    $$ = $1
   }
-| UNSIGNED
+| column_attribute_list_opt GENERATED ALWAYS AS IDENTITY identity_sequence_opt
   {
-    $$ = true
+    $1.Identity = ast.IdentityAlwaysStr
+    $1.IdentitySequence = $6
+    $$ = $1
   }
-| SIGNED
+| column_attribute_list_opt GENERATED BY DEFAULT AS IDENTITY identity_sequence_opt
   {
-    $$ = false
+    $1.Identity = ast.IdentityByDefaultStr
+    $1.IdentitySequence = $7
+    $$ = $1
   }
-
-zero_fill_opt:
+| column_attribute_list_opt GENERATED ALWAYS AS '(' expression ')'
   {
-    $$ = false
+    $1.GeneratedAlways = true
+    $1.As = $6
+    $$ = $1
   }
-| ZEROFILL
+| column_attribute_list_opt column_storage
   {
-    $$ = true
+    $1.Storage = $2
+    $$ = $1
   }
-
-charset_opt:
+| column_attribute_list_opt AUTO_INCREMENT
   {
-    $$ = ast.ColumnCharset{}
+    $1.Autoincrement = true
+    $$ = $1
   }
-| charset_or_character_set sql_id binary_opt
+| column_attribute_list_opt COMMENT_KEYWORD STRING
   {
-    $$ = ast.ColumnCharset{Name: string($2.String()), Binary: $3}
+    $1.Comment = ast.NewStrLiteral($3)
+    $$ = $1
   }
-| charset_or_character_set STRING binary_opt
+| column_attribute_list_opt keys
   {
-    $$ = ast.ColumnCharset{Name: sql_types.EncodeStringSQL($2), Binary: $3}
+    $1.KeyOpt = $2
+    $$ = $1
   }
-| charset_or_character_set BINARY
+| column_attribute_list_opt COLLATE STRING
   {
-    $$ = ast.ColumnCharset{Name: string($2)}
+    $1.Collate = sql_types.EncodeStringSQL($3)
   }
-| ASCII binary_opt
+| column_attribute_list_opt COLLATE id_or_var
   {
-    // ASCII: ast.Shorthand for CHARACTER SET latin1.
-    $$ = ast.ColumnCharset{Name: "latin1", Binary: $2}
+    $1.Collate = string($3.String())
+    $$ = $1
   }
-| UNICODE binary_opt
+| column_attribute_list_opt COLUMN_FORMAT column_format
   {
-    // UNICODE: ast.Shorthand for CHARACTER SET ucs2.
-    $$ = ast.ColumnCharset{Name: "ucs2", Binary: $2}
+    $1.Format = $3
   }
-| BINARY
+| column_attribute_list_opt SRID INTEGRAL
   {
-    // BINARY: ast.Shorthand for default CHARACTER SET but with binary collation
-    $$ = ast.ColumnCharset{Name: "", Binary: true}
+    $1.SRID = ast.NewIntLiteral($3)
+    $$ = $1
   }
-| BINARY ASCII
+| column_attribute_list_opt VISIBLE
   {
-    // BINARY ASCII: ast.Shorthand for CHARACTER SET latin1 with binary collation
-    $$ = ast.ColumnCharset{Name: "latin1", Binary: true}
+    val := false
+    $1.Invisible = &val
+    $$ = $1
   }
-| BINARY UNICODE
+| column_attribute_list_opt INVISIBLE
   {
-    // BINARY UNICODE: ast.Shorthand for CHARACTER SET ucs2 with binary collation
-    $$ = ast.ColumnCharset{Name: "ucs2", Binary: true}
+    val := true
+    $1.Invisible = &val
+    $$ = $1
   }
 
-binary_opt:
+identity_kind:
+  ALWAYS
   {
-    $$ = false
+    $$ = ast.IdentityAlwaysStr
   }
-| BINARY
+| BY DEFAULT
   {
-    $$ = true
+    $$ = ast.IdentityByDefaultStr
   }
 
-collate_opt:
-  {
-    $$ = ""
-  }
-| COLLATE id_or_var
+identity_sequence_opt:
   {
-    $$ = string($2.String())
+    $$ = nil
   }
-| COLLATE STRING
+| '(' sequence_spec ')'
   {
-    $$ = sql_types.EncodeStringSQL($2)
+    $$ = $2
   }
 
+column_format:
+  FIXED
+{
+  $$ = ast.FixedFormat
+}
+| DYNAMIC
+{
+  $$ = ast.DynamicFormat
+}
+| DEFAULT
+{
+  $$ = ast.DefaultFormat
+}
 
-index_definition:
-  index_info '(' index_column_list ')' index_option_list_opt
-  {
-    $$ = &ast.IndexDefinition{Info: $1, Columns: $3, Options: $5}
-  }
+column_storage:
+  VIRTUAL
+{
+  $$ = ast.VirtualStorage
+}
+| STORED
+{
+  $$ = ast.StoredStorage
+}
 
-index_option_list_opt:
+generated_column_attribute_list_opt:
   {
-    $$ = nil
+    $$ = &ast.ColumnTypeOptions{}
   }
-| index_option_list
+| generated_column_attribute_list_opt column_storage
   {
+    $1.Storage = $2
     $$ = $1
   }
-
-index_option_list:
-  index_option
+| generated_column_attribute_list_opt NULL
   {
-    $$ = []*ast.IndexOption{$1}
+    val := true
+    $1.Null = &val
+    $$ = $1
   }
-| index_option_list index_option
+| generated_column_attribute_list_opt NOT NULL
   {
-    $$ = append($$, $2)
+    val := false
+    $1.Null = &val
+    $$ = $1
   }
-
-index_option:
-  using_index_type
+| generated_column_attribute_list_opt COMMENT_KEYWORD STRING
   {
+    $1.Comment = ast.NewStrLiteral($3)
     $$ = $1
   }
-| COMMENT_KEYWORD STRING
-  {
-    $$ = &ast.IndexOption{Name: string($1), Value: ast.NewStrLiteral($2)}
-  }
-| VISIBLE
+| generated_column_attribute_list_opt keys
   {
-    $$ = &ast.IndexOption{Name: string($1) }
+    $1.KeyOpt = $2
+    $$ = $1
   }
-| INVISIBLE
+| generated_column_attribute_list_opt VISIBLE
   {
-    $$ = &ast.IndexOption{Name: string($1) }
+    val := false
+    $1.Invisible = &val
+    $$ = $1
   }
-| WITH PARSER id_or_var
+| generated_column_attribute_list_opt INVISIBLE
   {
-    $$ = &ast.IndexOption{Name: string($1) + " " + string($2), String: $3.String()}
+    val := true
+    $1.Invisible = &val
+    $$ = $1
   }
 
-equal_opt:
-  /* empty */
-  {
-    $$ = ""
-  }
-| '='
+now_or_signed_literal:
+now
   {
-    $$ = string($1)
+  	$$ = $1
   }
+| signed_literal_or_null
 
-index_info:
-  constraint_name_opt PRIMARY KEY name_opt
+now:
+CURRENT_TIMESTAMP func_datetime_precision
   {
-    $$ = &ast.IndexInfo{Type: string($2) + " " + string($3), ConstraintName: ast.NewColIdent($1), Name: ast.NewColIdent("PRIMARY"), Primary: true, Unique: true}
+    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("current_timestamp"), Fsp: $2}
   }
-| SPATIAL index_or_key_opt name_opt
+| LOCALTIME func_datetime_precision
   {
-    $$ = &ast.IndexInfo{Type: string($1) + " " + string($2), Name: ast.NewColIdent($3), Spatial: true, Unique: false}
+    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("localtime"), Fsp: $2}
   }
-| FULLTEXT index_or_key_opt name_opt
+| LOCALTIMESTAMP func_datetime_precision
   {
-    $$ = &ast.IndexInfo{Type: string($1) + " " + string($2), Name: ast.NewColIdent($3), Fulltext: true, Unique: false}
+    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("localtimestamp"), Fsp: $2}
   }
-| constraint_name_opt UNIQUE index_or_key_opt name_opt
+| UTC_TIMESTAMP func_datetime_precision
   {
-    $$ = &ast.IndexInfo{Type: string($2) + " " + string($3), ConstraintName: ast.NewColIdent($1), Name: ast.NewColIdent($4), Unique: true}
+    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("utc_timestamp"), Fsp:$2}
   }
-| index_or_key name_opt
+| NOW func_datetime_precision
   {
-    $$ = &ast.IndexInfo{Type: string($1), Name: ast.NewColIdent($2), Unique: false}
+    $$ = &ast.CurTimeFuncExpr{Name:ast.NewColIdent("now"), Fsp: $2}
   }
 
-constraint_name_opt:
-  {
-    $$ = ""
-  }
-| CONSTRAINT name_opt
+sequence_spec:
   {
-    $$ = $2
+    $$ = &ast.SequenceSpec{}
   }
-
-index_symbols:
-  INDEX
+| sequence_spec SEQUENCE NAME table_name
   {
-    $$ = string($1)
+    $1.Name = $4
+    $$ = $1
   }
-| KEYS
+| sequence_spec AS column_type
   {
-    $$ = string($1)
+    $1.DataType = ast.String(&$3)
+    $$ = $1
   }
-| INDEXES
+| sequence_spec START signed_integer
   {
-    $$ = string($1)
+    $1.StartWith = ast.IntRef($3)
+    $$ = $1
   }
-
-
-from_or_in:
-  FROM
+| sequence_spec START WITH signed_integer
   {
-    $$ = string($1)
+    $1.StartWith = ast.IntRef($4)
+    $$ = $1
   }
-| IN
+| sequence_spec INCREMENT signed_integer
   {
-    $$ = string($1)
+    $1.IncrementBy = ast.IntRef($3)
+    $$ = $1
   }
-
-index_or_key_opt:
+| sequence_spec INCREMENT BY signed_integer
   {
-    $$ = "key"
+    $1.IncrementBy = ast.IntRef($4)
+    $$ = $1
   }
-| index_or_key
+| sequence_spec MINVALUE signed_integer
   {
+    $1.MinValue = ast.IntRef($3)
     $$ = $1
   }
-
-index_or_key:
-  INDEX
+| sequence_spec NO MINVALUE
   {
-    $$ = string($1)
+    $1.NoMinValue = true
+    $$ = $1
   }
-  | KEY
+| sequence_spec MAXVALUE signed_integer
   {
-    $$ = string($1)
+    $1.MaxValue = ast.IntRef($3)
+    $$ = $1
   }
-
-name_opt:
+| sequence_spec NO MAXVALUE
   {
-    $$ = ""
+    $1.NoMaxValue = true
+    $$ = $1
   }
-| id_or_var
+| sequence_spec CACHE INTEGRAL
   {
-    $$ = string($1.String())
+    $1.Cache = ast.IntRef($3)
+    $$ = $1
   }
-
-index_column_list:
-  index_column
+| sequence_spec CYCLE
   {
-    $$ = []*ast.IndexColumn{$1}
+    val := true
+    $1.Cycle = &val
+    $$ = $1
   }
-| index_column_list ',' index_column
+| sequence_spec NO CYCLE
   {
-    $$ = append($$, $3)
+    val := false
+    $1.Cycle = &val
+    $$ = $1
   }
 
-index_column:
-  sql_id length_opt asc_desc_opt
+signed_integer:
+  INTEGRAL
+| '-' INTEGRAL
   {
-    $$ = &ast.IndexColumn{Column: $1, Length: $2, Direction: $3}
+    $$ = "-" + $2
   }
-| openb expression closeb asc_desc_opt
+| '+' INTEGRAL
   {
-    $$ = &ast.IndexColumn{Expression: $2, Direction: $4}
+    $$ = $2
   }
 
-constraint_definition:
-  CONSTRAINT id_or_var_opt constraint_info
+signed_literal_or_null:
+signed_literal
+| null_as_literal
+
+ null_as_literal:
+NULL
+ {
+    $$ = &ast.NullVal{}
+ }
+
+ signed_literal:
+ literal
+| '+' NUM_literal
+   {
+ 	$$= $2
+   }
+| '-' NUM_literal
+   {
+   	$$ = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: $2}
+   }
+
+literal:
+text_literal
   {
-    $$ = &ast.ConstraintDefinition{Name: $2, Details: $3}
+   $$= $1
   }
-|  constraint_info
+| NUM_literal
   {
-    $$ = &ast.ConstraintDefinition{Details: $1}
+  	$$= $1
   }
-
-check_constraint_definition:
-  CONSTRAINT id_or_var_opt check_constraint_info
+| boolean_value
   {
-    $$ = &ast.ConstraintDefinition{Name: $2, Details: $3}
+  	$$ = $1
   }
-|  check_constraint_info
+| HEX
   {
-    $$ = &ast.ConstraintDefinition{Details: $1}
+	$$ = ast.NewHexLiteral($1)
   }
-
-constraint_info:
-  FOREIGN KEY name_opt '(' column_list ')' reference_definition
+| HEXNUM
   {
-    $$ = &ast.ForeignKeyDefinition{IndexName: ast.NewColIdent($3), Source: $5, ReferenceDefinition: $7}
+  	$$ = ast.NewHexNumLiteral($1)
   }
-
-reference_definition:
-  REFERENCES table_name '(' column_list ')' fk_match_opt
+| BIT_LITERAL
   {
-    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6}
+	$$ = ast.NewBitLiteral($1)
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete
+| VALUE_ARG
   {
-    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7}
+    $$ = ast.NewArgument($1[1:])
+    bindVariable(psqlex, $1[1:])
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update
+| underscore_charsets  BIT_LITERAL %prec UNARY
   {
-    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7}
+  	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewBitLiteral($2)}
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete fk_on_update
+| underscore_charsets HEXNUM %prec UNARY
   {
-    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7, OnUpdate: $8}
+  	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewHexNumLiteral($2)}
   }
-| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update fk_on_delete
+| underscore_charsets HEX %prec UNARY
   {
-    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7, OnDelete: $8}
+   	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewHexLiteral($2)}
   }
-
-reference_definition_opt:
+| underscore_charsets column_name %prec UNARY
   {
-    $$ = nil
+    $$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: $2}
   }
-| reference_definition
+| underscore_charsets VALUE_ARG %prec UNARY
   {
-    $$ = $1
+    bindVariable(psqlex, $2[1:])
+    $$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewArgument($2[1:])}
   }
 
-check_constraint_info:
-  CHECK '(' expression ')' enforced_opt
+underscore_charsets:
+  UNDERSCORE_ARMSCII8
   {
-    $$ = &ast.CheckConstraintDefinition{Expr: $3, Enforced: $5}
+    $$ = ast.Armscii8Str
   }
-
-fk_match:
-  MATCH fk_match_action
+| UNDERSCORE_ASCII
   {
-    $$ = $2
+    $$ = ast.ASCIIStr
   }
-
-fk_match_action:
-  FULL
+| UNDERSCORE_BIG5
   {
-    $$ = ast.Full
+    $$ = ast.Big5Str
   }
-| PARTIAL
+| UNDERSCORE_BINARY
   {
-    $$ = ast.Partial
+    $$ = ast.UBinaryStr
   }
-| SIMPLE
+| UNDERSCORE_CP1250
   {
-    $$ = ast.Simple
+    $$ = ast.Cp1250Str
   }
-
-fk_match_opt:
+| UNDERSCORE_CP1251
   {
-    $$ = ast.DefaultMatch
+    $$ = ast.Cp1251Str
   }
-| fk_match
+| UNDERSCORE_CP1256
   {
-    $$ = $1
+    $$ = ast.Cp1256Str
   }
-
-fk_on_delete:
-  ON DELETE fk_reference_action
+| UNDERSCORE_CP1257
   {
-    $$ = $3
+    $$ = ast.Cp1257Str
   }
-
-fk_on_update:
-  ON UPDATE fk_reference_action
+| UNDERSCORE_CP850
   {
-    $$ = $3
+    $$ = ast.Cp850Str
   }
-
-fk_reference_action:
-  RESTRICT
+| UNDERSCORE_CP852
   {
-    $$ = ast.Restrict
+    $$ = ast.Cp852Str
   }
-| CASCADE
+| UNDERSCORE_CP866
   {
-    $$ = ast.Cascade
-  }
-| NO ACTION
-  {
-    $$ = ast.NoAction
+    $$ = ast.Cp866Str
   }
-| SET DEFAULT
+| UNDERSCORE_CP932
   {
-    $$ = ast.SetDefault
+    $$ = ast.Cp932Str
   }
-| SET NULL
+| UNDERSCORE_DEC8
   {
-    $$ = ast.SetNull
+    $$ = ast.Dec8Str
   }
-
-restrict_or_cascade_opt:
+| UNDERSCORE_EUCJPMS
   {
-    $$ = ""
+    $$ = ast.EucjpmsStr
   }
-| RESTRICT
+| UNDERSCORE_EUCKR
   {
-    $$ = string($1)
+    $$ = ast.EuckrStr
   }
-| CASCADE
+| UNDERSCORE_GB18030
   {
-    $$ = string($1)
+    $$ = ast.Gb18030Str
   }
-
-enforced:
-  ENFORCED
+| UNDERSCORE_GB2312
   {
-    $$ = true
+    $$ = ast.Gb2312Str
   }
-| NOT ENFORCED
+| UNDERSCORE_GBK
   {
-    $$ = false
+    $$ = ast.GbkStr
   }
-
-enforced_opt:
+| UNDERSCORE_GEOSTD8
   {
-    $$ = true
+    $$ = ast.Geostd8Str
   }
-| enforced
+| UNDERSCORE_GREEK
   {
-    $$ = $1
+    $$ = ast.GreekStr
   }
-
-table_option_list_opt:
+| UNDERSCORE_HEBREW
   {
-    $$ = nil
+    $$ = ast.HebrewStr
   }
-| table_option_list
+| UNDERSCORE_HP8
   {
-    $$ = $1
+    $$ = ast.Hp8Str
   }
-
-table_option_list:
-  table_option
+| UNDERSCORE_KEYBCS2
   {
-    $$ = ast.TableOptions{$1}
+    $$ = ast.Keybcs2Str
   }
-| table_option_list ',' table_option
+| UNDERSCORE_KOI8R
   {
-    $$ = append($1,$3)
+    $$ = ast.Koi8rStr
   }
-| table_option_list table_option
+| UNDERSCORE_KOI8U
   {
-    $$ = append($1,$2)
+    $$ = ast.Koi8uStr
   }
-
-space_separated_table_option_list:
-  table_option
+| UNDERSCORE_LATIN1
   {
-    $$ = ast.TableOptions{$1}
+    $$ = ast.Latin1Str
   }
-| space_separated_table_option_list table_option
+| UNDERSCORE_LATIN2
   {
-    $$ = append($1,$2)
+    $$ = ast.Latin2Str
   }
-
-table_option:
-  default_optional charset_or_character_set equal_opt charset
+| UNDERSCORE_LATIN5
   {
-    $$ = &ast.TableOption{Name:(string($2)), String:$4, CaseSensitive: true}
+    $$ = ast.Latin5Str
   }
-| default_optional COLLATE equal_opt charset
+| UNDERSCORE_LATIN7
   {
-    $$ = &ast.TableOption{Name:string($2), String:$4, CaseSensitive: true}
+    $$ = ast.Latin7Str
   }
-| COMMENT_KEYWORD equal_opt STRING
+| UNDERSCORE_MACCE
   {
-    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+    $$ = ast.MacceStr
   }
-| COMPRESSION equal_opt STRING
+| UNDERSCORE_MACROMAN
   {
-    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+    $$ = ast.MacromanStr
   }
-| CONNECTION equal_opt STRING
+| UNDERSCORE_SJIS
   {
-    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+    $$ = ast.SjisStr
   }
-| INDEX DIRECTORY equal_opt STRING
+| UNDERSCORE_SWE7
   {
-    $$ = &ast.TableOption{Name:(string($1)+" "+string($2)), Value:ast.NewStrLiteral($4)}
+    $$ = ast.Swe7Str
   }
-| ENCRYPTION equal_opt STRING
+| UNDERSCORE_TIS620
   {
-    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+    $$ = ast.Tis620Str
   }
-| INSERT_METHOD equal_opt insert_method_options
+| UNDERSCORE_UCS2
   {
-    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+    $$ = ast.Ucs2Str
   }
-| PACK_KEYS equal_opt DEFAULT
+| UNDERSCORE_UJIS
   {
-    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+    $$ = ast.UjisStr
   }
-| PASSWORD equal_opt STRING
+| UNDERSCORE_UTF16
   {
-    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+    $$ = ast.Utf16Str
   }
-| ROW_FORMAT equal_opt row_format_options
+| UNDERSCORE_UTF16LE
   {
-    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+    $$ = ast.Utf16leStr
   }
-| STATS_AUTO_RECALC equal_opt DEFAULT
+| UNDERSCORE_UTF32
   {
-    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+    $$ = ast.Utf32Str
   }
-| STATS_PERSISTENT equal_opt DEFAULT
+| UNDERSCORE_UTF8
   {
-    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+    $$ = ast.Utf8Str
   }
-| TABLESPACE equal_opt sql_id storage_opt
+| UNDERSCORE_UTF8MB4
   {
-    $$ = &ast.TableOption{Name:string($1), String: ($3.String() + $4)}
+    $$ = ast.Utf8mb4Str
   }
-| UNION equal_opt '(' table_name_list ')'
+| UNDERSCORE_UTF8MB3
   {
-    $$ = &ast.TableOption{Name:string($1), Tables: $4}
+    $$ = ast.Utf8Str
   }
 
-storage_opt:
+literal_or_null:
+literal
+| null_as_literal
+
+NUM_literal:
+INTEGRAL
   {
-    $$ = ""
+    $$ = ast.NewIntLiteral($1)
   }
-| STORAGE DISK
+| FLOAT
   {
-    $$ = " " + string($1) + " " + string($2)
+    $$ = ast.NewFloatLiteral($1)
   }
-| STORAGE MEMORY
+| DECIMAL
   {
-    $$ = " " + string($1) + " " + string($2)
+    $$ = ast.NewDecimalLiteral($1)
   }
 
-row_format_options:
-  DEFAULT
-| DYNAMIC
-| FIXED
-| COMPRESSED
-| REDUNDANT
-| COMPACT
-
-insert_method_options:
-  NO
-| FIRST
-| LAST
-
-table_opt_value:
-  reserved_sql_id
+text_literal:
+STRING
   {
-    $$ = $1.String()
+	$$ = ast.NewStrLiteral($1)
   }
-| STRING
+| NCHAR_STRING
   {
-    $$ = sql_types.EncodeStringSQL($1)
+	$$ = &ast.UnaryExpr{Operator: ast.NStringOp, Expr: ast.NewStrLiteral($1)}
   }
-| INTEGRAL
+ | underscore_charsets STRING %prec UNARY
+   {
+   	$$ = &ast.IntroducerExpr{CharacterSet: $1, Expr: ast.NewStrLiteral($2)}
+   }
+
+text_literal_or_arg:
+  text_literal
   {
-    $$ = string($1)
+    $$ = $1
   }
-
-column_opt:
+| VALUE_ARG
   {
-    $$ = ""
+    $$ = ast.NewArgument($1[1:])
+    bindVariable(psqlex, $1[1:])
   }
-| COLUMN
 
-first_opt:
+keys:
+  PRIMARY KEY
   {
-    $$ = false
+    $$ = ast.ColKeyPrimary
   }
-| FIRST
+| UNIQUE
   {
-    $$ = true
+    $$ = ast.ColKeyUnique
   }
-
-after_opt:
+| UNIQUE KEY
   {
-    $$ = nil
+    $$ = ast.ColKeyUniqueKey
   }
-| AFTER column_name
+| KEY
   {
-    $$ = $2
+    $$ = ast.ColKey
   }
 
-alter_schema_commands_list:
-  OWNER TO ID
+column_type:
+  numeric_type unsigned_opt zero_fill_opt
   {
-    $$ = []ast.AlterOption{&ast.AlterOwner{Owner: &ast.RoleName{Name: ast.RoleIdent{V: $3}}}}
+    $$ = $1
+    $$.Unsigned = $2
+    $$.Zerofill = $3
   }
-
-alter_commands_list:
+| char_type
+| time_type
+| spatial_type
+| user_defined_type
+| column_type '[' ']'
   {
-    $$ = nil
+    $$ = $1
+    $$.ArrayBounds = append($$.ArrayBounds, "")
   }
-| alter_options
+| column_type '[' INTEGRAL ']'
   {
     $$ = $1
+    $$.ArrayBounds = append($$.ArrayBounds, $3)
   }
-| alter_options ',' ORDER BY column_list
+
+/*
+  Names of the user-defined types (CREATE TYPE, CREATE DOMAIN) and
+  the built-in types which are not keywords (uuid, jsonb, inet ...)
+*/
+user_defined_type:
+  id_or_var
   {
-    $$ = append($1,&ast.OrderByOption{Cols:$5})
+    $$ = ast.ColumnType{Type: $1.String()}
   }
-| alter_commands_modifier_list
+| id_or_var '.' id_or_var
   {
-    $$ = $1
+    $$ = ast.ColumnType{Type: $1.String() + "." + $3.String()}
   }
-| alter_commands_modifier_list ',' alter_options
+
+numeric_type:
+  int_type length_opt
   {
-    $$ = append($1,$3...)
+    $$ = $1
+    $$.Length = $2
   }
-| alter_commands_modifier_list ',' alter_options ',' ORDER BY column_list
+| decimal_type
   {
-    $$ = append(append($1,$3...),&ast.OrderByOption{Cols:$7})
+    $$ = $1
   }
 
-alter_options:
-  alter_option
+int_type:
+  BIT
   {
-    $$ = []ast.AlterOption{$1}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| alter_options ',' alter_option
+| BIT VARYING
   {
-    $$ = append($1,$3)
-  }
-| alter_options ',' alter_commands_modifier
-  {
-    $$ = append($1,$3)
+    $$ = ast.ColumnType{Type: string($1) + " " + string($2)}
   }
-
-alter_option:
-  space_separated_table_option_list
+| BOOL
   {
-    $$ = $1
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ADD check_constraint_definition
+| BOOLEAN
   {
-    $$ = &ast.AddConstraintDefinition{ConstraintDefinition: $2}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ADD constraint_definition
+| TINYINT
   {
-    $$ = &ast.AddConstraintDefinition{ConstraintDefinition: $2}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ADD index_definition
+| SMALLINT
   {
-    $$ = &ast.AddIndexDefinition{IndexDefinition: $2}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ADD column_opt '(' column_definition_list ')'
+| MEDIUMINT
   {
-    $$ = &ast.AddColumns{Columns: $4}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ADD column_opt column_definition first_opt after_opt
+| INT
   {
-    $$ = &ast.AddColumns{Columns: []*ast.ColumnDefinition{$3}, First:$4, After:$5}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER column_opt column_name DROP DEFAULT
+| INTEGER
   {
-    $$ = &ast.AlterColumn{Column: $3, DropDefault:true}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER column_opt column_name SET DEFAULT signed_literal_or_null
+| BIGINT
   {
-    $$ = &ast.AlterColumn{Column: $3, DropDefault:false, DefaultVal:$6}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER column_opt column_name SET DEFAULT openb expression closeb
+
+decimal_type:
+REAL float_length_opt
   {
-	$$ = &ast.AlterColumn{Column: $3, DropDefault:false, DefaultVal:$7}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| ALTER column_opt column_name SET VISIBLE
+| DOUBLE float_length_opt
   {
-    val := false
-    $$ = &ast.AlterColumn{Column: $3, Invisible:&val}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| ALTER column_opt column_name SET INVISIBLE
+| DOUBLE PRECISION
   {
-    val := true
-    $$ = &ast.AlterColumn{Column: $3, Invisible:&val}
+    $$ = ast.ColumnType{Type: string($1) + " " + string($2)}
   }
-| ALTER CHECK id_or_var enforced
+| FLOAT_TYPE float_length_opt
   {
-    $$ = &ast.AlterCheck{Name: $3, Enforced: $4}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| ALTER INDEX id_or_var VISIBLE
+| DECIMAL_TYPE decimal_length_opt
   {
-    $$ = &ast.AlterIndex{Name: $3, Invisible: false}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| ALTER INDEX id_or_var INVISIBLE
+| NUMERIC decimal_length_opt
   {
-    $$ = &ast.AlterIndex{Name: $3, Invisible: true}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| CHANGE column_opt column_name column_definition first_opt after_opt
+| DECIMAL decimal_length_opt
   {
-    $$ = &ast.ChangeColumn{OldColumn:$3, NewColDefinition:$4, First:$5, After:$6}
+    $$ = ast.ColumnType{Type: string($1)}
+    $$.Length = $2.Length
+    $$.Scale = $2.Scale
   }
-| MODIFY column_opt column_definition first_opt after_opt
+
+time_type:
+  DATE
   {
-    $$ = &ast.ModifyColumn{NewColDefinition:$3, First:$4, After:$5}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| CONVERT TO charset_or_character_set charset collate_opt
+| TIME length_opt time_zone_opt
   {
-    $$ = &ast.AlterCharset{CharacterSet:$4, Collate:$5}
+    $$ = ast.ColumnType{Type: string($1), Length: $2, TimeZone: $3}
   }
-| DISABLE KEYS
+| TIMESTAMP length_opt time_zone_opt
   {
-    $$ = &ast.KeyState{Enable:false}
+    $$ = ast.ColumnType{Type: string($1), Length: $2, TimeZone: $3}
   }
-| ENABLE KEYS
+| INTERVAL length_opt
   {
-    $$ = &ast.KeyState{Enable:true}
+    $$ = ast.ColumnType{Type: string($1), Length: $2}
   }
-| DISCARD TABLESPACE
+
+char_type:
+  CHAR length_opt charset_opt
   {
-    $$ = &ast.TablespaceOperation{Import:false}
+    $$ = ast.ColumnType{Type: string($1), Length: $2, Charset: $3}
   }
-| IMPORT TABLESPACE
+| CHAR length_opt BYTE
   {
-    $$ = &ast.TablespaceOperation{Import:true}
+    // CHAR BYTE is an alias for binary. See also:
+    // https://dev.psql.com/doc/refman/8.0/en/string-type-syntax.html
+    $$ = ast.ColumnType{Type: "binary", Length: $2}
   }
-| DROP column_opt column_name
+| CHARACTER varying_opt length_opt charset_opt
   {
-    $$ = &ast.DropColumn{Name:$3}
+    $$ = ast.ColumnType{Type: string($1), Length: $3, Charset: $4}
+    if $2 != nil {
+      $$.Type += " " + $2.Val
+    }
   }
-| DROP index_or_key id_or_var
+| VARCHAR length_opt charset_opt
   {
-    $$ = &ast.DropKey{Type: ast.NormalKeyType, Name:$3}
+    $$ = ast.ColumnType{Type: string($1), Length: $2, Charset: $3}
   }
-| DROP PRIMARY KEY
+| BINARY length_opt
   {
-    $$ = &ast.DropKey{Type: ast.PrimaryKeyType}
+    $$ = ast.ColumnType{Type: string($1), Length: $2}
   }
-| DROP FOREIGN KEY id_or_var
+| BYTEA length_opt
   {
-    $$ = &ast.DropKey{Type: ast.ForeignKeyType, Name:$4}
+    $$ = ast.ColumnType{Type: string($1), Length: $2}
   }
-| DROP CHECK id_or_var
+| VARBINARY length_opt
   {
-    $$ = &ast.DropKey{Type: ast.CheckKeyType, Name:$3}
+    $$ = ast.ColumnType{Type: string($1), Length: $2}
   }
-| DROP CONSTRAINT id_or_var
+| TEXT charset_opt
   {
-    $$ = &ast.DropKey{Type: ast.CheckKeyType, Name:$3}
+    $$ = ast.ColumnType{Type: string($1), Charset: $2}
   }
-| FORCE
+| JSON
   {
-    $$ = &ast.Force{}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| RENAME to_opt table_name
+| ENUM '(' enum_values ')' charset_opt
   {
-    $$ = &ast.RenameTableName{Table:$3}
+    $$ = ast.ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
   }
-| RENAME index_or_key id_or_var TO id_or_var
+// need set_values / SetValues ?
+| SET '(' enum_values ')' charset_opt
   {
-    $$ = &ast.RenameIndex{OldName:$3, NewName:$5}
+    $$ = ast.ColumnType{Type: string($1), EnumValues: $3, Charset: $5}
   }
 
-alter_commands_modifier_list:
-  alter_commands_modifier
+spatial_type:
+  GEOMETRY
   {
-    $$ = []ast.AlterOption{$1}
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| alter_commands_modifier_list ',' alter_commands_modifier
+| POINT
   {
-    $$ = append($1,$3)
+    $$ = ast.ColumnType{Type: string($1)}
   }
-
-alter_commands_modifier:
-  LOCK equal_opt DEFAULT
-    {
-      $$ = &ast.LockOption{Type: ast.DefaultType}
-    }
-  | LOCK equal_opt NONE
-    {
-      $$ = &ast.LockOption{Type: ast.NoneType}
-    }
-  | LOCK equal_opt SHARED
-    {
-      $$ = &ast.LockOption{Type: ast.SharedType}
-    }
-  | LOCK equal_opt EXCLUSIVE
-    {
-      $$ = &ast.LockOption{Type: ast.ExclusiveType}
-    }
-  | WITH VALIDATION
-    {
-      $$ = &ast.Validation{With:true}
-    }
-  | WITHOUT VALIDATION
-    {
-      $$ = &ast.Validation{With:false}
-    }
-
-alter_statement:
-  alter_table_prefix alter_commands_list
+| LINESTRING
   {
-    $1.FullyParsed = true
-    $1.AlterOptions = $2
-    $$ = $1
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| alter_schema_prefix alter_schema_commands_list
+| POLYGON
   {
-    $1.FullyParsed = true
-    $1.AlterOptions = $2
-    $$ = $1
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| alter_sequence_prefix sequence_spec
+| GEOMETRYCOLLECTION
   {
-    $1.SequenceSpec = $2
-    $1.FullyParsed = true
-    $$ = $1
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER comment_opt definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
+| MULTIPOINT
   {
-    $$ = &ast.AlterView{ViewName: $6.ToViewName(), Comments: ast.Comments($2).Parsed(), Definer: $3 ,Security:$4, Columns:$7, Select: $9, CheckOption: $10 }
+    $$ = ast.ColumnType{Type: string($1)}
   }
-// The syntax here causes a shift / reduce issue, because ENCRYPTION is a non reserved keyword
-// and the database identifier is optional. When no identifier is given, the current database
-// is used. This means though that `alter database encryption` is ambiguous whether it means
-// the encryption keyword, or the encryption database name, resulting in the conflict.
-// The preference here is to shift, so it is treated as a database name. This matches the PostgresQL
-// behavior as well.
-| alter_database_prefix table_id_opt create_options
+| MULTILINESTRING
   {
-    $1.FullyParsed = true
-    $1.DBName = $2
-    $1.AlterOptions = $3
-    $$ = $1
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER comment_opt VSCHEMA CREATE VINDEX table_name vindex_type_opt vindex_params_opt
+| MULTIPOLYGON
   {
-    $$ = &ast.AlterVschema{
-        Action: ast.CreateVindexDDLAction,
-        Table: $6,
-        VindexSpec: &ast.VindexSpec{
-          Name: ast.NewColIdent($6.Name.String()),
-          Type: $7,
-          Params: $8,
-        },
-      }
+    $$ = ast.ColumnType{Type: string($1)}
   }
-| ALTER comment_opt VSCHEMA DROP VINDEX table_name
+
+enum_values:
+  STRING
   {
-    $$ = &ast.AlterVschema{
-        Action: ast.DropVindexDDLAction,
-        Table: $6,
-        VindexSpec: &ast.VindexSpec{
-          Name: ast.NewColIdent($6.Name.String()),
-        },
-      }
+    $$ = make([]string, 0, 4)
+    $$ = append($$, sql_types.EncodeStringSQL($1))
   }
-| ALTER comment_opt VSCHEMA ADD TABLE table_name
+| enum_values ',' STRING
   {
-    $$ = &ast.AlterVschema{Action: ast.AddVschemaTableDDLAction, Table: $6}
+    $$ = append($1, sql_types.EncodeStringSQL($3))
   }
-| ALTER comment_opt VSCHEMA DROP TABLE table_name
+
+length_opt:
   {
-    $$ = &ast.AlterVschema{Action: ast.DropVschemaTableDDLAction, Table: $6}
+    $$ = nil
   }
-| ALTER comment_opt VSCHEMA ON table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+| '(' INTEGRAL ')'
   {
-    $$ = &ast.AlterVschema{
-        Action: ast.AddColVindexDDLAction,
-        Table: $5,
-        VindexSpec: &ast.VindexSpec{
-            Name: $8,
-            Type: $12,
-            Params: $13,
-        },
-        VindexCols: $10,
-      }
+    $$ = ast.NewIntLiteral($2)
   }
-| ALTER comment_opt VSCHEMA ON table_name DROP VINDEX sql_id
+
+varying_opt:
   {
-    $$ = &ast.AlterVschema{
-        Action: ast.DropColVindexDDLAction,
-        Table: $5,
-        VindexSpec: &ast.VindexSpec{
-            Name: $8,
-        },
-      }
+    $$ = nil
   }
-| ALTER comment_opt VSCHEMA ADD SEQUENCE table_name
+| VARYING
   {
-    $$ = &ast.AlterVschema{Action: ast.AddSequenceDDLAction, Table: $6}
+    $$ = ast.NewStrLiteral($1)
   }
-| ALTER comment_opt VSCHEMA ON table_name ADD AUTO_INCREMENT sql_id USING table_name
+
+time_zone_opt:
   {
-    $$ = &ast.AlterVschema{
-        Action: ast.AddAutoIncDDLAction,
-        Table: $5,
-        AutoIncSpec: &ast.AutoIncSpec{
-            Column: $8,
-            Sequence: $10,
-        },
-    }
+    $$ = ""
+  }
+| WITH TIME ZONE
+  {
+    $$ = ast.WithTimeZoneStr
+  }
+| WITHOUT TIME ZONE
+  {
+    $$ = ast.WithoutTimeZoneStr
   }
 
-json_table_function:
-  JSON_TABLE openb expression ',' text_literal_or_arg jt_columns_clause closeb as_opt_id
+float_length_opt:
   {
-    $$ = &ast.JSONTableExpr{Expr: $3, Filter: $5, Columns: $6, Alias: $8}
+    $$ = ast.LengthScaleOption{}
   }
 
-jt_columns_clause:
-  COLUMNS openb columns_list closeb
+decimal_length_opt:
   {
-    $$= $3
+    $$ = ast.LengthScaleOption{}
+  }
+| '(' INTEGRAL ')'
+  {
+    $$ = ast.LengthScaleOption{
+        Length: ast.NewIntLiteral($2),
+    }
+  }
+| '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = ast.LengthScaleOption{
+        Length: ast.NewIntLiteral($2),
+        Scale: ast.NewIntLiteral($4),
+    }
   }
 
-columns_list:
-  jt_column
+unsigned_opt:
   {
-    $$= []*ast.JtColumnDefinition{$1}
+    $$ = false
   }
-| columns_list ',' jt_column
+| UNSIGNED
   {
-    $$ = append($1, $3)
+    $$ = true
+  }
+| SIGNED
+  {
+    $$ = false
   }
 
-jt_column:
- sql_id FOR ORDINALITY
+zero_fill_opt:
   {
-    $$ = &ast.JtColumnDefinition{JtOrdinal: &ast.JtOrdinalColDef{Name: $1}}
+    $$ = false
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg
+| ZEROFILL
   {
-    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
-    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6}
-    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+    $$ = true
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty
+
+charset_opt:
   {
-    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
-    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7}
-    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+    $$ = ast.ColumnCharset{}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_error
+| charset_or_character_set sql_id binary_opt
   {
-    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
-    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, ErrorOnResponse: $7}
-    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+    $$ = ast.ColumnCharset{Name: string($2.String()), Binary: $3}
   }
-| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty on_error
+| charset_or_character_set STRING binary_opt
   {
-    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
-    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
-    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+    $$ = ast.ColumnCharset{Name: sql_types.EncodeStringSQL($2), Binary: $3}
   }
-| NESTED jt_path_opt text_literal_or_arg jt_columns_clause
+| charset_or_character_set BINARY
   {
-    jtNestedPath := &ast.JtNestedPathColDef{Path: $3, Columns: $4}
-    $$ = &ast.JtColumnDefinition{JtNestedPath: jtNestedPath}
+    $$ = ast.ColumnCharset{Name: string($2)}
+  }
+| ASCII binary_opt
+  {
+    // ASCII: ast.Shorthand for CHARACTER SET latin1.
+    $$ = ast.ColumnCharset{Name: "latin1", Binary: $2}
+  }
+| UNICODE binary_opt
+  {
+    // UNICODE: ast.Shorthand for CHARACTER SET ucs2.
+    $$ = ast.ColumnCharset{Name: "ucs2", Binary: $2}
+  }
+| BINARY
+  {
+    // BINARY: ast.Shorthand for default CHARACTER SET but with binary collation
+    $$ = ast.ColumnCharset{Name: "", Binary: true}
+  }
+| BINARY ASCII
+  {
+    // BINARY ASCII: ast.Shorthand for CHARACTER SET latin1 with binary collation
+    $$ = ast.ColumnCharset{Name: "latin1", Binary: true}
+  }
+| BINARY UNICODE
+  {
+    // BINARY UNICODE: ast.Shorthand for CHARACTER SET ucs2 with binary collation
+    $$ = ast.ColumnCharset{Name: "ucs2", Binary: true}
   }
 
-jt_path_opt:
+binary_opt:
   {
     $$ = false
   }
-| PATH
+| BINARY
   {
     $$ = true
   }
-jt_exists_opt:
+
+collate_opt:
   {
-    $$=false
+    $$ = ""
   }
-| EXISTS
+| COLLATE id_or_var
   {
-    $$=true
+    $$ = string($2.String())
+  }
+| COLLATE STRING
+  {
+    $$ = sql_types.EncodeStringSQL($2)
   }
 
-on_empty:
-  json_on_response ON EMPTY
+
+index_definition:
+  index_info '(' index_column_list ')' index_option_list_opt
   {
-    $$= $1
+    $$ = &ast.IndexDefinition{Info: $1, Columns: $3, Options: $5}
+  }
+
+index_option_list_opt:
+  {
+    $$ = nil
+  }
+| index_option_list
+  {
+    $$ = $1
+  }
+
+index_option_list:
+  index_option
+  {
+    $$ = []*ast.IndexOption{$1}
+  }
+| index_option_list index_option
+  {
+    $$ = append($$, $2)
+  }
+
+index_option:
+  using_index_type
+  {
+    $$ = $1
+  }
+| COMMENT_KEYWORD STRING
+  {
+    $$ = &ast.IndexOption{Name: string($1), Value: ast.NewStrLiteral($2)}
+  }
+| VISIBLE
+  {
+    $$ = &ast.IndexOption{Name: string($1) }
+  }
+| INVISIBLE
+  {
+    $$ = &ast.IndexOption{Name: string($1) }
+  }
+| WITH PARSER id_or_var
+  {
+    $$ = &ast.IndexOption{Name: string($1) + " " + string($2), String: $3.String()}
+  }
+
+equal_opt:
+  /* empty */
+  {
+    $$ = ""
+  }
+| '='
+  {
+    $$ = string($1)
+  }
+
+index_info:
+  constraint_name_opt PRIMARY KEY name_opt
+  {
+    $$ = &ast.IndexInfo{Type: string($2) + " " + string($3), ConstraintName: ast.NewColIdent($1), Name: ast.NewColIdent("PRIMARY"), Primary: true, Unique: true}
+  }
+| SPATIAL index_or_key_opt name_opt
+  {
+    $$ = &ast.IndexInfo{Type: string($1) + " " + string($2), Name: ast.NewColIdent($3), Spatial: true, Unique: false}
+  }
+| FULLTEXT index_or_key_opt name_opt
+  {
+    $$ = &ast.IndexInfo{Type: string($1) + " " + string($2), Name: ast.NewColIdent($3), Fulltext: true, Unique: false}
+  }
+| constraint_name_opt UNIQUE index_or_key_opt name_opt
+  {
+    $$ = &ast.IndexInfo{Type: string($2) + " " + string($3), ConstraintName: ast.NewColIdent($1), Name: ast.NewColIdent($4), Unique: true}
+  }
+| index_or_key name_opt
+  {
+    $$ = &ast.IndexInfo{Type: string($1), Name: ast.NewColIdent($2), Unique: false}
+  }
+
+constraint_name_opt:
+  {
+    $$ = ""
+  }
+| CONSTRAINT name_opt
+  {
+    $$ = $2
+  }
+
+index_symbols:
+  INDEX
+  {
+    $$ = string($1)
+  }
+| KEYS
+  {
+    $$ = string($1)
+  }
+| INDEXES
+  {
+    $$ = string($1)
+  }
+
+
+from_or_in:
+  FROM
+  {
+    $$ = string($1)
+  }
+| IN
+  {
+    $$ = string($1)
+  }
+
+index_or_key_opt:
+  {
+    $$ = "key"
+  }
+| index_or_key
+  {
+    $$ = $1
+  }
+
+index_or_key:
+  INDEX
+  {
+    $$ = string($1)
+  }
+  | KEY
+  {
+    $$ = string($1)
+  }
+
+name_opt:
+  {
+    $$ = ""
+  }
+| id_or_var
+  {
+    $$ = string($1.String())
+  }
+
+index_column_list:
+  index_column
+  {
+    $$ = []*ast.IndexColumn{$1}
+  }
+| index_column_list ',' index_column
+  {
+    $$ = append($$, $3)
+  }
+
+index_column:
+  sql_id length_opt asc_desc_opt
+  {
+    $$ = &ast.IndexColumn{Column: $1, Length: $2, Direction: $3}
+  }
+| openb expression closeb asc_desc_opt
+  {
+    $$ = &ast.IndexColumn{Expression: $2, Direction: $4}
+  }
+
+constraint_definition:
+  CONSTRAINT id_or_var_opt constraint_info
+  {
+    $$ = &ast.ConstraintDefinition{Name: $2, Details: $3}
+  }
+|  constraint_info
+  {
+    $$ = &ast.ConstraintDefinition{Details: $1}
+  }
+
+check_constraint_definition:
+  CONSTRAINT id_or_var_opt check_constraint_info
+  {
+    $$ = &ast.ConstraintDefinition{Name: $2, Details: $3}
+  }
+|  check_constraint_info
+  {
+    $$ = &ast.ConstraintDefinition{Details: $1}
+  }
+
+constraint_info:
+  FOREIGN KEY name_opt '(' column_list ')' reference_definition
+  {
+    $$ = &ast.ForeignKeyDefinition{IndexName: ast.NewColIdent($3), Source: $5, ReferenceDefinition: $7}
+  }
+
+reference_definition:
+  REFERENCES table_name '(' column_list ')' fk_match_opt
+  {
+    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete
+  {
+    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update
+  {
+    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_delete fk_on_update
+  {
+    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnDelete: $7, OnUpdate: $8}
+  }
+| REFERENCES table_name '(' column_list ')' fk_match_opt fk_on_update fk_on_delete
+  {
+    $$ = &ast.ReferenceDefinition{ReferencedTable: $2, ReferencedColumns: $4, Match: $6, OnUpdate: $7, OnDelete: $8}
+  }
+
+reference_definition_opt:
+  {
+    $$ = nil
+  }
+| reference_definition
+  {
+    $$ = $1
+  }
+
+check_constraint_info:
+  CHECK '(' expression ')' enforced_opt
+  {
+    $$ = &ast.CheckConstraintDefinition{Expr: $3, Enforced: $5}
+  }
+
+fk_match:
+  MATCH fk_match_action
+  {
+    $$ = $2
+  }
+
+fk_match_action:
+  FULL
+  {
+    $$ = ast.Full
+  }
+| PARTIAL
+  {
+    $$ = ast.Partial
+  }
+| SIMPLE
+  {
+    $$ = ast.Simple
+  }
+
+fk_match_opt:
+  {
+    $$ = ast.DefaultMatch
+  }
+| fk_match
+  {
+    $$ = $1
+  }
+
+fk_on_delete:
+  ON DELETE fk_reference_action
+  {
+    $$ = $3
+  }
+
+fk_on_update:
+  ON UPDATE fk_reference_action
+  {
+    $$ = $3
+  }
+
+fk_reference_action:
+  RESTRICT
+  {
+    $$ = ast.Restrict
+  }
+| CASCADE
+  {
+    $$ = ast.Cascade
+  }
+| NO ACTION
+  {
+    $$ = ast.NoAction
+  }
+| SET DEFAULT
+  {
+    $$ = ast.SetDefault
+  }
+| SET NULL
+  {
+    $$ = ast.SetNull
+  }
+
+restrict_or_cascade_opt:
+  {
+    $$ = ""
+  }
+| RESTRICT
+  {
+    $$ = string($1)
+  }
+| CASCADE
+  {
+    $$ = string($1)
+  }
+
+enforced:
+  ENFORCED
+  {
+    $$ = true
+  }
+| NOT ENFORCED
+  {
+    $$ = false
+  }
+
+enforced_opt:
+  {
+    $$ = true
+  }
+| enforced
+  {
+    $$ = $1
+  }
+
+table_option_list_opt:
+  {
+    $$ = nil
+  }
+| table_option_list
+  {
+    $$ = $1
+  }
+
+table_option_list:
+  table_option
+  {
+    $$ = ast.TableOptions{$1}
+  }
+| table_option_list ',' table_option
+  {
+    $$ = append($1,$3)
+  }
+| table_option_list table_option
+  {
+    $$ = append($1,$2)
+  }
+
+space_separated_table_option_list:
+  table_option
+  {
+    $$ = ast.TableOptions{$1}
+  }
+| space_separated_table_option_list table_option
+  {
+    $$ = append($1,$2)
+  }
+
+table_option:
+  default_optional charset_or_character_set equal_opt charset
+  {
+    $$ = &ast.TableOption{Name:(string($2)), String:$4, CaseSensitive: true}
+  }
+| default_optional COLLATE equal_opt charset
+  {
+    $$ = &ast.TableOption{Name:string($2), String:$4, CaseSensitive: true}
+  }
+| COMMENT_KEYWORD equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+  }
+| COMPRESSION equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+  }
+| CONNECTION equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+  }
+| INDEX DIRECTORY equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:(string($1)+" "+string($2)), Value:ast.NewStrLiteral($4)}
+  }
+| ENCRYPTION equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+  }
+| INSERT_METHOD equal_opt insert_method_options
+  {
+    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+  }
+| PACK_KEYS equal_opt DEFAULT
+  {
+    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+  }
+| PASSWORD equal_opt STRING
+  {
+    $$ = &ast.TableOption{Name:string($1), Value:ast.NewStrLiteral($3)}
+  }
+| ROW_FORMAT equal_opt row_format_options
+  {
+    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+  }
+| STATS_AUTO_RECALC equal_opt DEFAULT
+  {
+    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+  }
+| STATS_PERSISTENT equal_opt DEFAULT
+  {
+    $$ = &ast.TableOption{Name:string($1), String:string($3)}
+  }
+| TABLESPACE equal_opt sql_id storage_opt
+  {
+    $$ = &ast.TableOption{Name:string($1), String: ($3.String() + $4)}
+  }
+| UNION equal_opt '(' table_name_list ')'
+  {
+    $$ = &ast.TableOption{Name:string($1), Tables: $4}
+  }
+
+storage_opt:
+  {
+    $$ = ""
+  }
+| STORAGE DISK
+  {
+    $$ = " " + string($1) + " " + string($2)
+  }
+| STORAGE MEMORY
+  {
+    $$ = " " + string($1) + " " + string($2)
+  }
+
+row_format_options:
+  DEFAULT
+| DYNAMIC
+| FIXED
+| COMPRESSED
+| REDUNDANT
+| COMPACT
+
+insert_method_options:
+  NO
+| FIRST
+| LAST
+
+table_opt_value:
+  reserved_sql_id
+  {
+    $$ = $1.String()
+  }
+| STRING
+  {
+    $$ = sql_types.EncodeStringSQL($1)
+  }
+| INTEGRAL
+  {
+    $$ = string($1)
+  }
+
+column_opt:
+  {
+    $$ = ""
+  }
+| COLUMN
+
+first_opt:
+  {
+    $$ = false
+  }
+| FIRST
+  {
+    $$ = true
+  }
+
+after_opt:
+  {
+    $$ = nil
+  }
+| AFTER column_name
+  {
+    $$ = $2
+  }
+
+alter_schema_commands_list:
+  alter_owner
+  {
+    $$ = []ast.AlterOption{$1}
+  }
+
+alter_owner:
+  OWNER TO role_name
+  {
+    $$ = &ast.AlterOwner{Owner: $3}
+  }
+
+owned_object_type:
+  TYPE
+  {
+    $$ = ast.TypeObjectStr
+  }
+| DOMAIN
+  {
+    $$ = ast.DomainObjectStr
+  }
+| MATERIALIZED VIEW
+  {
+    $$ = ast.MaterializedViewObjectStr
+  }
+
+default_privileges_roles_opt:
+  {
+    $$ = nil
+  }
+| FOR ROLE role_name_list
+  {
+    $$ = $3
+  }
+| FOR USER role_name_list
+  {
+    $$ = $3
+  }
+
+default_privileges_schemas_opt:
+  {
+    $$ = nil
+  }
+| IN SCHEMA schema_id_list
+  {
+    $$ = $3
+  }
+
+schema_id_list:
+  table_id
+  {
+    $$ = []ast.TableIdent{$1}
+  }
+| schema_id_list ',' table_id
+  {
+    $$ = append($1, $3)
+  }
+
+default_privileges_action:
+  GRANT grant_privilege_list ON grant_objects_type TO role_name_list with_grant_option_opt
+  {
+    $$ = &ast.Grant{Privileges: $2, ObjectType: $4, Grantees: $6, WithGrantOption: $7}
+  }
+| REVOKE grant_option_for_opt grant_privilege_list ON grant_objects_type FROM role_name_list revoke_cascade_opt
+  {
+    $$ = &ast.Revoke{GrantOptionFor: $2, Privileges: $3, ObjectType: $5, Grantees: $7, Cascade: $8}
+  }
+
+alter_commands_list:
+  {
+    $$ = nil
+  }
+| alter_options
+  {
+    $$ = $1
+  }
+| alter_options ',' ORDER BY column_list
+  {
+    $$ = append($1,&ast.OrderByOption{Cols:$5})
+  }
+| alter_commands_modifier_list
+  {
+    $$ = $1
+  }
+| alter_commands_modifier_list ',' alter_options
+  {
+    $$ = append($1,$3...)
+  }
+| alter_commands_modifier_list ',' alter_options ',' ORDER BY column_list
+  {
+    $$ = append(append($1,$3...),&ast.OrderByOption{Cols:$7})
+  }
+
+alter_options:
+  alter_option
+  {
+    $$ = []ast.AlterOption{$1}
+  }
+| alter_options ',' alter_option
+  {
+    $$ = append($1,$3)
+  }
+| alter_options ',' alter_commands_modifier
+  {
+    $$ = append($1,$3)
+  }
+
+alter_option:
+  space_separated_table_option_list
+  {
+    $$ = $1
+  }
+| alter_owner
+  {
+    $$ = $1
+  }
+| ADD check_constraint_definition
+  {
+    $$ = &ast.AddConstraintDefinition{ConstraintDefinition: $2}
+  }
+| ADD constraint_definition
+  {
+    $$ = &ast.AddConstraintDefinition{ConstraintDefinition: $2}
+  }
+| ADD index_definition
+  {
+    $$ = &ast.AddIndexDefinition{IndexDefinition: $2}
+  }
+| ADD column_opt '(' column_definition_list ')'
+  {
+    $$ = &ast.AddColumns{Columns: $4}
+  }
+| ADD column_opt column_definition first_opt after_opt
+  {
+    $$ = &ast.AddColumns{Columns: []*ast.ColumnDefinition{$3}, First:$4, After:$5}
+  }
+| ALTER column_opt column_name DROP DEFAULT
+  {
+    $$ = &ast.AlterColumn{Column: $3, DropDefault:true}
+  }
+| ALTER column_opt column_name SET DEFAULT signed_literal_or_null
+  {
+    $$ = &ast.AlterColumn{Column: $3, DropDefault:false, DefaultVal:$6}
+  }
+| ALTER column_opt column_name SET DEFAULT openb expression closeb
+  {
+	$$ = &ast.AlterColumn{Column: $3, DropDefault:false, DefaultVal:$7}
+  }
+| ALTER column_opt column_name SET DEFAULT function_call_generic
+  {
+    $$ = &ast.AlterColumn{Column: $3, DropDefault:false, DefaultVal:$6}
+  }
+| ALTER column_opt column_name SET VISIBLE
+  {
+    val := false
+    $$ = &ast.AlterColumn{Column: $3, Invisible:&val}
+  }
+| ALTER column_opt column_name SET INVISIBLE
+  {
+    val := true
+    $$ = &ast.AlterColumn{Column: $3, Invisible:&val}
+  }
+| ALTER column_opt column_name ADD GENERATED identity_kind AS IDENTITY identity_sequence_opt
+  {
+    $$ = &ast.AlterColumn{Column: $3, Identity: $6, IdentitySequence: $9}
+  }
+| ALTER CHECK id_or_var enforced
+  {
+    $$ = &ast.AlterCheck{Name: $3, Enforced: $4}
+  }
+| ALTER INDEX id_or_var VISIBLE
+  {
+    $$ = &ast.AlterIndex{Name: $3, Invisible: false}
+  }
+| ALTER INDEX id_or_var INVISIBLE
+  {
+    $$ = &ast.AlterIndex{Name: $3, Invisible: true}
+  }
+| CHANGE column_opt column_name column_definition first_opt after_opt
+  {
+    $$ = &ast.ChangeColumn{OldColumn:$3, NewColDefinition:$4, First:$5, After:$6}
+  }
+| MODIFY column_opt column_definition first_opt after_opt
+  {
+    $$ = &ast.ModifyColumn{NewColDefinition:$3, First:$4, After:$5}
+  }
+| CONVERT TO charset_or_character_set charset collate_opt
+  {
+    $$ = &ast.AlterCharset{CharacterSet:$4, Collate:$5}
+  }
+| DISABLE KEYS
+  {
+    $$ = &ast.KeyState{Enable:false}
+  }
+| ENABLE KEYS
+  {
+    $$ = &ast.KeyState{Enable:true}
+  }
+| ENABLE ROW LEVEL SECURITY
+  {
+    $$ = &ast.RowLevelSecurity{Action: ast.RowLevelSecurityEnableStr}
+  }
+| DISABLE ROW LEVEL SECURITY
+  {
+    $$ = &ast.RowLevelSecurity{Action: ast.RowLevelSecurityDisableStr}
+  }
+| FORCE ROW LEVEL SECURITY
+  {
+    $$ = &ast.RowLevelSecurity{Action: ast.RowLevelSecurityForceStr}
+  }
+| NO FORCE ROW LEVEL SECURITY
+  {
+    $$ = &ast.RowLevelSecurity{Action: ast.RowLevelSecurityNoForceStr}
+  }
+| DISCARD TABLESPACE
+  {
+    $$ = &ast.TablespaceOperation{Import:false}
+  }
+| IMPORT TABLESPACE
+  {
+    $$ = &ast.TablespaceOperation{Import:true}
+  }
+| DROP column_opt column_name
+  {
+    $$ = &ast.DropColumn{Name:$3}
+  }
+| DROP index_or_key id_or_var
+  {
+    $$ = &ast.DropKey{Type: ast.NormalKeyType, Name:$3}
+  }
+| DROP PRIMARY KEY
+  {
+    $$ = &ast.DropKey{Type: ast.PrimaryKeyType}
+  }
+| DROP FOREIGN KEY id_or_var
+  {
+    $$ = &ast.DropKey{Type: ast.ForeignKeyType, Name:$4}
+  }
+| DROP CHECK id_or_var
+  {
+    $$ = &ast.DropKey{Type: ast.CheckKeyType, Name:$3}
+  }
+| DROP CONSTRAINT id_or_var
+  {
+    $$ = &ast.DropKey{Type: ast.CheckKeyType, Name:$3}
+  }
+| FORCE
+  {
+    $$ = &ast.Force{}
+  }
+| RENAME to_opt table_name
+  {
+    $$ = &ast.RenameTableName{Table:$3}
+  }
+| RENAME index_or_key id_or_var TO id_or_var
+  {
+    $$ = &ast.RenameIndex{OldName:$3, NewName:$5}
+  }
+
+alter_commands_modifier_list:
+  alter_commands_modifier
+  {
+    $$ = []ast.AlterOption{$1}
+  }
+| alter_commands_modifier_list ',' alter_commands_modifier
+  {
+    $$ = append($1,$3)
+  }
+
+alter_commands_modifier:
+  LOCK equal_opt DEFAULT
+    {
+      $$ = &ast.LockOption{Type: ast.DefaultType}
+    }
+  | LOCK equal_opt NONE
+    {
+      $$ = &ast.LockOption{Type: ast.NoneType}
+    }
+  | LOCK equal_opt SHARED
+    {
+      $$ = &ast.LockOption{Type: ast.SharedType}
+    }
+  | LOCK equal_opt EXCLUSIVE
+    {
+      $$ = &ast.LockOption{Type: ast.ExclusiveType}
+    }
+  | WITH VALIDATION
+    {
+      $$ = &ast.Validation{With:true}
+    }
+  | WITHOUT VALIDATION
+    {
+      $$ = &ast.Validation{With:false}
+    }
+
+alter_statement:
+  alter_table_prefix alter_commands_list
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $$ = $1
+  }
+| alter_table_prefix ATTACH PARTITION table_name partition_bound
+  {
+    $1.FullyParsed = true
+    $1.PartitionSpec = &ast.PartitionSpec{Action: ast.AttachAction, TableName: $4, Bound: $5}
+    $$ = $1
+  }
+| alter_table_prefix DETACH PARTITION table_name
+  {
+    $1.FullyParsed = true
+    $1.PartitionSpec = &ast.PartitionSpec{Action: ast.DetachAction, TableName: $4}
+    $$ = $1
+  }
+| ALTER comment_opt INDEX table_name ATTACH PARTITION table_name
+  {
+    $$ = &ast.AlterIndexPartition{Comments: ast.Comments($2).Parsed(), Name: $4, AttachPartition: $7}
+  }
+| ALTER comment_opt function_or_procedure table_name function_arguments_opt alter_owner
+  {
+    $$ = &ast.AlterFunction{Comments: ast.Comments($2).Parsed(), Procedure: $3, Name: $4, Arguments: $5, AlterOptions: []ast.AlterOption{$6}}
+  }
+| alter_schema_prefix alter_schema_commands_list
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $$ = $1
+  }
+| alter_sequence_prefix sequence_spec
+  {
+    $1.SequenceSpec = $2
+    $1.FullyParsed = true
+    $$ = $1
+  }
+| alter_sequence_prefix alter_owner
+  {
+    $1.AlterOptions = []ast.AlterOption{$2}
+    $1.FullyParsed = true
+    $$ = $1
+  }
+| ALTER comment_opt definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
+  {
+    $$ = &ast.AlterView{ViewName: $6.ToViewName(), Comments: ast.Comments($2).Parsed(), Definer: $3 ,Security:$4, Columns:$7, Select: $9, CheckOption: $10 }
+  }
+| ALTER comment_opt definer_opt security_view_opt VIEW table_name alter_owner
+  {
+    $$ = &ast.AlterObjectOwner{Comments: ast.Comments($2).Parsed(), ObjectType: ast.ViewObjectStr, Object: $6, Owner: $7.(*ast.AlterOwner).Owner}
+  }
+| ALTER comment_opt owned_object_type table_name alter_owner
+  {
+    $$ = &ast.AlterObjectOwner{Comments: ast.Comments($2).Parsed(), ObjectType: $3, Object: $4, Owner: $5.(*ast.AlterOwner).Owner}
+  }
+| ALTER comment_opt PUBLICATION sql_id publication_action publication_objects
+  {
+    $$ = &ast.AlterPublication{Comments: ast.Comments($2).Parsed(), Name: $4, Action: $5, Tables: $6.Tables, Schemas: $6.Schemas}
+  }
+| ALTER comment_opt PUBLICATION sql_id alter_owner
+  {
+    $$ = &ast.AlterObjectOwner{Comments: ast.Comments($2).Parsed(), ObjectType: ast.PublicationObjectStr, Object: ast.TableName{Name: ast.NewTableIdent($4.String())}, Owner: $5.(*ast.AlterOwner).Owner}
+  }
+| ALTER comment_opt DEFAULT PRIVILEGES default_privileges_roles_opt default_privileges_schemas_opt default_privileges_action
+  {
+    $$ = &ast.AlterDefaultPrivileges{Comments: ast.Comments($2).Parsed(), Roles: $5, Schemas: $6, Action: $7}
+  }
+// The syntax here causes a shift / reduce issue, because ENCRYPTION is a non reserved keyword
+// and the database identifier is optional. When no identifier is given, the current database
+// is used. This means though that `alter database encryption` is ambiguous whether it means
+// the encryption keyword, or the encryption database name, resulting in the conflict.
+// The preference here is to shift, so it is treated as a database name. This matches the PostgresQL
+// behavior as well.
+| alter_database_prefix table_id_opt create_options
+  {
+    $1.FullyParsed = true
+    $1.DBName = $2
+    $1.AlterOptions = $3
+    $$ = $1
+  }
+| alter_database_prefix table_id alter_owner
+  {
+    $$ = &ast.AlterObjectOwner{ObjectType: ast.DatabaseObjectStr, Object: ast.TableName{Name: $2}, Owner: $3.(*ast.AlterOwner).Owner}
+  }
+| ALTER comment_opt VSCHEMA CREATE VINDEX table_name vindex_type_opt vindex_params_opt
+  {
+    $$ = &ast.AlterVschema{
+        Action: ast.CreateVindexDDLAction,
+        Table: $6,
+        VindexSpec: &ast.VindexSpec{
+          Name: ast.NewColIdent($6.Name.String()),
+          Type: $7,
+          Params: $8,
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA DROP VINDEX table_name
+  {
+    $$ = &ast.AlterVschema{
+        Action: ast.DropVindexDDLAction,
+        Table: $6,
+        VindexSpec: &ast.VindexSpec{
+          Name: ast.NewColIdent($6.Name.String()),
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA ADD TABLE table_name
+  {
+    $$ = &ast.AlterVschema{Action: ast.AddVschemaTableDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA DROP TABLE table_name
+  {
+    $$ = &ast.AlterVschema{Action: ast.DropVschemaTableDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA ON table_name ADD VINDEX sql_id '(' column_list ')' vindex_type_opt vindex_params_opt
+  {
+    $$ = &ast.AlterVschema{
+        Action: ast.AddColVindexDDLAction,
+        Table: $5,
+        VindexSpec: &ast.VindexSpec{
+            Name: $8,
+            Type: $12,
+            Params: $13,
+        },
+        VindexCols: $10,
+      }
+  }
+| ALTER comment_opt VSCHEMA ON table_name DROP VINDEX sql_id
+  {
+    $$ = &ast.AlterVschema{
+        Action: ast.DropColVindexDDLAction,
+        Table: $5,
+        VindexSpec: &ast.VindexSpec{
+            Name: $8,
+        },
+      }
+  }
+| ALTER comment_opt VSCHEMA ADD SEQUENCE table_name
+  {
+    $$ = &ast.AlterVschema{Action: ast.AddSequenceDDLAction, Table: $6}
+  }
+| ALTER comment_opt VSCHEMA ON table_name ADD AUTO_INCREMENT sql_id USING table_name
+  {
+    $$ = &ast.AlterVschema{
+        Action: ast.AddAutoIncDDLAction,
+        Table: $5,
+        AutoIncSpec: &ast.AutoIncSpec{
+            Column: $8,
+            Sequence: $10,
+        },
+    }
+  }
+
+json_table_function:
+  JSON_TABLE openb expression ',' text_literal_or_arg jt_columns_clause closeb as_opt_id
+  {
+    $$ = &ast.JSONTableExpr{Expr: $3, Filter: $5, Columns: $6, Alias: $8}
+  }
+
+jt_columns_clause:
+  COLUMNS openb columns_list closeb
+  {
+    $$= $3
+  }
+
+columns_list:
+  jt_column
+  {
+    $$= []*ast.JtColumnDefinition{$1}
+  }
+| columns_list ',' jt_column
+  {
+    $$ = append($1, $3)
+  }
+
+jt_column:
+ sql_id FOR ORDINALITY
+  {
+    $$ = &ast.JtColumnDefinition{JtOrdinal: &ast.JtOrdinalColDef{Name: $1}}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg
+  {
+    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
+    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6}
+    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty
+  {
+    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
+    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7}
+    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_error
+  {
+    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
+    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, ErrorOnResponse: $7}
+    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+  }
+| sql_id column_type collate_opt jt_exists_opt PATH text_literal_or_arg on_empty on_error
+  {
+    $2.Options= &ast.ColumnTypeOptions{Collate:$3}
+    jtPath := &ast.JtPathColDef{Name: $1, Type: $2, JtColExists: $4, Path: $6, EmptyOnResponse: $7, ErrorOnResponse: $8}
+    $$ = &ast.JtColumnDefinition{JtPath: jtPath}
+  }
+| NESTED jt_path_opt text_literal_or_arg jt_columns_clause
+  {
+    jtNestedPath := &ast.JtNestedPathColDef{Path: $3, Columns: $4}
+    $$ = &ast.JtColumnDefinition{JtNestedPath: jtNestedPath}
+  }
+
+jt_path_opt:
+  {
+    $$ = false
+  }
+| PATH
+  {
+    $$ = true
+  }
+jt_exists_opt:
+  {
+    $$=false
+  }
+| EXISTS
+  {
+    $$=true
+  }
+
+on_empty:
+  json_on_response ON EMPTY
+  {
+    $$= $1
+  }
+
+on_error:
+  json_on_response ON ERROR
+  {
+    $$= $1
+  }
+
+json_on_response:
+  ERROR
+  {
+    $$ = &ast.JtOnResponse{ResponseType: ast.ErrorJSONType}
+  }
+| NULL
+  {
+    $$ = &ast.JtOnResponse{ResponseType: ast.NullJSONType}
+  }
+| DEFAULT text_literal_or_arg
+  {
+    $$ = &ast.JtOnResponse{ResponseType: ast.DefaultJSONType, Expr: $2}
+  }
+
+rename_statement:
+  RENAME TABLE rename_list
+  {
+    $$ = &ast.RenameTable{TablePairs: $3}
+  }
+
+rename_list:
+  table_name TO table_name
+  {
+    $$ = []*ast.RenameTablePair{{FromTable: $1, ToTable: $3}}
+  }
+| rename_list ',' table_name TO table_name
+  {
+    $$ = append($1, &ast.RenameTablePair{FromTable: $3, ToTable: $5})
+  }
+
+drop_statement:
+  DROP comment_opt temp_opt TABLE exists_opt table_name_list restrict_or_cascade_opt
+  {
+    $$ = &ast.DropTable{FromTables: $6, IfExists: $5, Comments: ast.Comments($2).Parsed(), Temp: $3}
+  }
+| DROP comment_opt INDEX id_or_var ON table_name
+  {
+    // Change this to an alter statement
+    if $4.Lowered() == "primary" {
+      $$ = &ast.AlterTable{FullyParsed:true, Table: $6,AlterOptions: append([]ast.AlterOption{&ast.DropKey{Type: ast.PrimaryKeyType}})}
+    } else {
+      $$ = &ast.AlterTable{FullyParsed: true, Table: $6,AlterOptions: append([]ast.AlterOption{&ast.DropKey{Type: ast.NormalKeyType, Name:$4}})}
+    }
+  }
+| DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
+  {
+    $$ = &ast.DropView{FromTables: $5, Comments: ast.Comments($2).Parsed(), IfExists: $4}
+  }
+| DROP comment_opt database exists_opt table_id
+  {
+    $$ = &ast.DropDatabase{Comments: ast.Comments($2).Parsed(), DBName: $5, IfExists: $4}
+  }
+
+truncate_statement:
+  TRUNCATE TABLE table_name
+  {
+    $$ = &ast.TruncateTable{Table: $3}
+  }
+| TRUNCATE table_name
+  {
+    $$ = &ast.TruncateTable{Table: $2}
+  }
+refresh_statement:
+  REFRESH comment_opt MATERIALIZED VIEW concurrently_opt table_name with_data_opt
+  {
+    $$ = &ast.RefreshMaterializedView{Comments: ast.Comments($2).Parsed(), Concurrently: $5, ViewName: $6, WithData: $7}
+  }
+
+analyze_statement:
+  ANALYZE TABLE table_name
+  {
+    $$ = &ast.OtherRead{}
+  }
+
+show_statement:
+  SHOW charset_or_character_set like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Charset, Filter: $3}}
+  }
+| SHOW COLLATION like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Collation, Filter: $3}}
+  }
+| SHOW full_opt columns_or_fields from_or_in table_name from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Full: $2, Command: ast.Column, Tbl: $5, DbName: $6, Filter: $7}}
+  }
+| SHOW DATABASES like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: $3}}
+  }
+| SHOW SCHEMAS like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: $3}}
+  }
+| SHOW KEYSPACES like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Keyspace, Filter: $3}}
+  }
+| SHOW FUNCTION STATUS like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Function, Filter: $4}}
+  }
+| SHOW extended_opt index_symbols from_or_in table_name from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Index, Tbl: $5, DbName: $6, Filter: $7}}
+  }
+| SHOW OPEN TABLES from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.OpenTable, DbName:$4, Filter: $5}}
+  }
+| SHOW PRIVILEGES
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Privilege}}
+  }
+| SHOW PROCEDURE STATUS like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Procedure, Filter: $4}}
+  }
+| SHOW session_or_local_opt STATUS like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusSession, Filter: $4}}
+  }
+| SHOW GLOBAL STATUS like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusGlobal, Filter: $4}}
+  }
+| SHOW session_or_local_opt VARIABLES like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableSession, Filter: $4}}
+  }
+| SHOW GLOBAL VARIABLES like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableGlobal, Filter: $4}}
+  }
+| SHOW TABLE STATUS from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.TableStatus, DbName:$4, Filter: $5}}
+  }
+| SHOW full_opt TABLES from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Table, Full: $2, DbName:$4, Filter: $5}}
+  }
+| SHOW TRIGGERS from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Trigger, DbName:$3, Filter: $4}}
+  }
+| SHOW CREATE DATABASE table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateDb, Op: $4}}
+  }
+| SHOW CREATE EVENT table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateE, Op: $4}}
+  }
+| SHOW CREATE FUNCTION table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateF, Op: $4}}
+  }
+| SHOW CREATE PROCEDURE table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateProc, Op: $4}}
+  }
+| SHOW CREATE TABLE table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTbl, Op: $4}}
+  }
+| SHOW CREATE TRIGGER table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTr, Op: $4}}
+  }
+| SHOW CREATE VIEW table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateV, Op: $4}}
+  }
+| SHOW PLUGINS
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Plugins}}
+  }
+| SHOW GLOBAL GTID_EXECUTED from_database_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.GtidExecGlobal, DbName: $4}}
+  }
+| SHOW GLOBAL VGTID_EXECUTED from_database_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VGtidExecGlobal, DbName: $4}}
+  }
+| SHOW VSCHEMA TABLES
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaTables}}
+  }
+| SHOW VSCHEMA VINDEXES
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes}}
+  }
+| SHOW VSCHEMA VINDEXES from_or_on table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes, Tbl: $5}}
+  }
+| SHOW WARNINGS
+  {
+    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Warnings}}
+  }
+/*
+ * Catch-all for show statements without vitess keywords:
+ */
+| SHOW id_or_var ddl_skip_to_end
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2.String())}}
+  }
+| SHOW CREATE USER ddl_skip_to_end
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3)}}
+   }
+| SHOW BINARY id_or_var ddl_skip_to_end /* SHOW BINARY ... */
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + $3.String()}}
+  }
+| SHOW BINARY LOGS ddl_skip_to_end /* SHOW BINARY LOGS */
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3)}}
+  }
+| SHOW FUNCTION CODE table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3) + " " + ast.String($4)}}
+  }
+| SHOW PROCEDURE CODE table_name
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3) + " " + ast.String($4)}}
+  }
+| SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($3)}}
+  }
+| SHOW STORAGE ddl_skip_to_end
+  {
+    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2)}}
+  }
+
+extended_opt:
+  /* empty */
+  {
+    $$ = ""
+  }
+  | EXTENDED
+  {
+    $$ = "extended "
+  }
+
+full_opt:
+  /* empty */
+  {
+    $$ = false
+  }
+| FULL
+  {
+    $$ = true
+  }
+
+columns_or_fields:
+  COLUMNS
+  {
+      $$ = string($1)
+  }
+| FIELDS
+  {
+      $$ = string($1)
+  }
+
+from_database_opt:
+  /* empty */
+  {
+    $$ = ast.NewTableIdent("")
+  }
+| FROM table_id
+  {
+    $$ = $2
+  }
+| IN table_id
+  {
+    $$ = $2
+  }
+
+like_or_where_opt:
+  /* empty */
+  {
+    $$ = nil
+  }
+| LIKE STRING
+  {
+    $$ = &ast.ShowFilter{Like:string($2)}
+  }
+| WHERE expression
+  {
+    $$ = &ast.ShowFilter{Filter:$2}
+  }
+
+session_or_local_opt:
+  /* empty */
+  {
+    $$ = struct{}{}
+  }
+| SESSION
+  {
+    $$ = struct{}{}
+  }
+| LOCAL
+  {
+    $$ = struct{}{}
+  }
+
+from_or_on:
+  FROM
+  {
+    $$ = string($1)
+  }
+| ON
+  {
+    $$ = string($1)
+  }
+
+use_statement:
+  USE table_id
+  {
+    $$ = &ast.Use{DBName: $2}
+  }
+| USE
+  {
+    $$ = &ast.Use{DBName:ast.TableIdent{V:""}}
+  }
+| USE table_id AT_ID
+  {
+    $$ = &ast.Use{DBName:ast.NewTableIdent($2.String()+"@"+string($3))}
+  }
+
+begin_statement:
+  BEGIN
+  {
+    $$ = &ast.Begin{}
+  }
+| START TRANSACTION
+  {
+    $$ = &ast.Begin{}
+  }
+
+commit_statement:
+  COMMIT
+  {
+    $$ = &ast.Commit{}
+  }
+
+rollback_statement:
+  ROLLBACK
+  {
+    $$ = &ast.Rollback{}
+  }
+| ROLLBACK work_opt TO savepoint_opt sql_id
+  {
+    $$ = &ast.SRollback{Name: $5}
+  }
+
+work_opt:
+  { $$ = struct{}{} }
+| WORK
+  { $$ = struct{}{} }
+
+savepoint_opt:
+  { $$ = struct{}{} }
+| SAVEPOINT
+  { $$ = struct{}{} }
+
+
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &ast.Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &ast.Release{Name: $3}
+  }
+
+explain_format_opt:
+  {
+    $$ = ast.EmptyType
+  }
+| FORMAT '=' JSON
+  {
+    $$ = ast.JSONType
+  }
+| FORMAT '=' TREE
+  {
+    $$ = ast.TreeType
+  }
+| FORMAT '=' TRADITIONAL
+  {
+    $$ = ast.TraditionalType
+  }
+| ANALYSE
+  {
+    $$ = ast.AnalyzeType
+  }
+| ANALYZE
+  {
+    $$ = ast.AnalyzeType
+  }
+
+explain_synonyms:
+  EXPLAIN
+  {
+    $$ = $1
+  }
+| DESCRIBE
+  {
+    $$ = $1
+  }
+| DESC
+  {
+    $$ = $1
+  }
+
+explainable_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| update_statement
+  {
+    $$ = $1
+  }
+| insert_statement
+  {
+    $$ = $1
+  }
+| delete_statement
+  {
+    $$ = $1
+  }
+
+wild_opt:
+  {
+    $$ = ""
+  }
+| sql_id
+  {
+    $$ = $1.Val
+  }
+| STRING
+  {
+    $$ = sql_types.EncodeStringSQL($1)
+  }
+
+explain_statement:
+  explain_synonyms table_name wild_opt
+  {
+    $$ = &ast.ExplainTab{Table: $2, Wild: $3}
+  }
+| explain_synonyms explain_format_opt explainable_statement
+  {
+    $$ = &ast.ExplainStmt{Type: $2, Statement: $3}
+  }
+
+other_statement:
+  REPAIR skip_to_end
+  {
+    $$ = &ast.OtherAdmin{}
+  }
+| OPTIMIZE skip_to_end
+  {
+    $$ = &ast.OtherAdmin{}
+  }
+
+lock_statement:
+  LOCK TABLES lock_table_list
+  {
+    $$ = &ast.LockTables{Tables: $3}
+  }
+
+lock_table_list:
+  lock_table
+  {
+    $$ = ast.TableAndLockTypes{$1}
+  }
+| lock_table_list ',' lock_table
+  {
+    $$ = append($1, $3)
+  }
+
+lock_table:
+  aliased_table_name lock_type
+  {
+    $$ = &ast.TableAndLockType{Table:$1, Lock:$2}
+  }
+
+lock_type:
+  READ
+  {
+    $$ = ast.Read
+  }
+| READ LOCAL
+  {
+    $$ = ast.ReadLocal
+  }
+| WRITE
+  {
+    $$ = ast.Write
+  }
+| LOW_PRIORITY WRITE
+  {
+    $$ = ast.LowPriorityWrite
+  }
+
+unlock_statement:
+  UNLOCK TABLES
+  {
+    $$ = &ast.UnlockTables{}
+  }
+
+flush_statement:
+  FLUSH local_opt flush_option_list
+  {
+    $$ = &ast.Flush{IsLocal: $2, FlushOptions:$3}
+  }
+| FLUSH local_opt TABLES
+  {
+    $$ = &ast.Flush{IsLocal: $2}
+  }
+| FLUSH local_opt TABLES WITH READ LOCK
+  {
+    $$ = &ast.Flush{IsLocal: $2, WithLock:true}
+  }
+| FLUSH local_opt TABLES table_name_list
+  {
+    $$ = &ast.Flush{IsLocal: $2, TableNames:$4}
+  }
+| FLUSH local_opt TABLES table_name_list WITH READ LOCK
+  {
+    $$ = &ast.Flush{IsLocal: $2, TableNames:$4, WithLock:true}
+  }
+| FLUSH local_opt TABLES table_name_list FOR EXPORT
+  {
+    $$ = &ast.Flush{IsLocal: $2, TableNames:$4, ForExport:true}
+  }
+
+flush_option_list:
+  flush_option
+  {
+    $$ = []string{$1}
+  }
+| flush_option_list ',' flush_option
+  {
+    $$ = append($1,$3)
+  }
+
+flush_option:
+  BINARY LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| ERROR LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| GENERAL LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| HOSTS
+  {
+    $$ = string($1)
+  }
+| LOGS
+  {
+    $$ = string($1)
+  }
+| PRIVILEGES
+  {
+    $$ = string($1)
+  }
+| RELAY LOGS for_channel_opt
+  {
+    $$ = string($1) + " " + string($2) + $3
+  }
+| SLOW LOGS
+  {
+    $$ = string($1) + " " + string($2)
+  }
+| OPTIMIZER_COSTS
+  {
+    $$ = string($1)
+  }
+| STATUS
+  {
+    $$ = string($1)
+  }
+| USER_RESOURCES
+  {
+    $$ = string($1)
+  }
+
+local_opt:
+  {
+    $$ = false
+  }
+| LOCAL
+  {
+    $$ = true
   }
-
-on_error:
-  json_on_response ON ERROR
+| NO_WRITE_TO_BINLOG
   {
-    $$= $1
+    $$ = true
   }
 
-json_on_response:
-  ERROR
+for_channel_opt:
   {
-    $$ = &ast.JtOnResponse{ResponseType: ast.ErrorJSONType}
+    $$ = ""
   }
-| NULL
+| FOR CHANNEL id_or_var
   {
-    $$ = &ast.JtOnResponse{ResponseType: ast.NullJSONType}
+    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
   }
-| DEFAULT text_literal_or_arg
+
+comment_statement:
+  comment_on_prefix schema_name IS comment_value
   {
-    $$ = &ast.JtOnResponse{ResponseType: ast.DefaultJSONType, Expr: $2}
+    $$ = &ast.CommentOnSchema{Comments: ast.Comments($1).Parsed(), Schema: $2.Name, Value: $4}
   }
-
-rename_statement:
-  RENAME TABLE rename_list
+| comment_on_prefix comment_object_type table_name IS comment_value
   {
-    $$ = &ast.RenameTable{TablePairs: $3}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: $2, Object: $3, Value: $5}
   }
-
-rename_list:
-  table_name TO table_name
+| comment_on_prefix COLUMN column_name IS comment_value
   {
-    $$ = []*ast.RenameTablePair{{FromTable: $1, ToTable: $3}}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.ColumnObjectStr, Object: $3.Qualifier, Name: $3.Name, Value: $5}
   }
-| rename_list ',' table_name TO table_name
+| comment_on_prefix function_or_procedure table_name function_arguments_opt IS comment_value
   {
-    $$ = append($1, &ast.RenameTablePair{FromTable: $3, ToTable: $5})
+    objectType := ast.FunctionObjectStr
+    if $2 {
+      objectType = ast.ProcedureObjectStr
+    }
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: objectType, Object: $3, Arguments: $4, Value: $6}
   }
-
-drop_statement:
-  DROP comment_opt temp_opt TABLE exists_opt table_name_list restrict_or_cascade_opt
+| comment_on_prefix CONSTRAINT sql_id ON table_name IS comment_value
   {
-    $$ = &ast.DropTable{FromTables: $6, IfExists: $5, Comments: ast.Comments($2).Parsed(), Temp: $3}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.ConstraintObjectStr, Object: $5, Name: $3, Value: $7}
   }
-| DROP comment_opt INDEX id_or_var ON table_name
+| comment_on_prefix TRIGGER sql_id ON table_name IS comment_value
   {
-    // Change this to an alter statement
-    if $4.Lowered() == "primary" {
-      $$ = &ast.AlterTable{FullyParsed:true, Table: $6,AlterOptions: append([]ast.AlterOption{&ast.DropKey{Type: ast.PrimaryKeyType}})}
-    } else {
-      $$ = &ast.AlterTable{FullyParsed: true, Table: $6,AlterOptions: append([]ast.AlterOption{&ast.DropKey{Type: ast.NormalKeyType, Name:$4}})}
-    }
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.TriggerObjectStr, Object: $5, Name: $3, Value: $7}
   }
-| DROP comment_opt VIEW exists_opt view_name_list restrict_or_cascade_opt
+| comment_on_prefix POLICY sql_id ON table_name IS comment_value
   {
-    $$ = &ast.DropView{FromTables: $5, Comments: ast.Comments($2).Parsed(), IfExists: $4}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.PolicyObjectStr, Object: $5, Name: $3, Value: $7}
   }
-| DROP comment_opt database exists_opt table_id
+| comment_on_prefix RULE sql_id ON table_name IS comment_value
   {
-    $$ = &ast.DropDatabase{Comments: ast.Comments($2).Parsed(), DBName: $5, IfExists: $4}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.RuleObjectStr, Object: $5, Name: $3, Value: $7}
   }
-
-truncate_statement:
-  TRUNCATE TABLE table_name
+| comment_on_prefix PUBLICATION sql_id IS comment_value
   {
-    $$ = &ast.TruncateTable{Table: $3}
+    $$ = &ast.CommentOn{Comments: ast.Comments($1).Parsed(), ObjectType: ast.PublicationObjectStr, Object: ast.TableName{Name: ast.NewTableIdent($3.String())}, Value: $5}
   }
-| TRUNCATE table_name
+
+comment_on_prefix:
   {
-    $$ = &ast.TruncateTable{Table: $2}
+    setAllowComments(psqlex, true)
   }
-analyze_statement:
-  ANALYZE TABLE table_name
+  COMMENT comment_list ON
   {
-    $$ = &ast.OtherRead{}
+    // The mid-rule action shifts the arguments
+    $$ = $3
+    setAllowComments(psqlex, false)
   }
 
-show_statement:
-  SHOW charset_or_character_set like_or_where_opt
+comment_object_type:
+  TABLE
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Charset, Filter: $3}}
+    $$ = ast.TableObjectStr
   }
-| SHOW COLLATION like_or_where_opt
+| VIEW
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Collation, Filter: $3}}
+    $$ = ast.ViewObjectStr
   }
-| SHOW full_opt columns_or_fields from_or_in table_name from_database_opt like_or_where_opt
+| MATERIALIZED VIEW
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Full: $2, Command: ast.Column, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = ast.MaterializedViewObjectStr
   }
-| SHOW DATABASES like_or_where_opt
+| FOREIGN TABLE
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: $3}}
+    $$ = ast.ForeignTableObjectStr
   }
-| SHOW SCHEMAS like_or_where_opt
+| INDEX
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: $3}}
+    $$ = ast.IndexObjectStr
   }
-| SHOW KEYSPACES like_or_where_opt
+| SEQUENCE
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Keyspace, Filter: $3}}
+    $$ = ast.SequenceObjectStr
   }
-| SHOW FUNCTION STATUS like_or_where_opt
+| TYPE
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Function, Filter: $4}}
+    $$ = ast.TypeObjectStr
   }
-| SHOW extended_opt index_symbols from_or_in table_name from_database_opt like_or_where_opt
+| DOMAIN
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Index, Tbl: $5, DbName: $6, Filter: $7}}
+    $$ = ast.DomainObjectStr
   }
-| SHOW OPEN TABLES from_database_opt like_or_where_opt
+| EXTENSION
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.OpenTable, DbName:$4, Filter: $5}}
+    $$ = ast.ExtensionObjectStr
   }
-| SHOW PRIVILEGES
+| DATABASE
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Privilege}}
+    $$ = ast.DatabaseObjectStr
   }
-| SHOW PROCEDURE STATUS like_or_where_opt
+
+comment_value:
+  text_literal_or_arg
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Procedure, Filter: $4}}
+    $$ = $1
   }
-| SHOW session_or_local_opt STATUS like_or_where_opt
+| NULL
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusSession, Filter: $4}}
+    $$ = &ast.NullVal{}
   }
-| SHOW GLOBAL STATUS like_or_where_opt
+
+create_policy:
+  CREATE comment_opt POLICY sql_id ON table_name policy_as_opt policy_for_opt policy_to_opt policy_using_opt policy_check_opt
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusGlobal, Filter: $4}}
+    $$ = &ast.CreatePolicy{Comments: ast.Comments($2).Parsed(), Name: $4, Table: $6, Restrictive: $7, Command: $8, Roles: $9, Using: $10, WithCheck: $11}
   }
-| SHOW session_or_local_opt VARIABLES like_or_where_opt
+
+// PERMISSIVE and RESTRICTIVE are not keywords
+policy_as_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableSession, Filter: $4}}
+    $$ = false
   }
-| SHOW GLOBAL VARIABLES like_or_where_opt
+| AS ID
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableGlobal, Filter: $4}}
+    switch ast.NewColIdent($2).Lowered() {
+    case "permissive":
+      $$ = false
+    case "restrictive":
+      $$ = true
+    default:
+      psqlex.Error("expecting permissive or restrictive")
+      return 1
+    }
   }
-| SHOW TABLE STATUS from_database_opt like_or_where_opt
+
+policy_for_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.TableStatus, DbName:$4, Filter: $5}}
+    $$ = ast.PolicyAllStr
   }
-| SHOW full_opt TABLES from_database_opt like_or_where_opt
+| FOR ALL
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Table, Full: $2, DbName:$4, Filter: $5}}
+    $$ = ast.PolicyAllStr
   }
-| SHOW TRIGGERS from_database_opt like_or_where_opt
+| FOR SELECT
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Trigger, DbName:$3, Filter: $4}}
+    $$ = ast.PolicySelectStr
   }
-| SHOW CREATE DATABASE table_name
+| FOR INSERT
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateDb, Op: $4}}
+    $$ = ast.PolicyInsertStr
   }
-| SHOW CREATE EVENT table_name
+| FOR UPDATE
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateE, Op: $4}}
+    $$ = ast.PolicyUpdateStr
   }
-| SHOW CREATE FUNCTION table_name
+| FOR DELETE
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateF, Op: $4}}
+    $$ = ast.PolicyDeleteStr
   }
-| SHOW CREATE PROCEDURE table_name
+
+policy_to_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateProc, Op: $4}}
+    $$ = nil
   }
-| SHOW CREATE TABLE table_name
+| TO role_name_list
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTbl, Op: $4}}
+    $$ = $2
   }
-| SHOW CREATE TRIGGER table_name
+
+policy_using_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTr, Op: $4}}
+    $$ = nil
   }
-| SHOW CREATE VIEW table_name
+| USING openb expression closeb
   {
-    $$ = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateV, Op: $4}}
+    $$ = $3
   }
-| SHOW PLUGINS
+
+policy_check_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Plugins}}
+    $$ = nil
   }
-| SHOW GLOBAL GTID_EXECUTED from_database_opt
+| WITH CHECK openb expression closeb
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.GtidExecGlobal, DbName: $4}}
+    $$ = $4
   }
-| SHOW GLOBAL VGTID_EXECUTED from_database_opt
+
+create_publication:
+  CREATE comment_opt PUBLICATION sql_id publication_for_opt storage_parameters_opt
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VGtidExecGlobal, DbName: $4}}
+    $5.Comments = ast.Comments($2).Parsed()
+    $5.Name = $4
+    $5.Parameters = $6
+    $$ = $5
   }
-| SHOW VSCHEMA TABLES
+
+publication_for_opt:
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaTables}}
+    $$ = &ast.CreatePublication{}
   }
-| SHOW VSCHEMA VINDEXES
+| FOR ALL TABLES
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes}}
+    $$ = &ast.CreatePublication{AllTables: true}
   }
-| SHOW VSCHEMA VINDEXES from_or_on table_name
+| FOR publication_objects
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes, Tbl: $5}}
+    $$ = $2
   }
-| SHOW WARNINGS
+
+publication_action:
+  ADD
   {
-    $$ = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Warnings}}
+    $$ = ast.PublicationAddStr
   }
-/*
- * Catch-all for show statements without vitess keywords:
- */
-| SHOW id_or_var ddl_skip_to_end
+| SET
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2.String())}}
+    $$ = ast.PublicationSetStr
   }
-| SHOW CREATE USER ddl_skip_to_end
+| DROP
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3)}}
-   }
-| SHOW BINARY id_or_var ddl_skip_to_end /* SHOW BINARY ... */
+    $$ = ast.PublicationDropStr
+  }
+
+// A bare name continues the list of the preceding TABLE or TABLES IN SCHEMA
+publication_objects:
+  publication_tables_tail
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + $3.String()}}
+    $$ = $1
   }
-| SHOW BINARY LOGS ddl_skip_to_end /* SHOW BINARY LOGS */
+| publication_schemas_tail
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3)}}
+    $$ = $1
   }
-| SHOW FUNCTION CODE table_name
+
+publication_tables_tail:
+  TABLE publication_table
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3) + " " + ast.String($4)}}
+    $$ = &ast.CreatePublication{Tables: []*ast.PublicationTable{$2}}
   }
-| SHOW PROCEDURE CODE table_name
+| publication_tables_tail ',' TABLE publication_table
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2) + " " + string($3) + " " + ast.String($4)}}
+    $1.Tables = append($1.Tables, $4)
+    $$ = $1
   }
-| SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
+| publication_schemas_tail ',' TABLE publication_table
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($3)}}
+    $1.Tables = append($1.Tables, $4)
+    $$ = $1
   }
-| SHOW STORAGE ddl_skip_to_end
+| publication_tables_tail ',' publication_table
   {
-    $$ = &ast.Show{Internal: &ast.ShowOther{Command: string($2)}}
+    $1.Tables = append($1.Tables, $3)
+    $$ = $1
   }
 
-extended_opt:
-  /* empty */
+publication_schemas_tail:
+  TABLES IN SCHEMA table_id
   {
-    $$ = ""
+    $$ = &ast.CreatePublication{Schemas: []ast.TableIdent{$4}}
   }
-  | EXTENDED
+| publication_schemas_tail ',' TABLES IN SCHEMA table_id
   {
-    $$ = "extended "
+    $1.Schemas = append($1.Schemas, $6)
+    $$ = $1
   }
-
-full_opt:
-  /* empty */
+| publication_tables_tail ',' TABLES IN SCHEMA table_id
   {
-    $$ = false
+    $1.Schemas = append($1.Schemas, $6)
+    $$ = $1
   }
-| FULL
+| publication_schemas_tail ',' table_id
   {
-    $$ = true
+    $1.Schemas = append($1.Schemas, $3)
+    $$ = $1
   }
 
-columns_or_fields:
-  COLUMNS
+publication_table:
+  only_opt table_name
   {
-      $$ = string($1)
+    $$ = &ast.PublicationTable{Only: $1, Table: $2}
   }
-| FIELDS
+| only_opt table_name '(' column_list ')'
   {
-      $$ = string($1)
+    $$ = &ast.PublicationTable{Only: $1, Table: $2, Columns: $4}
   }
-
-from_database_opt:
-  /* empty */
+| only_opt table_name WHERE openb expression closeb
   {
-    $$ = ast.NewTableIdent("")
+    $$ = &ast.PublicationTable{Only: $1, Table: $2, Where: $5}
   }
-| FROM table_id
+| only_opt table_name '(' column_list ')' WHERE openb expression closeb
   {
-    $$ = $2
+    $$ = &ast.PublicationTable{Only: $1, Table: $2, Columns: $4, Where: $8}
   }
-| IN table_id
+
+create_rule:
+  CREATE comment_opt replace_opt RULE sql_id AS ON rule_event TO table_name where_expression_opt DO rule_instead_opt rule_actions
   {
-    $$ = $2
+    $$ = &ast.CreateRule{Comments: ast.Comments($2).Parsed(), IsReplace: $3, Name: $5, Event: $8, Table: $10, Where: $11, Instead: $13, Actions: $14}
   }
 
-like_or_where_opt:
-  /* empty */
+rule_event:
+  SELECT
   {
-    $$ = nil
+    $$ = ast.RuleSelectStr
   }
-| LIKE STRING
+| INSERT
   {
-    $$ = &ast.ShowFilter{Like:string($2)}
+    $$ = ast.RuleInsertStr
   }
-| WHERE expression
+| UPDATE
   {
-    $$ = &ast.ShowFilter{Filter:$2}
+    $$ = ast.RuleUpdateStr
+  }
+| DELETE
+  {
+    $$ = ast.RuleDeleteStr
   }
 
-session_or_local_opt:
-  /* empty */
+rule_instead_opt:
   {
-    $$ = struct{}{}
+    $$ = false
   }
-| SESSION
+| ALSO
   {
-    $$ = struct{}{}
+    $$ = false
   }
-| LOCAL
+| INSTEAD
   {
-    $$ = struct{}{}
+    $$ = true
   }
 
-from_or_on:
-  FROM
+rule_actions:
+  NOTHING
   {
-    $$ = string($1)
+    $$ = nil
   }
-| ON
+| rule_action
   {
-    $$ = string($1)
+    $$ = []ast.Statement{$1}
   }
-
-use_statement:
-  USE table_id
+| openb rule_action_list closeb
   {
-    $$ = &ast.Use{DBName: $2}
+    $$ = $2
   }
-| USE
+
+rule_action_list:
+  rule_action
   {
-    $$ = &ast.Use{DBName:ast.TableIdent{V:""}}
+    $$ = []ast.Statement{$1}
   }
-| USE table_id AT_ID
+| rule_action_list ';' rule_action
   {
-    $$ = &ast.Use{DBName:ast.NewTableIdent($2.String()+"@"+string($3))}
+    $$ = append($1, $3)
   }
 
-begin_statement:
-  BEGIN
+rule_action:
+  select_statement
   {
-    $$ = &ast.Begin{}
+    $$ = $1
   }
-| START TRANSACTION
+| insert_statement
+| update_statement
+| delete_statement
+
+grant_statement:
+  GRANT comment_opt grant_privilege_list ON grant_target TO role_name_list with_grant_option_opt
   {
-    $$ = &ast.Begin{}
+    $5.Comments = ast.Comments($2).Parsed()
+    $5.Privileges = $3
+    $5.Grantees = $7
+    $5.WithGrantOption = $8
+    $$ = $5
   }
 
-commit_statement:
-  COMMIT
+revoke_statement:
+  REVOKE comment_opt grant_option_for_opt grant_privilege_list ON grant_target FROM role_name_list revoke_cascade_opt
   {
-    $$ = &ast.Commit{}
+    $$ = &ast.Revoke{Comments: ast.Comments($2).Parsed(), GrantOptionFor: $3, Privileges: $4, ObjectType: $6.ObjectType, AllInSchema: $6.AllInSchema, Objects: $6.Objects, Grantees: $8, Cascade: $9}
   }
 
-rollback_statement:
-  ROLLBACK
+with_grant_option_opt:
   {
-    $$ = &ast.Rollback{}
+    $$ = false
   }
-| ROLLBACK work_opt TO savepoint_opt sql_id
+| WITH GRANT OPTION
   {
-    $$ = &ast.SRollback{Name: $5}
+    $$ = true
   }
 
-work_opt:
-  { $$ = struct{}{} }
-| WORK
-  { $$ = struct{}{} }
-
-savepoint_opt:
-  { $$ = struct{}{} }
-| SAVEPOINT
-  { $$ = struct{}{} }
-
-
-savepoint_statement:
-  SAVEPOINT sql_id
+grant_option_for_opt:
   {
-    $$ = &ast.Savepoint{Name: $2}
+    $$ = false
   }
-
-release_statement:
-  RELEASE SAVEPOINT sql_id
+| GRANT OPTION FOR
   {
-    $$ = &ast.Release{Name: $3}
+    $$ = true
   }
 
-explain_format_opt:
+revoke_cascade_opt:
   {
-    $$ = ast.EmptyType
+    $$ = false
   }
-| FORMAT '=' JSON
+| RESTRICT
   {
-    $$ = ast.JSONType
+    $$ = false
   }
-| FORMAT '=' TREE
+| CASCADE
   {
-    $$ = ast.TreeType
+    $$ = true
   }
-| FORMAT '=' TRADITIONAL
+
+grant_privilege_list:
+  grant_privilege
   {
-    $$ = ast.TraditionalType
+    $$ = []*ast.GrantPrivilege{$1}
   }
-| ANALYSE
+| grant_privilege_list ',' grant_privilege
   {
-    $$ = ast.AnalyzeType
+    $$ = append($1, $3)
   }
-| ANALYZE
+
+grant_privilege:
+  grant_privilege_type
   {
-    $$ = ast.AnalyzeType
+    $$ = &ast.GrantPrivilege{Type: $1}
   }
-
-explain_synonyms:
-  EXPLAIN
+| grant_privilege_type '(' column_list ')'
   {
-    $$ = $1
+    $$ = &ast.GrantPrivilege{Type: $1, Columns: $3}
   }
-| DESCRIBE
+
+grant_privilege_type:
+  ALL
   {
-    $$ = $1
+    $$ = ast.AllPrivilegeStr
   }
-| DESC
+| ALL PRIVILEGES
   {
-    $$ = $1
+    $$ = ast.AllPrivilegeStr
   }
-
-explainable_statement:
-  select_statement
+| SELECT
   {
-    $$ = $1
+    $$ = ast.SelectPrivilegeStr
   }
-| update_statement
+| INSERT
   {
-    $$ = $1
+    $$ = ast.InsertPrivilegeStr
   }
-| insert_statement
+| UPDATE
   {
-    $$ = $1
+    $$ = ast.UpdatePrivilegeStr
   }
-| delete_statement
+| DELETE
   {
-    $$ = $1
+    $$ = ast.DeletePrivilegeStr
   }
-
-wild_opt:
+| TRUNCATE
   {
-    $$ = ""
+    $$ = ast.TruncatePrivilegeStr
   }
-| sql_id
+| REFERENCES
   {
-    $$ = $1.Val
+    $$ = ast.ReferencesPrivilegeStr
   }
-| STRING
+| TRIGGER
   {
-    $$ = sql_types.EncodeStringSQL($1)
+    $$ = ast.TriggerPrivilegeStr
   }
-
-explain_statement:
-  explain_synonyms table_name wild_opt
+| CREATE
   {
-    $$ = &ast.ExplainTab{Table: $2, Wild: $3}
+    $$ = ast.CreatePrivilegeStr
   }
-| explain_synonyms explain_format_opt explainable_statement
+| TEMPORARY
   {
-    $$ = &ast.ExplainStmt{Type: $2, Statement: $3}
+    $$ = ast.TemporaryPrivilegeStr
   }
-
-other_statement:
-  REPAIR skip_to_end
+| TEMP
   {
-    $$ = &ast.OtherAdmin{}
+    $$ = ast.TemporaryPrivilegeStr
   }
-| OPTIMIZE skip_to_end
+| EXECUTE
   {
-    $$ = &ast.OtherAdmin{}
+    $$ = ast.ExecutePrivilegeStr
   }
-
-lock_statement:
-  LOCK TABLES lock_table_list
+| SET
   {
-    $$ = &ast.LockTables{Tables: $3}
+    $$ = ast.SetPrivilegeStr
   }
-
-lock_table_list:
-  lock_table
+| ALTER SYSTEM
   {
-    $$ = ast.TableAndLockTypes{$1}
+    $$ = ast.AlterSystemPrivilegeStr
   }
-| lock_table_list ',' lock_table
+// USAGE, CONNECT, MAINTAIN are not keywords
+| ID
   {
-    $$ = append($1, $3)
+    $$ = ast.NewColIdent(string($1)).Lowered()
   }
 
-lock_table:
-  aliased_table_name lock_type
+grant_target:
+  privilege_object_list
   {
-    $$ = &ast.TableAndLockType{Table:$1, Lock:$2}
+    $$ = &ast.Grant{ObjectType: ast.TableObjectStr, Objects: $1}
   }
-
-lock_type:
-  READ
+| grant_object_type privilege_object_list
   {
-    $$ = ast.Read
+    $$ = &ast.Grant{ObjectType: $1, Objects: $2}
   }
-| READ LOCAL
+| ALL grant_objects_type IN SCHEMA privilege_object_list
   {
-    $$ = ast.ReadLocal
+    $$ = &ast.Grant{ObjectType: $2, AllInSchema: true, Objects: $5}
   }
-| WRITE
+
+grant_object_type:
+  TABLE
   {
-    $$ = ast.Write
+    $$ = ast.TableObjectStr
   }
-| LOW_PRIORITY WRITE
+| SEQUENCE
   {
-    $$ = ast.LowPriorityWrite
+    $$ = ast.SequenceObjectStr
   }
-
-unlock_statement:
-  UNLOCK TABLES
+| FUNCTION
   {
-    $$ = &ast.UnlockTables{}
+    $$ = ast.FunctionObjectStr
   }
-
-flush_statement:
-  FLUSH local_opt flush_option_list
+| PROCEDURE
   {
-    $$ = &ast.Flush{IsLocal: $2, FlushOptions:$3}
+    $$ = ast.ProcedureObjectStr
   }
-| FLUSH local_opt TABLES
+| ROUTINE
   {
-    $$ = &ast.Flush{IsLocal: $2}
+    $$ = ast.RoutineObjectStr
   }
-| FLUSH local_opt TABLES WITH READ LOCK
+| SCHEMA
   {
-    $$ = &ast.Flush{IsLocal: $2, WithLock:true}
+    $$ = ast.SchemaObjectStr
   }
-| FLUSH local_opt TABLES table_name_list
+| DATABASE
   {
-    $$ = &ast.Flush{IsLocal: $2, TableNames:$4}
+    $$ = ast.DatabaseObjectStr
   }
-| FLUSH local_opt TABLES table_name_list WITH READ LOCK
+| TYPE
   {
-    $$ = &ast.Flush{IsLocal: $2, TableNames:$4, WithLock:true}
+    $$ = ast.TypeObjectStr
   }
-| FLUSH local_opt TABLES table_name_list FOR EXPORT
+| DOMAIN
   {
-    $$ = &ast.Flush{IsLocal: $2, TableNames:$4, ForExport:true}
+    $$ = ast.DomainObjectStr
   }
-
-flush_option_list:
-  flush_option
+| LANGUAGE
   {
-    $$ = []string{$1}
+    $$ = ast.LanguageObjectStr
   }
-| flush_option_list ',' flush_option
+| TABLESPACE
   {
-    $$ = append($1,$3)
+    $$ = ast.TablespaceObjectStr
   }
-
-flush_option:
-  BINARY LOGS
+| FOREIGN DATA WRAPPER
   {
-    $$ = string($1) + " " + string($2)
+    $$ = ast.ForeignDataWrapperObjectStr
   }
-| ERROR LOGS
+| FOREIGN SERVER
   {
-    $$ = string($1) + " " + string($2)
+    $$ = ast.ForeignServerObjectStr
   }
-| GENERAL LOGS
+
+grant_objects_type:
+  TABLES
   {
-    $$ = string($1) + " " + string($2)
+    $$ = ast.TablesObjectStr
   }
-| HOSTS
+| SEQUENCES
   {
-    $$ = string($1)
+    $$ = ast.SequencesObjectStr
   }
-| LOGS
+| FUNCTIONS
   {
-    $$ = string($1)
+    $$ = ast.FunctionsObjectStr
   }
-| PRIVILEGES
+| PROCEDURES
   {
-    $$ = string($1)
+    $$ = ast.ProceduresObjectStr
   }
-| RELAY LOGS for_channel_opt
+| ROUTINES
   {
-    $$ = string($1) + " " + string($2) + $3
+    $$ = ast.RoutinesObjectStr
   }
-| SLOW LOGS
+| TYPES
   {
-    $$ = string($1) + " " + string($2)
+    $$ = ast.TypesObjectStr
   }
-| OPTIMIZER_COSTS
+| SCHEMAS
   {
-    $$ = string($1)
+    $$ = ast.SchemasObjectStr
   }
-| STATUS
+
+privilege_object_list:
+  privilege_object
   {
-    $$ = string($1)
+    $$ = []*ast.PrivilegeObject{$1}
   }
-| USER_RESOURCES
+| privilege_object_list ',' privilege_object
   {
-    $$ = string($1)
+    $$ = append($1, $3)
   }
 
-local_opt:
+privilege_object:
+  table_name function_arguments_opt
   {
-    $$ = false
+    $$ = &ast.PrivilegeObject{Name: $1, Arguments: $2}
   }
-| LOCAL
+
+role_name_list:
+  role_name
   {
-    $$ = true
+    $$ = []*ast.RoleName{$1}
   }
-| NO_WRITE_TO_BINLOG
+| role_name_list ',' role_name
   {
-    $$ = true
+    $$ = append($1, $3)
   }
 
-for_channel_opt:
+role_name:
+  sql_id
   {
-    $$ = ""
+    $$ = ast.NewRoleName($1.String())
   }
-| FOR CHANNEL id_or_var
+| GROUP sql_id
   {
-    $$ = " " + string($1) + " " + string($2) + " " + $3.String()
+    $$ = ast.NewRoleName($2.String())
   }
-
-comment_statement:
+| CURRENT_USER
   {
-    setAllowComments(psqlex, true)
+    $$ = ast.NewRoleName(ast.CurrentUserRoleStr)
+  }
+| SESSION_USER
+  {
+    $$ = ast.NewRoleName(ast.SessionUserRoleStr)
   }
-  COMMENT comment_list ON schema_name IS text_literal_or_arg
+| CURRENT_ROLE
   {
-    // Strange argumets shift
-    $$ = &ast.CommentOnSchema{Comments: ast.Comments{$2}.Parsed(), Schema: $5.Name, Value: $7}
-    setAllowComments(psqlex, false)
+    $$ = ast.NewRoleName(ast.CurrentRoleStr)
   }
 
 
@@ -4460,6 +6300,10 @@ bool_pri IS NULL %prec IS
   {
 	$$ = &ast.ComparisonExpr{Left: $1, Operator: $2, Right: $3}
   }
+| bool_pri '=' ANY col_tuple
+  {
+	$$ = &ast.ComparisonExpr{Left: $1, Operator: ast.InOp, Right: $4}
+  }
 | predicate %prec EXPRESSION_PREC_SETTER
   {
 	$$ = $1
@@ -4510,10 +6354,6 @@ bit_expr IN col_tuple
  {
 	$$ = $1
  }
-| bit_expr '=' ANY col_tuple
-  {
-	$$ = &ast.ComparisonExpr{Left: $1, Operator: ast.InOp, Right: $4}
-  }
 
 bit_expr:
 bit_expr '|' bit_expr %prec '|'
@@ -4590,6 +6430,10 @@ function_call_keyword
   {
 	$$ = &ast.CollateExpr{Expr: $1, Collation: $3}
   }
+| simple_expr TYPECAST cast_type
+  {
+    $$ = &ast.TypeCastExpr{Expr: $1, Type: $3}
+  }
 | literal_or_null
   {
   	$$ = $1
@@ -4753,6 +6597,22 @@ compare:
   {
     $$ = ast.NullSafeEqualOp
   }
+| '~'
+  {
+    $$ = ast.PosixMatchOp
+  }
+| POSIX_IMATCH
+  {
+    $$ = ast.PosixIMatchOp
+  }
+| NOT_POSIX_MATCH
+  {
+    $$ = ast.NotPosixMatchOp
+  }
+| NOT_POSIX_IMATCH
+  {
+    $$ = ast.NotPosixIMatchOp
+  }
 
 col_tuple:
   row_tuple
@@ -5266,6 +7126,13 @@ convert_type_weight_string:
     $$ = nil
   }
 
+cast_type:
+  column_type
+  {
+    columnType := $1
+    $$ = &columnType
+  }
+
 convert_type:
   BINARY length_opt
   {
@@ -5504,6 +7371,19 @@ security_view:
     $$ = string($1)
   }
 
+with_data_opt:
+  {
+    $$ = true
+  }
+| WITH DATA
+  {
+    $$ = true
+  }
+| WITH NO DATA
+  {
+    $$ = false
+  }
+
 check_option_opt:
   {
     $$ = ""
@@ -5802,11 +7682,6 @@ expression_list_opt:
     $$ = $1
   }
 
-using_opt:
-  { $$ = nil }
-| using_index_type
-  { $$ = []*ast.IndexOption{$1} }
-
 using_index_type:
   USING sql_id
   {
@@ -5981,6 +7856,7 @@ reserved_keyword:
 | RIGHT
 | SELECT
 | SESSION_USER
+| SET
 | SIMILAR
 | SOME
 | STOP
@@ -6310,7 +8186,6 @@ non_reserved_keyword:
 | SERIALIZABLE
 | SERVER
 | SESSION
-| SET
 | SETOF
 | SETS
 | SHARE
@@ -18,7 +18,6 @@ package psql
 
 import (
 	"flag"
-	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -42,6 +41,7 @@ type PsqlTokenizer struct {
 	BindVars            map[string]struct{}
 
 	lastToken            string
+	expected             []string
 	posVarIndex          int
 	partialDDL           ast.Statement
 	nesting              int
@@ -50,6 +50,7 @@ type PsqlTokenizer struct {
 	leftContext          tokenizer.CyclicBuffer
 	ignoreCommentKeyword bool
 	scanDataMarkMode     bool
+	copyFromStdin        bool // The STDIN keyword is scanned, the data follows the end of the statement
 
 	Pos int
 	buf *tokenizer.BytesBuffer
@@ -59,6 +60,8 @@ type PsqlTokenizer struct {
 func (tzr *PsqlTokenizer) ResetTo(nextPos int) {
 	tzr.buf.ClipFrom(nextPos)
 	tzr.Pos = 0
+	tzr.copyFromStdin = false
+	tzr.scanDataMarkMode = false
 }
 
 // GetDialect implements tokenizer.Tokenizer.
@@ -243,8 +246,8 @@ func (tzr *PsqlTokenizer) Lex(lval *psqSymType) int {
 		}
 		typ, val = tzr.Scan()
 	}
-	// COPY command omit rest of DATA from stdin
-	if typ == ';' && lval.str == "stdin" {
+	// COPY command omit rest of DATA from stdin, the data is the value of the end of the statement
+	if typ == ';' && val != "" {
 		tzr.SkipToEnd = true
 		return 0
 	}
@@ -261,22 +264,17 @@ func (tzr *PsqlTokenizer) Lex(lval *psqSymType) int {
 }
 
 // PositionedErr holds context related to parser errors
-type PositionedErr struct {
-	Err  string
-	Pos  int
-	Near string
-}
+type PositionedErr = tokenizer.PositionedErr
 
-func (p PositionedErr) Error() string {
-	if p.Near != "" {
-		return fmt.Sprintf("%s at position %v near '%s'", p.Err, p.Pos, p.Near)
-	}
-	return fmt.Sprintf("%s at position %v", p.Err, p.Pos)
+// SetExpectedTokens is called by go yacc before the Error with the tokens expected by the parser.
+func (tzr *PsqlTokenizer) SetExpectedTokens(tokens []string) {
+	tzr.expected = tokens
 }
 
 // Error is called by go yacc if there's a parsing error.
 func (tzr *PsqlTokenizer) Error(err string) {
-	tzr.LastError = PositionedErr{Err: err, Pos: tzr.Pos + 1, Near: tzr.lastToken}
+	tzr.LastError = PositionedErr{Err: err, Pos: tzr.Pos + 1, Near: tzr.lastToken, Expected: tzr.expected}
+	tzr.expected = nil
 
 	// Try and re-sync to the next statement
 	tzr.skipStatement()
@@ -348,10 +346,25 @@ func (tzr *PsqlTokenizer) Scan() (int, string) {
 				return tzr.scanString(nxt, NCHAR_STRING)
 			}
 		}
-		return tzr.scanIdentifier(false)
+		// $tag$literal$tag$ is the PostgreSQL dollar-quoted string
+		if ch == '$' {
+			if tagLength := tzr.dollarQuoteTagLength(); tagLength > 0 {
+				return tzr.scanDollarString(tagLength)
+			}
+		}
+		typ, val := tzr.scanIdentifier(false)
+		if typ == STDIN {
+			tzr.copyFromStdin = true
+		}
+		return typ, val
 	case isDigit(ch):
 		return tzr.scanNumber()
 	case ch == ':':
+		// PostgreSQL type cast operator expr::type
+		if tzr.Peek(1) == ':' {
+			tzr.Skip(2)
+			return TYPECAST, "::"
+		}
 		return tzr.scanBindVar()
 	case ch == ';':
 		if tzr.multi {
@@ -360,7 +373,9 @@ func (tzr *PsqlTokenizer) Scan() (int, string) {
 			// forces the advance.
 			return 0, ""
 		}
-		if tzr.leftContext.Has("stdin") {
+		if tzr.copyFromStdin {
+			tzr.copyFromStdin = false
+			tzr.Skip(1)
 			return tzr.scanEndDataMark()
 		}
 		tzr.Skip(1)
@@ -374,7 +389,14 @@ func (tzr *PsqlTokenizer) Scan() (int, string) {
 
 		tzr.Skip(1)
 		switch ch {
-		case '=', ',', '+', '*', '%', '^', '~':
+		case '=', ',', '+', '*', '%', '^', '[', ']':
+			return int(ch), ""
+		case '~':
+			// PostgreSQL case insensitive regular expression match operator
+			if tzr.Cur() == '*' {
+				tzr.Skip(1)
+				return POSIX_IMATCH, ""
+			}
 			return int(ch), ""
 		case '(':
 			tzr.ignoreCommentKeyword = true
@@ -468,9 +490,17 @@ func (tzr *PsqlTokenizer) Scan() (int, string) {
 				return int(ch), ""
 			}
 		case '!':
-			if tzr.Cur() == '=' {
+			switch tzr.Cur() {
+			case '=':
 				tzr.Skip(1)
 				return NE, ""
+			case '~':
+				tzr.Skip(1)
+				if tzr.Cur() == '*' {
+					tzr.Skip(1)
+					return NOT_POSIX_IMATCH, ""
+				}
+				return NOT_POSIX_MATCH, ""
 			}
 			return int(ch), ""
 		case '\'', '"':
@@ -638,15 +668,19 @@ func (tzr *PsqlTokenizer) scanBindVar() (int, string) {
 	return token, tzr.buf.StringAt(start, tzr.Pos)
 }
 
-// scanEndDataMark scans a mark for end input data "\\."
+// scanEndDataMark scans a mark for end input data "\\.",
+// the scan is continued with the next text when the data is cut by the end of the text
 func (tzr *PsqlTokenizer) scanEndDataMark() (int, string) {
 
 	tzr.scanDataMarkMode = true
-	tzr.Skip(1)
 	start := tzr.Pos
 	for {
 		ch := tzr.Cur()
 		if ch == '\\' {
+			if tzr.Peek(1) == tokenizer.EofChar {
+				// The mark can be cut by the end of the text
+				break
+			}
 			tzr.Skip(1)
 			if tzr.Cur() == '.' {
 				tzr.Skip(1)
@@ -814,6 +848,45 @@ func (tzr *PsqlTokenizer) scanStringSlow(buffer *strings.Builder, delim rune, ty
 	return typ, buffer.String()
 }
 
+// dollarQuoteTagLength returns the length of the dollar quote tag ($$ or $tag$)
+// at the current position or 0 if there is no tag
+func (tzr *PsqlTokenizer) dollarQuoteTagLength() int {
+	if isDigit(tzr.Peek(1)) {
+		return 0
+	}
+	for i := 1; ; i++ {
+		ch := tzr.Peek(i)
+		if ch == '$' {
+			return i + 1
+		}
+		if !isLetter(ch) && !isDigit(ch) {
+			return 0
+		}
+	}
+}
+
+// scanDollarString scans a dollar-quoted string, the returned value
+// keeps the quote tags because the body is passed through as is
+func (tzr *PsqlTokenizer) scanDollarString(tagLength int) (int, string) {
+	start := tzr.Pos
+	tag := tzr.buf.StringAt(start, start+tagLength)
+	tzr.Skip(tagLength)
+
+	for {
+		switch tzr.Cur() {
+		case tokenizer.EofChar:
+			// Unterminated string.
+			return LEX_ERROR, tzr.buf.StringAt(start, tzr.Pos)
+		case '$':
+			if tzr.Pos+tagLength <= tzr.buf.Size() && tzr.buf.StringAt(tzr.Pos, tzr.Pos+tagLength) == tag {
+				tzr.Skip(tagLength)
+				return DOLLAR_STRING, tzr.buf.StringAt(start, tzr.Pos)
+			}
+		}
+		tzr.Skip(1)
+	}
+}
+
 // scanCommentType1 scans a SQL line-comment, which is applied until the end
 // of the line. The given prefix length varies based on whether the comment
 // is started with '//', '--' or '#'.
@@ -901,6 +974,8 @@ func (tzr *PsqlTokenizer) Reset() {
 	tzr.posVarIndex = 0
 	tzr.nesting = 0
 	tzr.SkipToEnd = false
+	tzr.copyFromStdin = false
+	tzr.scanDataMarkMode = false
 }
 
 func isLetter(ch rune) bool {
@@ -411,485 +411,487 @@ const LIST_ARG = 57702
 const COMMENT_KEYWORD = 57703
 const BIT_LITERAL = 57704
 const COMPRESSION = 57705
-const JSON_PRETTY = 57706
-const JSON_STORAGE_SIZE = 57707
-const JSON_STORAGE_FREE = 57708
-const JSON_CONTAINS = 57709
-const JSON_CONTAINS_PATH = 57710
-const JSON_EXTRACT = 57711
-const JSON_KEYS = 57712
-const JSON_OVERLAPS = 57713
-const JSON_SEARCH = 57714
-const JSON_VALUE = 57715
-const EXTRACT = 57716
-const NULL = 57717
-const TRUE = 57718
-const FALSE = 57719
-const OFF = 57720
-const DISCARD = 57721
-const IMPORT = 57722
-const ENABLE = 57723
-const DISABLE = 57724
-const TABLESPACE = 57725
-const VIRTUAL = 57726
-const STORED = 57727
-const BOTH = 57728
-const LEADING = 57729
-const TRAILING = 57730
-const EMPTY_FROM_CLAUSE = 57731
-const LOWER_THAN_CHARSET = 57732
-const CHARSET = 57733
-const UNIQUE = 57734
-const KEY = 57735
-const EXPRESSION_PREC_SETTER = 57736
-const OR = 57737
-const AND = 57738
-const NOT = 57739
-const BETWEEN = 57740
-const CASE = 57741
-const WHEN = 57742
-const THEN = 57743
-const ELSE = 57744
-const END = 57745
-const LE = 57746
-const GE = 57747
-const NE = 57748
-const NULL_SAFE_EQUAL = 57749
-const IS = 57750
-const LIKE = 57751
-const REGEXP = 57752
-const IN = 57753
-const SHIFT_LEFT = 57754
-const SHIFT_RIGHT = 57755
-const DIV = 57756
-const MOD = 57757
-const UNARY = 57758
-const COLLATE = 57759
-const BINARY = 57760
-const UNDERSCORE_ARMSCII8 = 57761
-const UNDERSCORE_ASCII = 57762
-const UNDERSCORE_BIG5 = 57763
-const UNDERSCORE_BINARY = 57764
-const UNDERSCORE_CP1250 = 57765
-const UNDERSCORE_CP1251 = 57766
-const UNDERSCORE_CP1256 = 57767
-const UNDERSCORE_CP1257 = 57768
-const UNDERSCORE_CP850 = 57769
-const UNDERSCORE_CP852 = 57770
-const UNDERSCORE_CP866 = 57771
-const UNDERSCORE_CP932 = 57772
-const UNDERSCORE_DEC8 = 57773
-const UNDERSCORE_EUCJPMS = 57774
-const UNDERSCORE_EUCKR = 57775
-const UNDERSCORE_GB18030 = 57776
-const UNDERSCORE_GB2312 = 57777
-const UNDERSCORE_GBK = 57778
-const UNDERSCORE_GEOSTD8 = 57779
-const UNDERSCORE_GREEK = 57780
-const UNDERSCORE_HEBREW = 57781
-const UNDERSCORE_HP8 = 57782
-const UNDERSCORE_KEYBCS2 = 57783
-const UNDERSCORE_KOI8R = 57784
-const UNDERSCORE_KOI8U = 57785
-const UNDERSCORE_LATIN1 = 57786
-const UNDERSCORE_LATIN2 = 57787
-const UNDERSCORE_LATIN5 = 57788
-const UNDERSCORE_LATIN7 = 57789
-const UNDERSCORE_MACCE = 57790
-const UNDERSCORE_MACROMAN = 57791
-const UNDERSCORE_SJIS = 57792
-const UNDERSCORE_SWE7 = 57793
-const UNDERSCORE_TIS620 = 57794
-const UNDERSCORE_UCS2 = 57795
-const UNDERSCORE_UJIS = 57796
-const UNDERSCORE_UTF16 = 57797
-const UNDERSCORE_UTF16LE = 57798
-const UNDERSCORE_UTF32 = 57799
-const UNDERSCORE_UTF8 = 57800
-const UNDERSCORE_UTF8MB4 = 57801
-const UNDERSCORE_UTF8MB3 = 57802
-const INTERVAL = 57803
-const JSON_EXTRACT_OP = 57804
-const JSON_UNQUOTE_EXTRACT_OP = 57805
-const CREATE = 57806
-const ALTER = 57807
-const DROP = 57808
-const RENAME = 57809
-const ANALYZE = 57810
-const ANALYSE = 57811
-const ADD = 57812
-const FLUSH = 57813
-const CHANGE = 57814
-const MODIFY = 57815
-const DEALLOCATE = 57816
-const REVERT = 57817
-const SCHEMA = 57818
-const TABLE = 57819
-const INDEX = 57820
-const VIEW = 57821
-const TO = 57822
-const IGNORE = 57823
-const IF = 57824
-const PRIMARY = 57825
-const COLUMN = 57826
-const SPATIAL = 57827
-const FULLTEXT = 57828
-const KEY_BLOCK_SIZE = 57829
-const CHECK = 57830
-const INDEXES = 57831
-const ACTION = 57832
-const CASCADE = 57833
-const CONSTRAINT = 57834
-const FOREIGN = 57835
-const NO = 57836
-const REFERENCES = 57837
-const RESTRICT = 57838
-const SHOW = 57839
-const DESCRIBE = 57840
-const EXPLAIN = 57841
-const DATE = 57842
-const ESCAPE = 57843
-const REPAIR = 57844
-const OPTIMIZE = 57845
-const TRUNCATE = 57846
-const COALESCE = 57847
-const EXCHANGE = 57848
-const REBUILD = 57849
-const PARTITIONING = 57850
-const REMOVE = 57851
-const PREPARE = 57852
-const EXECUTE = 57853
-const MAXVALUE = 57854
-const PARTITION = 57855
-const REORGANIZE = 57856
-const LESS = 57857
-const THAN = 57858
-const PROCEDURE = 57859
-const TRIGGER = 57860
-const VINDEX = 57861
-const VINDEXES = 57862
-const DIRECTORY = 57863
-const NAME = 57864
-const UPGRADE = 57865
-const STATUS = 57866
-const VARIABLES = 57867
-const WARNINGS = 57868
-const CASCADED = 57869
-const DEFINER = 57870
-const OPTION = 57871
-const SQL = 57872
-const UNDEFINED = 57873
-const SEQUENCE = 57874
-const MERGE = 57875
-const TEMPORARY = 57876
-const TEMPTABLE = 57877
-const INVOKER = 57878
-const SECURITY = 57879
-const FIRST = 57880
-const AFTER = 57881
-const LAST = 57882
-const FAIL = 57883
-const GLOB = 57884
-const INDEXED = 57885
-const PRAGMA = 57886
-const RAISE = 57887
-const CANCEL = 57888
-const RETRY = 57889
-const COMPLETE = 57890
-const CLEANUP = 57891
-const THROTTLE = 57892
-const UNTHROTTLE = 57893
-const EXPIRE = 57894
-const RATIO = 57895
-const BEGIN = 57896
-const START = 57897
-const TRANSACTION = 57898
-const COMMIT = 57899
-const ROLLBACK = 57900
-const SAVEPOINT = 57901
-const RELEASE = 57902
-const WORK = 57903
-const BIT = 57904
-const TINYINT = 57905
-const SMALLINT = 57906
-const MEDIUMINT = 57907
-const INT = 57908
-const INTEGER = 57909
-const BIGINT = 57910
-const INTNUM = 57911
-const REAL = 57912
-const DOUBLE = 57913
-const FLOAT_TYPE = 57914
-const DECIMAL_TYPE = 57915
-const NUMERIC = 57916
-const TIME = 57917
-const TIMESTAMP = 57918
-const DATETIME = 57919
-const YEAR = 57920
-const CHAR = 57921
-const VARCHAR = 57922
-const BOOL = 57923
-const CHARACTER = 57924
-const VARBINARY = 57925
-const NCHAR = 57926
-const TEXT = 57927
-const TINYTEXT = 57928
-const MEDIUMTEXT = 57929
-const LONGTEXT = 57930
-const BLOB = 57931
-const TINYBLOB = 57932
-const MEDIUMBLOB = 57933
-const LONGBLOB = 57934
-const JSON = 57935
-const JSON_SCHEMA_VALID = 57936
-const JSON_SCHEMA_VALIDATION_REPORT = 57937
-const ENUM = 57938
-const GEOMETRY = 57939
-const POINT = 57940
-const LINESTRING = 57941
-const POLYGON = 57942
-const GEOMETRYCOLLECTION = 57943
-const MULTIPOINT = 57944
-const MULTILINESTRING = 57945
-const MULTIPOLYGON = 57946
-const ASCII = 57947
-const UNICODE = 57948
-const NULLX = 57949
-const AUTOINCREMENT = 57950
-const APPROXNUM = 57951
-const SIGNED = 57952
-const UNSIGNED = 57953
-const ZEROFILL = 57954
-const CODE = 57955
-const COLLATION = 57956
-const COLUMNS = 57957
-const DATABASES = 57958
-const ENGINES = 57959
-const EVENT = 57960
-const EXTENDED = 57961
-const FIELDS = 57962
-const FULL = 57963
-const FUNCTION = 57964
-const GTID_EXECUTED = 57965
-const KEYSPACES = 57966
-const OPEN = 57967
-const PLUGINS = 57968
-const PRIVILEGES = 57969
-const PROCESSLIST = 57970
-const SCHEMAS = 57971
-const TABLES = 57972
-const TRIGGERS = 57973
-const USER = 57974
-const VGTID_EXECUTED = 57975
-const VSCHEMA = 57976
-const NAMES = 57977
-const GLOBAL = 57978
-const SESSION = 57979
-const ISOLATION = 57980
-const LEVEL = 57981
-const READ = 57982
-const WRITE = 57983
-const ONLY = 57984
-const REPEATABLE = 57985
-const COMMITTED = 57986
-const UNCOMMITTED = 57987
-const SERIALIZABLE = 57988
-const CURRENT_TIMESTAMP = 57989
-const DATABASE = 57990
-const CURRENT_DATE = 57991
-const NOW = 57992
-const CURRENT_TIME = 57993
-const LOCALTIME = 57994
-const LOCALTIMESTAMP = 57995
-const CURRENT_USER = 57996
-const UTC_DATE = 57997
-const UTC_TIME = 57998
-const UTC_TIMESTAMP = 57999
-const DAY = 58000
-const DAY_HOUR = 58001
-const DAY_MICROSECOND = 58002
-const DAY_MINUTE = 58003
-const DAY_SECOND = 58004
-const HOUR = 58005
-const HOUR_MICROSECOND = 58006
-const HOUR_MINUTE = 58007
-const HOUR_SECOND = 58008
-const MICROSECOND = 58009
-const MINUTE = 58010
-const MINUTE_MICROSECOND = 58011
-const MINUTE_SECOND = 58012
-const MONTH = 58013
-const QUARTER = 58014
-const SECOND = 58015
-const SECOND_MICROSECOND = 58016
-const YEAR_MONTH = 58017
-const WEEK = 58018
-const REPLACE = 58019
-const CONVERT = 58020
-const CAST = 58021
-const SUBSTR = 58022
-const SUBSTRING = 58023
-const GROUP_CONCAT = 58024
-const SEPARATOR = 58025
-const TIMESTAMPADD = 58026
-const TIMESTAMPDIFF = 58027
-const WEIGHT_STRING = 58028
-const LTRIM = 58029
-const RTRIM = 58030
-const TRIM = 58031
-const JSON_ARRAY = 58032
-const JSON_OBJECT = 58033
-const JSON_QUOTE = 58034
-const JSON_DEPTH = 58035
-const JSON_TYPE = 58036
-const JSON_LENGTH = 58037
-const JSON_VALID = 58038
-const JSON_ARRAY_APPEND = 58039
-const JSON_ARRAY_INSERT = 58040
-const JSON_INSERT = 58041
-const JSON_MERGE = 58042
-const JSON_MERGE_PATCH = 58043
-const JSON_MERGE_PRESERVE = 58044
-const JSON_REMOVE = 58045
-const JSON_REPLACE = 58046
-const JSON_SET = 58047
-const JSON_UNQUOTE = 58048
-const MATCH = 58049
-const AGAINST = 58050
-const BOOLEAN = 58051
-const LANGUAGE = 58052
-const WITH = 58053
-const QUERY = 58054
-const EXPANSION = 58055
-const WITHOUT = 58056
-const VALIDATION = 58057
-const UNUSED = 58058
-const ARRAY = 58059
-const BYTE = 58060
-const CUME_DIST = 58061
-const DESCRIPTION = 58062
-const DENSE_RANK = 58063
-const EMPTY = 58064
-const EXCEPT = 58065
-const FIRST_VALUE = 58066
-const GROUPING = 58067
-const GROUPS = 58068
-const JSON_TABLE = 58069
-const LAG = 58070
-const LAST_VALUE = 58071
-const LATERAL = 58072
-const LEAD = 58073
-const NTH_VALUE = 58074
-const NTILE = 58075
-const OF = 58076
-const OVER = 58077
-const PERCENT_RANK = 58078
-const RANK = 58079
-const RECURSIVE = 58080
-const ROW_NUMBER = 58081
-const SYSTEM = 58082
-const WINDOW = 58083
-const ACTIVE = 58084
-const ADMIN = 58085
-const AUTOEXTEND_SIZE = 58086
-const BUCKETS = 58087
-const CLONE = 58088
-const COLUMN_FORMAT = 58089
-const COMPONENT = 58090
-const DEFINITION = 58091
-const ENFORCED = 58092
-const ENGINE_ATTRIBUTE = 58093
-const EXCLUDE = 58094
-const FOLLOWING = 58095
-const GEOMCOLLECTION = 58096
-const GET_MASTER_PUBLIC_KEY = 58097
-const HISTOGRAM = 58098
-const HISTORY = 58099
-const INACTIVE = 58100
-const INVISIBLE = 58101
-const LOCKED = 58102
-const MASTER_COMPRESSION_ALGORITHMS = 58103
-const MASTER_PUBLIC_KEY_PATH = 58104
-const MASTER_TLS_CIPHERSUITES = 58105
-const MASTER_ZSTD_COMPRESSION_LEVEL = 58106
-const NESTED = 58107
-const NETWORK_NAMESPACE = 58108
-const NOWAIT = 58109
-const NULLS = 58110
-const OJ = 58111
-const OLD = 58112
-const OPTIONAL = 58113
-const ORDINALITY = 58114
-const ORGANIZATION = 58115
-const OTHERS = 58116
-const PARTIAL = 58117
-const PATH = 58118
-const PERSIST = 58119
-const PERSIST_ONLY = 58120
-const PRECEDING = 58121
-const PRIVILEGE_CHECKS_USER = 58122
-const PROCESS = 58123
-const RANDOM = 58124
-const REFERENCE = 58125
-const REQUIRE_ROW_FORMAT = 58126
-const RESOURCE = 58127
-const RESPECT = 58128
-const RESTART = 58129
-const RETAIN = 58130
-const REUSE = 58131
-const ROLE = 58132
-const SECONDARY = 58133
-const SECONDARY_ENGINE = 58134
-const SECONDARY_ENGINE_ATTRIBUTE = 58135
-const SECONDARY_LOAD = 58136
-const SECONDARY_UNLOAD = 58137
-const SIMPLE = 58138
-const SKIP = 58139
-const SRID = 58140
-const THREAD_PRIORITY = 58141
-const TIES = 58142
-const UNBOUNDED = 58143
-const VCPU = 58144
-const VISIBLE = 58145
-const RETURNING = 58146
-const FORMAT = 58147
-const TREE = 58148
-const TRADITIONAL = 58149
-const LOCAL = 58150
-const LOW_PRIORITY = 58151
-const NO_WRITE_TO_BINLOG = 58152
-const LOGS = 58153
-const ERROR = 58154
-const GENERAL = 58155
-const HOSTS = 58156
-const OPTIMIZER_COSTS = 58157
-const USER_RESOURCES = 58158
-const SLOW = 58159
-const CHANNEL = 58160
-const RELAY = 58161
-const EXPORT = 58162
-const AVG_ROW_LENGTH = 58163
-const CONNECTION = 58164
-const CHECKSUM = 58165
-const DELAY_KEY_WRITE = 58166
-const ENCRYPTION = 58167
-const INSERT_METHOD = 58168
-const MAX_ROWS = 58169
-const MIN_ROWS = 58170
-const PACK_KEYS = 58171
-const PASSWORD = 58172
-const FIXED = 58173
-const DYNAMIC = 58174
-const COMPRESSED = 58175
-const REDUNDANT = 58176
-const COMPACT = 58177
-const ROW_FORMAT = 58178
-const STATS_AUTO_RECALC = 58179
-const STATS_PERSISTENT = 58180
-const STATS_SAMPLE_PAGES = 58181
-const STORAGE = 58182
-const MEMORY = 58183
-const DISK = 58184
+const MODULE_ARGUMENTS = 57706
+const JSON_PRETTY = 57707
+const JSON_STORAGE_SIZE = 57708
+const JSON_STORAGE_FREE = 57709
+const JSON_CONTAINS = 57710
+const JSON_CONTAINS_PATH = 57711
+const JSON_EXTRACT = 57712
+const JSON_KEYS = 57713
+const JSON_OVERLAPS = 57714
+const JSON_SEARCH = 57715
+const JSON_VALUE = 57716
+const EXTRACT = 57717
+const NULL = 57718
+const TRUE = 57719
+const FALSE = 57720
+const OFF = 57721
+const DISCARD = 57722
+const IMPORT = 57723
+const ENABLE = 57724
+const DISABLE = 57725
+const TABLESPACE = 57726
+const VIRTUAL = 57727
+const STORED = 57728
+const BOTH = 57729
+const LEADING = 57730
+const TRAILING = 57731
+const EMPTY_FROM_CLAUSE = 57732
+const LOWER_THAN_CHARSET = 57733
+const CHARSET = 57734
+const UNIQUE = 57735
+const KEY = 57736
+const EXPRESSION_PREC_SETTER = 57737
+const OR = 57738
+const AND = 57739
+const NOT = 57740
+const BETWEEN = 57741
+const CASE = 57742
+const WHEN = 57743
+const THEN = 57744
+const ELSE = 57745
+const END = 57746
+const LE = 57747
+const GE = 57748
+const NE = 57749
+const NULL_SAFE_EQUAL = 57750
+const IS = 57751
+const LIKE = 57752
+const REGEXP = 57753
+const IN = 57754
+const SHIFT_LEFT = 57755
+const SHIFT_RIGHT = 57756
+const DIV = 57757
+const MOD = 57758
+const CONCAT = 57759
+const UNARY = 57760
+const COLLATE = 57761
+const BINARY = 57762
+const UNDERSCORE_ARMSCII8 = 57763
+const UNDERSCORE_ASCII = 57764
+const UNDERSCORE_BIG5 = 57765
+const UNDERSCORE_BINARY = 57766
+const UNDERSCORE_CP1250 = 57767
+const UNDERSCORE_CP1251 = 57768
+const UNDERSCORE_CP1256 = 57769
+const UNDERSCORE_CP1257 = 57770
+const UNDERSCORE_CP850 = 57771
+const UNDERSCORE_CP852 = 57772
+const UNDERSCORE_CP866 = 57773
+const UNDERSCORE_CP932 = 57774
+const UNDERSCORE_DEC8 = 57775
+const UNDERSCORE_EUCJPMS = 57776
+const UNDERSCORE_EUCKR = 57777
+const UNDERSCORE_GB18030 = 57778
+const UNDERSCORE_GB2312 = 57779
+const UNDERSCORE_GBK = 57780
+const UNDERSCORE_GEOSTD8 = 57781
+const UNDERSCORE_GREEK = 57782
+const UNDERSCORE_HEBREW = 57783
+const UNDERSCORE_HP8 = 57784
+const UNDERSCORE_KEYBCS2 = 57785
+const UNDERSCORE_KOI8R = 57786
+const UNDERSCORE_KOI8U = 57787
+const UNDERSCORE_LATIN1 = 57788
+const UNDERSCORE_LATIN2 = 57789
+const UNDERSCORE_LATIN5 = 57790
+const UNDERSCORE_LATIN7 = 57791
+const UNDERSCORE_MACCE = 57792
+const UNDERSCORE_MACROMAN = 57793
+const UNDERSCORE_SJIS = 57794
+const UNDERSCORE_SWE7 = 57795
+const UNDERSCORE_TIS620 = 57796
+const UNDERSCORE_UCS2 = 57797
+const UNDERSCORE_UJIS = 57798
+const UNDERSCORE_UTF16 = 57799
+const UNDERSCORE_UTF16LE = 57800
+const UNDERSCORE_UTF32 = 57801
+const UNDERSCORE_UTF8 = 57802
+const UNDERSCORE_UTF8MB4 = 57803
+const UNDERSCORE_UTF8MB3 = 57804
+const INTERVAL = 57805
+const JSON_EXTRACT_OP = 57806
+const JSON_UNQUOTE_EXTRACT_OP = 57807
+const CREATE = 57808
+const ALTER = 57809
+const DROP = 57810
+const RENAME = 57811
+const ANALYZE = 57812
+const ANALYSE = 57813
+const ADD = 57814
+const FLUSH = 57815
+const CHANGE = 57816
+const MODIFY = 57817
+const DEALLOCATE = 57818
+const REVERT = 57819
+const SCHEMA = 57820
+const TABLE = 57821
+const INDEX = 57822
+const VIEW = 57823
+const TO = 57824
+const IGNORE = 57825
+const IF = 57826
+const PRIMARY = 57827
+const COLUMN = 57828
+const SPATIAL = 57829
+const FULLTEXT = 57830
+const KEY_BLOCK_SIZE = 57831
+const CHECK = 57832
+const INDEXES = 57833
+const ACTION = 57834
+const CASCADE = 57835
+const CONSTRAINT = 57836
+const FOREIGN = 57837
+const NO = 57838
+const REFERENCES = 57839
+const RESTRICT = 57840
+const SHOW = 57841
+const DESCRIBE = 57842
+const EXPLAIN = 57843
+const DATE = 57844
+const ESCAPE = 57845
+const REPAIR = 57846
+const OPTIMIZE = 57847
+const TRUNCATE = 57848
+const COALESCE = 57849
+const EXCHANGE = 57850
+const REBUILD = 57851
+const PARTITIONING = 57852
+const REMOVE = 57853
+const PREPARE = 57854
+const EXECUTE = 57855
+const MAXVALUE = 57856
+const PARTITION = 57857
+const REORGANIZE = 57858
+const LESS = 57859
+const THAN = 57860
+const PROCEDURE = 57861
+const TRIGGER = 57862
+const VINDEX = 57863
+const VINDEXES = 57864
+const DIRECTORY = 57865
+const NAME = 57866
+const UPGRADE = 57867
+const STATUS = 57868
+const VARIABLES = 57869
+const WARNINGS = 57870
+const CASCADED = 57871
+const DEFINER = 57872
+const OPTION = 57873
+const SQL = 57874
+const UNDEFINED = 57875
+const SEQUENCE = 57876
+const MERGE = 57877
+const TEMPORARY = 57878
+const TEMPTABLE = 57879
+const INVOKER = 57880
+const SECURITY = 57881
+const FIRST = 57882
+const AFTER = 57883
+const LAST = 57884
+const FAIL = 57885
+const GLOB = 57886
+const INDEXED = 57887
+const PRAGMA = 57888
+const RAISE = 57889
+const CANCEL = 57890
+const RETRY = 57891
+const COMPLETE = 57892
+const CLEANUP = 57893
+const THROTTLE = 57894
+const UNTHROTTLE = 57895
+const EXPIRE = 57896
+const RATIO = 57897
+const BEGIN = 57898
+const START = 57899
+const TRANSACTION = 57900
+const COMMIT = 57901
+const ROLLBACK = 57902
+const SAVEPOINT = 57903
+const RELEASE = 57904
+const WORK = 57905
+const BIT = 57906
+const TINYINT = 57907
+const SMALLINT = 57908
+const MEDIUMINT = 57909
+const INT = 57910
+const INTEGER = 57911
+const BIGINT = 57912
+const INTNUM = 57913
+const REAL = 57914
+const DOUBLE = 57915
+const FLOAT_TYPE = 57916
+const DECIMAL_TYPE = 57917
+const NUMERIC = 57918
+const TIME = 57919
+const TIMESTAMP = 57920
+const DATETIME = 57921
+const YEAR = 57922
+const CHAR = 57923
+const VARCHAR = 57924
+const BOOL = 57925
+const CHARACTER = 57926
+const VARBINARY = 57927
+const NCHAR = 57928
+const TEXT = 57929
+const TINYTEXT = 57930
+const MEDIUMTEXT = 57931
+const LONGTEXT = 57932
+const BLOB = 57933
+const TINYBLOB = 57934
+const MEDIUMBLOB = 57935
+const LONGBLOB = 57936
+const JSON = 57937
+const JSON_SCHEMA_VALID = 57938
+const JSON_SCHEMA_VALIDATION_REPORT = 57939
+const ENUM = 57940
+const GEOMETRY = 57941
+const POINT = 57942
+const LINESTRING = 57943
+const POLYGON = 57944
+const GEOMETRYCOLLECTION = 57945
+const MULTIPOINT = 57946
+const MULTILINESTRING = 57947
+const MULTIPOLYGON = 57948
+const ASCII = 57949
+const UNICODE = 57950
+const NULLX = 57951
+const AUTOINCREMENT = 57952
+const APPROXNUM = 57953
+const SIGNED = 57954
+const UNSIGNED = 57955
+const ZEROFILL = 57956
+const CODE = 57957
+const COLLATION = 57958
+const COLUMNS = 57959
+const DATABASES = 57960
+const ENGINES = 57961
+const EVENT = 57962
+const EXTENDED = 57963
+const FIELDS = 57964
+const FULL = 57965
+const FUNCTION = 57966
+const GTID_EXECUTED = 57967
+const KEYSPACES = 57968
+const OPEN = 57969
+const PLUGINS = 57970
+const PRIVILEGES = 57971
+const PROCESSLIST = 57972
+const SCHEMAS = 57973
+const TABLES = 57974
+const TRIGGERS = 57975
+const USER = 57976
+const VGTID_EXECUTED = 57977
+const VSCHEMA = 57978
+const NAMES = 57979
+const GLOBAL = 57980
+const SESSION = 57981
+const ISOLATION = 57982
+const LEVEL = 57983
+const READ = 57984
+const WRITE = 57985
+const ONLY = 57986
+const REPEATABLE = 57987
+const COMMITTED = 57988
+const UNCOMMITTED = 57989
+const SERIALIZABLE = 57990
+const CURRENT_TIMESTAMP = 57991
+const DATABASE = 57992
+const CURRENT_DATE = 57993
+const NOW = 57994
+const CURRENT_TIME = 57995
+const LOCALTIME = 57996
+const LOCALTIMESTAMP = 57997
+const CURRENT_USER = 57998
+const UTC_DATE = 57999
+const UTC_TIME = 58000
+const UTC_TIMESTAMP = 58001
+const DAY = 58002
+const DAY_HOUR = 58003
+const DAY_MICROSECOND = 58004
+const DAY_MINUTE = 58005
+const DAY_SECOND = 58006
+const HOUR = 58007
+const HOUR_MICROSECOND = 58008
+const HOUR_MINUTE = 58009
+const HOUR_SECOND = 58010
+const MICROSECOND = 58011
+const MINUTE = 58012
+const MINUTE_MICROSECOND = 58013
+const MINUTE_SECOND = 58014
+const MONTH = 58015
+const QUARTER = 58016
+const SECOND = 58017
+const SECOND_MICROSECOND = 58018
+const YEAR_MONTH = 58019
+const WEEK = 58020
+const REPLACE = 58021
+const CONVERT = 58022
+const CAST = 58023
+const SUBSTR = 58024
+const SUBSTRING = 58025
+const GROUP_CONCAT = 58026
+const SEPARATOR = 58027
+const TIMESTAMPADD = 58028
+const TIMESTAMPDIFF = 58029
+const WEIGHT_STRING = 58030
+const LTRIM = 58031
+const RTRIM = 58032
+const TRIM = 58033
+const JSON_ARRAY = 58034
+const JSON_OBJECT = 58035
+const JSON_QUOTE = 58036
+const JSON_DEPTH = 58037
+const JSON_TYPE = 58038
+const JSON_LENGTH = 58039
+const JSON_VALID = 58040
+const JSON_ARRAY_APPEND = 58041
+const JSON_ARRAY_INSERT = 58042
+const JSON_INSERT = 58043
+const JSON_MERGE = 58044
+const JSON_MERGE_PATCH = 58045
+const JSON_MERGE_PRESERVE = 58046
+const JSON_REMOVE = 58047
+const JSON_REPLACE = 58048
+const JSON_SET = 58049
+const JSON_UNQUOTE = 58050
+const MATCH = 58051
+const AGAINST = 58052
+const BOOLEAN = 58053
+const LANGUAGE = 58054
+const WITH = 58055
+const QUERY = 58056
+const EXPANSION = 58057
+const WITHOUT = 58058
+const VALIDATION = 58059
+const UNUSED = 58060
+const ARRAY = 58061
+const BYTE = 58062
+const CUME_DIST = 58063
+const DESCRIPTION = 58064
+const DENSE_RANK = 58065
+const EMPTY = 58066
+const EXCEPT = 58067
+const FIRST_VALUE = 58068
+const GROUPING = 58069
+const GROUPS = 58070
+const JSON_TABLE = 58071
+const LAG = 58072
+const LAST_VALUE = 58073
+const LATERAL = 58074
+const LEAD = 58075
+const NTH_VALUE = 58076
+const NTILE = 58077
+const OF = 58078
+const OVER = 58079
+const PERCENT_RANK = 58080
+const RANK = 58081
+const RECURSIVE = 58082
+const ROW_NUMBER = 58083
+const SYSTEM = 58084
+const WINDOW = 58085
+const ACTIVE = 58086
+const ADMIN = 58087
+const AUTOEXTEND_SIZE = 58088
+const BUCKETS = 58089
+const CLONE = 58090
+const COLUMN_FORMAT = 58091
+const COMPONENT = 58092
+const DEFINITION = 58093
+const ENFORCED = 58094
+const ENGINE_ATTRIBUTE = 58095
+const EXCLUDE = 58096
+const FOLLOWING = 58097
+const GEOMCOLLECTION = 58098
+const GET_MASTER_PUBLIC_KEY = 58099
+const HISTOGRAM = 58100
+const HISTORY = 58101
+const INACTIVE = 58102
+const INVISIBLE = 58103
+const LOCKED = 58104
+const MASTER_COMPRESSION_ALGORITHMS = 58105
+const MASTER_PUBLIC_KEY_PATH = 58106
+const MASTER_TLS_CIPHERSUITES = 58107
+const MASTER_ZSTD_COMPRESSION_LEVEL = 58108
+const NESTED = 58109
+const NETWORK_NAMESPACE = 58110
+const NOWAIT = 58111
+const NULLS = 58112
+const OJ = 58113
+const OLD = 58114
+const OPTIONAL = 58115
+const ORDINALITY = 58116
+const ORGANIZATION = 58117
+const OTHERS = 58118
+const PARTIAL = 58119
+const PATH = 58120
+const PERSIST = 58121
+const PERSIST_ONLY = 58122
+const PRECEDING = 58123
+const PRIVILEGE_CHECKS_USER = 58124
+const PROCESS = 58125
+const RANDOM = 58126
+const REFERENCE = 58127
+const REQUIRE_ROW_FORMAT = 58128
+const RESOURCE = 58129
+const RESPECT = 58130
+const RESTART = 58131
+const RETAIN = 58132
+const REUSE = 58133
+const ROLE = 58134
+const SECONDARY = 58135
+const SECONDARY_ENGINE = 58136
+const SECONDARY_ENGINE_ATTRIBUTE = 58137
+const SECONDARY_LOAD = 58138
+const SECONDARY_UNLOAD = 58139
+const SIMPLE = 58140
+const SKIP = 58141
+const SRID = 58142
+const THREAD_PRIORITY = 58143
+const TIES = 58144
+const UNBOUNDED = 58145
+const VCPU = 58146
+const VISIBLE = 58147
+const RETURNING = 58148
+const FORMAT = 58149
+const TREE = 58150
+const TRADITIONAL = 58151
+const LOCAL = 58152
+const LOW_PRIORITY = 58153
+const NO_WRITE_TO_BINLOG = 58154
+const LOGS = 58155
+const ERROR = 58156
+const GENERAL = 58157
+const HOSTS = 58158
+const OPTIMIZER_COSTS = 58159
+const USER_RESOURCES = 58160
+const SLOW = 58161
+const CHANNEL = 58162
+const RELAY = 58163
+const EXPORT = 58164
+const AVG_ROW_LENGTH = 58165
+const CONNECTION = 58166
+const CHECKSUM = 58167
+const DELAY_KEY_WRITE = 58168
+const ENCRYPTION = 58169
+const INSERT_METHOD = 58170
+const MAX_ROWS = 58171
+const MIN_ROWS = 58172
+const PACK_KEYS = 58173
+const PASSWORD = 58174
+const FIXED = 58175
+const DYNAMIC = 58176
+const COMPRESSED = 58177
+const REDUNDANT = 58178
+const COMPACT = 58179
+const ROW_FORMAT = 58180
+const STATS_AUTO_RECALC = 58181
+const STATS_PERSISTENT = 58182
+const STATS_SAMPLE_PAGES = 58183
+const STORAGE = 58184
+const MEMORY = 58185
+const DISK = 58186
 
 var sqlite3Toknames = [...]string{
 	"$end",
@@ -1258,6 +1260,7 @@ var sqlite3Toknames = [...]string{
 	"COMMENT_KEYWORD",
 	"BIT_LITERAL",
 	"COMPRESSION",
+	"MODULE_ARGUMENTS",
 	"JSON_PRETTY",
 	"JSON_STORAGE_SIZE",
 	"JSON_STORAGE_FREE",
@@ -1322,6 +1325,7 @@ var sqlite3Toknames = [...]string{
 	"'%'",
 	"MOD",
 	"'^'",
+	"CONCAT",
 	"'~'",
 	"UNARY",
 	"COLLATE",
@@ -1763,1941 +1767,2054 @@ const sqlite3InitialStackSize = 16
 //line yacctab:1
 var sqlite3Exca = [...]int{
 	-1, 0,
-	12, 47,
-	13, 47,
-	38, 679,
-	-2, 37,
+	12, 48,
+	13, 48,
+	38, 733,
+	-2, 38,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 48,
-	1, 140,
-	860, 140,
-	-2, 148,
-	-1, 49,
-	1, 464,
-	860, 464,
-	-2, 148,
-	-1, 56,
-	35, 595,
-	495, 595,
-	506, 595,
-	541, 607,
-	542, 607,
-	-2, 597,
-	-1, 61,
-	497, 620,
-	-2, 618,
-	-1, 115,
-	494, 1110,
-	-2, 114,
-	-1, 117,
-	1, 141,
-	860, 141,
-	-2, 148,
-	-1, 128,
-	394, 148,
-	433, 148,
-	599, 148,
-	-2, 473,
-	-1, 129,
-	395, 371,
-	500, 371,
-	-2, 458,
-	-1, 333,
-	478, 1132,
-	-2, 1127,
-	-1, 334,
-	478, 1133,
-	-2, 1128,
-	-1, 408,
-	322, 688,
-	-2, 703,
-	-1, 611,
-	505, 41,
-	510, 41,
-	-2, 382,
-	-1, 667,
-	1, 517,
-	860, 517,
-	-2, 148,
-	-1, 892,
-	322, 689,
-	-2, 708,
-	-1, 893,
-	322, 690,
-	-2, 709,
-	-1, 943,
-	1, 421,
-	860, 421,
-	-2, 148,
-	-1, 1023,
-	505, 42,
-	510, 42,
-	-2, 383,
-	-1, 1281,
-	478, 1137,
-	-2, 1130,
-	-1, 1363,
-	1, 422,
-	860, 422,
-	-2, 148,
-	-1, 1370,
-	25, 169,
-	-2, 171,
-	-1, 1589,
-	350, 39,
-	-2, 744,
-	-1, 1641,
-	340, 91,
-	350, 91,
+	-1, 50,
+	1, 169,
+	862, 169,
+	-2, 177,
+	-1, 51,
+	1, 503,
+	862, 503,
+	-2, 177,
+	-1, 58,
+	35, 634,
+	497, 634,
+	508, 634,
+	543, 646,
+	544, 646,
+	-2, 636,
+	-1, 64,
+	499, 674,
+	-2, 672,
+	-1, 121,
+	496, 1166,
+	497, 136,
+	537, 1166,
+	-2, 117,
+	-1, 123,
+	1, 170,
+	862, 170,
+	-2, 177,
+	-1, 134,
+	395, 177,
+	435, 177,
+	601, 177,
+	-2, 512,
+	-1, 135,
+	396, 408,
+	502, 408,
+	-2, 497,
+	-1, 348,
+	480, 1187,
+	-2, 1182,
+	-1, 349,
+	480, 1188,
+	-2, 1183,
+	-1, 424,
+	322, 742,
+	-2, 757,
+	-1, 633,
+	507, 42,
+	512, 42,
+	-2, 419,
+	-1, 690,
+	1, 556,
+	862, 556,
+	-2, 177,
+	-1, 919,
+	322, 743,
+	-2, 762,
+	-1, 920,
+	322, 744,
 	-2, 763,
-	-1, 1994,
-	350, 39,
-	-2, 745,
-	-1, 2018,
-	7, 53,
-	18, 53,
-	20, 53,
-	351, 53,
-	-2, 737,
+	-1, 970,
+	1, 458,
+	862, 458,
+	-2, 177,
+	-1, 1061,
+	507, 43,
+	512, 43,
+	-2, 420,
+	-1, 1324,
+	480, 1192,
+	-2, 1185,
+	-1, 1410,
+	1, 459,
+	862, 459,
+	-2, 177,
+	-1, 1417,
+	25, 200,
+	-2, 202,
+	-1, 1660,
+	350, 40,
+	-2, 798,
+	-1, 1714,
+	340, 92,
+	350, 92,
+	-2, 817,
+	-1, 2086,
+	350, 40,
+	-2, 799,
+	-1, 2110,
+	7, 54,
+	18, 54,
+	20, 54,
+	351, 54,
+	-2, 791,
 }
 
 const sqlite3Private = 57344
 
-const sqlite3Last = 17807
+const sqlite3Last = 18909
 
 var sqlite3Act = [...]int{
-	333, 342, 2260, 2088, 2157, 335, 2087, 2233, 2089, 1791,
-	2234, 2204, 946, 2066, 1928, 2188, 1294, 1870, 1877, 1314,
-	1544, 1360, 3, 2122, 326, 37, 420, 1883, 907, 721,
-	2143, 872, 1610, 255, 1606, 251, 1790, 401, 277, 1755,
-	96, 336, 1789, 327, 324, 2009, 1613, 325, 248, 1913,
-	1679, 1611, 163, 1614, 1396, 163, 1738, 216, 163, 678,
-	1417, 247, 1702, 230, 1400, 163, 1681, 1680, 448, 421,
-	1624, 1443, 1670, 163, 249, 1379, 576, 1608, 1349, 145,
-	894, 1580, 1275, 1990, 1432, 1029, 1635, 163, 1251, 1192,
-	1000, 126, 1182, 1459, 1416, 163, 1338, 1141, 1021, 1675,
-	243, 1402, 405, 1320, 409, 577, 36, 95, 1643, 1337,
-	230, 38, 913, 230, 163, 230, 1250, 403, 1340, 935,
-	1295, 260, 423, 875, 1204, 804, 1158, 741, 1095, 642,
-	1028, 1091, 583, 612, 1414, 1080, 580, 584, 608, 445,
-	609, 1278, 932, 1380, 934, 919, 89, 415, 1393, 1247,
-	1718, 1717, 1457, 2038, 1746, 1747, 2092, 742, 2092, 219,
-	165, 166, 167, 2177, 2176, 1069, 1147, 742, 1291, 1292,
-	1146, 1145, 1144, 1143, 241, 2222, 242, 1136, 1576, 1978,
-	2267, 1447, 1408, 2232, 2246, 2237, 410, 1712, 1709, 1151,
-	2214, 411, 163, 429, 163, 434, 646, 163, 602, 706,
-	197, 645, 1445, 78, 2212, 2266, 1404, 2198, 606, 1491,
-	2256, 2172, 2244, 2218, 2219, 619, 773, 230, 723, 230,
-	2067, 1885, 1886, 2189, 633, 1446, 639, 2213, 2197, 2171,
-	219, 78, 78, 230, 230, 607, 2127, 774, 775, 776,
-	777, 778, 779, 780, 782, 781, 783, 784, 1754, 719,
-	2209, 1962, 442, 449, 2177, 1331, 1010, 734, 681, 37,
-	687, 688, 689, 1726, 751, 601, 1577, 1725, 1100, 713,
-	1383, 715, 644, 78, 751, 1866, 80, 649, 650, 651,
-	666, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	647, 597, 1652, 671, 672, 1651, 1355, 1356, 1653, 146,
-	1745, 120, 1867, 1868, 592, 1488, 936, 594, 937, 712,
-	714, 1354, 696, 396, 701, 702, 718, 395, 2158, 697,
-	1663, 118, 1373, 1372, 1953, 674, 1884, 690, 664, 1930,
-	1951, 788, 789, 790, 791, 995, 119, 1293, 1887, 2238,
-	685, 796, 219, 799, 219, 686, 1673, 1674, 121, 595,
-	228, 1016, 1135, 684, 1433, 683, 124, 232, 226, 1063,
-	2239, 1703, 1460, 1923, 220, 1721, 2255, 747, 1081, 870,
-	740, 1924, 699, 700, 705, 652, 865, 747, 1465, 881,
-	219, 648, 1932, 1468, 792, 1469, 1462, 1470, 785, 785,
-	1137, 1138, 1464, 621, 2264, 1887, 163, 159, 2268, 230,
-	230, 127, 597, 1064, 589, 1065, 694, 2242, 698, 581,
-	710, 591, 590, 703, 711, 163, 691, 121, 1931, 600,
-	1383, 122, 614, 704, 716, 1489, 717, 884, 882, 597,
-	662, 888, 230, 1466, 1463, 220, 163, 405, 1233, 599,
-	709, 230, 885, 581, 1092, 403, 887, 230, 615, 581,
-	1415, 871, 1737, 579, 598, 1027, 2223, 436, 1381, 1382,
-	595, 121, 1734, 596, 1452, 1581, 1583, 1088, 725, 728,
-	445, 624, 627, 1750, 1503, 886, 901, 614, 1902, 95,
-	621, 623, 1089, 1720, 159, 1008, 1007, 1006, 1004, 196,
-	621, 1324, 620, 191, 2105, 1442, 786, 787, 614, 617,
-	618, 1085, 581, 2091, 2025, 2091, 611, 615, 122, 1838,
-	1648, 1605, 1733, 871, 876, 2002, 1732, 1568, 1225, 1214,
-	1215, 1216, 1217, 1227, 1218, 1219, 1220, 1232, 1228, 1221,
-	1222, 1229, 1230, 1231, 1223, 1224, 1226, 1489, 1671, 1287,
-	923, 851, 676, 1332, 87, 2128, 1361, 220, 785, 220,
-	784, 746, 743, 744, 745, 750, 752, 749, 1865, 748,
-	1026, 746, 743, 744, 745, 750, 752, 749, 1444, 748,
-	1407, 1665, 87, 87, 596, 418, 163, 1163, 2166, 620,
-	996, 1602, 1723, 1740, 449, 220, 707, 665, 1739, 620,
-	1005, 1164, 1165, 1162, 634, 614, 112, 1101, 76, 636,
-	643, 596, 2217, 637, 635, 1832, 91, 603, 1381, 1382,
-	1740, 230, 1023, 1461, 87, 1739, 435, 680, 1406, 2170,
-	1032, 1086, 938, 413, 1034, 1035, 1582, 230, 641, 738,
-	1774, 1205, 1205, 1518, 1040, 1692, 724, 1043, 1044, 163,
-	1046, 755, 2041, 1082, 98, 1083, 2216, 2040, 85, 1084,
-	779, 780, 782, 781, 783, 784, 1033, 1998, 1437, 1036,
-	97, 230, 1405, 163, 113, 1042, 1039, 1153, 1155, 1156,
-	1041, 1025, 1090, 756, 756, 230, 404, 163, 777, 778,
-	779, 780, 782, 781, 783, 784, 756, 1020, 631, 630,
-	1154, 2240, 163, 1495, 1496, 1497, 1015, 1321, 1322, 163,
-	2026, 756, 2039, 1037, 1411, 2241, 87, 238, 163, 163,
-	163, 163, 163, 163, 163, 163, 163, 230, 1031, 1959,
-	1161, 347, 348, 349, 230, 230, 2078, 901, 2045, 2032,
-	1685, 1011, 1012, 1013, 2269, 1676, 867, 402, 1209, 1455,
-	1656, 163, 1098, 1067, 1061, 1097, 422, 1059, 1058, 1030,
-	1105, 165, 166, 167, 147, 2033, 148, 1109, 1057, 1111,
-	1112, 1113, 1114, 165, 166, 167, 1118, 1655, 165, 166,
-	167, 1038, 1427, 756, 239, 1927, 158, 1055, 1056, 756,
-	727, 901, 1060, 2065, 334, 1860, 230, 1609, 1189, 1189,
-	2228, 901, 1187, 1187, 1507, 1489, 1186, 1190, 1831, 1195,
-	230, 165, 166, 167, 756, 1093, 100, 756, 756, 1600,
-	2195, 1159, 1688, 756, 1831, 230, 230, 1607, 1166, 1369,
-	1168, 1157, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 756, 753, 164, 1600, 2185, 164,
-	1206, 147, 164, 148, 1533, 1167, 756, 231, 87, 164,
-	165, 166, 167, 2165, 1425, 1833, 92, 164, 1248, 1600,
-	2182, 1620, 756, 158, 2178, 901, 693, 93, 1600, 756,
-	2262, 164, 1514, 2263, 1596, 2261, 756, 695, 163, 164,
-	756, 1279, 230, 1103, 1104, 150, 911, 756, 1621, 1160,
-	1974, 901, 756, 1974, 231, 1816, 1108, 231, 164, 231,
-	1600, 2060, 1353, 1115, 1116, 1117, 163, 753, 901, 230,
-	1248, 1505, 1304, 1607, 1305, 1490, 1003, 92, 163, 1538,
-	1281, 230, 2124, 86, 94, 163, 1523, 163, 93, 163,
-	163, 230, 1522, 1711, 230, 1191, 1283, 1284, 1538, 901,
-	95, 1429, 1197, 1198, 1319, 230, 1441, 1022, 1333, 905,
-	37, 86, 86, 1279, 2090, 756, 2090, 1018, 95, 81,
-	445, 1107, 1017, 445, 1893, 1894, 1128, 121, 1370, 600,
-	2036, 2037, 150, 1132, 1133, 1310, 164, 1710, 164, 1644,
-	1280, 164, 1071, 1070, 1072, 1073, 1074, 1289, 756, 599,
-	1139, 708, 1281, 86, 1282, 1893, 1892, 1285, 1286, 621,
-	230, 231, 1102, 231, 598, 1621, 901, 1418, 1419, 1420,
-	1644, 404, 1422, 1424, 1426, 1336, 98, 231, 231, 679,
-	230, 1365, 1509, 1364, 1087, 230, 1032, 1347, 1299, 1032,
-	619, 1032, 1508, 1309, 1384, 1385, 1386, 230, 906, 230,
-	756, 930, 1374, 1451, 1375, 1376, 1377, 1378, 901, 1397,
-	1368, 883, 1328, 1312, 928, 929, 230, 230, 2047, 1403,
-	1389, 1390, 1391, 1392, 756, 402, 1326, 754, 754, 755,
-	755, 1836, 901, 1351, 449, 1776, 1352, 449, 1051, 163,
-	754, 2270, 755, 1367, 756, 1366, 163, 407, 163, 163,
-	605, 756, 163, 604, 163, 754, 1431, 755, 620, 1756,
-	640, 163, 873, 908, 1410, 165, 166, 167, 163, 1423,
-	756, 1505, 901, 1448, 760, 761, 762, 763, 764, 765,
-	766, 758, 1449, 756, 1436, 2136, 901, 1439, 2115, 1440,
-	163, 1450, 1398, 756, 2108, 230, 756, 1409, 1505, 1413,
-	1394, 1395, 1489, 1719, 1421, 756, 999, 1704, 1453, 1454,
-	1957, 901, 1621, 1553, 901, 2162, 1434, 1435, 1438, 1398,
-	901, 1700, 1701, 1480, 1481, 773, 1749, 754, 1483, 755,
-	1600, 1599, 756, 754, 2043, 755, 1458, 1484, 756, 1621,
-	164, 901, 1758, 231, 231, 901, 774, 775, 776, 777,
-	778, 779, 780, 782, 781, 783, 784, 1001, 754, 164,
-	755, 754, 754, 755, 755, 1925, 1159, 754, 1513, 755,
-	1505, 756, 1499, 1159, 1501, 910, 231, 756, 999, 998,
-	164, 1473, 1545, 944, 943, 231, 2020, 1705, 754, 149,
-	755, 231, 151, 152, 1678, 1388, 153, 154, 1976, 1500,
-	754, 1387, 755, 155, 156, 157, 901, 1682, 1831, 1078,
-	1024, 997, 1768, 1767, 1766, 1760, 754, 1764, 755, 1759,
-	114, 1757, 1683, 754, 666, 755, 1762, 1487, 2010, 2011,
-	754, 1929, 755, 2125, 754, 1761, 755, 1408, 1302, 916,
-	2252, 754, 2250, 755, 1160, 1130, 754, 101, 755, 1498,
-	163, 1160, 1763, 1765, 2235, 756, 1504, 163, 100, 2175,
-	99, 1972, 1189, 230, 2051, 1645, 1187, 1047, 2141, 230,
-	1586, 2013, 1907, 1906, 756, 1647, 149, 1574, 1905, 151,
-	152, 1693, 1590, 153, 154, 1604, 101, 1609, 1517, 1474,
-	155, 156, 157, 1849, 1965, 230, 1645, 100, 1850, 99,
-	1847, 2016, 1515, 163, 2015, 1848, 1489, 163, 94, 754,
-	1846, 755, 899, 895, 1845, 1851, 1281, 1630, 1631, 409,
-	164, 909, 1654, 2225, 2196, 1612, 1531, 1637, 896, 1316,
-	756, 1308, 2048, 2049, 2050, 773, 1615, 1837, 756, 1820,
-	588, 230, 754, 2097, 755, 2096, 1964, 394, 1169, 1889,
-	117, 1597, 1052, 1053, 1054, 231, 774, 775, 776, 777,
-	778, 779, 780, 782, 781, 783, 784, 807, 876, 230,
-	1562, 231, 933, 1575, 1661, 163, 1280, 1584, 1565, 1566,
-	1686, 428, 163, 164, 916, 1201, 102, 629, 87, 230,
-	1561, 628, 1601, 756, 754, 1598, 755, 1560, 1032, 1032,
-	1202, 410, 9, 1657, 1642, 231, 411, 164, 1698, 1636,
-	8, 1664, 1666, 1716, 1939, 1672, 1559, 1646, 754, 231,
-	755, 164, 1403, 163, 163, 163, 163, 163, 756, 1558,
-	1682, 1658, 1715, 1649, 163, 163, 164, 7, 754, 1557,
-	755, 1743, 1556, 164, 726, 754, 903, 755, 1677, 1708,
-	163, 1555, 164, 164, 164, 164, 164, 164, 164, 164,
-	164, 231, 122, 1684, 754, 1076, 755, 1690, 231, 231,
-	230, 1075, 1714, 1694, 1695, 1696, 1689, 754, 1554, 755,
-	1066, 1015, 440, 90, 1548, 164, 433, 754, 1829, 755,
-	754, 92, 755, 1713, 593, 2163, 1706, 1707, 94, 754,
-	2258, 755, 93, 412, 77, 1189, 1903, 1189, 1769, 1187,
-	1189, 1187, 667, 1773, 1187, 1189, 403, 1547, 1683, 1187,
-	1321, 1322, 1477, 1546, 1751, 2120, 754, 1888, 755, 230,
-	231, 1735, 754, 670, 755, 1634, 1313, 1792, 1752, 1792,
-	915, 1819, 1792, 1991, 231, 1797, 1493, 1792, 1794, 1818,
-	1798, 1804, 1805, 1806, 1807, 1753, 99, 163, 1811, 231,
-	231, 230, 1770, 2148, 1189, 754, 230, 755, 1187, 2147,
-	1131, 754, 1825, 755, 2052, 2053, 2054, 1048, 1049, 1050,
-	426, 427, 163, 163, 163, 163, 163, 1785, 2102, 1821,
-	1908, 901, 101, 1827, 163, 1811, 1428, 425, 100, 163,
-	101, 1543, 163, 100, 163, 99, 2101, 163, 163, 163,
-	1810, 100, 1997, 1839, 1612, 1306, 1307, 898, 1859, 897,
-	1542, 1812, 164, 888, 1637, 1607, 231, 1813, 1814, 1815,
-	901, 1799, 1800, 1801, 1802, 1803, 1781, 737, 1823, 1741,
-	2254, 2253, 1742, 1822, 809, 735, 810, 1901, 1830, 754,
-	164, 755, 1524, 231, 163, 663, 2095, 1300, 924, 917,
-	2253, 230, 164, 1852, 811, 231, 230, 95, 754, 164,
-	755, 164, 736, 164, 164, 231, 1541, 1097, 231, 1841,
-	1842, 163, 1844, 317, 1539, 1912, 1863, 673, 2254, 231,
-	1861, 1840, 2103, 1862, 1843, 163, 1869, 1910, 163, 2031,
-	1890, 1891, 107, 108, 417, 1856, 1857, 1897, 1940, 1898,
-	1896, 899, 895, 104, 1917, 39, 88, 1916, 1, 2035,
-	101, 2211, 208, 1290, 754, 1403, 755, 896, 874, 1911,
-	2236, 100, 754, 99, 755, 2207, 1920, 1909, 77, 1535,
-	2208, 1068, 94, 1062, 231, 2068, 229, 1935, 1249, 2121,
-	1401, 613, 1934, 128, 1362, 1363, 2191, 111, 574, 110,
-	616, 1937, 1938, 692, 231, 610, 1430, 1662, 1971, 231,
-	1371, 1942, 1899, 1900, 1534, 1949, 950, 230, 163, 948,
-	949, 231, 947, 231, 952, 1943, 951, 754, 1525, 755,
-	1977, 1134, 227, 447, 1633, 161, 578, 230, 585, 230,
-	231, 231, 939, 1603, 1873, 918, 638, 1946, 1947, 199,
-	1948, 1895, 1456, 1950, 204, 1952, 682, 1954, 2003, 797,
-	37, 163, 754, 164, 755, 1612, 1129, 1817, 1650, 446,
-	164, 439, 164, 164, 2007, 1301, 164, 1615, 164, 2034,
-	1588, 1615, 2017, 1999, 2005, 164, 1617, 2001, 163, 2014,
-	2186, 1659, 164, 912, 2021, 2100, 2023, 2024, 1992, 1993,
-	1996, 1516, 1203, 163, 1341, 1152, 163, 163, 163, 230,
-	253, 252, 2029, 250, 164, 1591, 1330, 759, 1917, 231,
-	337, 1916, 1578, 2030, 1579, 925, 1625, 1623, 2059, 1622,
-	230, 230, 230, 230, 1475, 1348, 622, 625, 626, 2012,
-	447, 2008, 447, 2203, 632, 1343, 1339, 2022, 1595, 1594,
-	2064, 261, 254, 246, 2028, 1915, 729, 731, 1722, 1926,
-	2062, 2063, 1724, 1660, 1922, 739, 891, 240, 2044, 587,
-	2046, 1200, 2126, 1492, 1189, 1961, 1189, 890, 1187, 1212,
-	1187, 1213, 64, 42, 1240, 813, 159, 234, 2077, 2221,
-	730, 32, 31, 2073, 30, 29, 103, 1699, 1626, 1629,
-	1630, 1631, 1627, 24, 1628, 1632, 1792, 23, 1792, 22,
-	122, 2084, 142, 21, 20, 2083, 26, 19, 2093, 18,
-	17, 2231, 1189, 2074, 2257, 116, 1187, 51, 48, 46,
-	2110, 125, 123, 857, 858, 859, 860, 861, 105, 106,
-	49, 109, 45, 668, 115, 2106, 1615, 160, 403, 2104,
-	192, 1323, 43, 4, 892, 893, 898, 2112, 897, 2111,
-	28, 27, 403, 16, 15, 14, 868, 2114, 230, 13,
-	398, 399, 400, 12, 164, 408, 11, 10, 6, 5,
-	35, 164, 34, 33, 2117, 733, 25, 231, 2118, 2,
-	0, 2138, 0, 231, 1189, 230, 2139, 2123, 1187, 230,
-	230, 0, 2142, 0, 437, 438, 230, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 230, 0, 0, 231,
-	0, 0, 879, 880, 0, 230, 163, 164, 2156, 2149,
-	2150, 164, 0, 2155, 2152, 2161, 2153, 1612, 2159, 0,
-	0, 0, 0, 2164, 2167, 37, 0, 1626, 1629, 1630,
-	1631, 1627, 1874, 1628, 1632, 921, 0, 2010, 2011, 0,
-	0, 0, 0, 0, 447, 231, 230, 230, 0, 0,
-	940, 0, 0, 0, 0, 0, 0, 1876, 0, 230,
-	0, 0, 0, 0, 163, 230, 2187, 0, 37, 2190,
-	0, 1871, 0, 231, 2199, 2123, 2192, 0, 0, 164,
-	2202, 0, 0, 2220, 2210, 2215, 164, 0, 1885, 1886,
-	2224, 0, 0, 231, 1872, 0, 0, 0, 2230, 775,
-	776, 777, 778, 779, 780, 782, 781, 783, 784, 230,
-	0, 0, 0, 1189, 0, 0, 0, 1187, 2243, 0,
-	2245, 2248, 2251, 0, 2249, 0, 1878, 164, 164, 164,
-	164, 164, 2247, 2259, 0, 0, 0, 2265, 164, 164,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1189,
-	0, 0, 0, 1187, 164, 2273, 2274, 2271, 2272, 0,
-	773, 2139, 768, 0, 769, 0, 0, 0, 0, 0,
-	772, 0, 0, 0, 231, 0, 0, 0, 770, 771,
-	767, 774, 775, 776, 777, 778, 779, 780, 782, 781,
-	783, 784, 0, 1884, 0, 0, 0, 139, 0, 0,
-	0, 0, 0, 141, 0, 1887, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 0, 0, 0, 0, 0,
-	0, 0, 0, 147, 0, 148, 868, 773, 1502, 868,
-	578, 0, 0, 231, 0, 0, 868, 0, 0, 0,
-	0, 136, 137, 135, 134, 158, 0, 0, 774, 775,
-	776, 777, 778, 779, 780, 782, 781, 783, 784, 0,
-	0, 164, 0, 0, 1077, 231, 0, 0, 0, 0,
-	231, 0, 0, 0, 0, 0, 0, 0, 1094, 0,
-	0, 0, 0, 0, 889, 0, 164, 164, 164, 164,
-	164, 0, 0, 0, 0, 0, 0, 0, 164, 0,
-	0, 0, 0, 164, 0, 0, 164, 0, 164, 0,
-	0, 164, 164, 164, 0, 0, 0, 0, 1875, 0,
-	447, 0, 0, 0, 0, 0, 0, 447, 447, 0,
-	285, 287, 286, 296, 297, 298, 299, 300, 301, 302,
-	283, 130, 138, 140, 0, 900, 129, 0, 131, 132,
-	0, 0, 0, 0, 150, 0, 0, 0, 164, 0,
-	0, 0, 0, 0, 0, 231, 0, 0, 0, 0,
-	231, 774, 775, 776, 777, 778, 779, 780, 782, 781,
-	783, 784, 0, 0, 0, 164, 0, 0, 318, 1183,
-	159, 0, 0, 0, 0, 0, 0, 0, 0, 164,
-	0, 1014, 164, 1196, 0, 0, 0, 0, 0, 0,
-	868, 0, 0, 0, 122, 0, 142, 0, 1210, 1211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 0, 195, 0, 0, 225, 0, 0, 0,
-	0, 0, 0, 195, 0, 447, 0, 1879, 0, 0,
-	0, 195, 0, 0, 0, 0, 0, 0, 0, 1882,
-	1009, 0, 0, 0, 0, 416, 0, 0, 0, 0,
-	0, 231, 164, 195, 0, 1303, 0, 0, 0, 0,
-	0, 0, 432, 0, 432, 0, 0, 0, 0, 0,
-	0, 231, 195, 231, 0, 0, 0, 0, 1880, 0,
-	0, 0, 1315, 1881, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 921, 164, 0, 447, 0, 0,
-	0, 0, 0, 0, 447, 0, 0, 447, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 578, 0,
-	0, 0, 164, 0, 133, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 164, 0, 0,
-	164, 164, 164, 231, 291, 292, 0, 0, 0, 0,
-	195, 0, 195, 0, 0, 195, 0, 143, 0, 0,
-	144, 0, 0, 0, 231, 231, 231, 231, 0, 0,
-	0, 0, 0, 585, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 578, 0, 0, 0, 328, 585, 278,
-	332, 280, 329, 330, 0, 276, 279, 331, 0, 0,
-	578, 0, 1183, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1183,
-	1183, 0, 0, 0, 281, 282, 284, 288, 289, 290,
-	293, 294, 295, 303, 305, 306, 304, 307, 308, 309,
-	312, 313, 314, 315, 310, 311, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 151, 152, 0, 0, 153, 154, 0, 0, 0,
-	0, 78, 155, 156, 157, 0, 0, 0, 0, 0,
-	0, 139, 0, 0, 0, 0, 0, 141, 0, 0,
-	0, 869, 231, 0, 0, 0, 0, 0, 1486, 170,
-	0, 0, 0, 0, 175, 168, 0, 147, 0, 148,
-	0, 0, 0, 0, 0, 0, 902, 904, 0, 231,
-	0, 0, 0, 231, 231, 136, 137, 135, 134, 158,
-	231, 0, 0, 231, 0, 0, 0, 0, 0, 0,
-	231, 0, 0, 0, 195, 0, 0, 159, 0, 231,
-	164, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 416, 0, 0, 0, 0, 0, 0,
-	0, 122, 0, 142, 0, 0, 0, 169, 0, 0,
-	0, 0, 0, 0, 195, 0, 0, 0, 0, 0,
-	231, 231, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 0, 231, 0, 0, 0, 0, 164, 231,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 130, 138, 140, 0, 0,
-	129, 0, 131, 132, 0, 0, 0, 0, 150, 0,
-	0, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 447, 0, 231, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1315, 0, 0, 0,
-	0, 0, 1592, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 185, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 868, 0, 0, 1619, 0,
-	0, 187, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 195, 0, 170, 0, 0, 0,
-	0, 175, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1667, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 869, 1687, 0, 869, 0, 0, 0, 0, 0,
-	0, 869, 0, 0, 0, 0, 0, 195, 0, 0,
-	0, 0, 1315, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 166, 167, 169, 0, 0, 0, 0, 0,
-	0, 195, 87, 0, 0, 165, 166, 167, 0, 0,
-	0, 0, 0, 0, 0, 1096, 0, 0, 172, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
-	195, 0, 0, 219, 0, 0, 0, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 1119, 1120, 195, 195,
-	195, 195, 195, 195, 195, 0, 0, 0, 178, 0,
-	0, 143, 0, 1183, 144, 0, 0, 0, 139, 0,
-	0, 179, 0, 217, 141, 0, 0, 0, 0, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 147, 0, 148, 868, 0, 868,
-	0, 185, 868, 0, 1207, 0, 0, 868, 1208, 0,
-	0, 0, 136, 137, 135, 134, 158, 0, 187, 0,
-	212, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 432, 210, 0, 0, 0, 0, 0, 432,
-	432, 0, 0, 0, 0, 869, 0, 0, 0, 0,
-	0, 0, 0, 0, 1824, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 902, 1288, 0,
-	0, 0, 149, 207, 868, 151, 152, 0, 0, 153,
-	154, 0, 215, 183, 0, 0, 155, 156, 157, 432,
-	1096, 432, 432, 432, 432, 432, 0, 0, 0, 1311,
-	0, 0, 0, 0, 0, 0, 0, 0, 173, 0,
-	177, 0, 130, 138, 140, 0, 1297, 129, 0, 131,
-	132, 0, 0, 0, 0, 150, 0, 0, 0, 0,
-	432, 0, 165, 166, 167, 0, 345, 346, 220, 0,
-	0, 0, 1188, 0, 416, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 0, 195, 868, 0, 1315,
-	0, 0, 1096, 195, 0, 195, 0, 195, 1350, 0,
-	198, 0, 200, 0, 0, 222, 0, 221, 203, 0,
-	202, 205, 213, 206, 0, 201, 0, 211, 0, 0,
-	214, 0, 209, 223, 0, 0, 0, 0, 1412, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 352, 353, 354, 355, 356,
-	357, 358, 359, 360, 361, 362, 363, 364, 365, 366,
-	367, 368, 369, 370, 371, 372, 373, 374, 375, 376,
-	377, 378, 379, 380, 381, 382, 383, 384, 385, 386,
-	387, 388, 389, 390, 391, 392, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1824, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 0, 0, 0, 868, 0, 0, 0, 0,
-	2004, 0, 2006, 0, 0, 0, 176, 1345, 0, 0,
-	1344, 0, 0, 0, 0, 182, 0, 0, 0, 0,
-	183, 190, 0, 0, 0, 0, 0, 195, 0, 0,
-	0, 0, 171, 174, 195, 133, 195, 195, 0, 0,
-	195, 0, 1478, 0, 0, 173, 0, 177, 180, 195,
-	0, 0, 2229, 0, 181, 0, 195, 0, 0, 184,
-	0, 0, 967, 0, 0, 0, 0, 0, 143, 0,
-	0, 144, 1315, 0, 224, 0, 0, 0, 195, 0,
-	188, 189, 0, 0, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 2069, 2070, 2071, 2072, 1506, 0, 0,
-	0, 1510, 0, 1511, 1512, 0, 218, 0, 0, 0,
-	0, 0, 1520, 0, 0, 1521, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 868, 0, 868, 0,
-	0, 1526, 1527, 1528, 1529, 1530, 0, 1532, 0, 432,
-	0, 0, 0, 1536, 0, 1537, 0, 0, 0, 1540,
-	0, 0, 0, 0, 0, 0, 0, 1549, 1550, 1551,
-	1552, 0, 0, 0, 0, 0, 0, 0, 0, 149,
-	1563, 1564, 151, 152, 0, 0, 153, 154, 1569, 1570,
-	1571, 1572, 1573, 155, 156, 157, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1585, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 432, 432, 0, 0, 0,
-	0, 447, 0, 176, 0, 0, 1096, 0, 195, 0,
-	1618, 0, 182, 0, 0, 1297, 0, 0, 190, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2145, 171,
-	174, 0, 2145, 2145, 0, 0, 0, 868, 0, 2154,
-	0, 0, 1315, 0, 0, 180, 0, 0, 0, 1315,
-	869, 181, 0, 0, 0, 0, 184, 0, 1315, 0,
-	0, 195, 0, 0, 0, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 189, 0,
-	0, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 447,
-	447, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2200, 0, 0, 0, 0, 0, 2205, 0,
+	348, 2170, 2250, 1032, 357, 2169, 2143, 2168, 2306, 974,
+	2340, 2290, 2015, 2282, 1418, 2283, 2207, 1884, 1425, 1337,
+	1615, 1431, 744, 1364, 1481, 3, 2236, 1686, 934, 1683,
+	342, 350, 1847, 417, 897, 269, 1882, 2023, 436, 100,
+	2024, 1994, 1708, 1034, 1883, 1468, 1687, 2101, 341, 38,
+	262, 1445, 1464, 664, 170, 1684, 2082, 170, 1830, 223,
+	170, 701, 1791, 339, 1737, 263, 244, 1503, 170, 340,
+	1513, 1764, 265, 1397, 37, 351, 170, 592, 464, 1697,
+	170, 261, 1386, 1681, 921, 1385, 151, 1651, 437, 1318,
+	1294, 170, 729, 1067, 1220, 1230, 1499, 1179, 1529, 170,
+	1059, 132, 1769, 428, 81, 292, 257, 1477, 975, 962,
+	1716, 940, 39, 274, 244, 593, 1338, 244, 170, 1388,
+	419, 900, 1133, 1293, 829, 1247, 1196, 99, 765, 1129,
+	421, 1066, 425, 596, 634, 1482, 461, 630, 266, 1461,
+	1118, 631, 600, 961, 946, 959, 431, 1446, 1980, 1321,
+	439, 1347, 1290, 1810, 1809, 1527, 1778, 93, 426, 766,
+	226, 1838, 1750, 1839, 2272, 2173, 2273, 1107, 1185, 766,
+	1184, 2173, 172, 173, 174, 1334, 1335, 1183, 1182, 1181,
+	255, 2327, 256, 1174, 1647, 2070, 1517, 2000, 1801, 2286,
+	2362, 2337, 2350, 1515, 1804, 2201, 427, 2131, 1189, 170,
+	623, 170, 666, 445, 170, 450, 204, 668, 672, 673,
+	674, 1996, 676, 677, 678, 679, 680, 681, 682, 683,
+	684, 627, 667, 2361, 244, 746, 244, 2300, 2357, 641,
+	1516, 2268, 2348, 2144, 1561, 2291, 226, 2299, 655, 2267,
+	661, 82, 1846, 172, 173, 174, 2256, 244, 244, 2054,
+	1138, 629, 1048, 153, 2273, 742, 126, 602, 124, 458,
+	1648, 465, 1959, 1960, 1439, 689, 775, 704, 125, 710,
+	711, 712, 1958, 758, 1818, 622, 775, 1449, 1817, 1403,
+	1404, 614, 963, 2313, 964, 82, 226, 82, 1725, 1837,
+	1558, 1724, 1402, 741, 1726, 724, 725, 38, 2311, 719,
+	411, 670, 410, 82, 127, 708, 84, 2317, 2318, 1379,
+	709, 614, 2251, 720, 1756, 1433, 1434, 713, 707, 1054,
+	706, 2312, 82, 1438, 1437, 84, 1027, 224, 1767, 1768,
+	2017, 2044, 226, 2042, 1173, 130, 812, 813, 814, 815,
+	687, 242, 246, 2287, 1336, 736, 820, 738, 823, 2010,
+	239, 237, 81, 1039, 1792, 1101, 1500, 2011, 1985, 1813,
+	2356, 1538, 1530, 1539, 2288, 1540, 227, 728, 1535, 771,
+	226, 133, 764, 1237, 219, 1119, 675, 127, 1534, 771,
+	621, 2019, 620, 722, 723, 735, 737, 217, 1532, 1044,
+	816, 906, 619, 890, 726, 809, 1175, 1176, 809, 1102,
+	226, 1103, 721, 740, 727, 2353, 714, 2344, 895, 1435,
+	671, 170, 2346, 597, 244, 244, 618, 685, 637, 2018,
+	1533, 1432, 127, 1536, 597, 597, 1559, 214, 595, 717,
+	1045, 170, 636, 1435, 896, 1987, 222, 646, 608, 1130,
+	1829, 1449, 227, 636, 1065, 1483, 616, 645, 244, 613,
+	166, 452, 170, 1826, 912, 1522, 909, 244, 1126, 1983,
+	419, 914, 2328, 244, 752, 1447, 1448, 911, 649, 1652,
+	1654, 915, 643, 1046, 128, 1812, 1127, 421, 1042, 461,
+	1842, 748, 203, 1573, 198, 928, 733, 1372, 2186, 1825,
+	734, 1123, 227, 1824, 810, 811, 2117, 896, 1930, 1721,
+	739, 1679, 1639, 1330, 1038, 950, 876, 699, 1765, 1033,
+	913, 1957, 91, 2172, 99, 618, 732, 694, 695, 2172,
+	663, 1512, 809, 434, 205, 703, 207, 808, 796, 229,
+	901, 228, 210, 730, 209, 212, 220, 213, 227, 208,
+	1676, 218, 451, 2262, 221, 618, 216, 230, 665, 697,
+	1064, 1924, 1531, 770, 767, 768, 769, 774, 776, 773,
+	1514, 772, 1559, 770, 767, 768, 769, 774, 776, 773,
+	1124, 772, 642, 615, 688, 91, 227, 1999, 1759, 116,
+	965, 762, 91, 252, 166, 1867, 1832, 101, 617, 669,
+	797, 1831, 170, 429, 1139, 1815, 1028, 1380, 1033, 2094,
+	1832, 1248, 606, 615, 465, 1831, 227, 80, 128, 624,
+	2220, 798, 799, 800, 801, 802, 803, 804, 806, 805,
+	807, 808, 796, 1780, 1201, 1998, 91, 95, 91, 1447,
+	1448, 2266, 1653, 244, 1061, 1120, 907, 1121, 1202, 1203,
+	1200, 1122, 1070, 779, 91, 643, 1072, 1073, 117, 244,
+	747, 2090, 89, 253, 1753, 1248, 1078, 1589, 1752, 1081,
+	1082, 170, 1084, 91, 1507, 1080, 102, 1079, 418, 1997,
+	1191, 1193, 1194, 1063, 653, 652, 1049, 1050, 1051, 2338,
+	1071, 1733, 2198, 1074, 244, 780, 170, 617, 780, 420,
+	1077, 2124, 1772, 1192, 1757, 1128, 1751, 2316, 244, 91,
+	170, 780, 780, 2051, 1776, 1565, 1566, 1567, 973, 780,
+	1058, 2354, 1663, 1199, 1662, 170, 2339, 617, 231, 1585,
+	1848, 2159, 170, 780, 1093, 1094, 1075, 1053, 780, 1098,
+	2135, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	244, 2315, 1069, 780, 2122, 642, 928, 244, 244, 1576,
+	225, 636, 639, 640, 438, 597, 1863, 1252, 1770, 633,
+	637, 362, 363, 364, 1744, 170, 892, 172, 173, 174,
+	928, 1745, 1068, 1525, 1136, 1143, 1105, 1099, 1135, 172,
+	173, 174, 1147, 1494, 1149, 1150, 1151, 1152, 1097, 1096,
+	780, 1156, 1095, 1727, 2014, 152, 780, 1076, 1459, 1141,
+	1142, 1460, 668, 1850, 1235, 780, 750, 154, 2196, 155,
+	244, 643, 1146, 938, 2142, 1227, 1227, 1970, 780, 1153,
+	1154, 1155, 1197, 1233, 244, 1469, 1131, 1952, 1224, 1228,
+	165, 2333, 928, 1986, 172, 173, 174, 1559, 1492, 1670,
+	244, 244, 1225, 1225, 1204, 1416, 1206, 1207, 1208, 1209,
+	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
+	1205, 780, 1674, 2297, 1238, 1195, 105, 1249, 172, 173,
+	174, 1674, 1490, 1860, 1859, 1858, 1852, 104, 1856, 103,
+	1851, 1239, 1849, 1674, 2278, 2342, 1291, 1854, 2343, 1923,
+	2341, 716, 643, 172, 173, 174, 1853, 1473, 777, 1322,
+	780, 1682, 718, 170, 2261, 1198, 780, 244, 244, 244,
+	1604, 642, 1923, 1855, 1857, 1925, 656, 636, 2274, 928,
+	2221, 658, 172, 173, 174, 659, 657, 91, 702, 152,
+	1348, 1348, 1694, 170, 2066, 1803, 244, 780, 1291, 731,
+	157, 154, 1560, 155, 127, 170, 1056, 621, 244, 620,
+	1043, 1354, 170, 1355, 170, 1324, 170, 170, 244, 619,
+	1060, 244, 1055, 90, 165, 2171, 1145, 1229, 1326, 1327,
+	1408, 2171, 244, 1322, 1511, 1240, 1241, 1381, 1417, 1802,
+	461, 1748, 1749, 461, 99, 1370, 1109, 1108, 1110, 1111,
+	1112, 85, 642, 898, 662, 1166, 955, 956, 1384, 1323,
+	1140, 38, 1170, 1171, 99, 1909, 1360, 90, 418, 90,
+	85, 798, 799, 800, 801, 802, 803, 804, 806, 805,
+	807, 808, 796, 1401, 933, 90, 96, 1325, 1574, 1324,
+	1328, 1329, 928, 780, 1472, 1469, 420, 97, 1609, 244,
+	1450, 1451, 1452, 780, 90, 1717, 780, 2229, 928, 1412,
+	1489, 1491, 1493, 102, 1395, 1584, 1342, 1411, 244, 2355,
+	1353, 1475, 1594, 244, 1070, 641, 780, 1070, 1359, 1070,
+	2253, 910, 1693, 1376, 157, 244, 1471, 244, 1465, 1362,
+	778, 1521, 779, 778, 1717, 779, 1593, 1415, 38, 2049,
+	928, 1869, 1374, 1581, 244, 244, 778, 778, 779, 779,
+	96, 1496, 1400, 1399, 778, 465, 779, 98, 465, 1414,
+	1369, 97, 104, 1413, 2066, 928, 932, 170, 778, 1332,
+	779, 1674, 2195, 778, 170, 779, 170, 170, 836, 1177,
+	170, 1125, 170, 957, 1421, 1498, 1624, 928, 778, 170,
+	779, 1578, 937, 81, 780, 626, 170, 1674, 2139, 777,
+	928, 1577, 1518, 803, 804, 806, 805, 807, 808, 796,
+	1694, 1466, 1462, 1463, 1506, 928, 780, 1509, 170, 1510,
+	602, 1520, 1519, 244, 1470, 1574, 628, 1609, 928, 625,
+	1485, 1486, 1487, 1488, 1484, 778, 2209, 779, 1523, 1524,
+	423, 778, 935, 779, 1466, 1501, 2026, 1508, 797, 1841,
+	778, 2211, 779, 1550, 1551, 780, 780, 1616, 1553, 780,
+	928, 797, 1572, 778, 1528, 779, 780, 1554, 2189, 798,
+	799, 800, 801, 802, 803, 804, 806, 805, 807, 808,
+	796, 1197, 798, 799, 800, 801, 802, 803, 804, 806,
+	805, 807, 808, 796, 689, 1977, 1976, 928, 1441, 1442,
+	1443, 1444, 1569, 1773, 1571, 2118, 778, 1543, 779, 1974,
+	1975, 1974, 1973, 1440, 1455, 1456, 1457, 1458, 1570, 799,
+	800, 801, 802, 803, 804, 806, 805, 807, 808, 796,
+	1089, 780, 2016, 2112, 156, 107, 2012, 158, 159, 780,
+	1969, 160, 161, 1797, 797, 778, 1454, 779, 162, 163,
+	164, 778, 1453, 779, 1035, 1036, 1557, 1694, 928, 1116,
+	628, 1928, 928, 1062, 1198, 798, 799, 800, 801, 802,
+	803, 804, 806, 805, 807, 808, 796, 1568, 109, 110,
+	1029, 113, 778, 170, 779, 121, 1574, 928, 167, 118,
+	170, 199, 2210, 105, 1559, 1811, 244, 2215, 1227, 1794,
+	1793, 1789, 1790, 1645, 104, 928, 103, 1674, 1673, 244,
+	780, 1657, 413, 414, 415, 98, 1031, 1030, 424, 1661,
+	1923, 1718, 1588, 1678, 1981, 1225, 971, 970, 1085, 2068,
+	780, 1720, 943, 244, 2102, 2103, 1345, 780, 234, 2064,
+	1694, 170, 2057, 1586, 780, 170, 2322, 453, 454, 801,
+	802, 803, 804, 806, 805, 807, 808, 796, 1602, 1324,
+	1718, 1688, 2056, 2320, 235, 1685, 1168, 2108, 156, 2284,
+	1559, 158, 159, 1680, 838, 160, 161, 1746, 778, 928,
+	779, 425, 162, 163, 164, 2271, 170, 2234, 778, 1710,
+	779, 778, 1422, 779, 1574, 1671, 780, 244, 2200, 2105,
+	780, 780, 901, 1323, 1646, 780, 1655, 426, 780, 780,
+	2025, 778, 780, 779, 1988, 1709, 1682, 1424, 1544, 1636,
+	1637, 2107, 882, 883, 884, 885, 886, 1672, 1938, 1941,
+	1937, 1675, 1419, 170, 1942, 1740, 170, 244, 244, 170,
+	1633, 1739, 1715, 1758, 1760, 427, 244, 1728, 1939, 1433,
+	1434, 2330, 2178, 1940, 2177, 1420, 2298, 1719, 1070, 1070,
+	1366, 1722, 1632, 936, 1358, 2212, 2213, 2214, 1775, 1787,
+	1766, 1480, 9, 1808, 1807, 38, 1730, 1736, 1729, 1929,
+	1913, 91, 1041, 170, 170, 170, 170, 170, 1426, 778,
+	1943, 779, 1703, 1704, 170, 170, 797, 607, 792, 123,
+	793, 1631, 1630, 1479, 8, 1629, 409, 1771, 1478, 7,
+	170, 778, 1628, 779, 794, 795, 791, 798, 799, 800,
+	801, 802, 803, 804, 806, 805, 807, 808, 796, 244,
+	81, 1964, 1806, 1781, 1777, 784, 785, 786, 787, 788,
+	789, 790, 782, 832, 1090, 1091, 1092, 238, 1053, 1732,
+	778, 778, 779, 779, 778, 1432, 779, 2136, 1798, 1799,
+	1861, 778, 610, 779, 236, 1805, 444, 1435, 960, 1227,
+	1866, 1227, 943, 106, 1227, 349, 419, 632, 1244, 1227,
+	1699, 1702, 1703, 1704, 1700, 1627, 1701, 1705, 612, 1844,
+	244, 1827, 1833, 1245, 651, 1834, 1225, 650, 1225, 2025,
+	1885, 1225, 1885, 1887, 1843, 1885, 1225, 2216, 2217, 2218,
+	1885, 240, 1904, 2030, 1890, 1897, 1898, 1899, 1900, 1891,
+	1845, 170, 930, 1862, 1835, 244, 778, 749, 779, 171,
+	244, 1227, 171, 942, 778, 171, 779, 928, 1086, 1087,
+	1088, 245, 1800, 171, 1918, 170, 170, 170, 170, 170,
+	1878, 171, 1904, 128, 1114, 171, 1626, 170, 1225, 916,
+	94, 1113, 170, 1915, 1914, 170, 171, 170, 690, 1920,
+	170, 170, 170, 1931, 171, 449, 1625, 1104, 1903, 1685,
+	1423, 1921, 456, 1619, 1951, 1035, 1036, 2359, 1905, 245,
+	1618, 1169, 245, 171, 1982, 1962, 1906, 1907, 1908, 1892,
+	1893, 1894, 1895, 1896, 1547, 778, 1916, 779, 2257, 1953,
+	915, 1710, 1954, 2205, 644, 647, 648, 1922, 1963, 1795,
+	761, 2026, 654, 1707, 1363, 778, 2083, 779, 1933, 1934,
+	1932, 1936, 778, 1935, 779, 1944, 1948, 1949, 1563, 778,
+	244, 779, 1617, 120, 1955, 244, 1614, 1613, 244, 99,
+	1135, 1612, 759, 1912, 1610, 1606, 686, 760, 1605, 442,
+	443, 1911, 693, 170, 1961, 2176, 1968, 1966, 1967, 103,
+	2241, 2004, 2182, 2240, 171, 96, 171, 170, 1991, 171,
+	170, 751, 98, 1971, 1972, 2183, 97, 1989, 696, 2002,
+	2031, 778, 1495, 779, 1979, 778, 778, 779, 779, 245,
+	778, 245, 779, 778, 778, 779, 779, 778, 441, 779,
+	104, 2089, 2001, 105, 105, 1740, 2005, 1990, 2007, 1427,
+	1469, 1739, 245, 245, 104, 104, 103, 2128, 2129, 2130,
+	2324, 1430, 2324, 2323, 2323, 834, 2021, 835, 1874, 1595,
+	926, 922, 1343, 951, 944, 2028, 2029, 2184, 1047, 111,
+	112, 2063, 105, 1743, 433, 2040, 923, 108, 2034, 40,
+	244, 170, 92, 104, 1, 103, 1747, 2310, 215, 1333,
+	1428, 899, 2285, 2033, 98, 1429, 2254, 2255, 1106, 244,
+	177, 244, 1100, 926, 922, 182, 175, 1699, 1702, 1703,
+	1704, 1700, 2145, 1701, 1705, 1292, 2206, 2102, 2103, 923,
+	1992, 1993, 2095, 170, 1995, 1502, 1688, 2097, 635, 1685,
+	1688, 134, 1409, 1410, 2084, 2085, 2293, 115, 590, 114,
+	244, 638, 2093, 715, 2109, 1497, 38, 2099, 1755, 2091,
+	1436, 979, 244, 2106, 170, 977, 978, 976, 982, 170,
+	244, 981, 980, 2120, 1596, 2114, 2069, 2113, 1172, 2115,
+	2116, 244, 241, 1706, 168, 966, 1677, 945, 176, 660,
+	206, 1978, 1526, 2133, 211, 705, 821, 244, 244, 244,
+	244, 1167, 1910, 1723, 462, 2121, 455, 2123, 1344, 1659,
+	1690, 2281, 179, 939, 2181, 2134, 171, 2141, 2088, 245,
+	245, 1587, 1246, 1389, 1190, 267, 264, 2138, 1665, 1378,
+	783, 352, 1649, 1650, 952, 1698, 171, 1696, 1695, 1545,
+	1396, 2104, 2151, 2100, 2150, 2305, 1391, 1387, 1669, 1227,
+	1668, 1227, 185, 245, 275, 268, 260, 171, 1735, 2152,
+	2153, 1738, 245, 1814, 2013, 186, 2154, 1816, 245, 1731,
+	2155, 2009, 2158, 763, 918, 254, 1225, 609, 1225, 1243,
+	1885, 2164, 1885, 2174, 2219, 1562, 2053, 917, 1255, 1256,
+	67, 2165, 43, 2037, 2038, 192, 2039, 1227, 1283, 2041,
+	248, 2043, 2185, 2045, 2326, 1688, 754, 32, 31, 30,
+	2191, 29, 194, 2187, 24, 23, 22, 2202, 21, 419,
+	20, 26, 19, 18, 1225, 244, 17, 2192, 2336, 2358,
+	122, 53, 50, 419, 233, 2199, 1011, 36, 1476, 605,
+	2193, 2203, 611, 119, 2127, 2197, 1236, 2208, 1984, 48,
+	2232, 599, 47, 2022, 2231, 131, 244, 129, 1227, 51,
+	244, 244, 46, 691, 1371, 44, 4, 244, 28, 27,
+	244, 2235, 16, 1356, 1357, 925, 15, 924, 14, 13,
+	12, 170, 244, 11, 10, 1225, 2242, 2243, 6, 5,
+	244, 170, 35, 2249, 2246, 34, 33, 171, 2245, 757,
+	1685, 25, 2, 0, 0, 2259, 2248, 0, 0, 0,
+	2260, 2252, 0, 2263, 0, 0, 919, 920, 925, 0,
+	924, 0, 0, 0, 0, 0, 172, 173, 174, 0,
+	0, 0, 0, 0, 38, 0, 0, 0, 245, 0,
+	0, 0, 244, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 244, 0, 0, 2292, 0,
+	170, 244, 2289, 0, 2208, 2294, 171, 0, 0, 0,
+	2301, 643, 0, 0, 0, 0, 2309, 2321, 2319, 2304,
+	2314, 2325, 0, 0, 0, 0, 38, 0, 0, 245,
+	2329, 171, 0, 0, 0, 0, 0, 2335, 0, 0,
+	0, 0, 0, 245, 0, 171, 0, 1276, 0, 0,
+	0, 0, 0, 0, 244, 2345, 2347, 0, 0, 2351,
+	171, 1227, 2349, 0, 0, 0, 0, 171, 0, 0,
+	0, 0, 0, 0, 2352, 2360, 171, 171, 171, 171,
+	171, 171, 171, 171, 171, 245, 2232, 1227, 1225, 2365,
+	2366, 2364, 245, 245, 0, 0, 0, 0, 0, 0,
+	2363, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 642, 0, 0, 1225, 0, 0, 0, 639, 640,
+	0, 597, 332, 0, 0, 633, 637, 1268, 1257, 1258,
+	1259, 1260, 1270, 1261, 1262, 1263, 1275, 1271, 1264, 1265,
+	1272, 1273, 1274, 1266, 1267, 1269, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 180, 0, 184, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 245, 0, 243, 0,
+	177, 0, 0, 0, 0, 182, 175, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 463, 0, 0, 594,
+	0, 601, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 0, 245, 245, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 179, 245, 0, 0, 0, 171, 0, 171,
+	0, 171, 171, 245, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 0, 0, 0, 0,
-	1697, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
+	780, 0, 0, 0, 0, 186, 463, 0, 463, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+	0, 947, 0, 0, 0, 0, 0, 0, 189, 753,
+	755, 0, 177, 0, 197, 192, 0, 182, 175, 0,
+	0, 0, 0, 0, 245, 178, 181, 0, 0, 0,
+	0, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 0, 245, 0, 0, 0, 188, 245, 0,
+	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 0, 0, 193, 0, 245,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 171,
+	0, 171, 171, 0, 179, 171, 0, 171, 0, 0,
+	893, 0, 0, 0, 171, 0, 0, 0, 0, 0,
+	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 173, 174, 0,
+	360, 361, 0, 171, 185, 0, 1226, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 904, 905, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	948, 0, 0, 0, 194, 0, 0, 0, 0, 463,
+	0, 0, 0, 0, 0, 967, 0, 0, 0, 0,
+	0, 367, 368, 369, 370, 371, 372, 373, 374, 375,
+	376, 377, 378, 379, 380, 381, 382, 383, 384, 385,
+	386, 387, 388, 389, 390, 391, 392, 393, 394, 395,
+	396, 397, 398, 399, 400, 401, 402, 403, 404, 405,
+	406, 407, 408, 0, 0, 166, 0, 0, 0, 0,
+	0, 0, 177, 0, 0, 0, 1788, 182, 175, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 171, 190, 0, 172, 173,
+	174, 245, 949, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 180, 0, 184, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 0, 778, 171, 779, 0, 0,
+	171, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 594, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 893, 0, 0,
+	893, 594, 0, 0, 0, 0, 0, 893, 0, 0,
+	0, 171, 0, 0, 185, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 1115, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1132, 0, 0, 0, 0, 0, 0, 192, 171, 0,
+	0, 171, 245, 245, 171, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 194, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 463, 0, 0, 0, 0, 0, 190, 463,
+	463, 0, 0, 0, 0, 0, 0, 0, 171, 171,
+	171, 171, 171, 0, 0, 0, 0, 0, 0, 171,
+	171, 0, 0, 180, 0, 184, 0, 0, 0, 183,
+	0, 0, 0, 0, 0, 171, 0, 166, 189, 0,
+	0, 0, 0, 0, 197, 0, 0, 0, 1052, 0,
+	0, 0, 0, 0, 245, 178, 181, 0, 0, 0,
+	0, 128, 1221, 148, 0, 0, 0, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 1234, 188, 0, 0,
+	0, 0, 191, 0, 893, 91, 0, 0, 172, 173,
+	174, 0, 1253, 1254, 0, 0, 145, 0, 0, 0,
+	0, 0, 147, 195, 196, 0, 0, 193, 0, 0,
+	152, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 154, 0, 155, 0, 0, 0, 0, 463,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 142, 143, 141, 140, 165, 171, 0, 0, 0,
+	245, 0, 0, 0, 0, 245, 0, 0, 0, 1346,
+	463, 463, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 171, 171, 171, 171, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 171, 1365, 0,
+	171, 0, 171, 0, 0, 171, 171, 171, 0, 0,
+	948, 0, 0, 463, 0, 0, 0, 0, 0, 0,
+	463, 183, 0, 463, 0, 0, 0, 2334, 0, 0,
+	189, 0, 0, 0, 594, 0, 197, 997, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 181, 0,
+	0, 0, 136, 144, 146, 0, 0, 135, 0, 137,
+	138, 0, 333, 187, 0, 157, 0, 0, 0, 188,
+	0, 0, 0, 0, 191, 245, 0, 0, 190, 0,
+	245, 0, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 601, 195, 196, 0, 171, 193,
+	0, 463, 0, 180, 0, 184, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 171, 169, 0, 0, 202,
+	594, 0, 232, 0, 0, 1504, 0, 0, 0, 0,
+	202, 0, 0, 0, 0, 0, 0, 594, 202, 1221,
+	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 432, 0, 0, 1221, 1221, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 0, 0,
+	448, 0, 448, 0, 0, 0, 0, 0, 145, 0,
+	202, 604, 0, 0, 147, 0, 0, 0, 0, 0,
+	0, 0, 152, 0, 0, 245, 171, 0, 0, 0,
+	0, 0, 0, 0, 154, 0, 155, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 245, 0, 0, 0,
+	0, 0, 0, 142, 143, 141, 140, 165, 0, 0,
+	0, 0, 0, 0, 0, 1556, 0, 0, 171, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 202, 0, 202, 0, 139, 202, 245, 0, 171,
+	0, 0, 0, 0, 171, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 927, 0, 245, 0, 0, 0,
+	0, 183, 1393, 0, 0, 1392, 0, 0, 149, 0,
+	189, 150, 245, 245, 245, 245, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 181, 0,
+	0, 82, 0, 0, 136, 144, 146, 0, 0, 135,
+	0, 137, 138, 187, 0, 0, 0, 157, 0, 188,
+	0, 0, 0, 0, 191, 0, 0, 0, 0, 177,
+	0, 0, 0, 0, 182, 175, 0, 1026, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 0, 0, 193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 463, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1365, 156,
+	0, 0, 158, 159, 0, 0, 160, 161, 0, 0,
+	894, 1666, 0, 162, 163, 164, 0, 176, 0, 166,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 985, 893, 0, 0, 1692, 0, 0, 0, 0,
+	0, 179, 0, 128, 0, 148, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 245, 245, 0, 0, 0,
+	0, 0, 245, 202, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 245, 0, 0,
+	0, 185, 0, 432, 0, 245, 171, 0, 0, 0,
+	0, 0, 0, 0, 186, 0, 0, 0, 0, 1761,
+	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 998, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 139, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 245, 1784,
+	1785, 194, 0, 0, 0, 0, 0, 0, 1365, 0,
+	245, 0, 0, 0, 0, 171, 245, 0, 0, 0,
+	149, 0, 0, 150, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1012, 1015, 1016, 1017,
+	1018, 1019, 1020, 0, 1021, 1022, 1023, 1024, 1025, 999,
+	1000, 1001, 1002, 983, 984, 1013, 0, 986, 0, 987,
+	988, 989, 990, 991, 992, 993, 994, 995, 0, 245,
+	996, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 0,
+	177, 0, 0, 0, 0, 182, 175, 0, 0, 0,
+	0, 1221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 202, 0, 0, 0, 0, 0,
+	0, 0, 91, 0, 0, 172, 173, 174, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 893, 0, 893,
+	0, 156, 893, 0, 158, 159, 0, 893, 160, 161,
+	0, 0, 0, 0, 0, 162, 163, 164, 0, 0,
+	0, 0, 463, 0, 0, 0, 0, 894, 176, 0,
+	894, 929, 931, 0, 0, 0, 0, 894, 0, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 179, 1014, 0, 0, 0, 1917, 0, 0,
+	0, 0, 463, 0, 0, 0, 0, 0, 202, 0,
+	0, 0, 0, 0, 0, 0, 893, 0, 0, 0,
+	145, 0, 1134, 0, 0, 0, 147, 0, 0, 0,
+	0, 0, 185, 177, 152, 0, 0, 202, 182, 175,
+	0, 0, 0, 0, 202, 186, 154, 0, 155, 0,
+	0, 0, 0, 1157, 1158, 202, 202, 202, 202, 202,
+	202, 202, 0, 0, 0, 142, 143, 141, 140, 165,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 202, 0, 0,
+	0, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 0, 0, 0, 0,
+	0, 176, 594, 0, 0, 893, 0, 1504, 0, 0,
+	1365, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 0, 184, 0, 0, 179, 0, 0, 0, 0,
+	448, 0, 0, 0, 0, 0, 0, 0, 448, 448,
+	0, 0, 0, 0, 894, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 136, 144, 146, 0,
+	0, 135, 0, 137, 138, 185, 0, 0, 0, 157,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 448, 1134,
+	448, 448, 448, 448, 448, 0, 172, 173, 174, 889,
+	892, 0, 0, 0, 0, 888, 891, 0, 192, 887,
+	0, 0, 0, 0, 0, 1340, 0, 0, 0, 0,
+	0, 0, 1917, 0, 0, 194, 0, 0, 0, 0,
+	0, 448, 0, 0, 0, 0, 893, 0, 0, 0,
+	0, 2096, 0, 2098, 0, 432, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 202, 0, 0,
+	0, 0, 0, 1134, 202, 0, 202, 0, 202, 1398,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 2125, 0, 0, 0, 0, 0,
+	0, 0, 1365, 0, 0, 0, 0, 0, 183, 1393,
+	0, 0, 1392, 2137, 0, 0, 0, 189, 0, 0,
+	0, 0, 0, 197, 0, 0, 0, 0, 0, 2146,
+	2147, 2148, 2149, 0, 178, 181, 902, 0, 0, 172,
+	173, 174, 0, 0, 604, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 0, 188, 0, 0, 0,
+	0, 191, 0, 0, 0, 0, 0, 0, 1250, 139,
+	0, 0, 1251, 0, 0, 0, 190, 893, 0, 893,
+	0, 0, 195, 196, 0, 0, 193, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 180, 149, 184, 247, 150, 0, 0, 0, 0,
+	0, 0, 412, 0, 0, 0, 416, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 202,
+	0, 929, 1331, 0, 0, 435, 202, 0, 202, 202,
+	0, 0, 202, 0, 1548, 0, 0, 0, 0, 0,
+	0, 202, 0, 0, 598, 0, 0, 463, 202, 0,
+	0, 0, 0, 0, 0, 1361, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 250, 0, 0, 0, 0,
+	202, 0, 0, 0, 0, 0, 0, 0, 2238, 0,
+	0, 0, 2238, 2238, 0, 0, 0, 893, 0, 2247,
+	0, 0, 1365, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 1365, 0, 158, 159, 0, 190,
+	160, 161, 1365, 0, 0, 0, 0, 162, 163, 164,
+	0, 0, 0, 0, 0, 692, 0, 698, 0, 0,
+	700, 0, 0, 0, 180, 0, 184, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 448, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1474, 0, 463, 463, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2302, 0, 183,
+	0, 0, 0, 2307, 0, 0, 0, 0, 189, 0,
+	0, 0, 0, 0, 197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 448, 448, 0, 0, 0, 188, 0, 0,
+	0, 0, 191, 1134, 0, 202, 2307, 0, 0, 0,
+	0, 0, 1340, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 0, 0, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 894, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 202, 0, 0, 0, 202, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2205, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 195, 195, 195, 195, 195, 0, 0, 0, 0,
-	0, 0, 195, 195, 0, 0, 0, 0, 0, 0,
-	0, 1780, 0, 0, 0, 0, 0, 0, 195, 0,
+	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 0, 0, 0, 0, 197, 202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 181,
+	0, 0, 0, 0, 1575, 0, 0, 0, 1579, 0,
+	1580, 0, 0, 0, 187, 1582, 1583, 0, 0, 0,
+	188, 0, 0, 0, 1591, 191, 0, 1592, 954, 0,
+	0, 0, 0, 0, 0, 202, 0, 0, 202, 0,
+	0, 1786, 0, 0, 0, 0, 195, 196, 0, 0,
+	193, 249, 0, 1597, 1598, 1599, 1600, 1601, 0, 1603,
+	0, 0, 0, 0, 0, 1607, 0, 1608, 0, 0,
+	0, 1611, 0, 0, 0, 0, 0, 0, 0, 1620,
+	1621, 1622, 1623, 0, 0, 202, 202, 202, 202, 202,
+	0, 0, 1634, 1635, 0, 0, 202, 202, 0, 0,
+	1640, 1641, 1642, 1643, 1644, 82, 0, 0, 0, 0,
+	0, 0, 202, 0, 0, 0, 0, 1656, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 448, 1664, 0,
+	0, 0, 0, 177, 0, 0, 0, 0, 182, 175,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1691, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 894, 972, 894,
+	0, 0, 894, 0, 0, 0, 0, 894, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 448, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 179, 0, 1083, 0, 0,
+	0, 0, 0, 0, 0, 0, 894, 202, 202, 202,
+	202, 202, 0, 0, 0, 0, 0, 0, 0, 1945,
+	0, 0, 1117, 0, 202, 0, 0, 1340, 0, 202,
+	0, 0, 202, 1956, 1134, 185, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
+	0, 1144, 0, 0, 0, 0, 0, 0, 1148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1159,
+	1160, 1161, 1162, 1163, 1164, 1165, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 0, 0, 0, 0,
+	0, 1180, 0, 0, 0, 894, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 202, 0, 0, 0, 0,
+	0, 1873, 0, 0, 0, 0, 0, 0, 0, 202,
+	0, 0, 202, 0, 0, 0, 0, 0, 0, 0,
+	1886, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1901, 1902, 0, 1339, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
+	182, 175, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 447, 0, 91, 0, 928, 172,
+	173, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 202, 1926, 1927, 0, 0, 0, 0,
+	359, 83, 0, 0, 0, 0, 894, 0, 0, 0,
+	0, 0, 0, 1946, 1947, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 202, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 258, 0, 0, 0,
+	0, 1373, 0, 0, 0, 0, 0, 179, 1377, 0,
+	1383, 0, 0, 1180, 0, 0, 202, 0, 0, 0,
+	0, 202, 0, 0, 0, 0, 0, 0, 440, 0,
+	0, 0, 422, 0, 83, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
+	0, 2003, 422, 0, 0, 0, 0, 0, 0, 0,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 603,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 0, 0, 2032, 0, 894, 0, 894,
+	0, 0, 2036, 0, 0, 0, 0, 194, 0, 190,
+	0, 0, 0, 0, 0, 0, 2046, 2047, 2048, 2050,
+	2052, 0, 0, 0, 0, 0, 0, 2058, 0, 0,
+	2060, 2061, 2062, 0, 180, 0, 184, 2065, 0, 0,
+	0, 0, 0, 2067, 0, 0, 2071, 2072, 2073, 2074,
+	2075, 2076, 2077, 2078, 2079, 2080, 0, 0, 2081, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1180, 743, 0, 743, 0, 0, 0,
+	1537, 0, 1541, 1542, 781, 0, 1546, 0, 0, 0,
+	0, 0, 0, 0, 0, 1549, 0, 0, 0, 83,
+	0, 0, 1552, 2110, 2111, 0, 0, 0, 0, 0,
+	0, 0, 0, 830, 0, 0, 0, 894, 0, 0,
+	2119, 172, 173, 174, 1555, 1341, 0, 422, 817, 818,
+	819, 0, 822, 202, 824, 825, 826, 827, 828, 0,
+	831, 833, 833, 202, 833, 837, 837, 839, 840, 841,
+	842, 843, 844, 845, 846, 847, 848, 849, 850, 851,
+	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
+	862, 863, 864, 865, 866, 867, 868, 869, 870, 871,
+	872, 873, 874, 875, 258, 877, 878, 879, 880, 881,
+	0, 0, 0, 0, 837, 837, 837, 837, 837, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, 0,
+	0, 0, 1340, 0, 2162, 0, 0, 0, 2163, 0,
+	0, 0, 183, 0, 2167, 0, 0, 0, 0, 0,
+	177, 189, 0, 0, 0, 182, 175, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 181,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 903, 187, 0, 941, 908, 0, 422,
+	188, 0, 0, 422, 0, 191, 0, 0, 0, 422,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2204, 195, 196, 0, 0,
+	193, 190, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 2222, 2223, 2224, 0, 2225, 2226, 0, 0, 2227,
+	0, 2228, 0, 2230, 2233, 0, 180, 0, 184, 0,
+	2237, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 0, 0, 0, 0, 182, 175, 0, 0,
+	0, 1714, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 0, 2265, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 186, 2270, 0, 0, 0,
+	0, 2275, 1754, 0, 0, 0, 0, 2276, 2277, 0,
+	0, 0, 0, 0, 0, 2279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 1037, 177,
+	0, 1040, 194, 0, 182, 175, 0, 0, 0, 1779,
+	0, 0, 1782, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 875, 0, 0, 0, 2331, 0, 0, 0,
+	2332, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 185, 0, 0, 0, 0, 0, 1819,
+	1820, 1821, 1822, 1823, 0, 0, 186, 0, 0, 0,
+	1180, 1828, 0, 0, 0, 0, 0, 176, 0, 0,
+	0, 0, 0, 0, 183, 0, 1836, 0, 0, 0,
+	0, 0, 0, 189, 0, 0, 192, 0, 1137, 197,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 181, 0, 194, 0, 0, 172, 173, 174, 0,
+	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
+	0, 0, 188, 0, 0, 0, 0, 191, 0, 0,
+	0, 185, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 186, 0, 0, 0, 195, 196,
+	743, 0, 193, 0, 0, 0, 0, 743, 743, 0,
+	0, 0, 1186, 1187, 1188, 0, 0, 0, 0, 0,
+	0, 0, 591, 0, 192, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 194, 0, 0, 0, 969, 0, 0, 0, 0,
+	177, 0, 0, 1231, 1232, 182, 175, 0, 0, 0,
+	0, 0, 1242, 0, 0, 0, 928, 172, 173, 174,
+	0, 0, 0, 0, 0, 0, 0, 1277, 1278, 1279,
+	1280, 1281, 1282, 1284, 1288, 1289, 258, 1295, 1296, 1297,
+	1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
+	1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317,
+	0, 597, 0, 0, 0, 595, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 172, 173, 174, 0, 1222,
+	0, 180, 0, 184, 0, 0, 0, 0, 0, 0,
+	0, 0, 1367, 1368, 0, 0, 0, 0, 177, 2008,
+	0, 0, 0, 182, 175, 0, 0, 0, 0, 0,
+	0, 0, 185, 2020, 0, 0, 2027, 0, 0, 0,
+	1407, 0, 0, 0, 0, 186, 0, 0, 0, 0,
+	0, 0, 0, 1382, 0, 0, 1390, 2239, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 1223,
+	0, 0, 0, 0, 0, 0, 0, 190, 0, 0,
+	0, 0, 194, 0, 0, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 1467, 0, 0, 0, 0, 0,
+	177, 0, 180, 0, 184, 182, 175, 2087, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 603, 0, 0, 0, 0, 0, 0, 0,
+	83, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 0, 0, 1505, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 0, 190, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 176, 183,
+	2126, 0, 0, 0, 0, 2132, 0, 0, 189, 0,
+	180, 0, 184, 192, 197, 0, 172, 173, 174, 0,
+	968, 0, 179, 0, 0, 178, 181, 0, 0, 0,
+	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 0, 188, 0, 0,
+	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 177, 0, 0, 0, 0, 182, 175,
+	0, 0, 0, 195, 196, 186, 0, 193, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
+	1564, 0, 182, 175, 0, 192, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 0, 194, 0, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 1590, 0, 0, 178, 181, 0, 0,
+	0, 176, 0, 0, 172, 173, 174, 0, 1762, 0,
+	0, 0, 187, 0, 0, 0, 0, 0, 188, 0,
+	0, 0, 0, 191, 0, 179, 0, 0, 830, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 0, 0, 193, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 183, 179,
+	0, 0, 0, 0, 0, 185, 0, 189, 0, 0,
+	0, 0, 0, 197, 0, 0, 0, 2258, 186, 0,
+	0, 180, 0, 184, 178, 181, 0, 2264, 1763, 0,
+	0, 0, 0, 1638, 0, 0, 172, 173, 174, 185,
+	187, 0, 0, 0, 0, 0, 188, 0, 192, 0,
+	0, 191, 186, 0, 941, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 0, 0, 0, 0,
+	0, 0, 195, 196, 422, 0, 193, 0, 0, 0,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
+	0, 1689, 0, 83, 0, 0, 0, 0, 0, 194,
+	0, 1711, 0, 1712, 1713, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1793, 0, 0, 0, 432, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 955, 0, 0, 0, 0, 0,
-	0, 1808, 1809, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 0, 0, 0,
+	0, 0, 1734, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
+	0, 184, 0, 0, 1774, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 174, 0, 0, 0, 0, 1638, 83, 0, 0,
+	1796, 300, 302, 301, 311, 312, 313, 314, 315, 316,
+	317, 298, 0, 0, 0, 0, 0, 0, 0, 183,
+	91, 0, 0, 172, 173, 174, 190, 0, 189, 0,
+	0, 0, 0, 0, 197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 181, 0, 0, 0,
+	0, 180, 0, 184, 0, 0, 0, 0, 0, 1840,
+	0, 187, 0, 0, 0, 0, 0, 188, 0, 0,
+	0, 0, 191, 0, 0, 0, 0, 0, 0, 1864,
+	1865, 0, 0, 0, 1868, 0, 0, 0, 1870, 1871,
+	1872, 0, 0, 195, 196, 0, 0, 193, 0, 1875,
+	1876, 1877, 0, 0, 1295, 1879, 0, 1880, 1881, 0,
+	0, 0, 1888, 1889, 0, 0, 0, 0, 0, 0,
+	1295, 1295, 1295, 1295, 1295, 258, 258, 258, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2303,
+	0, 0, 0, 0, 0, 0, 0, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
+	0, 0, 197, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 0, 178, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 258, 0, 0, 0, 0, 187,
+	0, 1390, 0, 0, 180, 188, 184, 0, 0, 0,
+	191, 0, 0, 190, 0, 0, 0, 0, 1950, 0,
+	0, 0, 0, 0, 0, 1390, 1390, 1390, 1390, 1390,
+	0, 195, 196, 0, 0, 193, 0, 0, 180, 1965,
+	184, 0, 1711, 422, 0, 0, 0, 1390, 0, 183,
+	1390, 0, 0, 0, 0, 0, 306, 307, 189, 0,
+	0, 0, 0, 0, 197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2308, 0,
+	0, 187, 0, 0, 0, 0, 0, 188, 0, 0,
+	0, 0, 191, 0, 0, 0, 0, 0, 0, 343,
+	0, 293, 347, 295, 344, 345, 0, 291, 294, 346,
+	0, 0, 0, 195, 196, 1505, 0, 193, 0, 0,
+	0, 0, 2006, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 296, 297, 299, 303,
+	304, 305, 308, 309, 310, 318, 320, 321, 319, 322,
+	323, 324, 327, 328, 329, 330, 325, 326, 331, 0,
+	0, 0, 0, 0, 2055, 0, 0, 2035, 0, 2059,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 869, 0, 869, 0, 0, 869, 0, 0,
-	0, 0, 869, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 432, 0, 0, 0,
-	0, 0, 1834, 1835, 0, 0, 0, 0, 0, 431,
+	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 0, 0, 0, 0, 197, 0, 0,
+	0, 0, 0, 0, 0, 177, 0, 0, 178, 181,
+	182, 175, 0, 0, 0, 0, 183, 0, 0, 2092,
+	0, 0, 0, 0, 187, 189, 0, 0, 0, 0,
+	188, 197, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 0, 178, 181, 0, 0, 1689, 0, 83, 0,
+	1689, 0, 0, 0, 0, 0, 195, 196, 187, 0,
+	193, 0, 0, 0, 188, 0, 0, 0, 0, 191,
+	0, 0, 0, 1390, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 0, 0, 0, 0, 0,
+	195, 196, 0, 0, 193, 0, 0, 0, 0, 0,
+	0, 2140, 0, 0, 0, 578, 570, 179, 0, 530,
+	580, 501, 517, 587, 520, 521, 551, 482, 540, 548,
+	515, 471, 504, 475, 512, 476, 502, 533, 0, 498,
+	571, 0, 0, 0, 177, 0, 0, 0, 0, 182,
+	175, 0, 0, 0, 2156, 0, 2157, 185, 499, 0,
+	0, 2160, 2161, 528, 532, 535, 545, 0, 0, 0,
+	186, 0, 0, 2166, 0, 0, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 477, 0,
+	0, 479, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 0, 0, 490, 0, 2188, 0, 0,
+	2190, 0, 0, 2175, 0, 2179, 2180, 194, 0, 0,
+	0, 0, 176, 2194, 0, 1689, 0, 0, 0, 500,
+	0, 0, 0, 0, 503, 0, 0, 0, 506, 0,
+	0, 0, 0, 0, 0, 0, 179, 177, 0, 0,
+	0, 0, 182, 175, 0, 524, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 2244, 258, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 553, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 0, 192,
+	0, 172, 173, 174, 2269, 561, 83, 0, 0, 0,
+	0, 0, 0, 0, 0, 568, 194, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 573,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2280, 582, 0, 0, 0, 0, 83, 185,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1854, 1855, 0, 0, 195, 0, 968, 0, 0,
+	0, 0, 186, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 869,
-	195, 195, 195, 195, 195, 0, 0, 0, 0, 0,
-	0, 0, 1853, 0, 0, 0, 0, 195, 0, 0,
-	1297, 0, 195, 0, 0, 195, 1864, 1096, 244, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 981,
-	984, 985, 986, 987, 988, 989, 0, 990, 991, 992,
-	993, 994, 969, 970, 971, 972, 953, 954, 982, 424,
-	956, 0, 957, 958, 959, 960, 961, 962, 963, 964,
-	965, 0, 195, 966, 973, 974, 975, 976, 977, 978,
-	979, 980, 869, 0, 1941, 0, 0, 0, 0, 0,
-	0, 1945, 0, 0, 0, 0, 0, 0, 0, 195,
-	0, 0, 0, 0, 0, 1955, 1956, 1958, 1960, 0,
-	0, 0, 0, 195, 0, 1966, 195, 0, 1968, 1969,
-	1970, 0, 0, 0, 0, 1973, 0, 0, 0, 0,
-	0, 1975, 0, 0, 1979, 1980, 1981, 1982, 1983, 1984,
-	1985, 1986, 1987, 1988, 0, 0, 1989, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 583, 0,
+	0, 0, 192, 0, 0, 0, 0, 536, 0, 538,
+	566, 529, 552, 493, 542, 0, 0, 549, 581, 194,
+	0, 0, 0, 0, 0, 511, 0, 0, 0, 0,
+	172, 173, 174, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2239, 0, 0, 0, 0, 0, 546, 0, 0,
+	0, 0, 0, 0, 0, 0, 585, 0, 0, 0,
+	0, 0, 0, 226, 579, 537, 0, 550, 0, 474,
+	544, 190, 481, 484, 586, 575, 507, 508, 0, 0,
+	0, 0, 0, 0, 0, 534, 539, 560, 525, 0,
+	0, 0, 0, 0, 0, 0, 180, 0, 184, 0,
+	0, 0, 0, 487, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 174, 0, 1783, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 983, 0, 0, 0,
-	0, 0, 2018, 2019, 0, 877, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 757, 0, 0, 0, 0,
-	869, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 805, 0, 0, 0, 0, 0, 195,
+	0, 492, 472, 505, 563, 473, 0, 469, 0, 0,
+	0, 0, 0, 0, 572, 526, 584, 576, 523, 522,
+	555, 488, 0, 0, 0, 486, 0, 468, 483, 491,
+	516, 543, 559, 565, 0, 0, 513, 0, 509, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 195, 0, 397, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 195, 0, 0, 195, 195, 195, 0, 344, 79,
-	419, 0, 0, 0, 756, 0, 0, 0, 0, 0,
-	0, 0, 0, 2081, 244, 0, 0, 2082, 0, 582,
-	0, 0, 0, 2086, 0, 920, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 0, 0, 0,
-	0, 175, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 869, 0, 869, 0, 170, 0, 0, 0, 0,
-	175, 168, 0, 0, 0, 0, 406, 0, 79, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 914, 406, 669, 0, 675,
-	0, 0, 677, 0, 0, 0, 0, 2119, 0, 0,
-	0, 0, 0, 586, 169, 0, 0, 0, 0, 0,
-	0, 2129, 2130, 2131, 0, 2132, 2133, 0, 0, 2134,
-	0, 2135, 0, 2137, 2140, 0, 0, 0, 172, 0,
-	2144, 0, 0, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2160,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 2169, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 2174, 0, 0, 0, 0, 2179,
-	0, 179, 869, 0, 0, 2180, 2181, 0, 0, 0,
-	0, 0, 0, 2183, 0, 0, 0, 178, 0, 0,
-	0, 0, 0, 0, 195, 720, 0, 720, 0, 0,
-	179, 185, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 79, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 0, 0, 2226, 0, 170, 0, 2227,
-	185, 0, 175, 168, 0, 0, 1002, 0, 0, 0,
-	0, 406, 793, 794, 795, 0, 798, 187, 800, 801,
-	802, 803, 1297, 806, 808, 808, 0, 808, 812, 812,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 823,
-	824, 825, 826, 827, 828, 829, 830, 831, 832, 833,
-	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
-	844, 845, 846, 847, 848, 849, 850, 0, 852, 853,
-	854, 855, 856, 0, 0, 169, 0, 812, 812, 812,
-	812, 812, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 927, 0, 0, 0, 0, 0, 0, 0, 172,
-	1099, 0, 165, 166, 167, 0, 922, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 166, 167, 864, 867, 0, 0, 0, 178,
-	863, 866, 0, 0, 862, 0, 878, 0, 754, 0,
-	755, 406, 179, 0, 0, 406, 0, 0, 0, 0,
-	0, 406, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1148, 1149, 1150, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	190, 0, 0, 0, 0, 577, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1193, 1194, 0, 0, 0, 0,
-	0, 0, 1199, 0, 0, 0, 0, 0, 0, 0,
-	0, 945, 0, 0, 0, 0, 0, 1234, 1235, 1236,
-	1237, 1238, 1239, 1241, 1245, 1246, 244, 1252, 1253, 1254,
-	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
-	1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 78, 0, 0, 1045, 0, 0, 0, 244, 0,
-	0, 0, 0, 0, 0, 173, 0, 177, 0, 183,
-	0, 0, 0, 165, 166, 167, 0, 0, 1079, 170,
-	0, 0, 0, 0, 175, 168, 0, 0, 0, 0,
-	1317, 1318, 0, 0, 173, 0, 177, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1106, 0, 0,
-	0, 0, 0, 0, 1110, 0, 0, 621, 1359, 0,
-	0, 0, 0, 0, 0, 1121, 1122, 1123, 1124, 1125,
-	1126, 1127, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1142, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1399, 0, 0,
-	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 620, 0, 0, 0,
-	0, 178, 0, 617, 618, 720, 581, 0, 0, 0,
-	611, 615, 720, 720, 179, 0, 170, 0, 0, 0,
-	0, 175, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 182, 0, 185, 0, 0, 0, 190, 0,
-	0, 0, 0, 0, 0, 0, 173, 0, 177, 171,
-	174, 187, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 0, 0, 0, 180, 0, 190, 170, 0,
-	0, 181, 0, 175, 168, 0, 184, 0, 171, 174,
-	0, 0, 0, 0, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 0, 0, 188, 189, 0,
-	181, 186, 0, 1325, 0, 184, 0, 0, 172, 0,
-	1329, 0, 1335, 0, 0, 1142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 189, 0, 0,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 178, 0,
-	0, 0, 0, 0, 0, 1519, 0, 0, 0, 0,
-	0, 179, 87, 0, 901, 165, 166, 167, 0, 0,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	805, 185, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1334, 0, 0, 1342, 187, 0,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 176, 170, 0, 0, 0, 0,
-	175, 168, 0, 182, 0, 0, 0, 0, 0, 190,
-	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
-	171, 174, 0, 0, 1142, 0, 0, 0, 0, 914,
-	187, 1467, 0, 1471, 1472, 0, 180, 1476, 586, 0,
-	0, 0, 181, 0, 0, 0, 1479, 184, 0, 0,
-	0, 0, 0, 1482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 586, 0, 0, 0, 0, 188, 189,
-	0, 0, 186, 169, 0, 1485, 0, 0, 0, 0,
-	0, 0, 165, 166, 167, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 0, 183, 0, 0, 0, 0, 170, 0,
-	0, 0, 0, 175, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 173, 0,
-	177, 0, 0, 0, 0, 0, 0, 178, 0, 0,
-	0, 87, 0, 0, 165, 166, 167, 0, 0, 0,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 574, 0, 0, 0, 180, 470, 184, 514, 519,
+	527, 554, 557, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 0, 745, 489, 567, 569, 562, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
+	0, 0, 0, 0, 0, 0, 82, 41, 42, 84,
+	0, 0, 0, 0, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 0, 478, 88, 0, 0, 197,
+	45, 73, 74, 190, 71, 75, 0, 0, 518, 0,
+	178, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 466, 460, 459, 0, 187, 0, 180, 0,
+	184, 0, 188, 0, 496, 497, 494, 191, 495, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 0, 0, 0, 169, 0, 0, 0,
-	1494, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 1748, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 237, 236, 0, 0, 0,
-	0, 0, 0, 1771, 1772, 0, 0, 0, 1775, 0,
-	0, 0, 1777, 1778, 1779, 0, 0, 0, 0, 0,
-	0, 0, 0, 1782, 1783, 1784, 0, 0, 1252, 1786,
-	178, 1787, 1788, 0, 0, 0, 1795, 1796, 0, 0,
-	183, 0, 0, 179, 1252, 1252, 1252, 1252, 1252, 244,
-	244, 244, 244, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 173, 0, 177, 0, 0,
-	0, 1296, 1641, 185, 0, 0, 0, 0, 0, 0,
-	0, 86, 170, 0, 0, 0, 0, 175, 168, 0,
-	187, 165, 166, 167, 0, 0, 176, 1828, 1567, 0,
-	0, 0, 183, 0, 0, 182, 244, 0, 0, 0,
-	0, 190, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 171, 174, 0, 0, 0, 173, 0, 177,
-	1858, 0, 406, 0, 0, 0, 0, 0, 180, 0,
-	1691, 0, 0, 0, 181, 0, 0, 0, 0, 184,
-	0, 1616, 0, 79, 0, 0, 575, 0, 0, 0,
-	169, 1638, 0, 1639, 1640, 0, 0, 0, 0, 0,
-	188, 189, 0, 0, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 1727, 1728,
-	1729, 1730, 1731, 0, 0, 0, 0, 0, 0, 1142,
-	1736, 0, 0, 901, 165, 166, 167, 0, 0, 0,
-	0, 0, 0, 0, 0, 1744, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 179, 0, 0,
-	0, 0, 0, 0, 581, 0, 0, 0, 579, 0,
-	0, 0, 0, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 182, 0, 0, 0, 1963, 185, 190, 183,
-	0, 1967, 0, 0, 0, 0, 0, 0, 0, 171,
-	174, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 173, 180, 177, 0, 0, 0,
-	0, 181, 0, 0, 0, 0, 184, 0, 0, 0,
-	0, 0, 0, 0, 0, 176, 1345, 0, 0, 1344,
-	0, 0, 2000, 0, 182, 0, 0, 188, 189, 0,
-	190, 186, 235, 0, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2146, 0, 0, 0, 0, 180, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 0, 0, 0, 188,
-	189, 0, 0, 186, 0, 0, 0, 0, 165, 166,
-	167, 0, 1298, 0, 2061, 0, 0, 173, 0, 177,
-	0, 0, 0, 0, 0, 1342, 0, 0, 0, 1904,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1342, 1342, 1342, 1342, 1342, 0, 1921, 2075, 0, 2076,
-	0, 0, 0, 0, 2079, 2080, 0, 1638, 406, 0,
-	1933, 0, 1342, 1936, 0, 1342, 2085, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	0, 0, 193, 0, 564, 0, 485, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 182, 0, 0, 0, 0, 0, 190, 0, 0,
-	2107, 0, 0, 2109, 0, 0, 0, 0, 171, 174,
-	0, 0, 0, 0, 0, 0, 0, 2113, 0, 0,
-	0, 0, 0, 0, 180, 0, 0, 0, 1914, 0,
-	181, 0, 0, 2116, 0, 184, 0, 0, 0, 0,
-	0, 0, 0, 1995, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 189, 0, 0,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1944, 0, 0, 183, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2151, 244, 0, 0, 176, 0, 0, 0, 0,
-	0, 173, 0, 177, 182, 0, 0, 0, 0, 0,
-	190, 0, 0, 2042, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 2173, 0, 2055, 0,
-	0, 2056, 2057, 2058, 0, 0, 0, 180, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 1616, 0, 79, 0, 1616, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 188,
-	189, 0, 0, 186, 0, 0, 0, 0, 0, 1342,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2027, 0, 562, 554, 0, 0, 514, 564,
-	485, 501, 571, 504, 505, 535, 466, 524, 532, 499,
-	455, 488, 459, 496, 460, 486, 517, 0, 482, 555,
-	0, 0, 0, 170, 0, 0, 0, 0, 175, 168,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
-	0, 0, 512, 516, 519, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 451, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 461, 0, 0,
-	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 474, 0, 0, 0, 0, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
-	0, 169, 0, 0, 190, 0, 0, 0, 484, 2094,
-	0, 2098, 2099, 487, 0, 171, 174, 490, 0, 0,
-	0, 1616, 0, 0, 0, 172, 0, 0, 0, 0,
-	0, 180, 0, 0, 508, 0, 0, 181, 0, 0,
-	0, 0, 184, 0, 0, 0, 0, 515, 0, 0,
-	0, 2168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 188, 189, 178, 0, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 179, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 537, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
-	0, 0, 0, 0, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 552, 187, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
-	0, 0, 0, 0, 0, 0, 0, 0, 557, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
-	0, 0, 566, 175, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2184, 0, 0,
-	0, 0, 79, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 567, 0, 0,
-	0, 0, 0, 0, 0, 0, 520, 0, 522, 550,
-	513, 536, 477, 526, 0, 0, 533, 565, 0, 0,
-	0, 0, 0, 0, 495, 0, 169, 0, 0, 165,
-	166, 167, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 530, 0, 0, 0, 0,
-	0, 0, 0, 0, 569, 0, 0, 0, 0, 0,
-	0, 219, 563, 521, 0, 534, 0, 458, 528, 0,
-	465, 468, 570, 559, 491, 492, 0, 0, 0, 0,
-	178, 0, 0, 518, 523, 544, 509, 0, 0, 0,
-	0, 0, 0, 179, 0, 0, 0, 0, 0, 0,
-	471, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 541, 0, 0, 0, 588, 556,
+	0, 589, 0, 0, 0, 0, 0, 0, 0, 0,
+	510, 0, 0, 183, 0, 0, 0, 0, 0, 0,
+	0, 547, 189, 0, 0, 558, 0, 0, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	181, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 0, 0, 0, 0,
+	0, 188, 0, 0, 0, 0, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 578,
+	570, 193, 0, 530, 580, 501, 517, 587, 520, 521,
+	551, 482, 540, 548, 515, 471, 504, 475, 512, 476,
+	502, 533, 0, 498, 571, 0, 183, 0, 177, 0,
+	0, 0, 0, 182, 175, 189, 0, 0, 0, 0,
+	0, 197, 499, 0, 0, 0, 0, 528, 532, 535,
+	545, 0, 178, 181, 0, 0, 0, 0, 0, 0,
+	0, 467, 0, 0, 0, 0, 0, 0, 187, 0,
+	0, 0, 477, 0, 188, 479, 0, 0, 0, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 0, 0, 193, 0, 176, 0, 0, 0,
+	0, 0, 0, 500, 0, 59, 0, 0, 503, 0,
+	0, 0, 506, 0, 0, 0, 0, 91, 0, 0,
+	179, 177, 0, 0, 0, 0, 182, 175, 0, 524,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 186, 0, 0, 0, 63, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 553,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 561,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 568,
+	194, 0, 0, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 573, 0, 0, 0, 0, 0, 0,
+	0, 49, 52, 55, 54, 57, 0, 582, 70, 0,
+	0, 78, 0, 185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 0, 58, 87, 86, 0, 0, 68,
+	69, 56, 0, 0, 0, 0, 0, 76, 77, 0,
+	0, 0, 583, 0, 0, 0, 192, 0, 0, 0,
+	0, 536, 0, 538, 566, 529, 552, 493, 542, 0,
+	0, 549, 581, 194, 0, 0, 0, 0, 0, 511,
+	0, 79, 0, 0, 172, 173, 174, 0, 0, 0,
+	0, 60, 61, 0, 62, 64, 65, 66, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 546, 0, 0, 0, 0, 0, 0, 0, 0,
+	585, 0, 0, 0, 0, 0, 0, 226, 579, 537,
+	0, 550, 0, 474, 544, 0, 481, 484, 586, 575,
+	507, 508, 0, 0, 0, 0, 0, 0, 0, 534,
+	539, 560, 525, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 487, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 173, 174,
+	0, 0, 0, 0, 0, 0, 0, 1667, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 492, 472, 505, 563, 473,
+	0, 469, 0, 0, 0, 0, 0, 0, 572, 526,
+	584, 576, 523, 522, 555, 488, 0, 0, 0, 486,
+	0, 468, 483, 491, 516, 543, 559, 565, 90, 0,
+	513, 0, 509, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 0, 0, 577,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 574, 0, 0, 0, 180,
+	470, 184, 514, 519, 527, 554, 557, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 0, 958, 489, 567,
+	569, 562, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 227, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 478,
+	0, 0, 0, 0, 0, 0, 0, 190, 0, 0,
+	0, 0, 518, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 466, 460, 459, 0,
+	0, 0, 180, 0, 184, 0, 0, 0, 496, 497,
+	494, 0, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 564, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 541, 0,
+	0, 0, 588, 556, 0, 589, 0, 0, 0, 0,
+	0, 0, 0, 0, 510, 0, 0, 183, 0, 0,
+	0, 0, 0, 0, 0, 547, 189, 0, 0, 558,
+	0, 0, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 0, 188, 0, 0, 0, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 578, 570, 193, 0, 530, 580, 501,
+	517, 587, 520, 521, 551, 482, 540, 548, 515, 471,
+	504, 475, 512, 476, 502, 533, 0, 498, 571, 0,
+	183, 0, 177, 0, 0, 0, 0, 182, 175, 189,
+	0, 0, 0, 0, 0, 197, 499, 0, 0, 0,
+	0, 528, 532, 535, 545, 0, 178, 181, 0, 0,
+	0, 0, 0, 0, 0, 467, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 0, 477, 0, 188, 479,
+	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 0, 0, 193, 0,
+	176, 0, 0, 0, 0, 0, 0, 500, 0, 0,
+	0, 0, 503, 0, 0, 0, 506, 0, 0, 0,
+	0, 0, 0, 0, 179, 177, 0, 0, 0, 0,
+	182, 175, 0, 524, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 186, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 0, 476, 456,
-	489, 547, 457, 0, 453, 0, 0, 0, 0, 0,
-	0, 556, 510, 568, 560, 507, 506, 539, 472, 0,
-	0, 0, 470, 0, 452, 467, 475, 500, 527, 543,
-	549, 0, 0, 497, 0, 493, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 0, 0,
-	967, 0, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 0,
-	0, 0, 173, 454, 177, 498, 503, 511, 538, 541,
-	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
-	722, 473, 551, 553, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 165, 166, 167, 0, 1184, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 450,
-	444, 443, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 480, 481, 478, 0, 479, 1185, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 548, 0, 469, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 525, 0, 0, 0, 572, 540, 0, 573, 0,
-	0, 0, 0, 0, 0, 0, 0, 494, 0, 0,
-	176, 0, 0, 0, 0, 0, 0, 0, 531, 182,
-	0, 0, 542, 0, 0, 190, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 171, 174, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 0, 0, 0, 173, 181, 177,
-	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 188, 189, 562, 554, 186, 0,
-	514, 564, 485, 501, 571, 504, 505, 535, 466, 524,
-	532, 499, 455, 488, 459, 496, 460, 486, 517, 0,
-	482, 555, 0, 0, 0, 170, 0, 0, 0, 0,
-	175, 168, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 0, 0, 0, 512, 516, 519, 529, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 451, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 461,
-	0, 0, 463, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 474, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
-	484, 0, 955, 0, 0, 487, 0, 0, 0, 490,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 0, 0, 0, 0, 508, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 515,
-	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 0, 0, 178, 0, 0,
-	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	179, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 968, 537, 180, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	185, 0, 0, 0, 0, 0, 545, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 552, 187, 0, 188,
-	189, 0, 0, 186, 0, 0, 170, 0, 0, 0,
-	0, 175, 168, 0, 0, 0, 0, 0, 0, 0,
-	557, 0, 0, 0, 0, 0, 0, 981, 984, 985,
-	986, 987, 988, 989, 566, 990, 991, 992, 993, 994,
-	969, 970, 971, 972, 953, 954, 982, 0, 956, 0,
-	957, 958, 959, 960, 961, 962, 963, 964, 965, 0,
-	0, 966, 973, 974, 975, 976, 977, 978, 979, 980,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 567,
-	0, 0, 0, 0, 169, 0, 0, 0, 520, 0,
-	522, 550, 513, 536, 477, 526, 0, 0, 533, 565,
-	0, 0, 0, 0, 0, 0, 495, 0, 172, 0,
-	0, 165, 166, 167, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 530, 0, 0,
-	0, 0, 0, 0, 0, 0, 569, 0, 178, 0,
-	0, 0, 0, 219, 563, 521, 0, 534, 0, 458,
-	528, 179, 465, 468, 570, 559, 491, 492, 0, 0,
-	0, 0, 0, 0, 983, 518, 523, 544, 509, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 185, 471, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
+	0, 0, 0, 553, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 176, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 561, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 568, 194, 0, 0, 179, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 573, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 582, 0, 0, 0, 0, 0, 185, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	476, 456, 489, 547, 457, 0, 453, 0, 0, 0,
-	0, 0, 0, 556, 510, 568, 560, 507, 506, 539,
-	472, 0, 0, 0, 470, 0, 452, 467, 475, 500,
-	527, 543, 549, 0, 0, 497, 0, 493, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	0, 0, 0, 0, 561, 0, 0, 0, 0, 0,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 583, 0, 0, 0,
+	192, 0, 0, 0, 0, 536, 0, 538, 566, 529,
+	552, 493, 542, 0, 0, 549, 581, 194, 0, 0,
+	0, 0, 0, 511, 0, 0, 0, 0, 172, 173,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	558, 0, 0, 0, 173, 454, 177, 498, 503, 511,
-	538, 541, 165, 166, 167, 0, 1668, 0, 0, 0,
-	464, 0, 931, 473, 551, 553, 546, 0, 0, 0,
+	0, 0, 0, 0, 0, 546, 0, 0, 0, 0,
+	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
+	0, 226, 579, 537, 0, 550, 0, 474, 544, 0,
+	481, 484, 586, 575, 507, 508, 0, 0, 0, 0,
+	0, 0, 0, 534, 539, 560, 525, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	0, 487, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 173, 174, 0, 1341, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 502, 0, 0,
-	0, 0, 0, 0, 1669, 0, 0, 0, 0, 0,
-	0, 450, 444, 443, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 480, 481, 478, 0, 479, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
+	472, 505, 563, 473, 0, 469, 0, 0, 0, 0,
+	0, 0, 572, 526, 584, 576, 523, 522, 555, 488,
+	0, 0, 0, 486, 0, 468, 483, 491, 516, 543,
+	559, 565, 0, 0, 513, 0, 509, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
+	0, 0, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 574,
+	0, 0, 0, 180, 470, 184, 514, 519, 527, 554,
+	557, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	0, 457, 489, 567, 569, 562, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 227, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 548, 0, 469, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 478, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 0, 0, 0, 518, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 525, 0, 0, 0, 572, 540, 0,
-	573, 0, 0, 0, 0, 0, 0, 0, 0, 494,
-	183, 0, 176, 0, 0, 0, 0, 0, 0, 0,
-	531, 182, 0, 0, 542, 0, 0, 190, 0, 0,
-	0, 0, 0, 0, 0, 173, 0, 177, 171, 174,
+	466, 460, 459, 0, 0, 0, 180, 0, 184, 0,
+	0, 0, 496, 497, 494, 0, 495, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 0, 0, 0, 0, 0,
-	181, 0, 0, 0, 0, 184, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 189, 562, 554,
-	186, 0, 514, 564, 485, 501, 571, 504, 505, 535,
-	466, 524, 532, 499, 455, 488, 459, 496, 460, 486,
-	517, 0, 482, 555, 0, 0, 0, 170, 0, 0,
-	0, 0, 175, 168, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 0, 0, 0, 512, 516, 519, 529,
+	0, 0, 564, 0, 485, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	451, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 461, 0, 0, 463, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 474, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 169, 0, 0, 0, 0,
-	0, 0, 484, 0, 0, 0, 0, 487, 0, 0,
-	0, 490, 0, 0, 0, 0, 0, 0, 0, 172,
-	0, 0, 0, 176, 0, 0, 0, 0, 508, 0,
-	0, 0, 182, 0, 0, 0, 0, 0, 190, 0,
-	0, 515, 0, 0, 0, 0, 0, 0, 0, 171,
-	174, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 0, 0, 0, 180, 0, 0, 0, 0,
-	0, 181, 179, 0, 0, 0, 184, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 537, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 189, 0,
-	0, 186, 185, 0, 0, 0, 0, 0, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 552, 187,
+	0, 0, 541, 0, 0, 0, 588, 556, 0, 589,
+	0, 0, 0, 0, 0, 0, 0, 0, 510, 0,
+	0, 183, 0, 0, 0, 0, 0, 0, 0, 547,
+	189, 0, 0, 558, 0, 0, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 181, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 0, 188,
+	0, 0, 0, 0, 191, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 557, 0, 0, 0, 0, 0, 0, 942,
-	0, 0, 0, 0, 170, 0, 566, 0, 0, 175,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 578, 570, 193,
+	0, 530, 580, 501, 517, 587, 520, 521, 551, 482,
+	540, 548, 515, 471, 504, 475, 512, 476, 502, 533,
+	0, 498, 571, 0, 183, 0, 177, 0, 0, 0,
+	0, 182, 175, 189, 0, 0, 0, 0, 0, 197,
+	499, 0, 0, 0, 0, 528, 532, 535, 545, 0,
+	178, 181, 0, 0, 0, 0, 0, 0, 0, 467,
+	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
+	477, 0, 188, 479, 0, 0, 0, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	0, 0, 193, 0, 176, 0, 0, 0, 0, 0,
+	0, 500, 0, 0, 0, 0, 503, 0, 0, 0,
+	506, 0, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 524, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 553, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 192, 0, 0, 0, 0, 0, 561, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 568, 194, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 567, 0, 0, 0, 0, 0, 0, 0, 0,
-	520, 0, 522, 550, 513, 536, 477, 526, 0, 0,
-	533, 565, 0, 0, 0, 0, 0, 0, 495, 0,
-	0, 0, 169, 165, 166, 167, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 0, 0, 530,
-	0, 0, 0, 0, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 219, 563, 521, 0, 534,
-	0, 458, 528, 0, 465, 468, 570, 559, 491, 492,
-	0, 0, 0, 0, 0, 0, 178, 518, 523, 544,
-	509, 0, 0, 0, 0, 0, 0, 0, 0, 179,
-	0, 0, 0, 0, 471, 0, 0, 0, 0, 0,
+	0, 573, 0, 0, 0, 0, 1394, 0, 0, 0,
+	0, 0, 0, 0, 0, 582, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	0, 0, 0, 182, 175, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	0, 0, 476, 456, 489, 547, 457, 0, 453, 0,
-	0, 0, 0, 0, 0, 556, 510, 568, 560, 507,
-	506, 539, 472, 0, 0, 0, 470, 0, 452, 467,
-	475, 500, 527, 543, 549, 0, 0, 497, 0, 493,
-	1276, 0, 262, 0, 0, 0, 0, 267, 0, 0,
-	0, 183, 170, 0, 0, 1277, 561, 175, 168, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 0, 0, 0, 173, 454, 177, 498,
-	503, 511, 538, 541, 0, 0, 0, 0, 0, 0,
-	0, 0, 464, 0, 441, 473, 551, 553, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	165, 166, 167, 0, 941, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 462, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 502,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 450, 444, 443, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 480, 481, 478, 0, 479,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 179, 0, 0,
-	0, 0, 0, 0, 0, 548, 0, 469, 0, 0,
+	583, 0, 0, 0, 0, 0, 0, 0, 0, 536,
+	0, 538, 566, 529, 552, 493, 542, 0, 0, 549,
+	581, 0, 0, 0, 0, 0, 0, 511, 0, 0,
+	0, 0, 172, 173, 174, 0, 1349, 0, 1350, 0,
+	0, 0, 0, 0, 177, 0, 176, 0, 0, 182,
+	175, 0, 0, 0, 0, 0, 0, 0, 0, 546,
+	0, 0, 0, 0, 0, 0, 0, 0, 585, 0,
+	179, 0, 0, 0, 0, 0, 579, 537, 0, 550,
+	0, 474, 544, 0, 481, 484, 586, 575, 507, 508,
+	0, 0, 0, 0, 0, 0, 0, 534, 539, 560,
+	525, 0, 0, 0, 1352, 1351, 0, 0, 0, 0,
+	185, 0, 0, 0, 0, 487, 0, 0, 0, 0,
+	0, 0, 176, 186, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
-	0, 0, 0, 0, 0, 525, 0, 0, 0, 572,
-	540, 0, 573, 0, 187, 0, 0, 0, 0, 0,
-	0, 494, 0, 0, 176, 0, 0, 0, 0, 0,
-	0, 0, 531, 182, 0, 0, 542, 0, 0, 190,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
-	171, 174, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 0, 0, 0,
-	0, 0, 181, 173, 0, 177, 0, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 188, 189,
-	0, 0, 186, 0, 0, 0, 0, 269, 270, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 87, 0, 0, 165, 166,
-	167, 338, 345, 346, 347, 348, 349, 339, 341, 0,
-	0, 340, 0, 285, 287, 286, 296, 297, 298, 299,
-	300, 301, 302, 283, 343, 350, 351, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 259, 0,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	257, 430, 0, 0, 0, 322, 0, 258, 0, 0,
-	266, 352, 353, 354, 355, 356, 357, 358, 359, 360,
-	361, 362, 363, 364, 365, 366, 367, 368, 369, 370,
-	371, 372, 373, 374, 375, 376, 377, 378, 379, 380,
-	381, 382, 383, 384, 385, 386, 387, 388, 389, 390,
-	391, 392, 393, 268, 0, 0, 0, 0, 0, 0,
-	0, 176, 0, 0, 0, 0, 0, 0, 0, 321,
-	182, 0, 0, 0, 0, 319, 190, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 171, 174, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 180, 0, 0, 183, 0, 262, 181,
-	0, 0, 0, 267, 184, 0, 0, 0, 170, 0,
-	0, 0, 0, 175, 168, 0, 0, 0, 0, 0,
-	0, 173, 0, 177, 0, 188, 189, 0, 0, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 78, 40, 41, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 0, 0, 44, 70, 71, 0,
-	68, 72, 0, 0, 0, 0, 0, 291, 292, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	328, 320, 278, 332, 280, 329, 330, 275, 276, 279,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	323, 265, 264, 179, 271, 272, 0, 281, 282, 284,
-	288, 289, 290, 293, 294, 295, 303, 305, 306, 304,
-	307, 308, 309, 312, 313, 314, 315, 310, 311, 316,
-	263, 0, 0, 185, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 69, 0, 176,
-	187, 0, 0, 0, 0, 0, 0, 0, 182, 0,
-	0, 0, 0, 0, 190, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 171, 174, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 0, 0, 0, 0, 0, 181, 0, 0,
-	0, 0, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 188, 189, 0, 0, 186, 0, 0,
-	0, 0, 274, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 270, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 0, 0, 165, 166, 167, 338, 345, 346,
-	347, 348, 349, 339, 341, 0, 0, 340, 0, 285,
-	287, 286, 296, 297, 298, 299, 300, 301, 302, 283,
-	343, 350, 351, 0, 0, 0, 0, 0, 0, 0,
-	0, 1242, 1243, 1244, 0, 0, 0, 0, 0, 0,
-	0, 57, 0, 245, 259, 0, 273, 0, 0, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 0, 0, 256, 257, 175, 168, 0,
-	0, 322, 0, 258, 0, 0, 266, 352, 353, 354,
-	355, 356, 357, 358, 359, 360, 361, 362, 363, 364,
-	365, 366, 367, 368, 369, 370, 371, 372, 373, 374,
-	375, 376, 377, 378, 379, 380, 381, 382, 383, 384,
-	385, 386, 387, 388, 389, 390, 391, 392, 393, 268,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 492, 472, 505, 563, 473, 0, 469,
+	194, 0, 0, 0, 0, 0, 572, 526, 584, 576,
+	523, 522, 555, 488, 0, 0, 185, 486, 0, 468,
+	483, 491, 516, 543, 559, 565, 0, 0, 513, 186,
+	509, 1319, 0, 276, 0, 0, 0, 0, 281, 0,
+	0, 0, 190, 177, 0, 0, 1320, 577, 182, 175,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	0, 0, 0, 574, 0, 0, 0, 180, 470, 184,
+	514, 519, 527, 554, 557, 0, 194, 0, 0, 0,
+	0, 0, 0, 480, 0, 745, 489, 567, 569, 562,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 173, 174, 0, 0, 0,
+	0, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 478, 0, 0,
+	0, 0, 0, 0, 0, 179, 0, 0, 0, 0,
+	518, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 497, 494, 0,
+	495, 0, 0, 0, 0, 185, 0, 0, 0, 0,
+	172, 173, 174, 0, 949, 0, 0, 0, 186, 0,
+	0, 0, 0, 0, 0, 0, 564, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 0, 541, 0, 0, 0,
+	588, 556, 0, 589, 0, 194, 0, 0, 0, 0,
+	0, 0, 510, 0, 0, 183, 0, 0, 0, 0,
+	0, 0, 0, 547, 189, 0, 0, 558, 0, 0,
+	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 181, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 187, 0, 0,
+	0, 0, 0, 188, 0, 0, 0, 0, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
+	0, 184, 0, 0, 0, 0, 0, 289, 0, 195,
+	196, 0, 0, 193, 0, 0, 0, 0, 284, 285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 91, 0, 0, 172,
+	173, 174, 353, 360, 361, 362, 363, 364, 354, 356,
+	190, 0, 355, 0, 0, 300, 302, 301, 311, 312,
+	313, 314, 315, 316, 317, 298, 358, 365, 366, 0,
+	0, 0, 0, 0, 0, 180, 0, 184, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
+	273, 0, 288, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 271, 446, 0, 0, 0, 337, 0, 0,
+	272, 0, 0, 280, 367, 368, 369, 370, 371, 372,
+	373, 374, 375, 376, 377, 378, 379, 380, 381, 382,
+	383, 384, 385, 386, 387, 388, 389, 390, 391, 392,
+	393, 394, 395, 396, 397, 398, 399, 400, 401, 402,
+	403, 404, 405, 406, 407, 408, 282, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 336, 0, 0, 0, 0, 183, 334, 0,
+	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
+	0, 0, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 181, 0, 0, 0, 0, 190,
+	0, 276, 0, 0, 0, 0, 281, 0, 0, 187,
+	0, 177, 0, 0, 0, 188, 182, 175, 0, 0,
+	191, 0, 0, 0, 180, 0, 184, 0, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 183, 0, 193, 0, 0, 0, 0,
+	0, 0, 189, 0, 0, 0, 0, 0, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	181, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	306, 307, 0, 0, 0, 187, 0, 0, 0, 176,
+	0, 188, 0, 0, 0, 0, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 0, 0, 195, 196, 0,
+	0, 193, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 343, 335, 293, 347, 295, 344, 345,
+	290, 291, 294, 346, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 338, 279, 278, 186, 286, 287, 0,
+	296, 297, 299, 303, 304, 305, 308, 309, 310, 318,
+	320, 321, 319, 322, 323, 324, 327, 328, 329, 330,
+	325, 326, 331, 277, 0, 0, 192, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 321, 0, 0, 0, 0,
-	169, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 194, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 0, 0, 0, 0, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 181,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 47, 50, 53, 52, 55,
-	0, 0, 67, 0, 0, 75, 0, 173, 0, 177,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 179, 56, 83,
-	82, 0, 0, 65, 66, 54, 0, 0, 0, 0,
-	0, 73, 74, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 0, 0,
-	0, 0, 0, 291, 292, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 58, 59, 0, 60, 61,
-	62, 63, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 328, 320, 278, 332,
-	280, 329, 330, 275, 276, 279, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 323, 265, 264, 0,
-	271, 272, 0, 281, 282, 284, 288, 289, 290, 293,
-	294, 295, 303, 305, 306, 304, 307, 308, 309, 312,
-	313, 314, 315, 310, 311, 316, 263, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 166,
-	167, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 0, 0, 0, 81, 0,
-	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 0, 0,
-	0, 0, 86, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 188,
-	189, 562, 554, 186, 0, 514, 564, 485, 501, 571,
-	504, 505, 535, 466, 524, 532, 499, 455, 488, 459,
-	496, 460, 486, 517, 0, 482, 555, 0, 0, 0,
-	170, 0, 0, 0, 0, 175, 168, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 0, 0, 0, 512,
-	516, 519, 529, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 451, 0, 0, 2201, 0, 0, 0,
-	0, 0, 0, 0, 461, 0, 0, 463, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 474, 0, 0, 0, 0, 183, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 0, 0, 0, 0, 484, 0, 0, 0, 0,
-	487, 173, 0, 177, 490, 0, 0, 0, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 515, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 179, 0, 0, 0, 0,
+	188, 0, 0, 0, 0, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 0, 0,
+	193, 0, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 284, 285, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 0, 0, 172, 173, 174,
+	353, 360, 361, 362, 363, 364, 354, 356, 0, 0,
+	355, 0, 0, 300, 302, 301, 311, 312, 313, 314,
+	315, 316, 317, 298, 358, 365, 366, 0, 0, 0,
+	0, 0, 0, 0, 0, 1285, 1286, 1287, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 259, 273, 0,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	271, 0, 0, 0, 0, 337, 0, 0, 272, 0,
+	0, 280, 367, 368, 369, 370, 371, 372, 373, 374,
+	375, 376, 377, 378, 379, 380, 381, 382, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 408, 282, 91, 0, 0, 0, 0,
+	0, 353, 360, 361, 362, 363, 364, 354, 356, 0,
+	336, 355, 0, 0, 0, 0, 334, 0, 0, 0,
+	0, 0, 0, 0, 0, 358, 365, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 190, 0, 276,
+	0, 0, 0, 0, 281, 0, 0, 0, 0, 177,
+	0, 0, 0, 0, 182, 175, 0, 0, 0, 0,
+	1741, 1742, 180, 0, 184, 0, 0, 0, 0, 283,
+	0, 0, 0, 367, 368, 369, 370, 371, 372, 373,
+	374, 375, 376, 377, 378, 379, 380, 381, 382, 383,
+	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
+	404, 405, 406, 407, 408, 0, 0, 0, 306, 307,
+	0, 0, 0, 0, 0, 0, 0, 176, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 537, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 552, 187, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 557, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 0, 566,
-	0, 0, 175, 168, 0, 0, 0, 0, 0, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 343, 335, 293, 347, 295, 344, 345, 290, 291,
+	294, 346, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 338, 279, 278, 186, 286, 287, 0, 296, 297,
+	299, 303, 304, 305, 308, 309, 310, 318, 320, 321,
+	319, 322, 323, 324, 327, 328, 329, 330, 325, 326,
+	331, 277, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 194, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 0, 0, 0, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 0, 0, 0, 188, 0,
+	0, 0, 343, 191, 0, 347, 0, 344, 345, 0,
+	0, 0, 346, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 0, 0, 193, 0,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 284, 285, 0, 0, 0, 0,
+	0, 0, 1405, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 91, 0, 0, 172, 173, 174, 353, 360,
+	361, 362, 363, 364, 354, 356, 0, 0, 355, 0,
+	0, 300, 302, 301, 311, 312, 313, 314, 315, 316,
+	317, 298, 358, 365, 366, 1406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 259, 273, 0, 288, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 271, 0,
+	0, 0, 0, 337, 0, 0, 272, 0, 0, 280,
+	367, 368, 369, 370, 371, 372, 373, 374, 375, 376,
+	377, 378, 379, 380, 381, 382, 383, 384, 385, 386,
+	387, 388, 389, 390, 391, 392, 393, 394, 395, 396,
+	397, 398, 399, 400, 401, 402, 403, 404, 405, 406,
+	407, 408, 282, 91, 0, 0, 0, 0, 0, 353,
+	360, 361, 362, 363, 364, 354, 356, 0, 336, 355,
+	0, 0, 0, 0, 334, 0, 0, 0, 0, 0,
+	0, 0, 0, 358, 365, 366, 0, 0, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 0, 276, 0, 0,
+	0, 0, 281, 0, 0, 0, 0, 177, 0, 0,
+	0, 0, 182, 175, 0, 0, 0, 0, 1741, 1742,
+	180, 0, 184, 0, 0, 0, 0, 283, 0, 0,
+	0, 367, 368, 369, 370, 371, 372, 373, 374, 375,
+	376, 377, 378, 379, 380, 381, 382, 383, 384, 385,
+	386, 387, 388, 389, 390, 391, 392, 393, 394, 395,
+	396, 397, 398, 399, 400, 401, 402, 403, 404, 405,
+	406, 407, 408, 0, 0, 0, 306, 307, 0, 0,
+	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 343,
+	335, 293, 347, 295, 344, 345, 290, 291, 294, 346,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 338,
+	279, 278, 186, 286, 287, 0, 296, 297, 299, 303,
+	304, 305, 308, 309, 310, 318, 320, 321, 319, 322,
+	323, 324, 327, 328, 329, 330, 325, 326, 331, 277,
+	0, 0, 192, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 194,
+	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
+	0, 0, 0, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 181, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 0, 188, 0, 0, 0,
+	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 195, 196, 0, 0, 193, 0, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 284, 285, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 0, 0, 172, 173, 174, 353, 360, 361, 362,
+	363, 364, 354, 356, 0, 0, 355, 0, 0, 300,
+	302, 301, 311, 312, 313, 314, 315, 316, 317, 298,
+	358, 365, 366, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 259, 273, 0, 288, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 271, 0, 0, 0,
+	0, 337, 0, 0, 272, 0, 0, 280, 367, 368,
+	369, 370, 371, 372, 373, 374, 375, 376, 377, 378,
+	379, 380, 381, 382, 383, 384, 385, 386, 387, 388,
+	389, 390, 391, 392, 393, 394, 395, 396, 397, 398,
+	399, 400, 401, 402, 403, 404, 405, 406, 407, 408,
+	282, 0, 1658, 0, 0, 360, 361, 0, 0, 0,
+	0, 1226, 0, 0, 0, 0, 336, 0, 0, 0,
+	0, 0, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 190, 0, 360, 361, 0, 0, 0,
+	0, 1226, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 180, 0,
+	184, 0, 0, 0, 0, 283, 367, 368, 369, 370,
+	371, 372, 373, 374, 375, 376, 377, 378, 379, 380,
+	381, 382, 383, 384, 385, 386, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 0, 0,
+	0, 0, 0, 0, 306, 307, 367, 368, 369, 370,
+	371, 372, 373, 374, 375, 376, 377, 378, 379, 380,
+	381, 382, 383, 384, 385, 386, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 343, 335, 293,
+	347, 295, 344, 345, 290, 291, 294, 346, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 338, 279, 278,
+	0, 286, 287, 0, 296, 297, 299, 303, 304, 305,
+	308, 309, 310, 318, 320, 321, 319, 322, 323, 324,
+	327, 328, 329, 330, 325, 326, 331, 277, 0, 0,
+	0, 90, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
+	0, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 181, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
+	0, 0, 0, 0, 188, 0, 0, 0, 0, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 578, 570, 193, 0, 530, 580, 501, 517,
+	587, 520, 521, 551, 482, 540, 548, 515, 471, 504,
+	475, 512, 476, 502, 533, 0, 498, 571, 0, 0,
+	0, 177, 0, 0, 0, 0, 182, 175, 0, 0,
+	0, 0, 0, 0, 0, 499, 0, 0, 0, 0,
+	528, 532, 535, 545, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 477, 0, 0, 479, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
-	0, 0, 0, 0, 567, 0, 0, 0, 182, 0,
-	0, 0, 0, 520, 190, 522, 550, 513, 536, 477,
-	526, 0, 0, 533, 565, 171, 174, 0, 0, 0,
-	0, 495, 0, 0, 0, 169, 165, 166, 167, 0,
-	2193, 180, 2194, 0, 0, 0, 0, 181, 170, 0,
-	0, 0, 184, 175, 168, 0, 0, 0, 0, 172,
-	0, 0, 530, 0, 0, 0, 0, 0, 0, 0,
-	0, 569, 0, 188, 189, 0, 0, 186, 0, 563,
-	521, 0, 534, 0, 458, 528, 0, 465, 468, 570,
-	559, 491, 492, 0, 0, 0, 0, 0, 0, 178,
-	518, 523, 544, 509, 0, 0, 0, 0, 0, 0,
-	0, 0, 179, 0, 0, 0, 0, 471, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 500, 0, 0, 0,
+	0, 503, 0, 0, 0, 506, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 524, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 476, 456, 489, 547, 457,
-	0, 453, 0, 0, 0, 0, 0, 0, 556, 510,
-	568, 560, 507, 506, 539, 472, 0, 0, 0, 470,
-	178, 452, 467, 475, 500, 527, 543, 549, 0, 0,
-	497, 0, 493, 179, 0, 262, 0, 0, 0, 0,
-	267, 0, 0, 0, 183, 170, 0, 0, 0, 561,
-	175, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 558, 0, 0, 0, 173,
-	454, 177, 498, 503, 511, 538, 541, 0, 0, 0,
-	187, 0, 0, 0, 0, 464, 0, 722, 473, 551,
-	553, 546, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 165, 166, 167, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 462,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 480, 481,
-	478, 0, 479, 0, 0, 0, 0, 178, 0, 0,
-	0, 0, 0, 0, 165, 166, 167, 0, 0, 0,
-	179, 0, 0, 0, 0, 0, 0, 0, 548, 0,
-	469, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 0, 0, 0, 0, 0, 525, 0,
-	0, 0, 572, 540, 0, 573, 0, 187, 0, 0,
-	0, 0, 0, 0, 494, 0, 0, 176, 0, 0,
-	0, 0, 0, 0, 0, 531, 182, 0, 0, 542,
-	0, 0, 190, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 171, 174, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	0, 0, 0, 0, 0, 181, 173, 0, 177, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 188, 189, 0, 0, 186, 0, 0, 0, 0,
-	269, 270, 2146, 0, 0, 0, 0, 0, 1357, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
-	0, 165, 166, 167, 338, 345, 346, 347, 348, 349,
-	339, 341, 183, 0, 340, 0, 285, 287, 286, 296,
-	297, 298, 299, 300, 301, 302, 283, 343, 350, 351,
-	1358, 0, 0, 0, 0, 0, 0, 173, 0, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 259, 0, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 257, 0, 0, 0, 0, 322, 0,
-	258, 0, 0, 266, 352, 353, 354, 355, 356, 357,
-	358, 359, 360, 361, 362, 363, 364, 365, 366, 367,
+	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 553, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 561, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 568, 194, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 573, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	582, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 0, 0, 0, 0, 182, 175,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 583, 0, 0, 0, 0,
+	0, 0, 0, 0, 536, 0, 538, 566, 529, 552,
+	493, 542, 0, 0, 549, 581, 0, 0, 0, 0,
+	0, 0, 511, 0, 0, 0, 0, 172, 173, 174,
+	0, 2295, 0, 2296, 0, 0, 0, 0, 0, 177,
+	0, 176, 0, 0, 182, 175, 0, 0, 0, 0,
+	0, 0, 0, 0, 546, 0, 0, 0, 0, 0,
+	0, 0, 0, 585, 0, 179, 0, 0, 0, 0,
+	0, 579, 537, 0, 550, 0, 474, 544, 0, 481,
+	484, 586, 575, 507, 508, 0, 0, 0, 0, 0,
+	0, 0, 534, 539, 560, 525, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 0, 0, 0, 0,
+	487, 0, 0, 0, 0, 0, 0, 176, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 492, 472,
+	505, 563, 473, 0, 469, 194, 0, 0, 0, 0,
+	0, 572, 526, 584, 576, 523, 522, 555, 488, 0,
+	0, 185, 486, 0, 468, 483, 491, 516, 543, 559,
+	565, 0, 0, 513, 186, 509, 0, 0, 276, 0,
+	0, 0, 0, 281, 0, 0, 0, 190, 177, 0,
+	0, 0, 577, 182, 175, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 0, 0, 0, 574, 0,
+	0, 0, 180, 470, 184, 514, 519, 527, 554, 557,
+	0, 194, 0, 0, 0, 0, 0, 0, 480, 0,
+	745, 489, 567, 569, 562, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	173, 174, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 478, 0, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 518, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 496, 497, 494, 0, 495, 0, 0, 0, 0,
+	185, 0, 0, 0, 0, 172, 173, 174, 0, 0,
+	0, 0, 809, 186, 0, 0, 0, 0, 0, 0,
+	0, 564, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 0,
+	0, 541, 0, 0, 0, 588, 556, 0, 589, 0,
+	194, 0, 0, 0, 0, 0, 0, 510, 0, 0,
+	183, 0, 0, 0, 0, 0, 0, 0, 547, 189,
+	0, 0, 558, 0, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 187, 0, 0, 0, 0, 0, 188, 0,
+	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 180, 0, 184, 0, 0, 0,
+	0, 0, 289, 0, 195, 196, 0, 0, 193, 0,
+	0, 0, 0, 284, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 91, 0, 928, 172, 173, 174, 353, 360, 361,
+	362, 363, 364, 354, 356, 190, 0, 355, 0, 0,
+	300, 302, 301, 311, 312, 313, 314, 315, 316, 317,
+	298, 358, 365, 366, 0, 0, 0, 0, 0, 0,
+	180, 0, 184, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 259, 273, 0, 288, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 271, 0, 0,
+	0, 0, 337, 0, 0, 272, 0, 0, 280, 367,
 	368, 369, 370, 371, 372, 373, 374, 375, 376, 377,
 	378, 379, 380, 381, 382, 383, 384, 385, 386, 387,
-	388, 389, 390, 391, 392, 393, 268, 0, 0, 0,
-	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
-	0, 0, 321, 182, 0, 0, 0, 0, 319, 190,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	171, 174, 0, 78, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2206, 0, 0, 180, 0, 0, 183,
-	0, 262, 181, 0, 0, 0, 267, 184, 0, 0,
-	0, 170, 0, 0, 0, 0, 175, 168, 0, 0,
-	0, 0, 0, 0, 173, 0, 177, 0, 188, 189,
-	0, 0, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 0, 0, 0, 0, 0,
-	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	291, 292, 0, 0, 0, 0, 0, 180, 0, 169,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 0, 188,
-	189, 0, 0, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 328, 320, 278, 332, 280, 329, 330,
-	275, 276, 279, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 323, 265, 264, 179, 271, 272, 0,
-	281, 282, 284, 288, 289, 290, 293, 294, 295, 303,
-	305, 306, 304, 307, 308, 309, 312, 313, 314, 315,
-	310, 311, 316, 263, 0, 0, 185, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 176, 187, 0, 0, 0, 0, 0, 0,
-	0, 182, 0, 0, 0, 0, 0, 190, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 171, 174,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 180, 0, 0, 0, 0, 0,
-	181, 0, 0, 0, 0, 184, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 189, 0, 0,
-	186, 0, 0, 0, 0, 274, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 270, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 87, 0, 0, 165, 166, 167,
-	338, 345, 346, 347, 348, 349, 339, 341, 0, 0,
-	340, 0, 285, 287, 286, 296, 297, 298, 299, 300,
-	301, 302, 283, 343, 350, 351, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 259, 0, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 0, 0, 256, 257,
-	175, 168, 0, 0, 322, 0, 258, 0, 0, 266,
-	352, 353, 354, 355, 356, 357, 358, 359, 360, 361,
-	362, 363, 364, 365, 366, 367, 368, 369, 370, 371,
-	372, 373, 374, 375, 376, 377, 378, 379, 380, 381,
-	382, 383, 384, 385, 386, 387, 388, 389, 390, 391,
-	392, 393, 268, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 321, 0,
-	0, 0, 0, 169, 319, 0, 0, 0, 0, 0,
+	388, 389, 390, 391, 392, 393, 394, 395, 396, 397,
+	398, 399, 400, 401, 402, 403, 404, 405, 406, 407,
+	408, 282, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 336, 0, 0,
+	0, 0, 183, 334, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 0, 0, 0, 0, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 181,
+	0, 0, 0, 0, 190, 0, 276, 0, 0, 0,
+	0, 281, 0, 0, 187, 0, 177, 0, 0, 0,
+	188, 182, 175, 0, 0, 191, 0, 0, 0, 180,
+	0, 184, 0, 1178, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 183, 0,
+	193, 0, 0, 0, 0, 0, 0, 189, 0, 0,
+	0, 0, 0, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 181, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 306, 307, 0, 0, 0,
+	187, 0, 0, 0, 176, 0, 188, 0, 0, 0,
+	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 195, 196, 0, 0, 193, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 343, 335,
+	293, 347, 295, 344, 345, 290, 291, 294, 346, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 338, 279,
+	278, 186, 286, 287, 0, 296, 297, 299, 303, 304,
+	305, 308, 309, 310, 318, 320, 321, 319, 322, 323,
+	324, 327, 328, 329, 330, 325, 326, 331, 277, 0,
+	0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 194, 0,
+	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
+	0, 0, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 0, 188, 0, 0, 0, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 0, 0, 193, 0, 0, 0, 0,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 284, 285, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	0, 0, 172, 173, 174, 353, 360, 361, 362, 363,
+	364, 354, 356, 0, 0, 355, 0, 0, 300, 302,
+	301, 311, 312, 313, 314, 315, 316, 317, 298, 358,
+	365, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 259, 273, 0, 288, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 271, 446, 0, 0, 0,
+	337, 0, 0, 272, 0, 0, 280, 367, 368, 369,
+	370, 371, 372, 373, 374, 375, 376, 377, 378, 379,
+	380, 381, 382, 383, 384, 385, 386, 387, 388, 389,
+	390, 391, 392, 393, 394, 395, 396, 397, 398, 399,
+	400, 401, 402, 403, 404, 405, 406, 407, 408, 282,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 0, 0, 0, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 336, 0, 0, 0, 0,
+	0, 334, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	173, 0, 177, 0, 0, 0, 0, 178, 0, 0,
+	0, 0, 190, 0, 276, 0, 0, 0, 0, 281,
+	0, 0, 0, 0, 177, 0, 0, 0, 0, 182,
+	175, 0, 0, 0, 0, 0, 0, 180, 0, 184,
+	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 0, 0, 0, 291, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 306, 307, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
-	320, 278, 332, 280, 329, 330, 275, 276, 279, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
-	265, 264, 0, 271, 272, 0, 281, 282, 284, 288,
-	289, 290, 293, 294, 295, 303, 305, 306, 304, 307,
-	308, 309, 312, 313, 314, 315, 310, 311, 316, 263,
-	0, 0, 0, 86, 0, 0, 0, 0, 0, 0,
-	0, 165, 166, 167, 0, 0, 0, 0, 176, 0,
-	0, 1593, 0, 0, 0, 0, 0, 182, 0, 0,
-	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 0, 0, 0, 0, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 188, 189, 562, 554, 186, 0, 514, 564,
-	485, 501, 571, 504, 505, 535, 466, 524, 532, 499,
-	455, 488, 459, 496, 460, 486, 517, 0, 482, 555,
-	0, 0, 0, 170, 0, 0, 0, 0, 175, 168,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
-	0, 0, 512, 516, 519, 529, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 451, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 461, 0, 0,
-	463, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 474, 0, 0, 0, 0, 183,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 169, 0, 0, 0, 0, 0, 0, 484, 0,
-	0, 0, 0, 487, 173, 0, 177, 490, 0, 0,
-	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 508, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 515, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 343, 335, 293, 347,
+	295, 344, 345, 290, 291, 294, 346, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 338, 279, 278, 186,
+	286, 287, 0, 296, 297, 299, 303, 304, 305, 308,
+	309, 310, 318, 320, 321, 319, 322, 323, 324, 327,
+	328, 329, 330, 325, 326, 331, 277, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 183, 194, 0, 0, 0,
+	0, 0, 0, 0, 189, 0, 0, 0, 0, 0,
+	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 181, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
+	0, 0, 0, 188, 0, 0, 0, 0, 191, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 537, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
-	0, 0, 0, 0, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 552, 187, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 0, 0, 193, 0, 0, 0, 0, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 284,
+	285, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
+	172, 173, 174, 353, 360, 361, 362, 363, 364, 354,
+	356, 0, 0, 355, 0, 0, 300, 302, 301, 311,
+	312, 313, 314, 315, 316, 317, 298, 358, 365, 366,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 557, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 0, 566, 0, 0, 175, 168, 0, 0, 0,
+	259, 273, 0, 288, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 271, 0, 0, 0, 0, 337, 0,
+	0, 272, 0, 0, 280, 367, 368, 369, 370, 371,
+	372, 373, 374, 375, 376, 377, 378, 379, 380, 381,
+	382, 383, 384, 385, 386, 387, 388, 389, 390, 391,
+	392, 393, 394, 395, 396, 397, 398, 399, 400, 401,
+	402, 403, 404, 405, 406, 407, 408, 282, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 334,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 176, 0, 0, 0, 0, 567, 0, 0,
-	0, 182, 0, 0, 0, 0, 520, 190, 522, 550,
-	513, 536, 477, 526, 0, 0, 533, 565, 171, 174,
-	0, 0, 0, 0, 495, 0, 0, 0, 169, 165,
-	166, 167, 0, 0, 180, 0, 0, 0, 0, 0,
-	181, 170, 0, 0, 0, 184, 175, 168, 0, 0,
-	0, 0, 172, 0, 0, 530, 0, 0, 0, 0,
-	0, 0, 0, 0, 569, 0, 188, 189, 0, 0,
-	186, 0, 563, 521, 0, 534, 0, 458, 528, 0,
-	465, 468, 570, 559, 491, 492, 0, 0, 0, 0,
-	0, 0, 178, 518, 523, 544, 509, 0, 0, 0,
-	0, 0, 1865, 0, 0, 179, 0, 0, 0, 0,
-	471, 0, 0, 0, 0, 0, 0, 0, 0, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 0, 0, 476, 456,
-	489, 547, 457, 0, 453, 0, 0, 0, 0, 0,
-	0, 556, 510, 568, 560, 507, 506, 539, 472, 0,
-	0, 0, 470, 178, 452, 467, 475, 500, 527, 543,
-	549, 0, 0, 497, 0, 493, 179, 0, 262, 0,
-	0, 0, 0, 267, 0, 0, 0, 183, 170, 0,
-	0, 0, 561, 175, 168, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 558, 0,
-	0, 0, 173, 454, 177, 498, 503, 511, 538, 541,
-	0, 0, 0, 187, 0, 0, 0, 0, 464, 0,
-	722, 473, 551, 553, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 166, 167, 0,
-	1298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 480, 481, 478, 0, 479, 0, 0, 0, 0,
-	178, 0, 0, 0, 87, 0, 0, 165, 166, 167,
-	0, 0, 0, 179, 0, 0, 0, 0, 0, 0,
-	0, 548, 0, 469, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 0, 0, 0, 0, 0,
-	0, 525, 0, 0, 0, 572, 540, 0, 573, 0,
-	187, 0, 0, 0, 0, 0, 0, 494, 0, 0,
-	176, 0, 0, 0, 0, 0, 0, 0, 531, 182,
-	0, 0, 542, 0, 0, 190, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 171, 174, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 0, 0, 0, 0, 181, 173,
-	0, 177, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 274, 0, 188, 189, 0, 0, 186, 0,
-	0, 0, 0, 269, 270, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 0, 901, 165, 166, 167, 338, 345, 346,
-	347, 348, 349, 339, 341, 183, 0, 340, 0, 285,
-	287, 286, 296, 297, 298, 299, 300, 301, 302, 283,
-	343, 350, 351, 0, 0, 0, 0, 0, 0, 0,
-	173, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	1346, 0, 0, 245, 259, 0, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 0, 0, 256, 257, 175, 168, 0,
-	0, 322, 0, 258, 0, 0, 266, 352, 353, 354,
-	355, 356, 357, 358, 359, 360, 361, 362, 363, 364,
-	365, 366, 367, 368, 369, 370, 371, 372, 373, 374,
-	375, 376, 377, 378, 379, 380, 381, 382, 383, 384,
-	385, 386, 387, 388, 389, 390, 391, 392, 393, 268,
-	0, 0, 0, 0, 0, 0, 0, 176, 0, 0,
-	0, 0, 0, 0, 0, 321, 182, 0, 0, 0,
-	169, 319, 190, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 171, 174, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 180,
-	0, 0, 183, 0, 0, 181, 0, 0, 0, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 173, 0, 177,
-	0, 188, 189, 0, 178, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 179, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 0, 0,
-	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 185, 0, 0,
-	0, 0, 0, 291, 292, 0, 0, 0, 0, 0,
-	180, 0, 0, 0, 187, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 188, 189, 0, 0, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 328, 320, 278, 332,
-	280, 329, 330, 275, 276, 279, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 323, 265, 264, 0,
-	271, 272, 0, 281, 282, 284, 288, 289, 290, 293,
-	294, 295, 303, 305, 306, 304, 307, 308, 309, 312,
-	313, 314, 315, 310, 311, 316, 263, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 166,
-	167, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 0, 0, 0, 0, 0,
 	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 180, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 188,
-	189, 562, 554, 186, 0, 514, 564, 485, 501, 571,
-	504, 505, 535, 466, 524, 532, 499, 455, 488, 459,
-	496, 460, 486, 517, 0, 482, 555, 0, 0, 0,
-	170, 0, 0, 0, 0, 175, 168, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 0, 0, 0, 512,
-	516, 519, 529, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 451, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 461, 0, 0, 463, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 474, 0, 0, 0, 0, 183, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 0, 0, 0, 0, 484, 0, 0, 0, 0,
-	487, 173, 0, 177, 490, 0, 0, 0, 0, 0,
-	0, 0, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 508, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 515, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 179, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 537, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 0, 0, 0, 0,
-	0, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 552, 187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 180, 0, 184, 0, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 557, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 0, 566,
-	0, 0, 175, 168, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
-	0, 0, 0, 0, 567, 0, 0, 0, 182, 0,
-	0, 0, 0, 520, 190, 522, 550, 513, 536, 477,
-	526, 0, 0, 533, 565, 171, 174, 0, 0, 0,
-	0, 495, 0, 0, 0, 169, 165, 166, 167, 0,
-	0, 180, 0, 0, 0, 0, 0, 181, 170, 0,
-	0, 0, 184, 175, 168, 0, 0, 0, 0, 172,
-	0, 0, 530, 0, 0, 0, 0, 0, 0, 0,
-	0, 569, 0, 188, 189, 0, 0, 186, 0, 563,
-	521, 0, 534, 0, 458, 528, 0, 465, 468, 570,
-	559, 491, 492, 0, 0, 0, 0, 0, 0, 178,
-	518, 523, 544, 509, 0, 0, 0, 0, 0, 1826,
-	0, 0, 179, 0, 0, 0, 0, 471, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 0, 0, 0, 0,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 476, 456, 489, 547, 457,
-	0, 453, 0, 0, 0, 0, 0, 0, 556, 510,
-	568, 560, 507, 506, 539, 472, 0, 0, 0, 470,
-	178, 452, 467, 475, 500, 527, 543, 549, 0, 0,
-	497, 170, 493, 179, 0, 262, 175, 168, 0, 0,
-	267, 0, 0, 0, 183, 170, 0, 0, 0, 561,
-	175, 168, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 558, 0, 0, 0, 173,
-	454, 177, 498, 503, 511, 538, 541, 0, 0, 0,
-	187, 0, 0, 0, 0, 464, 0, 722, 473, 551,
-	553, 546, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 165, 166, 167, 0, 922, 0, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 0, 462,
-	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
-	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 480, 481,
-	478, 0, 479, 0, 0, 0, 179, 178, 0, 0,
-	0, 0, 0, 0, 165, 166, 167, 0, 0, 0,
-	179, 0, 0, 0, 0, 0, 0, 0, 548, 0,
-	469, 0, 0, 0, 0, 0, 185, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 0, 0, 187, 0, 0, 0, 0, 525, 0,
-	0, 0, 572, 540, 0, 573, 0, 187, 0, 0,
-	0, 0, 0, 0, 494, 0, 0, 176, 0, 0,
-	0, 0, 0, 0, 0, 531, 182, 0, 0, 542,
-	0, 0, 190, 0, 0, 785, 0, 0, 0, 0,
-	0, 183, 0, 171, 174, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 180,
-	0, 0, 0, 0, 0, 181, 173, 0, 177, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 188, 189, 0, 0, 186, 0, 0, 0, 0,
-	269, 270, 0, 0, 1019, 0, 0, 165, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
-	0, 165, 166, 167, 338, 345, 346, 347, 348, 349,
-	339, 341, 183, 0, 340, 0, 285, 287, 286, 296,
-	297, 298, 299, 300, 301, 302, 283, 343, 350, 351,
-	0, 0, 0, 0, 0, 0, 0, 173, 0, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 259, 0, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 257, 430, 0, 0, 0, 322, 0,
-	258, 0, 0, 266, 352, 353, 354, 355, 356, 357,
-	358, 359, 360, 361, 362, 363, 364, 365, 366, 367,
-	368, 369, 370, 371, 372, 373, 374, 375, 376, 377,
-	378, 379, 380, 381, 382, 383, 384, 385, 386, 387,
-	388, 389, 390, 391, 392, 393, 268, 0, 0, 0,
-	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
-	0, 0, 321, 182, 0, 0, 0, 0, 319, 190,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	171, 174, 0, 0, 0, 183, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 0, 0, 183,
-	0, 0, 181, 0, 0, 0, 0, 184, 0, 0,
-	173, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 173, 0, 177, 0, 188, 189,
-	0, 0, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 182, 0, 0, 0, 0, 0,
-	190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 171, 174, 0, 0, 0, 0, 0, 0, 0,
-	291, 292, 0, 0, 0, 0, 0, 180, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 188,
-	189, 0, 0, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 328, 320, 278, 332, 280, 329, 330,
-	275, 276, 279, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 323, 265, 264, 0, 271, 272, 0,
-	281, 282, 284, 288, 289, 290, 293, 294, 295, 303,
-	305, 306, 304, 307, 308, 309, 312, 313, 314, 315,
-	310, 311, 316, 263, 0, 0, 0, 0, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 0, 0,
-	0, 0, 176, 190, 0, 0, 0, 0, 0, 0,
-	0, 182, 0, 0, 171, 174, 0, 190, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 171, 174,
-	180, 0, 0, 0, 0, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 180, 0, 0, 0, 0, 0,
-	181, 0, 0, 0, 0, 184, 0, 0, 0, 0,
-	0, 0, 188, 189, 0, 0, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 188, 189, 562, 554,
-	186, 0, 514, 564, 485, 501, 571, 504, 505, 535,
-	466, 524, 532, 499, 455, 488, 459, 496, 460, 486,
-	517, 0, 482, 555, 0, 0, 0, 170, 0, 0,
-	0, 0, 175, 168, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 0, 0, 0, 512, 516, 519, 529,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	451, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 461, 0, 0, 463, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 474, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 0, 169, 0, 0, 175, 168,
-	0, 0, 484, 0, 0, 0, 0, 487, 0, 0,
-	0, 490, 0, 0, 0, 0, 0, 0, 0, 172,
-	0, 0, 0, 0, 0, 0, 0, 0, 508, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 515, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 306, 307, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 169, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 537, 0,
-	0, 0, 0, 0, 0, 172, 0, 0, 0, 0,
-	0, 0, 185, 0, 0, 0, 0, 0, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 552, 187,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 557, 0, 0, 0, 0, 0, 179, 0,
-	0, 0, 0, 0, 0, 0, 566, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 185, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 343, 335, 293, 347, 295, 344,
+	345, 290, 291, 294, 346, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 338, 279, 278, 0, 286, 287,
+	997, 296, 297, 299, 303, 304, 305, 308, 309, 310,
+	318, 320, 321, 319, 322, 323, 324, 327, 328, 329,
+	330, 325, 326, 331, 277, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 0, 0, 0, 0, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	181, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 187, 0, 0, 0, 0,
-	0, 567, 0, 0, 0, 0, 0, 0, 0, 0,
-	520, 0, 522, 550, 513, 536, 477, 526, 0, 0,
-	533, 565, 0, 0, 0, 0, 0, 0, 495, 0,
-	0, 0, 0, 165, 166, 167, 1587, 0, 0, 345,
-	346, 0, 0, 0, 0, 1188, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 530,
-	0, 0, 0, 0, 0, 0, 0, 0, 569, 0,
-	0, 0, 0, 0, 0, 0, 563, 521, 0, 534,
-	0, 458, 528, 0, 465, 468, 570, 559, 491, 492,
-	0, 0, 0, 0, 0, 0, 0, 518, 523, 544,
-	509, 0, 0, 0, 0, 0, 1327, 0, 0, 165,
-	166, 167, 0, 0, 471, 0, 0, 0, 352, 353,
-	354, 355, 356, 357, 358, 359, 360, 361, 362, 363,
-	364, 365, 366, 367, 368, 369, 370, 371, 372, 373,
-	374, 375, 376, 377, 378, 379, 380, 381, 382, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	0, 0, 476, 456, 489, 547, 457, 0, 453, 0,
-	0, 0, 0, 0, 0, 556, 510, 568, 560, 507,
-	506, 539, 472, 0, 0, 0, 470, 0, 452, 467,
-	475, 500, 527, 543, 549, 0, 0, 497, 0, 493,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 0, 0, 561, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 0, 0, 0, 173, 454, 177, 498,
-	503, 511, 538, 541, 0, 0, 0, 0, 0, 0,
-	0, 0, 464, 0, 722, 473, 551, 553, 546, 0,
-	562, 554, 0, 0, 514, 564, 485, 501, 571, 504,
-	505, 535, 466, 524, 532, 499, 455, 488, 459, 496,
-	460, 486, 517, 0, 482, 555, 0, 183, 0, 170,
-	0, 0, 0, 0, 175, 168, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 0, 462, 0, 512, 516,
-	519, 529, 173, 0, 177, 0, 0, 0, 0, 502,
-	0, 0, 451, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 461, 0, 0, 463, 0, 0, 0,
-	0, 0, 0, 0, 0, 480, 481, 478, 0, 479,
-	474, 0, 0, 0, 0, 0, 0, 0, 926, 0,
-	0, 0, 0, 0, 0, 170, 0, 169, 0, 0,
-	175, 168, 0, 0, 484, 548, 0, 469, 0, 487,
-	0, 0, 0, 490, 0, 0, 0, 0, 0, 0,
-	0, 172, 0, 0, 0, 0, 0, 0, 0, 0,
-	508, 0, 0, 0, 0, 525, 0, 0, 0, 572,
-	540, 0, 573, 515, 0, 0, 0, 0, 0, 0,
-	0, 494, 0, 0, 176, 0, 0, 0, 0, 0,
-	0, 178, 531, 182, 0, 0, 542, 0, 0, 190,
-	0, 0, 0, 169, 179, 0, 0, 0, 0, 0,
-	171, 174, 0, 0, 0, 0, 0, 0, 0, 0,
-	537, 0, 0, 0, 0, 0, 180, 172, 0, 0,
-	0, 0, 181, 0, 185, 0, 0, 184, 0, 0,
-	545, 0, 0, 0, 0, 1140, 0, 0, 0, 0,
-	552, 187, 0, 0, 0, 0, 0, 0, 188, 189,
-	176, 0, 186, 0, 0, 0, 0, 178, 0, 182,
-	0, 0, 0, 0, 557, 190, 0, 0, 0, 0,
-	179, 0, 0, 0, 0, 0, 171, 174, 566, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 180, 0, 0, 0, 0, 0, 181, 0,
-	185, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 567, 188, 189, 0, 0, 186, 0,
-	0, 0, 520, 0, 522, 550, 513, 536, 477, 526,
-	0, 0, 533, 565, 0, 0, 0, 0, 0, 0,
-	495, 0, 87, 87, 0, 165, 166, 167, 0, 338,
-	345, 346, 347, 348, 349, 339, 341, 0, 0, 340,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 530, 343, 350, 351, 0, 0, 0, 0, 0,
-	569, 0, 0, 0, 0, 0, 0, 0, 563, 521,
-	0, 534, 0, 458, 528, 0, 465, 468, 570, 559,
-	491, 492, 0, 0, 0, 0, 0, 0, 0, 518,
-	523, 544, 509, 0, 0, 0, 0, 1918, 1919, 0,
-	0, 165, 166, 167, 0, 0, 471, 0, 0, 352,
-	353, 354, 355, 356, 357, 358, 359, 360, 361, 362,
-	363, 364, 365, 366, 367, 368, 369, 370, 371, 372,
-	373, 374, 375, 376, 377, 378, 379, 380, 381, 382,
-	383, 384, 385, 386, 387, 388, 389, 390, 391, 392,
-	393, 0, 0, 0, 476, 456, 489, 547, 457, 0,
-	453, 0, 0, 0, 0, 0, 0, 556, 510, 568,
-	560, 507, 506, 539, 472, 0, 0, 0, 470, 0,
-	452, 467, 475, 500, 527, 543, 549, 0, 0, 497,
-	0, 493, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 0, 0, 0, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 558, 0, 0, 0, 173, 454,
-	177, 498, 503, 511, 538, 541, 0, 0, 0, 0,
-	0, 0, 0, 0, 464, 0, 722, 473, 551, 553,
-	546, 0, 562, 554, 0, 0, 514, 564, 485, 501,
-	571, 504, 505, 535, 466, 524, 532, 499, 455, 488,
-	459, 496, 460, 486, 517, 0, 482, 555, 0, 183,
-	0, 170, 0, 0, 0, 0, 175, 168, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 0, 462, 0,
-	512, 516, 519, 529, 173, 0, 177, 0, 0, 0,
-	0, 502, 0, 0, 451, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 461, 0, 0, 463, 0,
-	0, 0, 0, 0, 0, 0, 0, 480, 481, 478,
-	0, 479, 474, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
-	0, 0, 0, 0, 0, 0, 484, 548, 0, 469,
-	0, 487, 0, 0, 0, 490, 0, 0, 0, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
-	0, 0, 508, 0, 0, 0, 0, 525, 0, 0,
-	0, 572, 540, 0, 573, 515, 0, 0, 0, 0,
-	0, 0, 0, 494, 0, 0, 176, 0, 0, 0,
-	0, 0, 0, 178, 531, 182, 0, 0, 542, 0,
-	0, 190, 0, 0, 0, 0, 179, 0, 0, 0,
-	0, 0, 171, 174, 0, 0, 0, 0, 0, 0,
-	0, 0, 537, 0, 0, 0, 0, 0, 180, 0,
-	0, 0, 0, 0, 181, 0, 185, 0, 0, 184,
-	0, 0, 545, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 552, 187, 0, 0, 0, 0, 0, 0,
-	188, 189, 176, 0, 186, 0, 0, 0, 0, 0,
-	0, 182, 0, 0, 0, 0, 557, 190, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 171, 174,
-	566, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 0, 0, 180, 0, 175, 168, 0, 0,
-	181, 0, 0, 0, 0, 184, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 567, 188, 189, 0, 0,
-	186, 0, 0, 0, 520, 0, 522, 550, 513, 536,
-	477, 526, 0, 0, 533, 565, 0, 0, 0, 0,
-	0, 0, 495, 0, 0, 0, 0, 165, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 0, 0, 0, 0, 0, 0,
-	0, 0, 569, 172, 0, 0, 0, 0, 0, 0,
-	563, 521, 0, 534, 0, 458, 528, 0, 465, 468,
-	570, 559, 491, 492, 0, 0, 0, 0, 0, 0,
-	0, 518, 523, 544, 509, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 471, 0,
+	0, 188, 0, 0, 0, 0, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 578,
+	570, 193, 0, 530, 580, 501, 517, 587, 520, 521,
+	551, 482, 540, 548, 515, 471, 504, 475, 512, 476,
+	502, 533, 0, 498, 571, 0, 0, 0, 177, 0,
+	0, 0, 0, 182, 175, 0, 0, 0, 0, 0,
+	0, 0, 499, 0, 0, 0, 0, 528, 532, 535,
+	545, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 467, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 477, 0, 0, 479, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 176, 0, 0, 182,
+	175, 0, 0, 500, 0, 0, 0, 0, 503, 0,
+	0, 0, 506, 0, 0, 0, 0, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 524,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 531, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 553,
+	1026, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 0, 0, 0, 561,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 568,
+	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 0, 0, 0,
+	0, 0, 0, 573, 0, 0, 0, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 0, 582, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 985, 0, 0, 0, 0, 192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 0, 0, 0,
+	0, 0, 583, 0, 0, 0, 0, 0, 0, 0,
+	0, 536, 0, 538, 566, 529, 552, 493, 542, 0,
+	0, 549, 581, 0, 0, 0, 0, 0, 0, 511,
+	0, 0, 0, 0, 172, 173, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 998, 0, 0,
+	0, 546, 0, 0, 0, 0, 0, 0, 0, 0,
+	585, 0, 0, 0, 0, 0, 0, 0, 579, 537,
+	0, 550, 0, 474, 544, 0, 481, 484, 586, 575,
+	507, 508, 0, 0, 0, 0, 0, 0, 0, 534,
+	539, 560, 525, 0, 0, 0, 0, 1057, 1957, 0,
+	172, 173, 174, 0, 0, 0, 0, 487, 0, 1012,
+	1015, 1016, 1017, 1018, 1019, 1020, 0, 1021, 1022, 1023,
+	1024, 1025, 999, 1000, 1001, 1002, 983, 984, 1013, 0,
+	986, 0, 987, 988, 989, 990, 991, 992, 993, 994,
+	995, 0, 0, 996, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1010, 0, 0, 0, 492, 472, 505, 563, 473,
+	0, 469, 0, 0, 0, 0, 0, 0, 572, 526,
+	584, 576, 523, 522, 555, 488, 0, 0, 0, 486,
+	0, 468, 483, 491, 516, 543, 559, 565, 0, 0,
+	513, 0, 509, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 0, 0, 577,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 574, 0, 0, 0, 180,
+	470, 184, 514, 519, 527, 554, 557, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 0, 745, 489, 567,
+	569, 562, 0, 578, 570, 0, 1014, 530, 580, 501,
+	517, 587, 520, 521, 551, 482, 540, 548, 515, 471,
+	504, 475, 512, 476, 502, 533, 0, 498, 571, 0,
+	190, 0, 177, 0, 0, 0, 0, 182, 175, 0,
+	0, 0, 0, 0, 0, 0, 499, 0, 0, 478,
+	0, 528, 532, 535, 545, 180, 0, 184, 0, 0,
+	0, 0, 518, 0, 0, 467, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 477, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 0, 0, 496, 497,
+	494, 0, 495, 490, 0, 0, 0, 0, 0, 0,
+	0, 953, 0, 0, 0, 0, 0, 0, 177, 0,
+	176, 0, 0, 182, 175, 0, 0, 500, 564, 0,
+	485, 0, 503, 0, 0, 0, 506, 0, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 524, 0, 0, 0, 0, 541, 0,
+	0, 0, 588, 556, 0, 589, 531, 0, 0, 0,
+	0, 0, 0, 0, 510, 0, 0, 183, 0, 0,
+	0, 0, 0, 0, 185, 547, 189, 0, 0, 558,
+	0, 0, 197, 0, 0, 0, 176, 186, 0, 0,
+	0, 0, 0, 178, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 553, 0, 0, 0, 0, 0, 187,
+	179, 0, 0, 0, 0, 188, 0, 192, 0, 0,
+	191, 0, 0, 561, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 568, 194, 0, 0, 0, 0, 0,
+	0, 195, 196, 183, 0, 193, 0, 0, 0, 0,
+	185, 0, 189, 0, 0, 0, 0, 573, 197, 0,
+	0, 0, 0, 186, 0, 0, 0, 0, 0, 178,
+	181, 582, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 0, 0, 0, 0,
+	0, 188, 0, 192, 0, 0, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	194, 0, 0, 0, 0, 0, 583, 195, 196, 0,
+	0, 193, 0, 0, 0, 536, 0, 538, 566, 529,
+	552, 493, 542, 0, 0, 549, 581, 0, 0, 0,
+	0, 0, 0, 511, 0, 0, 0, 0, 172, 173,
+	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 546, 0, 0, 0, 0,
+	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
+	0, 0, 579, 537, 0, 550, 0, 474, 544, 0,
+	481, 484, 586, 575, 507, 508, 0, 0, 0, 0,
+	0, 0, 0, 534, 539, 560, 525, 0, 0, 0,
+	0, 0, 1919, 0, 172, 173, 174, 0, 0, 0,
+	0, 487, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
+	472, 505, 563, 473, 0, 469, 0, 0, 0, 0,
+	0, 0, 572, 526, 584, 576, 523, 522, 555, 488,
+	0, 0, 0, 486, 0, 468, 483, 491, 516, 543,
+	559, 565, 0, 0, 513, 0, 509, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
+	0, 0, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 574,
+	0, 0, 0, 180, 470, 184, 514, 519, 527, 554,
+	557, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	0, 745, 489, 567, 569, 562, 0, 578, 570, 0,
+	0, 530, 580, 501, 517, 587, 520, 521, 551, 482,
+	540, 548, 515, 471, 504, 475, 512, 476, 502, 533,
+	0, 498, 571, 0, 190, 0, 177, 0, 0, 0,
+	0, 182, 175, 0, 0, 0, 0, 0, 0, 0,
+	499, 0, 0, 478, 0, 528, 532, 535, 545, 180,
+	0, 184, 0, 0, 0, 0, 518, 0, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	477, 0, 0, 479, 0, 0, 0, 0, 0, 0,
+	0, 0, 496, 497, 494, 0, 495, 490, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 176, 0, 0, 182, 175, 0,
+	0, 500, 564, 0, 485, 0, 503, 0, 0, 0,
+	506, 0, 0, 0, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 524, 0, 0,
+	0, 0, 541, 0, 0, 0, 588, 556, 0, 589,
+	531, 0, 0, 0, 0, 0, 0, 0, 510, 0,
+	0, 183, 0, 0, 0, 0, 0, 0, 185, 547,
+	189, 0, 0, 558, 0, 0, 197, 0, 0, 0,
+	176, 186, 0, 0, 0, 0, 0, 178, 181, 0,
+	0, 0, 0, 0, 0, 0, 0, 553, 0, 0,
+	0, 0, 0, 187, 179, 0, 0, 0, 0, 188,
+	0, 192, 0, 0, 191, 0, 0, 561, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 568, 194, 0,
+	0, 0, 0, 0, 0, 195, 196, 183, 0, 193,
+	0, 0, 0, 0, 185, 0, 189, 0, 0, 0,
+	0, 573, 197, 0, 0, 0, 0, 186, 0, 0,
+	0, 0, 0, 178, 181, 582, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
+	0, 0, 0, 0, 0, 188, 0, 192, 0, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 0, 0, 0, 0, 0,
+	583, 195, 196, 0, 0, 193, 0, 0, 0, 536,
+	0, 538, 566, 529, 552, 493, 542, 0, 0, 549,
+	581, 0, 0, 0, 0, 0, 0, 511, 0, 0,
+	0, 0, 172, 173, 174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 546,
+	0, 0, 0, 0, 0, 0, 0, 0, 585, 0,
+	0, 0, 0, 0, 0, 0, 579, 537, 0, 550,
+	0, 474, 544, 0, 481, 484, 586, 575, 507, 508,
+	0, 0, 0, 0, 0, 0, 0, 534, 539, 560,
+	525, 0, 0, 0, 0, 0, 1375, 0, 172, 173,
+	174, 0, 756, 0, 0, 487, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 492, 472, 505, 563, 473, 0, 469,
+	0, 0, 0, 0, 0, 0, 572, 526, 584, 576,
+	523, 522, 555, 488, 0, 0, 0, 486, 0, 468,
+	483, 491, 516, 543, 559, 565, 0, 0, 513, 0,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 0, 0, 0, 0, 577, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 574, 0, 0, 0, 180, 470, 184,
+	514, 519, 527, 554, 557, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 0, 745, 489, 567, 569, 562,
+	0, 578, 570, 0, 0, 530, 580, 501, 517, 587,
+	520, 521, 551, 482, 540, 548, 515, 471, 504, 475,
+	512, 476, 502, 533, 0, 498, 571, 0, 190, 0,
+	177, 0, 0, 0, 0, 182, 175, 0, 0, 0,
+	0, 0, 0, 0, 499, 0, 0, 478, 0, 528,
+	532, 535, 545, 180, 0, 184, 0, 0, 0, 0,
+	518, 0, 0, 467, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 477, 0, 0, 479, 0, 0,
+	0, 0, 0, 0, 0, 0, 496, 497, 494, 0,
+	495, 490, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 176, 0,
+	0, 182, 175, 0, 0, 500, 564, 0, 485, 0,
+	503, 0, 0, 0, 506, 0, 0, 0, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 524, 0, 0, 0, 0, 541, 0, 0, 0,
+	588, 556, 0, 589, 531, 0, 0, 0, 0, 0,
+	0, 0, 510, 0, 0, 183, 0, 0, 0, 0,
+	0, 0, 185, 547, 189, 0, 0, 558, 0, 0,
+	197, 0, 0, 0, 176, 186, 0, 0, 0, 0,
+	0, 178, 181, 0, 0, 0, 0, 0, 0, 0,
+	0, 553, 0, 0, 0, 0, 0, 187, 179, 0,
+	0, 0, 0, 188, 0, 192, 0, 0, 191, 0,
+	0, 561, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 568, 194, 0, 0, 0, 0, 0, 0, 195,
+	196, 183, 0, 193, 0, 0, 0, 0, 185, 0,
+	189, 0, 0, 0, 0, 573, 197, 0, 0, 0,
+	0, 186, 0, 0, 0, 0, 0, 178, 181, 582,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 0, 0, 0, 0, 0, 188,
+	0, 192, 0, 0, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 0,
+	0, 0, 0, 0, 583, 195, 196, 0, 0, 193,
+	0, 0, 0, 536, 0, 538, 566, 529, 552, 493,
+	542, 0, 0, 549, 581, 0, 0, 0, 0, 0,
+	0, 511, 0, 91, 0, 0, 172, 173, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 546, 0, 0, 0, 0, 0, 0,
+	0, 0, 585, 0, 0, 0, 0, 0, 0, 0,
+	579, 537, 0, 550, 0, 474, 544, 0, 481, 484,
+	586, 575, 507, 508, 0, 0, 0, 0, 0, 0,
+	0, 534, 539, 560, 525, 0, 0, 0, 0, 0,
+	0, 0, 172, 173, 174, 0, 0, 0, 0, 487,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 492, 472, 505,
+	563, 473, 0, 469, 0, 0, 0, 0, 0, 0,
+	572, 526, 584, 576, 523, 522, 555, 488, 0, 0,
+	0, 486, 0, 468, 483, 491, 516, 543, 559, 565,
+	0, 0, 513, 0, 509, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 0, 0,
+	0, 577, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 574, 0, 0,
+	0, 180, 470, 184, 514, 519, 527, 554, 557, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 0, 745,
+	489, 567, 569, 562, 0, 578, 570, 0, 0, 530,
+	580, 501, 517, 587, 520, 521, 551, 482, 540, 548,
+	515, 471, 504, 475, 512, 476, 502, 533, 0, 498,
+	571, 0, 190, 0, 177, 0, 0, 0, 0, 182,
+	175, 0, 0, 0, 0, 0, 0, 0, 499, 0,
+	0, 478, 0, 528, 532, 535, 545, 180, 0, 184,
+	0, 0, 0, 0, 518, 0, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 477, 0,
+	0, 479, 0, 0, 0, 0, 0, 0, 0, 0,
+	496, 497, 494, 0, 495, 490, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 500,
+	564, 0, 485, 0, 503, 0, 0, 0, 506, 0,
 	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 524, 0, 0, 0, 0,
+	541, 0, 0, 0, 588, 556, 0, 589, 531, 0,
+	0, 0, 0, 0, 0, 0, 510, 0, 0, 183,
+	0, 0, 0, 0, 0, 0, 185, 547, 189, 0,
+	0, 558, 0, 0, 197, 0, 0, 0, 0, 186,
+	0, 0, 0, 0, 0, 178, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 553, 0, 0, 0, 0,
+	0, 187, 0, 0, 0, 0, 0, 188, 0, 192,
+	0, 0, 191, 0, 0, 561, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 568, 194, 0, 0, 0,
+	0, 0, 0, 195, 196, 183, 0, 193, 0, 0,
+	0, 0, 0, 0, 189, 0, 0, 430, 0, 573,
+	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 181, 582, 0, 0, 0, 177, 0, 0,
+	0, 0, 182, 175, 0, 0, 0, 187, 0, 0,
+	0, 0, 0, 188, 0, 0, 0, 0, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 583, 195,
+	196, 0, 0, 193, 0, 0, 0, 536, 0, 538,
+	566, 529, 552, 493, 542, 0, 0, 549, 581, 0,
+	0, 0, 0, 0, 0, 511, 0, 0, 0, 0,
+	172, 173, 174, 0, 0, 176, 0, 0, 0, 0,
+	0, 0, 177, 0, 0, 0, 0, 182, 175, 0,
+	0, 0, 0, 0, 0, 0, 0, 546, 0, 179,
+	0, 0, 0, 0, 0, 0, 585, 0, 0, 0,
+	0, 0, 0, 0, 579, 537, 0, 550, 0, 474,
+	544, 0, 481, 484, 586, 575, 507, 508, 0, 0,
+	0, 0, 0, 0, 0, 534, 539, 560, 525, 185,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 186, 487, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 194,
+	0, 492, 472, 505, 563, 473, 0, 469, 0, 0,
+	0, 0, 0, 0, 572, 526, 584, 576, 523, 522,
+	555, 488, 0, 0, 185, 486, 0, 468, 483, 491,
+	516, 543, 559, 565, 0, 0, 513, 186, 509, 0,
+	0, 276, 0, 0, 0, 0, 281, 0, 0, 0,
+	190, 177, 0, 0, 0, 577, 182, 175, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 574, 0, 0, 0, 180, 470, 184, 514, 519,
+	527, 554, 557, 0, 194, 0, 0, 0, 0, 0,
+	0, 480, 0, 745, 489, 567, 569, 562, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 173, 174, 0, 0, 0, 177,
+	0, 0, 0, 0, 182, 175, 0, 0, 0, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 478, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 0, 0, 0, 518, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 496, 497, 494, 0, 495, 0,
+	0, 0, 0, 185, 0, 0, 0, 176, 172, 173,
+	174, 0, 0, 0, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 0, 564, 0, 485, 0, 0, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 0, 0, 541, 0, 0, 0, 588, 556,
+	0, 589, 0, 194, 0, 0, 0, 200, 0, 0,
+	510, 185, 0, 183, 0, 0, 0, 0, 0, 0,
+	0, 547, 189, 0, 186, 558, 0, 0, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	181, 0, 0, 190, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 187, 0, 0, 0, 0,
+	0, 188, 0, 0, 0, 0, 191, 0, 180, 0,
+	184, 194, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 0, 195, 196, 0,
+	0, 193, 0, 0, 0, 0, 284, 285, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 91, 0, 0, 172, 173, 174,
+	353, 360, 361, 362, 363, 364, 354, 356, 190, 0,
+	355, 0, 0, 300, 302, 301, 311, 312, 313, 314,
+	315, 316, 317, 298, 358, 365, 366, 0, 0, 0,
+	0, 0, 0, 180, 0, 184, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 273, 0,
+	288, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 2086, 174, 0, 270,
+	271, 177, 0, 0, 0, 337, 182, 175, 272, 0,
+	0, 280, 367, 368, 369, 370, 371, 372, 373, 374,
+	375, 376, 377, 378, 379, 380, 381, 382, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 392, 393, 394,
+	395, 396, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 408, 282, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
+	336, 0, 0, 0, 0, 189, 334, 0, 0, 176,
+	0, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 181, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 0, 0, 190, 187, 0,
+	0, 0, 0, 0, 188, 0, 0, 0, 0, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 184, 0, 0, 0, 0, 283,
+	195, 196, 0, 185, 193, 0, 0, 0, 0, 0,
+	0, 183, 0, 0, 0, 0, 186, 0, 0, 0,
+	189, 0, 0, 0, 0, 0, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 0, 178, 181, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 306, 307,
+	0, 0, 0, 187, 0, 0, 0, 0, 0, 188,
+	180, 0, 184, 194, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 0, 0, 193,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 343, 335, 293, 347, 295, 344, 345, 290, 291,
+	294, 346, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 338, 279, 278, 0, 286, 287, 0, 296, 297,
+	299, 303, 304, 305, 308, 309, 310, 318, 320, 321,
+	319, 322, 323, 324, 327, 328, 329, 330, 325, 326,
+	331, 277, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 1660, 174,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 0, 0, 0, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 0, 0, 0, 188, 0,
+	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
+	0, 0, 0, 0, 195, 196, 0, 189, 193, 0,
+	0, 0, 0, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 181, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 0, 0, 0, 0, 0, 188, 0, 0, 0,
+	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 476, 456, 489, 547,
-	457, 0, 453, 187, 0, 0, 0, 0, 0, 556,
-	510, 568, 560, 507, 506, 539, 472, 0, 0, 0,
-	470, 0, 452, 467, 475, 500, 527, 543, 549, 0,
-	0, 497, 0, 493, 0, 0, 262, 0, 0, 0,
-	0, 267, 0, 0, 0, 183, 170, 0, 0, 0,
-	561, 175, 168, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 558, 0, 0, 0,
-	173, 454, 177, 498, 503, 511, 538, 541, 0, 0,
-	0, 0, 0, 0, 0, 0, 464, 0, 722, 473,
-	551, 553, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 165, 166, 167,
-	0, 732, 0, 0, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	462, 0, 0, 0, 0, 0, 0, 0, 172, 0,
-	0, 0, 0, 502, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
-	481, 478, 0, 479, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 179, 0, 0, 0, 0, 0, 0, 0, 548,
-	0, 469, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 185, 0, 0, 0, 0, 0, 0, 0, 525,
-	0, 0, 0, 572, 540, 0, 573, 0, 187, 0,
-	0, 0, 0, 0, 0, 494, 0, 0, 176, 0,
-	0, 0, 0, 0, 0, 0, 531, 182, 0, 0,
-	542, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 0, 0, 0, 0,
-	180, 0, 0, 0, 0, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	173, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 188, 189, 0, 0, 186, 0, 0, 0,
-	0, 269, 270, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
-	0, 0, 165, 166, 167, 338, 345, 346, 347, 348,
-	349, 339, 341, 0, 0, 340, 0, 285, 287, 286,
-	296, 297, 298, 299, 300, 301, 302, 283, 343, 350,
-	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 259, 0, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 257, 0, 0, 0, 0, 322,
-	0, 258, 0, 0, 266, 352, 353, 354, 355, 356,
-	357, 358, 359, 360, 361, 362, 363, 364, 365, 366,
-	367, 368, 369, 370, 371, 372, 373, 374, 375, 376,
-	377, 378, 379, 380, 381, 382, 383, 384, 385, 386,
-	387, 388, 389, 390, 391, 392, 393, 268, 0, 0,
+	0, 0, 195, 196, 0, 0, 193, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 321, 0, 0, 0, 0, 176, 319,
-	0, 0, 0, 0, 0, 0, 0, 182, 0, 0,
-	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 0, 0, 0,
-	183, 0, 262, 0, 0, 0, 0, 267, 0, 0,
-	180, 0, 170, 0, 0, 0, 181, 175, 168, 0,
-	0, 184, 0, 0, 0, 173, 0, 177, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 188, 189, 0, 0, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 190, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 180, 0, 184, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 291, 292, 0, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 328, 320, 278, 332, 280, 329,
-	330, 275, 276, 279, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 323, 265, 264, 179, 271, 272,
-	0, 281, 282, 284, 288, 289, 290, 293, 294, 295,
-	303, 305, 306, 304, 307, 308, 309, 312, 313, 314,
-	315, 310, 311, 316, 263, 0, 0, 185, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 176, 187, 0, 0, 0, 0, 0,
-	0, 0, 182, 0, 0, 0, 0, 0, 190, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
-	174, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 180, 0, 0, 0, 0,
-	0, 181, 0, 0, 0, 0, 184, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 189, 0,
-	0, 186, 0, 0, 0, 0, 274, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 270, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 87, 0, 0, 165, 166,
-	167, 338, 345, 346, 347, 348, 349, 339, 341, 0,
-	0, 340, 0, 285, 287, 286, 296, 297, 298, 299,
-	300, 301, 302, 283, 343, 350, 351, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 259, 0,
-	273, 0, 0, 0, 0, 0, 0, 170, 0, 0,
-	0, 0, 175, 168, 0, 0, 0, 0, 0, 256,
-	257, 0, 0, 0, 0, 322, 0, 258, 0, 0,
-	266, 352, 353, 354, 355, 356, 357, 358, 359, 360,
-	361, 362, 363, 364, 365, 366, 367, 368, 369, 370,
-	371, 372, 373, 374, 375, 376, 377, 378, 379, 380,
-	381, 382, 383, 384, 385, 386, 387, 388, 389, 390,
-	391, 392, 393, 268, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 169, 170, 0, 0, 321,
-	0, 175, 168, 0, 170, 319, 0, 0, 0, 175,
-	168, 0, 0, 0, 0, 0, 0, 0, 0, 172,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 173, 0, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 179, 0, 169, 0, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
-	0, 0, 185, 0, 0, 0, 172, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 291, 292, 187,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 179, 0, 0, 0, 0, 0, 0, 0, 179,
-	0, 170, 0, 0, 0, 0, 175, 168, 0, 0,
-	328, 320, 278, 332, 280, 329, 330, 275, 276, 279,
-	331, 185, 0, 0, 0, 0, 0, 0, 0, 185,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	323, 265, 264, 0, 271, 272, 187, 281, 282, 284,
-	288, 289, 290, 293, 294, 295, 303, 305, 306, 304,
-	307, 308, 309, 312, 313, 314, 315, 310, 311, 316,
-	263, 170, 0, 165, 166, 167, 175, 168, 0, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
-	0, 0, 0, 172, 190, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 171, 174, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 180, 0, 0, 0, 0, 0, 181, 0, 0,
-	0, 0, 184, 178, 0, 0, 0, 0, 0, 169,
-	0, 0, 165, 166, 167, 0, 179, 0, 0, 0,
-	165, 166, 167, 188, 189, 0, 0, 186, 0, 0,
-	0, 0, 0, 172, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 185, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 0, 0, 185, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 173, 0, 177, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 193, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 165, 1994, 167,
-	0, 0, 338, 345, 346, 347, 348, 349, 339, 341,
-	183, 0, 340, 0, 0, 0, 0, 0, 183, 0,
-	0, 0, 0, 0, 0, 343, 350, 351, 0, 0,
-	0, 0, 0, 0, 0, 173, 0, 177, 0, 0,
-	0, 0, 0, 173, 0, 177, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 165, 1589, 167,
-	1918, 1919, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 352, 353, 354, 355, 356, 357, 358, 359,
-	360, 361, 362, 363, 364, 365, 366, 367, 368, 369,
-	370, 371, 372, 373, 374, 375, 376, 377, 378, 379,
-	380, 381, 382, 383, 384, 385, 386, 387, 388, 389,
-	390, 391, 392, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 182, 0, 0, 414, 0, 0, 190,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	171, 174, 0, 0, 0, 183, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 180, 0, 0, 0,
-	0, 0, 181, 0, 0, 0, 0, 184, 0, 0,
-	173, 0, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 188, 189,
-	0, 0, 186, 176, 0, 0, 0, 0, 0, 0,
-	0, 176, 182, 0, 0, 0, 0, 0, 190, 0,
-	182, 0, 0, 0, 0, 183, 190, 345, 346, 171,
-	174, 0, 0, 1188, 0, 0, 0, 171, 174, 0,
-	0, 0, 0, 0, 0, 180, 0, 0, 0, 0,
-	173, 181, 177, 180, 0, 0, 184, 0, 0, 181,
-	0, 0, 0, 0, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 189, 0,
-	0, 186, 0, 0, 0, 188, 189, 0, 0, 186,
-	0, 328, 0, 0, 332, 0, 329, 330, 0, 0,
-	0, 331, 0, 0, 0, 0, 352, 353, 354, 355,
-	356, 357, 358, 359, 360, 361, 362, 363, 364, 365,
-	366, 367, 368, 369, 370, 371, 372, 373, 374, 375,
-	376, 377, 378, 379, 380, 381, 382, 383, 384, 385,
-	386, 387, 388, 389, 390, 391, 392, 393, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 182, 0, 0,
-	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 0, 0, 0, 0, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 176, 0,
-	0, 0, 188, 189, 0, 0, 186, 182, 0, 0,
-	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 171, 174, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	180, 0, 0, 0, 0, 0, 181, 0, 0, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 188, 189, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 0, 0, 0, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 0, 0, 0, 0, 0, 188, 0,
+	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 0, 0, 193,
 }
 
 var sqlite3Pact = [...]int{
-	8974, -1000, -714, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	7898, -1000, -705, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1509, 1753, -1000, 1388,
-	-1000, -1000, -1000, 1730, -1000, 247, 911, -1000, 1471, 2900,
-	-1000, 16758, -1, -1000, 16750, -5, 2809, 16758, -1000, -215,
-	-1000, -228, 16758, -219, 5110, -1000, -1000, -651, 15790, 1270,
-	-330, -334, 16758, -1000, -1000, -1000, 1633, 1079, -1000, 224,
-	-1000, -1000, -1000, -1000, -1000, -1000, 16671, -1000, -1000, -1000,
-	-1000, -1000, 1732, 156, 16758, -1000, 1576, 1079, -1000, 15790,
-	1618, 1597, -1000, 13339, -1000, 13339, -41, -1000, -1000, 7911,
-	-1000, -1000, 5339, 16758, 11865, -91, -1000, 1471, -1000, -1000,
-	-1000, -235, -1000, -1000, 743, 740, -1000, -1000, 477, -3,
-	-24, -79, -79, -25, 1396, 1392, 303, 302, 94, -1000,
-	603, 191, 191, -531, -536, -1000, -52, 191, 191, 191,
-	-163, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	-64, 1471, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1803, 1895, -1000,
+	1585, -1000, -1000, -1000, 1887, -1000, 230, 990, 1771, -1000,
+	1672, 3762, -1000, 17526, -12, -1000, 17441, -14, -109, 17526,
+	1267, -224, -225, -225, -239, 17526, -236, 4077, -1000, -1000,
+	-647, 14088, 1439, -347, -349, 17526, -1000, -1000, -1000, 17526,
+	1857, 1182, -1000, 233, -1000, -1000, -1000, -1000, -1000, -1000,
+	16700, -1000, -1000, -1000, -1000, -1000, 1892, 103, 17526, -1000,
+	1799, 1182, -1000, 14088, 1839, 1786, -1000, 13580, -1000, 13580,
+	-49, -1000, -1000, 8926, -1000, -1000, 5664, 17526, 6471, 196,
+	1417, 50, -1000, 1672, -1000, -1000, -1000, -235, -1000, -1000,
+	829, 795, -1000, -1000, 577, 248, -60, -71, -71, -31,
+	1612, 1609, 288, 287, 414, -1000, 495, 138, 138, -512,
+	-145, -1000, -1000, -25, 138, 138, 138, -164, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, -79, 1672, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 16758, -202, 16758, -1000, 64, 16758, 602, 602, -292,
-	602, 602, 602, -214, 371, -335, -1000, -222, -169, -227,
-	-167, 572, -225, -1000, -221, -1000, 14723, -192, 14723, -1000,
-	1452, -1000, -1000, -1000, -1000, 427, -1000, -28, -1000, -1000,
-	-1000, -1000, 16758, 15545, 265, 220, -1000, -1000, -1000, -1000,
-	-277, -1000, -1000, 485, 842, 15790, 705, -1000, 1881, 115,
-	-1000, -1000, -1000, -1000, -1000, 17, 16296, 16296, 16296, 16296,
-	-1000, -1000, 499, 499, 499, 499, 16296, 499, 16296, 499,
-	499, 499, 499, 15790, 499, 499, 499, -1000, 499, 499,
-	499, 499, 499, 499, 499, 499, 499, 499, 499, 499,
-	499, 499, 499, 499, 499, 499, 499, 499, 499, 499,
-	499, 499, 499, 499, 499, 499, 499, 499, 499, 499,
-	499, 499, 499, 499, 499, 499, 499, 499, 63, 499,
-	499, 499, 499, 499, -1000, -1000, -1000, -1000, 499, 499,
-	499, 499, 499, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 4449, -1000, 10725, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 17526, 20,
+	17526, -1000, 27, 17526, 510, 510, -329, 510, 510, 510,
+	-226, 394, -350, -1000, -230, -160, -248, -176, 519, -151,
+	-1000, -246, -1000, 16624, -180, 16624, -1000, 1645, -1000, -1000,
+	-1000, -1000, 453, -225, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -35, -1000, -1000, -1000, -1000, 17526, 16126, 314, 171,
+	-1000, -1000, -1000, -1000, -277, -1000, -1000, 548, 698, 14088,
+	1175, -1000, 1146, 87, -1000, -1000, -1000, -1000, -1000, 13,
+	17695, 17695, 17695, 17695, -1000, -1000, 578, 578, 578, 578,
+	17695, 578, 17695, 578, 578, 578, 578, 578, 14088, 578,
+	578, 578, -1000, 578, 578, 578, 578, 578, 578, 578,
+	578, 578, 578, 578, 578, 578, 578, 578, 578, 578,
+	578, 578, 578, 578, 578, 578, 578, 578, 578, 578,
+	578, 578, 578, 578, 578, 578, 578, 578, 578, 578,
+	578, 578, 578, 26, 578, 578, 578, 578, 578, -1000,
+	-1000, -1000, -1000, 578, 578, 578, 578, 578, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3934, -1000, 11761,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 16758, -1000, 499, 16758, 16758,
-	-148, 1576, 1079, -1000, 1633, 1625, 224, -1000, 1728, 1319,
-	895, 430, 1079, 599, 16758, -1000, 754, -1000, 1037, -1000,
-	-1000, -1000, -1000, -1000, 865, 15790, -1000, -1000, 1241, 1685,
-	-1000, 4420, 62, 1386, 1684, 14799, -1000, -41, -41, 691,
-	7099, -349, -1000, -1000, -1000, 213, 8148, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	17526, -1000, 578, 17526, 17526, -138, 226, 1799, 1182, -1000,
+	1857, 1856, 233, -1000, 1910, 1336, 1078, 419, 1182, 766,
+	17526, -1000, 843, -1000, 1189, -1000, -1000, -1000, -1000, -1000,
+	792, 14088, -1000, -1000, 1344, 1880, -1000, 2616, 25, 1584,
+	1879, 15552, -1000, -49, -49, 783, 8112, -375, -1000, -1000,
+	-1000, 170, 6074, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3709,217 +3826,226 @@ var sqlite3Pact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 873, 16758, -1000, -1000, 6788, 449,
-	-1000, 902, -1000, 868, -1000, 848, 15790, 920, -6, 449,
-	-8, -9, -10, -1000, -438, -1000, -1000, -1000, -1000, -1000,
-	-1000, 191, 191, 191, 2503, 2900, -1000, -1000, -1000, -1000,
-	13325, 449, -1000, 901, -1000, 275, 60, 84, 84, 449,
-	-1000, -1000, 16758, 449, 449, 16758, 16758, -50, -1000, -1000,
-	-1000, -1000, 16758, 449, 274, 269, 449, 449, 16758, 449,
-	-1000, -1000, 1276, -1000, 1047, -1000, -1000, 191, 191, 402,
-	392, 391, 191, 388, -152, 1489, 387, 134, 1480, 1474,
-	16758, 900, 16758, -1000, -177, 162, 212, 1471, 674, -30,
-	-12, 449, -55, -55, 16758, -1000, 15295, -1000, -1000, 386,
-	15790, -1000, 583, 572, 572, -1000, -1000, -1000, -1000, -1000,
-	602, 16758, 583, -1000, -1000, -1000, 572, 602, 16758, 602,
-	602, 602, 602, 572, 572, 572, 602, 16758, 16758, 16758,
-	16758, 16758, 16758, 16758, 16758, 16758, 14723, -1000, 1271, -1000,
-	-1000, -1000, -1000, 14723, 14723, -1000, -1000, -1000, -224, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -433, 640,
-	14227, -1000, -655, -656, -657, -1000, -1000, -1000, -658, -662,
-	-1000, -1000, -1000, 15790, 15790, 15790, -562, -1000, 289, 16296,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 357, 174, 16296,
-	16296, 16296, 1345, 16296, 16296, 16296, 16296, 16296, 16296, 16296,
-	16296, 16296, 16296, 16296, 16296, 6522, 17161, 17161, 115, 115,
-	115, 115, -1000, 13339, 15790, 15790, 115, -1000, 449, 13172,
-	13339, 13339, 15790, 1401, 227, 842, 16758, -1000, 430, -1000,
-	-1000, -1000, 376, -1000, 16758, 16758, -157, 15790, 15790, 15790,
-	15790, 15790, 15790, 8912, 15790, 15790, 15790, 15790, 15790, 15790,
-	15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790,
-	15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790, 15790,
-	8406, 15295, 13339, 13339, 13339, 13339, 13339, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 61,
-	1280, 834, 557, -1000, 637, -1000, -489, 5646, 15790, 1683,
-	938, 16758, -1000, -1000, -1000, 1576, -1000, 1576, 1280, 1329,
-	1049, 13339, -1000, -1000, 1329, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 430, -1000, 16758, 599, 1551, 16758, 1044,
-	15790, 15790, 594, -1000, 670, -2, -1000, 16758, -1000, -1000,
-	13081, -1000, -1000, 14151, 16758, 223, 16758, -1000, 5172, 12416,
-	6287, -349, -1000, 6287, 552, -1000, -345, -362, 10219, 113,
-	-1000, -1000, -1000, 477, 4631, 468, 113, -305, -1000, -1000,
-	-1000, 848, -1000, 848, 848, 848, 848, -14, -14, -14,
-	-14, -1000, -1000, -1000, -1000, -1000, 892, 886, -1000, 848,
-	848, 848, 848, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -96, -1000, 15790, -158, 11865,
-	1533, 346, 809, -177, -49, 937, 449, 449, 449, -49,
-	-1000, 753, 498, 416, 1617, -1000, -1000, 591, -1000, 16758,
-	-201, -1000, -62, -1000, 11865, 449, 262, 84, 449, -1000,
-	449, -1000, -1000, 463, -199, -595, 16758, -201, 6522, -1000,
-	-1000, -1000, 449, -1000, -1000, -1000, -33, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 6522, 6522, -1000, -1000, -1000,
-	383, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -705, 16758, -1000,
-	-185, 204, -150, -102, -116, 16758, -165, 16758, 16758, -55,
-	990, 16758, 1536, 16758, -1000, -1000, -1000, -1000, -1000, 842,
-	16758, -1000, -1000, 602, 602, -1000, -1000, 16758, 602, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 602, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16758,
-	-1000, -1000, -1000, -1000, 16758, -1000, -1000, -1000, -1000, -267,
-	-352, 187, -1000, -1000, -1000, -1000, 1564, -1000, 842, 241,
-	-1000, 499, -1000, -1000, 315, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 357, 16296, 16296, 16296, 1948, -44, 976, 357,
-	2071, 1798, 255, 255, 225, 225, 120, 120, 120, 120,
-	120, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 380,
-	-1000, 761, -1000, 769, 682, 430, -1000, 761, 761, 858,
-	13339, -1000, -1000, 226, -1000, 15790, 430, -1000, -1000, 430,
-	582, 576, 1678, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1026, 17526, -83, -1000, 14758, 570, -1000, 981, -1000, 1016,
+	-1000, 163, 1708, 14088, 24, -220, 14088, 1295, -18, 900,
+	-107, -24, -1000, -444, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 138, 138, 138, 3180, 3762, -1000, 450, -1000,
+	-1000, -1000, 14978, 570, -1000, 964, -1000, 276, 48, 75,
+	75, 570, -1000, -1000, 17526, 570, 570, 17526, 17526, -63,
+	-1000, -1000, -1000, -1000, 17526, 570, 270, 268, 570, 570,
+	17526, 570, -1000, -1000, 1347, -1000, 1249, -1000, -1000, -1000,
+	138, 138, 436, 433, 432, 138, 421, -158, 1696, 420,
+	136, 1680, 1673, 17526, 960, 17526, -1000, -172, 152, 160,
+	1672, 781, -41, -20, 570, -62, -62, 17526, -1000, 17198,
+	-1000, -1000, 418, 14088, -1000, 580, 519, 519, -1000, -1000,
+	-1000, -1000, -1000, 510, 17526, 580, -1000, -1000, -1000, 519,
+	510, 17526, 510, 510, 510, 510, 519, 519, 519, 510,
+	17526, 17526, 17526, 17526, 17526, 17526, 17526, 17526, 17526, 16624,
+	-1000, 1402, -1000, -1000, -1000, -1000, 16624, 16624, -1000, -1000,
+	-1000, -1000, -244, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -429, 779, 12903, -1000, -651, -652, -653, -1000,
+	-1000, -1000, -660, -662, -1000, -1000, -1000, 14088, 14088, 14088,
+	-555, -1000, 291, 17695, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 350, 220, 17695, 17695, 17695, 17695, 17695, 17695, 17695,
+	17695, 17695, 17695, 17695, 17695, 17695, 17695, 17695, 17695, 5863,
+	11939, 11939, 87, 87, 87, 87, -1000, 13580, 14088, 14088,
+	87, -1000, 570, 12827, 304, 13580, 13580, 14088, 1604, 195,
+	698, 17526, -1000, 419, -1000, -1000, -1000, 395, -1000, 17526,
+	17526, 1730, 14088, 14088, 14088, 14088, 14088, 14088, 10745, 14088,
+	14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088,
+	14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088, 14088,
+	14088, 14088, 14088, 14088, 14088, 10237, 17198, 13580, 13580, 13580,
+	13580, 13580, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 23, 859, 1004, 799, -1000, 769,
+	-1000, -484, 5239, 14088, 1878, 1046, 17526, 9740, 9740, -1000,
+	-1000, -1000, 1799, -1000, 1799, 859, 1867, 1192, 13580, -1000,
+	-1000, 1867, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	419, -1000, 17526, 766, 1749, 17526, 1185, 14088, 14088, 760,
+	-1000, 1277, -8, -1000, 17526, -1000, -1000, 10068, -1000, -1000,
+	16050, 17526, 277, 17526, -1000, 2886, 9992, 7298, -375, -1000,
+	7298, 673, -1000, -366, -381, 11253, 74, -1000, -1000, -1000,
+	577, 1894, 494, -1000, 74, 1103, -306, -1000, -1000, -1000,
+	-1000, 914, -1000, 914, 914, 914, 914, 5, 5, 5,
+	5, -1000, -1000, -1000, -1000, -1000, 953, 947, -1000, 914,
+	914, 914, 914, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 449, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -82, -1000, 14088,
+	1855, 6471, 1708, 541, -1000, -1000, -1000, 814, 17198, 314,
+	698, -1000, -56, -172, -56, -56, -56, -56, -1000, 516,
+	482, 427, 1823, -1000, -1000, 751, -1000, 17526, -201, -1000,
+	-94, -1000, 6471, 570, 267, 75, 570, -1000, 570, -1000,
+	-1000, 489, -209, -592, 17526, -201, 5863, -1000, -1000, -1000,
+	570, -1000, -1000, -1000, -44, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 5863, 5863, -1000, -1000, -1000, 417, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 842, 809, 809, 809, 809, 809,
-	15790, 830, -1000, -1000, -1000, 1464, 1429, 430, 485, 430,
-	569, -1000, 1374, 809, 1366, 1310, 1291, 872, 1213, 1207,
-	1174, 809, 809, 809, 803, 1168, 1141, 1132, 1129, 1119,
-	1106, 1087, 1080, 1060, 809, 430, 13339, 13339, 561, 499,
-	39, -1000, 761, 430, 430, 761, 761, 15295, -1000, 16758,
-	-1000, -647, -1000, -392, 128, -1000, 11774, -1000, -1000, 430,
-	14153, -1000, 16985, -1000, -1000, -1000, -1000, -1000, 11109, 860,
-	1329, -1000, -1000, 499, 820, -1000, -1000, 842, 842, 15790,
-	-1000, -1000, -1000, 165, 449, -1000, -1000, -1000, 33, 1650,
-	988, 16758, 499, -1000, 4953, -1000, 829, 1669, -1000, -1000,
-	-1000, 1550, 2823, -1000, 499, 499, 16758, 996, 965, -1000,
-	32, -1000, 552, -349, -365, -1000, -1000, -1000, -1000, 842,
-	-1000, 411, -1000, 390, -1000, -56, -1000, -1000, -1000, -1000,
-	1373, -309, -1000, -1000, -1000, -164, -14, -1000, -1000, -1000,
-	7310, 104, 104, -276, -1000, -1000, -1000, 379, 379, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 883,
-	1214, -158, -1000, -1000, 374, -1000, -1000, 1380, 16758, -1000,
-	-1000, 461, 1533, -185, 16758, 234, 982, -1000, 937, 937,
-	937, 16758, -1000, -1000, -1000, -1000, -1000, -1000, 16758, 1979,
-	811, -1000, -195, -1000, -1000, 796, 878, 449, 449, -1000,
-	-1000, 1458, 157, -1000, -1000, -580, -1000, -1000, -201, -195,
-	113, -1000, 449, -1000, -1000, -1000, -1000, -708, 792, -13,
-	-189, -89, 16758, 16758, 16758, 16758, 16758, 29, -1000, -1000,
-	-1000, -35, -1000, 16758, 16758, 102, -1000, -1000, -1000, 572,
-	-1000, -1000, 572, -1000, -1000, -1000, -1000, -1000, 1448, 16758,
-	-357, -683, -1000, -680, 15790, -1000, -1000, -1000, -1000, 766,
-	-45, 976, 16296, 16296, -1000, 13339, -477, 665, 665, 6522,
-	-1000, -1000, -1000, 15790, 15790, 788, 222, -1000, 15790, 669,
-	-1000, -1000, 15790, 15790, 15790, 430, -1000, -1000, -1000, -1000,
-	-1000, 1662, -1000, 15790, 15790, 15790, -1000, -1000, 15790, 15790,
-	-1000, 15790, 15790, 3050, 430, 3050, 15790, 15790, 3050, -1000,
-	-1000, -1000, -1000, 3050, 15790, 15790, 15790, 15790, 15790, 15790,
-	15790, 15790, 15790, -1000, -1000, 761, 761, 13339, 15295, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 128,
-	-1000, 84, 84, 84, -1000, -1000, -1000, -1000, -1000, -1000,
-	545, 1567, -1000, 1059, 1650, -1000, 5172, 1329, -1000, -1000,
-	16758, -1000, 17161, -1000, -1000, 12844, 1576, 15790, -1000, 1498,
-	448, -1000, 196, 505, -1000, -1000, 12032, 721, 1057, 31,
-	16758, 5172, 5172, 5172, 5172, 5172, -1000, 1025, 1021, -1000,
-	1011, 1004, 1026, 16758, -1000, 655, 430, 1625, 2823, 224,
-	15790, 5646, 445, 5172, -1000, -1000, 5172, 16758, 11537, -1000,
-	-1000, -382, -359, -1000, -1000, -1000, 477, -1000, -1000, 1813,
-	1542, 1347, -1000, -1000, -1000, -1000, -1000, 104, 104, -1000,
-	-1000, -1000, -1000, -1000, -1000, 645, -1000, 614, -199, -1000,
-	918, 1437, 191, 191, -1000, -1000, 449, -1000, -1000, -1000,
-	-18, -1000, 1520, 16758, 979, 974, 973, -1000, 518, 1611,
-	16758, -1000, -1000, 16758, -158, 16758, -1000, -1000, -1000, 14724,
-	-1000, -1000, -1000, -195, -1000, -1000, -1000, -1000, -1000, -1000,
-	16758, -182, -1000, 856, 422, -1000, -1000, 931, -1000, -1000,
-	-1000, -1000, -207, -154, 16758, 75, 1214, 16758, -1000, -1000,
-	-1000, 602, 602, -1000, -1000, 1421, -1000, 449, 809, 16296,
-	16296, -1000, 115, -1000, 499, 430, 848, 848, -1000, 848,
-	-1000, -1000, 848, -254, 848, -260, 848, -1000, -1000, 430,
-	430, 800, 697, -449, -1000, 842, 15790, 1036, 984, 809,
-	-1000, 15790, 809, 809, 809, -1000, 842, 872, 951, 540,
-	-1000, -1000, -1000, -1000, 543, 809, 888, -642, 430, 588,
-	588, 588, 588, 588, 557, 557, 557, 557, -1000, -1000,
-	430, -1000, -1000, 1561, 1561, 1561, 16915, 16758, -1000, -1000,
-	-1000, 1636, 538, -1000, -1000, -1000, -1000, -1000, 842, 261,
-	-1000, 16758, 15790, 499, -1000, 195, 16758, 499, 16758, 898,
-	1669, 929, 972, 1818, -1000, -1000, -1000, -1000, 1015, -1000,
-	1012, -1000, -1000, 754, -1000, -1000, 834, 430, 876, 1650,
-	5172, 802, 802, -1000, 26, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 322, 16917, 1727, -1000, 373, -1000, 399, 122,
-	344, -1000, -1000, -1000, 251, 246, -1000, 16758, 825, -1000,
-	-1000, -1000, -14, 372, -14, -1000, -1000, -1000, -1000, 1027,
-	1273, -1000, 16758, -1000, -1000, 16758, 16758, 16758, 16758, -1000,
-	-1000, -1000, 550, -1000, 15790, -1000, -1000, -1000, 363, 363,
-	-1000, 754, -1000, -1000, -1000, 432, -1000, -1000, -508, 16758,
-	16758, 16758, 16758, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 115, 16296, -1000, -1000, -14, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15790, -1000, 15790,
-	-1000, 1576, 370, 842, 15790, 15790, -1000, 809, -1000, -1000,
-	-1000, 430, 3050, -1000, 3050, -1000, 15790, 125, 665, -1000,
+	-1000, -1000, -1000, -1000, -1000, -704, 17526, -1000, -187, 142,
+	-150, -118, -128, 17526, -189, 17526, 17526, -62, 1129, 17526,
+	1728, 17526, -1000, -1000, -1000, -1000, -1000, 698, 17526, -1000,
+	-1000, 510, 510, -1000, -1000, 17526, 510, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 510, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 17526, -1000, -1000,
+	-1000, -1000, 17526, -1000, -1000, -1000, -1000, -267, -369, 212,
+	-1000, -1000, -1000, -1000, 1766, -1000, 698, 242, -1000, 578,
+	-1000, -1000, 326, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	350, 17695, 17695, 17695, 811, -37, 894, -1000, 590, 847,
+	975, 975, 727, 727, 96, 96, 96, 96, 96, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 410, -1000, 986,
+	-1000, 724, 801, 419, -1000, 419, 743, -1000, -1000, -1000,
+	986, 986, 705, 13580, -1000, -1000, 249, -1000, 14088, 419,
+	-1000, -1000, 419, 736, 712, 1875, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	499, 1367, 499, 499, -1000, -1000, 1629, 1609, 1720, -1000,
-	842, -1000, 499, -1000, 16, 505, -1000, 1620, -1000, -1000,
-	15790, 785, -1000, 15790, -1000, -1000, -1000, -1000, -1000, -1000,
-	17161, 1620, 802, -1000, -1000, 133, -1000, 15790, -1000, -1000,
-	-1000, 2083, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 779, 15790, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 754, -1000, -1000, -1000, 518,
-	-117, 809, -1000, -1000, 1540, -1000, -1000, 15295, -1000, -1000,
-	573, 933, -1000, -183, -1000, 809, 809, 430, -1000, 809,
-	809, -1000, -1000, 540, -1000, 775, -1000, 125, 430, 969,
-	-1000, -1000, 17161, -1000, 5452, -1000, 1590, 1584, 10052, 10052,
-	-1000, 15790, 15790, 16758, 505, 16758, 1576, 842, 16758, 842,
-	-314, 1576, -1000, 809, -1000, 16758, 804, 1510, -1000, -1000,
-	224, 503, -1000, 169, 16758, 16758, 430, -107, -518, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 15790, -1000, 430, 960,
-	-1000, -575, -1000, 514, -1000, -1000, -1000, -1000, -1000, 514,
-	514, 842, 485, 464, -1000, -1000, 509, 430, 499, -1000,
-	-1000, 487, -1000, 224, -505, 15295, 9724, 459, -1000, -1000,
-	1039, -499, -523, 872, -1000, -666, -1000, -1000, 9296, -1000,
-	-1000, -1000, -1000, 5646, 9961, -474, -174, -505, -1000, -369,
-	-1000, -1000, -1000, -1000, -1000, 931, -1000, 1038, -1000, 430,
-	-1000, -1000, -1000, 440, -1000, 3590, -610, 955, -1000, -453,
-	-1000, -1000, -1000, 313, 349, -1000, -1000, -1000, -1000, -1000,
-	-1000, -98, -1000, -1000, -508, -516, -1000, -1000, 9961, -605,
-	113, 17161, -1000, 943, 941, 1668, -1000, -1000, -1000, -1000,
-	-1000, -1000, -180, -1000, -519, -1000, -1000, 1514, -314, -1000,
-	1716, -1000, 1687, 362, 362, -1000, -525, -613, -1000, -1000,
-	-1000, -1000, -1000, -109, 703, -1000, -1000, 17161, -1000, -1000,
-	-1000, 127, 127, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 698, 814, 814,
+	814, 814, 814, 14088, 896, -1000, -1000, -1000, 1458, 1455,
+	419, 548, 419, 688, -1000, 1454, 814, 1451, 1447, 1446,
+	857, 1442, 1390, 1383, 814, 814, 814, 786, 1376, 1356,
+	1285, 1212, 1205, 1202, 1201, 1162, 1140, 814, 419, 13580,
+	13580, 678, 578, 22, -1000, 986, 419, 419, 986, 986,
+	17198, -1000, 17526, -1000, -643, -1000, -400, 132, -1000, 9029,
+	-1000, -1000, 419, 11889, -1000, 18085, -1000, -1000, -1000, -1000,
+	-1000, 356, 354, 419, -1000, -1000, -1000, -1000, 8215, 825,
+	1867, -1000, -1000, 578, 1007, -1000, -1000, 698, 698, 14088,
+	-1000, 123, 570, -1000, -1000, -1000, 21, 1855, 1127, 17526,
+	578, -1000, 4947, -1000, 1040, 1301, -1000, -1000, -1000, 1748,
+	3653, -1000, 578, 578, 17526, 1070, 1031, -1000, 19, -1000,
+	673, -375, -371, -1000, -1000, -1000, -1000, 698, -1000, -1000,
+	443, -1000, -83, -1000, -1000, -1000, -1000, 1558, -1000, -1000,
+	302, 10876, 1891, -1000, 408, -1000, 415, 131, 338, -1000,
+	-1000, -1000, 261, 257, -1000, 17526, -317, -1000, -1000, -1000,
+	336, -159, 5, -1000, -1000, -1000, 6202, 72, 72, -296,
+	-1000, -1000, -1000, 402, 402, -1000, -1000, -1000, -1000, 334,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 902, -1000, 14088,
+	-1000, -1000, -1000, -1000, 1708, 578, 295, -706, -1000, -1000,
+	-1000, -1000, 17526, 221, -187, 7401, 17526, 17526, 17526, -1000,
+	-1000, -1000, -1000, -1000, -1000, 17526, 2908, 1001, -1000, -204,
+	-1000, -1000, 999, -1000, 914, 14088, 944, 570, 570, -1000,
+	-1000, 1661, 157, -1000, -1000, -575, -1000, -1000, -201, -204,
+	74, -1000, 570, -1000, -1000, -1000, -1000, -707, 994, -23,
+	-197, -78, 17526, 17526, 17526, 17526, 17526, 4, -1000, -1000,
+	-1000, -46, -1000, 17526, 17526, 90, -1000, -1000, -1000, 519,
+	-1000, -1000, 519, -1000, -1000, -1000, -1000, -1000, 1641, 17526,
+	-370, -678, -1000, -674, 14088, -1000, -1000, -1000, -1000, 798,
+	-40, 894, 17695, 17695, 13580, -485, 284, 284, 5863, -1000,
+	-1000, 400, -1000, -1000, 14088, 14088, 1094, 176, -1000, 14088,
+	684, -1000, -1000, 14088, 14088, 14088, 419, -1000, -1000, -1000,
+	-1000, -1000, 1874, -1000, 14088, 14088, 14088, -1000, -1000, 14088,
+	14088, -1000, 14088, 14088, 2434, 419, 2434, 14088, 14088, 2434,
+	-1000, -1000, -1000, -1000, 2434, 14088, 14088, 14088, 14088, 14088,
+	14088, 14088, 14088, 14088, -1000, -1000, 986, 986, 13580, 17198,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	132, -1000, 75, 75, 75, -1000, -1000, -1000, -1000, -1000,
+	-1000, 655, -1000, -1000, -1000, 1789, -1000, 1210, 1855, -1000,
+	2886, 1867, -1000, -1000, 17526, -1000, 11939, -1000, -1000, 15476,
+	1799, -1000, 1701, 562, -1000, 141, 565, -1000, -1000, 13072,
+	961, 1209, 18, 17526, 2886, 2886, 2886, 2886, 2886, -1000,
+	1151, 1149, -1000, 1169, 1150, 1211, 17526, -1000, 957, 419,
+	1856, 3653, 233, 14088, 5239, 487, 2886, -1000, -1000, 2886,
+	17526, 14902, -1000, -1000, -387, -401, -1000, 577, -1000, -1000,
+	1103, 1743, 1539, -1000, 14088, -1000, -1000, -1000, -1000, -1000,
+	-1000, 403, 403, 6433, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 941, -1000, -1000, 466, -1000, -1000,
+	-1000, 72, 72, -1000, -1000, -1000, -1000, -1000, -1000, 911,
+	-1000, 909, 895, -209, 698, -1000, -1000, -714, -1000, 1034,
+	1718, -39, -1000, -1000, 275, 1125, -1000, 521, 1818, 17526,
+	-1000, -1000, 17526, -153, 6471, 1708, 814, 17526, -1000, -1000,
+	-1000, 11384, -1000, -1000, -1000, -204, -1000, -1000, -1000, -1000,
+	-1000, -1000, 17526, -198, -1000, 937, 441, -1000, -1000, 942,
+	-1000, -1000, -1000, -1000, -208, -157, 17526, 76, 1427, 17526,
+	-1000, -1000, -1000, 510, 510, -1000, -1000, 1630, -1000, 570,
+	814, 17695, 17695, -1000, 87, -1000, 578, 419, 914, 914,
+	-1000, 914, -1000, -1000, 914, -253, 914, -255, 914, -1000,
+	-1000, 419, 419, 419, 739, 681, -453, -1000, 698, 14088,
+	1062, 1042, 814, -1000, 14088, 814, 814, 814, -1000, 698,
+	857, 1039, 764, -1000, -1000, -1000, -1000, 584, 814, 1029,
+	-638, 419, 827, 827, 827, 827, 827, 799, 799, 799,
+	799, -1000, -1000, 419, -1000, -1000, 1754, 1754, 1754, 17763,
+	17526, -1000, -1000, -1000, 1845, 582, -1000, -1000, -1000, -1000,
+	-1000, 254, -1000, 17526, 14088, 578, -1000, 279, 17526, 578,
+	17526, 1020, 1301, 1045, 1110, 1608, -1000, -1000, -1000, -1000,
+	1142, -1000, 1088, -1000, -1000, 843, -1000, -1000, 1004, 419,
+	933, 1855, 2886, 810, 810, -1000, 16, -1000, -1000, -1000,
+	-1000, -1000, -1000, 906, -1000, 814, -1000, -1000, -1000, 17526,
+	-1000, -1000, -1000, 5, 388, 5, -1000, 333, -1000, -1000,
+	-1000, 17526, -1000, 17526, 1866, -1000, -1000, -556, 17526, 17526,
+	-1000, -1000, -1000, -153, -1000, -1000, 374, -1000, -1000, 1567,
+	17526, -1000, -1000, 1708, 797, -1000, 14088, -1000, 843, -1000,
+	-1000, -1000, 463, -1000, -1000, -497, 17526, 17526, 17526, 17526,
+	-1000, -1000, -1000, 852, 1616, 138, 138, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 87, 17695, -1000, -1000, 5, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14088,
+	-1000, 14088, -1000, 1799, 365, 698, 14088, 14088, -1000, 814,
+	-1000, -1000, -1000, 419, 2434, -1000, 2434, -1000, 14088, 134,
+	284, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 578, 1486, 578, 578, -1000, -1000, 1805, 1816,
+	1885, -1000, 698, -1000, 578, -1000, 8, 565, -1000, 1842,
+	-1000, -1000, 14088, 869, -1000, 14088, -1000, -1000, -1000, -1000,
+	-1000, -1000, 11939, 1842, 810, -1000, -1000, 85, 14088, -1000,
+	771, -1000, -1000, -1000, 457, 315, 843, 1109, -1000, -558,
+	-1000, -1000, -1000, 521, -1000, -1000, 570, -1000, -1000, -105,
+	814, 1738, -1000, -1000, 17198, -1000, -1000, 837, 1002, -1000,
+	-1000, -1000, 1170, 1316, 190, -1000, 814, 814, 419, -1000,
+	814, 814, -1000, -1000, 764, -1000, 697, -1000, 134, 419,
+	1098, -1000, -1000, 11939, -1000, 5775, -1000, 1804, 1801, 7169,
+	7169, -1000, 14088, 14088, 17526, 565, 17526, 1799, 698, 17526,
+	698, -322, 1799, -1000, 719, -480, -1000, -1000, -1000, 1733,
+	17526, 17526, -1000, -1000, -1000, 233, 554, -1000, 133, 17526,
+	17526, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 419,
+	-97, -500, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14088,
+	-1000, 419, 1096, -1000, -577, -1000, 568, -1000, -1000, -1000,
+	-1000, -1000, 568, 568, 698, 548, 539, -1000, -1000, 533,
+	419, 578, -1000, -1000, 1080, -1000, -451, 233, -1000, 521,
+	-495, 17198, 12575, 512, -1000, -1000, 1181, -492, -505, 857,
+	-1000, -665, -1000, -1000, 6437, -1000, -1000, -1000, -1000, 5239,
+	6294, -81, 1074, 1057, 1870, -1000, -1000, -1000, -1000, -495,
+	-1000, -365, -1000, -1000, -1000, -1000, -1000, 942, -1000, 1176,
+	-1000, 419, -1000, -1000, -1000, 481, -1000, 3345, -604, -1000,
+	-1000, -1000, 300, 360, -1000, -1000, -1000, -1000, -1000, -1000,
+	1868, -1000, 1871, 375, 375, -1000, -95, -1000, -1000, -497,
+	-498, -1000, -1000, 6294, -599, 74, 11939, -1000, -1000, -1000,
+	-1000, -1000, -1000, -104, 680, -1000, -188, -1000, -503, -1000,
+	-1000, 1711, -322, -1000, -1000, -1000, -1000, -509, -605, -1000,
+	-1000, -1000, 11939, 140, 140, -1000, -1000,
 }
 
 var sqlite3Pgo = [...]int{
-	0, 2089, 24, 106, 598, 22, 648, 111, 2086, 2085,
-	2083, 2082, 2080, 2079, 2078, 1477, 1450, 1442, 2077, 2076,
-	2073, 2069, 2065, 2064, 2063, 2061, 2060, 2053, 2052, 1543,
-	147, 623, 2051, 2043, 2042, 2040, 91, 351, 67, 66,
-	356, 2032, 2031, 50, 2029, 2028, 2027, 336, 321, 301,
-	1390, 2025, 299, 84, 2024, 2021, 2020, 2019, 2017, 2016,
-	2014, 2013, 2009, 2007, 2003, 1995, 1994, 1992, 1991, 1996,
-	1421, 1990, 15, 1989, 56, 1987, 1984, 1983, 1982, 1981,
-	1979, 1977, 1975, 127, 1973, 1972, 1971, 660, 1969, 1967,
-	141, 82, 89, 1966, 1965, 80, 328, 1964, 93, 1963,
-	1962, 1959, 135, 1958, 4089, 1955, 49, 47, 1954, 48,
-	74, 44, 41, 5, 9, 1953, 1952, 38, 61, 1951,
-	1949, 86, 1948, 109, 96, 1946, 1945, 11, 4, 1943,
-	3, 6, 8, 45, 1941, 1939, 97, 1935, 1934, 1929,
-	70, 1927, 1926, 4325, 1925, 78, 118, 81, 83, 1924,
-	1922, 34, 1920, 1917, 1916, 1915, 1913, 35, 1911, 1910,
-	1905, 126, 31, 149, 46, 43, 53, 121, 1904, 33,
-	62, 1902, 124, 125, 1901, 1900, 1895, 37, 1893, 40,
-	112, 103, 26, 69, 1891, 1890, 1486, 606, 1886, 19,
-	1880, 28, 30, 1875, 77, 32, 1871, 143, 1869, 51,
-	142, 119, 144, 1868, 1867, 1866, 1859, 616, 1856, 1854,
-	1852, 59, 131, 134, 71, 1851, 1380, 1849, 1846, 68,
-	784, 1723, 29, 145, 1845, 1843, 1842, 2508, 128, 120,
-	16, 1835, 199, 1834, 1832, 1831, 707, 249, 92, 1412,
-	39, 1830, 1828, 12, 1826, 1824, 1822, 1820, 1819, 1816,
-	90, 1704, 75, 21, 72, 148, 54, 1810, 1807, 99,
-	76, 1806, 140, 138, 105, 85, 1803, 268, 130, 98,
-	1800, 129, 1799, 1798, 1797, 1796, 79, 1795, 1794, 1793,
-	1791, 137, 132, 101, 60, 1790, 64, 94, 133, 136,
-	23, 1789, 13, 36, 42, 20, 88, 116, 1788, 0,
-	1785, 14, 335, 1534, 1805, 1783, 1781, 2, 7, 10,
-	1780, 1775, 1770, 1768, 123, 1763, 1762, 1761, 1759, 18,
-	27, 17, 1, 1758, 1756, 4418, 2465, 108, 1755, 1753,
-	1407,
-}
-
-//line sqlite3.y:5903
+	0, 2222, 48, 74, 607, 24, 652, 112, 2221, 2219,
+	2216, 2215, 2212, 2209, 2208, 1558, 1553, 1521, 2204, 2203,
+	2200, 2199, 2198, 2196, 2192, 2189, 2188, 2186, 2185, 103,
+	146, 593, 2184, 2183, 2182, 2179, 101, 319, 37, 40,
+	335, 2177, 2175, 2173, 2172, 2171, 142, 2169, 2168, 2166,
+	2165, 2164, 2163, 2162, 2159, 2158, 107, 2157, 151, 2156,
+	2154, 1597, 2152, 2151, 268, 258, 256, 1549, 2150, 253,
+	96, 2149, 2148, 2146, 2143, 2142, 2141, 2140, 2138, 2136,
+	2135, 2134, 2131, 2129, 2128, 2127, 1285, 1616, 2126, 11,
+	2124, 58, 2120, 2118, 2112, 2110, 2109, 2108, 2107, 2106,
+	128, 2105, 2104, 2099, 587, 2097, 2095, 149, 89, 95,
+	2094, 2093, 84, 340, 2091, 98, 2089, 2087, 2084, 140,
+	2083, 5294, 2081, 64, 69, 2078, 50, 65, 63, 75,
+	31, 17, 2076, 2075, 105, 81, 2074, 2070, 42, 2068,
+	85, 82, 2067, 2066, 8, 2, 2065, 5, 7, 1,
+	47, 2063, 2061, 97, 2060, 2059, 2058, 79, 2057, 2055,
+	4426, 2054, 73, 119, 87, 56, 2053, 2052, 45, 2051,
+	2050, 2049, 2048, 2046, 72, 138, 2045, 2044, 126, 34,
+	152, 27, 30, 46, 113, 2043, 35, 62, 2042, 125,
+	124, 2041, 2038, 2034, 33, 2033, 39, 111, 43, 38,
+	88, 108, 2031, 1672, 627, 2030, 23, 2029, 28, 26,
+	2028, 83, 29, 2026, 147, 2024, 55, 145, 109, 143,
+	2023, 2022, 2021, 2016, 542, 2015, 2014, 2012, 61, 129,
+	135, 70, 2011, 1612, 2010, 2009, 78, 1625, 2402, 22,
+	144, 2007, 2006, 2005, 3402, 122, 116, 19, 2004, 92,
+	2003, 2002, 1998, 583, 255, 94, 1618, 32, 1996, 1994,
+	9, 1992, 1991, 1988, 1987, 1986, 1985, 1981, 264, 1128,
+	51, 3, 71, 139, 52, 1980, 1978, 102, 77, 1975,
+	141, 137, 115, 93, 1973, 250, 131, 100, 1971, 53,
+	1969, 1968, 1967, 1966, 86, 1963, 1962, 1961, 1958, 67,
+	1955, 41, 1954, 1951, 1950, 134, 133, 16, 1946, 6,
+	44, 36, 20, 90, 123, 1945, 0, 1942, 12, 326,
+	1638, 1627, 1932, 1928, 10, 13, 15, 1927, 1926, 1922,
+	1921, 121, 1919, 1918, 1917, 1916, 18, 21, 14, 4,
+	1914, 1912, 5320, 3624, 110, 1909, 1907, 1593,
+}
+
+//line sqlite3.y:6171
 type sqlite3SymType struct {
 	union             any
 	empty             struct{}
@@ -4071,11 +4197,21 @@ func (st *sqlite3SymType) createDatabaseUnion() *ast.CreateDatabase {
 	return v
 }
 
+func (st *sqlite3SymType) createIndexUnion() *ast.CreateIndex {
+	v, _ := st.union.(*ast.CreateIndex)
+	return v
+}
+
 func (st *sqlite3SymType) createTableUnion() *ast.CreateTable {
 	v, _ := st.union.(*ast.CreateTable)
 	return v
 }
 
+func (st *sqlite3SymType) createTriggerUnion() *ast.CreateTrigger {
+	v, _ := st.union.(*ast.CreateTrigger)
+	return v
+}
+
 func (st *sqlite3SymType) cteUnion() *ast.CommonTableExpr {
 	v, _ := st.union.(*ast.CommonTableExpr)
 	return v
@@ -4136,6 +4272,16 @@ func (st *sqlite3SymType) indexDefinitionUnion() *ast.IndexDefinition {
 	return v
 }
 
+func (st *sqlite3SymType) indexElementUnion() *ast.IndexElement {
+	v, _ := st.union.(*ast.IndexElement)
+	return v
+}
+
+func (st *sqlite3SymType) indexElementsUnion() []*ast.IndexElement {
+	v, _ := st.union.([]*ast.IndexElement)
+	return v
+}
+
 func (st *sqlite3SymType) indexHintUnion() *ast.IndexHint {
 	v, _ := st.union.(*ast.IndexHint)
 	return v
@@ -4346,6 +4492,11 @@ func (st *sqlite3SymType) statementUnion() ast.Statement {
 	return v
 }
 
+func (st *sqlite3SymType) statementsUnion() []ast.Statement {
+	v, _ := st.union.([]ast.Statement)
+	return v
+}
+
 func (st *sqlite3SymType) subqueryUnion() *ast.Subquery {
 	v, _ := st.union.(*ast.Subquery)
 	return v
@@ -4391,6 +4542,11 @@ func (st *sqlite3SymType) tableSpecUnion() *ast.TableSpec {
 	return v
 }
 
+func (st *sqlite3SymType) triggerEventUnion() *ast.TriggerEvent {
+	v, _ := st.union.(*ast.TriggerEvent)
+	return v
+}
+
 func (st *sqlite3SymType) trimTypeUnion() ast.TrimType {
 	v, _ := st.union.(ast.TrimType)
 	return v
@@ -4437,253 +4593,264 @@ func (st *sqlite3SymType) withUnion() *ast.With {
 }
 
 var sqlite3R1 = [...]int{
-	0, 323, 324, 324, 1, 1, 1, 1, 1, 1,
+	0, 340, 341, 341, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 299, 299,
-	299, 302, 302, 26, 65, 29, 29, 28, 28, 31,
-	31, 30, 2, 2, 2, 3, 3, 3, 3, 3,
-	3, 3, 3, 4, 4, 4, 4, 4, 5, 5,
-	5, 5, 7, 7, 7, 13, 14, 6, 15, 15,
-	77, 77, 16, 17, 17, 17, 17, 327, 327, 138,
-	138, 136, 136, 137, 137, 18, 19, 19, 202, 202,
-	201, 201, 201, 203, 203, 203, 203, 239, 239, 20,
-	20, 20, 20, 20, 88, 88, 301, 301, 300, 292,
-	292, 291, 291, 290, 298, 298, 297, 297, 296, 34,
-	35, 44, 44, 44, 44, 45, 46, 303, 303, 272,
-	51, 51, 50, 50, 50, 50, 50, 50, 52, 52,
-	48, 48, 47, 47, 49, 49, 274, 274, 261, 261,
-	273, 273, 273, 273, 273, 273, 273, 260, 260, 99,
-	99, 184, 184, 184, 184, 184, 184, 184, 184, 184,
-	184, 184, 184, 184, 184, 184, 318, 318, 318, 317,
-	317, 185, 185, 185, 185, 185, 185, 185, 185, 108,
-	108, 117, 117, 117, 117, 117, 106, 106, 107, 105,
-	105, 105, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 322, 322, 322, 322, 322, 322,
-	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
-	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
-	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
-	322, 322, 322, 322, 322, 322, 116, 116, 112, 112,
-	112, 113, 113, 113, 114, 114, 319, 319, 319, 319,
-	243, 243, 243, 243, 246, 246, 244, 244, 244, 244,
-	244, 244, 244, 244, 244, 245, 245, 245, 245, 245,
-	247, 247, 247, 247, 247, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
-	248, 249, 249, 249, 249, 249, 249, 249, 249, 259,
-	259, 250, 250, 255, 256, 257, 257, 257, 258, 258,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 254,
-	254, 253, 253, 253, 262, 286, 286, 285, 285, 283,
-	283, 283, 283, 283, 271, 271, 280, 280, 280, 280,
-	280, 270, 270, 266, 266, 266, 267, 267, 268, 268,
-	265, 265, 269, 269, 282, 282, 281, 281, 263, 263,
-	264, 264, 288, 320, 320, 320, 320, 320, 321, 321,
-	289, 310, 312, 312, 312, 311, 311, 308, 309, 307,
-	307, 307, 307, 307, 74, 74, 74, 214, 214, 215,
-	215, 278, 278, 277, 277, 277, 279, 279, 276, 276,
-	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
-	276, 276, 276, 210, 210, 210, 306, 306, 306, 306,
-	306, 306, 305, 305, 305, 275, 275, 275, 304, 304,
-	53, 53, 170, 170, 41, 41, 41, 41, 41, 41,
-	40, 40, 40, 36, 36, 36, 36, 36, 36, 36,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 316,
+	316, 316, 319, 319, 26, 82, 29, 29, 28, 28,
+	31, 31, 30, 2, 2, 2, 3, 3, 3, 3,
+	3, 3, 3, 3, 4, 4, 4, 4, 4, 5,
+	5, 5, 5, 7, 7, 7, 13, 14, 6, 15,
+	15, 94, 94, 16, 17, 17, 17, 17, 344, 344,
+	155, 155, 153, 153, 154, 154, 18, 19, 19, 219,
+	219, 218, 218, 218, 220, 220, 220, 220, 256, 256,
+	20, 20, 20, 20, 20, 20, 20, 105, 105, 318,
+	318, 317, 309, 309, 308, 308, 307, 315, 315, 314,
+	314, 313, 34, 34, 35, 44, 53, 53, 45, 45,
+	46, 46, 46, 47, 48, 48, 48, 48, 51, 51,
+	51, 51, 52, 52, 54, 54, 55, 55, 56, 56,
+	56, 56, 50, 50, 62, 63, 320, 320, 290, 68,
+	68, 67, 67, 67, 67, 67, 67, 69, 69, 65,
+	65, 64, 64, 66, 66, 292, 292, 279, 279, 291,
+	291, 291, 291, 291, 291, 291, 291, 278, 278, 278,
+	116, 116, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 335, 335, 335,
+	334, 334, 202, 202, 202, 202, 202, 202, 202, 202,
+	125, 125, 134, 134, 134, 134, 134, 123, 123, 124,
+	122, 122, 122, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 128, 128, 339, 339, 339, 339, 339,
+	339, 339, 339, 339, 339, 339, 339, 339, 339, 339,
+	339, 339, 339, 339, 339, 339, 339, 339, 339, 339,
+	339, 339, 339, 339, 339, 339, 339, 339, 339, 339,
+	339, 339, 339, 339, 339, 339, 339, 133, 133, 129,
+	129, 129, 130, 130, 130, 131, 131, 336, 336, 336,
+	336, 260, 260, 260, 260, 260, 261, 261, 261, 59,
+	59, 264, 264, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 263, 263, 263, 263, 263, 265, 265, 265,
+	265, 265, 266, 266, 266, 266, 266, 266, 266, 266,
+	266, 266, 266, 266, 266, 266, 266, 266, 267, 267,
+	267, 267, 267, 267, 267, 267, 277, 277, 268, 268,
+	273, 274, 275, 275, 275, 276, 276, 270, 270, 270,
+	270, 270, 270, 270, 270, 270, 272, 272, 271, 271,
+	271, 280, 304, 304, 303, 303, 301, 301, 301, 301,
+	301, 289, 289, 298, 298, 298, 298, 298, 288, 288,
+	284, 284, 284, 285, 285, 286, 286, 283, 283, 287,
+	287, 300, 300, 299, 299, 281, 281, 282, 282, 305,
+	337, 337, 337, 337, 337, 338, 338, 306, 327, 329,
+	329, 329, 328, 328, 325, 326, 324, 324, 324, 324,
+	324, 91, 91, 91, 231, 231, 232, 232, 296, 296,
+	295, 295, 295, 297, 297, 294, 294, 294, 294, 294,
+	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
+	294, 294, 227, 227, 227, 323, 323, 323, 323, 323,
+	323, 322, 322, 322, 293, 293, 293, 321, 321, 70,
+	70, 187, 187, 41, 41, 41, 41, 41, 41, 40,
+	40, 40, 36, 36, 36, 36, 36, 36, 36, 36,
 	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
 	36, 36, 36, 36, 36, 36, 36, 36, 36, 36,
-	36, 36, 36, 42, 42, 37, 37, 37, 37, 37,
-	37, 37, 37, 37, 37, 21, 21, 21, 21, 21,
-	21, 21, 21, 21, 21, 21, 126, 128, 129, 129,
-	127, 127, 127, 127, 127, 127, 55, 55, 54, 54,
-	131, 130, 132, 132, 132, 22, 33, 33, 23, 23,
-	23, 23, 24, 24, 56, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 209, 209, 217, 217, 208,
-	208, 232, 232, 232, 211, 211, 211, 316, 316, 316,
-	205, 205, 58, 58, 58, 60, 60, 61, 62, 62,
-	234, 234, 235, 235, 63, 64, 75, 75, 75, 75,
-	75, 75, 78, 78, 78, 9, 9, 9, 9, 71,
-	71, 71, 8, 8, 59, 59, 66, 313, 313, 314,
-	315, 315, 315, 315, 67, 25, 25, 25, 25, 25,
-	25, 94, 94, 83, 83, 83, 83, 83, 83, 83,
-	83, 83, 83, 83, 89, 89, 89, 84, 84, 328,
-	27, 329, 69, 70, 70, 87, 87, 87, 81, 81,
-	81, 86, 86, 86, 10, 10, 11, 193, 193, 12,
-	12, 91, 91, 93, 93, 93, 93, 93, 95, 95,
-	95, 95, 95, 95, 95, 90, 90, 92, 92, 92,
-	92, 224, 224, 224, 223, 223, 120, 120, 122, 121,
-	121, 123, 123, 124, 124, 124, 124, 168, 168, 146,
-	191, 191, 189, 189, 190, 190, 192, 192, 192, 192,
-	125, 125, 125, 125, 133, 133, 134, 134, 135, 135,
-	32, 233, 233, 230, 230, 230, 229, 229, 139, 139,
-	139, 141, 140, 140, 140, 140, 142, 142, 144, 144,
-	143, 143, 145, 150, 150, 149, 149, 147, 147, 147,
-	147, 148, 148, 148, 148, 151, 151, 104, 104, 104,
-	104, 104, 104, 115, 115, 115, 115, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 110, 110, 110, 110, 110, 110, 110, 110,
-	110, 110, 110, 110, 110, 110, 110, 110, 110, 110,
-	110, 110, 110, 110, 110, 76, 76, 76, 206, 206,
-	152, 152, 160, 160, 160, 160, 153, 153, 153, 153,
-	153, 153, 153, 161, 161, 161, 167, 162, 162, 158,
-	158, 158, 158, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 241, 241, 295, 295, 294, 294, 293, 293, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 79, 79, 79, 79, 79, 79, 79, 79, 79,
-	330, 330, 251, 251, 251, 159, 159, 159, 159, 159,
-	85, 85, 85, 85, 85, 238, 238, 238, 242, 240,
-	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 173, 173, 82, 82, 171, 171,
-	172, 174, 174, 169, 169, 169, 155, 155, 175, 175,
-	176, 176, 177, 177, 179, 178, 178, 180, 181, 181,
-	181, 182, 182, 183, 183, 183, 43, 43, 43, 43,
-	43, 38, 38, 38, 38, 39, 39, 39, 39, 96,
-	96, 96, 96, 98, 98, 97, 97, 72, 72, 73,
-	73, 73, 102, 102, 103, 103, 103, 100, 100, 101,
-	101, 186, 186, 187, 154, 154, 154, 154, 154, 188,
-	188, 188, 188, 194, 194, 164, 164, 166, 166, 165,
-	119, 195, 195, 199, 196, 196, 200, 200, 200, 200,
-	200, 197, 197, 198, 198, 226, 226, 226, 204, 204,
-	216, 216, 212, 212, 213, 213, 207, 207, 218, 218,
-	218, 68, 163, 163, 287, 287, 284, 221, 221, 222,
-	222, 225, 227, 227, 231, 231, 228, 228, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 220, 220, 220, 325, 326, 236, 237, 237,
-	237,
+	36, 36, 42, 42, 37, 37, 37, 37, 37, 37,
+	37, 37, 37, 37, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 143, 145, 146, 146, 144,
+	144, 144, 144, 144, 144, 72, 72, 71, 71, 148,
+	147, 149, 149, 149, 22, 33, 33, 23, 23, 23,
+	23, 24, 24, 73, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 74, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 226, 226, 234, 234, 225, 225,
+	249, 249, 249, 228, 228, 228, 333, 333, 333, 222,
+	222, 75, 75, 75, 77, 77, 78, 78, 60, 60,
+	60, 60, 61, 61, 57, 57, 57, 58, 58, 58,
+	58, 58, 79, 79, 251, 251, 252, 252, 80, 81,
+	92, 92, 92, 92, 92, 92, 95, 95, 95, 9,
+	9, 9, 9, 88, 88, 88, 8, 8, 76, 76,
+	83, 330, 330, 331, 332, 332, 332, 332, 84, 25,
+	25, 25, 25, 25, 25, 111, 111, 100, 100, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 106, 106,
+	106, 101, 101, 345, 27, 346, 86, 87, 87, 104,
+	104, 104, 98, 98, 98, 103, 103, 103, 10, 10,
+	11, 210, 210, 12, 12, 108, 108, 110, 110, 110,
+	110, 110, 112, 112, 112, 112, 112, 112, 112, 107,
+	107, 109, 109, 109, 109, 241, 241, 241, 240, 240,
+	137, 137, 139, 138, 138, 140, 140, 141, 141, 141,
+	141, 185, 185, 163, 208, 208, 206, 206, 207, 207,
+	209, 209, 209, 209, 142, 142, 142, 142, 150, 150,
+	151, 151, 152, 152, 32, 250, 250, 247, 247, 247,
+	246, 246, 156, 156, 156, 158, 157, 157, 157, 157,
+	159, 159, 161, 161, 160, 160, 162, 167, 167, 166,
+	166, 164, 164, 164, 164, 165, 165, 165, 165, 168,
+	168, 121, 121, 121, 121, 121, 121, 132, 132, 132,
+	132, 135, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 126, 126, 126, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 93,
+	93, 93, 223, 223, 169, 169, 177, 177, 177, 177,
+	170, 170, 170, 170, 170, 170, 170, 178, 178, 178,
+	184, 179, 179, 175, 175, 175, 175, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
+	174, 174, 174, 174, 174, 174, 174, 258, 258, 312,
+	312, 311, 311, 310, 310, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 347, 347, 269, 269,
+	269, 176, 176, 176, 176, 176, 102, 102, 102, 102,
+	102, 255, 255, 255, 259, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	190, 190, 99, 99, 188, 188, 189, 191, 191, 186,
+	186, 186, 172, 172, 192, 192, 193, 193, 194, 194,
+	196, 195, 195, 197, 198, 198, 198, 199, 199, 200,
+	200, 200, 43, 43, 43, 43, 43, 38, 38, 38,
+	38, 39, 39, 39, 39, 113, 113, 113, 113, 115,
+	115, 114, 114, 89, 89, 90, 90, 90, 119, 119,
+	120, 120, 120, 117, 117, 118, 118, 203, 203, 204,
+	171, 171, 171, 171, 171, 205, 205, 205, 205, 211,
+	211, 181, 181, 183, 183, 182, 136, 212, 212, 216,
+	213, 213, 217, 217, 217, 217, 217, 214, 214, 215,
+	215, 243, 243, 243, 221, 221, 233, 233, 233, 229,
+	229, 230, 230, 224, 224, 235, 235, 235, 85, 180,
+	180, 302, 238, 238, 239, 239, 242, 244, 244, 248,
+	248, 245, 245, 49, 49, 49, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 342, 343, 253, 254, 254, 254,
 }
 
 var sqlite3R2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 0, 1, 2, 3, 2, 3, 0, 1, 3,
-	1, 4, 3, 3, 4, 3, 2, 3, 4, 3,
-	4, 2, 7, 1, 3, 3, 3, 3, 1, 2,
-	1, 1, 3, 2, 2, 5, 7, 8, 6, 7,
-	1, 1, 10, 10, 9, 8, 8, 1, 1, 1,
-	3, 1, 3, 1, 3, 3, 5, 4, 1, 3,
-	3, 2, 2, 2, 2, 2, 1, 1, 1, 2,
-	2, 6, 12, 2, 0, 2, 0, 2, 1, 0,
-	2, 1, 3, 3, 0, 1, 1, 3, 3, 6,
-	4, 7, 8, 8, 8, 6, 3, 1, 1, 4,
-	0, 1, 1, 1, 1, 2, 2, 2, 0, 1,
-	4, 4, 4, 4, 4, 4, 2, 4, 1, 3,
-	1, 1, 3, 4, 3, 3, 3, 5, 10, 0,
-	2, 0, 2, 3, 5, 3, 4, 2, 3, 2,
-	3, 3, 3, 3, 2, 2, 1, 1, 1, 1,
-	1, 0, 2, 2, 3, 3, 2, 2, 2, 1,
-	1, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 2, 3, 2, 3, 0, 1,
+	3, 1, 4, 3, 3, 4, 3, 2, 3, 4,
+	3, 4, 2, 7, 1, 3, 3, 3, 3, 1,
+	2, 1, 1, 3, 2, 2, 5, 7, 8, 6,
+	7, 1, 1, 10, 10, 9, 8, 8, 1, 1,
+	1, 3, 1, 3, 1, 3, 3, 5, 4, 1,
+	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
+	2, 2, 5, 6, 9, 12, 2, 0, 2, 0,
+	2, 1, 0, 2, 1, 3, 3, 0, 1, 1,
+	3, 3, 6, 6, 4, 8, 0, 1, 1, 3,
+	3, 2, 4, 10, 0, 1, 1, 2, 1, 1,
+	3, 1, 0, 3, 0, 2, 2, 3, 1, 1,
+	1, 1, 0, 1, 6, 3, 1, 1, 4, 0,
+	1, 1, 1, 1, 2, 2, 2, 0, 1, 4,
+	4, 4, 4, 4, 4, 2, 4, 1, 3, 1,
+	2, 1, 3, 4, 3, 3, 3, 5, 3, 10,
+	0, 2, 0, 2, 3, 5, 3, 4, 2, 3,
+	2, 3, 3, 3, 3, 2, 2, 1, 1, 1,
+	1, 1, 0, 2, 2, 3, 3, 2, 2, 2,
+	1, 1, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 1, 1, 2, 1, 2,
+	1, 3, 1, 1, 1, 1, 1, 4, 6, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 2, 3, 3, 3, 2, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 5, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 3,
+	0, 0, 0, 1, 1, 0, 1, 0, 3, 3,
+	2, 2, 2, 1, 2, 2, 0, 1, 0, 2,
+	2, 5, 0, 1, 1, 2, 1, 2, 1, 1,
+	3, 0, 1, 4, 3, 3, 4, 2, 0, 2,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	1, 1, 3, 3, 4, 3, 1, 3, 1, 7,
+	6, 7, 7, 8, 8, 0, 1, 5, 2, 1,
+	1, 1, 0, 1, 3, 3, 1, 1, 2, 2,
+	2, 0, 1, 1, 1, 2, 0, 1, 0, 1,
+	1, 3, 2, 1, 2, 2, 1, 4, 4, 3,
+	3, 3, 4, 3, 3, 3, 3, 3, 3, 3,
+	4, 5, 0, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	1, 0, 2, 0, 1, 5, 1, 3, 7, 1,
+	3, 3, 1, 2, 2, 2, 5, 5, 5, 6,
+	8, 5, 5, 4, 4, 4, 6, 5, 5, 2,
+	2, 2, 2, 3, 3, 3, 4, 3, 3, 1,
+	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 2, 2, 11, 3, 8, 6, 6,
+	6, 13, 8, 6, 10, 8, 4, 1, 3, 3,
+	6, 7, 7, 8, 4, 0, 1, 0, 1, 3,
+	3, 1, 1, 2, 3, 3, 5, 7, 7, 6,
+	5, 3, 2, 3, 3, 3, 7, 3, 3, 3,
+	4, 7, 5, 2, 4, 4, 4, 4, 4, 5,
+	5, 4, 4, 4, 4, 4, 4, 4, 4, 2,
+	4, 4, 3, 3, 5, 2, 3, 4, 4, 4,
+	4, 4, 5, 3, 0, 1, 0, 1, 1, 1,
+	0, 2, 2, 0, 2, 2, 0, 1, 1, 1,
+	1, 2, 1, 3, 3, 2, 2, 2, 0, 1,
+	1, 1, 0, 1, 2, 4, 5, 1, 1, 1,
+	2, 2, 1, 5, 0, 1, 0, 1, 2, 3,
+	0, 3, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 3, 3, 2, 2,
+	3, 1, 3, 2, 1, 2, 1, 2, 2, 3,
+	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
+	1, 1, 1, 3, 2, 1, 1, 1, 0, 1,
+	1, 0, 3, 0, 7, 0, 2, 0, 2, 1,
+	2, 2, 0, 1, 1, 0, 1, 1, 5, 5,
+	4, 0, 2, 4, 4, 0, 1, 0, 1, 2,
+	3, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
+	0, 1, 2, 1, 3, 1, 1, 1, 4, 3,
+	1, 3, 4, 3, 0, 3, 1, 3, 1, 3,
+	1, 1, 3, 3, 4, 4, 4, 3, 2, 4,
+	0, 1, 0, 2, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 1, 1,
+	2, 6, 5, 6, 6, 0, 2, 3, 3, 0,
+	2, 3, 3, 2, 3, 1, 6, 3, 4, 3,
+	1, 3, 4, 5, 6, 3, 4, 5, 6, 3,
+	4, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	3, 1, 1, 2, 2, 2, 2, 1, 1, 2,
+	9, 6, 6, 6, 2, 2, 3, 3, 3, 1,
+	1, 1, 0, 3, 1, 1, 1, 2, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 1, 1, 2, 1, 2, 1,
-	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
-	1, 2, 2, 2, 2, 3, 3, 3, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 5,
-	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 0, 3, 0, 0, 0, 1, 1, 0, 1,
-	0, 3, 3, 2, 2, 2, 1, 2, 2, 0,
-	1, 0, 2, 2, 5, 0, 1, 1, 2, 1,
-	2, 1, 1, 3, 0, 1, 4, 3, 3, 4,
-	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 0, 1, 1, 3, 3, 4, 3, 1,
-	3, 1, 7, 6, 7, 7, 8, 8, 0, 1,
-	5, 2, 1, 1, 1, 0, 1, 3, 3, 1,
-	1, 2, 2, 2, 0, 1, 1, 1, 2, 0,
-	1, 0, 1, 1, 3, 2, 1, 2, 4, 4,
-	3, 3, 3, 4, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 1, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 8, 5, 5, 4, 4, 4, 6, 5, 5,
-	2, 2, 2, 2, 3, 3, 3, 4, 3, 3,
-	1, 3, 5, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 2, 11, 3, 8, 6,
-	6, 6, 13, 8, 6, 10, 8, 4, 1, 3,
-	3, 6, 7, 7, 8, 4, 0, 1, 0, 1,
-	3, 3, 1, 1, 2, 3, 3, 5, 7, 7,
-	6, 5, 3, 2, 3, 3, 3, 7, 3, 3,
-	3, 4, 7, 5, 2, 4, 4, 4, 4, 4,
-	5, 5, 4, 4, 4, 4, 4, 4, 4, 4,
-	2, 4, 4, 3, 3, 5, 2, 3, 4, 4,
-	4, 4, 4, 5, 3, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 1, 1,
-	1, 1, 2, 1, 3, 1, 2, 1, 1, 5,
-	0, 1, 0, 1, 2, 3, 0, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 1, 3, 3, 2, 2, 3, 1, 3, 2,
-	1, 2, 1, 2, 2, 3, 3, 6, 4, 7,
-	6, 1, 3, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	7, 0, 2, 0, 2, 1, 2, 2, 0, 1,
-	1, 0, 1, 1, 5, 5, 4, 0, 2, 4,
-	4, 0, 1, 0, 1, 2, 3, 4, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 1, 0, 1, 2, 1,
-	3, 1, 1, 1, 4, 3, 1, 3, 4, 3,
-	0, 3, 1, 3, 1, 3, 1, 1, 3, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 3, 0, 1, 1, 2, 6, 5, 6,
-	6, 0, 2, 3, 3, 0, 2, 3, 3, 2,
-	3, 1, 6, 3, 4, 3, 1, 3, 4, 5,
-	6, 3, 4, 5, 6, 3, 4, 1, 4, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 1, 3, 1, 1, 2,
-	2, 2, 2, 1, 1, 2, 9, 6, 6, 6,
-	2, 2, 3, 3, 3, 1, 1, 1, 0, 3,
-	1, 1, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 4,
-	5, 5, 6, 4, 4, 8, 6, 8, 6, 8,
-	5, 4, 2, 2, 1, 2, 2, 2, 8, 8,
-	6, 5, 4, 4, 4, 4, 4, 7, 4, 6,
-	6, 6, 4, 4, 4, 7, 8, 6, 5, 6,
-	8, 11, 7, 8, 8, 9, 4, 4, 4, 4,
-	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-	4, 0, 2, 0, 2, 1, 3, 1, 1, 1,
+	1, 1, 3, 4, 5, 5, 6, 4, 6, 4,
+	4, 8, 6, 8, 6, 8, 5, 4, 2, 2,
+	1, 2, 2, 2, 8, 8, 6, 5, 4, 4,
+	4, 4, 4, 7, 4, 6, 6, 6, 4, 4,
+	4, 7, 8, 6, 5, 6, 8, 11, 7, 8,
+	8, 9, 4, 4, 4, 4, 6, 6, 6, 6,
+	6, 6, 6, 6, 6, 6, 4, 0, 2, 0,
+	2, 1, 3, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 0, 2,
+	3, 4, 4, 4, 4, 4, 0, 3, 4, 7,
+	3, 1, 1, 1, 0, 2, 3, 1, 2, 2,
+	1, 2, 1, 2, 2, 1, 2, 2, 1, 1,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 2, 0, 3, 0, 2, 0, 1,
+	3, 1, 3, 2, 0, 1, 1, 0, 1, 2,
+	4, 4, 0, 2, 2, 1, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
+	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
+	1, 3, 2, 1, 1, 0, 1, 2, 4, 2,
+	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
+	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
+	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 0,
+	2, 0, 3, 0, 1, 0, 1, 1, 5, 0,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 2, 0, 2, 3, 4, 4, 4, 4, 4,
-	0, 3, 4, 7, 3, 1, 1, 1, 0, 2,
-	3, 1, 2, 2, 1, 2, 1, 2, 2, 1,
-	2, 2, 1, 1, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 2, 0, 3,
-	0, 2, 0, 1, 3, 1, 3, 2, 0, 1,
-	1, 0, 1, 2, 4, 4, 0, 2, 2, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 0,
-	3, 3, 3, 0, 3, 1, 1, 0, 4, 0,
-	1, 1, 0, 3, 1, 3, 2, 1, 1, 0,
-	1, 2, 4, 2, 2, 1, 5, 4, 4, 1,
-	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 3,
-	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 0, 2, 0, 3, 0, 1, 0, 1,
-	1, 5, 0, 1, 0, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4698,486 +4865,503 @@ var sqlite3R2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
-	1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var sqlite3Chk = [...]int{
-	-1000, -323, -1, -5, -27, -13, -14, -15, -16, -17,
-	-18, -19, -20, -21, -22, -23, -24, -56, -57, -58,
-	-60, -61, -62, -63, -64, -8, -59, -25, -26, -65,
-	-66, -67, -68, -10, -11, -12, -3, -2, -7, -328,
-	9, 10, -77, -28, 32, -34, -44, 481, -45, -35,
-	482, -46, 484, 483, 521, 485, 514, 337, 571, 572,
-	574, 575, 576, 577, -78, 519, 520, 488, 36, 173,
-	33, 34, 37, 527, 528, 491, -4, -29, 8, -325,
-	11, 694, 516, 515, 28, -6, 728, 349, -324, 860,
-	-186, -187, 22, 33, 29, -183, -179, -87, -187, 20,
-	18, 7, 38, -69, -329, -69, -69, 12, 13, -69,
-	-272, -274, 349, 417, 349, -69, -51, -50, -48, -47,
-	-49, -52, 31, -41, -40, -42, -36, -37, -279, 487,
-	482, 489, 490, 695, 385, 384, 382, 383, 483, 338,
-	484, 344, 33, 728, 731, -276, -52, 364, 366, 839,
-	495, 842, 843, 846, 847, 853, 854, 855, 386, 7,
-	-69, -231, -227, -299, -220, 352, 353, 354, 42, 114,
-	36, 769, 138, 555, 770, 41, 743, 557, 178, 191,
-	785, 791, 752, 530, 796, 221, 821, 238, 817, 818,
-	758, 494, -69, 494, -143, -227, 494, -197, 631, -217,
-	633, 646, 641, 639, -209, 642, 644, 534, -316, 653,
-	494, 648, 481, 643, 651, 543, -299, 434, 857, 394,
-	599, 638, 636, 654, 825, -227, 573, -234, 578, -221,
-	-299, -220, 576, -143, -75, 822, 486, 485, -236, -236,
-	-89, 825, 827, -162, -104, 401, -115, -118, -109, -110,
-	-156, -157, -158, -159, -116, -169, 423, 424, 431, 402,
-	-167, -119, 26, 724, 696, 695, 434, 31, 477, 331,
-	332, 698, 699, 404, 320, 671, 672, -117, 666, 673,
-	668, 701, 702, 377, 703, 367, 369, 368, 704, 705,
-	706, 611, 612, 707, 708, 709, 370, 371, 372, 373,
-	374, 375, 376, 710, 713, 711, 712, 714, 715, 716,
-	721, 722, 717, 718, 719, 720, 723, -221, -227, 499,
-	665, 493, 429, 694, -111, -107, -2, -165, 664, 669,
-	670, 674, 667, -299, -220, -113, -112, -152, 355, 361,
-	365, 362, -322, 378, -325, 356, 357, 358, 359, 360,
-	379, 380, 435, 436, 437, 438, 439, 440, 441, 442,
-	443, 444, 445, 446, 447, 448, 449, 450, 451, 452,
-	453, 454, 455, 456, 457, 458, 459, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 117, 647, 647, -143, -69, -69,
-	-69, -177, -87, -179, -4, -2, -325, 8, -69, -2,
-	-3, -7, -29, -31, 755, -30, -227, 12, 419, -143,
-	-182, -183, -6, -2, -104, 19, 23, 24, -70, -92,
-	425, -104, -227, -70, -92, -207, 498, -69, -69, -196,
-	-239, 573, -200, 654, 653, -222, -198, -221, -219, -197,
-	652, 69, 507, 487, 556, 23, 482, 485, 400, 25,
-	27, 80, 625, 83, 571, 403, 19, 508, 404, 696,
-	505, 433, 501, 574, 97, 509, 481, 335, 666, 668,
-	664, 665, 31, 50, 121, 13, 28, 126, 24, 483,
-	130, 407, 408, 518, 740, 347, 26, 516, 558, 22,
-	510, 14, 638, 559, 16, 17, 499, 498, 147, 419,
-	495, 560, 55, 333, 11, 160, 56, 29, 416, 57,
-	329, 396, 331, 417, 20, 724, 336, 511, 401, 58,
-	378, 751, 21, 339, 398, 18, 334, 207, 561, 500,
-	729, 562, 755, 512, 418, 227, 577, 484, 694, 513,
-	332, 575, 237, 576, 8, 32, 494, 261, 551, 406,
-	497, 535, 7, 395, 12, 340, 275, 320, 496, 387,
-	405, 15, 728, 731, -273, 417, -260, -264, -221, 509,
-	-289, 505, -143, -282, -281, -221, -325, -88, -216, 495,
-	503, 502, 395, -303, 398, 551, 665, 493, -47, -48,
-	-49, -197, 433, 842, 350, 350, -276, -264, -263, -262,
-	-304, 509, -288, -280, 501, 510, -270, 502, 503, -265,
-	495, 396, -304, 505, 495, -304, -304, 497, 35, 35,
-	386, 386, -304, -265, 500, 510, 505, 509, -218, -265,
-	497, 25, -271, 409, -271, 732, 732, -197, 433, -271,
-	-271, -271, 538, -271, -271, -271, -271, -271, -271, -271,
-	-271, -271, 494, -303, -96, 651, 344, -50, -33, -143,
-	-216, 495, 496, -303, 527, -143, 478, -143, -211, 417,
-	15, -211, -208, 647, 645, 632, 637, -211, -211, -211,
-	541, 630, -266, 495, 35, 506, 647, 541, 630, 541,
-	542, 541, 542, 640, 650, 541, -232, 14, 419, 665,
-	635, 639, 534, 494, 535, 496, 649, 647, 537, -237,
-	-325, -222, 573, -299, 828, -237, 32, 353, 497, -221,
-	-71, -221, 356, -9, -5, -16, -15, -17, 409, -94,
-	647, -83, 434, 829, 830, 831, 828, 644, 836, 834,
-	832, 541, 833, 350, 398, 400, 4, -104, 416, -153,
-	409, 410, 411, 412, 413, 414, 415, 419, 401, 403,
-	417, 418, 409, 399, 420, 421, 422, 423, 424, 425,
-	426, 428, 427, 429, 430, 433, 479, 480, -110, -110,
-	-110, -110, -167, -325, -325, -325, -110, -206, -325, -110,
-	-325, -325, -325, -325, -173, -104, -325, -330, -325, -330,
-	-330, -251, -325, -251, -325, -325, -325, -325, -325, -325,
-	-325, -325, -325, -325, -325, -325, -325, -325, -325, -325,
-	-325, -325, -325, -325, -325, -325, -325, -325, -325, -325,
-	-325, -325, -325, -325, -325, -325, -325, -325, -325, -325,
-	-325, 478, -325, -325, -325, -325, -325, -251, -251, -251,
-	-251, -251, 365, 361, 355, -169, 362, 356, -221, -227,
-	-2, -3, -162, -236, -313, -314, -146, -143, -325, -221,
-	-221, 527, -182, -6, -2, -177, -183, -179, -2, -69,
-	-81, -93, 326, 327, -95, 24, 39, 330, 328, 23,
-	-326, 351, -326, -186, -326, 350, -31, -191, 349, 324,
-	350, 21, -178, -180, -104, 339, 38, 14, -224, -223,
-	25, -221, 356, 478, 14, -144, 29, -143, -207, -207,
-	350, 573, -200, -239, -202, -201, 655, 657, 409, -226,
-	-221, 356, 31, 351, 350, -143, -243, -246, -248, -247,
-	-249, -244, -245, 596, 597, 434, 600, 602, 603, 604,
-	605, 606, 607, 608, 609, 610, 613, 32, 517, 592,
-	593, 594, 595, 614, 615, 616, 617, 618, 619, 620,
-	621, 579, 598, 726, 580, 581, 582, 583, 584, 585,
-	587, 588, 589, 590, 591, -302, -299, 349, 351, 350,
-	-250, 349, -104, -96, 494, -299, 495, 495, 495, -69,
-	694, -271, -271, -271, 18, -36, -37, -40, -37, 349,
-	-260, -269, -302, -299, 349, 396, 500, 395, -268, -265,
-	-268, -269, -299, -169, -299, -299, -169, -260, -197, -169,
-	-299, 396, 396, -299, -299, -143, -299, 31, 341, 342,
-	343, 31, 345, 346, 347, -271, -271, 356, 356, 356,
-	-271, 356, -305, 511, 555, 557, 31, 356, -306, 31,
-	849, 848, 850, 851, 852, 31, 31, -221, 349, -143,
-	-102, 545, 481, 483, 487, 339, 409, 350, 497, 494,
-	-299, -212, 499, -212, -221, -228, -227, -219, 356, -104,
-	-267, 14, 419, -232, -232, -211, -143, -267, -232, -211,
-	-143, -211, -211, -211, -211, -232, -232, -232, -211, -227,
-	-227, -143, -143, -143, -143, -143, -143, -143, -237, -205,
-	14, 339, -237, -237, -235, 576, 610, 823, 824, 350,
-	728, -136, -143, 828, 828, 828, 828, 828, -104, -104,
-	-104, 751, -160, 378, 401, 379, 380, -118, -161, -165,
-	-167, 363, 419, 403, 417, 418, -109, -110, -109, 43,
-	-109, -109, -109, -109, -109, -109, -109, -109, -109, -109,
-	-109, -109, -238, -221, 356, 434, -114, -113, 362, -322,
-	-114, -90, -92, -104, -104, -299, -221, -90, -90, -104,
-	-86, 24, 39, -171, -172, 405, -169, -326, -326, 362,
-	-221, -221, -80, -79, 676, 677, 678, 679, 681, 682,
-	683, 686, 687, 691, 692, 675, 693, 680, 685, 688,
-	689, 690, 684, 595, -104, -104, -104, -104, -104, -104,
-	-76, -104, 389, 390, 391, -104, -104, -163, -162, -298,
-	-297, -296, -104, -104, -104, -104, -104, -104, -104, -104,
-	-104, -104, -104, -104, -104, -104, -104, -104, -104, -104,
-	-104, -104, -104, -104, -104, -91, 24, 39, -90, -222,
-	-228, -219, -90, -91, -91, -90, -90, 478, -326, 350,
-	-315, 657, 658, 826, -230, -229, 25, -227, 356, -163,
-	14, -193, 340, -221, -182, -182, 326, 327, 322, -90,
-	-95, -326, -30, 25, -189, -221, 325, -104, -104, 350,
-	-181, 27, 28, -32, 493, -143, -223, 425, -228, -143,
-	-154, 32, 320, -5, -325, -143, -121, -123, -124, -125,
-	-146, -168, -325, -126, 747, 744, 14, -136, -137, -145,
-	-227, -200, -202, 350, 656, 658, 659, 339, 381, -104,
-	-253, 433, -278, -277, -276, -260, -262, -263, -264, 351,
-	-253, -257, 628, 627, -250, -250, -250, -250, -250, -252,
-	-197, 622, 623, 434, -252, -252, -252, 349, 349, -250,
-	-250, -250, -250, -255, -255, -255, -256, -256, -289, -104,
-	-286, -285, -283, -284, 364, 820, 776, 728, 340, -281,
-	-181, 358, -326, -102, -213, 499, -287, -284, -299, -299,
-	-299, -213, -299, 356, -299, 356, -299, 356, 19, 350,
-	-261, -260, -53, 555, -288, -282, -269, 396, -268, -269,
-	-269, 483, 32, -214, 767, 401, 820, 776, -260, -53,
-	-238, -299, 497, -238, -238, 356, -210, 857, -136, -98,
-	547, 409, 536, 536, 494, 494, 549, -143, 548, 550,
-	552, -143, -143, -212, 339, -138, -143, 26, -227, -143,
-	-211, -211, -143, -211, -211, -143, -221, -83, 657, 350,
-	728, 22, -84, 22, -325, 378, 379, 380, -161, -109,
-	-110, -109, 400, 518, -161, 350, -326, 25, 350, 340,
-	-326, -326, -326, 350, 14, -90, -174, -172, 407, -104,
-	-326, -326, 350, 350, 14, -242, -326, -326, -326, -326,
-	-326, -173, -326, 14, 350, 350, -326, -326, 350, 350,
-	-326, 350, 350, 350, -295, 350, 350, 350, 350, -326,
-	-326, -326, -326, 350, 350, 350, 350, 350, 350, 350,
-	350, 350, 350, -326, -326, -90, -90, -325, 478, -326,
-	-326, -326, -326, -326, -222, -314, 825, 658, -150, -149,
-	-147, 337, 498, 338, -229, -326, -114, 353, -190, 353,
-	-189, -155, -221, 362, -120, -122, 14, -95, -167, 351,
-	350, -180, 416, -225, -299, 478, -151, 15, -194, 339,
-	-195, -199, -169, -164, -166, -165, -325, -188, -326, -221,
-	32, 350, -139, -141, -140, -142, 329, 333, 335, 330,
-	331, 332, 336, -233, 25, -121, -3, -2, -325, -325,
-	-325, -143, -136, -327, 14, 340, -327, 350, 478, -201,
-	-203, 660, 657, 663, -299, 356, 350, -276, -264, -184,
-	-99, 41, -258, 629, -252, 735, -252, -221, 356, 434,
-	-254, 434, -254, 622, 623, -259, 356, -259, 351, -43,
-	-38, -39, 33, 344, -283, 356, 40, -221, 351, -181,
-	-98, -143, 401, 339, -287, -287, -287, -227, -189, 18,
-	350, 351, -170, 556, 351, 349, -269, -269, 31, 31,
-	820, 776, 767, -53, -170, -253, -299, 859, 858, 351,
-	496, 554, -103, 671, -100, 356, 352, -143, -143, -143,
-	-143, -143, 487, 483, 497, -136, -143, 350, -74, 513,
-	508, -232, -232, 33, -143, 657, 837, 835, -104, 400,
-	518, -118, -110, -92, 725, -240, 434, 596, 517, 594,
-	590, 610, 601, 627, 592, 628, 589, 588, 587, -240,
-	-238, -104, -104, -177, 408, -104, 406, -104, -104, -104,
-	-326, 14, -104, -104, -104, -296, -104, -104, -104, -294,
-	-293, -114, -169, -326, -294, -104, -104, -293, -293, -297,
-	-297, -297, -297, -297, -162, -162, -162, -162, -326, -326,
-	-91, -222, -147, -265, -265, -265, 350, -204, 22, 14,
-	320, -151, -121, -95, -221, -114, 425, -182, -104, 30,
-	-194, 350, 409, 350, -326, -326, 350, 320, 478, -195,
-	-123, -124, -124, -123, -124, 329, 329, 329, 334, 329,
-	334, 329, -140, -227, -326, -326, -3, -3, -104, -230,
-	340, -121, -121, -145, -227, 425, 657, 661, 662, -276,
-	-321, 378, 401, 31, 339, 625, 364, -319, 433, 764,
-	815, 820, 776, -320, 500, 395, 396, 512, 25, 42,
-	-254, -254, 351, 350, 351, -215, -214, -39, -38, -271,
-	-271, -299, 496, 26, -143, 339, 339, 339, 19, -260,
-	-169, -286, -189, -106, -325, -105, -107, -111, 423, 424,
-	-170, -143, -97, 545, 553, 349, -101, 353, -301, 340,
-	536, 625, 536, -143, -74, -43, -143, -211, -211, 33,
-	-299, -326, -118, -110, -325, -326, -250, -250, -250, -256,
-	-250, 584, -250, 584, -250, -326, -326, 350, -326, 22,
-	-326, -82, 700, -104, 350, 350, -326, -104, -326, -326,
-	-326, -295, 350, -326, 350, -326, 350, -241, 821, -326,
-	-326, -326, -326, -326, -326, -326, -326, -326, -326, -326,
-	-148, 22, -148, -148, 353, -143, -175, 16, 396, -199,
-	-104, -166, 320, -5, -221, -164, -221, -151, -134, -133,
-	339, 340, -135, 339, -133, 329, 329, -191, -326, -326,
-	350, -151, -121, -151, -151, 478, 378, -325, -108, -117,
-	-106, 12, 356, 356, -299, -318, 848, 849, 31, 358,
-	396, 396, -143, 349, -252, 356, -252, 31, 345, 346,
-	347, 31, 341, 342, 343, -143, -143, -143, -143, -189,
-	351, -104, -112, -112, -191, 351, -292, 728, -300, -221,
-	-221, -221, -221, -109, -252, -104, -104, -182, 356, -104,
-	-104, -326, -326, -294, -293, -104, -326, -131, -130, -132,
-	829, 378, 31, -240, -325, 329, 18, 16, -325, -325,
-	-176, 17, 19, 12, -164, 478, -177, -104, 349, -104,
-	-114, -177, -151, -104, -157, 349, -104, -191, -320, -326,
-	25, -291, -290, -222, 349, 340, -85, 419, 728, -326,
-	-326, -326, -326, -326, -326, -326, 350, -326, -130, -132,
-	-326, 339, -114, -192, -326, -221, 500, 19, 19, -192,
-	-192, -104, -162, -195, -221, -182, -189, -128, 632, -182,
-	-326, -189, 351, 25, -5, 350, 409, -189, -143, -326,
-	726, 336, 729, -104, -326, 339, 739, 829, 350, -326,
-	-326, -326, 351, -326, -325, 351, -185, -5, -72, 728,
-	-290, -275, -222, 356, 358, 351, 325, 727, 730, -295,
-	-221, 500, -230, -129, -127, -221, 782, -311, -310, 724,
-	-321, -317, 378, 401, 364, -319, 820, 776, 387, 388,
-	-72, -73, 544, 825, -301, 325, -326, -326, 350, 22,
-	-243, -55, 793, -308, -309, 339, -312, 638, 792, 813,
-	378, 356, 505, -292, 728, -127, 789, -253, -114, -309,
-	339, -308, 339, 13, 12, 546, 729, -54, 26, -128,
-	-307, 513, 508, 511, 32, -307, 730, 793, 507, 31,
-	378, -114, -131, -130, -130,
+	-1000, -340, -1, -5, -27, -13, -14, -15, -16, -17,
+	-18, -19, -20, -21, -22, -23, -24, -73, -74, -75,
+	-77, -78, -79, -80, -81, -8, -76, -25, -26, -82,
+	-83, -84, -85, -10, -11, -12, -57, -3, -2, -7,
+	-345, 9, 10, -94, -28, 32, -34, -44, -47, 483,
+	-62, -35, 484, -63, 486, 485, 523, 487, 516, 337,
+	573, 574, 576, 409, 577, 578, 579, -95, 521, 522,
+	490, 36, 173, 33, 34, 37, 529, 530, 493, 563,
+	-4, -29, 8, -342, 11, 696, 518, 517, 28, -6,
+	730, 349, -341, 862, -203, -204, 22, 33, 29, -200,
+	-196, -104, -204, 20, 18, 7, 38, -86, -346, -86,
+	-86, 12, 13, -86, -290, -292, 349, 418, 349, -52,
+	22, -86, -68, -67, -65, -64, -66, -69, 31, -41,
+	-40, -42, -36, -37, -297, 489, 484, 491, 492, 697,
+	386, 385, 383, 384, 485, 338, 486, 344, 33, 730,
+	733, -294, 352, -69, 364, 366, 841, 497, 844, 845,
+	848, 849, 855, 856, 857, 387, 7, -86, -248, -244,
+	-316, -237, 352, 353, 354, 42, 114, 36, 771, 138,
+	557, 772, 41, 745, 559, 178, 191, 787, 793, 754,
+	532, 798, 221, 823, 238, 819, 820, 760, 496, -86,
+	496, -160, -244, 496, -214, 633, -234, 635, 648, 643,
+	641, -226, 644, 646, 536, -333, 655, 496, 650, 483,
+	645, 653, 545, -316, 436, 859, 395, 601, 640, 638,
+	656, 827, -244, -60, 121, 147, 347, 575, -61, 575,
+	-61, -251, 580, -238, -316, -237, 578, -160, -92, 824,
+	488, 487, -253, -253, -106, 827, 829, -179, -121, 402,
+	-132, -135, -126, -127, -173, -174, -175, -176, -133, -186,
+	424, 425, 433, 403, -184, -136, 26, 726, 698, 697,
+	436, 31, 479, 564, 331, 332, 700, 701, 405, 320,
+	673, 674, -134, 668, 675, 670, 703, 704, 378, 705,
+	368, 370, 369, 706, 707, 708, 613, 614, 709, 710,
+	711, 371, 372, 373, 374, 375, 376, 377, 712, 715,
+	713, 714, 716, 717, 718, 723, 724, 719, 720, 721,
+	722, 725, -238, -244, 501, 667, 495, 430, 696, -128,
+	-124, -2, -182, 666, 671, 672, 676, 669, -316, -237,
+	-130, -129, -169, 355, 361, 365, 362, -339, 379, -342,
+	356, 357, 358, 359, 360, 380, 381, 437, 438, 439,
+	440, 441, 442, 443, 444, 445, 446, 447, 448, 449,
+	450, 451, 452, 453, 454, 455, 456, 457, 458, 459,
+	460, 461, 462, 463, 464, 465, 466, 467, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 477, 478, 117,
+	649, 649, -160, -86, -86, -86, -160, -194, -104, -196,
+	-4, -2, -342, 8, -86, -2, -3, -7, -29, -31,
+	757, -30, -244, 12, 420, -160, -199, -200, -6, -2,
+	-121, 19, 23, 24, -87, -109, 426, -121, -244, -87,
+	-109, -224, 500, -86, -86, -213, -256, 575, -217, 656,
+	655, -239, -215, -238, -236, -214, 654, 69, 509, 489,
+	558, 23, 484, 487, 401, 25, 27, 80, 627, 83,
+	573, 404, 19, 510, 405, 698, 507, 435, 503, 576,
+	97, 511, 483, 335, 668, 670, 666, 667, 31, 50,
+	121, 13, 28, 126, 24, 485, 130, 408, 409, 520,
+	742, 347, 26, 518, 560, 22, 512, 14, 640, 561,
+	16, 17, 501, 500, 147, 420, 497, 562, 55, 333,
+	11, 160, 56, 29, 417, 57, 329, 397, 331, 418,
+	20, 726, 336, 513, 402, 58, 379, 753, 21, 339,
+	399, 18, 334, 207, 563, 502, 731, 564, 757, 514,
+	419, 227, 579, 486, 696, 515, 332, 577, 237, 578,
+	8, 32, 496, 261, 553, 407, 499, 537, 7, 396,
+	12, 340, 275, 320, 498, 388, 406, 15, 730, 733,
+	-291, 418, -278, -282, -238, 511, -306, 507, -160, -45,
+	-46, -238, -175, -342, -244, -54, 406, 130, 388, -105,
+	-233, -53, -320, 399, 261, 553, 396, 667, 495, -64,
+	-65, -66, -214, 435, 844, 350, 350, -294, 733, -282,
+	-281, -280, -321, 511, -305, -298, 503, 512, -288, 504,
+	505, -283, 497, 397, -321, 507, 497, -321, -321, 499,
+	35, 35, 387, 387, -321, -283, 502, 512, 507, 511,
+	-235, -283, 499, 25, -289, 410, -289, 734, 352, 734,
+	-214, 435, -289, -289, -289, 540, -289, -289, -289, -289,
+	-289, -289, -289, -289, -289, 496, -320, -113, 653, 344,
+	-67, -33, -160, -233, 497, 498, -320, 529, -160, 480,
+	-160, -228, 418, 15, -228, -225, 649, 647, 634, 639,
+	-228, -228, -228, 543, 632, -284, 497, 35, 508, 649,
+	543, 632, 543, 544, 543, 544, 642, 652, 543, -249,
+	14, 420, 667, 637, 641, 536, 496, 537, 498, 651,
+	649, 539, -254, -342, -239, 575, -316, 830, -254, 32,
+	353, -61, 499, -238, -88, -238, 356, -9, -5, -16,
+	-15, -17, 410, -111, 649, -100, 436, 831, 832, 833,
+	830, 646, 838, 836, 834, 543, 835, 350, 399, 401,
+	4, -121, 417, -170, 410, 411, 412, 413, 414, 415,
+	416, 420, 402, 404, 418, 419, 432, 400, 421, 422,
+	423, 424, 425, 426, 427, 429, 428, 430, 431, 435,
+	481, 482, -127, -127, -127, -127, -184, -342, -342, -342,
+	-127, -223, -342, -127, -342, -342, -342, -342, -342, -190,
+	-121, -342, -347, -342, -347, -347, -269, -342, -269, -342,
+	-342, -342, -342, -342, -342, -342, -342, -342, -342, -342,
+	-342, -342, -342, -342, -342, -342, -342, -342, -342, -342,
+	-342, -342, -342, -342, -342, -342, -342, -342, -342, -342,
+	-342, -342, -342, -342, -342, -342, 480, -342, -342, -342,
+	-342, -342, -269, -269, -269, -269, -269, 365, 361, 355,
+	-186, 362, 356, -238, -244, -2, -3, -179, -253, -330,
+	-331, -163, -160, -342, -238, -238, 529, 410, -342, -199,
+	-6, -2, -194, -200, -196, -2, -86, -98, -110, 326,
+	327, -112, 24, 39, 330, 328, 23, -343, 351, -343,
+	-203, -343, 350, -31, -208, 349, 324, 350, 21, -195,
+	-197, -121, 339, 38, 14, -241, -240, 25, -238, 356,
+	480, 14, -161, 29, -160, -224, -224, 350, 575, -217,
+	-256, -219, -218, 657, 659, 410, -243, -238, 356, 31,
+	351, 350, -160, -282, -260, -201, -264, -266, -265, -267,
+	-261, -262, -263, 598, 599, 436, 602, 604, 605, 606,
+	607, 608, 609, 610, 611, 612, 615, 32, 519, 594,
+	595, 596, 597, 616, 617, 618, 619, 620, 621, 622,
+	623, -59, 581, 600, 728, 582, 583, 584, 585, 586,
+	587, 589, 590, 591, 592, 593, 352, -319, -316, 349,
+	351, 350, -271, 435, -198, 27, 28, -121, 480, 573,
+	-121, 237, 496, -113, 496, 537, 497, -86, 696, -289,
+	-289, -289, 18, -36, -37, -40, -37, 349, -278, -287,
+	-319, -316, 349, 397, 502, 396, -286, -283, -286, -287,
+	-316, -186, -316, -316, -186, -278, -214, -186, -316, 397,
+	397, -316, -316, -160, -316, 31, 341, 342, 343, 31,
+	345, 346, 347, -289, -289, 356, 356, 356, -289, 356,
+	-322, 513, 557, 559, 31, 356, -323, 31, 851, 850,
+	852, 853, 854, 31, 31, -238, 349, -160, -119, 547,
+	483, 485, 489, 339, 410, 350, 499, 496, -316, -229,
+	501, -229, -238, -245, -244, -236, 356, -121, -285, 14,
+	420, -249, -249, -228, -160, -285, -249, -228, -160, -228,
+	-228, -228, -228, -249, -249, -249, -228, -244, -244, -160,
+	-160, -160, -160, -160, -160, -160, -254, -222, 14, 339,
+	-254, -254, -252, 578, 612, 825, 826, 350, 730, -153,
+	-160, 830, 830, 830, 830, 830, -121, -121, -121, 753,
+	-177, 379, 402, 380, 381, -135, -178, -182, -184, 363,
+	420, 404, 418, 419, -126, -127, -126, -126, -126, -126,
+	-126, -126, -126, -126, -126, -126, -126, -126, -126, -126,
+	-255, -238, 356, 436, -131, -130, 362, -339, -131, -107,
+	-109, -121, -121, -316, -238, 500, -49, 69, 560, 577,
+	-107, -107, -121, -103, 24, 39, -188, -189, 406, -186,
+	-343, -343, 362, -238, -238, -97, -96, 678, 679, 680,
+	681, 683, 684, 685, 688, 689, 693, 694, 677, 695,
+	682, 687, 690, 691, 692, 686, 597, -121, -121, -121,
+	-121, -121, -121, -93, -121, 390, 391, 392, -121, -121,
+	-180, -179, -315, -314, -313, -121, -121, -121, -121, -121,
+	-121, -121, -121, -121, -121, -121, -121, -121, -121, -121,
+	-121, -121, -121, -121, -121, -121, -121, -121, -108, 24,
+	39, -107, -239, -245, -236, -107, -108, -108, -107, -107,
+	480, -343, 350, -332, 659, 660, 828, -247, -246, 25,
+	-244, 356, -180, 14, -210, 340, -238, -58, -239, 356,
+	358, 425, 424, -58, -199, -199, 326, 327, 322, -107,
+	-112, -343, -30, 25, -206, -238, 325, -121, -121, 350,
+	-198, -32, 495, -160, -240, 426, -245, -160, -171, 32,
+	320, -5, -342, -160, -138, -140, -141, -142, -163, -185,
+	-342, -143, 749, 746, 14, -153, -154, -162, -244, -217,
+	-219, 350, 658, 660, 661, 339, 382, -121, -271, -296,
+	-295, -294, -278, -280, -281, -282, 351, -271, -338, 379,
+	402, 31, 339, 627, 364, -336, 435, 766, 817, 822,
+	778, -337, 502, 396, 397, 514, -275, 630, 629, -268,
+	349, -268, -268, -268, -268, -270, -214, 624, 625, 436,
+	-270, -270, -270, 349, 349, -268, -268, -268, -268, 349,
+	352, -273, -273, -273, -274, -274, -306, -121, -168, 15,
+	-46, -198, -316, 356, -343, -239, -55, -56, -15, -16,
+	-17, -5, -230, 501, -119, -230, -230, -230, -230, -316,
+	356, -316, 356, -316, 356, 19, 350, -279, -278, -70,
+	557, -305, -300, -299, -238, -342, -287, 397, -286, -287,
+	-287, 485, 32, -231, 769, 402, 822, 778, -278, -70,
+	-255, -316, 499, -255, -255, 356, -227, 859, -153, -115,
+	549, 410, 538, 538, 496, 496, 551, -160, 550, 552,
+	554, -160, -160, -229, 339, -155, -160, 26, -244, -160,
+	-228, -228, -160, -228, -228, -160, -238, -100, 659, 350,
+	730, 22, -101, 22, -342, 379, 380, 381, -178, -126,
+	-127, -126, 401, 520, 350, -343, 25, 350, 340, -343,
+	-343, 350, -343, -343, 350, 14, -107, -191, -189, 408,
+	-121, -343, -343, 350, 350, 14, -259, -343, -343, -343,
+	-343, -343, -190, -343, 14, 350, 350, -343, -343, 350,
+	350, -343, 350, 350, 350, -312, 350, 350, 350, 350,
+	-343, -343, -343, -343, 350, 350, 350, 350, 350, 350,
+	350, 350, 350, 350, -343, -343, -107, -107, -342, 480,
+	-343, -343, -343, -343, -343, -239, -331, 827, 660, -167,
+	-166, -164, 337, 500, 338, -246, -343, -131, 353, -207,
+	353, -206, 358, 358, -343, -172, -238, 362, -137, -139,
+	14, -112, -184, 351, 350, -197, 417, -242, -316, 480,
+	-168, -211, 339, -212, -216, -186, -181, -183, -182, -342,
+	-205, -343, -238, 32, 350, -156, -158, -157, -159, 329,
+	333, 335, 330, 331, 332, 336, -250, 25, -138, -3,
+	-2, -342, -342, -342, -160, -153, -344, 14, 340, -344,
+	350, 480, -218, -220, 662, 659, 665, 350, -294, -282,
+	-201, -116, 41, 379, -342, -125, -134, -123, -122, -124,
+	-128, 424, 425, 12, 356, 356, -316, -335, 850, 851,
+	31, 358, 397, 397, -160, -276, 631, 358, -270, 737,
+	-270, -238, 356, 436, -272, 436, -272, 624, 625, -277,
+	356, -277, 358, 351, -121, -198, 409, -56, 862, -160,
+	402, -115, -160, 356, -238, -238, -244, -206, 18, 350,
+	351, -187, 558, 351, 350, -268, -121, 349, -287, -287,
+	31, 31, 822, 778, 769, -70, -187, -271, -316, 861,
+	860, 351, 498, 556, -120, 673, -117, 356, 352, -160,
+	-160, -160, -160, -160, 489, 485, 499, -153, -160, 350,
+	-91, 515, 510, -249, -249, 33, -160, 659, 839, 837,
+	-121, 401, 520, -135, -127, -109, 727, -257, 436, 598,
+	519, 596, 592, 612, 603, 629, 594, 630, 591, 590,
+	589, -257, -255, 356, -121, -121, -194, 409, -121, 407,
+	-121, -121, -121, -343, 14, -121, -121, -121, -313, -121,
+	-121, -121, -311, -310, -131, -186, -343, -311, -121, -121,
+	-310, -310, -314, -314, -314, -314, -314, -179, -179, -179,
+	-179, -343, -343, -108, -239, -164, -283, -283, -283, 350,
+	-221, 22, 14, 320, -168, -138, -112, -238, -131, 426,
+	-199, 30, -211, 350, 410, 350, -343, -343, 350, 320,
+	480, -212, -140, -141, -141, -140, -141, 329, 329, 329,
+	334, 329, 334, 329, -157, -244, -343, -343, -3, -3,
+	-121, -247, 340, -138, -138, -162, -244, 426, 659, 663,
+	664, -294, -338, 25, 42, -121, -129, -129, -174, 349,
+	351, -272, -272, 351, 350, 351, 351, 350, -232, -231,
+	862, 340, 26, 498, -48, 83, 558, 160, 339, 19,
+	-278, -186, -304, -303, -301, -302, 364, 822, 778, 730,
+	340, -299, -198, -343, -206, -123, -342, -187, -160, -114,
+	547, 555, 349, -118, 353, -318, 340, 538, 627, 538,
+	-160, -91, -43, -38, -39, 33, 344, -160, -228, -228,
+	33, -316, -343, -135, -127, -342, -343, -268, -268, -268,
+	-274, -268, 586, -268, 586, -268, -343, -343, -343, 350,
+	-343, 22, -343, -99, 702, -121, 350, 350, -343, -121,
+	-343, -343, -343, -312, 350, -343, 350, -343, 350, -258,
+	823, -343, -343, -343, -343, -343, -343, -343, -343, -343,
+	-343, -343, -165, 22, -165, -165, 353, -160, -192, 16,
+	397, -216, -121, -183, 320, -5, -238, -181, -238, -168,
+	-151, -150, 339, 340, -152, 339, -150, 329, 329, -208,
+	-343, -343, 350, -168, -138, -168, -168, 480, 349, -343,
+	-206, -270, 356, -270, 358, -238, -160, -51, 11, 12,
+	13, 753, -160, -206, -301, 356, 40, -238, -198, 351,
+	-121, -208, 351, -309, 730, -317, -238, -238, -238, -238,
+	-39, -38, -289, -289, -126, -270, -121, -121, -199, 356,
+	-121, -121, -343, -343, -311, -310, -121, -343, -148, -147,
+	-149, 831, 379, 31, -257, -342, 329, 18, 16, -342,
+	-342, -193, 17, 19, 12, -181, 480, -194, -121, 349,
+	-121, -131, -194, -168, -121, 351, 351, -50, 367, -208,
+	339, 753, -316, -337, -343, 25, -308, -307, -239, 349,
+	340, 31, 345, 346, 347, 31, 341, 342, 343, -102,
+	420, 730, -343, -343, -343, -343, -343, -343, -343, 350,
+	-343, -147, -149, -343, 339, -131, -209, -343, -238, 502,
+	19, 19, -209, -209, -121, -179, -212, -238, -199, -206,
+	-145, 634, -199, 351, -328, -327, 726, 25, -160, -206,
+	-5, 350, 410, -206, -160, -343, 728, 336, 731, -121,
+	-343, 339, 741, 831, 350, -343, -343, -343, 351, -343,
+	-342, -202, -325, -326, 339, -329, 640, 794, 815, -5,
+	-89, 730, -307, -293, -239, 356, 358, 351, 325, 729,
+	732, -312, -238, 502, -247, -146, -144, -238, 784, -338,
+	-334, 379, 402, 364, -336, 822, 778, 388, 389, -326,
+	339, -325, 339, 13, 12, -89, -90, 546, 827, -318,
+	325, -343, -343, 350, 22, -260, -72, 795, 379, 356,
+	-324, 515, 510, 513, 32, -324, 507, -309, 730, -144,
+	791, -271, -131, 509, 31, 379, 548, 731, -71, 26,
+	-145, 732, 795, -131, -148, -147, -147,
 }
 
 var sqlite3Def = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 34, 35, 36, 68, 70, 71, 0,
-	681, 681, 681, 0, 681, 0, 0, 681, -2, -2,
-	681, 1134, 0, 681, 0, 0, -2, 613, 615, 0,
-	617, -2, 0, 0, 626, 1287, 1287, 674, 0, 0,
-	0, 0, 0, 681, 681, 681, 1022, 48, 681, 0,
-	80, 81, 632, 633, 634, 63, 0, 1285, 1, 3,
-	69, 73, 0, 0, 0, 56, 1031, 0, 74, 0,
-	0, 685, 683, 0, 683, 0, 1116, 681, 681, 0,
-	109, 110, 0, 0, 0, -2, 113, -2, 142, 143,
-	144, 0, 149, 515, 465, 467, 470, 503, -2, -2,
-	458, 458, 458, 0, 0, 0, 0, 0, 458, 500,
-	1118, 364, 364, 0, 0, 426, 0, 364, 364, 364,
-	0, 364, 364, 364, 364, 364, 364, 364, 364, 364,
-	1049, 148, 1135, 1132, 1133, 38, 39, 40, 1262, 1263,
-	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
-	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
-	1284, 0, 1110, 0, 553, 780, 0, 604, 604, 0,
-	604, 604, 604, 0, 0, 0, 564, 0, 0, 0,
-	0, 601, 0, 580, 0, 586, 1288, 0, 1288, 1101,
-	0, 598, 596, 608, 609, 612, 616, 0, 621, 624,
-	1127, 1128, 0, 639, 47, 0, 630, 631, 644, 645,
-	0, 675, 676, 43, 877, 0, 801, 806, 817, 831,
-	832, 833, 834, 835, 837, 838, 0, 0, 0, 0,
-	843, 844, 0, 0, 0, 0, 0, 858, 0, 0,
-	0, 0, 0, 1004, 0, 970, 970, 894, 970, 972,
-	972, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1013, 0, 0,
-	0, 0, 0, 0, 266, 267, 876, 1090, 972, 972,
-	972, 972, 972, -2, -2, 212, 213, 214, 215, 216,
-	217, 218, 0, 208, 0, 271, 272, 268, 269, 270,
-	860, 861, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
-	252, 253, 254, 255, 256, 257, 258, 259, 260, 261,
-	262, 263, 264, 265, 1287, 0, 654, 0, 0, 0,
-	0, 1031, 0, 1023, 1022, 61, 0, 681, -2, 0,
-	0, 0, 0, 45, 0, 50, 740, 1071, 0, 1073,
-	57, 1032, 65, 67, 1033, 0, 686, 687, 0, 0,
-	717, 721, 0, 682, 0, 0, 1117, 1116, 1116, 95,
-	0, 1248, 1094, 107, 108, 0, 0, 1129, 1130, 1103,
-	1104, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
-	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
-	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
-	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
-	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257,
-	1258, 1259, 1260, 1261, 0, 0, 160, 161, 0, 41,
-	391, 0, 156, 0, 384, 331, 0, 1049, 0, 0,
-	0, 0, 0, 681, 0, 1111, 137, 138, 145, 146,
-	147, 364, 364, 364, 148, 148, 427, 474, 475, 476,
-	0, -2, 389, 0, 459, 0, 0, 378, 378, 382,
-	380, 381, 0, 0, 0, 0, 0, 0, 490, 491,
-	492, 493, 0, 0, 0, 0, 0, 0, 0, 0,
-	1119, 1120, 0, 365, 0, 513, 514, 364, 364, 0,
-	0, 0, 364, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 136, 1062, 0, 0, -2, 545, 0,
-	0, 0, 1112, 1112, 0, 552, 0, 554, 555, 0,
-	0, 556, 0, 601, 601, 599, 600, 558, 559, 560,
-	604, 0, 0, 373, 374, 375, 601, 604, 0, 604,
-	604, 604, 604, 601, 601, 601, 604, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1288, 583, 584, 587,
-	1289, 1290, 1248, 1288, 1288, 594, 1102, 614, 622, 625,
-	642, 640, 641, 643, 635, 636, 637, 638, 0, 655,
-	656, 661, 0, 0, 0, 666, 667, 668, 0, 0,
-	671, 672, 673, 0, 0, 0, 0, 799, 0, 0,
-	866, 867, 868, 869, 870, 871, 872, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 839, 840,
-	841, 842, 845, 0, 0, 0, 850, 851, 0, 0,
-	0, 0, 0, 691, 0, 1005, 0, 892, 0, 893,
-	895, 896, 0, 897, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1122, 124, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	701, 0, 0, 701, 701, 0, 0, 201, 202, 203,
-	204, 205, 219, 220, 221, 222, 223, 273, 1013, 0,
-	876, 0, 0, 44, 646, 647, 0, 763, 1122, 0,
-	697, 0, 55, 64, 66, 1031, 59, 1031, 0, 703,
-	0, 0, -2, -2, 704, 710, 711, 712, 713, 714,
-	52, 1286, 53, 0, 72, 0, 46, 0, 0, 0,
-	0, 0, 1024, 1025, 1028, 0, 684, 0, 718, 722,
-	0, 724, 725, 0, 0, 0, 0, 779, 0, 0,
-	0, 1248, 1100, 0, 97, 98, 0, 0, 0, 351,
-	1105, 1106, 1107, -2, 371, 0, 351, 335, 281, 282,
-	283, 331, 285, 331, 331, 331, 331, 340, 340, 340,
-	340, 314, 315, 316, 317, 318, 0, 0, 300, 331,
-	331, 331, 331, 321, 322, 323, 324, 325, 326, 327,
-	328, 286, 287, 288, 289, 290, 291, 292, 293, 294,
-	333, 333, 333, 334, 334, 0, 42, 0, 355, 0,
-	1028, 0, 0, 1062, 1114, 1124, 0, 0, 0, 1114,
-	115, 0, 0, 0, 0, 471, 472, 468, 504, 0,
-	460, 372, 0, -2, 0, 382, 0, 378, 382, 379,
-	382, 370, 383, 0, 0, 0, 0, 460, 0, 494,
-	495, 496, 0, 498, 499, 501, 0, 505, 506, 507,
-	508, 509, 510, 511, 512, 0, 0, 430, 431, 432,
-	0, 434, 435, 452, 453, 454, 436, 437, 438, 446,
-	447, 448, 449, 450, 451, 439, 440, 443, 0, 130,
-	1053, 0, 0, 0, 0, 0, 0, 0, 0, 1112,
-	0, 0, 0, 0, 700, 781, 1136, 1137, 605, 606,
-	0, 376, 377, 604, 604, 561, 591, 0, 604, 565,
-	592, 566, 568, 567, 569, 581, 582, 604, 572, 602,
-	603, 573, 574, 575, 576, 577, 578, 579, 588, 0,
-	610, 611, 589, 590, 0, 623, 627, 628, 629, 0,
-	0, 658, 91, 663, 664, 665, 677, 670, 878, 797,
-	798, 0, 800, 803, 0, 862, 864, 805, 807, 873,
-	874, 875, 0, 0, 0, 0, 0, 811, 815, 0,
-	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
-	829, 830, 836, 985, 986, 987, 853, 274, 275, 0,
-	854, 0, 715, 0, 0, 0, 852, 0, 0, 0,
-	0, 692, 693, 1011, 1008, 0, 0, 971, 973, 0,
-	0, 0, 0, 949, 950, 951, 952, 953, 954, 955,
-	956, 957, 958, 959, 960, 961, 962, 963, 964, 965,
-	966, 967, 968, 969, 988, 0, 0, 0, 0, 0,
-	1004, 0, 855, 856, 857, 0, 0, 0, 1123, 0,
-	125, 126, 0, 0, 0, 0, 0, 943, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 702, 1014,
-	0, -2, 0, 0, 0, 0, 0, 0, 1089, 0,
-	649, 650, 652, 0, 783, 764, 0, 766, 767, 0,
-	0, 696, 0, 699, 58, 60, 708, 709, 0, 726,
-	705, 54, 49, 0, 0, 742, 1072, 1034, 1035, 0,
-	1027, 1029, 1030, 0, 0, 75, 723, 719, 0, 795,
-	1083, 0, 0, 1075, 0, 778, 0, 729, 731, 732,
-	733, 761, 0, 736, 0, 0, 0, 0, 0, 93,
-	780, 1095, 96, 0, 0, 101, 102, 1096, 1097, 1098,
-	1099, 0, 139, -2, 423, 162, 164, 165, 166, 157,
-	-2, 338, 336, 337, 284, 340, 340, 308, 309, 310,
-	0, 349, 349, 346, 311, 312, 313, 0, 0, 301,
-	302, 303, 304, 295, 296, 297, 298, 299, 390, 0,
-	1036, 356, 357, 359, 0, 361, 362, 0, 0, 385,
-	386, 0, 1028, 1053, 0, 0, 0, 1125, 1124, 1124,
-	1124, 0, 150, 151, 152, 153, 154, 155, 0, 148,
-	0, 158, 462, 461, 388, 0, 0, 382, 382, 367,
-	368, 0, 0, 484, 417, 0, 485, 486, 460, 462,
-	351, 497, 0, 428, 429, 433, 441, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1050, 1051,
-	1052, 0, 546, 0, 0, 414, 89, 1113, 551, 601,
-	571, 593, 601, 563, 570, 585, 619, 662, 0, 0,
-	0, 0, 669, 0, 0, 804, 863, 865, 808, 0,
-	812, 816, 0, 0, 818, 0, 0, 0, 0, 0,
-	859, 883, 884, 0, 0, 1022, 0, 1009, 0, 0,
-	891, 974, 0, 0, 0, 0, 902, 903, 904, 905,
-	906, 0, 908, 0, 0, 0, 912, 913, 0, 0,
-	914, 0, 0, 0, 0, 0, 0, 0, 0, 926,
-	927, 928, 929, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 940, 879, 0, 0, 701, 0, 975,
-	976, 977, 978, 979, 1014, 648, 651, 653, 739, 784,
-	785, 0, 0, 0, 765, 1121, 694, 695, 698, -2,
-	0, 0, 1016, 0, 795, 727, 0, 706, 51, 741,
-	0, 1026, 0, 760, 1131, 0, 1031, 0, 78, 0,
-	1083, 1091, 0, 1074, 1085, 1087, 0, 0, 0, 1079,
-	0, 0, 0, 0, 0, 0, 768, 0, 0, 771,
-	0, 0, 0, 0, 762, 0, 0, 0, 0, 0,
-	0, -2, 0, 0, 87, 88, 0, 0, 0, 99,
-	100, 0, 0, 106, 352, 353, 148, 425, 163, 398,
-	0, 0, 280, 339, 305, 306, 307, 349, 349, 343,
-	344, 350, 345, 347, 348, 0, 329, 0, 419, 111,
-	1040, 1039, 364, 364, 358, 360, 0, 1126, 332, 387,
-	0, 129, 0, 0, 0, 0, 0, 135, 466, 0,
-	0, 477, 478, 0, 355, 0, 366, 369, 479, 0,
-	482, 483, 418, 462, 488, 489, 502, 444, 445, 442,
-	0, 0, 1063, 1064, 1069, 1067, 1068, 116, 519, 521,
-	520, 524, 0, 0, 0, 414, 1036, 0, 550, 415,
-	416, 604, 604, 657, 92, 0, 660, 0, 0, 0,
-	0, 809, 813, 716, 0, 0, 331, 331, 991, 331,
-	334, 994, 331, 996, 331, 999, 331, 1002, 1003, 0,
-	0, 0, 0, 1006, 890, 1012, 0, 0, 0, 0,
-	901, 0, 0, 0, 0, 127, 128, 943, 0, 0,
-	945, 947, 948, 918, 944, 0, 0, 941, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 880, 881,
-	0, 1015, 786, 791, 791, 791, 0, 0, 1108, 1109,
-	1017, 1018, 728, 707, 743, 680, 720, 76, 796, 0,
-	79, 0, 0, 0, 1088, 0, 0, 0, 0, 795,
-	730, 756, 758, 0, 753, 769, 770, 772, 0, 774,
-	0, 776, 777, 740, 735, 737, 0, 0, 0, 795,
-	0, 795, 795, 94, 0, 782, 103, 104, 105, 424,
-	167, 172, 0, 0, 0, 177, 0, 179, 0, 0,
-	0, 184, 185, 399, 0, 277, 279, 0, 0, 170,
-	341, 342, 340, 0, 340, 400, 420, 1037, 1038, 0,
-	0, 363, 0, 1115, 131, 0, 0, 0, 0, 159,
-	463, 354, 0, 480, 0, 206, 207, 209, 0, 0,
-	487, 740, 1054, 1055, 1056, 0, 1066, 1070, 119, 0,
-	0, 0, 0, 547, 548, 549, 90, 557, 562, 659,
-	678, 802, 810, 814, 0, 847, 989, 340, 992, 993,
-	995, 997, 998, 1000, 1001, 848, 849, 0, 886, 0,
-	888, 1031, 0, 1010, 0, 0, 900, 0, 909, 910,
-	911, 0, 0, 917, 0, 919, 0, 0, 0, 930,
-	931, 932, 933, 934, 935, 936, 937, 938, 939, 882,
-	0, 0, 0, 0, -2, 62, 1020, 0, 0, 1092,
-	1093, 1086, 0, 1078, 1081, 1077, 1080, 1022, 750, 757,
-	0, 0, 751, 0, 752, 773, 775, 734, -2, 738,
-	0, 1022, 795, 85, 86, 0, 173, 0, 175, 199,
-	200, 0, 178, 180, 181, 182, 186, 187, 188, 183,
-	276, 278, 0, 0, 319, 330, 320, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 740, 132, 133, 134, 469,
-	0, 0, 210, 211, 0, 1065, 518, 0, 117, 118,
-	0, 0, 523, 980, 990, 0, 0, 0, 1007, 0,
-	0, 907, 915, 0, 946, 0, 922, 0, 0, 0,
-	542, 543, 0, 942, 0, 792, 0, 0, 0, 0,
-	77, 0, 0, 0, 1076, 0, 1031, 754, 0, 759,
-	0, 1031, 84, 0, 176, 0, 0, 0, 392, 481,
-	0, 120, 121, 0, 0, 0, 0, 0, 0, 885,
-	887, 889, 898, 899, 916, 920, 0, 923, 0, 0,
-	924, 0, 544, 0, 788, 746, 747, 793, 794, 0,
-	0, 1021, 1019, 1084, 1082, 82, 0, 0, 0, 83,
-	174, 0, 191, 0, 1057, 0, 0, 0, 525, 846,
-	0, 0, 0, 943, 925, 0, 540, 541, 0, 787,
-	789, 790, 755, 763, 0, 405, 398, 1057, 516, 1059,
-	122, 123, 455, 456, 457, 116, 981, 0, 984, 0,
-	748, 749, 526, 0, 528, 0, 536, 393, 406, 0,
-	168, 192, 193, 0, 0, 196, 197, 198, 189, 190,
-	112, 0, 1060, 1061, 119, 982, 921, 527, 0, 0,
-	351, 0, 537, 394, 395, 0, 401, 402, 403, 404,
-	194, 195, 0, 522, 0, 529, 530, 538, 0, 396,
-	0, 397, 0, 0, 0, 1058, 0, 0, 539, 535,
-	407, 409, 410, 0, 0, 408, 983, 0, 411, 412,
-	413, 531, 532, 533, 534,
+	31, 32, 33, 34, 35, 36, 37, 69, 71, 72,
+	0, 735, 735, 735, 0, 735, 0, 0, 152, 735,
+	-2, -2, 735, 1189, 0, 735, 0, 0, -2, 652,
+	658, 0, 662, 662, -2, 0, 0, 680, 1345, 1345,
+	728, 0, 0, 0, 0, 0, 735, 735, 735, 0,
+	1078, 49, 735, 0, 81, 82, 686, 687, 688, 64,
+	0, 1343, 1, 3, 70, 74, 0, 0, 0, 57,
+	1087, 0, 75, 0, 0, 739, 737, 0, 737, 0,
+	1173, 735, 735, 0, 110, 111, 0, 0, 0, 154,
+	0, -2, 116, -2, 171, 172, 173, 0, 178, 554,
+	504, 506, 509, 542, -2, -2, 497, 497, 497, 0,
+	0, 0, 0, 0, 497, 539, 1175, 401, 401, 0,
+	0, 463, 466, 0, 401, 401, 401, 0, 401, 401,
+	401, 401, 401, 401, 401, 401, 401, 1105, 177, 1190,
+	1187, 1188, 39, 40, 41, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 0, 1166,
+	0, 592, 834, 0, 643, 643, 0, 643, 643, 643,
+	0, 0, 0, 603, 0, 0, 0, 0, 640, 0,
+	619, 0, 625, 1346, 0, 1346, 1157, 0, 637, 635,
+	647, 648, 651, 662, 659, 660, 661, 655, 656, 663,
+	657, 0, 675, 678, 1182, 1183, 0, 693, 48, 0,
+	684, 685, 698, 699, 0, 729, 730, 44, 931, 0,
+	855, 860, 871, 885, 886, 887, 888, 889, 891, 892,
+	0, 0, 0, 0, 897, 898, 0, 0, 0, 0,
+	0, 912, 0, 0, 0, 0, 0, 0, 1060, 0,
+	1026, 1026, 950, 1026, 1028, 1028, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1069, 0, 0, 0, 0, 0, 0, 297,
+	298, 930, 1146, 1028, 1028, 1028, 1028, 1028, -2, -2,
+	243, 244, 245, 246, 247, 248, 249, 0, 239, 0,
+	302, 303, 299, 300, 301, 914, 915, 255, 256, 257,
+	258, 259, 260, 261, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 276, 277,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 289, 290, 291, 292, 293, 294, 295, 296, 1345,
+	0, 708, 0, 0, 0, 0, 664, 1087, 0, 1079,
+	1078, 62, 0, 735, -2, 0, 0, 0, 0, 46,
+	0, 51, 794, 1127, 0, 1129, 58, 1088, 66, 68,
+	1089, 0, 740, 741, 0, 0, 771, 775, 0, 736,
+	0, 0, 1174, 1173, 1173, 96, 0, 1306, 1150, 108,
+	109, 0, 0, 1184, 1185, 1159, 1160, 1196, 1197, 1198,
+	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208,
+	1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
+	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
+	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
+	1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248,
+	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
+	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
+	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
+	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
+	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
+	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1307, 1308, 1309,
+	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	0, 0, 189, 191, 202, 42, 428, 0, 185, 0,
+	138, 388, 1084, 0, 0, 0, 0, 0, 0, 1105,
+	0, 0, 735, 0, 1167, 1168, 137, 166, 167, 174,
+	175, 176, 401, 401, 401, 177, 177, 464, 0, 513,
+	514, 515, 0, -2, 426, 0, 498, 0, 0, 415,
+	415, 419, 417, 418, 0, 0, 0, 0, 0, 0,
+	529, 530, 531, 532, 0, 0, 0, 0, 0, 0,
+	0, 0, 1176, 1177, 0, 402, 0, 552, 465, 553,
+	401, 401, 0, 0, 0, 401, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 165, 1118, 0, 0,
+	-2, 584, 0, 0, 0, 1169, 1169, 0, 591, 0,
+	593, 594, 0, 0, 595, 0, 640, 640, 638, 639,
+	597, 598, 599, 643, 0, 0, 410, 411, 412, 640,
+	643, 0, 643, 643, 643, 643, 640, 640, 640, 643,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1346,
+	622, 623, 626, 1347, 1348, 1306, 1346, 1346, 633, 1158,
+	653, 654, 676, 679, 696, 694, 695, 697, 689, 690,
+	691, 692, 0, 709, 710, 715, 0, 0, 0, 720,
+	721, 722, 0, 0, 725, 726, 727, 0, 0, 0,
+	0, 853, 0, 0, 920, 921, 922, 923, 924, 925,
+	926, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 893, 894, 895, 896, 899, 0, 0, 0,
+	904, 905, 0, 0, 0, 0, 0, 0, 745, 0,
+	1061, 0, 948, 0, 949, 951, 952, 0, 953, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1179, 127, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 755, 0, 0, 755, 755,
+	0, 0, 232, 233, 234, 235, 236, 250, 251, 252,
+	253, 254, 304, 1069, 0, 930, 0, 0, 45, 700,
+	701, 0, 817, 1179, 0, 751, 0, 0, 0, 56,
+	65, 67, 1087, 60, 1087, 0, 757, 0, 0, -2,
+	-2, 758, 764, 765, 766, 767, 768, 53, 1344, 54,
+	0, 73, 0, 47, 0, 0, 0, 0, 0, 1080,
+	1081, 1084, 0, 738, 0, 772, 776, 0, 778, 779,
+	0, 0, 0, 0, 833, 0, 0, 0, 1306, 1156,
+	0, 98, 99, 0, 0, 0, 388, 1161, 1162, 1163,
+	-2, 408, 0, 190, 388, 435, 372, 312, 313, 314,
+	315, 368, 322, 368, 368, 368, 368, 377, 377, 377,
+	377, 351, 352, 353, 354, 355, 0, 0, 337, 368,
+	368, 368, 368, 358, 359, 360, 361, 362, 363, 364,
+	365, 316, 323, 324, 325, 326, 327, 328, 329, 330,
+	331, 370, 370, 370, 371, 371, 319, 0, 43, 0,
+	849, 0, 1084, 0, 141, 1085, 1086, 0, 0, 48,
+	155, 153, 1171, 1118, 1171, 1171, 1171, 1171, 118, 0,
+	0, 0, 0, 510, 511, 507, 543, 0, 499, 409,
+	0, -2, 0, 419, 0, 415, 419, 416, 419, 407,
+	420, 0, 0, 0, 0, 499, 0, 533, 534, 535,
+	0, 537, 538, 540, 0, 544, 545, 546, 547, 548,
+	549, 550, 551, 0, 0, 469, 470, 471, 0, 473,
+	474, 491, 492, 493, 475, 476, 477, 485, 486, 487,
+	488, 489, 490, 478, 479, 482, 0, 134, 1109, 0,
+	0, 0, 0, 0, 0, 0, 0, 1169, 0, 0,
+	0, 0, 754, 835, 1191, 1192, 644, 645, 0, 413,
+	414, 643, 643, 600, 630, 0, 643, 604, 631, 605,
+	607, 606, 608, 620, 621, 643, 611, 641, 642, 612,
+	613, 614, 615, 616, 617, 618, 627, 0, 649, 650,
+	628, 629, 0, 677, 681, 682, 683, 0, 0, 712,
+	92, 717, 718, 719, 731, 724, 932, 851, 852, 0,
+	854, 857, 0, 916, 918, 859, 861, 927, 928, 929,
+	0, 0, 0, 0, 0, 865, 869, 872, 873, 874,
+	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
+	890, 1041, 1042, 1043, 907, 305, 306, 0, 908, 0,
+	769, 0, 0, 0, 906, 0, 0, 1193, 1194, 1195,
+	0, 0, 0, 0, 746, 747, 1067, 1064, 0, 0,
+	1027, 1029, 0, 0, 0, 0, 1005, 1006, 1007, 1008,
+	1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
+	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1044, 0, 0,
+	0, 0, 0, 1060, 0, 909, 910, 911, 0, 0,
+	0, 1180, 0, 128, 129, 0, 0, 0, 0, 0,
+	999, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 756, 1070, 0, -2, 0, 0, 0, 0, 0,
+	0, 1145, 0, 703, 704, 706, 0, 837, 818, 0,
+	820, 821, 0, 0, 750, 0, 753, 665, 667, 668,
+	669, 0, 0, 0, 59, 61, 762, 763, 0, 780,
+	759, 55, 50, 0, 0, 796, 1128, 1090, 1091, 0,
+	1083, 0, 0, 76, 777, 773, 0, 849, 1139, 0,
+	0, 1131, 0, 832, 0, 783, 785, 786, 787, 815,
+	0, 790, 0, 0, 0, 0, 0, 94, 834, 1151,
+	97, 0, 0, 102, 103, 1152, 1153, 1154, 1155, 168,
+	-2, 460, 192, 194, 195, 196, 186, -2, 198, 203,
+	0, 0, 0, 208, 0, 210, 0, 0, 0, 215,
+	216, 436, 0, 308, 310, 0, 375, 373, 374, 321,
+	0, 377, 377, 345, 346, 347, 0, 386, 386, 383,
+	348, 349, 350, 0, 0, 338, 339, 340, 341, 0,
+	320, 332, 333, 334, 335, 336, 427, 0, 112, 0,
+	139, 140, 389, 390, 1084, 0, 48, 0, 158, 159,
+	160, 161, 0, 0, 1109, 0, 0, 0, 0, 179,
+	180, 181, 182, 183, 184, 0, 177, 0, 187, 501,
+	500, 425, 0, 421, 368, 0, 0, 419, 419, 404,
+	405, 0, 0, 523, 454, 0, 524, 525, 499, 501,
+	388, 536, 0, 467, 468, 472, 480, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1106, 1107,
+	1108, 0, 585, 0, 0, 451, 90, 1170, 590, 640,
+	610, 632, 640, 602, 609, 624, 673, 716, 0, 0,
+	0, 0, 723, 0, 0, 858, 917, 919, 862, 0,
+	866, 870, 0, 0, 0, 0, 0, 0, 0, 913,
+	937, 0, 939, 940, 0, 0, 1078, 0, 1065, 0,
+	0, 947, 1030, 0, 0, 0, 0, 958, 959, 960,
+	961, 962, 0, 964, 0, 0, 0, 968, 969, 0,
+	0, 970, 0, 0, 0, 0, 0, 0, 0, 0,
+	982, 983, 984, 985, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 996, 933, 0, 0, 755, 0,
+	1031, 1032, 1033, 1034, 1035, 1070, 702, 705, 707, 793,
+	838, 839, 0, 0, 0, 819, 1178, 748, 749, 752,
+	-2, 0, 670, 671, 666, 0, 1072, 0, 849, 781,
+	0, 760, 52, 795, 0, 1082, 0, 814, 1186, 0,
+	1087, 79, 0, 1139, 1147, 0, 1130, 1141, 1143, 0,
+	0, 0, 1135, 0, 0, 0, 0, 0, 0, 822,
+	0, 0, 825, 0, 0, 0, 0, 816, 0, 0,
+	0, 0, 0, 0, -2, 0, 0, 88, 89, 0,
+	0, 0, 100, 101, 0, 0, 107, 177, 462, 193,
+	435, 0, 0, 204, 0, 206, 230, 231, 237, 238,
+	240, 0, 0, 0, 209, 211, 212, 213, 217, 218,
+	219, 214, 307, 309, 0, 311, 376, 0, 342, 343,
+	344, 386, 386, 380, 381, 387, 382, 384, 385, 0,
+	366, 0, 0, 456, 850, 142, 113, 0, 156, 0,
+	0, 0, 132, 133, 144, 0, 164, 505, 0, 0,
+	516, 517, 0, 392, 0, 1084, 0, 0, 403, 406,
+	518, 0, 521, 522, 455, 501, 527, 528, 541, 483,
+	484, 481, 0, 0, 1119, 1120, 1125, 1123, 1124, 119,
+	558, 560, 559, 563, 0, 0, 0, 451, 1092, 0,
+	589, 452, 453, 643, 643, 711, 93, 0, 714, 0,
+	0, 0, 0, 863, 867, 770, 0, 0, 368, 368,
+	1047, 368, 371, 1050, 368, 1052, 368, 1055, 368, 1058,
+	1059, 0, 0, 0, 0, 0, 1062, 946, 1068, 0,
+	0, 0, 0, 957, 0, 0, 0, 0, 130, 131,
+	999, 0, 0, 1001, 1003, 1004, 974, 1000, 0, 0,
+	997, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 934, 935, 0, 1071, 840, 845, 845, 845, 0,
+	0, 1164, 1165, 1073, 1074, 782, 761, 797, 734, 774,
+	77, 0, 80, 0, 0, 0, 1144, 0, 0, 0,
+	0, 849, 784, 810, 812, 0, 807, 823, 824, 826,
+	0, 828, 0, 830, 831, 794, 789, 791, 0, 0,
+	0, 849, 0, 849, 849, 95, 0, 836, 104, 105,
+	106, 461, 197, 0, 201, 0, 241, 242, 207, 0,
+	369, 378, 379, 377, 0, 377, 317, 0, 437, 457,
+	157, 0, 1172, 0, 0, 145, 146, 0, 0, 0,
+	188, 502, 391, 393, 394, 396, 0, 398, 399, 0,
+	0, 422, 423, 1084, 0, 519, 0, 526, 794, 1110,
+	1111, 1112, 0, 1122, 1126, 122, 0, 0, 0, 0,
+	586, 587, 588, 1096, 1095, 401, 401, 91, 596, 601,
+	713, 732, 856, 864, 868, 0, 901, 1045, 377, 1048,
+	1049, 1051, 1053, 1054, 1056, 1057, 902, 903, 938, 0,
+	942, 0, 944, 1087, 0, 1066, 0, 0, 956, 0,
+	965, 966, 967, 0, 0, 973, 0, 975, 0, 0,
+	0, 986, 987, 988, 989, 990, 991, 992, 993, 994,
+	995, 936, 0, 0, 0, 0, -2, 63, 1076, 0,
+	0, 1148, 1149, 1142, 0, 1134, 1137, 1133, 1136, 1078,
+	804, 811, 0, 0, 805, 0, 806, 827, 829, 788,
+	-2, 792, 0, 1078, 849, 86, 87, 0, 0, 205,
+	0, 356, 367, 357, 0, 162, 794, 0, 148, 149,
+	151, 147, 135, 508, 395, 397, 0, 1181, 424, 0,
+	0, 0, 1121, 557, 0, 120, 121, 0, 0, 562,
+	1093, 1094, 0, 0, 1036, 1046, 0, 0, 0, 1063,
+	0, 0, 963, 971, 0, 1002, 0, 978, 0, 0,
+	0, 581, 582, 0, 998, 0, 846, 0, 0, 0,
+	0, 78, 0, 0, 0, 1132, 0, 1087, 808, 0,
+	813, 0, 1087, 85, 0, 442, 318, 114, 163, 0,
+	0, 0, 400, 429, 520, 0, 123, 124, 0, 0,
+	0, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 0,
+	0, 0, 941, 943, 945, 954, 955, 972, 976, 0,
+	979, 0, 0, 980, 0, 583, 0, 842, 800, 801,
+	847, 848, 0, 0, 1077, 1075, 1140, 1138, 83, 0,
+	0, 0, 84, 222, 430, 443, 0, 0, 143, 150,
+	1113, 0, 0, 0, 564, 900, 0, 0, 0, 999,
+	981, 0, 579, 580, 0, 841, 843, 844, 809, 817,
+	0, 435, 431, 432, 0, 438, 439, 440, 441, 1113,
+	555, 1115, 125, 126, 494, 495, 496, 119, 1037, 0,
+	1040, 0, 802, 803, 565, 0, 567, 0, 575, 199,
+	223, 224, 0, 0, 227, 228, 229, 220, 221, 433,
+	0, 434, 0, 0, 0, 115, 0, 1116, 1117, 122,
+	1038, 977, 566, 0, 0, 388, 0, 576, 225, 226,
+	444, 446, 447, 0, 0, 445, 0, 561, 0, 568,
+	569, 577, 0, 448, 449, 450, 1114, 0, 0, 578,
+	574, 1039, 0, 570, 571, 572, 573,
 }
 
 var sqlite3Tok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 402, 3, 3, 3, 428, 420, 3,
-	349, 351, 425, 423, 350, 424, 478, 426, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 860,
-	410, 409, 411, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 403, 3, 3, 3, 429, 421, 3,
+	349, 351, 426, 424, 350, 425, 480, 427, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 862,
+	411, 410, 412, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 430, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 431, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 399, 3, 431,
+	3, 3, 3, 3, 400, 3, 433,
 }
 
 var sqlite3Tok2 = [...]int{
@@ -5237,11 +5421,11 @@ var sqlite3Tok3 = [...]int{
 	57720, 381, 57721, 382, 57722, 383, 57723, 384, 57724, 385,
 	57725, 386, 57726, 387, 57727, 388, 57728, 389, 57729, 390,
 	57730, 391, 57731, 392, 57732, 393, 57733, 394, 57734, 395,
-	57735, 396, 57736, 397, 57737, 398, 57738, 400, 57739, 401,
-	57740, 403, 57741, 404, 57742, 405, 57743, 406, 57744, 407,
-	57745, 408, 57746, 412, 57747, 413, 57748, 414, 57749, 415,
-	57750, 416, 57751, 417, 57752, 418, 57753, 419, 57754, 421,
-	57755, 422, 57756, 427, 57757, 429, 57758, 432, 57759, 433,
+	57735, 396, 57736, 397, 57737, 398, 57738, 399, 57739, 401,
+	57740, 402, 57741, 404, 57742, 405, 57743, 406, 57744, 407,
+	57745, 408, 57746, 409, 57747, 413, 57748, 414, 57749, 415,
+	57750, 416, 57751, 417, 57752, 418, 57753, 419, 57754, 420,
+	57755, 422, 57756, 423, 57757, 428, 57758, 430, 57759, 432,
 	57760, 434, 57761, 435, 57762, 436, 57763, 437, 57764, 438,
 	57765, 439, 57766, 440, 57767, 441, 57768, 442, 57769, 443,
 	57770, 444, 57771, 445, 57772, 446, 57773, 447, 57774, 448,
@@ -5250,8 +5434,8 @@ var sqlite3Tok3 = [...]int{
 	57785, 459, 57786, 460, 57787, 461, 57788, 462, 57789, 463,
 	57790, 464, 57791, 465, 57792, 466, 57793, 467, 57794, 468,
 	57795, 469, 57796, 470, 57797, 471, 57798, 472, 57799, 473,
-	57800, 474, 57801, 475, 57802, 476, 57803, 477, 57804, 479,
-	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57800, 474, 57801, 475, 57802, 476, 57803, 477, 57804, 478,
+	57805, 479, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
 	57810, 485, 57811, 486, 57812, 487, 57813, 488, 57814, 489,
 	57815, 490, 57816, 491, 57817, 492, 57818, 493, 57819, 494,
 	57820, 495, 57821, 496, 57822, 497, 57823, 498, 57824, 499,
@@ -5327,7 +5511,7 @@ var sqlite3Tok3 = [...]int{
 	58170, 845, 58171, 846, 58172, 847, 58173, 848, 58174, 849,
 	58175, 850, 58176, 851, 58177, 852, 58178, 853, 58179, 854,
 	58180, 855, 58181, 856, 58182, 857, 58183, 858, 58184, 859,
-	0,
+	58185, 860, 58186, 861, 0,
 }
 
 var sqlite3ErrorMessages = [...]struct {
@@ -5359,6 +5543,11 @@ type sqlite3Lexer interface {
 	Error(s string)
 }
 
+// sqlite3ExpectedLexer is implemented by the lexers that report the expected tokens of the syntax error
+type sqlite3ExpectedLexer interface {
+	SetExpectedTokens(tokens []string)
+}
+
 type sqlite3Parser interface {
 	Parse(sqlite3Lexer) int
 	Lookahead() int
@@ -5462,6 +5651,43 @@ func sqlite3ErrorMessage(state, lookAhead int) string {
 	return res
 }
 
+// sqlite3ExpectedTokens returns the names of all tokens the parser accepts in the state,
+// nil is returned if the default action of the state is to accept or reduce
+func sqlite3ExpectedTokens(state int) []string {
+	const TOKSTART = 4
+
+	expected := make([]string, 0)
+
+	// Look for shiftable tokens.
+	base := sqlite3Pact[state]
+	for tok := TOKSTART; tok-1 < len(sqlite3Toknames); tok++ {
+		if n := base + tok; n >= 0 && n < sqlite3Last && sqlite3Chk[sqlite3Act[n]] == tok {
+			expected = append(expected, sqlite3Tokname(tok))
+		}
+	}
+
+	if sqlite3Def[state] == -2 {
+		i := 0
+		for sqlite3Exca[i] != -1 || sqlite3Exca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; sqlite3Exca[i] >= 0; i += 2 {
+			tok := sqlite3Exca[i]
+			if tok < TOKSTART || sqlite3Exca[i+1] == 0 {
+				continue
+			}
+			expected = append(expected, sqlite3Tokname(tok))
+		}
+
+		if sqlite3Exca[i+1] != 0 {
+			return nil
+		}
+	}
+	return expected
+}
+
 func sqlite3lex1(lex sqlite3Lexer, lval *sqlite3SymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
@@ -5602,6 +5828,9 @@ sqlite3default:
 		/* error ... attempt to resume parsing */
 		switch Errflag {
 		case 0: /* brand new error */
+			if lexer, ok := sqlite3lex.(sqlite3ExpectedLexer); ok {
+				lexer.SetExpectedTokens(sqlite3ExpectedTokens(sqlite3state))
+			}
 			sqlite3lex.Error(sqlite3ErrorMessage(sqlite3state, sqlite3token))
 			Nerrs++
 			if sqlite3Debug >= 1 {
@@ -5682,24 +5911,24 @@ sqlite3default:
 
 	case 1:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:558
+//line sqlite3.y:576
 		{
 			setParseTree(sqlite3lex, sqlite3Dollar[1].statementUnion())
 		}
 	case 2:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:563
+//line sqlite3.y:581
 		{
 		}
 	case 3:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:564
+//line sqlite3.y:582
 		{
 		}
 	case 4:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:568
+//line sqlite3.y:586
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
@@ -5707,176 +5936,176 @@ sqlite3default:
 	case 5:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:572
+//line sqlite3.y:590
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].statementUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 37:
+	case 38:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:607
+//line sqlite3.y:626
 		{
 			setParseTree(sqlite3lex, nil)
 		}
-	case 38:
+	case 39:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:613
+//line sqlite3.y:632
 		{
 			sqlite3VAL.colIdent = ast.NewColIdentWithAt(string(sqlite3Dollar[1].str), ast.NoAt)
 		}
-	case 39:
+	case 40:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:617
+//line sqlite3.y:636
 		{
 			sqlite3VAL.colIdent = ast.NewColIdentWithAt(string(sqlite3Dollar[1].str), ast.SingleAt)
 		}
-	case 40:
+	case 41:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:621
+//line sqlite3.y:640
 		{
 			sqlite3VAL.colIdent = ast.NewColIdentWithAt(string(sqlite3Dollar[1].str), ast.DoubleAt)
 		}
-	case 41:
+	case 42:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:626
+//line sqlite3.y:645
 		{
 			sqlite3VAL.colIdent = ast.NewColIdentWithAt("", ast.NoAt)
 		}
-	case 42:
+	case 43:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:630
+//line sqlite3.y:649
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[1].colIdent
 		}
-	case 43:
+	case 44:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:636
+//line sqlite3.y:655
 		{
 			sqlite3LOCAL = &ast.OtherAdmin{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 44:
+	case 45:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:642
+//line sqlite3.y:661
 		{
 			sqlite3LOCAL = &ast.Load{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 45:
+	case 46:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.With
-//line sqlite3.y:648
+//line sqlite3.y:667
 		{
 			sqlite3LOCAL = &ast.With{Ctes: sqlite3Dollar[2].ctesUnion(), Recursive: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 46:
+	case 47:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.With
-//line sqlite3.y:652
+//line sqlite3.y:671
 		{
 			sqlite3LOCAL = &ast.With{Ctes: sqlite3Dollar[3].ctesUnion(), Recursive: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 47:
+	case 48:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.With
-//line sqlite3.y:657
+//line sqlite3.y:676
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 48:
+	case 49:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.With
-//line sqlite3.y:661
+//line sqlite3.y:680
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].withUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 49:
+	case 50:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:667
+//line sqlite3.y:686
 		{
 			sqlite3SLICE := (*[]*ast.CommonTableExpr)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].cteUnion())
 		}
-	case 50:
+	case 51:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.CommonTableExpr
-//line sqlite3.y:671
+//line sqlite3.y:690
 		{
 			sqlite3LOCAL = []*ast.CommonTableExpr{sqlite3Dollar[1].cteUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 51:
+	case 52:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.CommonTableExpr
-//line sqlite3.y:677
+//line sqlite3.y:696
 		{
 			sqlite3LOCAL = &ast.CommonTableExpr{TableID: sqlite3Dollar[1].tableIdent, Columns: sqlite3Dollar[2].columnsUnion(), Subquery: sqlite3Dollar[4].subqueryUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 52:
+	case 53:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:683
+//line sqlite3.y:702
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 53:
+	case 54:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:687
+//line sqlite3.y:706
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 54:
+	case 55:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:691
+//line sqlite3.y:710
 		{
 			ast.SetLockInSelect(sqlite3Dollar[2].selStmtUnion(), sqlite3Dollar[3].lockUnion())
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 55:
+	case 56:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:714
+//line sqlite3.y:733
 		{
 			sqlite3Dollar[1].selStmtUnion().SetOrderBy(sqlite3Dollar[2].orderByUnion())
 			sqlite3Dollar[1].selStmtUnion().SetLimit(sqlite3Dollar[3].limitUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 56:
+	case 57:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:720
+//line sqlite3.y:739
 		{
 			sqlite3Dollar[1].selStmtUnion().SetLimit(sqlite3Dollar[2].limitUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 57:
+	case 58:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:725
+//line sqlite3.y:744
 		{
 			sqlite3Dollar[1].selStmtUnion().SetOrderBy(sqlite3Dollar[2].orderByUnion())
 			sqlite3Dollar[1].selStmtUnion().SetLimit(sqlite3Dollar[3].limitUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 58:
+	case 59:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:731
+//line sqlite3.y:750
 		{
 			sqlite3Dollar[2].selStmtUnion().SetWith(sqlite3Dollar[1].withUnion())
 			sqlite3Dollar[2].selStmtUnion().SetOrderBy(sqlite3Dollar[3].orderByUnion())
@@ -5884,20 +6113,20 @@ sqlite3default:
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 59:
+	case 60:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:738
+//line sqlite3.y:757
 		{
 			sqlite3Dollar[2].selStmtUnion().SetWith(sqlite3Dollar[1].withUnion())
 			sqlite3Dollar[2].selStmtUnion().SetLimit(sqlite3Dollar[3].limitUnion())
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 60:
+	case 61:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:744
+//line sqlite3.y:763
 		{
 			sqlite3Dollar[2].selStmtUnion().SetWith(sqlite3Dollar[1].withUnion())
 			sqlite3Dollar[2].selStmtUnion().SetOrderBy(sqlite3Dollar[3].orderByUnion())
@@ -5905,147 +6134,147 @@ sqlite3default:
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 61:
+	case 62:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:751
+//line sqlite3.y:770
 		{
 			sqlite3Dollar[2].selStmtUnion().SetWith(sqlite3Dollar[1].withUnion())
 		}
-	case 62:
+	case 63:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:755
+//line sqlite3.y:774
 		{
 			sqlite3LOCAL = ast.NewSelect(ast.Comments(sqlite3Dollar[2].strs), ast.SelectExprs{&ast.Nextval{Expr: sqlite3Dollar[5].exprUnion()}}, []string{sqlite3Dollar[3].str} /*options*/, nil, ast.TableExprs{&ast.AliasedTableExpr{Expr: sqlite3Dollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 63:
+	case 64:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:761
+//line sqlite3.y:780
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 64:
+	case 65:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:765
+//line sqlite3.y:784
 		{
 			sqlite3LOCAL = &ast.Union{Left: sqlite3Dollar[1].selStmtUnion(), Distinct: sqlite3Dollar[2].booleanUnion(), Right: sqlite3Dollar[3].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 65:
+	case 66:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:769
+//line sqlite3.y:788
 		{
 			sqlite3LOCAL = &ast.Union{Left: sqlite3Dollar[1].selStmtUnion(), Distinct: sqlite3Dollar[2].booleanUnion(), Right: sqlite3Dollar[3].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 66:
+	case 67:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:773
+//line sqlite3.y:792
 		{
 			sqlite3LOCAL = &ast.Union{Left: sqlite3Dollar[1].selStmtUnion(), Distinct: sqlite3Dollar[2].booleanUnion(), Right: sqlite3Dollar[3].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 67:
+	case 68:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:777
+//line sqlite3.y:796
 		{
 			sqlite3LOCAL = &ast.Union{Left: sqlite3Dollar[1].selStmtUnion(), Distinct: sqlite3Dollar[2].booleanUnion(), Right: sqlite3Dollar[3].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 68:
+	case 69:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:783
+//line sqlite3.y:802
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 69:
+	case 70:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:787
+//line sqlite3.y:806
 		{
 			ast.SetLockInSelect(sqlite3Dollar[1].selStmtUnion(), sqlite3Dollar[2].lockUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 70:
+	case 71:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:792
+//line sqlite3.y:811
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 71:
+	case 72:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:796
+//line sqlite3.y:815
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 72:
+	case 73:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:802
+//line sqlite3.y:821
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 73:
+	case 74:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:806
+//line sqlite3.y:825
 		{
 			sqlite3Dollar[1].selStmtUnion().SetInto(sqlite3Dollar[2].selectIntoUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 74:
+	case 75:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:811
+//line sqlite3.y:830
 		{
 			sqlite3Dollar[1].selStmtUnion().SetInto(sqlite3Dollar[2].selectIntoUnion())
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 75:
+	case 76:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:818
+//line sqlite3.y:837
 		{
 			sqlite3LOCAL = &ast.Stream{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), SelectExpr: sqlite3Dollar[3].selectExprUnion(), Table: sqlite3Dollar[5].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 76:
+	case 77:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:824
+//line sqlite3.y:843
 		{
 			sqlite3LOCAL = &ast.VStream{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), SelectExpr: sqlite3Dollar[3].selectExprUnion(), Table: sqlite3Dollar[5].tableName, Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[6].exprUnion()), Limit: sqlite3Dollar[7].limitUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 77:
+	case 78:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectStatement
-//line sqlite3.y:832
+//line sqlite3.y:851
 		{
 			sqlite3LOCAL = ast.NewSelect(ast.Comments(sqlite3Dollar[2].strs), sqlite3Dollar[4].selectExprsUnion() /*SelectExprs*/, sqlite3Dollar[3].strs /*options*/, nil, sqlite3Dollar[5].tableExprsUnion() /*from*/, ast.NewWhere(ast.WhereClause, sqlite3Dollar[6].exprUnion()), ast.GroupBy(sqlite3Dollar[7].exprsUnion()), ast.NewWhere(ast.HavingClause, sqlite3Dollar[8].exprUnion()))
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 78:
+	case 79:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:840
+//line sqlite3.y:859
 		{
 			// insert_data returns a *ast.Insert pre-filled with Columns & Values
 			ins := sqlite3Dollar[5].insUnion()
@@ -6057,10 +6286,10 @@ sqlite3default:
 			sqlite3LOCAL = ins
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 79:
+	case 80:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:851
+//line sqlite3.y:870
 		{
 			cols := make(ast.Columns, 0, len(sqlite3Dollar[6].updateExprsUnion()))
 			vals := make(ast.ValTuple, 0, len(sqlite3Dollar[7].updateExprsUnion()))
@@ -6071,242 +6300,242 @@ sqlite3default:
 			sqlite3LOCAL = &ast.Insert{Action: sqlite3Dollar[1].insertActionUnion(), Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Ignore: sqlite3Dollar[3].ignoreUnion(), Table: sqlite3Dollar[4].tableName, Columns: cols, Rows: ast.Values{vals}, OnDup: ast.OnDup(sqlite3Dollar[7].updateExprsUnion())}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 80:
+	case 81:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.InsertAction
-//line sqlite3.y:863
+//line sqlite3.y:882
 		{
 			sqlite3LOCAL = ast.InsertAct
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 81:
+	case 82:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.InsertAction
-//line sqlite3.y:867
+//line sqlite3.y:886
 		{
 			sqlite3LOCAL = ast.ReplaceAct
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 82:
+	case 83:
 		sqlite3Dollar = sqlite3S[sqlite3pt-10 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:873
+//line sqlite3.y:892
 		{
 			sqlite3LOCAL = &ast.Update{With: sqlite3Dollar[1].withUnion(), Comments: ast.Comments(sqlite3Dollar[3].strs).Parsed(), Ignore: sqlite3Dollar[4].ignoreUnion(), TableExprs: sqlite3Dollar[5].tableExprsUnion(), Exprs: sqlite3Dollar[7].updateExprsUnion(), Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[8].exprUnion()), OrderBy: sqlite3Dollar[9].orderByUnion(), Limit: sqlite3Dollar[10].limitUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 83:
+	case 84:
 		sqlite3Dollar = sqlite3S[sqlite3pt-10 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:879
+//line sqlite3.y:898
 		{
 			sqlite3LOCAL = &ast.Delete{With: sqlite3Dollar[1].withUnion(), Comments: ast.Comments(sqlite3Dollar[3].strs).Parsed(), Ignore: sqlite3Dollar[4].ignoreUnion(), TableExprs: ast.TableExprs{&ast.AliasedTableExpr{Expr: sqlite3Dollar[6].tableName, As: sqlite3Dollar[7].tableIdent}}, Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[8].exprUnion()), OrderBy: sqlite3Dollar[9].orderByUnion(), Limit: sqlite3Dollar[10].limitUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 84:
+	case 85:
 		sqlite3Dollar = sqlite3S[sqlite3pt-9 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:883
+//line sqlite3.y:902
 		{
 			sqlite3LOCAL = &ast.Delete{With: sqlite3Dollar[1].withUnion(), Comments: ast.Comments(sqlite3Dollar[3].strs).Parsed(), Ignore: sqlite3Dollar[4].ignoreUnion(), Targets: sqlite3Dollar[6].tableNamesUnion(), TableExprs: sqlite3Dollar[8].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[9].exprUnion())}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 85:
+	case 86:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:887
+//line sqlite3.y:906
 		{
 			sqlite3LOCAL = &ast.Delete{With: sqlite3Dollar[1].withUnion(), Comments: ast.Comments(sqlite3Dollar[3].strs).Parsed(), Ignore: sqlite3Dollar[4].ignoreUnion(), Targets: sqlite3Dollar[5].tableNamesUnion(), TableExprs: sqlite3Dollar[7].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[8].exprUnion())}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 86:
+	case 87:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:891
+//line sqlite3.y:910
 		{
 			sqlite3LOCAL = &ast.Delete{With: sqlite3Dollar[1].withUnion(), Comments: ast.Comments(sqlite3Dollar[3].strs).Parsed(), Ignore: sqlite3Dollar[4].ignoreUnion(), Targets: sqlite3Dollar[5].tableNamesUnion(), TableExprs: sqlite3Dollar[7].tableExprsUnion(), Where: ast.NewWhere(ast.WhereClause, sqlite3Dollar[8].exprUnion())}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 87:
+	case 88:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:896
+//line sqlite3.y:915
 		{
 		}
-	case 88:
+	case 89:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:897
+//line sqlite3.y:916
 		{
 		}
-	case 89:
+	case 90:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableNames
-//line sqlite3.y:901
+//line sqlite3.y:920
 		{
 			sqlite3LOCAL = ast.TableNames{sqlite3Dollar[1].tableName.ToViewName()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 90:
+	case 91:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:905
+//line sqlite3.y:924
 		{
 			sqlite3SLICE := (*ast.TableNames)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableName.ToViewName())
 		}
-	case 91:
+	case 92:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableNames
-//line sqlite3.y:911
+//line sqlite3.y:930
 		{
 			sqlite3LOCAL = ast.TableNames{sqlite3Dollar[1].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 92:
+	case 93:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:915
+//line sqlite3.y:934
 		{
 			sqlite3SLICE := (*ast.TableNames)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableName)
 		}
-	case 93:
+	case 94:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableNames
-//line sqlite3.y:921
+//line sqlite3.y:940
 		{
 			sqlite3LOCAL = ast.TableNames{sqlite3Dollar[1].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 94:
+	case 95:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:925
+//line sqlite3.y:944
 		{
 			sqlite3SLICE := (*ast.TableNames)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableName)
 		}
-	case 95:
+	case 96:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:931
+//line sqlite3.y:950
 		{
 			sqlite3LOCAL = &ast.Set{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Exprs: sqlite3Dollar[3].setExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 96:
+	case 97:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:937
+//line sqlite3.y:956
 		{
 			sqlite3LOCAL = &ast.SetTransaction{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Scope: sqlite3Dollar[3].scopeUnion(), Characteristics: sqlite3Dollar[5].characteristicsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 97:
+	case 98:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:941
+//line sqlite3.y:960
 		{
 			sqlite3LOCAL = &ast.SetTransaction{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Characteristics: sqlite3Dollar[4].characteristicsUnion(), Scope: ast.ImplicitScope}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 98:
+	case 99:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.Characteristic
-//line sqlite3.y:947
+//line sqlite3.y:966
 		{
 			sqlite3LOCAL = []ast.Characteristic{sqlite3Dollar[1].characteristicUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 99:
+	case 100:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:951
+//line sqlite3.y:970
 		{
 			sqlite3SLICE := (*[]ast.Characteristic)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].characteristicUnion())
 		}
-	case 100:
+	case 101:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Characteristic
-//line sqlite3.y:957
+//line sqlite3.y:976
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].isolationLevelUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 101:
+	case 102:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Characteristic
-//line sqlite3.y:961
+//line sqlite3.y:980
 		{
 			sqlite3LOCAL = ast.ReadWrite
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 102:
+	case 103:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Characteristic
-//line sqlite3.y:965
+//line sqlite3.y:984
 		{
 			sqlite3LOCAL = ast.ReadOnly
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 103:
+	case 104:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsolationLevel
-//line sqlite3.y:971
+//line sqlite3.y:990
 		{
 			sqlite3LOCAL = ast.RepeatableRead
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 104:
+	case 105:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsolationLevel
-//line sqlite3.y:975
+//line sqlite3.y:994
 		{
 			sqlite3LOCAL = ast.ReadCommitted
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 105:
+	case 106:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsolationLevel
-//line sqlite3.y:979
+//line sqlite3.y:998
 		{
 			sqlite3LOCAL = ast.ReadUncommitted
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 106:
+	case 107:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsolationLevel
-//line sqlite3.y:983
+//line sqlite3.y:1002
 		{
 			sqlite3LOCAL = ast.Serializable
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 107:
+	case 108:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Scope
-//line sqlite3.y:989
+//line sqlite3.y:1008
 		{
 			sqlite3LOCAL = ast.SessionScope
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 108:
+	case 109:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Scope
-//line sqlite3.y:993
+//line sqlite3.y:1012
 		{
 			sqlite3LOCAL = ast.GlobalScope
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 109:
+	case 110:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:999
+//line sqlite3.y:1018
 		{
 			sqlite3Dollar[1].createTableUnion().TableSpec = sqlite3Dollar[2].tableSpecUnion()
 			sqlite3Dollar[1].createTableUnion().FullyParsed = true
 			sqlite3LOCAL = sqlite3Dollar[1].createTableUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 110:
+	case 111:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:1005
+//line sqlite3.y:1024
 		{
 			// Create table [name] like [name]
 			sqlite3Dollar[1].createTableUnion().OptLike = sqlite3Dollar[2].optLikeUnion()
@@ -6314,425 +6543,621 @@ sqlite3default:
 			sqlite3LOCAL = sqlite3Dollar[1].createTableUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 111:
+	case 112:
+		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:1031
+		{
+			sqlite3Dollar[1].createIndexUnion().Columns = sqlite3Dollar[3].indexElementsUnion()
+			sqlite3Dollar[1].createIndexUnion().Where = ast.NewWhere(ast.WhereClause, sqlite3Dollar[5].exprUnion())
+			sqlite3Dollar[1].createIndexUnion().FullyParsed = true
+			sqlite3LOCAL = sqlite3Dollar[1].createIndexUnion()
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 113:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:1012
+//line sqlite3.y:1038
 		{
-			indexDef := sqlite3Dollar[1].alterTableUnion().AlterOptions[0].(*ast.AddIndexDefinition).IndexDefinition
-			indexDef.Columns = sqlite3Dollar[3].indexColumnsUnion()
-			indexDef.Options = append(indexDef.Options, sqlite3Dollar[5].indexOptionsUnion()...)
-			sqlite3Dollar[1].alterTableUnion().AlterOptions = append(sqlite3Dollar[1].alterTableUnion().AlterOptions, sqlite3Dollar[6].alterOptionsUnion()...)
-			sqlite3Dollar[1].alterTableUnion().FullyParsed = true
-			sqlite3LOCAL = sqlite3Dollar[1].alterTableUnion()
+			sqlite3Dollar[1].createTriggerUnion().ForEachRow = sqlite3Dollar[2].booleanUnion()
+			sqlite3Dollar[1].createTriggerUnion().When = sqlite3Dollar[3].exprUnion()
+			sqlite3Dollar[1].createTriggerUnion().Body = sqlite3Dollar[5].statementsUnion()
+			sqlite3LOCAL = sqlite3Dollar[1].createTriggerUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 112:
+	case 114:
+		sqlite3Dollar = sqlite3S[sqlite3pt-9 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:1045
+		{
+			sqlite3LOCAL = &ast.CreateVirtualTable{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), IfNotExists: sqlite3Dollar[5].booleanUnion(), Table: sqlite3Dollar[6].tableName, Module: sqlite3Dollar[8].colIdent, Arguments: sqlite3Dollar[9].str}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 115:
 		sqlite3Dollar = sqlite3S[sqlite3pt-12 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:1021
+//line sqlite3.y:1049
 		{
 			sqlite3LOCAL = &ast.CreateView{ViewName: sqlite3Dollar[8].tableName.ToViewName(), Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), IsReplace: sqlite3Dollar[3].booleanUnion(), Algorithm: sqlite3Dollar[4].str, Definer: sqlite3Dollar[5].definerUnion(), Security: sqlite3Dollar[6].str, Columns: sqlite3Dollar[9].columnsUnion(), Select: sqlite3Dollar[11].selStmtUnion(), CheckOption: sqlite3Dollar[12].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 113:
+	case 116:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:1025
+//line sqlite3.y:1053
 		{
 			sqlite3Dollar[1].createDatabaseUnion().FullyParsed = true
 			sqlite3Dollar[1].createDatabaseUnion().CreateOptions = sqlite3Dollar[2].databaseOptionsUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].createDatabaseUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 114:
+	case 117:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:1032
+//line sqlite3.y:1060
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 115:
+	case 118:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:1036
+//line sqlite3.y:1064
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 116:
+	case 119:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:1041
+//line sqlite3.y:1069
 		{
 			sqlite3VAL.colIdent = ast.NewColIdent("")
 		}
-	case 117:
+	case 120:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1045
+//line sqlite3.y:1073
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[2].colIdent
 		}
-	case 118:
+	case 121:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1051
+//line sqlite3.y:1079
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[1].colIdent
 		}
-	case 119:
+	case 122:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.VindexParam
-//line sqlite3.y:1056
+//line sqlite3.y:1084
 		{
 			var v []ast.VindexParam
 			sqlite3LOCAL = v
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 120:
+	case 123:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.VindexParam
-//line sqlite3.y:1061
+//line sqlite3.y:1089
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].vindexParamsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 121:
+	case 124:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.VindexParam
-//line sqlite3.y:1067
+//line sqlite3.y:1095
 		{
 			sqlite3LOCAL = make([]ast.VindexParam, 0, 4)
 			sqlite3LOCAL = append(sqlite3LOCAL, sqlite3Dollar[1].vindexParam)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 122:
+	case 125:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1072
+//line sqlite3.y:1100
 		{
 			sqlite3SLICE := (*[]ast.VindexParam)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].vindexParam)
 		}
-	case 123:
+	case 126:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1078
+//line sqlite3.y:1106
 		{
 			sqlite3VAL.vindexParam = ast.VindexParam{Key: sqlite3Dollar[1].colIdent, Val: sqlite3Dollar[3].str}
 		}
-	case 124:
+	case 127:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.JSONObjectParam
-//line sqlite3.y:1083
+//line sqlite3.y:1111
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 125:
+	case 128:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.JSONObjectParam
-//line sqlite3.y:1087
+//line sqlite3.y:1115
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].jsonObjectParamsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 126:
+	case 129:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.JSONObjectParam
-//line sqlite3.y:1093
+//line sqlite3.y:1121
 		{
 			sqlite3LOCAL = []*ast.JSONObjectParam{sqlite3Dollar[1].jsonObjectParam}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 127:
+	case 130:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1097
+//line sqlite3.y:1125
 		{
 			sqlite3SLICE := (*[]*ast.JSONObjectParam)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].jsonObjectParam)
 		}
-	case 128:
+	case 131:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1103
+//line sqlite3.y:1131
 		{
 			sqlite3VAL.jsonObjectParam = &ast.JSONObjectParam{Key: sqlite3Dollar[1].exprUnion(), Value: sqlite3Dollar[3].exprUnion()}
 		}
-	case 129:
+	case 132:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.CreateTable
-//line sqlite3.y:1109
+//line sqlite3.y:1137
 		{
 			sqlite3LOCAL = &ast.CreateTable{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Table: sqlite3Dollar[6].tableName, IfNotExists: sqlite3Dollar[5].booleanUnion(), Temp: sqlite3Dollar[3].booleanUnion()}
 			setDDL(sqlite3lex, sqlite3LOCAL)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 130:
-		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.AlterTable
-//line sqlite3.y:1116
+	case 133:
+		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.CreateTable
+//line sqlite3.y:1142
 		{
-			sqlite3LOCAL = &ast.AlterTable{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Table: sqlite3Dollar[4].tableName}
+			// The .dump command writes the names of the shadow tables as strings
+			sqlite3LOCAL = &ast.CreateTable{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Table: ast.TableName{Name: ast.NewTableIdent(sqlite3Dollar[6].str)}, IfNotExists: sqlite3Dollar[5].booleanUnion(), Temp: sqlite3Dollar[3].booleanUnion()}
 			setDDL(sqlite3lex, sqlite3LOCAL)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 131:
-		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
+	case 134:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.AlterTable
-//line sqlite3.y:1123
+//line sqlite3.y:1150
 		{
-			sqlite3LOCAL = &ast.AlterTable{Table: sqlite3Dollar[7].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: sqlite3Dollar[4].colIdent, Type: string(sqlite3Dollar[3].str)}, Options: sqlite3Dollar[5].indexOptionsUnion()}}}}
+			sqlite3LOCAL = &ast.AlterTable{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Table: sqlite3Dollar[4].tableName}
 			setDDL(sqlite3lex, sqlite3LOCAL)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 132:
+	case 135:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.AlterTable
-//line sqlite3.y:1128
+		var sqlite3LOCAL *ast.CreateIndex
+//line sqlite3.y:1157
 		{
-			sqlite3LOCAL = &ast.AlterTable{Table: sqlite3Dollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: sqlite3Dollar[5].colIdent, Type: string(sqlite3Dollar[3].str) + " " + string(sqlite3Dollar[4].str), Fulltext: true}, Options: sqlite3Dollar[6].indexOptionsUnion()}}}}
+			sqlite3LOCAL = &ast.CreateIndex{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Unique: sqlite3Dollar[3].booleanUnion(), IfNotExists: sqlite3Dollar[5].booleanUnion(), Name: sqlite3Dollar[6].colIdent, Table: sqlite3Dollar[8].tableName}
 			setDDL(sqlite3lex, sqlite3LOCAL)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 133:
-		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.AlterTable
-//line sqlite3.y:1133
+	case 136:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+		var sqlite3LOCAL bool
+//line sqlite3.y:1163
 		{
-			sqlite3LOCAL = &ast.AlterTable{Table: sqlite3Dollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: sqlite3Dollar[5].colIdent, Type: string(sqlite3Dollar[3].str) + " " + string(sqlite3Dollar[4].str), Spatial: true}, Options: sqlite3Dollar[6].indexOptionsUnion()}}}}
-			setDDL(sqlite3lex, sqlite3LOCAL)
+			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 134:
-		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.AlterTable
-//line sqlite3.y:1138
+	case 137:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL bool
+//line sqlite3.y:1167
 		{
-			sqlite3LOCAL = &ast.AlterTable{Table: sqlite3Dollar[8].tableName, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{Info: &ast.IndexInfo{Name: sqlite3Dollar[5].colIdent, Type: string(sqlite3Dollar[3].str) + " " + string(sqlite3Dollar[4].str), Unique: true}, Options: sqlite3Dollar[6].indexOptionsUnion()}}}}
-			setDDL(sqlite3lex, sqlite3LOCAL)
+			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 135:
-		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.CreateDatabase
-//line sqlite3.y:1145
+	case 138:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL []*ast.IndexElement
+//line sqlite3.y:1173
 		{
-			sqlite3LOCAL = &ast.CreateDatabase{Comments: ast.Comments(sqlite3Dollar[4].strs).Parsed(), DBName: sqlite3Dollar[6].tableIdent, IfNotExists: sqlite3Dollar[5].booleanUnion()}
-			setDDL(sqlite3lex, sqlite3LOCAL)
+			sqlite3LOCAL = []*ast.IndexElement{sqlite3Dollar[1].indexElementUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 136:
+	case 139:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.AlterDatabase
-//line sqlite3.y:1152
+//line sqlite3.y:1177
 		{
-			sqlite3LOCAL = &ast.AlterDatabase{}
-			setDDL(sqlite3lex, sqlite3LOCAL)
+			sqlite3SLICE := (*[]*ast.IndexElement)(sqlite3Iaddr(sqlite3VAL.union))
+			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].indexElementUnion())
 		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 139:
-		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.TableSpec
-//line sqlite3.y:1163
+	case 140:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.IndexElement
+//line sqlite3.y:1183
 		{
-			sqlite3LOCAL = sqlite3Dollar[2].tableSpecUnion()
-			sqlite3LOCAL.Options = sqlite3Dollar[4].tableOptionsUnion()
+			sqlite3LOCAL = &ast.IndexElement{Column: sqlite3Dollar[1].colIdent, Collate: sqlite3Dollar[2].str, Direction: sqlite3Dollar[3].orderDirectionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 140:
+	case 141:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.IndexElement
+//line sqlite3.y:1187
+		{
+			sqlite3LOCAL = &ast.IndexElement{Expression: sqlite3Dollar[1].exprUnion(), Direction: sqlite3Dollar[2].orderDirectionUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 142:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.IndexElement
+//line sqlite3.y:1191
+		{
+			sqlite3LOCAL = &ast.IndexElement{Expression: sqlite3Dollar[2].exprUnion(), Direction: sqlite3Dollar[4].orderDirectionUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 143:
+		sqlite3Dollar = sqlite3S[sqlite3pt-10 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.CreateTrigger
+//line sqlite3.y:1197
+		{
+			sqlite3LOCAL = &ast.CreateTrigger{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Temp: sqlite3Dollar[3].booleanUnion(), IfNotExists: sqlite3Dollar[5].booleanUnion(), Name: sqlite3Dollar[6].colIdent, Timing: sqlite3Dollar[7].str, Events: []*ast.TriggerEvent{sqlite3Dollar[8].triggerEventUnion()}, Table: sqlite3Dollar[10].tableName}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 144:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+//line sqlite3.y:1202
+		{
+			sqlite3VAL.str = ""
+		}
+	case 145:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:1206
+		{
+			sqlite3VAL.str = ast.TriggerBeforeStr
+		}
+	case 146:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:1210
+		{
+			sqlite3VAL.str = ast.TriggerAfterStr
+		}
+	case 147:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:1214
+		{
+			sqlite3VAL.str = ast.TriggerInsteadOfStr
+		}
+	case 148:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TriggerEvent
+//line sqlite3.y:1220
+		{
+			sqlite3LOCAL = &ast.TriggerEvent{Type: ast.TriggerInsertStr}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 149:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TriggerEvent
+//line sqlite3.y:1224
+		{
+			sqlite3LOCAL = &ast.TriggerEvent{Type: ast.TriggerUpdateStr}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 150:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TriggerEvent
+//line sqlite3.y:1228
+		{
+			sqlite3LOCAL = &ast.TriggerEvent{Type: ast.TriggerUpdateStr, Columns: sqlite3Dollar[3].columnsUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 151:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TriggerEvent
+//line sqlite3.y:1232
+		{
+			sqlite3LOCAL = &ast.TriggerEvent{Type: ast.TriggerDeleteStr}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 152:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+		var sqlite3LOCAL bool
+//line sqlite3.y:1237
+		{
+			sqlite3LOCAL = false
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 153:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL bool
+//line sqlite3.y:1241
+		{
+			sqlite3LOCAL = true
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 154:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Expr
+//line sqlite3.y:1246
+		{
+			sqlite3LOCAL = nil
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 155:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Expr
+//line sqlite3.y:1250
+		{
+			sqlite3LOCAL = sqlite3Dollar[2].exprUnion()
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 156:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL []ast.Statement
+//line sqlite3.y:1257
+		{
+			sqlite3LOCAL = []ast.Statement{sqlite3Dollar[1].statementUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 157:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+//line sqlite3.y:1261
+		{
+			sqlite3SLICE := (*[]ast.Statement)(sqlite3Iaddr(sqlite3VAL.union))
+			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].statementUnion())
+		}
+	case 161:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:1270
+		{
+			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 162:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+//line sqlite3.y:1276
+		{
+			sqlite3VAL.str = ""
+		}
+	case 163:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:1280
+		{
+			sqlite3VAL.str = sqlite3Dollar[1].str
+		}
+	case 164:
+		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.CreateDatabase
+//line sqlite3.y:1286
+		{
+			sqlite3LOCAL = &ast.CreateDatabase{Comments: ast.Comments(sqlite3Dollar[4].strs).Parsed(), DBName: sqlite3Dollar[6].tableIdent, IfNotExists: sqlite3Dollar[5].booleanUnion()}
+			setDDL(sqlite3lex, sqlite3LOCAL)
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 165:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.AlterDatabase
+//line sqlite3.y:1293
+		{
+			sqlite3LOCAL = &ast.AlterDatabase{}
+			setDDL(sqlite3lex, sqlite3LOCAL)
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 168:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TableSpec
+//line sqlite3.y:1304
+		{
+			sqlite3LOCAL = sqlite3Dollar[2].tableSpecUnion()
+			sqlite3LOCAL.Options = sqlite3Dollar[4].tableOptionsUnion()
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 169:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.DatabaseOption
-//line sqlite3.y:1169
+//line sqlite3.y:1310
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 141:
+	case 170:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.DatabaseOption
-//line sqlite3.y:1173
+//line sqlite3.y:1314
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].databaseOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 142:
+	case 171:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.DatabaseOption
-//line sqlite3.y:1179
+//line sqlite3.y:1320
 		{
 			sqlite3LOCAL = []ast.DatabaseOption{sqlite3Dollar[1].databaseOption}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 143:
+	case 172:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.DatabaseOption
-//line sqlite3.y:1183
+//line sqlite3.y:1324
 		{
 			sqlite3LOCAL = []ast.DatabaseOption{sqlite3Dollar[1].databaseOption}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 144:
+	case 173:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.DatabaseOption
-//line sqlite3.y:1187
+//line sqlite3.y:1328
 		{
 			sqlite3LOCAL = []ast.DatabaseOption{sqlite3Dollar[1].databaseOption}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 145:
+	case 174:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1191
+//line sqlite3.y:1332
 		{
 			sqlite3SLICE := (*[]ast.DatabaseOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].databaseOption)
 		}
-	case 146:
+	case 175:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1195
+//line sqlite3.y:1336
 		{
 			sqlite3SLICE := (*[]ast.DatabaseOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].databaseOption)
 		}
-	case 147:
+	case 176:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1199
+//line sqlite3.y:1340
 		{
 			sqlite3SLICE := (*[]ast.DatabaseOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].databaseOption)
 		}
-	case 148:
+	case 177:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:1205
+//line sqlite3.y:1346
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 149:
+	case 178:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:1209
+//line sqlite3.y:1350
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 150:
+	case 179:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1215
+//line sqlite3.y:1356
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.CharacterSetType, Value: (sqlite3Dollar[4].colIdent.String()), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 151:
+	case 180:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1219
+//line sqlite3.y:1360
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.CharacterSetType, Value: (sql_types.EncodeStringSQL(sqlite3Dollar[4].str)), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 152:
+	case 181:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1225
+//line sqlite3.y:1366
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.CollateType, Value: (sqlite3Dollar[4].colIdent.String()), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 153:
+	case 182:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1229
+//line sqlite3.y:1370
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.CollateType, Value: (sql_types.EncodeStringSQL(sqlite3Dollar[4].str)), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 154:
+	case 183:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1235
+//line sqlite3.y:1376
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.EncryptionType, Value: (sqlite3Dollar[4].colIdent.String()), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 155:
+	case 184:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1239
+//line sqlite3.y:1380
 		{
 			sqlite3VAL.databaseOption = ast.DatabaseOption{Type: ast.EncryptionType, Value: (sql_types.EncodeStringSQL(sqlite3Dollar[4].str)), IsDefault: sqlite3Dollar[1].booleanUnion()}
 		}
-	case 156:
+	case 185:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.OptLike
-//line sqlite3.y:1245
+//line sqlite3.y:1386
 		{
 			sqlite3LOCAL = &ast.OptLike{LikeTable: sqlite3Dollar[2].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 157:
+	case 186:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.OptLike
-//line sqlite3.y:1249
+//line sqlite3.y:1390
 		{
 			sqlite3LOCAL = &ast.OptLike{LikeTable: sqlite3Dollar[3].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 158:
+	case 187:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.ColumnDefinition
-//line sqlite3.y:1255
+//line sqlite3.y:1396
 		{
 			sqlite3LOCAL = []*ast.ColumnDefinition{sqlite3Dollar[1].columnDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 159:
+	case 188:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1259
+//line sqlite3.y:1400
 		{
 			sqlite3SLICE := (*[]*ast.ColumnDefinition)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].columnDefinitionUnion())
 		}
-	case 160:
+	case 189:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableSpec
-//line sqlite3.y:1265
+//line sqlite3.y:1406
 		{
 			sqlite3LOCAL = &ast.TableSpec{}
 			sqlite3LOCAL.AddColumn(sqlite3Dollar[1].columnDefinitionUnion())
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 161:
+	case 190:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TableSpec
+//line sqlite3.y:1411
+		{
+			sqlite3LOCAL = &ast.TableSpec{}
+			sqlite3LOCAL.AddColumn(sqlite3Dollar[1].columnDefinitionUnion())
+			sqlite3LOCAL.AddConstraint(sqlite3Dollar[2].constraintDefinitionUnion())
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 191:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableSpec
-//line sqlite3.y:1270
+//line sqlite3.y:1417
 		{
 			sqlite3LOCAL = &ast.TableSpec{}
 			sqlite3LOCAL.AddConstraint(sqlite3Dollar[1].constraintDefinitionUnion())
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 162:
+	case 192:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1275
+//line sqlite3.y:1422
 		{
 			sqlite3VAL.tableSpecUnion().AddColumn(sqlite3Dollar[3].columnDefinitionUnion())
 		}
-	case 163:
+	case 193:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:1279
+//line sqlite3.y:1426
 		{
 			sqlite3VAL.tableSpecUnion().AddColumn(sqlite3Dollar[3].columnDefinitionUnion())
 			sqlite3VAL.tableSpecUnion().AddConstraint(sqlite3Dollar[4].constraintDefinitionUnion())
 		}
-	case 164:
+	case 194:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1284
+//line sqlite3.y:1431
 		{
 			sqlite3VAL.tableSpecUnion().AddIndex(sqlite3Dollar[3].indexDefinitionUnion())
 		}
-	case 165:
+	case 195:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1288
+//line sqlite3.y:1435
 		{
 			sqlite3VAL.tableSpecUnion().AddConstraint(sqlite3Dollar[3].constraintDefinitionUnion())
 		}
-	case 166:
+	case 196:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1292
+//line sqlite3.y:1439
 		{
 			sqlite3VAL.tableSpecUnion().AddConstraint(sqlite3Dollar[3].constraintDefinitionUnion())
 		}
-	case 167:
+	case 197:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnDefinition
-//line sqlite3.y:1303
+//line sqlite3.y:1450
 		{
 			sqlite3Dollar[2].columnType.Options = sqlite3Dollar[4].columnTypeOptionsUnion()
 			if sqlite3Dollar[2].columnType.Options.Collate == "" {
@@ -6742,10 +7167,20 @@ sqlite3default:
 			sqlite3LOCAL = &ast.ColumnDefinition{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 168:
+	case 198:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.ColumnDefinition
+//line sqlite3.y:1459
+		{
+			// SQLite columns may have no type
+			sqlite3Dollar[2].columnTypeOptionsUnion().Reference = sqlite3Dollar[3].referenceDefinitionUnion()
+			sqlite3LOCAL = &ast.ColumnDefinition{Name: sqlite3Dollar[1].colIdent, Type: ast.ColumnType{Options: sqlite3Dollar[2].columnTypeOptionsUnion()}}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 199:
 		sqlite3Dollar = sqlite3S[sqlite3pt-10 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnDefinition
-//line sqlite3.y:1312
+//line sqlite3.y:1465
 		{
 			sqlite3Dollar[2].columnType.Options = sqlite3Dollar[9].columnTypeOptionsUnion()
 			sqlite3Dollar[2].columnType.Options.As = sqlite3Dollar[7].exprUnion()
@@ -6754,2421 +7189,2475 @@ sqlite3default:
 			sqlite3LOCAL = &ast.ColumnDefinition{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 169:
+	case 200:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:1321
+//line sqlite3.y:1474
 		{
 			sqlite3VAL.str = ""
 		}
-	case 170:
+	case 201:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1325
+//line sqlite3.y:1478
 		{
 			sqlite3VAL.str = ""
 		}
-	case 171:
+	case 202:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1334
+//line sqlite3.y:1487
 		{
 			sqlite3LOCAL = &ast.ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: ast.ColKeyNone, Comment: nil, As: nil, Invisible: nil, Format: ast.UnspecifiedFormat, EngineAttribute: nil, SecondaryEngineAttribute: nil}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 172:
+	case 203:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1338
+//line sqlite3.y:1491
 		{
 			val := true
 			sqlite3Dollar[1].columnTypeOptionsUnion().Null = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 173:
+	case 204:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1344
+//line sqlite3.y:1497
 		{
 			val := false
 			sqlite3Dollar[1].columnTypeOptionsUnion().Null = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 174:
+	case 205:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1350
+//line sqlite3.y:1503
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Default = sqlite3Dollar[4].exprUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 175:
+	case 206:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1355
+//line sqlite3.y:1508
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Default = sqlite3Dollar[3].exprUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 176:
+	case 207:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1360
+//line sqlite3.y:1513
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().OnUpdate = sqlite3Dollar[4].exprUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 177:
+	case 208:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1365
+//line sqlite3.y:1518
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Autoincrement = true
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 178:
+	case 209:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1370
+//line sqlite3.y:1523
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Comment = ast.NewStrLiteral(sqlite3Dollar[3].str)
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 179:
+	case 210:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1375
+//line sqlite3.y:1528
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().KeyOpt = sqlite3Dollar[2].colKeyOptUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 180:
+	case 211:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1380
+//line sqlite3.y:1533
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Collate = sql_types.EncodeStringSQL(sqlite3Dollar[3].str)
 		}
-	case 181:
+	case 212:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1384
+//line sqlite3.y:1537
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Collate = string(sqlite3Dollar[3].colIdent.String())
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 182:
+	case 213:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1389
+//line sqlite3.y:1542
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Format = sqlite3Dollar[3].columnFormatUnion()
 		}
-	case 183:
+	case 214:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1393
+//line sqlite3.y:1546
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().SRID = ast.NewIntLiteral(sqlite3Dollar[3].str)
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 184:
+	case 215:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1398
+//line sqlite3.y:1551
 		{
 			val := false
 			sqlite3Dollar[1].columnTypeOptionsUnion().Invisible = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 185:
+	case 216:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1404
+//line sqlite3.y:1557
 		{
 			val := true
 			sqlite3Dollar[1].columnTypeOptionsUnion().Invisible = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 186:
+	case 217:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnFormat
-//line sqlite3.y:1412
+//line sqlite3.y:1565
 		{
 			sqlite3LOCAL = ast.FixedFormat
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 187:
+	case 218:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnFormat
-//line sqlite3.y:1416
+//line sqlite3.y:1569
 		{
 			sqlite3LOCAL = ast.DynamicFormat
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 188:
+	case 219:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnFormat
-//line sqlite3.y:1420
+//line sqlite3.y:1573
 		{
 			sqlite3LOCAL = ast.DefaultFormat
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 189:
+	case 220:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnStorage
-//line sqlite3.y:1426
+//line sqlite3.y:1579
 		{
 			sqlite3LOCAL = ast.VirtualStorage
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 190:
+	case 221:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnStorage
-//line sqlite3.y:1430
+//line sqlite3.y:1583
 		{
 			sqlite3LOCAL = ast.StoredStorage
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 191:
+	case 222:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1435
+//line sqlite3.y:1588
 		{
 			sqlite3LOCAL = &ast.ColumnTypeOptions{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 192:
+	case 223:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1439
+//line sqlite3.y:1592
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Storage = sqlite3Dollar[2].columnStorageUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 193:
+	case 224:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1444
+//line sqlite3.y:1597
 		{
 			val := true
 			sqlite3Dollar[1].columnTypeOptionsUnion().Null = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 194:
+	case 225:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1450
+//line sqlite3.y:1603
 		{
 			val := false
 			sqlite3Dollar[1].columnTypeOptionsUnion().Null = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 195:
+	case 226:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1456
+//line sqlite3.y:1609
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().Comment = ast.NewStrLiteral(sqlite3Dollar[3].str)
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 196:
+	case 227:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1461
+//line sqlite3.y:1614
 		{
 			sqlite3Dollar[1].columnTypeOptionsUnion().KeyOpt = sqlite3Dollar[2].colKeyOptUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 197:
+	case 228:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1466
+//line sqlite3.y:1619
 		{
 			val := false
 			sqlite3Dollar[1].columnTypeOptionsUnion().Invisible = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 198:
+	case 229:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColumnTypeOptions
-//line sqlite3.y:1472
+//line sqlite3.y:1625
 		{
 			val := true
 			sqlite3Dollar[1].columnTypeOptionsUnion().Invisible = &val
 			sqlite3LOCAL = sqlite3Dollar[1].columnTypeOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 199:
+	case 230:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1480
+//line sqlite3.y:1633
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 201:
+	case 232:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1487
+//line sqlite3.y:1640
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_timestamp"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 202:
+	case 233:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1491
+//line sqlite3.y:1644
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("localtime"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 203:
+	case 234:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1495
+//line sqlite3.y:1648
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("localtimestamp"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 204:
+	case 235:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1499
+//line sqlite3.y:1652
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("utc_timestamp"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 205:
+	case 236:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1503
+//line sqlite3.y:1656
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("now"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 208:
+	case 239:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1514
+//line sqlite3.y:1667
 		{
 			sqlite3LOCAL = &ast.NullVal{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 210:
+	case 241:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1521
+//line sqlite3.y:1674
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 211:
+	case 242:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1525
+//line sqlite3.y:1678
 		{
 			sqlite3LOCAL = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 212:
+	case 243:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1531
+//line sqlite3.y:1684
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 213:
+	case 244:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1535
+//line sqlite3.y:1688
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 214:
+	case 245:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1539
+//line sqlite3.y:1692
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].boolValUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 215:
+	case 246:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1543
+//line sqlite3.y:1696
 		{
 			sqlite3LOCAL = ast.NewHexLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 216:
+	case 247:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1547
+//line sqlite3.y:1700
 		{
 			sqlite3LOCAL = ast.NewHexNumLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 217:
+	case 248:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1551
+//line sqlite3.y:1704
 		{
 			sqlite3LOCAL = ast.NewBitLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 218:
+	case 249:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1555
+//line sqlite3.y:1708
 		{
 			sqlite3LOCAL = ast.NewArgument(sqlite3Dollar[1].str[1:])
 			bindVariable(sqlite3lex, sqlite3Dollar[1].str[1:])
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 219:
+	case 250:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1560
+//line sqlite3.y:1713
 		{
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: ast.NewBitLiteral(sqlite3Dollar[2].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 220:
+	case 251:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1564
+//line sqlite3.y:1717
 		{
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: ast.NewHexNumLiteral(sqlite3Dollar[2].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 221:
+	case 252:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1568
+//line sqlite3.y:1721
 		{
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: ast.NewHexLiteral(sqlite3Dollar[2].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 222:
+	case 253:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1572
+//line sqlite3.y:1725
 		{
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: sqlite3Dollar[2].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 223:
+	case 254:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1576
+//line sqlite3.y:1729
 		{
 			bindVariable(sqlite3lex, sqlite3Dollar[2].str[1:])
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: ast.NewArgument(sqlite3Dollar[2].str[1:])}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 224:
+	case 255:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1583
+//line sqlite3.y:1736
 		{
 			sqlite3VAL.str = ast.Armscii8Str
 		}
-	case 225:
+	case 256:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1587
+//line sqlite3.y:1740
 		{
 			sqlite3VAL.str = ast.ASCIIStr
 		}
-	case 226:
+	case 257:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1591
+//line sqlite3.y:1744
 		{
 			sqlite3VAL.str = ast.Big5Str
 		}
-	case 227:
+	case 258:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1595
+//line sqlite3.y:1748
 		{
 			sqlite3VAL.str = ast.UBinaryStr
 		}
-	case 228:
+	case 259:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1599
+//line sqlite3.y:1752
 		{
 			sqlite3VAL.str = ast.Cp1250Str
 		}
-	case 229:
+	case 260:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1603
+//line sqlite3.y:1756
 		{
 			sqlite3VAL.str = ast.Cp1251Str
 		}
-	case 230:
+	case 261:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1607
+//line sqlite3.y:1760
 		{
 			sqlite3VAL.str = ast.Cp1256Str
 		}
-	case 231:
+	case 262:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1611
+//line sqlite3.y:1764
 		{
 			sqlite3VAL.str = ast.Cp1257Str
 		}
-	case 232:
+	case 263:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1615
+//line sqlite3.y:1768
 		{
 			sqlite3VAL.str = ast.Cp850Str
 		}
-	case 233:
+	case 264:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1619
+//line sqlite3.y:1772
 		{
 			sqlite3VAL.str = ast.Cp852Str
 		}
-	case 234:
+	case 265:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1623
+//line sqlite3.y:1776
 		{
 			sqlite3VAL.str = ast.Cp866Str
 		}
-	case 235:
+	case 266:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1627
+//line sqlite3.y:1780
 		{
 			sqlite3VAL.str = ast.Cp932Str
 		}
-	case 236:
+	case 267:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1631
+//line sqlite3.y:1784
 		{
 			sqlite3VAL.str = ast.Dec8Str
 		}
-	case 237:
+	case 268:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1635
+//line sqlite3.y:1788
 		{
 			sqlite3VAL.str = ast.EucjpmsStr
 		}
-	case 238:
+	case 269:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1639
+//line sqlite3.y:1792
 		{
 			sqlite3VAL.str = ast.EuckrStr
 		}
-	case 239:
+	case 270:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1643
+//line sqlite3.y:1796
 		{
 			sqlite3VAL.str = ast.Gb18030Str
 		}
-	case 240:
+	case 271:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1647
+//line sqlite3.y:1800
 		{
 			sqlite3VAL.str = ast.Gb2312Str
 		}
-	case 241:
+	case 272:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1651
+//line sqlite3.y:1804
 		{
 			sqlite3VAL.str = ast.GbkStr
 		}
-	case 242:
+	case 273:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1655
+//line sqlite3.y:1808
 		{
 			sqlite3VAL.str = ast.Geostd8Str
 		}
-	case 243:
+	case 274:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1659
+//line sqlite3.y:1812
 		{
 			sqlite3VAL.str = ast.GreekStr
 		}
-	case 244:
+	case 275:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1663
+//line sqlite3.y:1816
 		{
 			sqlite3VAL.str = ast.HebrewStr
 		}
-	case 245:
+	case 276:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1667
+//line sqlite3.y:1820
 		{
 			sqlite3VAL.str = ast.Hp8Str
 		}
-	case 246:
+	case 277:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1671
+//line sqlite3.y:1824
 		{
 			sqlite3VAL.str = ast.Keybcs2Str
 		}
-	case 247:
+	case 278:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1675
+//line sqlite3.y:1828
 		{
 			sqlite3VAL.str = ast.Koi8rStr
 		}
-	case 248:
+	case 279:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1679
+//line sqlite3.y:1832
 		{
 			sqlite3VAL.str = ast.Koi8uStr
 		}
-	case 249:
+	case 280:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1683
+//line sqlite3.y:1836
 		{
 			sqlite3VAL.str = ast.Latin1Str
 		}
-	case 250:
+	case 281:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1687
+//line sqlite3.y:1840
 		{
 			sqlite3VAL.str = ast.Latin2Str
 		}
-	case 251:
+	case 282:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1691
+//line sqlite3.y:1844
 		{
 			sqlite3VAL.str = ast.Latin5Str
 		}
-	case 252:
+	case 283:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1695
+//line sqlite3.y:1848
 		{
 			sqlite3VAL.str = ast.Latin7Str
 		}
-	case 253:
+	case 284:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1699
+//line sqlite3.y:1852
 		{
 			sqlite3VAL.str = ast.MacceStr
 		}
-	case 254:
+	case 285:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1703
+//line sqlite3.y:1856
 		{
 			sqlite3VAL.str = ast.MacromanStr
 		}
-	case 255:
+	case 286:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1707
+//line sqlite3.y:1860
 		{
 			sqlite3VAL.str = ast.SjisStr
 		}
-	case 256:
+	case 287:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1711
+//line sqlite3.y:1864
 		{
 			sqlite3VAL.str = ast.Swe7Str
 		}
-	case 257:
+	case 288:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1715
+//line sqlite3.y:1868
 		{
 			sqlite3VAL.str = ast.Tis620Str
 		}
-	case 258:
+	case 289:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1719
+//line sqlite3.y:1872
 		{
 			sqlite3VAL.str = ast.Ucs2Str
 		}
-	case 259:
+	case 290:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1723
+//line sqlite3.y:1876
 		{
 			sqlite3VAL.str = ast.UjisStr
 		}
-	case 260:
+	case 291:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1727
+//line sqlite3.y:1880
 		{
 			sqlite3VAL.str = ast.Utf16Str
 		}
-	case 261:
+	case 292:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1731
+//line sqlite3.y:1884
 		{
 			sqlite3VAL.str = ast.Utf16leStr
 		}
-	case 262:
+	case 293:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1735
+//line sqlite3.y:1888
 		{
 			sqlite3VAL.str = ast.Utf32Str
 		}
-	case 263:
+	case 294:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1739
+//line sqlite3.y:1892
 		{
 			sqlite3VAL.str = ast.Utf8Str
 		}
-	case 264:
+	case 295:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1743
+//line sqlite3.y:1896
 		{
 			sqlite3VAL.str = ast.Utf8mb4Str
 		}
-	case 265:
+	case 296:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1747
+//line sqlite3.y:1900
 		{
 			sqlite3VAL.str = ast.Utf8Str
 		}
-	case 268:
+	case 299:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1757
+//line sqlite3.y:1910
 		{
 			sqlite3LOCAL = ast.NewIntLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 269:
+	case 300:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1761
+//line sqlite3.y:1914
 		{
 			sqlite3LOCAL = ast.NewFloatLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 270:
+	case 301:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1765
+//line sqlite3.y:1918
 		{
 			sqlite3LOCAL = ast.NewDecimalLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 271:
+	case 302:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1771
+//line sqlite3.y:1924
 		{
 			sqlite3LOCAL = ast.NewStrLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 272:
+	case 303:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1775
+//line sqlite3.y:1928
 		{
 			sqlite3LOCAL = &ast.UnaryExpr{Operator: ast.NStringOp, Expr: ast.NewStrLiteral(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 273:
+	case 304:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1779
+//line sqlite3.y:1932
 		{
 			sqlite3LOCAL = &ast.IntroducerExpr{CharacterSet: sqlite3Dollar[1].str, Expr: ast.NewStrLiteral(sqlite3Dollar[2].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 274:
+	case 305:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1785
+//line sqlite3.y:1938
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 275:
+	case 306:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:1789
+//line sqlite3.y:1942
 		{
 			sqlite3LOCAL = ast.NewArgument(sqlite3Dollar[1].str[1:])
 			bindVariable(sqlite3lex, sqlite3Dollar[1].str[1:])
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 276:
+	case 307:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnKeyOption
-//line sqlite3.y:1796
+//line sqlite3.y:1949
 		{
 			sqlite3LOCAL = ast.ColKeyPrimary
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 277:
+	case 308:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnKeyOption
-//line sqlite3.y:1800
+//line sqlite3.y:1953
 		{
 			sqlite3LOCAL = ast.ColKeyUnique
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 278:
+	case 309:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnKeyOption
-//line sqlite3.y:1804
+//line sqlite3.y:1957
 		{
 			sqlite3LOCAL = ast.ColKeyUniqueKey
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 279:
+	case 310:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColumnKeyOption
-//line sqlite3.y:1808
+//line sqlite3.y:1961
 		{
 			sqlite3LOCAL = ast.ColKey
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 280:
+	case 311:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1814
+//line sqlite3.y:1967
 		{
 			sqlite3VAL.columnType = sqlite3Dollar[1].columnType
 			sqlite3VAL.columnType.Unsigned = sqlite3Dollar[2].booleanUnion()
 			sqlite3VAL.columnType.Zerofill = sqlite3Dollar[3].booleanUnion()
 		}
-	case 284:
+	case 316:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:1980
+		{
+			sqlite3VAL.columnType = ast.ColumnType{Type: sqlite3Dollar[1].str}
+		}
+	case 317:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
+//line sqlite3.y:1984
+		{
+			sqlite3VAL.columnType = ast.ColumnType{Type: sqlite3Dollar[1].str, Length: ast.NewIntLiteral(sqlite3Dollar[3].str)}
+		}
+	case 318:
+		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
+//line sqlite3.y:1988
+		{
+			sqlite3VAL.columnType = ast.ColumnType{Type: sqlite3Dollar[1].str, Length: ast.NewIntLiteral(sqlite3Dollar[3].str), Scale: ast.NewIntLiteral(sqlite3Dollar[5].str)}
+		}
+	case 319:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:1994
+		{
+			sqlite3VAL.str = string(sqlite3Dollar[1].str)
+		}
+	case 320:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1825
+//line sqlite3.y:1998
+		{
+			sqlite3VAL.str = sqlite3Dollar[1].str + " " + string(sqlite3Dollar[2].str)
+		}
+	case 321:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:2004
 		{
 			sqlite3VAL.columnType = sqlite3Dollar[1].columnType
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].literalUnion()
 		}
-	case 285:
+	case 322:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1830
+//line sqlite3.y:2009
 		{
 			sqlite3VAL.columnType = sqlite3Dollar[1].columnType
 		}
-	case 286:
+	case 323:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1836
+//line sqlite3.y:2015
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 287:
+	case 324:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1840
+//line sqlite3.y:2019
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 288:
+	case 325:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1844
+//line sqlite3.y:2023
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 289:
+	case 326:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1848
+//line sqlite3.y:2027
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 290:
+	case 327:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1852
+//line sqlite3.y:2031
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 291:
+	case 328:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1856
+//line sqlite3.y:2035
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 292:
+	case 329:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1860
+//line sqlite3.y:2039
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 293:
+	case 330:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1864
+//line sqlite3.y:2043
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 294:
+	case 331:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1868
+//line sqlite3.y:2047
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 295:
+	case 332:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1874
+//line sqlite3.y:2053
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3VAL.columnType.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
-	case 296:
+	case 333:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1880
+//line sqlite3.y:2059
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3VAL.columnType.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
-	case 297:
+	case 334:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1886
+//line sqlite3.y:2065
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3VAL.columnType.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
-	case 298:
+	case 335:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1892
+//line sqlite3.y:2071
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3VAL.columnType.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
-	case 299:
+	case 336:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1898
+//line sqlite3.y:2077
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3VAL.columnType.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3VAL.columnType.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
-	case 300:
+	case 337:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1906
+//line sqlite3.y:2085
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 301:
+	case 338:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1910
+//line sqlite3.y:2089
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 302:
+	case 339:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1914
+//line sqlite3.y:2093
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 303:
+	case 340:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1918
+//line sqlite3.y:2097
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 304:
+	case 341:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1922
+//line sqlite3.y:2101
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 305:
+	case 342:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1928
+//line sqlite3.y:2107
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion(), Charset: sqlite3Dollar[3].columnCharset}
 		}
-	case 306:
+	case 343:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1932
+//line sqlite3.y:2111
 		{
 			// CHAR BYTE is an alias for binary. See also:
 			// https://dev.sqlite3.com/doc/refman/8.0/en/string-type-syntax.html
 			sqlite3VAL.columnType = ast.ColumnType{Type: "binary", Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 307:
+	case 344:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:1938
+//line sqlite3.y:2117
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion(), Charset: sqlite3Dollar[3].columnCharset}
 		}
-	case 308:
+	case 345:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1942
+//line sqlite3.y:2121
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 309:
+	case 346:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1946
+//line sqlite3.y:2125
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
-	case 310:
+	case 347:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1950
+//line sqlite3.y:2129
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Charset: sqlite3Dollar[2].columnCharset}
 		}
-	case 311:
+	case 348:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1954
+//line sqlite3.y:2133
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Charset: sqlite3Dollar[2].columnCharset}
 		}
-	case 312:
+	case 349:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1958
+//line sqlite3.y:2137
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Charset: sqlite3Dollar[2].columnCharset}
 		}
-	case 313:
+	case 350:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:1962
+//line sqlite3.y:2141
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), Charset: sqlite3Dollar[2].columnCharset}
 		}
-	case 314:
+	case 351:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1966
+//line sqlite3.y:2145
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 315:
+	case 352:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1970
+//line sqlite3.y:2149
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 316:
+	case 353:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1974
+//line sqlite3.y:2153
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 317:
+	case 354:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1978
+//line sqlite3.y:2157
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 318:
+	case 355:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1982
+//line sqlite3.y:2161
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 319:
+	case 356:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
-//line sqlite3.y:1986
+//line sqlite3.y:2165
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), EnumValues: sqlite3Dollar[3].strs, Charset: sqlite3Dollar[5].columnCharset}
 		}
-	case 320:
+	case 357:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
-//line sqlite3.y:1991
+//line sqlite3.y:2170
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str), EnumValues: sqlite3Dollar[3].strs, Charset: sqlite3Dollar[5].columnCharset}
 		}
-	case 321:
+	case 358:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:1997
+//line sqlite3.y:2176
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 322:
+	case 359:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2001
+//line sqlite3.y:2180
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 323:
+	case 360:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2005
+//line sqlite3.y:2184
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 324:
+	case 361:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2009
+//line sqlite3.y:2188
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 325:
+	case 362:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2013
+//line sqlite3.y:2192
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 326:
+	case 363:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2017
+//line sqlite3.y:2196
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 327:
+	case 364:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2021
+//line sqlite3.y:2200
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 328:
+	case 365:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2025
+//line sqlite3.y:2204
 		{
 			sqlite3VAL.columnType = ast.ColumnType{Type: string(sqlite3Dollar[1].str)}
 		}
-	case 329:
+	case 366:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2031
+//line sqlite3.y:2210
 		{
 			sqlite3VAL.strs = make([]string, 0, 4)
 			sqlite3VAL.strs = append(sqlite3VAL.strs, sql_types.EncodeStringSQL(sqlite3Dollar[1].str))
 		}
-	case 330:
+	case 367:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2036
+//line sqlite3.y:2215
 		{
 			sqlite3VAL.strs = append(sqlite3Dollar[1].strs, sql_types.EncodeStringSQL(sqlite3Dollar[3].str))
 		}
-	case 331:
+	case 368:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Literal
-//line sqlite3.y:2041
+//line sqlite3.y:2220
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 332:
+	case 369:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Literal
-//line sqlite3.y:2045
+//line sqlite3.y:2224
 		{
 			sqlite3LOCAL = ast.NewIntLiteral(sqlite3Dollar[2].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 333:
+	case 370:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2050
+//line sqlite3.y:2229
 		{
 			sqlite3VAL.LengthScaleOption = ast.LengthScaleOption{}
 		}
-	case 334:
+	case 371:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2055
+//line sqlite3.y:2234
 		{
 			sqlite3VAL.LengthScaleOption = ast.LengthScaleOption{}
 		}
-	case 335:
+	case 372:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2060
+//line sqlite3.y:2239
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 336:
+	case 373:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2064
+//line sqlite3.y:2243
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 337:
+	case 374:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2068
+//line sqlite3.y:2247
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 338:
+	case 375:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2073
+//line sqlite3.y:2252
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 339:
+	case 376:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2077
+//line sqlite3.y:2256
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 340:
+	case 377:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2082
+//line sqlite3.y:2261
 		{
 			sqlite3VAL.columnCharset = ast.ColumnCharset{}
 		}
-	case 341:
+	case 378:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2086
+//line sqlite3.y:2265
 		{
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: string(sqlite3Dollar[2].colIdent.String()), Binary: sqlite3Dollar[3].booleanUnion()}
 		}
-	case 342:
+	case 379:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2090
+//line sqlite3.y:2269
 		{
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: sql_types.EncodeStringSQL(sqlite3Dollar[2].str), Binary: sqlite3Dollar[3].booleanUnion()}
 		}
-	case 343:
+	case 380:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2094
+//line sqlite3.y:2273
 		{
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: string(sqlite3Dollar[2].str)}
 		}
-	case 344:
+	case 381:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2098
+//line sqlite3.y:2277
 		{
 			// ASCII: ast.Shorthand for CHARACTER SET latin1.
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: "latin1", Binary: sqlite3Dollar[2].booleanUnion()}
 		}
-	case 345:
+	case 382:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2103
+//line sqlite3.y:2282
 		{
 			// UNICODE: ast.Shorthand for CHARACTER SET ucs2.
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: "ucs2", Binary: sqlite3Dollar[2].booleanUnion()}
 		}
-	case 346:
+	case 383:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2108
+//line sqlite3.y:2287
 		{
 			// BINARY: ast.Shorthand for default CHARACTER SET but with binary collation
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: "", Binary: true}
 		}
-	case 347:
+	case 384:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2113
+//line sqlite3.y:2292
 		{
 			// BINARY ASCII: ast.Shorthand for CHARACTER SET latin1 with binary collation
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: "latin1", Binary: true}
 		}
-	case 348:
+	case 385:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2118
+//line sqlite3.y:2297
 		{
 			// BINARY UNICODE: ast.Shorthand for CHARACTER SET ucs2 with binary collation
 			sqlite3VAL.columnCharset = ast.ColumnCharset{Name: "ucs2", Binary: true}
 		}
-	case 349:
+	case 386:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2124
+//line sqlite3.y:2303
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 350:
+	case 387:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2128
+//line sqlite3.y:2307
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 351:
+	case 388:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2133
+//line sqlite3.y:2312
 		{
 			sqlite3VAL.str = ""
 		}
-	case 352:
+	case 389:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2137
+//line sqlite3.y:2316
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[2].colIdent.String())
 		}
-	case 353:
+	case 390:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2141
+//line sqlite3.y:2320
 		{
 			sqlite3VAL.str = sql_types.EncodeStringSQL(sqlite3Dollar[2].str)
 		}
-	case 354:
+	case 391:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexDefinition
-//line sqlite3.y:2148
+//line sqlite3.y:2327
 		{
 			sqlite3LOCAL = &ast.IndexDefinition{Info: sqlite3Dollar[1].indexInfoUnion(), Columns: sqlite3Dollar[3].indexColumnsUnion(), Options: sqlite3Dollar[5].indexOptionsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 355:
+	case 392:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.IndexOption
-//line sqlite3.y:2153
+//line sqlite3.y:2332
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 356:
+	case 393:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.IndexOption
-//line sqlite3.y:2157
+//line sqlite3.y:2336
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].indexOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 357:
+	case 394:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.IndexOption
-//line sqlite3.y:2163
+//line sqlite3.y:2342
 		{
 			sqlite3LOCAL = []*ast.IndexOption{sqlite3Dollar[1].indexOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 358:
+	case 395:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2167
+//line sqlite3.y:2346
 		{
 			sqlite3SLICE := (*[]*ast.IndexOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].indexOptionUnion())
 		}
-	case 359:
+	case 396:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:2173
+//line sqlite3.y:2352
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].indexOptionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 360:
+	case 397:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:2177
+//line sqlite3.y:2356
 		{
 			sqlite3LOCAL = &ast.IndexOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[2].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 361:
+	case 398:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:2181
+//line sqlite3.y:2360
 		{
 			sqlite3LOCAL = &ast.IndexOption{Name: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 362:
+	case 399:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:2185
+//line sqlite3.y:2364
 		{
 			sqlite3LOCAL = &ast.IndexOption{Name: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 363:
+	case 400:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:2189
+//line sqlite3.y:2368
 		{
 			sqlite3LOCAL = &ast.IndexOption{Name: string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str), String: sqlite3Dollar[3].colIdent.String()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 364:
+	case 401:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2195
+//line sqlite3.y:2374
 		{
 			sqlite3VAL.str = ""
 		}
-	case 365:
+	case 402:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2199
+//line sqlite3.y:2378
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 366:
+	case 403:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexInfo
-//line sqlite3.y:2205
+//line sqlite3.y:2384
 		{
 			sqlite3LOCAL = &ast.IndexInfo{Type: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str), ConstraintName: ast.NewColIdent(sqlite3Dollar[1].str), Name: ast.NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 367:
+	case 404:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexInfo
-//line sqlite3.y:2209
+//line sqlite3.y:2388
 		{
 			sqlite3LOCAL = &ast.IndexInfo{Type: string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str), Name: ast.NewColIdent(sqlite3Dollar[3].str), Spatial: true, Unique: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 368:
+	case 405:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexInfo
-//line sqlite3.y:2213
+//line sqlite3.y:2392
 		{
 			sqlite3LOCAL = &ast.IndexInfo{Type: string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str), Name: ast.NewColIdent(sqlite3Dollar[3].str), Fulltext: true, Unique: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 369:
+	case 406:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexInfo
-//line sqlite3.y:2217
+//line sqlite3.y:2396
 		{
 			sqlite3LOCAL = &ast.IndexInfo{Type: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str), ConstraintName: ast.NewColIdent(sqlite3Dollar[1].str), Name: ast.NewColIdent(sqlite3Dollar[4].str), Unique: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 370:
+	case 407:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexInfo
-//line sqlite3.y:2221
+//line sqlite3.y:2400
 		{
 			sqlite3LOCAL = &ast.IndexInfo{Type: string(sqlite3Dollar[1].str), Name: ast.NewColIdent(sqlite3Dollar[2].str), Unique: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 371:
+	case 408:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2226
+//line sqlite3.y:2405
 		{
 			sqlite3VAL.str = ""
 		}
-	case 372:
+	case 409:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2230
+//line sqlite3.y:2409
 		{
 			sqlite3VAL.str = sqlite3Dollar[2].str
 		}
-	case 373:
+	case 410:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2236
+//line sqlite3.y:2415
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 374:
+	case 411:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2240
+//line sqlite3.y:2419
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 375:
+	case 412:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2244
+//line sqlite3.y:2423
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 376:
+	case 413:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2251
+//line sqlite3.y:2430
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 377:
+	case 414:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2255
+//line sqlite3.y:2434
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 378:
+	case 415:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2260
+//line sqlite3.y:2439
 		{
 			sqlite3VAL.str = "key"
 		}
-	case 379:
+	case 416:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2264
+//line sqlite3.y:2443
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].str
 		}
-	case 380:
+	case 417:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2270
+//line sqlite3.y:2449
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 381:
+	case 418:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2274
+//line sqlite3.y:2453
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 382:
+	case 419:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2279
+//line sqlite3.y:2458
 		{
 			sqlite3VAL.str = ""
 		}
-	case 383:
+	case 420:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2283
+//line sqlite3.y:2462
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].colIdent.String())
 		}
-	case 384:
+	case 421:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.IndexColumn
-//line sqlite3.y:2289
+//line sqlite3.y:2468
 		{
 			sqlite3LOCAL = []*ast.IndexColumn{sqlite3Dollar[1].indexColumnUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 385:
+	case 422:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2293
+//line sqlite3.y:2472
 		{
 			sqlite3SLICE := (*[]*ast.IndexColumn)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].indexColumnUnion())
 		}
-	case 386:
+	case 423:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexColumn
-//line sqlite3.y:2299
+//line sqlite3.y:2478
 		{
 			sqlite3LOCAL = &ast.IndexColumn{Column: sqlite3Dollar[1].colIdent, Length: sqlite3Dollar[2].literalUnion(), Direction: sqlite3Dollar[3].orderDirectionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 387:
+	case 424:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexColumn
-//line sqlite3.y:2303
+//line sqlite3.y:2482
 		{
 			sqlite3LOCAL = &ast.IndexColumn{Expression: sqlite3Dollar[2].exprUnion(), Direction: sqlite3Dollar[4].orderDirectionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 388:
+	case 425:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConstraintDefinition
-//line sqlite3.y:2309
+//line sqlite3.y:2488
 		{
 			sqlite3LOCAL = &ast.ConstraintDefinition{Name: sqlite3Dollar[2].colIdent, Details: sqlite3Dollar[3].constraintInfoUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 389:
+	case 426:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConstraintDefinition
-//line sqlite3.y:2313
+//line sqlite3.y:2492
 		{
 			sqlite3LOCAL = &ast.ConstraintDefinition{Details: sqlite3Dollar[1].constraintInfoUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 390:
+	case 427:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConstraintDefinition
-//line sqlite3.y:2319
+//line sqlite3.y:2498
 		{
 			sqlite3LOCAL = &ast.ConstraintDefinition{Name: sqlite3Dollar[2].colIdent, Details: sqlite3Dollar[3].constraintInfoUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 391:
+	case 428:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConstraintDefinition
-//line sqlite3.y:2323
+//line sqlite3.y:2502
 		{
 			sqlite3LOCAL = &ast.ConstraintDefinition{Details: sqlite3Dollar[1].constraintInfoUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 392:
+	case 429:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ConstraintInfo
-//line sqlite3.y:2329
+//line sqlite3.y:2508
 		{
 			sqlite3LOCAL = &ast.ForeignKeyDefinition{IndexName: ast.NewColIdent(sqlite3Dollar[3].str), Source: sqlite3Dollar[5].columnsUnion(), ReferenceDefinition: sqlite3Dollar[7].referenceDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 393:
+	case 430:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2335
+//line sqlite3.y:2514
 		{
 			sqlite3LOCAL = &ast.ReferenceDefinition{ReferencedTable: sqlite3Dollar[2].tableName, ReferencedColumns: sqlite3Dollar[4].columnsUnion(), Match: sqlite3Dollar[6].matchActionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 394:
+	case 431:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2339
+//line sqlite3.y:2518
 		{
 			sqlite3LOCAL = &ast.ReferenceDefinition{ReferencedTable: sqlite3Dollar[2].tableName, ReferencedColumns: sqlite3Dollar[4].columnsUnion(), Match: sqlite3Dollar[6].matchActionUnion(), OnDelete: sqlite3Dollar[7].referenceActionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 395:
+	case 432:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2343
+//line sqlite3.y:2522
 		{
 			sqlite3LOCAL = &ast.ReferenceDefinition{ReferencedTable: sqlite3Dollar[2].tableName, ReferencedColumns: sqlite3Dollar[4].columnsUnion(), Match: sqlite3Dollar[6].matchActionUnion(), OnUpdate: sqlite3Dollar[7].referenceActionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 396:
+	case 433:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2347
+//line sqlite3.y:2526
 		{
 			sqlite3LOCAL = &ast.ReferenceDefinition{ReferencedTable: sqlite3Dollar[2].tableName, ReferencedColumns: sqlite3Dollar[4].columnsUnion(), Match: sqlite3Dollar[6].matchActionUnion(), OnDelete: sqlite3Dollar[7].referenceActionUnion(), OnUpdate: sqlite3Dollar[8].referenceActionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 397:
+	case 434:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2351
+//line sqlite3.y:2530
 		{
 			sqlite3LOCAL = &ast.ReferenceDefinition{ReferencedTable: sqlite3Dollar[2].tableName, ReferencedColumns: sqlite3Dollar[4].columnsUnion(), Match: sqlite3Dollar[6].matchActionUnion(), OnUpdate: sqlite3Dollar[7].referenceActionUnion(), OnDelete: sqlite3Dollar[8].referenceActionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 398:
+	case 435:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2356
+//line sqlite3.y:2535
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 399:
+	case 436:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ReferenceDefinition
-//line sqlite3.y:2360
+//line sqlite3.y:2539
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].referenceDefinitionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 400:
+	case 437:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ConstraintInfo
-//line sqlite3.y:2366
+//line sqlite3.y:2545
 		{
 			sqlite3LOCAL = &ast.CheckConstraintDefinition{Expr: sqlite3Dollar[3].exprUnion(), Enforced: sqlite3Dollar[5].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 401:
+	case 438:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2372
+//line sqlite3.y:2551
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].matchActionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 402:
+	case 439:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2378
+//line sqlite3.y:2557
 		{
 			sqlite3LOCAL = ast.Full
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 403:
+	case 440:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2382
+//line sqlite3.y:2561
 		{
 			sqlite3LOCAL = ast.Partial
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 404:
+	case 441:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2386
+//line sqlite3.y:2565
 		{
 			sqlite3LOCAL = ast.Simple
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 405:
+	case 442:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2391
+//line sqlite3.y:2570
 		{
 			sqlite3LOCAL = ast.DefaultMatch
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 406:
+	case 443:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchAction
-//line sqlite3.y:2395
+//line sqlite3.y:2574
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].matchActionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 407:
+	case 444:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2401
+//line sqlite3.y:2580
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].referenceActionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 408:
+	case 445:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2407
+//line sqlite3.y:2586
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].referenceActionUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 409:
+	case 446:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2413
+//line sqlite3.y:2592
 		{
 			sqlite3LOCAL = ast.Restrict
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 410:
+	case 447:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2417
+//line sqlite3.y:2596
 		{
 			sqlite3LOCAL = ast.Cascade
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 411:
+	case 448:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2421
+//line sqlite3.y:2600
 		{
 			sqlite3LOCAL = ast.NoAction
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 412:
+	case 449:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2425
+//line sqlite3.y:2604
 		{
 			sqlite3LOCAL = ast.SetDefault
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 413:
+	case 450:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ReferenceAction
-//line sqlite3.y:2429
+//line sqlite3.y:2608
 		{
 			sqlite3LOCAL = ast.SetNull
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 414:
+	case 451:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2434
+//line sqlite3.y:2613
 		{
 			sqlite3VAL.str = ""
 		}
-	case 415:
+	case 452:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2438
+//line sqlite3.y:2617
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 416:
+	case 453:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2442
+//line sqlite3.y:2621
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 417:
+	case 454:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2448
+//line sqlite3.y:2627
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 418:
+	case 455:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2452
+//line sqlite3.y:2631
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 419:
+	case 456:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2457
+//line sqlite3.y:2636
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 420:
+	case 457:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2461
+//line sqlite3.y:2640
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].booleanUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 421:
+	case 458:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableOptions
-//line sqlite3.y:2466
+//line sqlite3.y:2645
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 422:
+	case 459:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableOptions
-//line sqlite3.y:2470
+//line sqlite3.y:2649
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].tableOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 423:
+	case 460:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableOptions
-//line sqlite3.y:2476
+//line sqlite3.y:2655
 		{
 			sqlite3LOCAL = ast.TableOptions{sqlite3Dollar[1].tableOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 424:
+	case 461:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2480
+//line sqlite3.y:2659
 		{
 			sqlite3SLICE := (*ast.TableOptions)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableOptionUnion())
 		}
-	case 425:
+	case 462:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2484
+//line sqlite3.y:2663
 		{
 			sqlite3SLICE := (*ast.TableOptions)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].tableOptionUnion())
 		}
-	case 426:
+	case 463:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableOptions
-//line sqlite3.y:2490
+//line sqlite3.y:2669
+		{
+			sqlite3LOCAL = ast.TableOptions{sqlite3Dollar[1].tableOptionUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 464:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:2673
+		{
+			sqlite3SLICE := (*ast.TableOptions)(sqlite3Iaddr(sqlite3VAL.union))
+			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].tableOptionUnion())
+		}
+	case 465:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TableOption
+//line sqlite3.y:2679
 		{
-			sqlite3LOCAL = ast.TableOptions{sqlite3Dollar[1].tableOptionUnion()}
+			if ast.NewColIdent(sqlite3Dollar[2].str).Lowered() != "rowid" {
+				sqlite3lex.Error("expected ROWID after WITHOUT")
+				return 1
+			}
+			sqlite3LOCAL = &ast.TableOption{Name: "without rowid"}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 427:
-		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2494
+	case 466:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.TableOption
+//line sqlite3.y:2687
 		{
-			sqlite3SLICE := (*ast.TableOptions)(sqlite3Iaddr(sqlite3VAL.union))
-			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].tableOptionUnion())
+			if ast.NewColIdent(sqlite3Dollar[1].str).Lowered() != "strict" {
+				sqlite3lex.Error("unknown table option " + sqlite3Dollar[1].str)
+				return 1
+			}
+			sqlite3LOCAL = &ast.TableOption{Name: "strict"}
 		}
-	case 428:
+		sqlite3VAL.union = sqlite3LOCAL
+	case 467:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2500
+//line sqlite3.y:2695
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: (string(sqlite3Dollar[2].str)), String: sqlite3Dollar[4].str, CaseSensitive: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 429:
+	case 468:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2504
+//line sqlite3.y:2699
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[2].str), String: sqlite3Dollar[4].str, CaseSensitive: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 430:
+	case 469:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2508
+//line sqlite3.y:2703
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 431:
+	case 470:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2512
+//line sqlite3.y:2707
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 432:
+	case 471:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2516
+//line sqlite3.y:2711
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 433:
+	case 472:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2520
+//line sqlite3.y:2715
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: (string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)), Value: ast.NewStrLiteral(sqlite3Dollar[4].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 434:
+	case 473:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2524
+//line sqlite3.y:2719
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 435:
+	case 474:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2528
+//line sqlite3.y:2723
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 436:
+	case 475:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2532
+//line sqlite3.y:2727
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 437:
+	case 476:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2536
+//line sqlite3.y:2731
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Value: ast.NewStrLiteral(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 438:
+	case 477:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2540
+//line sqlite3.y:2735
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 439:
+	case 478:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2544
+//line sqlite3.y:2739
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 440:
+	case 479:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2548
+//line sqlite3.y:2743
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[3].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 441:
+	case 480:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2552
+//line sqlite3.y:2747
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), String: (sqlite3Dollar[3].colIdent.String() + sqlite3Dollar[4].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 442:
+	case 481:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableOption
-//line sqlite3.y:2556
+//line sqlite3.y:2751
 		{
 			sqlite3LOCAL = &ast.TableOption{Name: string(sqlite3Dollar[1].str), Tables: sqlite3Dollar[4].tableNamesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 443:
+	case 482:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2561
+//line sqlite3.y:2756
 		{
 			sqlite3VAL.str = ""
 		}
-	case 444:
+	case 483:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2565
+//line sqlite3.y:2760
 		{
 			sqlite3VAL.str = " " + string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 445:
+	case 484:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:2569
+//line sqlite3.y:2764
 		{
 			sqlite3VAL.str = " " + string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 455:
+	case 494:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2588
+//line sqlite3.y:2783
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].colIdent.String()
 		}
-	case 456:
+	case 495:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2592
+//line sqlite3.y:2787
 		{
 			sqlite3VAL.str = sql_types.EncodeStringSQL(sqlite3Dollar[1].str)
 		}
-	case 457:
+	case 496:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:2596
+//line sqlite3.y:2791
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 458:
+	case 497:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:2601
+//line sqlite3.y:2796
 		{
 			sqlite3VAL.str = ""
 		}
-	case 460:
+	case 499:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2607
+//line sqlite3.y:2802
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 461:
+	case 500:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2611
+//line sqlite3.y:2806
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 462:
+	case 501:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColName
-//line sqlite3.y:2616
+//line sqlite3.y:2811
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 463:
+	case 502:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColName
-//line sqlite3.y:2620
+//line sqlite3.y:2815
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].colNameUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 464:
+	case 503:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2625
+//line sqlite3.y:2820
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 465:
+	case 504:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2629
+//line sqlite3.y:2824
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].alterOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 466:
+	case 505:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
-//line sqlite3.y:2633
+//line sqlite3.y:2828
 		{
 			sqlite3SLICE := (*[]ast.AlterOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, &ast.OrderByOption{Cols: sqlite3Dollar[5].columnsUnion()})
 		}
-	case 467:
+	case 506:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2637
+//line sqlite3.y:2832
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].alterOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 468:
+	case 507:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2641
+//line sqlite3.y:2836
 		{
 			sqlite3SLICE := (*[]ast.AlterOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].alterOptionsUnion()...)
 		}
-	case 469:
+	case 508:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2645
+//line sqlite3.y:2840
 		{
 			sqlite3LOCAL = append(append(sqlite3Dollar[1].alterOptionsUnion(), sqlite3Dollar[3].alterOptionsUnion()...), &ast.OrderByOption{Cols: sqlite3Dollar[7].columnsUnion()})
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 470:
+	case 509:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2651
+//line sqlite3.y:2846
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 471:
+	case 510:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2655
+//line sqlite3.y:2850
 		{
 			sqlite3SLICE := (*[]ast.AlterOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].alterOptionUnion())
 		}
-	case 472:
+	case 511:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2659
+//line sqlite3.y:2854
 		{
 			sqlite3SLICE := (*[]ast.AlterOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].alterOptionUnion())
 		}
-	case 473:
+	case 512:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2665
+//line sqlite3.y:2860
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].tableOptionsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 474:
+	case 513:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2669
+//line sqlite3.y:2864
 		{
 			sqlite3LOCAL = &ast.AddConstraintDefinition{ConstraintDefinition: sqlite3Dollar[2].constraintDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 475:
+	case 514:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2673
+//line sqlite3.y:2868
 		{
 			sqlite3LOCAL = &ast.AddConstraintDefinition{ConstraintDefinition: sqlite3Dollar[2].constraintDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 476:
+	case 515:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2677
+//line sqlite3.y:2872
 		{
 			sqlite3LOCAL = &ast.AddIndexDefinition{IndexDefinition: sqlite3Dollar[2].indexDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 477:
+	case 516:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2681
+//line sqlite3.y:2876
 		{
 			sqlite3LOCAL = &ast.AddColumns{Columns: sqlite3Dollar[4].columnDefinitionsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 478:
+	case 517:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2685
+//line sqlite3.y:2880
 		{
 			sqlite3LOCAL = &ast.AddColumns{Columns: []*ast.ColumnDefinition{sqlite3Dollar[3].columnDefinitionUnion()}, First: sqlite3Dollar[4].booleanUnion(), After: sqlite3Dollar[5].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 479:
+	case 518:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2689
+//line sqlite3.y:2884
 		{
 			sqlite3LOCAL = &ast.AlterColumn{Column: sqlite3Dollar[3].colNameUnion(), DropDefault: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 480:
+	case 519:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2693
+//line sqlite3.y:2888
 		{
 			sqlite3LOCAL = &ast.AlterColumn{Column: sqlite3Dollar[3].colNameUnion(), DropDefault: false, DefaultVal: sqlite3Dollar[6].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 481:
+	case 520:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2697
+//line sqlite3.y:2892
 		{
 			sqlite3LOCAL = &ast.AlterColumn{Column: sqlite3Dollar[3].colNameUnion(), DropDefault: false, DefaultVal: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 482:
+	case 521:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2701
+//line sqlite3.y:2896
 		{
 			val := false
 			sqlite3LOCAL = &ast.AlterColumn{Column: sqlite3Dollar[3].colNameUnion(), Invisible: &val}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 483:
+	case 522:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2706
+//line sqlite3.y:2901
 		{
 			val := true
 			sqlite3LOCAL = &ast.AlterColumn{Column: sqlite3Dollar[3].colNameUnion(), Invisible: &val}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 484:
+	case 523:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2711
+//line sqlite3.y:2906
 		{
 			sqlite3LOCAL = &ast.AlterCheck{Name: sqlite3Dollar[3].colIdent, Enforced: sqlite3Dollar[4].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 485:
+	case 524:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2715
+//line sqlite3.y:2910
 		{
 			sqlite3LOCAL = &ast.AlterIndex{Name: sqlite3Dollar[3].colIdent, Invisible: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 486:
+	case 525:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2719
+//line sqlite3.y:2914
 		{
 			sqlite3LOCAL = &ast.AlterIndex{Name: sqlite3Dollar[3].colIdent, Invisible: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 487:
+	case 526:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2723
+//line sqlite3.y:2918
 		{
 			sqlite3LOCAL = &ast.ChangeColumn{OldColumn: sqlite3Dollar[3].colNameUnion(), NewColDefinition: sqlite3Dollar[4].columnDefinitionUnion(), First: sqlite3Dollar[5].booleanUnion(), After: sqlite3Dollar[6].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 488:
+	case 527:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2727
+//line sqlite3.y:2922
 		{
 			sqlite3LOCAL = &ast.ModifyColumn{NewColDefinition: sqlite3Dollar[3].columnDefinitionUnion(), First: sqlite3Dollar[4].booleanUnion(), After: sqlite3Dollar[5].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 489:
+	case 528:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2731
+//line sqlite3.y:2926
 		{
 			sqlite3LOCAL = &ast.AlterCharset{CharacterSet: sqlite3Dollar[4].str, Collate: sqlite3Dollar[5].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 490:
+	case 529:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2735
+//line sqlite3.y:2930
 		{
 			sqlite3LOCAL = &ast.KeyState{Enable: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 491:
+	case 530:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2739
+//line sqlite3.y:2934
 		{
 			sqlite3LOCAL = &ast.KeyState{Enable: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 492:
+	case 531:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2743
+//line sqlite3.y:2938
 		{
 			sqlite3LOCAL = &ast.TablespaceOperation{Import: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 493:
+	case 532:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2747
+//line sqlite3.y:2942
 		{
 			sqlite3LOCAL = &ast.TablespaceOperation{Import: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 494:
+	case 533:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2751
+//line sqlite3.y:2946
 		{
 			sqlite3LOCAL = &ast.DropColumn{Name: sqlite3Dollar[3].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 495:
+	case 534:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2755
+//line sqlite3.y:2950
 		{
 			sqlite3LOCAL = &ast.DropKey{Type: ast.NormalKeyType, Name: sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 496:
+	case 535:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2759
+//line sqlite3.y:2954
 		{
 			sqlite3LOCAL = &ast.DropKey{Type: ast.PrimaryKeyType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 497:
+	case 536:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2763
+//line sqlite3.y:2958
 		{
 			sqlite3LOCAL = &ast.DropKey{Type: ast.ForeignKeyType, Name: sqlite3Dollar[4].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 498:
+	case 537:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2767
+//line sqlite3.y:2962
 		{
 			sqlite3LOCAL = &ast.DropKey{Type: ast.CheckKeyType, Name: sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 499:
+	case 538:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2771
+//line sqlite3.y:2966
 		{
 			sqlite3LOCAL = &ast.DropKey{Type: ast.CheckKeyType, Name: sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 500:
+	case 539:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2775
+//line sqlite3.y:2970
 		{
 			sqlite3LOCAL = &ast.Force{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 501:
+	case 540:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2779
+//line sqlite3.y:2974
 		{
 			sqlite3LOCAL = &ast.RenameTableName{Table: sqlite3Dollar[3].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 502:
+	case 541:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2783
+//line sqlite3.y:2978
 		{
 			sqlite3LOCAL = &ast.RenameIndex{OldName: sqlite3Dollar[3].colIdent, NewName: sqlite3Dollar[5].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 503:
+	case 542:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:2789
+//line sqlite3.y:2984
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 504:
+	case 543:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2793
+//line sqlite3.y:2988
 		{
 			sqlite3SLICE := (*[]ast.AlterOption)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].alterOptionUnion())
 		}
-	case 505:
+	case 544:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2799
+//line sqlite3.y:2994
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(string(sqlite3Dollar[3].str))
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 506:
+	case 545:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2803
+//line sqlite3.y:2998
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(string(sqlite3Dollar[3].str))
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 507:
+	case 546:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2807
+//line sqlite3.y:3002
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(string(sqlite3Dollar[3].str))
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 508:
+	case 547:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2811
+//line sqlite3.y:3006
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(string(sqlite3Dollar[3].str))
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 509:
+	case 548:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2815
+//line sqlite3.y:3010
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.DefaultType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 510:
+	case 549:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2819
+//line sqlite3.y:3014
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.NoneType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 511:
+	case 550:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2823
+//line sqlite3.y:3018
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.SharedType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 512:
+	case 551:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2827
+//line sqlite3.y:3022
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.ExclusiveType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 513:
+	case 552:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2831
+//line sqlite3.y:3026
 		{
 			sqlite3LOCAL = &ast.Validation{With: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 514:
+	case 553:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:2835
+//line sqlite3.y:3030
 		{
 			sqlite3LOCAL = &ast.Validation{With: false}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 515:
+	case 554:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2841
+//line sqlite3.y:3036
 		{
 			sqlite3Dollar[1].alterTableUnion().FullyParsed = true
 			sqlite3Dollar[1].alterTableUnion().AlterOptions = sqlite3Dollar[2].alterOptionsUnion()
 			sqlite3LOCAL = sqlite3Dollar[1].alterTableUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 516:
+	case 555:
 		sqlite3Dollar = sqlite3S[sqlite3pt-11 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2847
+//line sqlite3.y:3042
 		{
 			sqlite3LOCAL = &ast.AlterView{ViewName: sqlite3Dollar[7].tableName.ToViewName(), Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Algorithm: sqlite3Dollar[3].str, Definer: sqlite3Dollar[4].definerUnion(), Security: sqlite3Dollar[5].str, Columns: sqlite3Dollar[8].columnsUnion(), Select: sqlite3Dollar[10].selStmtUnion(), CheckOption: sqlite3Dollar[11].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 517:
+	case 556:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2857
+//line sqlite3.y:3052
 		{
 			sqlite3Dollar[1].alterDatabaseUnion().FullyParsed = true
 			sqlite3Dollar[1].alterDatabaseUnion().DBName = sqlite3Dollar[2].tableIdent
@@ -9176,10 +9665,10 @@ sqlite3default:
 			sqlite3LOCAL = sqlite3Dollar[1].alterDatabaseUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 518:
+	case 557:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2864
+//line sqlite3.y:3059
 		{
 			sqlite3LOCAL = &ast.AlterVschema{
 				Action: ast.CreateVindexDDLAction,
@@ -9192,10 +9681,10 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 519:
+	case 558:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2876
+//line sqlite3.y:3071
 		{
 			sqlite3LOCAL = &ast.AlterVschema{
 				Action: ast.DropVindexDDLAction,
@@ -9206,26 +9695,26 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 520:
+	case 559:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2886
+//line sqlite3.y:3081
 		{
 			sqlite3LOCAL = &ast.AlterVschema{Action: ast.AddVschemaTableDDLAction, Table: sqlite3Dollar[6].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 521:
+	case 560:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2890
+//line sqlite3.y:3085
 		{
 			sqlite3LOCAL = &ast.AlterVschema{Action: ast.DropVschemaTableDDLAction, Table: sqlite3Dollar[6].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 522:
+	case 561:
 		sqlite3Dollar = sqlite3S[sqlite3pt-13 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2894
+//line sqlite3.y:3089
 		{
 			sqlite3LOCAL = &ast.AlterVschema{
 				Action: ast.AddColVindexDDLAction,
@@ -9239,10 +9728,10 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 523:
+	case 562:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2907
+//line sqlite3.y:3102
 		{
 			sqlite3LOCAL = &ast.AlterVschema{
 				Action: ast.DropColVindexDDLAction,
@@ -9253,18 +9742,18 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 524:
+	case 563:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2917
+//line sqlite3.y:3112
 		{
 			sqlite3LOCAL = &ast.AlterVschema{Action: ast.AddSequenceDDLAction, Table: sqlite3Dollar[6].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 525:
+	case 564:
 		sqlite3Dollar = sqlite3S[sqlite3pt-10 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:2921
+//line sqlite3.y:3116
 		{
 			sqlite3LOCAL = &ast.AlterVschema{
 				Action: ast.AddAutoIncDDLAction,
@@ -9276,201 +9765,201 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 526:
+	case 565:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:2934
+//line sqlite3.y:3129
 		{
 			sqlite3LOCAL = &ast.JSONTableExpr{Expr: sqlite3Dollar[3].exprUnion(), Filter: sqlite3Dollar[5].exprUnion(), Columns: sqlite3Dollar[6].jtColumnListUnion(), Alias: sqlite3Dollar[8].tableIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 527:
+	case 566:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.JtColumnDefinition
-//line sqlite3.y:2940
+//line sqlite3.y:3135
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].jtColumnListUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 528:
+	case 567:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.JtColumnDefinition
-//line sqlite3.y:2946
+//line sqlite3.y:3141
 		{
 			sqlite3LOCAL = []*ast.JtColumnDefinition{sqlite3Dollar[1].jtColumnDefinitionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 529:
+	case 568:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:2950
+//line sqlite3.y:3145
 		{
 			sqlite3SLICE := (*[]*ast.JtColumnDefinition)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].jtColumnDefinitionUnion())
 		}
-	case 530:
+	case 569:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2956
+//line sqlite3.y:3151
 		{
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtOrdinal: &ast.JtOrdinalColDef{Name: sqlite3Dollar[1].colIdent}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 531:
+	case 570:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2960
+//line sqlite3.y:3155
 		{
 			sqlite3Dollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: sqlite3Dollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType, JtColExists: sqlite3Dollar[4].booleanUnion(), Path: sqlite3Dollar[6].exprUnion()}
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 532:
+	case 571:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2966
+//line sqlite3.y:3161
 		{
 			sqlite3Dollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: sqlite3Dollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType, JtColExists: sqlite3Dollar[4].booleanUnion(), Path: sqlite3Dollar[6].exprUnion(), EmptyOnResponse: sqlite3Dollar[7].jtOnResponseUnion()}
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 533:
+	case 572:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2972
+//line sqlite3.y:3167
 		{
 			sqlite3Dollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: sqlite3Dollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType, JtColExists: sqlite3Dollar[4].booleanUnion(), Path: sqlite3Dollar[6].exprUnion(), ErrorOnResponse: sqlite3Dollar[7].jtOnResponseUnion()}
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 534:
+	case 573:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2978
+//line sqlite3.y:3173
 		{
 			sqlite3Dollar[2].columnType.Options = &ast.ColumnTypeOptions{Collate: sqlite3Dollar[3].str}
 			jtPath := &ast.JtPathColDef{Name: sqlite3Dollar[1].colIdent, Type: sqlite3Dollar[2].columnType, JtColExists: sqlite3Dollar[4].booleanUnion(), Path: sqlite3Dollar[6].exprUnion(), EmptyOnResponse: sqlite3Dollar[7].jtOnResponseUnion(), ErrorOnResponse: sqlite3Dollar[8].jtOnResponseUnion()}
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtPath: jtPath}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 535:
+	case 574:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtColumnDefinition
-//line sqlite3.y:2984
+//line sqlite3.y:3179
 		{
 			jtNestedPath := &ast.JtNestedPathColDef{Path: sqlite3Dollar[3].exprUnion(), Columns: sqlite3Dollar[4].jtColumnListUnion()}
 			sqlite3LOCAL = &ast.JtColumnDefinition{JtNestedPath: jtNestedPath}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 536:
+	case 575:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2990
+//line sqlite3.y:3185
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 537:
+	case 576:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2994
+//line sqlite3.y:3189
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 538:
+	case 577:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:2998
+//line sqlite3.y:3193
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 539:
+	case 578:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3002
+//line sqlite3.y:3197
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 540:
+	case 579:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtOnResponse
-//line sqlite3.y:3008
+//line sqlite3.y:3203
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].jtOnResponseUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 541:
+	case 580:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtOnResponse
-//line sqlite3.y:3014
+//line sqlite3.y:3209
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].jtOnResponseUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 542:
+	case 581:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtOnResponse
-//line sqlite3.y:3020
+//line sqlite3.y:3215
 		{
 			sqlite3LOCAL = &ast.JtOnResponse{ResponseType: ast.ErrorJSONType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 543:
+	case 582:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtOnResponse
-//line sqlite3.y:3024
+//line sqlite3.y:3219
 		{
 			sqlite3LOCAL = &ast.JtOnResponse{ResponseType: ast.NullJSONType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 544:
+	case 583:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.JtOnResponse
-//line sqlite3.y:3028
+//line sqlite3.y:3223
 		{
 			sqlite3LOCAL = &ast.JtOnResponse{ResponseType: ast.DefaultJSONType, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 545:
+	case 584:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3034
+//line sqlite3.y:3229
 		{
 			sqlite3LOCAL = &ast.RenameTable{TablePairs: sqlite3Dollar[3].renameTablePairsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 546:
+	case 585:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.RenameTablePair
-//line sqlite3.y:3040
+//line sqlite3.y:3235
 		{
 			sqlite3LOCAL = []*ast.RenameTablePair{{FromTable: sqlite3Dollar[1].tableName, ToTable: sqlite3Dollar[3].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 547:
+	case 586:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
-//line sqlite3.y:3044
+//line sqlite3.y:3239
 		{
 			sqlite3SLICE := (*[]*ast.RenameTablePair)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, &ast.RenameTablePair{FromTable: sqlite3Dollar[3].tableName, ToTable: sqlite3Dollar[5].tableName})
 		}
-	case 548:
+	case 587:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3050
+//line sqlite3.y:3245
 		{
 			sqlite3LOCAL = &ast.DropTable{FromTables: sqlite3Dollar[6].tableNamesUnion(), IfExists: sqlite3Dollar[5].booleanUnion(), Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Temp: sqlite3Dollar[3].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 549:
+	case 588:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3054
+//line sqlite3.y:3249
 		{
 			// Change this to an alter statement
 			if sqlite3Dollar[4].colIdent.Lowered() == "primary" {
@@ -9480,1082 +9969,1180 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 550:
+	case 589:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3063
+//line sqlite3.y:3258
 		{
 			sqlite3LOCAL = &ast.DropView{FromTables: sqlite3Dollar[5].tableNamesUnion(), Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), IfExists: sqlite3Dollar[4].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 551:
+	case 590:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3067
+//line sqlite3.y:3262
 		{
 			sqlite3LOCAL = &ast.DropDatabase{Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), DBName: sqlite3Dollar[5].tableIdent, IfExists: sqlite3Dollar[4].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 552:
+	case 591:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3073
+//line sqlite3.y:3268
 		{
 			sqlite3LOCAL = &ast.TruncateTable{Table: sqlite3Dollar[3].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 553:
+	case 592:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3077
+//line sqlite3.y:3272
 		{
 			sqlite3LOCAL = &ast.TruncateTable{Table: sqlite3Dollar[2].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 554:
+	case 593:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3082
+//line sqlite3.y:3277
 		{
 			sqlite3LOCAL = &ast.OtherRead{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 555:
+	case 594:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3088
+//line sqlite3.y:3283
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Charset, Filter: sqlite3Dollar[3].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 556:
+	case 595:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3092
+//line sqlite3.y:3287
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Collation, Filter: sqlite3Dollar[3].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 557:
+	case 596:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3096
+//line sqlite3.y:3291
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Full: sqlite3Dollar[2].booleanUnion(), Command: ast.Column, Tbl: sqlite3Dollar[5].tableName, DbName: sqlite3Dollar[6].tableIdent, Filter: sqlite3Dollar[7].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 558:
+	case 597:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3100
+//line sqlite3.y:3295
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: sqlite3Dollar[3].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 559:
+	case 598:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3104
+//line sqlite3.y:3299
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Database, Filter: sqlite3Dollar[3].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 560:
+	case 599:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3108
+//line sqlite3.y:3303
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Keyspace, Filter: sqlite3Dollar[3].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 561:
+	case 600:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3112
+//line sqlite3.y:3307
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Function, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 562:
+	case 601:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3116
+//line sqlite3.y:3311
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Index, Tbl: sqlite3Dollar[5].tableName, DbName: sqlite3Dollar[6].tableIdent, Filter: sqlite3Dollar[7].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 563:
+	case 602:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3120
+//line sqlite3.y:3315
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.OpenTable, DbName: sqlite3Dollar[4].tableIdent, Filter: sqlite3Dollar[5].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 564:
+	case 603:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3124
+//line sqlite3.y:3319
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Privilege}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 565:
+	case 604:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3128
+//line sqlite3.y:3323
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Procedure, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 566:
+	case 605:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3132
+//line sqlite3.y:3327
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusSession, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 567:
+	case 606:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3136
+//line sqlite3.y:3331
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.StatusGlobal, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 568:
+	case 607:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3140
+//line sqlite3.y:3335
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableSession, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 569:
+	case 608:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3144
+//line sqlite3.y:3339
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VariableGlobal, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 570:
+	case 609:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3148
+//line sqlite3.y:3343
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.TableStatus, DbName: sqlite3Dollar[4].tableIdent, Filter: sqlite3Dollar[5].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 571:
+	case 610:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3152
+//line sqlite3.y:3347
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Table, Full: sqlite3Dollar[2].booleanUnion(), DbName: sqlite3Dollar[4].tableIdent, Filter: sqlite3Dollar[5].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 572:
+	case 611:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3156
+//line sqlite3.y:3351
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Trigger, DbName: sqlite3Dollar[3].tableIdent, Filter: sqlite3Dollar[4].showFilterUnion()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 573:
+	case 612:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3160
+//line sqlite3.y:3355
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateDb, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 574:
+	case 613:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3164
+//line sqlite3.y:3359
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateE, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 575:
+	case 614:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3168
+//line sqlite3.y:3363
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateF, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 576:
+	case 615:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3172
+//line sqlite3.y:3367
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateProc, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 577:
+	case 616:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3176
+//line sqlite3.y:3371
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTbl, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 578:
+	case 617:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3180
+//line sqlite3.y:3375
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateTr, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 579:
+	case 618:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3184
+//line sqlite3.y:3379
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowCreate{Command: ast.CreateV, Op: sqlite3Dollar[4].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 580:
+	case 619:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3188
+//line sqlite3.y:3383
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Plugins}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 581:
+	case 620:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3192
+//line sqlite3.y:3387
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.GtidExecGlobal, DbName: sqlite3Dollar[4].tableIdent}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 582:
+	case 621:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3196
+//line sqlite3.y:3391
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VGtidExecGlobal, DbName: sqlite3Dollar[4].tableIdent}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 583:
+	case 622:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3200
+//line sqlite3.y:3395
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaTables}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 584:
+	case 623:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3204
+//line sqlite3.y:3399
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 585:
+	case 624:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3208
+//line sqlite3.y:3403
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.VschemaVindexes, Tbl: sqlite3Dollar[5].tableName}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 586:
+	case 625:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3212
+//line sqlite3.y:3407
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowBasic{Command: ast.Warnings}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 587:
+	case 626:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3219
+//line sqlite3.y:3414
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].colIdent.String())}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 588:
+	case 627:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3223
+//line sqlite3.y:3418
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 589:
+	case 628:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3227
+//line sqlite3.y:3422
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str) + " " + sqlite3Dollar[3].colIdent.String()}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 590:
+	case 629:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3231
+//line sqlite3.y:3426
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 591:
+	case 630:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3235
+//line sqlite3.y:3430
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str) + " " + ast.String(sqlite3Dollar[4].tableName)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 592:
+	case 631:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3239
+//line sqlite3.y:3434
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str) + " " + string(sqlite3Dollar[3].str) + " " + ast.String(sqlite3Dollar[4].tableName)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 593:
+	case 632:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3243
+//line sqlite3.y:3438
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[3].str)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 594:
+	case 633:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3247
+//line sqlite3.y:3442
 		{
 			sqlite3LOCAL = &ast.Show{Internal: &ast.ShowOther{Command: string(sqlite3Dollar[2].str)}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 595:
+	case 634:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3253
+//line sqlite3.y:3448
 		{
 			sqlite3VAL.str = ""
 		}
-	case 596:
+	case 635:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3257
+//line sqlite3.y:3452
 		{
 			sqlite3VAL.str = "extended "
 		}
-	case 597:
+	case 636:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3263
+//line sqlite3.y:3458
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 598:
+	case 637:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3267
+//line sqlite3.y:3462
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 599:
+	case 638:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3273
+//line sqlite3.y:3468
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 600:
+	case 639:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3277
+//line sqlite3.y:3472
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 601:
+	case 640:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3283
+//line sqlite3.y:3478
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 602:
+	case 641:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3287
+//line sqlite3.y:3482
+		{
+			sqlite3VAL.tableIdent = sqlite3Dollar[2].tableIdent
+		}
+	case 642:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:3486
+		{
+			sqlite3VAL.tableIdent = sqlite3Dollar[2].tableIdent
+		}
+	case 643:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.ShowFilter
+//line sqlite3.y:3492
+		{
+			sqlite3LOCAL = nil
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 644:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.ShowFilter
+//line sqlite3.y:3496
+		{
+			sqlite3LOCAL = &ast.ShowFilter{Like: string(sqlite3Dollar[2].str)}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 645:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL *ast.ShowFilter
+//line sqlite3.y:3500
+		{
+			sqlite3LOCAL = &ast.ShowFilter{Filter: sqlite3Dollar[2].exprUnion()}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 646:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+//line sqlite3.y:3506
+		{
+			sqlite3VAL.empty = struct{}{}
+		}
+	case 647:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:3510
+		{
+			sqlite3VAL.empty = struct{}{}
+		}
+	case 648:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:3514
+		{
+			sqlite3VAL.empty = struct{}{}
+		}
+	case 649:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:3520
+		{
+			sqlite3VAL.str = string(sqlite3Dollar[1].str)
+		}
+	case 650:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:3524
+		{
+			sqlite3VAL.str = string(sqlite3Dollar[1].str)
+		}
+	case 651:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3530
+		{
+			sqlite3LOCAL = &ast.Use{DBName: sqlite3Dollar[2].tableIdent}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 652:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3534
+		{
+			sqlite3LOCAL = &ast.Use{DBName: ast.TableIdent{V: ""}}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 653:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3538
+		{
+			sqlite3LOCAL = &ast.Use{DBName: ast.NewTableIdent(sqlite3Dollar[2].tableIdent.String() + "@" + string(sqlite3Dollar[3].str))}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 654:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3544
 		{
-			sqlite3VAL.tableIdent = sqlite3Dollar[2].tableIdent
+			sqlite3LOCAL = &ast.Begin{}
 		}
-	case 603:
+		sqlite3VAL.union = sqlite3LOCAL
+	case 655:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3291
-		{
-			sqlite3VAL.tableIdent = sqlite3Dollar[2].tableIdent
-		}
-	case 604:
-		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.ShowFilter
-//line sqlite3.y:3297
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3548
 		{
-			sqlite3LOCAL = nil
+			sqlite3LOCAL = &ast.Begin{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 605:
+	case 656:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.ShowFilter
-//line sqlite3.y:3301
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3554
 		{
-			sqlite3LOCAL = &ast.ShowFilter{Like: string(sqlite3Dollar[2].str)}
+			sqlite3LOCAL = &ast.Commit{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 606:
+	case 657:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-		var sqlite3LOCAL *ast.ShowFilter
-//line sqlite3.y:3305
+		var sqlite3LOCAL ast.Statement
+//line sqlite3.y:3558
 		{
-			sqlite3LOCAL = &ast.ShowFilter{Filter: sqlite3Dollar[2].exprUnion()}
+			sqlite3LOCAL = &ast.Commit{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 607:
+	case 658:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3311
+//line sqlite3.y:3563
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 608:
+	case 659:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3315
+//line sqlite3.y:3565
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 609:
+	case 660:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3319
+//line sqlite3.y:3567
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 610:
+	case 661:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3325
+//line sqlite3.y:3569
 		{
-			sqlite3VAL.str = string(sqlite3Dollar[1].str)
+			sqlite3VAL.empty = struct{}{}
 		}
-	case 611:
+	case 662:
+		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
+//line sqlite3.y:3572
+		{
+			sqlite3VAL.empty = struct{}{}
+		}
+	case 663:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3329
+//line sqlite3.y:3574
 		{
-			sqlite3VAL.str = string(sqlite3Dollar[1].str)
+			sqlite3VAL.empty = struct{}{}
 		}
-	case 612:
+	case 664:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3335
+//line sqlite3.y:3578
 		{
-			sqlite3LOCAL = &ast.Use{DBName: sqlite3Dollar[2].tableIdent}
+			sqlite3LOCAL = &ast.Pragma{Name: sqlite3Dollar[2].tableName}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 613:
-		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+	case 665:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3339
+//line sqlite3.y:3582
 		{
-			sqlite3LOCAL = &ast.Use{DBName: ast.TableIdent{V: ""}}
+			sqlite3LOCAL = &ast.Pragma{Name: sqlite3Dollar[2].tableName, Value: sqlite3Dollar[4].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 614:
-		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
+	case 666:
+		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3343
+//line sqlite3.y:3586
 		{
-			sqlite3LOCAL = &ast.Use{DBName: ast.NewTableIdent(sqlite3Dollar[2].tableIdent.String() + "@" + string(sqlite3Dollar[3].str))}
+			sqlite3LOCAL = &ast.Pragma{Name: sqlite3Dollar[2].tableName, Value: sqlite3Dollar[4].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 615:
+	case 667:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3349
+//line sqlite3.y:3592
 		{
-			sqlite3LOCAL = &ast.Begin{}
+			sqlite3VAL.str = sqlite3Dollar[1].colIdent.String()
 		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 616:
-		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3353
+	case 668:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:3596
 		{
-			sqlite3LOCAL = &ast.Begin{}
+			sqlite3VAL.str = sql_types.EncodeStringSQL(sqlite3Dollar[1].str)
 		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 617:
+	case 669:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3359
+//line sqlite3.y:3600
 		{
-			sqlite3LOCAL = &ast.Commit{}
+			sqlite3VAL.str = sqlite3Dollar[1].str
 		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 618:
+	case 670:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:3604
+		{
+			sqlite3VAL.str = "-" + sqlite3Dollar[2].str
+		}
+	case 671:
+		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
+//line sqlite3.y:3608
+		{
+			sqlite3VAL.str = sqlite3Dollar[2].str
+		}
+	case 672:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3365
+//line sqlite3.y:3614
 		{
 			sqlite3LOCAL = &ast.Rollback{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 619:
+	case 673:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3369
+//line sqlite3.y:3618
 		{
 			sqlite3LOCAL = &ast.SRollback{Name: sqlite3Dollar[5].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 620:
+	case 674:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3374
+//line sqlite3.y:3623
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 621:
+	case 675:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3376
+//line sqlite3.y:3625
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 622:
+	case 676:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3379
+//line sqlite3.y:3628
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 623:
+	case 677:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3381
+//line sqlite3.y:3630
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 624:
+	case 678:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3386
+//line sqlite3.y:3635
 		{
 			sqlite3LOCAL = &ast.Savepoint{Name: sqlite3Dollar[2].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 625:
+	case 679:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3392
+//line sqlite3.y:3641
 		{
 			sqlite3LOCAL = &ast.Release{Name: sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 626:
+	case 680:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3397
+//line sqlite3.y:3646
 		{
 			sqlite3LOCAL = ast.EmptyType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 627:
+	case 681:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3401
+//line sqlite3.y:3650
 		{
 			sqlite3LOCAL = ast.JSONType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 628:
+	case 682:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3405
+//line sqlite3.y:3654
 		{
 			sqlite3LOCAL = ast.TreeType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 629:
+	case 683:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3409
+//line sqlite3.y:3658
 		{
 			sqlite3LOCAL = ast.TraditionalType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 630:
+	case 684:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3413
+//line sqlite3.y:3662
 		{
 			sqlite3LOCAL = ast.AnalyzeType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 631:
+	case 685:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ExplainType
-//line sqlite3.y:3417
+//line sqlite3.y:3666
 		{
 			sqlite3LOCAL = ast.AnalyzeType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 632:
+	case 686:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3423
+//line sqlite3.y:3672
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].str
 		}
-	case 633:
+	case 687:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3427
+//line sqlite3.y:3676
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].str
 		}
-	case 634:
+	case 688:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3431
+//line sqlite3.y:3680
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].str
 		}
-	case 635:
+	case 689:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3437
+//line sqlite3.y:3686
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selStmtUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 636:
+	case 690:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3441
+//line sqlite3.y:3690
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].statementUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 637:
+	case 691:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3445
+//line sqlite3.y:3694
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].statementUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 638:
+	case 692:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3449
+//line sqlite3.y:3698
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].statementUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 639:
+	case 693:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3454
+//line sqlite3.y:3703
 		{
 			sqlite3VAL.str = ""
 		}
-	case 640:
+	case 694:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3458
+//line sqlite3.y:3707
 		{
 			sqlite3VAL.str = sqlite3Dollar[1].colIdent.Val
 		}
-	case 641:
+	case 695:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3462
+//line sqlite3.y:3711
 		{
 			sqlite3VAL.str = sql_types.EncodeStringSQL(sqlite3Dollar[1].str)
 		}
-	case 642:
+	case 696:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3468
+//line sqlite3.y:3717
 		{
 			sqlite3LOCAL = &ast.ExplainTab{Table: sqlite3Dollar[2].tableName, Wild: sqlite3Dollar[3].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 643:
+	case 697:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3472
+//line sqlite3.y:3721
 		{
 			sqlite3LOCAL = &ast.ExplainStmt{Type: sqlite3Dollar[2].explainTypeUnion(), Statement: sqlite3Dollar[3].statementUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 644:
+	case 698:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3478
+//line sqlite3.y:3727
 		{
 			sqlite3LOCAL = &ast.OtherAdmin{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 645:
+	case 699:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3482
+//line sqlite3.y:3731
 		{
 			sqlite3LOCAL = &ast.OtherAdmin{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 646:
+	case 700:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3488
+//line sqlite3.y:3737
 		{
 			sqlite3LOCAL = &ast.LockTables{Tables: sqlite3Dollar[3].tableAndLockTypesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 647:
+	case 701:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableAndLockTypes
-//line sqlite3.y:3494
+//line sqlite3.y:3743
 		{
 			sqlite3LOCAL = ast.TableAndLockTypes{sqlite3Dollar[1].tableAndLockTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 648:
+	case 702:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3498
+//line sqlite3.y:3747
 		{
 			sqlite3SLICE := (*ast.TableAndLockTypes)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableAndLockTypeUnion())
 		}
-	case 649:
+	case 703:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.TableAndLockType
-//line sqlite3.y:3504
+//line sqlite3.y:3753
 		{
 			sqlite3LOCAL = &ast.TableAndLockType{Table: sqlite3Dollar[1].aliasedTableNameUnion(), Lock: sqlite3Dollar[2].lockTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 650:
+	case 704:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.LockType
-//line sqlite3.y:3510
+//line sqlite3.y:3759
 		{
 			sqlite3LOCAL = ast.Read
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 651:
+	case 705:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.LockType
-//line sqlite3.y:3514
+//line sqlite3.y:3763
 		{
 			sqlite3LOCAL = ast.ReadLocal
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 652:
+	case 706:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.LockType
-//line sqlite3.y:3518
+//line sqlite3.y:3767
 		{
 			sqlite3LOCAL = ast.Write
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 653:
+	case 707:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.LockType
-//line sqlite3.y:3522
+//line sqlite3.y:3771
 		{
 			sqlite3LOCAL = ast.LowPriorityWrite
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 654:
+	case 708:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3528
+//line sqlite3.y:3777
 		{
 			sqlite3LOCAL = &ast.UnlockTables{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 655:
+	case 709:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3534
+//line sqlite3.y:3783
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion(), FlushOptions: sqlite3Dollar[3].strs}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 656:
+	case 710:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3538
+//line sqlite3.y:3787
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 657:
+	case 711:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3542
+//line sqlite3.y:3791
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion(), WithLock: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 658:
+	case 712:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3546
+//line sqlite3.y:3795
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion(), TableNames: sqlite3Dollar[4].tableNamesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 659:
+	case 713:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3550
+//line sqlite3.y:3799
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion(), TableNames: sqlite3Dollar[4].tableNamesUnion(), WithLock: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 660:
+	case 714:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3554
+//line sqlite3.y:3803
 		{
 			sqlite3LOCAL = &ast.Flush{IsLocal: sqlite3Dollar[2].booleanUnion(), TableNames: sqlite3Dollar[4].tableNamesUnion(), ForExport: true}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 661:
+	case 715:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3560
+//line sqlite3.y:3809
 		{
 			sqlite3VAL.strs = []string{sqlite3Dollar[1].str}
 		}
-	case 662:
+	case 716:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3564
+//line sqlite3.y:3813
 		{
 			sqlite3VAL.strs = append(sqlite3Dollar[1].strs, sqlite3Dollar[3].str)
 		}
-	case 663:
+	case 717:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3570
+//line sqlite3.y:3819
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 664:
+	case 718:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3574
+//line sqlite3.y:3823
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 665:
+	case 719:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3578
+//line sqlite3.y:3827
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 666:
+	case 720:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3582
+//line sqlite3.y:3831
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 667:
+	case 721:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3586
+//line sqlite3.y:3835
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 668:
+	case 722:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3590
+//line sqlite3.y:3839
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 669:
+	case 723:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3594
+//line sqlite3.y:3843
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str) + sqlite3Dollar[3].str
 		}
-	case 670:
+	case 724:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3598
+//line sqlite3.y:3847
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str)
 		}
-	case 671:
+	case 725:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3602
+//line sqlite3.y:3851
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 672:
+	case 726:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3606
+//line sqlite3.y:3855
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 673:
+	case 727:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3610
+//line sqlite3.y:3859
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 674:
+	case 728:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3615
+//line sqlite3.y:3864
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 675:
+	case 729:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3619
+//line sqlite3.y:3868
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 676:
+	case 730:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3623
+//line sqlite3.y:3872
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 677:
+	case 731:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3628
+//line sqlite3.y:3877
 		{
 			sqlite3VAL.str = ""
 		}
-	case 678:
+	case 732:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3632
+//line sqlite3.y:3881
 		{
 			sqlite3VAL.str = " " + string(sqlite3Dollar[1].str) + " " + string(sqlite3Dollar[2].str) + " " + sqlite3Dollar[3].colIdent.String()
 		}
-	case 679:
+	case 733:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3637
+//line sqlite3.y:3886
 		{
 			setAllowComments(sqlite3lex, true)
 		}
-	case 680:
+	case 734:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3641
+//line sqlite3.y:3890
 		{
 			// Strange argumets shift
 			sqlite3LOCAL = &ast.CommentOnSchema{Comments: ast.Comments{sqlite3Dollar[2].str}.Parsed(), Schema: sqlite3Dollar[5].schemaName.Name, Value: sqlite3Dollar[7].exprUnion()}
 			setAllowComments(sqlite3lex, false)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 681:
+	case 735:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3649
+//line sqlite3.y:3898
 		{
 			setAllowComments(sqlite3lex, true)
 		}
-	case 682:
+	case 736:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3653
+//line sqlite3.y:3902
 		{
 			sqlite3VAL.strs = sqlite3Dollar[2].strs
 			setAllowComments(sqlite3lex, false)
 		}
-	case 683:
+	case 737:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3659
+//line sqlite3.y:3908
 		{
 			sqlite3VAL.strs = nil
 		}
-	case 684:
+	case 738:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3663
+//line sqlite3.y:3912
 		{
 			sqlite3VAL.strs = append(sqlite3Dollar[1].strs, sqlite3Dollar[2].str)
 		}
-	case 685:
+	case 739:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3669
+//line sqlite3.y:3918
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 686:
+	case 740:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3673
+//line sqlite3.y:3922
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 687:
+	case 741:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3677
+//line sqlite3.y:3926
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 688:
+	case 742:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3682
+//line sqlite3.y:3931
 		{
 			sqlite3VAL.str = ""
 		}
-	case 689:
+	case 743:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3686
+//line sqlite3.y:3935
 		{
 			sqlite3VAL.str = ast.SQLNoCacheStr
 		}
-	case 690:
+	case 744:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3690
+//line sqlite3.y:3939
 		{
 			sqlite3VAL.str = ast.SQLCacheStr
 		}
-	case 691:
+	case 745:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3695
+//line sqlite3.y:3944
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 692:
+	case 746:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3699
+//line sqlite3.y:3948
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 693:
+	case 747:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:3703
+//line sqlite3.y:3952
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 694:
+	case 748:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3709
+//line sqlite3.y:3958
 		{
 			sqlite3LOCAL = &ast.PrepareStmt{Name: sqlite3Dollar[3].colIdent, Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Statement: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 695:
+	case 749:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3713
+//line sqlite3.y:3962
 		{
 			sqlite3LOCAL = &ast.PrepareStmt{
 				Name:     sqlite3Dollar[3].colIdent,
@@ -10566,562 +11153,562 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 696:
+	case 750:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3725
+//line sqlite3.y:3974
 		{
 			sqlite3LOCAL = &ast.ExecuteStmt{Name: sqlite3Dollar[3].colIdent, Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Arguments: sqlite3Dollar[4].columnsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 697:
+	case 751:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3730
+//line sqlite3.y:3979
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 698:
+	case 752:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3734
+//line sqlite3.y:3983
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].columnsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 699:
+	case 753:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3740
+//line sqlite3.y:3989
 		{
 			sqlite3LOCAL = &ast.DeallocateStmt{Type: ast.DeallocateType, Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Name: sqlite3Dollar[4].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 700:
+	case 754:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:3744
+//line sqlite3.y:3993
 		{
 			sqlite3LOCAL = &ast.DeallocateStmt{Type: ast.DropType, Comments: ast.Comments(sqlite3Dollar[2].strs).Parsed(), Name: sqlite3Dollar[4].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 701:
+	case 755:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExprs
-//line sqlite3.y:3749
+//line sqlite3.y:3998
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 702:
+	case 756:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExprs
-//line sqlite3.y:3753
+//line sqlite3.y:4002
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].selectExprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 703:
+	case 757:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3758
+//line sqlite3.y:4007
 		{
 			sqlite3VAL.strs = nil
 		}
-	case 704:
+	case 758:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3762
+//line sqlite3.y:4011
 		{
 			sqlite3VAL.strs = []string{sqlite3Dollar[1].str}
 		}
-	case 705:
+	case 759:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3766
+//line sqlite3.y:4015
 		{ // TODO: ast.This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			sqlite3VAL.strs = []string{sqlite3Dollar[1].str, sqlite3Dollar[2].str}
 		}
-	case 706:
+	case 760:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3770
+//line sqlite3.y:4019
 		{
 			sqlite3VAL.strs = []string{sqlite3Dollar[1].str, sqlite3Dollar[2].str, sqlite3Dollar[3].str}
 		}
-	case 707:
+	case 761:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:3774
+//line sqlite3.y:4023
 		{
 			sqlite3VAL.strs = []string{sqlite3Dollar[1].str, sqlite3Dollar[2].str, sqlite3Dollar[3].str, sqlite3Dollar[4].str}
 		}
-	case 708:
+	case 762:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3780
+//line sqlite3.y:4029
 		{
 			sqlite3VAL.str = ast.SQLNoCacheStr
 		}
-	case 709:
+	case 763:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3784
+//line sqlite3.y:4033
 		{
 			sqlite3VAL.str = ast.SQLCacheStr
 		}
-	case 710:
+	case 764:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3788
+//line sqlite3.y:4037
 		{
 			sqlite3VAL.str = ast.DistinctStr
 		}
-	case 711:
+	case 765:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3792
+//line sqlite3.y:4041
 		{
 			sqlite3VAL.str = ast.DistinctStr
 		}
-	case 712:
+	case 766:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3796
+//line sqlite3.y:4045
 		{
 			sqlite3VAL.str = ast.StraightJoinHint
 		}
-	case 713:
+	case 767:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3800
+//line sqlite3.y:4049
 		{
 			sqlite3VAL.str = ast.SQLCalcFoundRowsStr
 		}
-	case 714:
+	case 768:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3804
+//line sqlite3.y:4053
 		{
 			sqlite3VAL.str = ast.AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 715:
+	case 769:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExprs
-//line sqlite3.y:3810
+//line sqlite3.y:4059
 		{
 			sqlite3LOCAL = ast.SelectExprs{sqlite3Dollar[1].selectExprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 716:
+	case 770:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3814
+//line sqlite3.y:4063
 		{
 			sqlite3SLICE := (*ast.SelectExprs)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].selectExprUnion())
 		}
-	case 717:
+	case 771:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExpr
-//line sqlite3.y:3820
+//line sqlite3.y:4069
 		{
 			sqlite3LOCAL = &ast.StarExpr{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 718:
+	case 772:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExpr
-//line sqlite3.y:3824
+//line sqlite3.y:4073
 		{
 			sqlite3LOCAL = &ast.AliasedExpr{Expr: sqlite3Dollar[1].exprUnion(), As: sqlite3Dollar[2].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 719:
+	case 773:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExpr
-//line sqlite3.y:3828
+//line sqlite3.y:4077
 		{
 			sqlite3LOCAL = &ast.StarExpr{TableName: ast.TableName{Name: sqlite3Dollar[1].tableIdent}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 720:
+	case 774:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SelectExpr
-//line sqlite3.y:3832
+//line sqlite3.y:4081
 		{
 			sqlite3LOCAL = &ast.StarExpr{TableName: ast.TableName{Qualifier: sqlite3Dollar[1].tableIdent, Name: sqlite3Dollar[3].tableIdent}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 721:
+	case 775:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3837
+//line sqlite3.y:4086
 		{
 			sqlite3VAL.colIdent = ast.ColIdent{}
 		}
-	case 722:
+	case 776:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3841
+//line sqlite3.y:4090
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[1].colIdent
 		}
-	case 723:
+	case 777:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3845
+//line sqlite3.y:4094
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[2].colIdent
 		}
-	case 725:
+	case 779:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:3852
+//line sqlite3.y:4101
 		{
 			sqlite3VAL.colIdent = ast.NewColIdent(string(sqlite3Dollar[1].str))
 		}
-	case 726:
+	case 780:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExprs
-//line sqlite3.y:3857
+//line sqlite3.y:4106
 		{
 			sqlite3LOCAL = ast.TableExprs{&ast.AliasedTableExpr{Expr: ast.TableName{Name: ast.NewTableIdent("dual")}}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 727:
+	case 781:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExprs
-//line sqlite3.y:3861
+//line sqlite3.y:4110
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].tableExprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 728:
+	case 782:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExprs
-//line sqlite3.y:3867
+//line sqlite3.y:4116
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].tableExprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 729:
+	case 783:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExprs
-//line sqlite3.y:3873
+//line sqlite3.y:4122
 		{
 			sqlite3LOCAL = ast.TableExprs{sqlite3Dollar[1].tableExprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 730:
+	case 784:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3877
+//line sqlite3.y:4126
 		{
 			sqlite3SLICE := (*ast.TableExprs)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].tableExprUnion())
 		}
-	case 733:
+	case 787:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3887
+//line sqlite3.y:4136
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].aliasedTableNameUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 734:
+	case 788:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3891
+//line sqlite3.y:4140
 		{
 			sqlite3LOCAL = &ast.AliasedTableExpr{Expr: sqlite3Dollar[1].derivedTableUnion(), As: sqlite3Dollar[3].tableIdent, Columns: sqlite3Dollar[4].columnsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 735:
+	case 789:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3895
+//line sqlite3.y:4144
 		{
 			sqlite3LOCAL = &ast.ParenTableExpr{Exprs: sqlite3Dollar[2].tableExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 736:
+	case 790:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3899
+//line sqlite3.y:4148
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].tableExprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 737:
+	case 791:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.DerivedTable
-//line sqlite3.y:3905
+//line sqlite3.y:4154
 		{
 			sqlite3LOCAL = &ast.DerivedTable{Lateral: false, Select: sqlite3Dollar[2].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 738:
+	case 792:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.DerivedTable
-//line sqlite3.y:3909
+//line sqlite3.y:4158
 		{
 			sqlite3LOCAL = &ast.DerivedTable{Lateral: true, Select: sqlite3Dollar[3].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 739:
+	case 793:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.AliasedTableExpr
-//line sqlite3.y:3915
+//line sqlite3.y:4164
 		{
 			sqlite3LOCAL = &ast.AliasedTableExpr{Expr: sqlite3Dollar[1].tableName, As: sqlite3Dollar[2].tableIdent, Hints: sqlite3Dollar[3].indexHintsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 740:
+	case 794:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3920
+//line sqlite3.y:4169
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 741:
+	case 795:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3924
+//line sqlite3.y:4173
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].columnsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 742:
+	case 796:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3930
+//line sqlite3.y:4179
 		{
 			sqlite3LOCAL = ast.Columns{sqlite3Dollar[1].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 743:
+	case 797:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3934
+//line sqlite3.y:4183
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].colIdent)
 		}
-	case 744:
+	case 798:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3940
+//line sqlite3.y:4189
 		{
 			sqlite3LOCAL = ast.Columns{ast.NewColIdentWithAt(string(sqlite3Dollar[1].str), ast.SingleAt)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 745:
+	case 799:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3944
+//line sqlite3.y:4193
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, ast.NewColIdentWithAt(string(sqlite3Dollar[3].str), ast.SingleAt))
 		}
-	case 746:
+	case 800:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3950
+//line sqlite3.y:4199
 		{
 			sqlite3LOCAL = ast.Columns{sqlite3Dollar[1].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 747:
+	case 801:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:3954
+//line sqlite3.y:4203
 		{
 			sqlite3LOCAL = ast.Columns{ast.NewColIdent(string(sqlite3Dollar[1].str))}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 748:
+	case 802:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3958
+//line sqlite3.y:4207
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].colIdent)
 		}
-	case 749:
+	case 803:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:3962
+//line sqlite3.y:4211
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, ast.NewColIdent(string(sqlite3Dollar[3].str)))
 		}
-	case 750:
+	case 804:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3975
+//line sqlite3.y:4224
 		{
 			sqlite3LOCAL = &ast.JoinTableExpr{LeftExpr: sqlite3Dollar[1].tableExprUnion(), Join: sqlite3Dollar[2].joinTypeUnion(), RightExpr: sqlite3Dollar[3].tableExprUnion(), Condition: sqlite3Dollar[4].joinCondition}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 751:
+	case 805:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3979
+//line sqlite3.y:4228
 		{
 			sqlite3LOCAL = &ast.JoinTableExpr{LeftExpr: sqlite3Dollar[1].tableExprUnion(), Join: sqlite3Dollar[2].joinTypeUnion(), RightExpr: sqlite3Dollar[3].tableExprUnion(), Condition: sqlite3Dollar[4].joinCondition}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 752:
+	case 806:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3983
+//line sqlite3.y:4232
 		{
 			sqlite3LOCAL = &ast.JoinTableExpr{LeftExpr: sqlite3Dollar[1].tableExprUnion(), Join: sqlite3Dollar[2].joinTypeUnion(), RightExpr: sqlite3Dollar[3].tableExprUnion(), Condition: sqlite3Dollar[4].joinCondition}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 753:
+	case 807:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TableExpr
-//line sqlite3.y:3987
+//line sqlite3.y:4236
 		{
 			sqlite3LOCAL = &ast.JoinTableExpr{LeftExpr: sqlite3Dollar[1].tableExprUnion(), Join: sqlite3Dollar[2].joinTypeUnion(), RightExpr: sqlite3Dollar[3].tableExprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 754:
+	case 808:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:3993
+//line sqlite3.y:4242
 		{
 			sqlite3VAL.joinCondition = &ast.JoinCondition{On: sqlite3Dollar[2].exprUnion()}
 		}
-	case 755:
+	case 809:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:3995
+//line sqlite3.y:4244
 		{
 			sqlite3VAL.joinCondition = &ast.JoinCondition{Using: sqlite3Dollar[3].columnsUnion()}
 		}
-	case 756:
+	case 810:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:3999
+//line sqlite3.y:4248
 		{
 			sqlite3VAL.joinCondition = &ast.JoinCondition{}
 		}
-	case 757:
+	case 811:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4001
+//line sqlite3.y:4250
 		{
 			sqlite3VAL.joinCondition = sqlite3Dollar[1].joinCondition
 		}
-	case 758:
+	case 812:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:4005
+//line sqlite3.y:4254
 		{
 			sqlite3VAL.joinCondition = &ast.JoinCondition{}
 		}
-	case 759:
+	case 813:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:4007
+//line sqlite3.y:4256
 		{
 			sqlite3VAL.joinCondition = &ast.JoinCondition{On: sqlite3Dollar[2].exprUnion()}
 		}
-	case 760:
+	case 814:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:4011
+//line sqlite3.y:4260
 		{
 			sqlite3VAL.schemaName = ast.SchemaName{Name: sqlite3Dollar[2].schemaIdent}
 		}
-	case 761:
+	case 815:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:4016
+//line sqlite3.y:4265
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 762:
+	case 816:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4018
+//line sqlite3.y:4267
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 763:
+	case 817:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:4021
+//line sqlite3.y:4270
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 764:
+	case 818:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4025
+//line sqlite3.y:4274
 		{
 			sqlite3VAL.tableIdent = sqlite3Dollar[1].tableIdent
 		}
-	case 765:
+	case 819:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:4029
+//line sqlite3.y:4278
 		{
 			sqlite3VAL.tableIdent = sqlite3Dollar[2].tableIdent
 		}
-	case 767:
+	case 821:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4036
+//line sqlite3.y:4285
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent(string(sqlite3Dollar[1].str))
 		}
-	case 768:
+	case 822:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4042
+//line sqlite3.y:4291
 		{
 			sqlite3LOCAL = ast.NormalJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 769:
+	case 823:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4046
+//line sqlite3.y:4295
 		{
 			sqlite3LOCAL = ast.NormalJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 770:
+	case 824:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4050
+//line sqlite3.y:4299
 		{
 			sqlite3LOCAL = ast.NormalJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 771:
+	case 825:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4056
+//line sqlite3.y:4305
 		{
 			sqlite3LOCAL = ast.StraightJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 772:
+	case 826:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4062
+//line sqlite3.y:4311
 		{
 			sqlite3LOCAL = ast.LeftJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 773:
+	case 827:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4066
+//line sqlite3.y:4315
 		{
 			sqlite3LOCAL = ast.LeftJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 774:
+	case 828:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4070
+//line sqlite3.y:4319
 		{
 			sqlite3LOCAL = ast.RightJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 775:
+	case 829:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4074
+//line sqlite3.y:4323
 		{
 			sqlite3LOCAL = ast.RightJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 776:
+	case 830:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4080
+//line sqlite3.y:4329
 		{
 			sqlite3LOCAL = ast.NaturalJoinType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 777:
+	case 831:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.JoinType
-//line sqlite3.y:4084
+//line sqlite3.y:4333
 		{
 			if sqlite3Dollar[2].joinTypeUnion() == ast.LeftJoinType {
 				sqlite3LOCAL = ast.NaturalLeftJoinType
@@ -11130,575 +11717,575 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 778:
+	case 832:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:4094
+//line sqlite3.y:4343
 		{
 			sqlite3VAL.tableName = sqlite3Dollar[2].tableName
 		}
-	case 779:
+	case 833:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4098
+//line sqlite3.y:4347
 		{
 			sqlite3VAL.tableName = sqlite3Dollar[1].tableName
 		}
-	case 780:
+	case 834:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4104
+//line sqlite3.y:4353
 		{
 			sqlite3VAL.tableName = ast.TableName{Name: sqlite3Dollar[1].tableIdent}
 		}
-	case 781:
+	case 835:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:4108
+//line sqlite3.y:4357
 		{
 			sqlite3VAL.tableName = ast.TableName{Qualifier: sqlite3Dollar[1].tableIdent, Name: sqlite3Dollar[3].tableIdent}
 		}
-	case 782:
+	case 836:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:4114
+//line sqlite3.y:4363
 		{
 			sqlite3VAL.tableName = ast.TableName{Name: sqlite3Dollar[1].tableIdent}
 		}
-	case 783:
+	case 837:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHints
-//line sqlite3.y:4119
+//line sqlite3.y:4368
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 784:
+	case 838:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHints
-//line sqlite3.y:4123
+//line sqlite3.y:4372
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].indexHintsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 785:
+	case 839:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHints
-//line sqlite3.y:4129
+//line sqlite3.y:4378
 		{
 			sqlite3LOCAL = ast.IndexHints{sqlite3Dollar[1].indexHintUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 786:
+	case 840:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:4133
+//line sqlite3.y:4382
 		{
 			sqlite3SLICE := (*ast.IndexHints)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].indexHintUnion())
 		}
-	case 787:
+	case 841:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexHint
-//line sqlite3.y:4139
+//line sqlite3.y:4388
 		{
 			sqlite3LOCAL = &ast.IndexHint{Type: ast.UseOp, ForType: sqlite3Dollar[3].indexHintForTypeUnion(), Indexes: sqlite3Dollar[5].columnsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 788:
+	case 842:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexHint
-//line sqlite3.y:4143
+//line sqlite3.y:4392
 		{
 			sqlite3LOCAL = &ast.IndexHint{Type: ast.UseOp, ForType: sqlite3Dollar[3].indexHintForTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 789:
+	case 843:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexHint
-//line sqlite3.y:4147
+//line sqlite3.y:4396
 		{
 			sqlite3LOCAL = &ast.IndexHint{Type: ast.IgnoreOp, ForType: sqlite3Dollar[3].indexHintForTypeUnion(), Indexes: sqlite3Dollar[5].columnsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 790:
+	case 844:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexHint
-//line sqlite3.y:4151
+//line sqlite3.y:4400
 		{
 			sqlite3LOCAL = &ast.IndexHint{Type: ast.ForceOp, ForType: sqlite3Dollar[3].indexHintForTypeUnion(), Indexes: sqlite3Dollar[5].columnsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 791:
+	case 845:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHintForType
-//line sqlite3.y:4156
+//line sqlite3.y:4405
 		{
 			sqlite3LOCAL = ast.NoForType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 792:
+	case 846:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHintForType
-//line sqlite3.y:4160
+//line sqlite3.y:4409
 		{
 			sqlite3LOCAL = ast.JoinForType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 793:
+	case 847:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHintForType
-//line sqlite3.y:4164
+//line sqlite3.y:4413
 		{
 			sqlite3LOCAL = ast.OrderByForType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 794:
+	case 848:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IndexHintForType
-//line sqlite3.y:4168
+//line sqlite3.y:4417
 		{
 			sqlite3LOCAL = ast.GroupByForType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 795:
+	case 849:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4174
+//line sqlite3.y:4423
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 796:
+	case 850:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4178
+//line sqlite3.y:4427
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 797:
+	case 851:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4185
+//line sqlite3.y:4434
 		{
 			sqlite3LOCAL = &ast.OrExpr{Left: sqlite3Dollar[1].exprUnion(), Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 798:
+	case 852:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4189
+//line sqlite3.y:4438
 		{
 			sqlite3LOCAL = &ast.AndExpr{Left: sqlite3Dollar[1].exprUnion(), Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 799:
+	case 853:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4193
+//line sqlite3.y:4442
 		{
 			sqlite3LOCAL = &ast.NotExpr{Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 800:
+	case 854:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4197
+//line sqlite3.y:4446
 		{
 			sqlite3LOCAL = &ast.IsExpr{Left: sqlite3Dollar[1].exprUnion(), Right: sqlite3Dollar[3].isExprOperatorUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 801:
+	case 855:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4201
+//line sqlite3.y:4450
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 802:
+	case 856:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4205
+//line sqlite3.y:4454
 		{
 			sqlite3LOCAL = &ast.MemberOfExpr{Value: sqlite3Dollar[1].exprUnion(), JSONArr: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 803:
+	case 857:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4212
+//line sqlite3.y:4461
 		{
 			sqlite3LOCAL = &ast.IsExpr{Left: sqlite3Dollar[1].exprUnion(), Right: ast.IsNullOp}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 804:
+	case 858:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4216
+//line sqlite3.y:4465
 		{
 			sqlite3LOCAL = &ast.IsExpr{Left: sqlite3Dollar[1].exprUnion(), Right: ast.IsNotNullOp}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 805:
+	case 859:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4220
+//line sqlite3.y:4469
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: sqlite3Dollar[2].comparisonExprOperatorUnion(), Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 806:
+	case 860:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4224
+//line sqlite3.y:4473
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 807:
+	case 861:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4230
+//line sqlite3.y:4479
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.InOp, Right: sqlite3Dollar[3].colTupleUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 808:
+	case 862:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4234
+//line sqlite3.y:4483
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.NotInOp, Right: sqlite3Dollar[4].colTupleUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 809:
+	case 863:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4238
+//line sqlite3.y:4487
 		{
 			sqlite3LOCAL = &ast.BetweenExpr{Left: sqlite3Dollar[1].exprUnion(), IsBetween: true, From: sqlite3Dollar[3].exprUnion(), To: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 810:
+	case 864:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4242
+//line sqlite3.y:4491
 		{
 			sqlite3LOCAL = &ast.BetweenExpr{Left: sqlite3Dollar[1].exprUnion(), IsBetween: false, From: sqlite3Dollar[4].exprUnion(), To: sqlite3Dollar[6].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 811:
+	case 865:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4246
+//line sqlite3.y:4495
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.LikeOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 812:
+	case 866:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4250
+//line sqlite3.y:4499
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.NotLikeOp, Right: sqlite3Dollar[4].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 813:
+	case 867:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4254
+//line sqlite3.y:4503
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.LikeOp, Right: sqlite3Dollar[3].exprUnion(), Escape: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 814:
+	case 868:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4258
+//line sqlite3.y:4507
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.NotLikeOp, Right: sqlite3Dollar[4].exprUnion(), Escape: sqlite3Dollar[6].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 815:
+	case 869:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4262
+//line sqlite3.y:4511
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.RegexpOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 816:
+	case 870:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4266
+//line sqlite3.y:4515
 		{
 			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.NotRegexpOp, Right: sqlite3Dollar[4].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 817:
+	case 871:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4270
+//line sqlite3.y:4519
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 818:
-		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
+	case 872:
+		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4274
+//line sqlite3.y:4525
 		{
-			sqlite3LOCAL = &ast.ComparisonExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.InOp, Right: sqlite3Dollar[4].colTupleUnion()}
+			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.ConcatOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 819:
+	case 873:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4280
+//line sqlite3.y:4529
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.BitOrOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 820:
+	case 874:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4284
+//line sqlite3.y:4533
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.BitAndOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 821:
+	case 875:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4288
+//line sqlite3.y:4537
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.ShiftLeftOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 822:
+	case 876:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4292
+//line sqlite3.y:4541
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.ShiftRightOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 823:
+	case 877:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4296
+//line sqlite3.y:4545
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.PlusOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 824:
+	case 878:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4300
+//line sqlite3.y:4549
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.MinusOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 825:
+	case 879:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4304
+//line sqlite3.y:4553
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.MultOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 826:
+	case 880:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4308
+//line sqlite3.y:4557
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.DivOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 827:
+	case 881:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4312
+//line sqlite3.y:4561
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.ModOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 828:
+	case 882:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4316
+//line sqlite3.y:4565
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.IntDivOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 829:
+	case 883:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4320
+//line sqlite3.y:4569
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.ModOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 830:
+	case 884:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4324
+//line sqlite3.y:4573
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].exprUnion(), Operator: ast.BitXorOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 831:
+	case 885:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4328
+//line sqlite3.y:4577
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 832:
+	case 886:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4334
+//line sqlite3.y:4583
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 833:
+	case 887:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4338
+//line sqlite3.y:4587
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 834:
+	case 888:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4342
+//line sqlite3.y:4591
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 835:
+	case 889:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4346
+//line sqlite3.y:4595
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 836:
+	case 890:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4350
+//line sqlite3.y:4599
 		{
 			sqlite3LOCAL = &ast.CollateExpr{Expr: sqlite3Dollar[1].exprUnion(), Collation: sqlite3Dollar[3].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 837:
+	case 891:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4354
+//line sqlite3.y:4603
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 838:
+	case 892:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4358
+//line sqlite3.y:4607
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].colNameUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 839:
+	case 893:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4362
+//line sqlite3.y:4611
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 840:
+	case 894:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4366
+//line sqlite3.y:4615
 		{
 			sqlite3LOCAL = &ast.UnaryExpr{Operator: ast.UMinusOp, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 841:
+	case 895:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4370
+//line sqlite3.y:4619
 		{
 			sqlite3LOCAL = &ast.UnaryExpr{Operator: ast.TildaOp, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 842:
+	case 896:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4374
+//line sqlite3.y:4623
 		{
 			sqlite3LOCAL = &ast.UnaryExpr{Operator: ast.BangOp, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 843:
+	case 897:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4378
+//line sqlite3.y:4627
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].subqueryUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 844:
+	case 898:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4382
+//line sqlite3.y:4631
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 845:
+	case 899:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4386
+//line sqlite3.y:4635
 		{
 			sqlite3LOCAL = &ast.ExistsExpr{Subquery: sqlite3Dollar[2].subqueryUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 846:
+	case 900:
 		sqlite3Dollar = sqlite3S[sqlite3pt-9 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4390
+//line sqlite3.y:4639
 		{
 			sqlite3LOCAL = &ast.MatchExpr{Columns: sqlite3Dollar[3].selectExprsUnion(), Expr: sqlite3Dollar[7].exprUnion(), Option: sqlite3Dollar[8].matchExprOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 847:
+	case 901:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4394
+//line sqlite3.y:4643
 		{
 			sqlite3LOCAL = &ast.ConvertExpr{Expr: sqlite3Dollar[3].exprUnion(), Type: sqlite3Dollar[5].convertTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 848:
+	case 902:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4398
+//line sqlite3.y:4647
 		{
 			sqlite3LOCAL = &ast.ConvertExpr{Expr: sqlite3Dollar[3].exprUnion(), Type: sqlite3Dollar[5].convertTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 849:
+	case 903:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4402
+//line sqlite3.y:4651
 		{
 			sqlite3LOCAL = &ast.ConvertUsingExpr{Expr: sqlite3Dollar[3].exprUnion(), Type: sqlite3Dollar[5].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 850:
+	case 904:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4406
+//line sqlite3.y:4655
 		{
 			// From: https://dev.sqlite3.com/doc/refman/8.0/en/cast-functions.html#operator_binary
 			// To convert a string expression to a binary string, these constructs are equivalent:
@@ -11707,18 +12294,18 @@ sqlite3default:
 			sqlite3LOCAL = &ast.ConvertExpr{Expr: sqlite3Dollar[2].exprUnion(), Type: &ast.ConvertType{Type: sqlite3Dollar[1].str}}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 851:
+	case 905:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4414
+//line sqlite3.y:4663
 		{
 			sqlite3LOCAL = &ast.Default{ColName: sqlite3Dollar[2].str}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 852:
+	case 906:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4418
+//line sqlite3.y:4667
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -11727,1278 +12314,1294 @@ sqlite3default:
 			sqlite3LOCAL = &ast.IntervalExpr{Expr: sqlite3Dollar[2].exprUnion(), Unit: sqlite3Dollar[3].colIdent.String()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 853:
+	case 907:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4426
+//line sqlite3.y:4675
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].colNameUnion(), Operator: ast.JSONExtractOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 854:
+	case 908:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4430
+//line sqlite3.y:4679
 		{
 			sqlite3LOCAL = &ast.BinaryExpr{Left: sqlite3Dollar[1].colNameUnion(), Operator: ast.JSONUnquoteExtractOp, Right: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 855:
+	case 909:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TrimType
-//line sqlite3.y:4436
+//line sqlite3.y:4685
 		{
 			sqlite3LOCAL = ast.BothTrimType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 856:
+	case 910:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TrimType
-//line sqlite3.y:4440
+//line sqlite3.y:4689
 		{
 			sqlite3LOCAL = ast.LeadingTrimType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 857:
+	case 911:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.TrimType
-//line sqlite3.y:4444
+//line sqlite3.y:4693
 		{
 			sqlite3LOCAL = ast.TrailingTrimType
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 858:
+	case 912:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:4450
+//line sqlite3.y:4699
 		{
 			sqlite3VAL.str = ""
 		}
-	case 859:
+	case 913:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:4454
+//line sqlite3.y:4703
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[2].colIdent.String())
 		}
-	case 860:
+	case 914:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.BoolVal
-//line sqlite3.y:4460
+//line sqlite3.y:4709
 		{
 			sqlite3LOCAL = ast.BoolVal(true)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 861:
+	case 915:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.BoolVal
-//line sqlite3.y:4464
+//line sqlite3.y:4713
 		{
 			sqlite3LOCAL = ast.BoolVal(false)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 862:
+	case 916:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsExprOperator
-//line sqlite3.y:4471
+//line sqlite3.y:4720
 		{
 			sqlite3LOCAL = ast.IsTrueOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 863:
+	case 917:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsExprOperator
-//line sqlite3.y:4475
+//line sqlite3.y:4724
 		{
 			sqlite3LOCAL = ast.IsNotTrueOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 864:
+	case 918:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsExprOperator
-//line sqlite3.y:4479
+//line sqlite3.y:4728
 		{
 			sqlite3LOCAL = ast.IsFalseOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 865:
+	case 919:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IsExprOperator
-//line sqlite3.y:4483
+//line sqlite3.y:4732
 		{
 			sqlite3LOCAL = ast.IsNotFalseOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 866:
+	case 920:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4489
+//line sqlite3.y:4738
 		{
 			sqlite3LOCAL = ast.EqualOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 867:
+	case 921:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4493
+//line sqlite3.y:4742
 		{
 			sqlite3LOCAL = ast.LessThanOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 868:
+	case 922:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4497
+//line sqlite3.y:4746
 		{
 			sqlite3LOCAL = ast.GreaterThanOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 869:
+	case 923:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4501
+//line sqlite3.y:4750
 		{
 			sqlite3LOCAL = ast.LessEqualOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 870:
+	case 924:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4505
+//line sqlite3.y:4754
 		{
 			sqlite3LOCAL = ast.GreaterEqualOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 871:
+	case 925:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4509
+//line sqlite3.y:4758
 		{
 			sqlite3LOCAL = ast.NotEqualOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 872:
+	case 926:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ComparisonExprOperator
-//line sqlite3.y:4513
+//line sqlite3.y:4762
 		{
 			sqlite3LOCAL = ast.NullSafeEqualOp
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 873:
+	case 927:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColTuple
-//line sqlite3.y:4519
+//line sqlite3.y:4768
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].valTupleUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 874:
+	case 928:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColTuple
-//line sqlite3.y:4523
+//line sqlite3.y:4772
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].subqueryUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 875:
+	case 929:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ColTuple
-//line sqlite3.y:4527
+//line sqlite3.y:4776
 		{
 			sqlite3LOCAL = ast.ListArg(sqlite3Dollar[1].str[2:])
 			bindVariable(sqlite3lex, sqlite3Dollar[1].str[2:])
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 876:
+	case 930:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Subquery
-//line sqlite3.y:4534
+//line sqlite3.y:4783
 		{
 			sqlite3LOCAL = &ast.Subquery{sqlite3Dollar[1].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 877:
+	case 931:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Exprs
-//line sqlite3.y:4540
+//line sqlite3.y:4789
 		{
 			sqlite3LOCAL = ast.Exprs{sqlite3Dollar[1].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 878:
+	case 932:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:4544
+//line sqlite3.y:4793
 		{
 			sqlite3SLICE := (*ast.Exprs)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].exprUnion())
 		}
-	case 879:
+	case 933:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4554
+//line sqlite3.y:4803
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: sqlite3Dollar[1].colIdent, Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 880:
+	case 934:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4558
+//line sqlite3.y:4807
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: sqlite3Dollar[1].colIdent, Distinct: true, Exprs: sqlite3Dollar[4].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 881:
+	case 935:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4562
+//line sqlite3.y:4811
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: sqlite3Dollar[1].colIdent, Distinct: true, Exprs: sqlite3Dollar[4].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 882:
+	case 936:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4566
+//line sqlite3.y:4815
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Qualifier: sqlite3Dollar[1].tableIdent, Name: sqlite3Dollar[3].colIdent, Exprs: sqlite3Dollar[5].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 883:
+	case 937:
+		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Expr
+//line sqlite3.y:4825
+		{
+			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("raise"), Exprs: ast.SelectExprs{&ast.AliasedExpr{Expr: &ast.ColName{Name: ast.NewColIdent("ignore")}}}}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 938:
+		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
+		var sqlite3LOCAL ast.Expr
+//line sqlite3.y:4829
+		{
+			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("raise"), Exprs: ast.SelectExprs{&ast.AliasedExpr{Expr: &ast.ColName{Name: ast.NewColIdent(sqlite3Dollar[3].str)}}, &ast.AliasedExpr{Expr: ast.NewStrLiteral(sqlite3Dollar[5].str)}}}
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 939:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4576
+//line sqlite3.y:4833
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("left"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 884:
+	case 940:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4580
+//line sqlite3.y:4837
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("right"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 885:
+	case 941:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4584
+//line sqlite3.y:4841
 		{
 			sqlite3LOCAL = &ast.SubstrExpr{Name: sqlite3Dollar[3].exprUnion(), From: sqlite3Dollar[5].exprUnion(), To: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 886:
+	case 942:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4588
+//line sqlite3.y:4845
 		{
 			sqlite3LOCAL = &ast.SubstrExpr{Name: sqlite3Dollar[3].exprUnion(), From: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 887:
+	case 943:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4592
+//line sqlite3.y:4849
 		{
 			sqlite3LOCAL = &ast.SubstrExpr{Name: sqlite3Dollar[3].exprUnion(), From: sqlite3Dollar[5].exprUnion(), To: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 888:
+	case 944:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4596
+//line sqlite3.y:4853
 		{
 			sqlite3LOCAL = &ast.SubstrExpr{Name: sqlite3Dollar[3].exprUnion(), From: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 889:
+	case 945:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4600
+//line sqlite3.y:4857
 		{
 			sqlite3LOCAL = &ast.GroupConcatExpr{Distinct: sqlite3Dollar[3].booleanUnion(), Exprs: sqlite3Dollar[4].selectExprsUnion(), OrderBy: sqlite3Dollar[5].orderByUnion(), Separator: sqlite3Dollar[6].str, Limit: sqlite3Dollar[7].limitUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 890:
+	case 946:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4604
+//line sqlite3.y:4861
 		{
 			sqlite3LOCAL = &ast.CaseExpr{Expr: sqlite3Dollar[2].exprUnion(), Whens: sqlite3Dollar[3].whensUnion(), Else: sqlite3Dollar[4].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 891:
+	case 947:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4608
+//line sqlite3.y:4865
 		{
 			sqlite3LOCAL = &ast.ValuesFuncExpr{Name: sqlite3Dollar[3].colNameUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 892:
+	case 948:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4612
+//line sqlite3.y:4869
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 893:
+	case 949:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4623
+//line sqlite3.y:4880
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("utc_date")}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 894:
+	case 950:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4627
+//line sqlite3.y:4884
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 895:
+	case 951:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4633
+//line sqlite3.y:4890
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("current_date")}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 896:
+	case 952:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4637
+//line sqlite3.y:4894
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("utc_time"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 897:
+	case 953:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4642
+//line sqlite3.y:4899
 		{
 			sqlite3LOCAL = &ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_time"), Fsp: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 898:
+	case 954:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4646
+//line sqlite3.y:4903
 		{
 			sqlite3LOCAL = &ast.TimestampFuncExpr{Name: string("timestampadd"), Unit: sqlite3Dollar[3].colIdent.String(), Expr1: sqlite3Dollar[5].exprUnion(), Expr2: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 899:
+	case 955:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4650
+//line sqlite3.y:4907
 		{
 			sqlite3LOCAL = &ast.TimestampFuncExpr{Name: string("timestampdiff"), Unit: sqlite3Dollar[3].colIdent.String(), Expr1: sqlite3Dollar[5].exprUnion(), Expr2: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 900:
+	case 956:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4654
+//line sqlite3.y:4911
 		{
 			sqlite3LOCAL = &ast.ExtractFuncExpr{IntervalTypes: sqlite3Dollar[3].intervalTypeUnion(), Expr: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 901:
+	case 957:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4658
+//line sqlite3.y:4915
 		{
 			sqlite3LOCAL = &ast.WeightStringFuncExpr{Expr: sqlite3Dollar[3].exprUnion(), As: sqlite3Dollar[4].convertTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 902:
+	case 958:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4662
+//line sqlite3.y:4919
 		{
 			sqlite3LOCAL = &ast.JSONPrettyExpr{JSONVal: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 903:
+	case 959:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4666
+//line sqlite3.y:4923
 		{
 			sqlite3LOCAL = &ast.JSONStorageFreeExpr{JSONVal: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 904:
+	case 960:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4670
+//line sqlite3.y:4927
 		{
 			sqlite3LOCAL = &ast.JSONStorageSizeExpr{JSONVal: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 905:
+	case 961:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4674
+//line sqlite3.y:4931
 		{
 			sqlite3LOCAL = &ast.TrimFuncExpr{TrimFuncType: ast.LTrimType, StringArg: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 906:
+	case 962:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4678
+//line sqlite3.y:4935
 		{
 			sqlite3LOCAL = &ast.TrimFuncExpr{TrimFuncType: ast.RTrimType, StringArg: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 907:
+	case 963:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4682
+//line sqlite3.y:4939
 		{
 			sqlite3LOCAL = &ast.TrimFuncExpr{Type: sqlite3Dollar[3].trimTypeUnion(), TrimArg: sqlite3Dollar[4].exprUnion(), StringArg: sqlite3Dollar[6].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 908:
+	case 964:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4686
+//line sqlite3.y:4943
 		{
 			sqlite3LOCAL = &ast.TrimFuncExpr{StringArg: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 909:
+	case 965:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4690
+//line sqlite3.y:4947
 		{
 			sqlite3LOCAL = &ast.TrimFuncExpr{TrimArg: sqlite3Dollar[3].exprUnion(), StringArg: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 910:
+	case 966:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4694
+//line sqlite3.y:4951
 		{
 			sqlite3LOCAL = &ast.JSONSchemaValidFuncExpr{Schema: sqlite3Dollar[3].exprUnion(), Document: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 911:
+	case 967:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4698
+//line sqlite3.y:4955
 		{
 			sqlite3LOCAL = &ast.JSONSchemaValidationReportFuncExpr{Schema: sqlite3Dollar[3].exprUnion(), Document: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 912:
+	case 968:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4702
+//line sqlite3.y:4959
 		{
 			sqlite3LOCAL = &ast.JSONArrayExpr{Params: sqlite3Dollar[3].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 913:
+	case 969:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4706
+//line sqlite3.y:4963
 		{
 			sqlite3LOCAL = &ast.JSONObjectExpr{Params: sqlite3Dollar[3].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 914:
+	case 970:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4710
+//line sqlite3.y:4967
 		{
 			sqlite3LOCAL = &ast.JSONQuoteExpr{StringArg: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 915:
+	case 971:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4714
+//line sqlite3.y:4971
 		{
 			sqlite3LOCAL = &ast.JSONContainsExpr{Target: sqlite3Dollar[3].exprUnion(), Candidate: sqlite3Dollar[5].exprUnion(), PathList: sqlite3Dollar[6].jsonPathParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 916:
+	case 972:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4718
+//line sqlite3.y:4975
 		{
 			sqlite3LOCAL = &ast.JSONContainsPathExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), OneOrAll: sqlite3Dollar[5].exprUnion(), PathList: sqlite3Dollar[7].jsonPathParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 917:
+	case 973:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4722
+//line sqlite3.y:4979
 		{
 			sqlite3LOCAL = &ast.JSONExtractExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), PathList: sqlite3Dollar[5].jsonPathParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 918:
+	case 974:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4726
+//line sqlite3.y:4983
 		{
 			sqlite3LOCAL = &ast.JSONKeysExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), PathList: sqlite3Dollar[4].jsonPathParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 919:
+	case 975:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4730
+//line sqlite3.y:4987
 		{
 			sqlite3LOCAL = &ast.JSONOverlapsExpr{JSONDoc1: sqlite3Dollar[3].exprUnion(), JSONDoc2: sqlite3Dollar[5].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 920:
+	case 976:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4734
+//line sqlite3.y:4991
 		{
 			sqlite3LOCAL = &ast.JSONSearchExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), OneOrAll: sqlite3Dollar[5].exprUnion(), SearchStr: sqlite3Dollar[7].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 921:
+	case 977:
 		sqlite3Dollar = sqlite3S[sqlite3pt-11 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4738
+//line sqlite3.y:4995
 		{
 			sqlite3LOCAL = &ast.JSONSearchExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), OneOrAll: sqlite3Dollar[5].exprUnion(), SearchStr: sqlite3Dollar[7].exprUnion(), EscapeChar: sqlite3Dollar[9].exprUnion(), PathList: sqlite3Dollar[10].jsonPathParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 922:
+	case 978:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4742
+//line sqlite3.y:4999
 		{
 			sqlite3LOCAL = &ast.JSONValueExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), Path: sqlite3Dollar[5].jsonPathParam, ReturningType: sqlite3Dollar[6].convertTypeUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 923:
+	case 979:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4746
+//line sqlite3.y:5003
 		{
 			sqlite3LOCAL = &ast.JSONValueExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), Path: sqlite3Dollar[5].jsonPathParam, ReturningType: sqlite3Dollar[6].convertTypeUnion(), EmptyOnResponse: sqlite3Dollar[7].jtOnResponseUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 924:
+	case 980:
 		sqlite3Dollar = sqlite3S[sqlite3pt-8 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4750
+//line sqlite3.y:5007
 		{
 			sqlite3LOCAL = &ast.JSONValueExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), Path: sqlite3Dollar[5].jsonPathParam, ReturningType: sqlite3Dollar[6].convertTypeUnion(), ErrorOnResponse: sqlite3Dollar[7].jtOnResponseUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 925:
+	case 981:
 		sqlite3Dollar = sqlite3S[sqlite3pt-9 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4754
+//line sqlite3.y:5011
 		{
 			sqlite3LOCAL = &ast.JSONValueExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), Path: sqlite3Dollar[5].jsonPathParam, ReturningType: sqlite3Dollar[6].convertTypeUnion(), EmptyOnResponse: sqlite3Dollar[7].jtOnResponseUnion(), ErrorOnResponse: sqlite3Dollar[8].jtOnResponseUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 926:
+	case 982:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4758
+//line sqlite3.y:5015
 		{
 			sqlite3LOCAL = &ast.JSONAttributesExpr{Type: ast.DepthAttributeType, JSONDoc: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 927:
+	case 983:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4762
+//line sqlite3.y:5019
 		{
 			sqlite3LOCAL = &ast.JSONAttributesExpr{Type: ast.ValidAttributeType, JSONDoc: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 928:
+	case 984:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4766
+//line sqlite3.y:5023
 		{
 			sqlite3LOCAL = &ast.JSONAttributesExpr{Type: ast.TypeAttributeType, JSONDoc: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 929:
+	case 985:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4770
+//line sqlite3.y:5027
 		{
 			sqlite3LOCAL = &ast.JSONAttributesExpr{Type: ast.LengthAttributeType, JSONDoc: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 930:
+	case 986:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4774
+//line sqlite3.y:5031
 		{
 			sqlite3LOCAL = &ast.JSONAttributesExpr{Type: ast.LengthAttributeType, JSONDoc: sqlite3Dollar[3].exprUnion(), Path: sqlite3Dollar[5].jsonPathParam}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 931:
+	case 987:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4778
+//line sqlite3.y:5035
 		{
 			sqlite3LOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONArrayAppendType, JSONDoc: sqlite3Dollar[3].exprUnion(), Params: sqlite3Dollar[5].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 932:
+	case 988:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4782
+//line sqlite3.y:5039
 		{
 			sqlite3LOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONArrayInsertType, JSONDoc: sqlite3Dollar[3].exprUnion(), Params: sqlite3Dollar[5].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 933:
+	case 989:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4786
+//line sqlite3.y:5043
 		{
 			sqlite3LOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONInsertType, JSONDoc: sqlite3Dollar[3].exprUnion(), Params: sqlite3Dollar[5].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 934:
+	case 990:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4790
+//line sqlite3.y:5047
 		{
 			sqlite3LOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONReplaceType, JSONDoc: sqlite3Dollar[3].exprUnion(), Params: sqlite3Dollar[5].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 935:
+	case 991:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4794
+//line sqlite3.y:5051
 		{
 			sqlite3LOCAL = &ast.JSONValueModifierExpr{Type: ast.JSONSetType, JSONDoc: sqlite3Dollar[3].exprUnion(), Params: sqlite3Dollar[5].jsonObjectParamsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 936:
+	case 992:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4798
+//line sqlite3.y:5055
 		{
 			sqlite3LOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergeType, JSONDoc: sqlite3Dollar[3].exprUnion(), JSONDocList: sqlite3Dollar[5].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 937:
+	case 993:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4802
+//line sqlite3.y:5059
 		{
 			sqlite3LOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergePatchType, JSONDoc: sqlite3Dollar[3].exprUnion(), JSONDocList: sqlite3Dollar[5].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 938:
+	case 994:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4806
+//line sqlite3.y:5063
 		{
 			sqlite3LOCAL = &ast.JSONValueMergeExpr{Type: ast.JSONMergePreserveType, JSONDoc: sqlite3Dollar[3].exprUnion(), JSONDocList: sqlite3Dollar[5].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 939:
+	case 995:
 		sqlite3Dollar = sqlite3S[sqlite3pt-6 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4810
+//line sqlite3.y:5067
 		{
 			sqlite3LOCAL = &ast.JSONRemoveExpr{JSONDoc: sqlite3Dollar[3].exprUnion(), PathList: sqlite3Dollar[5].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 940:
+	case 996:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4814
+//line sqlite3.y:5071
 		{
 			sqlite3LOCAL = &ast.JSONUnquoteExpr{JSONValue: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 941:
+	case 997:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:4819
+//line sqlite3.y:5076
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 942:
+	case 998:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:4823
+//line sqlite3.y:5080
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].convertTypeUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 943:
+	case 999:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.JSONPathParam
-//line sqlite3.y:4828
+//line sqlite3.y:5085
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 944:
+	case 1000:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.JSONPathParam
-//line sqlite3.y:4832
+//line sqlite3.y:5089
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].jsonPathParamsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 945:
+	case 1001:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.JSONPathParam
-//line sqlite3.y:4838
+//line sqlite3.y:5095
 		{
 			sqlite3LOCAL = []ast.JSONPathParam{sqlite3Dollar[1].jsonPathParam}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 946:
+	case 1002:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:4842
+//line sqlite3.y:5099
 		{
 			sqlite3SLICE := (*[]ast.JSONPathParam)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].jsonPathParam)
 		}
-	case 947:
+	case 1003:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4848
+//line sqlite3.y:5105
 		{
 			sqlite3VAL.jsonPathParam = ast.JSONPathParam(sqlite3Dollar[1].exprUnion())
 		}
-	case 948:
+	case 1004:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4852
+//line sqlite3.y:5109
 		{
 			sqlite3VAL.jsonPathParam = ast.JSONPathParam(sqlite3Dollar[1].colNameUnion())
 		}
-	case 949:
+	case 1005:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:4858
+//line sqlite3.y:5115
 		{
 		}
-	case 950:
+	case 1006:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4860
+//line sqlite3.y:5117
 		{
 			sqlite3LOCAL = ast.IntervalDayHour
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 951:
+	case 1007:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4864
+//line sqlite3.y:5121
 		{
 			sqlite3LOCAL = ast.IntervalDayMicrosecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 952:
+	case 1008:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4868
+//line sqlite3.y:5125
 		{
 			sqlite3LOCAL = ast.IntervalDayMinute
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 953:
+	case 1009:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4872
+//line sqlite3.y:5129
 		{
 			sqlite3LOCAL = ast.IntervalDaySecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 954:
+	case 1010:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4876
+//line sqlite3.y:5133
 		{
 			sqlite3LOCAL = ast.IntervalHourMicrosecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 955:
+	case 1011:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4880
+//line sqlite3.y:5137
 		{
 			sqlite3LOCAL = ast.IntervalHourMinute
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 956:
+	case 1012:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4884
+//line sqlite3.y:5141
 		{
 			sqlite3LOCAL = ast.IntervalHourSecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 957:
+	case 1013:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4888
+//line sqlite3.y:5145
 		{
 			sqlite3LOCAL = ast.IntervalMinuteMicrosecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 958:
+	case 1014:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4892
+//line sqlite3.y:5149
 		{
 			sqlite3LOCAL = ast.IntervalMinuteSecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 959:
+	case 1015:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4896
+//line sqlite3.y:5153
 		{
 			sqlite3LOCAL = ast.IntervalSecondMicrosecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 960:
+	case 1016:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4900
+//line sqlite3.y:5157
 		{
 			sqlite3LOCAL = ast.IntervalYearMonth
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 961:
+	case 1017:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4906
+//line sqlite3.y:5163
 		{
 			sqlite3LOCAL = ast.IntervalDay
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 962:
+	case 1018:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4910
+//line sqlite3.y:5167
 		{
 			sqlite3LOCAL = ast.IntervalWeek
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 963:
+	case 1019:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4914
+//line sqlite3.y:5171
 		{
 			sqlite3LOCAL = ast.IntervalHour
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 964:
+	case 1020:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4918
+//line sqlite3.y:5175
 		{
 			sqlite3LOCAL = ast.IntervalMinute
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 965:
+	case 1021:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4922
+//line sqlite3.y:5179
 		{
 			sqlite3LOCAL = ast.IntervalMonth
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 966:
+	case 1022:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4926
+//line sqlite3.y:5183
 		{
 			sqlite3LOCAL = ast.IntervalQuarter
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 967:
+	case 1023:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4930
+//line sqlite3.y:5187
 		{
 			sqlite3LOCAL = ast.IntervalSecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 968:
+	case 1024:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4934
+//line sqlite3.y:5191
 		{
 			sqlite3LOCAL = ast.IntervalMicrosecond
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 969:
+	case 1025:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.IntervalTypes
-//line sqlite3.y:4938
+//line sqlite3.y:5195
 		{
 			sqlite3LOCAL = ast.IntervalYear
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 972:
+	case 1028:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4948
+//line sqlite3.y:5205
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 973:
+	case 1029:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4952
+//line sqlite3.y:5209
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 974:
+	case 1030:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4956
+//line sqlite3.y:5213
 		{
 			sqlite3LOCAL = ast.NewArgument(sqlite3Dollar[2].str[1:])
 			bindVariable(sqlite3lex, sqlite3Dollar[2].str[1:])
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 975:
+	case 1031:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4967
+//line sqlite3.y:5224
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("if"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 976:
+	case 1032:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4971
+//line sqlite3.y:5228
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("database"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 977:
+	case 1033:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4975
+//line sqlite3.y:5232
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("schema"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 978:
+	case 1034:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4979
+//line sqlite3.y:5236
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("mod"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 979:
+	case 1035:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:4983
+//line sqlite3.y:5240
 		{
 			sqlite3LOCAL = &ast.FuncExpr{Name: ast.NewColIdent("replace"), Exprs: sqlite3Dollar[3].selectExprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 980:
+	case 1036:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchExprOption
-//line sqlite3.y:4989
+//line sqlite3.y:5246
 		{
 			sqlite3LOCAL = ast.NoOption
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 981:
+	case 1037:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchExprOption
-//line sqlite3.y:4993
+//line sqlite3.y:5250
 		{
 			sqlite3LOCAL = ast.BooleanModeOpt
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 982:
+	case 1038:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchExprOption
-//line sqlite3.y:4997
+//line sqlite3.y:5254
 		{
 			sqlite3LOCAL = ast.NaturalLanguageModeOpt
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 983:
+	case 1039:
 		sqlite3Dollar = sqlite3S[sqlite3pt-7 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchExprOption
-//line sqlite3.y:5001
+//line sqlite3.y:5258
 		{
 			sqlite3LOCAL = ast.NaturalLanguageModeWithQueryExpansionOpt
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 984:
+	case 1040:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.MatchExprOption
-//line sqlite3.y:5005
+//line sqlite3.y:5262
 		{
 			sqlite3LOCAL = ast.QueryExpansionOpt
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 985:
+	case 1041:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5011
+//line sqlite3.y:5268
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].colIdent.String())
 		}
-	case 986:
+	case 1042:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5015
+//line sqlite3.y:5272
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 987:
+	case 1043:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5019
+//line sqlite3.y:5276
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 988:
+	case 1044:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5025
+//line sqlite3.y:5282
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 989:
+	case 1045:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5031
+//line sqlite3.y:5288
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 990:
+	case 1046:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5035
+//line sqlite3.y:5292
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion(), Charset: sqlite3Dollar[3].columnCharset}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 991:
+	case 1047:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5039
+//line sqlite3.y:5296
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 992:
+	case 1048:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5043
+//line sqlite3.y:5300
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 993:
+	case 1049:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5047
+//line sqlite3.y:5304
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 			sqlite3LOCAL.Length = sqlite3Dollar[2].LengthScaleOption.Length
 			sqlite3LOCAL.Scale = sqlite3Dollar[2].LengthScaleOption.Scale
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 994:
+	case 1050:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5053
+//line sqlite3.y:5310
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 995:
+	case 1051:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5057
+//line sqlite3.y:5314
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 996:
+	case 1052:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5061
+//line sqlite3.y:5318
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 997:
+	case 1053:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5065
+//line sqlite3.y:5322
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 998:
+	case 1054:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5069
+//line sqlite3.y:5326
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 999:
+	case 1055:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5073
+//line sqlite3.y:5330
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1000:
+	case 1056:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5077
+//line sqlite3.y:5334
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1001:
+	case 1057:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5081
+//line sqlite3.y:5338
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str), Length: sqlite3Dollar[2].literalUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1002:
+	case 1058:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5085
+//line sqlite3.y:5342
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1003:
+	case 1059:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ConvertType
-//line sqlite3.y:5089
+//line sqlite3.y:5346
 		{
 			sqlite3LOCAL = &ast.ConvertType{Type: string(sqlite3Dollar[1].str)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1004:
+	case 1060:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5095
+//line sqlite3.y:5352
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1005:
+	case 1061:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5099
+//line sqlite3.y:5356
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1006:
+	case 1062:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5104
+//line sqlite3.y:5361
 		{
 			sqlite3VAL.str = string("")
 		}
-	case 1007:
+	case 1063:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:5108
+//line sqlite3.y:5365
 		{
 			sqlite3VAL.str = " separator " + sql_types.EncodeStringSQL(sqlite3Dollar[2].str)
 		}
-	case 1008:
+	case 1064:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []*ast.When
-//line sqlite3.y:5114
+//line sqlite3.y:5371
 		{
 			sqlite3LOCAL = []*ast.When{sqlite3Dollar[1].whenUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1009:
+	case 1065:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:5118
+//line sqlite3.y:5375
 		{
 			sqlite3SLICE := (*[]*ast.When)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[2].whenUnion())
 		}
-	case 1010:
+	case 1066:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.When
-//line sqlite3.y:5124
+//line sqlite3.y:5381
 		{
 			sqlite3LOCAL = &ast.When{Cond: sqlite3Dollar[2].exprUnion(), Val: sqlite3Dollar[4].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1011:
+	case 1067:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5129
+//line sqlite3.y:5386
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1012:
+	case 1068:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5133
+//line sqlite3.y:5390
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1013:
+	case 1069:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColName
-//line sqlite3.y:5139
+//line sqlite3.y:5396
 		{
 			sqlite3LOCAL = &ast.ColName{Name: sqlite3Dollar[1].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1014:
+	case 1070:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColName
-//line sqlite3.y:5143
+//line sqlite3.y:5400
 		{
 			sqlite3LOCAL = &ast.ColName{Qualifier: ast.TableName{Name: sqlite3Dollar[1].tableIdent}, Name: sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1015:
+	case 1071:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.ColName
-//line sqlite3.y:5147
+//line sqlite3.y:5404
 		{
 			sqlite3LOCAL = &ast.ColName{Qualifier: ast.TableName{Qualifier: sqlite3Dollar[1].tableIdent, Name: sqlite3Dollar[3].tableIdent}, Name: sqlite3Dollar[5].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1016:
+	case 1072:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5153
+//line sqlite3.y:5410
 		{
 			// TODO(sougou): ast.Deprecate this construct.
 			if sqlite3Dollar[1].colIdent.Lowered() != "value" {
@@ -13008,380 +13611,380 @@ sqlite3default:
 			sqlite3LOCAL = ast.NewIntLiteral("1")
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1017:
+	case 1073:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5162
+//line sqlite3.y:5419
 		{
 			sqlite3LOCAL = ast.NewArgument(sqlite3Dollar[1].str[1:])
 			bindVariable(sqlite3lex, sqlite3Dollar[1].str[1:])
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1018:
+	case 1074:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Exprs
-//line sqlite3.y:5168
+//line sqlite3.y:5425
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1019:
+	case 1075:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Exprs
-//line sqlite3.y:5172
+//line sqlite3.y:5429
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].exprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1020:
+	case 1076:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5177
+//line sqlite3.y:5434
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1021:
+	case 1077:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5181
+//line sqlite3.y:5438
 		{
 			sqlite3LOCAL = sqlite3Dollar[2].exprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1022:
+	case 1078:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderBy
-//line sqlite3.y:5186
+//line sqlite3.y:5443
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1023:
+	case 1079:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderBy
-//line sqlite3.y:5190
+//line sqlite3.y:5447
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].orderByUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1024:
+	case 1080:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderBy
-//line sqlite3.y:5196
+//line sqlite3.y:5453
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].orderByUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1025:
+	case 1081:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderBy
-//line sqlite3.y:5202
+//line sqlite3.y:5459
 		{
 			sqlite3LOCAL = ast.OrderBy{sqlite3Dollar[1].orderUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1026:
+	case 1082:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5206
+//line sqlite3.y:5463
 		{
 			sqlite3SLICE := (*ast.OrderBy)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].orderUnion())
 		}
-	case 1027:
+	case 1083:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Order
-//line sqlite3.y:5212
+//line sqlite3.y:5469
 		{
 			sqlite3LOCAL = &ast.Order{Expr: sqlite3Dollar[1].exprUnion(), Direction: sqlite3Dollar[2].orderDirectionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1028:
+	case 1084:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderDirection
-//line sqlite3.y:5217
+//line sqlite3.y:5474
 		{
 			sqlite3LOCAL = ast.AscOrder
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1029:
+	case 1085:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderDirection
-//line sqlite3.y:5221
+//line sqlite3.y:5478
 		{
 			sqlite3LOCAL = ast.AscOrder
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1030:
+	case 1086:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.OrderDirection
-//line sqlite3.y:5225
+//line sqlite3.y:5482
 		{
 			sqlite3LOCAL = ast.DescOrder
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1031:
+	case 1087:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Limit
-//line sqlite3.y:5230
+//line sqlite3.y:5487
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1032:
+	case 1088:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Limit
-//line sqlite3.y:5234
+//line sqlite3.y:5491
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].limitUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1033:
+	case 1089:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Limit
-//line sqlite3.y:5240
+//line sqlite3.y:5497
 		{
 			sqlite3LOCAL = &ast.Limit{Rowcount: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1034:
+	case 1090:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Limit
-//line sqlite3.y:5244
+//line sqlite3.y:5501
 		{
 			sqlite3LOCAL = &ast.Limit{Offset: sqlite3Dollar[2].exprUnion(), Rowcount: sqlite3Dollar[4].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1035:
+	case 1091:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Limit
-//line sqlite3.y:5248
+//line sqlite3.y:5505
 		{
 			sqlite3LOCAL = &ast.Limit{Offset: sqlite3Dollar[4].exprUnion(), Rowcount: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1036:
+	case 1092:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:5253
+//line sqlite3.y:5510
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1037:
+	case 1093:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:5257
+//line sqlite3.y:5514
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion(), sqlite3Dollar[2].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1038:
+	case 1094:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:5261
+//line sqlite3.y:5518
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion(), sqlite3Dollar[2].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1039:
+	case 1095:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:5265
+//line sqlite3.y:5522
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1040:
+	case 1096:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL []ast.AlterOption
-//line sqlite3.y:5269
+//line sqlite3.y:5526
 		{
 			sqlite3LOCAL = []ast.AlterOption{sqlite3Dollar[1].alterOptionUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1041:
+	case 1097:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5276
+//line sqlite3.y:5533
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.DefaultType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1042:
+	case 1098:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5280
+//line sqlite3.y:5537
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.NoneType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1043:
+	case 1099:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5284
+//line sqlite3.y:5541
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.SharedType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1044:
+	case 1100:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5288
+//line sqlite3.y:5545
 		{
 			sqlite3LOCAL = &ast.LockOption{Type: ast.ExclusiveType}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1045:
+	case 1101:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5294
+//line sqlite3.y:5551
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(sqlite3Dollar[3].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1046:
+	case 1102:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5298
+//line sqlite3.y:5555
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(sqlite3Dollar[3].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1047:
+	case 1103:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5302
+//line sqlite3.y:5559
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(sqlite3Dollar[3].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1048:
+	case 1104:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.AlterOption
-//line sqlite3.y:5306
+//line sqlite3.y:5563
 		{
 			sqlite3LOCAL = ast.AlgorithmValue(sqlite3Dollar[3].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1049:
+	case 1105:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5311
+//line sqlite3.y:5568
 		{
 			sqlite3VAL.str = ""
 		}
-	case 1050:
+	case 1106:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5315
+//line sqlite3.y:5572
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[3].str)
 		}
-	case 1051:
+	case 1107:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5319
+//line sqlite3.y:5576
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[3].str)
 		}
-	case 1052:
+	case 1108:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5323
+//line sqlite3.y:5580
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[3].str)
 		}
-	case 1053:
+	case 1109:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5328
+//line sqlite3.y:5585
 		{
 			sqlite3VAL.str = ""
 		}
-	case 1054:
+	case 1110:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5332
+//line sqlite3.y:5589
 		{
 			sqlite3VAL.str = sqlite3Dollar[3].str
 		}
-	case 1055:
+	case 1111:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5338
+//line sqlite3.y:5595
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 1056:
+	case 1112:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5342
+//line sqlite3.y:5599
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 1057:
+	case 1113:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5347
+//line sqlite3.y:5604
 		{
 			sqlite3VAL.str = ""
 		}
-	case 1058:
+	case 1114:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
-//line sqlite3.y:5351
+//line sqlite3.y:5608
 		{
 			sqlite3VAL.str = sqlite3Dollar[2].str
 		}
-	case 1059:
+	case 1115:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5356
+//line sqlite3.y:5613
 		{
 			sqlite3VAL.str = "cascaded"
 		}
-	case 1060:
+	case 1116:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5360
+//line sqlite3.y:5617
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 1061:
+	case 1117:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5364
+//line sqlite3.y:5621
 		{
 			sqlite3VAL.str = string(sqlite3Dollar[1].str)
 		}
-	case 1062:
+	case 1118:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Definer
-//line sqlite3.y:5369
+//line sqlite3.y:5626
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1063:
+	case 1119:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Definer
-//line sqlite3.y:5373
+//line sqlite3.y:5630
 		{
 			sqlite3LOCAL = sqlite3Dollar[3].definerUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1064:
+	case 1120:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Definer
-//line sqlite3.y:5379
+//line sqlite3.y:5636
 		{
 			sqlite3LOCAL = &ast.Definer{
 				Name: string(sqlite3Dollar[1].str),
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1065:
+	case 1121:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Definer
-//line sqlite3.y:5385
+//line sqlite3.y:5642
 		{
 			sqlite3LOCAL = &ast.Definer{
 				Name: string(sqlite3Dollar[1].str),
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1066:
+	case 1122:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Definer
-//line sqlite3.y:5391
+//line sqlite3.y:5648
 		{
 			sqlite3LOCAL = &ast.Definer{
 				Name:    sqlite3Dollar[1].str,
@@ -13389,183 +13992,183 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1067:
+	case 1123:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5400
+//line sqlite3.y:5657
 		{
 			sqlite3VAL.str = sql_types.EncodeStringSQL(sqlite3Dollar[1].str)
 		}
-	case 1068:
+	case 1124:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5404
+//line sqlite3.y:5661
 		{
 			sqlite3VAL.str = ast.FormatIdentifier(sqlite3Dollar[1].str)
 		}
-	case 1069:
+	case 1125:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5409
+//line sqlite3.y:5666
 		{
 			sqlite3VAL.str = ""
 		}
-	case 1070:
+	case 1126:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5413
+//line sqlite3.y:5670
 		{
 			sqlite3VAL.str = ast.FormatAddress(sqlite3Dollar[1].str)
 		}
-	case 1071:
+	case 1127:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Lock
-//line sqlite3.y:5419
+//line sqlite3.y:5676
 		{
 			sqlite3LOCAL = ast.ForUpdateLock
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1072:
+	case 1128:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Lock
-//line sqlite3.y:5423
+//line sqlite3.y:5680
 		{
 			sqlite3LOCAL = ast.ShareModeLock
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1073:
+	case 1129:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SelectInto
-//line sqlite3.y:5429
+//line sqlite3.y:5686
 		{
 			sqlite3LOCAL = &ast.SelectInto{ExportOption: sql_types.EncodeStringSQL(sqlite3Dollar[2].tableName.Name.V)}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1074:
+	case 1130:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Insert
-//line sqlite3.y:5442
+//line sqlite3.y:5699
 		{
 			sqlite3LOCAL = &ast.Insert{Rows: sqlite3Dollar[2].valuesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1075:
+	case 1131:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Insert
-//line sqlite3.y:5446
+//line sqlite3.y:5703
 		{
 			sqlite3LOCAL = &ast.Insert{Rows: sqlite3Dollar[1].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1076:
+	case 1132:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Insert
-//line sqlite3.y:5450
+//line sqlite3.y:5707
 		{
 			sqlite3LOCAL = &ast.Insert{Columns: sqlite3Dollar[2].columnsUnion(), Rows: sqlite3Dollar[5].valuesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1077:
+	case 1133:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Insert
-//line sqlite3.y:5454
+//line sqlite3.y:5711
 		{
 			sqlite3LOCAL = &ast.Insert{Rows: sqlite3Dollar[4].valuesUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1078:
+	case 1134:
 		sqlite3Dollar = sqlite3S[sqlite3pt-4 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.Insert
-//line sqlite3.y:5458
+//line sqlite3.y:5715
 		{
 			sqlite3LOCAL = &ast.Insert{Columns: sqlite3Dollar[2].columnsUnion(), Rows: sqlite3Dollar[4].selStmtUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1079:
+	case 1135:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:5464
+//line sqlite3.y:5721
 		{
 			sqlite3LOCAL = ast.Columns{sqlite3Dollar[1].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1080:
+	case 1136:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Columns
-//line sqlite3.y:5468
+//line sqlite3.y:5725
 		{
 			sqlite3LOCAL = ast.Columns{sqlite3Dollar[3].colIdent}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1081:
+	case 1137:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5472
+//line sqlite3.y:5729
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].colIdent)
 		}
-	case 1082:
+	case 1138:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
-//line sqlite3.y:5476
+//line sqlite3.y:5733
 		{
 			sqlite3SLICE := (*ast.Columns)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[5].colIdent)
 		}
-	case 1083:
+	case 1139:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.UpdateExprs
-//line sqlite3.y:5481
+//line sqlite3.y:5738
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1084:
+	case 1140:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.UpdateExprs
-//line sqlite3.y:5485
+//line sqlite3.y:5742
 		{
 			sqlite3LOCAL = sqlite3Dollar[5].updateExprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1085:
+	case 1141:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Values
-//line sqlite3.y:5491
+//line sqlite3.y:5748
 		{
 			sqlite3LOCAL = ast.Values{sqlite3Dollar[1].valTupleUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1086:
+	case 1142:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5495
+//line sqlite3.y:5752
 		{
 			sqlite3SLICE := (*ast.Values)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].valTupleUnion())
 		}
-	case 1087:
+	case 1143:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ValTuple
-//line sqlite3.y:5501
+//line sqlite3.y:5758
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].valTupleUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1088:
+	case 1144:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ValTuple
-//line sqlite3.y:5505
+//line sqlite3.y:5762
 		{
 			sqlite3LOCAL = ast.ValTuple{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1089:
+	case 1145:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL ast.ValTuple
-//line sqlite3.y:5511
+//line sqlite3.y:5768
 		{
 			sqlite3LOCAL = ast.ValTuple(sqlite3Dollar[2].exprsUnion())
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1090:
+	case 1146:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5516
+//line sqlite3.y:5773
 		{
 			if len(sqlite3Dollar[1].valTupleUnion()) == 1 {
 				sqlite3LOCAL = sqlite3Dollar[1].valTupleUnion()[0]
@@ -13574,335 +14177,345 @@ sqlite3default:
 			}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1091:
+	case 1147:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.UpdateExprs
-//line sqlite3.y:5526
+//line sqlite3.y:5783
 		{
 			sqlite3LOCAL = ast.UpdateExprs{sqlite3Dollar[1].updateExprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1092:
+	case 1148:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5530
+//line sqlite3.y:5787
 		{
 			sqlite3SLICE := (*ast.UpdateExprs)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].updateExprUnion())
 		}
-	case 1093:
+	case 1149:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.UpdateExpr
-//line sqlite3.y:5536
+//line sqlite3.y:5793
 		{
 			sqlite3LOCAL = &ast.UpdateExpr{Name: sqlite3Dollar[1].colNameUnion(), Expr: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1094:
+	case 1150:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.SetExprs
-//line sqlite3.y:5542
+//line sqlite3.y:5799
 		{
 			sqlite3LOCAL = ast.SetExprs{sqlite3Dollar[1].setExprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1095:
+	case 1151:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
-//line sqlite3.y:5546
+//line sqlite3.y:5803
 		{
 			sqlite3SLICE := (*ast.SetExprs)(sqlite3Iaddr(sqlite3VAL.union))
 			*sqlite3SLICE = append(*sqlite3SLICE, sqlite3Dollar[3].setExprUnion())
 		}
-	case 1096:
+	case 1152:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SetExpr
-//line sqlite3.y:5552
+//line sqlite3.y:5809
 		{
 			sqlite3LOCAL = &ast.SetExpr{Name: sqlite3Dollar[1].colIdent, Scope: ast.ImplicitScope, Expr: ast.NewStrLiteral("on")}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1097:
+	case 1153:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SetExpr
-//line sqlite3.y:5556
+//line sqlite3.y:5813
 		{
 			sqlite3LOCAL = &ast.SetExpr{Name: sqlite3Dollar[1].colIdent, Scope: ast.ImplicitScope, Expr: ast.NewStrLiteral("off")}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1098:
+	case 1154:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SetExpr
-//line sqlite3.y:5560
+//line sqlite3.y:5817
 		{
 			sqlite3LOCAL = &ast.SetExpr{Name: sqlite3Dollar[1].colIdent, Scope: ast.ImplicitScope, Expr: sqlite3Dollar[3].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1099:
+	case 1155:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SetExpr
-//line sqlite3.y:5564
+//line sqlite3.y:5821
 		{
 			sqlite3LOCAL = &ast.SetExpr{Name: ast.NewColIdent(string(sqlite3Dollar[1].str)), Scope: ast.ImplicitScope, Expr: sqlite3Dollar[2].exprUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1100:
+	case 1156:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.SetExpr
-//line sqlite3.y:5568
+//line sqlite3.y:5825
 		{
 			sqlite3Dollar[2].setExprUnion().Scope = sqlite3Dollar[1].scopeUnion()
 			sqlite3LOCAL = sqlite3Dollar[2].setExprUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1102:
+	case 1158:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
-//line sqlite3.y:5576
+//line sqlite3.y:5833
 		{
 			sqlite3VAL.str = "charset"
 		}
-	case 1105:
+	case 1161:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5586
+//line sqlite3.y:5843
 		{
 			sqlite3LOCAL = ast.NewStrLiteral(sqlite3Dollar[1].colIdent.String())
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1106:
+	case 1162:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5590
+//line sqlite3.y:5847
 		{
 			sqlite3LOCAL = ast.NewStrLiteral(sqlite3Dollar[1].str)
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1107:
+	case 1163:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Expr
-//line sqlite3.y:5594
+//line sqlite3.y:5851
 		{
 			sqlite3LOCAL = &ast.Default{}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1110:
+	case 1166:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5603
+//line sqlite3.y:5860
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1111:
+	case 1167:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5605
+//line sqlite3.y:5862
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1112:
+	case 1168:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+		var sqlite3LOCAL bool
+//line sqlite3.y:5864
+		{
+			sqlite3LOCAL = true
+		}
+		sqlite3VAL.union = sqlite3LOCAL
+	case 1169:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5608
+//line sqlite3.y:5867
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1113:
+	case 1170:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5610
+//line sqlite3.y:5869
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1114:
+	case 1171:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5613
+//line sqlite3.y:5872
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1115:
+	case 1172:
 		sqlite3Dollar = sqlite3S[sqlite3pt-3 : sqlite3pt+1]
 		var sqlite3LOCAL bool
-//line sqlite3.y:5615
+//line sqlite3.y:5874
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1116:
+	case 1173:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Ignore
-//line sqlite3.y:5618
+//line sqlite3.y:5877
 		{
 			sqlite3LOCAL = false
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1117:
+	case 1174:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Ignore
-//line sqlite3.y:5620
+//line sqlite3.y:5879
 		{
 			sqlite3LOCAL = true
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1118:
+	case 1175:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5623
+//line sqlite3.y:5882
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 1119:
+	case 1176:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5625
+//line sqlite3.y:5884
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 1120:
+	case 1177:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5627
+//line sqlite3.y:5886
 		{
 			sqlite3VAL.empty = struct{}{}
 		}
-	case 1121:
+	case 1178:
 		sqlite3Dollar = sqlite3S[sqlite3pt-5 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Statement
-//line sqlite3.y:5631
+//line sqlite3.y:5890
 		{
 			sqlite3LOCAL = &ast.CallProc{Name: sqlite3Dollar[2].tableName, Params: sqlite3Dollar[4].exprsUnion()}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1122:
+	case 1179:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Exprs
-//line sqlite3.y:5636
+//line sqlite3.y:5895
 		{
 			sqlite3LOCAL = nil
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1123:
+	case 1180:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
 		var sqlite3LOCAL ast.Exprs
-//line sqlite3.y:5640
+//line sqlite3.y:5899
 		{
 			sqlite3LOCAL = sqlite3Dollar[1].exprsUnion()
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1124:
-		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-		var sqlite3LOCAL []*ast.IndexOption
-//line sqlite3.y:5645
-		{
-			sqlite3LOCAL = nil
-		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 1125:
-		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-		var sqlite3LOCAL []*ast.IndexOption
-//line sqlite3.y:5647
-		{
-			sqlite3LOCAL = []*ast.IndexOption{sqlite3Dollar[1].indexOptionUnion()}
-		}
-		sqlite3VAL.union = sqlite3LOCAL
-	case 1126:
+	case 1181:
 		sqlite3Dollar = sqlite3S[sqlite3pt-2 : sqlite3pt+1]
 		var sqlite3LOCAL *ast.IndexOption
-//line sqlite3.y:5651
+//line sqlite3.y:5905
 		{
 			sqlite3LOCAL = &ast.IndexOption{Name: string(sqlite3Dollar[1].str), String: string(sqlite3Dollar[2].colIdent.String())}
 		}
 		sqlite3VAL.union = sqlite3LOCAL
-	case 1127:
+	case 1182:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5657
+//line sqlite3.y:5911
 		{
 			sqlite3VAL.colIdent = sqlite3Dollar[1].colIdent
 		}
-	case 1128:
+	case 1183:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5661
+//line sqlite3.y:5915
 		{
 			sqlite3VAL.colIdent = ast.NewColIdent(string(sqlite3Dollar[1].str))
 		}
-	case 1130:
+	case 1185:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5668
+//line sqlite3.y:5922
 		{
 			sqlite3VAL.colIdent = ast.NewColIdent(string(sqlite3Dollar[1].str))
 		}
-	case 1131:
+	case 1186:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5674
+//line sqlite3.y:5928
 		{
 			sqlite3VAL.schemaIdent = ast.NewSchemaIdent(string(sqlite3Dollar[1].colIdent.String()))
 		}
-	case 1132:
+	case 1187:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5680
+//line sqlite3.y:5934
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent(string(sqlite3Dollar[1].colIdent.String()))
 		}
-	case 1133:
+	case 1188:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5684
+//line sqlite3.y:5938
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent(string(sqlite3Dollar[1].str))
 		}
-	case 1134:
+	case 1189:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5690
+//line sqlite3.y:5944
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent("")
 		}
-	case 1135:
+	case 1190:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5694
+//line sqlite3.y:5948
 		{
 			sqlite3VAL.tableIdent = sqlite3Dollar[1].tableIdent
 		}
-	case 1137:
+	case 1192:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5701
+//line sqlite3.y:5955
 		{
 			sqlite3VAL.tableIdent = ast.NewTableIdent(string(sqlite3Dollar[1].str))
 		}
-	case 1285:
+	case 1193:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:5969
+		{
+			sqlite3VAL.str = "abort"
+		}
+	case 1194:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:5973
+		{
+			sqlite3VAL.str = "fail"
+		}
+	case 1195:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5873
+//line sqlite3.y:5977
+		{
+			sqlite3VAL.str = "rollback"
+		}
+	case 1343:
+		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
+//line sqlite3.y:6141
 		{
 			if incNesting(sqlite3lex) {
 				sqlite3lex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1286:
+	case 1344:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5882
+//line sqlite3.y:6150
 		{
 			decNesting(sqlite3lex)
 		}
-	case 1287:
+	case 1345:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5887
+//line sqlite3.y:6155
 		{
 			skipToEnd(sqlite3lex)
 		}
-	case 1288:
+	case 1346:
 		sqlite3Dollar = sqlite3S[sqlite3pt-0 : sqlite3pt+1]
-//line sqlite3.y:5892
+//line sqlite3.y:6160
 		{
 			skipToEnd(sqlite3lex)
 		}
-	case 1289:
+	case 1347:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5896
+//line sqlite3.y:6164
 		{
 			skipToEnd(sqlite3lex)
 		}
-	case 1290:
+	case 1348:
 		sqlite3Dollar = sqlite3S[sqlite3pt-1 : sqlite3pt+1]
-//line sqlite3.y:5900
+//line sqlite3.y:6168
 		{
 			skipToEnd(sqlite3lex)
 		}
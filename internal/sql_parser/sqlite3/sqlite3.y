@@ -122,6 +122,12 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
   createDatabase  *ast.CreateDatabase
   alterDatabase  *ast.AlterDatabase
   createTable      *ast.CreateTable
+  createIndex      *ast.CreateIndex
+  indexElement     *ast.IndexElement
+  indexElements    []*ast.IndexElement
+  createTrigger    *ast.CreateTrigger
+  triggerEvent     *ast.TriggerEvent
+  statements       []ast.Statement
   tableAndLockType *ast.TableAndLockType
   alterTable       *ast.AlterTable
   tableOption      *ast.TableOption
@@ -268,6 +274,7 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
 %token <str> ID AT_ID AT_AT_ID HEX STRING NCHAR_STRING INTEGRAL FLOAT DECIMAL HEXNUM VALUE_ARG LIST_ARG COMMENT_KEYWORD BIT_LITERAL COMPRESSION
+%token <str> MODULE_ARGUMENTS
 %token <str> JSON_PRETTY JSON_STORAGE_SIZE JSON_STORAGE_FREE JSON_CONTAINS JSON_CONTAINS_PATH JSON_EXTRACT JSON_KEYS JSON_OVERLAPS JSON_SEARCH JSON_VALUE
 %token <str> EXTRACT
 %token <str> NULL TRUE FALSE OFF
@@ -297,7 +304,7 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
 %left <str> SHIFT_LEFT SHIFT_RIGHT
 %left <str> '+' '-'
 %left <str> '*' '/' DIV '%' MOD
-%left <str> '^'
+%left <str> '^' CONCAT
 %right <str> '~' UNARY
 %left <str> COLLATE
 %right <str> BINARY UNDERSCORE_ARMSCII8 UNDERSCORE_ASCII UNDERSCORE_BIG5 UNDERSCORE_BINARY UNDERSCORE_CP1250 UNDERSCORE_CP1251
@@ -410,7 +417,18 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
 %type <alterTable> alter_table_prefix
 %type <alterOption> alter_option alter_commands_modifier lock_index algorithm_index
 %type <alterOptions> alter_options alter_commands_list alter_commands_modifier_list algorithm_lock_opt
-%type <alterTable> create_index_prefix
+%type <createIndex> create_index_prefix
+%type <indexElements> index_element_list
+%type <indexElement> index_element
+%type <createTrigger> create_trigger_prefix
+%type <str> trigger_timing_opt raise_action module_arguments_opt
+%type <triggerEvent> trigger_event
+%type <boolean> trigger_for_each_opt unique_opt
+%type <expr> trigger_when_opt
+%type <statements> trigger_body
+%type <statement> trigger_statement pragma_statement
+%type <str> pragma_value sqlite_type_name
+%type <empty> begin_mode_opt transaction_opt
 %type <createDatabase> create_database_prefix
 %type <alterDatabase> alter_database_prefix
 %type <databaseOption> collate character_set encryption
@@ -502,7 +520,7 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
 %type <str> charset
 %type <scope> set_session_or_global
 %type <convertType> convert_type returning_type_opt convert_type_weight_string
-%type <columnType> column_type
+%type <columnType> column_type sqlite_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type spatial_type
 %type <literal> length_opt
 %type <expr> func_datetime_precision
@@ -528,7 +546,7 @@ func bindVariable(sqlite3lex sqlite3Lexer, bvar string) {
 %type <indexColumn> index_column
 %type <indexColumns> index_column_list
 %type <indexOption> index_option using_index_type
-%type <indexOptions> index_option_list index_option_list_opt using_opt
+%type <indexOptions> index_option_list index_option_list_opt
 %type <constraintInfo> constraint_info check_constraint_info
 %type <vindexParam> vindex_param
 %type <vindexParams> vindex_param_list vindex_params_opt
@@ -603,6 +621,7 @@ command:
 | prepare_statement
 | execute_statement
 | deallocate_statement
+| pragma_statement
 | /*empty*/
   {
     setParseTree(sqlite3lex, nil)
@@ -1008,15 +1027,24 @@ create_statement:
     $1.FullyParsed = true
     $$ = $1
   }
-| create_index_prefix '(' index_column_list ')' index_option_list_opt algorithm_lock_opt
+| create_index_prefix '(' index_element_list ')' where_expression_opt
   {
-    indexDef := $1.AlterOptions[0].(*ast.AddIndexDefinition).IndexDefinition
-    indexDef.Columns = $3
-    indexDef.Options = append(indexDef.Options,$5...)
-    $1.AlterOptions = append($1.AlterOptions,$6...)
+    $1.Columns = $3
+    $1.Where = ast.NewWhere(ast.WhereClause, $5)
     $1.FullyParsed = true
     $$ = $1
   }
+| create_trigger_prefix trigger_for_each_opt trigger_when_opt BEGIN trigger_body END
+  {
+    $1.ForEachRow = $2
+    $1.When = $3
+    $1.Body = $5
+    $$ = $1
+  }
+| CREATE comment_opt VIRTUAL TABLE not_exists_opt table_name USING sql_id module_arguments_opt
+  {
+    $$ = &ast.CreateVirtualTable{Comments: ast.Comments($2).Parsed(), IfNotExists: $5, Table: $6, Module: $8, Arguments: $9}
+  }
 | CREATE comment_opt replace_opt algorithm_view definer_opt security_view_opt VIEW table_name column_list_opt AS select_statement check_option_opt
   {
     $$ = &ast.CreateView{ViewName: $8.ToViewName(), Comments: ast.Comments($2).Parsed(), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
@@ -1110,6 +1138,12 @@ create_table_prefix:
     $$ = &ast.CreateTable{Comments: ast.Comments($2).Parsed(), Table: $6, IfNotExists: $5, Temp: $3}
     setDDL(sqlite3lex, $$)
   }
+| CREATE comment_opt temp_opt TABLE not_exists_opt STRING
+  {
+    // The .dump command writes the names of the shadow tables as strings
+    $$ = &ast.CreateTable{Comments: ast.Comments($2).Parsed(), Table: ast.TableName{Name: ast.NewTableIdent($6)}, IfNotExists: $5, Temp: $3}
+    setDDL(sqlite3lex, $$)
+  }
 
 alter_table_prefix:
   ALTER comment_opt TABLE table_name
@@ -1119,25 +1153,132 @@ alter_table_prefix:
   }
 
 create_index_prefix:
-  CREATE comment_opt INDEX id_or_var using_opt ON table_name
+  CREATE comment_opt unique_opt INDEX not_exists_opt sql_id ON table_name
   {
-    $$ = &ast.AlterTable{Table: $7, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$4, Type:string($3)}, Options:$5}}}}
+    $$ = &ast.CreateIndex{Comments: ast.Comments($2).Parsed(), Unique: $3, IfNotExists: $5, Name: $6, Table: $8}
     setDDL(sqlite3lex, $$)
   }
-| CREATE comment_opt FULLTEXT INDEX id_or_var using_opt ON table_name
+
+unique_opt:
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Fulltext:true}, Options:$6}}}}
-    setDDL(sqlite3lex, $$)
+    $$ = false
   }
-| CREATE comment_opt SPATIAL INDEX id_or_var using_opt ON table_name
+| UNIQUE
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Spatial:true}, Options:$6}}}}
-    setDDL(sqlite3lex, $$)
+    $$ = true
   }
-| CREATE comment_opt UNIQUE INDEX id_or_var using_opt ON table_name
+
+index_element_list:
+  index_element
   {
-    $$ = &ast.AlterTable{Table: $8, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition:&ast.IndexDefinition{Info: &ast.IndexInfo{Name:$5, Type:string($3)+" "+string($4), Unique:true}, Options:$6}}}}
-    setDDL(sqlite3lex, $$)
+    $$ = []*ast.IndexElement{$1}
+  }
+| index_element_list ',' index_element
+  {
+    $$ = append($1, $3)
+  }
+
+index_element:
+  sql_id collate_opt asc_desc_opt
+  {
+    $$ = &ast.IndexElement{Column: $1, Collate: $2, Direction: $3}
+  }
+| function_call_generic asc_desc_opt
+  {
+    $$ = &ast.IndexElement{Expression: $1, Direction: $2}
+  }
+| openb expression closeb asc_desc_opt
+  {
+    $$ = &ast.IndexElement{Expression: $2, Direction: $4}
+  }
+
+create_trigger_prefix:
+  CREATE comment_opt temp_opt TRIGGER not_exists_opt sql_id trigger_timing_opt trigger_event ON table_name
+  {
+    $$ = &ast.CreateTrigger{Comments: ast.Comments($2).Parsed(), Temp: $3, IfNotExists: $5, Name: $6, Timing: $7, Events: []*ast.TriggerEvent{$8}, Table: $10}
+  }
+
+trigger_timing_opt:
+  {
+    $$ = ""
+  }
+| BEFORE
+  {
+    $$ = ast.TriggerBeforeStr
+  }
+| AFTER
+  {
+    $$ = ast.TriggerAfterStr
+  }
+| INSTEAD OF
+  {
+    $$ = ast.TriggerInsteadOfStr
+  }
+
+trigger_event:
+  INSERT
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerInsertStr}
+  }
+| UPDATE
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerUpdateStr}
+  }
+| UPDATE OF column_list
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerUpdateStr, Columns: $3}
+  }
+| DELETE
+  {
+    $$ = &ast.TriggerEvent{Type: ast.TriggerDeleteStr}
+  }
+
+trigger_for_each_opt:
+  {
+    $$ = false
+  }
+| FOR EACH ROW
+  {
+    $$ = true
+  }
+
+trigger_when_opt:
+  {
+    $$ = nil
+  }
+| WHEN expression
+  {
+    $$ = $2
+  }
+
+// The statements of the trigger body are terminated by the semicolons, the last one too
+trigger_body:
+  trigger_statement ';'
+  {
+    $$ = []ast.Statement{$1}
+  }
+| trigger_body trigger_statement ';'
+  {
+    $$ = append($1, $2)
+  }
+
+trigger_statement:
+  insert_statement
+| update_statement
+| delete_statement
+| select_statement
+  {
+    $$ = $1
+  }
+
+// The module arguments are scanned by the tokenizer as a raw text between the parentheses
+module_arguments_opt:
+  {
+    $$ = ""
+  }
+| MODULE_ARGUMENTS
+  {
+    $$ = $1
   }
 
 create_database_prefix:
@@ -1266,6 +1407,12 @@ table_column_list:
     $$ = &ast.TableSpec{}
     $$.AddColumn($1)
   }
+| column_definition check_constraint_definition
+  {
+    $$ = &ast.TableSpec{}
+    $$.AddColumn($1)
+    $$.AddConstraint($2)
+  }
 | check_constraint_definition
   {
     $$ = &ast.TableSpec{}
@@ -1308,6 +1455,12 @@ column_definition:
     $2.Options.Reference = $5
     $$ = &ast.ColumnDefinition{Name: $1, Type: $2}
   }
+| sql_id column_attribute_list_opt reference_definition_opt
+  {
+    // SQLite columns may have no type
+    $2.Reference = $3
+    $$ = &ast.ColumnDefinition{Name: $1, Type: ast.ColumnType{Options: $2}}
+  }
 | sql_id column_type collate_opt generated_always_opt AS '(' expression ')' generated_column_attribute_list_opt reference_definition_opt
   {
     $2.Options = $9
@@ -1819,6 +1972,32 @@ column_type:
 | char_type
 | time_type
 | spatial_type
+| sqlite_type
+
+// SQLite accepts any sequence of names as a type name, the type affinity is taken from it
+sqlite_type:
+  sqlite_type_name
+  {
+    $$ = ast.ColumnType{Type: $1}
+  }
+| sqlite_type_name '(' INTEGRAL ')'
+  {
+    $$ = ast.ColumnType{Type: $1, Length: ast.NewIntLiteral($3)}
+  }
+| sqlite_type_name '(' INTEGRAL ',' INTEGRAL ')'
+  {
+    $$ = ast.ColumnType{Type: $1, Length: ast.NewIntLiteral($3), Scale: ast.NewIntLiteral($5)}
+  }
+
+sqlite_type_name:
+  ID
+  {
+    $$ = string($1)
+  }
+| sqlite_type_name ID
+  {
+    $$ = $1 + " " + string($2)
+  }
 
 numeric_type:
   int_type length_opt
@@ -2496,7 +2675,23 @@ space_separated_table_option_list:
   }
 
 table_option:
-  default_optional charset_or_character_set equal_opt charset
+  WITHOUT ID
+  {
+    if ast.NewColIdent($2).Lowered() != "rowid" {
+      sqlite3lex.Error("expected ROWID after WITHOUT")
+      return 1
+    }
+    $$ = &ast.TableOption{Name: "without rowid"}
+  }
+| ID
+  {
+    if ast.NewColIdent($1).Lowered() != "strict" {
+      sqlite3lex.Error("unknown table option " + $1)
+      return 1
+    }
+    $$ = &ast.TableOption{Name: "strict"}
+  }
+| default_optional charset_or_character_set equal_opt charset
   {
     $$ = &ast.TableOption{Name:(string($2)), String:$4, CaseSensitive: true}
   }
@@ -3345,7 +3540,7 @@ use_statement:
   }
 
 begin_statement:
-  BEGIN
+  BEGIN begin_mode_opt transaction_opt
   {
     $$ = &ast.Begin{}
   }
@@ -3355,10 +3550,64 @@ begin_statement:
   }
 
 commit_statement:
-  COMMIT
+  COMMIT transaction_opt
   {
     $$ = &ast.Commit{}
   }
+| END transaction_opt
+  {
+    $$ = &ast.Commit{}
+  }
+
+begin_mode_opt:
+  { $$ = struct{}{} }
+| DEFERRED
+  { $$ = struct{}{} }
+| IMMEDIATE
+  { $$ = struct{}{} }
+| EXCLUSIVE
+  { $$ = struct{}{} }
+
+transaction_opt:
+  { $$ = struct{}{} }
+| TRANSACTION
+  { $$ = struct{}{} }
+
+pragma_statement:
+  PRAGMA table_name
+  {
+    $$ = &ast.Pragma{Name: $2}
+  }
+| PRAGMA table_name '=' pragma_value
+  {
+    $$ = &ast.Pragma{Name: $2, Value: $4}
+  }
+| PRAGMA table_name openb pragma_value closeb
+  {
+    $$ = &ast.Pragma{Name: $2, Value: $4}
+  }
+
+pragma_value:
+  reserved_sql_id
+  {
+    $$ = $1.String()
+  }
+| STRING
+  {
+    $$ = sql_types.EncodeStringSQL($1)
+  }
+| INTEGRAL
+  {
+    $$ = $1
+  }
+| '-' INTEGRAL
+  {
+    $$ = "-" + $2
+  }
+| '+' INTEGRAL
+  {
+    $$ = $2
+  }
 
 rollback_statement:
   ROLLBACK
@@ -4270,13 +4519,13 @@ bit_expr IN col_tuple
  {
 	$$ = $1
  }
-| bit_expr '=' ANY col_tuple
-  {
-	$$ = &ast.ComparisonExpr{Left: $1, Operator: ast.InOp, Right: $4}
-  }
 
 bit_expr:
-bit_expr '|' bit_expr %prec '|'
+bit_expr CONCAT bit_expr %prec CONCAT
+  {
+	  $$ = &ast.BinaryExpr{Left: $1, Operator: ast.ConcatOp, Right: $3}
+  }
+| bit_expr '|' bit_expr %prec '|'
   {
 	  $$ = &ast.BinaryExpr{Left: $1, Operator: ast.BitOrOp, Right: $3}
   }
@@ -4572,7 +4821,15 @@ function_call_generic:
   as a result
 */
 function_call_keyword:
-  LEFT openb select_expression_list closeb
+  RAISE openb IGNORE closeb
+  {
+    $$ = &ast.FuncExpr{Name: ast.NewColIdent("raise"), Exprs: ast.SelectExprs{&ast.AliasedExpr{Expr: &ast.ColName{Name: ast.NewColIdent("ignore")}}}}
+  }
+| RAISE openb raise_action ',' STRING closeb
+  {
+    $$ = &ast.FuncExpr{Name: ast.NewColIdent("raise"), Exprs: ast.SelectExprs{&ast.AliasedExpr{Expr: &ast.ColName{Name: ast.NewColIdent($3)}}, &ast.AliasedExpr{Expr: ast.NewStrLiteral($5)}}}
+  }
+| LEFT openb select_expression_list closeb
   {
     $$ = &ast.FuncExpr{Name: ast.NewColIdent("left"), Exprs: $3}
   }
@@ -5601,6 +5858,8 @@ for_from:
 
 temp_opt:
   { $$ = false }
+| TEMP
+  { $$ = true }
 | TEMPORARY
   { $$ = true }
 
@@ -5641,11 +5900,6 @@ expression_list_opt:
     $$ = $1
   }
 
-using_opt:
-  { $$ = nil }
-| using_index_type
-  { $$ = []*ast.IndexOption{$1} }
-
 using_index_type:
   USING sql_id
   {
@@ -5710,6 +5964,20 @@ reserved_table_id:
 
   Sorted alphabetically
 */
+raise_action:
+  ABORT
+  {
+    $$ = "abort"
+  }
+| FAIL
+  {
+    $$ = "fail"
+  }
+| ROLLBACK
+  {
+    $$ = "rollback"
+  }
+
 reserved_keyword:
   ABORT
 | ACTION
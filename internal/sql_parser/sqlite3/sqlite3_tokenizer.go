@@ -18,7 +18,6 @@ package sqlite3
 
 import (
 	"flag"
-	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -28,7 +27,6 @@ import (
 	"github.com/usalko/prodl/internal/sql_parser/cache"
 	"github.com/usalko/prodl/internal/sql_parser/dialect"
 	"github.com/usalko/prodl/internal/sql_parser/tokenizer"
-	"github.com/usalko/prodl/internal/sql_types"
 )
 
 // Sqlite3Tokenizer is the struct used to generate SQL
@@ -42,6 +40,9 @@ type Sqlite3Tokenizer struct {
 	BindVars            map[string]struct{}
 
 	lastToken            string
+	expected             []string
+	lastTypes            [2]int
+	virtualTable         bool
 	posVarIndex          int
 	partialDDL           ast.Statement
 	nesting              int
@@ -50,12 +51,12 @@ type Sqlite3Tokenizer struct {
 	ignoreCommentKeyword bool
 
 	Pos int
-	buf string
+	buf *tokenizer.BytesBuffer
 }
 
 // ResetTo implements tokenizer.Tokenizer.
 func (tkn *Sqlite3Tokenizer) ResetTo(nextPos int) {
-	tkn.buf = strings.Clone(tkn.buf[nextPos:])
+	tkn.buf.ClipFrom(nextPos)
 	tkn.Pos = 0
 }
 
@@ -66,7 +67,7 @@ func (tkn *Sqlite3Tokenizer) GetDialect() dialect.SqlDialect {
 
 // GetText implements tokenizer.Tokenizer.
 func (tkn *Sqlite3Tokenizer) GetText(startPos int) string {
-	return tkn.buf[startPos:tkn.Pos]
+	return tkn.buf.StringAt(startPos, tkn.Pos)
 }
 
 // SetSkipSpecialComments implements tokenizer.Tokenizer.
@@ -199,6 +200,16 @@ var SQLITE3ServerVersion = flag.String("sqlite3_server_version", "", "SQLITE3 se
 // sql string.
 func NewSqlite3StringTokenizer(sql string) *Sqlite3Tokenizer {
 
+	return &Sqlite3Tokenizer{
+		buf:      tokenizer.NewBytesBufferString(sql),
+		BindVars: make(map[string]struct{}),
+	}
+}
+
+// NewBufferedSqlite3StringTokenizer creates a new Tokenizer for the
+// BytesBuffer.
+func NewBufferedSqlite3StringTokenizer(sql *tokenizer.BytesBuffer) *Sqlite3Tokenizer {
+
 	return &Sqlite3Tokenizer{
 		buf:      sql,
 		BindVars: make(map[string]struct{}),
@@ -225,29 +236,68 @@ func (tkn *Sqlite3Tokenizer) Lex(lval *sqlite3SymType) int {
 		// should instead result in parser errors. See the
 		// Parse function to see how this is handled.
 		tkn.partialDDL = nil
+		tkn.virtualTable = false
+	}
+	switch {
+	case typ == VIRTUAL:
+		tkn.virtualTable = true
+	case typ == '(' && tkn.virtualTable && tkn.lastTypes[0] == USING && tkn.lastTypes[1] == ID:
+		// CREATE VIRTUAL TABLE ... USING module(arguments), every module has its own
+		// syntax for the arguments, then they are returned as a single token
+		typ, val = tkn.scanModuleArguments()
 	}
 	lval.str = val
 	tkn.lastToken = val
+	tkn.lastTypes[0], tkn.lastTypes[1] = tkn.lastTypes[1], typ
 	return typ
 }
 
-// PositionedErr holds context related to parser errors
-type PositionedErr struct {
-	Err  string
-	Pos  int
-	Near string
+// scanModuleArguments scans the text up to the closing parenthesis, the nested
+// parentheses and the quoted strings or identifiers are skipped as a whole.
+// Assumes that the opening parenthesis has just been scanned.
+func (tkn *Sqlite3Tokenizer) scanModuleArguments() (int, string) {
+	start := tkn.Pos
+	depth := 0
+	for {
+		switch ch := tkn.Cur(); ch {
+		case tokenizer.EofChar:
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				tkn.Skip(1)
+				return MODULE_ARGUMENTS, strings.TrimSpace(tkn.buf.StringAt(start, tkn.Pos-1))
+			}
+			depth--
+		case '\'', '"', '`':
+			tkn.Skip(1)
+			for tkn.Cur() != ch || tkn.Peek(1) == ch {
+				if tkn.Cur() == tokenizer.EofChar {
+					return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
+				}
+				if tkn.Cur() == ch {
+					tkn.Skip(1)
+				}
+				tkn.Skip(1)
+			}
+		}
+		tkn.Skip(1)
+	}
 }
 
-func (p PositionedErr) Error() string {
-	if p.Near != "" {
-		return fmt.Sprintf("%s at position %v near '%s'", p.Err, p.Pos, p.Near)
-	}
-	return fmt.Sprintf("%s at position %v", p.Err, p.Pos)
+// PositionedErr holds context related to parser errors
+type PositionedErr = tokenizer.PositionedErr
+
+// SetExpectedTokens is called by go yacc before the Error with the tokens expected by the parser.
+func (tkn *Sqlite3Tokenizer) SetExpectedTokens(tokens []string) {
+	tkn.expected = tokens
 }
 
 // Error is called by go yacc if there's a parsing error.
 func (tkn *Sqlite3Tokenizer) Error(err string) {
-	tkn.LastError = PositionedErr{Err: err, Pos: tkn.Pos + 1, Near: tkn.lastToken}
+	tkn.LastError = PositionedErr{Err: err, Pos: tkn.Pos + 1, Near: tkn.lastToken, Expected: tkn.expected}
+	tkn.expected = nil
 
 	// Try and re-sync to the next statement
 	tkn.skipStatement()
@@ -346,8 +396,9 @@ func (tkn *Sqlite3Tokenizer) Scan() (int, string) {
 			return int(ch), ""
 		case '|':
 			if tkn.Cur() == '|' {
+				// The SQLite string concatenation operator
 				tkn.Skip(1)
-				return OR, ""
+				return CONCAT, ""
 			}
 			return int(ch), ""
 		case '?':
@@ -471,7 +522,7 @@ func (tkn *Sqlite3Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 		}
 		tkn.Skip(1)
 	}
-	keywordName := tkn.buf[start:tkn.Pos]
+	keywordName := tkn.buf.StringAt(start, tkn.Pos)
 	if keywordID, found := cache.KeywordLookup(keywordName, dialect.SQLITE3); found {
 		return keywordID, keywordName
 	}
@@ -486,7 +537,7 @@ func (tkn *Sqlite3Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 func (tkn *Sqlite3Tokenizer) scanHex() (int, string) {
 	start := tkn.Pos
 	tkn.scanMantissa(16)
-	hex := tkn.buf[start:tkn.Pos]
+	hex := tkn.buf.StringAt(start, tkn.Pos)
 	if tkn.Cur() != '\'' {
 		return LEX_ERROR, hex
 	}
@@ -501,7 +552,7 @@ func (tkn *Sqlite3Tokenizer) scanHex() (int, string) {
 func (tkn *Sqlite3Tokenizer) scanBitLiteral() (int, string) {
 	start := tkn.Pos
 	tkn.scanMantissa(2)
-	bit := tkn.buf[start:tkn.Pos]
+	bit := tkn.buf.StringAt(start, tkn.Pos)
 	if tkn.Cur() != '\'' {
 		return LEX_ERROR, bit
 	}
@@ -555,16 +606,16 @@ func (tkn *Sqlite3Tokenizer) scanLiteralIdentifier() (int, string) {
 					return LEX_ERROR, ""
 				}
 				tkn.Skip(1)
-				return ID, tkn.buf[start : tkn.Pos-1]
+				return ID, tkn.buf.StringAt(start, tkn.Pos-1)
 			}
 
 			var buf strings.Builder
-			buf.WriteString(tkn.buf[start:tkn.Pos])
+			buf.WriteString(tkn.buf.StringAt(start, tkn.Pos))
 			tkn.Skip(1)
 			return tkn.scanLiteralIdentifierSlow(&buf)
 		case tokenizer.EofChar:
 			// Premature EOF.
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		default:
 			tkn.Skip(1)
 		}
@@ -582,7 +633,7 @@ func (tkn *Sqlite3Tokenizer) scanBindVar() (int, string) {
 		tkn.Skip(1)
 	}
 	if !isLetter(tkn.Cur()) {
-		return LEX_ERROR, tkn.buf[start:tkn.Pos]
+		return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 	}
 	for {
 		ch := tkn.Cur()
@@ -591,7 +642,7 @@ func (tkn *Sqlite3Tokenizer) scanBindVar() (int, string) {
 		}
 		tkn.Skip(1)
 	}
-	return token, tkn.buf[start:tkn.Pos]
+	return token, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanMantissa scans a sequence of numeric characters with the same base.
@@ -647,7 +698,7 @@ exit:
 	if isLetter(tkn.Cur()) {
 		// A letter cannot immediately follow a float number.
 		if token == FLOAT || token == DECIMAL {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		// A letter seen after a few numbers means that we should parse this
 		// as an identifier and not a number.
@@ -658,16 +709,16 @@ exit:
 			}
 			tkn.Skip(1)
 		}
-		return ID, tkn.buf[start:tkn.Pos]
+		return ID, tkn.buf.StringAt(start, tkn.Pos)
 	}
 
-	return token, tkn.buf[start:tkn.Pos]
+	return token, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanString scans a string surrounded by the given `delim`, which can be
 // either single or double quotes. Assumes that the given delimiter has just
-// been scanned. If the skin contains any escape sequences, this function
-// will fall back to scanStringSlow
+// been scanned. SQLite has no backslash escapes, the only escape sequence is
+// the doubled delimiter, then this function will fall back to scanStringSlow
 func (tkn *Sqlite3Tokenizer) scanString(delim rune, typ int) (int, string) {
 	start := tkn.Pos
 
@@ -676,17 +727,14 @@ func (tkn *Sqlite3Tokenizer) scanString(delim rune, typ int) (int, string) {
 		case delim:
 			if tkn.Peek(1) != delim {
 				tkn.Skip(1)
-				return typ, tkn.buf[start : tkn.Pos-1]
+				return typ, tkn.buf.StringAt(start, tkn.Pos-1)
 			}
-			fallthrough
-
-		case '\\':
 			var buffer strings.Builder
-			buffer.WriteString(tkn.buf[start:tkn.Pos])
+			buffer.WriteString(tkn.buf.StringAt(start, tkn.Pos))
 			return tkn.scanStringSlow(&buffer, delim, typ)
 
 		case tokenizer.EofChar:
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 
 		tkn.Skip(1)
@@ -704,37 +752,26 @@ func (tkn *Sqlite3Tokenizer) scanStringSlow(buffer *strings.Builder, delim rune,
 			return LEX_ERROR, buffer.String()
 		}
 
-		if ch != delim && ch != '\\' {
-			// Scan ahead to the next interesting character.
+		if ch != delim {
+			// Scan ahead to the next delimiter.
 			start := tkn.Pos
-			for ; tkn.Pos < len(tkn.buf); tkn.Pos++ {
-				ch = rune(tkn.buf[tkn.Pos])
-				if ch == delim || ch == '\\' {
+			for ; tkn.Pos < tkn.buf.Size(); tkn.Pos++ {
+				ch = tkn.buf.RuneAt(tkn.Pos)
+				if ch == delim {
 					break
 				}
 			}
 
-			buffer.WriteString(tkn.buf[start:tkn.Pos])
-			if tkn.Pos >= len(tkn.buf) {
-				// Reached the end of the buffer without finding a delim or
-				// escape character.
+			buffer.WriteString(tkn.buf.StringAt(start, tkn.Pos))
+			if tkn.Pos >= tkn.buf.Size() {
+				// Reached the end of the buffer without finding a delim.
 				tkn.Skip(1)
 				continue
 			}
 		}
-		tkn.Skip(1) // Read one past the delim or escape character.
+		tkn.Skip(1) // Read one past the delim.
 
-		if ch == '\\' {
-			if tkn.Cur() == tokenizer.EofChar {
-				// String terminates mid escape character.
-				return LEX_ERROR, buffer.String()
-			}
-			if decodedChar := sql_types.SQLDecodeMap[byte(tkn.Cur())]; decodedChar == sql_types.DontEscape {
-				ch = tkn.Cur()
-			} else {
-				ch = rune(decodedChar)
-			}
-		} else if ch == delim && tkn.Cur() != delim {
+		if tkn.Cur() != delim {
 			// Correctly terminated string, which is not a double delim.
 			break
 		}
@@ -758,7 +795,7 @@ func (tkn *Sqlite3Tokenizer) scanCommentType1(prefixLen int) (int, string) {
 		}
 		tkn.Skip(1)
 	}
-	return COMMENT, tkn.buf[start:tkn.Pos]
+	return COMMENT, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanCommentType2 scans a '/*' delimited comment; assumes the opening
@@ -775,11 +812,11 @@ func (tkn *Sqlite3Tokenizer) scanCommentType2() (int, string) {
 			continue
 		}
 		if tkn.Cur() == tokenizer.EofChar {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		tkn.Skip(1)
 	}
-	return COMMENT, tkn.buf[start:tkn.Pos]
+	return COMMENT, tkn.buf.StringAt(start, tkn.Pos)
 }
 
 // scanSQLITE3SpecificComment scans a SQLITE3 comment pragma, which always starts with '//*`
@@ -795,12 +832,12 @@ func (tkn *Sqlite3Tokenizer) scanSQLITE3SpecificComment() (int, string) {
 			continue
 		}
 		if tkn.Cur() == tokenizer.EofChar {
-			return LEX_ERROR, tkn.buf[start:tkn.Pos]
+			return LEX_ERROR, tkn.buf.StringAt(start, tkn.Pos)
 		}
 		tkn.Skip(1)
 	}
 
-	commentVersion, sql := ExtractSqlite3Comment(tkn.buf[start:tkn.Pos])
+	commentVersion, sql := ExtractSqlite3Comment(tkn.buf.StringAt(start, tkn.Pos))
 
 	if "1" >= commentVersion {
 		// Only add the special comment to the tokenizer if the version of SQLITE3 is higher or equal to the comment version
@@ -819,10 +856,10 @@ func (tkn *Sqlite3Tokenizer) Skip(dist int) {
 }
 
 func (tkn *Sqlite3Tokenizer) Peek(dist int) rune {
-	if tkn.Pos+dist >= len(tkn.buf) {
+	if tkn.Pos+dist >= tkn.buf.Size() {
 		return tokenizer.EofChar
 	}
-	return rune(tkn.buf[tkn.Pos+dist])
+	return tkn.buf.RuneAt(tkn.Pos + dist)
 }
 
 // Reset clears any internal state.
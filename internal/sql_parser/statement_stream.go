@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/usalko/prodl/internal/sql_parser/ast"
 	"github.com/usalko/prodl/internal/sql_parser/dialect"
@@ -20,26 +21,235 @@ var (
 
 type StatementProcessor func(statementText string, statement ast.Statement, parseError error)
 
+// splitState is the state of the statement splitting kept between the calls while the statement is not finished
+type splitState struct {
+	// parenthesesDepth counts the open parentheses of the PostgreSQL rule actions
+	parenthesesDepth int
+	// actionsEnd is the position after the first semicolon inside the rule actions,
+	// the statement is cut there if the parentheses aren't closed
+	actionsEnd int
+	// actionStart is true after a semicolon inside the rule actions
+	actionStart bool
+	// blockDepth counts the open BEGIN and CASE blocks of a SQLite trigger body
+	blockDepth int
+	// started is true after the first keyword of the statement
+	started bool
+	// previous is the previous keyword of the statement
+	previous string
+	// create is true if the statement starts with CREATE
+	create bool
+	// rule is true if the statement is a PostgreSQL CREATE RULE statement
+	rule bool
+	// actions is true after the DO keyword of the PostgreSQL CREATE RULE statement
+	actions bool
+	// trigger is true if the statement is a SQLite CREATE TRIGGER statement
+	trigger bool
+	// commandBegin and commandEnd are the positions of the MySQL DELIMITER commands before the statement
+	commandBegin int
+	commandEnd   int
+}
+
+// ruleActions are the statements allowed in the PostgreSQL rule actions
+var ruleActions = map[string]bool{
+	"select": true, "insert": true, "update": true, "delete": true, "notify": true, "values": true, "with": true,
+}
+
+// inside returns true if a semicolon doesn't finish the statement
+func (state *splitState) inside() bool {
+	return state.parenthesesDepth > 0 || state.blockDepth > 0
+}
+
+// keyword tracks the CREATE RULE and CREATE TRIGGER statements, the PostgreSQL rule actions
+// are enclosed in the parentheses and the SQLite trigger body is a BEGIN ... END block,
+// both have the semicolons after every statement. The MySQL trigger body isn't tracked,
+// its END closes the IF, CASE, LOOP, WHILE and REPEAT blocks too.
+// It returns false if a statement which can't be the rule action starts inside the parentheses,
+// the parentheses aren't closed then.
+func (state *splitState) keyword(sqlDialect dialect.SqlDialect, keyword string) bool {
+	first := !state.started
+	state.started = true
+	previous := state.previous
+	state.previous = keyword
+	if state.actionStart {
+		state.actionStart = false
+		if !ruleActions[keyword] {
+			return false
+		}
+	}
+	switch keyword {
+	case "create":
+		state.create = first
+	case "rule":
+		state.rule = state.create && sqlDialect == dialect.PSQL && (previous == "create" || previous == "replace")
+	case "do":
+		state.actions = state.actions || state.rule
+	case "trigger":
+		state.trigger = state.trigger || state.create && sqlDialect == dialect.SQLITE3
+	case "begin", "case":
+		if state.trigger {
+			state.blockDepth++
+		}
+	case "end":
+		if state.trigger && state.blockDepth > 0 {
+			state.blockDepth--
+		}
+	}
+	return true
+}
+
+// delimiterCommand reads the argument of the MySQL client DELIMITER command up to the end of the line,
+// false is returned if the line can be cut by the page boundary
+func delimiterCommand(_tokenizer tokenizer.Tokenizer, last bool) (string, bool) {
+	start := _tokenizer.GetPos()
+	for ch := _tokenizer.Cur(); ch != '\n' && ch != tokenizer.EofChar; ch = _tokenizer.Cur() {
+		_tokenizer.Skip(1)
+	}
+	if !last && _tokenizer.Cur() == tokenizer.EofChar {
+		return "", false
+	}
+	fields := strings.Fields(_tokenizer.GetText(start))
+	if len(fields) == 0 {
+		return "", true
+	}
+	return fields[0], true
+}
+
+// isCommandStart returns true if only the blanks and the comments precede the token in the statement
+func isCommandStart(_tokenizer tokenizer.Tokenizer, stmtBegin int, tokenBegin int) bool {
+	return strings.TrimSpace(ast.StripLeadingComments(_tokenizer.GetText(stmtBegin)[:tokenBegin-stmtBegin])) == ""
+}
+
+// statementText blanks the DELIMITER commands before the statement and replaces the custom delimiter
+// at the end of the statement by the semicolon, the length and the lines of the text are kept
+func statementText(rawSql string, commandBegin int, commandEnd int, delimiter string) string {
+	if commandEnd <= 0 && delimiter == ";" {
+		return rawSql
+	}
+	text := []byte(rawSql)
+	for i := max(commandBegin, 0); i < commandEnd && i < len(text); i++ {
+		if text[i] != '\n' && text[i] != '\r' {
+			text[i] = ' '
+		}
+	}
+	if delimiter != ";" && strings.HasSuffix(rawSql, delimiter) {
+		end := len(text) - len(delimiter)
+		text[end] = ';'
+		for i := end + 1; i < len(text); i++ {
+			text[i] = ' '
+		}
+	}
+	return string(text)
+}
+
 // Process text and return position for nextStatement
-// If no valid statements the second parameter return false
-func processText(_tokenizer tokenizer.Tokenizer, processor StatementProcessor) (int, bool) {
+// If no valid statements the second parameter return false.
+// The semicolons inside the parentheses (like CREATE RULE ... DO (action; action)) and inside
+// the trigger body (like CREATE TRIGGER ... BEGIN statement; statement; END) don't split the statement,
+// the split state is kept between the calls while the statement is not finished.
+// The rule with the unclosed parentheses is reported with ErrIncompleteStatement up to its first
+// semicolon when a statement which can't be the rule action or the end of the text is reached.
+// The MySQL client DELIMITER command at the beginning of the statement changes the delimiter
+// of the next statements (like the ;; of the mysqldump triggers and routines), the command isn't
+// passed to the processor, it is blanked in the text of the next statement.
+// The token at the end of the text can be cut by the page boundary (like a long string),
+// it is scanned again with the next page unless the text is the last one or the token is the complete
+// end of the statement (like the end of the COPY data).
+func processText(_tokenizer tokenizer.Tokenizer, parseMode ParseMode, processor StatementProcessor, state *splitState, last bool) (int, bool) {
 	var tkn int
+	var value string
+	delimiterTokenizer, _ := _tokenizer.(tokenizer.DelimiterTokenizer)
 	stmtBegin := 0
 	statementIsEmpty := _tokenizer.GetPos() == 0
+	// unclosed reports the rule with the unclosed parentheses and continues after its first action
+	unclosed := func() {
+		if state.actionsEnd > 0 {
+			_tokenizer.Reset()
+			_tokenizer.Skip(state.actionsEnd - _tokenizer.GetPos())
+		}
+		rawSql := _tokenizer.GetText(stmtBegin)
+		processor(rawSql, nil, fmt.Errorf("%w: the parentheses of the rule actions aren't closed", ErrIncompleteStatement))
+		statementIsEmpty = true
+		*state = splitState{}
+		stmtBegin = _tokenizer.GetPos()
+	}
 	for {
-		tkn, _ = _tokenizer.Scan()
+		tokenBegin := _tokenizer.GetPos()
+		tkn, value = _tokenizer.Scan()
+		if !last && tkn != 0 && tkn != tokenizer.EofChar && tkn != ';' && _tokenizer.Cur() == tokenizer.EofChar {
+			_tokenizer.Reset()
+			_tokenizer.Skip(tokenBegin - _tokenizer.GetPos())
+			return stmtBegin, stmtBegin > 0
+		}
 		switch tkn {
+		case '(':
+			if state.actions {
+				state.parenthesesDepth++
+			}
+			state.actionStart = false
+			statementIsEmpty = false
+		case ')':
+			if state.parenthesesDepth > 0 {
+				state.parenthesesDepth--
+			}
+			state.actionStart = false
+			statementIsEmpty = false
 		case ';':
+			if state.inside() {
+				if state.parenthesesDepth > 0 {
+					if state.actionsEnd == 0 {
+						state.actionsEnd = _tokenizer.GetPos()
+					}
+					state.actionStart = true
+				}
+				continue
+			}
 			if !statementIsEmpty {
 				rawSql := _tokenizer.GetText(stmtBegin)
-				stmt, err := Parse(rawSql, _tokenizer.GetDialect())
-				processor(rawSql, stmt, err)
+				if delimiterTokenizer != nil {
+					rawSql = statementText(rawSql, state.commandBegin-stmtBegin, state.commandEnd-stmtBegin, delimiterTokenizer.GetDelimiter())
+				}
+				if parseMode.NeedParse(rawSql) {
+					stmt, err := Parse(rawSql, _tokenizer.GetDialect())
+					processor(rawSql, stmt, err)
+				} else {
+					processor(rawSql, nil, nil)
+				}
 				statementIsEmpty = true
 			}
+			*state = splitState{}
 			stmtBegin = _tokenizer.GetPos()
 		case 0, tokenizer.EofChar:
+			if last && state.parenthesesDepth > 0 {
+				unclosed()
+				continue
+			}
 			return stmtBegin, stmtBegin > 0
 		default:
+			if delimiterTokenizer != nil && tkn == _tokenizer.GetIdToken() && strings.EqualFold(value, "delimiter") &&
+				isCommandStart(_tokenizer, max(stmtBegin, state.commandEnd), tokenBegin) {
+				delimiter, ok := delimiterCommand(_tokenizer, last)
+				if !ok {
+					_tokenizer.Reset()
+					_tokenizer.Skip(tokenBegin - _tokenizer.GetPos())
+					return stmtBegin, stmtBegin > 0
+				}
+				if delimiter != "" {
+					delimiterTokenizer.SetDelimiter(delimiter)
+				}
+				if state.commandEnd == 0 {
+					state.commandBegin = tokenBegin
+				}
+				state.commandEnd = _tokenizer.GetPos()
+				continue
+			}
+			if keyword := _tokenizer.GetKeywordString(tkn); keyword != "" {
+				if !state.keyword(_tokenizer.GetDialect(), keyword) {
+					unclosed()
+					continue
+				}
+			} else {
+				state.actionStart = false
+			}
 			statementIsEmpty = false
 		}
 	}
@@ -47,6 +257,13 @@ func processText(_tokenizer tokenizer.Tokenizer, processor StatementProcessor) (
 
 // StatementStream split input stream into statements and call processor for every statement
 func StatementStream(blob io.Reader, sqlDialect dialect.SqlDialect, processor StatementProcessor) error {
+	return StatementStreamWithMode(blob, sqlDialect, PARSE_ALL, processor)
+}
+
+// StatementStreamWithMode split input stream into statements and call processor for every statement.
+// Statements skipped by the parse mode are passed to the processor with nil statement and nil error,
+// the caller can parse them later on demand with the Parse function.
+func StatementStreamWithMode(blob io.Reader, sqlDialect dialect.SqlDialect, parseMode ParseMode, processor StatementProcessor) error {
 	if blob == nil {
 		return fmt.Errorf("blob undefined (nil)")
 	}
@@ -58,18 +275,21 @@ func StatementStream(blob io.Reader, sqlDialect dialect.SqlDialect, processor St
 		return fmt.Errorf("can't initialize a buffered tokenizer, error is %v", err)
 	}
 
+	state := splitState{}
 	for {
 		n, err := blob.Read(page)
 		if n < PAGE_SIZE || err == io.EOF {
 			statementBuffer.Write(page[:n])
-			processText(_tokenizer, processor)
+			processText(_tokenizer, parseMode, processor, &state, true)
 			return nil
 		}
 		statementBuffer.Write(page)
-		nextStmtPos, ok := processText(_tokenizer, processor)
+		nextStmtPos, ok := processText(_tokenizer, parseMode, processor, &state, false)
 		if ok {
 			// Reset do statementBuffer.ClipFrom(nextStmtPos)
 			_tokenizer.ResetTo(nextStmtPos)
+			// The unfinished statement is scanned again from the beginning
+			state = splitState{}
 		}
 	}
 }
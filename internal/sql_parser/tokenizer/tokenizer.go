@@ -23,8 +23,18 @@ import (
 
 const (
 	EofChar = 0x100
+	// SemicolonChar is the semicolon inside the statement ended with the custom delimiter
+	SemicolonChar = 0x101
 )
 
+// DelimiterTokenizer is implemented by the tokenizers of the dialects with the client DELIMITER
+// command (MySQL). The custom delimiter is scanned as ';' and the semicolon as SemicolonChar,
+// the empty delimiter restores the semicolon.
+type DelimiterTokenizer interface {
+	SetDelimiter(delimiter string)
+	GetDelimiter() string
+}
+
 // Tokenizer is the interface used to generate SQL
 // tokens for the parser.
 type Tokenizer interface {
@@ -0,0 +1,103 @@
+package sql_parser
+
+import (
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// UserTypes keeps the user-defined types (CREATE TYPE, CREATE DOMAIN) met in the dump
+// to resolve the column types which reference them
+type UserTypes struct {
+	types map[string]ast.Statement
+}
+
+// NewUserTypes creates an empty set of the user-defined types
+func NewUserTypes() *UserTypes {
+	return &UserTypes{
+		types: make(map[string]ast.Statement, 10),
+	}
+}
+
+// Register remembers the type if the statement is CREATE TYPE or CREATE DOMAIN
+func (userTypes *UserTypes) Register(statement ast.Statement) bool {
+	var name ast.TableName
+	switch node := statement.(type) {
+	case *ast.CreateType:
+		name = node.Name
+	case *ast.CreateDomain:
+		name = node.Name
+	default:
+		return false
+	}
+	if !name.Qualifier.IsEmpty() {
+		userTypes.types[name.Qualifier.String()+"."+name.Name.String()] = statement
+	}
+	// The unqualified name is resolved by the first registered type (like the search path does)
+	if _, ok := userTypes.types[name.Name.String()]; !ok {
+		userTypes.types[name.Name.String()] = statement
+	}
+	return true
+}
+
+// Lookup returns CREATE TYPE or CREATE DOMAIN statement for the type name or nil
+func (userTypes *UserTypes) Lookup(typeName string) ast.Statement {
+	if statement, ok := userTypes.types[typeName]; ok {
+		return statement
+	}
+	if dot := strings.LastIndexByte(typeName, '.'); dot >= 0 {
+		return userTypes.types[typeName[dot+1:]]
+	}
+	return nil
+}
+
+// Resolve returns the definition of the user-defined type: the enum labels,
+// the composite attributes, the range subtype or the domain base type.
+// The second result is false if the type is not user-defined.
+func (userTypes *UserTypes) Resolve(typeName string) (string, bool) {
+	return userTypes.resolve(typeName, make(map[string]bool, 1))
+}
+
+func (userTypes *UserTypes) resolve(typeName string, visited map[string]bool) (string, bool) {
+	if visited[typeName] {
+		return "", false
+	}
+	visited[typeName] = true
+
+	switch node := userTypes.Lookup(typeName).(type) {
+	case *ast.CreateType:
+		text := strings.Builder{}
+		text.WriteString(node.Kind)
+		switch node.Kind {
+		case ast.TypeEnumStr:
+			text.WriteRune('(')
+			text.WriteString(strings.Join(node.Labels, ", "))
+			text.WriteRune(')')
+		case ast.TypeCompositeStr:
+			text.WriteRune('(')
+			for i, attribute := range node.Attributes {
+				if i != 0 {
+					text.WriteString(", ")
+				}
+				text.WriteString(ast.String(attribute))
+			}
+			text.WriteRune(')')
+		case ast.TypeRangeStr:
+			for _, parameter := range node.Parameters {
+				if strings.EqualFold(parameter.Name, "subtype") {
+					text.WriteRune('(')
+					text.WriteString(parameter.Value)
+					text.WriteRune(')')
+				}
+			}
+		}
+		return text.String(), true
+	case *ast.CreateDomain:
+		// A domain can be based on the other user-defined type
+		if baseType, ok := userTypes.resolve(node.Type.Type, visited); ok {
+			return baseType, true
+		}
+		return ast.String(node.Type), true
+	}
+	return "", false
+}
@@ -80,6 +80,18 @@ const (
 	// server not available
 	ServerNotAvailable
 
+	// already exists (database errors)
+	DupEntry
+	TableExists
+
+	// failed precondition (database errors)
+	ForeignKeyViolation
+
+	// aborted, the statement can succeed if it is executed again
+	Deadlock
+	LockWaitTimeout
+	SerializationFailure
+
 	// No state should be added below NumOfStates
 	NumOfStates
 )
@@ -87,6 +87,7 @@ limitations under the License.
 package sql_parser_errors
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
@@ -130,6 +131,28 @@ func NewErrorf(code int32, state State, format string, args ...any) error {
 	}
 }
 
+// WithCode annotates err with the error code and state, the message of err is kept.
+// The annotated error is unwrapped to err, so errors.Is and errors.As see err,
+// and Code and ErrState find the code and the state through the fmt.Errorf wrapping.
+// If err is nil, WithCode returns nil.
+func WithCode(err error, code int32, state State) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{cause: err, code: code, state: state}
+}
+
+// codedError is the error of the other package annotated with the code and the state
+type codedError struct {
+	cause error
+	code  int32
+	state State
+}
+
+func (c *codedError) Error() string { return c.cause.Error() }
+func (c *codedError) Cause() error  { return c.cause }
+func (c *codedError) Unwrap() error { return c.cause }
+
 // fundamentalError is an error that has a message and a stack, but no caller.
 type fundamentalError struct {
 	msg   string
@@ -149,6 +172,10 @@ func Code(err error) int32 {
 	if err, ok := err.(*fundamentalError); ok {
 		return err.code
 	}
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
 
 	cause := Cause(err)
 	if cause != err && cause != nil {
@@ -175,6 +202,10 @@ func ErrState(err error) State {
 	if err, ok := err.(*fundamentalError); ok {
 		return err.state
 	}
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.state
+	}
 
 	cause := Cause(err)
 	if cause != err && cause != nil {
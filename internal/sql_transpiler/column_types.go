@@ -0,0 +1,325 @@
+package sql_transpiler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+const (
+	// MYSQL_MAX_VARCHAR_LENGTH is the maximal length of the utf8mb4 VARCHAR column of MySQL
+	MYSQL_MAX_VARCHAR_LENGTH = 16383
+	// MYSQL_KEY_PREFIX_LENGTH is the prefix length of the TEXT and BLOB columns in the MySQL keys
+	MYSQL_KEY_PREFIX_LENGTH = "255"
+)
+
+// psqlTypeAliases maps the PostgreSQL type aliases to the type names
+var psqlTypeAliases = map[string]string{
+	"int":         "integer",
+	"int4":        "integer",
+	"int2":        "smallint",
+	"int8":        "bigint",
+	"serial4":     "serial",
+	"serial2":     "smallserial",
+	"serial8":     "bigserial",
+	"float4":      "real",
+	"float8":      "double precision",
+	"float":       "double precision",
+	"bool":        "boolean",
+	"varchar":     "character varying",
+	"char":        "character",
+	"bpchar":      "character",
+	"decimal":     "numeric",
+	"timestamptz": "timestamp",
+	"timetz":      "time",
+	"varbit":      "bit varying",
+}
+
+// psqlTypeName returns the PostgreSQL type name without the pg_catalog schema and the aliases
+func psqlTypeName(columnType *ast.ColumnType) string {
+	name := strings.TrimPrefix(strings.ToLower(columnType.Type), "pg_catalog.")
+	if alias, ok := psqlTypeAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// isSerialType returns true for the PostgreSQL serial pseudo-types
+func isSerialType(name string) bool {
+	switch name {
+	case "serial", "smallserial", "bigserial":
+		return true
+	}
+	return false
+}
+
+// isObjectIdentifierType returns true for the PostgreSQL object identifier types (regclass, oid ...)
+func isObjectIdentifierType(name string) bool {
+	return name == "oid" || strings.HasPrefix(name, "reg")
+}
+
+// isMysqlTextType returns true for the MySQL types that need the prefix length in the keys
+func isMysqlTextType(columnType *ast.ColumnType) bool {
+	switch strings.ToLower(columnType.Type) {
+	case "text", "longtext", "mediumtext", "tinytext", "blob", "longblob", "mediumblob", "tinyblob":
+		return true
+	}
+	return false
+}
+
+// columnType maps the PostgreSQL column type to the type of the target dialect,
+// the options of the column are kept
+func (transpiler *Transpiler) columnType(columnType *ast.ColumnType) {
+	name := psqlTypeName(columnType)
+	labels, isEnum := transpiler.enums[catalogName(typeTableName(columnType.Type))]
+	if len(columnType.ArrayBounds) > 0 {
+		// The arrays are kept as the text literals ('{1,2,3}')
+		name, isEnum = "array", false
+	}
+	mapped := ast.ColumnType{Options: columnType.Options}
+	if columnType.Options != nil {
+		columnType.Options.Collate = ""
+	}
+
+	switch transpiler.to {
+	case dialect.SQLITE3:
+		mapped.Type, mapped.Length, mapped.Scale = sqliteType(name, columnType)
+	case dialect.MYSQL:
+		if isEnum {
+			mapped.Type, mapped.EnumValues = "enum", labels
+		} else {
+			mapped.Type, mapped.Length, mapped.Scale = mysqlType(name, columnType)
+		}
+	default:
+		return
+	}
+	*columnType = mapped
+}
+
+// typeTableName parses the (qualified) name of the user type
+func typeTableName(typeName string) ast.TableName {
+	if dot := strings.LastIndexByte(typeName, '.'); dot >= 0 {
+		return ast.TableName{Qualifier: ast.NewTableIdent(typeName[:dot]), Name: ast.NewTableIdent(typeName[dot+1:])}
+	}
+	return ast.TableName{Name: ast.NewTableIdent(typeName)}
+}
+
+// sqliteType returns the SQLite type, the names are chosen by the type affinity rules
+func sqliteType(name string, columnType *ast.ColumnType) (string, *ast.Literal, *ast.Literal) {
+	switch name {
+	case "smallint", "integer", "bigint", "serial", "smallserial", "bigserial":
+		return "integer", nil, nil
+	case "real", "double precision":
+		return "real", nil, nil
+	case "numeric":
+		return "numeric", columnType.Length, columnType.Scale
+	case "money":
+		return "numeric", nil, nil
+	case "boolean":
+		return "boolean", nil, nil
+	case "character varying":
+		return "varchar", columnType.Length, nil
+	case "character":
+		return "char", columnType.Length, nil
+	case "bytea":
+		return "blob", nil, nil
+	case "timestamp", "date", "time":
+		return name, nil, nil
+	}
+	return "text", nil, nil
+}
+
+// mysqlType returns the MySQL type, the values of the PostgreSQL type must fit the type
+func mysqlType(name string, columnType *ast.ColumnType) (string, *ast.Literal, *ast.Literal) {
+	switch name {
+	case "smallint", "smallserial":
+		return "smallint", nil, nil
+	case "integer", "serial":
+		return "int", nil, nil
+	case "bigint", "bigserial":
+		return "bigint", nil, nil
+	case "real":
+		return "float", nil, nil
+	case "double precision":
+		return "double", nil, nil
+	case "numeric":
+		if columnType.Length == nil {
+			return "decimal", ast.NewIntLiteral("65"), ast.NewIntLiteral("30")
+		}
+		return "decimal", columnType.Length, columnType.Scale
+	case "money":
+		return "decimal", ast.NewIntLiteral("19"), ast.NewIntLiteral("2")
+	case "boolean":
+		return "boolean", nil, nil
+	case "character varying":
+		if columnType.Length == nil || literalInt(columnType.Length) > MYSQL_MAX_VARCHAR_LENGTH {
+			return "longtext", nil, nil
+		}
+		return "varchar", columnType.Length, nil
+	case "character":
+		if columnType.Length == nil {
+			return "char", ast.NewIntLiteral("1"), nil
+		}
+		if literalInt(columnType.Length) > 255 {
+			return "varchar", columnType.Length, nil
+		}
+		return "char", columnType.Length, nil
+	case "bytea":
+		return "longblob", nil, nil
+	case "timestamp":
+		// PostgreSQL keeps the microseconds by default
+		return "datetime", fractionalSeconds(columnType), nil
+	case "time":
+		return "time", fractionalSeconds(columnType), nil
+	case "date":
+		return "date", nil, nil
+	case "json", "jsonb":
+		return "json", nil, nil
+	case "uuid":
+		return "char", ast.NewIntLiteral("36"), nil
+	case "inet", "cidr":
+		return "varchar", ast.NewIntLiteral("43"), nil
+	case "macaddr", "macaddr8":
+		return "varchar", ast.NewIntLiteral("23"), nil
+	}
+	return "longtext", nil, nil
+}
+
+// fractionalSeconds returns the precision of the time types, PostgreSQL keeps the microseconds by default
+func fractionalSeconds(columnType *ast.ColumnType) *ast.Literal {
+	if columnType.Length != nil {
+		return columnType.Length
+	}
+	return ast.NewIntLiteral("6")
+}
+
+// literalInt returns the value of the integer literal or zero
+func literalInt(literal *ast.Literal) int {
+	value, _ := strconv.Atoi(literal.Val)
+	return value
+}
+
+// castType maps the type of the PostgreSQL cast to the cast type of the target dialect
+func (transpiler *Transpiler) castType(columnType *ast.ColumnType) *ast.ColumnType {
+	mapped := *columnType
+	mapped.Options = nil
+	transpiler.columnType(&mapped)
+	switch transpiler.to {
+	case dialect.SQLITE3:
+		// CAST of SQLite converts by the type affinity, the datetime values are kept as text
+		mapped.Length, mapped.Scale = nil, nil
+		switch mapped.Type {
+		case "varchar", "char", "timestamp", "date", "time":
+			mapped.Type = "text"
+		case "boolean":
+			mapped.Type = "integer"
+		}
+	case dialect.MYSQL:
+		switch mapped.Type {
+		case "smallint", "int", "bigint", "boolean":
+			return &ast.ColumnType{Type: "signed"}
+		case "varchar", "longtext", "enum":
+			return &ast.ColumnType{Type: "char"}
+		case "longblob":
+			return &ast.ColumnType{Type: "binary"}
+		}
+	}
+	return &mapped
+}
+
+// psqlType maps the MySQL column type to the PostgreSQL type in place, the MySQL attributes of the
+// type (unsigned, zerofill, character set and collation) are dropped, the options of the column are kept
+func psqlType(columnType *ast.ColumnType, autoIncrement bool) {
+	name := strings.ToLower(columnType.Type)
+	length, scale := columnType.Length, columnType.Scale
+	unsigned := columnType.Unsigned
+	columnType.Length, columnType.Scale = nil, nil
+	columnType.Unsigned, columnType.Zerofill = false, false
+	columnType.Charset, columnType.EnumValues = ast.ColumnCharset{}, nil
+	if columnType.Options != nil {
+		columnType.Options.Collate = ""
+	}
+
+	switch name {
+	case "bool", "boolean":
+		columnType.Type = "boolean"
+	case "tinyint":
+		if length != nil && literalInt(length) == 1 && !unsigned {
+			// TINYINT(1) is the MySQL boolean
+			columnType.Type = "boolean"
+		} else {
+			columnType.Type = "smallint"
+		}
+	case "smallint":
+		if unsigned {
+			columnType.Type = "integer"
+		} else {
+			columnType.Type = "smallint"
+		}
+	case "mediumint":
+		columnType.Type = "integer"
+	case "int", "integer":
+		if unsigned {
+			columnType.Type = "bigint"
+		} else {
+			columnType.Type = "integer"
+		}
+	case "bigint":
+		if unsigned && !autoIncrement {
+			columnType.Type, columnType.Length = "numeric", ast.NewIntLiteral("20")
+		} else {
+			columnType.Type = "bigint"
+		}
+	case "serial":
+		columnType.Type = "bigint"
+	case "decimal", "dec", "numeric", "fixed":
+		columnType.Type, columnType.Length, columnType.Scale = "numeric", length, scale
+	case "float":
+		if length != nil && scale == nil && literalInt(length) > 24 {
+			columnType.Type = "double precision"
+		} else {
+			columnType.Type = "real"
+		}
+	case "double", "real", "double precision":
+		columnType.Type = "double precision"
+	case "datetime", "timestamp":
+		// The MySQL precision is 0 by default, PostgreSQL keeps the microseconds
+		columnType.Type, columnType.Length = "timestamp", length
+	case "time":
+		columnType.Type, columnType.Length = "time", length
+	case "year":
+		columnType.Type = "smallint"
+	case "char", "nchar":
+		columnType.Type, columnType.Length = "char", length
+	case "varchar", "nvarchar":
+		columnType.Type, columnType.Length = "varchar", length
+	case "tinytext", "text", "mediumtext", "longtext", "set":
+		columnType.Type = "text"
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob",
+		"geometry", "point", "linestring", "polygon", "multipoint", "multilinestring", "multipolygon", "geometrycollection":
+		// The spatial values are dumped as WKB
+		columnType.Type = "bytea"
+	case "bit":
+		if length == nil || literalInt(length) == 1 {
+			columnType.Type = "boolean"
+		} else {
+			columnType.Type, columnType.Length = "bit varying", length
+		}
+	case "json":
+		columnType.Type = "jsonb"
+	default:
+		columnType.Type, columnType.Length, columnType.Scale = name, length, scale
+	}
+}
+
+// isPsqlDateType returns true for the PostgreSQL types of the MySQL dates, the zero dates of MySQL
+// are not valid in the types
+func isPsqlDateType(columnType *ast.ColumnType) bool {
+	switch columnType.Type {
+	case "timestamp", "date":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package sql_transpiler
+
+import (
+	"strings"
+
+	"github.com/usalko/prodl/internal/copy_codec"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+)
+
+// COPY_NULL is the NULL value of the text format of COPY
+const COPY_NULL = `\N`
+
+// copyText makes the COPY ... FROM stdin statement of PostgreSQL with the rows in the text format,
+// the data is terminated by the \. line as in the pg_dump output
+func copyText(table string, columns []string, rows [][]string) string {
+	text := strings.Builder{}
+	text.WriteString("COPY ")
+	text.WriteString(table)
+	if len(columns) > 0 {
+		text.WriteString(" (")
+		text.WriteString(strings.Join(columns, ", "))
+		text.WriteString(")")
+	}
+	text.WriteString(" FROM stdin;\n")
+	for _, row := range rows {
+		text.WriteString(strings.Join(row, "\t"))
+		text.WriteByte('\n')
+	}
+	text.WriteString(copy_codec.END_OF_DATA)
+	return text.String()
+}
+
+// copyTextValue escapes the backslashes and the control characters of the value for the text format of COPY
+func copyTextValue(value string) string {
+	return copy_codec.EncodeTextValue(value, '\t')
+}
+
+// copyData returns the data of the COPY ... FROM stdin statement text, the data starts on the line
+// following the semicolon of the statement and ends with the end data mark
+func copyData(statementText string) (string, bool) {
+	text := ast.StripLeadingComments(statementText)
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		switch ch := text[i]; {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == ';':
+			newLine := strings.IndexByte(text[i:], '\n')
+			if newLine < 0 {
+				return "", false
+			}
+			return text[i+newLine+1:], true
+		}
+	}
+	return "", false
+}
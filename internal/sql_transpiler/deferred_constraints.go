@@ -0,0 +1,256 @@
+package sql_transpiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+// DeferredConstraint is the secondary index or the foreign key stripped from CREATE TABLE
+// or ALTER TABLE in the deferred constraints mode, it is applied after the data of the dump
+type DeferredConstraint struct {
+	Name       string // Name of the index or the constraint
+	Table      string
+	ForeignKey bool
+	Statement  ast.Statement // CREATE INDEX or ALTER TABLE of the target dialect
+	Text       string        // Text of the statement in the target dialect
+}
+
+func (constraint DeferredConstraint) String() string {
+	kind := "index"
+	if constraint.ForeignKey {
+		kind = "foreign key"
+	}
+	return fmt.Sprintf("%v %v of %v", kind, constraint.Name, constraint.Table)
+}
+
+// SetDeferConstraints enables the deferred constraints mode: the secondary indexes and the foreign
+// keys of CREATE TABLE and ALTER TABLE are stripped from the statements and returned by DeferredConstraints.
+// The primary keys and the checks are kept, so are the keys of the MySQL AUTO_INCREMENT columns
+// and the foreign keys of SQLite (SQLite can't add the constraint to the existing table).
+func (transpiler *Transpiler) SetDeferConstraints(enabled bool) {
+	transpiler.deferConstraints = enabled
+}
+
+// DeferredConstraints returns the constraints stripped from the statements transpiled since
+// the previous call, the constraints are returned once
+func (transpiler *Transpiler) DeferredConstraints() []DeferredConstraint {
+	result := transpiler.constraints
+	transpiler.constraints = nil
+	return result
+}
+
+// deferIdentity strips the constraints of the statement executed as is, the statement text is kept
+// if nothing is stripped, the text of the ALTER TABLE deferred as a whole becomes the deferred statement
+func (transpiler *Transpiler) deferIdentity(statementText string, statement ast.Statement) []string {
+	if ddl, ok := statement.(ast.DDLStatement); !ok || !ddl.IsFullyParsed() {
+		return []string{statementText}
+	}
+	count := len(transpiler.constraints)
+	statements := transpiler.deferStatements(statement, []ast.Statement{statement})
+	switch {
+	case len(transpiler.constraints) == count:
+		return []string{statementText}
+	case len(statements) == 0 && len(transpiler.constraints) == count+1:
+		transpiler.constraints[count].Text = statementText
+	}
+	return transpiler.format(statements)
+}
+
+// deferStatements strips the constraints of the statements transpiled from CREATE TABLE or ALTER TABLE,
+// CREATE INDEX made of the keys is deferred as a whole, ALTER TABLE without options is dropped
+func (transpiler *Transpiler) deferStatements(source ast.Statement, statements []ast.Statement) []ast.Statement {
+	switch source.(type) {
+	case *ast.CreateTable, *ast.AlterTable:
+	default:
+		return statements
+	}
+	result := make([]ast.Statement, 0, len(statements))
+	for _, statement := range statements {
+		switch node := statement.(type) {
+		case *ast.CreateTable:
+			transpiler.deferTableConstraints(node)
+		case *ast.AlterTable:
+			if !transpiler.deferAlterOptions(node) {
+				continue
+			}
+		case *ast.CreateIndex:
+			transpiler.constraints = append(transpiler.constraints, DeferredConstraint{
+				Name:      node.Name.String(),
+				Table:     node.Table.Name.String(),
+				Statement: node,
+				Text:      ast.DialectString(node, transpiler.to),
+			})
+			continue
+		}
+		result = append(result, statement)
+	}
+	return result
+}
+
+// deferTableConstraints strips the secondary keys and the foreign keys of the table and its columns
+func (transpiler *Transpiler) deferTableConstraints(node *ast.CreateTable) {
+	spec := node.TableSpec
+	if spec == nil {
+		return
+	}
+	table := node.Table
+
+	indexes := make([]*ast.IndexDefinition, 0, len(spec.Indexes))
+	for _, index := range spec.Indexes {
+		if index.Info.Primary || index.Info.Spatial || len(index.Columns) == 0 || isAutoIncrement(tableColumn(spec, index.Columns[0].Column)) {
+			indexes = append(indexes, index)
+			continue
+		}
+		transpiler.deferIndex(table, index)
+	}
+	spec.Indexes = indexes
+
+	for _, column := range spec.Columns {
+		options := column.Type.Options
+		if options == nil {
+			continue
+		}
+		switch options.KeyOpt {
+		case ast.ColKeyUnique, ast.ColKeyUniqueKey, ast.ColKey:
+			if isAutoIncrement(column) {
+				break
+			}
+			info := &ast.IndexInfo{Type: "key"}
+			if options.KeyOpt != ast.ColKey {
+				info.Type, info.Unique = "unique key", true
+			}
+			options.KeyOpt = ast.ColKeyNone
+			transpiler.deferIndex(table, &ast.IndexDefinition{Info: info, Columns: []*ast.IndexColumn{{Column: column.Name}}})
+		}
+		// MySQL ignores the foreign keys of the columns, SQLite can't add them later
+		if options.Reference != nil && transpiler.to == dialect.PSQL {
+			transpiler.deferForeignKey(table, &ast.ConstraintDefinition{Details: &ast.ForeignKeyDefinition{
+				Source:              ast.Columns{column.Name},
+				ReferenceDefinition: options.Reference,
+			}})
+			options.Reference = nil
+		}
+	}
+
+	if transpiler.to == dialect.SQLITE3 {
+		return
+	}
+	constraints := make([]*ast.ConstraintDefinition, 0, len(spec.Constraints))
+	for _, constraint := range spec.Constraints {
+		if _, ok := constraint.Details.(*ast.ForeignKeyDefinition); ok {
+			transpiler.deferForeignKey(table, constraint)
+			continue
+		}
+		constraints = append(constraints, constraint)
+	}
+	spec.Constraints = constraints
+}
+
+// deferAlterOptions strips the secondary keys and the foreign keys added by ALTER TABLE,
+// false is returned if no options are left
+func (transpiler *Transpiler) deferAlterOptions(node *ast.AlterTable) bool {
+	if len(node.AlterOptions) == 0 {
+		return true
+	}
+	alterOptions := make([]ast.AlterOption, 0, len(node.AlterOptions))
+	for _, alterOption := range node.AlterOptions {
+		switch option := alterOption.(type) {
+		case *ast.AddIndexDefinition:
+			index := option.IndexDefinition
+			if !index.Info.Primary && !index.Info.Spatial && len(index.Columns) > 0 &&
+				!isAutoIncrement(transpiler.column(node.Table, index.Columns[0].Column)) {
+				transpiler.deferIndex(node.Table, index)
+				continue
+			}
+		case *ast.AddConstraintDefinition:
+			if _, ok := option.ConstraintDefinition.Details.(*ast.ForeignKeyDefinition); ok && transpiler.to != dialect.SQLITE3 {
+				transpiler.deferForeignKey(node.Table, option.ConstraintDefinition)
+				continue
+			}
+		}
+		alterOptions = append(alterOptions, alterOption)
+	}
+	node.AlterOptions = alterOptions
+	return len(alterOptions) > 0
+}
+
+// deferIndex queues the statement adding the index in the target dialect: CREATE INDEX for SQLite,
+// ADD CONSTRAINT ... UNIQUE for PostgreSQL, ADD KEY for MySQL. The unnamed index gets
+// the PostgreSQL name <table>_<columns>_key.
+func (transpiler *Transpiler) deferIndex(table ast.TableName, index *ast.IndexDefinition) {
+	name := index.Info.ConstraintName
+	if name.IsEmpty() {
+		name = index.Info.Name
+	}
+	if name.IsEmpty() {
+		columns := make([]string, 0, len(index.Columns))
+		for _, column := range index.Columns {
+			columns = append(columns, column.Column.String())
+		}
+		name = ast.NewColIdent(constraintName(table, columns, "key"))
+	}
+
+	var statement ast.Statement
+	switch {
+	case transpiler.to == dialect.SQLITE3 || (transpiler.to == dialect.PSQL && !index.Info.Unique):
+		index.Info.ConstraintName = name
+		statement = sqliteIndex(table, index)
+	case transpiler.to == dialect.PSQL:
+		statement = &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: &ast.IndexDefinition{
+			Info:    &ast.IndexInfo{Type: "unique", ConstraintName: name, Unique: true},
+			Columns: index.Columns,
+		}}}, FullyParsed: true}
+	default:
+		if index.Info.Name.IsEmpty() && index.Info.ConstraintName.IsEmpty() {
+			index.Info.Name = name
+		}
+		statement = &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: index}}, FullyParsed: true}
+	}
+	transpiler.constraints = append(transpiler.constraints, DeferredConstraint{
+		Name:      name.String(),
+		Table:     table.Name.String(),
+		Statement: statement,
+		Text:      ast.DialectString(statement, transpiler.to),
+	})
+}
+
+// deferForeignKey queues ALTER TABLE ... ADD CONSTRAINT of the foreign key, the unnamed
+// foreign key gets the PostgreSQL name <table>_<columns>_fkey
+func (transpiler *Transpiler) deferForeignKey(table ast.TableName, constraint *ast.ConstraintDefinition) {
+	if constraint.Name.IsEmpty() {
+		columns := []string{}
+		if foreignKey, ok := constraint.Details.(*ast.ForeignKeyDefinition); ok {
+			for _, column := range foreignKey.Source {
+				columns = append(columns, column.String())
+			}
+		}
+		constraint.Name = ast.NewColIdent(constraintName(table, columns, "fkey"))
+	}
+	statement := &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{
+		&ast.AddConstraintDefinition{ConstraintDefinition: constraint},
+	}, FullyParsed: true}
+	transpiler.constraints = append(transpiler.constraints, DeferredConstraint{
+		Name:       constraint.Name.String(),
+		Table:      table.Name.String(),
+		ForeignKey: true,
+		Statement:  statement,
+		Text:       ast.DialectString(statement, transpiler.to),
+	})
+}
+
+// constraintName makes the PostgreSQL name of the constraint <table>_<columns>_<suffix>
+func constraintName(table ast.TableName, columns []string, suffix string) string {
+	name := strings.Join(append(append([]string{table.Name.String()}, columns...), suffix), "_")
+	if len(name) > PSQL_MAX_IDENTIFIER_LENGTH {
+		name = name[:PSQL_MAX_IDENTIFIER_LENGTH]
+	}
+	return name
+}
+
+// isAutoIncrement returns true for the MySQL AUTO_INCREMENT column, MySQL requires the key of the column
+func isAutoIncrement(column *ast.ColumnDefinition) bool {
+	return column != nil && column.Type.Options != nil && column.Type.Options.Autoincrement
+}
@@ -0,0 +1,686 @@
+package sql_transpiler
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// PSQL_MAX_IDENTIFIER_LENGTH is the maximal length of the PostgreSQL identifier (NAMEDATALEN - 1),
+// the longer names are truncated by the server
+const PSQL_MAX_IDENTIFIER_LENGTH = 63
+
+// fromMysql rewrites the MySQL statement for PostgreSQL
+func (transpiler *Transpiler) fromMysql(statement ast.Statement) ([]ast.Statement, error) {
+	switch node := statement.(type) {
+	case *ast.Set, *ast.SetTransaction, *ast.LockTables, *ast.UnlockTables, *ast.Use, *ast.Flush,
+		*ast.CreateDatabase, *ast.AlterDatabase, *ast.DropDatabase, *ast.Begin, *ast.Commit:
+		// Session settings, locks, transactions of the autocommit mode and databases of mysqldump,
+		// the dump is loaded to the database of the connection
+		return nil, nil
+	case *ast.DropFunction:
+		// The routines aren't transpiled, there is nothing to drop
+		return nil, nil
+	case *ast.CallProc, *ast.Load, *ast.AlterView, *ast.RenameTable, *ast.CreateTrigger, *ast.CreateFunction:
+		// The bodies of the MySQL triggers and routines aren't parsed
+		return nil, ErrUnsupportedStatement
+	case *ast.CreateTable:
+		return transpiler.createMysqlTable(node)
+	case *ast.AlterTable:
+		return transpiler.alterMysqlTable(node)
+	case *ast.CreateView:
+		node.Algorithm, node.Definer, node.Security = "", nil, ""
+	case *ast.DropTable:
+		for _, table := range node.FromTables {
+			delete(transpiler.tables, catalogName(table))
+		}
+	}
+	return []ast.Statement{transpiler.rewriteMysql(statement).(ast.Statement)}, nil
+}
+
+// mysqlDrop keeps DROP TABLE IF EXISTS until the next statement: mysqldump drops both the table and the view
+// of the same name before the view is created, but PostgreSQL doesn't drop the view by DROP TABLE.
+// The DROP VIEW IF EXISTS following the DROP TABLE IF EXISTS of the same names is replaced by the DROP
+// of the object kind, it is the table if the table is created by the dump and the view otherwise.
+// The kept DROP TABLE is moved to the dropped statements by the other statement (nil at the end of the dump).
+// The statement to transpile is returned, true is returned if the statement is kept.
+func (transpiler *Transpiler) mysqlDrop(statement ast.Statement) (ast.Statement, bool) {
+	dropTable := transpiler.dropTable
+	transpiler.dropTable = nil
+	if dropView, ok := statement.(*ast.DropView); ok && dropTable != nil && dropView.IfExists && sameTables(dropTable.FromTables, dropView.FromTables) {
+		for _, table := range dropTable.FromTables {
+			if transpiler.tables[catalogName(table)] != nil {
+				return dropTable, false
+			}
+		}
+		return statement, false
+	}
+	if dropTable != nil {
+		statements, _ := transpiler.fromMysql(dropTable)
+		transpiler.dropped = append(transpiler.dropped, statements...)
+	}
+	if drop, ok := statement.(*ast.DropTable); ok && drop.IfExists && !drop.Temp {
+		transpiler.dropTable = drop
+		return statement, true
+	}
+	return statement, false
+}
+
+// sameTables returns true if the lists have the same tables in the same order
+func sameTables(tables ast.TableNames, others ast.TableNames) bool {
+	if len(tables) != len(others) {
+		return false
+	}
+	for i, table := range tables {
+		if catalogName(table) != catalogName(others[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rewriteMysql rewrites the names and the expressions of the node for PostgreSQL: the database
+// qualifiers are removed, the binary strings become the bytea literals
+func (transpiler *Transpiler) rewriteMysql(node ast.SQLNode) ast.SQLNode {
+	return ast.Rewrite(node, nil, func(cursor *ast.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case ast.TableName:
+			if !node.Qualifier.IsEmpty() {
+				cursor.Replace(unqualified(node))
+			}
+		case *ast.Literal:
+			if node.Type == ast.HexNum || node.Type == ast.HexVal {
+				if value, ok := binaryValue(node); ok {
+					cursor.Replace(ast.NewStrLiteral(byteaValue(value)))
+				}
+			}
+		case *ast.IntroducerExpr:
+			if value, ok := binaryValue(node); ok && strings.EqualFold(node.CharacterSet, "_binary") {
+				cursor.Replace(ast.NewStrLiteral(byteaValue(value)))
+			} else {
+				cursor.Replace(node.Expr)
+			}
+		case *ast.FuncExpr:
+			if node.Qualifier.IsEmpty() && node.Name.EqualString("ifnull") {
+				node.Name = ast.NewColIdent("coalesce")
+			}
+		}
+		return true
+	})
+}
+
+// rewriteMysqlExpr rewrites the expression outside of the nodes visited by the rewrite (column options)
+func (transpiler *Transpiler) rewriteMysqlExpr(expr ast.Expr) ast.Expr {
+	if expr == nil {
+		return nil
+	}
+	return transpiler.rewriteMysql(expr).(ast.Expr)
+}
+
+// binaryValue returns the bytes of the MySQL binary literal (0x..., X'...', _binary '...')
+func binaryValue(expr ast.Expr) ([]byte, bool) {
+	switch node := expr.(type) {
+	case *ast.IntroducerExpr:
+		return binaryValue(node.Expr)
+	case *ast.Literal:
+		switch node.Type {
+		case ast.StrVal:
+			return []byte(node.Val), true
+		case ast.HexNum:
+			value := strings.TrimPrefix(strings.TrimPrefix(node.Val, "0x"), "0X")
+			if len(value)%2 != 0 {
+				value = "0" + value
+			}
+			bytes, err := hex.DecodeString(value)
+			return bytes, err == nil
+		case ast.HexVal:
+			bytes, err := hex.DecodeString(node.Val)
+			return bytes, err == nil
+		}
+	}
+	return nil, false
+}
+
+// byteaValue returns the hex format of the PostgreSQL bytea value
+func byteaValue(value []byte) string {
+	return `\x` + hex.EncodeToString(value)
+}
+
+// isZeroDate returns true for the zero dates of MySQL ('0000-00-00', '0000-00-00 00:00:00')
+func isZeroDate(value string) bool {
+	return strings.HasPrefix(value, "0000-00-00") && strings.Trim(value, "0-: .") == ""
+}
+
+// psqlValue converts the MySQL value of the column for PostgreSQL: the integers and the bits become
+// the booleans of the boolean columns, the binary strings become the bytea literals and the zero
+// dates of the date columns become NULL. The column is nil if it is unknown, the value is kept then.
+func psqlValue(expr ast.Expr, column *ast.ColumnDefinition) ast.Expr {
+	if column == nil {
+		return expr
+	}
+	literal, ok := expr.(*ast.Literal)
+	switch {
+	case column.Type.Type == "boolean":
+		if ok {
+			switch literal.Type {
+			case ast.IntVal, ast.StrVal:
+				if value, err := strconv.ParseInt(literal.Val, 10, 64); err == nil {
+					return ast.BoolVal(value != 0)
+				}
+			case ast.BitVal:
+				if value, err := strconv.ParseInt(literal.Val, 2, 64); err == nil {
+					return ast.BoolVal(value != 0)
+				}
+			case ast.HexNum:
+				if bytes, ok := binaryValue(literal); ok {
+					return ast.BoolVal(strings.Trim(string(bytes), "\x00") != "")
+				}
+			}
+		}
+	case column.Type.Type == "bytea":
+		if value, ok := binaryValue(expr); ok {
+			return ast.NewStrLiteral(byteaValue(value))
+		}
+	case isPsqlDateType(&column.Type):
+		if ok && literal.Type == ast.StrVal && isZeroDate(literal.Val) {
+			return &ast.NullVal{}
+		}
+	}
+	return expr
+}
+
+// copyValue returns the value of the text format of COPY for the constant expression,
+// false if the expression isn't constant
+func copyValue(expr ast.Expr) (string, bool) {
+	switch node := expr.(type) {
+	case *ast.NullVal:
+		return COPY_NULL, true
+	case ast.BoolVal:
+		if node {
+			return "t", true
+		}
+		return "f", true
+	case *ast.Literal:
+		switch node.Type {
+		case ast.StrVal, ast.IntVal, ast.FloatVal, ast.DecimalVal, ast.BitVal:
+			return copyTextValue(node.Val), true
+		case ast.HexNum, ast.HexVal:
+			if value, ok := binaryValue(node); ok {
+				return copyTextValue(byteaValue(value)), true
+			}
+		}
+	case *ast.IntroducerExpr:
+		if value, ok := binaryValue(node); ok {
+			if strings.EqualFold(node.CharacterSet, "_binary") {
+				return copyTextValue(byteaValue(value)), true
+			}
+			return copyTextValue(string(value)), true
+		}
+	case *ast.UnaryExpr:
+		if literal, ok := node.Expr.(*ast.Literal); ok && node.Operator == ast.UMinusOp && literal.Type != ast.StrVal {
+			return "-" + literal.Val, true
+		}
+	}
+	return "", false
+}
+
+// unquoteMysqlString decodes the quoted MySQL string ('it\'s', 'it”s')
+func unquoteMysqlString(quoted string) string {
+	if len(quoted) < 2 {
+		return quoted
+	}
+	quote := quoted[0]
+	quoted = quoted[1 : len(quoted)-1]
+	value := strings.Builder{}
+	for i := 0; i < len(quoted); i++ {
+		ch := quoted[i]
+		if ch == '\\' && i+1 < len(quoted) {
+			i++
+			if decoded := sql_types.SQLDecodeMap[quoted[i]]; decoded != sql_types.DontEscape {
+				value.WriteByte(decoded)
+			} else {
+				value.WriteByte(quoted[i])
+			}
+			continue
+		}
+		if ch == quote && i+1 < len(quoted) && quoted[i+1] == quote {
+			i++
+		}
+		value.WriteByte(ch)
+	}
+	return value.String()
+}
+
+// psqlIdentifier returns the name of the PostgreSQL object of the table (index, type),
+// the name is prefixed by the table name because the PostgreSQL names are unique in the schema
+func psqlIdentifier(table ast.TableName, name string) string {
+	tableName := table.Name.String()
+	if !strings.HasPrefix(strings.ToLower(name), strings.ToLower(tableName)+"_") {
+		name = tableName + "_" + name
+	}
+	if len(name) > PSQL_MAX_IDENTIFIER_LENGTH {
+		name = name[:PSQL_MAX_IDENTIFIER_LENGTH]
+	}
+	return name
+}
+
+// createMysqlTable maps the columns and moves the MySQL keys to CREATE INDEX statements,
+// the AUTO_INCREMENT columns become the identity columns starting with the AUTO_INCREMENT
+// of the table, the comments become COMMENT ON statements. The foreign keys are deferred to
+// the end of the dump (mysqldump orders the tables by the name and disables the checks).
+func (transpiler *Transpiler) createMysqlTable(node *ast.CreateTable) ([]ast.Statement, error) {
+	node = transpiler.rewriteMysql(node).(*ast.CreateTable)
+	spec := node.TableSpec
+	if spec == nil {
+		if node.OptLike != nil {
+			node.OptLike.LikeTable = unqualified(node.OptLike.LikeTable)
+		}
+		return []ast.Statement{node}, nil
+	}
+	table := node.Table
+
+	var startWith *int
+	var comment *ast.Literal
+	for _, option := range spec.Options {
+		switch strings.ToUpper(option.Name) {
+		case "AUTO_INCREMENT":
+			if value, err := strconv.Atoi(option.Value.Val); err == nil && value > 1 {
+				startWith = &value
+			}
+		case "COMMENT":
+			comment = option.Value
+		}
+	}
+	spec.Options, spec.PartitionOption = nil, nil
+
+	statements := make([]ast.Statement, 0)
+	after := make([]ast.Statement, 0)
+	if comment != nil {
+		after = append(after, &ast.CommentOn{ObjectType: ast.TableObjectStr, Object: table, Value: comment})
+	}
+	for _, column := range spec.Columns {
+		before, columnAfter := transpiler.mysqlColumn(table, spec, column, startWith)
+		statements = append(statements, before...)
+		after = append(after, columnAfter...)
+	}
+
+	indexes := make([]*ast.IndexDefinition, 0, len(spec.Indexes))
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			index.Info.Name, index.Options = ast.NewColIdent(""), nil
+			for _, indexColumn := range index.Columns {
+				indexColumn.Length = nil
+			}
+			indexes = append(indexes, index)
+		} else if createIndex := transpiler.mysqlIndex(table, index); createIndex != nil {
+			after = append(after, createIndex)
+		}
+	}
+	spec.Indexes = indexes
+
+	constraints := make([]*ast.ConstraintDefinition, 0, len(spec.Constraints))
+	for _, constraint := range spec.Constraints {
+		switch details := constraint.Details.(type) {
+		case *ast.ForeignKeyDefinition:
+			details.ReferenceDefinition.ReferencedTable = unqualified(details.ReferenceDefinition.ReferencedTable)
+			details.IndexName = ast.NewColIdent("")
+			if transpiler.deferConstraints {
+				transpiler.deferForeignKey(table, constraint)
+				continue
+			}
+			transpiler.deferred = append(transpiler.deferred, &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{
+				&ast.AddConstraintDefinition{ConstraintDefinition: constraint},
+			}, FullyParsed: true})
+			continue
+		case *ast.CheckConstraintDefinition:
+			if column := jsonValidColumn(spec, details.Expr); column != nil {
+				// The JSON columns of MariaDB are LONGTEXT with the json_valid check
+				column.Type.Type = "jsonb"
+				continue
+			}
+			details.Expr, details.Enforced = transpiler.rewriteMysqlExpr(details.Expr), true
+		}
+		constraints = append(constraints, constraint)
+	}
+	spec.Constraints = constraints
+
+	transpiler.tables[catalogName(table)] = spec
+	statements = append(statements, node)
+	return append(statements, after...), nil
+}
+
+// jsonValidColumn returns the column of the json_valid(column) check or nil
+func jsonValidColumn(spec *ast.TableSpec, expr ast.Expr) *ast.ColumnDefinition {
+	jsonValid, ok := expr.(*ast.JSONAttributesExpr)
+	if !ok || jsonValid.Type != ast.ValidAttributeType {
+		return nil
+	}
+	colName, ok := jsonValid.JSONDoc.(*ast.ColName)
+	if !ok {
+		return nil
+	}
+	for _, column := range spec.Columns {
+		if column.Name.Equal(colName.Name) {
+			return column
+		}
+	}
+	return nil
+}
+
+// mysqlColumn maps the type and the options of the column, returns the statements to execute
+// before the table (enum types) and after the table (indexes and comments)
+func (transpiler *Transpiler) mysqlColumn(table ast.TableName, spec *ast.TableSpec, column *ast.ColumnDefinition, startWith *int) ([]ast.Statement, []ast.Statement) {
+	before := make([]ast.Statement, 0)
+	after := make([]ast.Statement, 0)
+	options := column.Type.Options
+	if options == nil {
+		options = &ast.ColumnTypeOptions{}
+		column.Type.Options = options
+	}
+
+	if strings.EqualFold(column.Type.Type, "enum") {
+		labels := make([]string, 0, len(column.Type.EnumValues))
+		for _, label := range column.Type.EnumValues {
+			labels = append(labels, unquoteMysqlString(label))
+		}
+		if transpiler.enumMode == ENUM_TYPE {
+			typeName := ast.TableName{Name: ast.NewTableIdent(psqlIdentifier(table, column.Name.String()))}
+			quoted := make([]string, 0, len(labels))
+			for _, label := range labels {
+				quoted = append(quoted, ast.DialectString(ast.NewStrLiteral(label), dialect.PSQL))
+			}
+			before = append(before, &ast.CreateType{Name: typeName, Kind: ast.TypeEnumStr, Labels: quoted})
+			column.Type.Type, column.Type.EnumValues, column.Type.Charset = ast.DialectString(typeName, dialect.PSQL), nil, ast.ColumnCharset{}
+			options.Collate = ""
+		} else {
+			values := make(ast.ValTuple, 0, len(labels))
+			for _, label := range labels {
+				values = append(values, ast.NewStrLiteral(label))
+			}
+			spec.Constraints = append(spec.Constraints, &ast.ConstraintDefinition{
+				Name: ast.NewColIdent(psqlIdentifier(table, column.Name.String()+"_check")),
+				Details: &ast.CheckConstraintDefinition{
+					Expr:     &ast.ComparisonExpr{Operator: ast.InOp, Left: &ast.ColName{Name: column.Name}, Right: values},
+					Enforced: true,
+				},
+			})
+			column.Type.Type = "text"
+			psqlType(&column.Type, false)
+		}
+	} else {
+		psqlType(&column.Type, options.Autoincrement)
+	}
+
+	if options.Autoincrement {
+		options.Autoincrement, options.Identity = false, "by default"
+		if startWith != nil {
+			options.IdentitySequence = &ast.SequenceSpec{StartWith: startWith}
+		}
+	}
+	// The binary values are converted for the column before the rewrite of the literals
+	options.Default = transpiler.rewriteMysqlExpr(psqlValue(options.Default, column))
+	if _, isNull := options.Default.(*ast.NullVal); isNull && options.Null != nil && !*options.Null {
+		// The zero date default of the NOT NULL column, the column takes the zero dates of the rows as NULL
+		options.Default, options.Null = nil, nil
+	}
+	// ON UPDATE needs the trigger in PostgreSQL
+	options.OnUpdate = nil
+	if options.As != nil {
+		// PostgreSQL has the stored generated columns only
+		options.As, options.GeneratedAlways, options.Storage = transpiler.rewriteMysqlExpr(options.As), true, ast.StoredStorage
+	}
+	if options.Reference != nil {
+		options.Reference.ReferencedTable = unqualified(options.Reference.ReferencedTable)
+	}
+	if options.Comment != nil {
+		after = append(after, &ast.CommentOn{ObjectType: ast.ColumnObjectStr, Object: table, Name: column.Name, Value: options.Comment})
+		options.Comment = nil
+	}
+	options.Invisible, options.Format, options.SRID = nil, ast.UnspecifiedFormat, nil
+	options.EngineAttribute, options.SecondaryEngineAttribute = nil, nil
+
+	switch options.KeyOpt {
+	case ast.ColKeyUniqueKey:
+		options.KeyOpt = ast.ColKeyUnique
+	case ast.ColKey, ast.ColKeyFulltextKey:
+		index := &ast.IndexDefinition{
+			Info:    &ast.IndexInfo{Name: column.Name, Fulltext: options.KeyOpt == ast.ColKeyFulltextKey},
+			Columns: []*ast.IndexColumn{{Column: column.Name}},
+		}
+		options.KeyOpt = ast.ColKeyNone
+		after = append(after, transpiler.mysqlIndex(table, index))
+	case ast.ColKeySpatialKey:
+		options.KeyOpt = ast.ColKeyNone
+	}
+	return before, after
+}
+
+// mysqlIndex makes CREATE INDEX of the MySQL key, the full-text keys become the GIN indexes of the
+// text search vectors and the spatial keys are dropped (the spatial values are kept as WKB).
+// The prefix lengths of the key columns are dropped.
+func (transpiler *Transpiler) mysqlIndex(table ast.TableName, index *ast.IndexDefinition) *ast.CreateIndex {
+	if index.Info.Spatial {
+		return nil
+	}
+	name := index.Info.Name
+	if name.IsEmpty() {
+		name = index.Info.ConstraintName
+	}
+	if name.IsEmpty() && len(index.Columns) > 0 {
+		name = index.Columns[0].Column
+	}
+	createIndex := &ast.CreateIndex{
+		Unique:      index.Info.Unique,
+		Name:        ast.NewColIdent(psqlIdentifier(table, name.String())),
+		Table:       table,
+		FullyParsed: true,
+	}
+	if index.Info.Fulltext {
+		createIndex.Method = ast.NewColIdent("gin")
+	}
+	for _, indexColumn := range index.Columns {
+		element := &ast.IndexElement{Column: indexColumn.Column, Expression: transpiler.rewriteMysqlExpr(indexColumn.Expression), Direction: indexColumn.Direction}
+		if index.Info.Fulltext {
+			element.Expression = &ast.FuncExpr{Name: ast.NewColIdent("to_tsvector"), Exprs: ast.SelectExprs{
+				&ast.AliasedExpr{Expr: ast.NewStrLiteral("simple")},
+				&ast.AliasedExpr{Expr: &ast.ColName{Name: indexColumn.Column}},
+			}}
+		}
+		createIndex.Columns = append(createIndex.Columns, element)
+	}
+	return createIndex
+}
+
+// alterMysqlTable rewrites the options of ALTER TABLE, the keys become CREATE INDEX statements and
+// the keys are disabled and enabled by mysqldump around the data (dropped). The options that
+// change or drop the existing columns and keys have another syntax in PostgreSQL and are not supported.
+func (transpiler *Transpiler) alterMysqlTable(node *ast.AlterTable) ([]ast.Statement, error) {
+	node = transpiler.rewriteMysql(node).(*ast.AlterTable)
+	table := node.Table
+	spec := transpiler.tables[catalogName(table)]
+	node.PartitionSpec, node.PartitionOption = nil, nil
+
+	alterOptions := make([]ast.AlterOption, 0, len(node.AlterOptions))
+	before := make([]ast.Statement, 0)
+	after := make([]ast.Statement, 0)
+	for _, alterOption := range node.AlterOptions {
+		switch option := alterOption.(type) {
+		case *ast.KeyState, ast.TableOptions, *ast.Force, ast.AlgorithmValue, *ast.LockOption:
+			continue
+		case *ast.AddIndexDefinition:
+			if option.IndexDefinition.Info.Primary {
+				option.IndexDefinition.Info.Name, option.IndexDefinition.Options = ast.NewColIdent(""), nil
+				for _, indexColumn := range option.IndexDefinition.Columns {
+					indexColumn.Length = nil
+				}
+			} else {
+				if createIndex := transpiler.mysqlIndex(table, option.IndexDefinition); createIndex != nil {
+					after = append(after, createIndex)
+				}
+				continue
+			}
+		case *ast.AddConstraintDefinition:
+			switch details := option.ConstraintDefinition.Details.(type) {
+			case *ast.ForeignKeyDefinition:
+				details.ReferenceDefinition.ReferencedTable = unqualified(details.ReferenceDefinition.ReferencedTable)
+				details.IndexName = ast.NewColIdent("")
+			case *ast.CheckConstraintDefinition:
+				details.Expr, details.Enforced = transpiler.rewriteMysqlExpr(details.Expr), true
+			}
+		case *ast.AddColumns:
+			if option.First || option.After != nil {
+				// PostgreSQL adds the columns to the end of the table
+				option.First, option.After = false, nil
+			}
+			columnsSpec := &ast.TableSpec{}
+			for _, column := range option.Columns {
+				columnBefore, columnAfter := transpiler.mysqlColumn(table, columnsSpec, column, nil)
+				before = append(before, columnBefore...)
+				after = append(after, columnAfter...)
+				if spec != nil {
+					spec.Columns = append(spec.Columns, column)
+				}
+			}
+			alterOptions = append(alterOptions, option)
+			for _, constraint := range columnsSpec.Constraints {
+				alterOptions = append(alterOptions, &ast.AddConstraintDefinition{ConstraintDefinition: constraint})
+			}
+			continue
+		case *ast.DropColumn, *ast.RenameTableName:
+		default:
+			return nil, ErrUnsupportedStatement
+		}
+		alterOptions = append(alterOptions, alterOption)
+	}
+
+	statements := before
+	if len(alterOptions) > 0 {
+		node.AlterOptions = alterOptions
+		statements = append(statements, node)
+	}
+	return append(statements, after...), nil
+}
+
+// mysqlInsert makes COPY of the INSERT with the constant rows split by the batch size, the other
+// INSERT statements keep the VALUES or SELECT, INSERT IGNORE becomes ON CONFLICT DO NOTHING and the ON DUPLICATE KEY
+// UPDATE and REPLACE become ON CONFLICT (primary key) DO UPDATE.
+func (transpiler *Transpiler) mysqlInsert(node *ast.Insert) ([]string, error) {
+	node.Partitions = nil
+	spec := transpiler.tables[catalogName(node.Table)]
+	columns := node.Columns
+	if len(columns) == 0 && spec != nil {
+		for _, column := range spec.Columns {
+			columns = append(columns, column.Name)
+		}
+	}
+	definitions := make([]*ast.ColumnDefinition, len(columns))
+	for i, column := range columns {
+		definitions[i] = transpiler.column(node.Table, column)
+	}
+
+	values, isValues := node.Rows.(ast.Values)
+	if isValues {
+		for _, row := range values {
+			for i := range row {
+				if i < len(definitions) {
+					row[i] = psqlValue(row[i], definitions[i])
+				} else {
+					row[i] = psqlValue(row[i], nil)
+				}
+			}
+		}
+	}
+	if isValues && node.Action == ast.InsertAct && !bool(node.Ignore) && len(node.OnDup) == 0 {
+		if texts, ok := transpiler.copyRows(unqualified(node.Table), node.Columns, values); ok {
+			return texts, nil
+		}
+	}
+	// The binary values are converted for the columns before the rewrite of the literals
+	node = transpiler.rewriteMysql(node).(*ast.Insert)
+
+	onConflict := ""
+	switch {
+	case node.Action == ast.ReplaceAct:
+		conflict, ok := primaryKey(spec)
+		if !ok || len(columns) == 0 {
+			return nil, ErrUnsupportedStatement
+		}
+		updates := make([]string, 0, len(columns))
+		for _, column := range columns {
+			updates = append(updates, ast.DialectString(column, dialect.PSQL)+" = excluded."+ast.DialectString(column, dialect.PSQL))
+		}
+		onConflict = " on conflict (" + conflict + ") do update set " + strings.Join(updates, ", ")
+	case len(node.OnDup) > 0:
+		conflict, ok := primaryKey(spec)
+		if !ok {
+			return nil, ErrUnsupportedStatement
+		}
+		onDup := ast.Rewrite(node.OnDup, nil, func(cursor *ast.Cursor) bool {
+			if valuesFunc, ok := cursor.Node().(*ast.ValuesFuncExpr); ok {
+				cursor.Replace(&ast.ColName{Name: valuesFunc.Name.Name, Qualifier: ast.TableName{Name: ast.NewTableIdent("excluded")}})
+			}
+			return true
+		}).(ast.OnDup)
+		updates := make([]string, 0, len(onDup))
+		for _, update := range onDup {
+			updates = append(updates, ast.DialectString(update.Name.Name, dialect.PSQL)+" = "+ast.DialectString(update.Expr, dialect.PSQL))
+		}
+		onConflict = " on conflict (" + conflict + ") do update set " + strings.Join(updates, ", ")
+	case bool(node.Ignore):
+		onConflict = " on conflict do nothing"
+	}
+	node.Action, node.Ignore, node.OnDup = ast.InsertAct, false, nil
+	return []string{ast.DialectString(node, dialect.PSQL) + onConflict}, nil
+}
+
+// copyRows makes COPY statements of the rows split by the batch size, false if a value isn't constant
+func (transpiler *Transpiler) copyRows(table ast.TableName, columns ast.Columns, values ast.Values) ([]string, bool) {
+	rows := make([][]string, 0, len(values))
+	for _, row := range values {
+		copyRow := make([]string, 0, len(row))
+		for _, expr := range row {
+			value, ok := copyValue(expr)
+			if !ok {
+				return nil, false
+			}
+			copyRow = append(copyRow, value)
+		}
+		rows = append(rows, copyRow)
+	}
+	columnNames := make([]string, 0, len(columns))
+	for _, column := range columns {
+		columnNames = append(columnNames, ast.DialectString(column, dialect.PSQL))
+	}
+	tableName := ast.DialectString(table, dialect.PSQL)
+	texts := make([]string, 0, 1)
+	for _, batch := range transpiler.batches(len(rows)) {
+		texts = append(texts, copyText(tableName, columnNames, rows[batch[0]:batch[1]]))
+	}
+	return texts, true
+}
+
+// primaryKey returns the columns of the primary key of the table for ON CONFLICT
+func primaryKey(spec *ast.TableSpec) (string, bool) {
+	if spec == nil {
+		return "", false
+	}
+	for _, column := range spec.Columns {
+		if column.Type.Options != nil && column.Type.Options.KeyOpt == ast.ColKeyPrimary {
+			return ast.DialectString(column.Name, dialect.PSQL), true
+		}
+	}
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			names := make([]string, 0, len(index.Columns))
+			for _, indexColumn := range index.Columns {
+				names = append(names, ast.DialectString(indexColumn.Column, dialect.PSQL))
+			}
+			return strings.Join(names, ", "), true
+		}
+	}
+	return "", false
+}
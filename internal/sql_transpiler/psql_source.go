@@ -0,0 +1,631 @@
+package sql_transpiler
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/usalko/prodl/internal/copy_codec"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+// fromPsql rewrites the PostgreSQL statement for the target dialect
+func (transpiler *Transpiler) fromPsql(statement ast.Statement) ([]ast.Statement, error) {
+	switch node := statement.(type) {
+	case *ast.Set, *ast.SetTransaction, *ast.CreateSequence, *ast.AlterSequence,
+		*ast.AlterObjectOwner, *ast.CommentOn, *ast.CommentOnSchema, *ast.Grant, *ast.Revoke,
+		*ast.AlterDefaultPrivileges, *ast.CreatePublication, *ast.AlterPublication:
+		// Session settings, sequences, ownership, privileges, comments and publications
+		// don't change the schema and the data in the target
+		return nil, nil
+	case *ast.CreateFunction, *ast.AlterFunction, *ast.CreateTrigger, *ast.CreateExtension, *ast.CreateDomain,
+		*ast.CreateRule, *ast.CreatePolicy, *ast.CreateMaterializedView, *ast.RefreshMaterializedView:
+		return nil, ErrUnsupportedStatement
+	case *ast.Select:
+		if isPsqlCatalogCall(node) {
+			return nil, nil
+		}
+	case *ast.CreateType:
+		return nil, transpiler.createType(node)
+	case *ast.CreateTable:
+		return transpiler.createTable(node)
+	case *ast.AlterTable:
+		return transpiler.alterTable(node)
+	case *ast.CreateIndex:
+		return transpiler.createIndex(node)
+	case *ast.Insert:
+		return append(transpiler.mysqlInsertSerials(node), transpiler.rewrite(statement).(ast.Statement)), nil
+	}
+	return []ast.Statement{transpiler.rewrite(statement).(ast.Statement)}, nil
+}
+
+// isPsqlCatalogCall returns true for the SELECT pg_catalog.set_config(...) and the
+// SELECT pg_catalog.setval(...) of pg_dump, they configure the session and the sequences
+func isPsqlCatalogCall(node *ast.Select) bool {
+	if len(node.SelectExprs) == 0 {
+		return false
+	}
+	for _, selectExpr := range node.SelectExprs {
+		aliased, ok := selectExpr.(*ast.AliasedExpr)
+		if !ok {
+			return false
+		}
+		funcExpr, ok := aliased.Expr.(*ast.FuncExpr)
+		if !ok {
+			return false
+		}
+		if funcExpr.Qualifier.String() != "pg_catalog" ||
+			!funcExpr.Name.EqualString("set_config") && !funcExpr.Name.EqualString("setval") {
+			return false
+		}
+	}
+	return true
+}
+
+// isNextval returns true for the nextval('sequence') default of the serial column
+func isNextval(expr ast.Expr) bool {
+	funcExpr, ok := expr.(*ast.FuncExpr)
+	return ok && funcExpr.Name.EqualString("nextval")
+}
+
+// rewrite rewrites the names and the expressions of the node for the target dialect:
+// the schema qualifiers are removed, the casts and the PostgreSQL functions are replaced
+func (transpiler *Transpiler) rewrite(node ast.SQLNode) ast.SQLNode {
+	return ast.Rewrite(node, nil, func(cursor *ast.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case ast.TableName:
+			if !node.Qualifier.IsEmpty() {
+				cursor.Replace(unqualified(node))
+			}
+		case *ast.TypeCastExpr:
+			cursor.Replace(transpiler.typeCast(node))
+		case *ast.FuncExpr:
+			if node.Qualifier.String() == "pg_catalog" {
+				node.Qualifier = ast.NewTableIdent("")
+			}
+			if transpiler.to == dialect.SQLITE3 && node.Qualifier.IsEmpty() && node.Name.EqualString("now") {
+				cursor.Replace(&ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_timestamp")})
+			}
+		case *ast.CurTimeFuncExpr:
+			if transpiler.to == dialect.SQLITE3 {
+				// SQLite has no fractional seconds precision and the local time functions
+				node.Fsp = nil
+				if node.Name.EqualString("localtimestamp") {
+					node.Name = ast.NewColIdent("current_timestamp")
+				} else if node.Name.EqualString("localtime") {
+					node.Name = ast.NewColIdent("current_time")
+				}
+			}
+		}
+		return true
+	})
+}
+
+// rewriteExpr rewrites the expression outside of the nodes visited by the rewrite (column options)
+func (transpiler *Transpiler) rewriteExpr(expr ast.Expr) ast.Expr {
+	if expr == nil {
+		return nil
+	}
+	return transpiler.rewrite(expr).(ast.Expr)
+}
+
+// typeCast rewrites the PostgreSQL cast expr::type, the casts of the literals are dropped (the
+// value is converted by the column) as well as the object identifier casts ('name'::regclass)
+func (transpiler *Transpiler) typeCast(node *ast.TypeCastExpr) ast.Expr {
+	switch node.Expr.(type) {
+	case *ast.Literal, *ast.NullVal, ast.BoolVal:
+		return node.Expr
+	}
+	if isObjectIdentifierType(psqlTypeName(node.Type)) {
+		return node.Expr
+	}
+	return &ast.TypeCastExpr{Expr: node.Expr, Type: transpiler.castType(node.Type)}
+}
+
+// createType keeps the labels of the enum type, the columns of the type are ENUM in MySQL
+// and TEXT in SQLite
+func (transpiler *Transpiler) createType(node *ast.CreateType) error {
+	if !strings.EqualFold(node.Kind, "enum") {
+		return ErrUnsupportedStatement
+	}
+	transpiler.enums[catalogName(node.Name)] = node.Labels
+	return nil
+}
+
+// createTable maps the columns, the serial columns become INTEGER PRIMARY KEY (SQLite) or
+// AUTO_INCREMENT (MySQL) columns, the PostgreSQL table options and partitioning are dropped
+func (transpiler *Transpiler) createTable(node *ast.CreateTable) ([]ast.Statement, error) {
+	spec := node.TableSpec
+	if spec == nil {
+		return []ast.Statement{transpiler.rewrite(node).(ast.Statement)}, nil
+	}
+	if !spec.PartitionOf.IsEmpty() {
+		return nil, ErrUnsupportedStatement
+	}
+	spec.Options, spec.PartitionOption = nil, nil
+
+	serials := make([]*ast.ColumnDefinition, 0)
+	for _, column := range spec.Columns {
+		if transpiler.transpileColumn(column) {
+			serials = append(serials, column)
+		}
+	}
+	node = transpiler.rewrite(node).(*ast.CreateTable)
+	transpiler.tables[catalogName(node.Table)] = spec
+
+	switch transpiler.to {
+	case dialect.SQLITE3:
+		sqliteRowid(spec, serials)
+	case dialect.MYSQL:
+		for _, index := range spec.Indexes {
+			transpiler.mysqlKeyPrefixes(node.Table, index)
+		}
+		for _, column := range serials {
+			if column.Type.Options == nil {
+				column.Type.Options = &ast.ColumnTypeOptions{}
+			}
+			notNull := false
+			column.Type.Options.Null = &notNull
+			if hasKey(spec, column.Name) {
+				if !hasAutoIncrement(spec) {
+					mysqlAutoIncrement(column, false)
+				}
+				continue
+			}
+			// pg_dump adds the primary key after the data, the column becomes AUTO_INCREMENT with the key
+			// (see addKey) or with the UNIQUE KEY at the end of the dump (see mysqlKeylessSerials)
+			transpiler.serialColumn(node.Table, column.Name)
+			transpiler.keyless = append(transpiler.keyless, &ast.ColName{Name: column.Name, Qualifier: node.Table})
+		}
+	}
+	return []ast.Statement{node}, nil
+}
+
+// mysqlKeylessSerials makes AUTO_INCREMENT UNIQUE KEY of the serial columns of CREATE TABLE which
+// didn't get the key (see createTable) accepted by the filter, MySQL requires the key of the AUTO_INCREMENT column
+func (transpiler *Transpiler) mysqlKeylessSerials(accept func(column *ast.ColName) bool) []ast.Statement {
+	statements := make([]ast.Statement, 0)
+	keyless := make([]*ast.ColName, 0, len(transpiler.keyless))
+	for _, column := range transpiler.keyless {
+		serials := transpiler.serials[catalogName(column.Qualifier)]
+		if !serials[column.Name.Lowered()] {
+			continue
+		}
+		if !accept(column) {
+			keyless = append(keyless, column)
+			continue
+		}
+		delete(serials, column.Name.Lowered())
+		definition := transpiler.column(column.Qualifier, column.Name)
+		if definition == nil || hasAutoIncrement(transpiler.tables[catalogName(column.Qualifier)]) {
+			continue
+		}
+		mysqlAutoIncrement(definition, true)
+		statements = append(statements, &ast.AlterTable{Table: column.Qualifier, AlterOptions: []ast.AlterOption{
+			&ast.ModifyColumn{NewColDefinition: ast.CloneRefOfColumnDefinition(definition)},
+		}})
+	}
+	transpiler.keyless = keyless
+	return statements
+}
+
+// mysqlInsertSerials makes AUTO_INCREMENT the serial columns without the key which the INSERT leaves to their defaults
+func (transpiler *Transpiler) mysqlInsertSerials(node *ast.Insert) []ast.Statement {
+	if transpiler.to != dialect.MYSQL || len(node.Columns) == 0 {
+		return nil
+	}
+	return transpiler.mysqlKeylessSerials(func(column *ast.ColName) bool {
+		return catalogName(column.Qualifier) == catalogName(node.Table) && node.Columns.FindColumn(column.Name) < 0
+	})
+}
+
+// transpileColumn maps the type and the options of the column, returns true for the serial
+// columns (the serial types, the identity columns and the nextval defaults)
+func (transpiler *Transpiler) transpileColumn(column *ast.ColumnDefinition) bool {
+	serial := isSerialType(psqlTypeName(&column.Type))
+	if options := column.Type.Options; options != nil {
+		if isNextval(options.Default) {
+			serial, options.Default = true, nil
+		}
+		if options.Identity != "" {
+			serial, options.Identity, options.IdentitySequence = true, "", nil
+		}
+		options.Default = transpiler.rewriteExpr(options.Default)
+		options.As = transpiler.rewriteExpr(options.As)
+		if options.Reference != nil {
+			options.Reference.ReferencedTable = unqualified(options.Reference.ReferencedTable)
+		}
+		if transpiler.to == dialect.SQLITE3 {
+			options.Comment = nil
+		}
+	}
+	transpiler.columnType(&column.Type)
+	if transpiler.to == dialect.MYSQL && column.Type.Options != nil {
+		column.Type.Options.Default = mysqlCurrentTimestamp(&column.Type, column.Type.Options.Default)
+	}
+	return serial
+}
+
+// mysqlCurrentTimestamp makes the current time default of the DATETIME column with the precision
+// of the column, MySQL requires the same fractional seconds precision of the default and the column
+func mysqlCurrentTimestamp(columnType *ast.ColumnType, expr ast.Expr) ast.Expr {
+	switch node := expr.(type) {
+	case *ast.FuncExpr:
+		if !node.Qualifier.IsEmpty() || !node.Name.EqualString("now") || len(node.Exprs) > 0 {
+			return expr
+		}
+	case *ast.CurTimeFuncExpr:
+		if !node.Name.EqualString("current_timestamp") && !node.Name.EqualString("localtimestamp") {
+			return expr
+		}
+	default:
+		return expr
+	}
+	if columnType.Type != "datetime" {
+		return expr
+	}
+	currentTimestamp := &ast.CurTimeFuncExpr{Name: ast.NewColIdent("current_timestamp")}
+	if columnType.Length != nil && literalInt(columnType.Length) > 0 {
+		currentTimestamp.Fsp = columnType.Length
+	}
+	return currentTimestamp
+}
+
+// sqliteRowid makes the serial column the INTEGER PRIMARY KEY (the alias of the rowid) of SQLite,
+// it is possible for the single serial column that is the primary key or of the table without
+// the primary key
+func sqliteRowid(spec *ast.TableSpec, serials []*ast.ColumnDefinition) {
+	if len(serials) != 1 {
+		return
+	}
+	serial := serials[0]
+	for _, column := range spec.Columns {
+		if column != serial && column.Type.Options != nil && column.Type.Options.KeyOpt == ast.ColKeyPrimary {
+			return
+		}
+	}
+	for i, index := range spec.Indexes {
+		if index.Info.Primary {
+			if len(index.Columns) != 1 || !index.Columns[0].Column.Equal(serial.Name) {
+				return
+			}
+			spec.Indexes = append(spec.Indexes[:i], spec.Indexes[i+1:]...)
+			break
+		}
+	}
+	if serial.Type.Options == nil {
+		serial.Type.Options = &ast.ColumnTypeOptions{}
+	}
+	serial.Type.Type = "integer"
+	serial.Type.Options.Null = nil
+	serial.Type.Options.KeyOpt = ast.ColKeyPrimary
+}
+
+// mysqlAutoIncrement makes the serial column AUTO_INCREMENT, MySQL requires the key of the column,
+// the column becomes UNIQUE KEY if it isn't the first column of the other key
+func mysqlAutoIncrement(column *ast.ColumnDefinition, unique bool) {
+	if column.Type.Options == nil {
+		column.Type.Options = &ast.ColumnTypeOptions{}
+	}
+	notNull := false
+	column.Type.Options.Null = &notNull
+	column.Type.Options.Autoincrement = true
+	if unique && column.Type.Options.KeyOpt == ast.ColKeyNone {
+		column.Type.Options.KeyOpt = ast.ColKeyUniqueKey
+	}
+}
+
+// hasKey returns true if the column is the key or the first column of the key
+func hasKey(spec *ast.TableSpec, name ast.ColIdent) bool {
+	for _, column := range spec.Columns {
+		if column.Name.Equal(name) && column.Type.Options != nil && column.Type.Options.KeyOpt != ast.ColKeyNone {
+			return true
+		}
+	}
+	for _, index := range spec.Indexes {
+		if len(index.Columns) > 0 && index.Columns[0].Column.Equal(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAutoIncrement returns true if the table has the AUTO_INCREMENT column,
+// MySQL allows the single AUTO_INCREMENT column per table
+func hasAutoIncrement(spec *ast.TableSpec) bool {
+	for _, column := range spec.Columns {
+		if column.Type.Options != nil && column.Type.Options.Autoincrement {
+			return true
+		}
+	}
+	return false
+}
+
+// mysqlKeyPrefixes sets the prefix length of the TEXT and BLOB columns of the key,
+// MySQL can't use the whole values of the columns in the keys
+func (transpiler *Transpiler) mysqlKeyPrefixes(table ast.TableName, index *ast.IndexDefinition) {
+	for _, indexColumn := range index.Columns {
+		if indexColumn.Expression != nil || indexColumn.Length != nil {
+			continue
+		}
+		if column := transpiler.column(table, indexColumn.Column); column != nil && isMysqlTextType(&column.Type) {
+			indexColumn.Length = ast.NewIntLiteral(MYSQL_KEY_PREFIX_LENGTH)
+		}
+	}
+}
+
+// alterTable rewrites the options of ALTER TABLE. SQLite can alter the table by one option of
+// the statement and can't add the constraints to the existing table, so the keys become the
+// unique indexes and the foreign keys and the checks are dropped. The nextval defaults set
+// by pg_dump make the column AUTO_INCREMENT in MySQL as soon as the column has the key.
+func (transpiler *Transpiler) alterTable(node *ast.AlterTable) ([]ast.Statement, error) {
+	node = transpiler.rewrite(node).(*ast.AlterTable)
+	node.Only, node.PartitionSpec, node.PartitionOption = false, nil, nil
+	table := node.Table
+	sqlite := transpiler.to == dialect.SQLITE3
+
+	alterOptions := make([]ast.AlterOption, 0, len(node.AlterOptions))
+	statements := make([]ast.Statement, 0)
+	for _, alterOption := range node.AlterOptions {
+		switch option := alterOption.(type) {
+		case *ast.AlterOwner, *ast.RowLevelSecurity:
+			continue
+		case *ast.AlterColumn:
+			if isNextval(option.DefaultVal) || option.Identity != "" {
+				statements = append(statements, transpiler.serialColumn(table, option.Column.Name)...)
+				continue
+			}
+			if sqlite {
+				continue
+			}
+			if column := transpiler.column(table, option.Column.Name); column != nil && option.DefaultVal != nil {
+				option.DefaultVal = mysqlCurrentTimestamp(&column.Type, option.DefaultVal)
+			}
+		case *ast.AddIndexDefinition:
+			if sqlite {
+				statements = append(statements, sqliteIndex(table, option.IndexDefinition))
+				continue
+			}
+			statements = append(statements, transpiler.addKey(table, option.IndexDefinition)...)
+		case *ast.AddConstraintDefinition:
+			if sqlite {
+				continue
+			}
+		case *ast.AddColumns:
+			spec := transpiler.tables[catalogName(table)]
+			for _, column := range option.Columns {
+				if transpiler.transpileColumn(column) && !sqlite && (spec == nil || !hasAutoIncrement(spec)) {
+					mysqlAutoIncrement(column, spec == nil || !hasKey(spec, column.Name))
+				}
+				if spec != nil {
+					spec.Columns = append(spec.Columns, column)
+				}
+				if sqlite {
+					statements = append(statements, &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{
+						&ast.AddColumns{Columns: []*ast.ColumnDefinition{column}},
+					}})
+				}
+			}
+			if sqlite {
+				continue
+			}
+		case *ast.DropColumn, *ast.RenameTableName:
+		default:
+			if sqlite {
+				continue
+			}
+		}
+		alterOptions = append(alterOptions, alterOption)
+	}
+
+	if sqlite {
+		for _, alterOption := range alterOptions {
+			statements = append(statements, &ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{alterOption}})
+		}
+		return statements, nil
+	}
+	if len(alterOptions) == 0 {
+		return statements, nil
+	}
+	node.AlterOptions = alterOptions
+	return append([]ast.Statement{node}, statements...), nil
+}
+
+// serialColumn handles the nextval default of the column set by ALTER TABLE, the MySQL column
+// becomes AUTO_INCREMENT as soon as it has the key (pg_dump adds the primary key after the default)
+func (transpiler *Transpiler) serialColumn(table ast.TableName, name ast.ColIdent) []ast.Statement {
+	if transpiler.to != dialect.MYSQL {
+		return nil
+	}
+	spec := transpiler.tables[catalogName(table)]
+	if spec != nil && hasKey(spec, name) {
+		return transpiler.mysqlModifyAutoIncrement(table, name)
+	}
+	serials := transpiler.serials[catalogName(table)]
+	if serials == nil {
+		serials = make(map[string]bool)
+		transpiler.serials[catalogName(table)] = serials
+	}
+	serials[name.Lowered()] = true
+	return nil
+}
+
+// addKey registers the key of the MySQL table, the serial columns of the key become AUTO_INCREMENT
+func (transpiler *Transpiler) addKey(table ast.TableName, index *ast.IndexDefinition) []ast.Statement {
+	transpiler.mysqlKeyPrefixes(table, index)
+	spec := transpiler.tables[catalogName(table)]
+	if spec != nil {
+		spec.Indexes = append(spec.Indexes, index)
+	}
+	serials := transpiler.serials[catalogName(table)]
+	if len(index.Columns) == 0 || !serials[index.Columns[0].Column.Lowered()] {
+		return nil
+	}
+	delete(serials, index.Columns[0].Column.Lowered())
+	return transpiler.mysqlModifyAutoIncrement(table, index.Columns[0].Column)
+}
+
+// mysqlModifyAutoIncrement makes ALTER TABLE ... MODIFY COLUMN ... AUTO_INCREMENT of the known column
+func (transpiler *Transpiler) mysqlModifyAutoIncrement(table ast.TableName, name ast.ColIdent) []ast.Statement {
+	column := transpiler.column(table, name)
+	if column == nil || hasAutoIncrement(transpiler.tables[catalogName(table)]) {
+		return nil
+	}
+	mysqlAutoIncrement(column, false)
+	return []ast.Statement{&ast.AlterTable{Table: table, AlterOptions: []ast.AlterOption{
+		&ast.ModifyColumn{NewColDefinition: ast.CloneRefOfColumnDefinition(column)},
+	}}}
+}
+
+// sqliteIndex makes CREATE [UNIQUE] INDEX of the key added by ALTER TABLE
+func sqliteIndex(table ast.TableName, index *ast.IndexDefinition) *ast.CreateIndex {
+	name := index.Info.ConstraintName
+	if name.IsEmpty() && !index.Info.Primary {
+		name = index.Info.Name
+	}
+	if name.IsEmpty() {
+		name = ast.NewColIdent(table.Name.String() + "_pkey")
+	}
+	elements := make([]*ast.IndexElement, 0, len(index.Columns))
+	for _, column := range index.Columns {
+		elements = append(elements, &ast.IndexElement{Column: column.Column, Expression: column.Expression, Direction: column.Direction})
+	}
+	return &ast.CreateIndex{
+		Unique:      index.Info.Unique || index.Info.Primary,
+		Name:        name,
+		Table:       table,
+		Columns:     elements,
+		FullyParsed: true,
+	}
+}
+
+// createIndex drops the PostgreSQL options of the index, the index methods other than btree
+// and hash are not supported. The MySQL indexes are added by ALTER TABLE to set the prefix
+// length of the TEXT columns.
+func (transpiler *Transpiler) createIndex(node *ast.CreateIndex) ([]ast.Statement, error) {
+	node = transpiler.rewrite(node).(*ast.CreateIndex)
+	if method := node.Method.Lowered(); method != "" && method != "btree" && method != "hash" {
+		return nil, ErrUnsupportedStatement
+	}
+	node.Method, node.Only, node.Concurrently = ast.NewColIdent(""), false, false
+	node.Include, node.Parameters, node.Tablespace = nil, nil, ast.NewTableIdent("")
+	for _, element := range node.Columns {
+		element.Collate, element.OpClass, element.OpClassParameters, element.Nulls = "", ast.TableName{}, nil, ""
+	}
+	if transpiler.to != dialect.MYSQL {
+		return []ast.Statement{node}, nil
+	}
+
+	if node.Where != nil {
+		// MySQL has no partial indexes
+		return nil, ErrUnsupportedStatement
+	}
+	info := &ast.IndexInfo{Type: "key", Name: node.Name}
+	if node.Unique {
+		info.Type, info.Unique = "unique key", true
+	}
+	index := &ast.IndexDefinition{Info: info}
+	for _, element := range node.Columns {
+		index.Columns = append(index.Columns, &ast.IndexColumn{Column: element.Column, Expression: element.Expression, Direction: element.Direction})
+	}
+	alterTable := &ast.AlterTable{Table: node.Table, AlterOptions: []ast.AlterOption{&ast.AddIndexDefinition{IndexDefinition: index}}}
+	return append([]ast.Statement{alterTable}, transpiler.addKey(node.Table, index)...), nil
+}
+
+// copyInserts makes the multi-row INSERT statements of the COPY ... FROM stdin data, the rows are
+// split by the batch size and the values are converted by the types of the transpiled columns
+func (transpiler *Transpiler) copyInserts(statementText string, node *ast.CopyFrom) ([]ast.Statement, error) {
+	if node.From.Type != ast.CopyFromStdin {
+		return nil, ErrUnsupportedStatement
+	}
+	options, err := copy_codec.NewOptions(node.With)
+	if err != nil || options.Format == copy_codec.FORMAT_BINARY {
+		return nil, ErrUnsupportedStatement
+	}
+	data, ok := copyData(statementText)
+	if !ok {
+		return nil, ErrUnsupportedStatement
+	}
+	columns := node.Columns
+	if len(columns) == 0 {
+		if spec := transpiler.tables[catalogName(node.Table)]; spec != nil {
+			for _, column := range spec.Columns {
+				columns = append(columns, column.Name)
+			}
+		}
+	}
+	names := make([]string, len(columns))
+	definitions := make([]*ast.ColumnDefinition, len(columns))
+	for i, column := range columns {
+		names[i] = column.String()
+		definitions[i] = transpiler.column(node.Table, column)
+	}
+
+	rows, err := copy_codec.NewDecoder(strings.NewReader(data), options, names, nil).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	statements := make([]ast.Statement, 0, 1)
+	for _, batch := range transpiler.batches(len(rows)) {
+		values := make(ast.Values, 0, batch[1]-batch[0])
+		for _, row := range rows[batch[0]:batch[1]] {
+			tuple := make(ast.ValTuple, len(row))
+			for i, value := range row {
+				var definition *ast.ColumnDefinition
+				if i < len(definitions) {
+					definition = definitions[i]
+				}
+				tuple[i] = insertValue(value, definition)
+			}
+			values = append(values, tuple)
+		}
+		statements = append(statements, &ast.Insert{
+			Action:  ast.InsertAct,
+			Table:   unqualified(node.Table),
+			Columns: node.Columns,
+			Rows:    values,
+		})
+	}
+	return statements, nil
+}
+
+// insertValue returns the literal of the COPY value for the transpiled column: the numbers of the
+// numeric columns, the booleans of the boolean columns and the binary literals of the bytea columns.
+// The column is nil if it is unknown.
+func insertValue(copyValue sql_types.Value, column *ast.ColumnDefinition) ast.Expr {
+	if copyValue.IsNull() {
+		return &ast.NullVal{}
+	}
+	value := copyValue.RawStr()
+	if column == nil {
+		return ast.NewStrLiteral(value)
+	}
+	switch strings.ToLower(column.Type.Type) {
+	case "boolean":
+		switch strings.ToLower(value) {
+		case "t", "true", "y", "yes", "on", "1":
+			return ast.BoolVal(true)
+		case "f", "false", "n", "no", "off", "0":
+			return ast.BoolVal(false)
+		}
+	case "blob", "longblob":
+		if bytes, err := sql_types.DecodeBytea(value); err == nil {
+			return ast.NewHexLiteral(hex.EncodeToString(bytes))
+		}
+	case "integer", "int", "smallint", "bigint":
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return ast.NewIntLiteral(value)
+		}
+	case "real", "float", "double", "numeric", "decimal":
+		// NaN and Infinity are kept as the strings
+		if _, err := strconv.ParseFloat(value, 64); err == nil && strings.Trim(value, "0123456789+-.eE") == "" {
+			if strings.ContainsAny(value, "eE") {
+				return ast.NewFloatLiteral(value)
+			}
+			return ast.NewDecimalLiteral(value)
+		}
+	}
+	return ast.NewStrLiteral(value)
+}
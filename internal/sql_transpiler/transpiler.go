@@ -0,0 +1,257 @@
+package sql_transpiler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+const (
+	// SUMMARY_LENGTH is the maximal count of runes of the statement in the error messages
+	SUMMARY_LENGTH = 80
+	// DEFAULT_BATCH_SIZE is the default maximal count of rows in the INSERT or COPY made of the other one
+	DEFAULT_BATCH_SIZE = 1000
+)
+
+var (
+	// ErrUnsupportedStatement is returned for the statements without counterpart in the target dialect
+	ErrUnsupportedStatement = errors.New("statement is not supported by the target dialect")
+)
+
+// EnumMode defines how the MySQL ENUM columns are transpiled for PostgreSQL
+type EnumMode uint8
+
+const (
+	ENUM_CHECK EnumMode = 0 // TEXT column with the CHECK constraint of the labels
+	ENUM_TYPE  EnumMode = 1 // Column of the enum type made by CREATE TYPE <table>_<column> AS ENUM
+)
+
+func (mode EnumMode) String() string {
+	switch mode {
+	case ENUM_CHECK:
+		return "check"
+	case ENUM_TYPE:
+		return "type"
+	}
+	return "undefined"
+}
+
+// ParseEnumMode converts cli option value (check|type) to the EnumMode
+func ParseEnumMode(value string) (EnumMode, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "check", "":
+		return ENUM_CHECK, nil
+	case "type":
+		return ENUM_TYPE, nil
+	}
+	return ENUM_CHECK, fmt.Errorf("unknown enum mode: %v, expected one of check|type", value)
+}
+
+// Transpiler rewrites the statements parsed in the source dialect for the target dialect.
+// It keeps the catalog of the transpiled schema (enum types, table columns, serial columns)
+// between the statements, so the same transpiler must be used for the whole dump.
+type Transpiler struct {
+	from      dialect.SqlDialect
+	to        dialect.SqlDialect
+	enumMode  EnumMode
+	batchSize int
+	enums     map[string][]string        // Labels of the enum types by the type name
+	tables    map[string]*ast.TableSpec  // Transpiled tables by the table name
+	serials   map[string]map[string]bool // Serial columns waiting for the key by the table name (MySQL)
+	keyless   []*ast.ColName             // Serial columns of CREATE TABLE getting the UNIQUE KEY at the end (MySQL)
+	deferred  []ast.Statement            // Statements executed after the dump (foreign keys)
+	dropTable *ast.DropTable             // DROP TABLE IF EXISTS waiting for the next statement (see mysqlDrop)
+	dropped   []ast.Statement            // Statements returned with the next transpiled statement
+
+	deferConstraints bool                 // Strip the secondary indexes and the foreign keys (see SetDeferConstraints)
+	constraints      []DeferredConstraint // Constraints stripped since the last DeferredConstraints call
+}
+
+// NewTranspiler makes the transpiler of the statements from the source to the target dialect
+func NewTranspiler(from dialect.SqlDialect, to dialect.SqlDialect) (*Transpiler, error) {
+	if from != to && from != dialect.PSQL && (from != dialect.MYSQL || to != dialect.PSQL) {
+		return nil, fmt.Errorf("transpilation from %v to %v is not supported", from.String(), to.String())
+	}
+	return &Transpiler{
+		from:      from,
+		to:        to,
+		batchSize: DEFAULT_BATCH_SIZE,
+		enums:     make(map[string][]string),
+		tables:    make(map[string]*ast.TableSpec),
+		serials:   make(map[string]map[string]bool),
+	}, nil
+}
+
+// SetBatchSize sets the maximal count of rows in the INSERT made of the COPY data and in the COPY
+// made of the INSERT, zero or negative size keeps all rows of the statement together
+func (transpiler *Transpiler) SetBatchSize(batchSize int) {
+	transpiler.batchSize = batchSize
+}
+
+// batches splits the count of rows by the batch size, the bounds of the batches are returned
+func (transpiler *Transpiler) batches(count int) [][2]int {
+	size := transpiler.batchSize
+	if size <= 0 || size > count {
+		size = count
+	}
+	result := make([][2]int, 0, 1)
+	for start := 0; start < count; start += size {
+		result = append(result, [2]int{start, min(start+size, count)})
+	}
+	return result
+}
+
+// SetEnumMode sets how the MySQL ENUM columns are transpiled for PostgreSQL
+func (transpiler *Transpiler) SetEnumMode(enumMode EnumMode) {
+	transpiler.enumMode = enumMode
+}
+
+// IsIdentity returns true if the statements are executed as is (the same dialects)
+func (transpiler *Transpiler) IsIdentity() bool {
+	return transpiler.from == transpiler.to
+}
+
+// Transpile returns the statement text(s) for the target dialect. The statement is dropped
+// (the empty result) if it has no effect in the target dialect (session settings, ownership,
+// privileges, sequences) or split into several statements (SQLite ALTER TABLE, MySQL keys).
+// The MySQL INSERT of the constant rows becomes COPY ... FROM stdin with the data for PostgreSQL,
+// the COPY ... FROM stdin data of PostgreSQL becomes the multi-row INSERT for the other dialects.
+// The statement text is returned as is if the dialects are the same or the statement
+// wasn't parsed, otherwise the parsed statement is rewritten in place.
+// The MySQL DROP TABLE IF EXISTS is returned with the next statement, when the object kind is known.
+func (transpiler *Transpiler) Transpile(statementText string, statement ast.Statement) ([]string, error) {
+	if transpiler.from == dialect.MYSQL && !transpiler.IsIdentity() && statement != nil {
+		var keep bool
+		if statement, keep = transpiler.mysqlDrop(statement); keep {
+			return nil, nil
+		}
+	}
+	result, err := transpiler.transpile(statementText, statement)
+	if err != nil || len(transpiler.dropped) == 0 {
+		return result, err
+	}
+	dropped := transpiler.format(transpiler.dropped)
+	transpiler.dropped = nil
+	return append(dropped, result...), nil
+}
+
+// transpile returns the statement text(s) for the target dialect (see Transpile)
+func (transpiler *Transpiler) transpile(statementText string, statement ast.Statement) ([]string, error) {
+	if transpiler.IsIdentity() || statement == nil {
+		if transpiler.deferConstraints && statement != nil {
+			return transpiler.deferIdentity(statementText, statement), nil
+		}
+		return []string{statementText}, nil
+	}
+	if copyFrom, ok := statement.(*ast.CopyFrom); ok {
+		if transpiler.to == dialect.PSQL {
+			// The rows of the COPY are not the part of the AST
+			return []string{statementText}, nil
+		}
+		// The rows of the COPY are decoded from the statement text
+		statements, err := transpiler.copyInserts(statementText, copyFrom)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", err, summary(statementText))
+		}
+		return transpiler.format(statements), nil
+	}
+	if ddl, ok := statement.(ast.DDLStatement); ok && !ddl.IsFullyParsed() {
+		return nil, fmt.Errorf("%w (partially parsed): %v", ErrUnsupportedStatement, summary(statementText))
+	}
+
+	var statements []ast.Statement
+	var err error
+	switch transpiler.from {
+	case dialect.MYSQL:
+		if insert, ok := statement.(*ast.Insert); ok {
+			texts, err := transpiler.mysqlInsert(insert)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", err, summary(statementText))
+			}
+			return texts, nil
+		}
+		statements, err = transpiler.fromMysql(statement)
+	default:
+		statements, err = transpiler.fromPsql(statement)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", err, summary(statementText))
+	}
+	if transpiler.deferConstraints {
+		statements = transpiler.deferStatements(statement, statements)
+	}
+	return transpiler.format(statements), nil
+}
+
+// format returns the texts of the statements in the target dialect
+func (transpiler *Transpiler) format(statements []ast.Statement) []string {
+	result := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		result = append(result, ast.DialectString(statement, transpiler.to))
+	}
+	return result
+}
+
+// Finish returns the statements deferred to the end of the dump (the foreign keys of the MySQL
+// tables that can reference the tables created later, the AUTO_INCREMENT of the PostgreSQL serial
+// columns without the key), the statements are returned once
+func (transpiler *Transpiler) Finish() []string {
+	_, result := transpiler.FinishStatements()
+	return result
+}
+
+// FinishStatements returns the deferred statements (see Finish) with their texts for the target dialect
+func (transpiler *Transpiler) FinishStatements() ([]ast.Statement, []string) {
+	transpiler.mysqlDrop(nil)
+	statements := append(transpiler.dropped, transpiler.deferred...)
+	if transpiler.to == dialect.MYSQL {
+		statements = append(statements, transpiler.mysqlKeylessSerials(func(*ast.ColName) bool { return true })...)
+	}
+	transpiler.dropped, transpiler.deferred = nil, nil
+	return statements, transpiler.format(statements)
+}
+
+// summary returns the beginning of the statement for the error messages
+func summary(statementText string) string {
+	text := strings.TrimSpace(ast.StripLeadingComments(statementText))
+	if newLine := strings.IndexByte(text, '\n'); newLine >= 0 {
+		text = strings.TrimSpace(text[:newLine]) + " ..."
+	}
+	if runes := []rune(text); len(runes) > SUMMARY_LENGTH {
+		text = string(runes[:SUMMARY_LENGTH]) + " ..."
+	}
+	return text
+}
+
+// catalogName is the name of the table or the type in the catalog, the schema is omitted
+// because the target schema has no qualifiers
+func catalogName(name ast.TableName) string {
+	return strings.ToLower(name.Name.String())
+}
+
+// unqualified returns the name without the schema qualifier
+func unqualified(name ast.TableName) ast.TableName {
+	return ast.TableName{Name: name.Name}
+}
+
+// column returns the column of the transpiled table or nil if the table or the column is unknown
+func (transpiler *Transpiler) column(table ast.TableName, column ast.ColIdent) *ast.ColumnDefinition {
+	spec := transpiler.tables[catalogName(table)]
+	if spec == nil {
+		return nil
+	}
+	return tableColumn(spec, column)
+}
+
+// tableColumn returns the column of the table specification or nil if the column is unknown
+func tableColumn(spec *ast.TableSpec, column ast.ColIdent) *ast.ColumnDefinition {
+	for _, definition := range spec.Columns {
+		if definition.Name.Equal(column) {
+			return definition
+		}
+	}
+	return nil
+}
@@ -0,0 +1,678 @@
+package sql_types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Oid is the object identifier of the PostgreSQL type (pg_type.oid)
+type Oid uint32
+
+// The object identifiers of the built-in PostgreSQL types and their arrays
+const (
+	OidUnknown     Oid = 0
+	OidBool        Oid = 16
+	OidBytea       Oid = 17
+	OidName        Oid = 19
+	OidInt8        Oid = 20
+	OidInt2        Oid = 21
+	OidInt4        Oid = 23
+	OidText        Oid = 25
+	OidOid         Oid = 26
+	OidJson        Oid = 114
+	OidXml         Oid = 142
+	OidCidr        Oid = 650
+	OidFloat4      Oid = 700
+	OidFloat8      Oid = 701
+	OidMoney       Oid = 790
+	OidMacaddr     Oid = 829
+	OidInet        Oid = 869
+	OidBpchar      Oid = 1042
+	OidVarchar     Oid = 1043
+	OidDate        Oid = 1082
+	OidTime        Oid = 1083
+	OidTimestamp   Oid = 1114
+	OidTimestamptz Oid = 1184
+	OidInterval    Oid = 1186
+	OidTimetz      Oid = 1266
+	OidBit         Oid = 1560
+	OidVarbit      Oid = 1562
+	OidNumeric     Oid = 1700
+	OidUuid        Oid = 2950
+	OidJsonb       Oid = 3802
+	OidInt4range   Oid = 3904
+	OidNumrange    Oid = 3906
+	OidTsrange     Oid = 3908
+	OidTstzrange   Oid = 3910
+	OidDaterange   Oid = 3912
+	OidInt8range   Oid = 3926
+
+	OidBoolArray        Oid = 1000
+	OidByteaArray       Oid = 1001
+	OidNameArray        Oid = 1003
+	OidInt2Array        Oid = 1005
+	OidInt4Array        Oid = 1007
+	OidTextArray        Oid = 1009
+	OidBpcharArray      Oid = 1014
+	OidVarcharArray     Oid = 1015
+	OidInt8Array        Oid = 1016
+	OidFloat4Array      Oid = 1021
+	OidFloat8Array      Oid = 1022
+	OidOidArray         Oid = 1028
+	OidMacaddrArray     Oid = 1040
+	OidInetArray        Oid = 1041
+	OidTimestampArray   Oid = 1115
+	OidDateArray        Oid = 1182
+	OidTimeArray        Oid = 1183
+	OidTimestamptzArray Oid = 1185
+	OidIntervalArray    Oid = 1187
+	OidNumericArray     Oid = 1231
+	OidTimetzArray      Oid = 1270
+	OidBitArray         Oid = 1561
+	OidVarbitArray      Oid = 1563
+	OidUuidArray        Oid = 2951
+	OidJsonArray        Oid = 199
+	OidXmlArray         Oid = 143
+	OidCidrArray        Oid = 651
+	OidMoneyArray       Oid = 791
+	OidJsonbArray       Oid = 3807
+	OidInt4rangeArray   Oid = 3905
+	OidNumrangeArray    Oid = 3907
+	OidTsrangeArray     Oid = 3909
+	OidTstzrangeArray   Oid = 3911
+	OidDaterangeArray   Oid = 3913
+	OidInt8rangeArray   Oid = 3927
+)
+
+// postgresTypeInfo is the built-in PostgreSQL type: the name of format_type(),
+// the type of the values, the array type and the subtype of the range
+type postgresTypeInfo struct {
+	name    string
+	typ     Type
+	array   Oid
+	subtype Oid
+}
+
+var postgresTypes = map[Oid]postgresTypeInfo{
+	OidBool:        {name: "boolean", typ: Int8, array: OidBoolArray},
+	OidBytea:       {name: "bytea", typ: VarBinary, array: OidByteaArray},
+	OidName:        {name: "name", typ: VarChar, array: OidNameArray},
+	OidInt8:        {name: "bigint", typ: Int64, array: OidInt8Array},
+	OidInt2:        {name: "smallint", typ: Int16, array: OidInt2Array},
+	OidInt4:        {name: "integer", typ: Int32, array: OidInt4Array},
+	OidText:        {name: "text", typ: Text, array: OidTextArray},
+	OidOid:         {name: "oid", typ: Uint32, array: OidOidArray},
+	OidJson:        {name: "json", typ: TypeJSON, array: OidJsonArray},
+	OidXml:         {name: "xml", typ: Text, array: OidXmlArray},
+	OidCidr:        {name: "cidr", typ: VarChar, array: OidCidrArray},
+	OidFloat4:      {name: "real", typ: Float32, array: OidFloat4Array},
+	OidFloat8:      {name: "double precision", typ: Float64, array: OidFloat8Array},
+	OidMoney:       {name: "money", typ: Decimal, array: OidMoneyArray},
+	OidMacaddr:     {name: "macaddr", typ: VarChar, array: OidMacaddrArray},
+	OidInet:        {name: "inet", typ: VarChar, array: OidInetArray},
+	OidBpchar:      {name: "character", typ: Char, array: OidBpcharArray},
+	OidVarchar:     {name: "character varying", typ: VarChar, array: OidVarcharArray},
+	OidDate:        {name: "date", typ: Date, array: OidDateArray},
+	OidTime:        {name: "time without time zone", typ: Time, array: OidTimeArray},
+	OidTimestamp:   {name: "timestamp without time zone", typ: Datetime, array: OidTimestampArray},
+	OidTimestamptz: {name: "timestamp with time zone", typ: Timestamp, array: OidTimestamptzArray},
+	OidInterval:    {name: "interval", typ: VarChar, array: OidIntervalArray},
+	OidTimetz:      {name: "time with time zone", typ: Time, array: OidTimetzArray},
+	OidBit:         {name: "bit", typ: Bit, array: OidBitArray},
+	OidVarbit:      {name: "bit varying", typ: Bit, array: OidVarbitArray},
+	OidNumeric:     {name: "numeric", typ: Decimal, array: OidNumericArray},
+	OidUuid:        {name: "uuid", typ: Char, array: OidUuidArray},
+	OidJsonb:       {name: "jsonb", typ: TypeJSON, array: OidJsonbArray},
+	OidInt4range:   {name: "int4range", typ: VarChar, array: OidInt4rangeArray, subtype: OidInt4},
+	OidNumrange:    {name: "numrange", typ: VarChar, array: OidNumrangeArray, subtype: OidNumeric},
+	OidTsrange:     {name: "tsrange", typ: VarChar, array: OidTsrangeArray, subtype: OidTimestamp},
+	OidTstzrange:   {name: "tstzrange", typ: VarChar, array: OidTstzrangeArray, subtype: OidTimestamptz},
+	OidDaterange:   {name: "daterange", typ: VarChar, array: OidDaterangeArray, subtype: OidDate},
+	OidInt8range:   {name: "int8range", typ: VarChar, array: OidInt8rangeArray, subtype: OidInt8},
+}
+
+// postgresArrayElements is the reverse of the array types of postgresTypes
+var postgresArrayElements = func() map[Oid]Oid {
+	elements := make(map[Oid]Oid, len(postgresTypes))
+	for oid, info := range postgresTypes {
+		elements[info.array] = oid
+	}
+	return elements
+}()
+
+// postgresTypeNames maps the names and the aliases of the built-in types to the object identifiers,
+// the serial pseudo-types are the integers
+var postgresTypeNames = map[string]Oid{
+	"bool":                        OidBool,
+	"boolean":                     OidBool,
+	"bytea":                       OidBytea,
+	"name":                        OidName,
+	"int8":                        OidInt8,
+	"bigint":                      OidInt8,
+	"bigserial":                   OidInt8,
+	"serial8":                     OidInt8,
+	"int2":                        OidInt2,
+	"smallint":                    OidInt2,
+	"smallserial":                 OidInt2,
+	"serial2":                     OidInt2,
+	"int":                         OidInt4,
+	"int4":                        OidInt4,
+	"integer":                     OidInt4,
+	"serial":                      OidInt4,
+	"serial4":                     OidInt4,
+	"text":                        OidText,
+	"oid":                         OidOid,
+	"json":                        OidJson,
+	"xml":                         OidXml,
+	"cidr":                        OidCidr,
+	"float4":                      OidFloat4,
+	"real":                        OidFloat4,
+	"float8":                      OidFloat8,
+	"double precision":            OidFloat8,
+	"float":                       OidFloat8,
+	"money":                       OidMoney,
+	"macaddr":                     OidMacaddr,
+	"inet":                        OidInet,
+	"bpchar":                      OidBpchar,
+	"char":                        OidBpchar,
+	"character":                   OidBpchar,
+	"varchar":                     OidVarchar,
+	"character varying":           OidVarchar,
+	"date":                        OidDate,
+	"time":                        OidTime,
+	"time without time zone":      OidTime,
+	"timetz":                      OidTimetz,
+	"time with time zone":         OidTimetz,
+	"timestamp":                   OidTimestamp,
+	"timestamp without time zone": OidTimestamp,
+	"timestamptz":                 OidTimestamptz,
+	"timestamp with time zone":    OidTimestamptz,
+	"interval":                    OidInterval,
+	"bit":                         OidBit,
+	"varbit":                      OidVarbit,
+	"bit varying":                 OidVarbit,
+	"numeric":                     OidNumeric,
+	"decimal":                     OidNumeric,
+	"uuid":                        OidUuid,
+	"jsonb":                       OidJsonb,
+	"int4range":                   OidInt4range,
+	"numrange":                    OidNumrange,
+	"tsrange":                     OidTsrange,
+	"tstzrange":                   OidTstzrange,
+	"daterange":                   OidDaterange,
+	"int8range":                   OidInt8range,
+}
+
+var (
+	postgresTypeModifiers = regexp.MustCompile(`\(\s*(\d+)\s*(?:,\s*(-?\d+)\s*)?\)`)
+	postgresArrayBounds   = regexp.MustCompile(`(?:\s*\[\s*\d*\s*\])+$|\s+array(?:\s*\[\s*\d*\s*\])?$`)
+	postgresNumeric       = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+	postgresUuid          = regexp.MustCompile(`^\{?([0-9a-fA-F]{8})-?([0-9a-fA-F]{4})-?([0-9a-fA-F]{4})-?([0-9a-fA-F]{4})-?([0-9a-fA-F]{12})\}?$`)
+	postgresRange         = regexp.MustCompile(`^(?i:empty|[\[(].*,.*[\])])$`)
+)
+
+// PostgresType is the PostgreSQL type of the column: the built-in type (Oid) or the user type (Name),
+// the type modifiers and the array dimensions. The labels of the user type are the enum values.
+type PostgresType struct {
+	Oid        Oid
+	Name       string
+	Precision  int    // Length of the character and bit types, precision of numeric and the time types, -1 if unset
+	Scale      int    // Scale of numeric, -1 if unset
+	Fields     string // Fields of interval (day to second)
+	Dimensions int    // Array dimensions
+	Labels     []string
+}
+
+// NewPostgresType returns the built-in type without the modifiers
+func NewPostgresType(oid Oid) (PostgresType, error) {
+	if element, ok := postgresArrayElements[oid]; ok {
+		return PostgresType{Oid: element, Name: postgresTypes[element].name, Precision: -1, Scale: -1, Dimensions: 1}, nil
+	}
+	info, ok := postgresTypes[oid]
+	if !ok {
+		return PostgresType{}, fmt.Errorf("unsupported postgres type oid: %d", oid)
+	}
+	return PostgresType{Oid: oid, Name: info.name, Precision: -1, Scale: -1}, nil
+}
+
+// NewPostgresEnum returns the user enum type with the labels
+func NewPostgresEnum(name string, labels []string) PostgresType {
+	return PostgresType{Name: name, Precision: -1, Scale: -1, Labels: labels}
+}
+
+// ParsePostgresType parses the type declaration of the column (numeric(10,2), character varying(255)[],
+// timestamp(3) with time zone, pg_catalog.int4), the unknown names are the user types
+func ParsePostgresType(declaration string) (PostgresType, error) {
+	pgType := PostgresType{Precision: -1, Scale: -1}
+	name := strings.TrimSpace(declaration)
+	if bounds := postgresArrayBounds.FindString(strings.ToLower(name)); bounds != "" {
+		pgType.Dimensions = max(strings.Count(bounds, "["), 1)
+		name = strings.TrimSpace(name[:len(name)-len(bounds)])
+	}
+	if match := postgresTypeModifiers.FindStringSubmatchIndex(name); match != nil {
+		precision, err := strconv.Atoi(name[match[2]:match[3]])
+		if err != nil {
+			return pgType, fmt.Errorf("invalid type modifier of %v: %w", declaration, err)
+		}
+		pgType.Precision = precision
+		if match[4] >= 0 {
+			if pgType.Scale, err = strconv.Atoi(name[match[4]:match[5]]); err != nil {
+				return pgType, fmt.Errorf("invalid type modifier of %v: %w", declaration, err)
+			}
+		}
+		name = name[:match[0]] + " " + name[match[1]:]
+	}
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" {
+		return pgType, fmt.Errorf("empty postgres type")
+	}
+	lowerName := strings.TrimPrefix(strings.ToLower(name), "pg_catalog.")
+	if strings.HasPrefix(lowerName, "interval ") {
+		pgType.Fields, lowerName = strings.TrimPrefix(lowerName, "interval "), "interval"
+	}
+	oid, ok := postgresTypeNames[lowerName]
+	if !ok {
+		// The user type (enum, domain, composite) is kept by the name
+		pgType.Name = name
+		return pgType, nil
+	}
+	pgType.Oid, pgType.Name = oid, postgresTypes[oid].name
+	switch {
+	case lowerName == "float" && pgType.Precision >= 0:
+		// float(p) is real up to 24 bits of the mantissa
+		if pgType.Precision <= 24 {
+			pgType.Oid, pgType.Name = OidFloat4, postgresTypes[OidFloat4].name
+		}
+		pgType.Precision = -1
+	case (oid == OidBpchar || oid == OidBit) && pgType.Precision < 0:
+		// character and bit are character(1) and bit(1)
+		pgType.Precision = 1
+	}
+	return pgType, nil
+}
+
+// String returns the type declaration as format_type() of PostgreSQL
+func (pgType PostgresType) String() string {
+	text := strings.Builder{}
+	switch pgType.Oid {
+	case OidTime, OidTimetz, OidTimestamp, OidTimestamptz:
+		name := pgType.Name
+		if pgType.Precision >= 0 {
+			// The precision follows the first word: timestamp(3) with time zone
+			word, rest, _ := strings.Cut(name, " ")
+			name = fmt.Sprintf("%v(%d) %v", word, pgType.Precision, rest)
+		}
+		text.WriteString(name)
+	case OidInterval:
+		text.WriteString(pgType.Name)
+		if pgType.Fields != "" {
+			text.WriteString(" " + pgType.Fields)
+		}
+		if pgType.Precision >= 0 {
+			fmt.Fprintf(&text, "(%d)", pgType.Precision)
+		}
+	default:
+		text.WriteString(pgType.Name)
+		if pgType.Precision >= 0 {
+			if pgType.Scale >= 0 {
+				fmt.Fprintf(&text, "(%d,%d)", pgType.Precision, pgType.Scale)
+			} else {
+				fmt.Fprintf(&text, "(%d)", pgType.Precision)
+			}
+		}
+	}
+	for i := 0; i < pgType.Dimensions; i++ {
+		text.WriteString("[]")
+	}
+	return text.String()
+}
+
+// TypeOid returns the object identifier of the type, the array type for the arrays
+func (pgType PostgresType) TypeOid() Oid {
+	if pgType.Dimensions > 0 {
+		return postgresTypes[pgType.Oid].array
+	}
+	return pgType.Oid
+}
+
+// IsArray returns true for the array types
+func (pgType PostgresType) IsArray() bool {
+	return pgType.Dimensions > 0
+}
+
+// IsRange returns true for the range types
+func (pgType PostgresType) IsRange() bool {
+	return postgresTypes[pgType.Oid].subtype != OidUnknown
+}
+
+// IsEnum returns true for the user types with the labels
+func (pgType PostgresType) IsEnum() bool {
+	return pgType.Oid == OidUnknown && pgType.Labels != nil
+}
+
+// Subtype returns the type of the range bounds
+func (pgType PostgresType) Subtype() (PostgresType, bool) {
+	subtype := postgresTypes[pgType.Oid].subtype
+	if subtype == OidUnknown {
+		return PostgresType{}, false
+	}
+	result, err := NewPostgresType(subtype)
+	return result, err == nil
+}
+
+// Type returns the type of the values: the arrays, the ranges and the user types are the text
+// literals, the booleans are Int8
+func (pgType PostgresType) Type() Type {
+	switch {
+	case pgType.IsArray():
+		return VarChar
+	case pgType.IsEnum():
+		return Enum
+	}
+	if info, ok := postgresTypes[pgType.Oid]; ok {
+		return info.typ
+	}
+	return VarChar
+}
+
+// PostgresToType computes the type of the values of the PostgreSQL type
+func PostgresToType(oid Oid) (Type, error) {
+	pgType, err := NewPostgresType(oid)
+	if err != nil {
+		return 0, err
+	}
+	return pgType.Type(), nil
+}
+
+// typeToPostgres is the PostgreSQL type of the vitess type, the unsigned types take the larger types
+var typeToPostgres = map[Type]PostgresType{
+	Int8:      {Oid: OidInt2},
+	Uint8:     {Oid: OidInt2},
+	Int16:     {Oid: OidInt2},
+	Uint16:    {Oid: OidInt4},
+	Int24:     {Oid: OidInt4},
+	Uint24:    {Oid: OidInt4},
+	Int32:     {Oid: OidInt4},
+	Uint32:    {Oid: OidInt8},
+	Int64:     {Oid: OidInt8},
+	Uint64:    {Oid: OidNumeric, Precision: 20, Scale: 0},
+	Float32:   {Oid: OidFloat4},
+	Float64:   {Oid: OidFloat8},
+	Timestamp: {Oid: OidTimestamptz},
+	Date:      {Oid: OidDate},
+	Time:      {Oid: OidTime},
+	Datetime:  {Oid: OidTimestamp},
+	Year:      {Oid: OidInt2},
+	Decimal:   {Oid: OidNumeric},
+	Text:      {Oid: OidText},
+	Blob:      {Oid: OidBytea},
+	VarChar:   {Oid: OidVarchar},
+	VarBinary: {Oid: OidBytea},
+	Char:      {Oid: OidBpchar},
+	Binary:    {Oid: OidBytea},
+	Bit:       {Oid: OidVarbit},
+	Enum:      {Oid: OidText},
+	Set:       {Oid: OidText, Dimensions: 1},
+	Geometry:  {Oid: OidBytea},
+	TypeJSON:  {Oid: OidJsonb},
+	HexNum:    {Oid: OidBytea},
+	HexVal:    {Oid: OidBytea},
+}
+
+// TypeToPostgres returns the PostgreSQL type keeping the values of the vitess type, text for the unknown types
+func TypeToPostgres(typ Type) PostgresType {
+	pgType, ok := typeToPostgres[typ]
+	if !ok {
+		pgType = PostgresType{Oid: OidText}
+	}
+	pgType.Name = postgresTypes[pgType.Oid].name
+	if pgType.Oid != OidNumeric || pgType.Precision == 0 {
+		pgType.Precision, pgType.Scale = -1, -1
+	}
+	return pgType
+}
+
+// NewPostgresValue validates the text of the value of the PostgreSQL type and returns the value of
+// the type: the booleans are 1 and 0, the bytea is decoded, the uuid is in the canonical form
+func NewPostgresValue(pgType PostgresType, text string) (Value, error) {
+	typ := pgType.Type()
+	if pgType.IsArray() {
+		if !strings.HasPrefix(text, "{") && !strings.HasPrefix(text, "[") || !strings.HasSuffix(text, "}") {
+			return NULL, fmt.Errorf("invalid %v array: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(text)), nil
+	}
+	if pgType.IsRange() {
+		if !postgresRange.MatchString(strings.TrimSpace(text)) {
+			return NULL, fmt.Errorf("invalid %v range: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(text)), nil
+	}
+	if pgType.IsEnum() {
+		for _, label := range pgType.Labels {
+			if label == text {
+				return MakeTrusted(typ, []byte(text)), nil
+			}
+		}
+		return NULL, fmt.Errorf("invalid value of enum %v: %v", pgType.Name, text)
+	}
+	value := strings.TrimSpace(text)
+	switch pgType.Oid {
+	case OidBool:
+		switch strings.ToLower(value) {
+		case "t", "true", "y", "yes", "on", "1":
+			return MakeTrusted(typ, []byte("1")), nil
+		case "f", "false", "n", "no", "off", "0":
+			return MakeTrusted(typ, []byte("0")), nil
+		}
+		return NULL, fmt.Errorf("invalid boolean: %v", text)
+	case OidInt2, OidInt4, OidInt8:
+		bitSize := map[Oid]int{OidInt2: 16, OidInt4: 32, OidInt8: 64}[pgType.Oid]
+		if _, err := strconv.ParseInt(value, 10, bitSize); err != nil {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidOid:
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidFloat4, OidFloat8:
+		bitSize := map[Oid]int{OidFloat4: 32, OidFloat8: 64}[pgType.Oid]
+		if _, err := strconv.ParseFloat(value, bitSize); err != nil {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidNumeric:
+		if err := validateNumeric(pgType, value); err != nil {
+			return NULL, err
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidMoney:
+		amount := strings.NewReplacer("$", "", ",", "").Replace(value)
+		if !postgresNumeric.MatchString(amount) {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(amount)), nil
+	case OidBpchar, OidVarchar:
+		// The trailing spaces over the length are truncated
+		if pgType.Precision >= 0 && utf8.RuneCountInString(strings.TrimRight(text, " ")) > pgType.Precision {
+			return NULL, fmt.Errorf("value too long for %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(text)), nil
+	case OidBytea:
+		bytes, err := DecodeBytea(text)
+		if err != nil {
+			return NULL, fmt.Errorf("invalid %v: %w", pgType, err)
+		}
+		return MakeTrusted(typ, bytes), nil
+	case OidDate, OidTime, OidTimetz, OidTimestamp, OidTimestamptz:
+		if err := validateDateTime(pgType, value); err != nil {
+			return NULL, err
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidUuid:
+		parts := postgresUuid.FindStringSubmatch(value)
+		if parts == nil || strings.HasPrefix(value, "{") != strings.HasSuffix(value, "}") {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(strings.ToLower(strings.Join(parts[1:], "-")))), nil
+	case OidJson, OidJsonb:
+		if !json.Valid([]byte(text)) {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(text)), nil
+	case OidInet, OidCidr:
+		if err := validateNetwork(pgType, value); err != nil {
+			return NULL, err
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidMacaddr:
+		if address, err := net.ParseMAC(value); err != nil || len(address) != 6 {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	case OidBit, OidVarbit:
+		if strings.Trim(value, "01") != "" || pgType.Precision >= 0 &&
+			(len(value) > pgType.Precision || pgType.Oid == OidBit && len(value) != pgType.Precision) {
+			return NULL, fmt.Errorf("invalid %v: %v", pgType, text)
+		}
+		return MakeTrusted(typ, []byte(value)), nil
+	}
+	// The text, the intervals and the user types are taken as is
+	return MakeTrusted(typ, []byte(text)), nil
+}
+
+// validateNumeric checks the numeric value fits the precision and the scale, the value is rounded to
+// the scale as PostgreSQL does
+func validateNumeric(pgType PostgresType, value string) error {
+	switch strings.ToLower(strings.TrimLeft(value, "+-")) {
+	case "nan", "infinity", "inf":
+		return nil
+	}
+	if !postgresNumeric.MatchString(value) {
+		return fmt.Errorf("invalid %v: %v", pgType, value)
+	}
+	if pgType.Precision < 0 {
+		return nil
+	}
+	number, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return fmt.Errorf("invalid %v: %v", pgType, value)
+	}
+	scale := max(pgType.Scale, 0)
+	// The rounded value must be less than 10^(precision-scale)
+	limit := new(big.Rat).SetInt(pow10(pgType.Precision - scale))
+	limit.Sub(limit, new(big.Rat).SetFrac(big.NewInt(5), pow10(scale+1)))
+	if number.Abs(number).Cmp(limit) >= 0 {
+		return fmt.Errorf("numeric field overflow for %v: %v", pgType, value)
+	}
+	return nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(n, 0))), nil)
+}
+
+var (
+	postgresDateLayouts      = []string{"2006-01-02"}
+	postgresTimeLayouts      = []string{"15:04:05.999999999", "15:04"}
+	postgresTimetzLayouts    = []string{"15:04:05.999999999Z07:00", "15:04:05.999999999Z07", "15:04Z07:00", "15:04Z07"}
+	postgresTimestampLayouts = []string{
+		"2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999", "2006-01-02 15:04", "2006-01-02",
+	}
+	postgresTimestamptzLayouts = []string{
+		"2006-01-02 15:04:05.999999999Z07:00", "2006-01-02 15:04:05.999999999Z07", "2006-01-02T15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999", "2006-01-02",
+	}
+)
+
+// validateDateTime checks the ISO format of the date and the time values, infinity of the dates
+func validateDateTime(pgType PostgresType, value string) error {
+	layouts := map[Oid][]string{
+		OidDate: postgresDateLayouts, OidTime: postgresTimeLayouts, OidTimetz: postgresTimetzLayouts,
+		OidTimestamp: postgresTimestampLayouts, OidTimestamptz: postgresTimestamptzLayouts,
+	}[pgType.Oid]
+	switch strings.ToLower(value) {
+	case "infinity", "-infinity":
+		if pgType.Oid != OidTime && pgType.Oid != OidTimetz {
+			return nil
+		}
+	case "24:00:00", "24:00":
+		if pgType.Oid == OidTime {
+			return nil
+		}
+	}
+	// The era suffix of the dates before Christ
+	value = strings.TrimSuffix(value, " BC")
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %v: %v", pgType, value)
+}
+
+// validateNetwork checks the address (inet) or the network without the host bits (cidr)
+func validateNetwork(pgType PostgresType, value string) error {
+	if !strings.Contains(value, "/") {
+		if net.ParseIP(value) == nil {
+			return fmt.Errorf("invalid %v: %v", pgType, value)
+		}
+		return nil
+	}
+	address, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return fmt.Errorf("invalid %v: %v", pgType, value)
+	}
+	if pgType.Oid == OidCidr && !address.Equal(network.IP) {
+		return fmt.Errorf("invalid %v value has bits set to right of mask: %v", pgType, value)
+	}
+	return nil
+}
+
+// DecodeBytea returns the bytes of the PostgreSQL bytea value in the hex (\x0a0b) or the escape (a\012\\) format
+func DecodeBytea(value string) ([]byte, error) {
+	if strings.HasPrefix(value, `\x`) {
+		return hex.DecodeString(value[2:])
+	}
+	bytes := make([]byte, 0, len(value))
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ch != '\\' {
+			bytes = append(bytes, ch)
+			continue
+		}
+		if i+1 < len(value) && value[i+1] == '\\' {
+			bytes = append(bytes, '\\')
+			i++
+			continue
+		}
+		if i+4 > len(value) {
+			return nil, fmt.Errorf("invalid bytea escape at %v", i)
+		}
+		code := 0
+		for _, digit := range []byte(value[i+1 : i+4]) {
+			if digit < '0' || digit > '7' {
+				return nil, fmt.Errorf("invalid bytea escape at %v", i)
+			}
+			code = code*8 + int(digit-'0')
+		}
+		bytes = append(bytes, byte(code))
+		i += 3
+	}
+	return bytes, nil
+}
+
+// EncodeBytea returns the hex format of the PostgreSQL bytea value
+func EncodeBytea(value []byte) string {
+	return `\x` + hex.EncodeToString(value)
+}
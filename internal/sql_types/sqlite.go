@@ -0,0 +1,81 @@
+package sql_types
+
+import "strings"
+
+// SqliteAffinity is the type affinity of the SQLite column
+type SqliteAffinity uint8
+
+const (
+	SqliteAffinityBlob    SqliteAffinity = 0
+	SqliteAffinityText    SqliteAffinity = 1
+	SqliteAffinityNumeric SqliteAffinity = 2
+	SqliteAffinityInteger SqliteAffinity = 3
+	SqliteAffinityReal    SqliteAffinity = 4
+)
+
+func (affinity SqliteAffinity) String() string {
+	switch affinity {
+	case SqliteAffinityText:
+		return "TEXT"
+	case SqliteAffinityNumeric:
+		return "NUMERIC"
+	case SqliteAffinityInteger:
+		return "INTEGER"
+	case SqliteAffinityReal:
+		return "REAL"
+	}
+	return "BLOB"
+}
+
+// SqliteAffinityOf returns the affinity of the declared type of the column by the rules of SQLite:
+// INT is INTEGER, CHAR, CLOB and TEXT are TEXT, BLOB and no type are BLOB, REAL, FLOA and DOUB are REAL,
+// the other types are NUMERIC
+func SqliteAffinityOf(declaredType string) SqliteAffinity {
+	name := strings.ToUpper(declaredType)
+	switch {
+	case strings.Contains(name, "INT"):
+		return SqliteAffinityInteger
+	case strings.Contains(name, "CHAR"), strings.Contains(name, "CLOB"), strings.Contains(name, "TEXT"):
+		return SqliteAffinityText
+	case strings.Contains(name, "BLOB"), strings.TrimSpace(name) == "":
+		return SqliteAffinityBlob
+	case strings.Contains(name, "REAL"), strings.Contains(name, "FLOA"), strings.Contains(name, "DOUB"):
+		return SqliteAffinityReal
+	}
+	return SqliteAffinityNumeric
+}
+
+// TypeToSqliteAffinity returns the affinity keeping the values of the type, the dates are the texts
+func TypeToSqliteAffinity(typ Type) SqliteAffinity {
+	switch {
+	case IsIntegral(typ), typ == Bit:
+		return SqliteAffinityInteger
+	case IsFloat(typ):
+		return SqliteAffinityReal
+	case typ == Decimal:
+		return SqliteAffinityNumeric
+	case IsBinary(typ), typ == Geometry, typ == HexNum, typ == HexVal, typ == Null:
+		return SqliteAffinityBlob
+	}
+	return SqliteAffinityText
+}
+
+// SqliteAffinityToType returns the type of the values of the affinity
+func SqliteAffinityToType(affinity SqliteAffinity) Type {
+	switch affinity {
+	case SqliteAffinityText:
+		return Text
+	case SqliteAffinityNumeric:
+		return Decimal
+	case SqliteAffinityInteger:
+		return Int64
+	case SqliteAffinityReal:
+		return Float64
+	}
+	return Blob
+}
+
+// SqliteAffinity returns the affinity of the SQLite column of the PostgreSQL type
+func (pgType PostgresType) SqliteAffinity() SqliteAffinity {
+	return TypeToSqliteAffinity(pgType.Type())
+}
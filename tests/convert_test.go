@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConvertMysqlDump(t *testing.T) {
+	dump, err := os.ReadFile("internal/sql_parser/test_data/mysql80_dump.sql")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	dumpFileName := writeDump(t, string(dump)+"CREATE FOOBAR `orders`;\n")
+	outputFileName := filepath.Join(t.TempDir(), "converted.sql")
+
+	exitCode, output := runCommand(t, "convert", "--from", "mysql", "--to", "psql", "-o", outputFileName, dumpFileName)
+	if exitCode != 0 {
+		t.Fatalf("convert exits with %v:\n%s", exitCode, output)
+	}
+	data, err := os.ReadFile(outputFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	converted := string(data)
+
+	// Only the DROP of the view is kept before the view
+	if strings.Contains(converted, "drop table if exists big_orders;") || !strings.Contains(converted, "drop view if exists big_orders;") {
+		t.Errorf("the drops of the view big_orders are wrong:\n%s", converted)
+	}
+	// The statements which can't be converted are left as the comments
+	for _, text := range []string{"TRIGGER `orders_before_insert`", "  IF NEW.`total` < 0 THEN", "PROCEDURE `orders_reset`", "CREATE FOOBAR `orders`"} {
+		found := false
+		for _, line := range strings.Split(converted, "\n") {
+			if !strings.Contains(line, text) {
+				continue
+			}
+			found = true
+			if !strings.HasPrefix(line, "-- ") {
+				t.Errorf("the not converted statement isn't commented out: %v", line)
+			}
+		}
+		if !found {
+			t.Errorf("the not converted statement %v isn't written", text)
+		}
+	}
+}
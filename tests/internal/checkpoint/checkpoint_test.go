@@ -0,0 +1,90 @@
+package checkpoint
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/usalko/prodl/internal/checkpoint"
+)
+
+func TestStateSaveAndLoad(t *testing.T) {
+	stateFileName := filepath.Join(t.TempDir(), "state.json")
+	state := checkpoint.NewState(stateFileName)
+
+	fileCheckpoint, err := state.Checkpoint("dump.sql.gz", "fingerprint1")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	fileCheckpoint.Commit("dump.sql", 0, 120, 4096)
+	if err := state.Save(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	loadedState, err := checkpoint.Load(stateFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	loadedCheckpoint, err := loadedState.Checkpoint("dump.sql.gz", "fingerprint1")
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if loadedCheckpoint.LastCommitted != 120 || loadedCheckpoint.Offset != 4096 || loadedCheckpoint.Entry != "dump.sql" {
+		t.Errorf("unexpected checkpoint %+v", loadedCheckpoint)
+	}
+
+	_, err = loadedState.Checkpoint("dump.sql.gz", "fingerprint2")
+	if !errors.Is(err, checkpoint.ErrFingerprintMismatch) {
+		t.Errorf("expected fingerprint mismatch, but error is %v", err)
+	}
+}
+
+func TestCheckpointIsApplied(t *testing.T) {
+	fileCheckpoint := &checkpoint.Checkpoint{}
+	fileCheckpoint.Commit("dump.sql", 1, 10, 100)
+
+	testcases := []struct {
+		entryIndex int
+		statement  int64
+		applied    bool
+	}{
+		{entryIndex: 0, statement: 1000, applied: true},
+		{entryIndex: 1, statement: 10, applied: true},
+		{entryIndex: 1, statement: 11, applied: false},
+		{entryIndex: 2, statement: 1, applied: false},
+	}
+	for _, tcase := range testcases {
+		if fileCheckpoint.IsApplied(tcase.entryIndex, tcase.statement) != tcase.applied {
+			t.Errorf("entry %v statement %v applied must be %v", tcase.entryIndex, tcase.statement, tcase.applied)
+		}
+	}
+
+	if err := fileCheckpoint.Verify(1, 10, 100); err != nil {
+		t.Errorf("%v", err)
+	}
+	if err := fileCheckpoint.Verify(1, 10, 99); !errors.Is(err, checkpoint.ErrOffsetMismatch) {
+		t.Errorf("expected offset mismatch, but error is %v", err)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "dump.sql")
+	if err := os.WriteFile(fileName, []byte("SELECT 1;"), 0644); err != nil {
+		t.Fatalf("%v", err)
+	}
+	fingerprint1, err := checkpoint.Fingerprint(fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := os.WriteFile(fileName, []byte("SELECT 2;"), 0644); err != nil {
+		t.Fatalf("%v", err)
+	}
+	fingerprint2, err := checkpoint.Fingerprint(fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if fingerprint1 == fingerprint2 {
+		t.Errorf("fingerprints must differ for different content")
+	}
+}
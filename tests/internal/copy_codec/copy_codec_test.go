@@ -0,0 +1,148 @@
+package copy_codec
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/usalko/prodl/internal/copy_codec"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+func encode(t *testing.T, options copy_codec.Options, columns []string, types []sql_types.Type, rows []sql_types.Row) string {
+	data := bytes.Buffer{}
+	encoder := copy_codec.NewEncoder(&data, options, columns, types)
+	for _, row := range rows {
+		require.NoError(t, encoder.Write(row))
+	}
+	require.NoError(t, encoder.Close())
+	return data.String()
+}
+
+func decode(t *testing.T, options copy_codec.Options, columns []string, types []sql_types.Type, data string) []sql_types.Row {
+	rows, err := copy_codec.NewDecoder(strings.NewReader(data), options, columns, types).ReadAll()
+	require.NoError(t, err)
+	return rows
+}
+
+func copyOptions(t *testing.T, statementText string) copy_codec.Options {
+	statement, err := sql_parser.Parse(statementText+";\n\\.", dialect.PSQL)
+	require.NoError(t, err)
+	options, err := copy_codec.NewOptions(statement.(*ast.CopyFrom).With)
+	require.NoError(t, err)
+	return options
+}
+
+func TestText(t *testing.T) {
+	options := copy_codec.DefaultOptions(copy_codec.FORMAT_TEXT)
+	columns := []string{"id", "name", "payload"}
+	types := []sql_types.Type{sql_types.Int32, sql_types.Text, sql_types.VarBinary}
+	data := "1\ttab\\there\t\\\\x00ff\n" +
+		"2\tback\\\\slash \\\\N \\101\\x42\t\\N\n" +
+		"3\t\\N\t\\\\001\\\\\\\\\n" +
+		"\\.\n" +
+		"ignored\n"
+
+	rows := decode(t, options, columns, types, data)
+	require.Equal(t, []sql_types.Row{
+		{sql_types.NewInt32(1), sql_types.MakeTrusted(sql_types.Text, []byte("tab\there")), sql_types.MakeTrusted(sql_types.VarBinary, []byte{0x00, 0xff})},
+		{sql_types.NewInt32(2), sql_types.MakeTrusted(sql_types.Text, []byte(`back\slash \N AB`)), sql_types.NULL},
+		{sql_types.NewInt32(3), sql_types.NULL, sql_types.MakeTrusted(sql_types.VarBinary, []byte{0x01, '\\'})},
+	}, rows)
+
+	encoded := encode(t, options, columns, types, rows)
+	require.Equal(t, "1\ttab\\there\t\\\\x00ff\n"+
+		"2\tback\\\\slash \\\\N AB\t\\N\n"+
+		"3\t\\N\t\\\\x015c\n", encoded)
+	require.Equal(t, rows, decode(t, options, columns, types, encoded))
+
+	_, err := copy_codec.NewDecoder(strings.NewReader("x\n"), options, columns, types).ReadAll()
+	require.ErrorIs(t, err, copy_codec.ErrMalformedData)
+}
+
+func TestCsv(t *testing.T) {
+	options := copyOptions(t, "COPY t (a, b, c) FROM stdin WITH (FORMAT csv, DELIMITER ';', QUOTE '''', ESCAPE '!', "+
+		"NULL 'null', HEADER true, FORCE_QUOTE (a), FORCE_NOT_NULL (b), FORCE_NULL (c))")
+	require.Equal(t, copy_codec.FORMAT_CSV, options.Format)
+	require.Equal(t, byte(';'), options.Delimiter)
+	require.Equal(t, byte('\''), options.Quote)
+	require.Equal(t, byte('!'), options.Escape)
+	require.Equal(t, "null", options.Null)
+	require.True(t, options.Header)
+	require.Equal(t, []string{"a"}, options.ForceQuote)
+
+	columns := []string{"a", "b", "c"}
+	data := "a;b;c\n" +
+		"1;null;'null'\n" +
+		"'it!'s;\r\nmultiline';'back!!slash';null\r\n" +
+		"\\.\n"
+	rows := decode(t, options, columns, nil, data)
+	require.Equal(t, []sql_types.Row{
+		{sql_types.NewVarChar("1"), sql_types.NewVarChar("null"), sql_types.NULL},
+		{sql_types.NewVarChar("it's;\r\nmultiline"), sql_types.NewVarChar("back!slash"), sql_types.NULL},
+	}, rows)
+
+	encoded := encode(t, options, columns, nil, rows)
+	require.Equal(t, "a;b;c\n"+
+		"'1';'null';null\n"+
+		"'it!'s;\r\nmultiline';back!slash;null\n", encoded)
+
+	options = copy_codec.DefaultOptions(copy_codec.FORMAT_CSV)
+	rows = decode(t, options, nil, nil, "\"say \"\"hi\"\"\",,\"\"\n")
+	require.Equal(t, []sql_types.Row{{sql_types.NewVarChar(`say "hi"`), sql_types.NULL, sql_types.NewVarChar("")}}, rows)
+	require.Equal(t, "\"say \"\"hi\"\"\",,\"\"\n", encode(t, options, nil, nil, rows))
+
+	_, err := copy_codec.NewDecoder(strings.NewReader("\"open\n"), options, nil, nil).ReadAll()
+	require.ErrorIs(t, err, copy_codec.ErrMalformedData)
+}
+
+func TestBinary(t *testing.T) {
+	options := copyOptions(t, "COPY t FROM stdin WITH (FORMAT binary)")
+	require.Equal(t, copy_codec.FORMAT_BINARY, options.Format)
+
+	types := []sql_types.Type{
+		sql_types.Int8, sql_types.Int16, sql_types.Int32, sql_types.Int64, sql_types.Float64,
+		sql_types.Decimal, sql_types.Date, sql_types.Timestamp, sql_types.Text, sql_types.VarBinary,
+	}
+	rows := []sql_types.Row{
+		{
+			sql_types.MakeTrusted(sql_types.Int8, []byte("1")), sql_types.MakeTrusted(sql_types.Int16, []byte("-2")),
+			sql_types.NewInt32(70000), sql_types.NewInt64(-9000000000), sql_types.NewFloat64(1.5),
+			sql_types.NewDecimal("-12345.0670"), sql_types.NewDate("1999-12-31"), sql_types.NewTimestamp("2024-02-29 13:45:01.5"),
+			sql_types.MakeTrusted(sql_types.Text, []byte("text")), sql_types.MakeTrusted(sql_types.VarBinary, []byte{0, 1, 2}),
+		},
+		{
+			sql_types.MakeTrusted(sql_types.Int8, []byte("0")), sql_types.NULL, sql_types.NULL, sql_types.NULL,
+			sql_types.NULL, sql_types.NewDecimal("0.001"), sql_types.NULL, sql_types.NULL, sql_types.NULL, sql_types.NULL,
+		},
+	}
+	encoded := encode(t, options, nil, types, rows)
+	require.True(t, strings.HasPrefix(encoded, copy_codec.BINARY_SIGNATURE))
+	require.True(t, strings.HasSuffix(encoded, "\xff\xff"))
+	// numeric -12345.0670: 3 digits, weight 1, negative, scale 4, digits 1 2345 0670
+	require.Contains(t, encoded, "\x00\x00\x00\x0e\x00\x03\x00\x01\x40\x00\x00\x04\x00\x01\x09\x29\x02\x9e")
+	require.Equal(t, rows, decode(t, options, nil, types, encoded))
+
+	untyped := decode(t, options, nil, nil, encoded)
+	require.Equal(t, sql_types.MakeTrusted(sql_types.VarBinary, []byte("text")), untyped[0][8])
+
+	_, err := copy_codec.NewDecoder(strings.NewReader("PGCOPY\n"), options, nil, nil).ReadAll()
+	require.ErrorIs(t, err, copy_codec.ErrMalformedData)
+}
+
+func TestNewOptions(t *testing.T) {
+	options := copyOptions(t, "COPY t (a, b) FROM stdin WITH (FORMAT csv, FORCE_QUOTE *)")
+	require.Equal(t, byte('"'), options.Escape)
+	require.Empty(t, options.ForceQuote)
+	require.Equal(t, "\"1\",\"2\"\n", encode(t, options, []string{"a", "b"}, nil,
+		[]sql_types.Row{{sql_types.NewVarChar("1"), sql_types.NewVarChar("2")}}))
+
+	_, err := copy_codec.NewOptions(ast.CopyOptions{{Type: ast.CopyOptionDelimiter, Value: "ab"}})
+	require.ErrorIs(t, err, copy_codec.ErrUnsupportedOption)
+	_, err = copy_codec.ParseFormat("xml")
+	require.Error(t, err)
+}
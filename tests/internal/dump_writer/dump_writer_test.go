@@ -0,0 +1,119 @@
+package dump_writer
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/usalko/prodl/internal/dump_writer"
+)
+
+func readFile(t *testing.T, fileName string) string {
+	file, err := os.Open(fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer file.Close()
+	var reader io.Reader = file
+	switch compression, _ := dump_writer.CompressionOf(fileName); compression {
+	case dump_writer.COMPRESSION_GZIP:
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		reader = gzipReader
+	case dump_writer.COMPRESSION_ZSTD:
+		decoder, err := zstd.NewReader(file)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		defer decoder.Close()
+		reader = decoder
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return string(content)
+}
+
+func TestWriteGzip(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "out.sql.gz")
+	dumpWriter, err := dump_writer.Create(fileName, false)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := dumpWriter.Write("", "SET client_encoding = 'UTF8'"); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := dumpWriter.WriteComment("posts", "skip statement"); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := dumpWriter.Write("posts", "\nCOPY posts (id, title) FROM stdin;\n1\tHello\n\\."); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := dumpWriter.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expected := "SET client_encoding = 'UTF8';\n\n" +
+		"-- skip statement\n" +
+		"COPY posts (id, title) FROM stdin;\n1\tHello\n\\.\n\n"
+	if content := readFile(t, fileName); content != expected {
+		t.Errorf("dump file content is\n%q\nbut expected\n%q", content, expected)
+	}
+}
+
+func TestWriteSplitPerTable(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "out.sql.zst")
+	dumpWriter, err := dump_writer.Create(fileName, true)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	statements := []struct {
+		table string
+		text  string
+	}{
+		{"", "create type mood as enum ('sad', 'ok')"},
+		{"users", "create table users (id integer)"},
+		{"public.posts", "create table posts (id integer, user_id integer)"},
+		{"users", "insert into users values (1);"},
+		{"", "alter table posts add foreign key (user_id) references users (id)"},
+	}
+	for _, statement := range statements {
+		if err := dumpWriter.Write(statement.table, statement.text); err != nil {
+			t.Fatalf("%v", err)
+		}
+	}
+	if err := dumpWriter.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	expected := map[string]string{
+		fileName: "create type mood as enum ('sad', 'ok');\n\n",
+		dumpWriter.TableFileName("users"): "create table users (id integer);\n\n" +
+			"insert into users values (1);\n\n",
+		dumpWriter.TableFileName("public.posts"):             "create table posts (id integer, user_id integer);\n\n",
+		dumpWriter.TableFileName(dump_writer.POST_DATA_NAME): "alter table posts add foreign key (user_id) references users (id);\n\n",
+	}
+	if len(dumpWriter.FileNames()) != len(expected) {
+		t.Errorf("dump files are %v but expected %v files", dumpWriter.FileNames(), len(expected))
+	}
+	for expectedFileName, expectedContent := range expected {
+		if content := readFile(t, expectedFileName); content != expectedContent {
+			t.Errorf("dump file %v content is\n%q\nbut expected\n%q", expectedFileName, content, expectedContent)
+		}
+	}
+	if name := filepath.Base(dumpWriter.TableFileName("public.posts")); name != "out.public.posts.sql.zst" {
+		t.Errorf("table file name is %v", name)
+	}
+}
+
+func TestSplitPerTableRequiresFileName(t *testing.T) {
+	if _, err := dump_writer.Create("", true); err == nil {
+		t.Errorf("split per table to the standard output must fail")
+	}
+}
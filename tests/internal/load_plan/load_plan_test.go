@@ -0,0 +1,297 @@
+package load_plan
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/usalko/prodl/internal/load_plan"
+	"github.com/usalko/prodl/internal/reject_file"
+	"github.com/usalko/prodl/internal/sql_connection"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+func TestSpool(t *testing.T) {
+	spool, err := load_plan.NewSpool(t.TempDir())
+	require.NoError(t, err)
+	defer spool.Close()
+
+	records := []load_plan.Record{
+		{Position: reject_file.Position{FileName: "dump.sql.gz", Entry: "dump.sql", Statement: 1, Offset: 0, Line: 1}, Text: "INSERT INTO t VALUES (1);"},
+		{Position: reject_file.Position{FileName: "dump.sql.gz", Entry: "dump.sql", Statement: 2, Offset: 26, Line: 2}, Text: ""},
+		{Text: "COPY t (a) FROM stdin;\n1\n\\."},
+	}
+	offsets := make([]int64, 0, len(records))
+	for _, record := range records {
+		offset, err := spool.Write(record)
+		require.NoError(t, err)
+		offsets = append(offsets, offset)
+	}
+	require.NoError(t, spool.Flush())
+	for i := len(records) - 1; i >= 0; i-- {
+		record, err := spool.Read(offsets[i])
+		require.NoError(t, err)
+		require.Equal(t, records[i], record)
+	}
+	_, err = spool.Read(spool.Size() + 1)
+	require.ErrorIs(t, err, load_plan.ErrMalformedSpool)
+}
+
+func TestPlan(t *testing.T) {
+	plan, err := load_plan.NewPlan(t.TempDir())
+	require.NoError(t, err)
+	defer plan.Close()
+
+	statements := []struct {
+		text    string
+		spooled bool
+	}{
+		{"SET client_encoding = 'UTF8';", false},
+		{"SELECT pg_catalog.set_config('search_path', '', false);", false},
+		{"CREATE TABLE public.a (id integer NOT NULL);", false},
+		{"CREATE TABLE public.b (id integer, a_id integer REFERENCES public.a (id));", false},
+		{"CREATE TABLE public.c (id integer);", false},
+		{"CREATE SEQUENCE public.c_id_seq;", false},
+		{"ALTER TABLE ONLY public.c ALTER COLUMN id SET DEFAULT nextval('public.c_id_seq'::regclass);", false},
+		{"INSERT INTO public.b VALUES (1, 1);", true},
+		{"INSERT INTO public.a VALUES (1);", true},
+		{"INSERT INTO public.c VALUES (1);", true},
+		{"INSERT INTO public.a VALUES (2);", true},
+		{"SET statement_timeout = 0;", false},
+		{"SELECT pg_catalog.setval('public.c_id_seq', 1, true);", true},
+		{"CREATE INDEX a_id ON public.a USING btree (id);", true},
+		{"ALTER TABLE ONLY public.a ADD CONSTRAINT a_pkey PRIMARY KEY (id);", true},
+		{"CREATE VIEW public.v AS SELECT 1;", false},
+	}
+	for i, statement := range statements {
+		parsed, err := sql_parser.Parse(statement.text, dialect.PSQL)
+		require.NoError(t, err, statement.text)
+		spooled, err := plan.Add(parsed, []string{statement.text}, reject_file.Position{Statement: int64(i + 1)})
+		require.NoError(t, err)
+		require.Equal(t, statement.spooled, spooled, statement.text)
+	}
+	require.Equal(t, []string{statements[0].text, statements[1].text, statements[11].text}, plan.Session())
+
+	jobs := []string{}
+	for _, job := range plan.Jobs() {
+		jobs = append(jobs, job.String())
+	}
+	require.Equal(t, []string{
+		"data of b (1 statements)",
+		"data of a (2 statements)",
+		"data of c (1 statements)",
+		"post-data of c (1 statements)",
+		"post-data of a (1 statements)",
+		"post-data of a (1 statements)",
+	}, jobs)
+
+	// The index and the primary key of a follow its data, the data of b doesn't wait for a
+	// because the data of b precedes the data of a in the dump
+	mutex := sync.Mutex{}
+	executed := []string{}
+	err = plan.Run(3, func(worker int, job *load_plan.Job) error {
+		return plan.Records(job, func(record load_plan.Record) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			executed = append(executed, record.Text)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Len(t, executed, 7)
+	order := map[string]int{}
+	for i, text := range executed {
+		order[text] = i
+	}
+	require.Less(t, order[statements[8].text], order[statements[10].text])
+	require.Less(t, order[statements[10].text], order[statements[13].text])
+	require.Less(t, order[statements[13].text], order[statements[14].text])
+	require.Less(t, order[statements[9].text], order[statements[12].text])
+}
+
+func TestPlanMysqlDumpSession(t *testing.T) {
+	for _, fileName := range []string{"../sql_parser/test_data/mysql57_dump.sql", "../sql_parser/test_data/mysql80_dump.sql"} {
+		t.Run(fileName, func(t *testing.T) {
+			plan, err := load_plan.NewPlan(t.TempDir())
+			require.NoError(t, err)
+			defer plan.Close()
+			file, err := os.Open(fileName)
+			require.NoError(t, err)
+			defer file.Close()
+
+			session := []string{}
+			sessionAfterJobs := 0
+			statementsCount := int64(0)
+			err = sql_parser.StatementStream(file, dialect.MYSQL, func(statementText string, statement ast.Statement, parseError error) {
+				require.NoError(t, parseError, statementText)
+				statementsCount++
+				spooled, err := plan.Add(statement, []string{statementText}, reject_file.Position{Statement: statementsCount})
+				require.NoError(t, err)
+				if kind, _ := sql_connection.ClassifyStatement(statementText); kind == sql_connection.STATEMENT_SESSION {
+					require.False(t, spooled, statementText)
+					session = append(session, statementText)
+					if len(plan.Jobs()) > 0 {
+						sessionAfterJobs++
+					}
+				}
+			})
+			require.NoError(t, err)
+			// The SET of the conditional comments, USE and the settings following the first job are replayed
+			// by the workers in the order of the dump, the server settings aren't
+			require.Equal(t, session, plan.Session())
+			require.Positive(t, sessionAfterJobs)
+			texts := strings.Join(plan.Session(), "\n")
+			require.Contains(t, texts, "/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;")
+			require.Contains(t, texts, "\nUSE `shop`;")
+			require.NotContains(t, texts, "GTID_PURGED")
+		})
+	}
+}
+
+func TestPlanForeignKeys(t *testing.T) {
+	plan, err := load_plan.NewPlan(t.TempDir())
+	require.NoError(t, err)
+	defer plan.Close()
+
+	for _, text := range []string{
+		"CREATE TABLE a (id integer PRIMARY KEY);",
+		"CREATE TABLE b (id integer, a_id integer, FOREIGN KEY (a_id) REFERENCES a (id));",
+		"INSERT INTO a VALUES (1);",
+		"INSERT INTO b VALUES (1, 1);",
+		"INSERT INTO a VALUES (2);",
+	} {
+		parsed, err := sql_parser.Parse(text, dialect.PSQL)
+		require.NoError(t, err, text)
+		_, err = plan.Add(parsed, []string{text}, reject_file.Position{})
+		require.NoError(t, err)
+	}
+	require.Len(t, plan.Jobs(), 2)
+
+	mutex := sync.Mutex{}
+	done := map[string]bool{}
+	err = plan.Run(2, func(worker int, job *load_plan.Job) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if job.Table == "b" && !done["a"] {
+			return fmt.Errorf("data of b is started before the data of a is done")
+		}
+		done[job.Table] = true
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, done["a"] && done["b"])
+
+	// The error stops the start of the new jobs
+	plan, err = load_plan.NewPlan(t.TempDir())
+	require.NoError(t, err)
+	defer plan.Close()
+	for _, text := range []string{"INSERT INTO a VALUES (1);", "CREATE INDEX i ON a (id);"} {
+		parsed, _ := sql_parser.Parse(text, dialect.PSQL)
+		_, err = plan.Add(parsed, []string{text}, reject_file.Position{})
+		require.NoError(t, err)
+	}
+	started := 0
+	err = plan.Run(2, func(worker int, job *load_plan.Job) error {
+		started++
+		return fmt.Errorf("job fail")
+	})
+	require.EqualError(t, err, "job fail")
+	require.Equal(t, 1, started)
+}
+
+func TestPlanPostDataStatements(t *testing.T) {
+	plan, err := load_plan.NewPlan(t.TempDir())
+	require.NoError(t, err)
+	defer plan.Close()
+
+	statements := []struct {
+		text    string
+		spooled bool
+	}{
+		{"CREATE TABLE public.a (id integer);", false},
+		{"CREATE TABLE public.b (id integer, a_id integer);", false},
+		{"CREATE TABLE public.audit (id integer);", false},
+		{"INSERT INTO public.a VALUES (1);", true},
+		{"INSERT INTO public.b VALUES (1, 1);", true},
+		{"INSERT INTO public.audit VALUES (1);", true},
+		{"CREATE TRIGGER b_check AFTER INSERT ON public.b FOR EACH ROW WHEN (new.a_id > 0) EXECUTE FUNCTION public.check_a();", true},
+		{"CREATE POLICY b_policy ON public.b USING (a_id IN (SELECT id FROM public.a));", true},
+		{"CREATE RULE a_audit AS ON INSERT TO public.a DO ALSO INSERT INTO public.audit VALUES (new.id);", true},
+		{"the statement which isn't parsed;", true},
+		{"the next statement which isn't parsed;", true},
+	}
+	for i, statement := range statements {
+		parsed, _ := sql_parser.Parse(statement.text, dialect.PSQL)
+		if i < len(statements)-2 {
+			require.NotNil(t, parsed, statement.text)
+		} else {
+			parsed = nil
+		}
+		spooled, err := plan.Add(parsed, []string{statement.text}, reject_file.Position{Statement: int64(i + 1)})
+		require.NoError(t, err)
+		require.Equal(t, statement.spooled, spooled, statement.text)
+	}
+
+	jobs := []string{}
+	for _, job := range plan.Jobs() {
+		jobs = append(jobs, job.String())
+	}
+	require.Equal(t, []string{
+		"data of a (1 statements)",
+		"data of b (1 statements)",
+		"data of audit (1 statements)",
+		"post-data of b (1 statements)",
+		"post-data of b (1 statements)",
+		"post-data of a (1 statements)",
+		"post-data of the unparsed statements (2 statements)",
+	}, jobs)
+
+	// The policy on b waits for the data of a, the rule on a waits for the data of audit,
+	// the unparsed statements wait for all jobs
+	mutex := sync.Mutex{}
+	done := map[string]bool{}
+	err = plan.Run(4, func(worker int, job *load_plan.Job) error {
+		return plan.Records(job, func(record load_plan.Record) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, dependency := range map[int][]int{8: {4}, 9: {4, 6}, 10: {4, 5, 6, 7, 8, 9}}[int(record.Position.Statement)] {
+				if !done[statements[dependency-1].text] {
+					return fmt.Errorf("%v is executed before %v", record.Text, statements[dependency-1].text)
+				}
+			}
+			done[record.Text] = true
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Len(t, done, 8)
+}
+
+func TestStatementTextTable(t *testing.T) {
+	testcases := []struct {
+		in    string
+		table string
+	}{
+		{in: "INSERT INTO t VALUES (1);", table: "t"},
+		{in: "-- data\nINSERT IGNORE INTO `shop`.`orders` VALUES (1),(2);", table: "shop.orders"},
+		{in: "REPLACE INTO orders (id) VALUES (1);", table: "orders"},
+		{in: "COPY public.\"Order Items\" (id, name) FROM stdin;\n1\tfirst\n\\.", table: "public.Order Items"},
+		{in: "insert into \"t\"(id) values (1);", table: "t"},
+		{in: "UPDATE t SET id = 1;", table: ""},
+		{in: "CREATE TABLE t (id integer);", table: ""},
+	}
+	for _, tcase := range testcases {
+		require.Equal(t, tcase.table, load_plan.StatementTextTable(tcase.in), tcase.in)
+	}
+	// The table of the text is the table of the parsed statement
+	for _, text := range []string{"INSERT INTO public.t VALUES (1);", "COPY public.t (id) FROM stdin;\n1\n\\."} {
+		statement, err := sql_parser.Parse(text, dialect.PSQL)
+		require.NoError(t, err)
+		require.Equal(t, load_plan.StatementTable(statement), load_plan.StatementTextTable(text))
+	}
+}
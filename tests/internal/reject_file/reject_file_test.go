@@ -0,0 +1,84 @@
+package reject_file
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/usalko/prodl/internal/reject_file"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+func TestRejectStatement(t *testing.T) {
+	rejectFileName := filepath.Join(t.TempDir(), "rejected.sql")
+	rejectFile, err := reject_file.Open(rejectFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	position := reject_file.Position{FileName: "dump.sql.gz", Entry: "dump.sql", Statement: 3, Offset: 120, Line: 7}
+	err = rejectFile.Reject(position, "\n-- insert\nINSERT INTO t VALUES (1)", nil, errors.New("duplicate key\ndetail: id=1"))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := rejectFile.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	content, err := os.ReadFile(rejectFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	expected := "-- file: dump.sql.gz, entry: dump.sql, statement: 3, offset: 120, line: 7\n" +
+		"-- error: duplicate key\n" +
+		"-- error: detail: id=1\n" +
+		"INSERT INTO t VALUES (1);\n\n"
+	if string(content) != expected {
+		t.Errorf("reject file content is\n%q\nbut expected\n%q", content, expected)
+	}
+}
+
+func TestRejectCopy(t *testing.T) {
+	rejectFileName := filepath.Join(t.TempDir(), "rejected.sql")
+	rejectFile, err := reject_file.Open(rejectFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	copyText := "\nCOPY public.articles (id, title) FROM stdin;\n1\tArticle 1\n\\."
+	statement, err := sql_parser.Parse(copyText, dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	position := reject_file.Position{FileName: "dump.sql", Entry: "dump.sql", Statement: 1}
+	if err := rejectFile.Reject(position, copyText, statement, errors.New("bad row")); err != nil {
+		t.Fatalf("%v", err)
+	}
+	// Not parsed statement (split-only mode) goes to the same table file
+	if err := rejectFile.Reject(position, copyText, nil, errors.New("bad row")); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := rejectFile.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	tableFileName := rejectFile.TableFileName("public.articles")
+	if tableFileName != filepath.Join(filepath.Dir(rejectFileName), "rejected.public.articles.sql") {
+		t.Errorf("unexpected table reject file name %v", tableFileName)
+	}
+	content, err := os.ReadFile(tableFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if strings.Count(string(content), "COPY public.articles (id, title) FROM stdin;\n1\tArticle 1\n\\.\n") != 2 {
+		t.Errorf("unexpected table reject file content %q", content)
+	}
+	mainContent, err := os.ReadFile(rejectFileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if len(mainContent) != 0 {
+		t.Errorf("unexpected reject file content %q", mainContent)
+	}
+}
@@ -0,0 +1,134 @@
+package sql_connection
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/usalko/prodl/internal/sql_connection"
+	"github.com/usalko/prodl/internal/sql_parser_errors"
+)
+
+func TestClassifyError(t *testing.T) {
+	testcases := []struct {
+		in        error
+		code      int32
+		state     sql_parser_errors.State
+		transient bool
+	}{
+		{in: &pgconn.PgError{Code: "40001"}, code: sql_parser_errors.Code_ABORTED, state: sql_parser_errors.SerializationFailure, transient: true},
+		{in: &pgconn.PgError{Code: "40P01"}, code: sql_parser_errors.Code_ABORTED, state: sql_parser_errors.Deadlock, transient: true},
+		{in: &pgconn.PgError{Code: "23505"}, code: sql_parser_errors.Code_ALREADY_EXISTS, state: sql_parser_errors.DupEntry},
+		{in: &pgconn.PgError{Code: "23503"}, code: sql_parser_errors.Code_FAILED_PRECONDITION, state: sql_parser_errors.ForeignKeyViolation},
+		{in: &mysql.MySQLError{Number: 1213}, code: sql_parser_errors.Code_ABORTED, state: sql_parser_errors.Deadlock, transient: true},
+		{in: &mysql.MySQLError{Number: 1062}, code: sql_parser_errors.Code_ALREADY_EXISTS, state: sql_parser_errors.DupEntry},
+		{in: &mysql.MySQLError{Number: 1146}, code: sql_parser_errors.Code_NOT_FOUND, state: sql_parser_errors.NoSuchTable},
+		{in: sqlite3.Error{Code: sqlite3.ErrBusy}, code: sql_parser_errors.Code_ABORTED, state: sql_parser_errors.LockWaitTimeout, transient: true},
+		{in: sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintForeignKey}, code: sql_parser_errors.Code_FAILED_PRECONDITION, state: sql_parser_errors.ForeignKeyViolation},
+		{in: fmt.Errorf("execute: %w", syscall.ECONNRESET), code: sql_parser_errors.Code_UNAVAILABLE, state: sql_parser_errors.ServerNotAvailable, transient: true},
+		{in: errors.New("unknown"), code: sql_parser_errors.Code_UNKNOWN, state: sql_parser_errors.Undefined},
+	}
+	for _, tcase := range testcases {
+		err := sql_connection.ClassifyError(tcase.in)
+		if code := sql_parser_errors.Code(err); code != tcase.code {
+			t.Errorf("ClassifyError(%v): code %v, want %v", tcase.in, code, tcase.code)
+		}
+		if state := sql_parser_errors.ErrState(err); state != tcase.state {
+			t.Errorf("ClassifyError(%v): state %v, want %v", tcase.in, state, tcase.state)
+		}
+		if err.Error() != tcase.in.Error() {
+			t.Errorf("ClassifyError(%v): message %v", tcase.in, err)
+		}
+		if transient := sql_connection.IsTransient(tcase.in); transient != tcase.transient {
+			t.Errorf("IsTransient(%v): %v, want %v", tcase.in, transient, tcase.transient)
+		}
+	}
+
+	// The deadlock which rolled back the statements executed before isn't transient
+	lost := fmt.Errorf("%w (the transaction is rolled back, 3 %w)", &mysql.MySQLError{Number: 1213}, sql_connection.ErrTransactionLost)
+	if sql_connection.IsTransient(lost) {
+		t.Errorf("IsTransient(%v): true, want false", lost)
+	}
+
+	connection, _ := openSqlite(t, "none")
+	defer connection.Close()
+	execute(t, connection, "CREATE TABLE t (id integer primary key)", "INSERT INTO t VALUES (1)")
+	err := sql_connection.ClassifyError(connection.Execute("INSERT INTO t VALUES (1)"))
+	if code := sql_parser_errors.Code(err); code != sql_parser_errors.Code_ALREADY_EXISTS {
+		t.Errorf("duplicate key: code %v, want %v", code, sql_parser_errors.Code_ALREADY_EXISTS)
+	}
+	err = sql_connection.ClassifyError(connection.Execute("INSERT INTO missing VALUES (1)"))
+	if state := sql_parser_errors.ErrState(err); state != sql_parser_errors.NoSuchTable {
+		t.Errorf("missing table: state %v, want %v", state, sql_parser_errors.NoSuchTable)
+	}
+}
+
+// failingConnection fails the statement with the queued errors, then succeeds
+type failingConnection struct {
+	sql_connection.SqlConnection
+	errors     []error
+	executions int
+}
+
+func (connection *failingConnection) Execute(rawSql string) error {
+	connection.executions++
+	if len(connection.errors) == 0 {
+		return nil
+	}
+	err := connection.errors[0]
+	connection.errors = connection.errors[1:]
+	return err
+}
+
+func TestErrorPolicy(t *testing.T) {
+	testcases := []struct {
+		in     string
+		policy sql_connection.ErrorPolicy
+		err    bool
+	}{
+		{in: "skip", policy: sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_SKIP}},
+		{in: "Stop", policy: sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_STOP}},
+		{in: "retry", policy: sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_RETRY, Retries: sql_connection.DEFAULT_RETRIES, Delay: sql_connection.DEFAULT_RETRY_DELAY}},
+		{in: "retry:3", policy: sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_RETRY, Retries: 3, Delay: sql_connection.DEFAULT_RETRY_DELAY}},
+		{in: "retry:0", err: true},
+		{in: "ignore", err: true},
+	}
+	for _, tcase := range testcases {
+		policy, err := sql_connection.ParseErrorPolicy(tcase.in)
+		if tcase.err {
+			if err == nil {
+				t.Errorf("ParseErrorPolicy(%v): %v, want error", tcase.in, policy)
+			}
+			continue
+		}
+		if err != nil || policy != tcase.policy {
+			t.Errorf("ParseErrorPolicy(%v): %v %v, want %v", tcase.in, policy, err, tcase.policy)
+		}
+	}
+
+	busy := sqlite3.Error{Code: sqlite3.ErrBusy}
+	retry := sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_RETRY, Retries: 2, Delay: time.Microsecond}
+
+	connection := &failingConnection{errors: []error{busy, busy}}
+	if retries, err := retry.Execute(connection, "INSERT"); retries != 2 || err != nil {
+		t.Errorf("retry:2 of two busy errors: %v retries %v, want 2 retries and no error", retries, err)
+	}
+	connection = &failingConnection{errors: []error{busy, busy, busy}}
+	if retries, err := retry.Execute(connection, "INSERT"); retries != 2 || sql_parser_errors.Code(err) != sql_parser_errors.Code_ABORTED {
+		t.Errorf("retry:2 of three busy errors: %v retries %v, want 2 retries and the busy error", retries, err)
+	}
+	connection = &failingConnection{errors: []error{&mysql.MySQLError{Number: 1062}}}
+	if retries, err := retry.Execute(connection, "INSERT"); retries != 0 || err == nil || connection.executions != 1 {
+		t.Errorf("retry:2 of the duplicate key: %v retries %v, want no retries", retries, err)
+	}
+	connection = &failingConnection{errors: []error{busy}}
+	skip := sql_connection.ErrorPolicy{Kind: sql_connection.ON_ERROR_SKIP}
+	if retries, err := skip.Execute(connection, "INSERT"); retries != 0 || err == nil {
+		t.Errorf("skip of the busy error: %v retries %v, want the busy error", retries, err)
+	}
+}
@@ -0,0 +1,54 @@
+package sql_connection
+
+import (
+	"testing"
+
+	"github.com/usalko/prodl/internal/sql_connection"
+)
+
+func TestClassifyStatement(t *testing.T) {
+	testcases := []struct {
+		in   string
+		kind sql_connection.StatementKind
+		name string
+	}{
+		{in: "SET statement_timeout = 0;", kind: sql_connection.STATEMENT_SESSION, name: "statement_timeout"},
+		{in: "\n-- comment\nset search_path=public", kind: sql_connection.STATEMENT_SESSION, name: "search_path"},
+		{in: "SET SESSION client_encoding TO 'UTF8'", kind: sql_connection.STATEMENT_SESSION, name: "client_encoding"},
+		{in: "SET SESSION AUTHORIZATION 'postgres'", kind: sql_connection.STATEMENT_SESSION, name: "authorization"},
+		{in: "SET LOCAL work_mem = '1GB'", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "SET CONSTRAINTS ALL DEFERRED", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "RESET search_path", kind: sql_connection.STATEMENT_SESSION, name: "search_path"},
+		{in: "RESET ALL", kind: sql_connection.STATEMENT_SESSION},
+		{in: "SELECT pg_catalog.set_config('search_path', '', false);", kind: sql_connection.STATEMENT_SESSION, name: "search_path"},
+		{in: "SELECT set_config('search_path', '', true)", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "SELECT pg_catalog.setval('public.t_id_seq', 1, false);", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "BEGIN;", kind: sql_connection.STATEMENT_TRANSACTION},
+		{in: "/* block */ commit", kind: sql_connection.STATEMENT_TRANSACTION},
+		{in: "START TRANSACTION", kind: sql_connection.STATEMENT_TRANSACTION},
+		{in: "CREATE INDEX CONCURRENTLY i ON t (a)", kind: sql_connection.STATEMENT_NON_TRANSACTIONAL},
+		{in: "CREATE UNIQUE INDEX CONCURRENTLY i ON t (a)", kind: sql_connection.STATEMENT_NON_TRANSACTIONAL},
+		{in: "CREATE INDEX i ON t (a)", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "create database db", kind: sql_connection.STATEMENT_NON_TRANSACTIONAL},
+		{in: "VACUUM ANALYZE t", kind: sql_connection.STATEMENT_NON_TRANSACTIONAL},
+		{in: "INSERT INTO t VALUES (1)", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "-- only comment", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "/*!40101 SET NAMES utf8 */;", kind: sql_connection.STATEMENT_SESSION, name: "names"},
+		{in: "/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;", kind: sql_connection.STATEMENT_SESSION, name: "@old_unique_checks"},
+		{in: "/*!50003 SET character_set_client  = utf8mb4 */ ;", kind: sql_connection.STATEMENT_SESSION, name: "character_set_client"},
+		{in: "/*M!100616 SET @OLD_NOTE_VERBOSITY=@@NOTE_VERBOSITY, NOTE_VERBOSITY=0 */;", kind: sql_connection.STATEMENT_SESSION, name: "@old_note_verbosity"},
+		{in: "/*!40000 ALTER TABLE `t` DISABLE KEYS */;", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "USE `shop`;", kind: sql_connection.STATEMENT_SESSION, name: "database"},
+		{in: "SET @@GLOBAL.GTID_PURGED=/*!80000 '+'*/ 'uuid:1-58';", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "SET GLOBAL max_connections = 10", kind: sql_connection.STATEMENT_REGULAR},
+		{in: "PRAGMA foreign_keys=OFF;", kind: sql_connection.STATEMENT_SESSION, name: "foreign_keys"},
+		{in: "PRAGMA writable_schema = on;", kind: sql_connection.STATEMENT_SESSION, name: "writable_schema"},
+	}
+	for _, tcase := range testcases {
+		kind, name := sql_connection.ClassifyStatement(tcase.in)
+		if kind != tcase.kind || name != tcase.name {
+			t.Errorf("ClassifyStatement(%q): %v %q, want %v %q", tcase.in, kind, name, tcase.kind, tcase.name)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package sql_connection
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/usalko/prodl/internal/sql_connection"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+func TestParseTransactionMode(t *testing.T) {
+	testcases := []struct {
+		in   string
+		mode sql_connection.TransactionMode
+		err  bool
+	}{
+		{in: "none", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_NONE}},
+		{in: "Single", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_SINGLE}},
+		{in: "per-entry", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_PER_ENTRY}},
+		{in: "per-table", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_PER_TABLE}},
+		{in: "batch:50", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_BATCH, BatchSize: 50}},
+		{in: "batch", mode: sql_connection.TransactionMode{Kind: sql_connection.TRANSACTION_BATCH, BatchSize: sql_connection.DEFAULT_TRANSACTION_BATCH_SIZE}},
+		{in: "batch:0", err: true},
+		{in: "batch:x", err: true},
+		{in: "table", err: true},
+	}
+	for _, tcase := range testcases {
+		mode, err := sql_connection.ParseTransactionMode(tcase.in)
+		if tcase.err {
+			if err == nil {
+				t.Errorf("ParseTransactionMode(%v): %v, want error", tcase.in, mode)
+			}
+			continue
+		}
+		if err != nil || mode != tcase.mode {
+			t.Errorf("ParseTransactionMode(%v): %v %v, want %v", tcase.in, mode, err, tcase.mode)
+		}
+	}
+}
+
+func openSqlite(t *testing.T, mode string) (sql_connection.SqlConnection, string) {
+	fileName := filepath.Join(t.TempDir(), "test.sqlite3")
+	connection, err := sql_connection.Connect(dialect.SQLITE3)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := connection.Establish(fileName); err != nil {
+		t.Fatalf("%v", err)
+	}
+	transactionMode, _ := sql_connection.ParseTransactionMode(mode)
+	connection.SetTransactionMode(transactionMode)
+	return connection, fileName
+}
+
+func countRows(t *testing.T, fileName string, table string) int {
+	db, err := sql.Open("sqlite3", fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer db.Close()
+	count := -1
+	if err := db.QueryRow("SELECT count(*) FROM " + table).Scan(&count); err != nil {
+		return -1
+	}
+	return count
+}
+
+func execute(t *testing.T, connection sql_connection.SqlConnection, statements ...string) {
+	for _, statement := range statements {
+		if err := connection.Execute(statement); err != nil {
+			t.Fatalf("execute %v: %v", statement, err)
+		}
+	}
+}
+
+func TestSqliteTransactions(t *testing.T) {
+	connection, fileName := openSqlite(t, "batch:2")
+	execute(t, connection, "CREATE TABLE t (id integer primary key)", "INSERT INTO t VALUES (1)", "INSERT INTO t VALUES (2)")
+	if count := countRows(t, fileName, "t"); count != 1 {
+		t.Errorf("batch:2 committed %v rows, want 1", count)
+	}
+	if err := connection.Execute("INSERT INTO t VALUES (2)"); err == nil {
+		t.Errorf("duplicate key must fail")
+	}
+	// The dump controls the transaction
+	execute(t, connection, "BEGIN TRANSACTION", "INSERT INTO t VALUES (3)", "INSERT INTO t VALUES (4)", "INSERT INTO t VALUES (5)", "COMMIT")
+	if err := connection.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if count := countRows(t, fileName, "t"); count != 5 {
+		t.Errorf("batch:2 loaded %v rows, want 5", count)
+	}
+
+	// PRAGMA foreign_keys is ignored inside the transaction, it is executed outside the batch transaction
+	connection, fileName = openSqlite(t, "batch:10")
+	execute(t, connection, "CREATE TABLE p (id integer primary key)", "CREATE TABLE c (p_id integer references p(id))", "PRAGMA foreign_keys=ON")
+	if count := countRows(t, fileName, "c"); count != 0 {
+		t.Errorf("the batch transaction isn't committed before PRAGMA")
+	}
+	if err := connection.Execute("INSERT INTO c VALUES (1)"); err == nil {
+		t.Errorf("PRAGMA foreign_keys=ON isn't applied, the foreign key violation must fail")
+	}
+	connection.Close()
+
+	connection, fileName = openSqlite(t, "single")
+	execute(t, connection, "BEGIN", "CREATE TABLE t (id integer primary key)", "INSERT INTO t VALUES (1)", "COMMIT")
+	if err := connection.Execute("VACUUM"); err == nil {
+		t.Errorf("VACUUM must fail in the single transaction")
+	}
+	if err := connection.Rollback(); err != nil {
+		t.Fatalf("%v", err)
+	}
+	connection.Close()
+	if count := countRows(t, fileName, "t"); count != -1 {
+		t.Errorf("rolled back single transaction left the table with %v rows", count)
+	}
+
+	connection, fileName = openSqlite(t, "per-table")
+	execute(t, connection, "CREATE TABLE t (id integer primary key)", "INSERT INTO t VALUES (1)")
+	if count := countRows(t, fileName, "t"); count != -1 {
+		t.Errorf("per-table transaction is committed before the Commit call")
+	}
+	if err := connection.Commit(); err != nil {
+		t.Fatalf("%v", err)
+	}
+	execute(t, connection, "INSERT INTO t VALUES (2)")
+	connection.Close()
+	if count := countRows(t, fileName, "t"); count != 2 {
+		t.Errorf("per-table loaded %v rows, want 2", count)
+	}
+}
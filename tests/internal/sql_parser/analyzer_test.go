@@ -76,6 +76,7 @@ func TestPreview(t *testing.T) {
 		{"revoke", ast.StmtPriv},
 		{"truncate", ast.StmtDDL},
 		{"flush", ast.StmtFlush},
+		{"copy public.t (id) from stdin", ast.StmtCopy},
 		{"unknown", ast.StmtUnknown},
 
 		{"/* leading comment */ select ...", ast.StmtSelect},
@@ -0,0 +1,105 @@
+package sql_parser
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+func TestSourcePositionAdvance(t *testing.T) {
+	position := sql_parser.StartPosition.Advance("select 1;")
+	require.Equal(t, sql_parser.SourcePosition{Line: 1, Column: 10, Offset: 9}, position)
+	position = position.Advance("\n-- é\nselect 'é'")
+	require.Equal(t, sql_parser.SourcePosition{Line: 3, Column: 11, Offset: 27}, position)
+}
+
+func TestDiagnosticStream(t *testing.T) {
+	stringForStream := "CREATE TABLE a (id int);\n" +
+		"INSERT INTO a VALUES (1,\n" +
+		"  2 3);\n" +
+		"select 1; select * from a where;\n"
+
+	diagnostics := make([]*sql_parser.Diagnostic, 0)
+	position := sql_parser.StartPosition
+	err := sql_parser.StatementStream(
+		strings.NewReader(stringForStream),
+		dialect.PSQL,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			if parseError != nil {
+				diagnostics = append(diagnostics, sql_parser.NewDiagnostic(statementText, position, dialect.PSQL, parseError))
+			}
+			position = position.Advance(statementText)
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, diagnostics, 2)
+
+	require.Equal(t, int64(3), diagnostics[0].Line)
+	require.Equal(t, int64(5), diagnostics[0].Column)
+	require.Equal(t, int64(int64(strings.Index(stringForStream, "3);"))), diagnostics[0].Offset)
+	require.Equal(t, "INSERT", diagnostics[0].Statement)
+	require.Equal(t, "syntax error: unexpected INTEGRAL, expecting ',' or ')'", diagnostics[0].Message)
+	require.Equal(t, "3", diagnostics[0].Near)
+	require.Equal(t, []string{"','", "')'"}, diagnostics[0].Expected)
+	require.Equal(t, "  2 3);", diagnostics[0].Excerpt)
+	require.Equal(t, 4, diagnostics[0].Caret)
+
+	// The second statement of the line starts in the middle of the line
+	require.Equal(t, int64(4), diagnostics[1].Line)
+	require.Equal(t, int64(32), diagnostics[1].Column)
+	require.Equal(t, "SELECT", diagnostics[1].Statement)
+	require.Equal(t, " select * from a where;", diagnostics[1].Excerpt)
+	require.Equal(t, 22, diagnostics[1].Caret)
+	require.NotEmpty(t, diagnostics[1].Expected)
+}
+
+func TestDiagnosticText(t *testing.T) {
+	statementText := "\nINSERT INTO t VALUE 'a';"
+	_, parseError := sql_parser.Parse(statementText, dialect.MYSQL)
+	require.Error(t, parseError)
+
+	diagnostic := sql_parser.NewDiagnostic(statementText, sql_parser.SourcePosition{Line: 2, Column: 10, Offset: 9}, dialect.MYSQL, parseError)
+	diagnostic.Source = "dump.sql"
+	require.Equal(t, `dump.sql:3:15: syntax error: unexpected ID near 'VALUE' (INSERT statement)
+    3 | INSERT INTO t VALUE 'a';
+      |               ^
+expected: SELECT or SET or VALUES or '(' or WITH
+`, diagnostic.Text())
+	require.Equal(t, diagnostic.Text(), sql_parser.DIAGNOSTIC_TEXT.Format(diagnostic))
+
+	decoded := sql_parser.Diagnostic{}
+	require.NoError(t, json.Unmarshal([]byte(sql_parser.DIAGNOSTIC_JSON.Format(diagnostic)), &decoded))
+	require.Equal(t, *diagnostic, decoded)
+}
+
+func TestDiagnosticLongLine(t *testing.T) {
+	rows := make([]string, 0, 300)
+	for i := 0; i < 300; i++ {
+		rows = append(rows, "(1,'x')")
+	}
+	statementText := "INSERT INTO t VALUES " + strings.Join(rows, ",") + ",(1 2);"
+	_, parseError := sql_parser.Parse(statementText, dialect.MYSQL)
+	require.Error(t, parseError)
+
+	diagnostic := sql_parser.NewDiagnostic(statementText, sql_parser.StartPosition, dialect.MYSQL, parseError)
+	require.Equal(t, int64(strings.Index(statementText, "2);")+1), diagnostic.Column)
+	require.True(t, strings.HasPrefix(diagnostic.Excerpt, "..."))
+	require.True(t, strings.HasSuffix(diagnostic.Excerpt, ",(1 2);"))
+	require.Equal(t, "2", string([]rune(diagnostic.Excerpt)[diagnostic.Caret]))
+}
+
+func TestParseDiagnosticFormat(t *testing.T) {
+	for _, format := range []sql_parser.DiagnosticFormat{sql_parser.DIAGNOSTIC_TEXT, sql_parser.DIAGNOSTIC_JSON} {
+		parsed, err := sql_parser.ParseDiagnosticFormat(format.String())
+		require.NoError(t, err)
+		require.Equal(t, format, parsed)
+	}
+	_, err := sql_parser.ParseDiagnosticFormat("xml")
+	require.EqualError(t, err, "unknown error format: xml, expected one of text|json")
+}
@@ -5139,6 +5139,14 @@ func TestParseVersionedComments(t *testing.T) {
 partition by range (id)
 (partition x values less than (5) engine InnoDB,
  partition t values less than (20) engine InnoDB)`,
+		}, {
+			input:        `SET @@GLOBAL.GTID_PURGED=/*!80000 '+'*/ '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-58'`,
+			mysqlVersion: "50744",
+			output:       `set @@GLOBAL.GTID_PURGED = '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-58'`,
+		}, {
+			input:        `SET @@GLOBAL.GTID_PURGED=/*!80000 '+'*/ '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-58'`,
+			mysqlVersion: "80036",
+			output:       `set @@GLOBAL.GTID_PURGED = '+3e11fa47-71ca-11e1-9e33-c80aa9429562:1-58'`,
 		},
 	}
 
@@ -204,3 +204,763 @@ CREATE TABLE public.phytonyms_citation (
 	}
 	fmt.Printf("tok: %v\n", tok)
 }
+
+func TestCreateIndexStatement(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE INDEX auth_user_username_6821ab7c_like ON public.auth_user USING btree (username varchar_pattern_ops)",
+			out: "create index auth_user_username_6821ab7c_like on public.auth_user using btree (username varchar_pattern_ops)",
+		},
+		{
+			in:  "CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS users_email_idx ON ONLY public.users USING btree (lower((email)::text) DESC NULLS LAST, id) INCLUDE (name) WITH (fillfactor='70', deduplicate_items=off) TABLESPACE fast WHERE ((status)::text = 'active'::text)",
+			out: "create unique index concurrently if not exists users_email_idx on only public.users using btree (lower(email::text) desc nulls last, id) include (`name`) with (fillfactor = '70', deduplicate_items = off) tablespace fast where `status`::text = 'active'::text",
+		},
+		{
+			in:  "CREATE INDEX ON public.articles USING gin (title public.gin_trgm_ops)",
+			out: "create index on public.articles using gin (title public.gin_trgm_ops)",
+		},
+		{
+			in:  "CREATE INDEX articles_idx ON articles USING gist (title gist_trgm_ops (siglen=32), (a + b) NULLS FIRST)",
+			out: "create index articles_idx on articles using gist (title gist_trgm_ops (siglen = 32), (a + b) nulls first)",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			createIndex, ok := tok.(*ast.CreateIndex)
+			if !ok {
+				t.Fatalf("not a create index statement: %T", tok)
+			}
+			if !createIndex.IsFullyParsed() {
+				t.Fatalf("create index statement is not fully parsed")
+			}
+			if out := ast.String(createIndex); out != tcase.out {
+				t.Errorf("create index statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, createIndex, &ast.IndexElement{})
+		})
+	}
+}
+
+// checkHelpers verifies the generated helpers of the statement: the clone is equal to the statement,
+// the walk and the rewrite visit the nodes of the given types
+func checkHelpers(t *testing.T, statement ast.Statement, nodes ...ast.SQLNode) {
+	t.Helper()
+	clone := ast.CloneStatement(statement)
+	if !ast.EqualsStatement(statement, clone) || ast.String(clone) != ast.String(statement) {
+		t.Errorf("clone %v differs from %v", ast.String(clone), ast.String(statement))
+	}
+	walked := map[string]bool{}
+	err := ast.Walk(func(node ast.SQLNode) (bool, error) {
+		walked[fmt.Sprintf("%T", node)] = true
+		return true, nil
+	}, statement)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	rewritten := map[string]bool{}
+	ast.Rewrite(statement, func(cursor *ast.Cursor) bool {
+		rewritten[fmt.Sprintf("%T", cursor.Node())] = true
+		return true
+	}, nil)
+	for _, node := range nodes {
+		name := fmt.Sprintf("%T", node)
+		if !walked[name] || !rewritten[name] {
+			t.Errorf("walk (%v) or rewrite (%v) doesn't visit %v of %v", walked[name], rewritten[name], name, ast.String(statement))
+		}
+	}
+}
+
+func TestCreateFunctionStatement(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE FUNCTION public.update_modified() RETURNS trigger\n    LANGUAGE plpgsql\n    AS $$\nBEGIN\n  NEW.modified = now();\n  RETURN NEW;\nEND;\n$$",
+			out: "create function public.update_modified() returns trigger language plpgsql as $$\nBEGIN\n  NEW.modified = now();\n  RETURN NEW;\nEND;\n$$",
+		},
+		{
+			in:  "CREATE OR REPLACE FUNCTION public.inc(a integer, INOUT b integer DEFAULT 1, VARIADIC c integer = 2) RETURNS SETOF record LANGUAGE sql IMMUTABLE STRICT PARALLEL SAFE AS $body$ SELECT a + b $body$",
+			out: "create or replace function public.inc(a integer, inout b integer default 1, variadic c integer default 2) returns setof record language sql immutable strict parallel safe as $body$ SELECT a + b $body$",
+		},
+		{
+			in:  "CREATE FUNCTION public.search(text) RETURNS TABLE(id bigint, title varchar(300)) LANGUAGE sql STABLE SECURITY DEFINER SET search_path TO 'public', 'pg_temp' COST 100 ROWS 10 AS 'SELECT 1'",
+			out: "create function public.search(text) returns table (id bigint, title varchar(300)) language sql stable security definer set search_path to 'public', 'pg_temp' cost 100 rows 10 as 'SELECT 1'",
+		},
+		{
+			in:  "CREATE PROCEDURE public.remove_expired(IN days integer) LANGUAGE plpgsql SET work_mem FROM CURRENT AS $$ BEGIN DELETE FROM t; END $$",
+			out: "create procedure public.remove_expired(in days integer) language plpgsql set work_mem from current as $$ BEGIN DELETE FROM t; END $$",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			createFunction, ok := tok.(*ast.CreateFunction)
+			if !ok {
+				t.Fatalf("not a create function statement: %T", tok)
+			}
+			if out := ast.String(createFunction); out != tcase.out {
+				t.Errorf("create function statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, createFunction, &ast.FunctionOption{})
+		})
+	}
+}
+
+func TestCreateFunctionBody(t *testing.T) {
+	text := "CREATE FUNCTION f() RETURNS integer LANGUAGE plpgsql AS $fn$ BEGIN RETURN $$;$$; END $fn$"
+	tok, err := sql_parser.Parse(text, dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	createFunction := tok.(*ast.CreateFunction)
+	if language := createFunction.Language(); language != "plpgsql" {
+		t.Errorf("function language is %v but expected plpgsql", language)
+	}
+	if body := createFunction.Body(); body != "$fn$ BEGIN RETURN $$;$$; END $fn$" {
+		t.Errorf("function body is %v", body)
+	}
+}
+
+func TestAlterFunctionStatement(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "ALTER FUNCTION public.update_modified() OWNER TO postgres",
+			out: "alter function public.update_modified() owner to postgres",
+		},
+		{
+			in:  "ALTER PROCEDURE public.remove_expired OWNER TO admin",
+			out: "alter procedure public.remove_expired owner to admin",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("alter function statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestCreateTriggerStatement(t *testing.T) {
+	testcases := []struct {
+		in       string
+		out      string
+		function string
+	}{
+		{
+			in:       "CREATE TRIGGER items_modified BEFORE INSERT OR UPDATE OF title, body ON public.items FOR EACH ROW EXECUTE FUNCTION public.update_modified()",
+			out:      "create trigger items_modified before insert or update of title, body on public.items for each row execute function public.update_modified()",
+			function: "public.update_modified",
+		},
+		{
+			in:       "CREATE TRIGGER items_audit AFTER DELETE OR TRUNCATE ON items FOR EACH STATEMENT WHEN (1 = 1) EXECUTE PROCEDURE audit('items', 1)",
+			out:      "create trigger items_audit after delete or truncate on items when (1 = 1) execute procedure audit('items', 1)",
+			function: "audit",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			createTrigger, ok := tok.(*ast.CreateTrigger)
+			if !ok {
+				t.Fatalf("not a create trigger statement: %T", tok)
+			}
+			if out := ast.String(createTrigger); out != tcase.out {
+				t.Errorf("create trigger statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			if function := ast.String(createTrigger.Function); function != tcase.function {
+				t.Errorf("trigger function is %v but expected %v", function, tcase.function)
+			}
+			checkHelpers(t, createTrigger, &ast.TriggerEvent{})
+		})
+	}
+}
+
+func TestCreateExtensionTypeDomainStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE EXTENSION IF NOT EXISTS pgcrypto WITH SCHEMA public",
+			out: "create extension if not exists pgcrypto schema public",
+		},
+		{
+			in:  "CREATE EXTENSION hstore SCHEMA ext VERSION '1.8' CASCADE",
+			out: "create extension hstore schema ext version '1.8' cascade",
+		},
+		{
+			in:  "CREATE TYPE public.mood AS ENUM (\n    'sad',\n    'ok',\n    'happy'\n)",
+			out: "create type public.mood as enum ('sad', 'ok', 'happy')",
+		},
+		{
+			in:  "CREATE TYPE public.address AS (street varchar(100), zip integer, city text COLLATE \"C\")",
+			out: "create type public.address as (street varchar(100), zip integer, city text collate 'C')",
+		},
+		{
+			in:  "CREATE TYPE public.floatrange AS RANGE (subtype = float8, subtype_diff = float8mi)",
+			out: "create type public.floatrange as range (subtype = float8, subtype_diff = float8mi)",
+		},
+		{
+			in:  "CREATE DOMAIN public.email AS varchar(255) CONSTRAINT email_check CHECK (((VALUE)::text ~ '^[^@]+@[^@]+$'::text))",
+			out: "create domain public.email as varchar(255) constraint email_check check (VALUE::text ~ '^[^@]+@[^@]+$'::text)",
+		},
+		{
+			in:  "CREATE DOMAIN public.positive integer DEFAULT 1 NOT NULL CHECK (VALUE > 0 AND VALUE !~* 'x')",
+			out: "create domain public.positive as integer default 1 not null check (VALUE > 0 and VALUE !~* 'x')",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestUserDefinedColumnType(t *testing.T) {
+	text := "CREATE TABLE public.users (id uuid NOT NULL, status public.mood NOT NULL, address address)"
+	tok, err := sql_parser.Parse(text, dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	createTable := tok.(*ast.CreateTable)
+	if !createTable.IsFullyParsed() {
+		t.Fatalf("create table statement is not fully parsed")
+	}
+	expectedTypes := []string{"uuid", "public.mood", "address"}
+	for i, column := range createTable.TableSpec.Columns {
+		if column.Type.Type != expectedTypes[i] {
+			t.Errorf("type of column %v is %v but expected %v", column.Name.String(), column.Type.Type, expectedTypes[i])
+		}
+	}
+}
+
+func TestTypeCastExpression(t *testing.T) {
+	text := "SELECT (a + b)::text, 'x'::regclass FROM t WHERE (a)::integer = 1"
+	tok, err := sql_parser.Parse(text, dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	expected := "select (a + b)::text, 'x'::regclass from t where a::integer = 1"
+	if out := ast.String(tok); out != expected {
+		t.Errorf("statement is\n%v\nbut expected\n%v", out, expected)
+	}
+}
+
+func TestCommentOnStatement(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "COMMENT ON SCHEMA public IS 'standard public schema'",
+			out: "comment on schema public is 'standard public schema'",
+		},
+		{
+			in:  "COMMENT ON TABLE public.users IS 'Registered users'",
+			out: "comment on table public.users is 'Registered users'",
+		},
+		{
+			in:  "COMMENT ON COLUMN public.users.email IS NULL",
+			out: "comment on column public.users.email is null",
+		},
+		{
+			in:  "COMMENT ON FUNCTION public.total(integer, integer) IS 'Sum'",
+			out: "comment on function public.total(integer, integer) is 'Sum'",
+		},
+		{
+			in:  "COMMENT ON CONSTRAINT users_pkey ON public.users IS 'Primary key'",
+			out: "comment on constraint users_pkey on public.users is 'Primary key'",
+		},
+		{
+			in:  "COMMENT ON EXTENSION plpgsql IS 'PL/pgSQL procedural language'",
+			out: "comment on extension plpgsql is 'PL/pgSQL procedural language'",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+
+	tok, err := sql_parser.Parse("COMMENT ON COLUMN public.users.email IS 'Primary e-mail'", dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	commentOn := tok.(*ast.CommentOn)
+	if commentOn.ObjectType != ast.ColumnObjectStr || ast.String(commentOn.Object) != "public.users" || commentOn.Name.String() != "email" {
+		t.Errorf("column comment target is %v %v.%v", commentOn.ObjectType, ast.String(commentOn.Object), commentOn.Name.String())
+	}
+}
+
+func TestOwnerStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "ALTER TABLE ONLY public.users OWNER TO postgres",
+			out: "alter table public.users owner to postgres",
+		},
+		{
+			in:  "ALTER SEQUENCE public.users_id_seq OWNER TO postgres",
+			out: "alter sequence public.users_id_seq owner to postgres",
+		},
+		{
+			in:  "ALTER VIEW public.active_users OWNER TO reporting",
+			out: "alter view public.active_users owner to reporting",
+		},
+		{
+			in:  "ALTER MATERIALIZED VIEW public.sales_summary OWNER TO reporting",
+			out: "alter materialized view public.sales_summary owner to reporting",
+		},
+		{
+			in:  "ALTER TYPE public.mood OWNER TO postgres",
+			out: "alter type public.mood owner to postgres",
+		},
+		{
+			in:  "ALTER DATABASE shop OWNER TO postgres",
+			out: "alter database shop owner to postgres",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestGrantRevokeStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "GRANT ALL ON SCHEMA public TO PUBLIC",
+			out: "grant all on schema public to public",
+		},
+		{
+			in:  "GRANT SELECT, UPDATE (email, name) ON TABLE public.users TO app, reporting WITH GRANT OPTION",
+			out: "grant select, update (email, `name`) on table public.users to app, reporting with grant option",
+		},
+		{
+			in:  "GRANT USAGE, SELECT ON SEQUENCE public.users_id_seq TO app",
+			out: "grant usage, select on sequence public.users_id_seq to app",
+		},
+		{
+			in:  "GRANT EXECUTE ON FUNCTION public.total(integer, integer) TO CURRENT_USER",
+			out: "grant execute on function public.total(integer, integer) to current_user",
+		},
+		{
+			in:  "GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public, audit TO admin",
+			out: "grant all on all tables in schema public, audit to admin",
+		},
+		{
+			in:  "GRANT SELECT ON users TO app",
+			out: "grant select on table users to app",
+		},
+		{
+			in:  "REVOKE ALL ON SCHEMA public FROM PUBLIC",
+			out: "revoke all on schema public from public",
+		},
+		{
+			in:  "REVOKE GRANT OPTION FOR SELECT ON TABLE public.users FROM GROUP reporting CASCADE",
+			out: "revoke grant option for select on table public.users from reporting cascade",
+		},
+		{
+			in:  "ALTER DEFAULT PRIVILEGES FOR ROLE postgres IN SCHEMA public GRANT SELECT ON TABLES TO reporting",
+			out: "alter default privileges for role postgres in schema public grant select on tables to reporting",
+		},
+		{
+			in:  "ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE EXECUTE ON FUNCTIONS FROM PUBLIC",
+			out: "alter default privileges in schema public revoke execute on functions from public",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+
+	tok, err := sql_parser.Parse("GRANT SELECT, INSERT ON TABLE public.users TO app", dialect.PSQL)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	grant := tok.(*ast.Grant)
+	if grant.ObjectType != ast.TableObjectStr || len(grant.Objects) != 1 || ast.String(grant.Objects[0]) != "public.users" {
+		t.Errorf("grant target is %v %v", grant.ObjectType, grant.Objects)
+	}
+	if len(grant.Privileges) != 2 || grant.Privileges[0].Type != ast.SelectPrivilegeStr || grant.Privileges[1].Type != ast.InsertPrivilegeStr {
+		t.Errorf("grant privileges are %v", grant.Privileges)
+	}
+	if len(grant.Grantees) != 1 || grant.Grantees[0].Name.V != "app" {
+		t.Errorf("grant grantees are %v", grant.Grantees)
+	}
+	if statementType := ast.ASTToStatementType(grant); statementType != ast.StmtPriv {
+		t.Errorf("grant statement type is %v", statementType)
+	}
+	checkHelpers(t, grant, &ast.GrantPrivilege{}, &ast.PrivilegeObject{}, &ast.RoleName{})
+}
+
+func TestRowLevelSecurityAndPolicyStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "ALTER TABLE public.accounts ENABLE ROW LEVEL SECURITY",
+			out: "alter table public.accounts enable row level security",
+		},
+		{
+			in:  "ALTER TABLE ONLY public.accounts NO FORCE ROW LEVEL SECURITY",
+			out: "alter table public.accounts no force row level security",
+		},
+		{
+			in:  "CREATE POLICY tenant_isolation ON public.accounts USING ((tenant_id = (current_setting('app.tenant_id'::text))::integer))",
+			out: "create policy tenant_isolation on public.accounts for all using (tenant_id = current_setting('app.tenant_id'::text)::integer)",
+		},
+		{
+			in:  "CREATE POLICY accounts_update ON public.accounts AS RESTRICTIVE FOR UPDATE TO app, PUBLIC USING (true) WITH CHECK ((balance >= 0))",
+			out: "create policy accounts_update on public.accounts as restrictive for update to app, public using (true) with check (balance >= 0)",
+		},
+		{
+			in:  "COMMENT ON POLICY tenant_isolation ON public.accounts IS 'Tenant isolation'",
+			out: "comment on policy tenant_isolation on public.accounts is 'Tenant isolation'",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+
+	if _, err := sql_parser.Parse("CREATE POLICY p ON accounts AS OPTIONAL", dialect.PSQL); err == nil {
+		t.Errorf("expected an error for the unknown policy kind")
+	}
+}
+
+func TestPublicationStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE PUBLICATION all_changes FOR ALL TABLES",
+			out: "create publication all_changes for all tables",
+		},
+		{
+			in:  "CREATE PUBLICATION pub WITH (publish = 'insert, update, delete, truncate')",
+			out: "create publication pub with (publish = 'insert, update, delete, truncate')",
+		},
+		{
+			in:  "CREATE PUBLICATION pub FOR TABLE users, orders (id, total) WHERE (total > 0), TABLES IN SCHEMA audit, history",
+			out: "create publication pub for table users, orders (id, total) where (total > 0), tables in schema audit, history",
+		},
+		{
+			in:  "ALTER PUBLICATION pub ADD TABLE ONLY public.users",
+			out: "alter publication pub add table only public.users",
+		},
+		{
+			in:  "ALTER PUBLICATION pub ADD TABLES IN SCHEMA public",
+			out: "alter publication pub add tables in schema public",
+		},
+		{
+			in:  "ALTER PUBLICATION pub OWNER TO postgres",
+			out: "alter publication pub owner to postgres",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestCreateRuleStatement(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE RULE active_users_insert AS ON INSERT TO public.active_users DO INSTEAD INSERT INTO public.users (name) VALUES (new.name)",
+			out: "create rule active_users_insert as on insert to public.active_users do instead insert into public.users(`name`) values (new.`name`)",
+		},
+		{
+			in:  "CREATE OR REPLACE RULE protect AS ON DELETE TO public.users WHERE (old.id = 1) DO INSTEAD NOTHING",
+			out: "create or replace rule protect as on delete to public.users where old.id = 1 do instead nothing",
+		},
+		{
+			in:  "CREATE RULE users_update AS ON UPDATE TO public.active_users DO INSTEAD (UPDATE public.users SET email = new.email WHERE id = old.id; DELETE FROM public.cache WHERE id = old.id)",
+			out: "create rule users_update as on update to public.active_users do instead (update public.users set email = new.email where id = old.id; delete from public.cache where id = old.id)",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestMaterializedViewStatements(t *testing.T) {
+	testcases := []struct {
+		in       string
+		out      string
+		withData bool
+	}{
+		{
+			in:       "CREATE MATERIALIZED VIEW public.sales_summary AS SELECT region, sum(amount) AS total FROM public.sales GROUP BY region WITH NO DATA",
+			out:      "create materialized view public.sales_summary as select region, sum(amount) as total from public.sales group by region with no data",
+			withData: false,
+		},
+		{
+			in:       "CREATE MATERIALIZED VIEW IF NOT EXISTS public.totals (region, total) USING heap WITH (fillfactor = 70) TABLESPACE fast AS SELECT region, total FROM public.sales WITH DATA",
+			out:      "create materialized view if not exists public.totals(region, total) using heap with (fillfactor = 70) tablespace fast as select region, total from public.sales",
+			withData: true,
+		},
+		{
+			in:       "REFRESH MATERIALIZED VIEW public.sales_summary",
+			out:      "refresh materialized view public.sales_summary",
+			withData: true,
+		},
+		{
+			in:       "REFRESH MATERIALIZED VIEW CONCURRENTLY public.sales_summary WITH NO DATA",
+			out:      "refresh materialized view concurrently public.sales_summary with no data",
+			withData: false,
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			var withData bool
+			switch node := tok.(type) {
+			case *ast.CreateMaterializedView:
+				withData = node.WithData
+			case *ast.RefreshMaterializedView:
+				withData = node.WithData
+			}
+			if withData != tcase.withData {
+				t.Errorf("with data is %v but expected %v", withData, tcase.withData)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestPartitionStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE TABLE public.measurement (city_id integer NOT NULL, logdate date NOT NULL) PARTITION BY RANGE (logdate)",
+			out: "create table public.measurement (\n\tcity_id integer not null,\n\tlogdate date not null\n)\npartition by range (logdate)",
+		},
+		{
+			in:  "CREATE TABLE public.accounts (id bigint, tenant integer) PARTITION BY HASH (id, tenant)",
+			out: "create table public.accounts (\n\tid bigint,\n\ttenant integer\n)\npartition by hash (id, tenant)",
+		},
+		{
+			in:  "CREATE TABLE public.measurement_y2026m10 PARTITION OF public.measurement FOR VALUES FROM ('2026-10-01') TO ('2026-11-01')",
+			out: "create table public.measurement_y2026m10 partition of public.measurement for values from ('2026-10-01') to ('2026-11-01')",
+		},
+		{
+			in:  "CREATE TABLE public.events_web PARTITION OF public.events FOR VALUES IN ('web', 'mobile') PARTITION BY LIST (region)",
+			out: "create table public.events_web partition of public.events for values in ('web', 'mobile')\npartition by list (region)",
+		},
+		{
+			in:  "CREATE TABLE public.accounts_0 PARTITION OF public.accounts FOR VALUES WITH (MODULUS 4, REMAINDER 0)",
+			out: "create table public.accounts_0 partition of public.accounts for values with (modulus 4, remainder 0)",
+		},
+		{
+			in:  "CREATE TABLE public.events_other PARTITION OF public.events DEFAULT",
+			out: "create table public.events_other partition of public.events default",
+		},
+		{
+			in:  "ALTER TABLE public.measurement ATTACH PARTITION public.measurement_y2026m11 FOR VALUES FROM ('2026-11-01') TO ('2026-12-01')",
+			out: "alter table public.measurement attach partition public.measurement_y2026m11 for values from ('2026-11-01') to ('2026-12-01')",
+		},
+		{
+			in:  "ALTER TABLE public.events DETACH PARTITION public.events_other",
+			out: "alter table public.events detach partition public.events_other",
+		},
+		{
+			in:  "ALTER INDEX public.measurement_pkey ATTACH PARTITION public.measurement_y2026m10_pkey",
+			out: "alter index public.measurement_pkey attach partition public.measurement_y2026m10_pkey",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if out := ast.String(tok); out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			checkHelpers(t, tok)
+		})
+	}
+}
+
+func TestPostgresColumnDefinitions(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE TABLE public.t (tags text[], grid integer[3][3], during tstzrange, doc jsonb DEFAULT '{}'::jsonb NOT NULL)",
+			out: "create table public.t (\n\ttags text[],\n\tgrid integer[3][3],\n\tduring tstzrange,\n\tdoc jsonb not null default ('{}'::jsonb)\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (title character varying(255), price double precision, flags bit varying(8))",
+			out: "create table public.t (\n\ttitle character varying(255),\n\tprice double precision,\n\tflags bit varying(8)\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (created_at timestamp(6) with time zone DEFAULT now() NOT NULL, updated_at timestamp without time zone, starts time with time zone)",
+			out: "create table public.t (\n\tcreated_at timestamp(6) with time zone not null default now(),\n\tupdated_at timestamp without time zone,\n\tstarts time with time zone\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (id integer DEFAULT nextval('public.t_id_seq'::regclass) NOT NULL)",
+			out: "create table public.t (\n\tid integer not null default (nextval('public.t_id_seq'::regclass))\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (price numeric, total numeric GENERATED ALWAYS AS (price * 2) STORED)",
+			out: "create table public.t (\n\tprice numeric,\n\ttotal numeric generated always as (price * 2) stored\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (id bigint GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY)",
+			out: "create table public.t (\n\tid bigint generated by default as identity primary key\n)",
+		},
+		{
+			in:  "CREATE TABLE public.t (id bigint GENERATED ALWAYS AS IDENTITY (SEQUENCE NAME public.t_id_seq START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1))",
+			out: "create table public.t (\n\tid bigint generated always as identity (sequence name public.t_id_seq start with 1 increment by 1 no minvalue no maxvalue cache 1)\n)",
+		},
+		{
+			in:  "ALTER TABLE public.t ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (SEQUENCE NAME public.t_id_seq START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1)",
+			out: "alter table public.t alter column id add generated always as identity (sequence name public.t_id_seq start with 1 increment by 1 no minvalue no maxvalue cache 1)",
+		},
+		{
+			in:  "CREATE SEQUENCE public.t_id_seq AS integer START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1",
+			out: "create sequence public.t_id_seq as integer start with 1 increment by 1 no minvalue no maxvalue cache 1",
+		},
+		{
+			in:  "CREATE SEQUENCE public.ring MINVALUE -10 MAXVALUE 10 CYCLE",
+			out: "create sequence public.ring minvalue -10 maxvalue 10 cycle",
+		},
+		{
+			in:  "SELECT '{1,2}'::integer[]",
+			out: "select '{1,2}'::integer[] from dual",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			out := ast.String(tok)
+			if out != tcase.out {
+				t.Errorf("statement is\n%v\nbut expected\n%v", out, tcase.out)
+			}
+			// the formatted statement has to survive the round trip
+			tok, err = sql_parser.Parse(out, dialect.PSQL)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			if again := ast.String(tok); again != out {
+				t.Errorf("round trip is\n%v\nbut expected\n%v", again, out)
+			}
+		})
+	}
+}
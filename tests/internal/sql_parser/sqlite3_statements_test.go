@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
 	"github.com/usalko/prodl/internal/sql_parser/dialect"
 	"github.com/usalko/prodl/internal/sql_parser/sqlite3"
 )
@@ -27,7 +28,7 @@ func TestSqlite3Statements(t *testing.T) {
 
 	for _, tcase := range testcases {
 		t.Run(tcase.in, func(t *testing.T) {
-			tok, err := sql_parser.Parse(tcase.in, dialect.PSQL)
+			tok, err := sql_parser.Parse(tcase.in, dialect.SQLITE3)
 			if err != nil {
 				t.Fatalf("%q", err)
 			}
@@ -35,3 +36,76 @@ func TestSqlite3Statements(t *testing.T) {
 		})
 	}
 }
+
+func TestSqlite3DumpStatements(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "CREATE TABLE users (id INTEGER PRIMARY KEY, name VARCHAR(40) NOT NULL, price DECIMAL(10,2), b UNSIGNED BIG INT)",
+			out: "create table users (\n\tid INTEGER primary key,\n\t`name` VARCHAR(40) not null,\n\tprice DECIMAL(10,2),\n\tb UNSIGNED BIG INT\n)",
+		},
+		{
+			in:  "CREATE TABLE IF NOT EXISTS 'docs_idx'(segid, term, pgno, PRIMARY KEY(segid, term)) WITHOUT ROWID",
+			out: "create table if not exists docs_idx (\n\tsegid,\n\tterm,\n\tpgno,\n\tPRIMARY KEY (segid, term)\n) without rowid",
+		},
+		{
+			in:  "CREATE TABLE typed (id INTEGER PRIMARY KEY, b ANY) STRICT",
+			out: "create table typed (\n\tid INTEGER primary key,\n\tb ANY\n) strict",
+		},
+		{
+			in:  "CREATE TABLE t (a TEXT CHECK (length(a) < 10))",
+			out: "create table t (\n\ta TEXT,\n\tcheck (length(a) < 10)\n)",
+		},
+		{
+			in:  "PRAGMA foreign_keys=OFF",
+			out: "pragma foreign_keys = OFF",
+		},
+		{
+			in:  "PRAGMA main.cache_size = -2000",
+			out: "pragma main.cache_size = -2000",
+		},
+		{
+			in:  "BEGIN IMMEDIATE TRANSACTION",
+			out: "begin",
+		},
+		{
+			in:  "END TRANSACTION",
+			out: "commit",
+		},
+		{
+			in:  "CREATE TRIGGER trg AFTER INSERT ON users BEGIN INSERT INTO audit(msg) VALUES ('user ' || NEW.name || ';'); UPDATE users SET n = n + 1 WHERE id = NEW.id; END",
+			out: "create trigger trg after insert on users begin insert into audit(msg) values ('user ' || NEW.`name` || ';'); update users set n = n + 1 where id = NEW.id; end",
+		},
+		{
+			in:  "CREATE TEMP TRIGGER IF NOT EXISTS trg UPDATE OF name ON users FOR EACH ROW WHEN OLD.n > 0 BEGIN DELETE FROM audit; END",
+			out: "create temp trigger if not exists trg update of `name` on users for each row when (OLD.n > 0) begin delete from audit; end",
+		},
+		{
+			in:  "CREATE VIRTUAL TABLE docs USING fts5(title, body, tokenize = 'porter ascii')",
+			out: "create virtual table docs using fts5(title, body, tokenize = 'porter ascii')",
+		},
+		{
+			in:  "CREATE UNIQUE INDEX IF NOT EXISTS idx ON users(email COLLATE NOCASE DESC) WHERE email IS NOT NULL",
+			out: "create unique index if not exists idx on users (email collate NOCASE desc) where email is not null",
+		},
+		{
+			in:  "INSERT INTO t VALUES('C:\\path\\', 'it''s')",
+			out: "insert into t values ('C:\\\\path\\\\', 'it\\'s')",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			statement, err := sql_parser.Parse(tcase.in, dialect.SQLITE3)
+			if err != nil {
+				t.Fatalf("%q", err)
+			}
+			if out := ast.String(statement); out != tcase.out {
+				t.Errorf("out: %q, want %q", out, tcase.out)
+			}
+			checkHelpers(t, statement)
+		})
+	}
+}
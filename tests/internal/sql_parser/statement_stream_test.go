@@ -1,13 +1,18 @@
 package sql_parser
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/usalko/prodl/internal/sql_parser"
 	"github.com/usalko/prodl/internal/sql_parser/ast"
 	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_parser/mysql"
 )
 
 type TextAndError struct {
@@ -228,3 +233,581 @@ SELECT * FROM public.articles_article;
 		t.Errorf("count of statements is %v but expected %v", len(parsedStatements), expectedParseStatementsCount)
 	}
 }
+
+func TestStatementStreamParseModes(t *testing.T) {
+	stringForStream := `
+CREATE TABLE public.articles_article (
+    id bigint NOT NULL,
+    title character varying(300) NOT NULL
+);
+
+COPY public.articles_article (id, title) FROM stdin;
+11	Article 1
+12	Article 2
+\.
+
+INSERT INTO public.articles_article (id, title) VALUES (13, 'Article 3');
+`
+	testcases := []struct {
+		mode                 sql_parser.ParseMode
+		expectedTextPieces   int
+		expectedParsedPieces int
+	}{
+		{mode: sql_parser.PARSE_ALL, expectedTextPieces: 3, expectedParsedPieces: 3},
+		{mode: sql_parser.PARSE_DDL, expectedTextPieces: 3, expectedParsedPieces: 1},
+		{mode: sql_parser.PARSE_NONE, expectedTextPieces: 3, expectedParsedPieces: 0},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.mode.String(), func(t *testing.T) {
+			var textPieces []string = make([]string, 0)
+			var parsedStatements []ast.Statement = make([]ast.Statement, 0)
+			parseErrors := make([]TextAndError, 0)
+
+			err := sql_parser.StatementStreamWithMode(
+				strings.NewReader(stringForStream),
+				dialect.PSQL,
+				tcase.mode,
+				// PROCESS STATEMENTS
+				func(statementText string, statement ast.Statement, parseError error) {
+					textPieces = append(textPieces, statementText)
+					if statement != nil {
+						parsedStatements = append(parsedStatements, statement)
+					}
+					if parseError != nil {
+						parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+					}
+				},
+			)
+			if err != nil {
+				t.Errorf("%q", err)
+			}
+			if len(textPieces) != tcase.expectedTextPieces {
+				t.Errorf("count of text pieces is %v but expected %v", len(textPieces), tcase.expectedTextPieces)
+			}
+			if len(parseErrors) > 0 {
+				t.Errorf("unexpected errors: %v", parseErrors)
+			}
+			if len(parsedStatements) != tcase.expectedParsedPieces {
+				t.Errorf("count of statements is %v but expected %v", len(parsedStatements), tcase.expectedParsedPieces)
+			}
+		})
+	}
+}
+
+func TestStatementStreamDollarQuotedBody(t *testing.T) {
+	stringForStream := `
+CREATE FUNCTION public.update_modified() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+  NEW.modified = now();
+  RETURN NEW;
+END;
+$$;
+
+ALTER FUNCTION public.update_modified() OWNER TO postgres;
+`
+	var textPieces []string = make([]string, 0)
+	parseErrors := make([]TextAndError, 0)
+
+	err := sql_parser.StatementStream(
+		strings.NewReader(stringForStream),
+		dialect.PSQL,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			textPieces = append(textPieces, statementText)
+			if parseError != nil {
+				parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+			}
+		},
+	)
+	if err != nil {
+		t.Errorf("%q", err)
+	}
+	if len(textPieces) != 2 {
+		t.Errorf("count of text pieces is %v but expected %v", len(textPieces), 2)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected errors: %v", parseErrors)
+	}
+}
+
+func TestStatementStreamCopyOptions(t *testing.T) {
+	stringForStream := `
+COPY public.notes (id, body) FROM stdin WITH (FORMAT csv, DELIMITER ';', FORCE_NOT_NULL (body));
+1;"semicolon; and 'quote'"
+2;
+\.
+
+SELECT pg_catalog.setval('public.notes_id_seq', 2, true);
+`
+	var textPieces []string = make([]string, 0)
+	var copyFrom *ast.CopyFrom
+	parseErrors := make([]TextAndError, 0)
+
+	err := sql_parser.StatementStream(
+		strings.NewReader(stringForStream),
+		dialect.PSQL,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			textPieces = append(textPieces, statementText)
+			if node, ok := statement.(*ast.CopyFrom); ok {
+				copyFrom = node
+			}
+			if parseError != nil {
+				parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+			}
+		},
+	)
+	if err != nil {
+		t.Errorf("%q", err)
+	}
+	if len(textPieces) != 2 {
+		t.Errorf("count of text pieces is %v but expected %v", len(textPieces), 2)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected errors: %v", parseErrors)
+	}
+	if copyFrom == nil {
+		t.Fatalf("copy statement isn't parsed")
+	}
+	if options := strings.Join(ast.Map(copyFrom.With, ast.CopyOption.String), ", "); options != "format csv, delimiter ;, force_not_null (body)" {
+		t.Errorf("copy options are %v", options)
+	}
+}
+
+func TestStatementStreamCopyPageBoundary(t *testing.T) {
+	copyText := "COPY public.notes (id, body) FROM stdin;\n1\tfirst\n2\tsecond\n\\.\n"
+	for shift := 0; shift < 128; shift++ {
+		// The comment (a part of the COPY statement) or the statement before the COPY statement
+		// moves the COPY statement and its data across the page boundary
+		paddings := []string{
+			"-- " + strings.Repeat("x", sql_parser.PAGE_SIZE-128+shift) + "\n",
+			"SELECT '" + strings.Repeat("x", sql_parser.PAGE_SIZE-128+shift) + "';\n",
+		}
+		for i, padding := range paddings {
+			in := padding + copyText + "\nSELECT 1;\n"
+			t.Run(fmt.Sprint(shift, "/", i), func(t *testing.T) {
+				textPieces := make([]string, 0)
+				var copyFrom *ast.CopyFrom
+				err := sql_parser.StatementStream(
+					strings.NewReader(in),
+					dialect.PSQL,
+					// PROCESS STATEMENTS
+					func(statementText string, statement ast.Statement, parseError error) {
+						if parseError != nil {
+							t.Errorf("unexpected error %v of %q", parseError, statementText)
+						}
+						textPieces = append(textPieces, statementText)
+						if node, ok := statement.(*ast.CopyFrom); ok {
+							copyFrom = node
+						}
+					},
+				)
+				if err != nil {
+					t.Errorf("%q", err)
+				}
+				if len(textPieces) != 2+i {
+					t.Fatalf("text pieces are %q but expected %v", textPieces, 2+i)
+				}
+				if copyFrom == nil {
+					t.Fatalf("copy statement isn't parsed from %q", textPieces[i])
+				}
+				if !strings.HasSuffix(textPieces[i], "2\tsecond\n\\.") {
+					t.Errorf("copy data is lost in %q", textPieces[i])
+				}
+			})
+		}
+	}
+}
+
+func TestStatementStreamRuleActions(t *testing.T) {
+	stringForStream := `
+CREATE RULE users_update AS
+    ON UPDATE TO public.active_users DO INSTEAD (UPDATE public.users SET email = new.email
+  WHERE (users.id = old.id); DELETE FROM public.cache
+  WHERE (cache.id = old.id));
+
+ALTER TABLE public.users ENABLE ROW LEVEL SECURITY;
+`
+	var textPieces []string = make([]string, 0)
+	parseErrors := make([]TextAndError, 0)
+
+	err := sql_parser.StatementStream(
+		strings.NewReader(stringForStream),
+		dialect.PSQL,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			textPieces = append(textPieces, statementText)
+			if parseError != nil {
+				parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+			}
+		},
+	)
+	if err != nil {
+		t.Errorf("%q", err)
+	}
+	if len(textPieces) != 2 {
+		t.Errorf("count of text pieces is %v but expected %v", len(textPieces), 2)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected errors: %v", parseErrors)
+	}
+}
+
+func TestStatementStreamUnclosedParentheses(t *testing.T) {
+	testcases := []struct {
+		in      string
+		sqls    []string
+		errors  int
+		dialect dialect.SqlDialect
+	}{
+		{
+			in:      "SELECT (1; SELECT 2; SELECT 3;",
+			sqls:    []string{"SELECT (1;", " SELECT 2;", " SELECT 3;"},
+			errors:  1,
+			dialect: dialect.PSQL,
+		},
+		{
+			in:      "SELECT (1; SELECT 2; SELECT 3;",
+			sqls:    []string{"SELECT (1;", " SELECT 2;", " SELECT 3;"},
+			errors:  1,
+			dialect: dialect.MYSQL,
+		},
+		{
+			in:      "SELECT (1; SELECT 2; SELECT 3;",
+			sqls:    []string{"SELECT (1;", " SELECT 2;", " SELECT 3;"},
+			errors:  1,
+			dialect: dialect.SQLITE3,
+		},
+		{
+			in:      "CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1); CREATE TABLE b (id int); SELECT 3;",
+			sqls:    []string{"CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1);", " CREATE TABLE b (id int);", " SELECT 3;"},
+			errors:  1,
+			dialect: dialect.PSQL,
+		},
+		{
+			in:      "CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1); SELECT 2; SELECT 3;",
+			sqls:    []string{"CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1);", " SELECT 2;", " SELECT 3;"},
+			errors:  1,
+			dialect: dialect.PSQL,
+		},
+		{
+			in:      "CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1)",
+			sqls:    []string{"CREATE RULE r AS ON INSERT TO t DO (INSERT INTO a VALUES (1)"},
+			errors:  1,
+			dialect: dialect.PSQL,
+		},
+		{
+			in:      "CREATE TABLE rule (do int); SELECT (1);",
+			sqls:    []string{"CREATE TABLE rule (do int);", " SELECT (1);"},
+			dialect: dialect.PSQL,
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			sqls := make([]string, 0)
+			parseErrors := 0
+			err := sql_parser.StatementStream(
+				strings.NewReader(tcase.in),
+				tcase.dialect,
+				// PROCESS STATEMENTS
+				func(statementText string, statement ast.Statement, parseError error) {
+					sqls = append(sqls, statementText)
+					if parseError != nil {
+						parseErrors++
+					}
+					if strings.HasPrefix(statementText, "CREATE RULE") && !errors.Is(parseError, sql_parser.ErrIncompleteStatement) {
+						t.Errorf("unexpected error of the unclosed rule: %v", parseError)
+					}
+				},
+			)
+			if err != nil {
+				t.Errorf("%q", err)
+			}
+			if strings.Join(sqls, "|") != strings.Join(tcase.sqls, "|") {
+				t.Errorf("statements are %q but expected %q", sqls, tcase.sqls)
+			}
+			if parseErrors != tcase.errors {
+				t.Errorf("count of errors is %v but expected %v", parseErrors, tcase.errors)
+			}
+		})
+	}
+}
+
+func TestStatementStreamSqlite3Dump(t *testing.T) {
+	file, err := os.Open("test_data/sqlite3_dump.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var statements []ast.Statement = make([]ast.Statement, 0)
+	parseErrors := make([]TextAndError, 0)
+
+	err = sql_parser.StatementStream(
+		file,
+		dialect.SQLITE3,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			statements = append(statements, statement)
+			if parseError != nil {
+				parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+			}
+		},
+	)
+	if err != nil {
+		t.Errorf("%q", err)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected errors: %v", parseErrors)
+	}
+	if len(statements) != 34 {
+		t.Fatalf("count of statements is %v but expected %v", len(statements), 34)
+	}
+
+	triggers := make([]*ast.CreateTrigger, 0)
+	for _, statement := range statements {
+		if trigger, ok := statement.(*ast.CreateTrigger); ok {
+			triggers = append(triggers, trigger)
+		}
+	}
+	if len(triggers) != 2 {
+		t.Fatalf("count of triggers is %v but expected %v", len(triggers), 2)
+	}
+	if len(triggers[0].Body) != 2 || len(triggers[1].Body) != 1 {
+		t.Errorf("trigger bodies have %v and %v statements but expected 2 and 1", len(triggers[0].Body), len(triggers[1].Body))
+	}
+}
+
+func TestStatementStreamTriggers(t *testing.T) {
+	testcases := []struct {
+		in      string
+		dialect dialect.SqlDialect
+		last    string
+	}{
+		{
+			in: `CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW BEGIN
+  CASE WHEN NEW.a > 0 THEN SET NEW.b = 1; ELSE SET NEW.b = 0; END CASE;
+END;
+INSERT INTO t VALUES (1);`,
+			dialect: dialect.MYSQL,
+			last:    "\nINSERT INTO t VALUES (1);",
+		},
+		{
+			in: `CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW BEGIN
+  IF NEW.a > 0 THEN SET NEW.b = 1; END IF;
+  SET NEW.c = 2;
+END;
+INSERT INTO t VALUES (1);`,
+			dialect: dialect.MYSQL,
+			last:    "\nINSERT INTO t VALUES (1);",
+		},
+		{
+			in: `CREATE TRIGGER trg BEFORE INSERT ON public.t FOR EACH ROW WHEN (CASE WHEN new.a > 0 THEN true ELSE false END) EXECUTE FUNCTION public.audit();
+BEGIN;
+INSERT INTO public.t VALUES (1);
+COMMIT;`,
+			dialect: dialect.PSQL,
+			last:    "\nCOMMIT;",
+		},
+	}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			sqls := make([]string, 0)
+			err := sql_parser.StatementStream(
+				strings.NewReader(tcase.in),
+				tcase.dialect,
+				// PROCESS STATEMENTS
+				func(statementText string, statement ast.Statement, parseError error) {
+					sqls = append(sqls, statementText)
+				},
+			)
+			if err != nil {
+				t.Errorf("%q", err)
+			}
+			if len(sqls) == 0 || sqls[len(sqls)-1] != tcase.last {
+				t.Errorf("statements are %q but expected the last one %q", sqls, tcase.last)
+			}
+		})
+	}
+}
+
+func TestStatementStreamDelimiter(t *testing.T) {
+	procedure := "CREATE PROCEDURE p()\nBEGIN\n  SELECT 1; SELECT 'a$$b';\nEND$$"
+	for shift := 0; shift < 24; shift++ {
+		// The comment moves the DELIMITER command and the delimiter across the page boundary
+		padding := "-- " + strings.Repeat("x", sql_parser.PAGE_SIZE-8-shift) + "\n"
+		in := padding + "DELIMITER $$\n" + procedure + "\nSELECT 4$$\ndelimiter ;\nSELECT 2;\nDELIMITER ;;\nSELECT 3;;\nDELIMITER ;"
+		t.Run(fmt.Sprint(shift), func(t *testing.T) {
+			sqls := make([]string, 0)
+			statements := make([]ast.Statement, 0)
+			err := sql_parser.StatementStream(
+				strings.NewReader(in),
+				dialect.MYSQL,
+				// PROCESS STATEMENTS
+				func(statementText string, statement ast.Statement, parseError error) {
+					if parseError != nil {
+						t.Errorf("unexpected error %v of %q", parseError, statementText)
+					}
+					sqls = append(sqls, statementText)
+					statements = append(statements, statement)
+				},
+			)
+			if err != nil {
+				t.Errorf("%q", err)
+			}
+			// The commands are blanked, the length and the lines of the text are kept
+			expected := []string{
+				padding + "            \n" + strings.TrimSuffix(procedure, "$$") + "; ",
+				"\nSELECT 4; ",
+				"\n           \nSELECT 2;",
+				"\n            \nSELECT 3; ",
+			}
+			if !reflect.DeepEqual(sqls, expected) {
+				t.Fatalf("statements are %q but expected %q", sqls, expected)
+			}
+			if _, ok := statements[0].(*ast.CreateFunction); !ok {
+				t.Errorf("the procedure is parsed as %T", statements[0])
+			}
+		})
+	}
+}
+
+func TestStatementStreamMysqlDumps(t *testing.T) {
+	testcases := []struct {
+		fileName      string
+		serverVersion string
+		statements    int
+		inserts       int
+		rows          int // Rows of the extended INSERT longer than the page
+		routines      int // Triggers and routines in the DELIMITER ;; blocks
+	}{
+		{"test_data/mysql57_dump.sql", "5.7.44", 99, 2, 403, 3},
+		{"test_data/mysql80_dump.sql", "5.7.44", 103, 2, 403, 3},
+		{"test_data/mysql80_dump.sql", "8.0.36", 103, 2, 403, 3},
+		{"test_data/mariadb_dump.sql", "8.0.36", 79, 2, 402, 3},
+		// The /*M!100616 ... */ comments are executed by MariaDB only
+		{"test_data/mariadb_dump.sql", "10.11.6-MariaDB", 81, 2, 402, 3},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.fileName+":"+testcase.serverVersion, func(t *testing.T) {
+			oldMySQLVersion, oldMariaDB := mysql.MySQLVersion, mysql.MariaDB
+			defer func() { mysql.MySQLVersion, mysql.MariaDB = oldMySQLVersion, oldMariaDB }()
+			if err := mysql.SetServerVersion(testcase.serverVersion); err != nil {
+				t.Fatal(err)
+			}
+
+			file, err := os.Open(testcase.fileName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			var statements []ast.Statement = make([]ast.Statement, 0)
+			var routineTexts []string
+			parseErrors := make([]TextAndError, 0)
+
+			err = sql_parser.StatementStream(
+				file,
+				dialect.MYSQL,
+				// PROCESS STATEMENTS
+				func(statementText string, statement ast.Statement, parseError error) {
+					statements = append(statements, statement)
+					switch statement.(type) {
+					case *ast.CreateTrigger, *ast.CreateFunction:
+						routineTexts = append(routineTexts, statementText)
+					}
+					if parseError != nil {
+						parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+					}
+				},
+			)
+			if err != nil {
+				t.Errorf("%q", err)
+			}
+			if len(parseErrors) > 0 {
+				t.Errorf("unexpected errors: %v", parseErrors)
+			}
+			if len(statements) != testcase.statements {
+				t.Errorf("count of statements is %v but expected %v", len(statements), testcase.statements)
+			}
+
+			inserts, rows := 0, 0
+			for _, statement := range statements {
+				checkHelpers(t, statement)
+				if insert, ok := statement.(*ast.Insert); ok {
+					inserts++
+					if values, ok := insert.Rows.(ast.Values); ok {
+						rows = max(rows, len(values))
+					}
+				}
+			}
+			if inserts != testcase.inserts {
+				t.Errorf("count of inserts is %v but expected %v", inserts, testcase.inserts)
+			}
+			if rows != testcase.rows {
+				t.Errorf("count of rows is %v but expected %v", rows, testcase.rows)
+			}
+
+			// The DELIMITER commands are blanked and the ;; delimiter becomes the semicolon
+			if len(routineTexts) != testcase.routines {
+				t.Errorf("count of triggers and routines is %v but expected %v", len(routineTexts), testcase.routines)
+			}
+			for _, text := range routineTexts {
+				if text := strings.TrimSpace(text); strings.Contains(text, "DELIMITER") || !strings.HasSuffix(text, ";") || strings.HasSuffix(text, ";;") {
+					t.Errorf("unexpected text of the trigger or the routine: %q", text)
+				}
+			}
+		})
+	}
+}
+
+func TestStatementStreamLongTokens(t *testing.T) {
+	// The strings and the comments are longer than the page, the semicolons inside them don't split the statement
+	longText := strings.Repeat("abc; ", sql_parser.PAGE_SIZE/2)
+	stringForStream := "SET @a = 'x';\n-- " + longText + "\nSET @b = '" + longText + "';\nINSERT INTO t VALUES (1,'" + longText + "');\n"
+
+	var textPieces []string = make([]string, 0)
+	parseErrors := make([]TextAndError, 0)
+
+	err := sql_parser.StatementStream(
+		strings.NewReader(stringForStream),
+		dialect.MYSQL,
+		// PROCESS STATEMENTS
+		func(statementText string, statement ast.Statement, parseError error) {
+			textPieces = append(textPieces, statementText)
+			if parseError != nil {
+				parseErrors = append(parseErrors, TextAndError{statementText, parseError})
+			}
+		},
+	)
+	if err != nil {
+		t.Errorf("%q", err)
+	}
+	if len(textPieces) != 3 {
+		t.Errorf("count of text pieces is %v but expected %v", len(textPieces), 3)
+	}
+	if len(parseErrors) > 0 {
+		t.Errorf("unexpected errors: %v", parseErrors)
+	}
+}
+
+func TestParseParseMode(t *testing.T) {
+	for _, value := range []string{"none", "ddl", "all"} {
+		parseMode, err := sql_parser.ParseParseMode(value)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		if parseMode.String() != value {
+			t.Errorf("parse mode %v but expected %v", parseMode, value)
+		}
+	}
+	if _, err := sql_parser.ParseParseMode("some"); err == nil {
+		t.Errorf("expected error for unknown parse mode")
+	}
+}
@@ -0,0 +1,554 @@
+/*M!999999\- enable the sandbox mode */ 
+-- MariaDB dump 10.19-11.4.2-MariaDB, for debian-linux-gnu (x86_64)
+--
+-- Host: localhost    Database: shop
+-- ------------------------------------------------------
+-- Server version	11.4.2-MariaDB-ubu2404
+
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
+/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;
+/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;
+/*!40101 SET NAMES utf8mb4 */;
+/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */;
+/*!40103 SET TIME_ZONE='+00:00' */;
+/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;
+/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;
+/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
+/*M!100616 SET @OLD_NOTE_VERBOSITY=@@NOTE_VERBOSITY, NOTE_VERBOSITY=0 */;
+
+--
+-- Table structure for table `products`
+--
+
+DROP TABLE IF EXISTS `products`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `products` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `sku` char(8) NOT NULL,
+  `price` decimal(8,2) NOT NULL DEFAULT 0.00,
+  `tags` longtext CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT NULL CHECK (json_valid(`tags`)),
+  `image` mediumblob DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_products_sku` (`sku`)
+) ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `products`
+--
+
+LOCK TABLES `products` WRITE;
+/*!40000 ALTER TABLE `products` DISABLE KEYS */;
+INSERT INTO `products` VALUES
+(1,'SKU-0001',9.99,'[\"a\", \"b;c\"]',X'89504E47'),
+(2,'SKU-0002',19.99,NULL,_binary 'GIF89a\0\0');
+/*!40000 ALTER TABLE `products` ENABLE KEYS */;
+UNLOCK TABLES;
+commit;
+
+--
+-- Table structure for table `product_stock`
+--
+
+DROP TABLE IF EXISTS `product_stock`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `product_stock` (
+  `product_id` int(11) NOT NULL,
+  `warehouse` varchar(32) NOT NULL,
+  `quantity` int(11) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`product_id`,`warehouse`),
+  CONSTRAINT `fk_stock_product` FOREIGN KEY (`product_id`) REFERENCES `products` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `product_stock`
+--
+
+LOCK TABLES `product_stock` WRITE;
+/*!40000 ALTER TABLE `product_stock` DISABLE KEYS */;
+INSERT INTO `product_stock` VALUES
+(1,'north',10),
+(2,'south',0),
+(4,'dock 4;;',4),
+(5,'dock 5;;',5),
+(6,'dock 6;;',6),
+(7,'dock 7;;',7),
+(8,'dock 8;;',8),
+(9,'dock 9;;',9),
+(10,'dock 10;;',10),
+(11,'dock 11;;',11),
+(12,'dock 12;;',12),
+(13,'dock 13;;',13),
+(14,'dock 14;;',14),
+(15,'dock 15;;',15),
+(16,'dock 16;;',16),
+(17,'dock 17;;',17),
+(18,'dock 18;;',18),
+(19,'dock 19;;',19),
+(20,'dock 20;;',20),
+(21,'dock 21;;',21),
+(22,'dock 22;;',22),
+(23,'dock 23;;',23),
+(24,'dock 24;;',24),
+(25,'dock 25;;',25),
+(26,'dock 26;;',26),
+(27,'dock 27;;',27),
+(28,'dock 28;;',28),
+(29,'dock 29;;',29),
+(30,'dock 30;;',30),
+(31,'dock 31;;',31),
+(32,'dock 32;;',32),
+(33,'dock 33;;',33),
+(34,'dock 34;;',34),
+(35,'dock 35;;',35),
+(36,'dock 36;;',36),
+(37,'dock 37;;',37),
+(38,'dock 38;;',38),
+(39,'dock 39;;',39),
+(40,'dock 40;;',40),
+(41,'dock 41;;',41),
+(42,'dock 42;;',42),
+(43,'dock 43;;',43),
+(44,'dock 44;;',44),
+(45,'dock 45;;',45),
+(46,'dock 46;;',46),
+(47,'dock 47;;',47),
+(48,'dock 48;;',48),
+(49,'dock 49;;',49),
+(50,'dock 50;;',50),
+(51,'dock 51;;',51),
+(52,'dock 52;;',52),
+(53,'dock 53;;',53),
+(54,'dock 54;;',54),
+(55,'dock 55;;',55),
+(56,'dock 56;;',56),
+(57,'dock 57;;',57),
+(58,'dock 58;;',58),
+(59,'dock 59;;',59),
+(60,'dock 60;;',60),
+(61,'dock 61;;',61),
+(62,'dock 62;;',62),
+(63,'dock 63;;',63),
+(64,'dock 64;;',64),
+(65,'dock 65;;',65),
+(66,'dock 66;;',66),
+(67,'dock 67;;',67),
+(68,'dock 68;;',68),
+(69,'dock 69;;',69),
+(70,'dock 70;;',70),
+(71,'dock 71;;',71),
+(72,'dock 72;;',72),
+(73,'dock 73;;',73),
+(74,'dock 74;;',74),
+(75,'dock 75;;',75),
+(76,'dock 76;;',76),
+(77,'dock 77;;',77),
+(78,'dock 78;;',78),
+(79,'dock 79;;',79),
+(80,'dock 80;;',80),
+(81,'dock 81;;',81),
+(82,'dock 82;;',82),
+(83,'dock 83;;',83),
+(84,'dock 84;;',84),
+(85,'dock 85;;',85),
+(86,'dock 86;;',86),
+(87,'dock 87;;',87),
+(88,'dock 88;;',88),
+(89,'dock 89;;',89),
+(90,'dock 90;;',90),
+(91,'dock 91;;',91),
+(92,'dock 92;;',92),
+(93,'dock 93;;',93),
+(94,'dock 94;;',94),
+(95,'dock 95;;',95),
+(96,'dock 96;;',96),
+(97,'dock 97;;',97),
+(98,'dock 98;;',98),
+(99,'dock 99;;',99),
+(100,'dock 100;;',100),
+(101,'dock 101;;',101),
+(102,'dock 102;;',102),
+(103,'dock 103;;',103),
+(104,'dock 104;;',104),
+(105,'dock 105;;',105),
+(106,'dock 106;;',106),
+(107,'dock 107;;',107),
+(108,'dock 108;;',108),
+(109,'dock 109;;',109),
+(110,'dock 110;;',110),
+(111,'dock 111;;',111),
+(112,'dock 112;;',112),
+(113,'dock 113;;',113),
+(114,'dock 114;;',114),
+(115,'dock 115;;',115),
+(116,'dock 116;;',116),
+(117,'dock 117;;',117),
+(118,'dock 118;;',118),
+(119,'dock 119;;',119),
+(120,'dock 120;;',120),
+(121,'dock 121;;',121),
+(122,'dock 122;;',122),
+(123,'dock 123;;',123),
+(124,'dock 124;;',124),
+(125,'dock 125;;',125),
+(126,'dock 126;;',126),
+(127,'dock 127;;',127),
+(128,'dock 128;;',128),
+(129,'dock 129;;',129),
+(130,'dock 130;;',130),
+(131,'dock 131;;',131),
+(132,'dock 132;;',132),
+(133,'dock 133;;',133),
+(134,'dock 134;;',134),
+(135,'dock 135;;',135),
+(136,'dock 136;;',136),
+(137,'dock 137;;',137),
+(138,'dock 138;;',138),
+(139,'dock 139;;',139),
+(140,'dock 140;;',140),
+(141,'dock 141;;',141),
+(142,'dock 142;;',142),
+(143,'dock 143;;',143),
+(144,'dock 144;;',144),
+(145,'dock 145;;',145),
+(146,'dock 146;;',146),
+(147,'dock 147;;',147),
+(148,'dock 148;;',148),
+(149,'dock 149;;',149),
+(150,'dock 150;;',150),
+(151,'dock 151;;',151),
+(152,'dock 152;;',152),
+(153,'dock 153;;',153),
+(154,'dock 154;;',154),
+(155,'dock 155;;',155),
+(156,'dock 156;;',156),
+(157,'dock 157;;',157),
+(158,'dock 158;;',158),
+(159,'dock 159;;',159),
+(160,'dock 160;;',160),
+(161,'dock 161;;',161),
+(162,'dock 162;;',162),
+(163,'dock 163;;',163),
+(164,'dock 164;;',164),
+(165,'dock 165;;',165),
+(166,'dock 166;;',166),
+(167,'dock 167;;',167),
+(168,'dock 168;;',168),
+(169,'dock 169;;',169),
+(170,'dock 170;;',170),
+(171,'dock 171;;',171),
+(172,'dock 172;;',172),
+(173,'dock 173;;',173),
+(174,'dock 174;;',174),
+(175,'dock 175;;',175),
+(176,'dock 176;;',176),
+(177,'dock 177;;',177),
+(178,'dock 178;;',178),
+(179,'dock 179;;',179),
+(180,'dock 180;;',180),
+(181,'dock 181;;',181),
+(182,'dock 182;;',182),
+(183,'dock 183;;',183),
+(184,'dock 184;;',184),
+(185,'dock 185;;',185),
+(186,'dock 186;;',186),
+(187,'dock 187;;',187),
+(188,'dock 188;;',188),
+(189,'dock 189;;',189),
+(190,'dock 190;;',190),
+(191,'dock 191;;',191),
+(192,'dock 192;;',192),
+(193,'dock 193;;',193),
+(194,'dock 194;;',194),
+(195,'dock 195;;',195),
+(196,'dock 196;;',196),
+(197,'dock 197;;',197),
+(198,'dock 198;;',198),
+(199,'dock 199;;',199),
+(200,'dock 200;;',200),
+(201,'dock 201;;',201),
+(202,'dock 202;;',202),
+(203,'dock 203;;',203),
+(204,'dock 204;;',204),
+(205,'dock 205;;',205),
+(206,'dock 206;;',206),
+(207,'dock 207;;',207),
+(208,'dock 208;;',208),
+(209,'dock 209;;',209),
+(210,'dock 210;;',210),
+(211,'dock 211;;',211),
+(212,'dock 212;;',212),
+(213,'dock 213;;',213),
+(214,'dock 214;;',214),
+(215,'dock 215;;',215),
+(216,'dock 216;;',216),
+(217,'dock 217;;',217),
+(218,'dock 218;;',218),
+(219,'dock 219;;',219),
+(220,'dock 220;;',220),
+(221,'dock 221;;',221),
+(222,'dock 222;;',222),
+(223,'dock 223;;',223),
+(224,'dock 224;;',224),
+(225,'dock 225;;',225),
+(226,'dock 226;;',226),
+(227,'dock 227;;',227),
+(228,'dock 228;;',228),
+(229,'dock 229;;',229),
+(230,'dock 230;;',230),
+(231,'dock 231;;',231),
+(232,'dock 232;;',232),
+(233,'dock 233;;',233),
+(234,'dock 234;;',234),
+(235,'dock 235;;',235),
+(236,'dock 236;;',236),
+(237,'dock 237;;',237),
+(238,'dock 238;;',238),
+(239,'dock 239;;',239),
+(240,'dock 240;;',240),
+(241,'dock 241;;',241),
+(242,'dock 242;;',242),
+(243,'dock 243;;',243),
+(244,'dock 244;;',244),
+(245,'dock 245;;',245),
+(246,'dock 246;;',246),
+(247,'dock 247;;',247),
+(248,'dock 248;;',248),
+(249,'dock 249;;',249),
+(250,'dock 250;;',250),
+(251,'dock 251;;',251),
+(252,'dock 252;;',252),
+(253,'dock 253;;',253),
+(254,'dock 254;;',254),
+(255,'dock 255;;',255),
+(256,'dock 256;;',256),
+(257,'dock 257;;',257),
+(258,'dock 258;;',258),
+(259,'dock 259;;',259),
+(260,'dock 260;;',260),
+(261,'dock 261;;',261),
+(262,'dock 262;;',262),
+(263,'dock 263;;',263),
+(264,'dock 264;;',264),
+(265,'dock 265;;',265),
+(266,'dock 266;;',266),
+(267,'dock 267;;',267),
+(268,'dock 268;;',268),
+(269,'dock 269;;',269),
+(270,'dock 270;;',270),
+(271,'dock 271;;',271),
+(272,'dock 272;;',272),
+(273,'dock 273;;',273),
+(274,'dock 274;;',274),
+(275,'dock 275;;',275),
+(276,'dock 276;;',276),
+(277,'dock 277;;',277),
+(278,'dock 278;;',278),
+(279,'dock 279;;',279),
+(280,'dock 280;;',280),
+(281,'dock 281;;',281),
+(282,'dock 282;;',282),
+(283,'dock 283;;',283),
+(284,'dock 284;;',284),
+(285,'dock 285;;',285),
+(286,'dock 286;;',286),
+(287,'dock 287;;',287),
+(288,'dock 288;;',288),
+(289,'dock 289;;',289),
+(290,'dock 290;;',290),
+(291,'dock 291;;',291),
+(292,'dock 292;;',292),
+(293,'dock 293;;',293),
+(294,'dock 294;;',294),
+(295,'dock 295;;',295),
+(296,'dock 296;;',296),
+(297,'dock 297;;',297),
+(298,'dock 298;;',298),
+(299,'dock 299;;',299),
+(300,'dock 300;;',300),
+(301,'dock 301;;',301),
+(302,'dock 302;;',302),
+(303,'dock 303;;',303),
+(304,'dock 304;;',304),
+(305,'dock 305;;',305),
+(306,'dock 306;;',306),
+(307,'dock 307;;',307),
+(308,'dock 308;;',308),
+(309,'dock 309;;',309),
+(310,'dock 310;;',310),
+(311,'dock 311;;',311),
+(312,'dock 312;;',312),
+(313,'dock 313;;',313),
+(314,'dock 314;;',314),
+(315,'dock 315;;',315),
+(316,'dock 316;;',316),
+(317,'dock 317;;',317),
+(318,'dock 318;;',318),
+(319,'dock 319;;',319),
+(320,'dock 320;;',320),
+(321,'dock 321;;',321),
+(322,'dock 322;;',322),
+(323,'dock 323;;',323),
+(324,'dock 324;;',324),
+(325,'dock 325;;',325),
+(326,'dock 326;;',326),
+(327,'dock 327;;',327),
+(328,'dock 328;;',328),
+(329,'dock 329;;',329),
+(330,'dock 330;;',330),
+(331,'dock 331;;',331),
+(332,'dock 332;;',332),
+(333,'dock 333;;',333),
+(334,'dock 334;;',334),
+(335,'dock 335;;',335),
+(336,'dock 336;;',336),
+(337,'dock 337;;',337),
+(338,'dock 338;;',338),
+(339,'dock 339;;',339),
+(340,'dock 340;;',340),
+(341,'dock 341;;',341),
+(342,'dock 342;;',342),
+(343,'dock 343;;',343),
+(344,'dock 344;;',344),
+(345,'dock 345;;',345),
+(346,'dock 346;;',346),
+(347,'dock 347;;',347),
+(348,'dock 348;;',348),
+(349,'dock 349;;',349),
+(350,'dock 350;;',350),
+(351,'dock 351;;',351),
+(352,'dock 352;;',352),
+(353,'dock 353;;',353),
+(354,'dock 354;;',354),
+(355,'dock 355;;',355),
+(356,'dock 356;;',356),
+(357,'dock 357;;',357),
+(358,'dock 358;;',358),
+(359,'dock 359;;',359),
+(360,'dock 360;;',360),
+(361,'dock 361;;',361),
+(362,'dock 362;;',362),
+(363,'dock 363;;',363),
+(364,'dock 364;;',364),
+(365,'dock 365;;',365),
+(366,'dock 366;;',366),
+(367,'dock 367;;',367),
+(368,'dock 368;;',368),
+(369,'dock 369;;',369),
+(370,'dock 370;;',370),
+(371,'dock 371;;',371),
+(372,'dock 372;;',372),
+(373,'dock 373;;',373),
+(374,'dock 374;;',374),
+(375,'dock 375;;',375),
+(376,'dock 376;;',376),
+(377,'dock 377;;',377),
+(378,'dock 378;;',378),
+(379,'dock 379;;',379),
+(380,'dock 380;;',380),
+(381,'dock 381;;',381),
+(382,'dock 382;;',382),
+(383,'dock 383;;',383),
+(384,'dock 384;;',384),
+(385,'dock 385;;',385),
+(386,'dock 386;;',386),
+(387,'dock 387;;',387),
+(388,'dock 388;;',388),
+(389,'dock 389;;',389),
+(390,'dock 390;;',390),
+(391,'dock 391;;',391),
+(392,'dock 392;;',392),
+(393,'dock 393;;',393),
+(394,'dock 394;;',394),
+(395,'dock 395;;',395),
+(396,'dock 396;;',396),
+(397,'dock 397;;',397),
+(398,'dock 398;;',398),
+(399,'dock 399;;',399),
+(400,'dock 400;;',400),
+(401,'dock 401;;',401),
+(402,'dock 402;;',402),
+(403,'dock 403;;',403);
+/*!40000 ALTER TABLE `product_stock` ENABLE KEYS */;
+UNLOCK TABLES;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'STRICT_TRANS_TABLES,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `product_stock_before_insert` BEFORE INSERT ON `product_stock` FOR EACH ROW BEGIN
+  IF NEW.`quantity` < 0 THEN
+    SET NEW.`quantity` = 0;
+  END IF;
+END */;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+commit;
+
+--
+-- Dumping routines for database 'shop'
+--
+/*!50003 DROP FUNCTION IF EXISTS `product_stock_sum` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'STRICT_TRANS_TABLES,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` FUNCTION `product_stock_sum`(minimum int) RETURNS int
+    READS SQL DATA
+BEGIN
+  DECLARE result int;
+  SELECT COUNT(*) INTO result FROM `product_stock` WHERE `quantity` >= minimum;
+  RETURN result;
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!50003 DROP PROCEDURE IF EXISTS `product_stock_reset` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'STRICT_TRANS_TABLES,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` PROCEDURE `product_stock_reset`(IN note varchar(32))
+BEGIN
+  -- The semicolons of the body; and the ;; inside the string don't end the routine
+  UPDATE `product_stock` SET `quantity` = 0 WHERE `quantity` < 0;
+  SELECT CONCAT('reset;; ', note);
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;
+
+/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
+/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;
+/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */;
+/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;
+/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;
+/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;
+/*M!100616 SET NOTE_VERBOSITY=@OLD_NOTE_VERBOSITY */;
+
+-- Dump completed on 2024-06-10 12:00:00
@@ -0,0 +1,195 @@
+-- MySQL dump 10.13  Distrib 5.7.44, for Linux (x86_64)
+--
+-- Host: localhost    Database: shop
+-- ------------------------------------------------------
+-- Server version	5.7.44
+
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
+/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;
+/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;
+/*!40101 SET NAMES utf8 */;
+/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */;
+/*!40103 SET TIME_ZONE='+00:00' */;
+/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;
+/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;
+/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
+/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
+
+--
+-- Current Database: `shop`
+--
+
+CREATE DATABASE /*!32312 IF NOT EXISTS*/ `shop` /*!40100 DEFAULT CHARACTER SET latin1 */;
+
+USE `shop`;
+
+--
+-- Table structure for table `customers`
+--
+
+DROP TABLE IF EXISTS `customers`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `customers` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `email` varchar(255) NOT NULL,
+  `name` varchar(100) DEFAULT NULL,
+  `avatar` blob,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_customers_email` (`email`)
+) ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `customers`
+--
+
+LOCK TABLES `customers` WRITE;
+/*!40000 ALTER TABLE `customers` DISABLE KEYS */;
+INSERT INTO `customers` VALUES (1,'ann@example.com','Ann',_binary '\0\0PNG\r\n;','2023-01-02 03:04:05'),(2,'bob@example.com','Bob O\'Neil; Jr.',NULL,'2023-01-03 00:00:00');
+/*!40000 ALTER TABLE `customers` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `orders`
+--
+
+DROP TABLE IF EXISTS `orders`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!40101 SET character_set_client = utf8 */;
+CREATE TABLE `orders` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `customer_id` int(11) NOT NULL,
+  `total` decimal(10,2) NOT NULL DEFAULT '0.00',
+  `status` enum('new','paid','shipped') NOT NULL DEFAULT 'new',
+  `checksum` varbinary(16) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `fk_orders_customer` (`customer_id`),
+  CONSTRAINT `fk_orders_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB AUTO_INCREMENT=4 DEFAULT CHARSET=utf8mb4;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `orders`
+--
+
+LOCK TABLES `orders` WRITE;
+/*!40000 ALTER TABLE `orders` DISABLE KEYS */;
+INSERT INTO `orders` VALUES (1,1,150.00,'paid',0x0A0B0C0D),(2,1,20.50,'new',NULL),(3,2,99.99,'shipped',_binary 'ab\\c'),(4,1,4.25,'paid','note 4; DELIMITER ;'),(5,2,5.25,'paid','note 5; DELIMITER ;'),(6,1,6.25,'paid','note 6; DELIMITER ;'),(7,2,7.25,'paid','note 7; DELIMITER ;'),(8,1,8.25,'paid','note 8; DELIMITER ;'),(9,2,9.25,'paid','note 9; DELIMITER ;'),(10,1,10.25,'paid','note 10; DELIMITER ;'),(11,2,11.25,'paid','note 11; DELIMITER ;'),(12,1,12.25,'paid','note 12; DELIMITER ;'),(13,2,13.25,'paid','note 13; DELIMITER ;'),(14,1,14.25,'paid','note 14; DELIMITER ;'),(15,2,15.25,'paid','note 15; DELIMITER ;'),(16,1,16.25,'paid','note 16; DELIMITER ;'),(17,2,17.25,'paid','note 17; DELIMITER ;'),(18,1,18.25,'paid','note 18; DELIMITER ;'),(19,2,19.25,'paid','note 19; DELIMITER ;'),(20,1,20.25,'paid','note 20; DELIMITER ;'),(21,2,21.25,'paid','note 21; DELIMITER ;'),(22,1,22.25,'paid','note 22; DELIMITER ;'),(23,2,23.25,'paid','note 23; DELIMITER ;'),(24,1,24.25,'paid','note 24; DELIMITER ;'),(25,2,25.25,'paid','note 25; DELIMITER ;'),(26,1,26.25,'paid','note 26; DELIMITER ;'),(27,2,27.25,'paid','note 27; DELIMITER ;'),(28,1,28.25,'paid','note 28; DELIMITER ;'),(29,2,29.25,'paid','note 29; DELIMITER ;'),(30,1,30.25,'paid','note 30; DELIMITER ;'),(31,2,31.25,'paid','note 31; DELIMITER ;'),(32,1,32.25,'paid','note 32; DELIMITER ;'),(33,2,33.25,'paid','note 33; DELIMITER ;'),(34,1,34.25,'paid','note 34; DELIMITER ;'),(35,2,35.25,'paid','note 35; DELIMITER ;'),(36,1,36.25,'paid','note 36; DELIMITER ;'),(37,2,37.25,'paid','note 37; DELIMITER ;'),(38,1,38.25,'paid','note 38; DELIMITER ;'),(39,2,39.25,'paid','note 39; DELIMITER ;'),(40,1,40.25,'paid','note 40; DELIMITER ;'),(41,2,41.25,'paid','note 41; DELIMITER ;'),(42,1,42.25,'paid','note 42; DELIMITER ;'),(43,2,43.25,'paid','note 43; DELIMITER ;'),(44,1,44.25,'paid','note 44; DELIMITER ;'),(45,2,45.25,'paid','note 45; DELIMITER ;'),(46,1,46.25,'paid','note 46; DELIMITER ;'),(47,2,47.25,'paid','note 47; DELIMITER ;'),(48,1,48.25,'paid','note 48; DELIMITER ;'),(49,2,49.25,'paid','note 49; DELIMITER ;'),(50,1,50.25,'paid','note 50; DELIMITER ;'),(51,2,51.25,'paid','note 51; DELIMITER ;'),(52,1,52.25,'paid','note 52; DELIMITER ;'),(53,2,53.25,'paid','note 53; DELIMITER ;'),(54,1,54.25,'paid','note 54; DELIMITER ;'),(55,2,55.25,'paid','note 55; DELIMITER ;'),(56,1,56.25,'paid','note 56; DELIMITER ;'),(57,2,57.25,'paid','note 57; DELIMITER ;'),(58,1,58.25,'paid','note 58; DELIMITER ;'),(59,2,59.25,'paid','note 59; DELIMITER ;'),(60,1,60.25,'paid','note 60; DELIMITER ;'),(61,2,61.25,'paid','note 61; DELIMITER ;'),(62,1,62.25,'paid','note 62; DELIMITER ;'),(63,2,63.25,'paid','note 63; DELIMITER ;'),(64,1,64.25,'paid','note 64; DELIMITER ;'),(65,2,65.25,'paid','note 65; DELIMITER ;'),(66,1,66.25,'paid','note 66; DELIMITER ;'),(67,2,67.25,'paid','note 67; DELIMITER ;'),(68,1,68.25,'paid','note 68; DELIMITER ;'),(69,2,69.25,'paid','note 69; DELIMITER ;'),(70,1,70.25,'paid','note 70; DELIMITER ;'),(71,2,71.25,'paid','note 71; DELIMITER ;'),(72,1,72.25,'paid','note 72; DELIMITER ;'),(73,2,73.25,'paid','note 73; DELIMITER ;'),(74,1,74.25,'paid','note 74; DELIMITER ;'),(75,2,75.25,'paid','note 75; DELIMITER ;'),(76,1,76.25,'paid','note 76; DELIMITER ;'),(77,2,77.25,'paid','note 77; DELIMITER ;'),(78,1,78.25,'paid','note 78; DELIMITER ;'),(79,2,79.25,'paid','note 79; DELIMITER ;'),(80,1,80.25,'paid','note 80; DELIMITER ;'),(81,2,81.25,'paid','note 81; DELIMITER ;'),(82,1,82.25,'paid','note 82; DELIMITER ;'),(83,2,83.25,'paid','note 83; DELIMITER ;'),(84,1,84.25,'paid','note 84; DELIMITER ;'),(85,2,85.25,'paid','note 85; DELIMITER ;'),(86,1,86.25,'paid','note 86; DELIMITER ;'),(87,2,87.25,'paid','note 87; DELIMITER ;'),(88,1,88.25,'paid','note 88; DELIMITER ;'),(89,2,89.25,'paid','note 89; DELIMITER ;'),(90,1,90.25,'paid','note 90; DELIMITER ;'),(91,2,91.25,'paid','note 91; DELIMITER ;'),(92,1,92.25,'paid','note 92; DELIMITER ;'),(93,2,93.25,'paid','note 93; DELIMITER ;'),(94,1,94.25,'paid','note 94; DELIMITER ;'),(95,2,95.25,'paid','note 95; DELIMITER ;'),(96,1,96.25,'paid','note 96; DELIMITER ;'),(97,2,97.25,'paid','note 97; DELIMITER ;'),(98,1,98.25,'paid','note 98; DELIMITER ;'),(99,2,99.25,'paid','note 99; DELIMITER ;'),(100,1,100.25,'paid','note 100; DELIMITER ;'),(101,2,101.25,'paid','note 101; DELIMITER ;'),(102,1,102.25,'paid','note 102; DELIMITER ;'),(103,2,103.25,'paid','note 103; DELIMITER ;'),(104,1,104.25,'paid','note 104; DELIMITER ;'),(105,2,105.25,'paid','note 105; DELIMITER ;'),(106,1,106.25,'paid','note 106; DELIMITER ;'),(107,2,107.25,'paid','note 107; DELIMITER ;'),(108,1,108.25,'paid','note 108; DELIMITER ;'),(109,2,109.25,'paid','note 109; DELIMITER ;'),(110,1,110.25,'paid','note 110; DELIMITER ;'),(111,2,111.25,'paid','note 111; DELIMITER ;'),(112,1,112.25,'paid','note 112; DELIMITER ;'),(113,2,113.25,'paid','note 113; DELIMITER ;'),(114,1,114.25,'paid','note 114; DELIMITER ;'),(115,2,115.25,'paid','note 115; DELIMITER ;'),(116,1,116.25,'paid','note 116; DELIMITER ;'),(117,2,117.25,'paid','note 117; DELIMITER ;'),(118,1,118.25,'paid','note 118; DELIMITER ;'),(119,2,119.25,'paid','note 119; DELIMITER ;'),(120,1,120.25,'paid','note 120; DELIMITER ;'),(121,2,121.25,'paid','note 121; DELIMITER ;'),(122,1,122.25,'paid','note 122; DELIMITER ;'),(123,2,123.25,'paid','note 123; DELIMITER ;'),(124,1,124.25,'paid','note 124; DELIMITER ;'),(125,2,125.25,'paid','note 125; DELIMITER ;'),(126,1,126.25,'paid','note 126; DELIMITER ;'),(127,2,127.25,'paid','note 127; DELIMITER ;'),(128,1,128.25,'paid','note 128; DELIMITER ;'),(129,2,129.25,'paid','note 129; DELIMITER ;'),(130,1,130.25,'paid','note 130; DELIMITER ;'),(131,2,131.25,'paid','note 131; DELIMITER ;'),(132,1,132.25,'paid','note 132; DELIMITER ;'),(133,2,133.25,'paid','note 133; DELIMITER ;'),(134,1,134.25,'paid','note 134; DELIMITER ;'),(135,2,135.25,'paid','note 135; DELIMITER ;'),(136,1,136.25,'paid','note 136; DELIMITER ;'),(137,2,137.25,'paid','note 137; DELIMITER ;'),(138,1,138.25,'paid','note 138; DELIMITER ;'),(139,2,139.25,'paid','note 139; DELIMITER ;'),(140,1,140.25,'paid','note 140; DELIMITER ;'),(141,2,141.25,'paid','note 141; DELIMITER ;'),(142,1,142.25,'paid','note 142; DELIMITER ;'),(143,2,143.25,'paid','note 143; DELIMITER ;'),(144,1,144.25,'paid','note 144; DELIMITER ;'),(145,2,145.25,'paid','note 145; DELIMITER ;'),(146,1,146.25,'paid','note 146; DELIMITER ;'),(147,2,147.25,'paid','note 147; DELIMITER ;'),(148,1,148.25,'paid','note 148; DELIMITER ;'),(149,2,149.25,'paid','note 149; DELIMITER ;'),(150,1,150.25,'paid','note 150; DELIMITER ;'),(151,2,151.25,'paid','note 151; DELIMITER ;'),(152,1,152.25,'paid','note 152; DELIMITER ;'),(153,2,153.25,'paid','note 153; DELIMITER ;'),(154,1,154.25,'paid','note 154; DELIMITER ;'),(155,2,155.25,'paid','note 155; DELIMITER ;'),(156,1,156.25,'paid','note 156; DELIMITER ;'),(157,2,157.25,'paid','note 157; DELIMITER ;'),(158,1,158.25,'paid','note 158; DELIMITER ;'),(159,2,159.25,'paid','note 159; DELIMITER ;'),(160,1,160.25,'paid','note 160; DELIMITER ;'),(161,2,161.25,'paid','note 161; DELIMITER ;'),(162,1,162.25,'paid','note 162; DELIMITER ;'),(163,2,163.25,'paid','note 163; DELIMITER ;'),(164,1,164.25,'paid','note 164; DELIMITER ;'),(165,2,165.25,'paid','note 165; DELIMITER ;'),(166,1,166.25,'paid','note 166; DELIMITER ;'),(167,2,167.25,'paid','note 167; DELIMITER ;'),(168,1,168.25,'paid','note 168; DELIMITER ;'),(169,2,169.25,'paid','note 169; DELIMITER ;'),(170,1,170.25,'paid','note 170; DELIMITER ;'),(171,2,171.25,'paid','note 171; DELIMITER ;'),(172,1,172.25,'paid','note 172; DELIMITER ;'),(173,2,173.25,'paid','note 173; DELIMITER ;'),(174,1,174.25,'paid','note 174; DELIMITER ;'),(175,2,175.25,'paid','note 175; DELIMITER ;'),(176,1,176.25,'paid','note 176; DELIMITER ;'),(177,2,177.25,'paid','note 177; DELIMITER ;'),(178,1,178.25,'paid','note 178; DELIMITER ;'),(179,2,179.25,'paid','note 179; DELIMITER ;'),(180,1,180.25,'paid','note 180; DELIMITER ;'),(181,2,181.25,'paid','note 181; DELIMITER ;'),(182,1,182.25,'paid','note 182; DELIMITER ;'),(183,2,183.25,'paid','note 183; DELIMITER ;'),(184,1,184.25,'paid','note 184; DELIMITER ;'),(185,2,185.25,'paid','note 185; DELIMITER ;'),(186,1,186.25,'paid','note 186; DELIMITER ;'),(187,2,187.25,'paid','note 187; DELIMITER ;'),(188,1,188.25,'paid','note 188; DELIMITER ;'),(189,2,189.25,'paid','note 189; DELIMITER ;'),(190,1,190.25,'paid','note 190; DELIMITER ;'),(191,2,191.25,'paid','note 191; DELIMITER ;'),(192,1,192.25,'paid','note 192; DELIMITER ;'),(193,2,193.25,'paid','note 193; DELIMITER ;'),(194,1,194.25,'paid','note 194; DELIMITER ;'),(195,2,195.25,'paid','note 195; DELIMITER ;'),(196,1,196.25,'paid','note 196; DELIMITER ;'),(197,2,197.25,'paid','note 197; DELIMITER ;'),(198,1,198.25,'paid','note 198; DELIMITER ;'),(199,2,199.25,'paid','note 199; DELIMITER ;'),(200,1,200.25,'paid','note 200; DELIMITER ;'),(201,2,201.25,'paid','note 201; DELIMITER ;'),(202,1,202.25,'paid','note 202; DELIMITER ;'),(203,2,203.25,'paid','note 203; DELIMITER ;'),(204,1,204.25,'paid','note 204; DELIMITER ;'),(205,2,205.25,'paid','note 205; DELIMITER ;'),(206,1,206.25,'paid','note 206; DELIMITER ;'),(207,2,207.25,'paid','note 207; DELIMITER ;'),(208,1,208.25,'paid','note 208; DELIMITER ;'),(209,2,209.25,'paid','note 209; DELIMITER ;'),(210,1,210.25,'paid','note 210; DELIMITER ;'),(211,2,211.25,'paid','note 211; DELIMITER ;'),(212,1,212.25,'paid','note 212; DELIMITER ;'),(213,2,213.25,'paid','note 213; DELIMITER ;'),(214,1,214.25,'paid','note 214; DELIMITER ;'),(215,2,215.25,'paid','note 215; DELIMITER ;'),(216,1,216.25,'paid','note 216; DELIMITER ;'),(217,2,217.25,'paid','note 217; DELIMITER ;'),(218,1,218.25,'paid','note 218; DELIMITER ;'),(219,2,219.25,'paid','note 219; DELIMITER ;'),(220,1,220.25,'paid','note 220; DELIMITER ;'),(221,2,221.25,'paid','note 221; DELIMITER ;'),(222,1,222.25,'paid','note 222; DELIMITER ;'),(223,2,223.25,'paid','note 223; DELIMITER ;'),(224,1,224.25,'paid','note 224; DELIMITER ;'),(225,2,225.25,'paid','note 225; DELIMITER ;'),(226,1,226.25,'paid','note 226; DELIMITER ;'),(227,2,227.25,'paid','note 227; DELIMITER ;'),(228,1,228.25,'paid','note 228; DELIMITER ;'),(229,2,229.25,'paid','note 229; DELIMITER ;'),(230,1,230.25,'paid','note 230; DELIMITER ;'),(231,2,231.25,'paid','note 231; DELIMITER ;'),(232,1,232.25,'paid','note 232; DELIMITER ;'),(233,2,233.25,'paid','note 233; DELIMITER ;'),(234,1,234.25,'paid','note 234; DELIMITER ;'),(235,2,235.25,'paid','note 235; DELIMITER ;'),(236,1,236.25,'paid','note 236; DELIMITER ;'),(237,2,237.25,'paid','note 237; DELIMITER ;'),(238,1,238.25,'paid','note 238; DELIMITER ;'),(239,2,239.25,'paid','note 239; DELIMITER ;'),(240,1,240.25,'paid','note 240; DELIMITER ;'),(241,2,241.25,'paid','note 241; DELIMITER ;'),(242,1,242.25,'paid','note 242; DELIMITER ;'),(243,2,243.25,'paid','note 243; DELIMITER ;'),(244,1,244.25,'paid','note 244; DELIMITER ;'),(245,2,245.25,'paid','note 245; DELIMITER ;'),(246,1,246.25,'paid','note 246; DELIMITER ;'),(247,2,247.25,'paid','note 247; DELIMITER ;'),(248,1,248.25,'paid','note 248; DELIMITER ;'),(249,2,249.25,'paid','note 249; DELIMITER ;'),(250,1,250.25,'paid','note 250; DELIMITER ;'),(251,2,251.25,'paid','note 251; DELIMITER ;'),(252,1,252.25,'paid','note 252; DELIMITER ;'),(253,2,253.25,'paid','note 253; DELIMITER ;'),(254,1,254.25,'paid','note 254; DELIMITER ;'),(255,2,255.25,'paid','note 255; DELIMITER ;'),(256,1,256.25,'paid','note 256; DELIMITER ;'),(257,2,257.25,'paid','note 257; DELIMITER ;'),(258,1,258.25,'paid','note 258; DELIMITER ;'),(259,2,259.25,'paid','note 259; DELIMITER ;'),(260,1,260.25,'paid','note 260; DELIMITER ;'),(261,2,261.25,'paid','note 261; DELIMITER ;'),(262,1,262.25,'paid','note 262; DELIMITER ;'),(263,2,263.25,'paid','note 263; DELIMITER ;'),(264,1,264.25,'paid','note 264; DELIMITER ;'),(265,2,265.25,'paid','note 265; DELIMITER ;'),(266,1,266.25,'paid','note 266; DELIMITER ;'),(267,2,267.25,'paid','note 267; DELIMITER ;'),(268,1,268.25,'paid','note 268; DELIMITER ;'),(269,2,269.25,'paid','note 269; DELIMITER ;'),(270,1,270.25,'paid','note 270; DELIMITER ;'),(271,2,271.25,'paid','note 271; DELIMITER ;'),(272,1,272.25,'paid','note 272; DELIMITER ;'),(273,2,273.25,'paid','note 273; DELIMITER ;'),(274,1,274.25,'paid','note 274; DELIMITER ;'),(275,2,275.25,'paid','note 275; DELIMITER ;'),(276,1,276.25,'paid','note 276; DELIMITER ;'),(277,2,277.25,'paid','note 277; DELIMITER ;'),(278,1,278.25,'paid','note 278; DELIMITER ;'),(279,2,279.25,'paid','note 279; DELIMITER ;'),(280,1,280.25,'paid','note 280; DELIMITER ;'),(281,2,281.25,'paid','note 281; DELIMITER ;'),(282,1,282.25,'paid','note 282; DELIMITER ;'),(283,2,283.25,'paid','note 283; DELIMITER ;'),(284,1,284.25,'paid','note 284; DELIMITER ;'),(285,2,285.25,'paid','note 285; DELIMITER ;'),(286,1,286.25,'paid','note 286; DELIMITER ;'),(287,2,287.25,'paid','note 287; DELIMITER ;'),(288,1,288.25,'paid','note 288; DELIMITER ;'),(289,2,289.25,'paid','note 289; DELIMITER ;'),(290,1,290.25,'paid','note 290; DELIMITER ;'),(291,2,291.25,'paid','note 291; DELIMITER ;'),(292,1,292.25,'paid','note 292; DELIMITER ;'),(293,2,293.25,'paid','note 293; DELIMITER ;'),(294,1,294.25,'paid','note 294; DELIMITER ;'),(295,2,295.25,'paid','note 295; DELIMITER ;'),(296,1,296.25,'paid','note 296; DELIMITER ;'),(297,2,297.25,'paid','note 297; DELIMITER ;'),(298,1,298.25,'paid','note 298; DELIMITER ;'),(299,2,299.25,'paid','note 299; DELIMITER ;'),(300,1,300.25,'paid','note 300; DELIMITER ;'),(301,2,301.25,'paid','note 301; DELIMITER ;'),(302,1,302.25,'paid','note 302; DELIMITER ;'),(303,2,303.25,'paid','note 303; DELIMITER ;'),(304,1,304.25,'paid','note 304; DELIMITER ;'),(305,2,305.25,'paid','note 305; DELIMITER ;'),(306,1,306.25,'paid','note 306; DELIMITER ;'),(307,2,307.25,'paid','note 307; DELIMITER ;'),(308,1,308.25,'paid','note 308; DELIMITER ;'),(309,2,309.25,'paid','note 309; DELIMITER ;'),(310,1,310.25,'paid','note 310; DELIMITER ;'),(311,2,311.25,'paid','note 311; DELIMITER ;'),(312,1,312.25,'paid','note 312; DELIMITER ;'),(313,2,313.25,'paid','note 313; DELIMITER ;'),(314,1,314.25,'paid','note 314; DELIMITER ;'),(315,2,315.25,'paid','note 315; DELIMITER ;'),(316,1,316.25,'paid','note 316; DELIMITER ;'),(317,2,317.25,'paid','note 317; DELIMITER ;'),(318,1,318.25,'paid','note 318; DELIMITER ;'),(319,2,319.25,'paid','note 319; DELIMITER ;'),(320,1,320.25,'paid','note 320; DELIMITER ;'),(321,2,321.25,'paid','note 321; DELIMITER ;'),(322,1,322.25,'paid','note 322; DELIMITER ;'),(323,2,323.25,'paid','note 323; DELIMITER ;'),(324,1,324.25,'paid','note 324; DELIMITER ;'),(325,2,325.25,'paid','note 325; DELIMITER ;'),(326,1,326.25,'paid','note 326; DELIMITER ;'),(327,2,327.25,'paid','note 327; DELIMITER ;'),(328,1,328.25,'paid','note 328; DELIMITER ;'),(329,2,329.25,'paid','note 329; DELIMITER ;'),(330,1,330.25,'paid','note 330; DELIMITER ;'),(331,2,331.25,'paid','note 331; DELIMITER ;'),(332,1,332.25,'paid','note 332; DELIMITER ;'),(333,2,333.25,'paid','note 333; DELIMITER ;'),(334,1,334.25,'paid','note 334; DELIMITER ;'),(335,2,335.25,'paid','note 335; DELIMITER ;'),(336,1,336.25,'paid','note 336; DELIMITER ;'),(337,2,337.25,'paid','note 337; DELIMITER ;'),(338,1,338.25,'paid','note 338; DELIMITER ;'),(339,2,339.25,'paid','note 339; DELIMITER ;'),(340,1,340.25,'paid','note 340; DELIMITER ;'),(341,2,341.25,'paid','note 341; DELIMITER ;'),(342,1,342.25,'paid','note 342; DELIMITER ;'),(343,2,343.25,'paid','note 343; DELIMITER ;'),(344,1,344.25,'paid','note 344; DELIMITER ;'),(345,2,345.25,'paid','note 345; DELIMITER ;'),(346,1,346.25,'paid','note 346; DELIMITER ;'),(347,2,347.25,'paid','note 347; DELIMITER ;'),(348,1,348.25,'paid','note 348; DELIMITER ;'),(349,2,349.25,'paid','note 349; DELIMITER ;'),(350,1,350.25,'paid','note 350; DELIMITER ;'),(351,2,351.25,'paid','note 351; DELIMITER ;'),(352,1,352.25,'paid','note 352; DELIMITER ;'),(353,2,353.25,'paid','note 353; DELIMITER ;'),(354,1,354.25,'paid','note 354; DELIMITER ;'),(355,2,355.25,'paid','note 355; DELIMITER ;'),(356,1,356.25,'paid','note 356; DELIMITER ;'),(357,2,357.25,'paid','note 357; DELIMITER ;'),(358,1,358.25,'paid','note 358; DELIMITER ;'),(359,2,359.25,'paid','note 359; DELIMITER ;'),(360,1,360.25,'paid','note 360; DELIMITER ;'),(361,2,361.25,'paid','note 361; DELIMITER ;'),(362,1,362.25,'paid','note 362; DELIMITER ;'),(363,2,363.25,'paid','note 363; DELIMITER ;'),(364,1,364.25,'paid','note 364; DELIMITER ;'),(365,2,365.25,'paid','note 365; DELIMITER ;'),(366,1,366.25,'paid','note 366; DELIMITER ;'),(367,2,367.25,'paid','note 367; DELIMITER ;'),(368,1,368.25,'paid','note 368; DELIMITER ;'),(369,2,369.25,'paid','note 369; DELIMITER ;'),(370,1,370.25,'paid','note 370; DELIMITER ;'),(371,2,371.25,'paid','note 371; DELIMITER ;'),(372,1,372.25,'paid','note 372; DELIMITER ;'),(373,2,373.25,'paid','note 373; DELIMITER ;'),(374,1,374.25,'paid','note 374; DELIMITER ;'),(375,2,375.25,'paid','note 375; DELIMITER ;'),(376,1,376.25,'paid','note 376; DELIMITER ;'),(377,2,377.25,'paid','note 377; DELIMITER ;'),(378,1,378.25,'paid','note 378; DELIMITER ;'),(379,2,379.25,'paid','note 379; DELIMITER ;'),(380,1,380.25,'paid','note 380; DELIMITER ;'),(381,2,381.25,'paid','note 381; DELIMITER ;'),(382,1,382.25,'paid','note 382; DELIMITER ;'),(383,2,383.25,'paid','note 383; DELIMITER ;'),(384,1,384.25,'paid','note 384; DELIMITER ;'),(385,2,385.25,'paid','note 385; DELIMITER ;'),(386,1,386.25,'paid','note 386; DELIMITER ;'),(387,2,387.25,'paid','note 387; DELIMITER ;'),(388,1,388.25,'paid','note 388; DELIMITER ;'),(389,2,389.25,'paid','note 389; DELIMITER ;'),(390,1,390.25,'paid','note 390; DELIMITER ;'),(391,2,391.25,'paid','note 391; DELIMITER ;'),(392,1,392.25,'paid','note 392; DELIMITER ;'),(393,2,393.25,'paid','note 393; DELIMITER ;'),(394,1,394.25,'paid','note 394; DELIMITER ;'),(395,2,395.25,'paid','note 395; DELIMITER ;'),(396,1,396.25,'paid','note 396; DELIMITER ;'),(397,2,397.25,'paid','note 397; DELIMITER ;'),(398,1,398.25,'paid','note 398; DELIMITER ;'),(399,2,399.25,'paid','note 399; DELIMITER ;'),(400,1,400.25,'paid','note 400; DELIMITER ;'),(401,2,401.25,'paid','note 401; DELIMITER ;'),(402,1,402.25,'paid','note 402; DELIMITER ;'),(403,2,403.25,'paid','note 403; DELIMITER ;');
+/*!40000 ALTER TABLE `orders` ENABLE KEYS */;
+UNLOCK TABLES;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `orders_before_insert` BEFORE INSERT ON `orders` FOR EACH ROW BEGIN
+  IF NEW.`total` < 0 THEN
+    SET NEW.`total` = 0;
+  END IF;
+END */;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+
+--
+-- Temporary table structure for view `big_orders`
+--
+
+DROP TABLE IF EXISTS `big_orders`;
+/*!50001 DROP VIEW IF EXISTS `big_orders`*/;
+SET @saved_cs_client     = @@character_set_client;
+SET character_set_client = utf8;
+/*!50001 CREATE VIEW `big_orders` AS SELECT 
+ 1 AS `id`,
+ 1 AS `total`*/;
+SET character_set_client = @saved_cs_client;
+
+--
+-- Current Database: `shop`
+--
+
+USE `shop`;
+
+--
+-- Final view structure for view `big_orders`
+--
+
+/*!50001 DROP VIEW IF EXISTS `big_orders`*/;
+/*!50001 SET @saved_cs_client          = @@character_set_client */;
+/*!50001 SET @saved_cs_results         = @@character_set_results */;
+/*!50001 SET @saved_col_connection     = @@collation_connection */;
+/*!50001 SET character_set_client      = utf8 */;
+/*!50001 SET character_set_results     = utf8 */;
+/*!50001 SET collation_connection      = utf8_general_ci */;
+/*!50001 CREATE ALGORITHM=UNDEFINED */
+/*!50013 DEFINER=`root`@`localhost` SQL SECURITY DEFINER */
+/*!50001 VIEW `big_orders` AS select `orders`.`id` AS `id`,`orders`.`total` AS `total` from `orders` where (`orders`.`total` > 100) */;
+/*!50001 SET character_set_client      = @saved_cs_client */;
+/*!50001 SET character_set_results     = @saved_cs_results */;
+/*!50001 SET collation_connection      = @saved_col_connection */;
+
+--
+-- Dumping routines for database 'shop'
+--
+/*!50003 DROP FUNCTION IF EXISTS `orders_sum` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` FUNCTION `orders_sum`(minimum int) RETURNS int
+    READS SQL DATA
+BEGIN
+  DECLARE result int;
+  SELECT COUNT(*) INTO result FROM `orders` WHERE `total` >= minimum;
+  RETURN result;
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!50003 DROP PROCEDURE IF EXISTS `orders_reset` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_general_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_AUTO_CREATE_USER,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` PROCEDURE `orders_reset`(IN note varchar(32))
+BEGIN
+  -- The semicolons of the body; and the ;; inside the string don't end the routine
+  UPDATE `orders` SET `total` = 0 WHERE `total` < 0;
+  SELECT CONCAT('reset;; ', note);
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;
+
+/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
+/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;
+/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */;
+/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;
+/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;
+/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;
+/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */;
+
+-- Dump completed on 2024-01-15 10:20:30
@@ -0,0 +1,206 @@
+-- MySQL dump 10.13  Distrib 8.0.36, for Linux (x86_64)
+--
+-- Host: localhost    Database: shop
+-- ------------------------------------------------------
+-- Server version	8.0.36
+
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
+/*!40101 SET @OLD_CHARACTER_SET_RESULTS=@@CHARACTER_SET_RESULTS */;
+/*!40101 SET @OLD_COLLATION_CONNECTION=@@COLLATION_CONNECTION */;
+/*!50503 SET NAMES utf8mb4 */;
+/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */;
+/*!40103 SET TIME_ZONE='+00:00' */;
+/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */;
+/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */;
+/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */;
+/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */;
+SET @MYSQLDUMP_TEMP_LOG_BIN = @@SESSION.SQL_LOG_BIN;
+SET @@SESSION.SQL_LOG_BIN= 0;
+
+--
+-- GTID state at the beginning of the backup 
+--
+
+SET @@GLOBAL.GTID_PURGED=/*!80000 '+'*/ '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-58';
+
+--
+-- Current Database: `shop`
+--
+
+CREATE DATABASE /*!32312 IF NOT EXISTS*/ `shop` /*!40100 DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci */ /*!80016 DEFAULT ENCRYPTION='N' */;
+
+USE `shop`;
+
+--
+-- Table structure for table `customers`
+--
+
+DROP TABLE IF EXISTS `customers`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `customers` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `email` varchar(255) COLLATE utf8mb4_0900_ai_ci NOT NULL,
+  `name` varchar(100) COLLATE utf8mb4_0900_ai_ci DEFAULT NULL,
+  `avatar` blob,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_customers_email` (`email`)
+) ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `customers`
+--
+
+LOCK TABLES `customers` WRITE;
+/*!40000 ALTER TABLE `customers` DISABLE KEYS */;
+INSERT INTO `customers` VALUES (1,'ann@example.com','Ann',_binary '\0\0PNG\r\n;','2023-01-02 03:04:05'),(2,'bob@example.com','Bob O\'Neil; Jr.',NULL,'2023-01-03 00:00:00');
+/*!40000 ALTER TABLE `customers` ENABLE KEYS */;
+UNLOCK TABLES;
+
+--
+-- Table structure for table `orders`
+--
+
+DROP TABLE IF EXISTS `orders`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `orders` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `customer_id` int NOT NULL,
+  `total` decimal(10,2) NOT NULL DEFAULT '0.00',
+  `status` enum('new','paid','shipped') COLLATE utf8mb4_0900_ai_ci NOT NULL DEFAULT 'new',
+  `payload` json DEFAULT NULL,
+  `checksum` varbinary(16) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `fk_orders_customer` (`customer_id`),
+  CONSTRAINT `fk_orders_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `chk_orders_total` CHECK ((`total` >= 0))
+) ENGINE=InnoDB AUTO_INCREMENT=4 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+--
+-- Dumping data for table `orders`
+--
+
+LOCK TABLES `orders` WRITE;
+/*!40000 ALTER TABLE `orders` DISABLE KEYS */;
+INSERT INTO `orders` VALUES (1,1,150.00,'paid','{\"items\": [1, 2], \"note\": \"a;b\"}',0x0A0B0C0D),(2,1,20.50,'new',NULL,NULL),(3,2,99.99,'shipped','[]',_binary 'ab\\c'),(4,1,4.25,'paid','{\"note\": \"4;;\"}',NULL),(5,2,5.25,'paid','{\"note\": \"5;;\"}',NULL),(6,1,6.25,'paid','{\"note\": \"6;;\"}',NULL),(7,2,7.25,'paid','{\"note\": \"7;;\"}',NULL),(8,1,8.25,'paid','{\"note\": \"8;;\"}',NULL),(9,2,9.25,'paid','{\"note\": \"9;;\"}',NULL),(10,1,10.25,'paid','{\"note\": \"10;;\"}',NULL),(11,2,11.25,'paid','{\"note\": \"11;;\"}',NULL),(12,1,12.25,'paid','{\"note\": \"12;;\"}',NULL),(13,2,13.25,'paid','{\"note\": \"13;;\"}',NULL),(14,1,14.25,'paid','{\"note\": \"14;;\"}',NULL),(15,2,15.25,'paid','{\"note\": \"15;;\"}',NULL),(16,1,16.25,'paid','{\"note\": \"16;;\"}',NULL),(17,2,17.25,'paid','{\"note\": \"17;;\"}',NULL),(18,1,18.25,'paid','{\"note\": \"18;;\"}',NULL),(19,2,19.25,'paid','{\"note\": \"19;;\"}',NULL),(20,1,20.25,'paid','{\"note\": \"20;;\"}',NULL),(21,2,21.25,'paid','{\"note\": \"21;;\"}',NULL),(22,1,22.25,'paid','{\"note\": \"22;;\"}',NULL),(23,2,23.25,'paid','{\"note\": \"23;;\"}',NULL),(24,1,24.25,'paid','{\"note\": \"24;;\"}',NULL),(25,2,25.25,'paid','{\"note\": \"25;;\"}',NULL),(26,1,26.25,'paid','{\"note\": \"26;;\"}',NULL),(27,2,27.25,'paid','{\"note\": \"27;;\"}',NULL),(28,1,28.25,'paid','{\"note\": \"28;;\"}',NULL),(29,2,29.25,'paid','{\"note\": \"29;;\"}',NULL),(30,1,30.25,'paid','{\"note\": \"30;;\"}',NULL),(31,2,31.25,'paid','{\"note\": \"31;;\"}',NULL),(32,1,32.25,'paid','{\"note\": \"32;;\"}',NULL),(33,2,33.25,'paid','{\"note\": \"33;;\"}',NULL),(34,1,34.25,'paid','{\"note\": \"34;;\"}',NULL),(35,2,35.25,'paid','{\"note\": \"35;;\"}',NULL),(36,1,36.25,'paid','{\"note\": \"36;;\"}',NULL),(37,2,37.25,'paid','{\"note\": \"37;;\"}',NULL),(38,1,38.25,'paid','{\"note\": \"38;;\"}',NULL),(39,2,39.25,'paid','{\"note\": \"39;;\"}',NULL),(40,1,40.25,'paid','{\"note\": \"40;;\"}',NULL),(41,2,41.25,'paid','{\"note\": \"41;;\"}',NULL),(42,1,42.25,'paid','{\"note\": \"42;;\"}',NULL),(43,2,43.25,'paid','{\"note\": \"43;;\"}',NULL),(44,1,44.25,'paid','{\"note\": \"44;;\"}',NULL),(45,2,45.25,'paid','{\"note\": \"45;;\"}',NULL),(46,1,46.25,'paid','{\"note\": \"46;;\"}',NULL),(47,2,47.25,'paid','{\"note\": \"47;;\"}',NULL),(48,1,48.25,'paid','{\"note\": \"48;;\"}',NULL),(49,2,49.25,'paid','{\"note\": \"49;;\"}',NULL),(50,1,50.25,'paid','{\"note\": \"50;;\"}',NULL),(51,2,51.25,'paid','{\"note\": \"51;;\"}',NULL),(52,1,52.25,'paid','{\"note\": \"52;;\"}',NULL),(53,2,53.25,'paid','{\"note\": \"53;;\"}',NULL),(54,1,54.25,'paid','{\"note\": \"54;;\"}',NULL),(55,2,55.25,'paid','{\"note\": \"55;;\"}',NULL),(56,1,56.25,'paid','{\"note\": \"56;;\"}',NULL),(57,2,57.25,'paid','{\"note\": \"57;;\"}',NULL),(58,1,58.25,'paid','{\"note\": \"58;;\"}',NULL),(59,2,59.25,'paid','{\"note\": \"59;;\"}',NULL),(60,1,60.25,'paid','{\"note\": \"60;;\"}',NULL),(61,2,61.25,'paid','{\"note\": \"61;;\"}',NULL),(62,1,62.25,'paid','{\"note\": \"62;;\"}',NULL),(63,2,63.25,'paid','{\"note\": \"63;;\"}',NULL),(64,1,64.25,'paid','{\"note\": \"64;;\"}',NULL),(65,2,65.25,'paid','{\"note\": \"65;;\"}',NULL),(66,1,66.25,'paid','{\"note\": \"66;;\"}',NULL),(67,2,67.25,'paid','{\"note\": \"67;;\"}',NULL),(68,1,68.25,'paid','{\"note\": \"68;;\"}',NULL),(69,2,69.25,'paid','{\"note\": \"69;;\"}',NULL),(70,1,70.25,'paid','{\"note\": \"70;;\"}',NULL),(71,2,71.25,'paid','{\"note\": \"71;;\"}',NULL),(72,1,72.25,'paid','{\"note\": \"72;;\"}',NULL),(73,2,73.25,'paid','{\"note\": \"73;;\"}',NULL),(74,1,74.25,'paid','{\"note\": \"74;;\"}',NULL),(75,2,75.25,'paid','{\"note\": \"75;;\"}',NULL),(76,1,76.25,'paid','{\"note\": \"76;;\"}',NULL),(77,2,77.25,'paid','{\"note\": \"77;;\"}',NULL),(78,1,78.25,'paid','{\"note\": \"78;;\"}',NULL),(79,2,79.25,'paid','{\"note\": \"79;;\"}',NULL),(80,1,80.25,'paid','{\"note\": \"80;;\"}',NULL),(81,2,81.25,'paid','{\"note\": \"81;;\"}',NULL),(82,1,82.25,'paid','{\"note\": \"82;;\"}',NULL),(83,2,83.25,'paid','{\"note\": \"83;;\"}',NULL),(84,1,84.25,'paid','{\"note\": \"84;;\"}',NULL),(85,2,85.25,'paid','{\"note\": \"85;;\"}',NULL),(86,1,86.25,'paid','{\"note\": \"86;;\"}',NULL),(87,2,87.25,'paid','{\"note\": \"87;;\"}',NULL),(88,1,88.25,'paid','{\"note\": \"88;;\"}',NULL),(89,2,89.25,'paid','{\"note\": \"89;;\"}',NULL),(90,1,90.25,'paid','{\"note\": \"90;;\"}',NULL),(91,2,91.25,'paid','{\"note\": \"91;;\"}',NULL),(92,1,92.25,'paid','{\"note\": \"92;;\"}',NULL),(93,2,93.25,'paid','{\"note\": \"93;;\"}',NULL),(94,1,94.25,'paid','{\"note\": \"94;;\"}',NULL),(95,2,95.25,'paid','{\"note\": \"95;;\"}',NULL),(96,1,96.25,'paid','{\"note\": \"96;;\"}',NULL),(97,2,97.25,'paid','{\"note\": \"97;;\"}',NULL),(98,1,98.25,'paid','{\"note\": \"98;;\"}',NULL),(99,2,99.25,'paid','{\"note\": \"99;;\"}',NULL),(100,1,100.25,'paid','{\"note\": \"100;;\"}',NULL),(101,2,101.25,'paid','{\"note\": \"101;;\"}',NULL),(102,1,102.25,'paid','{\"note\": \"102;;\"}',NULL),(103,2,103.25,'paid','{\"note\": \"103;;\"}',NULL),(104,1,104.25,'paid','{\"note\": \"104;;\"}',NULL),(105,2,105.25,'paid','{\"note\": \"105;;\"}',NULL),(106,1,106.25,'paid','{\"note\": \"106;;\"}',NULL),(107,2,107.25,'paid','{\"note\": \"107;;\"}',NULL),(108,1,108.25,'paid','{\"note\": \"108;;\"}',NULL),(109,2,109.25,'paid','{\"note\": \"109;;\"}',NULL),(110,1,110.25,'paid','{\"note\": \"110;;\"}',NULL),(111,2,111.25,'paid','{\"note\": \"111;;\"}',NULL),(112,1,112.25,'paid','{\"note\": \"112;;\"}',NULL),(113,2,113.25,'paid','{\"note\": \"113;;\"}',NULL),(114,1,114.25,'paid','{\"note\": \"114;;\"}',NULL),(115,2,115.25,'paid','{\"note\": \"115;;\"}',NULL),(116,1,116.25,'paid','{\"note\": \"116;;\"}',NULL),(117,2,117.25,'paid','{\"note\": \"117;;\"}',NULL),(118,1,118.25,'paid','{\"note\": \"118;;\"}',NULL),(119,2,119.25,'paid','{\"note\": \"119;;\"}',NULL),(120,1,120.25,'paid','{\"note\": \"120;;\"}',NULL),(121,2,121.25,'paid','{\"note\": \"121;;\"}',NULL),(122,1,122.25,'paid','{\"note\": \"122;;\"}',NULL),(123,2,123.25,'paid','{\"note\": \"123;;\"}',NULL),(124,1,124.25,'paid','{\"note\": \"124;;\"}',NULL),(125,2,125.25,'paid','{\"note\": \"125;;\"}',NULL),(126,1,126.25,'paid','{\"note\": \"126;;\"}',NULL),(127,2,127.25,'paid','{\"note\": \"127;;\"}',NULL),(128,1,128.25,'paid','{\"note\": \"128;;\"}',NULL),(129,2,129.25,'paid','{\"note\": \"129;;\"}',NULL),(130,1,130.25,'paid','{\"note\": \"130;;\"}',NULL),(131,2,131.25,'paid','{\"note\": \"131;;\"}',NULL),(132,1,132.25,'paid','{\"note\": \"132;;\"}',NULL),(133,2,133.25,'paid','{\"note\": \"133;;\"}',NULL),(134,1,134.25,'paid','{\"note\": \"134;;\"}',NULL),(135,2,135.25,'paid','{\"note\": \"135;;\"}',NULL),(136,1,136.25,'paid','{\"note\": \"136;;\"}',NULL),(137,2,137.25,'paid','{\"note\": \"137;;\"}',NULL),(138,1,138.25,'paid','{\"note\": \"138;;\"}',NULL),(139,2,139.25,'paid','{\"note\": \"139;;\"}',NULL),(140,1,140.25,'paid','{\"note\": \"140;;\"}',NULL),(141,2,141.25,'paid','{\"note\": \"141;;\"}',NULL),(142,1,142.25,'paid','{\"note\": \"142;;\"}',NULL),(143,2,143.25,'paid','{\"note\": \"143;;\"}',NULL),(144,1,144.25,'paid','{\"note\": \"144;;\"}',NULL),(145,2,145.25,'paid','{\"note\": \"145;;\"}',NULL),(146,1,146.25,'paid','{\"note\": \"146;;\"}',NULL),(147,2,147.25,'paid','{\"note\": \"147;;\"}',NULL),(148,1,148.25,'paid','{\"note\": \"148;;\"}',NULL),(149,2,149.25,'paid','{\"note\": \"149;;\"}',NULL),(150,1,150.25,'paid','{\"note\": \"150;;\"}',NULL),(151,2,151.25,'paid','{\"note\": \"151;;\"}',NULL),(152,1,152.25,'paid','{\"note\": \"152;;\"}',NULL),(153,2,153.25,'paid','{\"note\": \"153;;\"}',NULL),(154,1,154.25,'paid','{\"note\": \"154;;\"}',NULL),(155,2,155.25,'paid','{\"note\": \"155;;\"}',NULL),(156,1,156.25,'paid','{\"note\": \"156;;\"}',NULL),(157,2,157.25,'paid','{\"note\": \"157;;\"}',NULL),(158,1,158.25,'paid','{\"note\": \"158;;\"}',NULL),(159,2,159.25,'paid','{\"note\": \"159;;\"}',NULL),(160,1,160.25,'paid','{\"note\": \"160;;\"}',NULL),(161,2,161.25,'paid','{\"note\": \"161;;\"}',NULL),(162,1,162.25,'paid','{\"note\": \"162;;\"}',NULL),(163,2,163.25,'paid','{\"note\": \"163;;\"}',NULL),(164,1,164.25,'paid','{\"note\": \"164;;\"}',NULL),(165,2,165.25,'paid','{\"note\": \"165;;\"}',NULL),(166,1,166.25,'paid','{\"note\": \"166;;\"}',NULL),(167,2,167.25,'paid','{\"note\": \"167;;\"}',NULL),(168,1,168.25,'paid','{\"note\": \"168;;\"}',NULL),(169,2,169.25,'paid','{\"note\": \"169;;\"}',NULL),(170,1,170.25,'paid','{\"note\": \"170;;\"}',NULL),(171,2,171.25,'paid','{\"note\": \"171;;\"}',NULL),(172,1,172.25,'paid','{\"note\": \"172;;\"}',NULL),(173,2,173.25,'paid','{\"note\": \"173;;\"}',NULL),(174,1,174.25,'paid','{\"note\": \"174;;\"}',NULL),(175,2,175.25,'paid','{\"note\": \"175;;\"}',NULL),(176,1,176.25,'paid','{\"note\": \"176;;\"}',NULL),(177,2,177.25,'paid','{\"note\": \"177;;\"}',NULL),(178,1,178.25,'paid','{\"note\": \"178;;\"}',NULL),(179,2,179.25,'paid','{\"note\": \"179;;\"}',NULL),(180,1,180.25,'paid','{\"note\": \"180;;\"}',NULL),(181,2,181.25,'paid','{\"note\": \"181;;\"}',NULL),(182,1,182.25,'paid','{\"note\": \"182;;\"}',NULL),(183,2,183.25,'paid','{\"note\": \"183;;\"}',NULL),(184,1,184.25,'paid','{\"note\": \"184;;\"}',NULL),(185,2,185.25,'paid','{\"note\": \"185;;\"}',NULL),(186,1,186.25,'paid','{\"note\": \"186;;\"}',NULL),(187,2,187.25,'paid','{\"note\": \"187;;\"}',NULL),(188,1,188.25,'paid','{\"note\": \"188;;\"}',NULL),(189,2,189.25,'paid','{\"note\": \"189;;\"}',NULL),(190,1,190.25,'paid','{\"note\": \"190;;\"}',NULL),(191,2,191.25,'paid','{\"note\": \"191;;\"}',NULL),(192,1,192.25,'paid','{\"note\": \"192;;\"}',NULL),(193,2,193.25,'paid','{\"note\": \"193;;\"}',NULL),(194,1,194.25,'paid','{\"note\": \"194;;\"}',NULL),(195,2,195.25,'paid','{\"note\": \"195;;\"}',NULL),(196,1,196.25,'paid','{\"note\": \"196;;\"}',NULL),(197,2,197.25,'paid','{\"note\": \"197;;\"}',NULL),(198,1,198.25,'paid','{\"note\": \"198;;\"}',NULL),(199,2,199.25,'paid','{\"note\": \"199;;\"}',NULL),(200,1,200.25,'paid','{\"note\": \"200;;\"}',NULL),(201,2,201.25,'paid','{\"note\": \"201;;\"}',NULL),(202,1,202.25,'paid','{\"note\": \"202;;\"}',NULL),(203,2,203.25,'paid','{\"note\": \"203;;\"}',NULL),(204,1,204.25,'paid','{\"note\": \"204;;\"}',NULL),(205,2,205.25,'paid','{\"note\": \"205;;\"}',NULL),(206,1,206.25,'paid','{\"note\": \"206;;\"}',NULL),(207,2,207.25,'paid','{\"note\": \"207;;\"}',NULL),(208,1,208.25,'paid','{\"note\": \"208;;\"}',NULL),(209,2,209.25,'paid','{\"note\": \"209;;\"}',NULL),(210,1,210.25,'paid','{\"note\": \"210;;\"}',NULL),(211,2,211.25,'paid','{\"note\": \"211;;\"}',NULL),(212,1,212.25,'paid','{\"note\": \"212;;\"}',NULL),(213,2,213.25,'paid','{\"note\": \"213;;\"}',NULL),(214,1,214.25,'paid','{\"note\": \"214;;\"}',NULL),(215,2,215.25,'paid','{\"note\": \"215;;\"}',NULL),(216,1,216.25,'paid','{\"note\": \"216;;\"}',NULL),(217,2,217.25,'paid','{\"note\": \"217;;\"}',NULL),(218,1,218.25,'paid','{\"note\": \"218;;\"}',NULL),(219,2,219.25,'paid','{\"note\": \"219;;\"}',NULL),(220,1,220.25,'paid','{\"note\": \"220;;\"}',NULL),(221,2,221.25,'paid','{\"note\": \"221;;\"}',NULL),(222,1,222.25,'paid','{\"note\": \"222;;\"}',NULL),(223,2,223.25,'paid','{\"note\": \"223;;\"}',NULL),(224,1,224.25,'paid','{\"note\": \"224;;\"}',NULL),(225,2,225.25,'paid','{\"note\": \"225;;\"}',NULL),(226,1,226.25,'paid','{\"note\": \"226;;\"}',NULL),(227,2,227.25,'paid','{\"note\": \"227;;\"}',NULL),(228,1,228.25,'paid','{\"note\": \"228;;\"}',NULL),(229,2,229.25,'paid','{\"note\": \"229;;\"}',NULL),(230,1,230.25,'paid','{\"note\": \"230;;\"}',NULL),(231,2,231.25,'paid','{\"note\": \"231;;\"}',NULL),(232,1,232.25,'paid','{\"note\": \"232;;\"}',NULL),(233,2,233.25,'paid','{\"note\": \"233;;\"}',NULL),(234,1,234.25,'paid','{\"note\": \"234;;\"}',NULL),(235,2,235.25,'paid','{\"note\": \"235;;\"}',NULL),(236,1,236.25,'paid','{\"note\": \"236;;\"}',NULL),(237,2,237.25,'paid','{\"note\": \"237;;\"}',NULL),(238,1,238.25,'paid','{\"note\": \"238;;\"}',NULL),(239,2,239.25,'paid','{\"note\": \"239;;\"}',NULL),(240,1,240.25,'paid','{\"note\": \"240;;\"}',NULL),(241,2,241.25,'paid','{\"note\": \"241;;\"}',NULL),(242,1,242.25,'paid','{\"note\": \"242;;\"}',NULL),(243,2,243.25,'paid','{\"note\": \"243;;\"}',NULL),(244,1,244.25,'paid','{\"note\": \"244;;\"}',NULL),(245,2,245.25,'paid','{\"note\": \"245;;\"}',NULL),(246,1,246.25,'paid','{\"note\": \"246;;\"}',NULL),(247,2,247.25,'paid','{\"note\": \"247;;\"}',NULL),(248,1,248.25,'paid','{\"note\": \"248;;\"}',NULL),(249,2,249.25,'paid','{\"note\": \"249;;\"}',NULL),(250,1,250.25,'paid','{\"note\": \"250;;\"}',NULL),(251,2,251.25,'paid','{\"note\": \"251;;\"}',NULL),(252,1,252.25,'paid','{\"note\": \"252;;\"}',NULL),(253,2,253.25,'paid','{\"note\": \"253;;\"}',NULL),(254,1,254.25,'paid','{\"note\": \"254;;\"}',NULL),(255,2,255.25,'paid','{\"note\": \"255;;\"}',NULL),(256,1,256.25,'paid','{\"note\": \"256;;\"}',NULL),(257,2,257.25,'paid','{\"note\": \"257;;\"}',NULL),(258,1,258.25,'paid','{\"note\": \"258;;\"}',NULL),(259,2,259.25,'paid','{\"note\": \"259;;\"}',NULL),(260,1,260.25,'paid','{\"note\": \"260;;\"}',NULL),(261,2,261.25,'paid','{\"note\": \"261;;\"}',NULL),(262,1,262.25,'paid','{\"note\": \"262;;\"}',NULL),(263,2,263.25,'paid','{\"note\": \"263;;\"}',NULL),(264,1,264.25,'paid','{\"note\": \"264;;\"}',NULL),(265,2,265.25,'paid','{\"note\": \"265;;\"}',NULL),(266,1,266.25,'paid','{\"note\": \"266;;\"}',NULL),(267,2,267.25,'paid','{\"note\": \"267;;\"}',NULL),(268,1,268.25,'paid','{\"note\": \"268;;\"}',NULL),(269,2,269.25,'paid','{\"note\": \"269;;\"}',NULL),(270,1,270.25,'paid','{\"note\": \"270;;\"}',NULL),(271,2,271.25,'paid','{\"note\": \"271;;\"}',NULL),(272,1,272.25,'paid','{\"note\": \"272;;\"}',NULL),(273,2,273.25,'paid','{\"note\": \"273;;\"}',NULL),(274,1,274.25,'paid','{\"note\": \"274;;\"}',NULL),(275,2,275.25,'paid','{\"note\": \"275;;\"}',NULL),(276,1,276.25,'paid','{\"note\": \"276;;\"}',NULL),(277,2,277.25,'paid','{\"note\": \"277;;\"}',NULL),(278,1,278.25,'paid','{\"note\": \"278;;\"}',NULL),(279,2,279.25,'paid','{\"note\": \"279;;\"}',NULL),(280,1,280.25,'paid','{\"note\": \"280;;\"}',NULL),(281,2,281.25,'paid','{\"note\": \"281;;\"}',NULL),(282,1,282.25,'paid','{\"note\": \"282;;\"}',NULL),(283,2,283.25,'paid','{\"note\": \"283;;\"}',NULL),(284,1,284.25,'paid','{\"note\": \"284;;\"}',NULL),(285,2,285.25,'paid','{\"note\": \"285;;\"}',NULL),(286,1,286.25,'paid','{\"note\": \"286;;\"}',NULL),(287,2,287.25,'paid','{\"note\": \"287;;\"}',NULL),(288,1,288.25,'paid','{\"note\": \"288;;\"}',NULL),(289,2,289.25,'paid','{\"note\": \"289;;\"}',NULL),(290,1,290.25,'paid','{\"note\": \"290;;\"}',NULL),(291,2,291.25,'paid','{\"note\": \"291;;\"}',NULL),(292,1,292.25,'paid','{\"note\": \"292;;\"}',NULL),(293,2,293.25,'paid','{\"note\": \"293;;\"}',NULL),(294,1,294.25,'paid','{\"note\": \"294;;\"}',NULL),(295,2,295.25,'paid','{\"note\": \"295;;\"}',NULL),(296,1,296.25,'paid','{\"note\": \"296;;\"}',NULL),(297,2,297.25,'paid','{\"note\": \"297;;\"}',NULL),(298,1,298.25,'paid','{\"note\": \"298;;\"}',NULL),(299,2,299.25,'paid','{\"note\": \"299;;\"}',NULL),(300,1,300.25,'paid','{\"note\": \"300;;\"}',NULL),(301,2,301.25,'paid','{\"note\": \"301;;\"}',NULL),(302,1,302.25,'paid','{\"note\": \"302;;\"}',NULL),(303,2,303.25,'paid','{\"note\": \"303;;\"}',NULL),(304,1,304.25,'paid','{\"note\": \"304;;\"}',NULL),(305,2,305.25,'paid','{\"note\": \"305;;\"}',NULL),(306,1,306.25,'paid','{\"note\": \"306;;\"}',NULL),(307,2,307.25,'paid','{\"note\": \"307;;\"}',NULL),(308,1,308.25,'paid','{\"note\": \"308;;\"}',NULL),(309,2,309.25,'paid','{\"note\": \"309;;\"}',NULL),(310,1,310.25,'paid','{\"note\": \"310;;\"}',NULL),(311,2,311.25,'paid','{\"note\": \"311;;\"}',NULL),(312,1,312.25,'paid','{\"note\": \"312;;\"}',NULL),(313,2,313.25,'paid','{\"note\": \"313;;\"}',NULL),(314,1,314.25,'paid','{\"note\": \"314;;\"}',NULL),(315,2,315.25,'paid','{\"note\": \"315;;\"}',NULL),(316,1,316.25,'paid','{\"note\": \"316;;\"}',NULL),(317,2,317.25,'paid','{\"note\": \"317;;\"}',NULL),(318,1,318.25,'paid','{\"note\": \"318;;\"}',NULL),(319,2,319.25,'paid','{\"note\": \"319;;\"}',NULL),(320,1,320.25,'paid','{\"note\": \"320;;\"}',NULL),(321,2,321.25,'paid','{\"note\": \"321;;\"}',NULL),(322,1,322.25,'paid','{\"note\": \"322;;\"}',NULL),(323,2,323.25,'paid','{\"note\": \"323;;\"}',NULL),(324,1,324.25,'paid','{\"note\": \"324;;\"}',NULL),(325,2,325.25,'paid','{\"note\": \"325;;\"}',NULL),(326,1,326.25,'paid','{\"note\": \"326;;\"}',NULL),(327,2,327.25,'paid','{\"note\": \"327;;\"}',NULL),(328,1,328.25,'paid','{\"note\": \"328;;\"}',NULL),(329,2,329.25,'paid','{\"note\": \"329;;\"}',NULL),(330,1,330.25,'paid','{\"note\": \"330;;\"}',NULL),(331,2,331.25,'paid','{\"note\": \"331;;\"}',NULL),(332,1,332.25,'paid','{\"note\": \"332;;\"}',NULL),(333,2,333.25,'paid','{\"note\": \"333;;\"}',NULL),(334,1,334.25,'paid','{\"note\": \"334;;\"}',NULL),(335,2,335.25,'paid','{\"note\": \"335;;\"}',NULL),(336,1,336.25,'paid','{\"note\": \"336;;\"}',NULL),(337,2,337.25,'paid','{\"note\": \"337;;\"}',NULL),(338,1,338.25,'paid','{\"note\": \"338;;\"}',NULL),(339,2,339.25,'paid','{\"note\": \"339;;\"}',NULL),(340,1,340.25,'paid','{\"note\": \"340;;\"}',NULL),(341,2,341.25,'paid','{\"note\": \"341;;\"}',NULL),(342,1,342.25,'paid','{\"note\": \"342;;\"}',NULL),(343,2,343.25,'paid','{\"note\": \"343;;\"}',NULL),(344,1,344.25,'paid','{\"note\": \"344;;\"}',NULL),(345,2,345.25,'paid','{\"note\": \"345;;\"}',NULL),(346,1,346.25,'paid','{\"note\": \"346;;\"}',NULL),(347,2,347.25,'paid','{\"note\": \"347;;\"}',NULL),(348,1,348.25,'paid','{\"note\": \"348;;\"}',NULL),(349,2,349.25,'paid','{\"note\": \"349;;\"}',NULL),(350,1,350.25,'paid','{\"note\": \"350;;\"}',NULL),(351,2,351.25,'paid','{\"note\": \"351;;\"}',NULL),(352,1,352.25,'paid','{\"note\": \"352;;\"}',NULL),(353,2,353.25,'paid','{\"note\": \"353;;\"}',NULL),(354,1,354.25,'paid','{\"note\": \"354;;\"}',NULL),(355,2,355.25,'paid','{\"note\": \"355;;\"}',NULL),(356,1,356.25,'paid','{\"note\": \"356;;\"}',NULL),(357,2,357.25,'paid','{\"note\": \"357;;\"}',NULL),(358,1,358.25,'paid','{\"note\": \"358;;\"}',NULL),(359,2,359.25,'paid','{\"note\": \"359;;\"}',NULL),(360,1,360.25,'paid','{\"note\": \"360;;\"}',NULL),(361,2,361.25,'paid','{\"note\": \"361;;\"}',NULL),(362,1,362.25,'paid','{\"note\": \"362;;\"}',NULL),(363,2,363.25,'paid','{\"note\": \"363;;\"}',NULL),(364,1,364.25,'paid','{\"note\": \"364;;\"}',NULL),(365,2,365.25,'paid','{\"note\": \"365;;\"}',NULL),(366,1,366.25,'paid','{\"note\": \"366;;\"}',NULL),(367,2,367.25,'paid','{\"note\": \"367;;\"}',NULL),(368,1,368.25,'paid','{\"note\": \"368;;\"}',NULL),(369,2,369.25,'paid','{\"note\": \"369;;\"}',NULL),(370,1,370.25,'paid','{\"note\": \"370;;\"}',NULL),(371,2,371.25,'paid','{\"note\": \"371;;\"}',NULL),(372,1,372.25,'paid','{\"note\": \"372;;\"}',NULL),(373,2,373.25,'paid','{\"note\": \"373;;\"}',NULL),(374,1,374.25,'paid','{\"note\": \"374;;\"}',NULL),(375,2,375.25,'paid','{\"note\": \"375;;\"}',NULL),(376,1,376.25,'paid','{\"note\": \"376;;\"}',NULL),(377,2,377.25,'paid','{\"note\": \"377;;\"}',NULL),(378,1,378.25,'paid','{\"note\": \"378;;\"}',NULL),(379,2,379.25,'paid','{\"note\": \"379;;\"}',NULL),(380,1,380.25,'paid','{\"note\": \"380;;\"}',NULL),(381,2,381.25,'paid','{\"note\": \"381;;\"}',NULL),(382,1,382.25,'paid','{\"note\": \"382;;\"}',NULL),(383,2,383.25,'paid','{\"note\": \"383;;\"}',NULL),(384,1,384.25,'paid','{\"note\": \"384;;\"}',NULL),(385,2,385.25,'paid','{\"note\": \"385;;\"}',NULL),(386,1,386.25,'paid','{\"note\": \"386;;\"}',NULL),(387,2,387.25,'paid','{\"note\": \"387;;\"}',NULL),(388,1,388.25,'paid','{\"note\": \"388;;\"}',NULL),(389,2,389.25,'paid','{\"note\": \"389;;\"}',NULL),(390,1,390.25,'paid','{\"note\": \"390;;\"}',NULL),(391,2,391.25,'paid','{\"note\": \"391;;\"}',NULL),(392,1,392.25,'paid','{\"note\": \"392;;\"}',NULL),(393,2,393.25,'paid','{\"note\": \"393;;\"}',NULL),(394,1,394.25,'paid','{\"note\": \"394;;\"}',NULL),(395,2,395.25,'paid','{\"note\": \"395;;\"}',NULL),(396,1,396.25,'paid','{\"note\": \"396;;\"}',NULL),(397,2,397.25,'paid','{\"note\": \"397;;\"}',NULL),(398,1,398.25,'paid','{\"note\": \"398;;\"}',NULL),(399,2,399.25,'paid','{\"note\": \"399;;\"}',NULL),(400,1,400.25,'paid','{\"note\": \"400;;\"}',NULL),(401,2,401.25,'paid','{\"note\": \"401;;\"}',NULL),(402,1,402.25,'paid','{\"note\": \"402;;\"}',NULL),(403,2,403.25,'paid','{\"note\": \"403;;\"}',NULL);
+/*!40000 ALTER TABLE `orders` ENABLE KEYS */;
+UNLOCK TABLES;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_0900_ai_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `orders_before_insert` BEFORE INSERT ON `orders` FOR EACH ROW BEGIN
+  IF NEW.`total` < 0 THEN
+    SET NEW.`total` = 0;
+  END IF;
+END */;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+
+--
+-- Temporary view structure for view `big_orders`
+--
+
+DROP TABLE IF EXISTS `big_orders`;
+/*!50001 DROP VIEW IF EXISTS `big_orders`*/;
+SET @saved_cs_client     = @@character_set_client;
+/*!50503 SET character_set_client = utf8mb4 */;
+/*!50001 CREATE VIEW `big_orders` AS SELECT 
+ 1 AS `id`,
+ 1 AS `total`*/;
+SET character_set_client = @saved_cs_client;
+
+--
+-- Current Database: `shop`
+--
+
+USE `shop`;
+
+--
+-- Final view structure for view `big_orders`
+--
+
+/*!50001 DROP VIEW IF EXISTS `big_orders`*/;
+/*!50001 SET @saved_cs_client          = @@character_set_client */;
+/*!50001 SET @saved_cs_results         = @@character_set_results */;
+/*!50001 SET @saved_col_connection     = @@collation_connection */;
+/*!50001 SET character_set_client      = utf8mb4 */;
+/*!50001 SET character_set_results     = utf8mb4 */;
+/*!50001 SET collation_connection      = utf8mb4_0900_ai_ci */;
+/*!50001 CREATE ALGORITHM=UNDEFINED */
+/*!50013 DEFINER=`root`@`localhost` SQL SECURITY DEFINER */
+/*!50001 VIEW `big_orders` AS select `orders`.`id` AS `id`,`orders`.`total` AS `total` from `orders` where (`orders`.`total` > 100) */;
+/*!50001 SET character_set_client      = @saved_cs_client */;
+/*!50001 SET character_set_results     = @saved_cs_results */;
+/*!50001 SET collation_connection      = @saved_col_connection */;
+SET @@SESSION.SQL_LOG_BIN = @MYSQLDUMP_TEMP_LOG_BIN;
+
+--
+-- Dumping routines for database 'shop'
+--
+/*!50003 DROP FUNCTION IF EXISTS `orders_sum` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_0900_ai_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` FUNCTION `orders_sum`(minimum int) RETURNS int
+    READS SQL DATA
+BEGIN
+  DECLARE result int;
+  SELECT COUNT(*) INTO result FROM `orders` WHERE `total` >= minimum;
+  RETURN result;
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!50003 DROP PROCEDURE IF EXISTS `orders_reset` */;
+/*!50003 SET @saved_cs_client      = @@character_set_client */ ;
+/*!50003 SET @saved_cs_results     = @@character_set_results */ ;
+/*!50003 SET @saved_col_connection = @@collation_connection */ ;
+/*!50003 SET character_set_client  = utf8mb4 */ ;
+/*!50003 SET character_set_results = utf8mb4 */ ;
+/*!50003 SET collation_connection  = utf8mb4_0900_ai_ci */ ;
+/*!50003 SET @saved_sql_mode       = @@sql_mode */ ;
+/*!50003 SET sql_mode              = 'ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION' */ ;
+DELIMITER ;;
+CREATE DEFINER=`root`@`localhost` PROCEDURE `orders_reset`(IN note varchar(32))
+BEGIN
+  -- The semicolons of the body; and the ;; inside the string don't end the routine
+  UPDATE `orders` SET `total` = 0 WHERE `total` < 0;
+  SELECT CONCAT('reset;; ', note);
+END ;;
+DELIMITER ;
+/*!50003 SET sql_mode              = @saved_sql_mode */ ;
+/*!50003 SET character_set_client  = @saved_cs_client */ ;
+/*!50003 SET character_set_results = @saved_cs_results */ ;
+/*!50003 SET collation_connection  = @saved_col_connection */ ;
+/*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */;
+
+/*!40101 SET SQL_MODE=@OLD_SQL_MODE */;
+/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */;
+/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */;
+/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;
+/*!40101 SET CHARACTER_SET_RESULTS=@OLD_CHARACTER_SET_RESULTS */;
+/*!40101 SET COLLATION_CONNECTION=@OLD_COLLATION_CONNECTION */;
+/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */;
+
+-- Dump completed on 2024-02-20 08:15:42
@@ -0,0 +1,39 @@
+/* WARNING: Script requires that SQLITE_DBCONFIG_DEFENSIVE be disabled */
+PRAGMA foreign_keys=OFF;
+BEGIN TRANSACTION;
+CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, email TEXT UNIQUE, created_at TEXT DEFAULT CURRENT_TIMESTAMP);
+INSERT INTO users VALUES(1,'alice','a@example.com','2026-10-18 20:12:54');
+INSERT INTO users VALUES(2,'bob''s','b@example.com','2026-10-18 20:12:54');
+CREATE TABLE kv (k TEXT PRIMARY KEY, v BLOB) WITHOUT ROWID;
+INSERT INTO kv VALUES('x',X'0102');
+CREATE TABLE typed (id INTEGER PRIMARY KEY, n INT NOT NULL, b ANY) STRICT;
+CREATE TABLE orders (id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE, total REAL DEFAULT 0.0, note TEXT COLLATE NOCASE CHECK (length(note) < 100));
+INSERT INTO orders VALUES(1,1,9.5,'a;b');
+CREATE TABLE audit (id INTEGER PRIMARY KEY, msg TEXT, at TEXT);
+PRAGMA writable_schema=ON;
+INSERT INTO sqlite_schema(type,name,tbl_name,rootpage,sql)VALUES('table','docs','docs',0,'CREATE VIRTUAL TABLE docs USING fts5(title, body, tokenize=''porter'')');
+CREATE TABLE IF NOT EXISTS 'docs_data'(id INTEGER PRIMARY KEY, block BLOB);
+INSERT INTO docs_data VALUES(1,X'010101');
+INSERT INTO docs_data VALUES(10,X'000000000101010001010101');
+INSERT INTO docs_data VALUES(137438953473,X'0000001202306201060101020101740102020408');
+CREATE TABLE IF NOT EXISTS 'docs_idx'(segid, term, pgno, PRIMARY KEY(segid, term)) WITHOUT ROWID;
+INSERT INTO docs_idx VALUES(1,X'',2);
+CREATE TABLE IF NOT EXISTS 'docs_content'(id INTEGER PRIMARY KEY, c0, c1);
+INSERT INTO docs_content VALUES(1,'t','C:\path\');
+CREATE TABLE IF NOT EXISTS 'docs_docsize'(id INTEGER PRIMARY KEY, sz BLOB);
+INSERT INTO docs_docsize VALUES(1,X'0101');
+CREATE TABLE IF NOT EXISTS 'docs_config'(k PRIMARY KEY, v) WITHOUT ROWID;
+INSERT INTO docs_config VALUES('version',4);
+INSERT INTO sqlite_sequence VALUES('users',2);
+CREATE VIRTUAL TABLE IF NOT EXISTS places USING rtree(id, minX, maxX);
+CREATE VIEW v_users AS SELECT id, name FROM users;
+CREATE TRIGGER trg_users_insert AFTER INSERT ON users
+BEGIN
+  INSERT INTO audit(msg, at) VALUES ('user ' || NEW.name || ';', datetime('now'));
+  UPDATE users SET email = CASE WHEN NEW.email IS NULL THEN 'none' ELSE NEW.email END WHERE id = NEW.id;
+END;
+CREATE TRIGGER trg_orders_del BEFORE DELETE ON orders FOR EACH ROW WHEN OLD.total > 0 BEGIN SELECT RAISE(ABORT, 'no'); END;
+CREATE INDEX idx_orders_user ON orders (user_id);
+CREATE UNIQUE INDEX idx_users_email ON users(email) WHERE email IS NOT NULL;
+PRAGMA writable_schema=OFF;
+COMMIT;
@@ -283,6 +283,78 @@ func TestExtractMySQLComment(t *testing.T) {
 	}
 }
 
+func TestMariaDBVersion(t *testing.T) {
+	testcases := []struct {
+		version string
+		mariaDB bool
+		in      string
+		id      []int
+	}{{
+		version: "80036",
+		in:      "/*M!100616 SELECT*/ FROM /*!40101 IN*/",
+		id:      []int{mysql.FROM, mysql.IN, 0},
+	}, {
+		version: "101106",
+		mariaDB: true,
+		in:      "/*M!100616 SELECT*/ FROM /*!40101 IN*/",
+		id:      []int{mysql.SELECT, mysql.FROM, mysql.IN, 0},
+	}, {
+		version: "100506",
+		mariaDB: true,
+		in:      "/*M!100616 SELECT*/ FROM /*!40101 IN*/",
+		id:      []int{mysql.FROM, mysql.IN, 0},
+	}, {
+		version: "101106",
+		mariaDB: true,
+		in:      "/*M!999999\\- enable the sandbox mode */ FROM",
+		id:      []int{mysql.FROM, 0},
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.version+"_"+tcase.in, func(t *testing.T) {
+			oldMySQLVersion, oldMariaDB := mysql.MySQLVersion, mysql.MariaDB
+			defer func() { mysql.MySQLVersion, mysql.MariaDB = oldMySQLVersion, oldMariaDB }()
+			mysql.MySQLVersion, mysql.MariaDB = tcase.version, tcase.mariaDB
+			tok, err := sql_parser.NewStringTokenizer(tcase.in, dialect.MYSQL)
+			if err != nil {
+				t.Fatalf("%q", err)
+			}
+			for _, expectedID := range tcase.id {
+				id, _ := tok.Scan()
+				require.Equal(t, expectedID, id)
+			}
+		})
+	}
+}
+
+func TestExtractMariaDBComment(t *testing.T) {
+	testcases := []struct {
+		comment string
+		version string
+		sql     string
+	}{{
+		comment: "/*M!100616 SET NOTE_VERBOSITY=0 */",
+		version: "100616",
+		sql:     "SET NOTE_VERBOSITY=0",
+	}, {
+		comment: "/*M!50708 SELECT */",
+		version: "50708",
+		sql:     "SELECT",
+	}, {
+		comment: "/*M!SELECT */",
+		version: "",
+		sql:     "SELECT",
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.comment, func(t *testing.T) {
+			version, sql := mysql.ExtractMariaDBComment(tcase.comment)
+			require.Equal(t, tcase.version, version)
+			require.Equal(t, tcase.sql, sql)
+		})
+	}
+}
+
 func TestIntegerAndID(t *testing.T) {
 	testcases := []struct {
 		in  string
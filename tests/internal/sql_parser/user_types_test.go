@@ -0,0 +1,49 @@
+package sql_parser
+
+import (
+	"testing"
+
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+)
+
+func TestUserTypesResolve(t *testing.T) {
+	userTypes := sql_parser.NewUserTypes()
+	for _, text := range []string{
+		"CREATE TYPE public.mood AS ENUM ('sad', 'happy')",
+		"CREATE TYPE public.address AS (street text, zip integer)",
+		"CREATE TYPE public.floatrange AS RANGE (subtype = float8)",
+		"CREATE DOMAIN public.email AS text CHECK (VALUE ~ '@')",
+		"CREATE DOMAIN public.feeling AS public.mood NOT NULL",
+		"CREATE TABLE public.users (id integer)",
+	} {
+		statement, err := sql_parser.Parse(text, dialect.PSQL)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		userTypes.Register(statement)
+	}
+
+	testcases := []struct {
+		typeName string
+		resolved string
+		ok       bool
+	}{
+		{typeName: "public.mood", resolved: "enum('sad', 'happy')", ok: true},
+		{typeName: "mood", resolved: "enum('sad', 'happy')", ok: true},
+		{typeName: "public.address", resolved: "composite(street text, zip integer)", ok: true},
+		{typeName: "public.floatrange", resolved: "range(float8)", ok: true},
+		{typeName: "public.email", resolved: "text", ok: true},
+		{typeName: "public.feeling", resolved: "enum('sad', 'happy')", ok: true},
+		{typeName: "public.users", ok: false},
+		{typeName: "integer", ok: false},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.typeName, func(t *testing.T) {
+			resolved, ok := userTypes.Resolve(tcase.typeName)
+			if ok != tcase.ok || resolved != tcase.resolved {
+				t.Errorf("type %v is resolved as %q (%v) but expected %q (%v)", tcase.typeName, resolved, ok, tcase.resolved, tcase.ok)
+			}
+		})
+	}
+}
@@ -60,3 +60,40 @@ func TestConvertMySQLVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestSetServerVersion(t *testing.T) {
+	testcases := []struct {
+		version        string
+		commentVersion string
+		mariaDB        bool
+		error          string
+	}{{
+		version:        "5.7.44",
+		commentVersion: "50744",
+	}, {
+		version:        "8.0.36",
+		commentVersion: "80036",
+	}, {
+		version:        "10.11.6-MariaDB-0+deb12u1",
+		commentVersion: "101106",
+		mariaDB:        true,
+	}, {
+		version: "MariaDB",
+		error:   "MySQL version not correctly setup - MariaDB.",
+	}}
+
+	for _, tcase := range testcases {
+		t.Run(tcase.version, func(t *testing.T) {
+			oldMySQLVersion, oldMariaDB := mysql.MySQLVersion, mysql.MariaDB
+			defer func() { mysql.MySQLVersion, mysql.MariaDB = oldMySQLVersion, oldMariaDB }()
+			err := mysql.SetServerVersion(tcase.version)
+			if tcase.error != "" {
+				require.EqualError(t, err, tcase.error)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tcase.commentVersion, mysql.MySQLVersion)
+				require.Equal(t, tcase.mariaDB, mysql.MariaDB)
+			}
+		})
+	}
+}
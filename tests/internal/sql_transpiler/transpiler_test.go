@@ -0,0 +1,373 @@
+package sql_transpiler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/usalko/prodl/internal/sql_parser"
+	"github.com/usalko/prodl/internal/sql_parser/ast"
+	"github.com/usalko/prodl/internal/sql_parser/dialect"
+	"github.com/usalko/prodl/internal/sql_transpiler"
+)
+
+func transpile(t *testing.T, transpiler *sql_transpiler.Transpiler, statementText string) []string {
+	return transpileFrom(t, transpiler, dialect.PSQL, statementText)
+}
+
+func transpileFrom(t *testing.T, transpiler *sql_transpiler.Transpiler, sourceDialect dialect.SqlDialect, statementText string) []string {
+	statement, err := sql_parser.Parse(statementText, sourceDialect)
+	require.NoError(t, err, statementText)
+	result, err := transpiler.Transpile(statementText, statement)
+	require.NoError(t, err, statementText)
+	return result
+}
+
+func TestTranspileIdentity(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.PSQL)
+	require.NoError(t, err)
+	require.True(t, transpiler.IsIdentity())
+	statementText := "SET client_encoding = 'UTF8'"
+	require.Equal(t, []string{statementText}, transpile(t, transpiler, statementText))
+
+	_, err = sql_transpiler.NewTranspiler(dialect.MYSQL, dialect.SQLITE3)
+	require.Error(t, err)
+}
+
+func TestTranspilePsqlToSqlite(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.SQLITE3)
+	require.NoError(t, err)
+
+	require.Empty(t, transpile(t, transpiler, "SET statement_timeout = 0"))
+	require.Empty(t, transpile(t, transpiler, "SELECT pg_catalog.set_config('search_path', '', false)"))
+	require.Empty(t, transpile(t, transpiler, "SELECT pg_catalog.setval('public.users_id_seq', 2, true)"))
+	// Only the session and sequence functions of pg_catalog are dropped
+	require.Equal(t, []string{"select length('abc')"}, transpile(t, transpiler, "SELECT pg_catalog.length('abc')"))
+	require.Equal(t, []string{"select set_config('search_path', '', false)"}, transpile(t, transpiler, "SELECT set_config('search_path', '', false)"))
+	require.Empty(t, transpile(t, transpiler, "CREATE SEQUENCE public.users_id_seq START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1"))
+	require.Empty(t, transpile(t, transpiler, "ALTER TABLE public.users OWNER TO postgres"))
+
+	require.Equal(t, []string{"create table posts (\n" +
+		"\tid integer primary key,\n" +
+		"\ttitle varchar(200) default 'untitled',\n" +
+		"\tcreated_at timestamp not null default current_timestamp,\n" +
+		"\ttags text,\n" +
+		"\t\"select\" text\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE public.posts (\n"+
+		"    id bigserial PRIMARY KEY,\n"+
+		"    title character varying(200) DEFAULT 'untitled'::character varying,\n"+
+		"    created_at timestamp without time zone DEFAULT now() NOT NULL,\n"+
+		"    tags text[],\n"+
+		"    \"select\" jsonb\n"+
+		")"))
+
+	require.Equal(t, []string{"insert into posts(id, created_at) values (1, '2020-01-01 00:00:00')"},
+		transpile(t, transpiler, "INSERT INTO public.posts (id, created_at) VALUES (1, '2020-01-01 00:00:00'::timestamp)"))
+	require.Equal(t, []string{"create unique index posts_pkey on posts (id)"},
+		transpile(t, transpiler, "ALTER TABLE ONLY public.posts ADD CONSTRAINT posts_pkey PRIMARY KEY (id)"))
+	require.Equal(t, []string{"create index posts_title_idx on posts (title)"},
+		transpile(t, transpiler, "CREATE INDEX posts_title_idx ON public.posts USING btree (title)"))
+	require.Equal(t, []string{"create index posts_created_idx on posts (created_at) where created_at > '2020-01-01'"},
+		transpile(t, transpiler, "CREATE INDEX posts_created_idx ON public.posts USING btree (created_at) WHERE (created_at > '2020-01-01'::timestamp without time zone)"))
+	require.Empty(t, transpile(t, transpiler, "ALTER TABLE ONLY public.posts ALTER COLUMN id SET DEFAULT nextval('public.posts_id_seq'::regclass)"))
+}
+
+func TestTranspilePsqlToMysql(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.MYSQL)
+	require.NoError(t, err)
+
+	require.Empty(t, transpile(t, transpiler, "CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy')"))
+	require.Equal(t, []string{"create table users (\n" +
+		"\tid int not null,\n" +
+		"\t`name` varchar(100) not null,\n" +
+		"\tfeeling enum('sad', 'ok', 'happy'),\n" +
+		"\tcreated_at datetime(6)\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE public.users (\n"+
+		"    id integer NOT NULL,\n"+
+		"    name character varying(100) NOT NULL,\n"+
+		"    feeling public.mood,\n"+
+		"    created_at timestamp without time zone\n"+
+		")"))
+
+	// pg_dump sets the nextval default before the primary key, the column becomes AUTO_INCREMENT with the key
+	require.Empty(t, transpile(t, transpiler, "ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass)"))
+	require.Equal(t, []string{
+		"alter table users add constraint users_pkey PRIMARY KEY (id)",
+		"alter table users modify column id int not null auto_increment",
+	}, transpile(t, transpiler, "ALTER TABLE ONLY public.users ADD CONSTRAINT users_pkey PRIMARY KEY (id)"))
+
+	require.Equal(t, []string{"alter table users add key users_name_idx (`name`)"},
+		transpile(t, transpiler, "CREATE INDEX users_name_idx ON public.users USING btree (name)"))
+	require.Equal(t, []string{"select cast(`name` as char) from users"},
+		transpile(t, transpiler, "SELECT name::text FROM public.users"))
+
+	// MySQL allows the single AUTO_INCREMENT column per table, the serial column without the key
+	// becomes AUTO_INCREMENT UNIQUE KEY when the INSERT leaves it to the default or at the end of the dump
+	require.Equal(t, []string{"create table t (\n" +
+		"\tid int not null,\n" +
+		"\tx smallint not null\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE app.t (id serial, x smallserial)"))
+	require.Equal(t, []string{
+		"alter table t modify column id int not null auto_increment unique key",
+		"insert into t(x) values (1)",
+	}, transpile(t, transpiler, "INSERT INTO app.t (x) VALUES (1)"))
+
+	// The identity column gets the primary key added after the data, the current time default
+	// takes the precision of the column
+	require.Equal(t, []string{"create table orders (\n" +
+		"\tid int not null,\n" +
+		"\tcreated_at datetime(6) default current_timestamp(6),\n" +
+		"\tupdated_at datetime(0) default current_timestamp()\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE public.orders (\n"+
+		"    id integer NOT NULL GENERATED ALWAYS AS IDENTITY,\n"+
+		"    created_at timestamp(6) without time zone DEFAULT now(),\n"+
+		"    updated_at timestamp(0) without time zone DEFAULT CURRENT_TIMESTAMP\n"+
+		")"))
+	require.Equal(t, []string{"insert into orders(id, created_at) values (1, '2020-01-01 10:00:00')"},
+		transpile(t, transpiler, "INSERT INTO public.orders (id, created_at) VALUES (1, '2020-01-01 10:00:00')"))
+	require.Equal(t, []string{
+		"alter table orders add constraint orders_pkey PRIMARY KEY (id)",
+		"alter table orders modify column id int not null auto_increment",
+	}, transpile(t, transpiler, "ALTER TABLE ONLY public.orders ADD CONSTRAINT orders_pkey PRIMARY KEY (id)"))
+	require.Equal(t, []string{"alter table orders alter column created_at set default current_timestamp(6)"},
+		transpile(t, transpiler, "ALTER TABLE ONLY public.orders ALTER COLUMN created_at SET DEFAULT now()"))
+
+	require.Equal(t, []string{"create table events (\n\tid bigint not null\n)"},
+		transpile(t, transpiler, "CREATE TABLE public.events (id bigint GENERATED BY DEFAULT AS IDENTITY)"))
+	require.Equal(t, []string{"alter table events modify column id bigint not null auto_increment unique key"}, transpiler.Finish())
+}
+
+func TestTranspileUnsupported(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.SQLITE3)
+	require.NoError(t, err)
+
+	statementText := "CREATE INDEX posts_body_idx ON public.posts USING gin (body)"
+	statement, err := sql_parser.Parse(statementText, dialect.PSQL)
+	require.NoError(t, err)
+	_, err = transpiler.Transpile(statementText, statement)
+	require.ErrorIs(t, err, sql_transpiler.ErrUnsupportedStatement)
+	require.Contains(t, err.Error(), "CREATE INDEX posts_body_idx")
+}
+
+func TestTranspileMysqlToPsql(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.MYSQL, dialect.PSQL)
+	require.NoError(t, err)
+
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "/*!40101 SET NAMES utf8mb4 */"))
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "LOCK TABLES `posts` WRITE"))
+	require.Equal(t, []string{
+		"create table posts (\n" +
+			"\tid bigint not null generated by default as identity (start with 3),\n" +
+			"\tuser_id integer not null,\n" +
+			"\ttitle varchar(255) not null default '',\n" +
+			"\tstatus text not null default 'draft',\n" +
+			"\tis_public boolean not null default true,\n" +
+			"\tcover bytea,\n" +
+			"\tcreated_at timestamp default null,\n" +
+			"\tPRIMARY KEY (id),\n" +
+			"\tconstraint posts_status_check check (status in ('draft', 'published'))\n" +
+			")",
+		"comment on table posts is 'blog posts'",
+		"create unique index posts_title on posts (title)",
+		"create index posts_user_id on posts (user_id, created_at)",
+	}, transpileFrom(t, transpiler, dialect.MYSQL, "CREATE TABLE `shop`.`posts` (\n"+
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n"+
+		"  `user_id` int NOT NULL,\n"+
+		"  `title` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '',\n"+
+		"  `status` enum('draft','published') NOT NULL DEFAULT 'draft',\n"+
+		"  `is_public` tinyint(1) NOT NULL DEFAULT '1',\n"+
+		"  `cover` blob,\n"+
+		"  `created_at` datetime DEFAULT '0000-00-00 00:00:00' ON UPDATE CURRENT_TIMESTAMP,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  UNIQUE KEY `title` (`title`(100)),\n"+
+		"  KEY `user_id` (`user_id`,`created_at`),\n"+
+		"  CONSTRAINT `posts_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE\n"+
+		") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 COMMENT='blog posts'"))
+
+	// The extended INSERT becomes COPY, the values are converted by the column types
+	require.Equal(t, []string{"COPY posts FROM stdin;\n" +
+		"1\t1\tIt's\tdraft\tt\t\\\\x0001\t\\N\n" +
+		"2\t1\tline\\nnext\\ttab \\\\\tpublished\tf\t\\\\x6162\t2020-01-01 10:00:00\n" +
+		"\\."}, transpileFrom(t, transpiler, dialect.MYSQL, "INSERT INTO `posts` VALUES "+
+		"(1,1,'It\\'s','draft',1,0x0001,'0000-00-00 00:00:00'),"+
+		"(2,1,'line\\nnext\\ttab \\\\','published',0,_binary 'ab','2020-01-01 10:00:00')"))
+
+	require.Equal(t, []string{"insert into posts(id, title) values (1, 'a') on conflict do nothing"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "INSERT IGNORE INTO `posts` (`id`, `title`) VALUES (1,'a')"))
+	require.Equal(t, []string{"insert into posts(id, title) values (1, 'a') on conflict (id) do update set title = excluded.title"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "INSERT INTO `posts` (`id`, `title`) VALUES (1,'a') ON DUPLICATE KEY UPDATE `title` = VALUES(`title`)"))
+
+	// The NOT NULL column of the zero date default takes the zero dates as NULL
+	require.Equal(t, []string{"create table events (\n\tid integer not null,\n\tcreated_at timestamp,\n\tnote varchar(20) not null default ''\n)"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "CREATE TABLE `events` (`id` int NOT NULL, `created_at` datetime NOT NULL DEFAULT '0000-00-00 00:00:00', `note` varchar(20) NOT NULL DEFAULT '')"))
+	require.Equal(t, []string{"COPY events FROM stdin;\n1\t\\N\t0000-00-00\n\\."},
+		transpileFrom(t, transpiler, dialect.MYSQL, "INSERT INTO `events` VALUES (1,'0000-00-00 00:00:00','0000-00-00')"))
+	// The zero dates of the unknown columns are kept
+	require.Equal(t, []string{"COPY missing FROM stdin;\n1\t0000-00-00\n\\."},
+		transpileFrom(t, transpiler, dialect.MYSQL, "INSERT INTO `missing` VALUES (1,'0000-00-00')"))
+
+	// The bodies of the triggers and the routines aren't parsed, the drops of the routines have no effect
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "/*!50003 DROP PROCEDURE IF EXISTS `reset` */"))
+	for _, statementText := range []string{
+		"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `posts_bi` BEFORE INSERT ON `posts` FOR EACH ROW BEGIN\n  SET NEW.title = '';\nEND */;",
+		"CREATE DEFINER=`root`@`localhost` PROCEDURE `reset`()\nBEGIN\n  UPDATE `posts` SET `title` = '';\nEND ;",
+	} {
+		statement, err := sql_parser.Parse(statementText, dialect.MYSQL)
+		require.NoError(t, err, statementText)
+		_, err = transpiler.Transpile(statementText, statement)
+		require.ErrorIs(t, err, sql_transpiler.ErrUnsupportedStatement)
+	}
+
+	// mysqldump drops the table and the view of the same name, only the DROP of the object kind is kept
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "DROP TABLE IF EXISTS `big_posts`"))
+	require.Equal(t, []string{"drop view if exists big_posts"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "/*!50001 DROP VIEW IF EXISTS `big_posts`*/"))
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "DROP TABLE IF EXISTS `events`"))
+	require.Equal(t, []string{"drop table if exists events"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "/*!50001 DROP VIEW IF EXISTS `events`*/"))
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "DROP TABLE IF EXISTS `drafts`"))
+	require.Equal(t, []string{"drop table if exists drafts"},
+		transpileFrom(t, transpiler, dialect.MYSQL, "/*!40101 SET character_set_client = utf8 */"))
+	require.Empty(t, transpileFrom(t, transpiler, dialect.MYSQL, "DROP TABLE IF EXISTS `tags`"))
+
+	// The foreign keys are added after the dump
+	require.Equal(t, []string{
+		"drop table if exists tags",
+		"alter table posts add constraint posts_ibfk_1 foreign key (user_id) references users (id) on delete cascade",
+	}, transpiler.Finish())
+	require.Empty(t, transpiler.Finish())
+}
+
+func TestTranspileMysqlEnumType(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.MYSQL, dialect.PSQL)
+	require.NoError(t, err)
+	enumMode, err := sql_transpiler.ParseEnumMode("type")
+	require.NoError(t, err)
+	transpiler.SetEnumMode(enumMode)
+
+	require.Equal(t, []string{
+		"create type t_mood as enum ('sad', 'it''s ok')",
+		"create table t (\n\tmood t_mood default 'sad'\n)",
+	}, transpileFrom(t, transpiler, dialect.MYSQL, "CREATE TABLE t (mood enum('sad','it\\'s ok') DEFAULT 'sad')"))
+
+	_, err = sql_transpiler.ParseEnumMode("set")
+	require.EqualError(t, err, "unknown enum mode: set, expected one of check|type")
+}
+
+func TestTranspileCopyToInsert(t *testing.T) {
+	createTable := "CREATE TABLE public.items (id integer NOT NULL, name text, price numeric(10,2), active boolean, payload bytea)"
+	copyText := "COPY public.items (id, name, price, active, payload) FROM stdin;\n" +
+		"1\ttab\\there\t10.50\tt\t\\\\x00ff\n" +
+		"2\tback\\\\slash \\\\N\t\\N\tf\t\\\\001a\\\\\\\\\n" +
+		"3\tline\\nbreak 'q'\tNaN\t\\N\t\\N\n" +
+		"\\."
+
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.SQLITE3)
+	require.NoError(t, err)
+	transpiler.SetBatchSize(2)
+	transpile(t, transpiler, createTable)
+	require.Equal(t, []string{
+		"insert into items(id, name, price, active, payload) values " +
+			"(1, 'tab\there', 10.50, true, X'00ff'), (2, 'back\\slash \\N', null, false, X'01615c')",
+		"insert into items(id, name, price, active, payload) values (3, 'line\nbreak ''q''', 'NaN', null, null)",
+	}, transpile(t, transpiler, copyText))
+
+	transpiler, err = sql_transpiler.NewTranspiler(dialect.PSQL, dialect.MYSQL)
+	require.NoError(t, err)
+	transpile(t, transpiler, createTable)
+	require.Equal(t, []string{
+		"insert into items(id, `name`, price, active, payload) values " +
+			"(1, 'tab\\there', 10.50, true, X'00ff'), (2, 'back\\\\slash \\\\N', null, false, X'01615c'), " +
+			"(3, 'line\\nbreak \\'q\\'', 'NaN', null, null)",
+	}, transpile(t, transpiler, copyText))
+
+	// The columns of the unknown table are strings
+	require.Equal(t, []string{"insert into t values ('1', null)"},
+		transpile(t, transpiler, "COPY public.t FROM stdin;\n1\t\\N\n\\."))
+
+	// The csv data follows the options of COPY
+	require.Equal(t, []string{"insert into items(id, `name`) values (4, 'semicolon; \\'q\\''), (5, null)"},
+		transpile(t, transpiler, "COPY public.items (id, name) FROM stdin WITH (FORMAT csv, HEADER true);\n"+
+			"id,name\n4,\"semicolon; 'q'\"\n5,\n\\."))
+
+	_, err = transpiler.Transpile("COPY t FROM stdin WITH (FORMAT binary);\n\\.",
+		&ast.CopyFrom{Table: ast.TableName{Name: ast.NewTableIdent("t")}, From: ast.CopyFromSource{Type: ast.CopyFromStdin},
+			With: ast.CopyOptions{{Type: ast.CopyOptionFormat, Value: "binary"}}})
+	require.ErrorIs(t, err, sql_transpiler.ErrUnsupportedStatement)
+}
+
+func TestTranspileInsertToCopyBatches(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.MYSQL, dialect.PSQL)
+	require.NoError(t, err)
+	transpiler.SetBatchSize(2)
+
+	transpileFrom(t, transpiler, dialect.MYSQL, "CREATE TABLE `t` (`id` int NOT NULL, `v` varchar(10), `b` varbinary(4))")
+	require.Equal(t, []string{
+		"COPY t FROM stdin;\n1\t\\\\N\t\\\\x00\n2\ttab\\tnl\\n\\\\.\t\\N\n\\.",
+		"COPY t FROM stdin;\n3\t-\t\\N\n\\.",
+	}, transpileFrom(t, transpiler, dialect.MYSQL, "INSERT INTO `t` VALUES (1,'\\\\N',0x00),(2,'tab\\tnl\\n\\\\.',NULL),(3,'-',NULL)"))
+}
+
+func constraintTexts(transpiler *sql_transpiler.Transpiler) []string {
+	result := []string{}
+	for _, constraint := range transpiler.DeferredConstraints() {
+		result = append(result, constraint.String()+": "+constraint.Text)
+	}
+	return result
+}
+
+func TestTranspileDeferConstraints(t *testing.T) {
+	transpiler, err := sql_transpiler.NewTranspiler(dialect.PSQL, dialect.PSQL)
+	require.NoError(t, err)
+	transpiler.SetDeferConstraints(true)
+
+	require.Equal(t, []string{"create table public.b (\n" +
+		"\tid integer not null,\n" +
+		"\ta_id integer,\n" +
+		"\tcode text,\n" +
+		"\tconstraint b_pkey PRIMARY KEY (id)\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE public.b (id integer NOT NULL, a_id integer REFERENCES public.a (id), "+
+		"code text UNIQUE, CONSTRAINT b_pkey PRIMARY KEY (id))"))
+	require.Equal(t, []string{
+		"foreign key b_a_id_fkey of b: alter table public.b add constraint b_a_id_fkey foreign key (a_id) references public.a (id)",
+		"index b_code_key of b: alter table public.b add constraint b_code_key unique (code)",
+	}, constraintTexts(transpiler))
+
+	// The statement deferred as a whole keeps its text, the statement without constraints is executed as is
+	statementText := "ALTER TABLE ONLY public.b ADD CONSTRAINT b_a_fk FOREIGN KEY (a_id) REFERENCES public.a(id)"
+	require.Empty(t, transpile(t, transpiler, statementText))
+	require.Equal(t, []string{"foreign key b_a_fk of b: " + statementText}, constraintTexts(transpiler))
+	statementText = "ALTER TABLE ONLY public.b ADD CONSTRAINT b_pkey PRIMARY KEY (id)"
+	require.Equal(t, []string{statementText}, transpile(t, transpiler, statementText))
+	require.Empty(t, transpiler.DeferredConstraints())
+
+	// The keys of the MySQL table become the deferred indexes, the foreign keys aren't left to Finish
+	transpiler, err = sql_transpiler.NewTranspiler(dialect.MYSQL, dialect.PSQL)
+	require.NoError(t, err)
+	transpiler.SetDeferConstraints(true)
+	require.Equal(t, []string{"create table posts (\n" +
+		"\tid integer not null generated by default as identity,\n" +
+		"\tuser_id integer not null,\n" +
+		"\tPRIMARY KEY (id)\n" +
+		")"}, transpileFrom(t, transpiler, dialect.MYSQL, "CREATE TABLE `posts` (`id` int NOT NULL AUTO_INCREMENT, "+
+		"`user_id` int NOT NULL, PRIMARY KEY (`id`), KEY `user_id` (`user_id`), "+
+		"CONSTRAINT `posts_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"))
+	require.Equal(t, []string{
+		"foreign key posts_ibfk_1 of posts: alter table posts add constraint posts_ibfk_1 foreign key (user_id) references users (id)",
+		"index posts_user_id of posts: create index posts_user_id on posts (user_id)",
+	}, constraintTexts(transpiler))
+	require.Empty(t, transpiler.Finish())
+
+	// SQLite can't add the foreign keys to the existing table, the unique keys become the unique indexes
+	transpiler, err = sql_transpiler.NewTranspiler(dialect.PSQL, dialect.SQLITE3)
+	require.NoError(t, err)
+	transpiler.SetDeferConstraints(true)
+	require.Equal(t, []string{"create table b (\n" +
+		"\tid integer,\n" +
+		"\ta_id integer,\n" +
+		"\tcode text,\n" +
+		"\tforeign key (a_id) references a (id)\n" +
+		")"}, transpile(t, transpiler, "CREATE TABLE public.b (id integer, a_id integer, code text, "+
+		"UNIQUE (code, a_id), FOREIGN KEY (a_id) REFERENCES public.a (id))"))
+	require.Equal(t, []string{"index b_code_a_id_key of b: create unique index b_code_a_id_key on b (code, a_id)"},
+		constraintTexts(transpiler))
+}
@@ -0,0 +1,217 @@
+package sql_types
+
+import (
+	"testing"
+
+	"github.com/usalko/prodl/internal/sql_types"
+)
+
+func TestParsePostgresType(t *testing.T) {
+	testcases := []struct {
+		in     string
+		out    string
+		oid    sql_types.Oid
+		typ    sql_types.Type
+		arrays int
+	}{
+		{in: "integer", out: "integer", oid: sql_types.OidInt4, typ: sql_types.Int32},
+		{in: "pg_catalog.int8", out: "bigint", oid: sql_types.OidInt8, typ: sql_types.Int64},
+		{in: "bigserial", out: "bigint", oid: sql_types.OidInt8, typ: sql_types.Int64},
+		{in: "bool", out: "boolean", oid: sql_types.OidBool, typ: sql_types.Int8},
+		{in: "numeric(10,2)", out: "numeric(10,2)", oid: sql_types.OidNumeric, typ: sql_types.Decimal},
+		{in: "DECIMAL (5)", out: "numeric(5)", oid: sql_types.OidNumeric, typ: sql_types.Decimal},
+		{in: "float(10)", out: "real", oid: sql_types.OidFloat4, typ: sql_types.Float32},
+		{in: "float(53)", out: "double precision", oid: sql_types.OidFloat8, typ: sql_types.Float64},
+		{in: "character varying(255)[]", out: "character varying(255)[]", oid: sql_types.OidVarchar, typ: sql_types.VarChar, arrays: 1},
+		{in: "char", out: "character(1)", oid: sql_types.OidBpchar, typ: sql_types.Char},
+		{in: "int4[][]", out: "integer[][]", oid: sql_types.OidInt4, typ: sql_types.VarChar, arrays: 2},
+		{in: "text ARRAY", out: "text[]", oid: sql_types.OidText, typ: sql_types.VarChar, arrays: 1},
+		{in: "timestamptz", out: "timestamp with time zone", oid: sql_types.OidTimestamptz, typ: sql_types.Timestamp},
+		{in: "timestamp(3) without time zone", out: "timestamp(3) without time zone", oid: sql_types.OidTimestamp, typ: sql_types.Datetime},
+		{in: "time(0) with time zone", out: "time(0) with time zone", oid: sql_types.OidTimetz, typ: sql_types.Time},
+		{in: "interval day to second(3)", out: "interval day to second(3)", oid: sql_types.OidInterval, typ: sql_types.VarChar},
+		{in: "uuid", out: "uuid", oid: sql_types.OidUuid, typ: sql_types.Char},
+		{in: "jsonb", out: "jsonb", oid: sql_types.OidJsonb, typ: sql_types.TypeJSON},
+		{in: "bytea", out: "bytea", oid: sql_types.OidBytea, typ: sql_types.VarBinary},
+		{in: "inet", out: "inet", oid: sql_types.OidInet, typ: sql_types.VarChar},
+		{in: "tstzrange", out: "tstzrange", oid: sql_types.OidTstzrange, typ: sql_types.VarChar},
+		{in: "varbit(8)", out: "bit varying(8)", oid: sql_types.OidVarbit, typ: sql_types.Bit},
+		{in: "public.mood", out: "public.mood", oid: sql_types.OidUnknown, typ: sql_types.VarChar},
+	}
+	for _, tcase := range testcases {
+		pgType, err := sql_types.ParsePostgresType(tcase.in)
+		if err != nil {
+			t.Errorf("ParsePostgresType(%v): %v", tcase.in, err)
+			continue
+		}
+		if pgType.String() != tcase.out || pgType.Oid != tcase.oid || pgType.Type() != tcase.typ || pgType.Dimensions != tcase.arrays {
+			t.Errorf("ParsePostgresType(%v): %v (oid %d, type %v, dimensions %d), want %v (oid %d, type %v, dimensions %d)",
+				tcase.in, pgType, pgType.Oid, pgType.Type(), pgType.Dimensions, tcase.out, tcase.oid, tcase.typ, tcase.arrays)
+		}
+	}
+	if _, err := sql_types.ParsePostgresType(" "); err == nil {
+		t.Errorf("ParsePostgresType of the empty type must fail")
+	}
+}
+
+func TestPostgresOids(t *testing.T) {
+	pgType, err := sql_types.NewPostgresType(sql_types.OidNumericArray)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if pgType.String() != "numeric[]" || pgType.TypeOid() != sql_types.OidNumericArray {
+		t.Errorf("numeric array is %v (oid %d)", pgType, pgType.TypeOid())
+	}
+	pgType, _ = sql_types.NewPostgresType(sql_types.OidDaterange)
+	if subtype, ok := pgType.Subtype(); !ok || subtype.Oid != sql_types.OidDate {
+		t.Errorf("daterange subtype is %v", subtype)
+	}
+	if typ, err := sql_types.PostgresToType(sql_types.OidTimestamptz); err != nil || typ != sql_types.Timestamp {
+		t.Errorf("PostgresToType(timestamptz): %v %v", typ, err)
+	}
+	if _, err := sql_types.PostgresToType(12345); err == nil {
+		t.Errorf("PostgresToType of the unknown oid must fail")
+	}
+
+	testcases := []struct {
+		in  sql_types.Type
+		out string
+	}{
+		{sql_types.Int8, "smallint"},
+		{sql_types.Uint32, "bigint"},
+		{sql_types.Uint64, "numeric(20,0)"},
+		{sql_types.Decimal, "numeric"},
+		{sql_types.Datetime, "timestamp without time zone"},
+		{sql_types.Timestamp, "timestamp with time zone"},
+		{sql_types.VarBinary, "bytea"},
+		{sql_types.TypeJSON, "jsonb"},
+		{sql_types.Set, "text[]"},
+		{sql_types.Expression, "text"},
+	}
+	for _, tcase := range testcases {
+		if out := sql_types.TypeToPostgres(tcase.in).String(); out != tcase.out {
+			t.Errorf("TypeToPostgres(%v): %v, want %v", tcase.in, out, tcase.out)
+		}
+	}
+}
+
+func TestNewPostgresValue(t *testing.T) {
+	testcases := []struct {
+		pgType string
+		in     string
+		out    string
+		err    bool
+	}{
+		{pgType: "boolean", in: "t", out: "1"},
+		{pgType: "boolean", in: "off", out: "0"},
+		{pgType: "boolean", in: "maybe", err: true},
+		{pgType: "smallint", in: "32767", out: "32767"},
+		{pgType: "smallint", in: "32768", err: true},
+		{pgType: "numeric(5,2)", in: "999.994", out: "999.994"},
+		{pgType: "numeric(5,2)", in: "999.995", err: true},
+		{pgType: "numeric(5,2)", in: "-12.5", out: "-12.5"},
+		{pgType: "numeric", in: "NaN", out: "NaN"},
+		{pgType: "numeric", in: "1e400", out: "1e400"},
+		{pgType: "numeric", in: "1,5", err: true},
+		{pgType: "double precision", in: "-Infinity", out: "-Infinity"},
+		{pgType: "character varying(3)", in: "abc  ", out: "abc  "},
+		{pgType: "character varying(3)", in: "abcd", err: true},
+		{pgType: "character(2)", in: "дa", out: "дa"},
+		{pgType: "bytea", in: `\x0aff`, out: "\n\xff"},
+		{pgType: "bytea", in: `\x0`, err: true},
+		{pgType: "date", in: "2024-02-29", out: "2024-02-29"},
+		{pgType: "date", in: "2023-02-29", err: true},
+		{pgType: "date", in: "infinity", out: "infinity"},
+		{pgType: "time", in: "24:00:00", out: "24:00:00"},
+		{pgType: "timestamp", in: "2024-01-02 03:04:05.123456", out: "2024-01-02 03:04:05.123456"},
+		{pgType: "timestamptz", in: "2024-01-02 03:04:05+03", out: "2024-01-02 03:04:05+03"},
+		{pgType: "timestamptz", in: "yesterday", err: true},
+		{pgType: "uuid", in: "{A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11}", out: "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"},
+		{pgType: "uuid", in: "a0eebc99", err: true},
+		{pgType: "jsonb", in: `{"a": [1, 2]}`, out: `{"a": [1, 2]}`},
+		{pgType: "json", in: `{"a": }`, err: true},
+		{pgType: "inet", in: "192.168.0.1/24", out: "192.168.0.1/24"},
+		{pgType: "cidr", in: "192.168.0.0/24", out: "192.168.0.0/24"},
+		{pgType: "cidr", in: "192.168.0.1/24", err: true},
+		{pgType: "macaddr", in: "08:00:2b:01:02:03", out: "08:00:2b:01:02:03"},
+		{pgType: "bit(3)", in: "101", out: "101"},
+		{pgType: "bit(3)", in: "10", err: true},
+		{pgType: "int4range", in: "[1,10)", out: "[1,10)"},
+		{pgType: "int4range", in: "1-10", err: true},
+		{pgType: "integer[]", in: "{1,2,3}", out: "{1,2,3}"},
+		{pgType: "integer[]", in: "1,2,3", err: true},
+		{pgType: "interval", in: "1 day 02:00:00", out: "1 day 02:00:00"},
+	}
+	for _, tcase := range testcases {
+		pgType, err := sql_types.ParsePostgresType(tcase.pgType)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		value, err := sql_types.NewPostgresValue(pgType, tcase.in)
+		if tcase.err {
+			if err == nil {
+				t.Errorf("NewPostgresValue(%v, %q): %v, want error", tcase.pgType, tcase.in, value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NewPostgresValue(%v, %q): %v", tcase.pgType, tcase.in, err)
+			continue
+		}
+		if value.RawStr() != tcase.out || value.Type() != pgType.Type() {
+			t.Errorf("NewPostgresValue(%v, %q): %q (%v), want %q (%v)", tcase.pgType, tcase.in, value.RawStr(), value.Type(), tcase.out, pgType.Type())
+		}
+	}
+
+	mood := sql_types.NewPostgresEnum("mood", []string{"sad", "ok"})
+	if value, err := sql_types.NewPostgresValue(mood, "ok"); err != nil || value.Type() != sql_types.Enum {
+		t.Errorf("enum value: %v %v", value, err)
+	}
+	if _, err := sql_types.NewPostgresValue(mood, "happy"); err == nil {
+		t.Errorf("enum value happy must fail")
+	}
+}
+
+func TestSqliteAffinity(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out sql_types.SqliteAffinity
+	}{
+		{"INTEGER", sql_types.SqliteAffinityInteger},
+		{"bigint", sql_types.SqliteAffinityInteger},
+		{"point", sql_types.SqliteAffinityInteger},
+		{"varchar(255)", sql_types.SqliteAffinityText},
+		{"CLOB", sql_types.SqliteAffinityText},
+		{"blob", sql_types.SqliteAffinityBlob},
+		{"", sql_types.SqliteAffinityBlob},
+		{"double precision", sql_types.SqliteAffinityReal},
+		{"floating point", sql_types.SqliteAffinityInteger},
+		{"numeric(10,2)", sql_types.SqliteAffinityNumeric},
+		{"timestamp", sql_types.SqliteAffinityNumeric},
+	}
+	for _, tcase := range testcases {
+		if out := sql_types.SqliteAffinityOf(tcase.in); out != tcase.out {
+			t.Errorf("SqliteAffinityOf(%q): %v, want %v", tcase.in, out, tcase.out)
+		}
+	}
+
+	pgTypes := map[string]sql_types.SqliteAffinity{
+		"boolean":          sql_types.SqliteAffinityInteger,
+		"bigint":           sql_types.SqliteAffinityInteger,
+		"double precision": sql_types.SqliteAffinityReal,
+		"numeric(10,2)":    sql_types.SqliteAffinityNumeric,
+		"bytea":            sql_types.SqliteAffinityBlob,
+		"timestamptz":      sql_types.SqliteAffinityText,
+		"uuid":             sql_types.SqliteAffinityText,
+		"integer[]":        sql_types.SqliteAffinityText,
+	}
+	for name, affinity := range pgTypes {
+		pgType, _ := sql_types.ParsePostgresType(name)
+		if out := pgType.SqliteAffinity(); out != affinity {
+			t.Errorf("%v affinity: %v, want %v", name, out, affinity)
+		}
+	}
+	if typ := sql_types.SqliteAffinityToType(sql_types.SqliteAffinityNumeric); typ != sql_types.Decimal {
+		t.Errorf("NUMERIC type: %v", typ)
+	}
+}
@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"compress/gzip"
+	"database/sql"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/usalko/prodl/cmd"
+)
+
+// The arguments of the command executed by the test process (see runCommand), separated by the new lines
+const COMMAND_ARGS_ENV = "PRODL_TEST_COMMAND_ARGS"
+
+func TestMain(m *testing.M) {
+	if args := os.Getenv(COMMAND_ARGS_ENV); args != "" {
+		os.Args = append([]string{"prodl"}, strings.Split(args, "\n")...)
+		cmd.Execute()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runCommand executes the command in the test process (the commands exit the process by the exit code)
+func runCommand(t *testing.T, args ...string) (int, string) {
+	process := exec.Command(os.Args[0], "-test.run=^$")
+	process.Env = append(os.Environ(), COMMAND_ARGS_ENV+"="+strings.Join(args, "\n"))
+	output, err := process.CombinedOutput()
+	var exitError *exec.ExitError
+	if errors.As(err, &exitError) {
+		return exitError.ExitCode(), string(output)
+	}
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return 0, string(output)
+}
+
+// runLoad executes the load command in the test process
+func runLoad(t *testing.T, args ...string) (int, string) {
+	return runCommand(t, append([]string{"load"}, args...)...)
+}
+
+// writeDump writes the gzipped dump to the temporary directory
+func writeDump(t *testing.T, dump string) string {
+	fileName := filepath.Join(t.TempDir(), "dump.sql.gz")
+	file, err := os.Create(fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer file.Close()
+	writer := gzip.NewWriter(file)
+	if _, err := writer.Write([]byte(dump)); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("%v", err)
+	}
+	return fileName
+}
+
+// countRows returns the count of the rows of the sqlite3 table, -1 if the table doesn't exist
+func countRows(t *testing.T, fileName string, table string) int {
+	db, err := sql.Open("sqlite3", fileName)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer db.Close()
+	count := -1
+	if err := db.QueryRow("SELECT count(*) FROM " + table).Scan(&count); err != nil {
+		return -1
+	}
+	return count
+}
+
+func TestLoadResumeAfterDumpTransaction(t *testing.T) {
+	dumpFileName := writeDump(t, `PRAGMA foreign_keys=OFF;
+BEGIN TRANSACTION;
+CREATE TABLE t (id integer primary key);
+INSERT INTO t VALUES(1);
+INSERT INTO t VALUES(1);
+INSERT INTO t VALUES(2);
+INSERT INTO t VALUES(2);
+INSERT INTO t VALUES(3);
+COMMIT;
+`)
+	directory := t.TempDir()
+	targetFileName := filepath.Join(directory, "target.sqlite3")
+	stateFileName := filepath.Join(directory, "state.json")
+
+	// The abort rolls back the dump transaction, the checkpoint must stay before its BEGIN
+	exitCode, output := runLoad(t, "-c", "sqlite3://"+targetFileName, "--state-file", stateFileName,
+		"--checkpoint-interval", "1", "--max-errors", "2", dumpFileName)
+	if exitCode != cmd.EXIT_ABORTED {
+		t.Fatalf("the first load exits with %v, want %v:\n%s", exitCode, cmd.EXIT_ABORTED, output)
+	}
+	if count := countRows(t, targetFileName, "t"); count != -1 {
+		t.Fatalf("the dump transaction isn't rolled back, the table has %v rows", count)
+	}
+	// BEGIN, CREATE TABLE and two inserts are rolled back, PRAGMA and two failed inserts are executed
+	if !strings.Contains(output, "load is aborted: 3 statements executed, 0 retries, 2 failed (ALREADY_EXISTS 2), 4 rolled back, 1 files failed\n") {
+		t.Errorf("the counts of the aborted load are wrong:\n%s", output)
+	}
+
+	exitCode, output = runLoad(t, "-c", "sqlite3://"+targetFileName, "--resume", stateFileName,
+		"--checkpoint-interval", "1", dumpFileName)
+	if exitCode != cmd.EXIT_PARTIAL {
+		t.Fatalf("the resumed load exits with %v, want %v:\n%s", exitCode, cmd.EXIT_PARTIAL, output)
+	}
+	if count := countRows(t, targetFileName, "t"); count != 3 {
+		t.Errorf("the resumed load has %v rows, want 3:\n%s", count, output)
+	}
+}
+
+func TestLoadResumeSessionStatements(t *testing.T) {
+	dumpFileName := writeDump(t, `PRAGMA foreign_keys=ON;
+CREATE TABLE p (id integer primary key);
+CREATE TABLE c (p_id integer references p(id));
+INSERT INTO p VALUES(1);
+INSERT INTO c VALUES(1);
+INSERT INTO c VALUES(2);
+INSERT INTO c VALUES(1);
+`)
+	directory := t.TempDir()
+	targetFileName := filepath.Join(directory, "target.sqlite3")
+	stateFileName := filepath.Join(directory, "state.json")
+
+	exitCode, output := runLoad(t, "-c", "sqlite3://"+targetFileName, "--state-file", stateFileName,
+		"--checkpoint-interval", "1", "--on-error", "stop", dumpFileName)
+	if exitCode != cmd.EXIT_ABORTED {
+		t.Fatalf("the first load exits with %v, want %v:\n%s", exitCode, cmd.EXIT_ABORTED, output)
+	}
+
+	// The skipped PRAGMA is executed again, so the foreign key violation fails on resume too
+	exitCode, output = runLoad(t, "-c", "sqlite3://"+targetFileName, "--resume", stateFileName,
+		"--checkpoint-interval", "1", dumpFileName)
+	if exitCode != cmd.EXIT_PARTIAL {
+		t.Fatalf("the resumed load exits with %v, want %v:\n%s", exitCode, cmd.EXIT_PARTIAL, output)
+	}
+	if count := countRows(t, targetFileName, "c"); count != 2 {
+		t.Errorf("the resumed load has %v rows, want 2:\n%s", count, output)
+	}
+}